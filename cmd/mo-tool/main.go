@@ -16,6 +16,7 @@ package main
 
 import (
 	debug "github.com/matrixorigin/matrixone/cmd/mo-debug"
+	fsck "github.com/matrixorigin/matrixone/cmd/mo-fsck"
 	inspect "github.com/matrixorigin/matrixone/cmd/mo-inspect"
 	"github.com/spf13/cobra"
 	"os"
@@ -30,6 +31,7 @@ func main() {
 
 	rootCmd.AddCommand(debug.PrepareCommand())
 	rootCmd.AddCommand(inspect.PrepareCommand())
+	rootCmd.AddCommand(fsck.PrepareCommand())
 
 	if err := rootCmd.Execute(); err != nil {
 		os.Exit(1)
@@ -80,6 +80,9 @@ var (
 		frontend.MoCatalogMoUpgradeDDL,
 		frontend.MoCatalogMoUpgradeTenantDDL,
 		frontend.MoCatalogMoPitrDDL,
+		frontend.MoCatalogMoCdcTaskDDL,
+		frontend.MoCatalogMoCdcWatermarkDDL,
+		frontend.MoCatalogMoStreamOffsetsDDL,
 	}
 
 	initMoVersionFormat = `insert into %s.%s values ('%s', %d, %d, current_timestamp(), current_timestamp())`
@@ -27,6 +27,9 @@ import (
 var clusterUpgEntries = []versions.UpgradeEntry{
 	upg_mo_pitr,
 	upg_mo_subs,
+	upg_mo_cdc_task,
+	upg_mo_cdc_watermark,
+	upg_mo_stream_offsets,
 	upgradeShardingMetadata,
 	upgradeSharding,
 	upg_drop_idx_task_status,
@@ -75,6 +78,36 @@ var upg_mo_subs = versions.UpgradeEntry{
 	},
 }
 
+var upg_mo_cdc_task = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MO_CDC_TASK,
+	UpgType:   versions.CREATE_NEW_TABLE,
+	UpgSql:    frontend.MoCatalogMoCdcTaskDDL,
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		return versions.CheckTableDefinition(txn, accountId, catalog.MO_CATALOG, catalog.MO_CDC_TASK)
+	},
+}
+
+var upg_mo_cdc_watermark = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MO_CDC_WATERMARK,
+	UpgType:   versions.CREATE_NEW_TABLE,
+	UpgSql:    frontend.MoCatalogMoCdcWatermarkDDL,
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		return versions.CheckTableDefinition(txn, accountId, catalog.MO_CATALOG, catalog.MO_CDC_WATERMARK)
+	},
+}
+
+var upg_mo_stream_offsets = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MO_STREAM_OFFSETS,
+	UpgType:   versions.CREATE_NEW_TABLE,
+	UpgSql:    frontend.MoCatalogMoStreamOffsetsDDL,
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		return versions.CheckTableDefinition(txn, accountId, catalog.MO_CATALOG, catalog.MO_STREAM_OFFSETS)
+	},
+}
+
 // ------------------------------------------------------------------------------------------------------------
 var upg_drop_idx_task_status = versions.UpgradeEntry{
 	Schema:    catalog.MOTaskDB,
@@ -21,12 +21,20 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/frontend"
 	"github.com/matrixorigin/matrixone/pkg/util/executor"
+	"github.com/matrixorigin/matrixone/pkg/util/sysview"
 )
 
 var tenantUpgEntries = []versions.UpgradeEntry{
 	upg_systemMetrics_server_snapshot_usage,
 	upg_mo_snapshots,
 	upg_mo_retention,
+	upg_mo_events,
+	upg_mo_event_history,
+	upg_information_schema_events,
+	upg_mo_triggers,
+	upg_information_schema_triggers,
+	upg_mo_check_constraints,
+	upg_information_schema_check_constraints,
 }
 
 const viewServerSnapshotUsage = "server_snapshot_usage"
@@ -70,3 +78,99 @@ var upg_mo_retention = versions.UpgradeEntry{
 		return versions.CheckTableDefinition(txn, accountId, catalog.MO_CATALOG, catalog.MO_RETENTION)
 	},
 }
+
+var upg_mo_events = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MO_EVENTS,
+	UpgType:   versions.CREATE_NEW_TABLE,
+	UpgSql:    frontend.MoCatalogMoEventsDDL,
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		return versions.CheckTableDefinition(txn, accountId, catalog.MO_CATALOG, catalog.MO_EVENTS)
+	},
+}
+
+var upg_mo_event_history = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MO_EVENT_HISTORY,
+	UpgType:   versions.CREATE_NEW_TABLE,
+	UpgSql:    frontend.MoCatalogMoEventHistoryDDL,
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		return versions.CheckTableDefinition(txn, accountId, catalog.MO_CATALOG, catalog.MO_EVENT_HISTORY)
+	},
+}
+
+// information_schema.EVENTS was an empty table before, it is a view of
+// mo_catalog.mo_events now.
+var upg_information_schema_events = versions.UpgradeEntry{
+	Schema:    sysview.InformationDBConst,
+	TableName: "events",
+	UpgType:   versions.MODIFY_VIEW,
+	UpgSql:    sysview.InformationSchemaEventsDDL,
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		exists, viewDef, err := versions.CheckViewDefinition(txn, accountId, sysview.InformationDBConst, "events")
+		if err != nil {
+			return false, err
+		}
+
+		if exists && viewDef == sysview.InformationSchemaEventsDDL {
+			return true, nil
+		}
+		return false, nil
+	},
+	PreSql: fmt.Sprintf("DROP TABLE IF EXISTS %s.%s;", sysview.InformationDBConst, "events"),
+}
+
+var upg_mo_triggers = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MO_TRIGGERS,
+	UpgType:   versions.CREATE_NEW_TABLE,
+	UpgSql:    frontend.MoCatalogMoTriggersDDL,
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		return versions.CheckTableDefinition(txn, accountId, catalog.MO_CATALOG, catalog.MO_TRIGGERS)
+	},
+}
+
+// information_schema.TRIGGERS was an empty table before, it is a view of
+// mo_catalog.mo_triggers now.
+var upg_information_schema_triggers = versions.UpgradeEntry{
+	Schema:    sysview.InformationDBConst,
+	TableName: "triggers",
+	UpgType:   versions.MODIFY_VIEW,
+	UpgSql:    sysview.InformationSchemaTriggersDDL,
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		exists, viewDef, err := versions.CheckViewDefinition(txn, accountId, sysview.InformationDBConst, "triggers")
+		if err != nil {
+			return false, err
+		}
+
+		if exists && viewDef == sysview.InformationSchemaTriggersDDL {
+			return true, nil
+		}
+		return false, nil
+	},
+	PreSql: fmt.Sprintf("DROP TABLE IF EXISTS %s.%s;", sysview.InformationDBConst, "triggers"),
+}
+
+var upg_mo_check_constraints = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MO_CHECK_CONSTRAINTS,
+	UpgType:   versions.CREATE_NEW_TABLE,
+	UpgSql:    frontend.MoCatalogMoCheckConstraintsDDL,
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		return versions.CheckTableDefinition(txn, accountId, catalog.MO_CATALOG, catalog.MO_CHECK_CONSTRAINTS)
+	},
+}
+
+var upg_information_schema_check_constraints = versions.UpgradeEntry{
+	Schema:    sysview.InformationDBConst,
+	TableName: "check_constraints",
+	UpgType:   versions.CREATE_VIEW,
+	UpgSql:    sysview.InformationSchemaCheckConstraintsDDL,
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		exists, _, err := versions.CheckViewDefinition(txn, accountId, sysview.InformationDBConst, "check_constraints")
+		if err != nil {
+			return false, err
+		}
+		return exists, nil
+	},
+}
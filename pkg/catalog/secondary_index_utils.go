@@ -25,10 +25,11 @@ import (
 
 // Index Algorithm names
 const (
-	MoIndexDefaultAlgo = tree.INDEX_TYPE_INVALID // used by UniqueIndex or default SecondaryIndex
-	MoIndexBTreeAlgo   = tree.INDEX_TYPE_BTREE   // used for Mocking MySQL behaviour.
-	MoIndexIvfFlatAlgo = tree.INDEX_TYPE_IVFFLAT // used for IVF flat index on Vector/Array columns
-	MOIndexMasterAlgo  = tree.INDEX_TYPE_MASTER  // used for Master Index on VARCHAR columns
+	MoIndexDefaultAlgo  = tree.INDEX_TYPE_INVALID  // used by UniqueIndex or default SecondaryIndex
+	MoIndexBTreeAlgo    = tree.INDEX_TYPE_BTREE    // used for Mocking MySQL behaviour.
+	MoIndexIvfFlatAlgo  = tree.INDEX_TYPE_IVFFLAT  // used for IVF flat index on Vector/Array columns
+	MOIndexMasterAlgo   = tree.INDEX_TYPE_MASTER   // used for Master Index on VARCHAR columns
+	MOIndexFullTextAlgo = tree.INDEX_TYPE_FULLTEXT // used for FullText Index on CHAR/VARCHAR/TEXT columns
 )
 
 // ToLower is used for before comparing AlgoType and IndexAlgoParamOpType. Reason why they are strings
@@ -62,8 +63,14 @@ func IsMasterIndexAlgo(algo string) bool {
 	return _algo == MOIndexMasterAlgo.ToString()
 }
 
+func IsFullTextIndexAlgo(algo string) bool {
+	_algo := ToLower(algo)
+	return _algo == MOIndexFullTextAlgo.ToString()
+}
+
 // ------------------------[START] IndexAlgoParams------------------------
 const (
+	IndexAlgoParamParser    = "parser"
 	IndexAlgoParamLists     = "lists"
 	IndexAlgoParamOpType    = "op_type"
 	IndexAlgoParamOpType_l2 = "vector_l2_ops"
@@ -108,6 +115,10 @@ func IndexParamsToStringList(indexParams string) (string, error) {
 	}
 
 	res := ""
+	if val, ok := result[IndexAlgoParamParser]; ok {
+		res += fmt.Sprintf(" WITH PARSER %s", val)
+	}
+
 	if val, ok := result[IndexAlgoParamLists]; ok {
 		res += fmt.Sprintf(" %s = %s ", IndexAlgoParamLists, val)
 	}
@@ -165,6 +176,18 @@ func IndexParamsStringToMap(indexParams string) (map[string]string, error) {
 	return result, nil
 }
 
+// FullTextIndexParser returns the parser of a fulltext index, empty means the default parser.
+func FullTextIndexParser(indexParams string) (string, error) {
+	if len(indexParams) == 0 {
+		return "", nil
+	}
+	params, err := IndexParamsStringToMap(indexParams)
+	if err != nil {
+		return "", err
+	}
+	return params[IndexAlgoParamParser], nil
+}
+
 func indexParamsToMap(def *tree.Index) (map[string]string, error) {
 	res := make(map[string]string)
 
@@ -173,6 +196,10 @@ func indexParamsToMap(def *tree.Index) (map[string]string, error) {
 		// do nothing
 	case tree.INDEX_TYPE_MASTER:
 		// do nothing
+	case tree.INDEX_TYPE_FULLTEXT:
+		if def.IndexOption != nil && len(def.IndexOption.ParserName) > 0 {
+			res[IndexAlgoParamParser] = ToLower(def.IndexOption.ParserName)
+		}
 	case tree.INDEX_TYPE_IVFFLAT:
 		if def.IndexOption.AlgoParamList == 0 {
 			// NOTE:
@@ -239,3 +266,66 @@ func IsAlias(column string) bool {
 }
 
 // ------------------------[END] Aliaser------------------------
+
+// ------------------------[START] ExprKeyPart------------------------
+
+// This code is used by "regular secondary index" and "unique index" to keep the key parts which are not a
+// plain column, ie the functional key part KEY ((lower(a))) and the prefix key part KEY (a(10)).
+// Such a key part is kept in IndexDef.Parts as the sql text of the expression enclosed in parentheses,
+// which can not be a column name, and can be used in a sql directly.
+
+const (
+	MaxExprKeyPartLen = 256
+)
+
+func CreateExprKeyPart(expr string) string {
+	return fmt.Sprintf("(%s)", expr)
+}
+
+func ResolveExprKeyPart(part string) string {
+	return part[1 : len(part)-1]
+}
+
+func IsExprKeyPart(part string) bool {
+	return len(part) > 1 && part[0] == '(' && part[len(part)-1] == ')'
+}
+
+// CreatePrefixKeyPart returns the expression key part of the prefix key part col(length).
+func CreatePrefixKeyPart(col string, length int, binary bool) string {
+	castType := "varchar"
+	if binary {
+		castType = "varbinary"
+	}
+	return CreateExprKeyPart(fmt.Sprintf("cast(substring(`%s`, 1, %d) as %s(%d))", col, length, castType, length))
+}
+
+// ResolvePrefixKeyPart returns the column and the length of the prefix key part,
+// ok is false if the part is not created by CreatePrefixKeyPart.
+func ResolvePrefixKeyPart(part string) (col string, length int, ok bool) {
+	rest, found := strings.CutPrefix(part, "(cast(substring(`")
+	if !found {
+		return "", 0, false
+	}
+	col, rest, found = strings.Cut(rest, "`, 1, ")
+	if !found {
+		return "", 0, false
+	}
+	if _, err := fmt.Sscanf(rest, "%d", &length); err != nil {
+		return "", 0, false
+	}
+	if part != CreatePrefixKeyPart(col, length, false) && part != CreatePrefixKeyPart(col, length, true) {
+		return "", 0, false
+	}
+	return col, length, true
+}
+
+func HasExprKeyPart(parts []string) bool {
+	for _, part := range parts {
+		if IsExprKeyPart(part) {
+			return true
+		}
+	}
+	return false
+}
+
+// ------------------------[END] ExprKeyPart------------------------
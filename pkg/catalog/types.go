@@ -150,6 +150,27 @@ const (
 	MO_PITR = "mo_pitr"
 
 	MO_RETENTION = "mo_retention"
+
+	// MO_EVENTS the scheduled sql events of the account
+	MO_EVENTS = "mo_events"
+
+	// MO_EVENT_HISTORY the execution history of the scheduled sql events
+	MO_EVENT_HISTORY = "mo_event_history"
+
+	// MO_TRIGGERS the row level triggers of the account
+	MO_TRIGGERS = "mo_triggers"
+
+	// MO_CHECK_CONSTRAINTS the check constraints of the tables of the account
+	MO_CHECK_CONSTRAINTS = "mo_check_constraints"
+
+	// MO_CDC_TASK cdc task meta table
+	MO_CDC_TASK = "mo_cdc_task"
+
+	// MO_CDC_WATERMARK the watermarks of the tables of cdc tasks
+	MO_CDC_WATERMARK = "mo_cdc_watermark"
+
+	// MO_STREAM_OFFSETS the consumed offsets of the kafka connector tasks
+	MO_STREAM_OFFSETS = "mo_stream_offsets"
 )
 
 const (
@@ -202,6 +223,12 @@ const (
 	SystemRelAttr_CatalogVersion = "catalog_version"
 	SystemRelAttr_CPKey          = CPrimaryKeyColName
 
+	// the table properties of the compression of the column data,
+	// see objectio.CompressOption
+	PropCompressAlg    = "compress_alg"
+	PropCompressLevel  = "compress_level"
+	PropColumnEncoding = "column_encoding"
+
 	// 'mo_indexes' table
 	IndexAlgoName      = "algo"
 	IndexAlgoTableType = "algo_table_type"
@@ -326,6 +353,18 @@ const (
 	SystemSI_IVFFLAT_TblCol_Entries_id      = "__mo_index_centroid_fk_id"
 	SystemSI_IVFFLAT_TblCol_Entries_pk      = IndexTablePrimaryColName
 	SystemSI_IVFFLAT_TblCol_Entries_entry   = "__mo_index_centroid_fk_entry"
+
+	/************ 3. FULLTEXT Secondary Index ************/
+
+	// FULLTEXT index table columns, one row for each word of a document.
+	// the key column is serial(doc_id, pos), the doc_id column is the primary key of the document.
+	FullTextIndexTableKeyColName   = IndexTableIndexColName
+	FullTextIndexTableDocIdColName = IndexTablePrimaryColName
+	FullTextIndexTableWordColName  = "__mo_index_word"
+	FullTextIndexTablePosColName   = "__mo_index_pos"
+
+	// the score column of fulltext_index_scan(), which returns <doc_id, score> of the matched documents.
+	FullTextIndexScanScoreColName = "__mo_index_score"
 )
 
 const (
@@ -0,0 +1,251 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	mokafka "github.com/matrixorigin/matrixone/pkg/stream/adapter/kafka"
+)
+
+const (
+	kafkaOpInsert = "insert"
+	kafkaOpDelete = "delete"
+)
+
+// kafkaMessage is the value of a message published by the kafka sinker.
+// Data contains all the columns of an inserted row, or the primary key
+// columns of a deleted row.
+type kafkaMessage struct {
+	Database string         `json:"database"`
+	Table    string         `json:"table"`
+	Op       string         `json:"op"`
+	Ts       string         `json:"ts"`
+	Data     map[string]any `json:"data"`
+}
+
+var _ Sinker = new(kafkaSinker)
+
+// kafkaSinker publishes the changes of a table to a kafka topic. Each change
+// is a json message keyed by the primary key, so the changes of one row go
+// to the same partition in order. Like the mysqlSinker, only the last change
+// of each primary key is kept within one flush.
+type kafkaSinker struct {
+	producer mokafka.Producer
+	topic    string
+	info     *DbTableInfo
+
+	cols     []int
+	colNames []string
+	pkPos    int
+	// pkCols are the positions of the primary key columns in the data batch.
+	pkCols  []int
+	pkNames []string
+	pkTypes []types.Type
+
+	pending pendingRows
+}
+
+// NewKafkaSinker creates a Sinker which publishes the changes of the table
+// described by tableDef to the topic.
+func NewKafkaSinker(
+	ctx context.Context,
+	producer mokafka.Producer,
+	topic string,
+	info *DbTableInfo,
+	tableDef *plan.TableDef,
+) (Sinker, error) {
+	if tableDef.Pkey == nil || catalog.IsFakePkName(tableDef.Pkey.PkeyColName) {
+		return nil, moerr.NewNotSupportedf(ctx, "cdc for table %s.%s without primary key",
+			info.SourceDbName, info.SourceTblName)
+	}
+	s := &kafkaSinker{
+		producer: producer,
+		topic:    topic,
+		info:     info,
+		pkPos:    -1,
+		pending:  make(pendingRows),
+	}
+	colPos := make(map[string]int)
+	colTypes := make(map[string]types.Type)
+	pos := 0
+	for _, col := range tableDef.Cols {
+		if col.Name == catalog.Row_ID {
+			continue
+		}
+		colPos[col.Name] = pos
+		colTypes[col.Name] = types.New(types.T(col.Typ.Id), col.Typ.Width, col.Typ.Scale)
+		if col.Name == tableDef.Pkey.PkeyColName {
+			s.pkPos = pos
+		}
+		if !col.Hidden {
+			s.cols = append(s.cols, pos)
+			s.colNames = append(s.colNames, col.Name)
+		}
+		pos++
+	}
+	if s.pkPos < 0 {
+		return nil, moerr.NewInternalErrorf(ctx, "primary key %s not found in table %s.%s",
+			tableDef.Pkey.PkeyColName, info.SourceDbName, info.SourceTblName)
+	}
+	s.pkNames = tableDef.Pkey.Names
+	for _, name := range s.pkNames {
+		s.pkCols = append(s.pkCols, colPos[name])
+		s.pkTypes = append(s.pkTypes, colTypes[name])
+	}
+	return s, nil
+}
+
+func (s *kafkaSinker) Sink(ctx context.Context, data, tombstone *batch.Batch, snapshot bool) error {
+	if data != nil {
+		tsVec := data.Vecs[len(data.Vecs)-1]
+		for i := 0; i < data.RowCount(); i++ {
+			ts := vector.GetFixedAtNoTypeCheck[types.TS](tsVec, i)
+			key := string(data.Vecs[s.pkPos].GetRawBytesAt(i))
+			if !snapshot && !s.pending.shouldReplace(key, ts, false) {
+				continue
+			}
+			row, err := s.insertMessage(ctx, data, i, ts)
+			if err != nil {
+				return err
+			}
+			if snapshot {
+				// rows of a snapshot have distinct primary keys, publish
+				// them directly.
+				if _, err = s.producer.ProduceMessage(s.topic, row.key, row.literal); err != nil {
+					return err
+				}
+				continue
+			}
+			s.pending[key] = row
+		}
+	}
+	if tombstone != nil {
+		pkVec, tsVec := tombstone.Vecs[0], tombstone.Vecs[1]
+		for i := 0; i < tombstone.RowCount(); i++ {
+			ts := vector.GetFixedAtNoTypeCheck[types.TS](tsVec, i)
+			key := string(pkVec.GetRawBytesAt(i))
+			if !s.pending.shouldReplace(key, ts, true) {
+				continue
+			}
+			row, err := s.deleteMessage(ctx, pkVec, i, ts)
+			if err != nil {
+				return err
+			}
+			s.pending[key] = row
+		}
+	}
+	return nil
+}
+
+func (s *kafkaSinker) Flush(ctx context.Context) error {
+	for _, key := range s.pending.sortedKeys() {
+		row := s.pending[key]
+		if _, err := s.producer.ProduceMessage(s.topic, row.key, row.literal); err != nil {
+			return err
+		}
+	}
+	s.Reset()
+	return nil
+}
+
+func (s *kafkaSinker) Reset() {
+	s.pending = make(pendingRows)
+}
+
+func (s *kafkaSinker) insertMessage(ctx context.Context, data *batch.Batch, row int, ts types.TS) (*pendingRow, error) {
+	values := make(map[string]any, len(s.cols))
+	for i, pos := range s.cols {
+		v, err := jsonValue(ctx, data.Vecs[pos], row)
+		if err != nil {
+			return nil, err
+		}
+		values[s.colNames[i]] = v
+	}
+	pk := make(map[string]any, len(s.pkNames))
+	for i, name := range s.pkNames {
+		v, err := jsonValue(ctx, data.Vecs[s.pkCols[i]], row)
+		if err != nil {
+			return nil, err
+		}
+		pk[name] = v
+	}
+	return s.newMessage(kafkaOpInsert, ts, pk, values)
+}
+
+func (s *kafkaSinker) deleteMessage(ctx context.Context, pkVec *vector.Vector, row int, ts types.TS) (*pendingRow, error) {
+	pk := make(map[string]any, len(s.pkNames))
+	if len(s.pkNames) == 1 {
+		v, err := jsonValue(ctx, pkVec, row)
+		if err != nil {
+			return nil, err
+		}
+		pk[s.pkNames[0]] = v
+	} else {
+		tuple, err := types.Unpack(pkVec.GetBytesAt(row))
+		if err != nil {
+			return nil, err
+		}
+		if len(tuple) != len(s.pkTypes) {
+			return nil, moerr.NewInternalErrorf(ctx, "invalid compound primary key of %s, expect %d columns, got %d",
+				s.info.SourceTblName, len(s.pkTypes), len(tuple))
+		}
+		for i, val := range tuple {
+			if pk[s.pkNames[i]], err = jsonTupleValue(ctx, val, s.pkTypes[i]); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return s.newMessage(kafkaOpDelete, ts, pk, pk)
+}
+
+func (s *kafkaSinker) newMessage(op string, ts types.TS, pk, data map[string]any) (*pendingRow, error) {
+	key, err := json.Marshal(pk)
+	if err != nil {
+		return nil, err
+	}
+	value, err := json.Marshal(kafkaMessage{
+		Database: s.info.SourceDbName,
+		Table:    s.info.SourceTblName,
+		Op:       op,
+		Ts:       ts.ToString(),
+		Data:     data,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pendingRow{
+		ts:      ts,
+		deleted: op == kafkaOpDelete,
+		literal: value,
+		key:     key,
+	}, nil
+}
+
+// newKafkaProducer creates the producer publishing to the brokers of the
+// uri.
+func newKafkaProducer(info KafkaUriInfo) (mokafka.Producer, error) {
+	return mokafka.NewKafkaProducer(&kafka.ConfigMap{
+		"bootstrap.servers": info.Brokers,
+	})
+}
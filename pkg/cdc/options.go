@@ -0,0 +1,131 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+const (
+	OptStartTs              = "startts"
+	OptEndTs                = "endts"
+	OptNoFull               = "nofull"
+	OptFullConcurrency      = "fullconcurrency"
+	OptFullDMLRetry         = "fulldmlretry"
+	OptIncrementalDMLRetry  = "incrementaldmlretry"
+	OptIncrementalFrequency = "frequency"
+
+	defaultFullConcurrency      = 4
+	defaultIncrementalFrequency = time.Second
+)
+
+// TaskOptions are the options of a cdc task. It is stored as json in
+// mo_catalog.mo_cdc_task.
+type TaskOptions struct {
+	// StartTs is the ts to start capturing changes from. It implies NoFull.
+	StartTs string `json:"start_ts,omitempty"`
+	// EndTs is the ts to stop capturing changes at.
+	EndTs string `json:"end_ts,omitempty"`
+	// NoFull skips the full snapshot, only the changes committed after the
+	// task is created are captured.
+	NoFull bool `json:"no_full,omitempty"`
+	// FullConcurrency is the max number of tables reading the full
+	// snapshot at the same time.
+	FullConcurrency int `json:"full_concurrency,omitempty"`
+	// FullDMLRetry and IncrementalDMLRetry are the retry times of sending
+	// the statements to the sink.
+	FullDMLRetry        int `json:"full_dml_retry,omitempty"`
+	IncrementalDMLRetry int `json:"incremental_dml_retry,omitempty"`
+	// Frequency is the interval in milliseconds to pull the changes.
+	Frequency int64 `json:"frequency,omitempty"`
+}
+
+// ParseTaskOptions parses the options of CREATE CDC, which are key value
+// pairs. Keys are case insensitive, and the datetime values of StartTs and
+// EndTs are interpreted in loc.
+func ParseTaskOptions(ctx context.Context, kvs []string, loc *time.Location) (TaskOptions, error) {
+	opts := TaskOptions{
+		FullConcurrency: defaultFullConcurrency,
+	}
+	if len(kvs)%2 != 0 {
+		return opts, moerr.NewInternalError(ctx, "invalid cdc options")
+	}
+	for i := 0; i < len(kvs); i += 2 {
+		key, val := strings.ToLower(strings.TrimSpace(kvs[i])), strings.TrimSpace(kvs[i+1])
+		var err error
+		switch key {
+		case OptStartTs:
+			opts.StartTs, err = parseOptionTs(ctx, key, val, loc)
+		case OptEndTs:
+			opts.EndTs, err = parseOptionTs(ctx, key, val, loc)
+		case OptNoFull:
+			opts.NoFull, err = strconv.ParseBool(val)
+		case OptFullConcurrency:
+			opts.FullConcurrency, err = parsePositiveInt(val)
+		case OptFullDMLRetry:
+			opts.FullDMLRetry, err = parsePositiveInt(val)
+		case OptIncrementalDMLRetry:
+			opts.IncrementalDMLRetry, err = parsePositiveInt(val)
+		case OptIncrementalFrequency:
+			var d time.Duration
+			if d, err = time.ParseDuration(val); err == nil && d <= 0 {
+				err = moerr.NewInternalErrorNoCtx("not positive")
+			}
+			opts.Frequency = d.Milliseconds()
+		default:
+			return opts, moerr.NewInternalErrorf(ctx, "unsupported cdc option %q", kvs[i])
+		}
+		if err != nil {
+			return opts, moerr.NewInternalErrorf(ctx, "invalid value %q of cdc option %q", val, kvs[i])
+		}
+	}
+	if opts.StartTs != "" && opts.EndTs != "" {
+		start, end := types.StringToTS(opts.StartTs), types.StringToTS(opts.EndTs)
+		if !start.Less(&end) {
+			return opts, moerr.NewInternalError(ctx, "the start ts of cdc should be less than the end ts")
+		}
+	}
+	return opts, nil
+}
+
+// GetFrequency returns the interval to pull the incremental changes.
+func (opts TaskOptions) GetFrequency() time.Duration {
+	if opts.Frequency <= 0 {
+		return defaultIncrementalFrequency
+	}
+	return time.Duration(opts.Frequency) * time.Millisecond
+}
+
+func parseOptionTs(ctx context.Context, key, val string, loc *time.Location) (string, error) {
+	t, err := time.ParseInLocation(time.DateTime, val, loc)
+	if err != nil {
+		return "", err
+	}
+	return types.BuildTS(t.UnixNano(), 0).ToString(), nil
+}
+
+func parsePositiveInt(val string) (int, error) {
+	n, err := strconv.Atoi(val)
+	if err == nil && n <= 0 {
+		err = moerr.NewInternalErrorNoCtx("not positive")
+	}
+	return n, err
+}
@@ -0,0 +1,220 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/txn/client"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"go.uber.org/zap"
+)
+
+// sinkerFactory creates the Sinker of a table with its definition.
+type sinkerFactory func(ctx context.Context, tableDef *plan.TableDef) (Sinker, error)
+
+// tableReader pulls the changes of one source table periodically, sends
+// them to the sink and advances the watermark of the table. The watermark is
+// only advanced after the changes are flushed, so the changes between the
+// watermark and the failure point are replayed after a restart.
+type tableReader struct {
+	accountId uint32
+	info      *DbTableInfo
+	opts      *TaskOptions
+	eng       engine.Engine
+	txnClient client.TxnClient
+	newSinker sinkerFactory
+	wm        WatermarkUpdater
+	mp        *mpool.MPool
+	// fullSem limits the number of tables reading the full snapshot at the
+	// same time.
+	fullSem chan struct{}
+
+	sinker    Sinker
+	watermark types.TS
+	endTs     types.TS
+}
+
+func newTableReader(
+	accountId uint32,
+	info *DbTableInfo,
+	opts *TaskOptions,
+	eng engine.Engine,
+	txnClient client.TxnClient,
+	newSinker sinkerFactory,
+	wm WatermarkUpdater,
+	mp *mpool.MPool,
+	fullSem chan struct{},
+) *tableReader {
+	r := &tableReader{
+		accountId: accountId,
+		info:      info,
+		opts:      opts,
+		eng:       eng,
+		txnClient: txnClient,
+		newSinker: newSinker,
+		wm:        wm,
+		mp:        mp,
+		fullSem:   fullSem,
+	}
+	if opts.EndTs != "" {
+		r.endTs = types.StringToTS(opts.EndTs)
+	}
+	return r
+}
+
+// Run reads the changes until the end ts is reached or ctx is done.
+func (r *tableReader) Run(ctx context.Context) error {
+	var err error
+	if r.watermark, err = r.wm.Get(ctx, r.info); err != nil {
+		return err
+	}
+	if r.watermark.IsEmpty() && (r.opts.NoFull || r.opts.StartTs != "") {
+		// skip the full snapshot, start from the specified ts or now.
+		if r.opts.StartTs != "" {
+			r.watermark = types.StringToTS(r.opts.StartTs)
+		} else {
+			r.watermark = types.BuildTS(time.Now().UnixNano(), 0)
+		}
+		if err = r.wm.Update(ctx, r.info, r.watermark); err != nil {
+			return err
+		}
+	}
+
+	ticker := time.NewTicker(r.opts.GetFrequency())
+	defer ticker.Stop()
+	for {
+		if r.reachEnd() {
+			logutil.Info("cdc table reader reached the end ts",
+				zap.String("table", r.info.String()),
+				zap.String("end-ts", r.endTs.ToString()))
+			return nil
+		}
+		if err = r.readOnce(ctx); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *tableReader) reachEnd() bool {
+	return !r.endTs.IsEmpty() && !r.watermark.Less(&r.endTs)
+}
+
+// readOnce reads the changes in (watermark, now] in a new read only txn.
+func (r *tableReader) readOnce(ctx context.Context) (err error) {
+	txnOp, err := r.txnClient.New(ctx, timestamp.Timestamp{},
+		client.WithTxnReadyOnly(),
+		client.WithTxnCreateBy(r.accountId, "", "cdc", 0))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = txnOp.Rollback(ctx)
+	}()
+	if err = r.eng.New(ctx, txnOp); err != nil {
+		return err
+	}
+
+	to := types.TimestampToTS(txnOp.SnapshotTS())
+	if !r.endTs.IsEmpty() && r.endTs.Less(&to) {
+		to = r.endTs
+	}
+	if !r.watermark.Less(&to) {
+		return nil
+	}
+
+	db, err := r.eng.Database(ctx, r.info.SourceDbName, txnOp)
+	if err != nil {
+		return err
+	}
+	rel, err := db.Relation(ctx, r.info.SourceTblName, nil)
+	if err != nil {
+		return err
+	}
+	if r.sinker == nil {
+		if r.sinker, err = r.newSinker(ctx, rel.CopyTableDef(ctx)); err != nil {
+			return err
+		}
+	}
+
+	from := r.watermark
+	if from.IsEmpty() {
+		// the full snapshot at to.
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case r.fullSem <- struct{}{}:
+		}
+		defer func() {
+			<-r.fullSem
+		}()
+	} else {
+		// the range of CollectChanges is inclusive, and the changes at the
+		// watermark have been sent.
+		from = from.Next()
+	}
+
+	handle, err := rel.CollectChanges(ctx, from, to, r.mp)
+	if err != nil {
+		return err
+	}
+	defer handle.Close()
+
+	r.sinker.Reset()
+	for {
+		data, tombstone, hint, err := handle.Next(ctx, r.mp)
+		if err == nil {
+			err = r.sinker.Sink(ctx, data, tombstone, hint == engine.ChangesHandle_Snapshot)
+		}
+		if data != nil {
+			data.Clean(r.mp)
+		}
+		if tombstone != nil {
+			tombstone.Clean(r.mp)
+		}
+		if moerr.IsMoErrCode(err, moerr.OkExpectedEOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if data == nil && tombstone == nil {
+			// the handle returns nothing if ctx is done.
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+		}
+	}
+	if err = r.sinker.Flush(ctx); err != nil {
+		return err
+	}
+	if err = r.wm.Update(ctx, r.info, to); err != nil {
+		return err
+	}
+	r.watermark = to
+	return nil
+}
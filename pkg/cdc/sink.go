@@ -0,0 +1,121 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultSinkRetryTimes    = 3
+	defaultSinkRetryInterval = time.Second
+)
+
+var _ Sink = new(mysqlSink)
+
+// mysqlSink sends statements to a MySQL compatible server. All the
+// statements of one Send are executed in one transaction, and the whole
+// transaction is retried if it fails.
+type mysqlSink struct {
+	db            *sql.DB
+	retryInterval time.Duration
+}
+
+// NewMysqlSink opens a connection pool to the server described by info.
+func NewMysqlSink(ctx context.Context, info UriInfo) (Sink, error) {
+	cfg := mysql.NewConfig()
+	cfg.User = info.User
+	cfg.Passwd = info.Password
+	cfg.Net = "tcp"
+	cfg.Addr = fmt.Sprintf("%s:%d", info.Ip, info.Port)
+	cfg.Params = map[string]string{
+		// the values of time types are formatted in UTC.
+		"time_zone": "'+00:00'",
+	}
+	connector, err := mysql.NewConnector(cfg)
+	if err != nil {
+		return nil, err
+	}
+	db := sql.OpenDB(connector)
+	db.SetMaxOpenConns(1)
+	if err = db.PingContext(ctx); err != nil {
+		_ = db.Close()
+		return nil, moerr.NewInternalErrorf(ctx, "failed to connect to sink %s: %v", info.String(), err)
+	}
+	return newMysqlSinkWithDB(db), nil
+}
+
+func newMysqlSinkWithDB(db *sql.DB) *mysqlSink {
+	return &mysqlSink{
+		db:            db,
+		retryInterval: defaultSinkRetryInterval,
+	}
+}
+
+func (s *mysqlSink) Send(ctx context.Context, sqls []string, retryTimes int) (err error) {
+	if len(sqls) == 0 {
+		return nil
+	}
+	if retryTimes <= 0 {
+		retryTimes = defaultSinkRetryTimes
+	}
+	for i := 0; i < retryTimes; i++ {
+		if err = s.sendInTxn(ctx, sqls); err == nil {
+			return nil
+		}
+		logutil.Error("cdc sink send failed",
+			zap.Int("retry", i),
+			zap.Error(err))
+		if i == retryTimes-1 {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(s.retryInterval):
+		}
+	}
+	return err
+}
+
+func (s *mysqlSink) sendInTxn(ctx context.Context, sqls []string) (err error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	for _, stmt := range sqls {
+		if _, err = tx.ExecContext(ctx, stmt); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (s *mysqlSink) Close() {
+	_ = s.db.Close()
+}
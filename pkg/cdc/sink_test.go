@@ -0,0 +1,66 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMysqlSinkSend(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	s := newMysqlSinkWithDB(db)
+	defer s.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("DELETE 1")).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("REPLACE 2")).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	require.NoError(t, s.Send(context.Background(), []string{"DELETE 1", "REPLACE 2"}, 1))
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// nothing to send
+	require.NoError(t, s.Send(context.Background(), nil, 1))
+}
+
+func TestMysqlSinkRetry(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	s := newMysqlSinkWithDB(db)
+	s.retryInterval = time.Millisecond
+	defer s.Close()
+
+	// the whole transaction is retried.
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("REPLACE 1")).WillReturnError(moerr.NewInternalErrorNoCtx("failed"))
+	mock.ExpectRollback()
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("REPLACE 1")).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	require.NoError(t, s.Send(context.Background(), []string{"REPLACE 1"}, 2))
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	mock.ExpectBegin().WillReturnError(moerr.NewInternalErrorNoCtx("failed"))
+	assert.Error(t, s.Send(context.Background(), []string{"REPLACE 1"}, 1))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,327 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+const (
+	// defaultMaxSqlSize is the max size of one statement sent to the sink.
+	defaultMaxSqlSize = 4 * 1024 * 1024
+)
+
+var _ Sinker = new(mysqlSinker)
+
+// pendingRow is the last change of a primary key which is not flushed yet.
+type pendingRow struct {
+	ts      types.TS
+	deleted bool
+	// literal is the sql literal of the primary key for a deleted row,
+	// or the value tuple of the whole row for an inserted row. It is the
+	// message value for the kafka sinker.
+	literal []byte
+	// key is the message key of the kafka sinker.
+	key []byte
+}
+
+// pendingRows are the pending changes keyed by the primary key.
+type pendingRows map[string]*pendingRow
+
+// shouldReplace returns true if the change of key at ts is newer than the
+// pending one. An update is a delete and an insert with the same ts, so the
+// insert wins if both of them have the same ts.
+func (p pendingRows) shouldReplace(key string, ts types.TS, deleted bool) bool {
+	old, ok := p[key]
+	if !ok {
+		return true
+	}
+	if c := ts.Compare(&old.ts); c != 0 {
+		return c > 0
+	}
+	return !deleted
+}
+
+// sortedKeys returns the keys in order, it keeps the output stable and easy
+// to be tested and traced.
+func (p pendingRows) sortedKeys() []string {
+	keys := make([]string, 0, len(p))
+	for key := range p {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// mysqlSinker converts the changes of a table into REPLACE and DELETE
+// statements. Within one flush, only the last change of each primary key
+// is kept, so the statements are idempotent and can be replayed safely
+// after a failure between sending them and persisting the watermark.
+type mysqlSinker struct {
+	sink       Sink
+	info       *DbTableInfo
+	maxSqlSize int
+	// fullRetry and incrementalRetry are the retry times of sending the
+	// statements of the snapshot and of the incremental changes.
+	fullRetry        int
+	incrementalRetry int
+
+	// cols are the positions and names of the visible columns in the
+	// data batch.
+	cols     []int
+	colNames []string
+	// pkPos is the position of the primary key column in the data batch,
+	// it is the hidden compound key column if the table has a compound
+	// primary key.
+	pkPos   int
+	pkNames []string
+	pkTypes []types.Type
+
+	insertPrefix []byte
+	deletePrefix []byte
+
+	pending pendingRows
+}
+
+// NewMysqlSinker creates a Sinker which writes the changes of the table
+// described by tableDef into the sink table of info.
+func NewMysqlSinker(
+	ctx context.Context,
+	sink Sink,
+	info *DbTableInfo,
+	tableDef *plan.TableDef,
+	opts *TaskOptions,
+) (Sinker, error) {
+	if tableDef.Pkey == nil || catalog.IsFakePkName(tableDef.Pkey.PkeyColName) {
+		return nil, moerr.NewNotSupportedf(ctx, "cdc for table %s.%s without primary key",
+			info.SourceDbName, info.SourceTblName)
+	}
+	s := &mysqlSinker{
+		sink:       sink,
+		info:       info,
+		maxSqlSize: defaultMaxSqlSize,
+		pkPos:      -1,
+		pending:    make(pendingRows),
+
+		fullRetry:        opts.FullDMLRetry,
+		incrementalRetry: opts.IncrementalDMLRetry,
+	}
+	colTypes := make(map[string]types.Type)
+	pos := 0
+	for _, col := range tableDef.Cols {
+		if col.Name == catalog.Row_ID {
+			continue
+		}
+		typ := types.New(types.T(col.Typ.Id), col.Typ.Width, col.Typ.Scale)
+		colTypes[col.Name] = typ
+		if col.Name == tableDef.Pkey.PkeyColName {
+			s.pkPos = pos
+		}
+		if !col.Hidden {
+			s.cols = append(s.cols, pos)
+			s.colNames = append(s.colNames, col.Name)
+		}
+		pos++
+	}
+	if s.pkPos < 0 {
+		return nil, moerr.NewInternalErrorf(ctx, "primary key %s not found in table %s.%s",
+			tableDef.Pkey.PkeyColName, info.SourceDbName, info.SourceTblName)
+	}
+	s.pkNames = tableDef.Pkey.Names
+	for _, name := range s.pkNames {
+		s.pkTypes = append(s.pkTypes, colTypes[name])
+	}
+
+	s.insertPrefix = append(s.insertPrefix, "REPLACE INTO "...)
+	s.insertPrefix = appendIdentifier(s.insertPrefix, info.SinkDbName)
+	s.insertPrefix = append(s.insertPrefix, '.')
+	s.insertPrefix = appendIdentifier(s.insertPrefix, info.SinkTblName)
+	s.insertPrefix = append(s.insertPrefix, " ("...)
+	for i, name := range s.colNames {
+		if i > 0 {
+			s.insertPrefix = append(s.insertPrefix, ',')
+		}
+		s.insertPrefix = appendIdentifier(s.insertPrefix, name)
+	}
+	s.insertPrefix = append(s.insertPrefix, ") VALUES "...)
+
+	s.deletePrefix = append(s.deletePrefix, "DELETE FROM "...)
+	s.deletePrefix = appendIdentifier(s.deletePrefix, info.SinkDbName)
+	s.deletePrefix = append(s.deletePrefix, '.')
+	s.deletePrefix = appendIdentifier(s.deletePrefix, info.SinkTblName)
+	s.deletePrefix = append(s.deletePrefix, " WHERE "...)
+	if len(s.pkNames) > 1 {
+		s.deletePrefix = append(s.deletePrefix, '(')
+	}
+	for i, name := range s.pkNames {
+		if i > 0 {
+			s.deletePrefix = append(s.deletePrefix, ',')
+		}
+		s.deletePrefix = appendIdentifier(s.deletePrefix, name)
+	}
+	if len(s.pkNames) > 1 {
+		s.deletePrefix = append(s.deletePrefix, ')')
+	}
+	s.deletePrefix = append(s.deletePrefix, " IN ("...)
+	return s, nil
+}
+
+func (s *mysqlSinker) Sink(ctx context.Context, data, tombstone *batch.Batch, snapshot bool) error {
+	if snapshot {
+		// rows of a snapshot are all visible at the same ts and have
+		// distinct primary keys, send them directly.
+		if data == nil || data.RowCount() == 0 {
+			return nil
+		}
+		rows := make([][]byte, 0, data.RowCount())
+		for i := 0; i < data.RowCount(); i++ {
+			row, err := s.rowLiteral(ctx, data, i)
+			if err != nil {
+				return err
+			}
+			rows = append(rows, row)
+		}
+		return s.sink.Send(ctx, s.buildSqls(s.insertPrefix, "", rows), s.fullRetry)
+	}
+
+	if data != nil {
+		tsVec := data.Vecs[len(data.Vecs)-1]
+		for i := 0; i < data.RowCount(); i++ {
+			ts := vector.GetFixedAtNoTypeCheck[types.TS](tsVec, i)
+			key := string(data.Vecs[s.pkPos].GetRawBytesAt(i))
+			if !s.pending.shouldReplace(key, ts, false) {
+				continue
+			}
+			row, err := s.rowLiteral(ctx, data, i)
+			if err != nil {
+				return err
+			}
+			s.pending[key] = &pendingRow{ts: ts, literal: row}
+		}
+	}
+	if tombstone != nil {
+		pkVec, tsVec := tombstone.Vecs[0], tombstone.Vecs[1]
+		for i := 0; i < tombstone.RowCount(); i++ {
+			ts := vector.GetFixedAtNoTypeCheck[types.TS](tsVec, i)
+			key := string(pkVec.GetRawBytesAt(i))
+			if !s.pending.shouldReplace(key, ts, true) {
+				continue
+			}
+			literal, err := s.pkLiteral(ctx, pkVec, i)
+			if err != nil {
+				return err
+			}
+			s.pending[key] = &pendingRow{ts: ts, deleted: true, literal: literal}
+		}
+	}
+	return nil
+}
+
+func (s *mysqlSinker) Flush(ctx context.Context) error {
+	if len(s.pending) == 0 {
+		return nil
+	}
+	var deletes, inserts [][]byte
+	for _, key := range s.pending.sortedKeys() {
+		row := s.pending[key]
+		if row.deleted {
+			deletes = append(deletes, row.literal)
+		} else {
+			inserts = append(inserts, row.literal)
+		}
+	}
+	sqls := s.buildSqls(s.deletePrefix, ")", deletes)
+	sqls = append(sqls, s.buildSqls(s.insertPrefix, "", inserts)...)
+	if err := s.sink.Send(ctx, sqls, s.incrementalRetry); err != nil {
+		return err
+	}
+	s.Reset()
+	return nil
+}
+
+func (s *mysqlSinker) Reset() {
+	s.pending = make(pendingRows)
+}
+
+// buildSqls joins the literals between the prefix and the suffix, and splits
+// them into statements no larger than maxSqlSize.
+func (s *mysqlSinker) buildSqls(prefix []byte, suffix string, literals [][]byte) []string {
+	if len(literals) == 0 {
+		return nil
+	}
+	var sqls []string
+	buf := append([]byte(nil), prefix...)
+	n := 0
+	for _, literal := range literals {
+		if n > 0 && len(buf)+len(literal)+len(suffix)+1 > s.maxSqlSize {
+			buf = append(buf, suffix...)
+			sqls = append(sqls, string(buf))
+			buf = append(buf[:0], prefix...)
+			n = 0
+		}
+		if n > 0 {
+			buf = append(buf, ',')
+		}
+		buf = append(buf, literal...)
+		n++
+	}
+	buf = append(buf, suffix...)
+	return append(sqls, string(buf))
+}
+
+func (s *mysqlSinker) rowLiteral(ctx context.Context, data *batch.Batch, row int) (buf []byte, err error) {
+	buf = append(buf, '(')
+	for i, pos := range s.cols {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+		if buf, err = appendSqlValue(ctx, buf, data.Vecs[pos], row); err != nil {
+			return nil, err
+		}
+	}
+	return append(buf, ')'), nil
+}
+
+func (s *mysqlSinker) pkLiteral(ctx context.Context, pkVec *vector.Vector, row int) (buf []byte, err error) {
+	if len(s.pkNames) == 1 {
+		return appendSqlValue(ctx, buf, pkVec, row)
+	}
+	tuple, err := types.Unpack(pkVec.GetBytesAt(row))
+	if err != nil {
+		return nil, err
+	}
+	if len(tuple) != len(s.pkTypes) {
+		return nil, moerr.NewInternalErrorf(ctx, "invalid compound primary key of %s, expect %d columns, got %d",
+			s.info.SourceTblName, len(s.pkTypes), len(tuple))
+	}
+	buf = append(buf, '(')
+	for i, val := range tuple {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+		if buf, err = appendTupleValue(ctx, buf, val, s.pkTypes[i]); err != nil {
+			return nil, err
+		}
+	}
+	return append(buf, ')'), nil
+}
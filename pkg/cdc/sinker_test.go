@@ -0,0 +1,179 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	kafkatestutil "github.com/matrixorigin/matrixone/pkg/stream/adapter/kafka/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testSink struct {
+	sqls [][]string
+}
+
+func (s *testSink) Send(_ context.Context, sqls []string, _ int) error {
+	s.sqls = append(s.sqls, sqls)
+	return nil
+}
+
+func (s *testSink) Close() {}
+
+func newTestTableDef() *plan.TableDef {
+	return &plan.TableDef{
+		Cols: []*plan.ColDef{
+			{Name: "id", Typ: plan.Type{Id: int32(types.T_int32)}},
+			{Name: "name", Typ: plan.Type{Id: int32(types.T_varchar), Width: 20}},
+			{Name: catalog.Row_ID, Typ: plan.Type{Id: int32(types.T_Rowid)}, Hidden: true},
+		},
+		Pkey: &plan.PrimaryKeyDef{
+			PkeyColName: "id",
+			Names:       []string{"id"},
+		},
+	}
+}
+
+func newTestData(t *testing.T, mp *mpool.MPool, ids []int32, names []string, ts []int64) *batch.Batch {
+	bat := batch.NewWithSize(3)
+	bat.Vecs[0] = vector.NewVec(types.T_int32.ToType())
+	bat.Vecs[1] = vector.NewVec(types.T_varchar.ToType())
+	bat.Vecs[2] = vector.NewVec(types.T_TS.ToType())
+	for i := range ids {
+		require.NoError(t, vector.AppendFixed(bat.Vecs[0], ids[i], false, mp))
+		require.NoError(t, vector.AppendBytes(bat.Vecs[1], []byte(names[i]), names[i] == "", mp))
+		require.NoError(t, vector.AppendFixed(bat.Vecs[2], types.BuildTS(ts[i], 0), false, mp))
+	}
+	bat.SetRowCount(len(ids))
+	return bat
+}
+
+func newTestTombstone(t *testing.T, mp *mpool.MPool, ids []int32, ts []int64) *batch.Batch {
+	bat := batch.NewWithSize(2)
+	bat.Vecs[0] = vector.NewVec(types.T_int32.ToType())
+	bat.Vecs[1] = vector.NewVec(types.T_TS.ToType())
+	for i := range ids {
+		require.NoError(t, vector.AppendFixed(bat.Vecs[0], ids[i], false, mp))
+		require.NoError(t, vector.AppendFixed(bat.Vecs[1], types.BuildTS(ts[i], 0), false, mp))
+	}
+	bat.SetRowCount(len(ids))
+	return bat
+}
+
+func TestMysqlSinker(t *testing.T) {
+	ctx := context.Background()
+	mp := mpool.MustNewZero()
+	sink := &testSink{}
+	info := &DbTableInfo{"db1", "t1", "db2", "t2"}
+	s, err := NewMysqlSinker(ctx, sink, info, newTestTableDef(), &TaskOptions{})
+	require.NoError(t, err)
+
+	// snapshot is sent directly.
+	data := newTestData(t, mp, []int32{1, 2}, []string{"a", "it's"}, []int64{10, 10})
+	require.NoError(t, s.Sink(ctx, data, nil, true))
+	data.Clean(mp)
+	require.Equal(t, 1, len(sink.sqls))
+	assert.Equal(t, []string{"REPLACE INTO `db2`.`t2` (`id`,`name`) VALUES (1,'a'),(2,'it\\'s')"}, sink.sqls[0])
+
+	// 1 is updated, 2 is deleted, 3 is inserted and then deleted.
+	data = newTestData(t, mp, []int32{1, 3}, []string{"b", ""}, []int64{20, 20})
+	tombstone := newTestTombstone(t, mp, []int32{1, 2, 3}, []int64{20, 20, 30})
+	require.NoError(t, s.Sink(ctx, data, tombstone, false))
+	data.Clean(mp)
+	tombstone.Clean(mp)
+	require.NoError(t, s.Flush(ctx))
+	require.Equal(t, 2, len(sink.sqls))
+	assert.Equal(t, []string{
+		"DELETE FROM `db2`.`t2` WHERE `id` IN (2,3)",
+		"REPLACE INTO `db2`.`t2` (`id`,`name`) VALUES (1,'b')",
+	}, sink.sqls[1])
+
+	// nothing to flush.
+	require.NoError(t, s.Flush(ctx))
+	require.Equal(t, 2, len(sink.sqls))
+}
+
+func TestMysqlSinkerWithoutPk(t *testing.T) {
+	def := newTestTableDef()
+	def.Pkey.PkeyColName = catalog.FakePrimaryKeyColName
+	_, err := NewMysqlSinker(context.Background(), &testSink{}, &DbTableInfo{"db1", "t1", "db1", "t1"}, def, &TaskOptions{})
+	assert.Error(t, err)
+}
+
+func TestKafkaSinker(t *testing.T) {
+	ctx := context.Background()
+	mp := mpool.MustNewZero()
+	broker := kafkatestutil.NewFakeBroker()
+	info := &DbTableInfo{"db1", "t1", "db1", "t1"}
+	s, err := NewKafkaSinker(ctx, broker, "changes", info, newTestTableDef())
+	require.NoError(t, err)
+
+	// snapshot is published directly.
+	data := newTestData(t, mp, []int32{1, 2}, []string{"a", "it's"}, []int64{10, 10})
+	require.NoError(t, s.Sink(ctx, data, nil, true))
+	data.Clean(mp)
+	msgs := broker.Messages("changes")
+	require.Equal(t, 2, len(msgs))
+	assert.JSONEq(t, `{"id": 1}`, string(msgs[0].Key))
+	assert.JSONEq(t, `{"database": "db1", "table": "t1", "op": "insert", "ts": "10-0", "data": {"id": 1, "name": "a"}}`,
+		string(msgs[0].Value))
+	assert.JSONEq(t, `{"database": "db1", "table": "t1", "op": "insert", "ts": "10-0", "data": {"id": 2, "name": "it's"}}`,
+		string(msgs[1].Value))
+
+	// 1 is updated, 2 is deleted, 3 is inserted and then deleted.
+	data = newTestData(t, mp, []int32{1, 3}, []string{"b", ""}, []int64{20, 20})
+	tombstone := newTestTombstone(t, mp, []int32{1, 2, 3}, []int64{20, 20, 30})
+	require.NoError(t, s.Sink(ctx, data, tombstone, false))
+	data.Clean(mp)
+	tombstone.Clean(mp)
+	require.Equal(t, 2, len(broker.Messages("changes")))
+	require.NoError(t, s.Flush(ctx))
+	msgs = broker.Messages("changes")[2:]
+	require.Equal(t, 3, len(msgs))
+	var values []string
+	for _, msg := range msgs {
+		values = append(values, string(msg.Value))
+	}
+	assert.ElementsMatch(t, []string{
+		`{"database":"db1","table":"t1","op":"insert","ts":"20-0","data":{"id":1,"name":"b"}}`,
+		`{"database":"db1","table":"t1","op":"delete","ts":"20-0","data":{"id":2}}`,
+		`{"database":"db1","table":"t1","op":"delete","ts":"30-0","data":{"id":3}}`,
+	}, values)
+
+	// nothing to flush.
+	require.NoError(t, s.Flush(ctx))
+	require.Equal(t, 5, len(broker.Messages("changes")))
+}
+
+func TestBuildSqls(t *testing.T) {
+	s := &mysqlSinker{maxSqlSize: 12}
+	sqls := s.buildSqls([]byte("P "), ")", [][]byte{[]byte("(1)"), []byte("(2)"), []byte("(3)"), []byte("(4)")})
+	assert.Equal(t, []string{"P (1),(2))", "P (3),(4))"}, sqls)
+	assert.Nil(t, s.buildSqls([]byte("P "), "", nil))
+}
+
+func TestAppendQuoted(t *testing.T) {
+	assert.Equal(t, `'a\'b\\c\n\0'`, string(appendQuoted(nil, []byte("a'b\\c\n\x00"))))
+	assert.Equal(t, "x'0102'", string(appendHex(nil, []byte{1, 2})))
+	assert.Equal(t, "`a``b`", string(appendIdentifier(nil, "a`b")))
+}
@@ -0,0 +1,322 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/pb/task"
+	"github.com/matrixorigin/matrixone/pkg/taskservice"
+	"github.com/matrixorigin/matrixone/pkg/txn/client"
+	ie "github.com/matrixorigin/matrixone/pkg/util/internalExecutor"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	getTaskFormat = "select sink_type, sink_uri, tables, options from %s.%s where account_id = %d and task_id = '%s'"
+
+	updateTaskStateFormat = "update %s.%s set state = '%s', err_msg = '%s' where account_id = %d and task_id = '%s'"
+)
+
+// GetSqlForUpdateTaskState returns the sql to update the state of a cdc
+// task.
+func GetSqlForUpdateTaskState(accountId uint32, taskId, state, errMsg string) string {
+	return fmt.Sprintf(updateTaskStateFormat, catalog.MO_CATALOG, catalog.MO_CDC_TASK,
+		state, strings.ReplaceAll(errMsg, "'", "''"), accountId, taskId)
+}
+
+// TaskSpec is the definition of a cdc task stored in mo_catalog.mo_cdc_task.
+type TaskSpec struct {
+	AccountId uint32
+	TaskId    string
+	TaskName  string
+	SinkType  string
+	// SinkUri is the uri of the mysql and matrixone sinks.
+	SinkUri UriInfo
+	// KafkaUri is the uri of the kafka sink.
+	KafkaUri KafkaUriInfo
+	Tables   []*DbTableInfo
+	Options  TaskOptions
+}
+
+// LoadTaskSpec loads the definition of the cdc task.
+func LoadTaskSpec(ctx context.Context, exec ie.InternalExecutor, accountId uint32, taskId, taskName string) (*TaskSpec, error) {
+	ctx = defines.AttachAccountId(ctx, catalog.System_Account)
+	sql := fmt.Sprintf(getTaskFormat, catalog.MO_CATALOG, catalog.MO_CDC_TASK, accountId, taskId)
+	res := exec.Query(ctx, sql, ie.SessionOverrideOptions{})
+	if res.Error() != nil {
+		return nil, res.Error()
+	}
+	if res.RowCount() != 1 {
+		return nil, moerr.NewInternalErrorf(ctx, "cdc task %s not found", taskName)
+	}
+	var values [4]string
+	for i := range values {
+		var err error
+		if values[i], err = res.GetString(ctx, 0, uint64(i)); err != nil {
+			return nil, err
+		}
+	}
+	spec := &TaskSpec{
+		AccountId: accountId,
+		TaskId:    taskId,
+		TaskName:  taskName,
+		SinkType:  values[0],
+	}
+	var err error
+	if spec.SinkType == SinkTypeKafka {
+		spec.KafkaUri, err = ParseKafkaUri(ctx, values[1])
+	} else {
+		spec.SinkUri, err = ParseUri(ctx, values[1])
+	}
+	if err != nil {
+		return nil, err
+	}
+	if spec.Tables, err = ParseTables(ctx, values[2]); err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal([]byte(values[3]), &spec.Options); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+// TaskExecutor returns the executor of the daemon tasks of cdc.
+func TaskExecutor(
+	logger *zap.Logger,
+	ts taskservice.TaskService,
+	ieFactory func() ie.InternalExecutor,
+	attachToTask func(context.Context, uint64, taskservice.ActiveRoutine) error,
+	eng engine.Engine,
+	txnClient client.TxnClient,
+) func(context.Context, task.Task) error {
+	return func(ctx context.Context, t task.Task) error {
+		ctx1, cancel := context.WithTimeout(context.Background(), time.Second*3)
+		defer cancel()
+		tasks, err := ts.QueryDaemonTask(ctx1,
+			taskservice.WithTaskIDCond(taskservice.EQ, t.GetID()),
+		)
+		if err != nil {
+			return err
+		}
+		if len(tasks) != 1 {
+			return moerr.NewInternalErrorf(ctx, "invalid tasks count %d", len(tasks))
+		}
+		details, ok := tasks[0].Details.Details.(*task.Details_CreateCdc)
+		if !ok {
+			return moerr.NewInternalError(ctx, "invalid details type")
+		}
+
+		exec := ieFactory()
+		spec, err := LoadTaskSpec(ctx, exec, tasks[0].AccountID,
+			details.CreateCdc.TaskId, details.CreateCdc.TaskName)
+		if err != nil {
+			return err
+		}
+		if spec.SinkType != SinkTypeMySQL && spec.SinkType != SinkTypeMatrixOne && spec.SinkType != SinkTypeKafka {
+			return moerr.NewNotSupportedf(ctx, "cdc sink type %s", spec.SinkType)
+		}
+
+		c := NewTask(logger, spec, exec, eng, txnClient)
+		if err = attachToTask(ctx, t.GetID(), c); err != nil {
+			return err
+		}
+		// Start the cdc task and hangs here.
+		return c.Start(ctx)
+	}
+}
+
+var _ taskservice.ActiveRoutine = new(Task)
+
+// Task runs a cdc task, it starts a tableReader for each table of the task.
+// Pausing the task stops all the readers, the progress is kept in the
+// watermarks, and resuming the task starts the readers again.
+type Task struct {
+	logger    *zap.Logger
+	spec      *TaskSpec
+	ie        ie.InternalExecutor
+	eng       engine.Engine
+	txnClient client.TxnClient
+	mp        *mpool.MPool
+
+	resumeC    chan struct{}
+	pauseC     chan struct{}
+	cancelC    chan struct{}
+	cancelOnce sync.Once
+}
+
+// NewTask creates a cdc task.
+func NewTask(
+	logger *zap.Logger,
+	spec *TaskSpec,
+	ie ie.InternalExecutor,
+	eng engine.Engine,
+	txnClient client.TxnClient,
+) *Task {
+	return &Task{
+		logger:    logger,
+		spec:      spec,
+		ie:        ie,
+		eng:       eng,
+		txnClient: txnClient,
+		mp:        mpool.MustNewZeroNoFixed(),
+		resumeC:   make(chan struct{}, 1),
+		pauseC:    make(chan struct{}, 1),
+		cancelC:   make(chan struct{}),
+	}
+}
+
+// Start runs the task until it is canceled, ctx is done, or all the tables
+// reach the end ts.
+func (c *Task) Start(ctx context.Context) error {
+	ctx = defines.AttachAccountId(ctx, c.spec.AccountId)
+	for {
+		runCtx, cancel := context.WithCancel(ctx)
+		errC := make(chan error, 1)
+		go func() {
+			errC <- c.run(runCtx)
+		}()
+
+		select {
+		case <-ctx.Done():
+			cancel()
+			<-errC
+			return nil
+
+		case <-c.cancelC:
+			cancel()
+			<-errC
+			return c.cleanup(ctx)
+
+		case err := <-errC:
+			cancel()
+			state, errMsg := TaskStateFinished, ""
+			if err != nil {
+				state, errMsg = TaskStateFailed, err.Error()
+				c.logger.Error("cdc task failed",
+					zap.String("task-name", c.spec.TaskName),
+					zap.Error(err))
+			}
+			c.updateState(ctx, state, errMsg)
+			return err
+
+		case <-c.pauseC:
+			cancel()
+			<-errC
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-c.cancelC:
+				return c.cleanup(ctx)
+			case <-c.resumeC:
+			}
+		}
+	}
+}
+
+// run starts the readers of all the tables and waits for them.
+func (c *Task) run(ctx context.Context) error {
+	wm := NewWatermarkUpdater(c.spec.AccountId, c.spec.TaskId, c.ie)
+	fullSem := make(chan struct{}, c.spec.Options.FullConcurrency)
+	g, ctx := errgroup.WithContext(ctx)
+	for _, info := range c.spec.Tables {
+		info := info
+		g.Go(func() error {
+			// each table has its own connection to the sink, the changes of
+			// different tables are sent concurrently.
+			var newSinker sinkerFactory
+			if c.spec.SinkType == SinkTypeKafka {
+				producer, err := newKafkaProducer(c.spec.KafkaUri)
+				if err != nil {
+					return err
+				}
+				defer producer.Close()
+				newSinker = func(ctx context.Context, tableDef *plan.TableDef) (Sinker, error) {
+					return NewKafkaSinker(ctx, producer, c.spec.KafkaUri.Topic, info, tableDef)
+				}
+			} else {
+				sink, err := NewMysqlSink(ctx, c.spec.SinkUri)
+				if err != nil {
+					return err
+				}
+				defer sink.Close()
+				newSinker = func(ctx context.Context, tableDef *plan.TableDef) (Sinker, error) {
+					return NewMysqlSinker(ctx, sink, info, tableDef, &c.spec.Options)
+				}
+			}
+			r := newTableReader(c.spec.AccountId, info, &c.spec.Options,
+				c.eng, c.txnClient, newSinker, wm, c.mp, fullSem)
+			if err := r.Run(ctx); err != nil && ctx.Err() == nil {
+				return moerr.NewInternalErrorf(ctx, "cdc of %s failed: %v", info.String(), err)
+			}
+			return nil
+		})
+	}
+	return g.Wait()
+}
+
+// cleanup deletes the watermarks of the canceled task.
+func (c *Task) cleanup(ctx context.Context) error {
+	ctx = defines.AttachAccountId(ctx, catalog.System_Account)
+	return c.ie.Exec(ctx, GetSqlForDeleteWatermark(c.spec.AccountId, c.spec.TaskId), ie.SessionOverrideOptions{})
+}
+
+func (c *Task) updateState(ctx context.Context, state, errMsg string) {
+	ctx = defines.AttachAccountId(ctx, catalog.System_Account)
+	sql := GetSqlForUpdateTaskState(c.spec.AccountId, c.spec.TaskId, state, errMsg)
+	if err := c.ie.Exec(ctx, sql, ie.SessionOverrideOptions{}); err != nil {
+		c.logger.Error("failed to update the state of cdc task",
+			zap.String("task-name", c.spec.TaskName),
+			zap.Error(err))
+	}
+}
+
+// Resume implements the taskservice.ActiveRoutine interface.
+func (c *Task) Resume() error {
+	select {
+	case c.resumeC <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// Pause implements the taskservice.ActiveRoutine interface.
+func (c *Task) Pause() error {
+	select {
+	case c.pauseC <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// Cancel implements the taskservice.ActiveRoutine interface.
+func (c *Task) Cancel() error {
+	c.cancelOnce.Do(func() {
+		close(c.cancelC)
+	})
+	return nil
+}
@@ -0,0 +1,282 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+// appendSqlValue appends the sql literal of the row-th value of vec to buf.
+// Values of time types are formatted in UTC, the sink session must use the
+// time zone '+00:00'.
+func appendSqlValue(ctx context.Context, buf []byte, vec *vector.Vector, row int) ([]byte, error) {
+	if vec.IsConstNull() || vec.GetNulls().Contains(uint64(row)) {
+		return append(buf, "NULL"...), nil
+	}
+	if vec.IsConst() {
+		row = 0
+	}
+	typ := vec.GetType()
+	switch typ.Oid {
+	case types.T_bool:
+		if vector.GetFixedAtNoTypeCheck[bool](vec, row) {
+			return append(buf, "true"...), nil
+		}
+		return append(buf, "false"...), nil
+	case types.T_bit:
+		return strconv.AppendUint(buf, vector.GetFixedAtNoTypeCheck[uint64](vec, row), 10), nil
+	case types.T_int8:
+		return strconv.AppendInt(buf, int64(vector.GetFixedAtNoTypeCheck[int8](vec, row)), 10), nil
+	case types.T_int16:
+		return strconv.AppendInt(buf, int64(vector.GetFixedAtNoTypeCheck[int16](vec, row)), 10), nil
+	case types.T_int32:
+		return strconv.AppendInt(buf, int64(vector.GetFixedAtNoTypeCheck[int32](vec, row)), 10), nil
+	case types.T_int64:
+		return strconv.AppendInt(buf, vector.GetFixedAtNoTypeCheck[int64](vec, row), 10), nil
+	case types.T_uint8:
+		return strconv.AppendUint(buf, uint64(vector.GetFixedAtNoTypeCheck[uint8](vec, row)), 10), nil
+	case types.T_uint16:
+		return strconv.AppendUint(buf, uint64(vector.GetFixedAtNoTypeCheck[uint16](vec, row)), 10), nil
+	case types.T_uint32:
+		return strconv.AppendUint(buf, uint64(vector.GetFixedAtNoTypeCheck[uint32](vec, row)), 10), nil
+	case types.T_uint64:
+		return strconv.AppendUint(buf, vector.GetFixedAtNoTypeCheck[uint64](vec, row), 10), nil
+	case types.T_float32:
+		return strconv.AppendFloat(buf, float64(vector.GetFixedAtNoTypeCheck[float32](vec, row)), 'g', -1, 32), nil
+	case types.T_float64:
+		return strconv.AppendFloat(buf, vector.GetFixedAtNoTypeCheck[float64](vec, row), 'g', -1, 64), nil
+	case types.T_decimal64:
+		return append(buf, vector.GetFixedAtNoTypeCheck[types.Decimal64](vec, row).Format(typ.Scale)...), nil
+	case types.T_decimal128:
+		return append(buf, vector.GetFixedAtNoTypeCheck[types.Decimal128](vec, row).Format(typ.Scale)...), nil
+	case types.T_enum:
+		return strconv.AppendUint(buf, uint64(vector.GetFixedAtNoTypeCheck[types.Enum](vec, row)), 10), nil
+	case types.T_char, types.T_varchar, types.T_text, types.T_datalink:
+		return appendQuoted(buf, vec.GetBytesAt(row)), nil
+	case types.T_binary, types.T_varbinary, types.T_blob:
+		return appendHex(buf, vec.GetBytesAt(row)), nil
+	case types.T_json:
+		return appendQuoted(buf, []byte(types.DecodeJson(vec.GetBytesAt(row)).String())), nil
+	case types.T_array_float32:
+		return appendQuoted(buf, []byte(types.BytesToArrayToString[float32](vec.GetBytesAt(row)))), nil
+	case types.T_array_float64:
+		return appendQuoted(buf, []byte(types.BytesToArrayToString[float64](vec.GetBytesAt(row)))), nil
+	case types.T_date:
+		return appendQuoted(buf, []byte(vector.GetFixedAtNoTypeCheck[types.Date](vec, row).String())), nil
+	case types.T_time:
+		return appendQuoted(buf, []byte(vector.GetFixedAtNoTypeCheck[types.Time](vec, row).String2(typ.Scale))), nil
+	case types.T_datetime:
+		return appendQuoted(buf, []byte(vector.GetFixedAtNoTypeCheck[types.Datetime](vec, row).String2(typ.Scale))), nil
+	case types.T_timestamp:
+		ts := vector.GetFixedAtNoTypeCheck[types.Timestamp](vec, row)
+		return appendQuoted(buf, []byte(ts.String2(time.UTC, typ.Scale))), nil
+	case types.T_uuid:
+		return appendQuoted(buf, []byte(vector.GetFixedAtNoTypeCheck[types.Uuid](vec, row).String())), nil
+	default:
+		return nil, moerr.NewNotSupportedf(ctx, "cdc for column type %s", typ.String())
+	}
+}
+
+// appendTupleValue appends the sql literal of one element decoded from a
+// composite primary key.
+func appendTupleValue(ctx context.Context, buf []byte, val any, typ types.Type) ([]byte, error) {
+	switch v := val.(type) {
+	case nil:
+		return append(buf, "NULL"...), nil
+	case bool:
+		return strconv.AppendBool(buf, v), nil
+	case int8:
+		return strconv.AppendInt(buf, int64(v), 10), nil
+	case int16:
+		return strconv.AppendInt(buf, int64(v), 10), nil
+	case int32:
+		return strconv.AppendInt(buf, int64(v), 10), nil
+	case int64:
+		return strconv.AppendInt(buf, v, 10), nil
+	case uint8:
+		return strconv.AppendUint(buf, uint64(v), 10), nil
+	case uint16:
+		return strconv.AppendUint(buf, uint64(v), 10), nil
+	case uint32:
+		return strconv.AppendUint(buf, uint64(v), 10), nil
+	case uint64:
+		return strconv.AppendUint(buf, v, 10), nil
+	case float32:
+		return strconv.AppendFloat(buf, float64(v), 'g', -1, 32), nil
+	case float64:
+		return strconv.AppendFloat(buf, v, 'g', -1, 64), nil
+	case types.Decimal64:
+		return append(buf, v.Format(typ.Scale)...), nil
+	case types.Decimal128:
+		return append(buf, v.Format(typ.Scale)...), nil
+	case types.Enum:
+		return strconv.AppendUint(buf, uint64(v), 10), nil
+	case types.Date:
+		return appendQuoted(buf, []byte(v.String())), nil
+	case types.Time:
+		return appendQuoted(buf, []byte(v.String2(typ.Scale))), nil
+	case types.Datetime:
+		return appendQuoted(buf, []byte(v.String2(typ.Scale))), nil
+	case types.Timestamp:
+		return appendQuoted(buf, []byte(v.String2(time.UTC, typ.Scale))), nil
+	case types.Uuid:
+		return appendQuoted(buf, []byte(v.String())), nil
+	case []byte:
+		switch typ.Oid {
+		case types.T_binary, types.T_varbinary, types.T_blob:
+			return appendHex(buf, v), nil
+		}
+		return appendQuoted(buf, v), nil
+	default:
+		return nil, moerr.NewNotSupportedf(ctx, "cdc for primary key type %s", typ.String())
+	}
+}
+
+// jsonValue returns the row-th value of vec in the form encoded by json.
+// Decimals are kept as json numbers without losing precision, binary values
+// are encoded in base64, and values of time types are formatted in UTC.
+func jsonValue(ctx context.Context, vec *vector.Vector, row int) (any, error) {
+	if vec.IsConstNull() || vec.GetNulls().Contains(uint64(row)) {
+		return nil, nil
+	}
+	if vec.IsConst() {
+		row = 0
+	}
+	typ := vec.GetType()
+	switch typ.Oid {
+	case types.T_bool:
+		return vector.GetFixedAtNoTypeCheck[bool](vec, row), nil
+	case types.T_bit:
+		return vector.GetFixedAtNoTypeCheck[uint64](vec, row), nil
+	case types.T_int8:
+		return vector.GetFixedAtNoTypeCheck[int8](vec, row), nil
+	case types.T_int16:
+		return vector.GetFixedAtNoTypeCheck[int16](vec, row), nil
+	case types.T_int32:
+		return vector.GetFixedAtNoTypeCheck[int32](vec, row), nil
+	case types.T_int64:
+		return vector.GetFixedAtNoTypeCheck[int64](vec, row), nil
+	case types.T_uint8:
+		return vector.GetFixedAtNoTypeCheck[uint8](vec, row), nil
+	case types.T_uint16:
+		return vector.GetFixedAtNoTypeCheck[uint16](vec, row), nil
+	case types.T_uint32:
+		return vector.GetFixedAtNoTypeCheck[uint32](vec, row), nil
+	case types.T_uint64:
+		return vector.GetFixedAtNoTypeCheck[uint64](vec, row), nil
+	case types.T_float32:
+		return vector.GetFixedAtNoTypeCheck[float32](vec, row), nil
+	case types.T_float64:
+		return vector.GetFixedAtNoTypeCheck[float64](vec, row), nil
+	case types.T_json:
+		return json.RawMessage(types.DecodeJson(vec.GetBytesAt(row)).String()), nil
+	case types.T_array_float32:
+		return json.RawMessage(types.BytesToArrayToString[float32](vec.GetBytesAt(row))), nil
+	case types.T_array_float64:
+		return json.RawMessage(types.BytesToArrayToString[float64](vec.GetBytesAt(row))), nil
+	case types.T_binary, types.T_varbinary, types.T_blob:
+		return vec.GetBytesAt(row), nil
+	case types.T_char, types.T_varchar, types.T_text, types.T_datalink:
+		return string(vec.GetBytesAt(row)), nil
+	}
+	// the sql literals of the other types are numbers or quoted strings.
+	literal, err := appendSqlValue(ctx, nil, vec, row)
+	if err != nil {
+		return nil, err
+	}
+	return literalToJson(literal), nil
+}
+
+// jsonTupleValue returns one element decoded from a composite primary key in
+// the form encoded by json.
+func jsonTupleValue(ctx context.Context, val any, typ types.Type) (any, error) {
+	switch v := val.(type) {
+	case []byte:
+		switch typ.Oid {
+		case types.T_binary, types.T_varbinary, types.T_blob:
+			return v, nil
+		}
+		return string(v), nil
+	case nil, bool, int8, int16, int32, int64, uint8, uint16, uint32, uint64, float32, float64:
+		return v, nil
+	}
+	literal, err := appendTupleValue(ctx, nil, val, typ)
+	if err != nil {
+		return nil, err
+	}
+	return literalToJson(literal), nil
+}
+
+// literalToJson converts a sql literal without escaped characters into a
+// json number or string.
+func literalToJson(literal []byte) any {
+	if len(literal) >= 2 && literal[0] == '\'' {
+		return string(literal[1 : len(literal)-1])
+	}
+	return json.Number(literal)
+}
+
+// appendQuoted appends s as a single quoted string literal, escaping the
+// characters which are special in the MySQL string syntax.
+func appendQuoted(buf []byte, s []byte) []byte {
+	buf = append(buf, '\'')
+	for _, c := range s {
+		switch c {
+		case 0:
+			buf = append(buf, '\\', '0')
+		case '\n':
+			buf = append(buf, '\\', 'n')
+		case '\r':
+			buf = append(buf, '\\', 'r')
+		case '\\':
+			buf = append(buf, '\\', '\\')
+		case '\'':
+			buf = append(buf, '\\', '\'')
+		case 0x1a:
+			buf = append(buf, '\\', 'Z')
+		default:
+			buf = append(buf, c)
+		}
+	}
+	return append(buf, '\'')
+}
+
+func appendHex(buf []byte, s []byte) []byte {
+	if len(s) == 0 {
+		return append(buf, "''"...)
+	}
+	buf = append(buf, "x'"...)
+	buf = hex.AppendEncode(buf, s)
+	return append(buf, '\'')
+}
+
+func appendIdentifier(buf []byte, name string) []byte {
+	buf = append(buf, '`')
+	for i := 0; i < len(name); i++ {
+		if name[i] == '`' {
+			buf = append(buf, '`')
+		}
+		buf = append(buf, name[i])
+	}
+	return append(buf, '`')
+}
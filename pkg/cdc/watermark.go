@@ -0,0 +1,100 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	ie "github.com/matrixorigin/matrixone/pkg/util/internalExecutor"
+)
+
+const (
+	getWatermarkFormat = "select watermark from %s.%s where account_id = %d and task_id = '%s' and db_name = '%s' and table_name = '%s'"
+
+	insertWatermarkFormat = "insert into %s.%s values (%d, '%s', '%s', '%s', '%s')"
+
+	updateWatermarkFormat = "update %s.%s set watermark = '%s' where account_id = %d and task_id = '%s' and db_name = '%s' and table_name = '%s'"
+
+	deleteWatermarkFormat = "delete from %s.%s where account_id = %d and task_id = '%s'"
+)
+
+// GetSqlForDeleteWatermark returns the sql to delete all the watermarks of
+// a cdc task.
+func GetSqlForDeleteWatermark(accountId uint32, taskId string) string {
+	return fmt.Sprintf(deleteWatermarkFormat, catalog.MO_CATALOG, catalog.MO_CDC_WATERMARK, accountId, taskId)
+}
+
+var _ WatermarkUpdater = new(watermarkUpdater)
+
+// watermarkUpdater keeps the watermarks of a cdc task in the table
+// mo_catalog.mo_cdc_watermark of the sys account.
+type watermarkUpdater struct {
+	accountId uint32
+	taskId    string
+	ie        ie.InternalExecutor
+}
+
+// NewWatermarkUpdater creates a WatermarkUpdater for the task.
+func NewWatermarkUpdater(accountId uint32, taskId string, ie ie.InternalExecutor) WatermarkUpdater {
+	return &watermarkUpdater{
+		accountId: accountId,
+		taskId:    taskId,
+		ie:        ie,
+	}
+}
+
+func (u *watermarkUpdater) Get(ctx context.Context, info *DbTableInfo) (types.TS, error) {
+	ctx = defines.AttachAccountId(ctx, catalog.System_Account)
+	sql := fmt.Sprintf(getWatermarkFormat, catalog.MO_CATALOG, catalog.MO_CDC_WATERMARK,
+		u.accountId, u.taskId, info.SourceDbName, info.SourceTblName)
+	res := u.ie.Query(ctx, sql, ie.SessionOverrideOptions{})
+	if res.Error() != nil {
+		return types.TS{}, res.Error()
+	}
+	if res.RowCount() == 0 {
+		return types.TS{}, nil
+	}
+	if res.RowCount() != 1 {
+		return types.TS{}, moerr.NewInternalErrorf(ctx, "duplicate watermarks of %s in task %s",
+			info.String(), u.taskId)
+	}
+	s, err := res.GetString(ctx, 0, 0)
+	if err != nil {
+		return types.TS{}, err
+	}
+	return types.StringToTS(s), nil
+}
+
+func (u *watermarkUpdater) Update(ctx context.Context, info *DbTableInfo, watermark types.TS) error {
+	ctx = defines.AttachAccountId(ctx, catalog.System_Account)
+	old, err := u.Get(ctx, info)
+	if err != nil {
+		return err
+	}
+	var sql string
+	if old.IsEmpty() {
+		sql = fmt.Sprintf(insertWatermarkFormat, catalog.MO_CATALOG, catalog.MO_CDC_WATERMARK,
+			u.accountId, u.taskId, info.SourceDbName, info.SourceTblName, watermark.ToString())
+	} else {
+		sql = fmt.Sprintf(updateWatermarkFormat, catalog.MO_CATALOG, catalog.MO_CDC_WATERMARK,
+			watermark.ToString(), u.accountId, u.taskId, info.SourceDbName, info.SourceTblName)
+	}
+	return u.ie.Exec(ctx, sql, ie.SessionOverrideOptions{})
+}
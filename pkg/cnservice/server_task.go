@@ -20,6 +20,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/cdc"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/runtime"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -273,6 +274,12 @@ func (s *service) registerExecutorsLocked() {
 	// streaming connector task
 	s.task.runner.RegisterExecutor(task.TaskCode_ConnectorKafkaSink,
 		moconnector.KafkaSinkConnectorExecutor(s.logger, ts, ieFactory, s.task.runner.Attach))
+	// cdc task
+	s.task.runner.RegisterExecutor(task.TaskCode_InitCdc,
+		cdc.TaskExecutor(s.logger, ts, ieFactory, s.task.runner.Attach, s.storeEngine, s._txnClient))
+	// sql event task
+	s.task.runner.RegisterExecutor(task.TaskCode_SQLEvent,
+		frontend.EventTaskExecutor(ts, ieFactory))
 	s.task.runner.RegisterExecutor(task.TaskCode_MergeObject,
 		func(ctx context.Context, task task.Task) error {
 			metadata := task.GetMetadata()
@@ -224,6 +224,8 @@ const (
 	ErrNewTxnInCNRollingRestart   uint16 = 20635
 	ErrPrevCheckpointNotFinished  uint16 = 20636
 	ErrCantDelGCChecker           uint16 = 20637
+	ErrSavepointNotExist          uint16 = 20638
+	ErrTxnSerializationFailure    uint16 = 20639
 
 	// Group 7: lock service
 	// ErrDeadLockDetected lockservice has detected a deadlock and should abort the transaction if it receives this error
@@ -238,6 +240,8 @@ const (
 	ErrCannotCommitOrphan uint16 = 20705
 	// ErrLockConflict lock operation conflict
 	ErrLockConflict uint16 = 20706
+	// ErrLockNoWait lock cannot be acquired immediately with NOWAIT
+	ErrLockNoWait uint16 = 20707
 
 	// Group 8: partition
 	ErrPartitionFunctionIsNotAllowed       uint16 = 20801
@@ -264,6 +268,18 @@ const (
 	ErrRowSinglePartitionField             uint16 = 20822
 	ErrTooManyPartitionFuncFields          uint16 = 20823
 	ErrTooManyParameter                    uint16 = 20824
+	ErrOnlyOnRangeListPartition            uint16 = 20825
+	ErrCoalesceOnlyOnHashPartition         uint16 = 20826
+	ErrDropPartitionNonExistent            uint16 = 20827
+	ErrDropLastPartition                   uint16 = 20828
+	ErrConsecutiveReorgPartitions          uint16 = 20829
+	ErrReorgOutsideRange                   uint16 = 20830
+	ErrPartitionExchangePartTable          uint16 = 20831
+	ErrPartitionExchangeTempTable          uint16 = 20832
+	ErrPartitionExchangeForeignKey         uint16 = 20833
+	ErrTablesDifferentMetadata             uint16 = 20834
+	ErrRowDoesNotMatchPartition            uint16 = 20835
+	ErrUnknownPartition                    uint16 = 20836
 
 	// Group 9: streaming
 	ErrUnsupportedOption   uint16 = 20901
@@ -459,6 +475,8 @@ var errorMsgRefer = map[uint16]moErrorMsgItem{
 	ErrPrevCheckpointNotFinished:  {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "prev checkpoint not finished"},
 	ErrCantCompileForPrepare:      {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "can not compile for prepare"},
 	ErrCantDelGCChecker:           {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "can't delete gc checker"},
+	ErrSavepointNotExist:          {ER_SP_DOES_NOT_EXIST, []string{"42000"}, "SAVEPOINT %s does not exist"},
+	ErrTxnSerializationFailure:    {ER_LOCK_DEADLOCK, []string{"40001"}, "could not serialize access due to read/write dependencies among transactions, try restarting transaction"},
 
 	// Group 7: lock service
 	ErrDeadLockDetected:     {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "deadlock detected"},
@@ -467,6 +485,7 @@ var errorMsgRefer = map[uint16]moErrorMsgItem{
 	ErrDeadlockCheckBusy:    {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "deadlock check is busy"},
 	ErrCannotCommitOrphan:   {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "cannot commit a orphan transaction"},
 	ErrLockConflict:         {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "lock options conflict, wait policy is fast fail"},
+	ErrLockNoWait:           {ER_LOCK_NOWAIT, []string{"HY000"}, "Statement aborted because lock(s) could not be acquired immediately and NOWAIT is set."},
 
 	// Group 8: partition
 	ErrPartitionFunctionIsNotAllowed:       {ER_PARTITION_FUNCTION_IS_NOT_ALLOWED, []string{MySQLDefaultSqlState}, "This partition function is not allowed"},
@@ -493,6 +512,18 @@ var errorMsgRefer = map[uint16]moErrorMsgItem{
 	ErrRowSinglePartitionField:             {ER_ROW_SINGLE_PARTITION_FIELD_ERROR, []string{MySQLDefaultSqlState}, "Row expressions in VALUES IN only allowed for multi-field column partitioning"},
 	ErrTooManyPartitionFuncFields:          {ER_TOO_MANY_PARTITION_FUNC_FIELDS_ERROR, []string{MySQLDefaultSqlState}, "Too many fields in '%-.192s'"},
 	ErrTooManyParameter:                    {ER_PS_MANY_PARAM, []string{MySQLDefaultSqlState}, "Prepared statement contains too many placeholders"},
+	ErrOnlyOnRangeListPartition:            {ER_ONLY_ON_RANGE_LIST_PARTITION, []string{MySQLDefaultSqlState}, "%-.64s PARTITION can only be used on RANGE/LIST partitions"},
+	ErrCoalesceOnlyOnHashPartition:         {ER_COALESCE_ONLY_ON_HASH_PARTITION, []string{MySQLDefaultSqlState}, "%-.64s PARTITION can only be used on HASH partitions"},
+	ErrDropPartitionNonExistent:            {ER_DROP_PARTITION_NON_EXISTENT, []string{MySQLDefaultSqlState}, "Error in list of partitions to %-.64s"},
+	ErrDropLastPartition:                   {ER_DROP_LAST_PARTITION, []string{MySQLDefaultSqlState}, "Cannot remove all partitions, use DROP TABLE instead"},
+	ErrConsecutiveReorgPartitions:          {ER_CONSECUTIVE_REORG_PARTITIONS, []string{MySQLDefaultSqlState}, "When reorganizing a set of partitions they must be in consecutive order"},
+	ErrReorgOutsideRange:                   {ER_REORG_OUTSIDE_RANGE, []string{MySQLDefaultSqlState}, "Reorganize of range partitions cannot change total ranges except for last partition where it can extend the range"},
+	ErrPartitionExchangePartTable:          {ER_PARTITION_EXCHANGE_PART_TABLE, []string{MySQLDefaultSqlState}, "Table to exchange with partition is partitioned: '%-.64s'"},
+	ErrPartitionExchangeTempTable:          {ER_PARTITION_EXCHANGE_TEMP_TABLE, []string{MySQLDefaultSqlState}, "Table to exchange with partition is temporary: '%-.64s'"},
+	ErrPartitionExchangeForeignKey:         {ER_PARTITION_EXCHANGE_FOREIGN_KEY, []string{MySQLDefaultSqlState}, "Table to exchange with partition has foreign key references: '%-.64s'"},
+	ErrTablesDifferentMetadata:             {ER_TABLES_DIFFERENT_METADATA, []string{MySQLDefaultSqlState}, "Tables have different definitions"},
+	ErrRowDoesNotMatchPartition:            {ER_ROW_DOES_NOT_MATCH_PARTITION, []string{MySQLDefaultSqlState}, "Found a row that does not match the partition"},
+	ErrUnknownPartition:                    {ER_UNKNOWN_PARTITION, []string{MySQLDefaultSqlState}, "Unknown partition '%-.64s' in table '%-.64s'"},
 
 	// Group 9: streaming
 	ErrUnsupportedOption:   {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "unsupported option %s"},
@@ -983,6 +1014,14 @@ func NewBackendCannotConnect(ctx context.Context) *Error {
 	return newError(ctx, ErrBackendCannotConnect)
 }
 
+func NewSavepointNotExist(ctx context.Context, name string) *Error {
+	return newError(ctx, ErrSavepointNotExist, name)
+}
+
+func NewTxnSerializationFailure(ctx context.Context) *Error {
+	return newError(ctx, ErrTxnSerializationFailure)
+}
+
 func NewTxnClosed(ctx context.Context, txnID []byte) *Error {
 	id := "unknown"
 	if len(txnID) > 0 {
@@ -1270,6 +1309,10 @@ func NewLockConflict(ctx context.Context) *Error {
 	return newError(ctx, ErrLockConflict)
 }
 
+func NewLockNoWait(ctx context.Context) *Error {
+	return newError(ctx, ErrLockNoWait)
+}
+
 func NewPartitionFunctionIsNotAllowed(ctx context.Context) *Error {
 	return newError(ctx, ErrPartitionFunctionIsNotAllowed)
 }
@@ -1480,6 +1523,54 @@ func NewErrTooManyParameter(ctx context.Context) *Error {
 	return newError(ctx, ErrTooManyParameter)
 }
 
+func NewErrOnlyOnRangeListPartition(ctx context.Context, k any) *Error {
+	return newError(ctx, ErrOnlyOnRangeListPartition, k)
+}
+
+func NewErrCoalesceOnlyOnHashPartition(ctx context.Context, k any) *Error {
+	return newError(ctx, ErrCoalesceOnlyOnHashPartition, k)
+}
+
+func NewErrDropPartitionNonExistent(ctx context.Context, k any) *Error {
+	return newError(ctx, ErrDropPartitionNonExistent, k)
+}
+
+func NewErrDropLastPartition(ctx context.Context) *Error {
+	return newError(ctx, ErrDropLastPartition)
+}
+
+func NewErrConsecutiveReorgPartitions(ctx context.Context) *Error {
+	return newError(ctx, ErrConsecutiveReorgPartitions)
+}
+
+func NewErrReorgOutsideRange(ctx context.Context) *Error {
+	return newError(ctx, ErrReorgOutsideRange)
+}
+
+func NewErrPartitionExchangePartTable(ctx context.Context, table string) *Error {
+	return newError(ctx, ErrPartitionExchangePartTable, table)
+}
+
+func NewErrPartitionExchangeTempTable(ctx context.Context, table string) *Error {
+	return newError(ctx, ErrPartitionExchangeTempTable, table)
+}
+
+func NewErrPartitionExchangeForeignKey(ctx context.Context, table string) *Error {
+	return newError(ctx, ErrPartitionExchangeForeignKey, table)
+}
+
+func NewErrTablesDifferentMetadata(ctx context.Context) *Error {
+	return newError(ctx, ErrTablesDifferentMetadata)
+}
+
+func NewErrRowDoesNotMatchPartition(ctx context.Context) *Error {
+	return newError(ctx, ErrRowDoesNotMatchPartition)
+}
+
+func NewErrUnknownPartition(ctx context.Context, partition, table string) *Error {
+	return newError(ctx, ErrUnknownPartition, partition, table)
+}
+
 func NewErrFKRowIsReferenced(ctx context.Context) *Error {
 	return newError(ctx, ErrFKRowIsReferenced)
 }
@@ -406,6 +406,10 @@ func NewProcedureAlreadyExistsNoCtx(f string) *Error {
 	return newError(Context(), ErrProcedureAlreadyExists, f)
 }
 
+func NewTxnSerializationFailureNoCtx() *Error {
+	return newError(Context(), ErrTxnSerializationFailure)
+}
+
 func NewTxnNeedRetryNoCtx() *Error {
 	return newError(Context(), ErrTxnNeedRetry)
 }
@@ -15,15 +15,82 @@
 package compress
 
 import (
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
 	"github.com/pierrec/lz4/v4"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 )
 
 var Algorithms map[string]int = map[string]int{
 	"lz4":  Lz4,
+	"zstd": Zstd,
 	"none": None,
 }
 
+// DefaultZstdLevel is the level of zstd used if the level is not specified.
+const DefaultZstdLevel = 3
+
+var (
+	// zstdEncoders caches the encoders of each zstd.EncoderLevel. EncodeAll
+	// of the encoder is safe for concurrent use.
+	zstdEncoders sync.Map
+	zstdDecoder  struct {
+		sync.Once
+		*zstd.Decoder
+		err error
+	}
+)
+
+func getZstdEncoder(level int) (*zstd.Encoder, error) {
+	if level <= 0 {
+		level = DefaultZstdLevel
+	}
+	encoderLevel := zstd.EncoderLevelFromZstd(level)
+	if v, ok := zstdEncoders.Load(encoderLevel); ok {
+		return v.(*zstd.Encoder), nil
+	}
+	encoder, err := zstd.NewWriter(nil,
+		zstd.WithEncoderLevel(encoderLevel),
+		zstd.WithEncoderConcurrency(1))
+	if err != nil {
+		return nil, err
+	}
+	v, _ := zstdEncoders.LoadOrStore(encoderLevel, encoder)
+	return v.(*zstd.Encoder), nil
+}
+
+func getZstdDecoder() (*zstd.Decoder, error) {
+	zstdDecoder.Do(func() {
+		zstdDecoder.Decoder, zstdDecoder.err = zstd.NewReader(nil, zstd.WithDecoderConcurrency(0))
+	})
+	return zstdDecoder.Decoder, zstdDecoder.err
+}
+
+// CompressBound returns the maximum size of the compressed data of size n.
+func CompressBound(n int, typ int) int {
+	switch typ {
+	case Lz4:
+		return lz4.CompressBlockBound(n)
+	case Zstd:
+		// see ZSTD_COMPRESSBOUND of zstd
+		bound := n + n>>8
+		if n < 128<<10 {
+			bound += (128<<10 - n) >> 11
+		}
+		return bound
+	}
+	return n
+}
+
 func Compress(src, dst []byte, typ int) ([]byte, error) {
+	return CompressWithLevel(src, dst, typ, 0)
+}
+
+// CompressWithLevel compresses src into dst with the level of the algorithm.
+// The default level is used if level is 0. Only zstd supports the level now.
+func CompressWithLevel(src, dst []byte, typ int, level int) ([]byte, error) {
 	switch typ {
 	case Lz4:
 		n, err := lz4.CompressBlock(src, dst, nil)
@@ -31,6 +98,12 @@ func Compress(src, dst []byte, typ int) ([]byte, error) {
 			return nil, err
 		}
 		return dst[:n], nil
+	case Zstd:
+		encoder, err := getZstdEncoder(level)
+		if err != nil {
+			return nil, err
+		}
+		return encoder.EncodeAll(src, dst[:0]), nil
 	}
 	return nil, nil
 }
@@ -43,6 +116,19 @@ func Decompress(src, dst []byte, typ int) ([]byte, error) {
 			return nil, err
 		}
 		return dst[:n], nil
+	case Zstd:
+		decoder, err := getZstdDecoder()
+		if err != nil {
+			return nil, err
+		}
+		data, err := decoder.DecodeAll(src, dst[:0])
+		if err != nil {
+			return nil, err
+		}
+		if len(data) > len(dst) {
+			return nil, moerr.NewInternalErrorNoCtxf("zstd: decompressed size %d exceeds %d", len(data), len(dst))
+		}
+		return data, nil
 	}
 	return nil, nil
 }
@@ -22,6 +22,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 
 	"github.com/pierrec/lz4/v4"
+	"github.com/stretchr/testify/require"
 )
 
 func TestLz4(t *testing.T) {
@@ -41,3 +42,25 @@ func TestLz4(t *testing.T) {
 	}
 	fmt.Printf("dat: %v\n", data)
 }
+
+func TestZstd(t *testing.T) {
+	xs := make([]int64, 8192)
+	for i := range xs {
+		xs[i] = int64(i % 100)
+	}
+	raw := types.EncodeSlice(xs)
+	for _, level := range []int{0, 1, 3, 9, 19} {
+		buf := make([]byte, CompressBound(len(raw), Zstd))
+		buf, err := CompressWithLevel(raw, buf, Zstd, level)
+		require.NoError(t, err)
+		require.Less(t, len(buf), len(raw))
+
+		data := make([]byte, len(raw))
+		data, err = Decompress(buf, data, Zstd)
+		require.NoError(t, err)
+		require.Equal(t, raw, data)
+
+		_, err = Decompress(buf, make([]byte, len(raw)-1), Zstd)
+		require.Error(t, err)
+	}
+}
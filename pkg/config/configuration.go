@@ -70,6 +70,9 @@ var (
 
 	defaultServerVersionPrefix = "8.0.30-MatrixOne-v"
 
+	//the compression algorithms permitted for the client connections
+	defaultProtocolCompressionAlgorithms = "zlib,zstd,uncompressed"
+
 	//the length of query printed into console. -1, complete string. 0, empty string. >0 , length of characters at the header of the string.
 	defaultLengthOfQueryPrinted = 1024
 
@@ -243,6 +246,18 @@ type FrontendParameters struct {
 	//default is ''. Path of file that contains X509 key in PEM format for client
 	TlsKeyFile string `toml:"tlsKeyFile" user_setting:"advanced"`
 
+	//default is ''. Path of file that contains RSA private key in PEM format for caching_sha2_password.
+	//A new key pair is generated if it is empty. The CN servers behind proxy should share
+	//the same key, as proxy replays the encrypted password when it moves the connection.
+	CachingSha2PasswordPrivateKeyFile string `toml:"cachingSha2PasswordPrivateKeyFile" user_setting:"advanced"`
+
+	//default is 'zlib,zstd,uncompressed'. The compression algorithms permitted for the client connections.
+	ProtocolCompressionAlgorithms string `toml:"protocolCompressionAlgorithms" user_setting:"advanced"`
+
+	//default is 0. The compression level of the client connections. 0 means the default level of
+	//the algorithm, 6 for zlib and the level requested by the client or 3 for zstd.
+	ProtocolCompressionLevel int `toml:"protocolCompressionLevel" user_setting:"advanced"`
+
 	//default is 1
 	LogShardID uint64 `toml:"logshardid"`
 
@@ -337,6 +352,10 @@ func (fp *FrontendParameters) SetDefaultValues() {
 		fp.ServerVersionPrefix = defaultServerVersionPrefix
 	}
 
+	if fp.ProtocolCompressionAlgorithms == "" {
+		fp.ProtocolCompressionAlgorithms = defaultProtocolCompressionAlgorithms
+	}
+
 	if fp.LengthOfQueryPrinted == 0 {
 		fp.LengthOfQueryPrinted = int64(defaultLengthOfQueryPrinted)
 	}
@@ -0,0 +1,479 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytejson
+
+import (
+	"bytes"
+	"math"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/util"
+)
+
+// ModifyType is the way JSON_SET, JSON_INSERT and JSON_REPLACE change a document.
+type ModifyType byte
+
+const (
+	// ModifySet replaces the existing values and adds the new ones.
+	ModifySet ModifyType = iota + 1
+	// ModifyInsert adds the new values only.
+	ModifyInsert
+	// ModifyReplace replaces the existing values only.
+	ModifyReplace
+)
+
+// CreateByteJSON creates a json value from nil, bool, int64, uint64, float64, string or ByteJson.
+func CreateByteJSON(v any) (ByteJson, error) {
+	switch val := v.(type) {
+	case nil:
+		return Null, nil
+	case bool:
+		lit := LiteralFalse
+		if val {
+			lit = LiteralTrue
+		}
+		return ByteJson{Type: TpCodeLiteral, Data: []byte{lit}}, nil
+	case int64:
+		data := make([]byte, numberSize)
+		endian.PutUint64(data, uint64(val))
+		return ByteJson{Type: TpCodeInt64, Data: data}, nil
+	case uint64:
+		data := make([]byte, numberSize)
+		endian.PutUint64(data, val)
+		return ByteJson{Type: TpCodeUint64, Data: data}, nil
+	case float64:
+		if err := checkFloat64(val); err != nil {
+			return Null, err
+		}
+		data := make([]byte, numberSize)
+		endian.PutUint64(data, math.Float64bits(val))
+		return ByteJson{Type: TpCodeFloat64, Data: data}, nil
+	case string:
+		return ByteJson{Type: TpCodeString, Data: addString(nil, val)}, nil
+	case ByteJson:
+		return val, nil
+	default:
+		return Null, moerr.NewInvalidInputNoCtxf("unknown type %T", v)
+	}
+}
+
+// BuildArray creates a json array of the elements.
+func BuildArray(elems []ByteJson) ByteJson {
+	return mergeToArray(elems)
+}
+
+// BuildObject creates a json object of the keys and values,
+// the last value wins if a key appears more than once.
+func BuildObject(keys []string, vals []ByteJson) (ByteJson, error) {
+	idx := make([]int, len(keys))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool { return keys[idx[i]] < keys[idx[j]] })
+	uniq := idx[:0]
+	for _, i := range idx {
+		if len(uniq) > 0 && keys[uniq[len(uniq)-1]] == keys[i] {
+			uniq[len(uniq)-1] = i
+			continue
+		}
+		uniq = append(uniq, i)
+	}
+
+	n := len(uniq)
+	totalSize := headerSize + n*(keyEntrySize+valEntrySize)
+	for _, i := range uniq {
+		if len(keys[i]) > math.MaxUint16 {
+			return Null, moerr.NewInvalidInputNoCtxf("json key %s", keys[i])
+		}
+		totalSize += len(keys[i])
+		if vals[i].Type != TpCodeLiteral {
+			totalSize += len(vals[i].Data)
+		}
+	}
+	buf := make([]byte, headerSize+n*(keyEntrySize+valEntrySize), totalSize)
+	endian.PutUint32(buf, uint32(n))
+	endian.PutUint32(buf[docSizeOff:], uint32(totalSize))
+	sorted := make([]ByteJson, n)
+	for j, i := range uniq {
+		o := headerSize + j*keyEntrySize
+		endian.PutUint32(buf[o:], uint32(len(buf)))
+		endian.PutUint16(buf[o+keyOriginOff:], uint16(len(keys[i])))
+		buf = append(buf, keys[i]...)
+		sorted[j] = vals[i]
+	}
+	buf = addByteElem(buf, headerSize+n*keyEntrySize, sorted)
+	return ByteJson{Type: TpCodeObject, Data: buf}, nil
+}
+
+func (bj ByteJson) arrayElems() []ByteJson {
+	cnt := bj.GetElemCnt()
+	elems := make([]ByteJson, cnt)
+	for i := range elems {
+		elems[i] = bj.getArrayElem(i)
+	}
+	return elems
+}
+
+func (bj ByteJson) objectEntries() ([]string, []ByteJson) {
+	cnt := bj.GetElemCnt()
+	keys := make([]string, cnt)
+	vals := make([]ByteJson, cnt)
+	for i := 0; i < cnt; i++ {
+		keys[i] = string(bj.getObjectKey(i))
+		vals[i] = bj.getObjectVal(i)
+	}
+	return keys, vals
+}
+
+// searchKey returns the index of key in the object, or -1 if the key does not exist.
+func (bj ByteJson) searchKey(key string) int {
+	k := util.UnsafeStringToBytes(key)
+	cnt := bj.GetElemCnt()
+	idx := sort.Search(cnt, func(i int) bool {
+		return bytes.Compare(bj.getObjectKey(i), k) >= 0
+	})
+	if idx >= cnt || !bytes.Equal(bj.getObjectKey(idx), k) {
+		return -1
+	}
+	return idx
+}
+
+// arrayIndex returns the element index of an array leg, a value which is not an array
+// is treated as an array of one element, the same as MySQL.
+func (bj ByteJson) arrayIndex(leg subPath) (int, bool) {
+	cnt := 1
+	if bj.Type == TpCodeArray {
+		cnt = bj.GetElemCnt()
+	}
+	idx, _, _ := leg.idx.genIndex(cnt)
+	return idx, idx >= 0 && idx < cnt
+}
+
+// CheckPathNoWildcard returns an error if the path contains *, ** or an array range,
+// which is not allowed when a path must point to one single value.
+func CheckPathNoWildcard(path *Path) error {
+	if !path.IsSimple() {
+		return moerr.NewInvalidInputNoCtx("in this situation, path expressions may not contain the * and ** tokens or an array range")
+	}
+	return nil
+}
+
+// Lookup returns the value the path points to, the path must not contain any wildcard.
+func (bj ByteJson) Lookup(path *Path) (ByteJson, bool) {
+	cur := bj
+	for _, leg := range path.paths {
+		switch leg.tp {
+		case subPathKey:
+			if cur.Type != TpCodeObject {
+				return Null, false
+			}
+			idx := cur.searchKey(leg.key)
+			if idx < 0 {
+				return Null, false
+			}
+			cur = cur.getObjectVal(idx)
+		case subPathIdx:
+			idx, ok := cur.arrayIndex(leg)
+			if !ok {
+				return Null, false
+			}
+			if cur.Type == TpCodeArray {
+				cur = cur.getArrayElem(idx)
+			}
+		default:
+			return Null, false
+		}
+	}
+	return cur, true
+}
+
+// Match returns all the values the path points to in document order, unlike Query,
+// a path that points to nothing matches nothing rather than a json null.
+func (bj ByteJson) Match(path *Path) []ByteJson {
+	return bj.match(nil, path.paths)
+}
+
+func (bj ByteJson) match(out []ByteJson, legs []subPath) []ByteJson {
+	if len(legs) == 0 {
+		return append(out, bj)
+	}
+	leg, rest := legs[0], legs[1:]
+	switch leg.tp {
+	case subPathDoubleStar:
+		out = bj.match(out, rest)
+		switch bj.Type {
+		case TpCodeObject:
+			for i, cnt := 0, bj.GetElemCnt(); i < cnt; i++ {
+				out = bj.getObjectVal(i).match(out, legs)
+			}
+		case TpCodeArray:
+			for i, cnt := 0, bj.GetElemCnt(); i < cnt; i++ {
+				out = bj.getArrayElem(i).match(out, legs)
+			}
+		}
+	case subPathKey:
+		if bj.Type != TpCodeObject {
+			return out
+		}
+		if leg.key == "*" {
+			for i, cnt := 0, bj.GetElemCnt(); i < cnt; i++ {
+				out = bj.getObjectVal(i).match(out, rest)
+			}
+		} else if idx := bj.searchKey(leg.key); idx >= 0 {
+			out = bj.getObjectVal(idx).match(out, rest)
+		}
+	case subPathIdx:
+		if leg.idx.tp == numberIndices && leg.idx.num == subPathIdxALL {
+			if bj.Type != TpCodeArray {
+				return out
+			}
+			for i, cnt := 0, bj.GetElemCnt(); i < cnt; i++ {
+				out = bj.getArrayElem(i).match(out, rest)
+			}
+		} else if idx, ok := bj.arrayIndex(leg); ok {
+			if bj.Type == TpCodeArray {
+				out = bj.getArrayElem(idx).match(out, rest)
+			} else {
+				out = bj.match(out, rest)
+			}
+		}
+	case subPathRange:
+		cnt := 1
+		if bj.Type == TpCodeArray {
+			cnt = bj.GetElemCnt()
+		}
+		start, _, _ := leg.iRange.start.genIndex(cnt)
+		end, _, _ := leg.iRange.end.genIndex(cnt)
+		start, end = max(start, 0), min(end, cnt-1)
+		for i := start; i <= end; i++ {
+			if bj.Type == TpCodeArray {
+				out = bj.getArrayElem(i).match(out, rest)
+			} else {
+				out = bj.match(out, rest)
+			}
+		}
+	}
+	return out
+}
+
+// Modify sets the values at the paths one by one, the way of JSON_SET, JSON_INSERT and JSON_REPLACE.
+func (bj ByteJson) Modify(paths []*Path, vals []ByteJson, tp ModifyType) (ByteJson, error) {
+	if len(paths) != len(vals) {
+		return Null, moerr.NewInvalidInputNoCtx("the number of paths and values are not matched")
+	}
+	var err error
+	for i, path := range paths {
+		if err = CheckPathNoWildcard(path); err != nil {
+			return Null, err
+		}
+		if bj, err = bj.modify(path.paths, vals[i], tp); err != nil {
+			return Null, err
+		}
+	}
+	return bj, nil
+}
+
+func (bj ByteJson) modify(legs []subPath, val ByteJson, tp ModifyType) (ByteJson, error) {
+	if len(legs) == 0 {
+		if tp == ModifyInsert {
+			return bj, nil
+		}
+		return val, nil
+	}
+
+	leg, rest := legs[0], legs[1:]
+	// a new value is only added by the last leg, and never by JSON_REPLACE.
+	canAdd := len(rest) == 0 && tp != ModifyReplace
+
+	if leg.tp == subPathKey {
+		if bj.Type != TpCodeObject {
+			return bj, nil
+		}
+		keys, vals := bj.objectEntries()
+		idx := bj.searchKey(leg.key)
+		if idx >= 0 {
+			v, err := vals[idx].modify(rest, val, tp)
+			if err != nil {
+				return Null, err
+			}
+			vals[idx] = v
+		} else if canAdd {
+			keys = append(keys, leg.key)
+			vals = append(vals, val)
+		} else {
+			return bj, nil
+		}
+		return BuildObject(keys, vals)
+	}
+
+	idx, ok := bj.arrayIndex(leg)
+	if bj.Type != TpCodeArray {
+		if ok {
+			return bj.modify(rest, val, tp)
+		}
+		if idx > 0 && canAdd {
+			// the value is auto-wrapped into an array
+			return BuildArray([]ByteJson{bj, val}), nil
+		}
+		return bj, nil
+	}
+	elems := bj.arrayElems()
+	if ok {
+		v, err := elems[idx].modify(rest, val, tp)
+		if err != nil {
+			return Null, err
+		}
+		elems[idx] = v
+	} else if idx >= 0 && canAdd {
+		// appended to the end no matter how large the index is
+		elems = append(elems, val)
+	} else {
+		return bj, nil
+	}
+	return BuildArray(elems), nil
+}
+
+// Remove removes the values at the paths one by one, the way of JSON_REMOVE.
+func (bj ByteJson) Remove(paths []*Path) (ByteJson, error) {
+	for _, path := range paths {
+		if err := CheckPathNoWildcard(path); err != nil {
+			return Null, err
+		}
+		if path.empty() {
+			return Null, moerr.NewInvalidInputNoCtx("the path expression '$' is not allowed in this context")
+		}
+	}
+	var err error
+	for _, path := range paths {
+		if bj, err = bj.remove(path.paths); err != nil {
+			return Null, err
+		}
+	}
+	return bj, nil
+}
+
+func (bj ByteJson) remove(legs []subPath) (ByteJson, error) {
+	leg, rest := legs[0], legs[1:]
+
+	if leg.tp == subPathKey {
+		if bj.Type != TpCodeObject {
+			return bj, nil
+		}
+		idx := bj.searchKey(leg.key)
+		if idx < 0 {
+			return bj, nil
+		}
+		keys, vals := bj.objectEntries()
+		if len(rest) == 0 {
+			keys = append(keys[:idx], keys[idx+1:]...)
+			vals = append(vals[:idx], vals[idx+1:]...)
+		} else {
+			v, err := vals[idx].remove(rest)
+			if err != nil {
+				return Null, err
+			}
+			vals[idx] = v
+		}
+		return BuildObject(keys, vals)
+	}
+
+	idx, ok := bj.arrayIndex(leg)
+	if !ok {
+		return bj, nil
+	}
+	if bj.Type != TpCodeArray {
+		if len(rest) == 0 {
+			return bj, nil
+		}
+		return bj.remove(rest)
+	}
+	elems := bj.arrayElems()
+	if len(rest) == 0 {
+		elems = append(elems[:idx], elems[idx+1:]...)
+	} else {
+		v, err := elems[idx].remove(rest)
+		if err != nil {
+			return Null, err
+		}
+		elems[idx] = v
+	}
+	return BuildArray(elems), nil
+}
+
+// Contains reports whether the candidate is contained in the target, the way of JSON_CONTAINS:
+//   - a scalar contains a scalar if they are equal.
+//   - an array contains an array if every element of the candidate is contained in some element of the target.
+//   - an array contains a non-array if the candidate is contained in some element of the target.
+//   - an object contains an object if every key of the candidate is in the target and the values are contained.
+func Contains(target, candidate ByteJson) bool {
+	switch target.Type {
+	case TpCodeObject:
+		if candidate.Type != TpCodeObject {
+			return false
+		}
+		cnt := candidate.GetElemCnt()
+		for i := 0; i < cnt; i++ {
+			idx := target.searchKey(util.UnsafeBytesToString(candidate.getObjectKey(i)))
+			if idx < 0 || !Contains(target.getObjectVal(idx), candidate.getObjectVal(i)) {
+				return false
+			}
+		}
+		return true
+	case TpCodeArray:
+		if candidate.Type == TpCodeArray {
+			cnt := candidate.GetElemCnt()
+			for i := 0; i < cnt; i++ {
+				if !Contains(target, candidate.getArrayElem(i)) {
+					return false
+				}
+			}
+			return true
+		}
+		cnt := target.GetElemCnt()
+		for i := 0; i < cnt; i++ {
+			if Contains(target.getArrayElem(i), candidate) {
+				return true
+			}
+		}
+		return false
+	default:
+		if candidate.Type == TpCodeArray || candidate.Type == TpCodeObject {
+			return false
+		}
+		return CompareByteJson(target, candidate) == 0
+	}
+}
+
+// Keys returns the keys of an object as a json array, it returns false if bj is not an object.
+func (bj ByteJson) Keys() (ByteJson, bool) {
+	if bj.Type != TpCodeObject {
+		return Null, false
+	}
+	cnt := bj.GetElemCnt()
+	keys := make([]ByteJson, cnt)
+	for i := range keys {
+		keys[i] = ByteJson{Type: TpCodeString, Data: addString(nil, string(bj.getObjectKey(i)))}
+	}
+	return BuildArray(keys), true
+}
+
+// Length returns the number of elements of an array or an object, a scalar has length 1.
+func (bj ByteJson) Length() int {
+	if bj.Type == TpCodeArray || bj.Type == TpCodeObject {
+		return bj.GetElemCnt()
+	}
+	return 1
+}
@@ -0,0 +1,212 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytejson
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func mustParse(t *testing.T, s string) ByteJson {
+	bj, err := ParseFromString(s)
+	require.NoError(t, err)
+	return bj
+}
+
+func mustPath(t *testing.T, s string) *Path {
+	p, err := ParseJsonPath(s)
+	require.NoError(t, err)
+	return &p
+}
+
+func TestBuild(t *testing.T) {
+	one, err := CreateByteJSON(int64(1))
+	require.NoError(t, err)
+	str, err := CreateByteJSON("x")
+	require.NoError(t, err)
+	f, err := CreateByteJSON(1.5)
+	require.NoError(t, err)
+	b, err := CreateByteJSON(true)
+	require.NoError(t, err)
+	null, err := CreateByteJSON(nil)
+	require.NoError(t, err)
+
+	arr := BuildArray([]ByteJson{one, str, f, b, null})
+	require.Equal(t, `[1, "x", 1.5, true, null]`, arr.String())
+	require.Equal(t, `[]`, BuildArray(nil).String())
+
+	obj, err := BuildObject([]string{"b", "a", "c", "a"}, []ByteJson{one, str, arr, f})
+	require.NoError(t, err)
+	require.Equal(t, `{"a": 1.5, "b": 1, "c": [1, "x", 1.5, true, null]}`, obj.String())
+
+	// the built value can be queried as a parsed one
+	require.Equal(t, `"x"`, obj.Query([]*Path{mustPath(t, "$.c[1]")}).String())
+	keys, ok := obj.Keys()
+	require.True(t, ok)
+	require.Equal(t, `["a", "b", "c"]`, keys.String())
+	_, ok = arr.Keys()
+	require.False(t, ok)
+	require.Equal(t, 3, obj.Length())
+	require.Equal(t, 1, one.Length())
+}
+
+func TestModify(t *testing.T) {
+	kases := []struct {
+		doc  string
+		path string
+		val  string
+		tp   ModifyType
+		want string
+	}{
+		{`{"a": 1}`, "$.a", "2", ModifySet, `{"a": 2}`},
+		{`{"a": 1}`, "$.b", "2", ModifySet, `{"a": 1, "b": 2}`},
+		{`{"a": 1}`, "$.a", "2", ModifyInsert, `{"a": 1}`},
+		{`{"a": 1}`, "$.b", "2", ModifyInsert, `{"a": 1, "b": 2}`},
+		{`{"a": 1}`, "$.a", "2", ModifyReplace, `{"a": 2}`},
+		{`{"a": 1}`, "$.b", "2", ModifyReplace, `{"a": 1}`},
+		{`{"a": 1}`, "$.b.c", "2", ModifySet, `{"a": 1}`},
+		{`{"a": {"b": [1, 2]}}`, "$.a.b[1]", `"x"`, ModifySet, `{"a": {"b": [1, "x"]}}`},
+		{`[1, 2]`, "$[5]", "3", ModifySet, `[1, 2, 3]`},
+		{`[1, 2]`, "$[5]", "3", ModifyReplace, `[1, 2]`},
+		{`[1, 2]`, "$[last]", "3", ModifySet, `[1, 3]`},
+		{`[1, 2]`, "$[0]", "3", ModifyInsert, `[1, 2]`},
+		{`1`, "$[0]", "3", ModifySet, `3`},
+		{`1`, "$[1]", "3", ModifySet, `[1, 3]`},
+		{`{"a": 1}`, "$[1]", "3", ModifyInsert, `[{"a": 1}, 3]`},
+		{`{"a": 1}`, "$", "3", ModifySet, `3`},
+		{`{"a": 1}`, "$", "3", ModifyInsert, `{"a": 1}`},
+		{`{"a": 1}`, "$.a", "null", ModifySet, `{"a": null}`},
+	}
+	for _, k := range kases {
+		out, err := mustParse(t, k.doc).Modify([]*Path{mustPath(t, k.path)}, []ByteJson{mustParse(t, k.val)}, k.tp)
+		require.NoError(t, err, k.doc)
+		require.Equal(t, k.want, out.String(), "%s %s %s", k.doc, k.path, k.val)
+	}
+
+	// the paths are applied one by one
+	out, err := mustParse(t, `{"a": 1}`).Modify(
+		[]*Path{mustPath(t, "$.b"), mustPath(t, "$.b[1]")},
+		[]ByteJson{mustParse(t, "2"), mustParse(t, "3")}, ModifySet)
+	require.NoError(t, err)
+	require.Equal(t, `{"a": 1, "b": [2, 3]}`, out.String())
+
+	_, err = mustParse(t, `[1]`).Modify([]*Path{mustPath(t, "$[*]")}, []ByteJson{Null}, ModifySet)
+	require.Error(t, err)
+	_, err = mustParse(t, `{"a": 1}`).Modify([]*Path{mustPath(t, "$**.a")}, []ByteJson{Null}, ModifySet)
+	require.Error(t, err)
+}
+
+func TestRemove(t *testing.T) {
+	kases := []struct {
+		doc   string
+		paths []string
+		want  string
+	}{
+		{`{"a": 1, "b": 2}`, []string{"$.a"}, `{"b": 2}`},
+		{`{"a": 1, "b": 2}`, []string{"$.c"}, `{"a": 1, "b": 2}`},
+		{`[1, [2, 3], 4]`, []string{"$[1][0]"}, `[1, [3], 4]`},
+		{`[1, 2, 3]`, []string{"$[0]", "$[0]"}, `[3]`},
+		{`[1, 2, 3]`, []string{"$[last]"}, `[1, 2]`},
+		{`{"a": {"b": 1, "c": 2}}`, []string{"$.a.b"}, `{"a": {"c": 2}}`},
+	}
+	for _, k := range kases {
+		paths := make([]*Path, len(k.paths))
+		for i, p := range k.paths {
+			paths[i] = mustPath(t, p)
+		}
+		out, err := mustParse(t, k.doc).Remove(paths)
+		require.NoError(t, err)
+		require.Equal(t, k.want, out.String(), "%s %v", k.doc, k.paths)
+	}
+
+	_, err := mustParse(t, `[1]`).Remove([]*Path{mustPath(t, "$")})
+	require.Error(t, err)
+}
+
+func TestContains(t *testing.T) {
+	kases := []struct {
+		target    string
+		candidate string
+		want      bool
+	}{
+		{`1`, `1`, true},
+		{`1`, `1.0`, true},
+		{`1`, `"1"`, false},
+		{`[1, 2, [3, 4]]`, `2`, true},
+		{`[1, 2, [3, 4]]`, `[1, 3]`, true},
+		{`[1, 2, [3, 4]]`, `[1, 5]`, false},
+		{`[1, 2]`, `[]`, true},
+		{`{"a": 1, "b": {"c": [1, 2]}}`, `{"b": {"c": 2}}`, true},
+		{`{"a": 1, "b": {"c": [1, 2]}}`, `{"a": 2}`, false},
+		{`{"a": 1}`, `1`, false},
+		{`1`, `[1]`, false},
+		{`[{"a": 1}]`, `{"a": 1}`, true},
+	}
+	for _, k := range kases {
+		require.Equal(t, k.want, Contains(mustParse(t, k.target), mustParse(t, k.candidate)), "%s %s", k.target, k.candidate)
+	}
+}
+
+func TestLookup(t *testing.T) {
+	doc := mustParse(t, `{"a": null, "b": [1, {"c": 2}]}`)
+	kases := []struct {
+		path  string
+		found bool
+		want  string
+	}{
+		{"$", true, `{"a": null, "b": [1, {"c": 2}]}`},
+		{"$.a", true, `null`},
+		{"$.x", false, ``},
+		{"$.b[1].c", true, `2`},
+		{"$.b[last]", true, `{"c": 2}`},
+		{"$.b[2]", false, ``},
+		{"$.b[0][0]", true, `1`},
+		{"$[0].a", true, `null`},
+	}
+	for _, k := range kases {
+		v, ok := doc.Lookup(mustPath(t, k.path))
+		require.Equal(t, k.found, ok, k.path)
+		if ok {
+			require.Equal(t, k.want, v.String(), k.path)
+		}
+	}
+
+	matches := []struct {
+		path string
+		want []string
+	}{
+		{"$", []string{`{"a": null, "b": [1, {"c": 2}]}`}},
+		{"$.*", []string{`null`, `[1, {"c": 2}]`}},
+		{"$.x", nil},
+		{"$.b[*]", []string{`1`, `{"c": 2}`}},
+		{"$.b[0 to 5]", []string{`1`, `{"c": 2}`}},
+		{"$.b[last]", []string{`{"c": 2}`}},
+		{"$.b[3]", nil},
+		{"$**.c", []string{`2`}},
+		{"$.a[*]", nil},
+	}
+	for _, k := range matches {
+		var got []string
+		for _, v := range doc.Match(mustPath(t, k.path)) {
+			got = append(got, v.String())
+		}
+		require.Equal(t, k.want, got, k.path)
+	}
+
+	require.Error(t, CheckPathNoWildcard(mustPath(t, "$.*")))
+	require.Error(t, CheckPathNoWildcard(mustPath(t, "$[0 to 1]")))
+	require.NoError(t, CheckPathNoWildcard(mustPath(t, "$.a[last - 1]")))
+}
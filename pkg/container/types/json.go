@@ -15,6 +15,9 @@
 package types
 
 import (
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
 )
 
@@ -28,3 +31,60 @@ func ParseSliceToByteJson(dt []byte) (bytejson.ByteJson, error) {
 func ParseStringToPath(str string) (bytejson.Path, error) {
 	return bytejson.ParseJsonPath(str)
 }
+
+// ValueToByteJson converts a value of typ, in the bytes of the vector storage, to a json value,
+// the way MySQL converts the arguments of JSON_OBJECT, JSON_ARRAY and JSON_SET:
+// strings become json strings instead of being parsed, and json values are kept as they are.
+// loc is the time zone of the timestamps.
+func ValueToByteJson(typ Type, data []byte, loc *time.Location) (bytejson.ByteJson, error) {
+	var v any
+	switch typ.Oid {
+	case T_any:
+		v = nil
+	case T_json:
+		return DecodeJson(data), nil
+	case T_bool:
+		v = DecodeBool(data)
+	case T_bit:
+		v = DecodeFixed[uint64](data)
+	case T_int8:
+		v = int64(DecodeFixed[int8](data))
+	case T_int16:
+		v = int64(DecodeFixed[int16](data))
+	case T_int32:
+		v = int64(DecodeFixed[int32](data))
+	case T_int64:
+		v = DecodeFixed[int64](data)
+	case T_uint8:
+		v = uint64(DecodeFixed[uint8](data))
+	case T_uint16:
+		v = uint64(DecodeFixed[uint16](data))
+	case T_uint32:
+		v = uint64(DecodeFixed[uint32](data))
+	case T_uint64:
+		v = DecodeFixed[uint64](data)
+	case T_float32:
+		v = float64(DecodeFixed[float32](data))
+	case T_float64:
+		v = DecodeFixed[float64](data)
+	case T_decimal64:
+		return bytejson.ParseFromString(DecodeDecimal64(data).Format(typ.Scale))
+	case T_decimal128:
+		return bytejson.ParseFromString(DecodeFixed[Decimal128](data).Format(typ.Scale))
+	case T_date:
+		v = DecodeDate(data).String()
+	case T_time:
+		v = DecodeFixed[Time](data).String2(typ.Scale)
+	case T_datetime:
+		v = DecodeFixed[Datetime](data).String2(typ.Scale)
+	case T_timestamp:
+		v = DecodeFixed[Timestamp](data).String2(loc, typ.Scale)
+	case T_uuid:
+		v = DecodeUuid(data).String()
+	case T_char, T_varchar, T_text:
+		v = string(data)
+	default:
+		return bytejson.Null, moerr.NewInvalidInputNoCtxf("unsupported type for json: %v", typ.String())
+	}
+	return bytejson.CreateByteJSON(v)
+}
@@ -674,6 +674,8 @@ const (
 	PrivilegeTypeCanGrantRoleToOthersInCreateUser // used in checking the privilege of CreateUser with the default role
 	PrivilegeTypeValues
 	PrivilegeTypeUpgradeAccount
+	PrivilegeTypeEvent   //include create/alter/drop event
+	PrivilegeTypeTrigger //include create/drop trigger
 )
 
 type PrivilegeScope uint8
@@ -809,6 +811,10 @@ func (pt PrivilegeType) String() string {
 		return "execute"
 	case PrivilegeTypeValues:
 		return "values"
+	case PrivilegeTypeEvent:
+		return "event"
+	case PrivilegeTypeTrigger:
+		return "trigger"
 	}
 	panic(fmt.Sprintf("no such privilege type %d", pt))
 }
@@ -865,6 +871,10 @@ func (pt PrivilegeType) Scope() PrivilegeScope {
 		return PrivilegeScopeDatabase
 	case PrivilegeTypeDatabaseOwnership:
 		return PrivilegeScopeDatabase
+	case PrivilegeTypeEvent:
+		return PrivilegeScopeDatabase
+	case PrivilegeTypeTrigger:
+		return PrivilegeScopeDatabase
 	case PrivilegeTypeSelect:
 		return PrivilegeScopeTable
 	case PrivilegeTypeInsert:
@@ -925,6 +935,9 @@ var (
 		catalog.MOUpgradeTable:       {},
 		catalog.MOUpgradeTenantTable: {},
 		catalog.MO_PITR:              {},
+		catalog.MO_CDC_TASK:          {},
+		catalog.MO_CDC_WATERMARK:     {},
+		catalog.MO_STREAM_OFFSETS:    {},
 	}
 	//predefined tables of the database mo_catalog in every account
 	predefinedTables = map[string]int8{
@@ -957,6 +970,10 @@ var (
 		"mo_shards":                   0,
 		"mo_shards_metadata":          0,
 		catalog.MO_RETENTION:          0,
+		catalog.MO_EVENTS:             0,
+		catalog.MO_EVENT_HISTORY:      0,
+		catalog.MO_TRIGGERS:           0,
+		catalog.MO_CHECK_CONSTRAINTS:  0,
 	}
 	createDbInformationSchemaSql = "create database information_schema;"
 	createAutoTableSql           = MoCatalogMoAutoIncrTableDDL
@@ -982,6 +999,10 @@ var (
 		MoCatalogMoSnapshotsDDL,
 		MoCatalogMoPubsDDL,
 		MoCatalogMoRetentionDDL,
+		MoCatalogMoEventsDDL,
+		MoCatalogMoEventHistoryDDL,
+		MoCatalogMoTriggersDDL,
+		MoCatalogMoCheckConstraintsDDL,
 		MoCatalogMoSubsDDL,
 		MoCatalogMoStoredProcedureDDL,
 		MoCatalogMoStagesDDL,
@@ -1018,6 +1039,10 @@ var (
 	dropMoTablePartitions           = fmt.Sprintf(`drop table if exists %s.%s;`, catalog.MO_CATALOG, catalog.MO_TABLE_PARTITIONS)
 	dropMoForeignKeys               = `drop table if exists mo_catalog.mo_foreign_keys;`
 	dropMoRetention                 = `drop table if exists mo_catalog.mo_retention;`
+	dropMoEvents                    = `drop table if exists mo_catalog.mo_events;`
+	dropMoEventHistory              = `drop table if exists mo_catalog.mo_event_history;`
+	dropMoTriggers                  = `drop table if exists mo_catalog.mo_triggers;`
+	dropMoCheckConstraints          = `drop table if exists mo_catalog.mo_check_constraints;`
 
 	initMoMysqlCompatibilityModeFormat = `insert into mo_catalog.mo_mysql_compatibility_mode(
 		account_id,
@@ -2111,6 +2136,8 @@ var (
 		PrivilegeTypeAlterView:         {PrivilegeTypeAlterView, privilegeLevelStar, objectTypeDatabase, objectIDAll, true, "", "", privilegeEntryTypeGeneral, nil},
 		PrivilegeTypeDatabaseAll:       {PrivilegeTypeDatabaseAll, privilegeLevelStar, objectTypeDatabase, objectIDAll, true, "", "", privilegeEntryTypeGeneral, nil},
 		PrivilegeTypeDatabaseOwnership: {PrivilegeTypeDatabaseOwnership, privilegeLevelStar, objectTypeDatabase, objectIDAll, true, "", "", privilegeEntryTypeGeneral, nil},
+		PrivilegeTypeEvent:             {PrivilegeTypeEvent, privilegeLevelStar, objectTypeDatabase, objectIDAll, true, "", "", privilegeEntryTypeGeneral, nil},
+		PrivilegeTypeTrigger:           {PrivilegeTypeTrigger, privilegeLevelStar, objectTypeDatabase, objectIDAll, true, "", "", privilegeEntryTypeGeneral, nil},
 		PrivilegeTypeSelect:            {PrivilegeTypeSelect, privilegeLevelStarStar, objectTypeTable, objectIDAll, true, "", "", privilegeEntryTypeGeneral, nil},
 		PrivilegeTypeInsert:            {PrivilegeTypeInsert, privilegeLevelStarStar, objectTypeTable, objectIDAll, true, "", "", privilegeEntryTypeGeneral, nil},
 		PrivilegeTypeUpdate:            {PrivilegeTypeUpdate, privilegeLevelStarStar, objectTypeTable, objectIDAll, true, "", "", privilegeEntryTypeGeneral, nil},
@@ -2150,6 +2177,8 @@ var (
 		PrivilegeTypeAlterView,
 		PrivilegeTypeDatabaseAll,
 		PrivilegeTypeDatabaseOwnership,
+		PrivilegeTypeEvent,
+		PrivilegeTypeTrigger,
 		PrivilegeTypeSelect,
 		PrivilegeTypeInsert,
 		PrivilegeTypeUpdate,
@@ -2184,6 +2213,8 @@ var (
 		PrivilegeTypeAlterView,
 		PrivilegeTypeDatabaseAll,
 		PrivilegeTypeDatabaseOwnership,
+		PrivilegeTypeEvent,
+		PrivilegeTypeTrigger,
 		PrivilegeTypeSelect,
 		PrivilegeTypeInsert,
 		PrivilegeTypeUpdate,
@@ -2632,6 +2663,29 @@ type user struct {
 	AuthExist bool
 	IdentTyp  tree.AccountIdentifiedOption
 	IdentStr  string
+	// AuthPlugin is the authentication plugin of the password, empty for the default one.
+	AuthPlugin string
+}
+
+// hashPassWordWithPlugin hashes the password with the authentication plugin.
+// The default digest rounds of caching_sha2_password is used if ses is nil.
+func hashPassWordWithPlugin(ctx context.Context, ses *Session, plugin, pwd string) (string, error) {
+	switch plugin {
+	case "", AuthNativePassword:
+		return HashPassWord(pwd), nil
+	case AuthCachingSha2Password:
+		rounds := int64(cachingSha2DigestRounds)
+		if ses != nil {
+			if v, err := ses.GetGlobalSysVar("caching_sha2_password_digest_rounds"); err == nil {
+				if r, ok := v.(int64); ok {
+					rounds = r
+				}
+			}
+		}
+		return HashPassWordWithSha2(pwd, int(rounds)), nil
+	default:
+		return "", moerr.NewNotSupportedf(ctx, "authentication plugin %s", plugin)
+	}
 }
 
 func doAlterUser(ctx context.Context, ses *Session, au *alterUser) (err error) {
@@ -2731,7 +2785,10 @@ func doAlterUser(ctx context.Context, ses *Session, au *alterUser) (err error) {
 	}
 
 	//encryption the password
-	encryption = HashPassWord(password)
+	encryption, err = hashPassWordWithPlugin(ctx, ses, user.AuthPlugin, password)
+	if err != nil {
+		return err
+	}
 
 	if execResultArrayHasData(erArray) || getGlobalPu().SV.SkipCheckPrivilege {
 		sql, err = getSqlForUpdatePasswordOfUser(ctx, encryption, userName)
@@ -2766,6 +2823,8 @@ type alterAccount struct {
 	AdminName string
 	IdentTyp  tree.AccountIdentifiedOption
 	IdentStr  string
+	// AuthPlugin is the authentication plugin of the password, empty for the default one.
+	AuthPlugin string
 
 	// status_option or not
 	StatusOption tree.AccountStatus
@@ -2917,7 +2976,10 @@ func doAlterAccount(ctx context.Context, ses *Session, aa *alterAccount) (err er
 
 				//2, update the password
 				//encryption the password
-				encryption := HashPassWord(aa.IdentStr)
+				encryption, rtnErr := hashPassWordWithPlugin(ctx, ses, aa.AuthPlugin, aa.IdentStr)
+				if rtnErr != nil {
+					return rtnErr
+				}
 				sql, rtnErr = getSqlForUpdatePasswordOfUser(ctx, encryption, aa.AdminName)
 				if rtnErr != nil {
 					return rtnErr
@@ -3651,6 +3713,26 @@ func doDropAccount(ctx context.Context, ses *Session, da *dropAccount) (err erro
 			return rtnErr
 		}
 
+		rtnErr = bh.Exec(deleteCtx, dropMoEvents)
+		if rtnErr != nil {
+			return rtnErr
+		}
+
+		rtnErr = bh.Exec(deleteCtx, dropMoEventHistory)
+		if rtnErr != nil {
+			return rtnErr
+		}
+
+		rtnErr = bh.Exec(deleteCtx, dropMoTriggers)
+		if rtnErr != nil {
+			return rtnErr
+		}
+
+		rtnErr = bh.Exec(deleteCtx, dropMoCheckConstraints)
+		if rtnErr != nil {
+			return rtnErr
+		}
+
 		rtnErr = bh.Exec(deleteCtx, dropMoForeignKeys)
 		if rtnErr != nil {
 			return rtnErr
@@ -5221,6 +5303,14 @@ func determinePrivilegeSetOfStatement(stmt tree.Statement) *privilege {
 		objType = objectTypeDatabase
 		typs = append(typs, PrivilegeTypeCreateView, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
 		writeDatabaseAndTableDirectly = true
+	case *tree.CreateEvent, *tree.AlterEvent, *tree.DropEvent:
+		objType = objectTypeDatabase
+		typs = append(typs, PrivilegeTypeEvent, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
+		writeDatabaseAndTableDirectly = true
+	case *tree.CreateTrigger, *tree.DropTrigger:
+		objType = objectTypeDatabase
+		typs = append(typs, PrivilegeTypeTrigger, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
+		writeDatabaseAndTableDirectly = true
 	case *tree.Select:
 		objType = objectTypeTable
 		typs = append(typs, PrivilegeTypeSelect, PrivilegeTypeTableAll, PrivilegeTypeTableOwnership)
@@ -5301,7 +5391,8 @@ func determinePrivilegeSetOfStatement(stmt tree.Statement) *privilege {
 	case *tree.ExplainFor, *tree.ExplainAnalyze, *tree.ExplainStmt, *tree.ExplainPhyPlan:
 		objType = objectTypeNone
 		kind = privilegeKindNone
-	case *tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction:
+	case *tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction,
+		*tree.Savepoint, *tree.RollbackToSavepoint, *tree.ReleaseSavepoint:
 		objType = objectTypeNone
 		kind = privilegeKindNone
 	case *tree.SetVar:
@@ -6637,6 +6728,10 @@ func getSqlForCheckRoleHasPrivilegeWGODependsOnPrivType(privType PrivilegeType)
 		return getSqlForCheckRoleHasPrivilegeWGOOrWithOwnerShip(int64(privType), int64(PrivilegeTypeDatabaseAll), int64(PrivilegeTypeDatabaseOwnership))
 	case PrivilegeTypeDatabaseOwnership:
 		return getSqlForCheckRoleHasPrivilegeWGO(int64(privType))
+	case PrivilegeTypeEvent:
+		return getSqlForCheckRoleHasPrivilegeWGOOrWithOwnerShip(int64(privType), int64(PrivilegeTypeDatabaseAll), int64(PrivilegeTypeDatabaseOwnership))
+	case PrivilegeTypeTrigger:
+		return getSqlForCheckRoleHasPrivilegeWGOOrWithOwnerShip(int64(privType), int64(PrivilegeTypeDatabaseAll), int64(PrivilegeTypeDatabaseOwnership))
 
 	// table level privileges
 	case PrivilegeTypeSelect:
@@ -6896,6 +6991,10 @@ func convertAstPrivilegeTypeToPrivilegeType(ctx context.Context, priv tree.Privi
 		privType = PrivilegeTypeReference
 	case tree.PRIVILEGE_TYPE_STATIC_VALUES:
 		privType = PrivilegeTypeValues
+	case tree.PRIVILEGE_TYPE_STATIC_EVENT:
+		privType = PrivilegeTypeEvent
+	case tree.PRIVILEGE_TYPE_STATIC_TRIGGER:
+		privType = PrivilegeTypeTrigger
 	default:
 		return 0, moerr.NewInternalErrorf(ctx, "unsupported privilege type %s", priv.ToString())
 	}
@@ -7038,6 +7137,7 @@ type createAccount struct {
 	AdminName    string
 	IdentTyp     tree.AccountIdentifiedOption
 	IdentStr     string
+	AuthPlugin   string
 	StatusOption tree.AccountStatus
 	Comment      tree.AccountComment
 }
@@ -7333,7 +7433,10 @@ func createTablesInMoCatalogOfGeneralTenant2(bh BackgroundExec, ca *createAccoun
 		return err
 	}
 	//encryption the password
-	encryption := HashPassWord(password)
+	encryption, err := hashPassWordWithPlugin(newTenantCtx, nil, ca.AuthPlugin, password)
+	if err != nil {
+		return err
+	}
 	status := rootStatus
 	//TODO: fix the status of user or account
 	if ca.StatusOption.Exist {
@@ -7677,7 +7780,10 @@ func InitUser(ctx context.Context, ses *Session, tenant *TenantInfo, cu *createU
 		}
 
 		//encryption the password
-		encryption := HashPassWord(password)
+		encryption, err := hashPassWordWithPlugin(ctx, ses, user.AuthPlugin, password)
+		if err != nil {
+			return err
+		}
 
 		//TODO: get comment or attribute. there is no field in mo_user to store it.
 		host = user.Hostname
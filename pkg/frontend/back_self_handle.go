@@ -28,6 +28,21 @@ func execInFrontendInBack(backSes *backSession,
 	case *tree.BeginTransaction:
 	case *tree.CommitTransaction:
 	case *tree.RollbackTransaction:
+	case *tree.Savepoint:
+		err = backSes.GetTxnHandler().Savepoint(execCtx, string(st.Name))
+		if err != nil {
+			return
+		}
+	case *tree.RollbackToSavepoint:
+		err = backSes.GetTxnHandler().RollbackToSavepoint(execCtx, string(st.Name))
+		if err != nil {
+			return
+		}
+	case *tree.ReleaseSavepoint:
+		err = backSes.GetTxnHandler().ReleaseSavepoint(execCtx, string(st.Name))
+		if err != nil {
+			return
+		}
 	case *tree.Use:
 		execCtx.ses.EnterFPrint(FPInBackUse)
 		defer execCtx.ses.ExitFPrint(FPInBackUse)
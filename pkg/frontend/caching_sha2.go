@@ -0,0 +1,449 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+/*
+caching_sha2_password
+
+The authentication string of the user is stored as
+
+	$A$<rounds/1000 in %03X>$<20 bytes salt><43 bytes SHA256-crypt digest>
+
+The client sends the scramble XOR(SHA256(password), SHA256(SHA256(SHA256(password)), nonce))
+in the handshake response. The server keeps SHA256(SHA256(password)) of the users
+authenticated recently in the cache, the scramble can be checked with it directly (fast
+authentication). Otherwise, the server asks the client to send the password (full
+authentication), in clear text if the connection is secure, or encrypted by the RSA public
+key of the server.
+*/
+
+const (
+	cachingSha2DigestRounds = 5000
+	cachingSha2SaltLength   = 20
+	cachingSha2DigestLength = 43
+	// the length of the authentication string of caching_sha2_password.
+	cachingSha2AuthStringLength = 7 + cachingSha2SaltLength + cachingSha2DigestLength
+
+	// the header of the AuthMoreData packet
+	authMoreDataHeader byte = 0x01
+	// the status in the AuthMoreData packet of caching_sha2_password
+	cachingSha2RequestPublicKey byte = 0x02
+	cachingSha2FastAuthSuccess  byte = 0x03
+	cachingSha2PerformFullAuth  byte = 0x04
+
+	// the max number of the users in the fast authentication cache.
+	cachingSha2CacheSize = 10000
+)
+
+const cryptAlphabet = "./0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// HashPassWordWithSha2 hashes the password for caching_sha2_password.
+func HashPassWordWithSha2(pwd string, rounds int) string {
+	if len(pwd) == 0 {
+		return ""
+	}
+	rounds = rounds / 1000 * 1000
+	if rounds < cachingSha2DigestRounds {
+		rounds = cachingSha2DigestRounds
+	}
+	salt := make([]byte, cachingSha2SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		panic(err)
+	}
+	for i := range salt {
+		salt[i] = cryptAlphabet[int(salt[i])%len(cryptAlphabet)]
+	}
+	return fmt.Sprintf("$A$%03X$%s%s", rounds/1000, salt, sha256Crypt([]byte(pwd), salt, rounds))
+}
+
+// isSha2PassWord returns true if the authentication string is hashed for
+// caching_sha2_password.
+func isSha2PassWord(authString []byte) bool {
+	return len(authString) == cachingSha2AuthStringLength &&
+		bytes.HasPrefix(authString, []byte("$A$")) &&
+		authString[6] == '$'
+}
+
+// checkSha2PassWord checks the clear text password with the authentication
+// string of caching_sha2_password.
+func checkSha2PassWord(authString, pwd []byte) bool {
+	if !isSha2PassWord(authString) {
+		return false
+	}
+	rounds, err := strconv.ParseUint(string(authString[3:6]), 16, 32)
+	if err != nil {
+		return false
+	}
+	salt := authString[7 : 7+cachingSha2SaltLength]
+	digest := sha256Crypt(pwd, salt, int(rounds)*1000)
+	return subtle.ConstantTimeCompare(digest, authString[7+cachingSha2SaltLength:]) == 1
+}
+
+// sha256Crypt is the SHA256-crypt digest of the key, the salt is not
+// truncated to 16 bytes as what MySQL does.
+func sha256Crypt(key, salt []byte, rounds int) []byte {
+	h := sha256.New()
+	h.Write(key)
+	h.Write(salt)
+	h.Write(key)
+	b := h.Sum(nil)
+
+	h.Reset()
+	h.Write(key)
+	h.Write(salt)
+	h.Write(repeatBytes(b, len(key)))
+	for i := len(key); i > 0; i >>= 1 {
+		if i&1 != 0 {
+			h.Write(b)
+		} else {
+			h.Write(key)
+		}
+	}
+	a := h.Sum(nil)
+
+	h.Reset()
+	for i := 0; i < len(key); i++ {
+		h.Write(key)
+	}
+	p := repeatBytes(h.Sum(nil), len(key))
+
+	h.Reset()
+	for i := 0; i < 16+int(a[0]); i++ {
+		h.Write(salt)
+	}
+	s := repeatBytes(h.Sum(nil), len(salt))
+
+	c := a
+	for i := 0; i < rounds; i++ {
+		h.Reset()
+		if i&1 != 0 {
+			h.Write(p)
+		} else {
+			h.Write(c)
+		}
+		if i%3 != 0 {
+			h.Write(s)
+		}
+		if i%7 != 0 {
+			h.Write(p)
+		}
+		if i&1 != 0 {
+			h.Write(c)
+		} else {
+			h.Write(p)
+		}
+		c = h.Sum(c[:0])
+	}
+
+	out := make([]byte, 0, cachingSha2DigestLength)
+	encode := func(b2, b1, b0 byte, n int) {
+		w := uint(b2)<<16 | uint(b1)<<8 | uint(b0)
+		for ; n > 0; n-- {
+			out = append(out, cryptAlphabet[w&0x3f])
+			w >>= 6
+		}
+	}
+	encode(c[0], c[10], c[20], 4)
+	encode(c[21], c[1], c[11], 4)
+	encode(c[12], c[22], c[2], 4)
+	encode(c[3], c[13], c[23], 4)
+	encode(c[24], c[4], c[14], 4)
+	encode(c[15], c[25], c[5], 4)
+	encode(c[6], c[16], c[26], 4)
+	encode(c[27], c[7], c[17], 4)
+	encode(c[18], c[28], c[8], 4)
+	encode(c[9], c[19], c[29], 4)
+	encode(0, c[31], c[30], 3)
+	return out
+}
+
+// repeatBytes repeats b to the length n.
+func repeatBytes(b []byte, n int) []byte {
+	out := make([]byte, n)
+	for i := 0; i < n; i += len(b) {
+		copy(out[i:], b)
+	}
+	return out
+}
+
+// sha2Stage2 returns SHA256(SHA256(password)).
+func sha2Stage2(pwd []byte) []byte {
+	stage1 := sha256.Sum256(pwd)
+	stage2 := sha256.Sum256(stage1[:])
+	return stage2[:]
+}
+
+// ScrambleSha2 computes the scramble of caching_sha2_password sent by the
+// client.
+func ScrambleSha2(pwd, salt []byte) []byte {
+	stage1 := sha256.Sum256(pwd)
+	stage2 := sha256.Sum256(stage1[:])
+	h := sha256.New()
+	h.Write(stage2[:])
+	h.Write(salt)
+	scramble := h.Sum(nil)
+	for i := range scramble {
+		scramble[i] ^= stage1[i]
+	}
+	return scramble
+}
+
+// CheckSha2Scramble checks the scramble of caching_sha2_password with
+// SHA256(SHA256(password)).
+// stage1 = scramble XOR SHA256(stage2 + salt)
+// check(SHA256(stage1), stage2)
+func CheckSha2Scramble(stage2, salt, scramble []byte) bool {
+	if len(stage2) != sha256.Size || len(scramble) != sha256.Size {
+		return false
+	}
+	h := sha256.New()
+	h.Write(stage2)
+	h.Write(salt)
+	stage1 := h.Sum(nil)
+	for i := range stage1 {
+		stage1[i] ^= scramble[i]
+	}
+	got := sha256.Sum256(stage1)
+	return subtle.ConstantTimeCompare(got[:], stage2) == 1
+}
+
+type sha2CacheEntry struct {
+	authString string
+	stage2     []byte
+}
+
+// sha2Cache is the fast authentication cache of caching_sha2_password, it
+// keeps SHA256(SHA256(password)) of the users authenticated. The entry is
+// valid only if the authentication string of the user is not changed.
+type sha2Cache struct {
+	sync.Mutex
+	entries map[string]sha2CacheEntry
+}
+
+var globalSha2Cache = &sha2Cache{entries: make(map[string]sha2CacheEntry)}
+
+func (c *sha2Cache) get(user string, authString []byte) []byte {
+	c.Lock()
+	defer c.Unlock()
+	e, ok := c.entries[user]
+	if !ok || e.authString != string(authString) {
+		return nil
+	}
+	return e.stage2
+}
+
+func (c *sha2Cache) set(user string, authString, stage2 []byte) {
+	c.Lock()
+	defer c.Unlock()
+	if _, ok := c.entries[user]; !ok && len(c.entries) >= cachingSha2CacheSize {
+		for k := range c.entries {
+			delete(c.entries, k)
+			break
+		}
+	}
+	c.entries[user] = sha2CacheEntry{authString: string(authString), stage2: stage2}
+}
+
+// Sha2RSAKey is the RSA key pair used to encrypt the password in the full
+// authentication of caching_sha2_password on the insecure connections.
+type Sha2RSAKey struct {
+	key *rsa.PrivateKey
+	// publicKey is the public key in PEM format, which is sent to the client.
+	publicKey []byte
+}
+
+// sha2RSAKeys keeps the RSA keys loaded, the key is the file of the private
+// key, and empty for the generated one.
+var sha2RSAKeys struct {
+	sync.Mutex
+	keys map[string]*Sha2RSAKey
+}
+
+// GetSha2RSAKey loads the private key from the file, or generates a new one
+// if the file is not specified.
+func GetSha2RSAKey(ctx context.Context, keyFile string) (*Sha2RSAKey, error) {
+	sha2RSAKeys.Lock()
+	defer sha2RSAKeys.Unlock()
+	if k, ok := sha2RSAKeys.keys[keyFile]; ok {
+		return k, nil
+	}
+
+	var key *rsa.PrivateKey
+	if keyFile != "" {
+		data, err := os.ReadFile(keyFile)
+		if err != nil {
+			return nil, err
+		}
+		block, _ := pem.Decode(data)
+		if block == nil {
+			return nil, moerr.NewInternalErrorf(ctx, "invalid RSA private key file %s", keyFile)
+		}
+		if key, err = x509.ParsePKCS1PrivateKey(block.Bytes); err != nil {
+			k, err2 := x509.ParsePKCS8PrivateKey(block.Bytes)
+			if err2 != nil {
+				return nil, err
+			}
+			var ok bool
+			if key, ok = k.(*rsa.PrivateKey); !ok {
+				return nil, moerr.NewInternalErrorf(ctx, "%s is not a RSA private key", keyFile)
+			}
+		}
+	} else {
+		var err error
+		if key, err = rsa.GenerateKey(rand.Reader, 2048); err != nil {
+			return nil, err
+		}
+	}
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	k := &Sha2RSAKey{
+		key:       key,
+		publicKey: pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}),
+	}
+	if sha2RSAKeys.keys == nil {
+		sha2RSAKeys.keys = make(map[string]*Sha2RSAKey)
+	}
+	sha2RSAKeys.keys[keyFile] = k
+	return k, nil
+}
+
+// PublicKey returns the public key in PEM format.
+func (k *Sha2RSAKey) PublicKey() []byte {
+	return k.publicKey
+}
+
+// Size returns the size of the encrypted password.
+func (k *Sha2RSAKey) Size() int {
+	return k.key.Size()
+}
+
+// DecryptPassword decrypts the password encrypted by the client with the
+// public key, the password is XORed with the salt before encrypted.
+func (k *Sha2RSAKey) DecryptPassword(salt, data []byte) ([]byte, error) {
+	pwd, err := rsa.DecryptOAEP(sha1.New(), rand.Reader, k.key, data, nil)
+	if err != nil {
+		return nil, err
+	}
+	for i := range pwd {
+		pwd[i] ^= salt[i%len(salt)]
+	}
+	return bytes.TrimSuffix(pwd, []byte{0}), nil
+}
+
+// EncryptSha2Password encrypts the password with the public key in PEM
+// format sent by the server, the same as what the client does in the full
+// authentication of caching_sha2_password.
+func EncryptSha2Password(publicKey, salt, pwd []byte) ([]byte, error) {
+	block, _ := pem.Decode(publicKey)
+	if block == nil {
+		return nil, moerr.NewInternalErrorNoCtx("invalid RSA public key")
+	}
+	k, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	key, ok := k.(*rsa.PublicKey)
+	if !ok {
+		return nil, moerr.NewInternalErrorNoCtx("not a RSA public key")
+	}
+	data := make([]byte, len(pwd))
+	for i := range pwd {
+		data[i] = pwd[i] ^ salt[i%len(salt)]
+	}
+	return rsa.EncryptOAEP(sha1.New(), rand.Reader, key, data, nil)
+}
+
+// checkSha2Password authenticates the user with caching_sha2_password,
+// authString is the one stored for the user and auth is the scramble sent
+// by the client.
+func (mp *MysqlProtocolImpl) checkSha2Password(ctx context.Context, authString, salt, auth []byte) (bool, error) {
+	tenant := mp.GetSession().GetTenantInfo()
+	user := tenant.GetTenant() + ":" + tenant.GetUser()
+
+	if stage2 := globalSha2Cache.get(user, authString); stage2 != nil && CheckSha2Scramble(stage2, salt, auth) {
+		if err := mp.writePackets([]byte{authMoreDataHeader, cachingSha2FastAuthSuccess}); err != nil {
+			return false, err
+		}
+		mp.authString = stage2
+		return true, nil
+	}
+
+	if err := mp.writePackets([]byte{authMoreDataHeader, cachingSha2PerformFullAuth}); err != nil {
+		return false, err
+	}
+	pwd, err := mp.readSha2Password(ctx, salt)
+	if err != nil {
+		return false, err
+	}
+	if !checkSha2PassWord(authString, pwd) {
+		return false, nil
+	}
+	stage2 := sha2Stage2(pwd)
+	globalSha2Cache.set(user, authString, stage2)
+	mp.authString = stage2
+	return true, nil
+}
+
+// readSha2Password reads the password sent by the client in the full
+// authentication. The password is sent in clear text on the secure
+// connections, otherwise it is encrypted with the RSA public key which the
+// client may ask for. Proxy passes the encrypted password through, so the
+// CN servers behind the same proxy should share the private key file.
+func (mp *MysqlProtocolImpl) readSha2Password(ctx context.Context, salt []byte) ([]byte, error) {
+	data, err := mp.tcpConn.Read()
+	if err != nil {
+		return nil, err
+	}
+	if mp.IsTlsEstablished() {
+		return bytes.TrimSuffix(data, []byte{0}), nil
+	}
+
+	key, err := GetSha2RSAKey(ctx, mp.SV.CachingSha2PasswordPrivateKeyFile)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 1 && data[0] == cachingSha2RequestPublicKey {
+		if err = mp.writePackets(append([]byte{authMoreDataHeader}, key.PublicKey()...)); err != nil {
+			return nil, err
+		}
+		if data, err = mp.tcpConn.Read(); err != nil {
+			return nil, err
+		}
+	}
+	if len(data) != key.Size() {
+		return nil, moerr.NewInternalError(ctx, "caching_sha2_password requires a secure connection or RSA encrypted password")
+	}
+	return key.DecryptPassword(salt, data)
+}
@@ -0,0 +1,109 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSha256Crypt(t *testing.T) {
+	cases := []struct {
+		key, salt string
+		rounds    int
+		want      string
+	}{
+		{"Hello world!", "saltstring", 5000, "5B8vYYiY.CVt1RlTTf8KbXBH3hsxY/GNooZaBBGWEc5"},
+		{"Hello world!", "saltstringsaltst", 10000, "3xv.VbSHBb41AL9AvLeujZkZRBAwqFMz2.opqey6IcA"},
+	}
+	for _, c := range cases {
+		require.Equal(t, c.want, string(sha256Crypt([]byte(c.key), []byte(c.salt), c.rounds)))
+	}
+}
+
+func TestHashPassWordWithSha2(t *testing.T) {
+	require.Equal(t, "", HashPassWordWithSha2("", 5000))
+
+	authString := HashPassWordWithSha2("111", 5000)
+	require.Len(t, authString, cachingSha2AuthStringLength)
+	require.Equal(t, "$A$005$", authString[:7])
+	require.True(t, isSha2PassWord([]byte(authString)))
+	require.False(t, isSha2PassWord([]byte(HashPassWord("111"))))
+	require.True(t, checkSha2PassWord([]byte(authString), []byte("111")))
+	require.False(t, checkSha2PassWord([]byte(authString), []byte("112")))
+
+	// the salt is random.
+	require.NotEqual(t, authString, HashPassWordWithSha2("111", 5000))
+	require.Equal(t, "$A$00A$", HashPassWordWithSha2("111", 10000)[:7])
+
+	pwd, err := GetPassWord(authString)
+	require.NoError(t, err)
+	require.Equal(t, []byte(authString), pwd)
+}
+
+func TestCheckSha2Scramble(t *testing.T) {
+	salt := []byte("01234567890123456789")
+	stage2 := sha2Stage2([]byte("111"))
+	require.True(t, CheckSha2Scramble(stage2, salt, ScrambleSha2([]byte("111"), salt)))
+	require.False(t, CheckSha2Scramble(stage2, salt, ScrambleSha2([]byte("112"), salt)))
+	require.False(t, CheckSha2Scramble(stage2, salt, nil))
+}
+
+func TestSha2Cache(t *testing.T) {
+	c := &sha2Cache{entries: make(map[string]sha2CacheEntry)}
+	require.Nil(t, c.get("sys:u1", []byte("a")))
+	c.set("sys:u1", []byte("a"), []byte("stage2"))
+	require.Equal(t, []byte("stage2"), c.get("sys:u1", []byte("a")))
+	// the password has been changed.
+	require.Nil(t, c.get("sys:u1", []byte("b")))
+}
+
+func TestSha2RSAKey(t *testing.T) {
+	key, err := GetSha2RSAKey(context.TODO(), "")
+	require.NoError(t, err)
+	require.Contains(t, string(key.PublicKey()), "PUBLIC KEY")
+	key2, err := GetSha2RSAKey(context.TODO(), "")
+	require.NoError(t, err)
+	require.Equal(t, key, key2)
+
+	salt := []byte("01234567890123456789")
+	pwd := []byte("password\x00")
+	data := make([]byte, len(pwd))
+	for i := range pwd {
+		data[i] = pwd[i] ^ salt[i%len(salt)]
+	}
+	encrypted, err := rsa.EncryptOAEP(sha1.New(), rand.Reader, &key.key.PublicKey, data, nil)
+	require.NoError(t, err)
+	require.Len(t, encrypted, key.Size())
+	decrypted, err := key.DecryptPassword(salt, encrypted)
+	require.NoError(t, err)
+	require.Equal(t, []byte("password"), decrypted)
+
+	encrypted, err = EncryptSha2Password(key.PublicKey(), salt, pwd)
+	require.NoError(t, err)
+	decrypted, err = key.DecryptPassword(salt, encrypted)
+	require.NoError(t, err)
+	require.Equal(t, []byte("password"), decrypted)
+	_, err = EncryptSha2Password([]byte("not a key"), salt, pwd)
+	require.Error(t, err)
+
+	_, err = GetSha2RSAKey(context.TODO(), "not_exist.pem")
+	require.Error(t, err)
+}
@@ -0,0 +1,625 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/cdc"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	pb "github.com/matrixorigin/matrixone/pkg/pb/task"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/util"
+	"github.com/matrixorigin/matrixone/pkg/taskservice"
+)
+
+const (
+	defaultCdcTaskMaxRetryTimes = 10
+	defaultCdcTaskRetryInterval = int64(time.Second * 10)
+)
+
+const (
+	insertCdcTaskFormat = `insert into mo_catalog.mo_cdc_task values (%d, '%s', '%s', '%s', '%s', '%s', '%s', '%s', '%s', '', now())`
+
+	getCdcTaskIdFormat = `select task_id from mo_catalog.mo_cdc_task where account_id = %d and task_name = '%s'`
+
+	getCdcTasksFormat = `select task_id, task_name, source_uri, sink_uri, state, err_msg from mo_catalog.mo_cdc_task where account_id = %d`
+
+	getCdcWatermarksFormat = `select task_id, db_name, table_name, watermark from mo_catalog.mo_cdc_watermark where account_id = %d`
+
+	deleteCdcTaskFormat = `delete from mo_catalog.mo_cdc_task where account_id = %d and task_id = '%s'`
+)
+
+func getSqlForInsertCdcTask(
+	accountId uint32,
+	taskId, taskName, sourceUri, sinkType, sinkUri, tables, options, state string,
+) string {
+	return fmt.Sprintf(insertCdcTaskFormat, accountId, taskId,
+		util.QuoteSqlString(taskName), util.QuoteSqlString(sourceUri), sinkType, util.QuoteSqlString(sinkUri),
+		util.QuoteSqlString(tables), util.QuoteSqlString(options), state)
+}
+
+func getSqlForGetCdcTaskId(accountId uint32, taskName string) string {
+	return fmt.Sprintf(getCdcTaskIdFormat, accountId, util.QuoteSqlString(taskName))
+}
+
+func getSqlForGetCdcTasks(accountId uint32, taskName string) string {
+	sql := fmt.Sprintf(getCdcTasksFormat, accountId)
+	if taskName != "" {
+		sql += fmt.Sprintf(" and task_name = '%s'", util.QuoteSqlString(taskName))
+	}
+	return sql + " order by task_name"
+}
+
+func getSqlForGetCdcWatermarks(accountId uint32) string {
+	return fmt.Sprintf(getCdcWatermarksFormat, accountId)
+}
+
+func getSqlForDeleteCdcTask(accountId uint32, taskId string) string {
+	return fmt.Sprintf(deleteCdcTaskFormat, accountId, taskId)
+}
+
+func cdcTaskMetadata(taskId string) pb.TaskMetadata {
+	return pb.TaskMetadata{
+		ID:       taskId,
+		Executor: pb.TaskCode_InitCdc,
+		Options: pb.TaskOptions{
+			MaxRetryTimes: defaultCdcTaskMaxRetryTimes,
+			RetryInterval: defaultCdcTaskRetryInterval,
+			DelayDuration: 0,
+			Concurrency:   0,
+		},
+	}
+}
+
+// cdcTask is a row of mo_catalog.mo_cdc_task.
+type cdcTask struct {
+	taskId    string
+	taskName  string
+	sourceUri string
+	sinkUri   string
+	state     string
+	errMsg    string
+}
+
+func handleCreateCdc(ses *Session, execCtx *ExecCtx, st *tree.CreateCDC) error {
+	return doCreateCdc(execCtx.reqCtx, ses, st)
+}
+
+func doCreateCdc(ctx context.Context, ses *Session, st *tree.CreateCDC) (err error) {
+	ts := getGlobalPu().TaskService
+	if ts == nil {
+		return moerr.NewInternalError(ctx,
+			"task service not ready yet, please try again later.")
+	}
+	accountId := ses.GetTenantInfo().GetTenantID()
+
+	if st.TaskName == "" {
+		return moerr.NewInternalError(ctx, "the name of cdc task is empty")
+	}
+	if _, err = cdc.ParseUri(ctx, st.SourceUri); err != nil {
+		return err
+	}
+	sinkType := strings.ToLower(st.SinkType)
+	switch sinkType {
+	case cdc.SinkTypeMySQL, cdc.SinkTypeMatrixOne:
+		_, err = cdc.ParseUri(ctx, st.SinkUri)
+	case cdc.SinkTypeKafka:
+		_, err = cdc.ParseKafkaUri(ctx, st.SinkUri)
+	default:
+		return moerr.NewNotSupportedf(ctx, "cdc sink type %s", st.SinkType)
+	}
+	if err != nil {
+		return err
+	}
+	tables, err := cdc.ParseTables(ctx, st.Tables)
+	if err != nil {
+		return err
+	}
+	for _, info := range tables {
+		_, tableDef := ses.GetTxnCompileCtx().Resolve(info.SourceDbName, info.SourceTblName, nil)
+		if tableDef == nil {
+			return moerr.NewNoSuchTable(ctx, info.SourceDbName, info.SourceTblName)
+		}
+		if tableDef.Pkey == nil || catalog.IsFakePkName(tableDef.Pkey.PkeyColName) {
+			return moerr.NewNotSupportedf(ctx, "cdc for table %s.%s without primary key",
+				info.SourceDbName, info.SourceTblName)
+		}
+	}
+	opts, err := cdc.ParseTaskOptions(ctx, st.Option, ses.GetTimeZone())
+	if err != nil {
+		return err
+	}
+	optsJson, err := json.Marshal(opts)
+	if err != nil {
+		return err
+	}
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+	err = bh.Exec(ctx, "begin;")
+	defer func() {
+		err = finishTxn(ctx, bh, err)
+	}()
+	if err != nil {
+		return err
+	}
+
+	// the cdc meta tables are in the sys account.
+	sysCtx := defines.AttachAccountId(ctx, sysAccountID)
+	bh.ClearExecResultSet()
+	if err = bh.Exec(sysCtx, getSqlForGetCdcTaskId(accountId, st.TaskName)); err != nil {
+		return err
+	}
+	erArray, err := getResultSet(sysCtx, bh)
+	if err != nil {
+		return err
+	}
+	if execResultArrayHasData(erArray) {
+		if st.IfNotExists {
+			return nil
+		}
+		return moerr.NewInternalErrorf(ctx, "cdc task %s already exists", st.TaskName)
+	}
+
+	taskId := uuid.Must(uuid.NewV7()).String()
+	sql := getSqlForInsertCdcTask(accountId, taskId, st.TaskName, st.SourceUri, sinkType,
+		st.SinkUri, st.Tables, string(optsJson), cdc.TaskStateRunning)
+	if err = bh.Exec(sysCtx, sql); err != nil {
+		return err
+	}
+
+	details := &pb.Details{
+		AccountID: accountId,
+		Account:   ses.GetTenantName(),
+		Username:  ses.GetUserName(),
+		Details: &pb.Details_CreateCdc{
+			CreateCdc: &pb.CreateCdcDetails{
+				TaskId:   taskId,
+				TaskName: st.TaskName,
+			},
+		},
+	}
+	return ts.CreateDaemonTask(ctx, cdcTaskMetadata(taskId), details)
+}
+
+func handlePauseCdc(ses *Session, execCtx *ExecCtx, st *tree.PauseCDC) error {
+	return doUpdateCdcTasks(execCtx.reqCtx, ses, st.Option.TaskName, st.Option.All,
+		pb.TaskStatus_PauseRequested, cdc.TaskStatePaused)
+}
+
+func handleResumeCdc(ses *Session, execCtx *ExecCtx, st *tree.ResumeCDC) error {
+	return doUpdateCdcTasks(execCtx.reqCtx, ses, st.TaskName, false,
+		pb.TaskStatus_ResumeRequested, cdc.TaskStateRunning)
+}
+
+func handleDropCdc(ses *Session, execCtx *ExecCtx, st *tree.DropCDC) error {
+	return doUpdateCdcTasks(execCtx.reqCtx, ses, st.Option.TaskName, st.Option.All,
+		pb.TaskStatus_CancelRequested, cdc.TaskStateCanceled)
+}
+
+func handleRestartCdc(ses *Session, execCtx *ExecCtx, st *tree.RestartCDC) error {
+	return doRestartCdc(execCtx.reqCtx, ses, st)
+}
+
+// doUpdateCdcTasks requests the daemon tasks of the cdc tasks to change to
+// status. A dropped task is removed from the meta tables at once, its
+// executor stops asynchronously.
+func doUpdateCdcTasks(
+	ctx context.Context,
+	ses *Session,
+	taskName string,
+	all bool,
+	status pb.TaskStatus,
+	state string,
+) (err error) {
+	ts := getGlobalPu().TaskService
+	if ts == nil {
+		return moerr.NewInternalError(ctx,
+			"task service not ready yet, please try again later.")
+	}
+	accountId := ses.GetTenantInfo().GetTenantID()
+	if all {
+		taskName = ""
+	}
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+	err = bh.Exec(ctx, "begin;")
+	defer func() {
+		err = finishTxn(ctx, bh, err)
+	}()
+	if err != nil {
+		return err
+	}
+
+	sysCtx := defines.AttachAccountId(ctx, sysAccountID)
+	tasks, err := getCdcTasks(sysCtx, bh, accountId, taskName)
+	if err != nil {
+		return err
+	}
+	if len(tasks) == 0 {
+		if all {
+			return nil
+		}
+		return moerr.NewInternalErrorf(ctx, "cdc task %s not found", taskName)
+	}
+
+	for _, t := range tasks {
+		var sql string
+		if status == pb.TaskStatus_CancelRequested {
+			if err = bh.Exec(sysCtx, getSqlForDeleteCdcTask(accountId, t.taskId)); err != nil {
+				return err
+			}
+			sql = cdc.GetSqlForDeleteWatermark(accountId, t.taskId)
+		} else {
+			sql = cdc.GetSqlForUpdateTaskState(accountId, t.taskId, state, "")
+		}
+		if err = bh.Exec(sysCtx, sql); err != nil {
+			return err
+		}
+	}
+	return updateCdcDaemonTasks(ctx, ts, accountId, tasks, status, !all)
+}
+
+// updateCdcDaemonTasks changes the status of the daemon tasks of the cdc
+// tasks. If strict is false, the tasks which can not be changed to status
+// are skipped.
+func updateCdcDaemonTasks(
+	ctx context.Context,
+	ts taskservice.TaskService,
+	accountId uint32,
+	tasks []cdcTask,
+	status pb.TaskStatus,
+	strict bool,
+) error {
+	taskIds := make(map[string]string, len(tasks))
+	for _, t := range tasks {
+		taskIds[t.taskId] = t.taskName
+	}
+	daemonTasks, err := ts.QueryDaemonTask(ctx,
+		taskservice.WithTaskType(taskservice.EQ, pb.TaskType_TypeCdc.String()),
+		taskservice.WithAccountID(taskservice.EQ, accountId),
+	)
+	if err != nil {
+		return err
+	}
+	var updates []pb.DaemonTask
+	for _, t := range daemonTasks {
+		details, ok := t.Details.Details.(*pb.Details_CreateCdc)
+		if !ok {
+			return moerr.NewInternalErrorf(ctx, "invalid task type %s", t.TaskType.String())
+		}
+		taskName, ok := taskIds[details.CreateCdc.TaskId]
+		if !ok {
+			continue
+		}
+		var from []pb.TaskStatus
+		switch status {
+		case pb.TaskStatus_PauseRequested:
+			from = []pb.TaskStatus{pb.TaskStatus_Running}
+		case pb.TaskStatus_ResumeRequested:
+			from = []pb.TaskStatus{pb.TaskStatus_Paused}
+		case pb.TaskStatus_CancelRequested:
+			from = []pb.TaskStatus{pb.TaskStatus_Created, pb.TaskStatus_Running,
+				pb.TaskStatus_PauseRequested, pb.TaskStatus_Paused, pb.TaskStatus_ResumeRequested}
+		}
+		if t.TaskStatus == status {
+			continue
+		}
+		valid := false
+		for _, s := range from {
+			valid = valid || t.TaskStatus == s
+		}
+		if !valid {
+			if !strict {
+				continue
+			}
+			return moerr.NewInternalErrorf(ctx, "cdc task %s can not be changed to %s, now it is %s",
+				taskName, status, t.TaskStatus)
+		}
+		t.TaskStatus = status
+		updates = append(updates, t)
+	}
+	if len(updates) == 0 {
+		return nil
+	}
+	_, err = ts.UpdateDaemonTask(ctx, updates)
+	return err
+}
+
+// doRestartCdc restarts the cdc task from scratch. The old daemon task is
+// canceled, and a new one with the same definition is created, so the full
+// snapshot of the tables is sent again unless NoFull is specified.
+func doRestartCdc(ctx context.Context, ses *Session, st *tree.RestartCDC) (err error) {
+	ts := getGlobalPu().TaskService
+	if ts == nil {
+		return moerr.NewInternalError(ctx,
+			"task service not ready yet, please try again later.")
+	}
+	accountId := ses.GetTenantInfo().GetTenantID()
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+	err = bh.Exec(ctx, "begin;")
+	defer func() {
+		err = finishTxn(ctx, bh, err)
+	}()
+	if err != nil {
+		return err
+	}
+
+	sysCtx := defines.AttachAccountId(ctx, sysAccountID)
+	sql := fmt.Sprintf("select task_id, source_uri, sink_type, sink_uri, tables, options from mo_catalog.mo_cdc_task where account_id = %d and task_name = '%s'",
+		accountId, util.QuoteSqlString(st.TaskName))
+	bh.ClearExecResultSet()
+	if err = bh.Exec(sysCtx, sql); err != nil {
+		return err
+	}
+	erArray, err := getResultSet(sysCtx, bh)
+	if err != nil {
+		return err
+	}
+	if !execResultArrayHasData(erArray) {
+		return moerr.NewInternalErrorf(ctx, "cdc task %s not found", st.TaskName)
+	}
+	var values [6]string
+	for i := range values {
+		if values[i], err = erArray[0].GetString(sysCtx, 0, uint64(i)); err != nil {
+			return err
+		}
+	}
+	oldTaskId := values[0]
+
+	if err = bh.Exec(sysCtx, getSqlForDeleteCdcTask(accountId, oldTaskId)); err != nil {
+		return err
+	}
+	if err = bh.Exec(sysCtx, cdc.GetSqlForDeleteWatermark(accountId, oldTaskId)); err != nil {
+		return err
+	}
+	taskId := uuid.Must(uuid.NewV7()).String()
+	sql = getSqlForInsertCdcTask(accountId, taskId, st.TaskName, values[1], values[2],
+		values[3], values[4], values[5], cdc.TaskStateRunning)
+	if err = bh.Exec(sysCtx, sql); err != nil {
+		return err
+	}
+
+	if err = updateCdcDaemonTasks(ctx, ts, accountId,
+		[]cdcTask{{taskId: oldTaskId, taskName: st.TaskName}},
+		pb.TaskStatus_CancelRequested, false); err != nil {
+		return err
+	}
+	details := &pb.Details{
+		AccountID: accountId,
+		Account:   ses.GetTenantName(),
+		Username:  ses.GetUserName(),
+		Details: &pb.Details_CreateCdc{
+			CreateCdc: &pb.CreateCdcDetails{
+				TaskId:   taskId,
+				TaskName: st.TaskName,
+			},
+		},
+	}
+	return ts.CreateDaemonTask(ctx, cdcTaskMetadata(taskId), details)
+}
+
+func getCdcTasks(ctx context.Context, bh BackgroundExec, accountId uint32, taskName string) ([]cdcTask, error) {
+	bh.ClearExecResultSet()
+	if err := bh.Exec(ctx, getSqlForGetCdcTasks(accountId, taskName)); err != nil {
+		return nil, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return nil, err
+	}
+	if !execResultArrayHasData(erArray) {
+		return nil, nil
+	}
+	tasks := make([]cdcTask, 0, erArray[0].GetRowCount())
+	for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+		var values [6]string
+		for j := range values {
+			if values[j], err = erArray[0].GetString(ctx, i, uint64(j)); err != nil {
+				return nil, err
+			}
+		}
+		tasks = append(tasks, cdcTask{
+			taskId:    values[0],
+			taskName:  values[1],
+			sourceUri: values[2],
+			sinkUri:   values[3],
+			state:     values[4],
+			errMsg:    values[5],
+		})
+	}
+	return tasks, nil
+}
+
+var cdcCols = []Column{
+	&MysqlColumn{
+		ColumnImpl: ColumnImpl{
+			name:       "task_id",
+			columnType: defines.MYSQL_TYPE_VARCHAR,
+		},
+	},
+	&MysqlColumn{
+		ColumnImpl: ColumnImpl{
+			name:       "task_name",
+			columnType: defines.MYSQL_TYPE_VARCHAR,
+		},
+	},
+	&MysqlColumn{
+		ColumnImpl: ColumnImpl{
+			name:       "source_uri",
+			columnType: defines.MYSQL_TYPE_VARCHAR,
+		},
+	},
+	&MysqlColumn{
+		ColumnImpl: ColumnImpl{
+			name:       "sink_uri",
+			columnType: defines.MYSQL_TYPE_VARCHAR,
+		},
+	},
+	&MysqlColumn{
+		ColumnImpl: ColumnImpl{
+			name:       "state",
+			columnType: defines.MYSQL_TYPE_VARCHAR,
+		},
+	},
+	&MysqlColumn{
+		ColumnImpl: ColumnImpl{
+			name:       "checkpoint",
+			columnType: defines.MYSQL_TYPE_VARCHAR,
+		},
+	},
+	&MysqlColumn{
+		ColumnImpl: ColumnImpl{
+			name:       "lag",
+			columnType: defines.MYSQL_TYPE_VARCHAR,
+		},
+	},
+	&MysqlColumn{
+		ColumnImpl: ColumnImpl{
+			name:       "err_msg",
+			columnType: defines.MYSQL_TYPE_VARCHAR,
+		},
+	},
+}
+
+func handleShowCdc(ses *Session, execCtx *ExecCtx, st *tree.ShowCDC) error {
+	return doShowCdc(execCtx.reqCtx, ses, st)
+}
+
+func doShowCdc(ctx context.Context, ses *Session, st *tree.ShowCDC) (err error) {
+	accountId := ses.GetTenantInfo().GetTenantID()
+	taskName := st.Option.TaskName
+	if st.Option.All {
+		taskName = ""
+	}
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+	err = bh.Exec(ctx, "begin;")
+	defer func() {
+		err = finishTxn(ctx, bh, err)
+	}()
+	if err != nil {
+		return err
+	}
+
+	sysCtx := defines.AttachAccountId(ctx, sysAccountID)
+	tasks, err := getCdcTasks(sysCtx, bh, accountId, taskName)
+	if err != nil {
+		return err
+	}
+	if len(tasks) == 0 && !st.Option.All {
+		return moerr.NewInternalErrorf(ctx, "cdc task %s not found", taskName)
+	}
+
+	// task id -> table -> watermark
+	watermarks := make(map[string]map[string]types.TS)
+	bh.ClearExecResultSet()
+	if err = bh.Exec(sysCtx, getSqlForGetCdcWatermarks(accountId)); err != nil {
+		return err
+	}
+	erArray, err := getResultSet(sysCtx, bh)
+	if err != nil {
+		return err
+	}
+	if execResultArrayHasData(erArray) {
+		for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+			var values [4]string
+			for j := range values {
+				if values[j], err = erArray[0].GetString(sysCtx, i, uint64(j)); err != nil {
+					return err
+				}
+			}
+			if watermarks[values[0]] == nil {
+				watermarks[values[0]] = make(map[string]types.TS)
+			}
+			watermarks[values[0]][values[1]+"."+values[2]] = types.StringToTS(values[3])
+		}
+	}
+
+	mrs := ses.GetMysqlResultSet()
+	for _, col := range cdcCols {
+		mrs.AddColumn(col)
+	}
+	now := time.Now()
+	for _, t := range tasks {
+		checkpoint, lag := formatCdcWatermarks(watermarks[t.taskId], now, ses.GetTimeZone())
+		row := make([]interface{}, len(cdcCols))
+		row[0] = t.taskId
+		row[1] = t.taskName
+		row[2] = maskCdcUri(ctx, t.sourceUri)
+		row[3] = maskCdcUri(ctx, t.sinkUri)
+		row[4] = t.state
+		row[5] = checkpoint
+		row[6] = lag
+		row[7] = t.errMsg
+		mrs.AddRow(row)
+	}
+	return trySaveQueryResult(ctx, ses, mrs)
+}
+
+// formatCdcWatermarks returns the watermarks of the tables of a task, and the
+// lag of the slowest table.
+func formatCdcWatermarks(watermarks map[string]types.TS, now time.Time, loc *time.Location) (string, string) {
+	if len(watermarks) == 0 {
+		return "", ""
+	}
+	tables := make([]string, 0, len(watermarks))
+	for table := range watermarks {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+	items := make([]string, 0, len(tables))
+	var oldest time.Time
+	for i, table := range tables {
+		ts := watermarks[table]
+		t := time.Unix(0, ts.Physical()).In(loc)
+		if i == 0 || t.Before(oldest) {
+			oldest = t
+		}
+		items = append(items, fmt.Sprintf("%s: %s", table, t.Format("2006-01-02 15:04:05.000000")))
+	}
+	lag := now.Sub(oldest).Truncate(time.Millisecond)
+	if lag < 0 {
+		lag = 0
+	}
+	return strings.Join(items, ", "), lag.String()
+}
+
+func maskCdcUri(ctx context.Context, uri string) string {
+	if kafkaInfo, err := cdc.ParseKafkaUri(ctx, uri); err == nil {
+		// kafka uri has no credential.
+		return kafkaInfo.String()
+	}
+	info, err := cdc.ParseUri(ctx, uri)
+	if err != nil {
+		return ""
+	}
+	return info.String()
+}
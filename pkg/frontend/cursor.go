@@ -0,0 +1,489 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/binary"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// cursor is the server side READ_ONLY cursor opened by the COM_STMT_EXECUTE.
+//
+// The statement is executed in another goroutine and the result batches are
+// sent to the client on the COM_STMT_FETCH. The statement is suspended after
+// it produces the columns or a batch, and is resumed only when the client
+// fetches more rows. So the session is never used by the two goroutines at
+// the same time, and at most one batch is held by the cursor. If the session
+// executes another request while the statement is suspended, the statement is
+// run to the end first and the rest of its rows are kept by the cursor.
+type cursor struct {
+	ses    *Session
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// mrs holds the columns of the result set.
+	mrs *MysqlResultSet
+	// results receives nil after the columns of the result set are known,
+	// and then the result batches. It is closed after the statement ends.
+	results chan *batch.Batch
+	// resume resumes the suspended statement.
+	resume chan struct{}
+	// done is closed after the statement ends.
+	done chan struct{}
+	// err is the error of the statement. It is safe to read after the results
+	// are closed.
+	err error
+
+	// oldResper is the responser of the session before the cursor opened.
+	oldResper Responser
+
+	// bat is the batch received from the statement but not sent yet. It is
+	// owned by the pipeline, which is suspended until the batch is sent.
+	bat *batch.Batch
+	// row is the next row to send in bat.
+	row int
+	// suspended is true if the statement waits for the resume.
+	suspended bool
+	// materialized is true if the statement has been run to the end by
+	// materialize. The batches are owned by the cursor then.
+	materialized bool
+	// pending is the materialized batches not sent yet.
+	pending []*batch.Batch
+}
+
+// cursorResp captures the result of the statement that a cursor is opened for.
+type cursorResp struct {
+	Responser
+	cur *cursor
+}
+
+var _ Responser = &cursorResp{}
+
+func (resper *cursorResp) RespPreMeta(execCtx *ExecCtx, meta any) error {
+	cur := resper.cur
+	if len(cur.mrs.Columns) != 0 {
+		return nil
+	}
+	for _, c := range meta.([]any) {
+		cur.mrs.AddColumn(c.(Column))
+	}
+	return cur.yield(nil)
+}
+
+func (resper *cursorResp) RespResult(execCtx *ExecCtx, bat *batch.Batch) error {
+	if bat == nil || bat.RowCount() == 0 {
+		return nil
+	}
+	return resper.cur.yield(bat)
+}
+
+// RespPostMeta does nothing. The end of the result set is sent by the COM_STMT_FETCH.
+func (resper *cursorResp) RespPostMeta(*ExecCtx, any) error {
+	return nil
+}
+
+// Close does nothing. The responser of the session is restored after the cursor ends.
+func (resper *cursorResp) Close() {}
+
+// canOpenCursor checks the cursor can be opened for the prepared statement or not.
+// Like mysql, the cursor flag is ignored for the statement that does not return a result set.
+func canOpenCursor(stmt *PrepareStmt) bool {
+	if stmt.cursorType&CURSOR_TYPE_READ_ONLY == 0 {
+		return false
+	}
+	sel, ok := stmt.PrepareStmt.(*tree.Select)
+	return ok && sel.Ep == nil
+}
+
+// openCursor executes the prepared statement in the background and sends
+// the column definitions to the client.
+func openCursor(ses *Session, execCtx *ExecCtx, stmt *PrepareStmt, sql string) error {
+	cur := newCursor(ses, execCtx.reqCtx)
+	cur.start(func(ctx context.Context) error {
+		bgExecCtx := ExecCtx{
+			reqCtx:        ctx,
+			ses:           ses,
+			prepareColDef: stmt.ColDefData,
+		}
+		defer bgExecCtx.Close()
+		defer resetPrepareStmtParams(stmt)
+		return doComQuery(ses, &bgExecCtx, &UserInput{sql: sql})
+	})
+
+	if err := cur.waitMeta(execCtx.reqCtx); err != nil {
+		cur.close()
+		return err
+	}
+	if err := cur.sendColumnDefs(execCtx); err != nil {
+		cur.close()
+		return err
+	}
+	ses.addCursor(stmt.Name, cur)
+	return nil
+}
+
+func newCursor(ses *Session, reqCtx context.Context) *cursor {
+	// the statement outlives the request
+	ctx, cancel := context.WithCancel(context.WithoutCancel(reqCtx))
+	return &cursor{
+		ses:     ses,
+		ctx:     ctx,
+		cancel:  cancel,
+		mrs:     &MysqlResultSet{},
+		results: make(chan *batch.Batch),
+		resume:  make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+}
+
+// start replaces the responser of the session and runs the statement in
+// another goroutine until it is suspended.
+func (cur *cursor) start(exec func(ctx context.Context) error) {
+	cur.oldResper = cur.ses.ReplaceResponser(&cursorResp{Responser: cur.ses.GetResponser(), cur: cur})
+	go func() {
+		defer close(cur.done)
+		defer close(cur.results)
+		defer func() {
+			if e := recover(); e != nil {
+				cur.err = moerr.ConvertPanicError(cur.ctx, e)
+			}
+		}()
+		cur.err = exec(cur.ctx)
+	}()
+}
+
+// yield hands the batch to the cursor and suspends the statement until it
+// is resumed or the cursor is closed.
+func (cur *cursor) yield(bat *batch.Batch) error {
+	select {
+	case cur.results <- bat:
+	case <-cur.ctx.Done():
+		return cur.ctx.Err()
+	}
+	select {
+	case <-cur.resume:
+		return nil
+	case <-cur.ctx.Done():
+		return cur.ctx.Err()
+	}
+}
+
+// receive resumes the suspended statement and waits for its next result. It
+// returns false if the statement has ended.
+func (cur *cursor) receive(ctx context.Context) (*batch.Batch, bool, error) {
+	if cur.suspended {
+		select {
+		case cur.resume <- struct{}{}:
+		case <-ctx.Done():
+			return nil, false, ctx.Err()
+		}
+		cur.suspended = false
+	}
+	select {
+	case bat, ok := <-cur.results:
+		if !ok {
+			cur.finish()
+			return nil, false, cur.err
+		}
+		cur.suspended = true
+		return bat, true, nil
+	case <-ctx.Done():
+		return nil, false, ctx.Err()
+	}
+}
+
+// waitMeta waits for the columns of the result set.
+func (cur *cursor) waitMeta(ctx context.Context) error {
+	_, ok, err := cur.receive(ctx)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		// the statement ended before the result set is ready.
+		return moerr.NewInternalError(ctx, "the statement does not return a result set")
+	}
+	return nil
+}
+
+// sendColumnDefs sends the column definitions and the EOF with the SERVER_STATUS_CURSOR_EXISTS.
+func (cur *cursor) sendColumnDefs(execCtx *ExecCtx) error {
+	wr := cur.ses.GetResponser().MysqlRrWr()
+	columns := cur.mrs.Columns
+	if err := wr.WriteLengthEncodedNumber(uint64(len(columns))); err != nil {
+		return err
+	}
+	colDefs := execCtx.prepareColDef
+	if colDefs != nil && len(colDefs) != len(columns) {
+		colDefs = nil
+	}
+	for i, c := range columns {
+		var err error
+		if colDefs == nil {
+			err = wr.WriteColumnDef(execCtx.reqCtx, c, int(COM_STMT_EXECUTE))
+		} else {
+			err = wr.WriteColumnDefBytes(colDefs[i])
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return wr.WriteEOFOrOK(0, cur.ses.GetTxnHandler().GetServerStatus()|SERVER_STATUS_CURSOR_EXISTS)
+}
+
+// materialize runs the suspended statement to the end and keeps the rest of
+// its rows, so the session can execute other requests. The error of the
+// statement is returned by the fetch after the rows are sent.
+func (cur *cursor) materialize(ctx context.Context) error {
+	if cur.materialized {
+		return nil
+	}
+	mp := cur.ses.GetMemPool()
+	if cur.bat != nil {
+		bat, err := cur.bat.Dup(mp)
+		if err != nil {
+			return err
+		}
+		cur.bat = bat
+	}
+	cur.materialized = true
+	for {
+		bat, ok, err := cur.receive(ctx)
+		if !ok {
+			if ctx.Err() != nil {
+				return err
+			}
+			return nil
+		}
+		if bat == nil {
+			continue
+		}
+		if bat, err = bat.Dup(mp); err != nil {
+			return err
+		}
+		cur.pending = append(cur.pending, bat)
+	}
+}
+
+// next receives the next batch from the statement. It returns false if
+// there is no batch anymore.
+func (cur *cursor) next(ctx context.Context) (bool, error) {
+	if cur.materialized {
+		cur.release()
+		if len(cur.pending) == 0 {
+			return false, cur.err
+		}
+		cur.bat, cur.pending = cur.pending[0], cur.pending[1:]
+		return true, nil
+	}
+	cur.bat, cur.row = nil, 0
+	bat, ok, err := cur.receive(ctx)
+	if err != nil || !ok {
+		return false, err
+	}
+	cur.bat = bat
+	return true, nil
+}
+
+// fetch sends at most rows rows to the client.
+func (cur *cursor) fetch(ctx context.Context, rows uint32) error {
+	wr := cur.ses.GetResponser().MysqlRrWr()
+	mrs := &MysqlResultSet{
+		Columns: cur.mrs.Columns,
+		Data:    [][]any{make([]any, len(cur.mrs.Columns))},
+	}
+	for sent := uint32(0); sent < rows; {
+		if cur.bat == nil {
+			ok, err := cur.next(ctx)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				break
+			}
+		}
+		for ; cur.row < cur.bat.RowCount() && sent < rows; cur.row++ {
+			if err := extractRowFromEveryVector(ctx, cur.ses, cur.bat, cur.row, mrs.Data[0]); err != nil {
+				return err
+			}
+			if err := wr.WriteResultSetRow(mrs, 1); err != nil {
+				return err
+			}
+			sent++
+		}
+		if cur.row >= cur.bat.RowCount() {
+			cur.release()
+		}
+	}
+
+	status := cur.ses.GetTxnHandler().GetServerStatus() | SERVER_STATUS_CURSOR_EXISTS
+	if cur.bat == nil {
+		ok, err := cur.next(ctx)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			status |= SERVER_STATUS_LAST_ROW_SENT
+		}
+	}
+	return wr.WriteEOFOrOK(0, status)
+}
+
+// running returns true if the statement of the cursor has not ended.
+func (cur *cursor) running() bool {
+	select {
+	case <-cur.done:
+		return false
+	default:
+		return true
+	}
+}
+
+// close cancels the statement and waits for it to end.
+func (cur *cursor) close() {
+	cur.cancel()
+	cur.release()
+	for _, bat := range cur.pending {
+		bat.Clean(cur.ses.GetMemPool())
+	}
+	cur.pending = nil
+	for range cur.results {
+	}
+	cur.finish()
+}
+
+// release drops the batch being sent, and frees it if it is materialized.
+func (cur *cursor) release() {
+	if cur.materialized && cur.bat != nil {
+		cur.bat.Clean(cur.ses.GetMemPool())
+	}
+	cur.bat, cur.row = nil, 0
+}
+
+// finish waits for the statement to end and restores the responser of the session.
+func (cur *cursor) finish() {
+	<-cur.done
+	if cur.oldResper != nil {
+		cur.ses.ReplaceResponser(cur.oldResper)
+		cur.oldResper = nil
+	}
+}
+
+func (ses *Session) addCursor(name string, cur *cursor) {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	if ses.cursors == nil {
+		ses.cursors = make(map[string]*cursor)
+	}
+	ses.cursors[name] = cur
+}
+
+func (ses *Session) getCursor(name string) *cursor {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	return ses.cursors[name]
+}
+
+// closeCursor closes the cursor of the prepared statement if it exists.
+func (ses *Session) closeCursor(name string) {
+	ses.mu.Lock()
+	cur := ses.cursors[name]
+	delete(ses.cursors, name)
+	ses.mu.Unlock()
+	if cur != nil {
+		cur.close()
+	}
+}
+
+// closeCursors closes all cursors of the session.
+func (ses *Session) closeCursors() {
+	ses.mu.Lock()
+	cursors := ses.cursors
+	ses.cursors = nil
+	ses.mu.Unlock()
+	for _, cur := range cursors {
+		cur.close()
+	}
+}
+
+// settleCursors makes sure that no statement of the cursors is running when
+// the session executes the request. The cursor of the statement that the
+// request closes, resets or executes again is closed. For the other requests
+// that execute something, the suspended statements are run to the end and
+// the rest of their rows are kept by the cursors.
+func (ses *Session) settleCursors(ctx context.Context, req *Request) error {
+	ses.mu.Lock()
+	empty := len(ses.cursors) == 0
+	ses.mu.Unlock()
+	if empty {
+		return nil
+	}
+
+	switch req.GetCmd() {
+	case COM_QUIT:
+		ses.closeCursors()
+		return nil
+	case COM_STMT_EXECUTE, COM_STMT_CLOSE, COM_STMT_RESET:
+		if data := req.GetData().([]byte); len(data) >= 4 {
+			ses.closeCursor(getPrepareStmtName(binary.LittleEndian.Uint32(data[0:4])))
+		}
+		if req.GetCmd() != COM_STMT_EXECUTE {
+			return nil
+		}
+	case COM_PING, COM_STMT_FETCH:
+		// at most one statement of the cursors is suspended, the fetch only
+		// resumes the statement of its own cursor.
+		return nil
+	}
+
+	ses.mu.Lock()
+	var running []*cursor
+	for _, cur := range ses.cursors {
+		if cur.running() {
+			running = append(running, cur)
+		}
+	}
+	ses.mu.Unlock()
+	for _, cur := range running {
+		if err := cur.materialize(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// handleStmtFetch sends the rows of the cursor for the COM_STMT_FETCH.
+func handleStmtFetch(ses *Session, execCtx *ExecCtx, data []byte) error {
+	// see https://dev.mysql.com/doc/dev/mysql-server/latest/page_protocol_com_stmt_fetch.html
+	if len(data) < 8 {
+		return moerr.NewInvalidInput(execCtx.reqCtx, "sql command contains malformed packet")
+	}
+	stmtID := binary.LittleEndian.Uint32(data[0:4])
+	rows := binary.LittleEndian.Uint32(data[4:8])
+
+	name := getPrepareStmtName(stmtID)
+	cur := ses.getCursor(name)
+	if cur == nil {
+		return moerr.NewInvalidStatef(execCtx.reqCtx, "the statement (%d) has no open cursor", stmtID)
+	}
+	if err := cur.fetch(execCtx.reqCtx, rows); err != nil {
+		ses.closeCursor(name)
+		return err
+	}
+	return nil
+}
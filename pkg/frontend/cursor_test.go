@@ -0,0 +1,278 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/binary"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// testCursorWriter records the rows and the EOF status sent by the cursor.
+type testCursorWriter struct {
+	MysqlRrWr
+	columns  uint64
+	rows     []int64
+	statuses []uint16
+}
+
+func (wr *testCursorWriter) WriteLengthEncodedNumber(n uint64) error {
+	wr.columns = n
+	return nil
+}
+
+func (wr *testCursorWriter) WriteColumnDef(context.Context, Column, int) error {
+	return nil
+}
+
+func (wr *testCursorWriter) WriteResultSetRow(mrs *MysqlResultSet, cnt uint64) error {
+	wr.rows = append(wr.rows, mrs.Data[0][0].(int64))
+	return nil
+}
+
+func (wr *testCursorWriter) WriteEOFOrOK(warnings uint16, status uint16) error {
+	wr.statuses = append(wr.statuses, status)
+	return nil
+}
+
+type testCursorResp struct {
+	Responser
+	wr *testCursorWriter
+}
+
+func (resper *testCursorResp) MysqlRrWr() MysqlRrWr {
+	return resper.wr
+}
+
+func newTestCursorSession(t *testing.T) (*Session, *testCursorResp) {
+	mp, err := mpool.NewMPool("cursor_test", 0, mpool.NoFixed)
+	require.NoError(t, err)
+	t.Cleanup(func() { mpool.DeleteMPool(mp) })
+	resper := &testCursorResp{wr: &testCursorWriter{}}
+	ses := &Session{feSessionImpl: feSessionImpl{
+		pool:       mp,
+		respr:      resper,
+		txnHandler: &TxnHandler{},
+	}}
+	return ses, resper
+}
+
+// produceInt64Batches sends the batches with the rows [0, n*rowsPerBatch).
+func produceInt64Batches(ses *Session, n, rowsPerBatch int) func(context.Context) error {
+	return func(ctx context.Context) error {
+		resper := ses.GetResponser()
+		col := &MysqlColumn{}
+		col.SetName("a")
+		if err := resper.RespPreMeta(nil, []any{col}); err != nil {
+			return err
+		}
+		mp := ses.GetMemPool()
+		for i := 0; i < n; i++ {
+			bat := batch.NewWithSize(1)
+			bat.Vecs[0] = vector.NewVec(types.T_int64.ToType())
+			for j := 0; j < rowsPerBatch; j++ {
+				if err := vector.AppendFixed(bat.Vecs[0], int64(i*rowsPerBatch+j), false, mp); err != nil {
+					return err
+				}
+			}
+			bat.SetRowCount(rowsPerBatch)
+			err := resper.RespResult(nil, bat)
+			bat.Clean(mp)
+			if err != nil {
+				return err
+			}
+		}
+		return resper.RespPostMeta(nil, nil)
+	}
+}
+
+func TestCursorFetch(t *testing.T) {
+	ctx := context.TODO()
+	ses, resper := newTestCursorSession(t)
+
+	cur := newCursor(ses, ctx)
+	cur.start(produceInt64Batches(ses, 3, 2))
+	require.NoError(t, cur.waitMeta(ctx))
+	require.NoError(t, cur.sendColumnDefs(&ExecCtx{reqCtx: ctx}))
+	require.Equal(t, uint64(1), resper.wr.columns)
+	require.Equal(t, []uint16{SERVER_STATUS_CURSOR_EXISTS}, resper.wr.statuses)
+
+	require.NoError(t, cur.fetch(ctx, 3))
+	require.Equal(t, []int64{0, 1, 2}, resper.wr.rows)
+	require.Equal(t, SERVER_STATUS_CURSOR_EXISTS, resper.wr.statuses[1])
+
+	require.NoError(t, cur.fetch(ctx, 10))
+	require.Equal(t, []int64{0, 1, 2, 3, 4, 5}, resper.wr.rows)
+	require.Equal(t, SERVER_STATUS_CURSOR_EXISTS|SERVER_STATUS_LAST_ROW_SENT, resper.wr.statuses[2])
+
+	// the responser of the session is restored after the statement ends.
+	require.Equal(t, Responser(resper), ses.GetResponser())
+	cur.close()
+	require.Equal(t, int64(0), ses.pool.CurrNB())
+}
+
+func TestCursorSuspend(t *testing.T) {
+	ctx := context.TODO()
+	ses, resper := newTestCursorSession(t)
+
+	var produced atomic.Int32
+	produce := produceInt64Batches(ses, 100, 1)
+	cur := newCursor(ses, ctx)
+	cur.start(func(ctx context.Context) error {
+		ses.ReplaceResponser(&countingCursorResp{Responser: ses.GetResponser(), produced: &produced})
+		return produce(ctx)
+	})
+	require.NoError(t, cur.waitMeta(ctx))
+
+	// the statement is suspended until the client fetches the rows.
+	select {
+	case <-cur.done:
+		t.Fatal("the statement of the cursor should be suspended")
+	case <-time.After(50 * time.Millisecond):
+	}
+	require.Equal(t, int32(0), produced.Load())
+
+	require.NoError(t, cur.fetch(ctx, 1))
+	require.Equal(t, []int64{0}, resper.wr.rows)
+	// the next batch is received to know whether the last row is sent.
+	require.Equal(t, int32(2), produced.Load())
+
+	cur.close()
+	require.Error(t, cur.err)
+	require.Equal(t, Responser(resper), ses.GetResponser())
+	require.Equal(t, int64(0), ses.pool.CurrNB())
+}
+
+// countingCursorResp counts the batches produced by the statement.
+type countingCursorResp struct {
+	Responser
+	produced *atomic.Int32
+}
+
+func (resper *countingCursorResp) RespResult(execCtx *ExecCtx, bat *batch.Batch) error {
+	resper.produced.Add(1)
+	return resper.Responser.RespResult(execCtx, bat)
+}
+
+func TestCursorError(t *testing.T) {
+	ctx := context.TODO()
+	ses, resper := newTestCursorSession(t)
+
+	cur := newCursor(ses, ctx)
+	cur.start(func(context.Context) error {
+		return context.DeadlineExceeded
+	})
+	require.ErrorIs(t, cur.waitMeta(ctx), context.DeadlineExceeded)
+	cur.close()
+	require.Equal(t, Responser(resper), ses.GetResponser())
+
+	cur = newCursor(ses, ctx)
+	cur.start(func(context.Context) error {
+		panic("test panic")
+	})
+	require.Error(t, cur.waitMeta(ctx))
+	cur.close()
+	require.Equal(t, Responser(resper), ses.GetResponser())
+}
+
+func TestSessionCursors(t *testing.T) {
+	ctx := context.TODO()
+	ses, resper := newTestCursorSession(t)
+
+	fetchData := func(stmtID, rows uint32) []byte {
+		data := make([]byte, 8)
+		binary.LittleEndian.PutUint32(data[0:4], stmtID)
+		binary.LittleEndian.PutUint32(data[4:8], rows)
+		return data
+	}
+	execCtx := &ExecCtx{reqCtx: ctx}
+
+	require.Error(t, handleStmtFetch(ses, execCtx, fetchData(1, 1)[:4]))
+	require.Error(t, handleStmtFetch(ses, execCtx, fetchData(1, 1)))
+
+	cur1 := newCursor(ses, ctx)
+	cur1.start(produceInt64Batches(ses, 10, 1))
+	require.NoError(t, cur1.waitMeta(ctx))
+	ses.addCursor(getPrepareStmtName(1), cur1)
+	require.NoError(t, handleStmtFetch(ses, execCtx, fetchData(1, 2)))
+	require.Equal(t, []int64{0, 1}, resper.wr.rows)
+
+	// the statement is run to the end before the other commands.
+	require.NoError(t, ses.settleCursors(ctx, &Request{cmd: COM_PING}))
+	require.True(t, cur1.running())
+	require.NoError(t, ses.settleCursors(ctx, &Request{cmd: COM_QUERY, data: []byte("select 1")}))
+	require.False(t, cur1.running())
+	require.Equal(t, Responser(resper), ses.GetResponser())
+	require.NoError(t, handleStmtFetch(ses, execCtx, fetchData(1, 3)))
+	require.Equal(t, []int64{0, 1, 2, 3, 4}, resper.wr.rows)
+
+	// another cursor is opened while the rows of the first one are kept.
+	cur3 := newCursor(ses, ctx)
+	cur3.start(produceInt64Batches(ses, 3, 2))
+	require.NoError(t, cur3.waitMeta(ctx))
+	ses.addCursor(getPrepareStmtName(3), cur3)
+	require.NoError(t, handleStmtFetch(ses, execCtx, fetchData(3, 1)))
+	require.NoError(t, handleStmtFetch(ses, execCtx, fetchData(1, 100)))
+	require.NoError(t, handleStmtFetch(ses, execCtx, fetchData(3, 100)))
+	require.Equal(t, []int64{0, 1, 2, 3, 4, 0, 5, 6, 7, 8, 9, 1, 2, 3, 4, 5}, resper.wr.rows)
+	require.Equal(t, Responser(resper), ses.GetResponser())
+	require.NoError(t, ses.settleCursors(ctx, &Request{cmd: COM_QUERY, data: []byte("select 1")}))
+
+	cur2 := newCursor(ses, ctx)
+	cur2.start(produceInt64Batches(ses, 10, 1))
+	require.NoError(t, cur2.waitMeta(ctx))
+	ses.addCursor(getPrepareStmtName(2), cur2)
+
+	// the cursor is closed by the COM_STMT_CLOSE.
+	require.NoError(t, ses.settleCursors(ctx, &Request{cmd: COM_STMT_CLOSE, data: fetchData(2, 0)[:4]}))
+	require.Nil(t, ses.getCursor(getPrepareStmtName(2)))
+	require.NotNil(t, ses.getCursor(getPrepareStmtName(1)))
+	require.Equal(t, Responser(resper), ses.GetResponser())
+
+	// the kept rows are freed by the close.
+	cur4 := newCursor(ses, ctx)
+	cur4.start(produceInt64Batches(ses, 10, 1))
+	require.NoError(t, cur4.waitMeta(ctx))
+	ses.addCursor(getPrepareStmtName(4), cur4)
+	require.NoError(t, handleStmtFetch(ses, execCtx, fetchData(4, 1)))
+	require.NoError(t, ses.settleCursors(ctx, &Request{cmd: COM_QUERY, data: []byte("select 1")}))
+	require.NotEqual(t, int64(0), ses.pool.CurrNB())
+
+	ses.closeCursors()
+	require.Nil(t, ses.getCursor(getPrepareStmtName(1)))
+	require.Nil(t, ses.getCursor(getPrepareStmtName(4)))
+	require.Equal(t, int64(0), ses.pool.CurrNB())
+}
+
+func TestCanOpenCursor(t *testing.T) {
+	stmt := &PrepareStmt{PrepareStmt: &tree.Select{}}
+	require.False(t, canOpenCursor(stmt))
+	stmt.cursorType = CURSOR_TYPE_READ_ONLY
+	require.True(t, canOpenCursor(stmt))
+	stmt.PrepareStmt = &tree.Select{Ep: &tree.ExportParam{}}
+	require.False(t, canOpenCursor(stmt))
+	stmt.PrepareStmt = &tree.Insert{}
+	require.False(t, canOpenCursor(stmt))
+}
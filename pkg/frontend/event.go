@@ -0,0 +1,785 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	pb "github.com/matrixorigin/matrixone/pkg/pb/task"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/util"
+	"github.com/matrixorigin/matrixone/pkg/taskservice"
+	ie "github.com/matrixorigin/matrixone/pkg/util/internalExecutor"
+)
+
+const (
+	eventStatusEnabled  = "ENABLED"
+	eventStatusDisabled = "DISABLED"
+
+	eventHistoryRunning = "RUNNING"
+	eventHistorySuccess = "SUCCESS"
+	eventHistoryFailed  = "FAILED"
+)
+
+const (
+	eventColumns = `event_id, db_name, event_name, definer, definer_user_id, definer_role_id, event_body,
+		cast(ifnull(unix_timestamp(execute_at), 0) as bigint), ifnull(interval_value, ''), ifnull(interval_field, ''),
+		cast(ifnull(unix_timestamp(starts), 0) as bigint), cast(ifnull(unix_timestamp(ends), 0) as bigint),
+		status, on_completion, event_comment`
+
+	getEventFormat = `select ` + eventColumns + ` from mo_catalog.mo_events where db_name = '%s' and event_name = '%s'`
+
+	getEventByIdFormat = `select ` + eventColumns + ` from mo_catalog.mo_events where event_id = '%s'`
+
+	insertEventFormat = `insert into mo_catalog.mo_events values ('%s', '%s', '%s', '%s', %d, %d, '%s', %s, %s, %s, %s, %s, '%s', '%s', now(), now(), null, '%s')`
+
+	updateEventFormat = `update mo_catalog.mo_events set db_name = '%s', event_name = '%s', definer = '%s',
+		definer_user_id = %d, definer_role_id = %d, event_body = '%s', execute_at = %s, interval_value = %s,
+		interval_field = %s, starts = %s, ends = %s, status = '%s', on_completion = '%s', event_comment = '%s',
+		last_altered = now() where event_id = '%s'`
+
+	updateEventStatusFormat = `update mo_catalog.mo_events set status = '%s' where event_id = '%s'`
+
+	updateEventLastExecutedFormat = `update mo_catalog.mo_events set last_executed = now() where event_id = '%s'`
+
+	deleteEventFormat = `delete from mo_catalog.mo_events where event_id = '%s'`
+
+	insertEventHistoryFormat = `insert into mo_catalog.mo_event_history values ('%s', '%s', '%s', '%s', now(6), null, '%s', '')`
+
+	updateEventHistoryFormat = `update mo_catalog.mo_event_history set end_time = now(6), status = '%s', err_msg = '%s' where event_id = '%s' and task_id = '%s'`
+
+	deleteEventHistoryFormat = `delete from mo_catalog.mo_event_history where event_id = '%s'`
+
+	deleteEventHistoryOfTaskFormat = `delete from mo_catalog.mo_event_history where event_id = '%s' and task_id = '%s'`
+)
+
+// eventSchedule is the evaluated ON SCHEDULE clause of an event. The times
+// are unix seconds, 0 means not specified.
+type eventSchedule struct {
+	executeAt     int64
+	intervalValue int64
+	intervalUnit  string
+	starts        int64
+	ends          int64
+}
+
+// expired returns true if the event will never be executed after now.
+func (es eventSchedule) expired(now int64) bool {
+	if es.executeAt != 0 {
+		return es.executeAt <= now
+	}
+	return es.ends != 0 && es.ends <= now
+}
+
+// cronExpr returns the cron expression of the schedule in UTC. The cron
+// expression fires at starts and every interval after it. The intervals
+// which do not divide the upper field, such as 7 minutes, are scheduled by
+// @every, which is not aligned with starts.
+func (es eventSchedule) cronExpr(ctx context.Context) (string, error) {
+	if es.executeAt != 0 {
+		t := time.Unix(es.executeAt, 0).UTC()
+		return fmt.Sprintf("CRON_TZ=UTC %d %d %d %d %d *",
+			t.Second(), t.Minute(), t.Hour(), t.Day(), int(t.Month())), nil
+	}
+
+	n := es.intervalValue
+	if n <= 0 {
+		return "", moerr.NewInvalidInputf(ctx, "event interval %d is not positive", n)
+	}
+	t := time.Unix(es.starts, 0).UTC()
+	every := func(d time.Duration) string {
+		return fmt.Sprintf("@every %s", time.Duration(n)*d)
+	}
+	months := int64(0)
+	switch es.intervalUnit {
+	case "SECOND":
+		if 60%n != 0 {
+			return every(time.Second), nil
+		}
+		return fmt.Sprintf("CRON_TZ=UTC %d/%d * * * * *", int64(t.Second())%n, n), nil
+	case "MINUTE":
+		if 60%n != 0 {
+			return every(time.Minute), nil
+		}
+		return fmt.Sprintf("CRON_TZ=UTC %d %d/%d * * * *", t.Second(), int64(t.Minute())%n, n), nil
+	case "HOUR":
+		if 24%n != 0 {
+			return every(time.Hour), nil
+		}
+		return fmt.Sprintf("CRON_TZ=UTC %d %d %d/%d * * *", t.Second(), t.Minute(), int64(t.Hour())%n, n), nil
+	case "DAY":
+		if n != 1 {
+			return every(24 * time.Hour), nil
+		}
+		return fmt.Sprintf("CRON_TZ=UTC %d %d %d * * *", t.Second(), t.Minute(), t.Hour()), nil
+	case "WEEK":
+		if n != 1 {
+			return every(7 * 24 * time.Hour), nil
+		}
+		return fmt.Sprintf("CRON_TZ=UTC %d %d %d * * %d", t.Second(), t.Minute(), t.Hour(), int(t.Weekday())), nil
+	case "MONTH":
+		months = n
+	case "QUARTER":
+		months = 3 * n
+	case "YEAR":
+		months = 12 * n
+	default:
+		return "", moerr.NewNotSupportedf(ctx, "event interval unit %s", es.intervalUnit)
+	}
+	if 12%months != 0 {
+		return "", moerr.NewNotSupportedf(ctx, "event interval %d %s", n, es.intervalUnit)
+	}
+	return fmt.Sprintf("CRON_TZ=UTC %d %d %d %d %d/%d *", t.Second(), t.Minute(), t.Hour(), t.Day(),
+		(int64(t.Month())-1)%months+1, months), nil
+}
+
+// eventRecord is a row of mo_catalog.mo_events.
+type eventRecord struct {
+	eventId       string
+	dbName        string
+	eventName     string
+	definer       string
+	definerUserId uint32
+	definerRoleId uint32
+	body          string
+	schedule      eventSchedule
+	status        string
+	onCompletion  string
+	comment       string
+}
+
+// eventResult is implemented by both the results of the background executor
+// and the internal executor.
+type eventResult interface {
+	GetString(ctx context.Context, rindex, cindex uint64) (string, error)
+}
+
+func readEventRecord(ctx context.Context, res eventResult, row uint64) (*eventRecord, error) {
+	var values [15]string
+	for i := range values {
+		var err error
+		if values[i], err = res.GetString(ctx, row, uint64(i)); err != nil {
+			return nil, err
+		}
+	}
+	var ints [6]int64
+	for i, col := range []int{4, 5, 7, 8, 10, 11} {
+		if col == 8 && values[col] == "" {
+			continue
+		}
+		v, err := strconv.ParseInt(values[col], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		ints[i] = v
+	}
+	return &eventRecord{
+		eventId:       values[0],
+		dbName:        values[1],
+		eventName:     values[2],
+		definer:       values[3],
+		definerUserId: uint32(ints[0]),
+		definerRoleId: uint32(ints[1]),
+		body:          values[6],
+		schedule: eventSchedule{
+			executeAt:     ints[2],
+			intervalValue: ints[3],
+			intervalUnit:  values[9],
+			starts:        ints[4],
+			ends:          ints[5],
+		},
+		status:       values[12],
+		onCompletion: values[13],
+		comment:      values[14],
+	}, nil
+}
+
+func eventTimeValue(sec int64) string {
+	if sec == 0 {
+		return "null"
+	}
+	return fmt.Sprintf("from_unixtime(%d)", sec)
+}
+
+func getSqlForInsertEvent(ev *eventRecord) string {
+	intervalValue, intervalUnit := "null", "null"
+	if ev.schedule.executeAt == 0 {
+		intervalValue = fmt.Sprintf("'%d'", ev.schedule.intervalValue)
+		intervalUnit = fmt.Sprintf("'%s'", ev.schedule.intervalUnit)
+	}
+	return fmt.Sprintf(insertEventFormat, ev.eventId, util.QuoteSqlString(ev.dbName), util.QuoteSqlString(ev.eventName),
+		util.QuoteSqlString(ev.definer), ev.definerUserId, ev.definerRoleId, util.QuoteSqlString(ev.body),
+		eventTimeValue(ev.schedule.executeAt), intervalValue, intervalUnit,
+		eventTimeValue(ev.schedule.starts), eventTimeValue(ev.schedule.ends),
+		ev.status, ev.onCompletion, util.QuoteSqlString(ev.comment))
+}
+
+func getSqlForUpdateEvent(ev *eventRecord) string {
+	intervalValue, intervalUnit := "null", "null"
+	if ev.schedule.executeAt == 0 {
+		intervalValue = fmt.Sprintf("'%d'", ev.schedule.intervalValue)
+		intervalUnit = fmt.Sprintf("'%s'", ev.schedule.intervalUnit)
+	}
+	return fmt.Sprintf(updateEventFormat, util.QuoteSqlString(ev.dbName), util.QuoteSqlString(ev.eventName),
+		util.QuoteSqlString(ev.definer), ev.definerUserId, ev.definerRoleId, util.QuoteSqlString(ev.body),
+		eventTimeValue(ev.schedule.executeAt), intervalValue, intervalUnit,
+		eventTimeValue(ev.schedule.starts), eventTimeValue(ev.schedule.ends),
+		ev.status, ev.onCompletion, util.QuoteSqlString(ev.comment), ev.eventId)
+}
+
+func getEvent(ctx context.Context, bh BackgroundExec, dbName, eventName string) (*eventRecord, error) {
+	bh.ClearExecResultSet()
+	sql := fmt.Sprintf(getEventFormat, util.QuoteSqlString(dbName), util.QuoteSqlString(eventName))
+	if err := bh.Exec(ctx, sql); err != nil {
+		return nil, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return nil, err
+	}
+	if !execResultArrayHasData(erArray) {
+		return nil, nil
+	}
+	return readEventRecord(ctx, erArray[0], 0)
+}
+
+// getEventDbName returns the database of the event, the current database is
+// used if the name is not qualified.
+func getEventDbName(ses *Session, name *tree.EventName) (string, error) {
+	if name.HasNoNameQualifier() {
+		if ses.DatabaseNameIsEmpty() {
+			return "", moerr.NewNoDBNoCtx()
+		}
+		return ses.GetDatabaseName(), nil
+	}
+	return string(name.Name.SchemaName), nil
+}
+
+// evalEventInt evaluates the constant expression expr, which is formatted
+// into the select list by format.
+func evalEventInt(ctx context.Context, bh BackgroundExec, format string, expr tree.Expr) (int64, error) {
+	fmtCtx := tree.NewFmtCtx(dialect.MYSQL, tree.WithQuoteString(true))
+	expr.Format(fmtCtx)
+	bh.ClearExecResultSet()
+	if err := bh.Exec(ctx, "select "+fmt.Sprintf(format, fmtCtx.String())); err != nil {
+		return 0, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return 0, err
+	}
+	if !execResultArrayHasData(erArray) {
+		return 0, moerr.NewInvalidInputf(ctx, "event schedule %s", fmtCtx.String())
+	}
+	if isNull, err := erArray[0].ColumnIsNull(ctx, 0, 0); err != nil || isNull {
+		return 0, moerr.NewInvalidInputf(ctx, "event schedule %s", fmtCtx.String())
+	}
+	return erArray[0].GetInt64(ctx, 0, 0)
+}
+
+func evalEventSchedule(ctx context.Context, bh BackgroundExec, s *tree.EventSchedule, now int64) (es eventSchedule, err error) {
+	if s.At != nil {
+		es.executeAt, err = evalEventInt(ctx, bh, "cast(unix_timestamp(%s) as bigint)", s.At)
+		return
+	}
+	if es.intervalValue, err = evalEventInt(ctx, bh, "cast(%s as bigint)", s.IntervalValue); err != nil {
+		return
+	}
+	es.intervalUnit = s.IntervalUnit
+	es.starts = now
+	if s.Starts != nil {
+		if es.starts, err = evalEventInt(ctx, bh, "cast(unix_timestamp(%s) as bigint)", s.Starts); err != nil {
+			return
+		}
+	}
+	if s.Ends != nil {
+		if es.ends, err = evalEventInt(ctx, bh, "cast(unix_timestamp(%s) as bigint)", s.Ends); err != nil {
+			return
+		}
+		if es.ends < es.starts {
+			err = moerr.NewInvalidInput(ctx, "ENDS is either invalid or before STARTS")
+			return
+		}
+	}
+	_, err = es.cronExpr(ctx)
+	return
+}
+
+// resolveEventDefiner returns the definer of the event and the user and the
+// role the event body is executed as. Only the admin can create an event
+// for another user.
+func resolveEventDefiner(ctx context.Context, ses *Session, bh BackgroundExec, definer *tree.UsernameRecord, ev *eventRecord) error {
+	tenant := ses.GetTenantInfo()
+	if definer == nil || definer.Username == tenant.GetUser() {
+		ev.definer = tenant.GetUser() + "@%"
+		ev.definerUserId = tenant.GetUserID()
+		ev.definerRoleId = tenant.GetDefaultRoleID()
+		return nil
+	}
+	if !tenant.IsAdminRole() {
+		return moerr.NewInternalErrorf(ctx, "do not have privilege to create event for user %s", definer.Username)
+	}
+
+	sql, err := getSqlForPasswordOfUser(ctx, definer.Username)
+	if err != nil {
+		return err
+	}
+	bh.ClearExecResultSet()
+	if err = bh.Exec(ctx, sql); err != nil {
+		return err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return err
+	}
+	if !execResultArrayHasData(erArray) {
+		return moerr.NewInternalErrorf(ctx, "there is no user %s", definer.Username)
+	}
+	userId, err := erArray[0].GetInt64(ctx, 0, 0)
+	if err != nil {
+		return err
+	}
+	roleId, err := erArray[0].GetInt64(ctx, 0, 2)
+	if err != nil {
+		return err
+	}
+	host := definer.Hostname
+	if host == "" {
+		host = "%"
+	}
+	ev.definer = definer.Username + "@" + host
+	ev.definerUserId = uint32(userId)
+	ev.definerRoleId = uint32(roleId)
+	return nil
+}
+
+// checkEventOwner checks the current user can change the event, only the
+// definer of the event and the admin can alter or drop it.
+func checkEventOwner(ctx context.Context, ses *Session, ev *eventRecord) error {
+	tenant := ses.GetTenantInfo()
+	if tenant.IsAdminRole() || ev.definerUserId == tenant.GetUserID() {
+		return nil
+	}
+	return moerr.NewInternalErrorf(ctx, "do not have privilege to change event %s of %s", ev.eventName, ev.definer)
+}
+
+// createEventCronTask creates the cron task which executes the event. A new
+// task id is used every time, so the async tasks created by the dropped cron
+// task of the event are never conflicted.
+func createEventCronTask(ctx context.Context, ts taskservice.TaskService, accountId uint32, ev *eventRecord) error {
+	cronExpr, err := ev.schedule.cronExpr(ctx)
+	if err != nil {
+		return err
+	}
+	ec := pb.SQLEventContext{AccountID: accountId, EventID: ev.eventId}
+	data, err := ec.Marshal()
+	if err != nil {
+		return err
+	}
+	return ts.CreateCronTask(ctx, pb.TaskMetadata{
+		ID:       fmt.Sprintf("sql_event:%s:%d", ev.eventId, time.Now().UnixNano()),
+		Executor: pb.TaskCode_SQLEvent,
+		Context:  data,
+	}, cronExpr)
+}
+
+// deleteEventCronTasks deletes the cron tasks of the event.
+func deleteEventCronTasks(ctx context.Context, ts taskservice.TaskService, accountId uint32, eventId string) error {
+	tasks, err := ts.QueryCronTask(ctx,
+		taskservice.WithTaskExecutorCond(taskservice.EQ, pb.TaskCode_SQLEvent))
+	if err != nil {
+		return err
+	}
+	for _, t := range tasks {
+		var ec pb.SQLEventContext
+		if err = ec.Unmarshal(t.Metadata.Context); err != nil {
+			return err
+		}
+		if ec.AccountID != accountId || ec.EventID != eventId {
+			continue
+		}
+		if _, err = ts.GetStorage().DeleteCronTask(ctx,
+			taskservice.WithCronTaskId(taskservice.EQ, t.ID)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func handleCreateEvent(ses *Session, execCtx *ExecCtx, st *tree.CreateEvent) error {
+	return doCreateEvent(execCtx.reqCtx, ses, st)
+}
+
+func doCreateEvent(ctx context.Context, ses *Session, st *tree.CreateEvent) (err error) {
+	ts := getGlobalPu().TaskService
+	if ts == nil {
+		return moerr.NewInternalError(ctx,
+			"task service not ready yet, please try again later.")
+	}
+	accountId := ses.GetTenantInfo().GetTenantID()
+	dbName, err := getEventDbName(ses, st.Name)
+	if err != nil {
+		return err
+	}
+	ev := &eventRecord{
+		eventId:      uuid.Must(uuid.NewV7()).String(),
+		dbName:       dbName,
+		eventName:    string(st.Name.Name.ObjectName),
+		body:         st.BodySQL,
+		status:       eventStatusEnabled,
+		onCompletion: tree.EventCompletionNotPreserve.String(),
+		comment:      st.Comment,
+	}
+	if st.Status == tree.EventStatusDisable {
+		ev.status = eventStatusDisabled
+	}
+	if st.OnCompletion == tree.EventCompletionPreserve {
+		ev.onCompletion = tree.EventCompletionPreserve.String()
+	}
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+	err = bh.Exec(ctx, "begin;")
+	defer func() {
+		err = finishTxn(ctx, bh, err)
+	}()
+	if err != nil {
+		return err
+	}
+
+	exists, err := checkDatabaseExistsOrNot(ctx, bh, dbName)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return moerr.NewBadDB(ctx, dbName)
+	}
+	old, err := getEvent(ctx, bh, dbName, ev.eventName)
+	if err != nil {
+		return err
+	}
+	if old != nil {
+		if st.IfNotExists {
+			return nil
+		}
+		return moerr.NewInternalErrorf(ctx, "event %s already exists", ev.eventName)
+	}
+	if err = resolveEventDefiner(ctx, ses, bh, st.Definer, ev); err != nil {
+		return err
+	}
+	now := time.Now().Unix()
+	if ev.schedule, err = evalEventSchedule(ctx, bh, st.Schedule, now); err != nil {
+		return err
+	}
+	if ev.schedule.expired(now) {
+		// the same as mysql, the event is dropped immediately after creation
+		// unless it is preserved.
+		if ev.onCompletion != tree.EventCompletionPreserve.String() {
+			return nil
+		}
+		ev.status = eventStatusDisabled
+	}
+
+	bh.ClearExecResultSet()
+	if err = bh.Exec(ctx, getSqlForInsertEvent(ev)); err != nil {
+		return err
+	}
+	if ev.status != eventStatusEnabled {
+		return nil
+	}
+	return createEventCronTask(ctx, ts, accountId, ev)
+}
+
+func handleAlterEvent(ses *Session, execCtx *ExecCtx, st *tree.AlterEvent) error {
+	return doAlterEvent(execCtx.reqCtx, ses, st)
+}
+
+func doAlterEvent(ctx context.Context, ses *Session, st *tree.AlterEvent) (err error) {
+	ts := getGlobalPu().TaskService
+	if ts == nil {
+		return moerr.NewInternalError(ctx,
+			"task service not ready yet, please try again later.")
+	}
+	accountId := ses.GetTenantInfo().GetTenantID()
+	dbName, err := getEventDbName(ses, st.Name)
+	if err != nil {
+		return err
+	}
+	eventName := string(st.Name.Name.ObjectName)
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+	err = bh.Exec(ctx, "begin;")
+	defer func() {
+		err = finishTxn(ctx, bh, err)
+	}()
+	if err != nil {
+		return err
+	}
+
+	ev, err := getEvent(ctx, bh, dbName, eventName)
+	if err != nil {
+		return err
+	}
+	if ev == nil {
+		return moerr.NewInternalErrorf(ctx, "unknown event %s", eventName)
+	}
+
+	if err = checkEventOwner(ctx, ses, ev); err != nil {
+		return err
+	}
+	// the event is executed as the user who altered it last, the same as
+	// mysql, unless another definer is specified by the admin.
+	if err = resolveEventDefiner(ctx, ses, bh, st.Definer, ev); err != nil {
+		return err
+	}
+	now := time.Now().Unix()
+	if st.Schedule != nil {
+		if ev.schedule, err = evalEventSchedule(ctx, bh, st.Schedule, now); err != nil {
+			return err
+		}
+	}
+	switch st.OnCompletion {
+	case tree.EventCompletionPreserve, tree.EventCompletionNotPreserve:
+		ev.onCompletion = st.OnCompletion.String()
+	}
+	switch st.Status {
+	case tree.EventStatusEnable:
+		ev.status = eventStatusEnabled
+	case tree.EventStatusDisable:
+		ev.status = eventStatusDisabled
+	}
+	if st.HasComment {
+		ev.comment = st.Comment
+	}
+	if st.Body != nil {
+		ev.body = st.BodySQL
+	}
+	if st.Rename != nil {
+		if ev.dbName, err = getEventDbName(ses, st.Rename); err != nil {
+			return err
+		}
+		ev.eventName = string(st.Rename.Name.ObjectName)
+		exists, err := checkDatabaseExistsOrNot(ctx, bh, ev.dbName)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return moerr.NewBadDB(ctx, ev.dbName)
+		}
+		old, err := getEvent(ctx, bh, ev.dbName, ev.eventName)
+		if err != nil {
+			return err
+		}
+		if old != nil {
+			return moerr.NewInternalErrorf(ctx, "event %s already exists", ev.eventName)
+		}
+	}
+	if ev.status == eventStatusEnabled && ev.schedule.expired(now) {
+		if ev.onCompletion != tree.EventCompletionPreserve.String() {
+			return moerr.NewInvalidInput(ctx, "event execution time is in the past and ON COMPLETION NOT PRESERVE is set, the event was not changed")
+		}
+		ev.status = eventStatusDisabled
+	}
+
+	bh.ClearExecResultSet()
+	if err = bh.Exec(ctx, getSqlForUpdateEvent(ev)); err != nil {
+		return err
+	}
+	if err = deleteEventCronTasks(ctx, ts, accountId, ev.eventId); err != nil {
+		return err
+	}
+	if ev.status != eventStatusEnabled {
+		return nil
+	}
+	return createEventCronTask(ctx, ts, accountId, ev)
+}
+
+func handleDropEvent(ses *Session, execCtx *ExecCtx, st *tree.DropEvent) error {
+	return doDropEvent(execCtx.reqCtx, ses, st)
+}
+
+func doDropEvent(ctx context.Context, ses *Session, st *tree.DropEvent) (err error) {
+	ts := getGlobalPu().TaskService
+	if ts == nil {
+		return moerr.NewInternalError(ctx,
+			"task service not ready yet, please try again later.")
+	}
+	accountId := ses.GetTenantInfo().GetTenantID()
+	dbName, err := getEventDbName(ses, st.Name)
+	if err != nil {
+		return err
+	}
+	eventName := string(st.Name.Name.ObjectName)
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+	err = bh.Exec(ctx, "begin;")
+	defer func() {
+		err = finishTxn(ctx, bh, err)
+	}()
+	if err != nil {
+		return err
+	}
+
+	ev, err := getEvent(ctx, bh, dbName, eventName)
+	if err != nil {
+		return err
+	}
+	if ev == nil {
+		if st.IfExists {
+			return nil
+		}
+		return moerr.NewInternalErrorf(ctx, "unknown event %s", eventName)
+	}
+	if err = checkEventOwner(ctx, ses, ev); err != nil {
+		return err
+	}
+	for _, sql := range []string{
+		fmt.Sprintf(deleteEventFormat, ev.eventId),
+		fmt.Sprintf(deleteEventHistoryFormat, ev.eventId),
+	} {
+		if err = bh.Exec(ctx, sql); err != nil {
+			return err
+		}
+	}
+	return deleteEventCronTasks(ctx, ts, accountId, ev.eventId)
+}
+
+// eventAdminCtx returns the context of the admin of the account, which is
+// used to access the meta tables of the events.
+func eventAdminCtx(ctx context.Context, accountId uint32) context.Context {
+	if accountId == sysAccountID {
+		return defines.AttachAccount(ctx, sysAccountID, rootID, moAdminRoleID)
+	}
+	return defines.AttachAccount(ctx, accountId, GetAdminUserId(), GetAccountAdminRoleId())
+}
+
+// EventTaskExecutor returns the executor of the cron tasks of the sql
+// events. The event body is executed as the definer of the event, and every
+// execution is recorded in mo_catalog.mo_event_history.
+func EventTaskExecutor(
+	ts taskservice.TaskService,
+	ieFactory func() ie.InternalExecutor,
+) func(context.Context, pb.Task) error {
+	return func(ctx context.Context, t pb.Task) error {
+		var ec pb.SQLEventContext
+		if err := ec.Unmarshal(t.GetMetadata().Context); err != nil {
+			return err
+		}
+		return executeEvent(ctx, ts, ieFactory(), ec.AccountID, ec.EventID, t.GetMetadata().ID)
+	}
+}
+
+func executeEvent(
+	ctx context.Context,
+	ts taskservice.TaskService,
+	exec ie.InternalExecutor,
+	accountId uint32,
+	eventId string,
+	taskId string,
+) error {
+	adminCtx := eventAdminCtx(ctx, accountId)
+	res := exec.Query(adminCtx, fmt.Sprintf(getEventByIdFormat, eventId), ie.SessionOverrideOptions{})
+	if res.Error() != nil {
+		return res.Error()
+	}
+	if res.RowCount() != 1 {
+		// the event or the account has been dropped.
+		return deleteEventCronTasks(ctx, ts, accountId, eventId)
+	}
+	ev, err := readEventRecord(adminCtx, res, 0)
+	if err != nil {
+		return err
+	}
+	if ev.status != eventStatusEnabled {
+		return deleteEventCronTasks(ctx, ts, accountId, eventId)
+	}
+	now := time.Now().Unix()
+	if ev.schedule.executeAt == 0 {
+		if now < ev.schedule.starts {
+			return nil
+		}
+		if ev.schedule.ends != 0 && now > ev.schedule.ends {
+			return completeEvent(ctx, ts, exec, accountId, ev)
+		}
+	}
+
+	for _, sql := range []string{
+		fmt.Sprintf(deleteEventHistoryOfTaskFormat, eventId, util.QuoteSqlString(taskId)),
+		fmt.Sprintf(insertEventHistoryFormat, eventId, util.QuoteSqlString(ev.dbName), util.QuoteSqlString(ev.eventName),
+			util.QuoteSqlString(taskId), eventHistoryRunning),
+	} {
+		if err = exec.Exec(adminCtx, sql, ie.SessionOverrideOptions{}); err != nil {
+			return err
+		}
+	}
+
+	definerCtx := defines.AttachAccount(ctx, accountId, ev.definerUserId, ev.definerRoleId)
+	runErr := exec.Exec(definerCtx, ev.body, ie.NewOptsBuilder().Database(ev.dbName).Finish())
+
+	status, errMsg := eventHistorySuccess, ""
+	if runErr != nil {
+		status, errMsg = eventHistoryFailed, runErr.Error()
+	}
+	for _, sql := range []string{
+		fmt.Sprintf(updateEventHistoryFormat, status, util.QuoteSqlString(errMsg), eventId, util.QuoteSqlString(taskId)),
+		fmt.Sprintf(updateEventLastExecutedFormat, eventId),
+	} {
+		if err = exec.Exec(adminCtx, sql, ie.SessionOverrideOptions{}); err != nil {
+			return err
+		}
+	}
+
+	if ev.schedule.executeAt != 0 {
+		if err = completeEvent(ctx, ts, exec, accountId, ev); err != nil {
+			return err
+		}
+	}
+	return runErr
+}
+
+// completeEvent handles the event which will never be executed again. The
+// event is dropped, or disabled if it is ON COMPLETION PRESERVE.
+func completeEvent(
+	ctx context.Context,
+	ts taskservice.TaskService,
+	exec ie.InternalExecutor,
+	accountId uint32,
+	ev *eventRecord,
+) error {
+	sqls := []string{fmt.Sprintf(updateEventStatusFormat, eventStatusDisabled, ev.eventId)}
+	if ev.onCompletion != tree.EventCompletionPreserve.String() {
+		sqls = []string{
+			fmt.Sprintf(deleteEventFormat, ev.eventId),
+			fmt.Sprintf(deleteEventHistoryFormat, ev.eventId),
+		}
+	}
+	adminCtx := eventAdminCtx(ctx, accountId)
+	for _, sql := range sqls {
+		if err := exec.Exec(adminCtx, sql, ie.SessionOverrideOptions{}); err != nil {
+			return err
+		}
+	}
+	return deleteEventCronTasks(ctx, ts, accountId, ev.eventId)
+}
@@ -0,0 +1,192 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/robfig/cron/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_eventCronExpr(t *testing.T) {
+	ctx := context.Background()
+	parser := cron.NewParser(cron.Second | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+	// 2024-01-31 10:20:30 Wednesday
+	starts := time.Date(2024, 1, 31, 10, 20, 30, 0, time.UTC)
+
+	cases := []struct {
+		schedule eventSchedule
+		expr     string
+		next     time.Time
+	}{
+		{
+			schedule: eventSchedule{executeAt: starts.Unix()},
+			expr:     "CRON_TZ=UTC 30 20 10 31 1 *",
+		},
+		{
+			schedule: eventSchedule{intervalValue: 15, intervalUnit: "SECOND", starts: starts.Unix()},
+			expr:     "CRON_TZ=UTC 0/15 * * * * *",
+			next:     starts.Add(15 * time.Second),
+		},
+		{
+			schedule: eventSchedule{intervalValue: 7, intervalUnit: "SECOND", starts: starts.Unix()},
+			expr:     "@every 7s",
+		},
+		{
+			schedule: eventSchedule{intervalValue: 15, intervalUnit: "MINUTE", starts: starts.Unix()},
+			expr:     "CRON_TZ=UTC 30 5/15 * * * *",
+			next:     starts.Add(15 * time.Minute),
+		},
+		{
+			schedule: eventSchedule{intervalValue: 6, intervalUnit: "HOUR", starts: starts.Unix()},
+			expr:     "CRON_TZ=UTC 30 20 4/6 * * *",
+			next:     starts.Add(6 * time.Hour),
+		},
+		{
+			schedule: eventSchedule{intervalValue: 1, intervalUnit: "DAY", starts: starts.Unix()},
+			expr:     "CRON_TZ=UTC 30 20 10 * * *",
+			next:     starts.Add(24 * time.Hour),
+		},
+		{
+			schedule: eventSchedule{intervalValue: 2, intervalUnit: "DAY", starts: starts.Unix()},
+			expr:     "@every 48h0m0s",
+		},
+		{
+			schedule: eventSchedule{intervalValue: 1, intervalUnit: "WEEK", starts: starts.Unix()},
+			expr:     "CRON_TZ=UTC 30 20 10 * * 3",
+			next:     starts.Add(7 * 24 * time.Hour),
+		},
+		{
+			schedule: eventSchedule{intervalValue: 1, intervalUnit: "QUARTER", starts: starts.Unix()},
+			expr:     "CRON_TZ=UTC 30 20 10 31 1/3 *",
+		},
+		{
+			schedule: eventSchedule{intervalValue: 1, intervalUnit: "YEAR", starts: starts.Unix()},
+			expr:     "CRON_TZ=UTC 30 20 10 31 1/12 *",
+			next:     starts.AddDate(1, 0, 0),
+		},
+	}
+	for _, c := range cases {
+		expr, err := c.schedule.cronExpr(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, c.expr, expr)
+		sche, err := parser.Parse(expr)
+		require.NoError(t, err)
+		if !c.next.IsZero() {
+			assert.Equal(t, c.next, sche.Next(starts).UTC(), expr)
+		}
+	}
+
+	for _, es := range []eventSchedule{
+		{intervalValue: 0, intervalUnit: "DAY"},
+		{intervalValue: 5, intervalUnit: "MONTH"},
+		{intervalValue: 2, intervalUnit: "YEAR"},
+		{intervalValue: 1, intervalUnit: "DAY_HOUR"},
+	} {
+		_, err := es.cronExpr(ctx)
+		assert.Error(t, err)
+	}
+}
+
+func Test_eventScheduleExpired(t *testing.T) {
+	assert.True(t, eventSchedule{executeAt: 10}.expired(10))
+	assert.False(t, eventSchedule{executeAt: 11}.expired(10))
+	assert.False(t, eventSchedule{intervalValue: 1, intervalUnit: "DAY", starts: 1}.expired(10))
+	assert.True(t, eventSchedule{intervalValue: 1, intervalUnit: "DAY", starts: 1, ends: 5}.expired(10))
+}
+
+func Test_getSqlForEvent(t *testing.T) {
+	ev := &eventRecord{
+		eventId:       "id",
+		dbName:        "db",
+		eventName:     "e1",
+		definer:       "root@%",
+		definerUserId: 1,
+		definerRoleId: 2,
+		body:          "delete from t where a = 'x'",
+		schedule:      eventSchedule{intervalValue: 30, intervalUnit: "DAY", starts: 100},
+		status:        eventStatusEnabled,
+		onCompletion:  "NOT PRESERVE",
+	}
+	assert.Equal(t,
+		"insert into mo_catalog.mo_events values ('id', 'db', 'e1', 'root@%', 1, 2, 'delete from t where a = ''x''', null, '30', 'DAY', from_unixtime(100), null, 'ENABLED', 'NOT PRESERVE', now(), now(), null, '')",
+		getSqlForInsertEvent(ev))
+
+	ev.schedule = eventSchedule{executeAt: 200}
+	ev.status = eventStatusDisabled
+	assert.Equal(t,
+		`update mo_catalog.mo_events set db_name = 'db', event_name = 'e1', definer = 'root@%',
+		definer_user_id = 1, definer_role_id = 2, event_body = 'delete from t where a = ''x''', execute_at = from_unixtime(200), interval_value = null,
+		interval_field = null, starts = null, ends = null, status = 'DISABLED', on_completion = 'NOT PRESERVE', event_comment = '',
+		last_altered = now() where event_id = 'id'`,
+		getSqlForUpdateEvent(ev))
+}
+
+type testEventResult [][]string
+
+func (r testEventResult) GetString(_ context.Context, rindex, cindex uint64) (string, error) {
+	return r[rindex][cindex], nil
+}
+
+func Test_readEventRecord(t *testing.T) {
+	res := testEventResult{
+		{"id", "db", "e1", "u1@%", "3", "4", "select 1", "0", "10", "MINUTE", "100", "0", "ENABLED", "PRESERVE", "c"},
+		{"id2", "db", "e2", "u1@%", "3", "4", "select 1", "200", "", "", "0", "0", "DISABLED", "NOT PRESERVE", ""},
+	}
+	ev, err := readEventRecord(context.Background(), res, 0)
+	require.NoError(t, err)
+	assert.Equal(t, eventRecord{
+		eventId:       "id",
+		dbName:        "db",
+		eventName:     "e1",
+		definer:       "u1@%",
+		definerUserId: 3,
+		definerRoleId: 4,
+		body:          "select 1",
+		schedule:      eventSchedule{intervalValue: 10, intervalUnit: "MINUTE", starts: 100},
+		status:        eventStatusEnabled,
+		onCompletion:  "PRESERVE",
+		comment:       "c",
+	}, *ev)
+
+	ev, err = readEventRecord(context.Background(), res, 1)
+	require.NoError(t, err)
+	assert.Equal(t, eventSchedule{executeAt: 200}, ev.schedule)
+}
+
+func Test_checkEventOwner(t *testing.T) {
+	catalog.SetupDefines("")
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ses := newTestSession(t, ctrl)
+	defer ses.Close()
+
+	ctx := context.Background()
+	ev := &eventRecord{eventName: "e1", definer: "u1@%", definerUserId: 10}
+	ses.SetTenantInfo(&TenantInfo{Tenant: "abc", User: "u1", UserID: 10, DefaultRole: "r1"})
+	require.NoError(t, checkEventOwner(ctx, ses, ev))
+
+	ses.SetTenantInfo(&TenantInfo{Tenant: "abc", User: "u2", UserID: 11, DefaultRole: "r1"})
+	require.Error(t, checkEventOwner(ctx, ses, ev))
+
+	ses.SetTenantInfo(&TenantInfo{Tenant: "abc", User: "admin", UserID: 2, DefaultRole: accountAdminRoleName})
+	require.NoError(t, checkEventOwner(ctx, ses, ev))
+}
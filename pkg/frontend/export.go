@@ -36,6 +36,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 )
 
@@ -61,6 +62,12 @@ type ExportConfig struct {
 	AsyncGroup  *errgroup.Group
 	mrs         *MysqlResultSet
 	ctx         context.Context
+
+	// result columns of the query, the schema of the parquet file
+	colDefs []*plan.ColDef
+	parquet *parquetWriter
+	// `"name":` of each column of the json line
+	jsonKeys [][]byte
 }
 
 type writeParam struct {
@@ -104,6 +111,23 @@ func (ec *ExportConfig) needExportToFile() bool {
 	return ec != nil && ec.userConfig != nil && ec.userConfig.Outfile
 }
 
+// checkExportFormat checks the file format and its options of the export
+func checkExportFormat(ctx context.Context, ep *tree.ExportParam) error {
+	switch ep.FileFormat {
+	case "", tree.CSV, tree.JSONLINE:
+		if ep.RowGroupSize != 0 || ep.Compression != "" {
+			return moerr.NewInvalidInput(ctx, "row_group_size and compression are only supported by the parquet format")
+		}
+	case tree.PARQUET:
+		if _, ok := parquetCodecs[ep.Compression]; !ok {
+			return moerr.NewNotSupportedf(ctx, "parquet compression '%s'", ep.Compression)
+		}
+	default:
+		return moerr.NewNotSupportedf(ctx, "export format '%s'", ep.FileFormat)
+	}
+	return nil
+}
+
 func initExportFileParam(ep *ExportConfig, mrs *MysqlResultSet) {
 	ep.DefaultBufSize *= 1024 * 1024
 	n := (int)(mrs.GetColumnCount())
@@ -115,6 +139,9 @@ func initExportFileParam(ep *ExportConfig, mrs *MysqlResultSet) {
 		ep.Symbol[i] = []byte(ep.userConfig.Fields.Terminated.Value)
 	}
 	ep.Symbol[n-1] = []byte(ep.userConfig.Lines.TerminatedBy.Value)
+	if ep.userConfig.FileFormat == tree.JSONLINE {
+		initJsonLineKeys(ep, mrs)
+	}
 	columnsSet := make(map[string]int)
 	for i := 0; i < len(mrs.Columns); i++ {
 		columnsSet[mrs.Columns[i].Name()] = i
@@ -184,6 +211,22 @@ var openNewFile = func(ctx context.Context, ep *ExportConfig, mrs *MysqlResultSe
 	ep.AsyncGroup, _ = errgroup.WithContext(ctx)
 	ep.AsyncGroup.Go(asyncWriteFunc)
 
+	switch ep.userConfig.FileFormat {
+	case tree.JSONLINE:
+		ep.Rows = 0
+		return nil
+	case tree.PARQUET:
+		ep.Rows = 0
+		ep.parquet, err = newParquetWriter(ctx, ep)
+		if err != nil {
+			if err2 := ep.AsyncWriter.CloseWithError(err); err2 != nil {
+				return err2
+			}
+			return err
+		}
+		return nil
+	}
+
 	if ep.userConfig.Header {
 		var header string
 		n := len(mrs.Columns)
@@ -237,6 +280,18 @@ var formatOutputString = func(oq *ExportConfig, tmp, symbol []byte, enclosed byt
 
 var Close = func(ep *ExportConfig) error {
 	ep.FileCnt++
+	if ep.parquet != nil {
+		// write the footer of the parquet file
+		err := ep.parquet.close()
+		ep.parquet = nil
+		if err != nil {
+			if err2 := ep.AsyncWriter.CloseWithError(err); err2 != nil {
+				return err2
+			}
+			_ = ep.AsyncGroup.Wait()
+			return err
+		}
+	}
 	err := ep.AsyncWriter.Close()
 	if err != nil {
 		return err
@@ -740,12 +795,27 @@ func (ec *ExportConfig) init() {
 }
 
 func (ec *ExportConfig) Write(execCtx *ExecCtx, bat *batch.Batch) error {
+	if ec.userConfig.FileFormat == tree.PARQUET {
+		// the rows are encoded by the parquet writer in order
+		if err := writeToParquetFile(ec, bat); err != nil {
+			execCtx.ses.Error(execCtx.reqCtx,
+				"Error occurred while exporting to parquet file",
+				zap.Error(err))
+			return err
+		}
+		return nil
+	}
+
 	ec.Index.Add(1)
 	copied, err := bat.Dup(execCtx.ses.GetMemPool())
 	if err != nil {
 		return err
 	}
-	go constructByte(execCtx.reqCtx, execCtx.ses, copied, ec.Index.Load(), ec.ByteChan, ec)
+	if ec.userConfig.FileFormat == tree.JSONLINE {
+		go constructJsonLine(execCtx.reqCtx, execCtx.ses, copied, ec.Index.Load(), ec.ByteChan, ec)
+	} else {
+		go constructByte(execCtx.reqCtx, execCtx.ses, copied, ec.Index.Load(), ec.ByteChan, ec)
+	}
 
 	if err = exportDataFromBatchToCSVFile(ec); err != nil {
 		execCtx.ses.Error(execCtx.reqCtx,
@@ -0,0 +1,142 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+// initJsonLineKeys builds the `"name":` prefix of each column of the json
+// object of a row.
+func initJsonLineKeys(ep *ExportConfig, mrs *MysqlResultSet) {
+	ep.jsonKeys = make([][]byte, len(mrs.Columns))
+	for i, col := range mrs.Columns {
+		key, _ := json.Marshal(col.Name())
+		ep.jsonKeys[i] = append(key, ':')
+	}
+}
+
+// appendJsonString appends the json string of s to the buffer.
+func appendJsonString(buffer *bytes.Buffer, s []byte) {
+	data, _ := json.Marshal(string(s))
+	buffer.Write(data)
+}
+
+// constructJsonLine formats each row of the batch as a json object in a line,
+// in the same way as the constructByte for the csv file.
+func constructJsonLine(ctx context.Context, obj FeSession, bat *batch.Batch, index int32, ByteChan chan *BatchByte, ep *ExportConfig) {
+	ses := obj.(*Session)
+	buffer := &bytes.Buffer{}
+
+	for i := 0; i < bat.RowCount(); i++ {
+		buffer.WriteByte('{')
+		for j, vec := range bat.Vecs {
+			if j > 0 {
+				buffer.WriteByte(',')
+			}
+			buffer.Write(ep.jsonKeys[j])
+			if vec.GetNulls().Contains(uint64(i)) {
+				buffer.WriteString("null")
+				continue
+			}
+			switch vec.GetType().Oid {
+			case types.T_json:
+				buffer.WriteString(types.DecodeJson(vec.GetBytesAt(i)).String())
+			case types.T_bool:
+				buffer.WriteString(strconv.FormatBool(vector.GetFixedAtNoTypeCheck[bool](vec, i)))
+			case types.T_bit:
+				buffer.WriteString(strconv.FormatUint(vector.GetFixedAtNoTypeCheck[uint64](vec, i), 10))
+			case types.T_int8:
+				buffer.WriteString(strconv.FormatInt(int64(vector.GetFixedAtNoTypeCheck[int8](vec, i)), 10))
+			case types.T_int16:
+				buffer.WriteString(strconv.FormatInt(int64(vector.GetFixedAtNoTypeCheck[int16](vec, i)), 10))
+			case types.T_int32:
+				buffer.WriteString(strconv.FormatInt(int64(vector.GetFixedAtNoTypeCheck[int32](vec, i)), 10))
+			case types.T_int64:
+				buffer.WriteString(strconv.FormatInt(vector.GetFixedAtNoTypeCheck[int64](vec, i), 10))
+			case types.T_uint8:
+				buffer.WriteString(strconv.FormatUint(uint64(vector.GetFixedAtNoTypeCheck[uint8](vec, i)), 10))
+			case types.T_uint16:
+				buffer.WriteString(strconv.FormatUint(uint64(vector.GetFixedAtNoTypeCheck[uint16](vec, i)), 10))
+			case types.T_uint32:
+				buffer.WriteString(strconv.FormatUint(uint64(vector.GetFixedAtNoTypeCheck[uint32](vec, i)), 10))
+			case types.T_uint64:
+				buffer.WriteString(strconv.FormatUint(vector.GetFixedAtNoTypeCheck[uint64](vec, i), 10))
+			case types.T_float32:
+				buffer.WriteString(strconv.FormatFloat(float64(vector.GetFixedAtNoTypeCheck[float32](vec, i)), 'g', -1, 32))
+			case types.T_float64:
+				buffer.WriteString(strconv.FormatFloat(vector.GetFixedAtNoTypeCheck[float64](vec, i), 'g', -1, 64))
+			case types.T_decimal64:
+				buffer.WriteString(vector.GetFixedAtNoTypeCheck[types.Decimal64](vec, i).Format(vec.GetType().Scale))
+			case types.T_decimal128:
+				buffer.WriteString(vector.GetFixedAtNoTypeCheck[types.Decimal128](vec, i).Format(vec.GetType().Scale))
+			case types.T_char, types.T_varchar, types.T_blob, types.T_text, types.T_binary, types.T_varbinary, types.T_datalink:
+				appendJsonString(buffer, vec.GetBytesAt(i))
+			case types.T_array_float32:
+				appendJsonString(buffer, []byte(types.BytesToArrayToString[float32](vec.GetBytesAt(i))))
+			case types.T_array_float64:
+				appendJsonString(buffer, []byte(types.BytesToArrayToString[float64](vec.GetBytesAt(i))))
+			case types.T_date:
+				appendJsonString(buffer, []byte(vector.GetFixedAtNoTypeCheck[types.Date](vec, i).String()))
+			case types.T_datetime:
+				val := vector.GetFixedAtNoTypeCheck[types.Datetime](vec, i).String2(vec.GetType().Scale)
+				appendJsonString(buffer, []byte(val))
+			case types.T_time:
+				val := vector.GetFixedAtNoTypeCheck[types.Time](vec, i).String2(vec.GetType().Scale)
+				appendJsonString(buffer, []byte(val))
+			case types.T_timestamp:
+				val := vector.GetFixedAtNoTypeCheck[types.Timestamp](vec, i).String2(ses.GetTimeZone(), vec.GetType().Scale)
+				appendJsonString(buffer, []byte(val))
+			case types.T_uuid:
+				appendJsonString(buffer, []byte(vector.GetFixedAtNoTypeCheck[types.Uuid](vec, i).String()))
+			case types.T_enum:
+				appendJsonString(buffer, []byte(vector.GetFixedAtNoTypeCheck[types.Enum](vec, i).String()))
+			default:
+				ses.Error(ctx,
+					"Failed to construct json line due to unsupported type",
+					zap.Int("typeOid", int(vec.GetType().Oid)))
+				ByteChan <- &BatchByte{
+					err: moerr.NewInternalErrorf(ctx, "constructJsonLine : unsupported type %d", vec.GetType().Oid),
+				}
+				bat.Clean(ses.GetMemPool())
+				return
+			}
+		}
+		buffer.WriteString("}\n")
+	}
+
+	reslen := buffer.Len()
+	result := make([]byte, reslen)
+	copy(result, buffer.Bytes())
+	buffer = nil
+
+	ByteChan <- &BatchByte{
+		index:     index,
+		writeByte: result,
+		err:       nil,
+	}
+	ses.writeCsvBytes.Add(int64(reslen))
+	bat.Clean(ses.GetMemPool())
+}
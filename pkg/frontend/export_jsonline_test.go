@@ -0,0 +1,79 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+func Test_constructJsonLine(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ses := newTestSession(t, ctrl)
+	mp := ses.GetMemPool()
+
+	mrs := &MysqlResultSet{}
+	for _, name := range []string{"id", "name", "price", "doc"} {
+		col := new(MysqlColumn)
+		col.SetName(name)
+		mrs.AddColumn(col)
+	}
+	ep := &ExportConfig{
+		userConfig: &tree.ExportParam{FileFormat: tree.JSONLINE},
+	}
+	initJsonLineKeys(ep, mrs)
+
+	bat := batch.NewWithSize(4)
+	bat.Vecs[0] = vector.NewVec(types.T_int64.ToType())
+	bat.Vecs[1] = vector.NewVec(types.T_varchar.ToType())
+	bat.Vecs[2] = vector.NewVec(types.New(types.T_decimal64, 10, 2))
+	bat.Vecs[3] = vector.NewVec(types.T_json.ToType())
+
+	doc, err := bytejson.ParseFromString(`{"a": [1, 2]}`)
+	require.NoError(t, err)
+	docBytes, err := doc.Marshal()
+	require.NoError(t, err)
+
+	require.NoError(t, vector.AppendFixed(bat.Vecs[0], int64(1), false, mp))
+	require.NoError(t, vector.AppendBytes(bat.Vecs[1], []byte(`a"b`), false, mp))
+	require.NoError(t, vector.AppendFixed(bat.Vecs[2], types.Decimal64(12345), false, mp))
+	require.NoError(t, vector.AppendBytes(bat.Vecs[3], docBytes, false, mp))
+
+	require.NoError(t, vector.AppendFixed(bat.Vecs[0], int64(2), false, mp))
+	require.NoError(t, vector.AppendBytes(bat.Vecs[1], nil, true, mp))
+	require.NoError(t, vector.AppendFixed(bat.Vecs[2], types.Decimal64(0), true, mp))
+	require.NoError(t, vector.AppendBytes(bat.Vecs[3], nil, true, mp))
+	bat.SetRowCount(2)
+
+	byteChan := make(chan *BatchByte, 1)
+	constructJsonLine(context.TODO(), ses, bat, 1, byteChan, ep)
+	res := <-byteChan
+	require.NoError(t, res.err)
+	require.Equal(t, int32(1), res.index)
+	require.Equal(t,
+		`{"id":1,"name":"a\"b","price":123.45,"doc":{"a": [1, 2]}}`+"\n"+
+			`{"id":2,"name":null,"price":null,"doc":null}`+"\n",
+		string(res.writeByte))
+}
@@ -0,0 +1,300 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/binary"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/parquet-go/parquet-go/compress"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+// defaultParquetRowGroupSize is the max rows of a row group if the
+// row_group_size is not specified.
+const defaultParquetRowGroupSize = 128 * 1024
+
+var parquetCodecs = map[string]compress.Codec{
+	"":             &parquet.Snappy,
+	"none":         &parquet.Uncompressed,
+	"uncompressed": &parquet.Uncompressed,
+	"snappy":       &parquet.Snappy,
+	"gzip":         &parquet.Gzip,
+	"zstd":         &parquet.Zstd,
+	"lz4":          &parquet.Lz4Raw,
+	"brotli":       &parquet.Brotli,
+}
+
+// parquetValue returns the value of the row of the vector.
+type parquetValue func(vec *vector.Vector, row int) parquet.Value
+
+type parquetWriter struct {
+	writer *parquet.Writer
+	// leaves is the column index in the parquet schema of each result column.
+	leaves   []int
+	optional []bool
+	values   []parquetValue
+
+	rowGroupSize uint64
+	// rows and estimated bytes buffered in the current row group
+	groupRows  uint64
+	groupBytes uint64
+	rows       []parquet.Row
+}
+
+// parquetFileWriter is the io.Writer of the parquet writer, it writes the
+// output into the pipe of the current file.
+type parquetFileWriter struct {
+	ep *ExportConfig
+}
+
+func (w parquetFileWriter) Write(p []byte) (int, error) {
+	if err := writeDataToCSVFile(w.ep, p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func newParquetWriter(ctx context.Context, ep *ExportConfig) (*parquetWriter, error) {
+	if len(ep.colDefs) == 0 {
+		return nil, moerr.NewInternalError(ctx, "no result columns to export")
+	}
+	codec, ok := parquetCodecs[ep.userConfig.Compression]
+	if !ok {
+		return nil, moerr.NewNotSupportedf(ctx, "parquet compression '%s'", ep.userConfig.Compression)
+	}
+
+	pw := &parquetWriter{
+		leaves:       make([]int, len(ep.colDefs)),
+		optional:     make([]bool, len(ep.colDefs)),
+		values:       make([]parquetValue, len(ep.colDefs)),
+		rowGroupSize: ep.userConfig.RowGroupSize,
+	}
+	if pw.rowGroupSize == 0 {
+		pw.rowGroupSize = defaultParquetRowGroupSize
+	}
+
+	group := make(parquet.Group, len(ep.colDefs))
+	for i, col := range ep.colDefs {
+		if _, ok := group[col.Name]; ok {
+			return nil, moerr.NewInvalidInputf(ctx, "duplicate column name '%s' in parquet output", col.Name)
+		}
+		node, fn, err := getParquetNode(ctx, col)
+		if err != nil {
+			return nil, err
+		}
+		if !col.Typ.NotNullable {
+			node = parquet.Optional(node)
+			pw.optional[i] = true
+		}
+		group[col.Name] = node
+		pw.values[i] = fn
+	}
+	schema := parquet.NewSchema("mo", group)
+	for i, col := range ep.colDefs {
+		leaf, _ := schema.Lookup(col.Name)
+		pw.leaves[i] = leaf.ColumnIndex
+	}
+
+	pw.writer = parquet.NewWriter(parquetFileWriter{ep: ep},
+		schema,
+		parquet.Compression(codec),
+		parquet.CreatedBy("MatrixOne", "", ""),
+	)
+	return pw, nil
+}
+
+// getParquetNode returns the parquet type of the column, and how to get the
+// values of it.
+func getParquetNode(ctx context.Context, col *plan.ColDef) (parquet.Node, parquetValue, error) {
+	typ := col.Typ
+	switch types.T(typ.Id) {
+	case types.T_bool:
+		return parquet.Leaf(parquet.BooleanType), func(vec *vector.Vector, row int) parquet.Value {
+			return parquet.BooleanValue(vector.GetFixedAtNoTypeCheck[bool](vec, row))
+		}, nil
+	case types.T_int8:
+		return parquet.Int(8), func(vec *vector.Vector, row int) parquet.Value {
+			return parquet.Int32Value(int32(vector.GetFixedAtNoTypeCheck[int8](vec, row)))
+		}, nil
+	case types.T_int16:
+		return parquet.Int(16), func(vec *vector.Vector, row int) parquet.Value {
+			return parquet.Int32Value(int32(vector.GetFixedAtNoTypeCheck[int16](vec, row)))
+		}, nil
+	case types.T_int32:
+		return parquet.Int(32), func(vec *vector.Vector, row int) parquet.Value {
+			return parquet.Int32Value(vector.GetFixedAtNoTypeCheck[int32](vec, row))
+		}, nil
+	case types.T_int64:
+		return parquet.Int(64), func(vec *vector.Vector, row int) parquet.Value {
+			return parquet.Int64Value(vector.GetFixedAtNoTypeCheck[int64](vec, row))
+		}, nil
+	case types.T_uint8:
+		return parquet.Uint(8), func(vec *vector.Vector, row int) parquet.Value {
+			return parquet.Int32Value(int32(vector.GetFixedAtNoTypeCheck[uint8](vec, row)))
+		}, nil
+	case types.T_uint16:
+		return parquet.Uint(16), func(vec *vector.Vector, row int) parquet.Value {
+			return parquet.Int32Value(int32(vector.GetFixedAtNoTypeCheck[uint16](vec, row)))
+		}, nil
+	case types.T_uint32:
+		return parquet.Uint(32), func(vec *vector.Vector, row int) parquet.Value {
+			return parquet.Int32Value(int32(vector.GetFixedAtNoTypeCheck[uint32](vec, row)))
+		}, nil
+	case types.T_uint64, types.T_bit:
+		return parquet.Uint(64), func(vec *vector.Vector, row int) parquet.Value {
+			return parquet.Int64Value(int64(vector.GetFixedAtNoTypeCheck[uint64](vec, row)))
+		}, nil
+	case types.T_float32:
+		return parquet.Leaf(parquet.FloatType), func(vec *vector.Vector, row int) parquet.Value {
+			return parquet.FloatValue(vector.GetFixedAtNoTypeCheck[float32](vec, row))
+		}, nil
+	case types.T_float64:
+		return parquet.Leaf(parquet.DoubleType), func(vec *vector.Vector, row int) parquet.Value {
+			return parquet.DoubleValue(vector.GetFixedAtNoTypeCheck[float64](vec, row))
+		}, nil
+	case types.T_decimal64:
+		return parquet.Decimal(int(typ.Scale), int(typ.Width), parquet.Int64Type), func(vec *vector.Vector, row int) parquet.Value {
+			return parquet.Int64Value(int64(vector.GetFixedAtNoTypeCheck[types.Decimal64](vec, row)))
+		}, nil
+	case types.T_decimal128:
+		return parquet.Decimal(int(typ.Scale), int(typ.Width), parquet.FixedLenByteArrayType(16)), func(vec *vector.Vector, row int) parquet.Value {
+			// big-endian two's complement
+			v := vector.GetFixedAtNoTypeCheck[types.Decimal128](vec, row)
+			b := make([]byte, 16)
+			binary.BigEndian.PutUint64(b[:8], v.B64_127)
+			binary.BigEndian.PutUint64(b[8:], v.B0_63)
+			return parquet.FixedLenByteArrayValue(b)
+		}, nil
+	case types.T_date:
+		return parquet.Date(), func(vec *vector.Vector, row int) parquet.Value {
+			return parquet.Int32Value(vector.GetFixedAtNoTypeCheck[types.Date](vec, row).DaysSinceUnixEpoch())
+		}, nil
+	case types.T_time:
+		return parquet.Time(parquet.Microsecond), func(vec *vector.Vector, row int) parquet.Value {
+			return parquet.Int64Value(int64(vector.GetFixedAtNoTypeCheck[types.Time](vec, row)))
+		}, nil
+	case types.T_datetime:
+		return parquet.Timestamp(parquet.Microsecond), func(vec *vector.Vector, row int) parquet.Value {
+			return parquet.Int64Value(int64(vector.GetFixedAtNoTypeCheck[types.Datetime](vec, row)) - types.GetUnixEpochSecs())
+		}, nil
+	case types.T_timestamp:
+		return parquet.Timestamp(parquet.Microsecond), func(vec *vector.Vector, row int) parquet.Value {
+			return parquet.Int64Value(int64(vector.GetFixedAtNoTypeCheck[types.Timestamp](vec, row)) - types.GetUnixEpochSecs())
+		}, nil
+	case types.T_char, types.T_varchar, types.T_text, types.T_datalink:
+		return parquet.String(), func(vec *vector.Vector, row int) parquet.Value {
+			return parquet.ByteArrayValue(vec.GetBytesAt(row))
+		}, nil
+	case types.T_binary, types.T_varbinary, types.T_blob:
+		return parquet.Leaf(parquet.ByteArrayType), func(vec *vector.Vector, row int) parquet.Value {
+			return parquet.ByteArrayValue(vec.GetBytesAt(row))
+		}, nil
+	case types.T_json:
+		return parquet.JSON(), func(vec *vector.Vector, row int) parquet.Value {
+			return parquet.ByteArrayValue([]byte(types.DecodeJson(vec.GetBytesAt(row)).String()))
+		}, nil
+	case types.T_uuid:
+		return parquet.UUID(), func(vec *vector.Vector, row int) parquet.Value {
+			v := vector.GetFixedAtNoTypeCheck[types.Uuid](vec, row)
+			return parquet.FixedLenByteArrayValue(v[:])
+		}, nil
+	case types.T_enum:
+		return parquet.String(), func(vec *vector.Vector, row int) parquet.Value {
+			return parquet.ByteArrayValue([]byte(vector.GetFixedAtNoTypeCheck[types.Enum](vec, row).String()))
+		}, nil
+	case types.T_array_float32:
+		return parquet.String(), func(vec *vector.Vector, row int) parquet.Value {
+			return parquet.ByteArrayValue([]byte(types.BytesToArrayToString[float32](vec.GetBytesAt(row))))
+		}, nil
+	case types.T_array_float64:
+		return parquet.String(), func(vec *vector.Vector, row int) parquet.Value {
+			return parquet.ByteArrayValue([]byte(types.BytesToArrayToString[float64](vec.GetBytesAt(row))))
+		}, nil
+	}
+	return nil, nil, moerr.NewNYIf(ctx, "export %s column %s to parquet", types.T(typ.Id), col.Name)
+}
+
+// writeToParquetFile writes the rows of the batch into the current parquet
+// file. Rows are flushed as a row group once the row_group_size is reached,
+// and a new file is started before the batch if the bytes written and
+// buffered of the current file are over the max_file_size.
+func writeToParquetFile(ep *ExportConfig, bat *batch.Batch) error {
+	pw := ep.parquet
+	if ep.userConfig.MaxFileSize != 0 && ep.Rows != 0 &&
+		ep.CurFileSize+pw.groupBytes >= ep.userConfig.MaxFileSize {
+		if err := Close(ep); err != nil {
+			return err
+		}
+		if err := openNewFile(ep.ctx, ep, ep.mrs); err != nil {
+			return err
+		}
+		pw = ep.parquet
+	}
+
+	for start, cnt := 0, bat.RowCount(); start < cnt; {
+		end := min(cnt, start+int(pw.rowGroupSize-pw.groupRows))
+		pw.rows = pw.rows[:0]
+		for i := start; i < end; i++ {
+			row := make(parquet.Row, len(bat.Vecs))
+			for j, vec := range bat.Vecs {
+				if vec.IsNull(uint64(i)) {
+					row[pw.leaves[j]] = parquet.NullValue().Level(0, 0, pw.leaves[j])
+					continue
+				}
+				v := pw.values[j](vec, i)
+				pw.groupBytes += uint64(len(v.Bytes()))
+				if pw.optional[j] {
+					v = v.Level(0, 1, pw.leaves[j])
+				} else {
+					v = v.Level(0, 0, pw.leaves[j])
+				}
+				row[pw.leaves[j]] = v
+			}
+			pw.rows = append(pw.rows, row)
+		}
+		if _, err := pw.writer.WriteRows(pw.rows); err != nil {
+			return moerr.ConvertGoError(ep.ctx, err)
+		}
+		pw.groupRows += uint64(end - start)
+		ep.Rows += uint64(end - start)
+		if pw.groupRows >= pw.rowGroupSize {
+			if err := pw.flush(); err != nil {
+				return moerr.ConvertGoError(ep.ctx, err)
+			}
+		}
+		start = end
+	}
+	return nil
+}
+
+func (pw *parquetWriter) flush() error {
+	pw.groupRows = 0
+	pw.groupBytes = 0
+	return pw.writer.Flush()
+}
+
+// close writes the buffered rows and the footer of the file.
+func (pw *parquetWriter) close() error {
+	pw.groupRows = 0
+	pw.groupBytes = 0
+	return pw.writer.Close()
+}
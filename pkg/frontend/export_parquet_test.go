@@ -0,0 +1,149 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+func Test_checkExportFormat(t *testing.T) {
+	ctx := context.TODO()
+	require.NoError(t, checkExportFormat(ctx, &tree.ExportParam{}))
+	require.NoError(t, checkExportFormat(ctx, &tree.ExportParam{FileFormat: tree.JSONLINE}))
+	require.NoError(t, checkExportFormat(ctx, &tree.ExportParam{FileFormat: tree.PARQUET, RowGroupSize: 10, Compression: "zstd"}))
+	require.Error(t, checkExportFormat(ctx, &tree.ExportParam{FileFormat: tree.PARQUET, Compression: "lzo"}))
+	require.Error(t, checkExportFormat(ctx, &tree.ExportParam{FileFormat: tree.CSV, Compression: "zstd"}))
+	require.Error(t, checkExportFormat(ctx, &tree.ExportParam{FileFormat: tree.JSONLINE, RowGroupSize: 10}))
+	require.Error(t, checkExportFormat(ctx, &tree.ExportParam{FileFormat: "xml"}))
+}
+
+func Test_writeToParquetFile(t *testing.T) {
+	ctx := context.TODO()
+	mp := mpool.MustNewZero()
+
+	var file bytes.Buffer
+	stubs := gostub.Stub(&writeDataToCSVFile, func(ep *ExportConfig, output []byte) error {
+		file.Write(output)
+		ep.CurFileSize += uint64(len(output))
+		return nil
+	})
+	defer stubs.Reset()
+
+	ep := &ExportConfig{
+		userConfig: &tree.ExportParam{
+			FileFormat:   tree.PARQUET,
+			RowGroupSize: 2,
+			Compression:  "zstd",
+		},
+		ctx: ctx,
+		colDefs: []*plan.ColDef{
+			{Name: "id", Typ: plan.Type{Id: int32(types.T_int64), NotNullable: true}},
+			{Name: "name", Typ: plan.Type{Id: int32(types.T_varchar)}},
+			{Name: "price", Typ: plan.Type{Id: int32(types.T_decimal128), Width: 20, Scale: 2}},
+			{Name: "day", Typ: plan.Type{Id: int32(types.T_date)}},
+		},
+	}
+	var err error
+	ep.parquet, err = newParquetWriter(ctx, ep)
+	require.NoError(t, err)
+
+	bat := batch.NewWithSize(4)
+	bat.Vecs[0] = vector.NewVec(types.T_int64.ToType())
+	bat.Vecs[1] = vector.NewVec(types.T_varchar.ToType())
+	bat.Vecs[2] = vector.NewVec(types.New(types.T_decimal128, 20, 2))
+	bat.Vecs[3] = vector.NewVec(types.T_date.ToType())
+	defer bat.Clean(mp)
+	day, err := types.ParseDateCast("2024-01-02")
+	require.NoError(t, err)
+	for i := 0; i < 5; i++ {
+		require.NoError(t, vector.AppendFixed(bat.Vecs[0], int64(i), false, mp))
+		require.NoError(t, vector.AppendBytes(bat.Vecs[1], []byte{'a' + byte(i)}, i == 3, mp))
+		require.NoError(t, vector.AppendFixed(bat.Vecs[2], types.Decimal128{B0_63: uint64(i * 100), B64_127: 0}, false, mp))
+		require.NoError(t, vector.AppendFixed(bat.Vecs[3], day+types.Date(i), false, mp))
+	}
+	bat.SetRowCount(5)
+
+	require.NoError(t, writeToParquetFile(ep, bat))
+	require.Equal(t, uint64(5), ep.Rows)
+	require.NoError(t, ep.parquet.close())
+
+	f, err := parquet.OpenFile(bytes.NewReader(file.Bytes()), int64(file.Len()))
+	require.NoError(t, err)
+	require.Equal(t, int64(5), f.NumRows())
+	require.Len(t, f.RowGroups(), 3)
+	require.False(t, f.Root().Column("id").Optional())
+	require.True(t, f.Root().Column("name").Optional())
+	require.NotNil(t, f.Root().Column("day").Type().LogicalType().Date)
+
+	r := parquet.NewReader(f)
+	rows := make([]parquet.Row, 5)
+	for n := 0; n < len(rows); {
+		cnt, err := r.ReadRows(rows[n:])
+		for i := n; i < n+cnt; i++ {
+			rows[i] = rows[i].Clone()
+		}
+		n += cnt
+		if err == io.EOF {
+			require.Equal(t, len(rows), n)
+			break
+		}
+		require.NoError(t, err)
+	}
+	id, _ := f.Schema().Lookup("id")
+	name, _ := f.Schema().Lookup("name")
+	dayCol, _ := f.Schema().Lookup("day")
+	for i, row := range rows {
+		require.Equal(t, int64(i), row[id.ColumnIndex].Int64())
+		if i == 3 {
+			require.True(t, row[name.ColumnIndex].IsNull())
+		} else {
+			require.Equal(t, string([]byte{'a' + byte(i)}), string(row[name.ColumnIndex].ByteArray()))
+		}
+		require.Equal(t, (day + types.Date(i)).DaysSinceUnixEpoch(), row[dayCol.ColumnIndex].Int32())
+	}
+}
+
+func Test_newParquetWriterErrors(t *testing.T) {
+	ctx := context.TODO()
+	ep := &ExportConfig{
+		userConfig: &tree.ExportParam{FileFormat: tree.PARQUET},
+		colDefs: []*plan.ColDef{
+			{Name: "a", Typ: plan.Type{Id: int32(types.T_int64)}},
+			{Name: "a", Typ: plan.Type{Id: int32(types.T_int64)}},
+		},
+	}
+	_, err := newParquetWriter(ctx, ep)
+	require.Error(t, err)
+
+	ep.colDefs = []*plan.ColDef{
+		{Name: "r", Typ: plan.Type{Id: int32(types.T_Rowid)}},
+	}
+	_, err = newParquetWriter(ctx, ep)
+	require.Error(t, err)
+}
@@ -16,6 +16,7 @@ package frontend
 
 import (
 	"context"
+	"errors"
 	planPb "github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"math"
 	"sync"
@@ -177,6 +178,43 @@ func (ie *internalExecutor) Query(ctx context.Context, sql string, opts ie.Sessi
 	return res
 }
 
+// ExecTxn begins a txn in a new session, execs the sqls of execFunc in it,
+// then commits the txn, or rolls it back if execFunc returns an error.
+func (ie *internalExecutor) ExecTxn(ctx context.Context, execFunc func(ie.TxnExecutor) error, opts ie.SessionOverrideOptions) (err error) {
+	ie.Lock()
+	defer ie.Unlock()
+	var cancel context.CancelFunc
+	ctx, cancel = context.WithTimeout(ctx, getGlobalPu().SV.SessionTimeout.Duration)
+	defer cancel()
+	sess := ie.newCmdSession(ctx, opts)
+	defer sess.Close()
+	sess.EnterFPrint(FPInternalExecutorExecTxn)
+	defer sess.ExitFPrint(FPInternalExecutorExecTxn)
+	ie.proto.stashResult = false
+	txnExec := &internalTxnExecutor{ses: sess}
+	if err = txnExec.Exec(ctx, "begin"); err != nil {
+		return err
+	}
+	if err = execFunc(txnExec); err != nil {
+		return errors.Join(err, txnExec.Exec(ctx, "rollback"))
+	}
+	return txnExec.Exec(ctx, "commit")
+}
+
+// internalTxnExecutor execs the sqls in the txn of the session of ExecTxn.
+type internalTxnExecutor struct {
+	ses *Session
+}
+
+func (te *internalTxnExecutor) Exec(ctx context.Context, sql string) error {
+	tempExecCtx := ExecCtx{
+		reqCtx: ctx,
+		ses:    te.ses,
+	}
+	defer tempExecCtx.Close()
+	return doComQuery(te.ses, &tempExecCtx, &UserInput{sql: sql})
+}
+
 func (ie *internalExecutor) newCmdSession(ctx context.Context, opts ie.SessionOverrideOptions) *Session {
 	// Use the Mid configuration for session. We can make Mid a configuration
 	// param, or, compute from GuestMmuLimitation.   Lazy.
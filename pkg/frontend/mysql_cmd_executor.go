@@ -748,6 +748,52 @@ func handleSetVar(ses FeSession, execCtx *ExecCtx, sv *tree.SetVar, sql string)
 	return nil
 }
 
+// isolationLevelValues maps the isolation levels to the values of the
+// transaction_isolation variable.
+var isolationLevelValues = map[tree.IsolationLevelType]string{
+	tree.ISOLATION_LEVEL_REPEATABLE_READ:  "REPEATABLE-READ",
+	tree.ISOLATION_LEVEL_READ_COMMITTED:   "READ-COMMITTED",
+	tree.ISOLATION_LEVEL_READ_UNCOMMITTED: "READ-UNCOMMITTED",
+	tree.ISOLATION_LEVEL_SERIALIZABLE:     "SERIALIZABLE",
+}
+
+/*
+handle set transaction isolation level, the level is saved into the variable
+transaction_isolation and tx_isolation. The access mode is ignored.
+*/
+func doSetTransaction(ses *Session, execCtx *ExecCtx, st *tree.SetTransaction) error {
+	for _, c := range st.CharacterList {
+		if !c.IsLevel {
+			continue
+		}
+		level, ok := isolationLevelValues[c.Isolation]
+		if !ok {
+			return moerr.NewInternalErrorf(execCtx.reqCtx, "unsupported %s", c.Isolation.String())
+		}
+		if st.Global {
+			if err := doCheckRole(execCtx.reqCtx, ses); err != nil {
+				return err
+			}
+		}
+		for _, name := range []string{"transaction_isolation", "tx_isolation"} {
+			var err error
+			if st.Global {
+				err = ses.SetGlobalSysVar(execCtx.reqCtx, name, level)
+			} else {
+				err = ses.SetSessionSysVar(execCtx.reqCtx, name, level)
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func handleSetTransaction(ses FeSession, execCtx *ExecCtx, st *tree.SetTransaction) error {
+	return doSetTransaction(ses.(*Session), execCtx, st)
+}
+
 func doShowErrors(ses *Session, execCtx *ExecCtx) error {
 
 	levelCol := new(MysqlColumn)
@@ -1211,6 +1257,7 @@ func handleCreateAccount(ses FeSession, execCtx *ExecCtx, ca *tree.CreateAccount
 	create := &createAccount{
 		IfNotExists:  ca.IfNotExists,
 		IdentTyp:     ca.AuthOption.IdentifiedType.Typ,
+		AuthPlugin:   ca.AuthOption.IdentifiedType.Plugin,
 		StatusOption: ca.StatusOption,
 		Comment:      ca.Comment,
 	}
@@ -1264,6 +1311,7 @@ func handleAlterAccount(ses FeSession, execCtx *ExecCtx, st *tree.AlterAccount,
 		aa.AuthExist = true
 		aa.AdminName = b.bind(st.AuthOption.AdminName)
 		aa.IdentTyp = st.AuthOption.IdentifiedType.Typ
+		aa.AuthPlugin = st.AuthOption.IdentifiedType.Plugin
 		aa.IdentStr = b.bindIdentStr(&st.AuthOption.IdentifiedType)
 	}
 	if b.err != nil {
@@ -1303,6 +1351,7 @@ func handleCreateUser(ses FeSession, execCtx *ExecCtx, st *tree.CreateUser) erro
 		if u.AuthOption != nil {
 			v.AuthExist = true
 			v.IdentTyp = u.AuthOption.Typ
+			v.AuthPlugin = u.AuthOption.Plugin
 			switch v.IdentTyp {
 			case tree.AccountIdentifiedByPassword,
 				tree.AccountIdentifiedWithSSL:
@@ -1343,6 +1392,7 @@ func handleAlterUser(ses FeSession, execCtx *ExecCtx, st *tree.AlterUser) error
 		if su.AuthOption != nil {
 			u.AuthExist = true
 			u.IdentTyp = su.AuthOption.Typ
+			u.AuthPlugin = su.AuthOption.Plugin
 			switch u.IdentTyp {
 			case tree.AccountIdentifiedByPassword,
 				tree.AccountIdentifiedWithSSL:
@@ -2603,6 +2653,10 @@ func executeStmt(ses *Session,
 			defer func() {
 				ses.ClearExportParam()
 			}()
+			err = checkExportFormat(execCtx.reqCtx, st.Ep)
+			if err != nil {
+				return
+			}
 			err = doCheckFilePath(execCtx.reqCtx, ses, st.Ep)
 			if err != nil {
 				return
@@ -3008,6 +3062,12 @@ func ExecRequest(ses *Session, execCtx *ExecCtx, req *Request) (resp *Response,
 
 	var sql string
 	ses.Debugf(execCtx.reqCtx, "cmd %v", req.GetCmd())
+	if req.GetCmd() != COM_STMT_FETCH {
+		if err = ses.settleCursors(execCtx.reqCtx, req); err != nil {
+			resp = NewGeneralErrorResponse(req.GetCmd(), ses.GetTxnHandler().GetServerStatus(), err)
+			return resp, nil
+		}
+	}
 	ses.SetCmd(req.GetCmd())
 	switch req.GetCmd() {
 	case COM_QUIT:
@@ -3076,15 +3136,22 @@ func ExecRequest(ses *Session, execCtx *ExecCtx, req *Request) (resp *Response,
 		if err != nil {
 			return NewGeneralErrorResponse(COM_STMT_EXECUTE, ses.GetTxnHandler().GetServerStatus(), err), nil
 		}
-		err = doComQuery(ses, execCtx, &UserInput{sql: sql})
+		if canOpenCursor(prepareStmt) {
+			// the params are reset after the statement of the cursor ends
+			err = openCursor(ses, execCtx, prepareStmt, sql)
+		} else {
+			err = doComQuery(ses, execCtx, &UserInput{sql: sql})
+			resetPrepareStmtParams(prepareStmt)
+		}
 		if err != nil {
 			resp = NewGeneralErrorResponse(COM_STMT_EXECUTE, ses.GetTxnHandler().GetServerStatus(), err)
 		}
-		if prepareStmt.params != nil {
-			prepareStmt.params.GetNulls().Reset()
-			for k := range prepareStmt.getFromSendLongData {
-				delete(prepareStmt.getFromSendLongData, k)
-			}
+		return resp, nil
+
+	case COM_STMT_FETCH:
+		err = handleStmtFetch(ses, execCtx, req.GetData().([]byte))
+		if err != nil {
+			resp = NewGeneralErrorResponse(COM_STMT_FETCH, ses.GetTxnHandler().GetServerStatus(), err)
 		}
 		return resp, nil
 
@@ -3153,6 +3220,16 @@ func ExecRequest(ses *Session, execCtx *ExecCtx, req *Request) (resp *Response,
 	return resp, nil
 }
 
+// resetPrepareStmtParams clears the params of the prepared statement after it is executed.
+func resetPrepareStmtParams(prepareStmt *PrepareStmt) {
+	if prepareStmt.params != nil {
+		prepareStmt.params.GetNulls().Reset()
+		for k := range prepareStmt.getFromSendLongData {
+			delete(prepareStmt.getFromSendLongData, k)
+		}
+	}
+}
+
 func parseStmtExecute(reqCtx context.Context, ses *Session, data []byte) (string, *PrepareStmt, error) {
 	// see https://dev.mysql.com/doc/dev/mysql-server/latest/page_protocol_com_stmt_execute.html
 	pos := 0
@@ -0,0 +1,314 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"compress/zlib"
+	"io"
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+const (
+	// compressedHeaderLength is the length of the header of the compressed packet.
+	compressedHeaderLength = 7
+	// minCompressLength is the minimal length of the payload to compress.
+	// The smaller payload is sent uncompressed like mysql.
+	minCompressLength = 50
+	// defaultZlibCompressionLevel is the compression level of zlib that mysql uses.
+	defaultZlibCompressionLevel = 6
+	// defaultZstdCompressionLevel is the default value of zstd_compression_level of mysql.
+	defaultZstdCompressionLevel = 3
+)
+
+// CompressionAlgorithm is the algorithm of the compressed protocol.
+type CompressionAlgorithm int
+
+const (
+	CompressionNone CompressionAlgorithm = iota
+	CompressionZlib
+	CompressionZstd
+)
+
+func (a CompressionAlgorithm) String() string {
+	switch a {
+	case CompressionZlib:
+		return "zlib"
+	case CompressionZstd:
+		return "zstd"
+	default:
+		return "uncompressed"
+	}
+}
+
+// compressionCapability returns the capabilities of the compressed protocol
+// that the server permits. algorithms is the comma separated list like
+// "zlib,zstd,uncompressed".
+func compressionCapability(algorithms string) uint32 {
+	var capability uint32
+	for _, a := range strings.Split(algorithms, ",") {
+		switch strings.ToLower(strings.TrimSpace(a)) {
+		case "zlib":
+			capability |= CLIENT_COMPRESS
+		case "zstd":
+			capability |= CLIENT_ZSTD_COMPRESSION_ALGORITHM
+		}
+	}
+	return capability
+}
+
+// compressionOfCapability returns the algorithm negotiated by the capabilities.
+func compressionOfCapability(capability uint32) CompressionAlgorithm {
+	if capability&CLIENT_ZSTD_COMPRESSION_ALGORITHM != 0 {
+		return CompressionZstd
+	}
+	if capability&CLIENT_COMPRESS != 0 {
+		return CompressionZlib
+	}
+	return CompressionNone
+}
+
+// CompressedConn implements the compressed packet framing of the mysql protocol
+// on the connection. The data read from and written to it are the uncompressed
+// mysql packets.
+//
+// see https://dev.mysql.com/doc/dev/mysql-server/latest/page_protocol_basic_compression.html
+type CompressedConn struct {
+	net.Conn
+	algorithm CompressionAlgorithm
+	level     int
+
+	mu struct {
+		sync.Mutex
+		// sequenceId is the sequence id of the next compressed packet. It
+		// is different from the one of the mysql packet, and it is reset by
+		// the compressed packet from the client.
+		sequenceId uint8
+	}
+
+	// the fields below are used by Read.
+	header [compressedHeaderLength]byte
+	// readBuf holds the uncompressed data that has not been read.
+	readBuf      []byte
+	compressed   []byte
+	uncompressed []byte
+	zlibReader   io.ReadCloser
+	zstdDecoder  *zstd.Decoder
+
+	// writeMu serializes the writes, as the connection may be written by
+	// several goroutines, e.g. the proxy. The fields below are used by Write
+	// and protected by it.
+	writeMu     sync.Mutex
+	writeBuf    []byte
+	zlibWriter  *zlib.Writer
+	zlibBuf     bytes.Buffer
+	zstdEncoder *zstd.Encoder
+	zstdBuf     []byte
+}
+
+// NewCompressedConn creates the compressed connection. The default level of
+// the algorithm is used if level is 0.
+func NewCompressedConn(conn net.Conn, algorithm CompressionAlgorithm, level int) (*CompressedConn, error) {
+	c := &CompressedConn{
+		Conn:      conn,
+		algorithm: algorithm,
+		level:     level,
+	}
+	var err error
+	switch algorithm {
+	case CompressionZlib:
+		if c.level == 0 {
+			c.level = defaultZlibCompressionLevel
+		}
+		if c.zlibWriter, err = zlib.NewWriterLevel(&c.zlibBuf, c.level); err != nil {
+			return nil, err
+		}
+	case CompressionZstd:
+		if c.level == 0 {
+			c.level = defaultZstdCompressionLevel
+		}
+		c.zstdEncoder, err = zstd.NewWriter(nil,
+			zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(c.level)),
+			zstd.WithEncoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+		c.zstdDecoder, err = zstd.NewReader(nil,
+			zstd.WithDecoderConcurrency(1),
+			zstd.WithDecoderMaxMemory(uint64(MaxPayloadSize)))
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, moerr.NewInternalErrorNoCtxf("invalid compression algorithm %d", algorithm)
+	}
+	return c, nil
+}
+
+// Algorithm returns the compression algorithm of the connection.
+func (c *CompressedConn) Algorithm() CompressionAlgorithm {
+	return c.algorithm
+}
+
+// Read reads the uncompressed data.
+func (c *CompressedConn) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	for len(c.readBuf) == 0 {
+		if err := c.readPacket(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, c.readBuf)
+	c.readBuf = c.readBuf[n:]
+	return n, nil
+}
+
+// readPacket reads a compressed packet and decompresses it into the readBuf.
+func (c *CompressedConn) readPacket() error {
+	if _, err := io.ReadFull(c.Conn, c.header[:]); err != nil {
+		return err
+	}
+	compressedLength := int(uint32(c.header[0]) | uint32(c.header[1])<<8 | uint32(c.header[2])<<16)
+	c.mu.Lock()
+	c.mu.sequenceId = c.header[3] + 1
+	c.mu.Unlock()
+	uncompressedLength := int(uint32(c.header[4]) | uint32(c.header[5])<<8 | uint32(c.header[6])<<16)
+
+	if cap(c.compressed) < compressedLength {
+		c.compressed = make([]byte, compressedLength)
+	}
+	payload := c.compressed[:compressedLength]
+	if _, err := io.ReadFull(c.Conn, payload); err != nil {
+		return err
+	}
+
+	// the payload is not compressed.
+	if uncompressedLength == 0 {
+		c.readBuf = payload
+		return nil
+	}
+
+	var err error
+	c.uncompressed = c.uncompressed[:0]
+	switch c.algorithm {
+	case CompressionZlib:
+		if c.zlibReader == nil {
+			c.zlibReader, err = zlib.NewReader(bytes.NewReader(payload))
+		} else {
+			err = c.zlibReader.(zlib.Resetter).Reset(bytes.NewReader(payload), nil)
+		}
+		if err != nil {
+			return err
+		}
+		buf := bytes.NewBuffer(c.uncompressed)
+		buf.Grow(uncompressedLength)
+		// read one more byte to find out the payload longer than the header says.
+		if _, err = buf.ReadFrom(io.LimitReader(c.zlibReader, int64(uncompressedLength)+1)); err != nil {
+			return err
+		}
+		c.uncompressed = buf.Bytes()
+	case CompressionZstd:
+		if c.uncompressed, err = c.zstdDecoder.DecodeAll(payload, c.uncompressed); err != nil {
+			return err
+		}
+	}
+	if len(c.uncompressed) != uncompressedLength {
+		return moerr.NewInternalErrorNoCtxf("the length of the uncompressed payload %d is not %d",
+			len(c.uncompressed), uncompressedLength)
+	}
+	c.readBuf = c.uncompressed
+	return nil
+}
+
+// Write compresses the data and writes it in the compressed packets. It is
+// safe to call it concurrently, the data of a call is not interleaved with
+// the others.
+func (c *CompressedConn) Write(p []byte) (int, error) {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	written := 0
+	for written < len(p) {
+		n := Min(len(p)-written, int(MaxPayloadSize))
+		if err := c.writePacket(p[written : written+n]); err != nil {
+			return written, err
+		}
+		written += n
+	}
+	return written, nil
+}
+
+// writePacket writes the data in a compressed packet.
+func (c *CompressedConn) writePacket(data []byte) error {
+	payload := data
+	uncompressedLength := 0
+	if len(data) >= minCompressLength {
+		compressed, err := c.compress(data)
+		if err != nil {
+			return err
+		}
+		// send the data uncompressed if the compression does not help.
+		if len(compressed) < len(data) {
+			payload = compressed
+			uncompressedLength = len(data)
+		}
+	}
+
+	c.mu.Lock()
+	sequenceId := c.mu.sequenceId
+	c.mu.sequenceId++
+	c.mu.Unlock()
+
+	packet := append(c.writeBuf[:0],
+		byte(len(payload)), byte(len(payload)>>8), byte(len(payload)>>16),
+		sequenceId,
+		byte(uncompressedLength), byte(uncompressedLength>>8), byte(uncompressedLength>>16))
+	packet = append(packet, payload...)
+	c.writeBuf = packet
+
+	for sent := 0; sent < len(packet); {
+		n, err := c.Conn.Write(packet[sent:])
+		if err != nil {
+			return err
+		}
+		sent += n
+	}
+	return nil
+}
+
+func (c *CompressedConn) compress(data []byte) ([]byte, error) {
+	switch c.algorithm {
+	case CompressionZlib:
+		c.zlibBuf.Reset()
+		c.zlibWriter.Reset(&c.zlibBuf)
+		if _, err := c.zlibWriter.Write(data); err != nil {
+			return nil, err
+		}
+		if err := c.zlibWriter.Close(); err != nil {
+			return nil, err
+		}
+		return c.zlibBuf.Bytes(), nil
+	default:
+		c.zstdBuf = c.zstdEncoder.EncodeAll(data, c.zstdBuf[:0])
+		return c.zstdBuf, nil
+	}
+}
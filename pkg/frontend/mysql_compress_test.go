@@ -0,0 +1,145 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompressionCapability(t *testing.T) {
+	require.Equal(t, uint32(0), compressionCapability(""))
+	require.Equal(t, uint32(0), compressionCapability("uncompressed"))
+	require.Equal(t, CLIENT_COMPRESS, compressionCapability("zlib"))
+	require.Equal(t, CLIENT_COMPRESS|CLIENT_ZSTD_COMPRESSION_ALGORITHM, compressionCapability(" ZSTD, zlib,uncompressed"))
+
+	require.Equal(t, CompressionNone, compressionOfCapability(CLIENT_PROTOCOL_41))
+	require.Equal(t, CompressionZlib, compressionOfCapability(CLIENT_COMPRESS))
+	require.Equal(t, CompressionZstd, compressionOfCapability(CLIENT_COMPRESS|CLIENT_ZSTD_COMPRESSION_ALGORITHM))
+}
+
+func TestCompressedConn(t *testing.T) {
+	small := []byte("select 1")
+	large := bytes.Repeat([]byte("matrixone"), 1000)
+
+	for _, algorithm := range []CompressionAlgorithm{CompressionZlib, CompressionZstd} {
+		t.Run(algorithm.String(), func(t *testing.T) {
+			client, server := net.Pipe()
+			defer client.Close()
+			defer server.Close()
+			sc, err := NewCompressedConn(server, algorithm, 0)
+			require.NoError(t, err)
+
+			// the client sends a command in the compressed packet 0.
+			go func() {
+				_, _ = client.Write([]byte{byte(len(small)), 0, 0, 0, 0, 0, 0})
+				_, _ = client.Write(small)
+			}()
+			buf := make([]byte, len(small))
+			_, err = io.ReadFull(sc, buf)
+			require.NoError(t, err)
+			require.Equal(t, small, buf)
+
+			// the response is compressed, and its sequence id follows the command.
+			cc, err := NewCompressedConn(client, algorithm, 0)
+			require.NoError(t, err)
+			go func() {
+				_, _ = sc.Write(small)
+				_, _ = sc.Write(large)
+			}()
+			header := make([]byte, compressedHeaderLength)
+			_, err = io.ReadFull(client, header)
+			require.NoError(t, err)
+			require.Equal(t, []byte{byte(len(small)), 0, 0, 1, 0, 0, 0}, header)
+			_, err = io.ReadFull(client, buf)
+			require.NoError(t, err)
+			require.Equal(t, small, buf)
+
+			buf = make([]byte, len(large))
+			_, err = io.ReadFull(cc, buf)
+			require.NoError(t, err)
+			require.Equal(t, large, buf)
+			require.Less(t, len(cc.compressed), len(large))
+		})
+	}
+}
+
+func TestCompressedConnLargePayload(t *testing.T) {
+	client, server := net.Pipe()
+	defer client.Close()
+	defer server.Close()
+	sc, err := NewCompressedConn(server, CompressionZstd, 1)
+	require.NoError(t, err)
+	cc, err := NewCompressedConn(client, CompressionZstd, 1)
+	require.NoError(t, err)
+
+	// the payload is split into the compressed packets of MaxPayloadSize.
+	data := bytes.Repeat([]byte{1, 2, 3, 4}, int(MaxPayloadSize)/2)
+	go func() {
+		_, _ = sc.Write(data)
+	}()
+	buf := make([]byte, len(data))
+	_, err = io.ReadFull(cc, buf)
+	require.NoError(t, err)
+	require.Equal(t, data, buf)
+	require.Equal(t, uint8(2), cc.mu.sequenceId)
+}
+
+func TestCompressedConnConcurrentWrite(t *testing.T) {
+	for _, algorithm := range []CompressionAlgorithm{CompressionZlib, CompressionZstd} {
+		t.Run(algorithm.String(), func(t *testing.T) {
+			client, server := net.Pipe()
+			defer client.Close()
+			defer server.Close()
+			sc, err := NewCompressedConn(server, algorithm, 0)
+			require.NoError(t, err)
+			cc, err := NewCompressedConn(client, algorithm, 0)
+			require.NoError(t, err)
+
+			// every writer writes the messages filled with its own byte.
+			const writers, messages, size = 8, 20, 4096
+			var wg sync.WaitGroup
+			for i := 0; i < writers; i++ {
+				wg.Add(1)
+				go func(b byte) {
+					defer wg.Done()
+					data := bytes.Repeat([]byte{b}, size)
+					for j := 0; j < messages; j++ {
+						_, _ = sc.Write(data)
+					}
+				}(byte(i + 1))
+			}
+
+			counts := make(map[byte]int)
+			buf := make([]byte, size)
+			for i := 0; i < writers*messages; i++ {
+				_, err = io.ReadFull(cc, buf)
+				require.NoError(t, err)
+				require.Equal(t, bytes.Repeat(buf[:1], size), buf)
+				counts[buf[0]]++
+			}
+			wg.Wait()
+			for i := 0; i < writers; i++ {
+				require.Equal(t, messages, counts[byte(i+1)])
+			}
+			require.Equal(t, uint8(writers*messages), cc.mu.sequenceId)
+		})
+	}
+}
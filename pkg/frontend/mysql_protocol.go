@@ -152,7 +152,8 @@ const (
 
 	Utf8mb4CollationID uint8 = 45
 
-	AuthNativePassword string = "mysql_native_password"
+	AuthNativePassword      string = "mysql_native_password"
+	AuthCachingSha2Password string = "caching_sha2_password"
 
 	//the length of the mysql protocol header
 	HeaderLengthOfTheProtocol int = 4
@@ -250,6 +251,9 @@ type MysqlProtocolImpl struct {
 	// indicated by the plugin name field.
 	authResponse []byte
 
+	// the authentication method used by the client to generate authResponse.
+	authPlugin string
+
 	//the default database for the client
 	database string
 
@@ -257,6 +261,9 @@ type MysqlProtocolImpl struct {
 	// can pass to the server at connect time.
 	connectAttrs map[string]string
 
+	// the compression level of zstd requested by the client
+	zstdCompressionLevel uint8
+
 	//for debug
 	debugStats
 
@@ -275,7 +282,8 @@ type MysqlProtocolImpl struct {
 
 	// authString is the authentication string which is stored in mysql.user
 	// table. It is cached here to send it proxy when proxy tries to reuse
-	// a connection and do the authentication.
+	// a connection and do the authentication. For caching_sha2_password, it
+	// is SHA256(SHA256(password)).
 	authString []byte
 }
 
@@ -621,6 +629,8 @@ type response41 struct {
 	clientPluginName  string
 	isAskForTlsHeader bool
 	connectAttrs      map[string]string
+	// zstdCompressionLevel is set if CLIENT_ZSTD_COMPRESSION_ALGORITHM is set
+	zstdCompressionLevel uint8
 }
 
 // handshake response 320
@@ -775,10 +785,11 @@ func (mp *MysqlProtocolImpl) ParseExecuteData(ctx context.Context, proc *process
 		return moerr.NewInternalError(ctx, "malform packet")
 
 	}
-	if flag != 0 {
-		// TODO only support CURSOR_TYPE_NO_CURSOR flag now
+	if flag&^CURSOR_TYPE_READ_ONLY != 0 {
+		// only support CURSOR_TYPE_NO_CURSOR and CURSOR_TYPE_READ_ONLY flag now
 		return moerr.NewInvalidInputf(ctx, "unsupported Prepare flag '%v'", flag)
 	}
+	stmt.cursorType = flag
 
 	// skip iteration-count, always 1
 	pos += 4
@@ -1403,24 +1414,38 @@ func (mp *MysqlProtocolImpl) authenticateUser(ctx context.Context, authResponse
 	ses := mp.GetSession()
 	if !mp.SV.SkipCheckUser {
 		ses.Debugf(ctx, "authenticate user 1")
-		psw, err = ses.AuthenticateUser(ctx, mp.GetUserName(), mp.GetDatabaseName(), mp.authResponse, mp.GetSalt(), CheckPassword)
+		psw, err = ses.AuthenticateUser(ctx, mp.GetUserName(), mp.GetDatabaseName(), mp.authResponse, mp.GetSalt(),
+			func(pwd, salt, auth []byte) bool {
+				ok, err := mp.checkPassword(ctx, pwd, salt, auth)
+				if err != nil {
+					ses.Errorf(ctx, "check password failed. error:%v", err)
+				}
+				return ok
+			})
 		if err != nil {
 			return err
 		}
 
-		// update the authString field. It will be sent to proxy to help it do authentication.
-		mp.authString = psw
-
 		ses.Debugf(ctx, "authenticate user 2")
 
-		//TO Check password
-		if CheckPassword(psw, mp.GetSalt(), authResponse) {
-			ses.Debugf(ctx, "check password succeeded")
-			if err = ses.InitSystemVariables(ctx); err != nil {
+		// the password of caching_sha2_password has been checked in AuthenticateUser,
+		// and the authString has been updated.
+		if !isSha2PassWord(psw) {
+			// update the authString field. It will be sent to proxy to help it do authentication.
+			mp.authString = psw
+
+			//TO Check password
+			ok, err := mp.checkPassword(ctx, psw, mp.GetSalt(), mp.authResponse)
+			if err != nil {
 				return err
 			}
-		} else {
-			return moerr.NewInternalError(ctx, "check password failed")
+			if !ok {
+				return moerr.NewInternalError(ctx, "check password failed")
+			}
+		}
+		ses.Debugf(ctx, "check password succeeded")
+		if err = ses.InitSystemVariables(ctx); err != nil {
+			return err
 		}
 	} else {
 		ses.Debugf(ctx, "skip authenticate user")
@@ -1444,6 +1469,35 @@ func (mp *MysqlProtocolImpl) authenticateUser(ctx context.Context, authResponse
 	return nil
 }
 
+// checkPassword checks the authentication with the authentication method of
+// the password stored. The client is asked to switch to that method if it
+// is not the one used in the handshake response.
+func (mp *MysqlProtocolImpl) checkPassword(ctx context.Context, pwd, salt, auth []byte) (bool, error) {
+	var err error
+	plugin := AuthNativePassword
+	if isSha2PassWord(pwd) {
+		plugin = AuthCachingSha2Password
+	}
+	current := mp.authPlugin
+	if current == "" {
+		current = AuthNativePassword
+	}
+	if plugin != current {
+		if mp.capability&CLIENT_PLUGIN_AUTH == 0 {
+			return false, moerr.NewInternalErrorf(ctx, "the client does not support the authentication method %s", plugin)
+		}
+		if auth, err = mp.negotiateAuthenticationMethod(ctx, plugin); err != nil {
+			return false, err
+		}
+		mp.authResponse = auth
+		mp.authPlugin = plugin
+	}
+	if plugin == AuthCachingSha2Password {
+		return mp.checkSha2Password(ctx, pwd, salt, auth)
+	}
+	return CheckPassword(pwd, salt, auth), nil
+}
+
 func (mp *MysqlProtocolImpl) HandleHandshake(ctx context.Context, payload []byte) (bool, error) {
 	var err error
 	if len(payload) < 2 {
@@ -1466,6 +1520,10 @@ func (mp *MysqlProtocolImpl) HandleHandshake(ctx context.Context, payload []byte
 		}
 
 		mp.authResponse = resp41.authResponse
+		mp.authPlugin = resp41.clientPluginName
+		if mp.authPlugin == "" {
+			mp.authPlugin = AuthNativePassword
+		}
 		mp.capability = mp.capability & resp41.capabilities
 
 		if nameAndCharset, ok3 := collationID2CharsetAndName[int(resp41.collationID)]; !ok3 {
@@ -1480,6 +1538,7 @@ func (mp *MysqlProtocolImpl) HandleHandshake(ctx context.Context, payload []byte
 		mp.username = resp41.username
 		mp.database = resp41.database
 		mp.connectAttrs = resp41.connectAttrs
+		mp.zstdCompressionLevel = resp41.zstdCompressionLevel
 	} else {
 		var resp320 response320
 		var ok2 bool
@@ -1493,6 +1552,7 @@ func (mp *MysqlProtocolImpl) HandleHandshake(ctx context.Context, payload []byte
 		}
 
 		mp.authResponse = resp320.authResponse
+		mp.authPlugin = AuthNativePassword
 		mp.capability = mp.capability & resp320.capabilities
 		mp.collationID = int(Utf8mb4CollationID)
 		mp.collationName = "utf8mb4_general_ci"
@@ -1542,9 +1602,35 @@ func (mp *MysqlProtocolImpl) Authenticate(ctx context.Context) error {
 		return err
 	}
 	mp.tcpConn.allowedPacketSize = int(allowedPacketSize.(int64))
+	// the packets after the OK packet are compressed.
+	return mp.enableCompression()
+}
+
+// enableCompression switches the connection to the compressed protocol if
+// the client and the server agree on a compression algorithm.
+func (mp *MysqlProtocolImpl) enableCompression() error {
+	conn, err := mp.NewCompressedConn(mp.tcpConn.RawConn())
+	if err != nil || conn == nil {
+		return err
+	}
+	mp.UseConn(conn)
 	return nil
 }
 
+// NewCompressedConn wraps the conn with the compressed protocol negotiated
+// in the handshake. It returns nil if the compression is not used.
+func (mp *MysqlProtocolImpl) NewCompressedConn(conn net.Conn) (net.Conn, error) {
+	algorithm := compressionOfCapability(mp.capability)
+	if algorithm == CompressionNone {
+		return nil, nil
+	}
+	level := mp.SV.ProtocolCompressionLevel
+	if level == 0 && algorithm == CompressionZstd {
+		level = int(mp.zstdCompressionLevel)
+	}
+	return NewCompressedConn(conn, algorithm, level)
+}
+
 // the server makes a handshake v10 packet
 // return handshake packet
 func (mp *MysqlProtocolImpl) makeHandshakeV10Payload() []byte {
@@ -1574,7 +1660,7 @@ func (mp *MysqlProtocolImpl) makeHandshakeV10Payload() []byte {
 	pos = mp.io.WriteUint16(data, pos, DefaultClientConnStatus)
 
 	//int<2>              capabilities flags (upper 2 bytes)
-	pos = mp.io.WriteUint16(data, pos, uint16((DefaultCapability>>16)&0xFFFF))
+	pos = mp.io.WriteUint16(data, pos, uint16((mp.capability>>16)&0xFFFF))
 
 	if (DefaultCapability & CLIENT_PLUGIN_AUTH) != 0 {
 		//int<1>              length of auth-plugin-data
@@ -1707,9 +1793,9 @@ func (mp *MysqlProtocolImpl) analyseHandshakeResponse41(ctx context.Context, dat
 		}
 
 		//to switch authenticate method
-		if info.clientPluginName != AuthNativePassword {
+		if info.clientPluginName != AuthNativePassword && info.clientPluginName != AuthCachingSha2Password {
 			var err error
-			if info.authResponse, err = mp.negotiateAuthenticationMethod(ctx); err != nil {
+			if info.authResponse, err = mp.negotiateAuthenticationMethod(ctx, AuthNativePassword); err != nil {
 				return false, info, moerr.NewInternalErrorf(ctx, "negotiate authentication method failed. error:%v", err)
 			}
 			info.clientPluginName = AuthNativePassword
@@ -1740,6 +1826,14 @@ func (mp *MysqlProtocolImpl) analyseHandshakeResponse41(ctx context.Context, dat
 		}
 	}
 
+	//int<1>             zstd compression level
+	if info.capabilities&CLIENT_ZSTD_COMPRESSION_ALGORITHM != 0 {
+		info.zstdCompressionLevel, _, ok = mp.io.ReadUint8(data, pos)
+		if !ok {
+			return false, info, moerr.NewInternalError(ctx, "get zstd compression level failed")
+		}
+	}
+
 	return true, info, nil
 }
 
@@ -1880,9 +1974,9 @@ func (mp *MysqlProtocolImpl) makeAuthSwitchRequestPayload(authMethodName string)
 // the server can send AuthSwitchRequest to ask client to use designated authentication method,
 // if both server and client support CLIENT_PLUGIN_AUTH capability.
 // return data authenticated with new method
-func (mp *MysqlProtocolImpl) negotiateAuthenticationMethod(ctx context.Context) ([]byte, error) {
+func (mp *MysqlProtocolImpl) negotiateAuthenticationMethod(ctx context.Context, authMethodName string) ([]byte, error) {
 	var err error
-	aswPkt := mp.makeAuthSwitchRequestPayload(AuthNativePassword)
+	aswPkt := mp.makeAuthSwitchRequestPayload(authMethodName)
 	err = mp.writePackets(aswPkt)
 	if err != nil {
 		return nil, err
@@ -2697,8 +2791,8 @@ func (mp *MysqlProtocolImpl) WriteResultSetRow(mrs *MysqlResultSet, cnt uint64)
 	defer mp.m.Unlock()
 	var err error = nil
 
-	// XXX now we known COM_QUERY will use textRow, COM_STMT_EXECUTE use binaryRow
-	useBinaryRow := cmd == COM_STMT_EXECUTE
+	// XXX now we known COM_QUERY will use textRow, COM_STMT_EXECUTE and COM_STMT_FETCH use binaryRow
+	useBinaryRow := cmd == COM_STMT_EXECUTE || cmd == COM_STMT_FETCH
 
 	//make rows into the batch
 	for i := uint64(0); i < cnt; i++ {
@@ -3070,6 +3164,7 @@ func NewMysqlClientProtocol(sid string, connectionID uint32, tcp *Conn, maxBytes
 	if SV.EnableTls {
 		mysql.capability = mysql.capability | CLIENT_SSL
 	}
+	mysql.capability |= compressionCapability(SV.ProtocolCompressionAlgorithms)
 
 	return mysql
 }
@@ -3110,7 +3205,11 @@ func HashPassWordWithByte(pwd []byte) string {
 
 // GetPassWord is used to get hash byte password
 // SHA1(SHA1(password))
+// The authentication string of caching_sha2_password is returned as it is.
 func GetPassWord(pwd string) ([]byte, error) {
+	if isSha2PassWord([]byte(pwd)) {
+		return []byte(pwd), nil
+	}
 	pwdByte, err := hex.DecodeString(pwd[1:])
 	if err != nil {
 		logutil.Errorf("GetPassWord failed.")
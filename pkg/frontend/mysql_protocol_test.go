@@ -2732,6 +2732,37 @@ func Test_analyse41resp(t *testing.T) {
 			convey.So(ok, convey.ShouldEqual, c.res)
 		}
 	})
+
+	convey.Convey("analyse 41 resp with zstd compression level", t, func() {
+		sv, err := getSystemVariables("test/system_vars_config.toml")
+		if err != nil {
+			t.Error(err)
+		}
+		pu := config.NewParameterUnit(sv, nil, nil, nil)
+		pu.SV.SkipCheckUser = true
+		setGlobalPu(pu)
+		ioses, err := NewIOSession(serverConn, pu)
+		convey.ShouldBeNil(err)
+		proto := NewMysqlClientProtocol("", 0, ioses, 1024, sv)
+
+		var header [4]byte
+		proto.io.WriteUint32(header[:], 0, CLIENT_PROTOCOL_41|CLIENT_ZSTD_COMPRESSION_ALGORITHM)
+		data := append(header[:], []byte{
+			0, 0, 0, 0,
+			0,
+			0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+			'a', 'b', 'c', 0,
+			'd', 'e', 'f', 0,
+		}...)
+
+		ok, _, _ := proto.analyseHandshakeResponse41(context.TODO(), data)
+		convey.So(ok, convey.ShouldBeFalse)
+
+		ok, resp41, err := proto.analyseHandshakeResponse41(context.TODO(), append(data, 7))
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ok, convey.ShouldBeTrue)
+		convey.So(resp41.zstdCompressionLevel, convey.ShouldEqual, 7)
+	})
 }
 
 func Test_handleHandshake(t *testing.T) {
@@ -181,6 +181,16 @@ func (interpreter *Interpreter) MatchExpr(expr tree.Expr) (tree.Expr, error) {
 	case *tree.IsNotFalseExpr:
 	case *tree.FuncExpr:
 	case *tree.UnresolvedName:
+		// NEW.col and OLD.col of the trigger body are replaced by their values
+		if e.NumParts == 2 {
+			if tblName := strings.ToLower(e.TblName()); tblName == "new" || tblName == "old" {
+				val, err := interpreter.GetSpVar(tblName + "." + e.ColName())
+				if err != nil {
+					return nil, err
+				}
+				return triggerValueExpr(val), nil
+			}
+		}
 		// change column name to var name
 		val, err := interpreter.GetSpVar(e.ColName())
 		if err != nil {
@@ -300,6 +310,31 @@ func (interpreter *Interpreter) ExecuteSp(stmt tree.Statement, dbName string) (e
 	return nil
 }
 
+// ExecuteTrigger executes the body of a procedural trigger for every row, the
+// columns of the row are the variables NEW.col and OLD.col. Unlike the stored
+// procedure, it is executed in the transaction of the triggering statement.
+func (interpreter *Interpreter) ExecuteTrigger(stmt tree.Statement, dbName string, rows []map[string]interface{}) (err error) {
+	interpreter.bh.ClearExecResultSet()
+	err = interpreter.bh.Exec(interpreter.ctx, "use "+dbName)
+	if err != nil {
+		return err
+	}
+
+	// the values attached to the context by interpret are dropped for every
+	// row, otherwise the context chain grows with the rows.
+	ctx := interpreter.ctx
+	for _, row := range rows {
+		*interpreter.varScope = append(*interpreter.varScope, row)
+		_, err = interpreter.interpret(stmt)
+		*interpreter.varScope = (*interpreter.varScope)[:len(*interpreter.varScope)-1]
+		interpreter.ctx = ctx
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (interpreter *Interpreter) interpret(stmt tree.Statement) (SpStatus, error) {
 	if stmt == nil {
 		return SpOk, nil
@@ -150,6 +150,40 @@ var (
 			primary key(pitr_name, create_account)
 			)`, catalog.MO_CATALOG, catalog.MO_PITR)
 
+	MoCatalogMoCdcTaskDDL = fmt.Sprintf(`CREATE TABLE %s.%s (
+			account_id bigint unsigned,
+			task_id uuid,
+			task_name varchar(1000),
+			source_uri text,
+			sink_type varchar(20),
+			sink_uri text,
+			tables text,
+			options text,
+			state varchar(20),
+			err_msg text,
+			create_time timestamp,
+			primary key(account_id, task_id)
+			)`, catalog.MO_CATALOG, catalog.MO_CDC_TASK)
+
+	MoCatalogMoCdcWatermarkDDL = fmt.Sprintf(`CREATE TABLE %s.%s (
+			account_id bigint unsigned,
+			task_id uuid,
+			db_name varchar(256),
+			table_name varchar(256),
+			watermark varchar(128),
+			primary key(account_id, task_id, db_name, table_name)
+			)`, catalog.MO_CATALOG, catalog.MO_CDC_WATERMARK)
+
+	MoCatalogMoStreamOffsetsDDL = fmt.Sprintf(`CREATE TABLE %s.%s (
+			task_id bigint unsigned,
+			db_name varchar(256),
+			table_name varchar(256),
+			topic varchar(256),
+			partition_id int,
+			msg_offset bigint,
+			primary key(task_id, topic, partition_id)
+			)`, catalog.MO_CATALOG, catalog.MO_STREAM_OFFSETS)
+
 	MoCatalogMoRetentionDDL = fmt.Sprintf(`CREATE TABLE %s.%s (
     		database_name varchar(5000),
 			table_name varchar(5000),
@@ -157,6 +191,63 @@ var (
     		primary key(database_name, table_name)
     		)`, catalog.MO_CATALOG, catalog.MO_RETENTION)
 
+	MoCatalogMoEventsDDL = fmt.Sprintf(`CREATE TABLE %s.%s (
+			event_id uuid,
+			db_name varchar(5000),
+			event_name varchar(64),
+			definer varchar(300),
+			definer_user_id int unsigned,
+			definer_role_id int unsigned,
+			event_body text,
+			execute_at timestamp,
+			interval_value varchar(256),
+			interval_field varchar(18),
+			starts timestamp,
+			ends timestamp,
+			status varchar(18),
+			on_completion varchar(12),
+			created timestamp,
+			last_altered timestamp,
+			last_executed timestamp,
+			event_comment varchar(2048),
+			primary key(db_name, event_name)
+			)`, catalog.MO_CATALOG, catalog.MO_EVENTS)
+
+	MoCatalogMoEventHistoryDDL = fmt.Sprintf(`CREATE TABLE %s.%s (
+			event_id uuid,
+			db_name varchar(5000),
+			event_name varchar(64),
+			task_id varchar(128),
+			start_time timestamp(6),
+			end_time timestamp(6),
+			status varchar(16),
+			err_msg text,
+			primary key(event_id, task_id)
+			)`, catalog.MO_CATALOG, catalog.MO_EVENT_HISTORY)
+
+	MoCatalogMoTriggersDDL = fmt.Sprintf(`CREATE TABLE %s.%s (
+			trigger_id uuid,
+			db_name varchar(5000),
+			trigger_name varchar(64),
+			table_name varchar(5000),
+			action_timing varchar(10),
+			event_manipulation varchar(10),
+			action_order int unsigned,
+			action_statement text,
+			definer varchar(300),
+			created timestamp,
+			primary key(db_name, trigger_name)
+			)`, catalog.MO_CATALOG, catalog.MO_TRIGGERS)
+
+	MoCatalogMoCheckConstraintsDDL = fmt.Sprintf(`CREATE TABLE %s.%s (
+			table_id bigint unsigned,
+			database_id bigint unsigned,
+			name varchar(64),
+			check_clause text,
+			enforced bool,
+			primary key(table_id, name)
+			)`, catalog.MO_CATALOG, catalog.MO_CHECK_CONSTRAINTS)
+
 	MoCatalogMoPubsDDL = `create table mo_catalog.mo_pubs (
     		pub_name varchar(64) primary key,
     		database_name varchar(5000),
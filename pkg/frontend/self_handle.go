@@ -29,6 +29,21 @@ func execInFrontend(ses *Session, execCtx *ExecCtx) (err error) {
 		RecordStatementTxnID(execCtx.reqCtx, ses)
 	case *tree.CommitTransaction:
 	case *tree.RollbackTransaction:
+	case *tree.Savepoint:
+		err = ses.GetTxnHandler().Savepoint(execCtx, string(st.Name))
+		if err != nil {
+			return
+		}
+	case *tree.RollbackToSavepoint:
+		err = ses.GetTxnHandler().RollbackToSavepoint(execCtx, string(st.Name))
+		if err != nil {
+			return
+		}
+	case *tree.ReleaseSavepoint:
+		err = ses.GetTxnHandler().ReleaseSavepoint(execCtx, string(st.Name))
+		if err != nil {
+			return
+		}
 	case *tree.SetRole:
 		ses.EnterFPrint(FPSetRole)
 		defer ses.ExitFPrint(FPSetRole)
@@ -385,7 +400,9 @@ func execInFrontend(ses *Session, execCtx *ExecCtx) (err error) {
 	case *tree.SetTransaction:
 		ses.EnterFPrint(FPSetTransaction)
 		defer ses.ExitFPrint(FPSetTransaction)
-		//TODO: handle set transaction
+		if err = handleSetTransaction(ses, execCtx, st); err != nil {
+			return
+		}
 	case *tree.LockTableStmt:
 
 	case *tree.UnLockTableStmt:
@@ -462,11 +479,71 @@ func execInFrontend(ses *Session, execCtx *ExecCtx) (err error) {
 		defer ses.ExitFPrint(FPSetConnectionID)
 		ses.SetConnectionID(st.ConnectionID)
 	case *tree.CreateCDC:
+		ses.EnterFPrint(FPCreateCdc)
+		defer ses.ExitFPrint(FPCreateCdc)
+		if err = handleCreateCdc(ses, execCtx, st); err != nil {
+			return
+		}
 	case *tree.PauseCDC:
+		ses.EnterFPrint(FPPauseCdc)
+		defer ses.ExitFPrint(FPPauseCdc)
+		if err = handlePauseCdc(ses, execCtx, st); err != nil {
+			return
+		}
 	case *tree.DropCDC:
+		ses.EnterFPrint(FPDropCdc)
+		defer ses.ExitFPrint(FPDropCdc)
+		if err = handleDropCdc(ses, execCtx, st); err != nil {
+			return
+		}
 	case *tree.RestartCDC:
+		ses.EnterFPrint(FPRestartCdc)
+		defer ses.ExitFPrint(FPRestartCdc)
+		if err = handleRestartCdc(ses, execCtx, st); err != nil {
+			return
+		}
 	case *tree.ResumeCDC:
+		ses.EnterFPrint(FPResumeCdc)
+		defer ses.ExitFPrint(FPResumeCdc)
+		if err = handleResumeCdc(ses, execCtx, st); err != nil {
+			return
+		}
 	case *tree.ShowCDC:
+		ses.EnterFPrint(FPShowCdc)
+		defer ses.ExitFPrint(FPShowCdc)
+		if err = handleShowCdc(ses, execCtx, st); err != nil {
+			return
+		}
+	case *tree.CreateEvent:
+		ses.EnterFPrint(FPCreateEvent)
+		defer ses.ExitFPrint(FPCreateEvent)
+		if err = handleCreateEvent(ses, execCtx, st); err != nil {
+			return
+		}
+	case *tree.AlterEvent:
+		ses.EnterFPrint(FPAlterEvent)
+		defer ses.ExitFPrint(FPAlterEvent)
+		if err = handleAlterEvent(ses, execCtx, st); err != nil {
+			return
+		}
+	case *tree.DropEvent:
+		ses.EnterFPrint(FPDropEvent)
+		defer ses.ExitFPrint(FPDropEvent)
+		if err = handleDropEvent(ses, execCtx, st); err != nil {
+			return
+		}
+	case *tree.CreateTrigger:
+		ses.EnterFPrint(FPCreateTrigger)
+		defer ses.ExitFPrint(FPCreateTrigger)
+		if err = handleCreateTrigger(ses, execCtx, st); err != nil {
+			return
+		}
+	case *tree.DropTrigger:
+		ses.EnterFPrint(FPDropTrigger)
+		defer ses.ExitFPrint(FPDropTrigger)
+		if err = handleDropTrigger(ses, execCtx, st); err != nil {
+			return
+		}
 	}
 	return
 }
@@ -137,6 +137,8 @@ type Session struct {
 
 	prepareStmts map[string]*PrepareStmt
 	lastStmtId   uint32
+	// cursors are the opened cursors of the prepared statements
+	cursors map[string]*cursor
 
 	priv *privilege
 
@@ -595,6 +597,8 @@ func (ses *Session) ReserveConnAndClose() {
 }
 
 func (ses *Session) Close() {
+	// the statements of the cursors may be still running
+	ses.closeCursors()
 	ses.mu.Lock()
 	defer ses.mu.Unlock()
 	ses.feSessionImpl.Close()
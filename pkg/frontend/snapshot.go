@@ -112,7 +112,18 @@ var (
 		catalog.MO_SNAPSHOTS: 1,
 		catalog.MO_PITR:      1,
 
+		catalog.MO_CDC_TASK:      1,
+		catalog.MO_CDC_WATERMARK: 1,
+
+		catalog.MO_STREAM_OFFSETS: 1,
+
 		catalog.MO_RETENTION: 0,
+
+		catalog.MO_EVENTS:        1,
+		catalog.MO_EVENT_HISTORY: 1,
+		catalog.MO_TRIGGERS:      1,
+
+		catalog.MO_CHECK_CONSTRAINTS: 1,
 	}
 )
 
@@ -20,6 +20,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
@@ -50,6 +51,9 @@ func executeStatusStmt(ses *Session, execCtx *ExecCtx) (err error) {
 
 			// open new file
 			ep.DefaultBufSize = getGlobalPu().SV.ExportDataDefaultFlushSize
+			ep.ctx = execCtx.reqCtx
+			ep.mrs = mrs
+			ep.colDefs = plan2.GetResultColumnsFromPlan(execCtx.cw.Plan())
 			initExportFileParam(ep, mrs)
 			if err = openNewFile(execCtx.reqCtx, ep, mrs); err != nil {
 				return
@@ -145,7 +145,8 @@ func statementCanBeExecutedInUncommittedTransaction(ctx context.Context, ses FeS
 	case *tree.Insert, *tree.Update, *tree.Delete, *tree.Select, *tree.Load, *tree.MoDump, *tree.ValuesStatement, *tree.Replace:
 		return true, nil
 		//transaction
-	case *tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction:
+	case *tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction,
+		*tree.Savepoint, *tree.RollbackToSavepoint, *tree.ReleaseSavepoint:
 		return true, nil
 		//show
 	case *tree.ShowCreateTable,
@@ -177,7 +178,8 @@ func statementCanBeExecutedInUncommittedTransaction(ctx context.Context, ses FeS
 		*tree.ShowCreatePublications,
 		*tree.ShowBackendServers,
 		*tree.ShowAccountUpgrade,
-		*tree.ShowConnectors:
+		*tree.ShowConnectors,
+		*tree.ShowCDC:
 		return true, nil
 		//others
 	case *tree.ExplainStmt, *tree.ExplainAnalyze, *tree.ExplainFor, *InternalCmdFieldList:
@@ -306,6 +306,34 @@ func (mr *MockLockServiceMockRecorder) IsOrphanTxn(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsOrphanTxn", reflect.TypeOf((*MockLockService)(nil).IsOrphanTxn), arg0, arg1)
 }
 
+// RollbackToSavepoint mocks base method.
+func (m *MockLockService) RollbackToSavepoint(ctx context.Context, txnID []byte, sp lockservice.LockSavepoint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackToSavepoint", ctx, txnID, sp)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackToSavepoint indicates an expected call of RollbackToSavepoint.
+func (mr *MockLockServiceMockRecorder) RollbackToSavepoint(ctx, txnID, sp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackToSavepoint", reflect.TypeOf((*MockLockService)(nil).RollbackToSavepoint), ctx, txnID, sp)
+}
+
+// Savepoint mocks base method.
+func (m *MockLockService) Savepoint(txnID []byte) lockservice.LockSavepoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Savepoint", txnID)
+	ret0, _ := ret[0].(lockservice.LockSavepoint)
+	return ret0
+}
+
+// Savepoint indicates an expected call of Savepoint.
+func (mr *MockLockServiceMockRecorder) Savepoint(txnID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Savepoint", reflect.TypeOf((*MockLockService)(nil).Savepoint), txnID)
+}
+
 // IterLocks mocks base method.
 func (m *MockLockService) IterLocks(arg0 func(uint64, [][]byte, lockservice.Lock) bool) {
 	m.ctrl.T.Helper()
@@ -737,6 +737,20 @@ func (mr *MockTxnOperatorMockRecorder) ResetRetry(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetRetry", reflect.TypeOf((*MockTxnOperator)(nil).ResetRetry), arg0)
 }
 
+// ReleaseSavepoint mocks base method.
+func (m *MockTxnOperator) ReleaseSavepoint(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseSavepoint", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseSavepoint indicates an expected call of ReleaseSavepoint.
+func (mr *MockTxnOperatorMockRecorder) ReleaseSavepoint(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseSavepoint", reflect.TypeOf((*MockTxnOperator)(nil).ReleaseSavepoint), ctx, name)
+}
+
 // Rollback mocks base method.
 func (m *MockTxnOperator) Rollback(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -751,6 +765,34 @@ func (mr *MockTxnOperatorMockRecorder) Rollback(ctx interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollback", reflect.TypeOf((*MockTxnOperator)(nil).Rollback), ctx)
 }
 
+// RollbackToSavepoint mocks base method.
+func (m *MockTxnOperator) RollbackToSavepoint(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackToSavepoint", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackToSavepoint indicates an expected call of RollbackToSavepoint.
+func (mr *MockTxnOperatorMockRecorder) RollbackToSavepoint(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackToSavepoint", reflect.TypeOf((*MockTxnOperator)(nil).RollbackToSavepoint), ctx, name)
+}
+
+// Savepoint mocks base method.
+func (m *MockTxnOperator) Savepoint(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Savepoint", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Savepoint indicates an expected call of Savepoint.
+func (mr *MockTxnOperatorMockRecorder) Savepoint(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Savepoint", reflect.TypeOf((*MockTxnOperator)(nil).Savepoint), ctx, name)
+}
+
 // SetFootPrints mocks base method.
 func (m *MockTxnOperator) SetFootPrints(prints [][2]uint32) {
 	m.ctrl.T.Helper()
@@ -1229,6 +1271,20 @@ func (mr *MockWorkspaceMockRecorder) Readonly() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Readonly", reflect.TypeOf((*MockWorkspace)(nil).Readonly))
 }
 
+// ReleaseSavepoint mocks base method.
+func (m *MockWorkspace) ReleaseSavepoint(ctx context.Context, idx int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseSavepoint", ctx, idx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseSavepoint indicates an expected call of ReleaseSavepoint.
+func (mr *MockWorkspaceMockRecorder) ReleaseSavepoint(ctx, idx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseSavepoint", reflect.TypeOf((*MockWorkspace)(nil).ReleaseSavepoint), ctx, idx)
+}
+
 // Rollback mocks base method.
 func (m *MockWorkspace) Rollback(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -1257,6 +1313,34 @@ func (mr *MockWorkspaceMockRecorder) RollbackLastStatement(ctx interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackLastStatement", reflect.TypeOf((*MockWorkspace)(nil).RollbackLastStatement), ctx)
 }
 
+// RollbackToSavepoint mocks base method.
+func (m *MockWorkspace) RollbackToSavepoint(ctx context.Context, idx int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackToSavepoint", ctx, idx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackToSavepoint indicates an expected call of RollbackToSavepoint.
+func (mr *MockWorkspaceMockRecorder) RollbackToSavepoint(ctx, idx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackToSavepoint", reflect.TypeOf((*MockWorkspace)(nil).RollbackToSavepoint), ctx, idx)
+}
+
+// Savepoint mocks base method.
+func (m *MockWorkspace) Savepoint(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Savepoint", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Savepoint indicates an expected call of Savepoint.
+func (mr *MockWorkspaceMockRecorder) Savepoint(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Savepoint", reflect.TypeOf((*MockWorkspace)(nil).Savepoint), ctx)
+}
+
 // SetHaveDDL mocks base method.
 func (m *MockWorkspace) SetHaveDDL(flag bool) {
 	m.ctrl.T.Helper()
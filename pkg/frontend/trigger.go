@@ -0,0 +1,519 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/compile"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/util"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+const (
+	getTriggerFormat = `select table_name from mo_catalog.mo_triggers where db_name = '%s' and trigger_name = '%s'`
+
+	getTriggerOrderFormat = `select action_order from mo_catalog.mo_triggers where db_name = '%s' and trigger_name = '%s'
+		and table_name = '%s' and action_timing = '%s' and event_manipulation = '%s'`
+
+	getMaxTriggerOrderFormat = `select cast(ifnull(max(action_order), 0) as bigint) from mo_catalog.mo_triggers
+		where db_name = '%s' and table_name = '%s' and action_timing = '%s' and event_manipulation = '%s'`
+
+	shiftTriggerOrderFormat = `update mo_catalog.mo_triggers set action_order = action_order + 1
+		where db_name = '%s' and table_name = '%s' and action_timing = '%s' and event_manipulation = '%s' and action_order >= %d`
+
+	insertTriggerFormat = `insert into mo_catalog.mo_triggers values ('%s', '%s', '%s', '%s', '%s', '%s', %d, '%s', '%s', now())`
+
+	deleteTriggerFormat = `delete from mo_catalog.mo_triggers where db_name = '%s' and trigger_name = '%s'`
+
+	checkTriggerTableFormat = `select relkind from mo_catalog.mo_tables where reldatabase = '%s' and relname = '%s'`
+
+	getTableTriggersFormat = `select trigger_name, action_timing, event_manipulation, action_statement from mo_catalog.mo_triggers
+		where db_name = '%s' and table_name = '%s' order by action_timing desc, event_manipulation, action_order`
+)
+
+func getSqlForInsertTrigger(triggerId, dbName, triggerName, tableName string, st *tree.CreateTrigger, order int64, definer string) string {
+	return fmt.Sprintf(insertTriggerFormat, triggerId, util.QuoteSqlString(dbName), util.QuoteSqlString(triggerName),
+		util.QuoteSqlString(tableName), st.Time, st.Event, order, util.QuoteSqlString(st.BodySQL), util.QuoteSqlString(definer))
+}
+
+// getTriggerDbName returns the database of the trigger, the current database
+// is used if the name is not qualified.
+func getTriggerDbName(ses *Session, name *tree.TriggerName) (string, error) {
+	if name.HasNoNameQualifier() {
+		if ses.DatabaseNameIsEmpty() {
+			return "", moerr.NewNoDBNoCtx()
+		}
+		return ses.GetDatabaseName(), nil
+	}
+	return string(name.Name.SchemaName), nil
+}
+
+// getTriggerTable returns the table of the trigger, it is empty if the
+// trigger does not exist.
+func getTriggerTable(ctx context.Context, bh BackgroundExec, dbName, triggerName string) (string, error) {
+	bh.ClearExecResultSet()
+	sql := fmt.Sprintf(getTriggerFormat, util.QuoteSqlString(dbName), util.QuoteSqlString(triggerName))
+	if err := bh.Exec(ctx, sql); err != nil {
+		return "", err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return "", err
+	}
+	if !execResultArrayHasData(erArray) {
+		return "", nil
+	}
+	return erArray[0].GetString(ctx, 0, 0)
+}
+
+// getTriggerOrder returns the action order of the new trigger, the triggers
+// after it are moved backward.
+func getTriggerOrder(ctx context.Context, bh BackgroundExec, dbName, tableName string, st *tree.CreateTrigger) (int64, error) {
+	if st.Order == nil {
+		bh.ClearExecResultSet()
+		sql := fmt.Sprintf(getMaxTriggerOrderFormat, util.QuoteSqlString(dbName), util.QuoteSqlString(tableName), st.Time, st.Event)
+		if err := bh.Exec(ctx, sql); err != nil {
+			return 0, err
+		}
+		erArray, err := getResultSet(ctx, bh)
+		if err != nil {
+			return 0, err
+		}
+		if !execResultArrayHasData(erArray) {
+			return 1, nil
+		}
+		order, err := erArray[0].GetInt64(ctx, 0, 0)
+		if err != nil {
+			return 0, err
+		}
+		return order + 1, nil
+	}
+
+	bh.ClearExecResultSet()
+	sql := fmt.Sprintf(getTriggerOrderFormat, util.QuoteSqlString(dbName), util.QuoteSqlString(string(st.Order.Other)),
+		util.QuoteSqlString(tableName), st.Time, st.Event)
+	if err := bh.Exec(ctx, sql); err != nil {
+		return 0, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return 0, err
+	}
+	if !execResultArrayHasData(erArray) {
+		return 0, moerr.NewInvalidInputf(ctx, "referenced trigger '%s' for the given action time and event type does not exist", st.Order.Other)
+	}
+	value, err := erArray[0].GetString(ctx, 0, 0)
+	if err != nil {
+		return 0, err
+	}
+	order, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	if st.Order.Follows {
+		order++
+	}
+	sql = fmt.Sprintf(shiftTriggerOrderFormat, util.QuoteSqlString(dbName), util.QuoteSqlString(tableName), st.Time, st.Event, order)
+	if err = bh.Exec(ctx, sql); err != nil {
+		return 0, err
+	}
+	return order, nil
+}
+
+// resolveTriggerDefiner returns the definer of the trigger, the ids of the
+// user and the default role of the definer are set to def. Only the admin can
+// create a trigger for another user.
+func resolveTriggerDefiner(ctx context.Context, ses *Session, bh BackgroundExec, definer *tree.UsernameRecord, def *plan.TriggerDef) (string, error) {
+	tenant := ses.GetTenantInfo()
+	if definer == nil || definer.Username == tenant.GetUser() {
+		def.DefinerUserId = tenant.GetUserID()
+		def.DefinerRoleId = tenant.GetDefaultRoleID()
+		return tenant.GetUser() + "@%", nil
+	}
+	if !tenant.IsAdminRole() {
+		return "", moerr.NewInternalErrorf(ctx, "do not have privilege to create trigger for user %s", definer.Username)
+	}
+
+	sql, err := getSqlForPasswordOfUser(ctx, definer.Username)
+	if err != nil {
+		return "", err
+	}
+	bh.ClearExecResultSet()
+	if err = bh.Exec(ctx, sql); err != nil {
+		return "", err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return "", err
+	}
+	if !execResultArrayHasData(erArray) {
+		return "", moerr.NewInternalErrorf(ctx, "there is no user %s", definer.Username)
+	}
+	userId, err := erArray[0].GetInt64(ctx, 0, 0)
+	if err != nil {
+		return "", err
+	}
+	roleId, err := erArray[0].GetInt64(ctx, 0, 2)
+	if err != nil {
+		return "", err
+	}
+	def.DefinerUserId = uint32(userId)
+	def.DefinerRoleId = uint32(roleId)
+	host := definer.Hostname
+	if host == "" {
+		host = "%"
+	}
+	return definer.Username + "@" + host, nil
+}
+
+// getTableConstraint returns the table and its constraint, which keeps the
+// triggers of the table.
+func getTableConstraint(ctx context.Context, ses *Session, dbName, tableName string) (engine.Relation, *engine.ConstraintDef, error) {
+	txnOp := ses.GetTxnHandler().GetTxn()
+	db, err := ses.GetTxnHandler().GetStorage().Database(ctx, dbName, txnOp)
+	if err != nil {
+		return nil, nil, err
+	}
+	rel, err := db.Relation(ctx, tableName, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	ct, err := compile.GetConstraintDef(ctx, rel)
+	if err != nil {
+		return nil, nil, err
+	}
+	return rel, ct, nil
+}
+
+// checkTriggerOwner checks the current user can drop the trigger, only the
+// definer of the trigger and the admin can drop it.
+func checkTriggerOwner(ctx context.Context, ses *Session, ct *engine.ConstraintDef, triggerName string) error {
+	tenant := ses.GetTenantInfo()
+	if tenant.IsAdminRole() {
+		return nil
+	}
+	for _, c := range ct.Cts {
+		if t, ok := c.(*engine.TriggersDef); ok {
+			for _, trigger := range t.Triggers {
+				if trigger.Name == triggerName && trigger.DefinerUserId == tenant.GetUserID() {
+					return nil
+				}
+			}
+		}
+	}
+	return moerr.NewInternalErrorf(ctx, "do not have privilege to drop trigger %s", triggerName)
+}
+
+// updateTableTriggers keeps the triggers of the table in mo_triggers in the
+// constraint of the table, so they are resolved with the table definition and
+// the cached plans of the table are rebuilt. The definers of the existing
+// triggers are kept, def is the trigger being created.
+func updateTableTriggers(ctx context.Context, ses *Session, bh BackgroundExec, dbName, tableName string, def *plan.TriggerDef) error {
+	rel, oldCt, err := getTableConstraint(ctx, ses, dbName, tableName)
+	if err != nil {
+		return err
+	}
+	definers := make(map[string]*plan.TriggerDef)
+	for _, ct := range oldCt.Cts {
+		if t, ok := ct.(*engine.TriggersDef); ok {
+			for _, trigger := range t.Triggers {
+				definers[trigger.Name] = trigger
+			}
+		}
+	}
+	if def != nil {
+		definers[def.Name] = def
+	}
+
+	bh.ClearExecResultSet()
+	if err = bh.Exec(ctx, fmt.Sprintf(getTableTriggersFormat, util.QuoteSqlString(dbName), util.QuoteSqlString(tableName))); err != nil {
+		return err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return err
+	}
+	triggers := &engine.TriggersDef{}
+	if execResultArrayHasData(erArray) {
+		for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+			trigger := &plan.TriggerDef{}
+			if trigger.Name, err = erArray[0].GetString(ctx, i, 0); err != nil {
+				return err
+			}
+			if trigger.Time, err = erArray[0].GetString(ctx, i, 1); err != nil {
+				return err
+			}
+			if trigger.Event, err = erArray[0].GetString(ctx, i, 2); err != nil {
+				return err
+			}
+			if trigger.Body, err = erArray[0].GetString(ctx, i, 3); err != nil {
+				return err
+			}
+			if definer, ok := definers[trigger.Name]; ok {
+				trigger.DefinerUserId = definer.DefinerUserId
+				trigger.DefinerRoleId = definer.DefinerRoleId
+			}
+			triggers.Triggers = append(triggers.Triggers, trigger)
+		}
+	}
+	newCt, err := compile.MakeNewCreateConstraint(oldCt, triggers)
+	if err != nil {
+		return err
+	}
+	return rel.UpdateConstraint(ctx, newCt)
+}
+
+func handleCreateTrigger(ses *Session, execCtx *ExecCtx, st *tree.CreateTrigger) error {
+	return doCreateTrigger(execCtx.reqCtx, ses, st)
+}
+
+func doCreateTrigger(ctx context.Context, ses *Session, st *tree.CreateTrigger) (err error) {
+	dbName, err := getTriggerDbName(ses, st.Name)
+	if err != nil {
+		return err
+	}
+	triggerName := string(st.Name.Name.ObjectName)
+	tableName := string(st.Table.ObjectName)
+	if st.Table.ExplicitSchema && string(st.Table.SchemaName) != dbName {
+		return moerr.NewInvalidInput(ctx, "trigger in wrong schema")
+	}
+	if _, ok := sysDatabases[dbName]; ok {
+		return moerr.NewInternalErrorf(ctx, "can not create trigger in database %s", dbName)
+	}
+	if _, err = plan2.ClassifyTrigger(ctx, st.Time, st.Event, st.Body); err != nil {
+		return err
+	}
+
+	// the trigger is created in the transaction of the session, in which the
+	// constraint of the table is updated.
+	bh := ses.GetShareTxnBackgroundExec(ctx, false)
+	defer bh.Close()
+
+	exists, err := checkDatabaseExistsOrNot(ctx, bh, dbName)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return moerr.NewBadDB(ctx, dbName)
+	}
+	bh.ClearExecResultSet()
+	if err = bh.Exec(ctx, fmt.Sprintf(checkTriggerTableFormat, util.QuoteSqlString(dbName), util.QuoteSqlString(tableName))); err != nil {
+		return err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return err
+	}
+	if !execResultArrayHasData(erArray) {
+		return moerr.NewNoSuchTable(ctx, dbName, tableName)
+	}
+	kind, err := erArray[0].GetString(ctx, 0, 0)
+	if err != nil {
+		return err
+	}
+	if kind == catalog.SystemViewRel {
+		return moerr.NewInvalidInputf(ctx, "'%s.%s' is not BASE TABLE", dbName, tableName)
+	}
+
+	old, err := getTriggerTable(ctx, bh, dbName, triggerName)
+	if err != nil {
+		return err
+	}
+	if old != "" {
+		if st.IfNotExists {
+			return nil
+		}
+		return moerr.NewInternalErrorf(ctx, "trigger %s already exists", triggerName)
+	}
+	def := &plan.TriggerDef{Name: triggerName}
+	definer, err := resolveTriggerDefiner(ctx, ses, bh, st.Definer, def)
+	if err != nil {
+		return err
+	}
+	order, err := getTriggerOrder(ctx, bh, dbName, tableName, st)
+	if err != nil {
+		return err
+	}
+
+	bh.ClearExecResultSet()
+	if err = bh.Exec(ctx, getSqlForInsertTrigger(uuid.Must(uuid.NewV7()).String(), dbName, triggerName, tableName, st, order, definer)); err != nil {
+		return err
+	}
+	return updateTableTriggers(ctx, ses, bh, dbName, tableName, def)
+}
+
+func handleDropTrigger(ses *Session, execCtx *ExecCtx, st *tree.DropTrigger) error {
+	return doDropTrigger(execCtx.reqCtx, ses, st)
+}
+
+func doDropTrigger(ctx context.Context, ses *Session, st *tree.DropTrigger) (err error) {
+	dbName, err := getTriggerDbName(ses, st.Name)
+	if err != nil {
+		return err
+	}
+	triggerName := string(st.Name.Name.ObjectName)
+
+	bh := ses.GetShareTxnBackgroundExec(ctx, false)
+	defer bh.Close()
+
+	tableName, err := getTriggerTable(ctx, bh, dbName, triggerName)
+	if err != nil {
+		return err
+	}
+	if tableName == "" {
+		if st.IfExists {
+			return nil
+		}
+		return moerr.NewInternalErrorf(ctx, "trigger does not exist")
+	}
+	_, ct, err := getTableConstraint(ctx, ses, dbName, tableName)
+	if err != nil {
+		return err
+	}
+	if err = checkTriggerOwner(ctx, ses, ct, triggerName); err != nil {
+		return err
+	}
+	bh.ClearExecResultSet()
+	if err = bh.Exec(ctx, fmt.Sprintf(deleteTriggerFormat, util.QuoteSqlString(dbName), util.QuoteSqlString(triggerName))); err != nil {
+		return err
+	}
+	return updateTableTriggers(ctx, ses, bh, dbName, tableName, nil)
+}
+
+// triggerValueExpr returns the literal of the value of NEW.col or OLD.col.
+func triggerValueExpr(val interface{}) tree.Expr {
+	switch v := val.(type) {
+	case nil:
+		return tree.NewNumVal("", "", false, tree.P_null)
+	case bool:
+		return tree.NewNumVal(v, strconv.FormatBool(v), false, tree.P_bool)
+	case int64:
+		return tree.NewNumVal(v, strconv.FormatInt(v, 10), false, tree.P_int64)
+	case uint64:
+		return tree.NewNumVal(v, strconv.FormatUint(v, 10), false, tree.P_uint64)
+	case float64:
+		return tree.NewNumVal(v, strconv.FormatFloat(v, 'g', -1, 64), false, tree.P_float64)
+	default:
+		s := fmt.Sprintf("%v", v)
+		return tree.NewNumVal(s, s, false, tree.P_char)
+	}
+}
+
+// triggerValue converts the value extracted from the vector to the type
+// which can be resolved as a variable.
+func triggerValue(val interface{}) interface{} {
+	switch v := val.(type) {
+	case nil, bool, int64, uint64, float64, string:
+		return v
+	case int8:
+		return int64(v)
+	case int16:
+		return int64(v)
+	case int32:
+		return int64(v)
+	case uint8:
+		return uint64(v)
+	case uint16:
+		return uint64(v)
+	case uint32:
+		return uint64(v)
+	case float32:
+		return float64(v)
+	case []byte:
+		return string(v)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// newTriggerRunner returns the runner of the procedural triggers fired by the
+// statements of the session. The triggers fired by the parallel steps of the
+// statement are run one at a time.
+func newTriggerRunner(ses *Session) compile.TriggerRunner {
+	var mu sync.Mutex
+	return func(ctx context.Context, trigger *plan.RowTrigger, bat *batch.Batch) error {
+		mu.Lock()
+		defer mu.Unlock()
+		return runProceduralTrigger(ctx, ses, trigger, bat)
+	}
+}
+
+// runProceduralTrigger executes the body of the procedural trigger for every
+// transition row of the batch as the definer of the trigger, in the
+// transaction of the triggering statement.
+func runProceduralTrigger(ctx context.Context, ses *Session, trigger *plan.RowTrigger, bat *batch.Batch) error {
+	lower, err := ses.GetSessionSysVar("lower_case_table_names")
+	if err != nil {
+		return err
+	}
+	stmt, err := parsers.ParseOne(ctx, dialect.MYSQL, trigger.Body, lower.(int64))
+	if err != nil {
+		return err
+	}
+	defer stmt.Free()
+
+	values := make([]interface{}, 1)
+	transition := make([]map[string]interface{}, 0, bat.RowCount())
+	for r := 0; r < bat.RowCount(); r++ {
+		row := make(map[string]interface{}, len(bat.Vecs))
+		for j, vec := range bat.Vecs {
+			var name string
+			if j < len(trigger.OldCols) {
+				name = "old." + strings.ToLower(trigger.OldCols[j])
+			} else {
+				name = "new." + strings.ToLower(trigger.NewCols[j-len(trigger.OldCols)])
+			}
+			if err = extractRowFromVector(ctx, ses, vec, 0, values, r); err != nil {
+				return err
+			}
+			row[name] = triggerValue(values[0])
+		}
+		transition = append(transition, row)
+	}
+
+	accountId, err := defines.GetAccountId(ctx)
+	if err != nil {
+		return err
+	}
+	definerCtx := defines.AttachAccount(ctx, accountId, trigger.DefinerUserId, trigger.DefinerRoleId)
+	bh := ses.GetShareTxnBackgroundExec(definerCtx, false)
+	defer bh.Close()
+	var varScope []map[string]interface{}
+	interpreter := Interpreter{
+		ctx:         definerCtx,
+		ses:         ses,
+		bh:          bh,
+		varScope:    &varScope,
+		fmtctx:      tree.NewFmtCtx(dialect.MYSQL, tree.WithQuoteString(true)),
+		outParamMap: make(map[string]interface{}),
+	}
+	return interpreter.ExecuteTrigger(stmt, trigger.DbName, transition)
+}
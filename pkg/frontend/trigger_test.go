@@ -0,0 +1,90 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/util"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+func Test_getSqlForInsertTrigger(t *testing.T) {
+	ctx := context.Background()
+	stmt, err := parsers.ParseOne(ctx, dialect.MYSQL,
+		"create trigger trg before update on t1 for each row set new.b = 'it''s'", 1)
+	require.NoError(t, err)
+	st := stmt.(*tree.CreateTrigger)
+	sql := getSqlForInsertTrigger("id", "db1", "trg", "t1", st, 2, "root@%")
+	require.Equal(t,
+		`insert into mo_catalog.mo_triggers values ('id', 'db1', 'trg', 't1', 'BEFORE', 'UPDATE', 2, '`+
+			util.QuoteSqlString(st.BodySQL)+`', 'root@%', now())`, sql)
+}
+
+func Test_triggerValue(t *testing.T) {
+	require.Equal(t, int64(-3), triggerValue(int8(-3)))
+	require.Equal(t, uint64(3), triggerValue(uint16(3)))
+	require.Equal(t, float64(1.5), triggerValue(float32(1.5)))
+	require.Equal(t, "abc", triggerValue([]byte("abc")))
+	require.Equal(t, "2024-01-31", triggerValue(types.DateFromCalendar(2024, 1, 31)))
+	require.Nil(t, triggerValue(nil))
+
+	fmtCtx := tree.NewFmtCtx(dialect.MYSQL, tree.WithQuoteString(true))
+	for val, expected := range map[interface{}]string{
+		nil:          "null",
+		true:         "true",
+		int64(-3):    "-3",
+		uint64(3):    "3",
+		float64(1.5): "1.5",
+		"it's":       `"it's"`,
+		"2024-01-31": `"2024-01-31"`,
+	} {
+		fmtCtx.Reset()
+		triggerValueExpr(val).Format(fmtCtx)
+		require.Equal(t, expected, fmtCtx.String())
+	}
+}
+
+func Test_checkTriggerOwner(t *testing.T) {
+	catalog.SetupDefines("")
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ses := newTestSession(t, ctrl)
+	defer ses.Close()
+
+	ctx := context.Background()
+	ct := &engine.ConstraintDef{Cts: []engine.Constraint{
+		&engine.TriggersDef{Triggers: []*plan.TriggerDef{
+			{Name: "trg1", DefinerUserId: 10},
+			{Name: "trg2", DefinerUserId: 11},
+		}},
+	}}
+	ses.SetTenantInfo(&TenantInfo{Tenant: "abc", User: "u1", UserID: 10, DefaultRole: "r1"})
+	require.NoError(t, checkTriggerOwner(ctx, ses, ct, "trg1"))
+	require.Error(t, checkTriggerOwner(ctx, ses, ct, "trg2"))
+
+	ses.SetTenantInfo(&TenantInfo{Tenant: "abc", User: "admin", UserID: 2, DefaultRole: accountAdminRoleName})
+	require.NoError(t, checkTriggerOwner(ctx, ses, ct, "trg2"))
+}
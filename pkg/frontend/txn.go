@@ -17,6 +17,7 @@ package frontend
 import (
 	"context"
 	"errors"
+	"strings"
 	"sync"
 
 	"github.com/google/uuid"
@@ -28,6 +29,7 @@ import (
 	moruntime "github.com/matrixorigin/matrixone/pkg/common/runtime"
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
+	"github.com/matrixorigin/matrixone/pkg/pb/txn"
 	"github.com/matrixorigin/matrixone/pkg/txn/client"
 	"github.com/matrixorigin/matrixone/pkg/txn/clock"
 	"github.com/matrixorigin/matrixone/pkg/txn/storage/memorystorage"
@@ -407,6 +409,16 @@ func (th *TxnHandler) createTxnOpUnsafe(execCtx *ExecCtx) error {
 		}
 	}
 
+	if !execCtx.ses.IsBackgroundSession() {
+		serializable, err := isSerializableIsolation(execCtx.ses)
+		if err != nil {
+			return err
+		}
+		if serializable {
+			opts = append(opts, client.WithTxnIsolation(txn.TxnIsolation_SSI))
+		}
+	}
+
 	th.txnOp, err = getGlobalPu().TxnClient.New(
 		th.txnCtx,
 		execCtx.ses.getLastCommitTS(),
@@ -421,6 +433,21 @@ func (th *TxnHandler) createTxnOpUnsafe(execCtx *ExecCtx) error {
 	return err
 }
 
+// isSerializableIsolation returns true if the isolation level of the session
+// is SERIALIZABLE, the txn runs in serializable snapshot isolation.
+func isSerializableIsolation(ses FeSession) (bool, error) {
+	for _, name := range []string{"transaction_isolation", "tx_isolation"} {
+		val, err := ses.GetSessionSysVar(name)
+		if err != nil {
+			return false, err
+		}
+		if level, ok := val.(string); ok && strings.EqualFold(level, "SERIALIZABLE") {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func (th *TxnHandler) GetTxn() TxnOperator {
 	th.mu.Lock()
 	defer th.mu.Unlock()
@@ -632,6 +659,41 @@ func (th *TxnHandler) rollbackUnsafe(execCtx *ExecCtx) error {
 	return err
 }
 
+// Savepoint creates the savepoint in the txn. The savepoint with the same
+// name is replaced.
+func (th *TxnHandler) Savepoint(execCtx *ExecCtx, name string) error {
+	execCtx.ses.EnterFPrint(FPSavepoint)
+	defer execCtx.ses.ExitFPrint(FPSavepoint)
+	txnOp := th.GetTxn()
+	if txnOp == nil {
+		return moerr.NewInternalError(execCtx.reqCtx, "savepoint without txn")
+	}
+	return txnOp.Savepoint(execCtx.reqCtx, name)
+}
+
+// RollbackToSavepoint discards the changes of the statements after the
+// savepoint and releases the locks taken by them.
+func (th *TxnHandler) RollbackToSavepoint(execCtx *ExecCtx, name string) error {
+	execCtx.ses.EnterFPrint(FPRollbackToSavepoint)
+	defer execCtx.ses.ExitFPrint(FPRollbackToSavepoint)
+	txnOp := th.GetTxn()
+	if txnOp == nil {
+		return moerr.NewSavepointNotExist(execCtx.reqCtx, name)
+	}
+	return txnOp.RollbackToSavepoint(execCtx.reqCtx, name)
+}
+
+// ReleaseSavepoint removes the savepoint and the savepoints after it.
+func (th *TxnHandler) ReleaseSavepoint(execCtx *ExecCtx, name string) error {
+	execCtx.ses.EnterFPrint(FPReleaseSavepoint)
+	defer execCtx.ses.ExitFPrint(FPReleaseSavepoint)
+	txnOp := th.GetTxn()
+	if txnOp == nil {
+		return moerr.NewSavepointNotExist(execCtx.reqCtx, name)
+	}
+	return txnOp.ReleaseSavepoint(execCtx.reqCtx, name)
+}
+
 /*
 SetAutocommit sets the value of the system variable 'autocommit'.
 
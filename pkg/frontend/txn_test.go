@@ -125,6 +125,18 @@ func (txn *testWorkspace) RollbackLastStatement(ctx context.Context) error {
 	return nil
 }
 
+func (t *testWorkspace) Savepoint(ctx context.Context) error {
+	return nil
+}
+
+func (t *testWorkspace) RollbackToSavepoint(ctx context.Context, idx int) error {
+	return nil
+}
+
+func (t *testWorkspace) ReleaseSavepoint(ctx context.Context, idx int) error {
+	return nil
+}
+
 func (t *testWorkspace) WriteOffset() uint64 {
 	//TODO implement me
 	panic("implement me")
@@ -138,6 +138,17 @@ const (
 	FPAlterPitr
 	FPRestorePitr
 	FPSetConnectionID
+	FPCreateCdc
+	FPPauseCdc
+	FPDropCdc
+	FPResumeCdc
+	FPRestartCdc
+	FPShowCdc
+	FPCreateEvent
+	FPAlterEvent
+	FPDropEvent
+	FPCreateTrigger
+	FPDropTrigger
 	FPRollbackTxn
 	FPCommitTxn
 	FPFinishTxn
@@ -178,6 +189,10 @@ const (
 	FPInternalExecutorExec
 	FPInternalExecutorQuery
 	FPHandleAnalyzeStmt
+	FPSavepoint
+	FPRollbackToSavepoint
+	FPReleaseSavepoint
+	FPInternalExecutorExecTxn
 )
 
 type (
@@ -262,6 +277,8 @@ type PrepareStmt struct {
 
 	params              *vector.Vector
 	getFromSendLongData map[int]struct{}
+	// cursorType is the flag of the latest COM_STMT_EXECUTE
+	cursorType uint8
 
 	compile *compile.Compile
 }
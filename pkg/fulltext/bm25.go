@@ -0,0 +1,168 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fulltext
+
+import (
+	"math"
+	"strings"
+)
+
+const (
+	// DefaultK1 controls the term frequency saturation of BM25.
+	DefaultK1 = 1.2
+	// DefaultB controls the document length normalization of BM25.
+	DefaultB = 0.75
+)
+
+// Posting is one occurrence of a word in a document, Doc is the encoded primary key.
+type Posting struct {
+	Doc  string
+	Word string
+	Pos  int32
+}
+
+// Stats is the statistics of the indexed documents.
+type Stats struct {
+	// DocCount is the number of indexed documents.
+	DocCount int64
+	// AvgDocLen is the average number of words of a document.
+	AvgDocLen float64
+	// DocLen is the number of words of each document which has postings.
+	DocLen map[string]int64
+}
+
+// Scorer ranks documents with Okapi BM25.
+type Scorer struct {
+	K1 float64
+	B  float64
+}
+
+func NewScorer() *Scorer {
+	return &Scorer{K1: DefaultK1, B: DefaultB}
+}
+
+// Score returns the relevance of each document matched by the query.
+// postings must contain all postings of the words returned by q.Words().
+func (s *Scorer) Score(q *Query, postings []Posting, stats Stats) map[string]float64 {
+	// doc -> word -> positions
+	docs := make(map[string]map[string][]int32)
+	for _, p := range postings {
+		words, ok := docs[p.Doc]
+		if !ok {
+			words = make(map[string][]int32)
+			docs[p.Doc] = words
+		}
+		words[p.Word] = append(words[p.Word], p.Pos)
+	}
+
+	// term frequency of each term in each document.
+	tfs := make([]map[string]int, len(q.Terms))
+	for i := range q.Terms {
+		tfs[i] = make(map[string]int)
+		for doc, words := range docs {
+			if tf := termFrequency(&q.Terms[i], words); tf > 0 {
+				tfs[i][doc] = tf
+			}
+		}
+	}
+
+	hasMust := false
+	for _, term := range q.Terms {
+		if term.Op == OpMust {
+			hasMust = true
+		}
+	}
+
+	scores := make(map[string]float64)
+	for doc := range docs {
+		// with must terms, a document matches if it has all of them, otherwise if it has any term.
+		matched, rejected := hasMust, false
+		score := float64(0)
+		for i, term := range q.Terms {
+			tf, ok := tfs[i][doc]
+			switch term.Op {
+			case OpMust:
+				rejected = rejected || !ok
+			case OpMustNot:
+				rejected = rejected || ok
+				continue
+			case OpShould:
+				matched = matched || ok
+			}
+			if ok {
+				score += s.termScore(tf, int64(len(tfs[i])), stats.DocLen[doc], stats)
+			}
+		}
+		if matched && !rejected {
+			scores[doc] = score
+		}
+	}
+	return scores
+}
+
+// termScore is idf * tf * (k1 + 1) / (tf + k1 * (1 - b + b * dl / avgdl)).
+func (s *Scorer) termScore(tf int, df int64, docLen int64, stats Stats) float64 {
+	n := float64(stats.DocCount)
+	if n < float64(df) {
+		n = float64(df)
+	}
+	idf := math.Log(1 + (n-float64(df)+0.5)/(float64(df)+0.5))
+	norm := float64(1)
+	if stats.AvgDocLen > 0 {
+		norm = 1 - s.B + s.B*float64(docLen)/stats.AvgDocLen
+	}
+	f := float64(tf)
+	return idf * f * (s.K1 + 1) / (f + s.K1*norm)
+}
+
+// termFrequency returns how many times the term occurs in a document.
+func termFrequency(term *Term, words map[string][]int32) int {
+	last := len(term.Words) - 1
+	positionsOf := func(i int) []int32 {
+		if term.Prefix && i == last {
+			var positions []int32
+			for w, ps := range words {
+				if strings.HasPrefix(w, term.Words[i]) {
+					positions = append(positions, ps...)
+				}
+			}
+			return positions
+		}
+		return words[term.Words[i]]
+	}
+
+	starts := positionsOf(0)
+	if last == 0 {
+		return len(starts)
+	}
+	// a phrase matches where its words are at consecutive positions.
+	tf := 0
+	next := make([]map[int32]bool, last+1)
+	for i := 1; i <= last; i++ {
+		next[i] = make(map[int32]bool)
+		for _, pos := range positionsOf(i) {
+			next[i][pos] = true
+		}
+	}
+	for _, pos := range starts {
+		i := 1
+		for ; i <= last && next[i][pos+int32(i)]; i++ {
+		}
+		if i > last {
+			tf++
+		}
+	}
+	return tf
+}
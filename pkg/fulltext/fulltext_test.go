@@ -0,0 +1,136 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fulltext
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func words(tokens []Token) []string {
+	ws := make([]string, len(tokens))
+	for i, tok := range tokens {
+		ws[i] = tok.Word
+	}
+	return ws
+}
+
+func TestTokenizer(t *testing.T) {
+	tok, err := NewTokenizer("")
+	require.NoError(t, err)
+	tokens := tok.Tokenize("Hello, World! 数据库abc", 3, nil)
+	require.Equal(t, []string{"hello", "world", "数据", "据库", "abc"}, words(tokens))
+	require.Equal(t, int32(3), tokens[0].Pos)
+	require.Equal(t, int32(7), tokens[4].Pos)
+
+	tok, err = NewTokenizer("NGRAM")
+	require.NoError(t, err)
+	require.Equal(t, []string{"ab", "bc", "中", "x"}, words(tok.Tokenize("abc 中 x", 0, nil)))
+
+	_, err = NewTokenizer("no_such_parser")
+	require.Error(t, err)
+	require.False(t, IsValidParser("no_such_parser"))
+	require.True(t, IsValidParser(""))
+}
+
+func TestParseQuery(t *testing.T) {
+	tok, err := NewTokenizer(DefaultParser)
+	require.NoError(t, err)
+
+	q, err := ParseQuery("Red apple", ModeNaturalLanguage, tok)
+	require.NoError(t, err)
+	require.Equal(t, []Term{{Op: OpShould, Words: []string{"red"}}, {Op: OpShould, Words: []string{"apple"}}}, q.Terms)
+
+	q, err = ParseQuery(`+red -"green apple" app* pie`, ModeBoolean, tok)
+	require.NoError(t, err)
+	require.Equal(t, []Term{
+		{Op: OpMust, Words: []string{"red"}},
+		{Op: OpMustNot, Words: []string{"green", "apple"}},
+		{Op: OpShould, Words: []string{"app"}, Prefix: true},
+		{Op: OpShould, Words: []string{"pie"}},
+	}, q.Terms)
+	ws, prefixes := q.Words()
+	require.Equal(t, []string{"red", "green", "apple", "pie"}, ws)
+	require.Equal(t, []string{"app"}, prefixes)
+}
+
+func makePostings(tok Tokenizer, docs map[string]string) ([]Posting, Stats) {
+	var postings []Posting
+	stats := Stats{DocCount: int64(len(docs)), DocLen: make(map[string]int64)}
+	total := 0
+	for doc, text := range docs {
+		tokens := tok.Tokenize(text, 0, nil)
+		for _, t := range tokens {
+			postings = append(postings, Posting{Doc: doc, Word: t.Word, Pos: t.Pos})
+		}
+		stats.DocLen[doc] = int64(len(tokens))
+		total += len(tokens)
+	}
+	stats.AvgDocLen = float64(total) / float64(len(docs))
+	return postings, stats
+}
+
+func TestScore(t *testing.T) {
+	tok, err := NewTokenizer(DefaultParser)
+	require.NoError(t, err)
+	postings, stats := makePostings(tok, map[string]string{
+		"1": "red apple red apple",
+		"2": "green apple pie",
+		"3": "red car",
+		"4": "blue sky",
+	})
+	scorer := NewScorer()
+
+	search := func(query string, mode Mode) map[string]float64 {
+		q, err := ParseQuery(query, mode, tok)
+		require.NoError(t, err)
+		return scorer.Score(q, postings, stats)
+	}
+
+	scores := search("red apple", ModeNaturalLanguage)
+	require.Len(t, scores, 3)
+	require.Greater(t, scores["1"], scores["2"])
+	require.Greater(t, scores["1"], scores["3"])
+
+	scores = search("+apple -pie", ModeBoolean)
+	require.Len(t, scores, 1)
+	require.Contains(t, scores, "1")
+
+	scores = search(`"apple red"`, ModeBoolean)
+	require.Len(t, scores, 1)
+	require.Contains(t, scores, "1")
+
+	scores = search("gre* sky", ModeBoolean)
+	require.Len(t, scores, 2)
+	require.Contains(t, scores, "2")
+	require.Contains(t, scores, "4")
+
+	require.Empty(t, search("-apple", ModeBoolean))
+}
+
+func TestScoreCJK(t *testing.T) {
+	tok, err := NewTokenizer(NgramParser)
+	require.NoError(t, err)
+	postings, stats := makePostings(tok, map[string]string{
+		"1": "我们都是中国人",
+		"2": "国家的人民",
+	})
+	q, err := ParseQuery("中国人", ModeBoolean, tok)
+	require.NoError(t, err)
+	scores := NewScorer().Score(q, postings, stats)
+	require.Len(t, scores, 1)
+	require.Contains(t, scores, "1")
+}
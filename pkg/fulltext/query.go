@@ -0,0 +1,153 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fulltext
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// Mode is the search modifier of MATCH ... AGAINST.
+type Mode int64
+
+const (
+	ModeNaturalLanguage Mode = iota
+	ModeBoolean
+)
+
+// Operator tells how a term of a query is matched.
+type Operator int
+
+const (
+	// OpShould terms are optional, they only add relevance.
+	OpShould Operator = iota
+	// OpMust terms must be present in every matched document.
+	OpMust
+	// OpMustNot terms must not be present in any matched document.
+	OpMustNot
+)
+
+// Term is a word, a prefix or a phrase of a query.
+type Term struct {
+	Op Operator
+	// Words has more than one word if the term is a phrase.
+	Words []string
+	// Prefix is true if the last word is a prefix, as `word*`.
+	Prefix bool
+}
+
+// Query is a parsed fulltext search query.
+type Query struct {
+	Terms []Term
+}
+
+// ParseQuery parses the AGAINST string of a fulltext search.
+//
+// In natural language mode every word of the query is an optional term.
+// In boolean mode the query supports `+word`, `-word`, `word*` and `"a phrase"`.
+func ParseQuery(query string, mode Mode, tokenizer Tokenizer) (*Query, error) {
+	q := &Query{}
+	switch mode {
+	case ModeNaturalLanguage:
+		for _, tok := range tokenizer.Tokenize(query, 0, nil) {
+			q.Terms = append(q.Terms, Term{Op: OpShould, Words: []string{tok.Word}})
+		}
+	case ModeBoolean:
+		for _, unit := range splitBooleanQuery(query) {
+			term := Term{Op: OpShould}
+			switch unit[0] {
+			case '+':
+				term.Op = OpMust
+				unit = unit[1:]
+			case '-':
+				term.Op = OpMustNot
+				unit = unit[1:]
+			}
+			unit = strings.Trim(unit, "()<>~")
+			if strings.HasSuffix(unit, "*") {
+				term.Prefix = true
+				unit = strings.TrimRight(unit, "*")
+			}
+			for _, tok := range tokenizer.Tokenize(strings.Trim(unit, `"`), 0, nil) {
+				term.Words = append(term.Words, tok.Word)
+			}
+			if len(term.Words) == 0 {
+				continue
+			}
+			q.Terms = append(q.Terms, term)
+		}
+	default:
+		return nil, moerr.NewNotSupportedNoCtxf("fulltext search mode %d", mode)
+	}
+	return q, nil
+}
+
+// splitBooleanQuery splits a boolean query on white spaces, a quoted phrase is one unit.
+func splitBooleanQuery(query string) []string {
+	var units []string
+	start, quoted := -1, false
+	for i, r := range query {
+		switch {
+		case r == '"':
+			if start < 0 {
+				start = i
+			}
+			if quoted {
+				units = append(units, query[start:i+1])
+				start = -1
+			}
+			quoted = !quoted
+		case unicode.IsSpace(r) && !quoted:
+			if start >= 0 {
+				units = append(units, query[start:i])
+				start = -1
+			}
+		default:
+			if start < 0 {
+				start = i
+			}
+		}
+	}
+	if start >= 0 {
+		units = append(units, query[start:])
+	}
+	return units
+}
+
+// Words returns the distinct words to look up in the index, prefix words are returned separately.
+func (q *Query) Words() (words []string, prefixes []string) {
+	seen := make(map[string]bool)
+	for _, term := range q.Terms {
+		for i, w := range term.Words {
+			isPrefix := term.Prefix && i == len(term.Words)-1
+			key := w
+			if isPrefix {
+				key = w + "*"
+			}
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			if isPrefix {
+				prefixes = append(prefixes, w)
+			} else {
+				words = append(words, w)
+			}
+		}
+	}
+	return
+}
@@ -0,0 +1,162 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fulltext
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+const (
+	// DefaultParser splits words on whitespace and punctuation, CJK text is split into bigrams.
+	DefaultParser = "default"
+	// NgramParser splits every run of letters and digits into overlapping n-grams.
+	NgramParser = "ngram"
+
+	// DefaultNgramSize is the n of the ngram parser, same as ngram_token_size of MySQL.
+	DefaultNgramSize = 2
+	// MaxWordLength is the max length in runes of a word kept in the index.
+	MaxWordLength = 84
+)
+
+// Token is one word of a document and its position in the document.
+type Token struct {
+	Word string
+	Pos  int32
+}
+
+// Tokenizer splits a text into lower case words.
+type Tokenizer interface {
+	// Tokenize appends the tokens of text to tokens, positions start from pos.
+	Tokenize(text string, pos int32, tokens []Token) []Token
+}
+
+var tokenizers = struct {
+	sync.RWMutex
+	m map[string]func() Tokenizer
+}{
+	m: map[string]func() Tokenizer{
+		DefaultParser: func() Tokenizer { return &defaultTokenizer{} },
+		NgramParser:   func() Tokenizer { return &ngramTokenizer{n: DefaultNgramSize} },
+	},
+}
+
+// RegisterTokenizer registers a fulltext parser which can be used by `WITH PARSER name`.
+func RegisterTokenizer(name string, fn func() Tokenizer) {
+	tokenizers.Lock()
+	defer tokenizers.Unlock()
+	tokenizers.m[strings.ToLower(name)] = fn
+}
+
+// IsValidParser returns true if the fulltext parser was registered.
+func IsValidParser(name string) bool {
+	if name == "" {
+		return true
+	}
+	tokenizers.RLock()
+	defer tokenizers.RUnlock()
+	_, ok := tokenizers.m[strings.ToLower(name)]
+	return ok
+}
+
+// NewTokenizer returns the tokenizer of the fulltext parser, empty name means the default parser.
+func NewTokenizer(name string) (Tokenizer, error) {
+	if name == "" {
+		name = DefaultParser
+	}
+	tokenizers.RLock()
+	fn, ok := tokenizers.m[strings.ToLower(name)]
+	tokenizers.RUnlock()
+	if !ok {
+		return nil, moerr.NewNotSupportedNoCtxf("fulltext parser '%s'", name)
+	}
+	return fn(), nil
+}
+
+func isCJK(r rune) bool {
+	return unicode.Is(unicode.Han, r) || unicode.Is(unicode.Hiragana, r) ||
+		unicode.Is(unicode.Katakana, r) || unicode.Is(unicode.Hangul, r)
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
+
+// splitRuns calls fn for each run of word runes in text, a run is either all CJK or without CJK.
+func splitRuns(text string, fn func(run []rune, cjk bool)) {
+	var run []rune
+	cjk := false
+	for _, r := range strings.ToLower(text) {
+		if !isWordRune(r) {
+			if len(run) > 0 {
+				fn(run, cjk)
+				run = run[:0]
+			}
+			continue
+		}
+		if len(run) > 0 && isCJK(r) != cjk {
+			fn(run, cjk)
+			run = run[:0]
+		}
+		cjk = isCJK(r)
+		run = append(run, r)
+	}
+	if len(run) > 0 {
+		fn(run, cjk)
+	}
+}
+
+// appendNgrams appends the overlapping n-grams of run, a run shorter than n is one word.
+func appendNgrams(run []rune, n int, pos int32, tokens []Token) ([]Token, int32) {
+	if len(run) <= n {
+		return append(tokens, Token{Word: string(run), Pos: pos}), pos + 1
+	}
+	for i := 0; i+n <= len(run); i++ {
+		tokens = append(tokens, Token{Word: string(run[i : i+n]), Pos: pos})
+		pos++
+	}
+	return tokens, pos
+}
+
+type defaultTokenizer struct{}
+
+func (t *defaultTokenizer) Tokenize(text string, pos int32, tokens []Token) []Token {
+	splitRuns(text, func(run []rune, cjk bool) {
+		if cjk {
+			tokens, pos = appendNgrams(run, DefaultNgramSize, pos, tokens)
+			return
+		}
+		if len(run) > MaxWordLength {
+			run = run[:MaxWordLength]
+		}
+		tokens = append(tokens, Token{Word: string(run), Pos: pos})
+		pos++
+	})
+	return tokens
+}
+
+type ngramTokenizer struct {
+	n int
+}
+
+func (t *ngramTokenizer) Tokenize(text string, pos int32, tokens []Token) []Token {
+	splitRuns(text, func(run []rune, _ bool) {
+		tokens, pos = appendNgrams(run, t.n, pos, tokens)
+	})
+	return tokens
+}
@@ -396,6 +396,13 @@ func (l *localLockTable) acquireRowLockLocked(c *lockContext) error {
 				continue
 			}
 
+			// the row is held by other txns, skip it and let the caller
+			// filter it out.
+			if c.opts.Policy == pb.WaitPolicy_SkipLocked {
+				c.result.SkippedRows = append(c.result.SkippedRows, uint32(idx))
+				continue
+			}
+
 			// need wait for prev txn closed
 			if c.w == nil {
 				c.w = acquireWaiter(c.waitTxn)
@@ -465,7 +472,10 @@ func (l *localLockTable) handleLockConflictLocked(
 	c *lockContext,
 	key []byte,
 	conflictWith Lock) error {
-	if c.opts.Policy == pb.WaitPolicy_FastFail {
+	// a range lock cannot be partially skipped, so SkipLocked is the
+	// same as FastFail here.
+	if c.opts.Policy == pb.WaitPolicy_FastFail ||
+		c.opts.Policy == pb.WaitPolicy_SkipLocked {
 		return ErrLockConflict
 	}
 
@@ -58,15 +58,15 @@ func (lp *localLockTableProxy) lock(
 	rows [][]byte,
 	options LockOptions,
 	cb func(pb.Result, error)) {
-	if options.Mode != pb.LockMode_Shared {
+	// the proxy can only share a single row lock which is waited for, other
+	// cases are sent to the remote lock table directly.
+	if options.Mode != pb.LockMode_Shared ||
+		options.Policy != pb.WaitPolicy_Wait ||
+		len(rows) != 1 {
 		lp.remote.lock(ctx, txn, rows, options, cb)
 		return
 	}
 
-	if len(rows) != 1 {
-		panic("local lock table proxy can only support on single row")
-	}
-
 	lp.mu.Lock()
 	key := util.UnsafeBytesToString(rows[0])
 	v, ok := lp.mu.holders[key]
@@ -104,7 +104,7 @@ func (l *remoteLockTable) lock(
 			return
 		}
 
-		txn.lockAdded(l.bind.Group, l.bind, rows, l.logger)
+		txn.lockAdded(l.bind.Group, l.bind, skipRows(rows, resp.Lock.Result.SkippedRows), l.logger)
 		logRemoteLockAdded(l.logger, txn, rows, opts, l.bind)
 		cb(resp.Lock.Result, nil)
 		return
@@ -293,3 +293,19 @@ func isRetryError(err error) bool {
 	}
 	return true
 }
+
+// skipRows returns the rows which are not skipped by the lock table.
+func skipRows(rows [][]byte, skipped []uint32) [][]byte {
+	if len(skipped) == 0 {
+		return rows
+	}
+	locked := make([][]byte, 0, len(rows)-len(skipped))
+	for i, row := range rows {
+		if len(skipped) > 0 && skipped[0] == uint32(i) {
+			skipped = skipped[1:]
+			continue
+		}
+		locked = append(locked, row)
+	}
+	return locked
+}
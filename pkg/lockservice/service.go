@@ -235,6 +235,40 @@ func (s *service) Unlock(
 	return nil
 }
 
+func (s *service) Savepoint(txnID []byte) LockSavepoint {
+	txn := s.activeTxnHolder.getActiveTxn(txnID, false, "")
+	if txn == nil {
+		return LockSavepoint{}
+	}
+
+	txn.Lock()
+	defer txn.Unlock()
+	if !bytes.Equal(txn.txnID, txnID) {
+		return LockSavepoint{}
+	}
+	return txn.savepointLocked()
+}
+
+func (s *service) RollbackToSavepoint(
+	ctx context.Context,
+	txnID []byte,
+	sp LockSavepoint,
+) error {
+	s.wait()
+
+	txn := s.activeTxnHolder.getActiveTxn(txnID, false, "")
+	if txn == nil {
+		return nil
+	}
+
+	txn.Lock()
+	defer txn.Unlock()
+	if !bytes.Equal(txn.txnID, txnID) {
+		return nil
+	}
+	return txn.rollbackToSavepointLocked(s.serviceID, sp, s.getLockTable, s.logger)
+}
+
 func (s *service) IsOrphanTxn(
 	ctx context.Context,
 	txn []byte,
@@ -90,6 +90,99 @@ func TestLockResultWithNoConflictOnRemote(t *testing.T) {
 	)
 }
 
+func TestRollbackToSavepointWithRemoteLocks(t *testing.T) {
+	runLockServiceTests(
+		t,
+		[]string{"s1", "s2"},
+		func(alloc *lockTableAllocator, s []*service) {
+			l1 := s[0]
+			l2 := s[1]
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+			defer cancel()
+
+			txn1 := []byte("txn1")
+			txn2 := []byte("txn2")
+			row1 := []byte{1}
+			row2 := []byte{2}
+
+			// table 1 is bound to l1, table 2 is bound to l2
+			mustAddTestLock(t, ctx, l1, 1, txn1, [][]byte{row1}, pb.Granularity_Row)
+			require.NoError(t, l1.Unlock(ctx, txn1, timestamp.Timestamp{}))
+
+			sp := l2.Savepoint(txn2)
+			mustAddTestLock(t, ctx, l2, 2, txn2, [][]byte{row1}, pb.Granularity_Row)
+			mustAddTestLock(t, ctx, l2, 1, txn2, [][]byte{row2}, pb.Granularity_Row)
+
+			// the lock on the local lock table is released, the lock on the
+			// remote lock table can not be released alone and it is kept until
+			// the transaction ends.
+			require.NoError(t, l2.RollbackToSavepoint(ctx, txn2, sp))
+			lt, err := l2.getLockTable(0, 2)
+			require.NoError(t, err)
+			checkLock(t, lt.(*localLockTable), row1, nil, nil, nil)
+			lt, err = l1.getLockTable(0, 1)
+			require.NoError(t, err)
+			checkLock(t, lt.(*localLockTable), row2, [][]byte{txn2}, nil, nil)
+
+			// the released row can be locked by other transactions.
+			mustAddTestLock(t, ctx, l1, 2, txn1, [][]byte{row1}, pb.Granularity_Row)
+			require.NoError(t, l1.Unlock(ctx, txn1, timestamp.Timestamp{}))
+
+			require.NoError(t, l2.Unlock(ctx, txn2, timestamp.Timestamp{}))
+		},
+	)
+}
+
+func TestLockWithSkipLockedOnRemote(t *testing.T) {
+	runLockServiceTests(
+		t,
+		[]string{"s1", "s2"},
+		func(alloc *lockTableAllocator, s []*service) {
+			l1 := s[0]
+			l2 := s[1]
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+			defer cancel()
+
+			txn1 := []byte("txn1")
+			txn2 := []byte("txn2")
+			row1 := []byte{1}
+			row2 := []byte{2}
+
+			// txn1 hold lock row1 on l1
+			mustAddTestLock(t, ctx, l1, 1, txn1, [][]byte{row1}, pb.Granularity_Row)
+
+			// txn2 lock row1 and row2 on l2, row1 is skipped
+			res, err := l2.Lock(
+				ctx,
+				1,
+				[][]byte{row1, row2},
+				txn2,
+				pb.LockOptions{
+					Granularity: pb.Granularity_Row,
+					Mode:        pb.LockMode_Exclusive,
+					Policy:      pb.WaitPolicy_SkipLocked,
+				})
+			require.NoError(t, err)
+			require.Equal(t, []uint32{0}, res.SkippedRows)
+
+			txn := l2.activeTxnHolder.getActiveTxn(txn2, false, "")
+			require.NotNil(t, txn)
+			txn.Lock()
+			keys := txn.getHoldLocksLocked(0).tableKeys[1].slice()
+			require.Equal(t, 1, keys.len())
+			keys.iter(func(key []byte) bool {
+				require.Equal(t, row2, key)
+				return true
+			})
+			keys.unref()
+			txn.Unlock()
+
+			require.NoError(t, l1.Unlock(ctx, txn1, timestamp.Timestamp{}))
+			require.NoError(t, l2.Unlock(ctx, txn2, timestamp.Timestamp{}))
+		},
+	)
+}
+
 func TestLockResultWithConflictAndTxnCommittedOnRemote(t *testing.T) {
 	runLockServiceTests(
 		t,
@@ -140,6 +140,49 @@ func TestReentrantRowLock(t *testing.T) {
 	}
 }
 
+func TestRollbackToSavepoint(t *testing.T) {
+	for name, runner := range runners {
+		t.Run(name, func(t *testing.T) {
+			table := uint64(0)
+			runner(
+				t,
+				table,
+				func(
+					ctx context.Context,
+					s *service,
+					lt *localLockTable) {
+					option := newTestRowExclusiveOptions()
+					txn1 := newTestTxnID(1)
+
+					_, err := s.Lock(ctx, table, newTestRows(1), txn1, option)
+					require.NoError(t, err)
+					defer func() {
+						assert.NoError(t, s.Unlock(ctx, txn1, timestamp.Timestamp{}))
+					}()
+
+					sp := s.Savepoint(txn1)
+					_, err = s.Lock(ctx, table, newTestRows(1, 2), txn1, option)
+					require.NoError(t, err)
+					_, err = s.Lock(ctx, table, newTestRows(3), txn1, option)
+					require.NoError(t, err)
+					checkLock(t, lt, []byte{2}, [][]byte{txn1}, nil, nil)
+					checkLock(t, lt, []byte{3}, [][]byte{txn1}, nil, nil)
+
+					require.NoError(t, s.RollbackToSavepoint(ctx, txn1, sp))
+					checkLock(t, lt, []byte{1}, [][]byte{txn1}, nil, nil)
+					checkLock(t, lt, []byte{2}, nil, nil, nil)
+					checkLock(t, lt, []byte{3}, nil, nil, nil)
+
+					// other txn can lock the released rows
+					txn2 := newTestTxnID(2)
+					_, err = s.Lock(ctx, table, newTestRows(2), txn2, option)
+					require.NoError(t, err)
+					require.NoError(t, s.Unlock(ctx, txn2, timestamp.Timestamp{}))
+				})
+		})
+	}
+}
+
 func TestRowLockWithSharedAndExclusive(t *testing.T) {
 	for name, runner := range runners {
 		t.Run(name, func(t *testing.T) {
@@ -3709,6 +3752,74 @@ func TestRangeLockWithFailFast(t *testing.T) {
 	}
 }
 
+func TestRowLockWithSkipLocked(t *testing.T) {
+	for name, runner := range runners {
+		t.Run(name, func(t *testing.T) {
+			table := uint64(0)
+			runner(
+				t,
+				table,
+				func(
+					ctx context.Context,
+					s *service,
+					lt *localLockTable) {
+					option := newTestRowExclusiveOptions()
+					txn1 := newTestTxnID(1)
+					txn2 := newTestTxnID(2)
+
+					_, err := s.Lock(ctx, table, newTestRows(2, 4), txn1, option)
+					require.NoError(t, err)
+					defer func() {
+						assert.NoError(t, s.Unlock(ctx, txn1, timestamp.Timestamp{}))
+					}()
+
+					option.Policy = pb.WaitPolicy_SkipLocked
+					rows := newTestRows(1, 2, 3, 4)
+					res, err := s.Lock(ctx, table, rows, txn2, option)
+					require.NoError(t, err)
+					defer func() {
+						assert.NoError(t, s.Unlock(ctx, txn2, timestamp.Timestamp{}))
+					}()
+					require.Equal(t, []uint32{1, 3}, res.SkippedRows)
+					checkLock(t, lt, rows[0], [][]byte{txn2}, nil, nil)
+					checkLock(t, lt, rows[1], [][]byte{txn1}, nil, nil)
+					checkLock(t, lt, rows[2], [][]byte{txn2}, nil, nil)
+					checkLock(t, lt, rows[3], [][]byte{txn1}, nil, nil)
+				})
+		})
+	}
+}
+
+func TestRangeLockWithSkipLocked(t *testing.T) {
+	for name, runner := range runners {
+		t.Run(name, func(t *testing.T) {
+			table := uint64(0)
+			runner(
+				t,
+				table,
+				func(
+					ctx context.Context,
+					s *service,
+					lt *localLockTable) {
+					option := newTestRangeExclusiveOptions()
+					option.Policy = pb.WaitPolicy_SkipLocked
+					rows := newTestRows(1, 2)
+					txn1 := newTestTxnID(1)
+					txn2 := newTestTxnID(2)
+
+					_, err := s.Lock(ctx, table, rows, txn1, option)
+					require.NoError(t, err)
+					defer func() {
+						assert.NoError(t, s.Unlock(ctx, txn1, timestamp.Timestamp{}))
+					}()
+
+					_, err = s.Lock(ctx, table, rows, txn2, option)
+					require.True(t, moerr.IsMoErrCode(err, moerr.ErrLockConflict))
+				})
+		})
+	}
+}
+
 func TestIssue2128(t *testing.T) {
 	runLockServiceTests(
 		t,
@@ -47,6 +47,9 @@ type activeTxn struct {
 	lockHolders    map[uint32]*tableLockHolder
 	remoteService  string
 	deadlockFound  bool
+	// removedLocks is the number of locks removed by the range locks merging, a
+	// savepoint is no longer able to tell the locks added after it once changed.
+	removedLocks uint64
 }
 
 func newActiveTxn(
@@ -89,6 +92,7 @@ func (txn *activeTxn) lockRemoved(
 	})
 	v.close()
 	h.tableKeys[table] = newV
+	txn.removedLocks++
 }
 
 func (txn *activeTxn) lockAdded(
@@ -220,6 +224,70 @@ func (txn *activeTxn) reset() {
 	txn.blockedWaiters = txn.blockedWaiters[:0]
 	txn.remoteService = ""
 	txn.deadlockFound = false
+	txn.removedLocks = 0
+}
+
+func (txn *activeTxn) savepointLocked() LockSavepoint {
+	sp := LockSavepoint{
+		removed: txn.removedLocks,
+		holds:   make(map[uint32]map[uint64]int, len(txn.lockHolders)),
+	}
+	for group, h := range txn.lockHolders {
+		m := make(map[uint64]int, len(h.tableKeys))
+		for table, cs := range h.tableKeys {
+			m[table] = cs.mustGet().len()
+		}
+		sp.holds[group] = m
+	}
+	return sp
+}
+
+// rollbackToSavepointLocked releases the locks added after the savepoint. Like InnoDB,
+// the locks which can not be released alone are kept until the transaction ends: the
+// locks on a remote lock table can only be released all together, and some locks held
+// before the savepoint may be merged into the range locks added after it.
+func (txn *activeTxn) rollbackToSavepointLocked(
+	serviceID string,
+	sp LockSavepoint,
+	lockTableFunc func(uint32, uint64) (lockTable, error),
+	logger *log.MOLogger,
+) error {
+	if sp.removed != txn.removedLocks {
+		return nil
+	}
+
+	for group, h := range txn.lockHolders {
+		for table, cs := range h.tableKeys {
+			n := sp.holds[group][table]
+			s := cs.slice()
+			if s.len() <= n {
+				s.unref()
+				continue
+			}
+
+			l, err := lockTableFunc(group, table)
+			if err != nil {
+				s.unref()
+				return err
+			}
+			if _, ok := l.(*localLockTable); !ok {
+				s.unref()
+				continue
+			}
+
+			values := s.all()
+			kept := newCowSlice(txn.fsp, values[:n])
+			released := newCowSlice(txn.fsp, values[n:])
+			s.unref()
+
+			logTxnUnlockTable(logger, serviceID, txn, table)
+			l.unlock(txn, released, timestamp.Timestamp{})
+			released.close()
+			cs.close()
+			h.tableKeys[table] = kept
+		}
+	}
+	return nil
 }
 
 func (txn *activeTxn) abort(
@@ -44,6 +44,14 @@ var (
 	ErrLockConflict = moerr.NewLockConflictNoCtx()
 )
 
+// LockSavepoint is the number of locks held by a transaction on each lock table
+// when the savepoint was created.
+type LockSavepoint struct {
+	// removed is the number of merged locks removed from the transaction.
+	removed uint64
+	holds   map[uint32]map[uint64]int
+}
+
 // Option lockservice option
 type Option func(s *service)
 
@@ -106,6 +114,13 @@ type LockService interface {
 	// Unlock release all locks associated with the transaction. If commitTS is not empty, means
 	// the txn was committed.
 	Unlock(ctx context.Context, txnID []byte, commitTS timestamp.Timestamp, mutations ...pb.ExtraMutation) error
+	// Savepoint records the locks held by the transaction so far. The locks added after
+	// the savepoint can be released by RollbackToSavepoint.
+	Savepoint(txnID []byte) LockSavepoint
+	// RollbackToSavepoint releases the locks added after the savepoint. The locks on the
+	// remote lock tables, and all the locks if the locks held before the savepoint are
+	// merged into the range locks added after it, are kept until the transaction ends.
+	RollbackToSavepoint(ctx context.Context, txnID []byte, sp LockSavepoint) error
 	// IsOrphanTxn check txn is orphan txn
 	IsOrphanTxn(context.Context, []byte) (bool, error)
 
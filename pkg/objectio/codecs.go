@@ -93,6 +93,12 @@ func RegisterIOEnrtyCodec(h IOEntryHeader, encFn IOEncodeFunc, decFn IODecodeFun
 	}
 }
 
+// IsKnownIOEntry reports whether a codec is registered for the header
+func IsKnownIOEntry(h IOEntryHeader) bool {
+	_, ok := ioEntryCodecs[h]
+	return ok
+}
+
 func GetIOEntryCodec(h IOEntryHeader) (codec ioEntryCodec) {
 	var ok bool
 	codec, ok = ioEntryCodecs[h]
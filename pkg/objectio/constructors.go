@@ -37,23 +37,40 @@ func constructorFactory(size int64, algo uint8) CacheConstructor {
 		}
 
 		// no compress
-		if algo == compress.None {
+		if CompressAlg(algo) == compress.None {
+			if IsEncoded(algo) {
+				return decodeCacheData(data, allocator)
+			}
 			cacheData = allocator.AllocateCacheData(len(data))
 			copy(cacheData.Bytes(), data)
 			return cacheData, nil
 		}
 
-		// lz4 compress
 		decompressed := allocator.AllocateCacheData(int(size))
-		bs, err := compress.Decompress(data, decompressed.Bytes(), compress.Lz4)
+		bs, err := compress.Decompress(data, decompressed.Bytes(), int(CompressAlg(algo)))
 		if err != nil {
+			decompressed.Release()
 			return
 		}
+		if IsEncoded(algo) {
+			defer decompressed.Release()
+			return decodeCacheData(bs, allocator)
+		}
 		decompressed = decompressed.Slice(len(bs))
 		return decompressed, nil
 	}
 }
 
+// decodeCacheData decodes the encoded column data into the cache data.
+func decodeCacheData(encoded []byte, allocator fileservice.CacheDataAllocator) (fscache.Data, error) {
+	decoded := allocator.AllocateCacheData(DecodedColumnSize(encoded))
+	if err := DecodeColumn(encoded, decoded.Bytes()); err != nil {
+		decoded.Release()
+		return nil, err
+	}
+	return decoded, nil
+}
+
 func Decode(buf []byte) (any, error) {
 	header := DecodeIOEntryHeader(buf)
 	codec := GetIOEntryCodec(*header)
@@ -0,0 +1,718 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectio
+
+import (
+	"encoding/binary"
+	"math/bits"
+	"strconv"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/compress"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+// The column data can be encoded by the lightweight encodings before the
+// compression. The encoded column data is flagged by AlgEncoded in the Alg
+// of the extent, and it is decoded into the layout of the marshaled vector,
+// so the readers of the column data are not aware of the encodings.
+//
+// The layout of the encoded column data:
+//
+// IOEntryHeader | DecodedSize | Class | Type | Length | Values | Nulls | Sorted
+// --------------|-------------|-------|------|--------|--------|-------|-------
+// 4             | 4           | 1     | 20   | 4      | -      | -     | 1
+//
+// Values and Nulls start with one byte of the encoding followed by the
+// encoded data:
+//
+// encodingPlain: DataLen(4) | Data | AreaLen(4) | Area
+// encodingDict:  Count(4) | [Len(4) | Value]... | IndexWidth(1) | Indexes
+// encodingFOR:   Width(1) | Signed(1) | Base(8) | Bits(1) | Packed | AreaLen(4) | Area
+// encodingDelta: Width(1) | Signed(1) | First(8) | Base(8) | Bits(1) | Packed | AreaLen(4) | Area
+// encodingRLE:   Size(4) | Runs(4) | [Value(1) | uvarint RunLength]... [| AreaLen(4) | Area]
+//
+// The nulls are encoded in encodingPlain (NspLen | Nsp) or encodingRLE.
+
+const (
+	// AlgEncoded is set in the Alg of the extent if the column data is
+	// encoded by the lightweight encodings.
+	AlgEncoded uint8 = 0x80
+	// algMask is the mask of the compression algorithm in the Alg.
+	algMask uint8 = 0x0f
+)
+
+const (
+	encodingPlain uint8 = iota
+	encodingDict
+	encodingFOR
+	encodingDelta
+	encodingRLE
+)
+
+const (
+	// maxDictSize is the max number of the distinct values of the dictionary.
+	maxDictSize = 1 << 16
+	// encodedPrefixSize is the size of Class | Type | Length.
+	encodedPrefixSize = 1 + types.TSize + 4
+)
+
+// CompressAlg returns the compression algorithm of alg.
+func CompressAlg(alg uint8) uint8 {
+	return alg & algMask
+}
+
+// IsEncoded returns true if the column data is encoded.
+func IsEncoded(alg uint8) bool {
+	return alg&AlgEncoded != 0
+}
+
+// CompressOption is the option of the compression of the column data.
+type CompressOption struct {
+	// Alg is the compression algorithm.
+	Alg uint8
+	// Level is the level of the algorithm, 0 means the default level.
+	Level int
+	// Encoding enables the lightweight encodings of the column data, which
+	// are dictionary for the low-cardinality strings, frame-of-reference or
+	// delta for the integers and the timestamps and RLE for the booleans and
+	// the nulls.
+	Encoding bool
+}
+
+var DefaultCompressOption = CompressOption{Alg: compress.Lz4}
+
+func (opt CompressOption) String() string {
+	s := compress.T(opt.Alg).String()
+	if opt.Level != 0 {
+		s += "-" + strconv.Itoa(opt.Level)
+	}
+	if opt.Encoding {
+		s += "+encoding"
+	}
+	return s
+}
+
+// marshaledVector is the sections of the marshaled vector.
+type marshaledVector struct {
+	prefix []byte
+	typ    types.Type
+	length int
+	data   []byte
+	area   []byte
+	nsp    []byte
+	sorted byte
+}
+
+func parseMarshaledVector(buf []byte) (mv marshaledVector, ok bool) {
+	if len(buf) < encodedPrefixSize+13 {
+		return
+	}
+	mv.prefix = buf[:encodedPrefixSize]
+	if int(buf[0]) != vector.FLAT {
+		return
+	}
+	mv.typ = types.DecodeType(buf[1 : 1+types.TSize])
+	mv.length = int(types.DecodeUint32(buf[1+types.TSize:]))
+	buf = buf[encodedPrefixSize:]
+	sections := make([][]byte, 3)
+	for i := range sections {
+		if len(buf) < 4 {
+			return
+		}
+		n := int(types.DecodeUint32(buf))
+		if len(buf) < 4+n {
+			return
+		}
+		sections[i] = buf[4 : 4+n]
+		buf = buf[4+n:]
+	}
+	if len(buf) != 1 {
+		return
+	}
+	mv.data, mv.area, mv.nsp, mv.sorted = sections[0], sections[1], sections[2], buf[0]
+	ok = true
+	return
+}
+
+// encodeColumn encodes the marshaled vector, which follows the IOEntryHeader
+// in raw. It returns nil if the encodings do not make the data smaller than
+// both the raw and the decoded data, so the origin size of the extent, which
+// is the decoded size, is enough to decompress the encoded data into.
+func encodeColumn(raw []byte, dst []byte) []byte {
+	mv, ok := parseMarshaledVector(raw[IOEntryHeaderSize:])
+	if !ok {
+		return nil
+	}
+	dst = append(dst[:0], raw[:IOEntryHeaderSize]...)
+	dst = binary.LittleEndian.AppendUint32(dst, 0)
+	dst = append(dst, mv.prefix...)
+
+	decodedSize := len(raw)
+	plainSize := 1 + 4 + len(mv.data) + 4 + len(mv.area)
+	start := len(dst)
+	switch {
+	case mv.typ.IsVarlen():
+		var areaSize int
+		if dst, areaSize, ok = encodeDict(dst, &mv); ok {
+			decodedSize += areaSize - len(mv.area)
+		}
+	case mv.typ.Oid == types.T_bool:
+		dst, ok = encodeRLE(dst, mv.data), true
+		dst = appendSection(dst, mv.area)
+	default:
+		dst, ok = encodeIntegers(dst, &mv)
+	}
+	if !ok || len(dst)-start >= plainSize {
+		decodedSize = len(raw)
+		dst = dst[:start]
+		dst = append(dst, encodingPlain)
+		dst = appendSection(dst, mv.data)
+		dst = appendSection(dst, mv.area)
+	}
+
+	start = len(dst)
+	if len(mv.nsp) > 0 {
+		dst = encodeRLE(dst, mv.nsp)
+	}
+	if len(dst)-start >= 1+4+len(mv.nsp) || len(mv.nsp) == 0 {
+		dst = dst[:start]
+		dst = append(dst, encodingPlain)
+		dst = appendSection(dst, mv.nsp)
+	}
+	dst = append(dst, mv.sorted)
+
+	if len(dst) >= len(raw) || len(dst) > decodedSize {
+		return nil
+	}
+	binary.LittleEndian.PutUint32(dst[IOEntryHeaderSize:], uint32(decodedSize))
+	return dst
+}
+
+func appendSection(dst []byte, section []byte) []byte {
+	dst = binary.LittleEndian.AppendUint32(dst, uint32(len(section)))
+	return append(dst, section...)
+}
+
+// encodeDict encodes the varlena values in the dictionary. The null values
+// are encoded as the empty value. It returns the size of the decoded area,
+// which holds each distinct value once.
+func encodeDict(dst []byte, mv *marshaledVector) ([]byte, int, bool) {
+	if mv.length == 0 || len(mv.data) != mv.length*types.VarlenaSize {
+		return dst, 0, false
+	}
+	var nsp nulls.Nulls
+	if len(mv.nsp) > 0 {
+		if err := nsp.ReadNoCopy(mv.nsp); err != nil {
+			return dst, 0, false
+		}
+	}
+	varlenas := types.DecodeSlice[types.Varlena](mv.data)
+	dict := make(map[string]int)
+	values := make([][]byte, 0)
+	indexes := make([]int, mv.length)
+	areaSize := 0
+	for i := range varlenas {
+		var value []byte
+		if !nsp.Contains(uint64(i)) {
+			if !varlenas[i].IsSmall() {
+				offset, length := varlenas[i].OffsetLen()
+				if uint64(offset)+uint64(length) > uint64(len(mv.area)) {
+					return dst, 0, false
+				}
+			}
+			value = varlenas[i].GetByteSlice(mv.area)
+		}
+		idx, ok := dict[string(value)]
+		if !ok {
+			if len(values) == maxDictSize {
+				return dst, 0, false
+			}
+			idx = len(values)
+			dict[string(value)] = idx
+			values = append(values, value)
+			if len(value) > types.VarlenaInlineSize {
+				areaSize += len(value)
+			}
+		}
+		indexes[i] = idx
+	}
+
+	dst = append(dst, encodingDict)
+	dst = binary.LittleEndian.AppendUint32(dst, uint32(len(values)))
+	for _, value := range values {
+		dst = appendSection(dst, value)
+	}
+	width := 1
+	if len(values) > 1<<8 {
+		width = 2
+	}
+	dst = append(dst, byte(width))
+	for _, idx := range indexes {
+		if width == 1 {
+			dst = append(dst, byte(idx))
+		} else {
+			dst = binary.LittleEndian.AppendUint16(dst, uint16(idx))
+		}
+	}
+	return dst, areaSize, true
+}
+
+// integerEncodingOf returns the width and the signedness of the types that
+// are encoded as the integers.
+func integerEncodingOf(oid types.T) (width int, signed bool, ok bool) {
+	switch oid {
+	case types.T_int8:
+		return 1, true, true
+	case types.T_int16:
+		return 2, true, true
+	case types.T_int32, types.T_date:
+		return 4, true, true
+	case types.T_int64, types.T_time, types.T_datetime, types.T_timestamp, types.T_decimal64:
+		return 8, true, true
+	case types.T_uint8:
+		return 1, false, true
+	case types.T_uint16, types.T_enum:
+		return 2, false, true
+	case types.T_uint32:
+		return 4, false, true
+	case types.T_uint64, types.T_bit:
+		return 8, false, true
+	}
+	return 0, false, false
+}
+
+// orderKeys returns the integers as the uint64 keys that keep the order of
+// the values.
+func orderKeys(data []byte, width int, signed bool) []uint64 {
+	n := len(data) / width
+	keys := make([]uint64, n)
+	for i := 0; i < n; i++ {
+		var k uint64
+		switch width {
+		case 1:
+			k = uint64(data[i])
+			if signed {
+				k = uint64(int64(int8(k)))
+			}
+		case 2:
+			k = uint64(binary.LittleEndian.Uint16(data[i*2:]))
+			if signed {
+				k = uint64(int64(int16(k)))
+			}
+		case 4:
+			k = uint64(binary.LittleEndian.Uint32(data[i*4:]))
+			if signed {
+				k = uint64(int64(int32(k)))
+			}
+		case 8:
+			k = binary.LittleEndian.Uint64(data[i*8:])
+		}
+		if signed {
+			k ^= 1 << 63
+		}
+		keys[i] = k
+	}
+	return keys
+}
+
+func putOrderKey(dst []byte, k uint64, width int, signed bool) {
+	if signed {
+		k ^= 1 << 63
+	}
+	switch width {
+	case 1:
+		dst[0] = byte(k)
+	case 2:
+		binary.LittleEndian.PutUint16(dst, uint16(k))
+	case 4:
+		binary.LittleEndian.PutUint32(dst, uint32(k))
+	case 8:
+		binary.LittleEndian.PutUint64(dst, k)
+	}
+}
+
+// encodeIntegers encodes the integers in frame-of-reference, or in delta if
+// the values are sorted and the deltas are packed smaller.
+func encodeIntegers(dst []byte, mv *marshaledVector) ([]byte, bool) {
+	width, signed, ok := integerEncodingOf(mv.typ.Oid)
+	if !ok || mv.length == 0 || len(mv.data) != mv.length*width {
+		return dst, false
+	}
+	keys := orderKeys(mv.data, width, signed)
+
+	minKey, maxKey := keys[0], keys[0]
+	sorted := true
+	var minDelta, maxDelta uint64 = 1<<64 - 1, 0
+	for i, k := range keys {
+		minKey, maxKey = min(minKey, k), max(maxKey, k)
+		if i > 0 && sorted {
+			if k < keys[i-1] {
+				sorted = false
+				continue
+			}
+			minDelta, maxDelta = min(minDelta, k-keys[i-1]), max(maxDelta, k-keys[i-1])
+		}
+	}
+	forBits := bits.Len64(maxKey - minKey)
+	if sorted && len(keys) > 1 && bits.Len64(maxDelta-minDelta) < forBits {
+		deltaBits := bits.Len64(maxDelta - minDelta)
+		offsets := make([]uint64, len(keys)-1)
+		for i := 1; i < len(keys); i++ {
+			offsets[i-1] = keys[i] - keys[i-1] - minDelta
+		}
+		dst = append(dst, encodingDelta, byte(width), types.EncodeBool(&signed)[0])
+		dst = binary.LittleEndian.AppendUint64(dst, keys[0])
+		dst = binary.LittleEndian.AppendUint64(dst, minDelta)
+		dst = append(dst, byte(deltaBits))
+		dst = appendPacked(dst, offsets, deltaBits)
+	} else {
+		for i := range keys {
+			keys[i] -= minKey
+		}
+		dst = append(dst, encodingFOR, byte(width), types.EncodeBool(&signed)[0])
+		dst = binary.LittleEndian.AppendUint64(dst, minKey)
+		dst = append(dst, byte(forBits))
+		dst = appendPacked(dst, keys, forBits)
+	}
+	return appendSection(dst, mv.area), true
+}
+
+// appendPacked packs the values of nbits bits into dst.
+func appendPacked(dst []byte, values []uint64, nbits int) []byte {
+	if nbits == 0 {
+		return dst
+	}
+	var acc uint64
+	var used int
+	for _, v := range values {
+		acc |= v << used
+		if used+nbits >= 64 {
+			dst = binary.LittleEndian.AppendUint64(dst, acc)
+			acc = v >> (64 - used)
+			used = used + nbits - 64
+		} else {
+			used += nbits
+		}
+	}
+	for ; used > 0; used -= 8 {
+		dst = append(dst, byte(acc))
+		acc >>= 8
+	}
+	return dst
+}
+
+func packedSize(n int, nbits int) int {
+	return (n*nbits + 7) / 8
+}
+
+// unpack calls fn with the n values of nbits bits packed in src.
+func unpack(src []byte, n int, nbits int, fn func(i int, v uint64)) {
+	pos := 0
+	for i := 0; i < n; i++ {
+		var v uint64
+		for got := 0; got < nbits; {
+			off := pos & 7
+			take := min(8-off, nbits-got)
+			v |= (uint64(src[pos>>3]) >> off) & (1<<take - 1) << got
+			got += take
+			pos += take
+		}
+		fn(i, v)
+	}
+}
+
+// encodeRLE encodes the bytes in the runs of the same byte.
+func encodeRLE(dst []byte, src []byte) []byte {
+	dst = append(dst, encodingRLE)
+	dst = binary.LittleEndian.AppendUint32(dst, uint32(len(src)))
+	countAt := len(dst)
+	dst = binary.LittleEndian.AppendUint32(dst, 0)
+	runs := 0
+	for i := 0; i < len(src); {
+		j := i + 1
+		for j < len(src) && src[j] == src[i] {
+			j++
+		}
+		dst = append(dst, src[i])
+		dst = binary.AppendUvarint(dst, uint64(j-i))
+		runs++
+		i = j
+	}
+	binary.LittleEndian.PutUint32(dst[countAt:], uint32(runs))
+	return dst
+}
+
+// decoder reads the encoded column data, err is set if the data is short.
+type decoder struct {
+	buf []byte
+	err error
+}
+
+func (d *decoder) next(n int) []byte {
+	if d.err != nil {
+		return nil
+	}
+	if n < 0 || len(d.buf) < n {
+		d.err = moerr.NewInternalErrorNoCtx("invalid encoded column data")
+		return nil
+	}
+	ret := d.buf[:n]
+	d.buf = d.buf[n:]
+	return ret
+}
+
+func (d *decoder) byte() byte {
+	if b := d.next(1); b != nil {
+		return b[0]
+	}
+	return 0
+}
+
+func (d *decoder) uint32() int {
+	if b := d.next(4); b != nil {
+		return int(binary.LittleEndian.Uint32(b))
+	}
+	return 0
+}
+
+func (d *decoder) uint64() uint64 {
+	if b := d.next(8); b != nil {
+		return binary.LittleEndian.Uint64(b)
+	}
+	return 0
+}
+
+func (d *decoder) section() []byte {
+	return d.next(d.uint32())
+}
+
+// writer writes the decoded column data into the buffer of the decoded size.
+type writer struct {
+	buf []byte
+	err error
+}
+
+func (w *writer) next(n int) []byte {
+	if w.err != nil {
+		return nil
+	}
+	if n < 0 || len(w.buf) < n {
+		w.err = moerr.NewInternalErrorNoCtx("invalid decoded size of the column data")
+		return nil
+	}
+	ret := w.buf[:n]
+	w.buf = w.buf[n:]
+	return ret
+}
+
+func (w *writer) write(data []byte) {
+	if b := w.next(len(data)); b != nil {
+		copy(b, data)
+	}
+}
+
+func (w *writer) uint32(v int) {
+	if b := w.next(4); b != nil {
+		binary.LittleEndian.PutUint32(b, uint32(v))
+	}
+}
+
+func (w *writer) section(data []byte) {
+	w.uint32(len(data))
+	w.write(data)
+}
+
+// DecodedColumnSize returns the size of the decoded column data.
+func DecodedColumnSize(encoded []byte) int {
+	if len(encoded) < IOEntryHeaderSize+4 {
+		return 0
+	}
+	return int(binary.LittleEndian.Uint32(encoded[IOEntryHeaderSize:]))
+}
+
+// DecodeColumn decodes the encoded column data into dst, whose length must
+// be DecodedColumnSize(encoded).
+func DecodeColumn(encoded []byte, dst []byte) error {
+	d := &decoder{buf: encoded}
+	w := &writer{buf: dst}
+	w.write(d.next(IOEntryHeaderSize))
+	d.uint32()
+	prefix := d.next(encodedPrefixSize)
+	w.write(prefix)
+	if d.err != nil {
+		return d.err
+	}
+	length := int(types.DecodeUint32(prefix[1+types.TSize:]))
+
+	switch encoding := d.byte(); encoding {
+	case encodingPlain:
+		w.section(d.section())
+		w.section(d.section())
+	case encodingDict:
+		decodeDict(d, w, length)
+	case encodingFOR, encodingDelta:
+		decodeIntegers(d, w, encoding, length)
+		w.section(d.section())
+	case encodingRLE:
+		decodeRLE(d, w)
+		w.section(d.section())
+	default:
+		d.err = moerr.NewInternalErrorNoCtx("invalid encoding of the column data")
+	}
+
+	switch d.byte() {
+	case encodingPlain:
+		w.section(d.section())
+	case encodingRLE:
+		decodeRLE(d, w)
+	default:
+		d.err = moerr.NewInternalErrorNoCtx("invalid encoding of the nulls")
+	}
+	w.write(d.next(1))
+
+	if d.err != nil {
+		return d.err
+	}
+	if w.err == nil && len(w.buf) != 0 {
+		w.err = moerr.NewInternalErrorNoCtx("invalid decoded size of the column data")
+	}
+	return w.err
+}
+
+func decodeDict(d *decoder, w *writer, length int) {
+	count := d.uint32()
+	values := make([][]byte, 0, min(count, maxDictSize))
+	areaSize := 0
+	for i := 0; i < count && d.err == nil; i++ {
+		value := d.section()
+		values = append(values, value)
+		if len(value) > types.VarlenaInlineSize {
+			areaSize += len(value)
+		}
+	}
+	width := int(d.byte())
+	indexes := d.next(length * width)
+	if d.err != nil {
+		return
+	}
+
+	// the varlenas of the values in the dictionary
+	varlenas := make([]types.Varlena, len(values))
+	area := make([]byte, 0, areaSize)
+	for i, value := range values {
+		if len(value) > types.VarlenaInlineSize {
+			varlenas[i].SetOffsetLen(uint32(len(area)), uint32(len(value)))
+			area = append(area, value...)
+		} else {
+			varlenas[i][0] = byte(len(value))
+			copy(varlenas[i][1:], value)
+		}
+	}
+
+	w.uint32(length * types.VarlenaSize)
+	data := w.next(length * types.VarlenaSize)
+	if w.err != nil {
+		return
+	}
+	for i := 0; i < length; i++ {
+		var idx int
+		if width == 1 {
+			idx = int(indexes[i])
+		} else {
+			idx = int(binary.LittleEndian.Uint16(indexes[i*2:]))
+		}
+		if idx >= len(varlenas) {
+			d.err = moerr.NewInternalErrorNoCtx("invalid index of the dictionary")
+			return
+		}
+		copy(data[i*types.VarlenaSize:], varlenas[idx][:])
+	}
+	w.section(area)
+}
+
+func decodeIntegers(d *decoder, w *writer, encoding byte, length int) {
+	width := int(d.byte())
+	signed := types.DecodeBool(d.next(1))
+	var first uint64
+	if encoding == encodingDelta {
+		first = d.uint64()
+	}
+	base := d.uint64()
+	nbits := int(d.byte())
+	if d.err != nil || nbits > 64 {
+		d.err = moerr.NewInternalErrorNoCtx("invalid encoded integers")
+		return
+	}
+	w.uint32(length * width)
+	data := w.next(length * width)
+	if w.err != nil {
+		return
+	}
+
+	if encoding == encodingFOR {
+		packed := d.next(packedSize(length, nbits))
+		if d.err != nil {
+			return
+		}
+		unpack(packed, length, nbits, func(i int, v uint64) {
+			putOrderKey(data[i*width:], base+v, width, signed)
+		})
+		return
+	}
+
+	if length == 0 {
+		return
+	}
+	packed := d.next(packedSize(length-1, nbits))
+	if d.err != nil {
+		return
+	}
+	prev := first
+	putOrderKey(data, prev, width, signed)
+	unpack(packed, length-1, nbits, func(i int, v uint64) {
+		prev += base + v
+		putOrderKey(data[(i+1)*width:], prev, width, signed)
+	})
+}
+
+func decodeRLE(d *decoder, w *writer) {
+	size := d.uint32()
+	runs := d.uint32()
+	w.uint32(size)
+	out := w.next(size)
+	if d.err != nil || w.err != nil {
+		return
+	}
+	pos := 0
+	for i := 0; i < runs; i++ {
+		value := d.byte()
+		n, l := binary.Uvarint(d.buf)
+		if l <= 0 || uint64(size-pos) < n {
+			d.err = moerr.NewInternalErrorNoCtx("invalid encoded runs")
+			return
+		}
+		d.buf = d.buf[l:]
+		for j := 0; j < int(n); j++ {
+			out[pos+j] = value
+		}
+		pos += int(n)
+	}
+	if pos != size {
+		d.err = moerr.NewInternalErrorNoCtx("invalid encoded runs")
+	}
+}
@@ -0,0 +1,319 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectio
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/compress"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/stretchr/testify/require"
+)
+
+func marshalColumn(t *testing.T, vec *vector.Vector) []byte {
+	var buf bytes.Buffer
+	h := IOEntryHeader{IOET_ColData, IOET_ColumnData_CurrVer}
+	buf.Write(EncodeIOEntryHeader(&h))
+	require.NoError(t, vec.MarshalBinaryWithBuffer(&buf))
+	return buf.Bytes()
+}
+
+func decodeColumn(t *testing.T, encoded []byte) *vector.Vector {
+	decoded := make([]byte, DecodedColumnSize(encoded))
+	require.NoError(t, DecodeColumn(encoded, decoded))
+	obj, err := Decode(decoded)
+	require.NoError(t, err)
+	return obj.(*vector.Vector)
+}
+
+func newEncodingTestVectors(t *testing.T, mp *mpool.MPool, n int) map[string]*vector.Vector {
+	vecs := make(map[string]*vector.Vector)
+	appendVec := func(name string, typ types.Type, fn func(vec *vector.Vector, i int) error) {
+		vec := vector.NewVec(typ)
+		for i := 0; i < n; i++ {
+			require.NoError(t, fn(vec, i))
+		}
+		vecs[name] = vec
+	}
+
+	appendVec("sorted int64", types.T_int64.ToType(), func(vec *vector.Vector, i int) error {
+		return vector.AppendFixed(vec, int64(1000000+i*3), false, mp)
+	})
+	appendVec("negative int32", types.T_int32.ToType(), func(vec *vector.Vector, i int) error {
+		return vector.AppendFixed(vec, int32(i%7-3), false, mp)
+	})
+	appendVec("int8 bounds", types.T_int8.ToType(), func(vec *vector.Vector, i int) error {
+		return vector.AppendFixed(vec, int8(math.MinInt8+i%3), false, mp)
+	})
+	appendVec("uint64", types.T_uint64.ToType(), func(vec *vector.Vector, i int) error {
+		return vector.AppendFixed(vec, uint64(math.MaxUint64-uint64(i%100)), false, mp)
+	})
+	appendVec("timestamp", types.T_timestamp.ToType(), func(vec *vector.Vector, i int) error {
+		return vector.AppendFixed(vec, types.Timestamp(1700000000000000+i*1000000), false, mp)
+	})
+	appendVec("date with nulls", types.T_date.ToType(), func(vec *vector.Vector, i int) error {
+		return vector.AppendFixed(vec, types.Date(738000+i/10), i%5 == 0, mp)
+	})
+	appendVec("bool", types.T_bool.ToType(), func(vec *vector.Vector, i int) error {
+		return vector.AppendFixed(vec, i/100%2 == 0, i >= n/2, mp)
+	})
+	appendVec("varchar", types.T_varchar.ToType(), func(vec *vector.Vector, i int) error {
+		return vector.AppendBytes(vec, []byte(fmt.Sprintf("city-%d", i%5)), false, mp)
+	})
+	appendVec("long text with nulls", types.T_text.ToType(), func(vec *vector.Vector, i int) error {
+		return vector.AppendBytes(vec, []byte(strings.Repeat("matrixone", 5+i%3)), i%4 == 0, mp)
+	})
+	return vecs
+}
+
+func TestColumnEncoding(t *testing.T) {
+	mp := mpool.MustNewZeroNoFixed()
+	for name, vec := range newEncodingTestVectors(t, mp, 8192) {
+		t.Run(name, func(t *testing.T) {
+			defer vec.Free(mp)
+			raw := marshalColumn(t, vec)
+			encoded := encodeColumn(raw, nil)
+			require.NotNil(t, encoded)
+			require.Less(t, len(encoded), len(raw))
+
+			decoded := decodeColumn(t, encoded)
+			require.Equal(t, vec.Length(), decoded.Length())
+			require.Equal(t, vec.GetType().Oid, decoded.GetType().Oid)
+			require.Equal(t, vec.GetSorted(), decoded.GetSorted())
+			for i := 0; i < vec.Length(); i++ {
+				require.Equal(t, vec.IsNull(uint64(i)), decoded.IsNull(uint64(i)))
+				if !vec.IsNull(uint64(i)) {
+					require.Equal(t, vec.GetRawBytesAt(i), decoded.GetRawBytesAt(i), "row %d", i)
+				}
+			}
+		})
+	}
+}
+
+func TestColumnEncodingNotSmaller(t *testing.T) {
+	mp := mpool.MustNewZeroNoFixed()
+	vec := vector.NewVec(types.T_varchar.ToType())
+	defer vec.Free(mp)
+	for i := 0; i < 100; i++ {
+		require.NoError(t, vector.AppendBytes(vec, []byte(fmt.Sprintf("unique-%d", i)), false, mp))
+	}
+	raw := marshalColumn(t, vec)
+	encoded := encodeColumn(raw, nil)
+	if encoded != nil {
+		require.Less(t, len(encoded), len(raw))
+	}
+
+	// const vectors are not encoded
+	constVec, err := vector.NewConstFixed(types.T_int64.ToType(), int64(1), 100, mp)
+	require.NoError(t, err)
+	defer constVec.Free(mp)
+	require.Nil(t, encodeColumn(marshalColumn(t, constVec), nil))
+}
+
+func TestDecodeColumnCorrupted(t *testing.T) {
+	mp := mpool.MustNewZeroNoFixed()
+	vec := vector.NewVec(types.T_int64.ToType())
+	defer vec.Free(mp)
+	for i := 0; i < 1000; i++ {
+		require.NoError(t, vector.AppendFixed(vec, int64(i), false, mp))
+	}
+	encoded := encodeColumn(marshalColumn(t, vec), nil)
+	require.NotNil(t, encoded)
+	for _, n := range []int{IOEntryHeaderSize + 4, len(encoded) / 2, len(encoded) - 1} {
+		decoded := make([]byte, DecodedColumnSize(encoded))
+		require.Error(t, DecodeColumn(encoded[:n], decoded))
+	}
+	require.Error(t, DecodeColumn(encoded, make([]byte, DecodedColumnSize(encoded)-1)))
+}
+
+func TestBitPacking(t *testing.T) {
+	for _, nbits := range []int{0, 1, 3, 8, 13, 31, 63, 64} {
+		values := make([]uint64, 100)
+		for i := range values {
+			values[i] = uint64(i*2654435761) & (1<<nbits - 1)
+		}
+		packed := appendPacked(nil, values, nbits)
+		require.Equal(t, packedSize(len(values), nbits), len(packed))
+		unpack(packed, len(values), nbits, func(i int, v uint64) {
+			require.Equal(t, values[i], v)
+		})
+	}
+}
+
+func TestWriteWithCompressOption(t *testing.T) {
+	ctx := context.Background()
+	dir := path.Join(InitTestEnv(ModuleName, t.Name()), "/local")
+	c := fileservice.Config{
+		Name:    defines.LocalFileServiceName,
+		Backend: "DISK",
+		DataDir: dir,
+		Cache:   fileservice.DisabledCacheConfig,
+	}
+	service, err := fileservice.NewFileService(ctx, c, nil)
+	require.NoError(t, err)
+	defer service.Close()
+
+	mp := mpool.MustNewZeroNoFixed()
+	vecs := newEncodingTestVectors(t, mp, 8192)
+	bat := batch.NewWithSize(0)
+	for name, vec := range vecs {
+		bat.Attrs = append(bat.Attrs, name)
+		bat.Vecs = append(bat.Vecs, vec)
+	}
+	bat.SetRowCount(8192)
+	defer bat.Clean(mp)
+
+	for i, opt := range []CompressOption{
+		DefaultCompressOption,
+		{Alg: compress.Zstd, Level: 9},
+		{Alg: compress.Zstd, Encoding: true},
+		{Alg: compress.None, Encoding: true},
+	} {
+		t.Run(opt.String(), func(t *testing.T) {
+			name := fmt.Sprintf("%d.blk", i)
+			writer, err := NewObjectWriterSpecial(WriterNormal, name, service)
+			require.NoError(t, err)
+			writer.SetCompressOption(opt)
+			_, err = writer.Write(bat)
+			require.NoError(t, err)
+			blocks, err := writer.WriteEnd(ctx)
+			require.NoError(t, err)
+
+			reader, err := NewObjectReaderWithStr(name, service)
+			require.NoError(t, err)
+			ext := blocks[0].BlockHeader().MetaLocation()
+			reader.CacheMetaExtent(&ext)
+			meta, err := reader.ReadMeta(ctx, mp)
+			require.NoError(t, err)
+			dataMeta, _ := meta.DataMeta()
+			blockMeta := dataMeta.GetBlockMeta(0)
+
+			idxs := make([]uint16, len(bat.Vecs))
+			typs := make([]types.Type, len(bat.Vecs))
+			for i, vec := range bat.Vecs {
+				idxs[i] = uint16(i)
+				typs[i] = *vec.GetType()
+				alg := blockMeta.MustGetColumn(uint16(i)).Location().Alg()
+				require.Equal(t, opt.Alg, CompressAlg(alg))
+				if !opt.Encoding {
+					require.False(t, IsEncoded(alg))
+				}
+			}
+			ioVec, err := reader.ReadOneBlock(ctx, idxs, typs, 0, mp)
+			require.NoError(t, err)
+			defer ioVec.Release()
+			for i, vec := range bat.Vecs {
+				obj, err := Decode(ioVec.Entries[i].CachedData.Bytes())
+				require.NoError(t, err)
+				read := obj.(*vector.Vector)
+				require.Equal(t, vec.Length(), read.Length())
+				for row := 0; row < vec.Length(); row++ {
+					require.Equal(t, vec.IsNull(uint64(row)), read.IsNull(uint64(row)))
+					if !vec.IsNull(uint64(row)) {
+						require.Equal(t, vec.GetRawBytesAt(row), read.GetRawBytesAt(row))
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestWriteMixedEncodingBlocks(t *testing.T) {
+	ctx := context.Background()
+	dir := path.Join(InitTestEnv(ModuleName, t.Name()), "/local")
+	c := fileservice.Config{
+		Name:    defines.LocalFileServiceName,
+		Backend: "DISK",
+		DataDir: dir,
+		Cache:   fileservice.DisabledCacheConfig,
+	}
+	service, err := fileservice.NewFileService(ctx, c, nil)
+	require.NoError(t, err)
+	defer service.Close()
+
+	mp := mpool.MustNewZeroNoFixed()
+	// the first block is encoded by frame-of-reference, the second one can
+	// not be encoded smaller and is kept plain.
+	rnd := rand.New(rand.NewSource(1))
+	bats := make([]*batch.Batch, 2)
+	for i := range bats {
+		vec := vector.NewVec(types.T_int64.ToType())
+		for row := 0; row < 8192; row++ {
+			v := int64(1000000 + row)
+			if i == 1 {
+				v = int64(rnd.Uint64())
+			}
+			require.NoError(t, vector.AppendFixed(vec, v, false, mp))
+		}
+		bats[i] = batch.NewWithSize(1)
+		bats[i].Attrs = []string{"a"}
+		bats[i].Vecs[0] = vec
+		bats[i].SetRowCount(8192)
+		defer bats[i].Clean(mp)
+	}
+
+	writer, err := NewObjectWriterSpecial(WriterNormal, "mixed.blk", service)
+	require.NoError(t, err)
+	writer.SetCompressOption(CompressOption{Alg: compress.Lz4, Encoding: true})
+	for _, bat := range bats {
+		_, err = writer.Write(bat)
+		require.NoError(t, err)
+	}
+	writer.WriteObjectMeta(ctx, 8192*2, []ColumnMeta{BuildObjectColumnMeta()})
+	blocks, err := writer.WriteEnd(ctx)
+	require.NoError(t, err)
+
+	reader, err := NewObjectReaderWithStr("mixed.blk", service)
+	require.NoError(t, err)
+	ext := blocks[0].BlockHeader().MetaLocation()
+	reader.CacheMetaExtent(&ext)
+	meta, err := reader.ReadMeta(ctx, mp)
+	require.NoError(t, err)
+	dataMeta, _ := meta.DataMeta()
+
+	var originSize uint32
+	for i, bat := range bats {
+		loc := dataMeta.GetColumnMeta(uint32(i), 0).Location()
+		require.Equal(t, i == 0, IsEncoded(loc.Alg()))
+		require.Equal(t, uint8(compress.Lz4), CompressAlg(loc.Alg()))
+		require.Equal(t, uint32(len(marshalColumn(t, bat.Vecs[0]))), loc.OriginSize())
+		originSize += loc.OriginSize()
+
+		ioVec, err := reader.ReadOneBlock(ctx, []uint16{0}, []types.Type{types.T_int64.ToType()}, uint16(i), mp)
+		require.NoError(t, err)
+		obj, err := Decode(ioVec.Entries[0].CachedData.Bytes())
+		require.NoError(t, err)
+		require.Equal(t,
+			vector.MustFixedColWithTypeCheck[int64](bat.Vecs[0]),
+			vector.MustFixedColWithTypeCheck[int64](obj.(*vector.Vector)))
+		ioVec.Release()
+	}
+	loc := dataMeta.MustGetColumn(0).Location()
+	require.False(t, IsEncoded(loc.Alg()))
+	require.Equal(t, uint8(compress.Lz4), CompressAlg(loc.Alg()))
+	require.Equal(t, originSize, loc.OriginSize())
+}
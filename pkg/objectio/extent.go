@@ -24,7 +24,7 @@ import (
 // Alg | Offset | Length | OriginSize
 // ----|--------|--------|------------
 // 1   | 4      | 4      | 4
-// Alg: Specifies the compression algorithm
+// Alg: Specifies the compression algorithm, AlgEncoded is set if the data is encoded
 // Offset: The offset of the compressed data in the file
 // Length: The length of the compressed data
 // OriginSize: The length of the original data
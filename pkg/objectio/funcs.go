@@ -25,6 +25,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
@@ -71,6 +72,36 @@ func ReadExtent(
 	return
 }
 
+// VerifyExtent reads the extent bypassing all caches and decodes the
+// IOEntry stored in it. Checksum, decompression and decoding failures
+// are returned as errors instead of panics.
+func VerifyExtent(
+	ctx context.Context,
+	name string,
+	extent *Extent,
+	fs fileservice.FileService,
+) (err error) {
+	var v []byte
+	if v, err = ReadExtent(
+		ctx,
+		name,
+		extent,
+		fileservice.SkipAllCache,
+		fs,
+		constructorFactory); err != nil {
+		return
+	}
+	if len(v) < IOEntryHeaderSize {
+		return moerr.NewInternalErrorNoCtxf("extent %s: short io entry of %d bytes", extent.String(), len(v))
+	}
+	header := DecodeIOEntryHeader(v)
+	if !IsKnownIOEntry(*header) {
+		return moerr.NewInternalErrorNoCtxf("extent %s: unknown %s", extent.String(), header.String())
+	}
+	_, err = Decode(v)
+	return
+}
+
 func ReadBloomFilter(
 	ctx context.Context,
 	name string,
@@ -342,6 +342,14 @@ func BuildHeader() Header {
 	return buf[:]
 }
 
+func (h Header) Magic() uint64 {
+	return types.DecodeUint64(h[:8])
+}
+
+func (h Header) Version() uint16 {
+	return types.DecodeUint16(h[8 : 8+2])
+}
+
 func (h Header) SetExtent(location Extent) {
 	copy(h[8+2:8+2+ExtentSize], location)
 }
@@ -39,3 +39,7 @@ func (e ObjectMeta) MustTombstoneMeta() ObjectDataMeta {
 func (e ObjectMeta) SubMeta(pos uint16) (ObjectDataMeta, bool) {
 	return objectMetaV3(e[IOEntryHeaderSize:]).SubMeta(pos)
 }
+
+func (e ObjectMeta) SubMetaCount() uint16 {
+	return objectMetaV3(e[IOEntryHeaderSize:]).SubMetaCount()
+}
@@ -26,7 +26,6 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
-	"github.com/pierrec/lz4/v4"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
@@ -47,6 +46,8 @@ type objectWriterV1 struct {
 	lastId            uint32
 	name              ObjectName
 	compressBuf       []byte
+	encodeBuf         []byte
+	compressOpt       CompressOption
 	bloomFilter       []byte
 	objStats          ObjectStats
 	sortKeySeqnum     uint16
@@ -107,6 +108,7 @@ func newObjectWriterSpecialV1(wt WriterType, fileName string, fs fileservice.Fil
 		blocks:        make([][]blockData, 2),
 		lastId:        0,
 		sortKeySeqnum: math.MaxUint16,
+		compressOpt:   DefaultCompressOption,
 	}
 	writer.blocks[SchemaData] = make([]blockData, 0)
 	writer.blocks[SchemaTombstone] = make([]blockData, 0)
@@ -126,6 +128,7 @@ func newObjectWriterV1(name ObjectName, fs fileservice.FileService, schemaVersio
 		blocks:        make([][]blockData, 2),
 		lastId:        0,
 		sortKeySeqnum: math.MaxUint16,
+		compressOpt:   DefaultCompressOption,
 	}
 	writer.blocks[SchemaData] = make([]blockData, 0)
 	writer.blocks[SchemaTombstone] = make([]blockData, 0)
@@ -247,6 +250,11 @@ func (w *objectWriterV1) SetSortKeySeqnum(seqnum uint16) {
 	w.sortKeySeqnum = seqnum
 }
 
+// SetCompressOption sets the option of the compression of the column data.
+func (w *objectWriterV1) SetCompressOption(opt CompressOption) {
+	w.compressOpt = opt
+}
+
 func (w *objectWriterV1) WriteObjectMetaBF(buf []byte) (err error) {
 	w.bloomFilter = buf
 	return
@@ -328,7 +336,9 @@ func (w *objectWriterV1) prepareBlockMeta(offset uint32, blocks []blockData, col
 		off = offset
 		size = 0
 		oSize = 0
-		alg := uint8(0)
+		// the encoding is kept in the extent of each block, the column meta
+		// of the object is flagged as encoded only if all the blocks are.
+		alg, first := uint8(0), true
 		for i, block := range blocks {
 			if block.meta.BlockHeader().ColumnCount() <= idx {
 				continue
@@ -340,7 +350,11 @@ func (w *objectWriterV1) prepareBlockMeta(offset uint32, blocks []blockData, col
 			offset += location.Length()
 			size += location.Length()
 			oSize += location.OriginSize()
-			alg = location.Alg()
+			if first {
+				alg, first = location.Alg(), false
+			} else if alg != location.Alg() {
+				alg = CompressAlg(alg)
+			}
 		}
 		if uint16(len(colmeta)) <= idx {
 			continue
@@ -625,22 +639,49 @@ func (w *objectWriterV1) GetDataStats() ObjectStats {
 }
 
 func (w *objectWriterV1) WriteWithCompress(offset uint32, buf []byte) (data []byte, extent Extent, err error) {
+	return w.writeWithCompress(offset, buf, compress.Lz4, 0)
+}
+
+func (w *objectWriterV1) writeWithCompress(offset uint32, buf []byte, alg uint8, level int) (data []byte, extent Extent, err error) {
 	var tmpData []byte
 	dataLen := len(buf)
-	compressBlockBound := lz4.CompressBlockBound(dataLen)
-	if len(w.compressBuf) < compressBlockBound {
-		w.compressBuf = make([]byte, compressBlockBound)
-	}
-	if tmpData, err = compress.Compress(buf, w.compressBuf[:compressBlockBound], compress.Lz4); err != nil {
-		return
+	switch CompressAlg(alg) {
+	case compress.None:
+		tmpData = buf
+	default:
+		compressBlockBound := compress.CompressBound(dataLen, int(CompressAlg(alg)))
+		if len(w.compressBuf) < compressBlockBound {
+			w.compressBuf = make([]byte, compressBlockBound)
+		}
+		if tmpData, err = compress.CompressWithLevel(buf, w.compressBuf[:compressBlockBound], int(CompressAlg(alg)), level); err != nil {
+			return
+		}
 	}
 	length := uint32(len(tmpData))
 	data = make([]byte, length)
 	copy(data, tmpData[:length])
-	extent = NewExtent(compress.Lz4, offset, length, uint32(dataLen))
+	extent = NewExtent(alg, offset, length, uint32(dataLen))
 	return
 }
 
+// writeColumn compresses the marshaled column data with the compress option,
+// the data is encoded first if the encodings make it smaller. The origin size
+// of the extent is always the size of the decoded data.
+func (w *objectWriterV1) writeColumn(buf []byte) (data []byte, extent Extent, err error) {
+	alg := w.compressOpt.Alg
+	if w.compressOpt.Encoding {
+		if encoded := encodeColumn(buf, w.encodeBuf); encoded != nil {
+			w.encodeBuf = encoded
+			if data, extent, err = w.writeWithCompress(0, encoded, alg|AlgEncoded, w.compressOpt.Level); err != nil {
+				return
+			}
+			extent.SetOriginSize(uint32(DecodedColumnSize(encoded)))
+			return
+		}
+	}
+	return w.writeWithCompress(0, buf, alg, w.compressOpt.Level)
+}
+
 func (w *objectWriterV1) addBlock(blocks *[]blockData, blockMeta BlockObject, bat *batch.Batch, seqnums *Seqnums) (int, error) {
 	// CHANGE ME
 	// block.BlockHeader()return w.WriteWithCompress(offset, buf.Bytes()).SetBlockID(w.lastId)
@@ -669,7 +710,7 @@ func (w *objectWriterV1) addBlock(blocks *[]blockData, blockMeta BlockObject, ba
 			return 0, err
 		}
 		var ext Extent
-		if data, ext, err = w.WriteWithCompress(0, buf.Bytes()); err != nil {
+		if data, ext, err = w.writeColumn(buf.Bytes()); err != nil {
 			return 0, err
 		}
 		size += len(data)
@@ -680,7 +721,7 @@ func (w *objectWriterV1) addBlock(blocks *[]blockData, blockMeta BlockObject, ba
 			panic("any type batch")
 		}
 		blockMeta.ColumnMeta(seqnums.Seqs[i]).SetNullCnt(uint32(vec.GetNulls().GetCardinality()))
-		w.originSize += ext.OriginSize()
+		w.originSize += uint32(buf.Len())
 	}
 	blockMeta.BlockHeader().SetRows(uint32(rows))
 	*blocks = append(*blocks, block)
@@ -118,6 +118,30 @@ func NewAddPartitionReq(did, tid uint64, partitionDef *plan.PartitionByDef) *Alt
 	}
 }
 
+func NewDropPartitionReq(did, tid uint64, partitionDef *plan.PartitionByDef) *AlterTableReq {
+	return &AlterTableReq{
+		DbId:    did,
+		TableId: tid,
+		Kind:    AlterKind_DropPartition,
+		Operation: &AlterTableReq_DropPartition{
+			DropPartition: &AlterTableDropPartition{
+				PartitionDef: partitionDef,
+			},
+		},
+	}
+}
+
+func NewUpdateKindReq(did, tid uint64, kind string) *AlterTableReq {
+	return &AlterTableReq{
+		DbId:    did,
+		TableId: tid,
+		Kind:    AlterKind_UpdateKind,
+		Operation: &AlterTableReq_UpdateKind{
+			UpdateKind: &AlterTableKind{Kind: kind},
+		},
+	}
+}
+
 func NewRenameColumnReq(did, tid uint64, oldname, newname string, seqnum uint32) *AlterTableReq {
 	return &AlterTableReq{
 		DbId:    did,
@@ -105,18 +105,22 @@ const (
 	AlterKind_UpdatePolicy     AlterKind = 6
 	AlterKind_AddPartition     AlterKind = 7
 	AlterKind_RenameColumn     AlterKind = 8
+	AlterKind_DropPartition    AlterKind = 9
+	AlterKind_UpdateKind       AlterKind = 10
 )
 
 var AlterKind_name = map[int32]string{
-	0: "Invalid",
-	1: "AddColumn",
-	2: "DropColumn",
-	3: "RenameTable",
-	4: "UpdateComment",
-	5: "UpdateConstraint",
-	6: "UpdatePolicy",
-	7: "AddPartition",
-	8: "RenameColumn",
+	0:  "Invalid",
+	1:  "AddColumn",
+	2:  "DropColumn",
+	3:  "RenameTable",
+	4:  "UpdateComment",
+	5:  "UpdateConstraint",
+	6:  "UpdatePolicy",
+	7:  "AddPartition",
+	8:  "RenameColumn",
+	9:  "DropPartition",
+	10: "UpdateKind",
 }
 
 var AlterKind_value = map[string]int32{
@@ -129,6 +133,8 @@ var AlterKind_value = map[string]int32{
 	"UpdatePolicy":     6,
 	"AddPartition":     7,
 	"RenameColumn":     8,
+	"DropPartition":    9,
+	"UpdateKind":       10,
 }
 
 func (x AlterKind) String() string {
@@ -178,6 +184,8 @@ const (
 	Entry_Update        Entry_EntryType = 2
 	Entry_Alter         Entry_EntryType = 3
 	Entry_SpecialDelete Entry_EntryType = 4
+	// Read is the read set of a serializable txn
+	Entry_Read Entry_EntryType = 5
 )
 
 var Entry_EntryType_name = map[int32]string{
@@ -186,6 +194,7 @@ var Entry_EntryType_name = map[int32]string{
 	2: "Update",
 	3: "Alter",
 	4: "SpecialDelete",
+	5: "Read",
 }
 
 var Entry_EntryType_value = map[string]int32{
@@ -194,6 +203,7 @@ var Entry_EntryType_value = map[string]int32{
 	"Update":        2,
 	"Alter":         3,
 	"SpecialDelete": 4,
+	"Read":          5,
 }
 
 func (x Entry_EntryType) String() string {
@@ -1109,14 +1119,23 @@ func (m *MetadataCkp) GetBat() *Batch {
 }
 
 type AlterTablePolicy struct {
-	MinOsizeQuailifed    uint32      `protobuf:"varint,1,opt,name=min_osize_quailifed,json=minOsizeQuailifed,proto3" json:"min_osize_quailifed,omitempty"`
-	MaxObjOnerun         uint32      `protobuf:"varint,2,opt,name=max_obj_onerun,json=maxObjOnerun,proto3" json:"max_obj_onerun,omitempty"`
-	MaxOsizeMergedObj    uint32      `protobuf:"varint,3,opt,name=max_osize_merged_obj,json=maxOsizeMergedObj,proto3" json:"max_osize_merged_obj,omitempty"`
-	Hints                []MergeHint `protobuf:"varint,4,rep,packed,name=hints,proto3,enum=api.MergeHint" json:"hints,omitempty"`
-	MinCnMergeSize       uint64      `protobuf:"varint,5,opt,name=min_cn_merge_size,json=minCnMergeSize,proto3" json:"min_cn_merge_size,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	MinOsizeQuailifed uint32      `protobuf:"varint,1,opt,name=min_osize_quailifed,json=minOsizeQuailifed,proto3" json:"min_osize_quailifed,omitempty"`
+	MaxObjOnerun      uint32      `protobuf:"varint,2,opt,name=max_obj_onerun,json=maxObjOnerun,proto3" json:"max_obj_onerun,omitempty"`
+	MaxOsizeMergedObj uint32      `protobuf:"varint,3,opt,name=max_osize_merged_obj,json=maxOsizeMergedObj,proto3" json:"max_osize_merged_obj,omitempty"`
+	Hints             []MergeHint `protobuf:"varint,4,rep,packed,name=hints,proto3,enum=api.MergeHint" json:"hints,omitempty"`
+	MinCnMergeSize    uint64      `protobuf:"varint,5,opt,name=min_cn_merge_size,json=minCnMergeSize,proto3" json:"min_cn_merge_size,omitempty"`
+	// the compression of the column data, see objectio.CompressOption.
+	// An empty compress_alg keeps the algorithm of the table, and the level
+	// and the encoding are only changed if set_compress_level and
+	// set_column_encoding are true.
+	CompressAlg          string   `protobuf:"bytes,6,opt,name=compress_alg,json=compressAlg,proto3" json:"compress_alg,omitempty"`
+	CompressLevel        int32    `protobuf:"varint,7,opt,name=compress_level,json=compressLevel,proto3" json:"compress_level,omitempty"`
+	ColumnEncoding       bool     `protobuf:"varint,8,opt,name=column_encoding,json=columnEncoding,proto3" json:"column_encoding,omitempty"`
+	SetCompressLevel     bool     `protobuf:"varint,9,opt,name=set_compress_level,json=setCompressLevel,proto3" json:"set_compress_level,omitempty"`
+	SetColumnEncoding    bool     `protobuf:"varint,10,opt,name=set_column_encoding,json=setColumnEncoding,proto3" json:"set_column_encoding,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *AlterTablePolicy) Reset()         { *m = AlterTablePolicy{} }
@@ -1187,6 +1206,41 @@ func (m *AlterTablePolicy) GetMinCnMergeSize() uint64 {
 	return 0
 }
 
+func (m *AlterTablePolicy) GetCompressAlg() string {
+	if m != nil {
+		return m.CompressAlg
+	}
+	return ""
+}
+
+func (m *AlterTablePolicy) GetCompressLevel() int32 {
+	if m != nil {
+		return m.CompressLevel
+	}
+	return 0
+}
+
+func (m *AlterTablePolicy) GetColumnEncoding() bool {
+	if m != nil {
+		return m.ColumnEncoding
+	}
+	return false
+}
+
+func (m *AlterTablePolicy) GetSetCompressLevel() bool {
+	if m != nil {
+		return m.SetCompressLevel
+	}
+	return false
+}
+
+func (m *AlterTablePolicy) GetSetColumnEncoding() bool {
+	if m != nil {
+		return m.SetColumnEncoding
+	}
+	return false
+}
+
 type AlterTableConstraint struct {
 	Constraints          []byte   `protobuf:"bytes,1,opt,name=constraints,proto3" json:"constraints,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1501,6 +1555,102 @@ func (m *AlterTableAddPartition) GetPartitionDef() *plan.PartitionByDef {
 	return nil
 }
 
+type AlterTableDropPartition struct {
+	PartitionDef         *plan.PartitionByDef `protobuf:"bytes,1,opt,name=partition_def,json=partitionDef,proto3" json:"partition_def,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *AlterTableDropPartition) Reset()         { *m = AlterTableDropPartition{} }
+func (m *AlterTableDropPartition) String() string { return proto.CompactTextString(m) }
+func (*AlterTableDropPartition) ProtoMessage()    {}
+func (*AlterTableDropPartition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{20}
+}
+func (m *AlterTableDropPartition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterTableDropPartition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterTableDropPartition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterTableDropPartition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTableDropPartition.Merge(m, src)
+}
+func (m *AlterTableDropPartition) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AlterTableDropPartition) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTableDropPartition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterTableDropPartition proto.InternalMessageInfo
+
+func (m *AlterTableDropPartition) GetPartitionDef() *plan.PartitionByDef {
+	if m != nil {
+		return m.PartitionDef
+	}
+	return nil
+}
+
+// AlterTableKind changes the relkind of the table, such as the tables swapped
+// by EXCHANGE PARTITION.
+type AlterTableKind struct {
+	Kind                 string   `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlterTableKind) Reset()         { *m = AlterTableKind{} }
+func (m *AlterTableKind) String() string { return proto.CompactTextString(m) }
+func (*AlterTableKind) ProtoMessage()    {}
+func (*AlterTableKind) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{21}
+}
+func (m *AlterTableKind) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterTableKind) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterTableKind.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterTableKind) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTableKind.Merge(m, src)
+}
+func (m *AlterTableKind) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AlterTableKind) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTableKind.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterTableKind proto.InternalMessageInfo
+
+func (m *AlterTableKind) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
 type AlterTableDropColumn struct {
 	LogicalIdx           uint32   `protobuf:"varint,1,opt,name=logical_idx,json=logicalIdx,proto3" json:"logical_idx,omitempty"`
 	SequenceNum          uint32   `protobuf:"varint,2,opt,name=sequence_num,json=sequenceNum,proto3" json:"sequence_num,omitempty"`
@@ -1513,7 +1663,7 @@ func (m *AlterTableDropColumn) Reset()         { *m = AlterTableDropColumn{} }
 func (m *AlterTableDropColumn) String() string { return proto.CompactTextString(m) }
 func (*AlterTableDropColumn) ProtoMessage()    {}
 func (*AlterTableDropColumn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{20}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{22}
 }
 func (m *AlterTableDropColumn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1569,6 +1719,8 @@ type AlterTableReq struct {
 	//	*AlterTableReq_UpdatePolicy
 	//	*AlterTableReq_AddPartition
 	//	*AlterTableReq_RenameCol
+	//	*AlterTableReq_DropPartition
+	//	*AlterTableReq_UpdateKind
 	Operation            isAlterTableReq_Operation `protobuf_oneof:"operation"`
 	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
 	XXX_unrecognized     []byte                    `json:"-"`
@@ -1579,7 +1731,7 @@ func (m *AlterTableReq) Reset()         { *m = AlterTableReq{} }
 func (m *AlterTableReq) String() string { return proto.CompactTextString(m) }
 func (*AlterTableReq) ProtoMessage()    {}
 func (*AlterTableReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{21}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{23}
 }
 func (m *AlterTableReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1638,6 +1790,12 @@ type AlterTableReq_AddPartition struct {
 type AlterTableReq_RenameCol struct {
 	RenameCol *AlterTableRenameCol `protobuf:"bytes,11,opt,name=rename_col,json=renameCol,proto3,oneof" json:"rename_col,omitempty"`
 }
+type AlterTableReq_DropPartition struct {
+	DropPartition *AlterTableDropPartition `protobuf:"bytes,12,opt,name=drop_partition,json=dropPartition,proto3,oneof" json:"drop_partition,omitempty"`
+}
+type AlterTableReq_UpdateKind struct {
+	UpdateKind *AlterTableKind `protobuf:"bytes,13,opt,name=update_kind,json=updateKind,proto3,oneof" json:"update_kind,omitempty"`
+}
 
 func (*AlterTableReq_AddColumn) isAlterTableReq_Operation()     {}
 func (*AlterTableReq_DropColumn) isAlterTableReq_Operation()    {}
@@ -1647,6 +1805,8 @@ func (*AlterTableReq_UpdateCstr) isAlterTableReq_Operation()    {}
 func (*AlterTableReq_UpdatePolicy) isAlterTableReq_Operation()  {}
 func (*AlterTableReq_AddPartition) isAlterTableReq_Operation()  {}
 func (*AlterTableReq_RenameCol) isAlterTableReq_Operation()     {}
+func (*AlterTableReq_DropPartition) isAlterTableReq_Operation() {}
+func (*AlterTableReq_UpdateKind) isAlterTableReq_Operation()    {}
 
 func (m *AlterTableReq) GetOperation() isAlterTableReq_Operation {
 	if m != nil {
@@ -1732,6 +1892,20 @@ func (m *AlterTableReq) GetRenameCol() *AlterTableRenameCol {
 	return nil
 }
 
+func (m *AlterTableReq) GetDropPartition() *AlterTableDropPartition {
+	if x, ok := m.GetOperation().(*AlterTableReq_DropPartition); ok {
+		return x.DropPartition
+	}
+	return nil
+}
+
+func (m *AlterTableReq) GetUpdateKind() *AlterTableKind {
+	if x, ok := m.GetOperation().(*AlterTableReq_UpdateKind); ok {
+		return x.UpdateKind
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*AlterTableReq) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1743,6 +1917,8 @@ func (*AlterTableReq) XXX_OneofWrappers() []interface{} {
 		(*AlterTableReq_UpdatePolicy)(nil),
 		(*AlterTableReq_AddPartition)(nil),
 		(*AlterTableReq_RenameCol)(nil),
+		(*AlterTableReq_DropPartition)(nil),
+		(*AlterTableReq_UpdateKind)(nil),
 	}
 }
 
@@ -1758,6 +1934,9 @@ type SchemaExtra struct {
 	MaxOsizeMergedObj    uint32      `protobuf:"varint,7,opt,name=max_osize_merged_obj,json=maxOsizeMergedObj,proto3" json:"max_osize_merged_obj,omitempty"`
 	Hints                []MergeHint `protobuf:"varint,8,rep,packed,name=hints,proto3,enum=api.MergeHint" json:"hints,omitempty"`
 	MinCnMergeSize       uint64      `protobuf:"varint,9,opt,name=min_cn_merge_size,json=minCnMergeSize,proto3" json:"min_cn_merge_size,omitempty"`
+	CompressAlg          string      `protobuf:"bytes,10,opt,name=compress_alg,json=compressAlg,proto3" json:"compress_alg,omitempty"`
+	CompressLevel        int32       `protobuf:"varint,11,opt,name=compress_level,json=compressLevel,proto3" json:"compress_level,omitempty"`
+	ColumnEncoding       bool        `protobuf:"varint,12,opt,name=column_encoding,json=columnEncoding,proto3" json:"column_encoding,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
 	XXX_sizecache        int32       `json:"-"`
@@ -1767,7 +1946,7 @@ func (m *SchemaExtra) Reset()         { *m = SchemaExtra{} }
 func (m *SchemaExtra) String() string { return proto.CompactTextString(m) }
 func (*SchemaExtra) ProtoMessage()    {}
 func (*SchemaExtra) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{22}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{24}
 }
 func (m *SchemaExtra) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1859,6 +2038,27 @@ func (m *SchemaExtra) GetMinCnMergeSize() uint64 {
 	return 0
 }
 
+func (m *SchemaExtra) GetCompressAlg() string {
+	if m != nil {
+		return m.CompressAlg
+	}
+	return ""
+}
+
+func (m *SchemaExtra) GetCompressLevel() int32 {
+	if m != nil {
+		return m.CompressLevel
+	}
+	return 0
+}
+
+func (m *SchemaExtra) GetColumnEncoding() bool {
+	if m != nil {
+		return m.ColumnEncoding
+	}
+	return false
+}
+
 // Int64Map mainly used in unit test
 type Int64Map struct {
 	M                    map[int64]int64 `protobuf:"bytes,1,rep,name=m,proto3" json:"m,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
@@ -1871,7 +2071,7 @@ func (m *Int64Map) Reset()         { *m = Int64Map{} }
 func (m *Int64Map) String() string { return proto.CompactTextString(m) }
 func (*Int64Map) ProtoMessage()    {}
 func (*Int64Map) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{23}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{25}
 }
 func (m *Int64Map) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1920,7 +2120,7 @@ func (m *TransDestPos) Reset()         { *m = TransDestPos{} }
 func (m *TransDestPos) String() string { return proto.CompactTextString(m) }
 func (*TransDestPos) ProtoMessage()    {}
 func (*TransDestPos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{24}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{26}
 }
 func (m *TransDestPos) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1981,7 +2181,7 @@ func (m *BlkTransMap) Reset()         { *m = BlkTransMap{} }
 func (m *BlkTransMap) String() string { return proto.CompactTextString(m) }
 func (*BlkTransMap) ProtoMessage()    {}
 func (*BlkTransMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{25}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{27}
 }
 func (m *BlkTransMap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2028,7 +2228,7 @@ func (m *BlkTransferBooking) Reset()         { *m = BlkTransferBooking{} }
 func (m *BlkTransferBooking) String() string { return proto.CompactTextString(m) }
 func (*BlkTransferBooking) ProtoMessage()    {}
 func (*BlkTransferBooking) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{26}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{28}
 }
 func (m *BlkTransferBooking) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2083,7 +2283,7 @@ func (m *MergeCommitEntry) Reset()         { *m = MergeCommitEntry{} }
 func (m *MergeCommitEntry) String() string { return proto.CompactTextString(m) }
 func (*MergeCommitEntry) ProtoMessage()    {}
 func (*MergeCommitEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{27}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{29}
 }
 func (m *MergeCommitEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2195,7 +2395,7 @@ func (m *MergeTaskEntry) Reset()         { *m = MergeTaskEntry{} }
 func (m *MergeTaskEntry) String() string { return proto.CompactTextString(m) }
 func (*MergeTaskEntry) ProtoMessage()    {}
 func (*MergeTaskEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{28}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{30}
 }
 func (m *MergeTaskEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2312,6 +2512,8 @@ func init() {
 	proto.RegisterType((*AlterTableRenameCol)(nil), "api.AlterTableRenameCol")
 	proto.RegisterType((*AlterTableAddColumn)(nil), "api.AlterTableAddColumn")
 	proto.RegisterType((*AlterTableAddPartition)(nil), "api.AlterTableAddPartition")
+	proto.RegisterType((*AlterTableDropPartition)(nil), "api.AlterTableDropPartition")
+	proto.RegisterType((*AlterTableKind)(nil), "api.AlterTableKind")
 	proto.RegisterType((*AlterTableDropColumn)(nil), "api.AlterTableDropColumn")
 	proto.RegisterType((*AlterTableReq)(nil), "api.AlterTableReq")
 	proto.RegisterType((*SchemaExtra)(nil), "api.SchemaExtra")
@@ -2328,156 +2530,167 @@ func init() {
 func init() { proto.RegisterFile("api.proto", fileDescriptor_00212fb1f9d3bf1c) }
 
 var fileDescriptor_00212fb1f9d3bf1c = []byte{
-	// 2375 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x49, 0x6f, 0x1c, 0xc7,
-	0xf5, 0x67, 0xcf, 0x3e, 0xaf, 0x67, 0x69, 0x96, 0x28, 0x79, 0x4c, 0xfb, 0x2f, 0xf1, 0xdf, 0xde,
-	0x68, 0x3b, 0xa6, 0x10, 0xda, 0x49, 0x6c, 0xc3, 0xb0, 0x21, 0x0e, 0x6d, 0x71, 0x12, 0x51, 0xc3,
-	0x34, 0x47, 0x36, 0x60, 0x04, 0x68, 0xd4, 0x74, 0x17, 0x87, 0xad, 0xe9, 0xae, 0x2a, 0x55, 0xd7,
-	0x50, 0xa4, 0xaf, 0x49, 0xbe, 0x40, 0x6e, 0xb9, 0xd9, 0xa7, 0x1c, 0x92, 0x63, 0xce, 0x39, 0x06,
-	0x3e, 0x3a, 0xc8, 0xbe, 0xc2, 0x70, 0x80, 0x20, 0xcb, 0x35, 0x1f, 0x20, 0xa8, 0xa5, 0x67, 0x86,
-	0x94, 0xec, 0xc4, 0x41, 0x00, 0x1f, 0x48, 0x54, 0xfd, 0xde, 0xd2, 0x6f, 0xab, 0x7a, 0xaf, 0x06,
-	0x9a, 0x98, 0x27, 0x5b, 0x5c, 0x30, 0xc9, 0x50, 0x19, 0xf3, 0x64, 0xfd, 0x85, 0x49, 0x22, 0x8f,
-	0x67, 0xe3, 0xad, 0x88, 0x65, 0xd7, 0x27, 0x6c, 0xc2, 0xae, 0x6b, 0xda, 0x78, 0x76, 0xa4, 0x77,
-	0x7a, 0xa3, 0x57, 0x46, 0x66, 0xbd, 0x2b, 0x93, 0x8c, 0xe4, 0x12, 0x67, 0xdc, 0x02, 0xc0, 0x53,
-	0x4c, 0xcd, 0xda, 0xff, 0x1a, 0xb4, 0x47, 0xb7, 0x0f, 0x12, 0x3a, 0x09, 0xc8, 0xbd, 0x19, 0xc9,
-	0x25, 0x7a, 0x1c, 0x9a, 0x1c, 0x0b, 0x9c, 0x11, 0x49, 0x44, 0xcf, 0xd9, 0x70, 0x36, 0x9b, 0xc1,
-	0x02, 0x78, 0xb5, 0xf1, 0xfe, 0x07, 0xd7, 0x9c, 0x8f, 0x3f, 0xb8, 0xb6, 0xe2, 0xff, 0xd8, 0x81,
-	0x4e, 0x21, 0x99, 0x73, 0x46, 0x73, 0x82, 0x7a, 0x50, 0xcf, 0x25, 0x13, 0x64, 0xb0, 0x6b, 0x05,
-	0x8b, 0x2d, 0x7a, 0x1a, 0x3a, 0x39, 0x11, 0x27, 0x49, 0x44, 0x6e, 0xc4, 0xb1, 0x20, 0x79, 0xde,
-	0x2b, 0x69, 0x86, 0x0b, 0xa8, 0xd6, 0x70, 0x8c, 0x45, 0x3c, 0xd8, 0xed, 0x95, 0x37, 0x9c, 0xcd,
-	0x4a, 0x50, 0x6c, 0x95, 0x59, 0x82, 0xf0, 0x34, 0x89, 0xf0, 0x60, 0xb7, 0x57, 0xd1, 0xb4, 0x05,
-	0x80, 0xae, 0x02, 0xa4, 0x6c, 0x72, 0x68, 0x45, 0xab, 0x9a, 0xbc, 0x84, 0x2c, 0x99, 0xfd, 0x2a,
-	0x78, 0xa3, 0xdb, 0x87, 0x52, 0x2c, 0xdb, 0xad, 0x75, 0xcb, 0x99, 0xa0, 0x87, 0x72, 0xee, 0xf2,
-	0x1c, 0x58, 0x92, 0xfd, 0xa1, 0x03, 0xb5, 0xb7, 0x49, 0x24, 0x99, 0x40, 0x08, 0x2a, 0x31, 0x96,
-	0x58, 0x73, 0xb7, 0x02, 0xbd, 0x46, 0x57, 0xa1, 0x22, 0xcf, 0x38, 0xd1, 0xae, 0xb9, 0xdb, 0xb0,
-	0xa5, 0xa3, 0x3c, 0x3a, 0xe3, 0x24, 0xd0, 0x38, 0x5a, 0x87, 0x06, 0x9d, 0xa5, 0x29, 0x1e, 0xa7,
-	0x44, 0x7b, 0xd7, 0x08, 0xe6, 0x7b, 0xe4, 0x41, 0x99, 0xe6, 0x5c, 0x3b, 0xd6, 0x0a, 0xd4, 0x12,
-	0x3d, 0x0a, 0x8d, 0x24, 0x0f, 0x23, 0x46, 0x73, 0xa9, 0x1d, 0x6a, 0x04, 0xf5, 0x24, 0xef, 0xab,
-	0xad, 0x62, 0x4e, 0x09, 0xed, 0xd5, 0x36, 0x9c, 0xcd, 0x76, 0xa0, 0x96, 0xca, 0x1c, 0x2c, 0x08,
-	0xee, 0xd5, 0x8d, 0x39, 0x6a, 0xed, 0x7f, 0x1d, 0xaa, 0x3b, 0x58, 0x46, 0xc7, 0x68, 0x1d, 0xaa,
-	0x58, 0x4a, 0x91, 0xf7, 0x9c, 0x8d, 0xf2, 0x66, 0x73, 0xa7, 0xf2, 0xe1, 0x9f, 0xae, 0xad, 0x04,
-	0x06, 0x42, 0x4f, 0x41, 0xe5, 0x84, 0x44, 0x2a, 0x1d, 0xe5, 0x4d, 0x77, 0xdb, 0xdd, 0x52, 0x95,
-	0x66, 0x5c, 0xb4, 0x7c, 0x9a, 0xec, 0xff, 0xd4, 0x81, 0xfa, 0x48, 0x19, 0x3a, 0xd8, 0x45, 0x97,
-	0xa0, 0x1a, 0x8f, 0xc3, 0x24, 0xd6, 0xbe, 0x57, 0x82, 0x4a, 0x3c, 0x1e, 0xc4, 0x0a, 0x94, 0x1a,
-	0x2c, 0x19, 0x50, 0x2a, 0xf0, 0xff, 0xa1, 0xc5, 0xb1, 0x90, 0x89, 0x4c, 0x18, 0x55, 0x34, 0x93,
-	0x52, 0x77, 0x8e, 0x0d, 0x62, 0x74, 0x19, 0x6a, 0x38, 0x8a, 0x14, 0xb1, 0xa2, 0xbd, 0xa9, 0xe2,
-	0x28, 0x1a, 0xc4, 0xe8, 0x11, 0xa8, 0xc7, 0xe3, 0x90, 0xe2, 0x8c, 0x68, 0xdf, 0x9b, 0x41, 0x2d,
-	0x1e, 0xdf, 0xc6, 0x19, 0x51, 0x04, 0x69, 0x09, 0x35, 0x43, 0x90, 0x86, 0xf0, 0x14, 0x74, 0xb8,
-	0x48, 0x32, 0x2c, 0xce, 0xc2, 0x9c, 0xdc, 0xa3, 0xb3, 0x4c, 0xc7, 0xa2, 0x1d, 0xb4, 0x2d, 0x7a,
-	0xa8, 0x41, 0xff, 0x7b, 0x0e, 0x74, 0x0e, 0xcf, 0x68, 0x74, 0x8b, 0x4d, 0x46, 0x38, 0x49, 0x03,
-	0x72, 0x0f, 0xbd, 0x00, 0xf5, 0x88, 0x86, 0xc7, 0xf8, 0x84, 0x68, 0x8f, 0xdc, 0xed, 0xb5, 0xad,
-	0xc5, 0x81, 0x19, 0x15, 0xab, 0xa0, 0x16, 0xd1, 0x3d, 0x7c, 0x42, 0x2c, 0xfb, 0x7d, 0x4c, 0xa5,
-	0x4d, 0xf4, 0xa7, 0xb2, 0xbf, 0x83, 0xa9, 0x44, 0x3e, 0x54, 0xe5, 0x3c, 0xe3, 0xee, 0x76, 0x4b,
-	0x47, 0xd8, 0x86, 0x32, 0x30, 0x24, 0xff, 0x5b, 0xd0, 0x3d, 0x67, 0x53, 0xce, 0x55, 0xe8, 0xa2,
-	0x29, 0x0f, 0x53, 0x16, 0x61, 0x15, 0x29, 0x5b, 0x95, 0x6e, 0x34, 0xe5, 0xb7, 0x2c, 0x84, 0x9e,
-	0x86, 0x46, 0xc4, 0xb2, 0x0c, 0xd3, 0xb8, 0x48, 0x1f, 0x68, 0xe5, 0x6f, 0x52, 0x29, 0xce, 0x82,
-	0x39, 0xcd, 0x7f, 0x1d, 0x56, 0x0f, 0x04, 0x51, 0xdb, 0x44, 0xbe, 0x23, 0x12, 0x49, 0xfa, 0x59,
-	0x8c, 0x9e, 0x05, 0x20, 0x8a, 0x2f, 0x4c, 0x93, 0x5c, 0xea, 0xc2, 0x38, 0x2f, 0xde, 0xd4, 0xd4,
-	0x5b, 0x49, 0x2e, 0xfd, 0x7f, 0x94, 0xa0, 0xaa, 0x41, 0xf4, 0x62, 0x21, 0xa4, 0xcb, 0x5c, 0x99,
-	0xd4, 0xd9, 0x5e, 0x5b, 0x08, 0x99, 0xff, 0xba, 0xe0, 0x8d, 0xb8, 0x5a, 0xaa, 0x3a, 0xd6, 0x5e,
-	0x2e, 0x8a, 0xa3, 0xae, 0xf7, 0x83, 0x18, 0x5d, 0x03, 0x57, 0x1d, 0x9c, 0x31, 0xce, 0xc9, 0xa2,
-	0x3c, 0xa0, 0x80, 0x06, 0x31, 0xfa, 0x3f, 0x00, 0x23, 0xab, 0x13, 0x5e, 0x31, 0x27, 0x53, 0x23,
-	0x3a, 0xe7, 0x4f, 0x40, 0x7b, 0x2e, 0xbf, 0x54, 0x2b, 0xad, 0x02, 0xd4, 0x4c, 0x8f, 0x41, 0xf3,
-	0x28, 0x29, 0x54, 0x98, 0x9a, 0x69, 0x28, 0x40, 0x13, 0x1f, 0x87, 0xf2, 0x18, 0x4b, 0x5d, 0x2a,
-	0x85, 0xff, 0xfa, 0xcc, 0x04, 0x0a, 0x46, 0x4f, 0x40, 0x87, 0x4f, 0xc3, 0xe8, 0x98, 0x44, 0xd3,
-	0x70, 0x7c, 0x16, 0x4a, 0xda, 0x6b, 0x6c, 0x38, 0x9b, 0xd5, 0xc0, 0xe5, 0xd3, 0xbe, 0x02, 0x77,
-	0xce, 0x46, 0xd4, 0xdf, 0x87, 0xe6, 0xdc, 0x6f, 0x04, 0x50, 0x1b, 0xd0, 0x9c, 0x08, 0xe9, 0xad,
-	0xa8, 0xf5, 0x2e, 0x49, 0x89, 0x24, 0x9e, 0xa3, 0xd6, 0x77, 0x78, 0x8c, 0x25, 0xf1, 0x4a, 0xa8,
-	0x09, 0xd5, 0x1b, 0xa9, 0x24, 0xc2, 0x2b, 0xa3, 0x55, 0x68, 0x1f, 0x72, 0x12, 0x25, 0x38, 0xb5,
-	0x9c, 0x15, 0xff, 0x3b, 0x0e, 0x80, 0x56, 0xce, 0x59, 0x42, 0x25, 0x7a, 0x1e, 0x6a, 0x59, 0x42,
-	0x43, 0x99, 0x7f, 0x66, 0x6d, 0x56, 0xb3, 0x84, 0x8e, 0x72, 0xcd, 0x8c, 0x4f, 0x15, 0x73, 0xe9,
-	0x33, 0x99, 0xf1, 0xe9, 0x28, 0x2f, 0x5c, 0x2f, 0x3f, 0xd4, 0x75, 0x63, 0x06, 0x96, 0x38, 0x65,
-	0x93, 0xfe, 0x94, 0x7f, 0x61, 0x66, 0x7c, 0xd7, 0x01, 0x77, 0x9f, 0x48, 0xac, 0x32, 0xfa, 0x45,
-	0xda, 0xf1, 0x77, 0x07, 0x3c, 0x9d, 0x34, 0x7d, 0x72, 0x0f, 0x58, 0x9a, 0x44, 0x67, 0x68, 0x0b,
-	0x2e, 0x29, 0x63, 0x58, 0x9e, 0xbc, 0x47, 0xc2, 0x7b, 0x33, 0x9c, 0xa4, 0xc9, 0x11, 0x31, 0xd7,
-	0x62, 0x3b, 0x58, 0xcd, 0x12, 0x3a, 0x54, 0x94, 0x6f, 0x16, 0x04, 0xf4, 0x24, 0x74, 0x94, 0x3d,
-	0x6c, 0x7c, 0x37, 0x64, 0x94, 0x88, 0x19, 0xd5, 0x76, 0xb5, 0x83, 0x56, 0x86, 0x4f, 0x87, 0xe3,
-	0xbb, 0x43, 0x8d, 0xa1, 0xeb, 0xb0, 0xa6, 0xb9, 0xb4, 0xd6, 0x8c, 0x88, 0x09, 0x89, 0x95, 0x88,
-	0xb6, 0x4c, 0xa9, 0xc5, 0xa7, 0x5a, 0xed, 0xbe, 0xa6, 0x0c, 0xc7, 0x77, 0xd1, 0x93, 0x50, 0x3d,
-	0x4e, 0xa8, 0xcc, 0x7b, 0x95, 0x8d, 0xf2, 0x66, 0x67, 0xbb, 0xa3, 0x6d, 0xd7, 0xe4, 0xbd, 0x84,
-	0xca, 0xc0, 0x10, 0xd1, 0xb3, 0xa0, 0x2c, 0x0a, 0x23, 0x6a, 0x74, 0x86, 0x4a, 0x87, 0x6d, 0x94,
-	0x9d, 0x2c, 0xa1, 0x7d, 0xaa, 0x25, 0x0e, 0x93, 0xf7, 0x88, 0xff, 0x32, 0xac, 0x2d, 0x7c, 0xd5,
-	0x1d, 0x47, 0x60, 0x55, 0x8b, 0x1b, 0xe0, 0x46, 0xf3, 0x5d, 0x6e, 0x5b, 0xdf, 0x32, 0xe4, 0xbf,
-	0x00, 0xab, 0xcb, 0x92, 0x59, 0x46, 0xa8, 0x54, 0x3d, 0x3d, 0x32, 0xcb, 0x62, 0x2a, 0xb0, 0x5b,
-	0x7f, 0x1f, 0x2e, 0x2f, 0xd8, 0x03, 0xa2, 0x4e, 0xa8, 0x5e, 0xaa, 0x3b, 0x83, 0xa5, 0xb1, 0x39,
-	0xb2, 0x56, 0x86, 0xa5, 0xb1, 0x3e, 0xb1, 0x8f, 0x42, 0x83, 0x92, 0xfb, 0x86, 0x64, 0x66, 0x88,
-	0x3a, 0x25, 0xf7, 0x15, 0xc9, 0xa7, 0x70, 0xe9, 0xa2, 0xba, 0x3e, 0x4b, 0xff, 0x3b, 0x65, 0xea,
-	0x02, 0xce, 0xd5, 0x44, 0x44, 0x23, 0x12, 0xaa, 0x6e, 0x62, 0xc2, 0xef, 0x16, 0xd8, 0xed, 0x59,
-	0xe6, 0xc7, 0xcb, 0xdf, 0xbb, 0x11, 0xc7, 0x7d, 0x96, 0xce, 0x32, 0x8a, 0x9e, 0x84, 0x5a, 0xa4,
-	0x57, 0xb6, 0x46, 0x5b, 0x66, 0x10, 0xe8, 0xb3, 0x74, 0x97, 0x1c, 0x05, 0x96, 0x86, 0x9e, 0x81,
-	0x6e, 0xa2, 0x6f, 0x8a, 0x90, 0xb3, 0x5c, 0x77, 0x43, 0x6d, 0x41, 0x35, 0xe8, 0x18, 0xf8, 0xc0,
-	0xa2, 0xfe, 0x21, 0x5c, 0x39, 0xf7, 0x95, 0x83, 0xa2, 0x7b, 0xa2, 0x57, 0xa0, 0xbd, 0x68, 0xaf,
-	0x31, 0x39, 0x9a, 0x9f, 0x09, 0xfd, 0xbd, 0x39, 0xdf, 0xce, 0x99, 0xfa, 0xee, 0xa2, 0x13, 0xef,
-	0x92, 0x23, 0xff, 0xdd, 0xe5, 0x14, 0xef, 0x0a, 0xc6, 0xad, 0xed, 0xd7, 0xc0, 0x4d, 0xd9, 0x24,
-	0x89, 0x70, 0x1a, 0x26, 0xf1, 0xa9, 0x2d, 0x65, 0xb0, 0xd0, 0x20, 0x3e, 0x7d, 0x20, 0x2c, 0xa5,
-	0x07, 0xc3, 0xf2, 0x97, 0x0a, 0xb4, 0x97, 0xf3, 0x70, 0xef, 0x5c, 0x0b, 0x70, 0xce, 0xb7, 0x80,
-	0xf9, 0x30, 0x51, 0x5a, 0x1a, 0x26, 0x7c, 0xa8, 0x4c, 0x13, 0x6a, 0x1a, 0x42, 0x51, 0xd0, 0x5a,
-	0xe3, 0x37, 0x12, 0x1a, 0x07, 0x9a, 0x86, 0x5e, 0x01, 0xc0, 0x71, 0x1c, 0xda, 0x48, 0x57, 0xb4,
-	0xe7, 0xbd, 0x05, 0xe7, 0xf9, 0x9c, 0xec, 0xad, 0x04, 0x4d, 0x3c, 0x4f, 0xd0, 0x6b, 0xe0, 0xc6,
-	0x82, 0xf1, 0x42, 0xb6, 0xaa, 0x65, 0x1f, 0xbd, 0x20, 0xbb, 0x08, 0xca, 0xde, 0x4a, 0x00, 0xf1,
-	0x22, 0x44, 0x6f, 0x40, 0x4b, 0xe8, 0xda, 0x0a, 0x4d, 0x5f, 0xaf, 0x69, 0xf1, 0xf5, 0x0b, 0xe2,
-	0x4b, 0xd5, 0xbc, 0xb7, 0x12, 0xb8, 0x62, 0xa9, 0xb8, 0xdf, 0x80, 0xce, 0x4c, 0xf7, 0x82, 0xb0,
-	0x38, 0x16, 0xa6, 0xfd, 0x5c, 0xb9, 0xa0, 0xc2, 0x9e, 0x9f, 0xbd, 0x95, 0xa0, 0x6d, 0xf8, 0x8b,
-	0x03, 0xf5, 0x1a, 0xb8, 0x85, 0x82, 0x5c, 0x0a, 0xdd, 0x93, 0x1e, 0xb4, 0x7f, 0x71, 0x6e, 0x95,
-	0xfd, 0x56, 0x41, 0x2e, 0x05, 0x7a, 0x0d, 0xac, 0xba, 0x90, 0xeb, 0x6b, 0xac, 0xd7, 0xd4, 0xf2,
-	0x97, 0x2f, 0xc8, 0x9b, 0x3b, 0x6e, 0x6f, 0x25, 0x68, 0x19, 0x6e, 0x7b, 0xe7, 0xed, 0x40, 0x5b,
-	0x85, 0x7d, 0x5e, 0x4c, 0x3d, 0xd0, 0xd2, 0x8f, 0x3d, 0x18, 0xf9, 0x79, 0xfd, 0x29, 0x1d, 0xf8,
-	0x7c, 0xdd, 0x82, 0x8d, 0x60, 0xc4, 0xd2, 0x9e, 0xfb, 0xd0, 0xd4, 0xcd, 0x8f, 0xaf, 0x4a, 0x9d,
-	0x28, 0x36, 0x3b, 0x2e, 0x34, 0x19, 0x27, 0x42, 0x0f, 0x40, 0xfe, 0x3f, 0x4b, 0xe0, 0x1e, 0x46,
-	0xc7, 0x24, 0xc3, 0x6f, 0x9e, 0x4a, 0x81, 0xd1, 0xd3, 0xd0, 0xa5, 0xe4, 0x54, 0x2a, 0xad, 0xc5,
-	0x0c, 0x68, 0x0a, 0xb8, 0xad, 0xe0, 0x3e, 0x4b, 0xcd, 0x0c, 0xa8, 0xc7, 0x06, 0xc1, 0x38, 0x27,
-	0x71, 0x68, 0xe6, 0x62, 0x35, 0x3d, 0xa9, 0xb1, 0xc1, 0x80, 0x37, 0xec, 0x60, 0xdc, 0x31, 0xf5,
-	0x11, 0x46, 0xc7, 0x98, 0x4e, 0x48, 0x6c, 0x47, 0xf6, 0xb6, 0x41, 0xfb, 0x06, 0x3c, 0x77, 0xb9,
-	0x54, 0xce, 0x5f, 0x2e, 0x9f, 0xd2, 0x1e, 0xaa, 0xff, 0x79, 0x7b, 0xa8, 0x7d, 0x8e, 0xf6, 0x50,
-	0xff, 0xb7, 0xed, 0xa1, 0xf1, 0xb9, 0xdb, 0x43, 0xf3, 0xa1, 0xed, 0x21, 0x86, 0xc6, 0x80, 0xca,
-	0xaf, 0xbe, 0xb4, 0x8f, 0x39, 0xf2, 0xc1, 0xc9, 0xec, 0xf4, 0x68, 0x06, 0xc1, 0x82, 0xb2, 0xb5,
-	0x6f, 0xe6, 0x48, 0x27, 0x5b, 0x7f, 0x09, 0x6a, 0x66, 0xa3, 0xde, 0x2d, 0x53, 0x72, 0xa6, 0x93,
-	0x52, 0x0e, 0xd4, 0x12, 0xad, 0x41, 0xf5, 0x04, 0xa7, 0x33, 0x73, 0xfb, 0x96, 0x03, 0xb3, 0x79,
-	0xb5, 0xf4, 0xb2, 0xe3, 0xbf, 0x0d, 0xad, 0x91, 0xc0, 0x34, 0xdf, 0x25, 0xb9, 0xba, 0x0b, 0xd1,
-	0x15, 0xa8, 0xb1, 0xf1, 0xdd, 0x81, 0xbd, 0x94, 0xaa, 0x81, 0xdd, 0x29, 0x7c, 0x9c, 0x4e, 0x15,
-	0x6e, 0xae, 0x4f, 0xbb, 0x53, 0xb8, 0x60, 0xf7, 0x15, 0x5e, 0x36, 0xb8, 0xd9, 0xf9, 0xdf, 0x76,
-	0xc0, 0xdd, 0x49, 0xa7, 0x5a, 0xb7, 0xf2, 0xe0, 0xf9, 0x85, 0x07, 0x8f, 0x98, 0xae, 0xbf, 0x20,
-	0x5a, 0x27, 0xec, 0x4b, 0xc8, 0xc9, 0xd6, 0x6f, 0x3e, 0xcc, 0x95, 0xaa, 0x71, 0xe5, 0x99, 0x65,
-	0x57, 0xdc, 0xed, 0x55, 0x33, 0xe8, 0x2f, 0xb9, 0xb0, 0xec, 0xdd, 0x1e, 0xa0, 0xe2, 0x3b, 0x47,
-	0x44, 0xec, 0x30, 0x36, 0x4d, 0xe8, 0x04, 0x6d, 0x43, 0x23, 0xc3, 0x9c, 0x27, 0x74, 0x92, 0x5b,
-	0x93, 0xbc, 0x8b, 0x26, 0x59, 0x5b, 0xe6, 0x7c, 0xfe, 0x4f, 0x4a, 0xe0, 0xe9, 0xdc, 0xf4, 0xf5,
-	0x80, 0x6f, 0xac, 0x7b, 0xe8, 0x13, 0xed, 0x32, 0xd4, 0xe4, 0x38, 0x5d, 0xdc, 0xb5, 0x55, 0x39,
-	0x4e, 0x1f, 0x98, 0xb1, 0xcb, 0x17, 0x67, 0xec, 0xaf, 0x40, 0x23, 0x97, 0x58, 0xc8, 0x50, 0x0f,
-	0x18, 0x9f, 0x3a, 0x46, 0x59, 0xbb, 0xea, 0x9a, 0x77, 0x94, 0xab, 0x46, 0xb2, 0x28, 0xce, 0xbc,
-	0x57, 0xdd, 0x28, 0x6f, 0xb6, 0x02, 0xc8, 0x8a, 0xaa, 0xcc, 0xf5, 0x03, 0x47, 0x10, 0x2c, 0x0b,
-	0x8e, 0x9a, 0xe6, 0x70, 0x2d, 0xa6, 0x59, 0xbe, 0x0c, 0xf5, 0xb1, 0x89, 0x8c, 0xbd, 0x21, 0xcf,
-	0x27, 0x68, 0x11, 0xb8, 0xa0, 0xe0, 0x53, 0x9f, 0xb5, 0x4b, 0xf5, 0x74, 0xd2, 0x25, 0xdf, 0x0c,
-	0xc0, 0x42, 0xb7, 0x58, 0xa4, 0xf2, 0x46, 0x84, 0xd0, 0x95, 0xdd, 0x0c, 0xd4, 0xd2, 0xff, 0x7e,
-	0x09, 0x3a, 0x3a, 0x80, 0x23, 0x9c, 0x4f, 0xff, 0xe7, 0xe1, 0x5b, 0x7a, 0xc8, 0x56, 0xce, 0x3d,
-	0x64, 0x7d, 0x68, 0x4b, 0x66, 0x0f, 0xdb, 0x52, 0x88, 0x5c, 0xc9, 0xb4, 0x31, 0x3a, 0x00, 0x5b,
-	0x70, 0x89, 0xe4, 0x32, 0xc9, 0x74, 0x94, 0x32, 0x92, 0x85, 0xb3, 0x1c, 0x4f, 0x4c, 0xc7, 0xa9,
-	0x04, 0xab, 0x73, 0xd2, 0x3e, 0xc9, 0xee, 0x28, 0x82, 0xb2, 0x05, 0x47, 0x11, 0x9b, 0x51, 0xa9,
-	0xcc, 0x34, 0x37, 0x42, 0xd3, 0x22, 0xe6, 0x51, 0x3d, 0xcb, 0x89, 0x50, 0xb4, 0x86, 0xa6, 0xd5,
-	0xd4, 0xd6, 0x10, 0x04, 0x33, 0xed, 0xb9, 0x69, 0x08, 0x6a, 0x3b, 0x88, 0x9f, 0xfb, 0x51, 0x09,
-	0x6a, 0x43, 0xde, 0x67, 0x31, 0x41, 0x75, 0x28, 0xdf, 0x66, 0xdc, 0x5b, 0x41, 0xab, 0xd0, 0x1a,
-	0xf2, 0x9b, 0x44, 0xda, 0xd7, 0xaa, 0xf7, 0xd7, 0x3a, 0xf2, 0xc0, 0x1d, 0xf2, 0x03, 0x61, 0x4b,
-	0xd0, 0xfb, 0x5b, 0x1d, 0xb9, 0x4a, 0xee, 0x20, 0xa1, 0x13, 0xef, 0xa3, 0x2e, 0x6a, 0x41, 0x7d,
-	0xc8, 0xdf, 0x4a, 0x67, 0xf9, 0xb1, 0xf7, 0xb3, 0xae, 0x91, 0x5f, 0xbc, 0x70, 0xbc, 0x9f, 0x77,
-	0x51, 0x07, 0x9a, 0x43, 0x3e, 0xa0, 0x39, 0x27, 0x91, 0xf4, 0x7e, 0xd1, 0x45, 0x6b, 0xd0, 0x1d,
-	0xf2, 0x1b, 0x71, 0xfc, 0x16, 0x9e, 0xa5, 0xf2, 0x40, 0x73, 0xfd, 0xb2, 0x8b, 0xda, 0xd0, 0x18,
-	0xf2, 0x1d, 0x1c, 0x4d, 0x67, 0xdc, 0xfb, 0x55, 0xd7, 0x7c, 0x74, 0x24, 0x70, 0x44, 0x0e, 0x39,
-	0xa6, 0xde, 0xaf, 0xbb, 0xe8, 0x12, 0x74, 0x86, 0xfc, 0x50, 0x32, 0x81, 0x27, 0x44, 0x07, 0xc4,
-	0xfb, 0x4d, 0x17, 0x3d, 0x02, 0x68, 0xc8, 0x6f, 0xa6, 0x6c, 0x8c, 0xd3, 0xa5, 0x8f, 0xfe, 0xb6,
-	0x8b, 0xae, 0xc0, 0xaa, 0xfa, 0xa8, 0x24, 0x22, 0x22, 0x5c, 0x5a, 0xd3, 0x7f, 0xd7, 0x45, 0x08,
-	0xda, 0xca, 0x65, 0xb5, 0xd5, 0x99, 0xf0, 0x7e, 0x6f, 0x79, 0x77, 0x93, 0x7c, 0xaa, 0xfe, 0xfa,
-	0x29, 0xc1, 0x94, 0x08, 0xef, 0x0f, 0xd6, 0xa4, 0x80, 0xe0, 0x98, 0x08, 0xef, 0x8f, 0xdd, 0xe7,
-	0x7e, 0xe0, 0x40, 0x73, 0x3e, 0xa7, 0x20, 0x17, 0xea, 0x03, 0x7a, 0x82, 0xd3, 0x24, 0xf6, 0x56,
-	0x50, 0x1b, 0x9a, 0xf3, 0x69, 0xc4, 0x73, 0x50, 0x07, 0x60, 0x31, 0x60, 0x78, 0x25, 0xd4, 0x05,
-	0x77, 0x69, 0x62, 0x30, 0x2f, 0xc3, 0x3b, 0xcb, 0x4d, 0xdf, 0xab, 0xa0, 0x35, 0xf0, 0x0a, 0xa8,
-	0x68, 0xed, 0x5e, 0x15, 0x79, 0xd0, 0xba, 0xb3, 0xd4, 0xa0, 0xbd, 0x9a, 0x42, 0x96, 0xdb, 0xaf,
-	0xa7, 0xf2, 0xd3, 0x9a, 0xf7, 0x53, 0xf5, 0xbd, 0xc6, 0x73, 0x37, 0xa1, 0x39, 0x6f, 0x01, 0xa8,
-	0x01, 0x95, 0x1b, 0x33, 0xc9, 0x8c, 0x95, 0xb7, 0x99, 0x79, 0x8a, 0xe6, 0x9e, 0x83, 0x5a, 0xd0,
-	0xd8, 0x49, 0x26, 0xc6, 0xa4, 0x12, 0xba, 0x04, 0xdd, 0x3e, 0xa3, 0x32, 0xa1, 0x33, 0x36, 0xcb,
-	0xf5, 0x0f, 0x09, 0x5e, 0x79, 0xe7, 0xf5, 0x0f, 0x3f, 0xb9, 0xea, 0x7c, 0xf4, 0xc9, 0x55, 0xe7,
-	0xe3, 0x4f, 0xae, 0xae, 0xbc, 0xff, 0xe7, 0xab, 0xce, 0xbb, 0x5f, 0x5a, 0xfa, 0x71, 0x32, 0xc3,
-	0x52, 0x24, 0xa7, 0x4c, 0x24, 0x93, 0x84, 0x16, 0x1b, 0x4a, 0xae, 0xf3, 0xe9, 0xe4, 0x3a, 0x1f,
-	0x5f, 0xc7, 0x3c, 0x19, 0xd7, 0xf4, 0xaf, 0x90, 0x2f, 0xfe, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x18,
-	0x36, 0x77, 0xdd, 0xe3, 0x14, 0x00, 0x00,
+	// 2554 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4b, 0x6f, 0xe4, 0xc6,
+	0xf1, 0x17, 0xe7, 0x3d, 0xc5, 0x79, 0x50, 0x2d, 0xed, 0xee, 0x58, 0xf6, 0x7f, 0x57, 0x7f, 0x7a,
+	0x6d, 0xcb, 0x2f, 0x2d, 0x22, 0x3b, 0x8e, 0x6d, 0x18, 0x36, 0xa4, 0xd1, 0x7a, 0x35, 0xc9, 0xae,
+	0x46, 0xa1, 0x66, 0x6d, 0xc0, 0x08, 0x40, 0xf4, 0x90, 0xad, 0x11, 0x77, 0xc8, 0x6e, 0x2e, 0xd9,
+	0xa3, 0x95, 0x7c, 0x4d, 0xf2, 0x05, 0x72, 0xcb, 0xcd, 0x3e, 0x27, 0xc7, 0xdc, 0x02, 0xe4, 0x18,
+	0x18, 0x39, 0x39, 0xc8, 0xfb, 0x09, 0xc3, 0x41, 0x90, 0xe4, 0x03, 0xe4, 0x1e, 0xf4, 0x83, 0x1c,
+	0x8e, 0x56, 0x76, 0xd6, 0x81, 0x01, 0x1f, 0x66, 0xd0, 0xf5, 0xab, 0xea, 0xea, 0xaa, 0xea, 0xea,
+	0xae, 0x6a, 0x42, 0x13, 0xc7, 0xc1, 0x66, 0x9c, 0x30, 0xce, 0x50, 0x19, 0xc7, 0xc1, 0xda, 0x8b,
+	0x93, 0x80, 0x1f, 0xcf, 0xc6, 0x9b, 0x1e, 0x8b, 0x6e, 0x4c, 0xd8, 0x84, 0xdd, 0x90, 0xbc, 0xf1,
+	0xec, 0x48, 0x52, 0x92, 0x90, 0x23, 0x35, 0x67, 0xad, 0xcb, 0x83, 0x88, 0xa4, 0x1c, 0x47, 0xb1,
+	0x06, 0x20, 0x0e, 0x31, 0x55, 0x63, 0xfb, 0x1b, 0xd0, 0x1e, 0xed, 0x1f, 0x04, 0x74, 0xe2, 0x90,
+	0xfb, 0x33, 0x92, 0x72, 0xf4, 0x04, 0x34, 0x63, 0x9c, 0xe0, 0x88, 0x70, 0x92, 0xf4, 0x8c, 0x75,
+	0x63, 0xa3, 0xe9, 0xcc, 0x81, 0xd7, 0x1b, 0x1f, 0x7c, 0x78, 0xcd, 0xf8, 0xe4, 0xc3, 0x6b, 0x4b,
+	0xf6, 0x4f, 0x0c, 0xe8, 0x64, 0x33, 0xd3, 0x98, 0xd1, 0x94, 0xa0, 0x1e, 0xd4, 0x53, 0xce, 0x12,
+	0x32, 0xd8, 0xd5, 0x13, 0x33, 0x12, 0x3d, 0x0d, 0x9d, 0x94, 0x24, 0x27, 0x81, 0x47, 0xb6, 0x7d,
+	0x3f, 0x21, 0x69, 0xda, 0x2b, 0x49, 0x81, 0x73, 0xa8, 0xd4, 0x70, 0x8c, 0x13, 0x7f, 0xb0, 0xdb,
+	0x2b, 0xaf, 0x1b, 0x1b, 0x15, 0x27, 0x23, 0x85, 0x59, 0x09, 0x89, 0xc3, 0xc0, 0xc3, 0x83, 0xdd,
+	0x5e, 0x45, 0xf2, 0xe6, 0x00, 0xba, 0x0a, 0x10, 0xb2, 0xc9, 0xa1, 0x9e, 0x5a, 0x95, 0xec, 0x02,
+	0x52, 0x30, 0xfb, 0x75, 0xb0, 0x46, 0xfb, 0x87, 0x3c, 0x29, 0xda, 0x2d, 0x75, 0xf3, 0x59, 0x42,
+	0x0f, 0x79, 0xee, 0x72, 0x0e, 0x14, 0xe6, 0xfe, 0xc8, 0x80, 0xda, 0x3b, 0xc4, 0xe3, 0x2c, 0x41,
+	0x08, 0x2a, 0x3e, 0xe6, 0x58, 0x4a, 0xb7, 0x1c, 0x39, 0x46, 0x57, 0xa1, 0xc2, 0xcf, 0x62, 0x22,
+	0x5d, 0x33, 0xb7, 0x60, 0x53, 0x46, 0x79, 0x74, 0x16, 0x13, 0x47, 0xe2, 0x68, 0x0d, 0x1a, 0x74,
+	0x16, 0x86, 0x78, 0x1c, 0x12, 0xe9, 0x5d, 0xc3, 0xc9, 0x69, 0x64, 0x41, 0x99, 0xa6, 0xb1, 0x74,
+	0xac, 0xe5, 0x88, 0x21, 0x7a, 0x0c, 0x1a, 0x41, 0xea, 0x7a, 0x8c, 0xa6, 0x5c, 0x3a, 0xd4, 0x70,
+	0xea, 0x41, 0xda, 0x17, 0xa4, 0x10, 0x0e, 0x09, 0xed, 0xd5, 0xd6, 0x8d, 0x8d, 0xb6, 0x23, 0x86,
+	0xc2, 0x1c, 0x9c, 0x10, 0xdc, 0xab, 0x2b, 0x73, 0xc4, 0xd8, 0xfe, 0x26, 0x54, 0x77, 0x30, 0xf7,
+	0x8e, 0xd1, 0x1a, 0x54, 0x31, 0xe7, 0x49, 0xda, 0x33, 0xd6, 0xcb, 0x1b, 0xcd, 0x9d, 0xca, 0x47,
+	0x7f, 0xbd, 0xb6, 0xe4, 0x28, 0x08, 0x3d, 0x05, 0x95, 0x13, 0xe2, 0x89, 0xed, 0x28, 0x6f, 0x98,
+	0x5b, 0xe6, 0xa6, 0xc8, 0x34, 0xe5, 0xa2, 0x96, 0x93, 0x6c, 0xfb, 0xe7, 0x06, 0xd4, 0x47, 0xc2,
+	0xd0, 0xc1, 0x2e, 0x5a, 0x81, 0xaa, 0x3f, 0x76, 0x03, 0x5f, 0xfa, 0x5e, 0x71, 0x2a, 0xfe, 0x78,
+	0xe0, 0x0b, 0x90, 0x4b, 0xb0, 0xa4, 0x40, 0x2e, 0xc0, 0xff, 0x87, 0x56, 0x8c, 0x13, 0x1e, 0xf0,
+	0x80, 0x51, 0xc1, 0x53, 0x5b, 0x6a, 0xe6, 0xd8, 0xc0, 0x47, 0x97, 0xa0, 0x86, 0x3d, 0x4f, 0x30,
+	0x2b, 0xd2, 0x9b, 0x2a, 0xf6, 0xbc, 0x81, 0x8f, 0xae, 0x40, 0xdd, 0x1f, 0xbb, 0x14, 0x47, 0x44,
+	0xfa, 0xde, 0x74, 0x6a, 0xfe, 0x78, 0x1f, 0x47, 0x44, 0x30, 0xb8, 0x66, 0xd4, 0x14, 0x83, 0x2b,
+	0xc6, 0x53, 0xd0, 0x89, 0x93, 0x20, 0xc2, 0xc9, 0x99, 0x9b, 0x92, 0xfb, 0x74, 0x16, 0xc9, 0x58,
+	0xb4, 0x9d, 0xb6, 0x46, 0x0f, 0x25, 0x68, 0xff, 0xc0, 0x80, 0xce, 0xe1, 0x19, 0xf5, 0x6e, 0xb3,
+	0xc9, 0x08, 0x07, 0xa1, 0x43, 0xee, 0xa3, 0x17, 0xa1, 0xee, 0x51, 0xf7, 0x18, 0x9f, 0x10, 0xe9,
+	0x91, 0xb9, 0xb5, 0xba, 0x39, 0x3f, 0x30, 0xa3, 0x6c, 0xe4, 0xd4, 0x3c, 0xba, 0x87, 0x4f, 0x88,
+	0x16, 0x7f, 0x80, 0x29, 0xef, 0x95, 0x3e, 0x5f, 0xfc, 0x5d, 0x4c, 0x39, 0xb2, 0xa1, 0xca, 0xf3,
+	0x1d, 0x37, 0xb7, 0x5a, 0x32, 0xc2, 0x3a, 0x94, 0x8e, 0x62, 0xd9, 0xdf, 0x81, 0xee, 0x82, 0x4d,
+	0x69, 0x2c, 0x42, 0xe7, 0x4d, 0x63, 0x37, 0x64, 0x1e, 0x16, 0x91, 0xd2, 0x59, 0x69, 0x7a, 0xd3,
+	0xf8, 0xb6, 0x86, 0xd0, 0xd3, 0xd0, 0xf0, 0x58, 0x14, 0x61, 0xea, 0x67, 0xdb, 0x07, 0x52, 0xf9,
+	0x4d, 0xca, 0x93, 0x33, 0x27, 0xe7, 0xd9, 0x6f, 0xc2, 0xf2, 0x41, 0x42, 0x04, 0x19, 0xf0, 0x77,
+	0x93, 0x80, 0x93, 0x7e, 0xe4, 0xa3, 0x67, 0x01, 0x88, 0x90, 0x73, 0xc3, 0x20, 0xe5, 0x3d, 0xe3,
+	0xa1, 0xe9, 0x4d, 0xc9, 0xbd, 0x1d, 0xa4, 0xdc, 0xfe, 0x77, 0x09, 0xaa, 0x12, 0x44, 0x2f, 0x65,
+	0x93, 0x64, 0x9a, 0x0b, 0x93, 0x3a, 0x5b, 0xab, 0xf3, 0x49, 0xea, 0x5f, 0x26, 0x7c, 0x93, 0x64,
+	0x43, 0x91, 0xc7, 0xd2, 0xcb, 0x79, 0x72, 0xd4, 0x25, 0x3d, 0xf0, 0xd1, 0x35, 0x30, 0xc5, 0xc1,
+	0x19, 0xe3, 0x94, 0xcc, 0xd3, 0x03, 0x32, 0x68, 0xe0, 0xa3, 0xff, 0x03, 0x50, 0x73, 0xe5, 0x86,
+	0x57, 0xd4, 0xc9, 0x94, 0x88, 0xdc, 0xf3, 0x27, 0xa1, 0x9d, 0xcf, 0x2f, 0xe4, 0x4a, 0x2b, 0x03,
+	0xa5, 0xd0, 0xe3, 0xd0, 0x3c, 0x0a, 0x42, 0x52, 0xcc, 0x99, 0x86, 0x00, 0x24, 0xf3, 0x09, 0x28,
+	0x8f, 0x31, 0x97, 0xa9, 0x92, 0xf9, 0x2f, 0xcf, 0x8c, 0x23, 0x60, 0xf4, 0x24, 0x74, 0xe2, 0xa9,
+	0xeb, 0x1d, 0x13, 0x6f, 0xea, 0x8e, 0xcf, 0x5c, 0x4e, 0x7b, 0x8d, 0x75, 0x63, 0xa3, 0xea, 0x98,
+	0xf1, 0xb4, 0x2f, 0xc0, 0x9d, 0xb3, 0x11, 0xb5, 0xdf, 0x85, 0x66, 0xee, 0x37, 0x02, 0xa8, 0x0d,
+	0x68, 0x4a, 0x12, 0x6e, 0x2d, 0x89, 0xf1, 0x2e, 0x09, 0x09, 0x27, 0x96, 0x21, 0xc6, 0x77, 0x63,
+	0x1f, 0x73, 0x62, 0x95, 0x50, 0x13, 0xaa, 0xdb, 0x21, 0x27, 0x89, 0x55, 0x46, 0xcb, 0xd0, 0x3e,
+	0x8c, 0x89, 0x17, 0xe0, 0x50, 0x4b, 0x56, 0x50, 0x03, 0x2a, 0x0e, 0xc1, 0xbe, 0x55, 0xb5, 0xbf,
+	0x67, 0x00, 0xc8, 0x65, 0x62, 0x16, 0x50, 0x8e, 0x9e, 0x87, 0x5a, 0x14, 0x50, 0x97, 0xa7, 0x9f,
+	0x9b, 0xa5, 0xd5, 0x28, 0xa0, 0xa3, 0x54, 0x0a, 0xe3, 0x53, 0x21, 0x5c, 0xfa, 0x5c, 0x61, 0x7c,
+	0x3a, 0x4a, 0xb3, 0x20, 0x94, 0x2f, 0x0c, 0x82, 0x32, 0x03, 0x73, 0x1c, 0xb2, 0x49, 0x7f, 0x1a,
+	0x7f, 0x65, 0x66, 0x7c, 0xdf, 0x00, 0xf3, 0x0e, 0xe1, 0x58, 0xec, 0xed, 0x57, 0x69, 0xc7, 0x4f,
+	0xcb, 0x60, 0xc9, 0xed, 0x93, 0x67, 0xf8, 0x80, 0x85, 0x81, 0x77, 0x86, 0x36, 0x61, 0x45, 0x18,
+	0xc3, 0xd2, 0xe0, 0x7d, 0xe2, 0xde, 0x9f, 0xe1, 0x20, 0x0c, 0x8e, 0x88, 0xba, 0x20, 0xdb, 0xce,
+	0x72, 0x14, 0xd0, 0xa1, 0xe0, 0x7c, 0x3b, 0x63, 0xa0, 0xeb, 0xd0, 0x11, 0xf6, 0xb0, 0xf1, 0x3d,
+	0x97, 0x51, 0x92, 0xcc, 0xa8, 0xb4, 0xab, 0xed, 0xb4, 0x22, 0x7c, 0x3a, 0x1c, 0xdf, 0x1b, 0x4a,
+	0x0c, 0xdd, 0x80, 0x55, 0x29, 0x25, 0xb5, 0x46, 0x24, 0x99, 0x10, 0x5f, 0x4c, 0xe9, 0x95, 0xb5,
+	0x5a, 0x7c, 0x2a, 0xd5, 0xde, 0x91, 0x9c, 0xe1, 0xf8, 0x1e, 0xba, 0x0e, 0xd5, 0xe3, 0x80, 0xf2,
+	0xb4, 0x57, 0x59, 0x2f, 0x6f, 0x74, 0xb6, 0x3a, 0xd2, 0x76, 0xc9, 0xde, 0x0b, 0x28, 0x77, 0x14,
+	0x13, 0x3d, 0x0b, 0xc2, 0x22, 0xd7, 0xa3, 0x4a, 0xa7, 0x2b, 0x74, 0xe8, 0x92, 0xd9, 0x89, 0x02,
+	0xda, 0xa7, 0x72, 0xc6, 0x61, 0xf0, 0x3e, 0x91, 0xb7, 0x10, 0x8b, 0x62, 0x51, 0x9a, 0x5d, 0x1c,
+	0x4e, 0xf4, 0xf1, 0x31, 0x33, 0x6c, 0x3b, 0x9c, 0x88, 0x7b, 0x37, 0x17, 0x09, 0xc9, 0x09, 0x09,
+	0xe5, 0x61, 0xaa, 0x3a, 0xed, 0x0c, 0xbd, 0x2d, 0x40, 0xf4, 0x0c, 0x74, 0x3d, 0x16, 0xce, 0x22,
+	0xea, 0x12, 0xea, 0x31, 0x3f, 0xa0, 0x13, 0x79, 0x96, 0x1a, 0x4e, 0x47, 0xc1, 0x37, 0x35, 0x8a,
+	0x5e, 0x00, 0x94, 0x12, 0xee, 0x9e, 0xd3, 0xd9, 0x94, 0xb2, 0x56, 0x4a, 0x78, 0x7f, 0x41, 0xed,
+	0x26, 0xac, 0x28, 0xe9, 0x45, 0xd5, 0x20, 0xc5, 0x97, 0xa5, 0x78, 0x51, 0xbb, 0xfd, 0x2a, 0xac,
+	0xce, 0x37, 0x4f, 0x16, 0xd3, 0x04, 0x8b, 0xc3, 0xb5, 0x0e, 0xa6, 0x97, 0x53, 0xa9, 0xae, 0xea,
+	0x45, 0xc8, 0x7e, 0x11, 0x96, 0x8b, 0x33, 0xa3, 0x88, 0x50, 0x2e, 0xda, 0x15, 0x4f, 0x0d, 0xb3,
+	0x86, 0x47, 0x93, 0xf6, 0x1d, 0xb8, 0x34, 0x17, 0x77, 0x88, 0xb8, 0x7c, 0xe4, 0x50, 0x5c, 0x87,
+	0x2c, 0xf4, 0xd5, 0x6d, 0xa4, 0xe7, 0xb0, 0xd0, 0x97, 0x97, 0xd1, 0x63, 0xd0, 0xa0, 0xe4, 0x81,
+	0x62, 0xa9, 0xf6, 0xa8, 0x4e, 0xc9, 0x03, 0xc1, 0xb2, 0x29, 0xac, 0x9c, 0x57, 0xd7, 0x67, 0xe1,
+	0xff, 0xa6, 0x4c, 0xec, 0x6a, 0x2a, 0x9a, 0x3d, 0xea, 0x11, 0x57, 0x14, 0x4a, 0x95, 0x4f, 0x66,
+	0x86, 0xed, 0xcf, 0x22, 0xdb, 0x2f, 0xae, 0xb7, 0xed, 0xfb, 0x2a, 0x8c, 0xe8, 0x3a, 0xd4, 0x54,
+	0xa8, 0xf5, 0xa1, 0x6b, 0xa9, 0x1e, 0xa7, 0xcf, 0xc2, 0x5d, 0x72, 0xe4, 0x68, 0x9e, 0xd8, 0xeb,
+	0x40, 0x5e, 0x82, 0x6e, 0xcc, 0x52, 0x59, 0xe8, 0xa5, 0x05, 0x55, 0xa7, 0xa3, 0xe0, 0x03, 0x8d,
+	0xda, 0x87, 0x70, 0x79, 0x61, 0x95, 0x83, 0xac, 0x31, 0x40, 0xaf, 0x41, 0x7b, 0xde, 0x39, 0xf8,
+	0xe4, 0x28, 0x3f, 0xe4, 0x72, 0xbd, 0x5c, 0x6e, 0xe7, 0x4c, 0xac, 0x3b, 0x6f, 0x32, 0x76, 0xc9,
+	0x91, 0x3d, 0x82, 0x2b, 0x73, 0xa5, 0xbb, 0x09, 0x8b, 0xbf, 0x14, 0xad, 0xd7, 0xa1, 0x33, 0xd7,
+	0xfa, 0xad, 0x80, 0xfa, 0xa2, 0xe5, 0x9a, 0x06, 0xd4, 0xd7, 0x71, 0x97, 0x63, 0xfb, 0x3d, 0x58,
+	0x5d, 0x5c, 0x5b, 0xc7, 0xed, 0x1a, 0x98, 0x21, 0x9b, 0x04, 0x1e, 0x0e, 0xdd, 0xc0, 0x3f, 0xd5,
+	0xf7, 0x02, 0x68, 0x68, 0xe0, 0x9f, 0x3e, 0xb4, 0x25, 0xa5, 0x87, 0xb7, 0xe4, 0xef, 0x55, 0x68,
+	0x17, 0x73, 0xe0, 0xfe, 0x42, 0x65, 0x35, 0x16, 0x2b, 0x6b, 0xde, 0xa3, 0x95, 0x0a, 0x3d, 0x9a,
+	0xad, 0x2d, 0x2e, 0xaf, 0x1b, 0xf9, 0xed, 0x20, 0x35, 0x0a, 0x7f, 0x94, 0x07, 0xe8, 0x35, 0x00,
+	0xec, 0xfb, 0xfa, 0x40, 0xc9, 0x8a, 0x6b, 0x6e, 0xf5, 0xe6, 0x92, 0x8b, 0xf9, 0xb0, 0xb7, 0xe4,
+	0x34, 0x71, 0x46, 0xa0, 0x37, 0xc0, 0xf4, 0x13, 0x16, 0x67, 0x73, 0xab, 0x72, 0xee, 0x63, 0xe7,
+	0xe6, 0xce, 0x83, 0xb2, 0xb7, 0xe4, 0x80, 0x9f, 0x53, 0xe8, 0x2d, 0x68, 0x25, 0x32, 0xaf, 0x5d,
+	0xd5, 0x2e, 0xd5, 0xe4, 0xf4, 0xb5, 0x73, 0xd3, 0x0b, 0x27, 0x69, 0x6f, 0xc9, 0x31, 0x93, 0x39,
+	0x89, 0xde, 0x82, 0xce, 0x4c, 0x96, 0x58, 0x37, 0x3b, 0x92, 0xaa, 0xaa, 0x5f, 0x3e, 0xa7, 0x42,
+	0x9f, 0xdd, 0xbd, 0x25, 0xa7, 0xad, 0xe4, 0x35, 0x20, 0xec, 0xcf, 0x14, 0xa4, 0x3c, 0xe9, 0x35,
+	0x2e, 0xb4, 0x7f, 0x7e, 0x67, 0x08, 0xfb, 0xb5, 0x82, 0x94, 0x27, 0xe8, 0x0d, 0xd0, 0xea, 0xdc,
+	0x58, 0xd6, 0x04, 0x79, 0x65, 0x99, 0x5b, 0x97, 0xce, 0xcd, 0x57, 0x05, 0x63, 0x6f, 0xc9, 0x69,
+	0x29, 0x69, 0x45, 0xa3, 0x1d, 0x68, 0x8b, 0xb0, 0xe7, 0x29, 0x27, 0x6f, 0x30, 0x73, 0xeb, 0xf1,
+	0x87, 0x23, 0x9f, 0x67, 0xa9, 0xd0, 0x81, 0x17, 0xcf, 0x0c, 0xe8, 0x08, 0x7a, 0x2c, 0xec, 0x99,
+	0x17, 0x6e, 0x5d, 0x7e, 0x75, 0x88, 0xad, 0x4b, 0x32, 0x02, 0xdd, 0x84, 0x8e, 0xdc, 0xba, 0xf9,
+	0xfa, 0x2d, 0x39, 0xfd, 0x89, 0x0b, 0x76, 0xaf, 0x68, 0x40, 0xdb, 0x2f, 0x02, 0xe8, 0x95, 0x3c,
+	0x82, 0x32, 0xcf, 0xda, 0x52, 0xc7, 0xca, 0x39, 0x1d, 0x22, 0xd9, 0xe6, 0xb1, 0x13, 0xd4, 0x8e,
+	0x09, 0x4d, 0x16, 0x93, 0x44, 0xb6, 0xb5, 0xf6, 0x3f, 0xca, 0x60, 0x1e, 0x7a, 0xc7, 0x24, 0xc2,
+	0x37, 0x4f, 0x79, 0x82, 0xd1, 0xd3, 0xd0, 0xa5, 0xe4, 0x54, 0xde, 0xf1, 0x59, 0x67, 0xaf, 0xce,
+	0x4f, 0x5b, 0xc0, 0x7d, 0x16, 0xaa, 0xce, 0x5e, 0x36, 0x83, 0x09, 0x8b, 0x63, 0xe2, 0xbb, 0xea,
+	0xb5, 0x23, 0x7a, 0x62, 0xd1, 0x0c, 0x2a, 0x70, 0x5b, 0x3f, 0x77, 0x74, 0xbd, 0x71, 0xbd, 0x63,
+	0x4c, 0x27, 0xc4, 0xd7, 0x0f, 0xb1, 0xb6, 0x42, 0xfb, 0x0a, 0x5c, 0xb8, 0x57, 0x2b, 0x8b, 0xf7,
+	0xea, 0x67, 0x94, 0xfa, 0xea, 0xa3, 0x97, 0xfa, 0xda, 0x17, 0x28, 0xf5, 0xf5, 0xff, 0x5a, 0xea,
+	0x1b, 0x5f, 0xb8, 0xd4, 0x37, 0x1f, 0xa9, 0xd4, 0xc3, 0xa3, 0x94, 0x7a, 0xf3, 0x11, 0x4b, 0x7d,
+	0xeb, 0xa2, 0x52, 0x6f, 0xfb, 0xd0, 0x18, 0x50, 0xfe, 0xca, 0xcb, 0x77, 0x70, 0x8c, 0x6c, 0x30,
+	0x22, 0xfd, 0x0c, 0x51, 0x2f, 0x8a, 0x8c, 0xb3, 0x79, 0x47, 0x3d, 0x48, 0x8c, 0x68, 0xed, 0x65,
+	0xa8, 0x29, 0x42, 0x3c, 0x80, 0xa7, 0xe4, 0x4c, 0xe6, 0x41, 0xd9, 0x11, 0x43, 0xb4, 0x0a, 0xd5,
+	0x13, 0x1c, 0xce, 0x54, 0xad, 0x2b, 0x3b, 0x8a, 0x78, 0xbd, 0xf4, 0xaa, 0x61, 0xbf, 0x03, 0xad,
+	0x51, 0x82, 0x69, 0xba, 0x4b, 0x52, 0x51, 0x79, 0xd0, 0x65, 0xa8, 0xb1, 0xf1, 0xbd, 0x81, 0xbe,
+	0x86, 0xab, 0x8e, 0xa6, 0x04, 0x3e, 0x0e, 0xa7, 0x02, 0x57, 0xc5, 0x4a, 0x53, 0x02, 0x4f, 0xd8,
+	0x03, 0x81, 0x97, 0x15, 0xae, 0x28, 0xfb, 0xbb, 0x06, 0x98, 0x3b, 0xe1, 0x54, 0xea, 0x16, 0x1e,
+	0x3c, 0x3f, 0xf7, 0xe0, 0x8a, 0x6a, 0x1a, 0xe7, 0x4c, 0xed, 0x84, 0x7e, 0x52, 0x1b, 0xd1, 0xda,
+	0xad, 0x8b, 0x5c, 0xa9, 0x2a, 0x57, 0x9e, 0x29, 0xba, 0x62, 0x6e, 0x2d, 0xab, 0x17, 0x63, 0xc1,
+	0x85, 0xa2, 0x77, 0x7b, 0x80, 0xb2, 0x75, 0x8e, 0x48, 0xb2, 0xc3, 0xd8, 0x54, 0x34, 0x51, 0x5b,
+	0xd0, 0x88, 0x70, 0x1c, 0x07, 0x74, 0x92, 0x6a, 0x93, 0xac, 0xf3, 0x26, 0x69, 0x5b, 0x72, 0x39,
+	0xfb, 0x67, 0x25, 0xb0, 0x64, 0x3a, 0xf4, 0xe5, 0x4b, 0x51, 0x59, 0x77, 0xe1, 0x5b, 0xff, 0x12,
+	0xd4, 0xf8, 0x38, 0x9c, 0x57, 0x97, 0x2a, 0x1f, 0x87, 0x0f, 0x3d, 0xd6, 0xca, 0xe7, 0x1f, 0x6b,
+	0x5f, 0x87, 0x46, 0xca, 0x71, 0xc2, 0x5d, 0xd9, 0x9f, 0x7e, 0x66, 0x17, 0xae, 0xed, 0xaa, 0x4b,
+	0xd9, 0x51, 0x2a, 0x4a, 0xe7, 0xfc, 0x3c, 0xa4, 0xbd, 0xea, 0x7a, 0x79, 0xa3, 0xe5, 0x40, 0x94,
+	0x1d, 0x84, 0x54, 0x26, 0x6e, 0x42, 0x30, 0xcf, 0x24, 0x6a, 0x52, 0xc2, 0xd4, 0x98, 0x14, 0xf9,
+	0x1a, 0xd4, 0xc7, 0x2a, 0x32, 0xba, 0x26, 0x2c, 0x6e, 0xd0, 0x3c, 0x70, 0x4e, 0x26, 0x27, 0x96,
+	0xd5, 0x43, 0xf1, 0x06, 0x97, 0xa7, 0xac, 0xe9, 0x80, 0x86, 0x6e, 0x33, 0x4f, 0xec, 0x1b, 0x49,
+	0x12, 0x79, 0x98, 0x9a, 0x8e, 0x18, 0xda, 0x3f, 0x2c, 0x41, 0x47, 0x06, 0x70, 0x84, 0xd3, 0xe9,
+	0x97, 0x1e, 0xbe, 0xc2, 0x17, 0x91, 0xca, 0xc2, 0x17, 0x11, 0x1b, 0xda, 0x9c, 0xe9, 0xf3, 0x5d,
+	0x08, 0x91, 0xc9, 0x99, 0x34, 0x46, 0x06, 0x60, 0x13, 0x56, 0x48, 0xca, 0x83, 0x48, 0x46, 0x29,
+	0x22, 0x91, 0x3b, 0x4b, 0xf1, 0x44, 0xd5, 0xd8, 0x8a, 0xb3, 0x9c, 0xb3, 0xee, 0x90, 0xe8, 0xae,
+	0x60, 0x08, 0x5b, 0xb0, 0xe7, 0xb1, 0x19, 0xe5, 0xc2, 0x4c, 0x75, 0x09, 0x35, 0x35, 0xa2, 0xbe,
+	0xce, 0xcc, 0x52, 0x92, 0x08, 0x5e, 0x43, 0xf2, 0x6a, 0x82, 0x54, 0x8c, 0x84, 0xa9, 0x86, 0xa4,
+	0xa9, 0x18, 0x82, 0x1c, 0xf8, 0xcf, 0xfd, 0xb8, 0x04, 0xb5, 0x61, 0xdc, 0x67, 0x3e, 0x41, 0x75,
+	0x28, 0xef, 0xb3, 0xd8, 0x5a, 0x42, 0xcb, 0xd0, 0x1a, 0xc6, 0xb7, 0x08, 0xd7, 0x9f, 0x3d, 0xac,
+	0x7f, 0xd6, 0x91, 0x05, 0xe6, 0x30, 0x3e, 0x48, 0x74, 0x0a, 0x5a, 0xff, 0xaa, 0x23, 0x53, 0xcc,
+	0x13, 0x1f, 0x19, 0xad, 0x8f, 0xbb, 0xa8, 0x05, 0xf5, 0x61, 0xfc, 0x76, 0x38, 0x4b, 0x8f, 0xad,
+	0x5f, 0x76, 0xd5, 0xfc, 0xf9, 0x03, 0xd9, 0xfa, 0x55, 0x17, 0x75, 0xa0, 0x39, 0x8c, 0x07, 0x34,
+	0x8d, 0x89, 0xc7, 0xad, 0x5f, 0x77, 0xd1, 0x2a, 0x74, 0x87, 0xf1, 0xb6, 0xef, 0xbf, 0x8d, 0x67,
+	0x21, 0x3f, 0x90, 0x52, 0xbf, 0xe9, 0xa2, 0x36, 0x34, 0x86, 0xf1, 0x0e, 0xf6, 0xa6, 0xb3, 0xd8,
+	0xfa, 0x6d, 0x57, 0x2d, 0x3a, 0x4a, 0xb0, 0x47, 0x0e, 0x63, 0x4c, 0xad, 0xdf, 0x75, 0xd1, 0x0a,
+	0x74, 0x86, 0xf1, 0x21, 0x67, 0x09, 0x9e, 0x10, 0x19, 0x10, 0xeb, 0xf7, 0x5d, 0x74, 0x05, 0xd0,
+	0x30, 0xbe, 0x15, 0xb2, 0x31, 0x0e, 0x0b, 0x8b, 0xfe, 0xa1, 0x8b, 0x2e, 0xc3, 0xb2, 0x58, 0x94,
+	0x93, 0xc4, 0x23, 0x31, 0xd7, 0xa6, 0xff, 0xb1, 0x8b, 0x10, 0xb4, 0x87, 0xb1, 0x22, 0xe5, 0x4e,
+	0x58, 0x7f, 0xd2, 0xb2, 0xbb, 0x41, 0x3a, 0x15, 0xbf, 0x7e, 0x48, 0x30, 0x25, 0x89, 0xf5, 0x67,
+	0x6d, 0x92, 0x78, 0xf7, 0x93, 0xc4, 0xfa, 0x4b, 0xf7, 0xb9, 0x5f, 0x18, 0xd0, 0xcc, 0x3b, 0x33,
+	0x64, 0x42, 0x7d, 0x40, 0x4f, 0x70, 0x18, 0xf8, 0xd6, 0x12, 0x6a, 0x43, 0x33, 0xef, 0xbf, 0x2c,
+	0x03, 0x75, 0x00, 0xe6, 0x2d, 0x95, 0x55, 0x42, 0x5d, 0x30, 0x0b, 0x3d, 0x92, 0xfa, 0xc4, 0x70,
+	0xb7, 0xd8, 0xe6, 0x58, 0x15, 0xb4, 0x0a, 0x56, 0x06, 0x65, 0xcd, 0x8c, 0x55, 0x45, 0x16, 0xb4,
+	0xee, 0x16, 0x5a, 0x12, 0xab, 0x26, 0x90, 0x62, 0xc3, 0x61, 0x89, 0xfd, 0x69, 0xe5, 0x1d, 0x84,
+	0x58, 0xaf, 0x21, 0xd4, 0x2f, 0x34, 0x05, 0x56, 0x53, 0x98, 0x74, 0x37, 0xaf, 0xed, 0x16, 0x3c,
+	0x77, 0x0b, 0x9a, 0x79, 0x61, 0x12, 0x9f, 0x37, 0xb6, 0x67, 0x9c, 0x29, 0x47, 0xf6, 0x99, 0xfa,
+	0xec, 0x91, 0x5a, 0x06, 0x6a, 0x41, 0x63, 0x27, 0x98, 0x28, 0xab, 0x4b, 0x68, 0x05, 0xba, 0x7d,
+	0x46, 0x79, 0x40, 0x67, 0x6c, 0x96, 0xca, 0x8f, 0x56, 0x56, 0x79, 0xe7, 0xcd, 0x8f, 0x3e, 0xbd,
+	0x6a, 0x7c, 0xfc, 0xe9, 0x55, 0xe3, 0x93, 0x4f, 0xaf, 0x2e, 0x7d, 0xf0, 0xb7, 0xab, 0xc6, 0x7b,
+	0x2f, 0x14, 0x3e, 0x84, 0x47, 0x98, 0x27, 0xc1, 0x29, 0x4b, 0x82, 0x49, 0x40, 0x33, 0x82, 0x92,
+	0x1b, 0xf1, 0x74, 0x72, 0x23, 0x1e, 0xdf, 0xc0, 0x71, 0x30, 0xae, 0xc9, 0x2f, 0xde, 0x2f, 0xfd,
+	0x67, 0x00, 0xf3, 0x3b, 0x39, 0xdc, 0x4f, 0x17, 0x00, 0x00,
 }
 
 func (m *TNPingRequest) Marshal() (dAtA []byte, err error) {
@@ -3250,6 +3463,48 @@ func (m *AlterTablePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.SetColumnEncoding {
+		i--
+		if m.SetColumnEncoding {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.SetCompressLevel {
+		i--
+		if m.SetCompressLevel {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ColumnEncoding {
+		i--
+		if m.ColumnEncoding {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.CompressLevel != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.CompressLevel))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.CompressAlg) > 0 {
+		i -= len(m.CompressAlg)
+		copy(dAtA[i:], m.CompressAlg)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.CompressAlg)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.MinCnMergeSize != 0 {
 		i = encodeVarintApi(dAtA, i, uint64(m.MinCnMergeSize))
 		i--
@@ -3529,6 +3784,79 @@ func (m *AlterTableAddPartition) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *AlterTableDropPartition) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlterTableDropPartition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableDropPartition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.PartitionDef != nil {
+		{
+			size, err := m.PartitionDef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AlterTableKind) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlterTableKind) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableKind) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AlterTableDropColumn) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -3785,50 +4113,114 @@ func (m *AlterTableReq_RenameCol) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	}
 	return len(dAtA) - i, nil
 }
-func (m *SchemaExtra) Marshal() (dAtA []byte, err error) {
+func (m *AlterTableReq_DropPartition) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SchemaExtra) MarshalTo(dAtA []byte) (int, error) {
+func (m *AlterTableReq_DropPartition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DropPartition != nil {
+		{
+			size, err := m.DropPartition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AlterTableReq_UpdateKind) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SchemaExtra) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AlterTableReq_UpdateKind) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
+	if m.UpdateKind != nil {
+		{
+			size, err := m.UpdateKind.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *SchemaExtra) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchemaExtra) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SchemaExtra) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ColumnEncoding {
+		i--
+		if m.ColumnEncoding {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.CompressLevel != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.CompressLevel))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.CompressAlg) > 0 {
+		i -= len(m.CompressAlg)
+		copy(dAtA[i:], m.CompressAlg)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.CompressAlg)))
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.MinCnMergeSize != 0 {
 		i = encodeVarintApi(dAtA, i, uint64(m.MinCnMergeSize))
 		i--
 		dAtA[i] = 0x48
 	}
 	if len(m.Hints) > 0 {
-		dAtA28 := make([]byte, len(m.Hints)*10)
-		var j27 int
+		dAtA31 := make([]byte, len(m.Hints)*10)
+		var j30 int
 		for _, num := range m.Hints {
 			for num >= 1<<7 {
-				dAtA28[j27] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA31[j30] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j27++
+				j30++
 			}
-			dAtA28[j27] = uint8(num)
-			j27++
+			dAtA31[j30] = uint8(num)
+			j30++
 		}
-		i -= j27
-		copy(dAtA[i:], dAtA28[:j27])
-		i = encodeVarintApi(dAtA, i, uint64(j27))
+		i -= j30
+		copy(dAtA[i:], dAtA31[:j30])
+		i = encodeVarintApi(dAtA, i, uint64(j30))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -4604,6 +4996,22 @@ func (m *AlterTablePolicy) ProtoSize() (n int) {
 	if m.MinCnMergeSize != 0 {
 		n += 1 + sovApi(uint64(m.MinCnMergeSize))
 	}
+	l = len(m.CompressAlg)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.CompressLevel != 0 {
+		n += 1 + sovApi(uint64(m.CompressLevel))
+	}
+	if m.ColumnEncoding {
+		n += 2
+	}
+	if m.SetCompressLevel {
+		n += 2
+	}
+	if m.SetColumnEncoding {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4720,6 +5128,38 @@ func (m *AlterTableAddPartition) ProtoSize() (n int) {
 	return n
 }
 
+func (m *AlterTableDropPartition) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartitionDef != nil {
+		l = m.PartitionDef.ProtoSize()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AlterTableKind) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *AlterTableDropColumn) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -4858,6 +5298,30 @@ func (m *AlterTableReq_RenameCol) ProtoSize() (n int) {
 	}
 	return n
 }
+func (m *AlterTableReq_DropPartition) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DropPartition != nil {
+		l = m.DropPartition.ProtoSize()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+func (m *AlterTableReq_UpdateKind) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpdateKind != nil {
+		l = m.UpdateKind.ProtoSize()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
 func (m *SchemaExtra) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -4899,6 +5363,16 @@ func (m *SchemaExtra) ProtoSize() (n int) {
 	if m.MinCnMergeSize != 0 {
 		n += 1 + sovApi(uint64(m.MinCnMergeSize))
 	}
+	l = len(m.CompressAlg)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.CompressLevel != 0 {
+		n += 1 + sovApi(uint64(m.CompressLevel))
+	}
+	if m.ColumnEncoding {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -7269,6 +7743,117 @@ func (m *AlterTablePolicy) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompressAlg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompressAlg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompressLevel", wireType)
+			}
+			m.CompressLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompressLevel |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColumnEncoding", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ColumnEncoding = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetCompressLevel", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SetCompressLevel = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetColumnEncoding", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SetColumnEncoding = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -7901,6 +8486,176 @@ func (m *AlterTableAddPartition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AlterTableDropPartition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterTableDropPartition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterTableDropPartition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionDef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PartitionDef == nil {
+				m.PartitionDef = &plan.PartitionByDef{}
+			}
+			if err := m.PartitionDef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlterTableKind) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterTableKind: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterTableKind: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *AlterTableDropColumn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -8356,6 +9111,76 @@ func (m *AlterTableReq) Unmarshal(dAtA []byte) error {
 			}
 			m.Operation = &AlterTableReq_RenameCol{v}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DropPartition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AlterTableDropPartition{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Operation = &AlterTableReq_DropPartition{v}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateKind", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AlterTableKind{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Operation = &AlterTableReq_UpdateKind{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -8655,6 +9480,77 @@ func (m *SchemaExtra) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompressAlg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompressAlg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompressLevel", wireType)
+			}
+			m.CompressLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompressLevel |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColumnEncoding", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ColumnEncoding = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
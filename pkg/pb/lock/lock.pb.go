@@ -83,16 +83,21 @@ type WaitPolicy int32
 const (
 	WaitPolicy_Wait     WaitPolicy = 0
 	WaitPolicy_FastFail WaitPolicy = 1
+	// SkipLocked skip the rows which are held by other txns, only
+	// supported with row granularity.
+	WaitPolicy_SkipLocked WaitPolicy = 2
 )
 
 var WaitPolicy_name = map[int32]string{
 	0: "Wait",
 	1: "FastFail",
+	2: "SkipLocked",
 }
 
 var WaitPolicy_value = map[string]int32{
-	"Wait":     0,
-	"FastFail": 1,
+	"Wait":       0,
+	"FastFail":   1,
+	"SkipLocked": 2,
 }
 
 func (x WaitPolicy) String() string {
@@ -2427,7 +2432,10 @@ type Result struct {
 	Waiters         uint32 `protobuf:"varint,9,opt,name=Waiters,proto3" json:"Waiters,omitempty"`
 	// NewLockAdd if true means new lock added, false means lock is added before by current
 	// txn
-	NewLockAdd           bool     `protobuf:"varint,10,opt,name=NewLockAdd,proto3" json:"NewLockAdd,omitempty"`
+	NewLockAdd bool `protobuf:"varint,10,opt,name=NewLockAdd,proto3" json:"NewLockAdd,omitempty"`
+	// SkippedRows is the index of rows which are not locked because they are
+	// held by other txns, only used with SkipLocked wait policy.
+	SkippedRows          []uint32 `protobuf:"varint,11,rep,packed,name=SkippedRows,proto3" json:"SkippedRows,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2536,6 +2544,13 @@ func (m *Result) GetNewLockAdd() bool {
 	return false
 }
 
+func (m *Result) GetSkippedRows() []uint32 {
+	if m != nil {
+		return m.SkippedRows
+	}
+	return nil
+}
+
 type ExtraMutation struct {
 	Key                  []byte   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	Skip                 bool     `protobuf:"varint,2,opt,name=skip,proto3" json:"skip,omitempty"`
@@ -2647,130 +2662,131 @@ func init() {
 func init() { proto.RegisterFile("lock.proto", fileDescriptor_164ad2988c7acaf1) }
 
 var fileDescriptor_164ad2988c7acaf1 = []byte{
-	// 1959 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x59, 0x4b, 0x73, 0x1b, 0xc7,
-	0x11, 0xe6, 0x02, 0x20, 0x1e, 0xbd, 0x78, 0x2c, 0x87, 0x14, 0xbd, 0x52, 0x1c, 0x0a, 0xd9, 0x92,
-	0xab, 0x60, 0x3a, 0x16, 0x4b, 0x54, 0x64, 0x3b, 0x72, 0xac, 0x8a, 0x04, 0x4a, 0xb4, 0xac, 0x07,
-	0x9d, 0x01, 0xa4, 0x54, 0xe5, 0xb6, 0x04, 0x46, 0xe4, 0x16, 0xc1, 0x5d, 0x64, 0x77, 0x20, 0x82,
-	0x3f, 0x20, 0x55, 0xf9, 0x05, 0x39, 0xe7, 0x98, 0x4b, 0xf2, 0x3b, 0x7c, 0x74, 0x55, 0x6e, 0x39,
-	0xa4, 0x12, 0xe5, 0x2f, 0x24, 0xf7, 0xd4, 0x3c, 0x76, 0x77, 0x66, 0x1f, 0x84, 0xe5, 0xdb, 0x4e,
-	0x77, 0xcf, 0xd7, 0xd3, 0x8d, 0xd9, 0xaf, 0xbb, 0x17, 0x00, 0xb3, 0x60, 0x72, 0x76, 0x7b, 0x1e,
-	0x06, 0x34, 0x40, 0x35, 0xf6, 0x7c, 0xe3, 0xd3, 0x13, 0x8f, 0x9e, 0x2e, 0x8e, 0x6f, 0x4f, 0x82,
-	0xf3, 0xbd, 0x93, 0xe0, 0x24, 0xd8, 0xe3, 0xca, 0xe3, 0xc5, 0x1b, 0xbe, 0xe2, 0x0b, 0xfe, 0x24,
-	0x36, 0xdd, 0xe8, 0x51, 0xef, 0x9c, 0x44, 0xd4, 0x3d, 0x9f, 0x0b, 0x81, 0xf3, 0xdf, 0x0a, 0x98,
-	0xcf, 0x83, 0xc9, 0xd9, 0xd1, 0x9c, 0x7a, 0x81, 0x1f, 0xa1, 0xbb, 0x60, 0x1e, 0x86, 0xae, 0xbf,
-	0x98, 0xb9, 0xa1, 0x47, 0x2f, 0x6d, 0xa3, 0x6f, 0x0c, 0xba, 0xfb, 0x1b, 0xb7, 0xb9, 0x5f, 0x45,
-	0x81, 0x55, 0x2b, 0xe4, 0x40, 0xed, 0x45, 0x30, 0x25, 0x76, 0x85, 0x5b, 0x77, 0x85, 0x35, 0x43,
-	0x65, 0x52, 0xcc, 0x75, 0x68, 0x00, 0xf5, 0x6f, 0x83, 0x99, 0x37, 0xb9, 0xb4, 0xab, 0xdc, 0xca,
-	0x12, 0x56, 0xbf, 0x75, 0x3d, 0x2a, 0xe4, 0x58, 0xea, 0xd1, 0x87, 0xd0, 0x7a, 0x12, 0x84, 0x17,
-	0x6e, 0x38, 0x1d, 0x07, 0x76, 0xad, 0x6f, 0x0c, 0x5a, 0x38, 0x15, 0xa0, 0x01, 0xf4, 0xc6, 0xee,
-	0xf1, 0x8c, 0x1c, 0x90, 0x37, 0xc3, 0x53, 0xd7, 0x3f, 0x21, 0x53, 0x7b, 0xbd, 0x6f, 0x0c, 0x9a,
-	0x38, 0x2b, 0x66, 0x38, 0x98, 0xd0, 0xf0, 0x92, 0xb9, 0xb0, 0xeb, 0x7d, 0x63, 0x50, 0xc5, 0xa9,
-	0x00, 0x6d, 0xc1, 0xfa, 0x61, 0x18, 0x2c, 0xe6, 0x76, 0xa3, 0x6f, 0x0c, 0x3a, 0x58, 0x2c, 0xd0,
-	0x2e, 0x34, 0x47, 0xa7, 0x6e, 0x38, 0xf5, 0xfc, 0x13, 0xbb, 0xa9, 0x46, 0x13, 0x4b, 0x71, 0xa2,
-	0x47, 0xf7, 0x01, 0x46, 0xbe, 0x3b, 0x1f, 0x9d, 0x06, 0x74, 0x1c, 0xd9, 0xad, 0xbe, 0x31, 0x30,
-	0xf7, 0xb7, 0x6e, 0xa7, 0x09, 0x1e, 0xc7, 0x4f, 0x8f, 0x6a, 0xdf, 0xfd, 0xf3, 0xe6, 0x1a, 0x56,
-	0xac, 0x9d, 0xbf, 0x1b, 0xd0, 0x62, 0x09, 0xe2, 0x67, 0x66, 0x67, 0xe1, 0x0f, 0x3c, 0xdd, 0x35,
-	0x2c, 0x16, 0xec, 0xfc, 0x23, 0x12, 0xbe, 0xf5, 0x26, 0xe4, 0xe9, 0x01, 0x4f, 0x6d, 0x0b, 0xa7,
-	0x02, 0x64, 0x43, 0xe3, 0x35, 0x09, 0x23, 0x2f, 0xf0, 0x79, 0x42, 0x6b, 0x38, 0x5e, 0x32, 0xb4,
-	0xd7, 0xee, 0xcc, 0x9b, 0xf2, 0xdc, 0x35, 0xb1, 0x58, 0xa4, 0xf1, 0xae, 0x97, 0xc5, 0x5b, 0x5f,
-	0x11, 0x6f, 0x1f, 0xcc, 0xa3, 0xd0, 0x3b, 0xf1, 0x7c, 0x71, 0xd6, 0x06, 0xf7, 0xaa, 0x8a, 0x9c,
-	0x7f, 0x34, 0xa1, 0x81, 0xc9, 0xef, 0x17, 0x24, 0xa2, 0x22, 0xfb, 0xfc, 0xf1, 0xe9, 0x81, 0x8c,
-	0x2b, 0x15, 0xa0, 0xbb, 0x4a, 0xf8, 0x3c, 0x36, 0x73, 0xbf, 0x97, 0x5e, 0x1b, 0x2e, 0x96, 0x59,
-	0x53, 0xd2, 0x74, 0x0b, 0xea, 0x2f, 0x08, 0x3d, 0x0d, 0xa6, 0xf2, 0x0a, 0xb5, 0xc5, 0x0e, 0x21,
-	0xc3, 0x52, 0x87, 0x3e, 0x81, 0x1a, 0xdb, 0xc2, 0xa3, 0x37, 0xe3, 0xab, 0xcb, 0x24, 0xd2, 0xbb,
-	0xc4, 0xe5, 0x46, 0xe8, 0x0e, 0xd4, 0x5f, 0xf9, 0xcc, 0x82, 0xa7, 0xc5, 0xdc, 0xdf, 0x14, 0xe6,
-	0x42, 0xa6, 0x6f, 0x90, 0x86, 0xe8, 0x2b, 0x80, 0x43, 0x42, 0xc7, 0x4b, 0x9f, 0x7b, 0xa9, 0xf3,
-	0x6d, 0x1f, 0xc8, 0x17, 0x24, 0x91, 0xeb, 0x5b, 0x95, 0x0d, 0xe8, 0x29, 0x74, 0x0f, 0x09, 0x65,
-	0x57, 0xd0, 0xf3, 0x4f, 0x9e, 0x7b, 0x11, 0xe5, 0x89, 0x34, 0xf7, 0x7f, 0x92, 0x40, 0x28, 0x3a,
-	0x1d, 0x26, 0xb3, 0x11, 0xfd, 0x02, 0x1a, 0x87, 0x84, 0x3e, 0xf2, 0xfc, 0x29, 0xbf, 0xab, 0xec,
-	0xf6, 0xc5, 0x18, 0x4c, 0xa8, 0x6f, 0x8e, 0x4d, 0x11, 0x86, 0x8d, 0x67, 0x84, 0xcc, 0xd3, 0x3c,
-	0xb3, 0xfd, 0xe2, 0xf6, 0xee, 0x88, 0xfd, 0x39, 0xb5, 0x8e, 0x94, 0xdf, 0xce, 0x82, 0x62, 0x42,
-	0x4c, 0xce, 0x03, 0x4a, 0x78, 0x5e, 0x40, 0x0d, 0x4a, 0xd7, 0x65, 0x82, 0xd2, 0x95, 0xe8, 0x39,
-	0xf4, 0xf8, 0x85, 0x75, 0x29, 0x91, 0x97, 0xdd, 0x36, 0x39, 0xd6, 0x87, 0x02, 0x2b, 0xa3, 0xd4,
-	0xc1, 0xb2, 0x5b, 0xd1, 0x10, 0xda, 0x43, 0xd7, 0xf7, 0x03, 0x3a, 0x0c, 0xce, 0xcf, 0x3d, 0x6a,
-	0xb7, 0x39, 0xd4, 0x75, 0x01, 0xa5, 0x6a, 0x74, 0x1c, 0x6d, 0x13, 0x03, 0x39, 0x24, 0xf4, 0xe1,
-	0x84, 0x7a, 0x6f, 0xc9, 0x78, 0xe9, 0xdb, 0x1d, 0x15, 0x44, 0xd5, 0x64, 0x40, 0x54, 0x15, 0x4b,
-	0xfb, 0x88, 0x50, 0xcc, 0x18, 0x21, 0xa4, 0x71, 0x64, 0x5d, 0x35, 0xed, 0x39, 0x75, 0x26, 0xed,
-	0x39, 0x3d, 0xc3, 0x1c, 0xba, 0x7e, 0x06, 0xb3, 0xa7, 0x62, 0xe6, 0xd4, 0x19, 0xcc, 0x9c, 0x1e,
-	0xbd, 0x02, 0x84, 0xc9, 0xb9, 0xeb, 0xf9, 0xe3, 0xa5, 0xff, 0xd4, 0x8f, 0x41, 0x2d, 0x0e, 0x7a,
-	0x53, 0x80, 0xe6, 0xf5, 0x3a, 0x6a, 0x01, 0x00, 0xfa, 0x35, 0x98, 0xc3, 0x53, 0x32, 0x39, 0x3b,
-	0x0a, 0xe7, 0xa7, 0xae, 0x6f, 0x6f, 0x70, 0x3c, 0x5b, 0x1e, 0x32, 0x55, 0xe8, 0x40, 0xea, 0x16,
-	0xe7, 0x7f, 0x4d, 0x68, 0x62, 0x12, 0xcd, 0x03, 0x3f, 0x22, 0x2b, 0xd8, 0x25, 0x25, 0x8a, 0xca,
-	0x15, 0x44, 0xb1, 0x05, 0xeb, 0x8f, 0xc3, 0x30, 0x08, 0x39, 0x9b, 0xb4, 0xb1, 0x58, 0xa0, 0x8f,
-	0xa1, 0xf1, 0x92, 0x5c, 0xf0, 0x97, 0xa2, 0x56, 0xc8, 0x4b, 0x38, 0xd6, 0xa3, 0x9f, 0x4b, 0xa6,
-	0x11, 0xd4, 0x81, 0x54, 0xa6, 0x11, 0xc7, 0xd4, 0xa8, 0x66, 0x3f, 0xa1, 0x9a, 0xba, 0xfa, 0xb2,
-	0xc6, 0x54, 0xa3, 0xed, 0x88, 0xb9, 0xe6, 0x81, 0xc6, 0x35, 0x0d, 0x35, 0x69, 0x2a, 0xd7, 0x68,
-	0x7b, 0x55, 0xb2, 0xf9, 0x26, 0x47, 0x36, 0x4d, 0xf5, 0x5d, 0xca, 0x92, 0x8d, 0x86, 0x93, 0x65,
-	0x9b, 0x7b, 0x29, 0xdb, 0x08, 0xb6, 0xb8, 0x96, 0x61, 0x1b, 0x6d, 0x77, 0x42, 0x37, 0xa3, 0x22,
-	0xba, 0x01, 0xf5, 0x3a, 0x15, 0xd0, 0x8d, 0x06, 0x55, 0xc0, 0x37, 0xdf, 0xe4, 0xf8, 0x46, 0xe3,
-	0x88, 0x2c, 0xdf, 0xe8, 0x71, 0x65, 0x08, 0xe7, 0x45, 0x9e, 0x70, 0x04, 0x4b, 0xfc, 0xb4, 0x84,
-	0x70, 0x34, 0xb4, 0x1c, 0xe3, 0x1c, 0x64, 0x18, 0x47, 0x90, 0xc5, 0x8d, 0x22, 0xc6, 0xd1, 0x80,
-	0x74, 0xca, 0x39, 0xc8, 0x50, 0x4e, 0x57, 0x45, 0xd1, 0x29, 0x47, 0x47, 0xd1, 0x38, 0x67, 0x54,
-	0xc4, 0x39, 0x3d, 0x35, 0xf7, 0x05, 0x9c, 0xa3, 0xe7, 0x3e, 0x4f, 0x3a, 0xa3, 0x22, 0xd2, 0xd1,
-	0xf8, 0xa1, 0x80, 0x74, 0x74, 0xd0, 0x3c, 0xeb, 0xbc, 0x2e, 0x64, 0x1d, 0xc1, 0x12, 0xfd, 0x72,
-	0xd6, 0xd1, 0x60, 0x8b, 0x68, 0xe7, 0xa1, 0x4e, 0x3b, 0x48, 0xa3, 0x7f, 0x95, 0x76, 0x34, 0x24,
-	0x8d, 0x77, 0xfe, 0x68, 0x88, 0x0e, 0x39, 0x6e, 0x6c, 0x58, 0xb3, 0xb6, 0xf4, 0x25, 0xed, 0xb4,
-	0xb1, 0x58, 0xac, 0x68, 0xd6, 0x10, 0xd4, 0x70, 0x70, 0x11, 0xd9, 0xd5, 0x7e, 0x75, 0xd0, 0xc6,
-	0xfc, 0x19, 0xdd, 0x81, 0x86, 0x6c, 0xba, 0xf3, 0xad, 0x8a, 0x54, 0xc4, 0xef, 0x92, 0x5c, 0x3a,
-	0xf7, 0xa1, 0xad, 0x5e, 0x68, 0xb4, 0x0b, 0x75, 0x4c, 0xa2, 0xc5, 0x8c, 0xf2, 0xb3, 0x98, 0x31,
-	0xcf, 0x09, 0x59, 0x4c, 0x25, 0x62, 0xe5, 0x7c, 0x09, 0x1b, 0xb9, 0xf6, 0xa4, 0x24, 0x16, 0x0b,
-	0xaa, 0x38, 0xb8, 0xe0, 0x51, 0xb4, 0x31, 0x7b, 0x74, 0x5c, 0x40, 0x79, 0xbe, 0x91, 0x8d, 0xe6,
-	0x42, 0xb4, 0xad, 0xeb, 0x58, 0x2c, 0xd0, 0x3d, 0x30, 0x55, 0xc2, 0xa9, 0xf4, 0xab, 0x03, 0x73,
-	0xbf, 0x93, 0x76, 0xfb, 0xe3, 0xa5, 0x1f, 0xa7, 0x59, 0xb1, 0x73, 0x1e, 0xc0, 0xb5, 0xc2, 0xde,
-	0x07, 0x7d, 0x04, 0x55, 0xf6, 0x06, 0x88, 0x08, 0x0b, 0x71, 0x98, 0xde, 0x39, 0x82, 0xed, 0x62,
-	0x3a, 0xcb, 0x1e, 0xc8, 0xf8, 0x81, 0x07, 0xfa, 0x0a, 0x1a, 0x52, 0x5b, 0xfe, 0x93, 0x0f, 0x43,
-	0xe2, 0x52, 0x32, 0x3d, 0xf2, 0xe3, 0x9f, 0x3c, 0x11, 0x38, 0x7f, 0x32, 0xa0, 0xa3, 0xb5, 0x91,
-	0x25, 0x28, 0x9f, 0x41, 0x53, 0xbc, 0xf3, 0xe3, 0x91, 0x6c, 0x84, 0xaf, 0x9a, 0x21, 0x12, 0x5b,
-	0xf4, 0x39, 0xb4, 0x5e, 0x2c, 0xa8, 0x2b, 0x2e, 0x50, 0x95, 0xc7, 0x24, 0x9b, 0xd7, 0xc7, 0x4b,
-	0x1a, 0xba, 0xb1, 0x2e, 0xee, 0xa2, 0x13, 0x5b, 0xc7, 0x82, 0xae, 0x5e, 0x73, 0x9c, 0xbf, 0x18,
-	0xbc, 0x4c, 0x28, 0x9d, 0x9e, 0x7e, 0x9d, 0x8d, 0xec, 0x75, 0x4e, 0xe6, 0x95, 0x8a, 0x3a, 0xaf,
-	0x24, 0x13, 0x46, 0xb5, 0x6c, 0xc2, 0xa8, 0xbd, 0xdf, 0x84, 0xb1, 0x9e, 0x9f, 0x30, 0x9e, 0x40,
-	0x2f, 0x53, 0x6f, 0x7e, 0xd4, 0x28, 0xe1, 0xfc, 0xd5, 0x00, 0xbb, 0xac, 0xcd, 0x5d, 0x11, 0xfc,
-	0x2d, 0xa8, 0x8f, 0xa8, 0x4b, 0x17, 0x91, 0xde, 0x5c, 0x08, 0x19, 0x96, 0x3a, 0xb4, 0x0d, 0x75,
-	0xfe, 0xfb, 0xc6, 0xef, 0xbc, 0x5c, 0xa1, 0x7b, 0x00, 0x89, 0x4f, 0xf6, 0xe2, 0x57, 0xcb, 0x8f,
-	0xab, 0x18, 0x3a, 0xbf, 0x81, 0xeb, 0xa5, 0x65, 0x12, 0x75, 0xa1, 0x72, 0xf4, 0x8c, 0x1f, 0xb4,
-	0x89, 0x2b, 0x47, 0xcf, 0x7e, 0xd8, 0x09, 0x9d, 0x2f, 0xc0, 0x2e, 0xeb, 0x38, 0xaf, 0xce, 0x80,
-	0xf3, 0x09, 0x5c, 0x2f, 0xad, 0x1b, 0xd9, 0xc3, 0x30, 0x37, 0x65, 0x4d, 0xe8, 0x6a, 0x37, 0xa5,
-	0x95, 0x24, 0xe7, 0xe6, 0x97, 0x70, 0xbd, 0xb4, 0x2d, 0x5d, 0xe1, 0xe7, 0x3e, 0xdc, 0x28, 0xaf,
-	0x2d, 0xa2, 0xd3, 0x94, 0x5a, 0x49, 0x74, 0xa9, 0xc0, 0xb9, 0x07, 0xd7, 0x0a, 0x87, 0x9b, 0x15,
-	0x2e, 0x07, 0xb0, 0x5d, 0xdc, 0xa3, 0xe4, 0xe2, 0xfa, 0x0c, 0xb6, 0x8b, 0x27, 0x9e, 0x15, 0x1e,
-	0x3e, 0x86, 0x0f, 0x4a, 0x1a, 0x97, 0x9c, 0x0b, 0x0c, 0x9b, 0x05, 0x93, 0x10, 0xfa, 0x12, 0x3a,
-	0xa2, 0x02, 0x32, 0xda, 0x4f, 0x89, 0x53, 0x5e, 0xd6, 0x44, 0x25, 0x2f, 0xab, 0x6e, 0xeb, 0xfc,
-	0x0a, 0xb6, 0x8a, 0x7a, 0x1d, 0x74, 0x0b, 0x3a, 0x42, 0xc2, 0x68, 0x56, 0x64, 0x94, 0xbd, 0x1d,
-	0xba, 0xd0, 0xb9, 0x0b, 0x9b, 0x05, 0x63, 0xd5, 0x8a, 0x88, 0x1f, 0xc0, 0x56, 0x51, 0x63, 0x94,
-	0x7e, 0x0e, 0x31, 0xd4, 0xcf, 0x21, 0x96, 0xa8, 0x2a, 0x15, 0xee, 0x9e, 0x17, 0x90, 0x03, 0x40,
-	0xf9, 0x41, 0x64, 0x05, 0x17, 0x24, 0x28, 0x46, 0x8c, 0xf2, 0x29, 0x6c, 0x16, 0xf4, 0x15, 0x8c,
-	0x0e, 0x64, 0x0b, 0x22, 0x4e, 0x21, 0x57, 0xce, 0xe7, 0xd0, 0x4a, 0x12, 0x87, 0x6c, 0x68, 0xc4,
-	0x9d, 0x8f, 0xf0, 0x14, 0x2f, 0x0b, 0x4e, 0xfb, 0x87, 0x6a, 0x5c, 0xfb, 0xd1, 0x1d, 0x68, 0xb2,
-	0x2b, 0xc4, 0xcb, 0x90, 0x71, 0x15, 0xff, 0x25, 0x66, 0x8c, 0x68, 0xbf, 0x76, 0xa3, 0x61, 0xe0,
-	0xbf, 0x99, 0x79, 0x13, 0xca, 0xcf, 0xdf, 0xc4, 0xaa, 0x88, 0xfd, 0x50, 0x5f, 0xbb, 0xd1, 0xb7,
-	0x21, 0x79, 0x2b, 0xfb, 0xd8, 0x2a, 0xb7, 0xd1, 0x85, 0xe8, 0x0b, 0x68, 0x25, 0x15, 0x4a, 0x76,
-	0x31, 0x57, 0x55, 0xaf, 0xd4, 0xf8, 0x3d, 0x3e, 0xe3, 0xf5, 0xc1, 0x8c, 0x4f, 0xf5, 0x8c, 0x5c,
-	0xf2, 0xe1, 0xa9, 0x8d, 0x55, 0x91, 0x6a, 0xc1, 0xb2, 0xd4, 0xd0, 0x2d, 0x58, 0x66, 0x77, 0x00,
-	0xd8, 0xa9, 0x59, 0x3d, 0x27, 0x21, 0x9f, 0x81, 0xda, 0x58, 0x91, 0xb0, 0xcc, 0x8b, 0x27, 0xf1,
-	0x1d, 0xaf, 0x83, 0xe3, 0x25, 0xdb, 0xf9, 0x92, 0x5c, 0xb0, 0xc4, 0x3d, 0x9c, 0x8a, 0xb9, 0xa5,
-	0x89, 0x15, 0x89, 0x33, 0x82, 0x8e, 0x56, 0x6f, 0xd9, 0x4f, 0x75, 0x46, 0x2e, 0x65, 0x8d, 0x67,
-	0x8f, 0xac, 0xf9, 0x8b, 0xce, 0xbc, 0xb9, 0xcc, 0x32, 0x7f, 0x66, 0xd7, 0x2a, 0x24, 0xf3, 0x99,
-	0x3b, 0x21, 0xe3, 0x40, 0xce, 0x9f, 0xa9, 0x60, 0xf7, 0x67, 0xda, 0x47, 0x58, 0xd4, 0xe0, 0xfd,
-	0x98, 0xb5, 0x86, 0x5a, 0xb0, 0x8e, 0x59, 0x52, 0x2c, 0x63, 0xf7, 0x23, 0xf1, 0xa3, 0xf3, 0x4f,
-	0xab, 0x1d, 0x68, 0x3d, 0x5e, 0x4e, 0x66, 0x8b, 0xc8, 0x7b, 0x4b, 0xac, 0x35, 0x04, 0x50, 0x67,
-	0x15, 0x95, 0x4c, 0x2d, 0x63, 0xf7, 0x16, 0x40, 0xfa, 0x85, 0x15, 0x35, 0xa1, 0xc6, 0x56, 0xd6,
-	0x1a, 0x6a, 0x43, 0xf3, 0x89, 0x1b, 0xd1, 0x27, 0xae, 0x37, 0xb3, 0x8c, 0xdd, 0x9b, 0x69, 0x8d,
-	0x66, 0x36, 0x2f, 0x03, 0x9f, 0x08, 0x6f, 0x8f, 0x2e, 0x99, 0x63, 0x63, 0xf7, 0x6f, 0x95, 0x78,
-	0xa2, 0x66, 0x7a, 0xe6, 0x58, 0xf8, 0x11, 0x8d, 0x84, 0x65, 0xa0, 0xae, 0x3a, 0xa8, 0x5a, 0x15,
-	0x84, 0xb2, 0x83, 0xa7, 0x55, 0x65, 0x32, 0x9d, 0xf4, 0xac, 0x1a, 0x32, 0x93, 0xa1, 0xd2, 0x5a,
-	0x47, 0xd7, 0x0a, 0x46, 0x45, 0xab, 0x8e, 0x7a, 0x60, 0xca, 0xcf, 0xbf, 0x7c, 0x53, 0x03, 0x6d,
-	0x40, 0x47, 0x0a, 0xa4, 0xff, 0x26, 0xda, 0xcc, 0x0d, 0x71, 0x56, 0x0b, 0x59, 0xfa, 0x28, 0x66,
-	0x01, 0x93, 0xa8, 0x1c, 0x61, 0x99, 0xcc, 0x67, 0xae, 0x96, 0x59, 0x6d, 0xb4, 0x5d, 0x34, 0x8f,
-	0x58, 0x1d, 0x66, 0x9e, 0xab, 0x49, 0x56, 0x97, 0x1d, 0x51, 0x79, 0xeb, 0xad, 0xde, 0x2e, 0x89,
-	0x4b, 0xb0, 0x70, 0xc0, 0xed, 0xd8, 0xe9, 0x1f, 0xfb, 0x2c, 0x30, 0x6b, 0x8d, 0x39, 0x50, 0xc4,
-	0x32, 0x51, 0x96, 0xa1, 0x98, 0xbf, 0xe2, 0xb9, 0x1c, 0x2d, 0x26, 0x13, 0xab, 0xa2, 0x88, 0x53,
-	0xf7, 0x56, 0xf5, 0xd1, 0xf0, 0xfb, 0x7f, 0xef, 0x18, 0xdf, 0xbd, 0xdb, 0x31, 0xbe, 0x7f, 0xb7,
-	0x63, 0xfc, 0xeb, 0xdd, 0xce, 0xda, 0x9f, 0xff, 0xb3, 0x63, 0xfc, 0x4e, 0xfd, 0x4f, 0xe0, 0xdc,
-	0xa5, 0xa1, 0xb7, 0x0c, 0x78, 0x0b, 0x15, 0x2f, 0x7c, 0xb2, 0x37, 0x3f, 0x3b, 0xd9, 0x9b, 0x1f,
-	0xef, 0xb1, 0xec, 0x1d, 0xd7, 0xf9, 0x3f, 0x01, 0x77, 0xff, 0x1f, 0x00, 0x00, 0xff, 0xff, 0xd7,
-	0x5b, 0xeb, 0xde, 0x5d, 0x18, 0x00, 0x00,
+	// 1976 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x59, 0xdd, 0x72, 0xdb, 0xc6,
+	0xf5, 0x17, 0x48, 0x8a, 0x04, 0x0f, 0xf8, 0x01, 0xad, 0x64, 0x05, 0xf6, 0x3f, 0x7f, 0x99, 0xc5,
+	0x38, 0x33, 0x8c, 0xd2, 0x58, 0x63, 0x39, 0x4e, 0x52, 0xa7, 0xf1, 0xd4, 0xa6, 0x6c, 0xc5, 0xf5,
+	0x87, 0xd2, 0x25, 0xed, 0xce, 0xf4, 0x0e, 0x22, 0xd7, 0x12, 0x46, 0x14, 0xc0, 0x82, 0xa0, 0x25,
+	0xbd, 0x41, 0x9f, 0xa0, 0xd7, 0xbd, 0x6b, 0x6f, 0xda, 0xe7, 0xc8, 0x65, 0x66, 0x7a, 0xd7, 0x8b,
+	0x4e, 0xeb, 0xbe, 0x42, 0x7b, 0xdf, 0x39, 0xbb, 0x0b, 0x62, 0x17, 0x1f, 0x66, 0xd2, 0x3b, 0xec,
+	0xf9, 0xf8, 0x9d, 0x3d, 0x87, 0x8b, 0xdf, 0x9e, 0x03, 0x02, 0x4c, 0xc3, 0xf1, 0xd9, 0xed, 0x59,
+	0x14, 0xc6, 0x21, 0xa9, 0xe1, 0xf3, 0x8d, 0x4f, 0x4f, 0xfc, 0xf8, 0x74, 0x71, 0x7c, 0x7b, 0x1c,
+	0x9e, 0xef, 0x9d, 0x84, 0x27, 0xe1, 0x1e, 0x57, 0x1e, 0x2f, 0xde, 0xf0, 0x15, 0x5f, 0xf0, 0x27,
+	0xe1, 0x74, 0xa3, 0x1b, 0xfb, 0xe7, 0x6c, 0x1e, 0x7b, 0xe7, 0x33, 0x21, 0x70, 0xff, 0x5d, 0x01,
+	0xeb, 0x79, 0x38, 0x3e, 0x3b, 0x9a, 0xc5, 0x7e, 0x18, 0xcc, 0xc9, 0x5d, 0xb0, 0x0e, 0x23, 0x2f,
+	0x58, 0x4c, 0xbd, 0xc8, 0x8f, 0xaf, 0x1c, 0xa3, 0x67, 0xf4, 0x3b, 0xfb, 0x1b, 0xb7, 0x79, 0x5c,
+	0x45, 0x41, 0x55, 0x2b, 0xe2, 0x42, 0xed, 0x45, 0x38, 0x61, 0x4e, 0x85, 0x5b, 0x77, 0x84, 0x35,
+	0xa2, 0xa2, 0x94, 0x72, 0x1d, 0xe9, 0x43, 0xfd, 0xdb, 0x70, 0xea, 0x8f, 0xaf, 0x9c, 0x2a, 0xb7,
+	0xb2, 0x85, 0xd5, 0xaf, 0x3d, 0x3f, 0x16, 0x72, 0x2a, 0xf5, 0xe4, 0x43, 0x68, 0x3e, 0x09, 0xa3,
+	0x0b, 0x2f, 0x9a, 0x8c, 0x42, 0xa7, 0xd6, 0x33, 0xfa, 0x4d, 0x9a, 0x0a, 0x48, 0x1f, 0xba, 0x23,
+	0xef, 0x78, 0xca, 0x0e, 0xd8, 0x9b, 0xc1, 0xa9, 0x17, 0x9c, 0xb0, 0x89, 0xb3, 0xde, 0x33, 0xfa,
+	0x26, 0xcd, 0x8a, 0x11, 0x87, 0xb2, 0x38, 0xba, 0xc2, 0x10, 0x4e, 0xbd, 0x67, 0xf4, 0xab, 0x34,
+	0x15, 0x90, 0x2d, 0x58, 0x3f, 0x8c, 0xc2, 0xc5, 0xcc, 0x69, 0xf4, 0x8c, 0x7e, 0x9b, 0x8a, 0x05,
+	0xd9, 0x05, 0x73, 0x78, 0xea, 0x45, 0x13, 0x3f, 0x38, 0x71, 0x4c, 0x35, 0x9b, 0x44, 0x4a, 0x97,
+	0x7a, 0x72, 0x1f, 0x60, 0x18, 0x78, 0xb3, 0xe1, 0x69, 0x18, 0x8f, 0xe6, 0x4e, 0xb3, 0x67, 0xf4,
+	0xad, 0xfd, 0xad, 0xdb, 0x69, 0x81, 0x47, 0xc9, 0xd3, 0xa3, 0xda, 0x77, 0x7f, 0xbf, 0xb9, 0x46,
+	0x15, 0x6b, 0xf7, 0xaf, 0x06, 0x34, 0xb1, 0x40, 0x7c, 0xcf, 0xb8, 0x17, 0xfe, 0xc0, 0xcb, 0x5d,
+	0xa3, 0x62, 0x81, 0xfb, 0x1f, 0xb2, 0xe8, 0xad, 0x3f, 0x66, 0x4f, 0x0f, 0x78, 0x69, 0x9b, 0x34,
+	0x15, 0x10, 0x07, 0x1a, 0xaf, 0x59, 0x34, 0xf7, 0xc3, 0x80, 0x17, 0xb4, 0x46, 0x93, 0x25, 0xa2,
+	0xbd, 0xf6, 0xa6, 0xfe, 0x84, 0xd7, 0xce, 0xa4, 0x62, 0x91, 0xe6, 0xbb, 0x5e, 0x96, 0x6f, 0x7d,
+	0x45, 0xbe, 0x3d, 0xb0, 0x8e, 0x22, 0xff, 0xc4, 0x0f, 0xc4, 0x5e, 0x1b, 0x3c, 0xaa, 0x2a, 0x72,
+	0xff, 0x66, 0x42, 0x83, 0xb2, 0xdf, 0x2e, 0xd8, 0x3c, 0x16, 0xd5, 0xe7, 0x8f, 0x4f, 0x0f, 0x64,
+	0x5e, 0xa9, 0x80, 0xdc, 0x55, 0xd2, 0xe7, 0xb9, 0x59, 0xfb, 0xdd, 0xf4, 0xd8, 0x70, 0xb1, 0xac,
+	0x9a, 0x52, 0xa6, 0x5b, 0x50, 0x7f, 0xc1, 0xe2, 0xd3, 0x70, 0x22, 0x8f, 0x50, 0x4b, 0x78, 0x08,
+	0x19, 0x95, 0x3a, 0xf2, 0x09, 0xd4, 0xd0, 0x85, 0x67, 0x6f, 0x25, 0x47, 0x17, 0x25, 0x32, 0xba,
+	0xc4, 0xe5, 0x46, 0xe4, 0x0e, 0xd4, 0x5f, 0x05, 0x68, 0xc1, 0xcb, 0x62, 0xed, 0x6f, 0x0a, 0x73,
+	0x21, 0xd3, 0x1d, 0xa4, 0x21, 0xf9, 0x1a, 0xe0, 0x90, 0xc5, 0xa3, 0xcb, 0x80, 0x47, 0xa9, 0x73,
+	0xb7, 0x0f, 0xe4, 0x0b, 0xb2, 0x94, 0xeb, 0xae, 0x8a, 0x03, 0x79, 0x0a, 0x9d, 0x43, 0x16, 0xe3,
+	0x11, 0xf4, 0x83, 0x93, 0xe7, 0xfe, 0x3c, 0xe6, 0x85, 0xb4, 0xf6, 0xff, 0x6f, 0x09, 0xa1, 0xe8,
+	0x74, 0x98, 0x8c, 0x23, 0xf9, 0x0c, 0x1a, 0x87, 0x2c, 0x7e, 0xe4, 0x07, 0x13, 0xc7, 0x94, 0xa7,
+	0x2f, 0xc1, 0x40, 0xa1, 0xee, 0x9c, 0x98, 0x12, 0x0a, 0x1b, 0xcf, 0x18, 0x9b, 0xa5, 0x75, 0x46,
+	0x7f, 0x71, 0x7a, 0x77, 0x84, 0x7f, 0x4e, 0xad, 0x23, 0xe5, 0xdd, 0x31, 0x29, 0x14, 0x52, 0x76,
+	0x1e, 0xc6, 0x8c, 0xd7, 0x05, 0xd4, 0xa4, 0x74, 0x5d, 0x26, 0x29, 0x5d, 0x49, 0x9e, 0x43, 0x97,
+	0x1f, 0x58, 0x2f, 0x66, 0xf2, 0xb0, 0x3b, 0x16, 0xc7, 0xfa, 0x50, 0x60, 0x65, 0x94, 0x3a, 0x58,
+	0xd6, 0x95, 0x0c, 0xa0, 0x35, 0xf0, 0x82, 0x20, 0x8c, 0x07, 0xe1, 0xf9, 0xb9, 0x1f, 0x3b, 0x2d,
+	0x0e, 0x75, 0x5d, 0x40, 0xa9, 0x1a, 0x1d, 0x47, 0x73, 0x42, 0x90, 0x43, 0x16, 0x3f, 0x1c, 0xc7,
+	0xfe, 0x5b, 0x36, 0xba, 0x0c, 0x9c, 0xb6, 0x0a, 0xa2, 0x6a, 0x32, 0x20, 0xaa, 0x0a, 0xcb, 0x3e,
+	0x64, 0x31, 0x45, 0x46, 0x88, 0xe2, 0x24, 0xb3, 0x8e, 0x5a, 0xf6, 0x9c, 0x3a, 0x53, 0xf6, 0x9c,
+	0x1e, 0x31, 0x07, 0x5e, 0x90, 0xc1, 0xec, 0xaa, 0x98, 0x39, 0x75, 0x06, 0x33, 0xa7, 0x27, 0xaf,
+	0x80, 0x50, 0x76, 0xee, 0xf9, 0xc1, 0xe8, 0x32, 0x78, 0x1a, 0x24, 0xa0, 0x36, 0x07, 0xbd, 0x29,
+	0x40, 0xf3, 0x7a, 0x1d, 0xb5, 0x00, 0x80, 0xfc, 0x02, 0xac, 0xc1, 0x29, 0x1b, 0x9f, 0x1d, 0x45,
+	0xb3, 0x53, 0x2f, 0x70, 0x36, 0x38, 0x9e, 0x23, 0x37, 0x99, 0x2a, 0x74, 0x20, 0xd5, 0xc5, 0xfd,
+	0x8f, 0x09, 0x26, 0x65, 0xf3, 0x59, 0x18, 0xcc, 0xd9, 0x0a, 0x76, 0x49, 0x89, 0xa2, 0xf2, 0x1e,
+	0xa2, 0xd8, 0x82, 0xf5, 0xc7, 0x51, 0x14, 0x46, 0x9c, 0x4d, 0x5a, 0x54, 0x2c, 0xc8, 0xc7, 0xd0,
+	0x78, 0xc9, 0x2e, 0xf8, 0x4b, 0x51, 0x2b, 0xe4, 0x25, 0x9a, 0xe8, 0xc9, 0x4f, 0x25, 0xd3, 0x08,
+	0xea, 0x20, 0x2a, 0xd3, 0x88, 0x6d, 0x6a, 0x54, 0xb3, 0xbf, 0xa4, 0x9a, 0xba, 0xfa, 0xb2, 0x26,
+	0x54, 0xa3, 0x79, 0x48, 0x4b, 0xf2, 0x40, 0xe3, 0x9a, 0x86, 0x5a, 0x34, 0x95, 0x6b, 0x34, 0x5f,
+	0xc5, 0x83, 0xfc, 0x32, 0x47, 0x36, 0xa6, 0xfa, 0x2e, 0x65, 0xc9, 0x46, 0xc3, 0xc9, 0x78, 0x92,
+	0x7b, 0x29, 0xdb, 0x08, 0xb6, 0xb8, 0x96, 0x61, 0x1b, 0xcd, 0x3b, 0xb1, 0x25, 0xc3, 0x22, 0xba,
+	0x01, 0xf5, 0x38, 0x15, 0xd0, 0x8d, 0x06, 0x95, 0xf7, 0xc7, 0xbc, 0x32, 0x7c, 0xa3, 0x71, 0x44,
+	0x96, 0x6f, 0xf4, 0xbc, 0x74, 0x2d, 0x79, 0x91, 0x27, 0x1c, 0xc1, 0x12, 0xff, 0x5f, 0x42, 0x38,
+	0x1a, 0x5a, 0xd6, 0x97, 0x1c, 0x64, 0x18, 0x47, 0x90, 0xc5, 0x8d, 0x22, 0xc6, 0xd1, 0x80, 0x34,
+	0x2f, 0x44, 0xd1, 0x28, 0xa7, 0xa3, 0xa2, 0xe8, 0x94, 0xa3, 0xa3, 0xa8, 0x3a, 0xac, 0x7d, 0x9e,
+	0x73, 0xba, 0x6a, 0xed, 0x0b, 0x38, 0x47, 0xaf, 0x7d, 0xce, 0x00, 0x41, 0xf3, 0xa4, 0xa3, 0xf1,
+	0x43, 0x01, 0xe9, 0xe8, 0xa0, 0x39, 0x03, 0xf2, 0xba, 0x90, 0x75, 0x04, 0x4b, 0xf4, 0xca, 0x59,
+	0x47, 0x83, 0x2d, 0xa2, 0x9d, 0x87, 0x3a, 0xed, 0x10, 0x8d, 0xfe, 0x55, 0xda, 0xd1, 0x90, 0x34,
+	0xde, 0xf9, 0x9d, 0x21, 0x3a, 0xe4, 0xa4, 0xb1, 0xc1, 0x66, 0xed, 0x32, 0x90, 0xb4, 0xd3, 0xa2,
+	0x62, 0xb1, 0xa2, 0x59, 0x23, 0x50, 0xa3, 0xe1, 0xc5, 0xdc, 0xa9, 0xf6, 0xaa, 0xfd, 0x16, 0xe5,
+	0xcf, 0xe4, 0x0e, 0x34, 0x64, 0xd3, 0x9d, 0x6f, 0x55, 0xa4, 0x22, 0x79, 0x97, 0xe4, 0xd2, 0xbd,
+	0x0f, 0x2d, 0xf5, 0x40, 0x93, 0x5d, 0xa8, 0x53, 0x36, 0x5f, 0x4c, 0x63, 0xbe, 0x17, 0x2b, 0xe1,
+	0x39, 0x21, 0x4b, 0xa8, 0x44, 0xac, 0xdc, 0xaf, 0x60, 0x23, 0xd7, 0x9e, 0x94, 0xe4, 0x62, 0x43,
+	0x95, 0x86, 0x17, 0x3c, 0x8b, 0x16, 0xc5, 0x47, 0xd7, 0x03, 0x92, 0xe7, 0x1b, 0xd9, 0x68, 0x2e,
+	0x44, 0xdb, 0xba, 0x4e, 0xc5, 0x82, 0xdc, 0x03, 0x4b, 0x25, 0x9c, 0x4a, 0xaf, 0xda, 0xb7, 0xf6,
+	0xdb, 0x69, 0xb7, 0x3f, 0xba, 0x0c, 0x92, 0x32, 0x2b, 0x76, 0xee, 0x03, 0xb8, 0x56, 0xd8, 0xfb,
+	0x90, 0x8f, 0xa0, 0x8a, 0x6f, 0x80, 0xc8, 0xb0, 0x10, 0x07, 0xf5, 0xee, 0x11, 0x6c, 0x17, 0xd3,
+	0x59, 0x76, 0x43, 0xc6, 0x0f, 0xdc, 0xd0, 0xd7, 0xd0, 0x90, 0xda, 0xf2, 0x9f, 0x7c, 0x10, 0x31,
+	0x2f, 0x66, 0x93, 0xa3, 0x20, 0xf9, 0xc9, 0x97, 0x02, 0xf7, 0xf7, 0x06, 0xb4, 0xb5, 0x36, 0xb2,
+	0x04, 0xe5, 0x73, 0x30, 0xc5, 0x3b, 0x3f, 0x1a, 0x3a, 0x95, 0x95, 0x33, 0xc4, 0xd2, 0x96, 0x7c,
+	0x01, 0xcd, 0x17, 0x8b, 0xd8, 0x13, 0x07, 0xa8, 0xda, 0xab, 0xa6, 0xcd, 0xeb, 0xe3, 0xcb, 0x38,
+	0xf2, 0x12, 0x9d, 0xf4, 0x4b, 0x6d, 0x5d, 0x1b, 0x3a, 0xfa, 0x9d, 0xe3, 0xfe, 0xc9, 0xe0, 0xd7,
+	0x84, 0xd2, 0xe9, 0xe9, 0xc7, 0xd9, 0xc8, 0x1e, 0xe7, 0xe5, 0xbc, 0x52, 0x51, 0xe7, 0x95, 0xe5,
+	0x84, 0x51, 0x2d, 0x9b, 0x30, 0x6a, 0x3f, 0x6e, 0xc2, 0x58, 0xcf, 0x4f, 0x18, 0x4f, 0xa0, 0x9b,
+	0xb9, 0x6f, 0xfe, 0xa7, 0x51, 0xc2, 0xfd, 0xb3, 0x01, 0x4e, 0x59, 0x9b, 0xbb, 0x22, 0xf9, 0x5b,
+	0x50, 0x1f, 0xc6, 0x5e, 0xbc, 0x98, 0xeb, 0xcd, 0x85, 0x90, 0x51, 0xa9, 0x23, 0xdb, 0x50, 0xe7,
+	0xbf, 0x6f, 0xf2, 0xce, 0xcb, 0x15, 0xb9, 0x07, 0xb0, 0x8c, 0x89, 0x2f, 0x7e, 0xb5, 0x7c, 0xbb,
+	0x8a, 0xa1, 0xfb, 0x2b, 0xb8, 0x5e, 0x7a, 0x4d, 0x92, 0x0e, 0x54, 0x8e, 0x9e, 0xf1, 0x8d, 0x9a,
+	0xb4, 0x72, 0xf4, 0xec, 0x87, 0xed, 0xd0, 0xfd, 0x12, 0x9c, 0xb2, 0x8e, 0xf3, 0xfd, 0x15, 0x70,
+	0x3f, 0x81, 0xeb, 0xa5, 0xf7, 0x46, 0x76, 0x33, 0x18, 0xa6, 0xac, 0x09, 0x5d, 0x1d, 0xa6, 0xf4,
+	0x26, 0xc9, 0x85, 0xf9, 0x19, 0x5c, 0x2f, 0x6d, 0x4b, 0x57, 0xc4, 0xb9, 0x0f, 0x37, 0xca, 0xef,
+	0x16, 0xd1, 0x69, 0x4a, 0xad, 0x24, 0xba, 0x54, 0xe0, 0xde, 0x83, 0x6b, 0x85, 0xc3, 0xcd, 0x8a,
+	0x90, 0x7d, 0xd8, 0x2e, 0xee, 0x51, 0x72, 0x79, 0x7d, 0x0e, 0xdb, 0xc5, 0x13, 0xcf, 0x8a, 0x08,
+	0x1f, 0xc3, 0x07, 0x25, 0x8d, 0x4b, 0x2e, 0x04, 0x85, 0xcd, 0x82, 0x49, 0x88, 0x7c, 0x05, 0x6d,
+	0x71, 0x03, 0x22, 0xed, 0xa7, 0xc4, 0x29, 0x0f, 0xeb, 0x52, 0x25, 0x0f, 0xab, 0x6e, 0xeb, 0xfe,
+	0x1c, 0xb6, 0x8a, 0x7a, 0x1d, 0x72, 0x0b, 0xda, 0x42, 0x82, 0x34, 0x2b, 0x2a, 0x8a, 0x6f, 0x87,
+	0x2e, 0x74, 0xef, 0xc2, 0x66, 0xc1, 0x58, 0xb5, 0x22, 0xe3, 0x07, 0xb0, 0x55, 0xd4, 0x18, 0xa5,
+	0x9f, 0x43, 0x0c, 0xf5, 0x73, 0x88, 0x2d, 0x6e, 0x95, 0x0a, 0x0f, 0x8f, 0x8f, 0xee, 0x01, 0x90,
+	0xfc, 0x20, 0xb2, 0x82, 0x0b, 0x96, 0x28, 0x46, 0x82, 0xf2, 0x29, 0x6c, 0x16, 0xf4, 0x15, 0x48,
+	0x07, 0x42, 0x22, 0x77, 0x21, 0x57, 0xee, 0x17, 0xd0, 0x5c, 0x16, 0x0e, 0x3f, 0xe9, 0x24, 0x9d,
+	0x8f, 0x88, 0x94, 0x2c, 0x0b, 0x76, 0xfb, 0xc7, 0x6a, 0x72, 0xf7, 0x93, 0x3b, 0x60, 0xe2, 0x11,
+	0xe2, 0xd7, 0x90, 0xf1, 0x3e, 0xfe, 0x5b, 0x9a, 0x21, 0xd1, 0x7e, 0xe3, 0xcd, 0x07, 0x61, 0xf0,
+	0x66, 0xea, 0x8f, 0x63, 0xbe, 0x7f, 0x93, 0xaa, 0x22, 0xfc, 0xa1, 0xbe, 0xf1, 0xe6, 0xdf, 0x46,
+	0xec, 0xad, 0xec, 0x63, 0xab, 0xdc, 0x46, 0x17, 0x92, 0x2f, 0xa1, 0xb9, 0xbc, 0xa1, 0x9c, 0xda,
+	0xca, 0xdb, 0x2b, 0x35, 0xfe, 0x11, 0x9f, 0xf1, 0x7a, 0x60, 0x25, 0xbb, 0x7a, 0xc6, 0xae, 0xf8,
+	0xf0, 0xd4, 0xa2, 0xaa, 0x48, 0xb5, 0xc0, 0x2a, 0x35, 0x74, 0x0b, 0xac, 0xec, 0x0e, 0x00, 0xee,
+	0x1a, 0xef, 0x73, 0x16, 0xf1, 0x19, 0xa8, 0x45, 0x15, 0x09, 0x56, 0x5e, 0x3c, 0x89, 0xef, 0x78,
+	0x6d, 0x9a, 0x2c, 0xd1, 0xf3, 0x25, 0xbb, 0xc0, 0xc2, 0x3d, 0x9c, 0x88, 0xb9, 0xc5, 0xa4, 0x8a,
+	0x04, 0x63, 0x0f, 0xcf, 0xfc, 0xd9, 0x8c, 0x4d, 0x78, 0x83, 0x67, 0xf5, 0xaa, 0xfd, 0x36, 0x55,
+	0x45, 0xee, 0x10, 0xda, 0xda, 0x8d, 0x8c, 0x3f, 0xe6, 0x19, 0xbb, 0x92, 0x5d, 0x00, 0x3e, 0x62,
+	0x7b, 0x38, 0x3f, 0xf3, 0x67, 0xf2, 0x77, 0xe0, 0xcf, 0x78, 0xf0, 0x22, 0x36, 0x9b, 0x7a, 0x63,
+	0x36, 0x0a, 0xe5, 0x84, 0x9a, 0x0a, 0x76, 0x7f, 0xa2, 0x7d, 0xa6, 0x25, 0x0d, 0xde, 0xb1, 0xd9,
+	0x6b, 0xa4, 0x09, 0xeb, 0x14, 0xcb, 0x66, 0x1b, 0xbb, 0x1f, 0x89, 0x63, 0xc1, 0x3f, 0xbe, 0xb6,
+	0xa1, 0xf9, 0xf8, 0x72, 0x3c, 0x5d, 0xcc, 0xfd, 0xb7, 0xcc, 0x5e, 0x23, 0x00, 0x75, 0xbc, 0x73,
+	0xd9, 0xc4, 0x36, 0x76, 0x3f, 0x03, 0x48, 0xbf, 0xc1, 0x12, 0x13, 0x6a, 0xb8, 0xb2, 0xd7, 0x48,
+	0x0b, 0xcc, 0x27, 0xde, 0x3c, 0x7e, 0xe2, 0xf9, 0x53, 0xdb, 0x20, 0x1d, 0x00, 0xcc, 0x49, 0x1c,
+	0x20, 0xbb, 0xb2, 0x7b, 0x33, 0xbd, 0xd5, 0xd1, 0xe7, 0x65, 0x18, 0x30, 0x11, 0xfd, 0xd1, 0x15,
+	0x6e, 0xc4, 0xd8, 0xfd, 0x4b, 0x25, 0x99, 0xc1, 0x51, 0x8f, 0x7e, 0x22, 0xae, 0x68, 0x3d, 0x04,
+	0x62, 0xda, 0x52, 0xda, 0x15, 0x42, 0xb2, 0xa3, 0xaa, 0x5d, 0x45, 0x99, 0x4e, 0x93, 0x76, 0x8d,
+	0x58, 0xcb, 0x31, 0xd4, 0x5e, 0x27, 0xd7, 0x0a, 0x86, 0x4b, 0xbb, 0x4e, 0xba, 0x60, 0xc9, 0x0f,
+	0xc6, 0xdc, 0xa9, 0x41, 0x36, 0xa0, 0x2d, 0x05, 0x32, 0xbe, 0x49, 0x36, 0x73, 0x63, 0x9f, 0xdd,
+	0x24, 0xb6, 0x3e, 0xbc, 0xd9, 0x80, 0x12, 0x95, 0x55, 0x6c, 0x0b, 0x63, 0xe6, 0x6e, 0x3f, 0xbb,
+	0x45, 0xb6, 0x8b, 0x26, 0x18, 0xbb, 0x8d, 0xe6, 0xb9, 0x5b, 0xcc, 0xee, 0xe0, 0x16, 0x15, 0x9e,
+	0xb0, 0xbb, 0xbb, 0x2c, 0xb9, 0xb4, 0x45, 0x00, 0x6e, 0x87, 0xbb, 0x7f, 0x1c, 0x60, 0x62, 0xf6,
+	0x1a, 0x06, 0x50, 0xc4, 0xb2, 0x50, 0xb6, 0xa1, 0x98, 0xbf, 0xe2, 0xb5, 0x1c, 0x2e, 0xc6, 0x63,
+	0xbb, 0xa2, 0x88, 0xd3, 0xf0, 0x76, 0xf5, 0xd1, 0xe0, 0xfb, 0x7f, 0xee, 0x18, 0xdf, 0xbd, 0xdb,
+	0x31, 0xbe, 0x7f, 0xb7, 0x63, 0xfc, 0xe3, 0xdd, 0xce, 0xda, 0x1f, 0xfe, 0xb5, 0x63, 0xfc, 0x46,
+	0xfd, 0x17, 0xe1, 0xdc, 0x8b, 0x23, 0xff, 0x32, 0xe4, 0x4d, 0x57, 0xb2, 0x08, 0xd8, 0xde, 0xec,
+	0xec, 0x64, 0x6f, 0x76, 0xbc, 0x87, 0xd5, 0x3b, 0xae, 0xf3, 0xff, 0x0e, 0xee, 0xfe, 0x77, 0x00,
+	0xeb, 0x6a, 0xb9, 0xfb, 0x8f, 0x18, 0x00, 0x00,
 }
 
 func (m *LockOptions) Marshal() (dAtA []byte, err error) {
@@ -4554,6 +4570,24 @@ func (m *Result) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.SkippedRows) > 0 {
+		dAtA38 := make([]byte, len(m.SkippedRows)*10)
+		var j37 int
+		for _, num := range m.SkippedRows {
+			for num >= 1<<7 {
+				dAtA38[j37] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j37++
+			}
+			dAtA38[j37] = uint8(num)
+			j37++
+		}
+		i -= j37
+		copy(dAtA[i:], dAtA38[:j37])
+		i = encodeVarintLock(dAtA, i, uint64(j37))
+		i--
+		dAtA[i] = 0x5a
+	}
 	if m.NewLockAdd {
 		i--
 		if m.NewLockAdd {
@@ -5464,6 +5498,13 @@ func (m *Result) ProtoSize() (n int) {
 	if m.NewLockAdd {
 		n += 2
 	}
+	if len(m.SkippedRows) > 0 {
+		l = 0
+		for _, e := range m.SkippedRows {
+			l += sovLock(uint64(e))
+		}
+		n += 1 + sovLock(uint64(l)) + l
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -10353,6 +10394,82 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.NewLockAdd = bool(v != 0)
+		case 11:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLock
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SkippedRows = append(m.SkippedRows, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLock
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthLock
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthLock
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SkippedRows) == 0 {
+					m.SkippedRows = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLock
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SkippedRows = append(m.SkippedRows, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkippedRows", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLock(dAtA[iNdEx:])
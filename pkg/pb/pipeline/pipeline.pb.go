@@ -1269,18 +1269,19 @@ func (m *Deletion) GetPrimaryKeyIdx() int32 {
 }
 
 type PreInsert struct {
-	SchemaName           string         `protobuf:"bytes,1,opt,name=schema_name,json=schemaName,proto3" json:"schema_name,omitempty"`
-	TableDef             *plan.TableDef `protobuf:"bytes,2,opt,name=table_def,json=tableDef,proto3" json:"table_def,omitempty"`
-	Idx                  []int32        `protobuf:"varint,3,rep,packed,name=idx,proto3" json:"idx,omitempty"`
-	Attrs                []string       `protobuf:"bytes,4,rep,name=attrs,proto3" json:"attrs,omitempty"`
-	HasAutoCol           bool           `protobuf:"varint,5,opt,name=has_auto_col,json=hasAutoCol,proto3" json:"has_auto_col,omitempty"`
-	IsUpdate             bool           `protobuf:"varint,6,opt,name=is_update,json=isUpdate,proto3" json:"is_update,omitempty"`
-	EstimatedRowCount    int64          `protobuf:"varint,7,opt,name=estimated_row_count,json=estimatedRowCount,proto3" json:"estimated_row_count,omitempty"`
-	CompPkeyExpr         *plan.Expr     `protobuf:"bytes,8,opt,name=comp_pkey_expr,json=compPkeyExpr,proto3" json:"comp_pkey_expr,omitempty"`
-	ClusterByExpr        *plan.Expr     `protobuf:"bytes,9,opt,name=cluster_by_expr,json=clusterByExpr,proto3" json:"cluster_by_expr,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	SchemaName           string           `protobuf:"bytes,1,opt,name=schema_name,json=schemaName,proto3" json:"schema_name,omitempty"`
+	TableDef             *plan.TableDef   `protobuf:"bytes,2,opt,name=table_def,json=tableDef,proto3" json:"table_def,omitempty"`
+	Idx                  []int32          `protobuf:"varint,3,rep,packed,name=idx,proto3" json:"idx,omitempty"`
+	Attrs                []string         `protobuf:"bytes,4,rep,name=attrs,proto3" json:"attrs,omitempty"`
+	HasAutoCol           bool             `protobuf:"varint,5,opt,name=has_auto_col,json=hasAutoCol,proto3" json:"has_auto_col,omitempty"`
+	IsUpdate             bool             `protobuf:"varint,6,opt,name=is_update,json=isUpdate,proto3" json:"is_update,omitempty"`
+	EstimatedRowCount    int64            `protobuf:"varint,7,opt,name=estimated_row_count,json=estimatedRowCount,proto3" json:"estimated_row_count,omitempty"`
+	CompPkeyExpr         *plan.Expr       `protobuf:"bytes,8,opt,name=comp_pkey_expr,json=compPkeyExpr,proto3" json:"comp_pkey_expr,omitempty"`
+	ClusterByExpr        *plan.Expr       `protobuf:"bytes,9,opt,name=cluster_by_expr,json=clusterByExpr,proto3" json:"cluster_by_expr,omitempty"`
+	Checks               []*plan.CheckDef `protobuf:"bytes,10,rep,name=checks,proto3" json:"checks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *PreInsert) Reset()         { *m = PreInsert{} }
@@ -1379,18 +1380,26 @@ func (m *PreInsert) GetClusterByExpr() *plan.Expr {
 	return nil
 }
 
+func (m *PreInsert) GetChecks() []*plan.CheckDef {
+	if m != nil {
+		return m.Checks
+	}
+	return nil
+}
+
 type LockTarget struct {
-	TableId              uint64        `protobuf:"varint,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
-	PrimaryColIdxInBat   int32         `protobuf:"varint,2,opt,name=primary_col_idx_in_bat,json=primaryColIdxInBat,proto3" json:"primary_col_idx_in_bat,omitempty"`
-	PrimaryColTyp        plan.Type     `protobuf:"bytes,3,opt,name=primary_col_typ,json=primaryColTyp,proto3" json:"primary_col_typ"`
-	RefreshTsIdxInBat    int32         `protobuf:"varint,4,opt,name=refresh_ts_idx_in_bat,json=refreshTsIdxInBat,proto3" json:"refresh_ts_idx_in_bat,omitempty"`
-	FilterColIdxInBat    int32         `protobuf:"varint,5,opt,name=filter_col_idx_in_bat,json=filterColIdxInBat,proto3" json:"filter_col_idx_in_bat,omitempty"`
-	LockTable            bool          `protobuf:"varint,6,opt,name=lock_table,json=lockTable,proto3" json:"lock_table,omitempty"`
-	ChangeDef            bool          `protobuf:"varint,7,opt,name=ChangeDef,proto3" json:"ChangeDef,omitempty"`
-	Mode                 lock.LockMode `protobuf:"varint,8,opt,name=Mode,proto3,enum=lock.LockMode" json:"Mode,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	TableId              uint64          `protobuf:"varint,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	PrimaryColIdxInBat   int32           `protobuf:"varint,2,opt,name=primary_col_idx_in_bat,json=primaryColIdxInBat,proto3" json:"primary_col_idx_in_bat,omitempty"`
+	PrimaryColTyp        plan.Type       `protobuf:"bytes,3,opt,name=primary_col_typ,json=primaryColTyp,proto3" json:"primary_col_typ"`
+	RefreshTsIdxInBat    int32           `protobuf:"varint,4,opt,name=refresh_ts_idx_in_bat,json=refreshTsIdxInBat,proto3" json:"refresh_ts_idx_in_bat,omitempty"`
+	FilterColIdxInBat    int32           `protobuf:"varint,5,opt,name=filter_col_idx_in_bat,json=filterColIdxInBat,proto3" json:"filter_col_idx_in_bat,omitempty"`
+	LockTable            bool            `protobuf:"varint,6,opt,name=lock_table,json=lockTable,proto3" json:"lock_table,omitempty"`
+	ChangeDef            bool            `protobuf:"varint,7,opt,name=ChangeDef,proto3" json:"ChangeDef,omitempty"`
+	Mode                 lock.LockMode   `protobuf:"varint,8,opt,name=Mode,proto3,enum=lock.LockMode" json:"Mode,omitempty"`
+	WaitPolicy           lock.WaitPolicy `protobuf:"varint,9,opt,name=WaitPolicy,proto3,enum=lock.WaitPolicy" json:"WaitPolicy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *LockTarget) Reset()         { *m = LockTarget{} }
@@ -1482,6 +1491,13 @@ func (m *LockTarget) GetMode() lock.LockMode {
 	return lock.LockMode_Exclusive
 }
 
+func (m *LockTarget) GetWaitPolicy() lock.WaitPolicy {
+	if m != nil {
+		return m.WaitPolicy
+	}
+	return lock.WaitPolicy_Wait
+}
+
 type LockOp struct {
 	Targets              []*LockTarget `protobuf:"bytes,1,rep,name=targets,proto3" json:"targets,omitempty"`
 	Block                bool          `protobuf:"varint,2,opt,name=block,proto3" json:"block,omitempty"`
@@ -5372,336 +5388,336 @@ func init() {
 func init() { proto.RegisterFile("pipeline.proto", fileDescriptor_7ac67a7adf3df9c7) }
 
 var fileDescriptor_7ac67a7adf3df9c7 = []byte{
-	// 5261 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3b, 0x4d, 0x8f, 0x1c, 0xc7,
-	0x75, 0x9c, 0xef, 0xee, 0x37, 0x1f, 0x3b, 0x5b, 0x14, 0xc9, 0x11, 0x29, 0x91, 0xab, 0xb6, 0x48,
-	0xad, 0x29, 0x69, 0x29, 0xad, 0xcc, 0xc4, 0x88, 0x63, 0xcb, 0xcb, 0x5d, 0xd2, 0x1e, 0x8b, 0xbb,
-	0xdc, 0xd4, 0x2e, 0x23, 0xc4, 0x08, 0xd2, 0xe8, 0xed, 0xae, 0x99, 0x6d, 0x6f, 0x4f, 0x57, 0xb3,
-	0xbb, 0x87, 0xdc, 0xe5, 0x29, 0x40, 0x7e, 0x42, 0xfe, 0x40, 0xe0, 0x4b, 0x10, 0x04, 0xf9, 0x40,
-	0x72, 0x0c, 0x72, 0x77, 0x6e, 0x3e, 0xe5, 0x90, 0x43, 0x10, 0xd8, 0x97, 0x20, 0x1f, 0xb7, 0x24,
-	0xc8, 0x25, 0x48, 0xf0, 0x5e, 0x55, 0x7f, 0xcc, 0x07, 0x97, 0xa2, 0x2c, 0x05, 0x36, 0xa0, 0x5b,
-	0xd5, 0x7b, 0xaf, 0xaa, 0xbb, 0xde, 0x57, 0xbd, 0x57, 0xf5, 0x0a, 0x7a, 0x91, 0x1f, 0x89, 0xc0,
-	0x0f, 0xc5, 0x46, 0x14, 0xcb, 0x54, 0x32, 0x23, 0xeb, 0x5f, 0x7d, 0x7f, 0xec, 0xa7, 0xc7, 0xd3,
-	0xa3, 0x0d, 0x57, 0x4e, 0xee, 0x8c, 0xe5, 0x58, 0xde, 0x21, 0x82, 0xa3, 0xe9, 0x88, 0x7a, 0xd4,
-	0xa1, 0x96, 0x1a, 0x78, 0x15, 0xa2, 0xc0, 0x09, 0x75, 0x7b, 0x25, 0xf5, 0x27, 0x22, 0x49, 0x9d,
-	0x49, 0x94, 0x21, 0x03, 0xe9, 0x9e, 0xe8, 0xb6, 0x99, 0x9e, 0x6a, 0x3a, 0xeb, 0x7f, 0x2b, 0xd0,
-	0xda, 0x15, 0x49, 0xe2, 0x8c, 0x05, 0xb3, 0xa0, 0x96, 0xf8, 0xde, 0xa0, 0xb2, 0x56, 0x59, 0xef,
-	0x6d, 0xf6, 0x37, 0xf2, 0xdf, 0x3a, 0x48, 0x9d, 0x74, 0x9a, 0x70, 0x44, 0x22, 0x8d, 0x3b, 0xf1,
-	0x06, 0xd5, 0x79, 0x9a, 0x5d, 0x91, 0x1e, 0x4b, 0x8f, 0x23, 0x92, 0xf5, 0xa1, 0x26, 0xe2, 0x78,
-	0x50, 0x5b, 0xab, 0xac, 0x77, 0x38, 0x36, 0x19, 0x83, 0xba, 0xe7, 0xa4, 0xce, 0xa0, 0x4e, 0x20,
-	0x6a, 0xb3, 0xb7, 0xa1, 0x17, 0xc5, 0xd2, 0xb5, 0xfd, 0x70, 0x24, 0x6d, 0xc2, 0x36, 0x08, 0xdb,
-	0x41, 0xe8, 0x30, 0x1c, 0xc9, 0x1d, 0xa4, 0x1a, 0x40, 0xcb, 0x09, 0x9d, 0xe0, 0x2c, 0x11, 0x83,
-	0x26, 0xa1, 0xb3, 0x2e, 0xeb, 0x41, 0xd5, 0xf7, 0x06, 0xad, 0xb5, 0xca, 0x7a, 0x9d, 0x57, 0x7d,
-	0x0f, 0xbf, 0x31, 0x9d, 0xfa, 0xde, 0xc0, 0x50, 0xdf, 0xc0, 0x36, 0xb3, 0xa0, 0x13, 0x0a, 0xe1,
-	0xed, 0xc9, 0x94, 0x8b, 0x28, 0x38, 0x1b, 0x98, 0x6b, 0x95, 0x75, 0x83, 0xcf, 0xc0, 0xac, 0xc7,
-	0x60, 0x6e, 0xcb, 0x30, 0x14, 0x6e, 0x2a, 0x63, 0x76, 0x03, 0xda, 0xd9, 0x92, 0x6c, 0xcd, 0x8a,
-	0x06, 0x87, 0x0c, 0x34, 0xf4, 0xd8, 0x3b, 0xb0, 0xe2, 0x66, 0xd4, 0xb6, 0x1f, 0x7a, 0xe2, 0x94,
-	0x78, 0xd1, 0xe0, 0xbd, 0x1c, 0x3c, 0x44, 0xa8, 0xf5, 0x6f, 0x55, 0x68, 0x1d, 0x1c, 0x4f, 0x47,
-	0xa3, 0x40, 0xb0, 0xb7, 0xa1, 0xab, 0x9b, 0xdb, 0x32, 0x18, 0x7a, 0xa7, 0x7a, 0xde, 0x59, 0x20,
-	0x5b, 0x83, 0xb6, 0x06, 0x1c, 0x9e, 0x45, 0x42, 0x4f, 0x5b, 0x06, 0xcd, 0xce, 0xb3, 0xeb, 0x87,
-	0xc4, 0xe2, 0x1a, 0x9f, 0x05, 0xce, 0x51, 0x39, 0xa7, 0xc4, 0xf5, 0x59, 0x2a, 0x87, 0xbe, 0xb6,
-	0x15, 0xf8, 0x4f, 0x05, 0x17, 0xe3, 0xed, 0x30, 0x25, 0xde, 0x37, 0x78, 0x19, 0xc4, 0x36, 0xe1,
-	0x52, 0xa2, 0x86, 0xd8, 0xb1, 0x13, 0x8e, 0x45, 0x62, 0x4f, 0xfd, 0x30, 0xfd, 0xb5, 0x6f, 0x0c,
-	0x9a, 0x6b, 0xb5, 0xf5, 0x3a, 0xbf, 0xa8, 0x91, 0x9c, 0x70, 0x8f, 0x09, 0xc5, 0x3e, 0x80, 0xd7,
-	0xe6, 0xc6, 0xa8, 0x21, 0xad, 0xb5, 0xda, 0x7a, 0x8d, 0xb3, 0x99, 0x21, 0x43, 0x1a, 0x71, 0x1f,
-	0x56, 0xe3, 0x69, 0x88, 0xda, 0xfa, 0xc0, 0x0f, 0x52, 0x11, 0x1f, 0x44, 0xc2, 0x25, 0x19, 0xb6,
-	0x37, 0xaf, 0x6c, 0x90, 0x42, 0xf3, 0x79, 0x34, 0x5f, 0x1c, 0x61, 0xfd, 0x77, 0x15, 0x8c, 0x1d,
-	0x3f, 0x89, 0x9c, 0xd4, 0x3d, 0x66, 0x57, 0xa0, 0x35, 0x9a, 0x86, 0x6e, 0x21, 0xc1, 0x26, 0x76,
-	0x87, 0x1e, 0xfb, 0x4d, 0x58, 0x09, 0xa4, 0xeb, 0x04, 0x76, 0x2e, 0xac, 0x41, 0x75, 0xad, 0xb6,
-	0xde, 0xde, 0xbc, 0x58, 0x68, 0x72, 0xae, 0x0c, 0xbc, 0x47, 0xb4, 0x85, 0x72, 0x7c, 0x1b, 0xfa,
-	0xb1, 0x98, 0xc8, 0x54, 0x94, 0x86, 0xd7, 0x68, 0x38, 0x2b, 0x86, 0x7f, 0x1a, 0x3b, 0xd1, 0x9e,
-	0xf4, 0x04, 0x5f, 0x51, 0xb4, 0xc5, 0xf0, 0x0f, 0x4b, 0xfc, 0x14, 0x63, 0xdb, 0xf7, 0x4e, 0x6d,
-	0xfa, 0xc0, 0xa0, 0xbe, 0x56, 0x5b, 0x6f, 0x14, 0xcc, 0x11, 0xe3, 0xa1, 0x77, 0xfa, 0x10, 0x31,
-	0xec, 0x23, 0xb8, 0x3c, 0x3f, 0x44, 0xcd, 0x3a, 0x68, 0xd0, 0x98, 0x8b, 0x33, 0x63, 0x38, 0xa1,
-	0xd8, 0x5b, 0xd0, 0xc9, 0x06, 0xa5, 0xa8, 0x48, 0x4d, 0x25, 0xda, 0xa4, 0xa4, 0x48, 0x57, 0xa0,
-	0xe5, 0x27, 0x76, 0xe2, 0x87, 0x27, 0x64, 0x40, 0x06, 0x6f, 0xfa, 0xc9, 0x81, 0x1f, 0x9e, 0xb0,
-	0xd7, 0xc1, 0x88, 0x85, 0xab, 0x30, 0x06, 0x61, 0x5a, 0xb1, 0x70, 0x09, 0x75, 0x05, 0xb0, 0x69,
-	0xbb, 0xa9, 0xd0, 0x66, 0xd4, 0x8c, 0x85, 0xbb, 0x9d, 0x0a, 0x2b, 0x81, 0xc6, 0xae, 0x88, 0xc7,
-	0x82, 0x5d, 0x05, 0x03, 0x07, 0x1e, 0xb8, 0x4e, 0x48, 0x7c, 0x37, 0x78, 0xde, 0x47, 0x3b, 0x8e,
-	0x9c, 0x38, 0xf5, 0x9d, 0x80, 0x14, 0xdb, 0xe0, 0x59, 0x97, 0x5d, 0x03, 0x33, 0x49, 0x9d, 0x38,
-	0xc5, 0xd5, 0x91, 0x42, 0x37, 0xb8, 0x41, 0x00, 0xb4, 0x89, 0x2b, 0xd0, 0x12, 0xa1, 0x47, 0xa8,
-	0xba, 0x92, 0xa4, 0x08, 0xbd, 0xa1, 0x77, 0x6a, 0xfd, 0x75, 0x05, 0xba, 0xbb, 0xd3, 0x20, 0xf5,
-	0xb7, 0xe2, 0xf1, 0x54, 0x4c, 0xc2, 0x14, 0xed, 0x7f, 0xc7, 0x4f, 0x52, 0xfd, 0x65, 0x6a, 0xb3,
-	0x75, 0x30, 0xbf, 0x17, 0xcb, 0x69, 0x74, 0xff, 0x34, 0xca, 0x24, 0x0d, 0x4a, 0xa9, 0x10, 0xc2,
-	0x0b, 0x24, 0x7b, 0x0f, 0xda, 0x8f, 0x62, 0x4f, 0xc4, 0xf7, 0xce, 0x88, 0xb6, 0xb6, 0x40, 0x5b,
-	0x46, 0xb3, 0x37, 0xc0, 0x3c, 0x10, 0x91, 0x13, 0x3b, 0xa8, 0x02, 0xf8, 0x63, 0x26, 0x2f, 0x00,
-	0xb8, 0x56, 0x22, 0x1e, 0x7a, 0xda, 0xac, 0xb2, 0xae, 0x35, 0x06, 0x73, 0x6b, 0x3c, 0x8e, 0xc5,
-	0xd8, 0x49, 0xc9, 0x81, 0xc9, 0x88, 0x7e, 0xb7, 0xc6, 0xab, 0x32, 0x22, 0x27, 0x89, 0x0b, 0x50,
-	0xfc, 0xa1, 0x36, 0xbb, 0x0e, 0x75, 0xb1, 0xfc, 0x7f, 0x08, 0xce, 0x2e, 0x43, 0xd3, 0x95, 0xe1,
-	0xc8, 0x1f, 0x6b, 0xd7, 0xaa, 0x7b, 0xd6, 0x3f, 0x57, 0xa1, 0x41, 0x8b, 0x43, 0xf6, 0xa2, 0xbb,
-	0xb3, 0xc5, 0x53, 0x27, 0xc8, 0xa4, 0x82, 0x80, 0xfb, 0x4f, 0x9d, 0x80, 0xad, 0x41, 0x03, 0xa7,
-	0x49, 0x96, 0xf0, 0x46, 0x21, 0xd8, 0x2d, 0x68, 0xa0, 0x12, 0x25, 0xb3, 0x7f, 0x80, 0x4a, 0x74,
-	0xaf, 0xfe, 0x93, 0x7f, 0xbc, 0x71, 0x81, 0x2b, 0x34, 0x7b, 0x07, 0xea, 0xce, 0x78, 0x9c, 0x90,
-	0x2e, 0xcf, 0x98, 0x53, 0xbe, 0x5e, 0x4e, 0x04, 0xec, 0x2e, 0x98, 0x4a, 0x6e, 0x48, 0xdd, 0x20,
-	0xea, 0x2b, 0xa5, 0x6d, 0xa4, 0x2c, 0x52, 0x5e, 0x50, 0x22, 0xc7, 0xfd, 0x44, 0x7b, 0x30, 0xd2,
-	0x68, 0x83, 0x17, 0x00, 0xf4, 0xf3, 0x51, 0x2c, 0xb6, 0x82, 0x40, 0xba, 0x07, 0xfe, 0x73, 0xa1,
-	0x77, 0x85, 0x19, 0x18, 0xbb, 0x05, 0xbd, 0x7d, 0xa5, 0x72, 0x5c, 0x24, 0xd3, 0x20, 0x4d, 0xf4,
-	0x4e, 0x31, 0x07, 0x65, 0x1b, 0xc0, 0x66, 0x20, 0x87, 0xb4, 0x7c, 0x73, 0xad, 0xb6, 0xde, 0xe5,
-	0x4b, 0x30, 0xd6, 0x7f, 0x54, 0xa1, 0x39, 0x0c, 0x13, 0x11, 0xa7, 0x68, 0x00, 0xce, 0x68, 0x24,
-	0xdc, 0x54, 0x28, 0xc7, 0x53, 0xe7, 0x79, 0x1f, 0x17, 0x70, 0x28, 0x3f, 0x8d, 0xfd, 0x54, 0x1c,
-	0x7c, 0xa4, 0x45, 0x5c, 0x00, 0xd8, 0x6d, 0x58, 0x75, 0x3c, 0xcf, 0xce, 0xa8, 0xed, 0x58, 0x3e,
-	0x4b, 0xc8, 0x18, 0x0c, 0xbe, 0xe2, 0x78, 0xde, 0x96, 0x86, 0x73, 0xf9, 0x2c, 0x61, 0x6f, 0x41,
-	0x2d, 0x16, 0x23, 0x12, 0x78, 0x7b, 0x73, 0x45, 0x09, 0xe4, 0xd1, 0xd1, 0x8f, 0x84, 0x9b, 0x72,
-	0x31, 0xe2, 0x88, 0x63, 0xaf, 0x41, 0xc3, 0x49, 0xd3, 0x58, 0x31, 0xd8, 0xe4, 0xaa, 0xc3, 0x36,
-	0xe0, 0x22, 0x19, 0x5d, 0xea, 0xcb, 0xd0, 0x4e, 0x9d, 0xa3, 0x00, 0xf7, 0xb8, 0x44, 0xbb, 0xf3,
-	0xd5, 0x1c, 0x75, 0x88, 0x98, 0xa1, 0x97, 0xe0, 0x06, 0x30, 0x4f, 0x1f, 0x3a, 0x13, 0x91, 0x90,
-	0x37, 0x37, 0xf9, 0xc5, 0xd9, 0x11, 0x7b, 0x88, 0x62, 0x5f, 0x83, 0x6e, 0x31, 0x06, 0xcd, 0xd6,
-	0x20, 0x0b, 0xe8, 0xe4, 0x40, 0xb4, 0xea, 0x4b, 0xd0, 0xf4, 0x13, 0x5b, 0x84, 0x9e, 0xf6, 0x24,
-	0x0d, 0x3f, 0xb9, 0x1f, 0x7a, 0xec, 0x5d, 0x30, 0xd5, 0x57, 0x3c, 0x31, 0x1a, 0x00, 0x2d, 0xaf,
-	0xa7, 0xf5, 0x0d, 0xc1, 0x3b, 0x62, 0xc4, 0x8d, 0x54, 0xb7, 0xac, 0x37, 0xa1, 0xb1, 0x15, 0xc7,
-	0xce, 0x19, 0xad, 0x15, 0x1b, 0x83, 0x0a, 0xb9, 0x44, 0xd5, 0xb1, 0x5c, 0xa8, 0xed, 0x3a, 0x11,
-	0xbb, 0x09, 0xd5, 0x49, 0x44, 0x98, 0xf6, 0xe6, 0xa5, 0x92, 0x9a, 0x39, 0xd1, 0xc6, 0x6e, 0x74,
-	0x3f, 0x4c, 0xe3, 0x33, 0x5e, 0x9d, 0x44, 0x57, 0xef, 0x42, 0x4b, 0x77, 0x31, 0x78, 0x39, 0x11,
-	0x67, 0x24, 0x3e, 0x93, 0x63, 0x13, 0x3f, 0xf0, 0xd4, 0x09, 0xa6, 0xd9, 0x8e, 0xac, 0x3a, 0xbf,
-	0x51, 0xfd, 0x66, 0xc5, 0xfa, 0xcf, 0x3a, 0x18, 0x3b, 0x22, 0x10, 0xb8, 0x2e, 0xd4, 0xc1, 0xb2,
-	0x98, 0xb4, 0x02, 0xcc, 0xc0, 0x90, 0x46, 0x39, 0x69, 0x1a, 0x25, 0xb4, 0x1e, 0xcc, 0xc0, 0xd0,
-	0x7b, 0x0c, 0xef, 0x4d, 0xdd, 0x13, 0x91, 0x92, 0x02, 0x74, 0x79, 0xd6, 0x45, 0xcc, 0x9e, 0xc6,
-	0xd4, 0x15, 0x46, 0x77, 0xd9, 0x1b, 0x00, 0xb1, 0x7c, 0x66, 0xfb, 0xca, 0x53, 0x2a, 0xa7, 0x63,
-	0xc4, 0xf2, 0xd9, 0x10, 0x7d, 0xe5, 0xff, 0x8b, 0xdc, 0x7f, 0x1d, 0x06, 0x25, 0xb9, 0x63, 0x04,
-	0x64, 0xfb, 0xa1, 0x7d, 0x84, 0xdb, 0xb1, 0x56, 0x81, 0x62, 0x4e, 0x0a, 0x90, 0x86, 0xe1, 0x3d,
-	0xda, 0xab, 0xb5, 0x36, 0x9b, 0xe7, 0x68, 0xf3, 0x52, 0xe3, 0x80, 0xe5, 0xc6, 0x71, 0x0f, 0xe0,
-	0x40, 0x8c, 0x27, 0x22, 0x4c, 0x77, 0x9d, 0x68, 0xd0, 0x26, 0xc1, 0x5b, 0x85, 0xe0, 0x33, 0x69,
-	0x6d, 0x14, 0x44, 0x4a, 0x0b, 0x4a, 0xa3, 0x70, 0x03, 0x75, 0x9d, 0xd0, 0x4e, 0xe3, 0x69, 0xe8,
-	0x3a, 0xa9, 0x18, 0x74, 0xe8, 0x53, 0x6d, 0xd7, 0x09, 0x0f, 0x35, 0xa8, 0xa4, 0xc1, 0xdd, 0xb2,
-	0x06, 0xdf, 0x82, 0x95, 0x28, 0xf6, 0x27, 0x4e, 0x7c, 0x66, 0x9f, 0x88, 0x33, 0x12, 0x46, 0x4f,
-	0x85, 0x7a, 0x1a, 0xfc, 0x89, 0x38, 0x1b, 0x7a, 0xa7, 0x57, 0xbf, 0x0d, 0x2b, 0x73, 0x3f, 0xf0,
-	0x4a, 0x7a, 0xf7, 0xf7, 0x55, 0x30, 0xf7, 0x63, 0xa1, 0xbd, 0xce, 0x0d, 0x68, 0x27, 0xee, 0xb1,
-	0x98, 0x38, 0x24, 0x25, 0x3d, 0x03, 0x28, 0x10, 0x0a, 0x67, 0xd6, 0xae, 0xaa, 0xe7, 0xdb, 0x15,
-	0xfe, 0x87, 0xda, 0x88, 0xd1, 0x98, 0xb0, 0x59, 0x38, 0x93, 0x7a, 0xd9, 0x99, 0xac, 0x41, 0xe7,
-	0xd8, 0x49, 0x6c, 0x67, 0x9a, 0x4a, 0xdb, 0x95, 0x01, 0x29, 0x9d, 0xc1, 0xe1, 0xd8, 0x49, 0xb6,
-	0xa6, 0xa9, 0xdc, 0x96, 0xb4, 0xb1, 0xfb, 0x89, 0x3d, 0x8d, 0x3c, 0xe4, 0xa1, 0x72, 0xd9, 0x86,
-	0x9f, 0x3c, 0xa6, 0x3e, 0xea, 0xa4, 0x48, 0x52, 0x7f, 0xe2, 0x68, 0x81, 0xda, 0xae, 0x9c, 0x86,
-	0x29, 0x39, 0xee, 0x1a, 0x5f, 0xcd, 0x51, 0x5c, 0x3e, 0xdb, 0x46, 0x04, 0xfb, 0x00, 0x7a, 0xae,
-	0x9c, 0x44, 0x76, 0x84, 0x7c, 0xa5, 0x2d, 0x51, 0xc5, 0x88, 0xe5, 0x2d, 0xab, 0x83, 0x14, 0xfb,
-	0x27, 0x42, 0xed, 0xd1, 0x9b, 0xb0, 0xe2, 0x06, 0xd3, 0x24, 0x15, 0xb1, 0x7d, 0xa4, 0x87, 0x98,
-	0x0b, 0x43, 0xba, 0x9a, 0x44, 0xed, 0xeb, 0xd6, 0x3f, 0x54, 0x01, 0x1e, 0x4a, 0xf7, 0xe4, 0xd0,
-	0x89, 0xc7, 0x22, 0xc5, 0x68, 0x28, 0x33, 0x17, 0x6d, 0xce, 0xad, 0x54, 0x19, 0x09, 0xdb, 0x84,
-	0xcb, 0x99, 0xa4, 0x5d, 0x19, 0x50, 0x64, 0xa6, 0xf4, 0x5d, 0x4b, 0x8b, 0x69, 0xac, 0x8a, 0xed,
-	0x49, 0xd9, 0xd9, 0x37, 0x0b, 0xed, 0xc0, 0x31, 0xe9, 0x59, 0x44, 0x16, 0xbe, 0x6c, 0x57, 0xed,
-	0x16, 0xc3, 0x0f, 0xcf, 0x22, 0xf6, 0x01, 0x5c, 0x8a, 0xc5, 0x28, 0x16, 0xc9, 0xb1, 0x9d, 0x26,
-	0xe5, 0x8f, 0xa9, 0xa0, 0x68, 0x55, 0x23, 0x0f, 0x93, 0xfc, 0x5b, 0x1f, 0xc0, 0xa5, 0x11, 0x45,
-	0xc7, 0xf3, 0xbf, 0xa7, 0x9c, 0xc3, 0xaa, 0x42, 0x96, 0xff, 0xee, 0x4d, 0xa0, 0x14, 0x51, 0x19,
-	0x7c, 0xb6, 0xc5, 0x06, 0xc4, 0x8c, 0xa3, 0x40, 0xe0, 0xfe, 0xb5, 0x7d, 0x8c, 0x71, 0xfb, 0x8e,
-	0x18, 0xe9, 0xa0, 0xb1, 0x00, 0x30, 0x0b, 0xea, 0xbb, 0xd2, 0x13, 0x24, 0x94, 0xde, 0x66, 0x6f,
-	0x83, 0x92, 0x4d, 0xe4, 0x24, 0x42, 0x39, 0xe1, 0xac, 0x3d, 0x68, 0x22, 0xe4, 0x51, 0xc4, 0x36,
-	0xa0, 0x95, 0x12, 0x87, 0x13, 0xed, 0x9a, 0x5f, 0x2b, 0x2c, 0xb4, 0x60, 0x3f, 0xcf, 0x88, 0x50,
-	0x03, 0x8f, 0x70, 0x46, 0xed, 0x2f, 0x55, 0xc7, 0xe2, 0xb0, 0x92, 0x1b, 0xc1, 0xe3, 0xd0, 0x7f,
-	0x32, 0x15, 0xec, 0x63, 0x58, 0x8d, 0x62, 0x61, 0xfb, 0x04, 0xb3, 0xa7, 0x27, 0xb6, 0x9b, 0xaa,
-	0x64, 0x8b, 0x3e, 0x81, 0x3c, 0x2e, 0x46, 0x9c, 0x6c, 0xa7, 0xa7, 0xbc, 0x17, 0xcd, 0xf4, 0xad,
-	0x1f, 0xc2, 0x95, 0x9c, 0xe2, 0x40, 0xb8, 0x32, 0xf4, 0x9c, 0xf8, 0x8c, 0xfc, 0xd5, 0xdc, 0xdc,
-	0xc9, 0xab, 0xcc, 0x7d, 0x40, 0x73, 0xff, 0xb8, 0x06, 0xbd, 0x47, 0xe1, 0xce, 0x34, 0x0a, 0x7c,
-	0xf4, 0x21, 0x9f, 0x28, 0x13, 0x57, 0xa6, 0x55, 0x29, 0x9b, 0xd6, 0x3a, 0xf4, 0xf5, 0x57, 0x50,
-	0x76, 0xca, 0x30, 0x74, 0x92, 0xa9, 0xe0, 0xdb, 0x32, 0x50, 0x56, 0xf1, 0x6d, 0xb8, 0x34, 0xa5,
-	0x95, 0x2b, 0xca, 0x63, 0xe1, 0x9e, 0xd8, 0x2f, 0x88, 0x17, 0x99, 0x22, 0xc4, 0xa1, 0x48, 0x46,
-	0x26, 0x72, 0x03, 0xda, 0xc5, 0xf0, 0xcc, 0xbe, 0x21, 0x27, 0xa4, 0x3f, 0x91, 0xa1, 0xed, 0x65,
-	0xbf, 0xac, 0x77, 0x17, 0xf4, 0x0c, 0x3d, 0x59, 0xac, 0x04, 0xf7, 0x98, 0xdf, 0x81, 0xd5, 0x19,
-	0x4a, 0xfa, 0x8b, 0x26, 0xfd, 0xc5, 0xfb, 0x85, 0x70, 0x67, 0x97, 0x5f, 0xee, 0xe2, 0xff, 0x28,
-	0x4f, 0xbc, 0x22, 0x67, 0xa1, 0xda, 0x8f, 0xf8, 0xe3, 0x50, 0xc6, 0x42, 0x6b, 0x9e, 0xe1, 0x27,
-	0x43, 0xea, 0x5f, 0xdd, 0x83, 0xd7, 0x96, 0xcd, 0xb2, 0xc4, 0x9d, 0xae, 0x95, 0xdd, 0xe9, 0x5c,
-	0xac, 0x5b, 0xb8, 0xd6, 0x3f, 0xae, 0x40, 0xfb, 0xc1, 0xf4, 0xf9, 0xf3, 0x33, 0x95, 0x5b, 0xb2,
-	0x0e, 0x54, 0xf6, 0x68, 0x96, 0x2a, 0xaf, 0xec, 0x61, 0xb8, 0xbd, 0x7f, 0x82, 0x3e, 0x95, 0x26,
-	0x31, 0xb9, 0xee, 0x61, 0x94, 0xbc, 0x7f, 0x72, 0x78, 0x8e, 0x3d, 0x2b, 0x34, 0x06, 0x88, 0xf7,
-	0xa6, 0x7e, 0x80, 0xbb, 0xb2, 0x36, 0xdd, 0xbc, 0x8f, 0x71, 0xe7, 0x70, 0xa4, 0xf4, 0xe5, 0x41,
-	0x2c, 0x27, 0x4a, 0xa3, 0xb5, 0x5b, 0x5d, 0x82, 0xb1, 0xfe, 0xae, 0x06, 0xf5, 0x1f, 0x48, 0x3f,
-	0x54, 0x39, 0x5b, 0x60, 0x07, 0x2a, 0xf9, 0x41, 0xe1, 0xb4, 0x62, 0x11, 0x3c, 0xc4, 0xf4, 0xe1,
-	0x75, 0x30, 0x50, 0x31, 0x02, 0x95, 0x56, 0x10, 0xca, 0x95, 0x0a, 0x55, 0x64, 0x16, 0x95, 0xa5,
-	0x99, 0x45, 0x1e, 0xf8, 0xd7, 0x5f, 0x16, 0xf8, 0x9b, 0x81, 0x18, 0xa1, 0xaa, 0x86, 0x9e, 0x8e,
-	0xe7, 0xcb, 0x93, 0x19, 0x88, 0xdc, 0x96, 0xa1, 0xc7, 0xbe, 0x0e, 0x10, 0xfb, 0xe3, 0x63, 0x4d,
-	0xd9, 0x5c, 0x4c, 0xc6, 0x08, 0x4b, 0xa4, 0x1c, 0x5e, 0xd7, 0x19, 0xbe, 0xad, 0x9d, 0xd8, 0x11,
-	0x72, 0x49, 0xad, 0xa3, 0x95, 0xe5, 0x0c, 0xcb, 0xcf, 0x06, 0x2e, 0xcf, 0x9c, 0x0d, 0x10, 0x77,
-	0x69, 0xbd, 0x6f, 0x00, 0xee, 0x4d, 0xc7, 0xb6, 0x0c, 0xed, 0x28, 0xcb, 0x6d, 0x0d, 0x84, 0x3c,
-	0x0a, 0xf7, 0x4f, 0xd0, 0xf9, 0x61, 0x42, 0xac, 0xf3, 0x0b, 0x73, 0x3e, 0xbf, 0x58, 0x83, 0xce,
-	0x8f, 0xa4, 0x1f, 0xda, 0x13, 0x27, 0xb2, 0x53, 0x67, 0x4c, 0xc1, 0x47, 0x83, 0x03, 0xc2, 0x76,
-	0x9d, 0xe8, 0xd0, 0x19, 0xd3, 0x26, 0xac, 0x93, 0x6e, 0x34, 0x92, 0xb6, 0x22, 0xd0, 0x20, 0x14,
-	0xef, 0x35, 0x30, 0x69, 0x0a, 0x4a, 0xc9, 0x3b, 0x4a, 0xf6, 0x08, 0x40, 0x8e, 0x5a, 0xff, 0x52,
-	0x05, 0x63, 0x2b, 0x4c, 0x7d, 0x92, 0xe7, 0x65, 0x68, 0xc6, 0x94, 0x5f, 0x68, 0x69, 0xea, 0x5e,
-	0x2e, 0xb1, 0xea, 0x0b, 0x24, 0x36, 0x23, 0x89, 0xda, 0x67, 0x96, 0x44, 0xfd, 0x3c, 0x49, 0xcc,
-	0x72, 0xad, 0x71, 0x2e, 0xd7, 0x16, 0xb2, 0xb2, 0x2f, 0x43, 0x8c, 0xf3, 0x92, 0x30, 0x5e, 0x26,
-	0x09, 0x73, 0x5e, 0x12, 0xd6, 0x5f, 0xd6, 0xc0, 0x78, 0x28, 0x46, 0xe9, 0x57, 0xc6, 0xf3, 0xab,
-	0x62, 0x3c, 0xd6, 0xbf, 0xd7, 0xc0, 0xe4, 0xb8, 0xc2, 0x2f, 0x51, 0x66, 0x77, 0x00, 0x48, 0x16,
-	0xe7, 0x0b, 0x8e, 0xe4, 0x45, 0x89, 0x3f, 0xfb, 0x10, 0xda, 0x4a, 0x26, 0x6a, 0x44, 0xe3, 0x05,
-	0x23, 0x94, 0xe0, 0x0e, 0x17, 0xe5, 0xdd, 0xfc, 0xcc, 0xf2, 0x6e, 0x7d, 0x6e, 0x79, 0x1b, 0x5f,
-	0x84, 0xbc, 0xcd, 0x73, 0xe5, 0x0d, 0x2f, 0x93, 0x77, 0xfb, 0x65, 0xf2, 0xee, 0x2c, 0xc8, 0xfb,
-	0xc7, 0x35, 0xe8, 0x92, 0xbc, 0x0f, 0xc4, 0xe4, 0x17, 0x73, 0x8a, 0x73, 0x42, 0xaa, 0xbd, 0xaa,
-	0x90, 0xea, 0x9f, 0x59, 0x48, 0x8d, 0xcf, 0x2d, 0xa4, 0xe6, 0x17, 0x21, 0xa4, 0xd6, 0xb9, 0x42,
-	0x32, 0x5e, 0x26, 0x24, 0xf3, 0xd5, 0x8d, 0x32, 0x17, 0xd2, 0x2f, 0xbc, 0x73, 0x7d, 0x25, 0xa4,
-	0x2f, 0x48, 0x48, 0xb0, 0x20, 0x24, 0x8c, 0x2c, 0x7e, 0x61, 0x23, 0xfa, 0x32, 0x22, 0x8b, 0x73,
-	0x99, 0xdd, 0xf8, 0x22, 0x98, 0xdd, 0x3c, 0x97, 0xd9, 0xad, 0x97, 0x31, 0xfb, 0x73, 0x44, 0x16,
-	0x7f, 0x55, 0x03, 0x38, 0xf0, 0xc3, 0x71, 0x20, 0xbe, 0x8a, 0x2d, 0x7e, 0x65, 0x62, 0x8b, 0xbf,
-	0xa9, 0x82, 0xb1, 0xeb, 0xc4, 0x27, 0xbf, 0x74, 0x16, 0xf2, 0x35, 0x68, 0xc9, 0xb0, 0x6c, 0x0f,
-	0x65, 0xba, 0xa6, 0x0c, 0x7f, 0x29, 0x54, 0xfe, 0xf7, 0x2b, 0xd0, 0xda, 0x8f, 0xa5, 0x37, 0x75,
-	0xd3, 0xcf, 0xa9, 0xef, 0xb3, 0xff, 0x58, 0x7b, 0xd9, 0x3f, 0xd6, 0xe7, 0xff, 0xd1, 0xfa, 0x83,
-	0x0a, 0x98, 0xfa, 0x17, 0x1e, 0x6e, 0x7e, 0x49, 0x46, 0xf7, 0xf2, 0xbf, 0x78, 0x06, 0x26, 0x9d,
-	0x13, 0x9d, 0xab, 0x46, 0xe7, 0xda, 0x4f, 0xf5, 0x73, 0xd9, 0x8f, 0xf5, 0x87, 0x15, 0xe8, 0xd2,
-	0x11, 0xdd, 0x83, 0x69, 0xe8, 0xd2, 0x4d, 0xc4, 0xf2, 0x53, 0xa5, 0x35, 0xa8, 0xc7, 0x22, 0xcd,
-	0xae, 0xfa, 0x3a, 0xea, 0x33, 0xdb, 0x32, 0xd8, 0x11, 0x23, 0x4e, 0x18, 0x64, 0x82, 0x13, 0x8f,
-	0x93, 0x65, 0x97, 0x8d, 0x08, 0xc7, 0x55, 0x45, 0x4e, 0xec, 0x4c, 0x92, 0xec, 0xb2, 0x51, 0xf5,
-	0x18, 0x83, 0x3a, 0x9d, 0x3c, 0x37, 0xe8, 0x4c, 0x84, 0xda, 0xd6, 0x16, 0x5c, 0xba, 0x7f, 0x9a,
-	0x8a, 0x38, 0x74, 0x82, 0x3d, 0x67, 0x22, 0x36, 0xb7, 0x65, 0xa0, 0x8e, 0xd1, 0x32, 0xe2, 0x4a,
-	0x41, 0x8c, 0x3f, 0x5c, 0x2e, 0xa5, 0x50, 0x1d, 0xeb, 0x26, 0xb4, 0x47, 0x7e, 0x20, 0x6c, 0x39,
-	0x1a, 0x25, 0x22, 0xc5, 0xaf, 0xab, 0x16, 0x2d, 0xab, 0xc6, 0x75, 0xcf, 0xfa, 0xdb, 0x3a, 0x74,
-	0xb2, 0x4f, 0xd1, 0x55, 0xf3, 0xf2, 0xe5, 0x5f, 0x03, 0x93, 0x66, 0x4b, 0xfc, 0xe7, 0x82, 0x78,
-	0x50, 0xe3, 0x06, 0x02, 0xe8, 0x6e, 0x70, 0x0b, 0x56, 0x4b, 0x9f, 0xb2, 0x53, 0x99, 0x3a, 0x81,
-	0x66, 0x43, 0xe9, 0xd6, 0xa8, 0x44, 0xc2, 0x57, 0xb0, 0xf3, 0x88, 0xda, 0x87, 0x48, 0x8d, 0xec,
-	0xcd, 0x0f, 0xd1, 0x16, 0xd8, 0x8b, 0x18, 0xf6, 0x3d, 0x58, 0xc1, 0xd5, 0x6e, 0xaa, 0x13, 0x59,
-	0x5a, 0xaf, 0xb2, 0xeb, 0x1b, 0xc5, 0x27, 0x96, 0xf2, 0x8c, 0x77, 0xc3, 0x19, 0x16, 0xbe, 0x09,
-	0xe0, 0xc6, 0xc2, 0x49, 0x85, 0x9d, 0x3c, 0x09, 0xc8, 0xe6, 0x4d, 0x6e, 0x2a, 0xc8, 0xc1, 0x93,
-	0x20, 0x5f, 0x69, 0xee, 0x94, 0x4d, 0xb5, 0x52, 0x52, 0xf4, 0xf7, 0xa1, 0x2d, 0x63, 0x7f, 0xec,
-	0x87, 0xea, 0xc8, 0xcf, 0x58, 0xf2, 0xb7, 0xa0, 0x08, 0xe8, 0x00, 0xd0, 0x82, 0xa6, 0x52, 0xd4,
-	0x25, 0x67, 0xe7, 0x1a, 0xc3, 0x38, 0xf4, 0x0e, 0x8f, 0xb6, 0x65, 0x70, 0x48, 0x15, 0x3b, 0xdb,
-	0x32, 0x18, 0x00, 0xcd, 0x7a, 0x7b, 0x71, 0x59, 0x28, 0x9f, 0x8d, 0x59, 0x62, 0x75, 0xe8, 0x37,
-	0x37, 0x03, 0xbb, 0x05, 0x2b, 0x49, 0x1a, 0xfb, 0x6e, 0x8a, 0x4b, 0xb4, 0x27, 0xd2, 0x13, 0xe4,
-	0xb9, 0x0d, 0xde, 0x55, 0xe0, 0x83, 0x27, 0xc1, 0xae, 0xf4, 0xc4, 0xd5, 0x2d, 0xb8, 0xb8, 0x64,
-	0xba, 0x57, 0xba, 0x4c, 0x71, 0x01, 0x0e, 0xd2, 0x58, 0x38, 0x13, 0x52, 0x9e, 0x77, 0xa0, 0x95,
-	0x1e, 0x05, 0x74, 0x53, 0x52, 0x59, 0x7a, 0x53, 0xd2, 0x4c, 0x8f, 0x90, 0x4b, 0x25, 0x75, 0xac,
-	0xd2, 0x9d, 0x85, 0xee, 0xe1, 0x87, 0x02, 0x7f, 0xe2, 0xa7, 0xba, 0x36, 0x47, 0x75, 0xac, 0x8f,
-	0xc0, 0xa4, 0x19, 0xe8, 0x1b, 0xf9, 0x0e, 0x5e, 0x39, 0x77, 0x07, 0xb7, 0xde, 0x03, 0xf3, 0xb7,
-	0xf1, 0x37, 0x69, 0xd0, 0x0d, 0x68, 0xd3, 0x6d, 0x9a, 0xad, 0x4e, 0xc2, 0xf5, 0x2d, 0x0f, 0x81,
-	0xee, 0xd1, 0x71, 0x38, 0x80, 0xf1, 0x38, 0xf4, 0x65, 0xb8, 0x15, 0x04, 0xd6, 0x4f, 0xab, 0x60,
-	0x7e, 0xdf, 0x49, 0x8e, 0xc9, 0x4b, 0xb0, 0x35, 0x68, 0xef, 0x09, 0xe1, 0x21, 0x60, 0xd7, 0x89,
-	0x74, 0x11, 0x40, 0x19, 0xc4, 0xae, 0x82, 0xf1, 0x7d, 0xb5, 0x67, 0x7c, 0xa2, 0xcf, 0xd8, 0xf3,
-	0x7e, 0x36, 0x9a, 0x6e, 0xeb, 0x44, 0x76, 0x29, 0x5d, 0x06, 0xb1, 0xdb, 0xd0, 0xc7, 0x2e, 0x5d,
-	0xb5, 0xa3, 0x0e, 0x8a, 0x40, 0x79, 0x08, 0x83, 0x2f, 0xc0, 0xd9, 0x6d, 0x00, 0xdc, 0xdc, 0xe8,
-	0x1e, 0x30, 0x59, 0xb2, 0xaf, 0x95, 0xb0, 0xec, 0x3a, 0xc0, 0x0f, 0x72, 0x07, 0xab, 0xcb, 0x58,
-	0x4a, 0x10, 0xf6, 0x36, 0x74, 0x75, 0x8f, 0x8b, 0xd1, 0xb6, 0xbe, 0x3d, 0x6a, 0xf0, 0x59, 0x20,
-	0xbb, 0x0f, 0xab, 0xfc, 0x95, 0x0b, 0x8c, 0x16, 0x40, 0xd6, 0x9f, 0x56, 0xa1, 0xa3, 0xf7, 0x24,
-	0xf2, 0xd9, 0x33, 0x3c, 0xab, 0x9c, 0xcf, 0xb3, 0xea, 0x67, 0xe3, 0x59, 0xed, 0x33, 0xf1, 0xac,
-	0x7e, 0x2e, 0xcf, 0x96, 0xae, 0xb6, 0xf1, 0xaa, 0xab, 0x7d, 0x29, 0xeb, 0xaf, 0x03, 0x1c, 0xe4,
-	0x41, 0x80, 0xe6, 0x7b, 0x09, 0x62, 0x1d, 0x00, 0x90, 0xaf, 0x52, 0xac, 0x5a, 0xfa, 0x53, 0x95,
-	0x57, 0x16, 0xc1, 0xff, 0x54, 0x00, 0x0e, 0x9c, 0x49, 0xa4, 0xb6, 0x3a, 0xf6, 0x5d, 0x68, 0x27,
-	0xd4, 0x53, 0x67, 0xaa, 0xaa, 0x6c, 0xb1, 0xe4, 0x4b, 0x0b, 0x52, 0xdd, 0x44, 0x0b, 0xe3, 0x90,
-	0xe4, 0x6d, 0x8a, 0x6e, 0xd4, 0x0c, 0x74, 0xa5, 0x5c, 0xd5, 0xd1, 0x0d, 0x81, 0xe8, 0x36, 0xf9,
-	0x26, 0xf4, 0x34, 0x41, 0x24, 0x62, 0x57, 0x84, 0xca, 0xaa, 0x2b, 0xbc, 0xab, 0xa0, 0xfb, 0x0a,
-	0xc8, 0x3e, 0xcc, 0xc9, 0x5c, 0x19, 0x4c, 0x27, 0x4b, 0x85, 0xa4, 0x87, 0x6c, 0x2b, 0x02, 0x6b,
-	0x33, 0x5b, 0x0a, 0xfd, 0x88, 0x01, 0x75, 0xfc, 0x5e, 0xff, 0x02, 0x6b, 0x43, 0x4b, 0xcf, 0xda,
-	0xaf, 0xb0, 0x2e, 0x98, 0x54, 0x59, 0x45, 0xb8, 0xaa, 0xf5, 0xf3, 0x55, 0x68, 0x0f, 0xc3, 0x24,
-	0x8d, 0xa7, 0x6a, 0x9f, 0x2f, 0x0a, 0x88, 0x1a, 0x54, 0x40, 0xa4, 0xaf, 0x6e, 0xd5, 0x32, 0xe8,
-	0xea, 0xf6, 0x16, 0xd4, 0x9d, 0x30, 0xf5, 0x75, 0x58, 0x53, 0xaa, 0x52, 0xcb, 0x52, 0x76, 0x4e,
-	0x78, 0xf6, 0x3e, 0xb4, 0x74, 0x49, 0x9b, 0x2e, 0x2b, 0x59, 0x5a, 0x0f, 0x97, 0xd1, 0xb0, 0x0d,
-	0x30, 0x3c, 0x5d, 0x6b, 0xa7, 0x75, 0xab, 0x34, 0x75, 0x56, 0x85, 0xc7, 0x73, 0x1a, 0xf6, 0x16,
-	0xd4, 0x9c, 0xb1, 0x52, 0x23, 0xba, 0xe3, 0xcf, 0x48, 0xa9, 0x42, 0x89, 0x23, 0x8e, 0xdd, 0xd1,
-	0x01, 0x34, 0x46, 0x54, 0xda, 0x3a, 0x4b, 0x73, 0x66, 0xc7, 0xb5, 0x2a, 0x90, 0xa6, 0x10, 0xeb,
-	0x0e, 0x98, 0x89, 0x98, 0xf8, 0x6a, 0x80, 0x39, 0x3f, 0x20, 0x4b, 0x79, 0xb9, 0x91, 0x64, 0xc9,
-	0xef, 0x5d, 0x68, 0x27, 0x94, 0x9b, 0xa9, 0x21, 0x90, 0xdd, 0xdc, 0xe5, 0x43, 0xf2, 0xc4, 0x8d,
-	0x43, 0x52, 0x24, 0x71, 0x77, 0xc0, 0x9c, 0x38, 0xf1, 0x89, 0x1a, 0xd4, 0x9e, 0xff, 0x4e, 0x96,
-	0x38, 0x70, 0x63, 0x92, 0xa5, 0x10, 0x16, 0xd4, 0x89, 0xb6, 0x93, 0x6d, 0x1f, 0x19, 0xad, 0xe2,
-	0x37, 0xe2, 0xd8, 0xbb, 0xd0, 0x8a, 0x54, 0xc4, 0x4a, 0xf5, 0x03, 0xed, 0xcd, 0xd5, 0x82, 0x4c,
-	0x87, 0xb2, 0x3c, 0xa3, 0x60, 0xdf, 0x81, 0x9e, 0xba, 0x85, 0x1e, 0xe9, 0x00, 0x8f, 0x6a, 0x0a,
-	0x66, 0xca, 0xa6, 0x66, 0xe2, 0x3f, 0xde, 0x4d, 0x67, 0xc2, 0xc1, 0x6f, 0x41, 0x57, 0xe8, 0xfd,
-	0xd7, 0x4e, 0x5c, 0x27, 0x1c, 0xf4, 0x69, 0xf8, 0xe5, 0xe5, 0xdb, 0x33, 0xef, 0x88, 0x72, 0x30,
-	0xb5, 0x0e, 0x4d, 0x75, 0xe7, 0x38, 0x58, 0xa5, 0x51, 0xa5, 0x92, 0x5f, 0x75, 0x23, 0xc5, 0x35,
-	0x9e, 0xdd, 0x9b, 0xbb, 0x2b, 0xc4, 0x0d, 0x98, 0xd1, 0x98, 0xc1, 0x8b, 0x2e, 0x00, 0x67, 0x6e,
-	0x11, 0x3f, 0x11, 0x67, 0x6c, 0x13, 0xa0, 0xb8, 0x63, 0x1d, 0x5c, 0x9c, 0x57, 0xc5, 0xfc, 0x82,
-	0x95, 0x9b, 0xf9, 0xdd, 0x2a, 0x3a, 0x97, 0xf2, 0x9d, 0xaf, 0xba, 0x36, 0x7b, 0x8d, 0x86, 0xbe,
-	0xbe, 0x64, 0xa8, 0xba, 0x3d, 0xe3, 0x2b, 0xd1, 0xdc, 0xd5, 0xf1, 0x7b, 0x60, 0xc8, 0xd8, 0xa3,
-	0x62, 0x81, 0xc1, 0x25, 0xb2, 0xde, 0x55, 0x5d, 0x8c, 0xa2, 0xea, 0xfe, 0xc8, 0x29, 0xb5, 0xa4,
-	0xea, 0xb0, 0xf7, 0xa1, 0x13, 0xc5, 0xf2, 0x47, 0xc2, 0x4d, 0x55, 0x98, 0x75, 0x79, 0xb1, 0x5e,
-	0x50, 0xe3, 0x29, 0xea, 0x2a, 0xc2, 0xa8, 0x2b, 0x2f, 0x0c, 0xa3, 0xd6, 0xb2, 0xc0, 0x61, 0xb0,
-	0x78, 0x3f, 0x49, 0x08, 0x9c, 0x45, 0x87, 0x1c, 0xaf, 0x2f, 0xce, 0xa2, 0xc3, 0x8f, 0x01, 0xb4,
-	0xfc, 0xe4, 0x81, 0x1f, 0x27, 0xe9, 0xe0, 0xaa, 0xaa, 0xb3, 0xd4, 0x5d, 0x0c, 0x58, 0xfc, 0xe4,
-	0xa1, 0x93, 0xa4, 0x83, 0x6b, 0x59, 0xc9, 0x27, 0xf6, 0x90, 0xe7, 0x2a, 0x23, 0x25, 0xad, 0x7d,
-	0x63, 0x9e, 0xe7, 0xf9, 0xb1, 0xbb, 0x4e, 0x4d, 0x49, 0xc7, 0x3f, 0x86, 0x15, 0x35, 0xa6, 0x30,
-	0xc1, 0x37, 0xe7, 0x75, 0x72, 0xe6, 0xfc, 0x96, 0x77, 0xe3, 0x99, 0xe3, 0xdc, 0x7c, 0x02, 0x74,
-	0x3f, 0x6a, 0x82, 0xeb, 0x4b, 0x27, 0xc8, 0x1d, 0x95, 0x9a, 0x20, 0x3f, 0x6a, 0xbc, 0x0d, 0x4d,
-	0x4f, 0xd5, 0x50, 0xdd, 0x58, 0x70, 0x40, 0xba, 0xc6, 0x87, 0x6b, 0x0a, 0xf6, 0x75, 0x68, 0x51,
-	0x65, 0x83, 0x8c, 0x06, 0x6b, 0xf3, 0x4a, 0xac, 0x2a, 0x12, 0x78, 0x33, 0x50, 0x95, 0x09, 0xef,
-	0x42, 0x2b, 0xcb, 0x44, 0xdf, 0x9a, 0x37, 0x4c, 0xbd, 0xbd, 0xf1, 0x8c, 0x82, 0xdd, 0x84, 0xc6,
-	0x04, 0xdd, 0xf3, 0xc0, 0x9a, 0x77, 0x6c, 0xca, 0x6b, 0x2b, 0x2c, 0x39, 0x1e, 0x0a, 0x30, 0x95,
-	0xf5, 0x7d, 0x6d, 0xc1, 0xf1, 0xe4, 0xd1, 0x27, 0x87, 0xa4, 0x88, 0x44, 0x7f, 0x0f, 0xae, 0x96,
-	0xeb, 0x0d, 0xb2, 0x62, 0x04, 0x9d, 0x39, 0xbc, 0x4d, 0xb3, 0xbc, 0xb5, 0x44, 0xc1, 0x67, 0xcb,
-	0x16, 0xf8, 0x95, 0xe8, 0x05, 0xf5, 0x0c, 0x77, 0xf3, 0xcd, 0x0f, 0xfd, 0xca, 0xe0, 0xe6, 0xc2,
-	0x6f, 0xe5, 0xdb, 0x67, 0xb6, 0x25, 0xd2, 0xae, 0xfb, 0x4d, 0xe8, 0x8c, 0xa6, 0xcf, 0x9f, 0x9f,
-	0xe9, 0x04, 0x76, 0x70, 0x8b, 0xc6, 0x95, 0xb2, 0xa4, 0xd2, 0xed, 0x39, 0x6f, 0x8f, 0x4a, 0x57,
-	0xe9, 0x57, 0xa0, 0xe5, 0x86, 0xb6, 0xe3, 0x79, 0xf1, 0xe0, 0x1d, 0x75, 0x7b, 0xee, 0x86, 0x5b,
-	0x9e, 0x47, 0x65, 0x08, 0x32, 0x12, 0x54, 0x3b, 0x6b, 0xfb, 0xde, 0x60, 0x5d, 0x6d, 0xc3, 0x19,
-	0x68, 0xe8, 0x51, 0x55, 0xbe, 0x13, 0x3b, 0x41, 0x20, 0x02, 0x24, 0xf8, 0xba, 0xae, 0xca, 0xd7,
-	0xa0, 0xa1, 0xc7, 0xde, 0x82, 0xce, 0xc4, 0x39, 0xb5, 0x33, 0xc8, 0xe0, 0xb6, 0x2a, 0x79, 0x9e,
-	0x38, 0xa7, 0xfb, 0x1a, 0x84, 0x6a, 0xae, 0xca, 0xd2, 0x48, 0xd9, 0xde, 0x9d, 0x57, 0xf3, 0x3c,
-	0x77, 0xe7, 0xa6, 0x9f, 0xa7, 0xf1, 0xe4, 0x8e, 0xc8, 0x09, 0xdb, 0xc1, 0xe6, 0xe0, 0xbd, 0x45,
-	0x77, 0xa4, 0x0f, 0x1d, 0xd0, 0x1d, 0x65, 0xe7, 0x0f, 0x9b, 0x00, 0xca, 0x5b, 0x93, 0xb0, 0xdf,
-	0x9f, 0x1f, 0x93, 0x67, 0x01, 0x5c, 0xd5, 0x64, 0x91, 0xa8, 0x37, 0x01, 0x28, 0x1f, 0x51, 0x63,
-	0x36, 0xe6, 0xc7, 0xe4, 0x49, 0x00, 0x37, 0x9f, 0xe6, 0xf9, 0xc0, 0x1d, 0x30, 0xa7, 0x18, 0xee,
-	0xdb, 0x4e, 0x10, 0x0c, 0xee, 0xcc, 0xdb, 0x40, 0x96, 0x09, 0x70, 0x63, 0xaa, 0x5b, 0xf8, 0x11,
-	0x3a, 0x29, 0xa2, 0x90, 0x6c, 0xf0, 0xc1, 0xfc, 0x47, 0xf2, 0x74, 0x81, 0x9b, 0xc7, 0x79, 0xe6,
-	0xf0, 0x2d, 0xe8, 0x66, 0xc7, 0x3f, 0x6a, 0xd8, 0x87, 0xf3, 0x5b, 0x47, 0x39, 0x24, 0xe6, 0x59,
-	0xdd, 0xb9, 0x1a, 0x7c, 0x17, 0xda, 0x8a, 0xe3, 0x6a, 0xe8, 0xe6, 0xbc, 0x82, 0x15, 0x01, 0x22,
-	0x57, 0xa2, 0xa1, 0x61, 0xd6, 0x5d, 0xe8, 0x6c, 0xd1, 0x13, 0x0f, 0x3f, 0x21, 0xdf, 0x79, 0x13,
-	0xea, 0xf9, 0x61, 0x4e, 0xee, 0x94, 0x89, 0xe2, 0xb9, 0x18, 0x86, 0x23, 0xc9, 0x09, 0x6d, 0xfd,
-	0x79, 0x0d, 0x9a, 0x07, 0x72, 0x1a, 0xbb, 0xe2, 0xe5, 0x05, 0x71, 0x6f, 0x66, 0x32, 0x0a, 0x8b,
-	0x52, 0x0e, 0x25, 0x0e, 0x42, 0x97, 0xcf, 0x89, 0x6a, 0x94, 0x3f, 0xe7, 0xe7, 0x44, 0x79, 0x25,
-	0x92, 0x2a, 0xfa, 0x56, 0x1d, 0xd2, 0xcf, 0x69, 0x72, 0xec, 0xc9, 0x67, 0x21, 0xea, 0x67, 0x83,
-	0x4a, 0xc5, 0x20, 0x03, 0x0d, 0x3d, 0xaa, 0x8a, 0xcd, 0x08, 0xc8, 0x00, 0x54, 0xd2, 0xde, 0xc9,
-	0x80, 0x64, 0x06, 0xd9, 0x19, 0x54, 0xeb, 0x05, 0x67, 0x50, 0xb7, 0x21, 0xaf, 0xd2, 0xd3, 0x11,
-	0xd2, 0x8b, 0xab, 0xf8, 0x36, 0xc1, 0xcc, 0x1f, 0x00, 0xe9, 0xe8, 0xe8, 0xb5, 0x8d, 0xe2, 0x49,
-	0xd0, 0x61, 0xd6, 0xe2, 0x05, 0xd9, 0x92, 0xc3, 0xa9, 0x28, 0x96, 0x47, 0xfa, 0x1c, 0x01, 0x5e,
-	0xe5, 0x70, 0x6a, 0x1f, 0xc7, 0x65, 0x47, 0x6e, 0x7e, 0x62, 0xbb, 0x32, 0x4c, 0x52, 0x9d, 0xc0,
-	0xb7, 0xfc, 0x64, 0x1b, 0xbb, 0xd6, 0xef, 0x82, 0xb1, 0x27, 0x3d, 0x12, 0x21, 0x63, 0x50, 0x9f,
-	0xb8, 0xd1, 0x54, 0xc7, 0xb2, 0xd4, 0xd6, 0xef, 0x7b, 0x94, 0x70, 0xf4, 0xfb, 0x1e, 0x62, 0x5d,
-	0x4d, 0x1d, 0x1c, 0x61, 0x5b, 0xbd, 0x2a, 0x38, 0x0b, 0xa4, 0xe3, 0x69, 0x81, 0x64, 0x5d, 0xeb,
-	0xcf, 0x2a, 0xb0, 0xba, 0x1f, 0x4b, 0x57, 0x24, 0xc9, 0x43, 0xdc, 0x3c, 0x1d, 0x0a, 0x85, 0x18,
-	0xd4, 0xe9, 0xfc, 0x47, 0x15, 0xdd, 0x53, 0x1b, 0x95, 0x41, 0x25, 0xd6, 0x79, 0x0e, 0x50, 0xe3,
-	0x26, 0x41, 0x28, 0x05, 0xc8, 0xd1, 0x34, 0xb0, 0x56, 0x42, 0xd3, 0xc9, 0xd1, 0x4d, 0xe8, 0x15,
-	0x75, 0xaf, 0x34, 0x83, 0x7e, 0x6d, 0x93, 0x43, 0x69, 0x96, 0x1b, 0xd0, 0x8e, 0x85, 0x83, 0xe1,
-	0x05, 0x4d, 0xd3, 0x20, 0x1a, 0x50, 0x20, 0x9c, 0xc7, 0x3a, 0x86, 0xfe, 0x7e, 0x2c, 0x22, 0x27,
-	0x16, 0xe8, 0xb1, 0x26, 0xc4, 0x95, 0xcb, 0xd0, 0x0c, 0x44, 0x38, 0x4e, 0x8f, 0xf5, 0xff, 0xea,
-	0x5e, 0xfe, 0x9a, 0xaa, 0x5a, 0x7a, 0x4d, 0x85, 0xdc, 0x89, 0x85, 0xa3, 0x1f, 0x5d, 0x51, 0x1b,
-	0x95, 0x35, 0x9c, 0x06, 0xfa, 0x4c, 0xca, 0xe0, 0xaa, 0x63, 0xfd, 0x49, 0x0d, 0xda, 0x9a, 0x33,
-	0xf4, 0x15, 0xc5, 0xe7, 0x4a, 0xce, 0xe7, 0x3e, 0xd4, 0x92, 0x27, 0x81, 0x66, 0x3c, 0x36, 0xd9,
-	0x47, 0x50, 0x0b, 0xfc, 0x89, 0x4e, 0x22, 0xae, 0xcd, 0xf8, 0xbf, 0x59, 0xfe, 0xea, 0xe3, 0x0b,
-	0xa4, 0x66, 0xd7, 0xc8, 0x3f, 0x9d, 0xda, 0xa8, 0x15, 0x9a, 0x27, 0xe8, 0x8b, 0x4e, 0x51, 0xf5,
-	0x90, 0xa9, 0x8e, 0x4b, 0x85, 0x6d, 0x99, 0xbd, 0x74, 0xb9, 0xa9, 0x21, 0x43, 0x8f, 0x7d, 0x03,
-	0x8c, 0x24, 0x74, 0xa2, 0xe4, 0x58, 0xa6, 0x3a, 0x69, 0x60, 0x1b, 0xe9, 0x69, 0xb8, 0xb1, 0xbd,
-	0x77, 0x78, 0x1a, 0x1e, 0x68, 0x8c, 0xfe, 0x58, 0x4e, 0xc9, 0xbe, 0x03, 0x9d, 0x44, 0x24, 0x89,
-	0x2a, 0x40, 0x1e, 0x49, 0x6d, 0x47, 0x97, 0xca, 0x49, 0x01, 0x61, 0x71, 0xd5, 0x7a, 0x70, 0x3b,
-	0x29, 0x40, 0xec, 0xfb, 0xd0, 0xcb, 0xc6, 0x07, 0x72, 0x3c, 0x16, 0x59, 0x89, 0xe9, 0xb5, 0x85,
-	0x19, 0x1e, 0x12, 0xba, 0x34, 0x4f, 0x37, 0x29, 0x23, 0xd8, 0xf7, 0xa0, 0x17, 0x29, 0x61, 0xda,
-	0xfa, 0xc0, 0x54, 0x99, 0xe0, 0xd5, 0x99, 0xed, 0x7a, 0x46, 0xd8, 0x45, 0xdd, 0x67, 0x01, 0x4f,
-	0xac, 0xff, 0xaa, 0x40, 0xbb, 0xf4, 0xd7, 0xf4, 0xc6, 0x2d, 0x11, 0x71, 0x76, 0x78, 0x8a, 0x6d,
-	0x84, 0x1d, 0x4b, 0xfd, 0x6c, 0xc4, 0xe4, 0xd4, 0x46, 0x58, 0x2c, 0xf5, 0x69, 0xba, 0xc9, 0xa9,
-	0x8d, 0x3e, 0x48, 0xe7, 0x6f, 0xaa, 0x34, 0x9f, 0x84, 0x52, 0xe7, 0x9d, 0x02, 0x38, 0xa4, 0x43,
-	0x0d, 0x54, 0xa7, 0x23, 0x27, 0xc9, 0x8e, 0x73, 0xf3, 0x3e, 0x1a, 0xdb, 0x53, 0x11, 0xe3, 0xbf,
-	0x68, 0xf7, 0x95, 0x75, 0x51, 0xd6, 0xe4, 0x36, 0x9e, 0xcb, 0x50, 0xdd, 0x32, 0x74, 0xb8, 0x81,
-	0x80, 0x1f, 0xca, 0x90, 0x86, 0x69, 0xc9, 0x12, 0x3f, 0x4d, 0x9e, 0x75, 0xd1, 0x39, 0x3c, 0x99,
-	0x0a, 0x0c, 0x69, 0x3c, 0x7a, 0x5f, 0x61, 0xf2, 0x16, 0xf5, 0x87, 0x9e, 0xf5, 0xaf, 0x15, 0x58,
-	0x5d, 0x60, 0x36, 0x46, 0x10, 0xc8, 0xe8, 0xac, 0x1c, 0xb7, 0xc3, 0x9b, 0xd8, 0x1d, 0x7a, 0x84,
-	0x48, 0x27, 0xa4, 0x4c, 0x55, 0x8d, 0x48, 0x27, 0xa8, 0x49, 0x97, 0xa0, 0x99, 0x9e, 0xd2, 0x6a,
-	0x95, 0x61, 0x34, 0xd2, 0x53, 0x5c, 0xe6, 0x16, 0x98, 0x81, 0x1c, 0xdb, 0x81, 0x78, 0x2a, 0x02,
-	0xe2, 0x43, 0x6f, 0xf3, 0xed, 0x73, 0xa4, 0xbc, 0xf1, 0x50, 0x8e, 0x1f, 0x22, 0x2d, 0x37, 0x02,
-	0xdd, 0xb2, 0x7e, 0x00, 0x46, 0x06, 0x65, 0x26, 0x34, 0x76, 0xc4, 0xd1, 0x74, 0xdc, 0xbf, 0x80,
-	0x99, 0x3c, 0x8e, 0xe8, 0x57, 0xb0, 0xf5, 0xa9, 0x13, 0x87, 0xfd, 0x2a, 0xa2, 0xef, 0xc7, 0xb1,
-	0x8c, 0xfb, 0x35, 0x6c, 0xee, 0x3b, 0xa1, 0xef, 0xf6, 0xeb, 0xd8, 0x7c, 0xe0, 0xa4, 0x4e, 0xd0,
-	0x6f, 0x58, 0x7f, 0xd1, 0x00, 0x63, 0x5f, 0x7f, 0x9d, 0xed, 0x40, 0x37, 0x7f, 0x82, 0xb8, 0xfc,
-	0x60, 0x63, 0x7f, 0xbe, 0x41, 0x07, 0x1b, 0x9d, 0xa8, 0xd4, 0x9b, 0x7f, 0xc8, 0x58, 0x5d, 0x78,
-	0xc8, 0xf8, 0x06, 0xd4, 0x9e, 0xc4, 0x67, 0xb3, 0x17, 0x1e, 0xfb, 0x81, 0x13, 0x72, 0x04, 0xb3,
-	0x0f, 0xa1, 0x8d, 0x72, 0xb7, 0x13, 0xda, 0x51, 0xf5, 0xa1, 0x40, 0xf9, 0x49, 0x28, 0xc1, 0x39,
-	0x20, 0x91, 0xde, 0x75, 0x37, 0xc0, 0x70, 0x8f, 0xfd, 0xc0, 0x8b, 0x45, 0xa8, 0xcf, 0xf5, 0xd8,
-	0xe2, 0x2f, 0xf3, 0x9c, 0x86, 0x7d, 0x97, 0xaa, 0x5c, 0xb3, 0xc3, 0x8c, 0xf2, 0x25, 0xfb, 0xa5,
-	0x99, 0x1c, 0x33, 0xa3, 0xe0, 0x2b, 0x25, 0x72, 0xda, 0x5c, 0x8a, 0x22, 0xfc, 0x56, 0xb9, 0x08,
-	0x5f, 0x3d, 0x6e, 0xa3, 0x4d, 0xc1, 0xc8, 0x33, 0x1d, 0xe9, 0x78, 0xec, 0x16, 0xd4, 0x43, 0xe9,
-	0x89, 0xc5, 0xd3, 0x82, 0x6c, 0x1f, 0xe2, 0x84, 0xa7, 0x97, 0xa9, 0xd3, 0xe4, 0xd8, 0x56, 0xfb,
-	0x39, 0xba, 0x12, 0xd0, 0x8f, 0x58, 0xa6, 0xc9, 0xf1, 0x0e, 0xee, 0xe8, 0xa8, 0x8c, 0x37, 0xa1,
-	0x97, 0xad, 0x45, 0xd7, 0xe8, 0xaa, 0xbb, 0xc5, 0x6e, 0x06, 0x55, 0x25, 0xba, 0x1b, 0x70, 0xd1,
-	0x3d, 0x76, 0xc2, 0x50, 0x04, 0xf6, 0xd1, 0x74, 0x34, 0xca, 0x76, 0x80, 0x0e, 0xdd, 0x0b, 0xad,
-	0x6a, 0xd4, 0x3d, 0xc2, 0xd0, 0x86, 0x62, 0x41, 0x37, 0xf4, 0x03, 0xf5, 0x72, 0xc2, 0x76, 0xc3,
-	0x74, 0xd0, 0x25, 0xca, 0x76, 0xe8, 0x07, 0x74, 0x76, 0xb8, 0x1d, 0xa6, 0xec, 0x63, 0xe8, 0x4f,
-	0xa7, 0xbe, 0x97, 0xd8, 0xa9, 0xcc, 0xde, 0x05, 0x0e, 0x7a, 0xc4, 0xba, 0x52, 0x64, 0xf6, 0x78,
-	0xea, 0x7b, 0x87, 0x52, 0xbf, 0x0c, 0xec, 0x12, 0x7d, 0xd6, 0xb5, 0x3e, 0x86, 0x4e, 0x59, 0x77,
-	0x50, 0x17, 0x29, 0x65, 0xe9, 0x5f, 0x60, 0x00, 0xcd, 0x3d, 0x19, 0x4f, 0x9c, 0xa0, 0x5f, 0xc1,
-	0xb6, 0x7a, 0x9a, 0xd2, 0xaf, 0xb2, 0x0e, 0x18, 0x59, 0x2c, 0xdd, 0xaf, 0x59, 0xdf, 0x02, 0x23,
-	0x7b, 0xe8, 0x48, 0x2f, 0xcc, 0xa4, 0x27, 0x54, 0x60, 0xa3, 0x3c, 0x93, 0x81, 0x00, 0x0a, 0x6a,
-	0xb2, 0x57, 0xb9, 0xd5, 0xe2, 0x55, 0xae, 0xf5, 0x5b, 0xd0, 0x29, 0xff, 0x5c, 0x76, 0x6e, 0x55,
-	0x29, 0xce, 0xad, 0x96, 0x8c, 0xa2, 0x6b, 0x8d, 0x58, 0x4e, 0xec, 0x52, 0x10, 0x60, 0x20, 0x00,
-	0x3f, 0x73, 0xfb, 0x09, 0x34, 0xd5, 0x0b, 0x64, 0xb6, 0x0a, 0xdd, 0xc7, 0xe1, 0x49, 0x28, 0x9f,
-	0x85, 0x0a, 0xd0, 0xbf, 0xc0, 0x2e, 0xc2, 0x4a, 0xb6, 0x5a, 0xfd, 0xd4, 0xb9, 0x5f, 0x61, 0x7d,
-	0xe8, 0x10, 0x3f, 0x33, 0x48, 0x95, 0xbd, 0x01, 0x03, 0xed, 0x95, 0x77, 0x64, 0x28, 0xf6, 0x64,
-	0xea, 0x8f, 0xce, 0x32, 0x6c, 0x8d, 0xad, 0x40, 0xfb, 0x20, 0x95, 0xd1, 0x81, 0x08, 0x3d, 0x3f,
-	0x1c, 0xf7, 0xeb, 0xb7, 0x1f, 0x40, 0x53, 0x3d, 0x8c, 0x2e, 0x7d, 0x52, 0x01, 0xfa, 0x17, 0x90,
-	0xfa, 0x53, 0xc7, 0x4f, 0xfd, 0x70, 0xbc, 0x27, 0x4e, 0x53, 0xe5, 0x0d, 0x30, 0xdb, 0xee, 0x57,
-	0x59, 0x0f, 0x40, 0xcf, 0x7a, 0x3f, 0xf4, 0xfa, 0xb5, 0x7b, 0xdb, 0x3f, 0xf9, 0xd9, 0xf5, 0xca,
-	0x4f, 0x7f, 0x76, 0xbd, 0xf2, 0x4f, 0x3f, 0xbb, 0x7e, 0xe1, 0x8f, 0x7e, 0x7e, 0xbd, 0xf2, 0xc3,
-	0x0f, 0x4b, 0xcf, 0xbe, 0x27, 0x4e, 0x1a, 0xfb, 0xa7, 0xea, 0x46, 0x26, 0xeb, 0x84, 0xe2, 0x4e,
-	0x74, 0x32, 0xbe, 0x13, 0x1d, 0xdd, 0xc9, 0x84, 0x7d, 0xd4, 0xa4, 0xd7, 0xdc, 0x1f, 0xfd, 0x5f,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x28, 0xb8, 0x2f, 0x99, 0x4c, 0x3e, 0x00, 0x00,
+	// 5253 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3b, 0x5b, 0x8f, 0xdd, 0xc6,
+	0x79, 0x3a, 0x77, 0xf2, 0x3b, 0x97, 0x3d, 0x3b, 0xb2, 0x24, 0x5a, 0xb6, 0xa5, 0x35, 0x63, 0xd9,
+	0x1b, 0xc5, 0x5e, 0xc9, 0xeb, 0xb8, 0x0d, 0x9a, 0x26, 0xce, 0x6a, 0x25, 0x25, 0x27, 0x91, 0x56,
+	0xdb, 0xd9, 0x55, 0x8d, 0x06, 0x45, 0x09, 0x2e, 0x39, 0xe7, 0x2c, 0xb3, 0x3c, 0x24, 0x45, 0xf2,
+	0x48, 0xbb, 0x7e, 0x2a, 0xd0, 0xd7, 0xbe, 0xf5, 0x0f, 0x14, 0x79, 0x29, 0x8a, 0xa2, 0x17, 0xb4,
+	0x8f, 0x45, 0xdf, 0xd3, 0xb7, 0xfc, 0x82, 0xa2, 0x48, 0x5e, 0x8a, 0x5e, 0xde, 0xda, 0xa2, 0x2f,
+	0xbd, 0xe0, 0xfb, 0x66, 0x86, 0xe4, 0xb9, 0x68, 0x65, 0x39, 0x76, 0x91, 0x00, 0x7e, 0xe2, 0xcc,
+	0x77, 0x19, 0xce, 0xcc, 0x77, 0x99, 0x99, 0x6f, 0xbe, 0x81, 0x41, 0x12, 0x24, 0x22, 0x0c, 0x22,
+	0xb1, 0x95, 0xa4, 0x71, 0x1e, 0x33, 0x43, 0xd7, 0xaf, 0xbe, 0x37, 0x09, 0xf2, 0xe3, 0xd9, 0xd1,
+	0x96, 0x17, 0x4f, 0x6f, 0x4d, 0xe2, 0x49, 0x7c, 0x8b, 0x08, 0x8e, 0x66, 0x63, 0xaa, 0x51, 0x85,
+	0x4a, 0x92, 0xf1, 0x2a, 0x24, 0xa1, 0x1b, 0xa9, 0xf2, 0x5a, 0x1e, 0x4c, 0x45, 0x96, 0xbb, 0xd3,
+	0x44, 0x23, 0xc3, 0xd8, 0x3b, 0x51, 0x65, 0x33, 0x3f, 0x55, 0x74, 0xf6, 0xff, 0xd6, 0xa0, 0xf3,
+	0x50, 0x64, 0x99, 0x3b, 0x11, 0xcc, 0x86, 0x46, 0x16, 0xf8, 0x56, 0x6d, 0xa3, 0xb6, 0x39, 0xd8,
+	0x1e, 0x6e, 0x15, 0xdd, 0x3a, 0xc8, 0xdd, 0x7c, 0x96, 0x71, 0x44, 0x22, 0x8d, 0x37, 0xf5, 0xad,
+	0xfa, 0x22, 0xcd, 0x43, 0x91, 0x1f, 0xc7, 0x3e, 0x47, 0x24, 0x1b, 0x42, 0x43, 0xa4, 0xa9, 0xd5,
+	0xd8, 0xa8, 0x6d, 0xf6, 0x38, 0x16, 0x19, 0x83, 0xa6, 0xef, 0xe6, 0xae, 0xd5, 0x24, 0x10, 0x95,
+	0xd9, 0x5b, 0x30, 0x48, 0xd2, 0xd8, 0x73, 0x82, 0x68, 0x1c, 0x3b, 0x84, 0x6d, 0x11, 0xb6, 0x87,
+	0xd0, 0x51, 0x34, 0x8e, 0xef, 0x22, 0x95, 0x05, 0x1d, 0x37, 0x72, 0xc3, 0xb3, 0x4c, 0x58, 0x6d,
+	0x42, 0xeb, 0x2a, 0x1b, 0x40, 0x3d, 0xf0, 0xad, 0xce, 0x46, 0x6d, 0xb3, 0xc9, 0xeb, 0x81, 0x8f,
+	0xff, 0x98, 0xcd, 0x02, 0xdf, 0x32, 0xe4, 0x3f, 0xb0, 0xcc, 0x6c, 0xe8, 0x45, 0x42, 0xf8, 0x7b,
+	0x71, 0xce, 0x45, 0x12, 0x9e, 0x59, 0xe6, 0x46, 0x6d, 0xd3, 0xe0, 0x73, 0x30, 0xfb, 0x31, 0x98,
+	0xbb, 0x71, 0x14, 0x09, 0x2f, 0x8f, 0x53, 0x76, 0x1d, 0xba, 0x7a, 0x48, 0x8e, 0x9a, 0x8a, 0x16,
+	0x07, 0x0d, 0x1a, 0xf9, 0xec, 0x1d, 0x58, 0xf3, 0x34, 0xb5, 0x13, 0x44, 0xbe, 0x38, 0xa5, 0xb9,
+	0x68, 0xf1, 0x41, 0x01, 0x1e, 0x21, 0xd4, 0xfe, 0xd7, 0x3a, 0x74, 0x0e, 0x8e, 0x67, 0xe3, 0x71,
+	0x28, 0xd8, 0x5b, 0xd0, 0x57, 0xc5, 0xdd, 0x38, 0x1c, 0xf9, 0xa7, 0xaa, 0xdd, 0x79, 0x20, 0xdb,
+	0x80, 0xae, 0x02, 0x1c, 0x9e, 0x25, 0x42, 0x35, 0x5b, 0x05, 0xcd, 0xb7, 0xf3, 0x30, 0x88, 0x68,
+	0x8a, 0x1b, 0x7c, 0x1e, 0xb8, 0x40, 0xe5, 0x9e, 0x5a, 0xcd, 0x25, 0x2a, 0x97, 0xfe, 0xb6, 0x13,
+	0x06, 0x4f, 0x05, 0x17, 0x93, 0xdd, 0x28, 0xa7, 0xb9, 0x6f, 0xf1, 0x2a, 0x88, 0x6d, 0xc3, 0xa5,
+	0x4c, 0xb2, 0x38, 0xa9, 0x1b, 0x4d, 0x44, 0xe6, 0xcc, 0x82, 0x28, 0xff, 0xb5, 0xaf, 0x5b, 0xed,
+	0x8d, 0xc6, 0x66, 0x93, 0x5f, 0x54, 0x48, 0x4e, 0xb8, 0xc7, 0x84, 0x62, 0xb7, 0xe1, 0x95, 0x05,
+	0x1e, 0xc9, 0xd2, 0xd9, 0x68, 0x6c, 0x36, 0x38, 0x9b, 0x63, 0x19, 0x11, 0xc7, 0x3d, 0x58, 0x4f,
+	0x67, 0x11, 0x6a, 0xeb, 0xfd, 0x20, 0xcc, 0x45, 0x7a, 0x90, 0x08, 0x8f, 0x64, 0xd8, 0xdd, 0xbe,
+	0xb2, 0x45, 0x0a, 0xcd, 0x17, 0xd1, 0x7c, 0x99, 0xc3, 0xfe, 0xaf, 0x3a, 0x18, 0x77, 0x83, 0x2c,
+	0x71, 0x73, 0xef, 0x98, 0x5d, 0x81, 0xce, 0x78, 0x16, 0x79, 0xa5, 0x04, 0xdb, 0x58, 0x1d, 0xf9,
+	0xec, 0x37, 0x61, 0x2d, 0x8c, 0x3d, 0x37, 0x74, 0x0a, 0x61, 0x59, 0xf5, 0x8d, 0xc6, 0x66, 0x77,
+	0xfb, 0x62, 0xa9, 0xc9, 0x85, 0x32, 0xf0, 0x01, 0xd1, 0x16, 0x75, 0xf6, 0x2d, 0x18, 0xa6, 0x62,
+	0x1a, 0xe7, 0xa2, 0xc2, 0xde, 0x20, 0x76, 0x56, 0xb2, 0x7f, 0x9c, 0xba, 0xc9, 0x5e, 0xec, 0x0b,
+	0xbe, 0x26, 0x69, 0x4b, 0xf6, 0xf7, 0x2b, 0xf3, 0x29, 0x26, 0x4e, 0xe0, 0x9f, 0x3a, 0xf4, 0x03,
+	0xab, 0xb9, 0xd1, 0xd8, 0x6c, 0x95, 0x93, 0x23, 0x26, 0x23, 0xff, 0xf4, 0x01, 0x62, 0xd8, 0x07,
+	0x70, 0x79, 0x91, 0x45, 0xb6, 0x6a, 0xb5, 0x88, 0xe7, 0xe2, 0x1c, 0x0f, 0x27, 0x14, 0x7b, 0x13,
+	0x7a, 0x9a, 0x29, 0x3f, 0x4b, 0xa4, 0xdd, 0xb4, 0x78, 0x37, 0xab, 0x28, 0xd2, 0x15, 0xe8, 0x04,
+	0x99, 0x93, 0x05, 0xd1, 0x09, 0x19, 0x90, 0xc1, 0xdb, 0x41, 0x76, 0x10, 0x44, 0x27, 0xec, 0x55,
+	0x30, 0x52, 0xe1, 0x49, 0x8c, 0x41, 0x98, 0x4e, 0x2a, 0x3c, 0x42, 0x5d, 0x01, 0x2c, 0x3a, 0x5e,
+	0x2e, 0x94, 0x19, 0xb5, 0x53, 0xe1, 0xed, 0xe6, 0xc2, 0xce, 0xa0, 0xf5, 0x50, 0xa4, 0x13, 0xc1,
+	0xae, 0x82, 0x81, 0x8c, 0x07, 0x9e, 0x1b, 0xd1, 0xbc, 0x1b, 0xbc, 0xa8, 0xa3, 0x1d, 0x27, 0x6e,
+	0x9a, 0x07, 0x6e, 0x48, 0x8a, 0x6d, 0x70, 0x5d, 0x65, 0xaf, 0x81, 0x99, 0xe5, 0x6e, 0x9a, 0xe3,
+	0xe8, 0x48, 0xa1, 0x5b, 0xdc, 0x20, 0x00, 0xda, 0xc4, 0x15, 0xe8, 0x88, 0xc8, 0x27, 0x54, 0x53,
+	0x4a, 0x52, 0x44, 0xfe, 0xc8, 0x3f, 0xb5, 0xff, 0xa6, 0x06, 0xfd, 0x87, 0xb3, 0x30, 0x0f, 0x76,
+	0xd2, 0xc9, 0x4c, 0x4c, 0xa3, 0x1c, 0xed, 0xff, 0x6e, 0x90, 0xe5, 0xea, 0xcf, 0x54, 0x66, 0x9b,
+	0x60, 0x7e, 0x37, 0x8d, 0x67, 0xc9, 0xbd, 0xd3, 0x44, 0x4b, 0x1a, 0xa4, 0x52, 0x21, 0x84, 0x97,
+	0x48, 0xf6, 0x2e, 0x74, 0x1f, 0xa5, 0xbe, 0x48, 0xef, 0x9c, 0x11, 0x6d, 0x63, 0x89, 0xb6, 0x8a,
+	0x66, 0xaf, 0x83, 0x79, 0x20, 0x12, 0x37, 0x75, 0x51, 0x05, 0xb0, 0x63, 0x26, 0x2f, 0x01, 0x38,
+	0x56, 0x22, 0x1e, 0xf9, 0xca, 0xac, 0x74, 0xd5, 0x9e, 0x80, 0xb9, 0x33, 0x99, 0xa4, 0x62, 0xe2,
+	0xe6, 0xe4, 0xc0, 0xe2, 0x84, 0xba, 0xdb, 0xe0, 0xf5, 0x38, 0x21, 0x27, 0x89, 0x03, 0x90, 0xf3,
+	0x43, 0x65, 0x76, 0x0d, 0x9a, 0x62, 0x75, 0x7f, 0x08, 0xce, 0x2e, 0x43, 0xdb, 0x8b, 0xa3, 0x71,
+	0x30, 0x51, 0xae, 0x55, 0xd5, 0xec, 0x7f, 0xaa, 0x43, 0x8b, 0x06, 0x87, 0xd3, 0x8b, 0xee, 0xce,
+	0x11, 0x4f, 0xdd, 0x50, 0x4b, 0x05, 0x01, 0xf7, 0x9e, 0xba, 0x21, 0xdb, 0x80, 0x16, 0x36, 0x93,
+	0xad, 0x98, 0x1b, 0x89, 0x60, 0x6f, 0x43, 0x0b, 0x95, 0x28, 0x9b, 0xef, 0x01, 0x2a, 0xd1, 0x9d,
+	0xe6, 0x4f, 0xfe, 0xe1, 0xfa, 0x05, 0x2e, 0xd1, 0xec, 0x1d, 0x68, 0xba, 0x93, 0x49, 0x66, 0x35,
+	0x17, 0xcd, 0xa9, 0x18, 0x2f, 0x27, 0x02, 0xf6, 0x21, 0x98, 0x52, 0x6e, 0x48, 0xdd, 0x22, 0xea,
+	0x2b, 0x95, 0x65, 0xa4, 0x2a, 0x52, 0x5e, 0x52, 0xe2, 0x8c, 0x07, 0x99, 0xf2, 0x60, 0xa4, 0xd1,
+	0x06, 0x2f, 0x01, 0xe8, 0xe7, 0x93, 0x54, 0xec, 0x84, 0x61, 0xec, 0x1d, 0x04, 0x9f, 0x08, 0xb5,
+	0x2a, 0xcc, 0xc1, 0xd8, 0xdb, 0x30, 0xd8, 0x97, 0x2a, 0xc7, 0x45, 0x36, 0x0b, 0xf3, 0x4c, 0xad,
+	0x14, 0x0b, 0x50, 0xb6, 0x05, 0x6c, 0x0e, 0x72, 0x48, 0xc3, 0x37, 0x37, 0x1a, 0x9b, 0x7d, 0xbe,
+	0x02, 0x63, 0xff, 0x7b, 0x1d, 0xda, 0xa3, 0x28, 0x13, 0x69, 0x8e, 0x06, 0xe0, 0x8e, 0xc7, 0xc2,
+	0xcb, 0x85, 0x74, 0x3c, 0x4d, 0x5e, 0xd4, 0x71, 0x00, 0x87, 0xf1, 0xc7, 0x69, 0x90, 0x8b, 0x83,
+	0x0f, 0x94, 0x88, 0x4b, 0x00, 0xbb, 0x09, 0xeb, 0xae, 0xef, 0x3b, 0x9a, 0xda, 0x49, 0xe3, 0x67,
+	0x19, 0x19, 0x83, 0xc1, 0xd7, 0x5c, 0xdf, 0xdf, 0x51, 0x70, 0x1e, 0x3f, 0xcb, 0xd8, 0x9b, 0xd0,
+	0x48, 0xc5, 0x98, 0x04, 0xde, 0xdd, 0x5e, 0x93, 0x02, 0x79, 0x74, 0xf4, 0x23, 0xe1, 0xe5, 0x5c,
+	0x8c, 0x39, 0xe2, 0xd8, 0x2b, 0xd0, 0x72, 0xf3, 0x3c, 0x95, 0x13, 0x6c, 0x72, 0x59, 0x61, 0x5b,
+	0x70, 0x91, 0x8c, 0x2e, 0x0f, 0xe2, 0xc8, 0xc9, 0xdd, 0xa3, 0x10, 0xd7, 0xb8, 0x4c, 0xb9, 0xf3,
+	0xf5, 0x02, 0x75, 0x88, 0x98, 0x91, 0x9f, 0xe1, 0x02, 0xb0, 0x48, 0x1f, 0xb9, 0x53, 0x91, 0x91,
+	0x37, 0x37, 0xf9, 0xc5, 0x79, 0x8e, 0x3d, 0x44, 0xb1, 0xaf, 0x40, 0xbf, 0xe4, 0x41, 0xb3, 0x35,
+	0xc8, 0x02, 0x7a, 0x05, 0x10, 0xad, 0xfa, 0x12, 0xb4, 0x83, 0xcc, 0x11, 0x91, 0xaf, 0x3c, 0x49,
+	0x2b, 0xc8, 0xee, 0x45, 0x3e, 0xfb, 0x1a, 0x98, 0xf2, 0x2f, 0xbe, 0x18, 0x5b, 0x40, 0xc3, 0x1b,
+	0x28, 0x7d, 0x43, 0xf0, 0x5d, 0x31, 0xe6, 0x46, 0xae, 0x4a, 0xf6, 0x1b, 0xd0, 0xda, 0x49, 0x53,
+	0xf7, 0x8c, 0xc6, 0x8a, 0x05, 0xab, 0x46, 0x2e, 0x51, 0x56, 0x6c, 0x0f, 0x1a, 0x0f, 0xdd, 0x84,
+	0xdd, 0x80, 0xfa, 0x34, 0x21, 0x4c, 0x77, 0xfb, 0x52, 0x45, 0xcd, 0xdc, 0x64, 0xeb, 0x61, 0x72,
+	0x2f, 0xca, 0xd3, 0x33, 0x5e, 0x9f, 0x26, 0x57, 0x3f, 0x84, 0x8e, 0xaa, 0xe2, 0xe6, 0xe5, 0x44,
+	0x9c, 0x91, 0xf8, 0x4c, 0x8e, 0x45, 0xfc, 0xc1, 0x53, 0x37, 0x9c, 0xe9, 0x15, 0x59, 0x56, 0x7e,
+	0xa3, 0xfe, 0x8d, 0x9a, 0xfd, 0x1f, 0x4d, 0x30, 0xee, 0x8a, 0x50, 0xe0, 0xb8, 0x50, 0x07, 0xab,
+	0x62, 0x52, 0x0a, 0x30, 0x07, 0x43, 0x1a, 0xe9, 0xa4, 0x89, 0x4b, 0x28, 0x3d, 0x98, 0x83, 0xa1,
+	0xf7, 0x18, 0xdd, 0x99, 0x79, 0x27, 0x22, 0x27, 0x05, 0xe8, 0x73, 0x5d, 0x45, 0xcc, 0x9e, 0xc2,
+	0x34, 0x25, 0x46, 0x55, 0xd9, 0xeb, 0x00, 0x69, 0xfc, 0xcc, 0x09, 0xa4, 0xa7, 0x94, 0x4e, 0xc7,
+	0x48, 0xe3, 0x67, 0x23, 0xf4, 0x95, 0xff, 0x2f, 0x72, 0xff, 0x75, 0xb0, 0x4a, 0x1e, 0xda, 0x17,
+	0x39, 0x41, 0xe4, 0x1c, 0xe1, 0x72, 0xac, 0x54, 0xa0, 0x6c, 0x93, 0x36, 0x48, 0xa3, 0xe8, 0x0e,
+	0x22, 0xb5, 0x36, 0x9b, 0xe7, 0x68, 0xf3, 0x4a, 0xe3, 0x80, 0xd5, 0xc6, 0x71, 0x07, 0xe0, 0x40,
+	0x4c, 0xa6, 0x22, 0xca, 0x1f, 0xba, 0x89, 0xd5, 0x25, 0xc1, 0xdb, 0xa5, 0xe0, 0xb5, 0xb4, 0xb6,
+	0x4a, 0x22, 0xa9, 0x05, 0x15, 0x2e, 0x5c, 0x40, 0x3d, 0x37, 0x72, 0xf2, 0x74, 0x16, 0x79, 0x6e,
+	0x2e, 0xac, 0x1e, 0xfd, 0xaa, 0xeb, 0xb9, 0xd1, 0xa1, 0x02, 0x55, 0x34, 0xb8, 0x5f, 0xd5, 0xe0,
+	0xb7, 0x61, 0x2d, 0x49, 0x83, 0xa9, 0x9b, 0x9e, 0x39, 0x27, 0xe2, 0x8c, 0x84, 0x31, 0x90, 0x5b,
+	0x3d, 0x05, 0xfe, 0x81, 0x38, 0x1b, 0xf9, 0xa7, 0x57, 0xbf, 0x05, 0x6b, 0x0b, 0x1d, 0x78, 0x29,
+	0xbd, 0xfb, 0x9f, 0x3a, 0x98, 0xfb, 0xa9, 0x50, 0x5e, 0xe7, 0x3a, 0x74, 0x33, 0xef, 0x58, 0x4c,
+	0x5d, 0x92, 0x92, 0x6a, 0x01, 0x24, 0x08, 0x85, 0x33, 0x6f, 0x57, 0xf5, 0xf3, 0xed, 0x0a, 0xfb,
+	0x21, 0x17, 0x62, 0x34, 0x26, 0x2c, 0x96, 0xce, 0xa4, 0x59, 0x75, 0x26, 0x1b, 0xd0, 0x3b, 0x76,
+	0x33, 0xc7, 0x9d, 0xe5, 0xb1, 0xe3, 0xc5, 0x21, 0x29, 0x9d, 0xc1, 0xe1, 0xd8, 0xcd, 0x76, 0x66,
+	0x79, 0xbc, 0x1b, 0xd3, 0xc2, 0x1e, 0x64, 0xce, 0x2c, 0xf1, 0xdd, 0x5c, 0xbb, 0x6c, 0x23, 0xc8,
+	0x1e, 0x53, 0x1d, 0x75, 0x52, 0x64, 0x79, 0x30, 0x75, 0x95, 0x40, 0x1d, 0x2f, 0x9e, 0x45, 0x39,
+	0x39, 0xee, 0x06, 0x5f, 0x2f, 0x50, 0x3c, 0x7e, 0xb6, 0x8b, 0x08, 0x76, 0x1b, 0x06, 0x5e, 0x3c,
+	0x4d, 0x9c, 0x04, 0xe7, 0x95, 0x96, 0x44, 0xb9, 0x47, 0xac, 0x2e, 0x59, 0x3d, 0xa4, 0xd8, 0x3f,
+	0x11, 0x72, 0x8d, 0xde, 0x86, 0x35, 0x2f, 0x9c, 0x65, 0xb9, 0x48, 0x9d, 0x23, 0xc5, 0x62, 0x2e,
+	0xb1, 0xf4, 0x15, 0x89, 0x5a, 0xd7, 0xdf, 0x86, 0xb6, 0x77, 0x2c, 0xbc, 0x13, 0x54, 0xaf, 0x46,
+	0x39, 0x4d, 0xbb, 0x08, 0xc3, 0x69, 0x52, 0x58, 0xfb, 0x0f, 0x1b, 0x00, 0x0f, 0x62, 0xef, 0xe4,
+	0xd0, 0x4d, 0x27, 0x22, 0xc7, 0x5d, 0x93, 0x36, 0x2b, 0x65, 0xf6, 0x9d, 0x5c, 0x1a, 0x13, 0xdb,
+	0x86, 0xcb, 0x5a, 0x23, 0xbc, 0x38, 0xa4, 0x1d, 0x9c, 0xb4, 0x0b, 0x25, 0x55, 0xa6, 0xb0, 0xf2,
+	0x0c, 0x40, 0x46, 0xc1, 0xbe, 0x01, 0x6b, 0x55, 0x9e, 0xfc, 0x2c, 0xb1, 0x1a, 0xd5, 0x9e, 0x57,
+	0x56, 0xdf, 0x7e, 0xc9, 0x7e, 0x78, 0x96, 0xb0, 0xdb, 0x70, 0x29, 0x15, 0xe3, 0x54, 0x64, 0xc7,
+	0x4e, 0x9e, 0x55, 0x7f, 0x26, 0x37, 0x4f, 0xeb, 0x0a, 0x79, 0x98, 0x15, 0xff, 0xba, 0x0d, 0x97,
+	0xc6, 0xb4, 0x8b, 0x5e, 0xec, 0x9e, 0x74, 0x22, 0xeb, 0x12, 0x59, 0xed, 0xdd, 0x1b, 0x40, 0x47,
+	0x49, 0xe9, 0x18, 0xf4, 0x52, 0x1c, 0xd2, 0x64, 0x1c, 0x85, 0x02, 0xd7, 0xb9, 0xdd, 0x63, 0xdc,
+	0xdf, 0xdf, 0x15, 0x63, 0xb5, 0xb9, 0x2c, 0x01, 0xcc, 0x86, 0xe6, 0xc3, 0xd8, 0x17, 0x24, 0xbc,
+	0xc1, 0xf6, 0x60, 0x0b, 0xf9, 0xb6, 0x70, 0x26, 0x11, 0xca, 0x09, 0xc7, 0x6e, 0x03, 0x7c, 0xec,
+	0x06, 0xf9, 0x7e, 0x1c, 0x06, 0x9e, 0x3c, 0xb2, 0xe1, 0x49, 0x93, 0x28, 0x4b, 0x38, 0xaf, 0xd0,
+	0xd8, 0x7b, 0xd0, 0xc6, 0x36, 0x1e, 0x25, 0x6c, 0x0b, 0x3a, 0x39, 0xc9, 0x24, 0x53, 0x4e, 0xff,
+	0x95, 0xd2, 0xf6, 0x4b, 0x81, 0x71, 0x4d, 0x84, 0xba, 0x7d, 0x84, 0x2d, 0x2b, 0x4f, 0x2c, 0x2b,
+	0x36, 0x87, 0xb5, 0xc2, 0xbc, 0x1e, 0x47, 0xc1, 0x93, 0x99, 0x60, 0x1f, 0xc1, 0x7a, 0x92, 0x0a,
+	0x27, 0x20, 0x98, 0x33, 0x3b, 0x71, 0xbc, 0x5c, 0x1e, 0xe3, 0xe8, 0x17, 0x28, 0x95, 0x92, 0xe3,
+	0x64, 0x37, 0x3f, 0xe5, 0x83, 0x64, 0xae, 0x6e, 0xff, 0x10, 0xae, 0x14, 0x14, 0x07, 0xc2, 0x8b,
+	0x23, 0xdf, 0x4d, 0xcf, 0xc8, 0x13, 0x2e, 0xb4, 0x9d, 0xbd, 0x4c, 0xdb, 0x07, 0xd4, 0xf6, 0x8f,
+	0x1b, 0x30, 0x78, 0x14, 0xdd, 0x9d, 0x25, 0x61, 0x80, 0xde, 0xe9, 0x07, 0xd2, 0x79, 0x48, 0xa3,
+	0xad, 0x55, 0x8d, 0x76, 0x13, 0x86, 0xea, 0x2f, 0x28, 0x6d, 0x69, 0x72, 0xea, 0xf8, 0x2a, 0xe1,
+	0xbb, 0x71, 0x28, 0xed, 0xed, 0x5b, 0x70, 0x69, 0x46, 0x23, 0x97, 0x94, 0xa8, 0xf6, 0xce, 0x73,
+	0x76, 0xa2, 0x4c, 0x12, 0x22, 0x2b, 0x92, 0x21, 0x0c, 0x7d, 0x52, 0xc9, 0xae, 0x3d, 0x07, 0x14,
+	0x84, 0xd4, 0x93, 0x38, 0x72, 0x7c, 0xdd, 0x65, 0xb5, 0x6e, 0xa1, 0xcf, 0x19, 0xc4, 0xe5, 0x48,
+	0x70, 0xf5, 0xfa, 0x1d, 0x58, 0x9f, 0xa3, 0xa4, 0x5e, 0xb4, 0xa9, 0x17, 0xef, 0x95, 0xc2, 0x9d,
+	0x1f, 0x7e, 0xb5, 0x8a, 0xfd, 0x91, 0x3e, 0x7e, 0x2d, 0x9e, 0x87, 0x2a, 0x0f, 0x15, 0x4c, 0xa2,
+	0x38, 0x15, 0x4a, 0x57, 0x8d, 0x20, 0x1b, 0x51, 0xfd, 0xea, 0x1e, 0xbc, 0xb2, 0xaa, 0x95, 0x15,
+	0x8e, 0x7a, 0xa3, 0xea, 0xa8, 0x17, 0x76, 0xd1, 0xa5, 0xd3, 0xfe, 0x93, 0x1a, 0x74, 0xef, 0xcf,
+	0x3e, 0xf9, 0xe4, 0x4c, 0x9e, 0x5a, 0x59, 0x0f, 0x6a, 0x7b, 0xd4, 0x4a, 0x9d, 0xd7, 0xf6, 0x70,
+	0x23, 0xbf, 0x7f, 0x82, 0xde, 0x9a, 0x1a, 0x31, 0xb9, 0xaa, 0xe1, 0xfe, 0x7b, 0xff, 0xe4, 0xf0,
+	0x1c, 0x0f, 0x20, 0xd1, 0xb8, 0xf5, 0xbc, 0x33, 0x0b, 0x42, 0x5c, 0xef, 0x95, 0xb1, 0x17, 0x75,
+	0xdc, 0xd1, 0x8e, 0xc6, 0x52, 0x5f, 0xee, 0xa7, 0xf1, 0x54, 0x6a, 0xb4, 0x72, 0xd8, 0x2b, 0x30,
+	0xf6, 0xdf, 0x37, 0xa0, 0xf9, 0xfd, 0x38, 0x88, 0xe4, 0x69, 0x30, 0x74, 0x42, 0x79, 0xac, 0x42,
+	0xe1, 0x74, 0x52, 0x11, 0x3e, 0xc0, 0x83, 0xc9, 0xab, 0x60, 0x78, 0xb1, 0x42, 0xd5, 0x25, 0xca,
+	0x8b, 0xc3, 0x07, 0xf3, 0x67, 0x96, 0xda, 0xca, 0x33, 0x4b, 0x71, 0xa4, 0x68, 0xbe, 0xe8, 0x48,
+	0x61, 0x86, 0x62, 0x8c, 0xaa, 0x1a, 0xf9, 0x56, 0xab, 0x4a, 0x4b, 0x8d, 0x19, 0x88, 0xdc, 0x8d,
+	0x23, 0x9f, 0x7d, 0x15, 0x20, 0x0d, 0x26, 0xc7, 0x8a, 0xb2, 0xbd, 0x7c, 0xcc, 0x23, 0x2c, 0x91,
+	0x72, 0x78, 0x55, 0xc5, 0x0e, 0x1c, 0xe5, 0xf6, 0x8e, 0x70, 0x96, 0xe4, 0x38, 0x3a, 0xfa, 0x34,
+	0xb2, 0x3a, 0xea, 0x70, 0x79, 0x2e, 0xea, 0x40, 0xb3, 0x4b, 0xe3, 0x7d, 0x1d, 0x70, 0xd5, 0x3b,
+	0x76, 0xe2, 0xc8, 0x49, 0xf4, 0xa9, 0xd9, 0x40, 0xc8, 0xa3, 0x68, 0xff, 0x04, 0xdd, 0x25, 0x1e,
+	0xb5, 0xd5, 0xc9, 0xc5, 0x5c, 0x3c, 0xb9, 0x6c, 0x40, 0xef, 0x47, 0x71, 0x10, 0x39, 0x53, 0x37,
+	0x71, 0x72, 0x77, 0x42, 0xdb, 0x9a, 0x16, 0x07, 0x84, 0x3d, 0x74, 0x93, 0x43, 0x77, 0x42, 0xcb,
+	0xbb, 0x24, 0x26, 0x23, 0xe9, 0x4a, 0x02, 0x05, 0x42, 0xf1, 0xbe, 0x06, 0x26, 0x35, 0x41, 0x87,
+	0xfd, 0x9e, 0x94, 0x3d, 0x02, 0x70, 0x46, 0xed, 0x7f, 0xae, 0x83, 0xb1, 0x13, 0xe5, 0x01, 0xc9,
+	0xf3, 0x32, 0xb4, 0x53, 0x3a, 0xb9, 0x28, 0x69, 0xaa, 0x5a, 0x21, 0xb1, 0xfa, 0x73, 0x24, 0x36,
+	0x27, 0x89, 0xc6, 0xa7, 0x96, 0x44, 0xf3, 0x3c, 0x49, 0xcc, 0xcf, 0x5a, 0xeb, 0xdc, 0x59, 0x5b,
+	0x3a, 0xef, 0x7d, 0x11, 0x62, 0x5c, 0x94, 0x84, 0xf1, 0x22, 0x49, 0x98, 0x8b, 0x92, 0xb0, 0xff,
+	0xaa, 0x01, 0xc6, 0x03, 0x31, 0xce, 0xbf, 0x34, 0x9e, 0x5f, 0x15, 0xe3, 0xb1, 0xff, 0xad, 0x01,
+	0x26, 0xc7, 0x11, 0x7e, 0x81, 0x32, 0xbb, 0x05, 0x40, 0xb2, 0x38, 0x5f, 0x70, 0x24, 0xaf, 0x43,
+	0x12, 0xde, 0xfb, 0xd0, 0x95, 0x32, 0x91, 0x1c, 0xad, 0xe7, 0x70, 0x48, 0xc1, 0x1d, 0x2e, 0xcb,
+	0xbb, 0xfd, 0xa9, 0xe5, 0xdd, 0xf9, 0xcc, 0xf2, 0x36, 0x3e, 0x0f, 0x79, 0x9b, 0xe7, 0xca, 0x1b,
+	0x5e, 0x24, 0xef, 0xee, 0x8b, 0xe4, 0xdd, 0x5b, 0x92, 0xf7, 0x8f, 0x1b, 0xd0, 0x27, 0x79, 0x1f,
+	0x88, 0xe9, 0x2f, 0xe6, 0x14, 0x17, 0x84, 0xd4, 0x78, 0x59, 0x21, 0x35, 0x3f, 0xb5, 0x90, 0x5a,
+	0x9f, 0x59, 0x48, 0xed, 0xcf, 0x43, 0x48, 0x9d, 0x73, 0x85, 0x64, 0xbc, 0x48, 0x48, 0xe6, 0xcb,
+	0x1b, 0x65, 0x21, 0xa4, 0x5f, 0x78, 0xe5, 0xfa, 0x52, 0x48, 0x9f, 0x93, 0x90, 0x60, 0x49, 0x48,
+	0xb8, 0xb3, 0xf8, 0x85, 0x8d, 0xe8, 0x8b, 0xd8, 0x59, 0x9c, 0x3b, 0xd9, 0xad, 0xcf, 0x63, 0xb2,
+	0xdb, 0xe7, 0x4e, 0x76, 0xe7, 0x45, 0x93, 0xfd, 0x19, 0x76, 0x16, 0x7f, 0xdd, 0x00, 0x38, 0x08,
+	0xa2, 0x49, 0x28, 0xbe, 0xdc, 0x5b, 0xfc, 0xca, 0xec, 0x2d, 0xfe, 0xb6, 0x0e, 0xc6, 0x43, 0x37,
+	0x3d, 0xf9, 0xa5, 0xb3, 0x90, 0xaf, 0x40, 0x27, 0x8e, 0xaa, 0xf6, 0x50, 0xa5, 0x6b, 0xc7, 0xd1,
+	0x2f, 0x85, 0xca, 0xff, 0x7e, 0x0d, 0x3a, 0xfb, 0x69, 0xec, 0xcf, 0xbc, 0xfc, 0x33, 0xea, 0xfb,
+	0x7c, 0x1f, 0x1b, 0x2f, 0xea, 0x63, 0x73, 0xb1, 0x8f, 0xf6, 0x1f, 0xd4, 0xc0, 0x54, 0x5d, 0x78,
+	0xb0, 0xfd, 0x05, 0x19, 0xdd, 0x8b, 0x7b, 0xf1, 0x0c, 0x4c, 0x8a, 0x13, 0x9d, 0xab, 0x46, 0xe7,
+	0xda, 0x4f, 0xfd, 0x33, 0xd9, 0x8f, 0xfd, 0x47, 0x35, 0xe8, 0x53, 0x50, 0xef, 0xfe, 0x2c, 0xf2,
+	0xe8, 0x8e, 0x63, 0x75, 0x54, 0x69, 0x03, 0x9a, 0x29, 0x46, 0xdc, 0xe4, 0x6f, 0x7a, 0x2a, 0x66,
+	0x1a, 0x87, 0x18, 0x31, 0x25, 0x0c, 0x4e, 0x82, 0x9b, 0x4e, 0xb2, 0x55, 0xd7, 0x98, 0x08, 0xc7,
+	0x51, 0xe1, 0xe5, 0xe9, 0x34, 0xd3, 0xd7, 0x98, 0xb2, 0x86, 0x57, 0xa2, 0x14, 0xd3, 0x6e, 0x51,
+	0x4c, 0x84, 0xca, 0xf6, 0x0e, 0x5c, 0xba, 0x77, 0x9a, 0x8b, 0x34, 0x72, 0x43, 0x8c, 0x90, 0x6c,
+	0x63, 0x6c, 0x92, 0xc2, 0x68, 0x9a, 0xb8, 0x56, 0x12, 0x63, 0x87, 0xab, 0x49, 0x1a, 0xb2, 0x62,
+	0xdf, 0x80, 0xee, 0x38, 0x08, 0x85, 0x13, 0x8f, 0xc7, 0x99, 0xc8, 0xf1, 0xef, 0xb2, 0x44, 0xc3,
+	0x6a, 0x70, 0x55, 0xb3, 0xff, 0xae, 0x09, 0x3d, 0xfd, 0x2b, 0xba, 0xc4, 0x5e, 0x3d, 0xfc, 0xd7,
+	0xc0, 0xa4, 0xd6, 0x32, 0xbc, 0x79, 0xac, 0x53, 0x0b, 0x06, 0x02, 0xe8, 0xd6, 0x71, 0x07, 0xd6,
+	0x2b, 0xbf, 0x72, 0xf2, 0x38, 0x77, 0x43, 0xab, 0xb1, 0x78, 0x1f, 0x55, 0x21, 0xe1, 0x6b, 0x58,
+	0x79, 0x44, 0xe5, 0x43, 0xa4, 0xc6, 0xe9, 0x2d, 0x82, 0x68, 0x4b, 0xd3, 0x8b, 0x18, 0xf6, 0x5d,
+	0x58, 0xc3, 0xd1, 0x6e, 0xcb, 0x18, 0x2e, 0x8d, 0x57, 0xda, 0xf5, 0xf5, 0xf2, 0x17, 0x2b, 0xe7,
+	0x8c, 0xf7, 0xa3, 0x6a, 0x15, 0x2d, 0xc6, 0x4b, 0x05, 0x46, 0xd9, 0xb2, 0x27, 0x21, 0xd9, 0xbc,
+	0xc9, 0x4d, 0x09, 0x39, 0x78, 0x12, 0x16, 0x23, 0x2d, 0x9c, 0xb2, 0x29, 0x47, 0x4a, 0x8a, 0xfe,
+	0x1e, 0x74, 0xe3, 0x34, 0x98, 0x04, 0x91, 0x0c, 0xf9, 0x19, 0x2b, 0x7a, 0x0b, 0x92, 0x80, 0x02,
+	0x80, 0x36, 0xb4, 0xa5, 0xa2, 0xae, 0x88, 0xca, 0x2b, 0x0c, 0xe3, 0x30, 0x38, 0x3c, 0xc2, 0xd0,
+	0x36, 0xe5, 0x02, 0xed, 0xc6, 0xa1, 0x0a, 0xcb, 0xdf, 0x5c, 0x1e, 0x16, 0xca, 0x67, 0x6b, 0x9e,
+	0x58, 0x06, 0xfd, 0x16, 0x5a, 0xc0, 0x2b, 0x9a, 0x2c, 0x4f, 0x03, 0x2f, 0xc7, 0x21, 0x3a, 0x53,
+	0x0c, 0x46, 0x77, 0xc9, 0x33, 0xf4, 0x25, 0xf8, 0xe0, 0x49, 0x88, 0x51, 0xe8, 0xab, 0x3b, 0x70,
+	0x71, 0x45, 0x73, 0x2f, 0x75, 0x4d, 0xe3, 0x01, 0x1c, 0xe4, 0xa9, 0x70, 0xa7, 0xa4, 0x3c, 0xef,
+	0x40, 0x27, 0x3f, 0x0a, 0xe9, 0x0e, 0xa6, 0xb6, 0xf2, 0x0e, 0xa6, 0x9d, 0x1f, 0xe1, 0x2c, 0x55,
+	0xd4, 0xb1, 0x4e, 0xb7, 0x21, 0xaa, 0x86, 0x3f, 0x0a, 0x83, 0x69, 0x90, 0xab, 0xac, 0x1f, 0x59,
+	0xb1, 0x3f, 0x00, 0x93, 0x5a, 0xa0, 0x7f, 0x14, 0x2b, 0x78, 0xed, 0xdc, 0x15, 0xdc, 0x7e, 0x17,
+	0xcc, 0xdf, 0xc6, 0x6e, 0x12, 0xd3, 0x75, 0xe8, 0xd2, 0x3d, 0x9d, 0x23, 0x23, 0xe1, 0x72, 0x68,
+	0x40, 0xa0, 0x3b, 0x08, 0xb1, 0x01, 0x8c, 0xc7, 0x51, 0x10, 0x47, 0x3b, 0x61, 0x68, 0xff, 0xb4,
+	0x0e, 0xe6, 0xf7, 0xdc, 0xec, 0x98, 0xbc, 0x04, 0x26, 0x11, 0xed, 0x09, 0xe1, 0x23, 0x00, 0xaf,
+	0xdb, 0x64, 0x7a, 0x41, 0x15, 0x84, 0x71, 0xc9, 0xef, 0xc9, 0x35, 0xe3, 0x07, 0x2a, 0xc6, 0x5e,
+	0xd4, 0x35, 0x37, 0xdd, 0x03, 0x0a, 0x7d, 0xdd, 0x5d, 0x05, 0xb1, 0x9b, 0x30, 0xc4, 0x2a, 0x5d,
+	0xe2, 0xa3, 0x0e, 0x8a, 0x50, 0x7a, 0x08, 0x83, 0x2f, 0xc1, 0xd9, 0x4d, 0x00, 0x5c, 0xdc, 0xe8,
+	0x86, 0x31, 0x5b, 0xb1, 0xae, 0x55, 0xb0, 0xec, 0x1a, 0xc0, 0xf7, 0x0b, 0x07, 0xab, 0x12, 0x64,
+	0x2a, 0x10, 0x4c, 0xa1, 0x52, 0x35, 0x2e, 0xc6, 0xbb, 0xea, 0x5e, 0xaa, 0xc5, 0xe7, 0x81, 0x98,
+	0xba, 0xc4, 0x5f, 0x3a, 0x75, 0x69, 0x09, 0x64, 0xff, 0x59, 0x1d, 0x7a, 0x6a, 0x4d, 0x22, 0x9f,
+	0x3d, 0x37, 0x67, 0xb5, 0xf3, 0xe7, 0xac, 0xfe, 0xe9, 0xe6, 0xac, 0xf1, 0xa9, 0xe6, 0xac, 0x79,
+	0xee, 0x9c, 0xad, 0x1c, 0x6d, 0xeb, 0x65, 0x47, 0xfb, 0xc2, 0xa9, 0xbf, 0x06, 0x70, 0x50, 0x6c,
+	0x02, 0xd4, 0xbc, 0x57, 0x20, 0xf6, 0x01, 0x00, 0xf9, 0x2a, 0x39, 0x55, 0x2b, 0x3b, 0x55, 0x7b,
+	0x69, 0x11, 0xfc, 0x77, 0x0d, 0xe0, 0xc0, 0x9d, 0x26, 0x72, 0xa9, 0x63, 0xdf, 0x81, 0x6e, 0x46,
+	0x35, 0x19, 0x53, 0x95, 0x09, 0x91, 0x15, 0x5f, 0x5a, 0x92, 0xaa, 0x22, 0x5a, 0x18, 0x87, 0xac,
+	0x28, 0xd3, 0xee, 0x46, 0xb6, 0x40, 0x97, 0xd5, 0x75, 0xb5, 0xbb, 0x21, 0x10, 0xdd, 0x53, 0xdf,
+	0x80, 0x81, 0x22, 0x48, 0x44, 0xea, 0x89, 0x48, 0x5a, 0x75, 0x8d, 0xf7, 0x25, 0x74, 0x5f, 0x02,
+	0xd9, 0xfb, 0x05, 0x99, 0x17, 0x87, 0xb3, 0xe9, 0x4a, 0x21, 0x29, 0x96, 0x5d, 0x49, 0x60, 0x6f,
+	0xeb, 0xa1, 0x50, 0x47, 0x0c, 0x68, 0xe2, 0xff, 0x86, 0x17, 0x58, 0x17, 0x3a, 0xaa, 0xd5, 0x61,
+	0x8d, 0xf5, 0xc1, 0xa4, 0x9c, 0x2d, 0xc2, 0xd5, 0xed, 0x9f, 0xaf, 0x43, 0x77, 0x14, 0x65, 0x79,
+	0x3a, 0x93, 0xeb, 0x7c, 0x99, 0x9a, 0xd4, 0xa2, 0xd4, 0x24, 0x75, 0x29, 0x2c, 0x87, 0x81, 0x45,
+	0xf6, 0x36, 0x34, 0xdd, 0x28, 0x0f, 0xd4, 0xb6, 0xa6, 0x92, 0xff, 0xa6, 0x8f, 0xec, 0x9c, 0xf0,
+	0xec, 0x3d, 0xe8, 0xa8, 0x64, 0x39, 0x95, 0xb0, 0xb2, 0x32, 0xd3, 0x4e, 0xd3, 0xb0, 0x2d, 0x30,
+	0x7c, 0x95, 0xc5, 0x67, 0xb5, 0x16, 0x9b, 0xd6, 0xf9, 0x7d, 0xbc, 0xa0, 0xc1, 0xec, 0x01, 0x77,
+	0x22, 0xd5, 0x88, 0xb2, 0x07, 0x34, 0x29, 0xe5, 0x3e, 0x71, 0xc4, 0xb1, 0x5b, 0x6a, 0x03, 0x8d,
+	0x3b, 0x2a, 0xcb, 0x58, 0x6c, 0x53, 0x87, 0x6b, 0xe5, 0x46, 0x1a, 0x4b, 0xc8, 0x90, 0x89, 0x69,
+	0x20, 0x19, 0xcc, 0x45, 0x06, 0x7d, 0xe4, 0xe5, 0x46, 0xa6, 0x4a, 0xec, 0x43, 0xe8, 0x66, 0x74,
+	0x36, 0x93, 0x2c, 0xa0, 0x6f, 0xee, 0x0a, 0x96, 0xe2, 0xe0, 0xc6, 0x21, 0x2b, 0xca, 0xf8, 0x9f,
+	0xa9, 0x9b, 0x9e, 0x48, 0xa6, 0xee, 0xe2, 0x7f, 0xf4, 0xc1, 0x81, 0x1b, 0x53, 0x55, 0xc2, 0xcb,
+	0x53, 0xa2, 0xed, 0xe9, 0xe5, 0x43, 0xd3, 0xca, 0xf9, 0x46, 0x1c, 0xfb, 0x1a, 0x74, 0x12, 0xb9,
+	0x63, 0xa5, 0xcc, 0x84, 0xee, 0xf6, 0x7a, 0x49, 0xa6, 0xb6, 0xb2, 0x5c, 0x53, 0xb0, 0x6f, 0xc3,
+	0x40, 0xde, 0x5b, 0x8f, 0xd5, 0x06, 0xcf, 0x1a, 0x68, 0xd3, 0xd1, 0x3c, 0x73, 0xfb, 0x3f, 0xde,
+	0xcf, 0xab, 0x55, 0xf6, 0x4d, 0xe8, 0x0b, 0xb5, 0xfe, 0x3a, 0x19, 0x66, 0xfd, 0x0d, 0x89, 0xfd,
+	0xf2, 0xea, 0xe5, 0x99, 0xf7, 0x44, 0xa5, 0xc6, 0x36, 0xa1, 0x2d, 0xef, 0x1c, 0xad, 0x75, 0xe2,
+	0xaa, 0x24, 0x13, 0xcb, 0x1b, 0x29, 0xae, 0xf0, 0xec, 0xce, 0xc2, 0x5d, 0x21, 0x2e, 0xc0, 0x8c,
+	0x78, 0xac, 0xe7, 0x5d, 0x00, 0xce, 0xdd, 0x22, 0xe2, 0x7d, 0xe8, 0x36, 0x40, 0x79, 0xc7, 0x6a,
+	0x5d, 0x5c, 0x54, 0xc5, 0xe2, 0x82, 0x95, 0x9b, 0xc5, 0xdd, 0x2a, 0x3a, 0x97, 0xea, 0x9d, 0xaf,
+	0xbc, 0x36, 0x7b, 0x85, 0x58, 0x5f, 0x5d, 0xc1, 0x2a, 0x6f, 0xcf, 0xf8, 0x5a, 0x32, 0x0f, 0x60,
+	0xef, 0x82, 0x11, 0x63, 0xfe, 0x9f, 0x73, 0x74, 0x66, 0x5d, 0x22, 0xeb, 0x5d, 0x57, 0x69, 0x2e,
+	0x32, 0xa3, 0x90, 0x9c, 0x52, 0x27, 0x96, 0x15, 0xf6, 0x1e, 0xa6, 0xb2, 0xc5, 0x98, 0xff, 0x22,
+	0xb7, 0x59, 0x97, 0x97, 0x33, 0x11, 0x15, 0x9e, 0x76, 0x5d, 0xe5, 0x36, 0xea, 0xca, 0x73, 0xb7,
+	0x51, 0x1b, 0x7a, 0xe3, 0x60, 0x2d, 0x91, 0x48, 0x04, 0xb6, 0xa2, 0xb6, 0x1c, 0xaf, 0x2e, 0xb7,
+	0x22, 0x31, 0x98, 0x7d, 0x14, 0x64, 0xf7, 0x83, 0x34, 0xcb, 0xad, 0xab, 0x32, 0x83, 0x53, 0x55,
+	0x71, 0xc3, 0x12, 0x64, 0x0f, 0xdc, 0x2c, 0xb7, 0x5e, 0xd3, 0xc9, 0xa4, 0x58, 0xc3, 0x39, 0x97,
+	0x27, 0x52, 0xd2, 0xda, 0xd7, 0x17, 0xe7, 0xbc, 0x08, 0xbb, 0xab, 0xa3, 0x29, 0x16, 0xd9, 0x47,
+	0xb0, 0x26, 0x79, 0x4a, 0x13, 0x7c, 0x63, 0x51, 0x27, 0xe7, 0xe2, 0xb7, 0xbc, 0x9f, 0x56, 0xab,
+	0x65, 0x03, 0xe8, 0x7e, 0x64, 0x03, 0xd7, 0x56, 0x36, 0x50, 0x38, 0xaa, 0x7e, 0x5a, 0xad, 0xb2,
+	0x9b, 0xd0, 0xf6, 0x65, 0x76, 0xd6, 0xf5, 0x25, 0x07, 0xa4, 0xb2, 0x87, 0xb8, 0xa2, 0x60, 0x5f,
+	0x85, 0x0e, 0xe5, 0x42, 0xc4, 0x89, 0xb5, 0xb1, 0xa8, 0xc4, 0x32, 0x23, 0x81, 0xb7, 0x43, 0xfa,
+	0xa2, 0x61, 0xea, 0x93, 0xe8, 0x9b, 0x8b, 0x86, 0xa9, 0x96, 0x37, 0xae, 0x29, 0xd8, 0x0d, 0x68,
+	0x4d, 0xd1, 0x3d, 0x5b, 0xf6, 0xa2, 0x63, 0x93, 0x5e, 0x5b, 0x62, 0xc9, 0xf1, 0xd0, 0x06, 0x53,
+	0x5a, 0xdf, 0x57, 0x96, 0x1c, 0x4f, 0xb1, 0xfb, 0xe4, 0x90, 0x15, 0x65, 0xf6, 0x7b, 0x70, 0xb5,
+	0x9a, 0x6f, 0xa0, 0x93, 0x11, 0xd4, 0xc9, 0xe1, 0x2d, 0x6a, 0xe5, 0xcd, 0x15, 0x0a, 0x3e, 0x9f,
+	0xb6, 0xc0, 0xaf, 0x24, 0xab, 0x11, 0xd4, 0x2d, 0xb9, 0x68, 0xa1, 0x5f, 0xb1, 0x6e, 0x2c, 0x75,
+	0xab, 0x58, 0x3e, 0xf5, 0x92, 0x88, 0x65, 0xf6, 0x0d, 0xe8, 0x8d, 0xf1, 0x7e, 0x5c, 0x1d, 0x60,
+	0xad, 0xb7, 0x37, 0x6a, 0xf3, 0xa7, 0xa4, 0xca, 0xed, 0x39, 0xef, 0x8e, 0xcb, 0x0a, 0x66, 0x09,
+	0x7b, 0x91, 0xe3, 0xfa, 0x7e, 0x6a, 0xbd, 0x23, 0x6f, 0xcf, 0xbd, 0x68, 0xc7, 0xf7, 0x29, 0x0d,
+	0x21, 0x4e, 0x04, 0x65, 0xe5, 0x62, 0x6e, 0xce, 0xa6, 0x5c, 0x86, 0x35, 0x68, 0xe4, 0x23, 0x01,
+	0x1e, 0x35, 0xc3, 0x50, 0x60, 0xf2, 0x8b, 0xf5, 0x55, 0x49, 0xa0, 0x41, 0x23, 0x1f, 0x73, 0xc1,
+	0xa6, 0xee, 0xa9, 0xa3, 0x21, 0xd6, 0x4d, 0xa2, 0xe8, 0x4e, 0xdd, 0xd3, 0x7d, 0x05, 0x42, 0x35,
+	0x97, 0x09, 0x6f, 0xa4, 0x6c, 0x5f, 0x5b, 0x54, 0xf3, 0xe2, 0xec, 0xce, 0xcd, 0x40, 0x17, 0xa5,
+	0x3b, 0x22, 0x27, 0xec, 0x84, 0xdb, 0xd6, 0xbb, 0xcb, 0xee, 0x48, 0x05, 0x1d, 0xd0, 0x1d, 0xa9,
+	0x22, 0xf2, 0x48, 0x6f, 0x4d, 0xc2, 0x7e, 0x6f, 0x91, 0xa7, 0x38, 0x05, 0x70, 0x33, 0xd7, 0x45,
+	0xe4, 0xa1, 0xf3, 0x88, 0xe4, 0xd9, 0x5a, 0xe4, 0x29, 0x0e, 0x01, 0xdc, 0x7c, 0xaa, 0x8b, 0xb8,
+	0x2e, 0xcd, 0xa2, 0x20, 0x8e, 0x1c, 0x37, 0x0c, 0xad, 0x5b, 0x8b, 0x36, 0xa0, 0x4f, 0x02, 0xdc,
+	0x98, 0xa9, 0x12, 0xfe, 0x84, 0x22, 0x45, 0xb4, 0x25, 0xb3, 0x6e, 0x2f, 0xfe, 0xa4, 0x38, 0x2e,
+	0x70, 0xf3, 0x58, 0x17, 0x71, 0xe9, 0xd0, 0xe1, 0x1f, 0xc9, 0xf6, 0xfe, 0xe2, 0xd2, 0x51, 0xdd,
+	0x12, 0x73, 0x9d, 0xd1, 0x2e, 0x99, 0x3f, 0x84, 0xae, 0x9c, 0x71, 0xc9, 0xba, 0xbd, 0xa8, 0x60,
+	0xe5, 0x06, 0x91, 0x4b, 0xd1, 0x10, 0x9b, 0xfd, 0x21, 0xf4, 0x76, 0xe8, 0xf1, 0x48, 0x90, 0x91,
+	0xef, 0xbc, 0x01, 0xcd, 0x22, 0x98, 0x53, 0x38, 0x65, 0xa2, 0xf8, 0x44, 0xe0, 0x03, 0x14, 0x4e,
+	0x68, 0xfb, 0x2f, 0x1a, 0xd0, 0x3e, 0x88, 0x67, 0xa9, 0x27, 0x5e, 0x9c, 0x6a, 0xf7, 0x86, 0x96,
+	0x51, 0x54, 0xa6, 0x72, 0x48, 0x71, 0x10, 0xba, 0x1a, 0x27, 0x6a, 0xd0, 0xf9, 0xb9, 0x88, 0x13,
+	0x15, 0x99, 0x48, 0x32, 0x9d, 0x5c, 0x56, 0x48, 0x3f, 0x67, 0xd9, 0xb1, 0x1f, 0x3f, 0xc3, 0x6c,
+	0x5a, 0xda, 0x12, 0x35, 0x39, 0x68, 0xd0, 0xc8, 0xa7, 0x7c, 0x5b, 0x4d, 0x40, 0x06, 0x20, 0x0f,
+	0xed, 0x3d, 0x0d, 0x24, 0x33, 0xd0, 0x31, 0xa8, 0xce, 0x73, 0x62, 0x50, 0x37, 0xa1, 0xc8, 0xff,
+	0xb3, 0x8c, 0x95, 0x67, 0xd3, 0x02, 0xcf, 0xb6, 0xc1, 0x2c, 0x9e, 0x16, 0xa9, 0xdd, 0xd1, 0x2b,
+	0x5b, 0x05, 0x64, 0xeb, 0x50, 0x97, 0x78, 0x49, 0xb6, 0x22, 0x38, 0x95, 0xa4, 0xf1, 0x91, 0x8a,
+	0x23, 0xc0, 0xcb, 0x04, 0xa7, 0xf6, 0x91, 0x4f, 0x87, 0xdc, 0x82, 0x0c, 0x63, 0x9d, 0x59, 0xae,
+	0x0e, 0xf0, 0x9d, 0x20, 0xdb, 0xc5, 0xaa, 0xfd, 0xbb, 0x60, 0xe0, 0x0b, 0x0c, 0x14, 0x21, 0x06,
+	0x85, 0xa6, 0x5e, 0x32, 0x53, 0x7b, 0x59, 0x2a, 0xab, 0x97, 0x43, 0x52, 0x38, 0xea, 0xe5, 0x10,
+	0x4d, 0x5d, 0x83, 0x20, 0x54, 0x96, 0xef, 0x15, 0xce, 0xc2, 0xd8, 0xf5, 0x95, 0x40, 0x74, 0xd5,
+	0xfe, 0xf3, 0x1a, 0xac, 0xef, 0xa7, 0xb1, 0x27, 0xb2, 0xec, 0x01, 0x2e, 0x9e, 0x2e, 0x6d, 0x85,
+	0x18, 0x34, 0x29, 0xfe, 0x23, 0xd3, 0xf9, 0xa9, 0x8c, 0xca, 0x20, 0x0f, 0xd6, 0xc5, 0x19, 0xa0,
+	0xc1, 0x4d, 0x82, 0xd0, 0x11, 0xa0, 0x40, 0x13, 0x63, 0xa3, 0x82, 0xa6, 0xc8, 0xd1, 0x0d, 0x18,
+	0x94, 0x19, 0xb5, 0xd4, 0x82, 0x7a, 0xc7, 0x53, 0x40, 0xa9, 0x95, 0xeb, 0xd0, 0x4d, 0x85, 0x8b,
+	0xdb, 0x0b, 0x6a, 0xa6, 0x45, 0x34, 0x20, 0x41, 0xd8, 0x8e, 0x7d, 0x0c, 0xc3, 0xfd, 0x54, 0x24,
+	0x6e, 0x2a, 0xd0, 0x63, 0x4d, 0x69, 0x56, 0x2e, 0x43, 0x3b, 0x14, 0xd1, 0x24, 0x3f, 0x56, 0xfd,
+	0x55, 0xb5, 0xe2, 0x9d, 0x56, 0xbd, 0xf2, 0x4e, 0x0b, 0x67, 0x27, 0x15, 0xae, 0x7a, 0xce, 0x45,
+	0x65, 0x54, 0xd6, 0x68, 0x16, 0xaa, 0x98, 0x94, 0xc1, 0x65, 0xc5, 0xfe, 0xd3, 0x06, 0x74, 0xd5,
+	0xcc, 0xd0, 0x5f, 0xe4, 0x3c, 0xd7, 0x8a, 0x79, 0x1e, 0x42, 0x03, 0xc3, 0x4a, 0x72, 0xe2, 0xb1,
+	0xc8, 0x3e, 0x80, 0x46, 0x18, 0x4c, 0xd5, 0x21, 0xe2, 0xb5, 0x39, 0xff, 0x37, 0x3f, 0xbf, 0x2a,
+	0x7c, 0x81, 0xd4, 0x18, 0x85, 0x9a, 0x45, 0xc1, 0xa9, 0x83, 0x5a, 0xa1, 0xe6, 0x04, 0x7d, 0xd1,
+	0x29, 0xaa, 0x1e, 0x4e, 0xaa, 0xeb, 0x51, 0x62, 0x9b, 0xb6, 0x97, 0x3e, 0x37, 0x15, 0x64, 0xe4,
+	0xb3, 0xaf, 0x83, 0x91, 0x45, 0x6e, 0x92, 0x1d, 0xc7, 0xb9, 0x3a, 0x34, 0xb0, 0x2d, 0x7c, 0x0c,
+	0xb7, 0xbb, 0x77, 0x78, 0x1a, 0x1d, 0x28, 0x8c, 0xfa, 0x59, 0x41, 0xc9, 0xbe, 0x0d, 0xbd, 0x4c,
+	0x64, 0x99, 0x4c, 0x6d, 0x1e, 0xc7, 0x56, 0x67, 0x71, 0x65, 0x3a, 0x90, 0x58, 0x1c, 0xb5, 0x62,
+	0xee, 0x66, 0x25, 0x88, 0x7d, 0x0f, 0x06, 0x9a, 0x3f, 0x8c, 0x27, 0x13, 0xa1, 0x93, 0x57, 0x5f,
+	0x5b, 0x6a, 0xe1, 0x01, 0xa1, 0x2b, 0xed, 0xf4, 0xb3, 0x2a, 0x82, 0x7d, 0x17, 0x1f, 0xcd, 0x91,
+	0x30, 0x1d, 0x15, 0x30, 0x95, 0x26, 0x78, 0x75, 0x6e, 0xb9, 0x9e, 0x13, 0x76, 0x99, 0x29, 0x5a,
+	0xc2, 0x33, 0xfb, 0x3f, 0x6b, 0xd0, 0xad, 0xf4, 0x9a, 0x5e, 0xcf, 0x65, 0x22, 0xd5, 0xc1, 0x53,
+	0x2c, 0x23, 0xec, 0x38, 0x56, 0x0f, 0x52, 0x4c, 0x4e, 0x65, 0x84, 0xa5, 0xb1, 0x8a, 0xa6, 0x9b,
+	0x9c, 0xca, 0xe8, 0x83, 0xd4, 0xf9, 0x4d, 0x26, 0xfd, 0x93, 0x50, 0x9a, 0xbc, 0x57, 0x02, 0x47,
+	0x14, 0xd4, 0x40, 0x75, 0x3a, 0x72, 0x33, 0x1d, 0xce, 0x2d, 0xea, 0x68, 0x6c, 0x4f, 0x45, 0x8a,
+	0x7d, 0x51, 0xee, 0x4b, 0x57, 0x51, 0xd6, 0xe4, 0x36, 0x3e, 0x89, 0x23, 0x79, 0xcb, 0xd0, 0xe3,
+	0x06, 0x02, 0x7e, 0x18, 0x47, 0xc4, 0xa6, 0x24, 0x4b, 0xf3, 0x69, 0x72, 0x5d, 0x45, 0xe7, 0xf0,
+	0x64, 0x26, 0x70, 0x4b, 0xe3, 0xd3, 0xcb, 0x0d, 0x93, 0x77, 0xa8, 0x3e, 0xf2, 0xed, 0x7f, 0xa9,
+	0xc1, 0xfa, 0xd2, 0x64, 0xe3, 0x0e, 0x02, 0x27, 0x5a, 0x27, 0xf0, 0xf6, 0x78, 0x1b, 0xab, 0x23,
+	0x9f, 0x10, 0xf9, 0x94, 0x94, 0xa9, 0xae, 0x10, 0xf9, 0x14, 0x35, 0xe9, 0x12, 0xb4, 0xf3, 0x53,
+	0x1a, 0xad, 0x34, 0x8c, 0x56, 0x7e, 0x8a, 0xc3, 0xdc, 0x01, 0x33, 0x8c, 0x27, 0x4e, 0x28, 0x9e,
+	0x8a, 0x90, 0xe6, 0x61, 0xb0, 0xfd, 0xd6, 0x39, 0x52, 0xde, 0x7a, 0x10, 0x4f, 0x1e, 0x20, 0x2d,
+	0x37, 0x42, 0x55, 0xb2, 0xbf, 0x0f, 0x86, 0x86, 0x32, 0x13, 0x5a, 0x77, 0xc5, 0xd1, 0x6c, 0x32,
+	0xbc, 0x80, 0x27, 0x79, 0xe4, 0x18, 0xd6, 0xb0, 0xf4, 0xb1, 0x9b, 0x46, 0xc3, 0x3a, 0xa2, 0xef,
+	0xa5, 0x69, 0x9c, 0x0e, 0x1b, 0x58, 0xdc, 0x77, 0xa3, 0xc0, 0x1b, 0x36, 0xb1, 0x78, 0xdf, 0xcd,
+	0xdd, 0x70, 0xd8, 0xb2, 0xff, 0xb2, 0x05, 0xc6, 0xbe, 0xfa, 0x3b, 0xbb, 0x0b, 0x7d, 0xdd, 0x93,
+	0xe7, 0x04, 0x36, 0xf6, 0x17, 0x0b, 0x14, 0xd8, 0xe8, 0x25, 0x95, 0xda, 0xe2, 0x13, 0xc9, 0xfa,
+	0xd2, 0x13, 0xc9, 0xd7, 0xa1, 0xf1, 0x24, 0x3d, 0x9b, 0xbf, 0xf0, 0xd8, 0x0f, 0xdd, 0x88, 0x23,
+	0x18, 0x6f, 0xe4, 0x51, 0xee, 0x4e, 0x46, 0x2b, 0xaa, 0xd5, 0x5c, 0xdc, 0x36, 0xcb, 0x95, 0x96,
+	0x03, 0x12, 0xc9, 0x32, 0x06, 0x05, 0xbc, 0xe3, 0x20, 0xf4, 0x53, 0x11, 0xa9, 0xb8, 0x1e, 0x5b,
+	0xee, 0x32, 0x2f, 0x68, 0xd8, 0x77, 0x28, 0xcb, 0x55, 0x07, 0x33, 0xaa, 0x97, 0xec, 0x97, 0xe6,
+	0xce, 0x98, 0x9a, 0x82, 0xaf, 0x55, 0xc8, 0x69, 0x71, 0x29, 0xd3, 0xfb, 0x3b, 0xd5, 0xf4, 0x7e,
+	0xf9, 0x6c, 0x8e, 0x16, 0x05, 0xa3, 0x38, 0xe9, 0xc4, 0x2e, 0xe6, 0xfd, 0x37, 0x23, 0x8c, 0x24,
+	0x2f, 0x45, 0x0b, 0xf4, 0x3a, 0xc4, 0x09, 0x4f, 0x6f, 0x5e, 0x67, 0xd9, 0xb1, 0x23, 0xd7, 0x73,
+	0x74, 0x25, 0xa0, 0x9e, 0xc7, 0xcc, 0xb2, 0xe3, 0xbb, 0xb8, 0xa2, 0xa3, 0x32, 0xde, 0x80, 0x81,
+	0x1e, 0x8b, 0xca, 0xd1, 0x95, 0x77, 0x8b, 0x7d, 0x0d, 0x95, 0x29, 0xba, 0x5b, 0x70, 0xd1, 0x3b,
+	0x76, 0xa3, 0x48, 0x84, 0xce, 0xd1, 0x6c, 0x3c, 0xd6, 0x2b, 0x40, 0x8f, 0xee, 0x85, 0xd6, 0x15,
+	0xea, 0x0e, 0x61, 0x68, 0x41, 0xb1, 0xa1, 0x1f, 0x05, 0xa1, 0x7c, 0x93, 0xe1, 0x78, 0x11, 0x06,
+	0x08, 0x90, 0xb2, 0x1b, 0x05, 0x21, 0xc5, 0x0e, 0x31, 0xa4, 0xf9, 0x11, 0x0c, 0xf1, 0xe1, 0x6c,
+	0xe6, 0xe4, 0xb1, 0x7e, 0x71, 0x68, 0x0d, 0x36, 0x1a, 0xf3, 0x3b, 0xb3, 0xc7, 0xb3, 0xc0, 0x3f,
+	0x8c, 0xd5, 0x9b, 0xc3, 0x3e, 0xd1, 0xeb, 0xaa, 0xfd, 0x11, 0xf4, 0xaa, 0xba, 0x83, 0xba, 0x48,
+	0x47, 0x96, 0xe1, 0x05, 0x06, 0xd0, 0xde, 0x8b, 0xd3, 0xa9, 0x1b, 0x0e, 0x6b, 0x58, 0x96, 0x8f,
+	0x5e, 0x86, 0x75, 0xd6, 0x03, 0x43, 0xef, 0xa5, 0x87, 0x0d, 0xfb, 0x9b, 0x60, 0xe8, 0x27, 0x94,
+	0x68, 0xfd, 0x38, 0x6d, 0x72, 0x63, 0x23, 0x3d, 0x93, 0x81, 0x00, 0xda, 0xd4, 0xe8, 0xf7, 0xbe,
+	0xf5, 0xf2, 0xbd, 0xaf, 0xfd, 0x5b, 0xd0, 0xab, 0x76, 0x4e, 0xc7, 0xad, 0x6a, 0x65, 0xdc, 0x6a,
+	0x05, 0x17, 0xfe, 0x66, 0x9c, 0xc6, 0x53, 0xa7, 0xb2, 0x09, 0x30, 0x10, 0x80, 0xbf, 0xb9, 0xf9,
+	0x04, 0xda, 0xf2, 0x6d, 0x33, 0x5b, 0x87, 0xfe, 0xe3, 0xe8, 0x24, 0x8a, 0x9f, 0x45, 0x12, 0x30,
+	0xbc, 0xc0, 0x2e, 0xc2, 0x9a, 0x1e, 0xad, 0x7a, 0x44, 0x3d, 0xac, 0xb1, 0x21, 0xf4, 0x68, 0x3e,
+	0x35, 0xa4, 0xce, 0x5e, 0x07, 0x4b, 0x79, 0xe5, 0xbb, 0x71, 0x24, 0xf6, 0xe2, 0x3c, 0x18, 0x9f,
+	0x69, 0x6c, 0x83, 0xad, 0x41, 0xf7, 0x20, 0x8f, 0x93, 0x03, 0x11, 0xf9, 0x41, 0x34, 0x19, 0x36,
+	0x6f, 0xde, 0x87, 0xb6, 0x7c, 0x72, 0x5d, 0xf9, 0xa5, 0x04, 0x0c, 0x2f, 0x20, 0x35, 0x66, 0xbe,
+	0x07, 0xd1, 0x64, 0x4f, 0x9c, 0xe6, 0xd2, 0x1b, 0xe0, 0x69, 0x7b, 0x58, 0x67, 0x03, 0x00, 0xd5,
+	0xea, 0xbd, 0xc8, 0x1f, 0x36, 0xee, 0xec, 0xfe, 0xe4, 0x67, 0xd7, 0x6a, 0x3f, 0xfd, 0xd9, 0xb5,
+	0xda, 0x3f, 0xfe, 0xec, 0xda, 0x85, 0x3f, 0xfe, 0xf9, 0xb5, 0xda, 0x0f, 0xdf, 0xaf, 0x3c, 0x28,
+	0x9f, 0xba, 0x79, 0x1a, 0x9c, 0xca, 0x1b, 0x19, 0x5d, 0x89, 0xc4, 0xad, 0xe4, 0x64, 0x72, 0x2b,
+	0x39, 0xba, 0xa5, 0x85, 0x7d, 0xd4, 0xa6, 0x77, 0xe2, 0x1f, 0xfc, 0xdf, 0x00, 0x87, 0x78, 0xfe,
+	0x5c, 0xa6, 0x3e, 0x00, 0x00,
 }
 
 func (m *Message) Marshal() (dAtA []byte, err error) {
@@ -6786,6 +6802,20 @@ func (m *PreInsert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Checks) > 0 {
+		for iNdEx := len(m.Checks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Checks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPipeline(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if m.ClusterByExpr != nil {
 		{
 			size, err := m.ClusterByExpr.MarshalToSizedBuffer(dAtA[:i])
@@ -6909,6 +6939,11 @@ func (m *LockTarget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.WaitPolicy != 0 {
+		i = encodeVarintPipeline(dAtA, i, uint64(m.WaitPolicy))
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.Mode != 0 {
 		i = encodeVarintPipeline(dAtA, i, uint64(m.Mode))
 		i--
@@ -11621,6 +11656,12 @@ func (m *PreInsert) ProtoSize() (n int) {
 		l = m.ClusterByExpr.ProtoSize()
 		n += 1 + l + sovPipeline(uint64(l))
 	}
+	if len(m.Checks) > 0 {
+		for _, e := range m.Checks {
+			l = e.ProtoSize()
+			n += 1 + l + sovPipeline(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11656,6 +11697,9 @@ func (m *LockTarget) ProtoSize() (n int) {
 	if m.Mode != 0 {
 		n += 1 + sovPipeline(uint64(m.Mode))
 	}
+	if m.WaitPolicy != 0 {
+		n += 1 + sovPipeline(uint64(m.WaitPolicy))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -16781,6 +16825,40 @@ func (m *PreInsert) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checks = append(m.Checks, &plan.CheckDef{})
+			if err := m.Checks[len(m.Checks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPipeline(dAtA[iNdEx:])
@@ -17000,6 +17078,25 @@ func (m *LockTarget) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitPolicy", wireType)
+			}
+			m.WaitPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WaitPolicy |= lock.WaitPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPipeline(dAtA[iNdEx:])
@@ -366,7 +366,7 @@ func (x ForeignKeyDef_RefAction) String() string {
 }
 
 func (ForeignKeyDef_RefAction) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{27, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{28, 0}
 }
 
 type OrderBySpec_OrderByFlag int32
@@ -403,7 +403,7 @@ func (x OrderBySpec_OrderByFlag) String() string {
 }
 
 func (OrderBySpec_OrderByFlag) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{45, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{47, 0}
 }
 
 type FrameClause_FrameType int32
@@ -428,7 +428,7 @@ func (x FrameClause_FrameType) String() string {
 }
 
 func (FrameClause_FrameType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{48, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{50, 0}
 }
 
 type FrameBound_BoundType int32
@@ -456,7 +456,7 @@ func (x FrameBound_BoundType) String() string {
 }
 
 func (FrameBound_BoundType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{49, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{51, 0}
 }
 
 type Node_NodeType int32
@@ -626,7 +626,7 @@ func (x Node_NodeType) String() string {
 }
 
 func (Node_NodeType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{56, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{58, 0}
 }
 
 type Node_JoinType int32
@@ -675,7 +675,7 @@ func (x Node_JoinType) String() string {
 }
 
 func (Node_JoinType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{56, 1}
+	return fileDescriptor_2d655ab2f7683c23, []int{58, 1}
 }
 
 type Node_AggMode int32
@@ -703,7 +703,7 @@ func (x Node_AggMode) String() string {
 }
 
 func (Node_AggMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{56, 2}
+	return fileDescriptor_2d655ab2f7683c23, []int{58, 2}
 }
 
 type Node_FillType int32
@@ -740,7 +740,7 @@ func (x Node_FillType) String() string {
 }
 
 func (Node_FillType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{56, 3}
+	return fileDescriptor_2d655ab2f7683c23, []int{58, 3}
 }
 
 type Query_StatementType int32
@@ -780,7 +780,7 @@ func (x Query_StatementType) String() string {
 }
 
 func (Query_StatementType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{68, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{70, 0}
 }
 
 type TransationControl_TclType int32
@@ -808,7 +808,7 @@ func (x TransationControl_TclType) String() string {
 }
 
 func (TransationControl_TclType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{69, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{72, 0}
 }
 
 type TransationBegin_TransationMode int32
@@ -836,7 +836,7 @@ func (x TransationBegin_TransationMode) String() string {
 }
 
 func (TransationBegin_TransationMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{70, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{73, 0}
 }
 
 type DataControl_DclType int32
@@ -885,7 +885,7 @@ func (x DataControl_DclType) String() string {
 }
 
 func (DataControl_DclType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{75, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{78, 0}
 }
 
 type DataDefinition_DdlType int32
@@ -1012,7 +1012,7 @@ func (x DataDefinition_DdlType) String() string {
 }
 
 func (DataDefinition_DdlType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{76, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{79, 0}
 }
 
 type AlterTableDrop_Typ int32
@@ -1023,6 +1023,7 @@ const (
 	AlterTableDrop_KEY         AlterTableDrop_Typ = 2
 	AlterTableDrop_PRIMARY_KEY AlterTableDrop_Typ = 3
 	AlterTableDrop_FOREIGN_KEY AlterTableDrop_Typ = 4
+	AlterTableDrop_CHECK       AlterTableDrop_Typ = 5
 )
 
 var AlterTableDrop_Typ_name = map[int32]string{
@@ -1031,6 +1032,7 @@ var AlterTableDrop_Typ_name = map[int32]string{
 	2: "KEY",
 	3: "PRIMARY_KEY",
 	4: "FOREIGN_KEY",
+	5: "CHECK",
 }
 
 var AlterTableDrop_Typ_value = map[string]int32{
@@ -1039,6 +1041,7 @@ var AlterTableDrop_Typ_value = map[string]int32{
 	"KEY":         2,
 	"PRIMARY_KEY": 3,
 	"FOREIGN_KEY": 4,
+	"CHECK":       5,
 }
 
 func (x AlterTableDrop_Typ) String() string {
@@ -1046,7 +1049,7 @@ func (x AlterTableDrop_Typ) String() string {
 }
 
 func (AlterTableDrop_Typ) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{84, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{87, 0}
 }
 
 type AlterTable_AlgorithmType int32
@@ -1077,7 +1080,7 @@ func (x AlterTable_AlgorithmType) String() string {
 }
 
 func (AlterTable_AlgorithmType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{96, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{102, 0}
 }
 
 type MetadataScanInfo_MetadataScanInfoType int32
@@ -1135,7 +1138,7 @@ func (x MetadataScanInfo_MetadataScanInfoType) String() string {
 }
 
 func (MetadataScanInfo_MetadataScanInfoType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{119, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{125, 0}
 }
 
 type Type struct {
@@ -3163,10 +3166,13 @@ type Default struct {
 	Expr         *Expr  `protobuf:"bytes,1,opt,name=expr,proto3" json:"expr,omitempty"`
 	OriginString string `protobuf:"bytes,2,opt,name=origin_string,json=originString,proto3" json:"origin_string,omitempty"`
 	// XXX: Deprecated and to be removed soon.
-	NullAbility          bool     `protobuf:"varint,3,opt,name=null_ability,json=nullAbility,proto3" json:"null_ability,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	NullAbility bool `protobuf:"varint,3,opt,name=null_ability,json=nullAbility,proto3" json:"null_ability,omitempty"`
+	// generated is set for the generated columns, it is kept with the
+	// default so that it is persisted together with the column.
+	Generated            *GeneratedCol `protobuf:"bytes,4,opt,name=generated,proto3" json:"generated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *Default) Reset()         { *m = Default{} }
@@ -3223,6 +3229,70 @@ func (m *Default) GetNullAbility() bool {
 	return false
 }
 
+func (m *Default) GetGenerated() *GeneratedCol {
+	if m != nil {
+		return m.Generated
+	}
+	return nil
+}
+
+type GeneratedCol struct {
+	// origin_string is bound on the columns of the table by name whenever
+	// the column is computed.
+	OriginString         string   `protobuf:"bytes,1,opt,name=origin_string,json=originString,proto3" json:"origin_string,omitempty"`
+	Stored               bool     `protobuf:"varint,2,opt,name=stored,proto3" json:"stored,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GeneratedCol) Reset()         { *m = GeneratedCol{} }
+func (m *GeneratedCol) String() string { return proto.CompactTextString(m) }
+func (*GeneratedCol) ProtoMessage()    {}
+func (*GeneratedCol) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{23}
+}
+func (m *GeneratedCol) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GeneratedCol) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GeneratedCol.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GeneratedCol) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GeneratedCol.Merge(m, src)
+}
+func (m *GeneratedCol) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *GeneratedCol) XXX_DiscardUnknown() {
+	xxx_messageInfo_GeneratedCol.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GeneratedCol proto.InternalMessageInfo
+
+func (m *GeneratedCol) GetOriginString() string {
+	if m != nil {
+		return m.OriginString
+	}
+	return ""
+}
+
+func (m *GeneratedCol) GetStored() bool {
+	if m != nil {
+		return m.Stored
+	}
+	return false
+}
+
 type OnUpdate struct {
 	Expr                 *Expr    `protobuf:"bytes,1,opt,name=expr,proto3" json:"expr,omitempty"`
 	OriginString         string   `protobuf:"bytes,2,opt,name=origin_string,json=originString,proto3" json:"origin_string,omitempty"`
@@ -3235,7 +3305,7 @@ func (m *OnUpdate) Reset()         { *m = OnUpdate{} }
 func (m *OnUpdate) String() string { return proto.CompactTextString(m) }
 func (*OnUpdate) ProtoMessage()    {}
 func (*OnUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{23}
+	return fileDescriptor_2d655ab2f7683c23, []int{24}
 }
 func (m *OnUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3289,7 +3359,7 @@ func (m *IndexOption) Reset()         { *m = IndexOption{} }
 func (m *IndexOption) String() string { return proto.CompactTextString(m) }
 func (*IndexOption) ProtoMessage()    {}
 func (*IndexOption) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{24}
+	return fileDescriptor_2d655ab2f7683c23, []int{25}
 }
 func (m *IndexOption) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3347,7 +3417,7 @@ func (m *PrimaryKeyDef) Reset()         { *m = PrimaryKeyDef{} }
 func (m *PrimaryKeyDef) String() string { return proto.CompactTextString(m) }
 func (*PrimaryKeyDef) ProtoMessage()    {}
 func (*PrimaryKeyDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{25}
+	return fileDescriptor_2d655ab2f7683c23, []int{26}
 }
 func (m *PrimaryKeyDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3444,7 +3514,7 @@ func (m *IndexDef) Reset()         { *m = IndexDef{} }
 func (m *IndexDef) String() string { return proto.CompactTextString(m) }
 func (*IndexDef) ProtoMessage()    {}
 func (*IndexDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{26}
+	return fileDescriptor_2d655ab2f7683c23, []int{27}
 }
 func (m *IndexDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3576,7 +3646,7 @@ func (m *ForeignKeyDef) Reset()         { *m = ForeignKeyDef{} }
 func (m *ForeignKeyDef) String() string { return proto.CompactTextString(m) }
 func (*ForeignKeyDef) ProtoMessage()    {}
 func (*ForeignKeyDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{27}
+	return fileDescriptor_2d655ab2f7683c23, []int{28}
 }
 func (m *ForeignKeyDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3648,9 +3718,15 @@ func (m *ForeignKeyDef) GetOnUpdate() ForeignKeyDef_RefAction {
 }
 
 type CheckDef struct {
+	// Name for anonymous constraints, [TABLE_NAME]_chk_[N]
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Name for anonymous constraints, __mo_chk_[INDEX_ID]
-	Check                *Expr    `protobuf:"bytes,2,opt,name=check,proto3" json:"check,omitempty"`
+	// check is only bound in PreInsertCtx, on the columns of the
+	// pre-insert batch.
+	Check *Expr `protobuf:"bytes,2,opt,name=check,proto3" json:"check,omitempty"`
+	// origin_string is bound on the columns of the table by name whenever
+	// the constraint is evaluated.
+	OriginString         string   `protobuf:"bytes,3,opt,name=origin_string,json=originString,proto3" json:"origin_string,omitempty"`
+	Enforced             bool     `protobuf:"varint,4,opt,name=enforced,proto3" json:"enforced,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3660,7 +3736,7 @@ func (m *CheckDef) Reset()         { *m = CheckDef{} }
 func (m *CheckDef) String() string { return proto.CompactTextString(m) }
 func (*CheckDef) ProtoMessage()    {}
 func (*CheckDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{28}
+	return fileDescriptor_2d655ab2f7683c23, []int{29}
 }
 func (m *CheckDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3703,6 +3779,113 @@ func (m *CheckDef) GetCheck() *Expr {
 	return nil
 }
 
+func (m *CheckDef) GetOriginString() string {
+	if m != nil {
+		return m.OriginString
+	}
+	return ""
+}
+
+func (m *CheckDef) GetEnforced() bool {
+	if m != nil {
+		return m.Enforced
+	}
+	return false
+}
+
+// TriggerDef is a row level trigger of the table, the triggers of a table
+// are kept in the order of their execution, see mo_catalog.mo_triggers.
+type TriggerDef struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// time is BEFORE or AFTER
+	Time string `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	// event is INSERT, UPDATE or DELETE
+	Event string `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
+	// body is the sql of the trigger body
+	Body string `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	// the trigger body is executed as the definer
+	DefinerUserId        uint32   `protobuf:"varint,5,opt,name=definer_user_id,json=definerUserId,proto3" json:"definer_user_id,omitempty"`
+	DefinerRoleId        uint32   `protobuf:"varint,6,opt,name=definer_role_id,json=definerRoleId,proto3" json:"definer_role_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TriggerDef) Reset()         { *m = TriggerDef{} }
+func (m *TriggerDef) String() string { return proto.CompactTextString(m) }
+func (*TriggerDef) ProtoMessage()    {}
+func (*TriggerDef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{30}
+}
+func (m *TriggerDef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TriggerDef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TriggerDef.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TriggerDef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerDef.Merge(m, src)
+}
+func (m *TriggerDef) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *TriggerDef) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerDef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerDef proto.InternalMessageInfo
+
+func (m *TriggerDef) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TriggerDef) GetTime() string {
+	if m != nil {
+		return m.Time
+	}
+	return ""
+}
+
+func (m *TriggerDef) GetEvent() string {
+	if m != nil {
+		return m.Event
+	}
+	return ""
+}
+
+func (m *TriggerDef) GetBody() string {
+	if m != nil {
+		return m.Body
+	}
+	return ""
+}
+
+func (m *TriggerDef) GetDefinerUserId() uint32 {
+	if m != nil {
+		return m.DefinerUserId
+	}
+	return 0
+}
+
+func (m *TriggerDef) GetDefinerRoleId() uint32 {
+	if m != nil {
+		return m.DefinerRoleId
+	}
+	return 0
+}
+
 type ClusterByDef struct {
 	// XXX: Deprecated and to be removed soon. letter case: lower ?
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -3717,7 +3900,7 @@ func (m *ClusterByDef) Reset()         { *m = ClusterByDef{} }
 func (m *ClusterByDef) String() string { return proto.CompactTextString(m) }
 func (*ClusterByDef) ProtoMessage()    {}
 func (*ClusterByDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{29}
+	return fileDescriptor_2d655ab2f7683c23, []int{31}
 }
 func (m *ClusterByDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3772,7 +3955,7 @@ func (m *PropertyDef) Reset()         { *m = PropertyDef{} }
 func (m *PropertyDef) String() string { return proto.CompactTextString(m) }
 func (*PropertyDef) ProtoMessage()    {}
 func (*PropertyDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{30}
+	return fileDescriptor_2d655ab2f7683c23, []int{32}
 }
 func (m *PropertyDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3827,7 +4010,7 @@ func (m *Property) Reset()         { *m = Property{} }
 func (m *Property) String() string { return proto.CompactTextString(m) }
 func (*Property) ProtoMessage()    {}
 func (*Property) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{31}
+	return fileDescriptor_2d655ab2f7683c23, []int{33}
 }
 func (m *Property) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3881,7 +4064,7 @@ func (m *PropertiesDef) Reset()         { *m = PropertiesDef{} }
 func (m *PropertiesDef) String() string { return proto.CompactTextString(m) }
 func (*PropertiesDef) ProtoMessage()    {}
 func (*PropertiesDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{32}
+	return fileDescriptor_2d655ab2f7683c23, []int{34}
 }
 func (m *PropertiesDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3937,7 +4120,7 @@ func (m *PartitionByDef) Reset()         { *m = PartitionByDef{} }
 func (m *PartitionByDef) String() string { return proto.CompactTextString(m) }
 func (*PartitionByDef) ProtoMessage()    {}
 func (*PartitionByDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{33}
+	return fileDescriptor_2d655ab2f7683c23, []int{35}
 }
 func (m *PartitionByDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4048,7 +4231,7 @@ func (m *PartitionExpr) Reset()         { *m = PartitionExpr{} }
 func (m *PartitionExpr) String() string { return proto.CompactTextString(m) }
 func (*PartitionExpr) ProtoMessage()    {}
 func (*PartitionExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{34}
+	return fileDescriptor_2d655ab2f7683c23, []int{36}
 }
 func (m *PartitionExpr) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4104,7 +4287,7 @@ func (m *PartitionColumns) Reset()         { *m = PartitionColumns{} }
 func (m *PartitionColumns) String() string { return proto.CompactTextString(m) }
 func (*PartitionColumns) ProtoMessage()    {}
 func (*PartitionColumns) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{35}
+	return fileDescriptor_2d655ab2f7683c23, []int{37}
 }
 func (m *PartitionColumns) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4164,7 +4347,7 @@ func (m *PartitionItem) Reset()         { *m = PartitionItem{} }
 func (m *PartitionItem) String() string { return proto.CompactTextString(m) }
 func (*PartitionItem) ProtoMessage()    {}
 func (*PartitionItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{36}
+	return fileDescriptor_2d655ab2f7683c23, []int{38}
 }
 func (m *PartitionItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4253,7 +4436,7 @@ func (m *ViewDef) Reset()         { *m = ViewDef{} }
 func (m *ViewDef) String() string { return proto.CompactTextString(m) }
 func (*ViewDef) ProtoMessage()    {}
 func (*ViewDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{37}
+	return fileDescriptor_2d655ab2f7683c23, []int{39}
 }
 func (m *ViewDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4303,6 +4486,7 @@ type TableDef struct {
 	Fkeys        []*ForeignKeyDef `protobuf:"bytes,13,rep,name=fkeys,proto3" json:"fkeys,omitempty"`
 	RefChildTbls []uint64         `protobuf:"varint,14,rep,packed,name=ref_child_tbls,json=refChildTbls,proto3" json:"ref_child_tbls,omitempty"`
 	Checks       []*CheckDef      `protobuf:"bytes,15,rep,name=checks,proto3" json:"checks,omitempty"`
+	Triggers     []*TriggerDef    `protobuf:"bytes,16,rep,name=triggers,proto3" json:"triggers,omitempty"`
 	Partition    *PartitionByDef  `protobuf:"bytes,21,opt,name=partition,proto3" json:"partition,omitempty"`
 	ClusterBy    *ClusterByDef    `protobuf:"bytes,22,opt,name=cluster_by,json=clusterBy,proto3" json:"cluster_by,omitempty"`
 	Props        []*PropertyDef   `protobuf:"bytes,23,rep,name=props,proto3" json:"props,omitempty"`
@@ -4326,7 +4510,7 @@ func (m *TableDef) Reset()         { *m = TableDef{} }
 func (m *TableDef) String() string { return proto.CompactTextString(m) }
 func (*TableDef) ProtoMessage()    {}
 func (*TableDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{38}
+	return fileDescriptor_2d655ab2f7683c23, []int{40}
 }
 func (m *TableDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4446,6 +4630,13 @@ func (m *TableDef) GetChecks() []*CheckDef {
 	return nil
 }
 
+func (m *TableDef) GetTriggers() []*TriggerDef {
+	if m != nil {
+		return m.Triggers
+	}
+	return nil
+}
+
 func (m *TableDef) GetPartition() *PartitionByDef {
 	if m != nil {
 		return m.Partition
@@ -4545,7 +4736,7 @@ func (m *TableDef_DefType) Reset()         { *m = TableDef_DefType{} }
 func (m *TableDef_DefType) String() string { return proto.CompactTextString(m) }
 func (*TableDef_DefType) ProtoMessage()    {}
 func (*TableDef_DefType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{38, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{40, 0}
 }
 func (m *TableDef_DefType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4619,7 +4810,7 @@ func (m *TableFunction) Reset()         { *m = TableFunction{} }
 func (m *TableFunction) String() string { return proto.CompactTextString(m) }
 func (*TableFunction) ProtoMessage()    {}
 func (*TableFunction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{39}
+	return fileDescriptor_2d655ab2f7683c23, []int{41}
 }
 func (m *TableFunction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4688,7 +4879,7 @@ func (m *HashMapStats) Reset()         { *m = HashMapStats{} }
 func (m *HashMapStats) String() string { return proto.CompactTextString(m) }
 func (*HashMapStats) ProtoMessage()    {}
 func (*HashMapStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{40}
+	return fileDescriptor_2d655ab2f7683c23, []int{42}
 }
 func (m *HashMapStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4819,7 +5010,7 @@ func (m *Stats) Reset()         { *m = Stats{} }
 func (m *Stats) String() string { return proto.CompactTextString(m) }
 func (*Stats) ProtoMessage()    {}
 func (*Stats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{41}
+	return fileDescriptor_2d655ab2f7683c23, []int{43}
 }
 func (m *Stats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4917,7 +5108,7 @@ func (m *RowsetExpr) Reset()         { *m = RowsetExpr{} }
 func (m *RowsetExpr) String() string { return proto.CompactTextString(m) }
 func (*RowsetExpr) ProtoMessage()    {}
 func (*RowsetExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{42}
+	return fileDescriptor_2d655ab2f7683c23, []int{44}
 }
 func (m *RowsetExpr) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4978,7 +5169,7 @@ func (m *ColData) Reset()         { *m = ColData{} }
 func (m *ColData) String() string { return proto.CompactTextString(m) }
 func (*ColData) ProtoMessage()    {}
 func (*ColData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{43}
+	return fileDescriptor_2d655ab2f7683c23, []int{45}
 }
 func (m *ColData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5026,7 +5217,7 @@ func (m *RowsetData) Reset()         { *m = RowsetData{} }
 func (m *RowsetData) String() string { return proto.CompactTextString(m) }
 func (*RowsetData) ProtoMessage()    {}
 func (*RowsetData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{44}
+	return fileDescriptor_2d655ab2f7683c23, []int{46}
 }
 func (m *RowsetData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5082,7 +5273,7 @@ func (m *OrderBySpec) Reset()         { *m = OrderBySpec{} }
 func (m *OrderBySpec) String() string { return proto.CompactTextString(m) }
 func (*OrderBySpec) ProtoMessage()    {}
 func (*OrderBySpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{45}
+	return fileDescriptor_2d655ab2f7683c23, []int{47}
 }
 func (m *OrderBySpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5147,7 +5338,7 @@ func (m *WindowSpec) Reset()         { *m = WindowSpec{} }
 func (m *WindowSpec) String() string { return proto.CompactTextString(m) }
 func (*WindowSpec) ProtoMessage()    {}
 func (*WindowSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{46}
+	return fileDescriptor_2d655ab2f7683c23, []int{48}
 }
 func (m *WindowSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5224,7 +5415,7 @@ func (m *SampleFuncSpec) Reset()         { *m = SampleFuncSpec{} }
 func (m *SampleFuncSpec) String() string { return proto.CompactTextString(m) }
 func (*SampleFuncSpec) ProtoMessage()    {}
 func (*SampleFuncSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{47}
+	return fileDescriptor_2d655ab2f7683c23, []int{49}
 }
 func (m *SampleFuncSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5287,7 +5478,7 @@ func (m *FrameClause) Reset()         { *m = FrameClause{} }
 func (m *FrameClause) String() string { return proto.CompactTextString(m) }
 func (*FrameClause) ProtoMessage()    {}
 func (*FrameClause) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{48}
+	return fileDescriptor_2d655ab2f7683c23, []int{50}
 }
 func (m *FrameClause) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5350,7 +5541,7 @@ func (m *FrameBound) Reset()         { *m = FrameBound{} }
 func (m *FrameBound) String() string { return proto.CompactTextString(m) }
 func (*FrameBound) ProtoMessage()    {}
 func (*FrameBound) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{49}
+	return fileDescriptor_2d655ab2f7683c23, []int{51}
 }
 func (m *FrameBound) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5421,7 +5612,7 @@ func (m *OnDuplicateKeyCtx) Reset()         { *m = OnDuplicateKeyCtx{} }
 func (m *OnDuplicateKeyCtx) String() string { return proto.CompactTextString(m) }
 func (*OnDuplicateKeyCtx) ProtoMessage()    {}
 func (*OnDuplicateKeyCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{50}
+	return fileDescriptor_2d655ab2f7683c23, []int{52}
 }
 func (m *OnDuplicateKeyCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5538,7 +5729,7 @@ func (m *InsertCtx) Reset()         { *m = InsertCtx{} }
 func (m *InsertCtx) String() string { return proto.CompactTextString(m) }
 func (*InsertCtx) ProtoMessage()    {}
 func (*InsertCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{51}
+	return fileDescriptor_2d655ab2f7683c23, []int{53}
 }
 func (m *InsertCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5636,7 +5827,7 @@ func (m *ReplaceCtx) Reset()         { *m = ReplaceCtx{} }
 func (m *ReplaceCtx) String() string { return proto.CompactTextString(m) }
 func (*ReplaceCtx) ProtoMessage()    {}
 func (*ReplaceCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{52}
+	return fileDescriptor_2d655ab2f7683c23, []int{54}
 }
 func (m *ReplaceCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5755,7 +5946,7 @@ func (m *AnalyzeInfo) Reset()         { *m = AnalyzeInfo{} }
 func (m *AnalyzeInfo) String() string { return proto.CompactTextString(m) }
 func (*AnalyzeInfo) ProtoMessage()    {}
 func (*AnalyzeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{53}
+	return fileDescriptor_2d655ab2f7683c23, []int{55}
 }
 func (m *AnalyzeInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5915,7 +6106,7 @@ func (m *PartitionPrune) Reset()         { *m = PartitionPrune{} }
 func (m *PartitionPrune) String() string { return proto.CompactTextString(m) }
 func (*PartitionPrune) ProtoMessage()    {}
 func (*PartitionPrune) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{54}
+	return fileDescriptor_2d655ab2f7683c23, []int{56}
 }
 func (m *PartitionPrune) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5970,7 +6161,7 @@ func (m *OriginTableMessageForFuzzy) Reset()         { *m = OriginTableMessageFo
 func (m *OriginTableMessageForFuzzy) String() string { return proto.CompactTextString(m) }
 func (*OriginTableMessageForFuzzy) ProtoMessage()    {}
 func (*OriginTableMessageForFuzzy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{55}
+	return fileDescriptor_2d655ab2f7683c23, []int{57}
 }
 func (m *OriginTableMessageForFuzzy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6092,7 +6283,7 @@ func (m *Node) Reset()         { *m = Node{} }
 func (m *Node) String() string { return proto.CompactTextString(m) }
 func (*Node) ProtoMessage()    {}
 func (*Node) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{56}
+	return fileDescriptor_2d655ab2f7683c23, []int{58}
 }
 func (m *Node) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6535,7 +6726,7 @@ func (m *Snapshot) Reset()         { *m = Snapshot{} }
 func (m *Snapshot) String() string { return proto.CompactTextString(m) }
 func (*Snapshot) ProtoMessage()    {}
 func (*Snapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{57}
+	return fileDescriptor_2d655ab2f7683c23, []int{59}
 }
 func (m *Snapshot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6591,7 +6782,7 @@ func (m *SnapshotTenant) Reset()         { *m = SnapshotTenant{} }
 func (m *SnapshotTenant) String() string { return proto.CompactTextString(m) }
 func (*SnapshotTenant) ProtoMessage()    {}
 func (*SnapshotTenant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{58}
+	return fileDescriptor_2d655ab2f7683c23, []int{60}
 }
 func (m *SnapshotTenant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6653,7 +6844,7 @@ func (m *ExternScan) Reset()         { *m = ExternScan{} }
 func (m *ExternScan) String() string { return proto.CompactTextString(m) }
 func (*ExternScan) ProtoMessage()    {}
 func (*ExternScan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{59}
+	return fileDescriptor_2d655ab2f7683c23, []int{61}
 }
 func (m *ExternScan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6746,26 +6937,27 @@ func (m *ExternScan) GetTbColToDataCol() map[string]int32 {
 }
 
 type LockTarget struct {
-	TableId              uint64        `protobuf:"varint,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
-	PrimaryColIdxInBat   int32         `protobuf:"varint,2,opt,name=primary_col_idx_in_bat,json=primaryColIdxInBat,proto3" json:"primary_col_idx_in_bat,omitempty"`
-	PrimaryColTyp        Type          `protobuf:"bytes,3,opt,name=primary_col_typ,json=primaryColTyp,proto3" json:"primary_col_typ"`
-	RefreshTsIdxInBat    int32         `protobuf:"varint,4,opt,name=refresh_ts_idx_in_bat,json=refreshTsIdxInBat,proto3" json:"refresh_ts_idx_in_bat,omitempty"`
-	FilterColIdxInBat    int32         `protobuf:"varint,5,opt,name=filter_col_idx_in_bat,json=filterColIdxInBat,proto3" json:"filter_col_idx_in_bat,omitempty"`
-	LockTable            bool          `protobuf:"varint,6,opt,name=lock_table,json=lockTable,proto3" json:"lock_table,omitempty"`
-	IsPartitionTable     bool          `protobuf:"varint,7,opt,name=is_partition_table,json=isPartitionTable,proto3" json:"is_partition_table,omitempty"`
-	PartitionTableIds    []uint64      `protobuf:"varint,8,rep,packed,name=partition_table_ids,json=partitionTableIds,proto3" json:"partition_table_ids,omitempty"`
-	Block                bool          `protobuf:"varint,9,opt,name=block,proto3" json:"block,omitempty"`
-	Mode                 lock.LockMode `protobuf:"varint,10,opt,name=Mode,proto3,enum=lock.LockMode" json:"Mode,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	TableId              uint64          `protobuf:"varint,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	PrimaryColIdxInBat   int32           `protobuf:"varint,2,opt,name=primary_col_idx_in_bat,json=primaryColIdxInBat,proto3" json:"primary_col_idx_in_bat,omitempty"`
+	PrimaryColTyp        Type            `protobuf:"bytes,3,opt,name=primary_col_typ,json=primaryColTyp,proto3" json:"primary_col_typ"`
+	RefreshTsIdxInBat    int32           `protobuf:"varint,4,opt,name=refresh_ts_idx_in_bat,json=refreshTsIdxInBat,proto3" json:"refresh_ts_idx_in_bat,omitempty"`
+	FilterColIdxInBat    int32           `protobuf:"varint,5,opt,name=filter_col_idx_in_bat,json=filterColIdxInBat,proto3" json:"filter_col_idx_in_bat,omitempty"`
+	LockTable            bool            `protobuf:"varint,6,opt,name=lock_table,json=lockTable,proto3" json:"lock_table,omitempty"`
+	IsPartitionTable     bool            `protobuf:"varint,7,opt,name=is_partition_table,json=isPartitionTable,proto3" json:"is_partition_table,omitempty"`
+	PartitionTableIds    []uint64        `protobuf:"varint,8,rep,packed,name=partition_table_ids,json=partitionTableIds,proto3" json:"partition_table_ids,omitempty"`
+	Block                bool            `protobuf:"varint,9,opt,name=block,proto3" json:"block,omitempty"`
+	Mode                 lock.LockMode   `protobuf:"varint,10,opt,name=Mode,proto3,enum=lock.LockMode" json:"Mode,omitempty"`
+	WaitPolicy           lock.WaitPolicy `protobuf:"varint,11,opt,name=wait_policy,json=waitPolicy,proto3,enum=lock.WaitPolicy" json:"wait_policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *LockTarget) Reset()         { *m = LockTarget{} }
 func (m *LockTarget) String() string { return proto.CompactTextString(m) }
 func (*LockTarget) ProtoMessage()    {}
 func (*LockTarget) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{60}
+	return fileDescriptor_2d655ab2f7683c23, []int{62}
 }
 func (m *LockTarget) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6864,6 +7056,13 @@ func (m *LockTarget) GetMode() lock.LockMode {
 	return lock.LockMode_Exclusive
 }
 
+func (m *LockTarget) GetWaitPolicy() lock.WaitPolicy {
+	if m != nil {
+		return m.WaitPolicy
+	}
+	return lock.WaitPolicy_Wait
+}
+
 type PreInsertUkCtx struct {
 	// index of columns(parts of unique key) in pre batch
 	Columns              []int32  `protobuf:"varint,1,rep,packed,name=columns,proto3" json:"columns,omitempty"`
@@ -6879,7 +7078,7 @@ func (m *PreInsertUkCtx) Reset()         { *m = PreInsertUkCtx{} }
 func (m *PreInsertUkCtx) String() string { return proto.CompactTextString(m) }
 func (*PreInsertUkCtx) ProtoMessage()    {}
 func (*PreInsertUkCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{61}
+	return fileDescriptor_2d655ab2f7683c23, []int{63}
 }
 func (m *PreInsertUkCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6948,7 +7147,7 @@ func (m *PreDeleteCtx) Reset()         { *m = PreDeleteCtx{} }
 func (m *PreDeleteCtx) String() string { return proto.CompactTextString(m) }
 func (*PreDeleteCtx) ProtoMessage()    {}
 func (*PreDeleteCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{62}
+	return fileDescriptor_2d655ab2f7683c23, []int{64}
 }
 func (m *PreDeleteCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6985,22 +7184,23 @@ func (m *PreDeleteCtx) GetIdx() []int32 {
 }
 
 type PreInsertCtx struct {
-	Ref                  *ObjectRef `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
-	TableDef             *TableDef  `protobuf:"bytes,2,opt,name=table_def,json=tableDef,proto3" json:"table_def,omitempty"`
-	HasAutoCol           bool       `protobuf:"varint,3,opt,name=has_auto_col,json=hasAutoCol,proto3" json:"has_auto_col,omitempty"`
-	IsUpdate             bool       `protobuf:"varint,4,opt,name=is_update,json=isUpdate,proto3" json:"is_update,omitempty"`
-	CompPkeyExpr         *Expr      `protobuf:"bytes,5,opt,name=comp_pkey_expr,json=compPkeyExpr,proto3" json:"comp_pkey_expr,omitempty"`
-	ClusterByExpr        *Expr      `protobuf:"bytes,6,opt,name=cluster_by_expr,json=clusterByExpr,proto3" json:"cluster_by_expr,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	Ref                  *ObjectRef  `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
+	TableDef             *TableDef   `protobuf:"bytes,2,opt,name=table_def,json=tableDef,proto3" json:"table_def,omitempty"`
+	HasAutoCol           bool        `protobuf:"varint,3,opt,name=has_auto_col,json=hasAutoCol,proto3" json:"has_auto_col,omitempty"`
+	IsUpdate             bool        `protobuf:"varint,4,opt,name=is_update,json=isUpdate,proto3" json:"is_update,omitempty"`
+	CompPkeyExpr         *Expr       `protobuf:"bytes,5,opt,name=comp_pkey_expr,json=compPkeyExpr,proto3" json:"comp_pkey_expr,omitempty"`
+	ClusterByExpr        *Expr       `protobuf:"bytes,6,opt,name=cluster_by_expr,json=clusterByExpr,proto3" json:"cluster_by_expr,omitempty"`
+	Checks               []*CheckDef `protobuf:"bytes,7,rep,name=checks,proto3" json:"checks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *PreInsertCtx) Reset()         { *m = PreInsertCtx{} }
 func (m *PreInsertCtx) String() string { return proto.CompactTextString(m) }
 func (*PreInsertCtx) ProtoMessage()    {}
 func (*PreInsertCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{63}
+	return fileDescriptor_2d655ab2f7683c23, []int{65}
 }
 func (m *PreInsertCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7071,6 +7271,13 @@ func (m *PreInsertCtx) GetClusterByExpr() *Expr {
 	return nil
 }
 
+func (m *PreInsertCtx) GetChecks() []*CheckDef {
+	if m != nil {
+		return m.Checks
+	}
+	return nil
+}
+
 type RuntimeFilterSpec struct {
 	Tag                  int32    `protobuf:"varint,1,opt,name=tag,proto3" json:"tag,omitempty"`
 	MatchPrefix          bool     `protobuf:"varint,2,opt,name=match_prefix,json=matchPrefix,proto3" json:"match_prefix,omitempty"`
@@ -7085,7 +7292,7 @@ func (m *RuntimeFilterSpec) Reset()         { *m = RuntimeFilterSpec{} }
 func (m *RuntimeFilterSpec) String() string { return proto.CompactTextString(m) }
 func (*RuntimeFilterSpec) ProtoMessage()    {}
 func (*RuntimeFilterSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{64}
+	return fileDescriptor_2d655ab2f7683c23, []int{66}
 }
 func (m *RuntimeFilterSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7153,7 +7360,7 @@ func (m *IdList) Reset()         { *m = IdList{} }
 func (m *IdList) String() string { return proto.CompactTextString(m) }
 func (*IdList) ProtoMessage()    {}
 func (*IdList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{65}
+	return fileDescriptor_2d655ab2f7683c23, []int{67}
 }
 func (m *IdList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7200,7 +7407,7 @@ func (m *ColPosMap) Reset()         { *m = ColPosMap{} }
 func (m *ColPosMap) String() string { return proto.CompactTextString(m) }
 func (*ColPosMap) ProtoMessage()    {}
 func (*ColPosMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{66}
+	return fileDescriptor_2d655ab2f7683c23, []int{68}
 }
 func (m *ColPosMap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7258,7 +7465,7 @@ func (m *DeleteCtx) Reset()         { *m = DeleteCtx{} }
 func (m *DeleteCtx) String() string { return proto.CompactTextString(m) }
 func (*DeleteCtx) ProtoMessage()    {}
 func (*DeleteCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{67}
+	return fileDescriptor_2d655ab2f7683c23, []int{69}
 }
 func (m *DeleteCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7381,17 +7588,20 @@ type Query struct {
 	// load write S3
 	LoadWriteS3 bool `protobuf:"varint,7,opt,name=loadWriteS3,proto3" json:"loadWriteS3,omitempty"`
 	// detectSqls are sqls detect fk self refer constraint
-	DetectSqls           []string `protobuf:"bytes,8,rep,name=detectSqls,proto3" json:"detectSqls,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	DetectSqls []string `protobuf:"bytes,8,rep,name=detectSqls,proto3" json:"detectSqls,omitempty"`
+	// rowTriggers are the procedural row triggers which are executed for
+	// every row produced by their step after the query is done.
+	RowTriggers          []*RowTrigger `protobuf:"bytes,9,rep,name=rowTriggers,proto3" json:"rowTriggers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *Query) Reset()         { *m = Query{} }
 func (m *Query) String() string { return proto.CompactTextString(m) }
 func (*Query) ProtoMessage()    {}
 func (*Query) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{68}
+	return fileDescriptor_2d655ab2f7683c23, []int{70}
 }
 func (m *Query) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7476,6 +7686,127 @@ func (m *Query) GetDetectSqls() []string {
 	return nil
 }
 
+func (m *Query) GetRowTriggers() []*RowTrigger {
+	if m != nil {
+		return m.RowTriggers
+	}
+	return nil
+}
+
+// RowTrigger is a trigger whose body can not be compiled into the plan.
+// The step whose root is node_id outputs the OLD columns and then the NEW
+// columns of every affected row.
+type RowTrigger struct {
+	DbName               string   `protobuf:"bytes,1,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	TableName            string   `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	TriggerName          string   `protobuf:"bytes,3,opt,name=trigger_name,json=triggerName,proto3" json:"trigger_name,omitempty"`
+	Body                 string   `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	NodeId               int32    `protobuf:"varint,5,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	OldCols              []string `protobuf:"bytes,6,rep,name=old_cols,json=oldCols,proto3" json:"old_cols,omitempty"`
+	NewCols              []string `protobuf:"bytes,7,rep,name=new_cols,json=newCols,proto3" json:"new_cols,omitempty"`
+	DefinerUserId        uint32   `protobuf:"varint,8,opt,name=definer_user_id,json=definerUserId,proto3" json:"definer_user_id,omitempty"`
+	DefinerRoleId        uint32   `protobuf:"varint,9,opt,name=definer_role_id,json=definerRoleId,proto3" json:"definer_role_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RowTrigger) Reset()         { *m = RowTrigger{} }
+func (m *RowTrigger) String() string { return proto.CompactTextString(m) }
+func (*RowTrigger) ProtoMessage()    {}
+func (*RowTrigger) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{71}
+}
+func (m *RowTrigger) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RowTrigger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RowTrigger.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RowTrigger) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RowTrigger.Merge(m, src)
+}
+func (m *RowTrigger) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *RowTrigger) XXX_DiscardUnknown() {
+	xxx_messageInfo_RowTrigger.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RowTrigger proto.InternalMessageInfo
+
+func (m *RowTrigger) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *RowTrigger) GetTableName() string {
+	if m != nil {
+		return m.TableName
+	}
+	return ""
+}
+
+func (m *RowTrigger) GetTriggerName() string {
+	if m != nil {
+		return m.TriggerName
+	}
+	return ""
+}
+
+func (m *RowTrigger) GetBody() string {
+	if m != nil {
+		return m.Body
+	}
+	return ""
+}
+
+func (m *RowTrigger) GetNodeId() int32 {
+	if m != nil {
+		return m.NodeId
+	}
+	return 0
+}
+
+func (m *RowTrigger) GetOldCols() []string {
+	if m != nil {
+		return m.OldCols
+	}
+	return nil
+}
+
+func (m *RowTrigger) GetNewCols() []string {
+	if m != nil {
+		return m.NewCols
+	}
+	return nil
+}
+
+func (m *RowTrigger) GetDefinerUserId() uint32 {
+	if m != nil {
+		return m.DefinerUserId
+	}
+	return 0
+}
+
+func (m *RowTrigger) GetDefinerRoleId() uint32 {
+	if m != nil {
+		return m.DefinerRoleId
+	}
+	return 0
+}
+
 type TransationControl struct {
 	// TransationControl type
 	TclType TransationControl_TclType `protobuf:"varint,1,opt,name=tcl_type,json=tclType,proto3,enum=plan.TransationControl_TclType" json:"tcl_type,omitempty"`
@@ -7494,7 +7825,7 @@ func (m *TransationControl) Reset()         { *m = TransationControl{} }
 func (m *TransationControl) String() string { return proto.CompactTextString(m) }
 func (*TransationControl) ProtoMessage()    {}
 func (*TransationControl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{69}
+	return fileDescriptor_2d655ab2f7683c23, []int{72}
 }
 func (m *TransationControl) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7598,7 +7929,7 @@ func (m *TransationBegin) Reset()         { *m = TransationBegin{} }
 func (m *TransationBegin) String() string { return proto.CompactTextString(m) }
 func (*TransationBegin) ProtoMessage()    {}
 func (*TransationBegin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{70}
+	return fileDescriptor_2d655ab2f7683c23, []int{73}
 }
 func (m *TransationBegin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7645,7 +7976,7 @@ func (m *TransationCommit) Reset()         { *m = TransationCommit{} }
 func (m *TransationCommit) String() string { return proto.CompactTextString(m) }
 func (*TransationCommit) ProtoMessage()    {}
 func (*TransationCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{71}
+	return fileDescriptor_2d655ab2f7683c23, []int{74}
 }
 func (m *TransationCommit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7692,7 +8023,7 @@ func (m *TransationRollback) Reset()         { *m = TransationRollback{} }
 func (m *TransationRollback) String() string { return proto.CompactTextString(m) }
 func (*TransationRollback) ProtoMessage()    {}
 func (*TransationRollback) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{72}
+	return fileDescriptor_2d655ab2f7683c23, []int{75}
 }
 func (m *TransationRollback) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7747,7 +8078,7 @@ func (m *Plan) Reset()         { *m = Plan{} }
 func (m *Plan) String() string { return proto.CompactTextString(m) }
 func (*Plan) ProtoMessage()    {}
 func (*Plan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{73}
+	return fileDescriptor_2d655ab2f7683c23, []int{76}
 }
 func (m *Plan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7870,7 +8201,7 @@ func (m *Column) Reset()         { *m = Column{} }
 func (m *Column) String() string { return proto.CompactTextString(m) }
 func (*Column) ProtoMessage()    {}
 func (*Column) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{74}
+	return fileDescriptor_2d655ab2f7683c23, []int{77}
 }
 func (m *Column) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7926,7 +8257,7 @@ func (m *DataControl) Reset()         { *m = DataControl{} }
 func (m *DataControl) String() string { return proto.CompactTextString(m) }
 func (*DataControl) ProtoMessage()    {}
 func (*DataControl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{75}
+	return fileDescriptor_2d655ab2f7683c23, []int{78}
 }
 func (m *DataControl) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8081,7 +8412,7 @@ func (m *DataDefinition) Reset()         { *m = DataDefinition{} }
 func (m *DataDefinition) String() string { return proto.CompactTextString(m) }
 func (*DataDefinition) ProtoMessage()    {}
 func (*DataDefinition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{76}
+	return fileDescriptor_2d655ab2f7683c23, []int{79}
 }
 func (m *DataDefinition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8385,7 +8716,7 @@ func (m *SubscriptionOption) Reset()         { *m = SubscriptionOption{} }
 func (m *SubscriptionOption) String() string { return proto.CompactTextString(m) }
 func (*SubscriptionOption) ProtoMessage()    {}
 func (*SubscriptionOption) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{77}
+	return fileDescriptor_2d655ab2f7683c23, []int{80}
 }
 func (m *SubscriptionOption) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8442,7 +8773,7 @@ func (m *CreateDatabase) Reset()         { *m = CreateDatabase{} }
 func (m *CreateDatabase) String() string { return proto.CompactTextString(m) }
 func (*CreateDatabase) ProtoMessage()    {}
 func (*CreateDatabase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{78}
+	return fileDescriptor_2d655ab2f7683c23, []int{81}
 }
 func (m *CreateDatabase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8511,7 +8842,7 @@ func (m *AlterDatabase) Reset()         { *m = AlterDatabase{} }
 func (m *AlterDatabase) String() string { return proto.CompactTextString(m) }
 func (*AlterDatabase) ProtoMessage()    {}
 func (*AlterDatabase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{79}
+	return fileDescriptor_2d655ab2f7683c23, []int{82}
 }
 func (m *AlterDatabase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8573,7 +8904,7 @@ func (m *DropDatabase) Reset()         { *m = DropDatabase{} }
 func (m *DropDatabase) String() string { return proto.CompactTextString(m) }
 func (*DropDatabase) ProtoMessage()    {}
 func (*DropDatabase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{80}
+	return fileDescriptor_2d655ab2f7683c23, []int{83}
 }
 func (m *DropDatabase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8649,7 +8980,7 @@ func (m *FkColName) Reset()         { *m = FkColName{} }
 func (m *FkColName) String() string { return proto.CompactTextString(m) }
 func (*FkColName) ProtoMessage()    {}
 func (*FkColName) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{81}
+	return fileDescriptor_2d655ab2f7683c23, []int{84}
 }
 func (m *FkColName) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8715,7 +9046,7 @@ func (m *ForeignKeyInfo) Reset()         { *m = ForeignKeyInfo{} }
 func (m *ForeignKeyInfo) String() string { return proto.CompactTextString(m) }
 func (*ForeignKeyInfo) ProtoMessage()    {}
 func (*ForeignKeyInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{82}
+	return fileDescriptor_2d655ab2f7683c23, []int{85}
 }
 func (m *ForeignKeyInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8808,7 +9139,7 @@ func (m *CreateTable) Reset()         { *m = CreateTable{} }
 func (m *CreateTable) String() string { return proto.CompactTextString(m) }
 func (*CreateTable) ProtoMessage()    {}
 func (*CreateTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{83}
+	return fileDescriptor_2d655ab2f7683c23, []int{86}
 }
 func (m *CreateTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8948,7 +9279,7 @@ func (m *AlterTableDrop) Reset()         { *m = AlterTableDrop{} }
 func (m *AlterTableDrop) String() string { return proto.CompactTextString(m) }
 func (*AlterTableDrop) ProtoMessage()    {}
 func (*AlterTableDrop) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{84}
+	return fileDescriptor_2d655ab2f7683c23, []int{87}
 }
 func (m *AlterTableDrop) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9013,7 +9344,7 @@ func (m *AlterTableAddFk) Reset()         { *m = AlterTableAddFk{} }
 func (m *AlterTableAddFk) String() string { return proto.CompactTextString(m) }
 func (*AlterTableAddFk) ProtoMessage()    {}
 func (*AlterTableAddFk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{85}
+	return fileDescriptor_2d655ab2f7683c23, []int{88}
 }
 func (m *AlterTableAddFk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9085,7 +9416,7 @@ func (m *AlterTableAddIndex) Reset()         { *m = AlterTableAddIndex{} }
 func (m *AlterTableAddIndex) String() string { return proto.CompactTextString(m) }
 func (*AlterTableAddIndex) ProtoMessage()    {}
 func (*AlterTableAddIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{86}
+	return fileDescriptor_2d655ab2f7683c23, []int{89}
 }
 func (m *AlterTableAddIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9163,7 +9494,7 @@ func (m *AlterTableDropIndex) Reset()         { *m = AlterTableDropIndex{} }
 func (m *AlterTableDropIndex) String() string { return proto.CompactTextString(m) }
 func (*AlterTableDropIndex) ProtoMessage()    {}
 func (*AlterTableDropIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{87}
+	return fileDescriptor_2d655ab2f7683c23, []int{90}
 }
 func (m *AlterTableDropIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9234,7 +9565,7 @@ func (m *AlterTableAlterIndex) Reset()         { *m = AlterTableAlterIndex{} }
 func (m *AlterTableAlterIndex) String() string { return proto.CompactTextString(m) }
 func (*AlterTableAlterIndex) ProtoMessage()    {}
 func (*AlterTableAlterIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{88}
+	return fileDescriptor_2d655ab2f7683c23, []int{91}
 }
 func (m *AlterTableAlterIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9305,7 +9636,7 @@ func (m *AlterTableAlterReIndex) Reset()         { *m = AlterTableAlterReIndex{}
 func (m *AlterTableAlterReIndex) String() string { return proto.CompactTextString(m) }
 func (*AlterTableAlterReIndex) ProtoMessage()    {}
 func (*AlterTableAlterReIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{89}
+	return fileDescriptor_2d655ab2f7683c23, []int{92}
 }
 func (m *AlterTableAlterReIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9375,7 +9706,7 @@ func (m *AlterTableAddPartition) Reset()         { *m = AlterTableAddPartition{}
 func (m *AlterTableAddPartition) String() string { return proto.CompactTextString(m) }
 func (*AlterTableAddPartition) ProtoMessage()    {}
 func (*AlterTableAddPartition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{90}
+	return fileDescriptor_2d655ab2f7683c23, []int{93}
 }
 func (m *AlterTableAddPartition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9425,6 +9756,213 @@ func (m *AlterTableAddPartition) GetPartitionDef() *PartitionByDef {
 	return nil
 }
 
+type AlterTableDropPartition struct {
+	PartitionNames       []string        `protobuf:"bytes,1,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	PartitionTableNames  []string        `protobuf:"bytes,2,rep,name=partition_table_names,json=partitionTableNames,proto3" json:"partition_table_names,omitempty"`
+	PartitionDef         *PartitionByDef `protobuf:"bytes,3,opt,name=PartitionDef,proto3" json:"PartitionDef,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *AlterTableDropPartition) Reset()         { *m = AlterTableDropPartition{} }
+func (m *AlterTableDropPartition) String() string { return proto.CompactTextString(m) }
+func (*AlterTableDropPartition) ProtoMessage()    {}
+func (*AlterTableDropPartition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{94}
+}
+func (m *AlterTableDropPartition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterTableDropPartition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterTableDropPartition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterTableDropPartition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTableDropPartition.Merge(m, src)
+}
+func (m *AlterTableDropPartition) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AlterTableDropPartition) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTableDropPartition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterTableDropPartition proto.InternalMessageInfo
+
+func (m *AlterTableDropPartition) GetPartitionNames() []string {
+	if m != nil {
+		return m.PartitionNames
+	}
+	return nil
+}
+
+func (m *AlterTableDropPartition) GetPartitionTableNames() []string {
+	if m != nil {
+		return m.PartitionTableNames
+	}
+	return nil
+}
+
+func (m *AlterTableDropPartition) GetPartitionDef() *PartitionByDef {
+	if m != nil {
+		return m.PartitionDef
+	}
+	return nil
+}
+
+type AlterTableTruncatePartition struct {
+	PartitionNames       []string `protobuf:"bytes,1,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	PartitionTableNames  []string `protobuf:"bytes,2,rep,name=partition_table_names,json=partitionTableNames,proto3" json:"partition_table_names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlterTableTruncatePartition) Reset()         { *m = AlterTableTruncatePartition{} }
+func (m *AlterTableTruncatePartition) String() string { return proto.CompactTextString(m) }
+func (*AlterTableTruncatePartition) ProtoMessage()    {}
+func (*AlterTableTruncatePartition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{95}
+}
+func (m *AlterTableTruncatePartition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterTableTruncatePartition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterTableTruncatePartition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterTableTruncatePartition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTableTruncatePartition.Merge(m, src)
+}
+func (m *AlterTableTruncatePartition) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AlterTableTruncatePartition) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTableTruncatePartition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterTableTruncatePartition proto.InternalMessageInfo
+
+func (m *AlterTableTruncatePartition) GetPartitionNames() []string {
+	if m != nil {
+		return m.PartitionNames
+	}
+	return nil
+}
+
+func (m *AlterTableTruncatePartition) GetPartitionTableNames() []string {
+	if m != nil {
+		return m.PartitionTableNames
+	}
+	return nil
+}
+
+type AlterTableExchangePartition struct {
+	PartitionName      string `protobuf:"bytes,1,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	PartitionTableName string `protobuf:"bytes,2,opt,name=partition_table_name,json=partitionTableName,proto3" json:"partition_table_name,omitempty"`
+	// the plain table to exchange with the partition
+	Database          string `protobuf:"bytes,3,opt,name=database,proto3" json:"database,omitempty"`
+	Table             string `protobuf:"bytes,4,opt,name=table,proto3" json:"table,omitempty"`
+	WithoutValidation bool   `protobuf:"varint,5,opt,name=without_validation,json=withoutValidation,proto3" json:"without_validation,omitempty"`
+	// the filter of the rows belonging to the partition, empty if WITHOUT VALIDATION
+	ValidationFilter     string   `protobuf:"bytes,6,opt,name=validation_filter,json=validationFilter,proto3" json:"validation_filter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlterTableExchangePartition) Reset()         { *m = AlterTableExchangePartition{} }
+func (m *AlterTableExchangePartition) String() string { return proto.CompactTextString(m) }
+func (*AlterTableExchangePartition) ProtoMessage()    {}
+func (*AlterTableExchangePartition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{96}
+}
+func (m *AlterTableExchangePartition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterTableExchangePartition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterTableExchangePartition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterTableExchangePartition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTableExchangePartition.Merge(m, src)
+}
+func (m *AlterTableExchangePartition) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AlterTableExchangePartition) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTableExchangePartition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterTableExchangePartition proto.InternalMessageInfo
+
+func (m *AlterTableExchangePartition) GetPartitionName() string {
+	if m != nil {
+		return m.PartitionName
+	}
+	return ""
+}
+
+func (m *AlterTableExchangePartition) GetPartitionTableName() string {
+	if m != nil {
+		return m.PartitionTableName
+	}
+	return ""
+}
+
+func (m *AlterTableExchangePartition) GetDatabase() string {
+	if m != nil {
+		return m.Database
+	}
+	return ""
+}
+
+func (m *AlterTableExchangePartition) GetTable() string {
+	if m != nil {
+		return m.Table
+	}
+	return ""
+}
+
+func (m *AlterTableExchangePartition) GetWithoutValidation() bool {
+	if m != nil {
+		return m.WithoutValidation
+	}
+	return false
+}
+
+func (m *AlterTableExchangePartition) GetValidationFilter() string {
+	if m != nil {
+		return m.ValidationFilter
+	}
+	return ""
+}
+
 type AlterTableComment struct {
 	NewComment           string   `protobuf:"bytes,1,opt,name=new_comment,json=newComment,proto3" json:"new_comment,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -9436,7 +9974,7 @@ func (m *AlterTableComment) Reset()         { *m = AlterTableComment{} }
 func (m *AlterTableComment) String() string { return proto.CompactTextString(m) }
 func (*AlterTableComment) ProtoMessage()    {}
 func (*AlterTableComment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{91}
+	return fileDescriptor_2d655ab2f7683c23, []int{97}
 }
 func (m *AlterTableComment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9484,7 +10022,7 @@ func (m *AlterTableName) Reset()         { *m = AlterTableName{} }
 func (m *AlterTableName) String() string { return proto.CompactTextString(m) }
 func (*AlterTableName) ProtoMessage()    {}
 func (*AlterTableName) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{92}
+	return fileDescriptor_2d655ab2f7683c23, []int{98}
 }
 func (m *AlterTableName) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9541,7 +10079,7 @@ func (m *AlterAddColumn) Reset()         { *m = AlterAddColumn{} }
 func (m *AlterAddColumn) String() string { return proto.CompactTextString(m) }
 func (*AlterAddColumn) ProtoMessage()    {}
 func (*AlterAddColumn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{93}
+	return fileDescriptor_2d655ab2f7683c23, []int{99}
 }
 func (m *AlterAddColumn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9610,7 +10148,7 @@ func (m *AlterDropColumn) Reset()         { *m = AlterDropColumn{} }
 func (m *AlterDropColumn) String() string { return proto.CompactTextString(m) }
 func (*AlterDropColumn) ProtoMessage()    {}
 func (*AlterDropColumn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{94}
+	return fileDescriptor_2d655ab2f7683c23, []int{100}
 }
 func (m *AlterDropColumn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9664,7 +10202,7 @@ func (m *RenameTable) Reset()         { *m = RenameTable{} }
 func (m *RenameTable) String() string { return proto.CompactTextString(m) }
 func (*RenameTable) ProtoMessage()    {}
 func (*RenameTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{95}
+	return fileDescriptor_2d655ab2f7683c23, []int{101}
 }
 func (m *RenameTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9724,7 +10262,7 @@ func (m *AlterTable) Reset()         { *m = AlterTable{} }
 func (m *AlterTable) String() string { return proto.CompactTextString(m) }
 func (*AlterTable) ProtoMessage()    {}
 func (*AlterTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{96}
+	return fileDescriptor_2d655ab2f7683c23, []int{102}
 }
 func (m *AlterTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9843,6 +10381,9 @@ type AlterTable_Action struct {
 	//	*AlterTable_Action_DropColumn
 	//	*AlterTable_Action_AlterReindex
 	//	*AlterTable_Action_AddPartition
+	//	*AlterTable_Action_DropPartition
+	//	*AlterTable_Action_TruncatePartition
+	//	*AlterTable_Action_ExchangePartition
 	Action               isAlterTable_Action_Action `protobuf_oneof:"action"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
@@ -9853,7 +10394,7 @@ func (m *AlterTable_Action) Reset()         { *m = AlterTable_Action{} }
 func (m *AlterTable_Action) String() string { return proto.CompactTextString(m) }
 func (*AlterTable_Action) ProtoMessage()    {}
 func (*AlterTable_Action) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{96, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{102, 0}
 }
 func (m *AlterTable_Action) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9918,17 +10459,29 @@ type AlterTable_Action_AlterReindex struct {
 type AlterTable_Action_AddPartition struct {
 	AddPartition *AlterTableAddPartition `protobuf:"bytes,10,opt,name=addPartition,proto3,oneof" json:"addPartition,omitempty"`
 }
+type AlterTable_Action_DropPartition struct {
+	DropPartition *AlterTableDropPartition `protobuf:"bytes,11,opt,name=dropPartition,proto3,oneof" json:"dropPartition,omitempty"`
+}
+type AlterTable_Action_TruncatePartition struct {
+	TruncatePartition *AlterTableTruncatePartition `protobuf:"bytes,12,opt,name=truncatePartition,proto3,oneof" json:"truncatePartition,omitempty"`
+}
+type AlterTable_Action_ExchangePartition struct {
+	ExchangePartition *AlterTableExchangePartition `protobuf:"bytes,13,opt,name=exchangePartition,proto3,oneof" json:"exchangePartition,omitempty"`
+}
 
-func (*AlterTable_Action_Drop) isAlterTable_Action_Action()         {}
-func (*AlterTable_Action_AddFk) isAlterTable_Action_Action()        {}
-func (*AlterTable_Action_AddIndex) isAlterTable_Action_Action()     {}
-func (*AlterTable_Action_AlterIndex) isAlterTable_Action_Action()   {}
-func (*AlterTable_Action_AlterComment) isAlterTable_Action_Action() {}
-func (*AlterTable_Action_AlterName) isAlterTable_Action_Action()    {}
-func (*AlterTable_Action_AddColumn) isAlterTable_Action_Action()    {}
-func (*AlterTable_Action_DropColumn) isAlterTable_Action_Action()   {}
-func (*AlterTable_Action_AlterReindex) isAlterTable_Action_Action() {}
-func (*AlterTable_Action_AddPartition) isAlterTable_Action_Action() {}
+func (*AlterTable_Action_Drop) isAlterTable_Action_Action()              {}
+func (*AlterTable_Action_AddFk) isAlterTable_Action_Action()             {}
+func (*AlterTable_Action_AddIndex) isAlterTable_Action_Action()          {}
+func (*AlterTable_Action_AlterIndex) isAlterTable_Action_Action()        {}
+func (*AlterTable_Action_AlterComment) isAlterTable_Action_Action()      {}
+func (*AlterTable_Action_AlterName) isAlterTable_Action_Action()         {}
+func (*AlterTable_Action_AddColumn) isAlterTable_Action_Action()         {}
+func (*AlterTable_Action_DropColumn) isAlterTable_Action_Action()        {}
+func (*AlterTable_Action_AlterReindex) isAlterTable_Action_Action()      {}
+func (*AlterTable_Action_AddPartition) isAlterTable_Action_Action()      {}
+func (*AlterTable_Action_DropPartition) isAlterTable_Action_Action()     {}
+func (*AlterTable_Action_TruncatePartition) isAlterTable_Action_Action() {}
+func (*AlterTable_Action_ExchangePartition) isAlterTable_Action_Action() {}
 
 func (m *AlterTable_Action) GetAction() isAlterTable_Action_Action {
 	if m != nil {
@@ -10007,6 +10560,27 @@ func (m *AlterTable_Action) GetAddPartition() *AlterTableAddPartition {
 	return nil
 }
 
+func (m *AlterTable_Action) GetDropPartition() *AlterTableDropPartition {
+	if x, ok := m.GetAction().(*AlterTable_Action_DropPartition); ok {
+		return x.DropPartition
+	}
+	return nil
+}
+
+func (m *AlterTable_Action) GetTruncatePartition() *AlterTableTruncatePartition {
+	if x, ok := m.GetAction().(*AlterTable_Action_TruncatePartition); ok {
+		return x.TruncatePartition
+	}
+	return nil
+}
+
+func (m *AlterTable_Action) GetExchangePartition() *AlterTableExchangePartition {
+	if x, ok := m.GetAction().(*AlterTable_Action_ExchangePartition); ok {
+		return x.ExchangePartition
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*AlterTable_Action) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -10020,6 +10594,9 @@ func (*AlterTable_Action) XXX_OneofWrappers() []interface{} {
 		(*AlterTable_Action_DropColumn)(nil),
 		(*AlterTable_Action_AlterReindex)(nil),
 		(*AlterTable_Action_AddPartition)(nil),
+		(*AlterTable_Action_DropPartition)(nil),
+		(*AlterTable_Action_TruncatePartition)(nil),
+		(*AlterTable_Action_ExchangePartition)(nil),
 	}
 }
 
@@ -10048,7 +10625,7 @@ func (m *DropTable) Reset()         { *m = DropTable{} }
 func (m *DropTable) String() string { return proto.CompactTextString(m) }
 func (*DropTable) ProtoMessage()    {}
 func (*DropTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{97}
+	return fileDescriptor_2d655ab2f7683c23, []int{103}
 }
 func (m *DropTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10175,7 +10752,7 @@ func (m *CreateView) Reset()         { *m = CreateView{} }
 func (m *CreateView) String() string { return proto.CompactTextString(m) }
 func (*CreateView) ProtoMessage()    {}
 func (*CreateView) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{98}
+	return fileDescriptor_2d655ab2f7683c23, []int{104}
 }
 func (m *CreateView) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10245,7 +10822,7 @@ func (m *AlterView) Reset()         { *m = AlterView{} }
 func (m *AlterView) String() string { return proto.CompactTextString(m) }
 func (*AlterView) ProtoMessage()    {}
 func (*AlterView) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{99}
+	return fileDescriptor_2d655ab2f7683c23, []int{105}
 }
 func (m *AlterView) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10308,7 +10885,7 @@ func (m *CreateSequence) Reset()         { *m = CreateSequence{} }
 func (m *CreateSequence) String() string { return proto.CompactTextString(m) }
 func (*CreateSequence) ProtoMessage()    {}
 func (*CreateSequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{100}
+	return fileDescriptor_2d655ab2f7683c23, []int{106}
 }
 func (m *CreateSequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10372,7 +10949,7 @@ func (m *DropSequence) Reset()         { *m = DropSequence{} }
 func (m *DropSequence) String() string { return proto.CompactTextString(m) }
 func (*DropSequence) ProtoMessage()    {}
 func (*DropSequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{101}
+	return fileDescriptor_2d655ab2f7683c23, []int{107}
 }
 func (m *DropSequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10442,7 +11019,7 @@ func (m *AlterSequence) Reset()         { *m = AlterSequence{} }
 func (m *AlterSequence) String() string { return proto.CompactTextString(m) }
 func (*AlterSequence) ProtoMessage()    {}
 func (*AlterSequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{102}
+	return fileDescriptor_2d655ab2f7683c23, []int{108}
 }
 func (m *AlterSequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10508,7 +11085,7 @@ func (m *CreateIndex) Reset()         { *m = CreateIndex{} }
 func (m *CreateIndex) String() string { return proto.CompactTextString(m) }
 func (*CreateIndex) ProtoMessage()    {}
 func (*CreateIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{103}
+	return fileDescriptor_2d655ab2f7683c23, []int{109}
 }
 func (m *CreateIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10590,7 +11167,7 @@ func (m *AlterIndex) Reset()         { *m = AlterIndex{} }
 func (m *AlterIndex) String() string { return proto.CompactTextString(m) }
 func (*AlterIndex) ProtoMessage()    {}
 func (*AlterIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{104}
+	return fileDescriptor_2d655ab2f7683c23, []int{110}
 }
 func (m *AlterIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10640,7 +11217,7 @@ func (m *DropIndex) Reset()         { *m = DropIndex{} }
 func (m *DropIndex) String() string { return proto.CompactTextString(m) }
 func (*DropIndex) ProtoMessage()    {}
 func (*DropIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{105}
+	return fileDescriptor_2d655ab2f7683c23, []int{111}
 }
 func (m *DropIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10715,7 +11292,7 @@ func (m *TruncateTable) Reset()         { *m = TruncateTable{} }
 func (m *TruncateTable) String() string { return proto.CompactTextString(m) }
 func (*TruncateTable) ProtoMessage()    {}
 func (*TruncateTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{106}
+	return fileDescriptor_2d655ab2f7683c23, []int{112}
 }
 func (m *TruncateTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10813,7 +11390,7 @@ func (m *ClusterTable) Reset()         { *m = ClusterTable{} }
 func (m *ClusterTable) String() string { return proto.CompactTextString(m) }
 func (*ClusterTable) ProtoMessage()    {}
 func (*ClusterTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{107}
+	return fileDescriptor_2d655ab2f7683c23, []int{113}
 }
 func (m *ClusterTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10875,7 +11452,7 @@ func (m *ShowVariables) Reset()         { *m = ShowVariables{} }
 func (m *ShowVariables) String() string { return proto.CompactTextString(m) }
 func (*ShowVariables) ProtoMessage()    {}
 func (*ShowVariables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{108}
+	return fileDescriptor_2d655ab2f7683c23, []int{114}
 }
 func (m *ShowVariables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10929,7 +11506,7 @@ func (m *SetVariables) Reset()         { *m = SetVariables{} }
 func (m *SetVariables) String() string { return proto.CompactTextString(m) }
 func (*SetVariables) ProtoMessage()    {}
 func (*SetVariables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{109}
+	return fileDescriptor_2d655ab2f7683c23, []int{115}
 }
 func (m *SetVariables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10980,7 +11557,7 @@ func (m *SetVariablesItem) Reset()         { *m = SetVariablesItem{} }
 func (m *SetVariablesItem) String() string { return proto.CompactTextString(m) }
 func (*SetVariablesItem) ProtoMessage()    {}
 func (*SetVariablesItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{110}
+	return fileDescriptor_2d655ab2f7683c23, []int{116}
 }
 func (m *SetVariablesItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11058,7 +11635,7 @@ func (m *Prepare) Reset()         { *m = Prepare{} }
 func (m *Prepare) String() string { return proto.CompactTextString(m) }
 func (*Prepare) ProtoMessage()    {}
 func (*Prepare) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{111}
+	return fileDescriptor_2d655ab2f7683c23, []int{117}
 }
 func (m *Prepare) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11127,7 +11704,7 @@ func (m *Execute) Reset()         { *m = Execute{} }
 func (m *Execute) String() string { return proto.CompactTextString(m) }
 func (*Execute) ProtoMessage()    {}
 func (*Execute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{112}
+	return fileDescriptor_2d655ab2f7683c23, []int{118}
 }
 func (m *Execute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11181,7 +11758,7 @@ func (m *Deallocate) Reset()         { *m = Deallocate{} }
 func (m *Deallocate) String() string { return proto.CompactTextString(m) }
 func (*Deallocate) ProtoMessage()    {}
 func (*Deallocate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{113}
+	return fileDescriptor_2d655ab2f7683c23, []int{119}
 }
 func (m *Deallocate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11228,7 +11805,7 @@ func (m *OtherDCL) Reset()         { *m = OtherDCL{} }
 func (m *OtherDCL) String() string { return proto.CompactTextString(m) }
 func (*OtherDCL) ProtoMessage()    {}
 func (*OtherDCL) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{114}
+	return fileDescriptor_2d655ab2f7683c23, []int{120}
 }
 func (m *OtherDCL) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11276,7 +11853,7 @@ func (m *TableLockInfo) Reset()         { *m = TableLockInfo{} }
 func (m *TableLockInfo) String() string { return proto.CompactTextString(m) }
 func (*TableLockInfo) ProtoMessage()    {}
 func (*TableLockInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{115}
+	return fileDescriptor_2d655ab2f7683c23, []int{121}
 }
 func (m *TableLockInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11330,7 +11907,7 @@ func (m *LockTables) Reset()         { *m = LockTables{} }
 func (m *LockTables) String() string { return proto.CompactTextString(m) }
 func (*LockTables) ProtoMessage()    {}
 func (*LockTables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{116}
+	return fileDescriptor_2d655ab2f7683c23, []int{122}
 }
 func (m *LockTables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11376,7 +11953,7 @@ func (m *UnLockTables) Reset()         { *m = UnLockTables{} }
 func (m *UnLockTables) String() string { return proto.CompactTextString(m) }
 func (*UnLockTables) ProtoMessage()    {}
 func (*UnLockTables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{117}
+	return fileDescriptor_2d655ab2f7683c23, []int{123}
 }
 func (m *UnLockTables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11416,7 +11993,7 @@ func (m *MetadataScanInfos) Reset()         { *m = MetadataScanInfos{} }
 func (m *MetadataScanInfos) String() string { return proto.CompactTextString(m) }
 func (*MetadataScanInfos) ProtoMessage()    {}
 func (*MetadataScanInfos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{118}
+	return fileDescriptor_2d655ab2f7683c23, []int{124}
 }
 func (m *MetadataScanInfos) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11473,7 +12050,7 @@ func (m *MetadataScanInfo) Reset()         { *m = MetadataScanInfo{} }
 func (m *MetadataScanInfo) String() string { return proto.CompactTextString(m) }
 func (*MetadataScanInfo) ProtoMessage()    {}
 func (*MetadataScanInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{119}
+	return fileDescriptor_2d655ab2f7683c23, []int{125}
 }
 func (m *MetadataScanInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11628,12 +12205,14 @@ func init() {
 	proto.RegisterType((*ResultColDef)(nil), "plan.ResultColDef")
 	proto.RegisterType((*ColDef)(nil), "plan.ColDef")
 	proto.RegisterType((*Default)(nil), "plan.Default")
+	proto.RegisterType((*GeneratedCol)(nil), "plan.GeneratedCol")
 	proto.RegisterType((*OnUpdate)(nil), "plan.OnUpdate")
 	proto.RegisterType((*IndexOption)(nil), "plan.IndexOption")
 	proto.RegisterType((*PrimaryKeyDef)(nil), "plan.PrimaryKeyDef")
 	proto.RegisterType((*IndexDef)(nil), "plan.IndexDef")
 	proto.RegisterType((*ForeignKeyDef)(nil), "plan.ForeignKeyDef")
 	proto.RegisterType((*CheckDef)(nil), "plan.CheckDef")
+	proto.RegisterType((*TriggerDef)(nil), "plan.TriggerDef")
 	proto.RegisterType((*ClusterByDef)(nil), "plan.ClusterByDef")
 	proto.RegisterType((*PropertyDef)(nil), "plan.PropertyDef")
 	proto.RegisterType((*Property)(nil), "plan.Property")
@@ -11679,6 +12258,7 @@ func init() {
 	proto.RegisterMapType((map[string]int32)(nil), "plan.ColPosMap.MapEntry")
 	proto.RegisterType((*DeleteCtx)(nil), "plan.DeleteCtx")
 	proto.RegisterType((*Query)(nil), "plan.Query")
+	proto.RegisterType((*RowTrigger)(nil), "plan.RowTrigger")
 	proto.RegisterType((*TransationControl)(nil), "plan.TransationControl")
 	proto.RegisterType((*TransationBegin)(nil), "plan.TransationBegin")
 	proto.RegisterType((*TransationCommit)(nil), "plan.TransationCommit")
@@ -11701,6 +12281,9 @@ func init() {
 	proto.RegisterType((*AlterTableAlterIndex)(nil), "plan.AlterTableAlterIndex")
 	proto.RegisterType((*AlterTableAlterReIndex)(nil), "plan.AlterTableAlterReIndex")
 	proto.RegisterType((*AlterTableAddPartition)(nil), "plan.AlterTableAddPartition")
+	proto.RegisterType((*AlterTableDropPartition)(nil), "plan.AlterTableDropPartition")
+	proto.RegisterType((*AlterTableTruncatePartition)(nil), "plan.AlterTableTruncatePartition")
+	proto.RegisterType((*AlterTableExchangePartition)(nil), "plan.AlterTableExchangePartition")
 	proto.RegisterType((*AlterTableComment)(nil), "plan.AlterTableComment")
 	proto.RegisterType((*AlterTableName)(nil), "plan.AlterTableName")
 	proto.RegisterType((*AlterAddColumn)(nil), "plan.AlterAddColumn")
@@ -11737,689 +12320,709 @@ func init() {
 func init() { proto.RegisterFile("plan.proto", fileDescriptor_2d655ab2f7683c23) }
 
 var fileDescriptor_2d655ab2f7683c23 = []byte{
-	// 10901 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0xbd, 0x4d, 0x8c, 0x23, 0x47,
-	0x96, 0x18, 0x5c, 0xfc, 0x27, 0x1f, 0x7f, 0x2a, 0x2b, 0xfa, 0x8f, 0xdd, 0x6a, 0x75, 0x97, 0x52,
-	0x2d, 0xa9, 0xd5, 0xa3, 0x69, 0x49, 0xdd, 0xfa, 0x69, 0x69, 0x67, 0x76, 0xc4, 0x62, 0xb1, 0xbb,
-	0xa8, 0x66, 0x91, 0x35, 0x49, 0x56, 0xb7, 0x34, 0x8b, 0xef, 0x4b, 0x24, 0x99, 0xc9, 0xaa, 0x54,
-	0x25, 0x33, 0xa9, 0xcc, 0x64, 0x57, 0x95, 0x80, 0x05, 0xc6, 0x5e, 0xc0, 0xc6, 0xfa, 0x6a, 0x60,
-	0x6f, 0x6b, 0x8c, 0xd7, 0x17, 0x63, 0xed, 0x05, 0x0c, 0x78, 0x81, 0x35, 0x0c, 0xdf, 0xec, 0xc3,
-	0xd8, 0x30, 0x0c, 0x03, 0x3e, 0x18, 0xb6, 0x81, 0xb5, 0x31, 0x3e, 0xf8, 0xb8, 0x87, 0xf5, 0xc5,
-	0xa7, 0x35, 0xde, 0x8b, 0xc8, 0xcc, 0x48, 0x92, 0x35, 0x2d, 0xcd, 0xcc, 0xc2, 0xf6, 0xa5, 0x2a,
-	0xe3, 0xbd, 0x17, 0xff, 0x11, 0xef, 0x2f, 0x5e, 0x04, 0x01, 0xe6, 0x8e, 0xe1, 0xde, 0x9f, 0xfb,
-	0x5e, 0xe8, 0xb1, 0x3c, 0x7e, 0xdf, 0xf8, 0xfe, 0x91, 0x1d, 0x1e, 0x2f, 0xc6, 0xf7, 0x27, 0xde,
-	0xec, 0xdd, 0x23, 0xef, 0xc8, 0x7b, 0x97, 0x90, 0xe3, 0xc5, 0x94, 0x52, 0x94, 0xa0, 0x2f, 0x9e,
-	0xe9, 0x06, 0x38, 0xde, 0xe4, 0x44, 0x7c, 0x6f, 0x86, 0xf6, 0xcc, 0x0a, 0x42, 0x63, 0x36, 0xe7,
-	0x00, 0xf5, 0xcf, 0x32, 0x90, 0x1f, 0x9d, 0xcf, 0x2d, 0xd6, 0x80, 0xac, 0x6d, 0x36, 0x33, 0xdb,
-	0x99, 0xbb, 0x05, 0x2d, 0x6b, 0x9b, 0x6c, 0x1b, 0xaa, 0xae, 0x17, 0xf6, 0x17, 0x8e, 0x63, 0x8c,
-	0x1d, 0xab, 0x99, 0xdd, 0xce, 0xdc, 0x2d, 0x6b, 0x32, 0x88, 0xbd, 0x02, 0x15, 0x63, 0x11, 0x7a,
-	0xba, 0xed, 0x4e, 0xfc, 0x66, 0x8e, 0xf0, 0x65, 0x04, 0x74, 0xdd, 0x89, 0xcf, 0x2e, 0x43, 0xe1,
-	0xd4, 0x36, 0xc3, 0xe3, 0x66, 0x9e, 0x4a, 0xe4, 0x09, 0x84, 0x06, 0x13, 0xc3, 0xb1, 0x9a, 0x05,
-	0x0e, 0xa5, 0x04, 0x42, 0x43, 0xaa, 0xa4, 0xb8, 0x9d, 0xb9, 0x5b, 0xd1, 0x78, 0x82, 0xdd, 0x02,
-	0xb0, 0xdc, 0xc5, 0xec, 0x85, 0xe1, 0x2c, 0xac, 0xa0, 0x59, 0x22, 0x94, 0x04, 0x51, 0x7f, 0x04,
-	0x95, 0x59, 0x70, 0xb4, 0x67, 0x19, 0xa6, 0xe5, 0xb3, 0x6b, 0x50, 0x9a, 0x05, 0x47, 0x7a, 0x68,
-	0x1c, 0x89, 0x2e, 0x14, 0x67, 0xc1, 0xd1, 0xc8, 0x38, 0x62, 0xd7, 0xa1, 0x4c, 0x88, 0xf3, 0x39,
-	0xef, 0x43, 0x41, 0x43, 0x42, 0xec, 0xb1, 0xfa, 0x17, 0x05, 0x28, 0xf5, 0xec, 0xd0, 0xf2, 0x0d,
-	0x87, 0x5d, 0x85, 0xa2, 0x1d, 0xb8, 0x0b, 0xc7, 0xa1, 0xec, 0x65, 0x4d, 0xa4, 0xd8, 0x55, 0x28,
-	0xd8, 0x8f, 0x5e, 0x18, 0x0e, 0xcf, 0xbb, 0xb7, 0xa1, 0xf1, 0x24, 0x6b, 0x42, 0xd1, 0x7e, 0xff,
-	0x23, 0x44, 0xe4, 0x04, 0x42, 0xa4, 0x09, 0xf3, 0xf0, 0x01, 0x62, 0xf2, 0x31, 0x86, 0xd2, 0x84,
-	0xf9, 0xe8, 0x03, 0xc4, 0x60, 0xef, 0x73, 0x84, 0xa1, 0x34, 0xd6, 0xb2, 0xa0, 0x5a, 0x70, 0x00,
-	0xea, 0x58, 0xcb, 0x22, 0xaa, 0x65, 0xc1, 0x6b, 0x29, 0x09, 0x84, 0x48, 0x13, 0x86, 0xd7, 0x52,
-	0x8e, 0x31, 0x71, 0x2d, 0x0b, 0x5e, 0x4b, 0x65, 0x3b, 0x73, 0x37, 0x4f, 0x18, 0x5e, 0xcb, 0x65,
-	0xc8, 0x9b, 0x08, 0x87, 0xed, 0xcc, 0xdd, 0xcc, 0xde, 0x86, 0x46, 0x29, 0x84, 0x06, 0x08, 0xad,
-	0xe2, 0x00, 0x23, 0x34, 0x10, 0xd0, 0x31, 0x42, 0x6b, 0x38, 0x1a, 0x08, 0x1d, 0x0b, 0xe8, 0x14,
-	0xa1, 0xf5, 0xed, 0xcc, 0xdd, 0x2c, 0x42, 0x31, 0xc5, 0x6e, 0x40, 0xc9, 0x34, 0x42, 0x0b, 0x11,
-	0x0d, 0xd1, 0xe5, 0x08, 0x80, 0x38, 0x5c, 0x71, 0x88, 0xdb, 0x14, 0x9d, 0x8e, 0x00, 0x4c, 0x85,
-	0x2a, 0x92, 0x45, 0x78, 0x45, 0xe0, 0x65, 0x20, 0xfb, 0x10, 0x6a, 0xa6, 0x35, 0xb1, 0x67, 0x86,
-	0xc3, 0xfb, 0xb4, 0xb5, 0x9d, 0xb9, 0x5b, 0x7d, 0xb0, 0x79, 0x9f, 0xf6, 0x44, 0x8c, 0xd9, 0xdb,
-	0xd0, 0x52, 0x64, 0xec, 0x11, 0xd4, 0x45, 0xfa, 0xfd, 0x07, 0x34, 0xb0, 0x8c, 0xf2, 0x29, 0xa9,
-	0x7c, 0xef, 0x3f, 0x78, 0xb4, 0xb7, 0xa1, 0xa5, 0x09, 0xd9, 0x1d, 0xa8, 0xc5, 0x5b, 0x04, 0x33,
-	0x5e, 0x12, 0xad, 0x4a, 0x41, 0xb1, 0x5b, 0x5f, 0x05, 0x9e, 0x8b, 0x04, 0x97, 0xc5, 0xb8, 0x45,
-	0x00, 0xb6, 0x0d, 0x60, 0x5a, 0x53, 0x63, 0xe1, 0x84, 0x88, 0xbe, 0x22, 0x06, 0x50, 0x82, 0xb1,
-	0x5b, 0x50, 0x59, 0xcc, 0xb1, 0x97, 0xcf, 0x0c, 0xa7, 0x79, 0x55, 0x10, 0x24, 0x20, 0x2c, 0x1d,
-	0xd7, 0x39, 0x62, 0xaf, 0x89, 0xd9, 0x8d, 0x00, 0xb8, 0x57, 0xec, 0x60, 0xc7, 0x76, 0x9b, 0x4d,
-	0x5a, 0xa7, 0x3c, 0xc1, 0x6e, 0x42, 0x2e, 0xf0, 0x27, 0xcd, 0xeb, 0xd4, 0x4b, 0xe0, 0xbd, 0xec,
-	0x9c, 0xcd, 0x7d, 0x0d, 0xc1, 0x3b, 0x25, 0x28, 0xd0, 0x9e, 0x51, 0x6f, 0x42, 0xf9, 0xc0, 0xf0,
-	0x8d, 0x99, 0x66, 0x4d, 0x99, 0x02, 0xb9, 0xb9, 0x17, 0x88, 0xdd, 0x82, 0x9f, 0x6a, 0x0f, 0x8a,
-	0xcf, 0x0c, 0x1f, 0x71, 0x0c, 0xf2, 0xae, 0x31, 0xb3, 0x08, 0x59, 0xd1, 0xe8, 0x1b, 0x77, 0x48,
-	0x70, 0x1e, 0x84, 0xd6, 0x4c, 0xb0, 0x02, 0x91, 0x42, 0xf8, 0x91, 0xe3, 0x8d, 0xc5, 0x4e, 0x28,
-	0x6b, 0x22, 0xa5, 0xfe, 0xcd, 0x0c, 0x14, 0xdb, 0x9e, 0x83, 0xc5, 0x5d, 0x83, 0x92, 0x6f, 0x39,
-	0x7a, 0x52, 0x5d, 0xd1, 0xb7, 0x9c, 0x03, 0x2f, 0x40, 0xc4, 0xc4, 0xe3, 0x08, 0xbe, 0x37, 0x8b,
-	0x13, 0x8f, 0x10, 0x51, 0x03, 0x72, 0x52, 0x03, 0xae, 0x43, 0x39, 0x1c, 0x3b, 0x3a, 0xc1, 0xf3,
-	0x04, 0x2f, 0x85, 0x63, 0xa7, 0x8f, 0xa8, 0x6b, 0x50, 0x32, 0xc7, 0x1c, 0x53, 0x20, 0x4c, 0xd1,
-	0x1c, 0x23, 0x42, 0xfd, 0x04, 0x2a, 0x9a, 0x71, 0x2a, 0x9a, 0x71, 0x05, 0x8a, 0x58, 0x80, 0xe0,
-	0x72, 0x79, 0xad, 0x10, 0x8e, 0x9d, 0xae, 0x89, 0x60, 0x6c, 0x84, 0x6d, 0x52, 0x1b, 0xf2, 0x5a,
-	0x61, 0xe2, 0x39, 0x5d, 0x53, 0x1d, 0x01, 0xb4, 0x3d, 0xdf, 0xff, 0x95, 0xbb, 0x70, 0x19, 0x0a,
-	0xa6, 0x35, 0x0f, 0x8f, 0x39, 0x83, 0xd0, 0x78, 0x42, 0xbd, 0x07, 0x65, 0x9c, 0x97, 0x9e, 0x1d,
-	0x84, 0xec, 0x16, 0xe4, 0x1d, 0x3b, 0x08, 0x9b, 0x99, 0xed, 0xdc, 0xd2, 0xac, 0x11, 0x5c, 0xdd,
-	0x86, 0xf2, 0xbe, 0x71, 0xf6, 0x0c, 0x67, 0x0e, 0x4b, 0xa3, 0x29, 0x14, 0x53, 0x22, 0xe6, 0xb3,
-	0x06, 0x30, 0x32, 0xfc, 0x23, 0x2b, 0x24, 0x7e, 0xf6, 0x97, 0x19, 0xa8, 0x0e, 0x17, 0xe3, 0xaf,
-	0x17, 0x96, 0x7f, 0x8e, 0x6d, 0xbe, 0x0b, 0xb9, 0xf0, 0x7c, 0x4e, 0x39, 0x1a, 0x0f, 0xae, 0xf2,
-	0xe2, 0x25, 0xfc, 0x7d, 0xcc, 0xa4, 0x21, 0x09, 0x76, 0xc2, 0xf5, 0x4c, 0x2b, 0x1a, 0x83, 0x82,
-	0x56, 0xc4, 0x64, 0xd7, 0x44, 0xa1, 0xe0, 0xcd, 0xc5, 0x2c, 0x64, 0xbd, 0x39, 0xdb, 0x86, 0xc2,
-	0xe4, 0xd8, 0x76, 0x4c, 0x9a, 0x80, 0x74, 0x9b, 0x39, 0x02, 0x67, 0xc9, 0xf7, 0x4e, 0xf5, 0xc0,
-	0xfe, 0x26, 0x62, 0xf2, 0x25, 0xdf, 0x3b, 0x1d, 0xda, 0xdf, 0x58, 0xea, 0x48, 0x48, 0x1a, 0x80,
-	0xe2, 0xb0, 0xdd, 0xea, 0xb5, 0x34, 0x65, 0x03, 0xbf, 0x3b, 0x5f, 0x74, 0x87, 0xa3, 0xa1, 0x92,
-	0x61, 0x0d, 0x80, 0xfe, 0x60, 0xa4, 0x8b, 0x74, 0x96, 0x15, 0x21, 0xdb, 0xed, 0x2b, 0x39, 0xa4,
-	0x41, 0x78, 0xb7, 0xaf, 0xe4, 0x59, 0x09, 0x72, 0xad, 0xfe, 0x97, 0x4a, 0x81, 0x3e, 0x7a, 0x3d,
-	0xa5, 0xa8, 0xfe, 0x71, 0x16, 0x2a, 0x83, 0xf1, 0x57, 0xd6, 0x24, 0xc4, 0x3e, 0xe3, 0x2a, 0xb5,
-	0xfc, 0x17, 0x96, 0x4f, 0xdd, 0xce, 0x69, 0x22, 0x85, 0x1d, 0x31, 0xc7, 0xd4, 0xb9, 0x9c, 0x96,
-	0x35, 0xc7, 0x44, 0x37, 0x39, 0xb6, 0x66, 0x06, 0x75, 0x0e, 0xe9, 0x28, 0x85, 0xbb, 0xc2, 0x1b,
-	0x7f, 0x45, 0xdd, 0xcb, 0x69, 0xf8, 0xc9, 0x6e, 0x43, 0x95, 0x97, 0x21, 0xaf, 0x2f, 0xe0, 0xa0,
-	0xe5, 0xc5, 0x57, 0x94, 0x17, 0x1f, 0xe5, 0xa4, 0x52, 0x39, 0x52, 0x48, 0x30, 0x0e, 0xea, 0x8b,
-	0x15, 0xed, 0x8d, 0xbf, 0xe2, 0xd8, 0x32, 0x5f, 0xd1, 0xde, 0xf8, 0x2b, 0x42, 0x7d, 0x0f, 0xb6,
-	0x82, 0xc5, 0x38, 0x98, 0xf8, 0xf6, 0x3c, 0xb4, 0x3d, 0x97, 0xd3, 0x54, 0x88, 0x46, 0x91, 0x11,
-	0x44, 0x7c, 0x17, 0xca, 0xf3, 0xc5, 0x58, 0xb7, 0xdd, 0xa9, 0x47, 0xcc, 0xbd, 0xfa, 0xa0, 0xce,
-	0x27, 0xe6, 0x60, 0x31, 0xee, 0xba, 0x53, 0x4f, 0x2b, 0xcd, 0xf9, 0x87, 0xfa, 0x26, 0x94, 0x04,
-	0x0c, 0xa5, 0x77, 0x68, 0xb9, 0x86, 0x1b, 0xea, 0xb1, 0xd8, 0x2f, 0x73, 0x40, 0xd7, 0x54, 0xff,
-	0x34, 0x03, 0xca, 0x50, 0xaa, 0x66, 0xdf, 0x0a, 0x8d, 0xb5, 0x5c, 0xe1, 0x55, 0x00, 0x63, 0x32,
-	0xf1, 0x16, 0xbc, 0x18, 0xbe, 0x78, 0x2a, 0x02, 0xd2, 0x35, 0xe5, 0xb1, 0xc9, 0xa5, 0xc6, 0xe6,
-	0x35, 0xa8, 0x45, 0xf9, 0xa4, 0x0d, 0x5d, 0x15, 0xb0, 0x68, 0x74, 0x82, 0x45, 0x6a, 0x57, 0x97,
-	0x82, 0x05, 0xcf, 0x7d, 0x15, 0x8a, 0xa4, 0x23, 0x04, 0xd1, 0x88, 0xf3, 0x94, 0xfa, 0x77, 0xb2,
-	0x50, 0x7e, 0xbc, 0x70, 0x27, 0xd8, 0x64, 0xf6, 0x3a, 0xe4, 0xa7, 0x0b, 0x77, 0x42, 0xcd, 0x8d,
-	0x45, 0x46, 0xbc, 0x52, 0x34, 0x42, 0xe2, 0x1e, 0x34, 0xfc, 0x23, 0xdc, 0xbb, 0x2b, 0x7b, 0x10,
-	0xe1, 0xea, 0x3f, 0xcb, 0xf0, 0x12, 0x1f, 0x3b, 0xc6, 0x11, 0x2b, 0x43, 0xbe, 0x3f, 0xe8, 0x77,
-	0x94, 0x0d, 0x56, 0x83, 0x72, 0xb7, 0x3f, 0xea, 0x68, 0xfd, 0x56, 0x4f, 0xc9, 0xd0, 0x82, 0x1e,
-	0xb5, 0x76, 0x7a, 0x1d, 0x25, 0x8b, 0x98, 0x67, 0x83, 0x5e, 0x6b, 0xd4, 0xed, 0x75, 0x94, 0x3c,
-	0xc7, 0x68, 0xdd, 0xf6, 0x48, 0x29, 0x33, 0x05, 0x6a, 0x07, 0xda, 0x60, 0xf7, 0xb0, 0xdd, 0xd1,
-	0xfb, 0x87, 0xbd, 0x9e, 0xa2, 0xb0, 0x4b, 0xb0, 0x19, 0x43, 0x06, 0x1c, 0xb8, 0x8d, 0x59, 0x9e,
-	0xb5, 0xb4, 0x96, 0xf6, 0x44, 0xf9, 0x8c, 0x95, 0x21, 0xd7, 0x7a, 0xf2, 0x44, 0xf9, 0x29, 0xee,
-	0x8d, 0xca, 0xf3, 0x6e, 0x5f, 0x7f, 0xd6, 0xea, 0x1d, 0x76, 0x94, 0x9f, 0x66, 0xa3, 0xf4, 0x40,
-	0xdb, 0xed, 0x68, 0xca, 0x4f, 0xf3, 0x6c, 0x0b, 0x6a, 0x3f, 0x19, 0xf4, 0x3b, 0xfb, 0xad, 0x83,
-	0x03, 0x6a, 0xc8, 0x4f, 0xcb, 0xea, 0xcf, 0xf3, 0x90, 0xc7, 0x9e, 0x30, 0x35, 0xe1, 0x03, 0x71,
-	0x17, 0x71, 0x23, 0xee, 0xe4, 0x7f, 0xfe, 0xe7, 0xb7, 0x37, 0x38, 0x07, 0x78, 0x0d, 0x72, 0x8e,
-	0x1d, 0xd2, 0x04, 0xc6, 0xab, 0x47, 0xe8, 0x46, 0x7b, 0x1b, 0x1a, 0xe2, 0xd8, 0x2d, 0xc8, 0x70,
-	0x56, 0x50, 0x7d, 0xd0, 0x10, 0xcb, 0x4b, 0xc8, 0x92, 0xbd, 0x0d, 0x2d, 0x33, 0x67, 0x37, 0x21,
-	0xf3, 0x42, 0xf0, 0x85, 0x1a, 0xc7, 0x73, 0x69, 0x82, 0xd8, 0x17, 0x6c, 0x1b, 0x72, 0x13, 0x8f,
-	0x6b, 0x3e, 0x31, 0x9e, 0xf3, 0x56, 0x2c, 0x7f, 0xe2, 0x39, 0xec, 0x75, 0xc8, 0xf9, 0xc6, 0x29,
-	0xcd, 0x68, 0x3c, 0x5d, 0x31, 0xf3, 0x46, 0x22, 0xdf, 0x38, 0xc5, 0x46, 0x4c, 0x69, 0x27, 0xc5,
-	0x8d, 0x88, 0xe6, 0x1b, 0xab, 0x99, 0xb2, 0x6d, 0xc8, 0x9c, 0xd2, 0x5e, 0x8a, 0x85, 0xfd, 0x73,
-	0xdb, 0x35, 0xbd, 0xd3, 0xe1, 0xdc, 0x9a, 0x20, 0xc5, 0x29, 0x7b, 0x03, 0x72, 0xc1, 0x62, 0x4c,
-	0x7b, 0xa9, 0xfa, 0x60, 0x6b, 0x85, 0x2b, 0x62, 0x45, 0xc1, 0x62, 0xcc, 0xde, 0x84, 0xfc, 0xc4,
-	0xf3, 0x7d, 0xb1, 0x9f, 0x94, 0xa8, 0xc1, 0x91, 0x40, 0x40, 0xe5, 0x07, 0xf1, 0x58, 0x61, 0x48,
-	0xba, 0x53, 0x4c, 0x94, 0x70, 0x64, 0xac, 0x30, 0x64, 0x77, 0x04, 0x9b, 0xaf, 0xc9, 0xad, 0x8e,
-	0x84, 0x00, 0x96, 0x83, 0x58, 0x9c, 0xa4, 0x99, 0x71, 0x46, 0x9a, 0x55, 0x4c, 0x14, 0x71, 0x7f,
-	0x6c, 0xd3, 0xcc, 0x38, 0x63, 0x77, 0x20, 0xf7, 0xc2, 0x9a, 0x90, 0x92, 0x15, 0xd7, 0x26, 0x26,
-	0xe9, 0x19, 0x75, 0x0f, 0xd1, 0x28, 0xcf, 0x8c, 0xc5, 0x19, 0x6e, 0xc7, 0x4d, 0x2e, 0x79, 0x8c,
-	0xc5, 0x59, 0xd7, 0x44, 0xce, 0xe6, 0x9a, 0x2f, 0x48, 0xcb, 0xca, 0x68, 0xf8, 0x89, 0x1a, 0x7e,
-	0x60, 0x39, 0xd6, 0x24, 0xb4, 0x5f, 0xd8, 0xe1, 0x39, 0xa9, 0x56, 0x19, 0x4d, 0x06, 0xed, 0x14,
-	0x21, 0x6f, 0x9d, 0xcd, 0x7d, 0xf5, 0x01, 0x40, 0x52, 0x0f, 0x96, 0xe4, 0x58, 0x6e, 0xa4, 0x39,
-	0x38, 0x96, 0x8b, 0x9c, 0xc1, 0x34, 0x42, 0x83, 0x96, 0x4f, 0x4d, 0xa3, 0x6f, 0xf5, 0x3a, 0x54,
-	0x62, 0x95, 0x8c, 0xd5, 0x20, 0x63, 0x08, 0x8e, 0x9c, 0x31, 0xd4, 0xbb, 0xa8, 0x21, 0x45, 0x4a,
-	0x57, 0x1a, 0x87, 0xa9, 0x88, 0x4f, 0x67, 0xc6, 0xea, 0x0f, 0xa0, 0xa6, 0x59, 0xc1, 0xc2, 0x09,
-	0xdb, 0x9e, 0xb3, 0x6b, 0x4d, 0xd9, 0x3b, 0x00, 0x71, 0x3a, 0x10, 0x82, 0x33, 0x59, 0x4c, 0xbb,
-	0xd6, 0x54, 0x93, 0xf0, 0xea, 0x3f, 0xcc, 0x93, 0x0a, 0xb2, 0xcb, 0x65, 0xbf, 0x10, 0xf2, 0x19,
-	0x49, 0xc8, 0xc7, 0x2c, 0x2d, 0x9b, 0x56, 0x74, 0x8e, 0x6d, 0xd3, 0xb4, 0xdc, 0x48, 0xa1, 0xe1,
-	0x29, 0x1c, 0x7d, 0xc3, 0x39, 0xa2, 0x15, 0xde, 0x78, 0xc0, 0xa2, 0x4a, 0x67, 0x73, 0xdf, 0x0a,
-	0x02, 0x2e, 0x4a, 0x0d, 0xe7, 0x28, 0xda, 0x6c, 0x85, 0x5f, 0xb6, 0xd9, 0xae, 0x43, 0xd9, 0xf5,
-	0x42, 0x9d, 0xcc, 0x8d, 0x22, 0xd5, 0x51, 0x12, 0x76, 0x15, 0x7b, 0x0b, 0x4a, 0x42, 0x51, 0x14,
-	0xab, 0x5c, 0xec, 0xc5, 0x5d, 0x0e, 0xd4, 0x22, 0x2c, 0x6b, 0xa2, 0xde, 0x31, 0x9b, 0x59, 0x6e,
-	0x18, 0x89, 0x0e, 0x91, 0x64, 0xdf, 0x83, 0x8a, 0xe7, 0xea, 0x5c, 0x9b, 0x14, 0xcb, 0x5c, 0xac,
-	0xa7, 0x81, 0x7b, 0x48, 0x50, 0xad, 0xec, 0x89, 0x2f, 0x6c, 0x8a, 0xe3, 0x9d, 0xea, 0x13, 0xc3,
-	0x37, 0x69, 0xa9, 0x97, 0xb5, 0x92, 0xe3, 0x9d, 0xb6, 0x0d, 0xdf, 0xe4, 0xa2, 0xf4, 0x6b, 0x77,
-	0x31, 0xa3, 0xe5, 0x5d, 0xd7, 0x44, 0x8a, 0xdd, 0x84, 0xca, 0xc4, 0x59, 0x04, 0xa1, 0xe5, 0xef,
-	0x9c, 0x73, 0xfb, 0x40, 0x4b, 0x00, 0xd8, 0xae, 0xb9, 0x6f, 0xcf, 0x0c, 0xff, 0x9c, 0xd6, 0x72,
-	0x59, 0x8b, 0x92, 0xa8, 0xc2, 0xcc, 0x4f, 0x6c, 0xf3, 0x8c, 0x1b, 0x09, 0x1a, 0x4f, 0x20, 0xfd,
-	0x31, 0x99, 0x70, 0x01, 0x2d, 0xd7, 0xb2, 0x16, 0x25, 0x69, 0x1e, 0xe8, 0x93, 0xd6, 0x6c, 0x45,
-	0x13, 0xa9, 0x94, 0x1e, 0xb8, 0x75, 0xa1, 0x1e, 0xc8, 0x96, 0x45, 0xb1, 0xe7, 0xdb, 0x47, 0xb6,
-	0x10, 0xa4, 0x97, 0xb8, 0x28, 0xe6, 0x20, 0x52, 0x14, 0xbf, 0x86, 0x92, 0x18, 0x62, 0x14, 0x09,
-	0xb8, 0xe8, 0xd3, 0xfc, 0x92, 0x8b, 0x04, 0x84, 0xb3, 0xd7, 0xa1, 0x2e, 0xca, 0x0a, 0x42, 0xdf,
-	0x76, 0x8f, 0xc4, 0xe2, 0xa9, 0x71, 0xe0, 0x90, 0x60, 0x28, 0xdf, 0x70, 0x7a, 0x75, 0x63, 0x6c,
-	0x3b, 0xb8, 0xb9, 0x72, 0xc2, 0x7c, 0x5e, 0x38, 0x4e, 0x8b, 0x83, 0xd4, 0x01, 0x94, 0xa3, 0x09,
-	0xf9, 0x8d, 0xd4, 0xa9, 0xfe, 0x16, 0x54, 0xbb, 0xae, 0x69, 0x9d, 0x0d, 0x48, 0x64, 0xb3, 0x77,
-	0x80, 0x4d, 0x7c, 0xcb, 0x08, 0x2d, 0xdd, 0x3a, 0x0b, 0x7d, 0x43, 0xe7, 0x26, 0x36, 0x37, 0x6f,
-	0x15, 0x8e, 0xe9, 0x20, 0x62, 0x84, 0x70, 0xf5, 0x3f, 0x67, 0xa0, 0x7e, 0xc0, 0x67, 0xea, 0xa9,
-	0x75, 0xbe, 0xcb, 0x8d, 0x80, 0x49, 0xb4, 0xcb, 0xf2, 0x1a, 0x7d, 0xb3, 0x5b, 0x50, 0x9d, 0x9f,
-	0x58, 0xe7, 0x7a, 0x4a, 0x61, 0xae, 0x20, 0xa8, 0x4d, 0xfb, 0xe9, 0x6d, 0x28, 0x7a, 0x54, 0xbb,
-	0x10, 0x14, 0x82, 0xbf, 0x4a, 0xcd, 0xd2, 0x04, 0x01, 0x53, 0xa1, 0x1e, 0x17, 0x25, 0xab, 0x00,
-	0xa2, 0x30, 0x9a, 0xb6, 0xcb, 0x50, 0x40, 0x54, 0xd0, 0x2c, 0x6c, 0xe7, 0x50, 0xeb, 0xa5, 0x04,
-	0x7b, 0x0f, 0xea, 0x13, 0x6f, 0x36, 0xd7, 0xa3, 0xec, 0x42, 0x64, 0xa4, 0xf9, 0x40, 0x15, 0x49,
-	0x0e, 0x78, 0x59, 0xea, 0x1f, 0xe4, 0xa0, 0x4c, 0x6d, 0x10, 0xac, 0xc0, 0x36, 0xcf, 0x22, 0x56,
-	0x50, 0xd1, 0x0a, 0xb6, 0x89, 0xfc, 0xf1, 0x55, 0x00, 0x1b, 0x49, 0x74, 0x89, 0x21, 0x54, 0x08,
-	0x12, 0x35, 0x65, 0x6e, 0xf8, 0x61, 0xd0, 0xcc, 0xf1, 0xa6, 0x50, 0x02, 0xd7, 0xe8, 0xc2, 0xb5,
-	0xbf, 0x5e, 0xf0, 0xd6, 0x97, 0x35, 0x91, 0x62, 0x77, 0x41, 0xe1, 0x85, 0xd1, 0xa0, 0xcb, 0x3a,
-	0x4c, 0x83, 0xe0, 0x34, 0xe6, 0xd1, 0xca, 0xe4, 0x34, 0xd6, 0x19, 0x0a, 0x09, 0xce, 0x0e, 0x80,
-	0x40, 0x1d, 0x84, 0xc8, 0x1b, 0xbd, 0x94, 0xde, 0xe8, 0x4d, 0x28, 0xbd, 0xb0, 0x03, 0x1b, 0x67,
-	0xb5, 0xcc, 0xb7, 0x8e, 0x48, 0x4a, 0xd3, 0x50, 0x79, 0xd9, 0x34, 0xc4, 0xdd, 0x36, 0x9c, 0x23,
-	0xae, 0x3d, 0x46, 0xdd, 0x6e, 0x39, 0x47, 0x1e, 0x7b, 0x1f, 0xae, 0x24, 0x68, 0xd1, 0x1b, 0xf2,
-	0xa5, 0x90, 0xbb, 0x40, 0x63, 0x31, 0x25, 0xf5, 0x88, 0xd4, 0xfb, 0x7b, 0xb0, 0x25, 0x65, 0x99,
-	0xa3, 0x8e, 0x10, 0x10, 0x9f, 0xa8, 0x68, 0x9b, 0x31, 0x39, 0xa9, 0x0e, 0x81, 0xfa, 0xaf, 0xb3,
-	0x50, 0x7f, 0xec, 0xf9, 0x96, 0x7d, 0xe4, 0x26, 0xab, 0x6e, 0x45, 0xc9, 0x8c, 0x56, 0x62, 0x56,
-	0x5a, 0x89, 0xb7, 0xa1, 0x3a, 0xe5, 0x19, 0xf5, 0x70, 0xcc, 0x6d, 0xcf, 0xbc, 0x06, 0x02, 0x34,
-	0x1a, 0x3b, 0xb8, 0x03, 0x23, 0x02, 0xca, 0x9c, 0xa7, 0xcc, 0x51, 0x26, 0x94, 0x0f, 0xec, 0x53,
-	0xe2, 0x94, 0xa6, 0xe5, 0x58, 0x21, 0x9f, 0x9e, 0xc6, 0x83, 0x57, 0x85, 0x52, 0x21, 0xb7, 0xe9,
-	0xbe, 0x66, 0x4d, 0x5b, 0xa4, 0x63, 0x20, 0xe3, 0xdc, 0x25, 0x72, 0x91, 0x57, 0x70, 0xd9, 0xe2,
-	0xb7, 0xcc, 0xcb, 0x77, 0xbb, 0x3a, 0x82, 0x4a, 0x0c, 0x46, 0x85, 0x51, 0xeb, 0x08, 0x25, 0x71,
-	0x83, 0x55, 0xa1, 0xd4, 0x6e, 0x0d, 0xdb, 0xad, 0xdd, 0x8e, 0x92, 0x41, 0xd4, 0xb0, 0x33, 0xe2,
-	0x8a, 0x61, 0x96, 0x6d, 0x42, 0x15, 0x53, 0xbb, 0x9d, 0xc7, 0xad, 0xc3, 0xde, 0x48, 0xc9, 0xb1,
-	0x3a, 0x54, 0xfa, 0x03, 0xbd, 0xd5, 0x1e, 0x75, 0x07, 0x7d, 0x25, 0xaf, 0x7e, 0x06, 0xe5, 0xf6,
-	0xb1, 0x35, 0x39, 0xb9, 0x68, 0x14, 0xc9, 0x76, 0xb3, 0x26, 0x27, 0x42, 0xc9, 0x5b, 0xb2, 0xdd,
-	0xac, 0xc9, 0x89, 0xfa, 0x0c, 0x6a, 0xed, 0x88, 0x91, 0x5f, 0x54, 0xca, 0x03, 0x68, 0xd0, 0xe6,
-	0x9b, 0x8c, 0xa3, 0xdd, 0x97, 0x5d, 0xb3, 0xfb, 0x6a, 0x48, 0xd3, 0x1e, 0x8b, 0xed, 0xf7, 0x21,
-	0x54, 0x0f, 0x7c, 0x6f, 0x6e, 0xf9, 0x21, 0x15, 0xab, 0x40, 0xee, 0xc4, 0x3a, 0x17, 0xa5, 0xe2,
-	0x67, 0x62, 0xdd, 0x66, 0x65, 0xeb, 0xf6, 0x01, 0x94, 0xa3, 0x6c, 0xdf, 0x3a, 0xcf, 0x8f, 0x90,
-	0x8b, 0x51, 0x1e, 0xdb, 0x0a, 0xb0, 0xb2, 0xfb, 0x00, 0xf3, 0x18, 0x20, 0x34, 0x86, 0x48, 0x7d,
-	0x15, 0x85, 0x6b, 0x12, 0x85, 0xfa, 0x97, 0x39, 0x68, 0x1c, 0x18, 0x7e, 0x68, 0xe3, 0xe4, 0xf0,
-	0x61, 0x78, 0x0b, 0xf2, 0xb4, 0xe4, 0xb9, 0x21, 0x7d, 0x29, 0xd6, 0x7d, 0x39, 0x0d, 0x89, 0x7e,
-	0x22, 0x60, 0x9f, 0x42, 0x63, 0x1e, 0x81, 0x75, 0xe2, 0xe7, 0x7c, 0x6c, 0x96, 0xb3, 0xd0, 0x98,
-	0xd7, 0xe7, 0x72, 0x92, 0xfd, 0x10, 0x2e, 0xa7, 0xf3, 0x5a, 0x41, 0x90, 0xf0, 0x51, 0x79, 0xb2,
-	0x2e, 0xa5, 0x32, 0x72, 0x32, 0xd6, 0x86, 0xad, 0x24, 0xfb, 0xc4, 0x73, 0x16, 0x33, 0x37, 0x10,
-	0xca, 0xf8, 0xd5, 0xa5, 0xda, 0xdb, 0x1c, 0xab, 0x29, 0xf3, 0x25, 0x08, 0x53, 0xa1, 0x16, 0xc3,
-	0xfa, 0x8b, 0x19, 0x6d, 0x89, 0xbc, 0x96, 0x82, 0xb1, 0x87, 0x00, 0x71, 0x1a, 0xcd, 0xaf, 0xdc,
-	0x9a, 0xfe, 0x75, 0x43, 0x6b, 0xa6, 0x49, 0x64, 0xa8, 0x32, 0x20, 0x33, 0xf0, 0xed, 0xf0, 0x78,
-	0x46, 0x5c, 0x2c, 0xa7, 0x25, 0x00, 0x62, 0x96, 0x81, 0x8e, 0xb6, 0x5e, 0x9c, 0x45, 0x30, 0xb4,
-	0x86, 0x1d, 0x0c, 0x17, 0xe3, 0xb8, 0x5c, 0x14, 0x83, 0x49, 0x2f, 0x67, 0xc1, 0x91, 0xb0, 0x88,
-	0x93, 0x16, 0xee, 0x07, 0x47, 0xec, 0x01, 0x5c, 0x49, 0x88, 0x12, 0xfe, 0x1b, 0x34, 0x81, 0x38,
-	0x77, 0x32, 0x7c, 0x31, 0x13, 0x0e, 0xd4, 0xcf, 0xa1, 0x9e, 0x9a, 0x9d, 0x97, 0x0a, 0xe4, 0xeb,
-	0x50, 0xc6, 0xff, 0x28, 0x8e, 0xc5, 0x02, 0x2c, 0x61, 0x7a, 0x18, 0xfa, 0xaa, 0x05, 0xca, 0xf2,
-	0x58, 0xb3, 0x3b, 0xe4, 0x25, 0xa2, 0x49, 0x59, 0xf5, 0xf6, 0x44, 0x28, 0x34, 0xfa, 0x57, 0x27,
-	0x31, 0x4b, 0xad, 0x5e, 0x99, 0x2c, 0xf5, 0xef, 0x67, 0xa5, 0x36, 0xe3, 0x88, 0xb3, 0x37, 0xe4,
-	0xe5, 0x27, 0x6d, 0xdc, 0x64, 0xcc, 0x48, 0xe2, 0xbc, 0x0d, 0x8a, 0xe7, 0x9b, 0xb6, 0x6b, 0x90,
-	0xd7, 0x8a, 0x0f, 0x77, 0x96, 0x34, 0xbc, 0x4d, 0x01, 0x3f, 0x10, 0x60, 0xb4, 0x10, 0x4c, 0x2b,
-	0x76, 0x02, 0x08, 0x13, 0x5e, 0x06, 0xc9, 0xd2, 0x29, 0x9f, 0x96, 0x4e, 0x6f, 0x41, 0xc5, 0xb1,
-	0x82, 0x40, 0x0f, 0x8f, 0x0d, 0x97, 0xe4, 0x77, 0xba, 0xd3, 0x65, 0x44, 0x8e, 0x8e, 0x0d, 0x17,
-	0x09, 0x6d, 0x57, 0x17, 0x6e, 0xfe, 0xe2, 0x2a, 0xa1, 0xed, 0x92, 0x11, 0x84, 0x72, 0xff, 0xf2,
-	0xba, 0x89, 0x15, 0x62, 0x91, 0xad, 0xce, 0xab, 0xfa, 0x2a, 0x94, 0x9e, 0xd9, 0xd6, 0xa9, 0xe0,
-	0x65, 0x2f, 0x6c, 0xeb, 0x34, 0xe2, 0x65, 0xf8, 0xad, 0xfe, 0xa7, 0x32, 0x94, 0x89, 0x78, 0xf7,
-	0x62, 0xef, 0xe0, 0x77, 0xb1, 0x10, 0xb6, 0x85, 0x9c, 0xca, 0xaf, 0xb1, 0x4b, 0xb8, 0xd4, 0x7a,
-	0x15, 0x40, 0x92, 0xa1, 0x5c, 0x23, 0xa8, 0x84, 0xb1, 0xe8, 0x44, 0xd5, 0x9a, 0x14, 0xb3, 0xe0,
-	0x6b, 0x47, 0xb8, 0x36, 0x12, 0x00, 0xbb, 0xcf, 0x15, 0x5f, 0x72, 0x6a, 0x94, 0x64, 0xc6, 0x42,
-	0x7d, 0x88, 0xec, 0x60, 0xd2, 0x86, 0x31, 0x41, 0xfa, 0x81, 0xe5, 0x07, 0xd1, 0x76, 0xaa, 0x6b,
-	0x51, 0x12, 0x39, 0x1a, 0x2a, 0x4f, 0xc2, 0x6e, 0x8d, 0xb6, 0xaf, 0xac, 0xfd, 0x69, 0x44, 0xc0,
-	0xee, 0x42, 0x89, 0x44, 0xb6, 0x85, 0x12, 0x5c, 0x62, 0x9d, 0x91, 0x32, 0xa5, 0x45, 0x68, 0xf6,
-	0x36, 0x14, 0xa6, 0x27, 0xd6, 0x79, 0xd0, 0xac, 0xcb, 0x2c, 0x21, 0x25, 0x0b, 0x35, 0x4e, 0xc1,
-	0xee, 0x40, 0xc3, 0xb7, 0xa6, 0x3a, 0xf9, 0x0b, 0x51, 0x78, 0x07, 0xcd, 0x06, 0xc9, 0xe6, 0x9a,
-	0x6f, 0x4d, 0xdb, 0x08, 0x1c, 0x8d, 0x9d, 0x80, 0xbd, 0x09, 0x45, 0x92, 0x4a, 0x68, 0x17, 0x48,
-	0x35, 0x47, 0x22, 0x4e, 0x13, 0x58, 0xf6, 0x00, 0x2a, 0x09, 0xdb, 0xb8, 0x42, 0x1d, 0xba, 0xbc,
-	0xc4, 0x8f, 0x88, 0x8d, 0x6b, 0x09, 0x19, 0x7b, 0x1f, 0x40, 0x58, 0x2c, 0xfa, 0xf8, 0x9c, 0x3c,
-	0xf0, 0xd5, 0xd8, 0xa2, 0x93, 0x04, 0xa0, 0x6c, 0xd7, 0xbc, 0x05, 0x05, 0x94, 0x12, 0x41, 0xf3,
-	0x1a, 0xb5, 0x66, 0x2b, 0x2d, 0x42, 0xa8, 0x77, 0x84, 0x67, 0x77, 0xa1, 0x8c, 0x8b, 0x4b, 0xc7,
-	0x29, 0x6c, 0xca, 0x26, 0x9c, 0x58, 0x89, 0xa8, 0xa5, 0x59, 0xa7, 0xc3, 0xaf, 0x1d, 0x76, 0x0f,
-	0xf2, 0xa6, 0x35, 0x0d, 0x9a, 0xd7, 0xa9, 0xc4, 0xab, 0xd2, 0x5c, 0xa2, 0xe2, 0xb0, 0x6b, 0x4d,
-	0xb9, 0x68, 0x41, 0x1a, 0xb6, 0x07, 0x0d, 0x5c, 0x7a, 0x0f, 0x48, 0xf1, 0xc6, 0x21, 0x6f, 0xde,
-	0xa0, 0x5c, 0xaf, 0x2d, 0xe5, 0xea, 0x0b, 0x22, 0x9a, 0xa0, 0x8e, 0x1b, 0xfa, 0xe7, 0x5a, 0xdd,
-	0x95, 0x61, 0xec, 0x06, 0x94, 0xed, 0xa0, 0xe7, 0x4d, 0x4e, 0x2c, 0xb3, 0xf9, 0x0a, 0x3f, 0xb4,
-	0x8b, 0xd2, 0xec, 0x13, 0xa8, 0xd3, 0x62, 0xc4, 0x24, 0x56, 0xde, 0xbc, 0x29, 0x8b, 0xbc, 0x91,
-	0x8c, 0xd2, 0xd2, 0x94, 0xa8, 0x6e, 0xd9, 0x81, 0x1e, 0x5a, 0xb3, 0xb9, 0xe7, 0xa3, 0xf1, 0xf7,
-	0x2a, 0x37, 0x78, 0xec, 0x60, 0x14, 0x81, 0x90, 0xcf, 0xc7, 0xe7, 0x85, 0xba, 0x37, 0x9d, 0x06,
-	0x56, 0xd8, 0xbc, 0x45, 0x7b, 0xad, 0x11, 0x1d, 0x1b, 0x0e, 0x08, 0x4a, 0x4a, 0x69, 0xa0, 0x9b,
-	0xe7, 0xae, 0x31, 0xb3, 0x27, 0xcd, 0xdb, 0xdc, 0xc6, 0xb4, 0x83, 0x5d, 0x0e, 0x90, 0xcd, 0xbc,
-	0x6d, 0xd9, 0xcc, 0xbb, 0xf1, 0x84, 0xac, 0x38, 0x6a, 0xcf, 0x87, 0x4b, 0x72, 0x3f, 0xb5, 0xd0,
-	0x25, 0x05, 0x61, 0x6f, 0x43, 0x16, 0xff, 0x3b, 0x05, 0xc8, 0x99, 0xd6, 0xf4, 0xc6, 0x67, 0xc0,
-	0x56, 0x47, 0xf2, 0x65, 0x4a, 0x48, 0x41, 0x28, 0x21, 0x9f, 0x66, 0x1f, 0x65, 0xd4, 0x4f, 0xa0,
-	0x9e, 0xda, 0x96, 0x6b, 0x95, 0x29, 0x6e, 0x54, 0x18, 0x33, 0xe1, 0x38, 0xe1, 0x09, 0xf5, 0xdf,
-	0xe5, 0xa0, 0xb6, 0x67, 0x04, 0xc7, 0xfb, 0xc6, 0x7c, 0x18, 0x1a, 0x61, 0x80, 0x63, 0x7b, 0x6c,
-	0x04, 0xc7, 0x33, 0x63, 0xce, 0xfd, 0xea, 0x19, 0xee, 0xa9, 0x11, 0xb0, 0xa1, 0xfd, 0x8d, 0x85,
-	0xb3, 0x8a, 0xc9, 0x81, 0x7b, 0xf0, 0x54, 0x9c, 0xcf, 0xc4, 0x69, 0xe4, 0x03, 0xc1, 0xf1, 0x62,
-	0x3a, 0x75, 0x2c, 0xc1, 0xaf, 0xa2, 0x24, 0xbb, 0x03, 0x75, 0xf1, 0x49, 0xe6, 0xdb, 0x99, 0x38,
-	0xac, 0x4d, 0x03, 0xd9, 0x43, 0xa8, 0x0a, 0xc0, 0x28, 0xe2, 0x5a, 0x8d, 0xd8, 0x73, 0x96, 0x20,
-	0x34, 0x99, 0x8a, 0xfd, 0x18, 0xae, 0x48, 0xc9, 0xc7, 0x9e, 0xbf, 0xbf, 0x70, 0x42, 0xbb, 0xdd,
-	0x17, 0xba, 0xf2, 0x2b, 0x2b, 0xd9, 0x13, 0x12, 0x6d, 0x7d, 0xce, 0x74, 0x6b, 0xf7, 0x6d, 0x57,
-	0x68, 0x12, 0x69, 0xe0, 0x12, 0x95, 0x71, 0x46, 0xbc, 0x2f, 0x4d, 0x65, 0x9c, 0xe1, 0x4a, 0x17,
-	0x80, 0x7d, 0x2b, 0x3c, 0xf6, 0x4c, 0xd2, 0x24, 0xe2, 0x95, 0x3e, 0x94, 0x51, 0x5a, 0x9a, 0x12,
-	0x87, 0x13, 0xcd, 0xf8, 0x89, 0x1b, 0x92, 0xb9, 0x94, 0xd3, 0xa2, 0x24, 0xca, 0x05, 0xdf, 0x70,
-	0x8f, 0xac, 0xa0, 0x59, 0xdd, 0xce, 0xdd, 0xcd, 0x68, 0x22, 0xa5, 0xfe, 0x8d, 0x2c, 0x14, 0xf8,
-	0x4c, 0xbe, 0x02, 0x95, 0xb1, 0xe3, 0x4d, 0x4e, 0x74, 0x77, 0x31, 0x8b, 0x9c, 0xee, 0x04, 0x40,
-	0xd5, 0x8a, 0xcc, 0x9c, 0x80, 0x3b, 0x61, 0x33, 0x1a, 0x7d, 0x63, 0x91, 0xde, 0x22, 0xc4, 0xba,
-	0x72, 0x04, 0x15, 0x29, 0x6c, 0x84, 0xef, 0x9d, 0xd2, 0x6a, 0xc8, 0x13, 0x22, 0x4a, 0x92, 0x5f,
-	0x9f, 0x44, 0x0c, 0x66, 0x2a, 0x10, 0xae, 0x4c, 0x80, 0xb6, 0x1b, 0x2e, 0xbb, 0xfc, 0x8a, 0x2b,
-	0x2e, 0x3f, 0x76, 0x0b, 0xd0, 0x88, 0x9a, 0x58, 0x03, 0xd7, 0x6a, 0xf7, 0x69, 0x84, 0xcb, 0x9a,
-	0x04, 0x61, 0x1f, 0xc5, 0x6b, 0x91, 0x7a, 0x24, 0x7c, 0xad, 0x82, 0x79, 0xca, 0xab, 0x56, 0x4b,
-	0xd1, 0xa9, 0xcf, 0x01, 0x34, 0xef, 0x34, 0xb0, 0x42, 0x52, 0xaf, 0xae, 0x51, 0xf3, 0x53, 0xc7,
-	0x69, 0xde, 0xe9, 0x81, 0x17, 0x44, 0xa7, 0x92, 0xd9, 0xf8, 0x54, 0x32, 0xd6, 0xc4, 0x72, 0xeb,
-	0x35, 0x31, 0xf5, 0x5d, 0x28, 0xa1, 0x88, 0x35, 0x42, 0x83, 0xdd, 0x11, 0x6e, 0x48, 0xae, 0x62,
-	0x09, 0x07, 0x69, 0x52, 0xab, 0x70, 0x4c, 0xf6, 0xa2, 0x96, 0x50, 0x9e, 0xd7, 0x24, 0x2f, 0x47,
-	0xcc, 0xaa, 0x45, 0x81, 0x42, 0x68, 0xbf, 0x02, 0x15, 0x6c, 0x2c, 0x9d, 0x4c, 0x88, 0x96, 0x95,
-	0x7d, 0xef, 0xb4, 0x8d, 0x69, 0xf5, 0xbf, 0x64, 0xa0, 0x3a, 0xf0, 0x4d, 0x94, 0x11, 0xc3, 0xb9,
-	0x35, 0x79, 0xa9, 0xe2, 0x88, 0x22, 0xde, 0x73, 0x1c, 0x23, 0x56, 0xbb, 0x50, 0xc4, 0x47, 0x00,
-	0xf6, 0x3e, 0xe4, 0xa7, 0x8e, 0x71, 0x44, 0x9d, 0x8d, 0x0d, 0x4a, 0xa9, 0xf8, 0xe8, 0xfb, 0xb1,
-	0x63, 0x1c, 0x69, 0x44, 0xaa, 0xfe, 0x4e, 0x5c, 0x3f, 0x9d, 0x51, 0xc8, 0x27, 0x13, 0x1b, 0x74,
-	0x4a, 0x36, 0x6c, 0x2b, 0x19, 0x56, 0x86, 0xfc, 0x6e, 0x67, 0xd8, 0xe6, 0x66, 0x24, 0x1a, 0x94,
-	0x43, 0xfd, 0x71, 0x57, 0x1b, 0x8e, 0x94, 0x3c, 0x1d, 0xbb, 0x11, 0xa0, 0xd7, 0x1a, 0x8e, 0x94,
-	0x32, 0x03, 0x28, 0x1e, 0xf6, 0xbb, 0x3f, 0x3e, 0xec, 0x28, 0x8a, 0xfa, 0x1f, 0x32, 0x00, 0x89,
-	0x03, 0x9d, 0x7d, 0x0f, 0xaa, 0xa7, 0x94, 0xd2, 0xa5, 0x93, 0x15, 0xb9, 0x8f, 0xc0, 0xd1, 0xa4,
-	0x7e, 0x7c, 0x5f, 0xb2, 0x26, 0x50, 0xcc, 0xae, 0x1e, 0xb1, 0x54, 0xe7, 0x89, 0x84, 0x66, 0xef,
-	0x40, 0xd9, 0xc3, 0x7e, 0x20, 0x69, 0x4e, 0x96, 0xb1, 0x52, 0xf7, 0xb5, 0x92, 0xc7, 0x13, 0x28,
-	0x8e, 0xa7, 0x7e, 0xe4, 0x35, 0x8a, 0x49, 0x1f, 0x23, 0xa8, 0xed, 0x18, 0x8b, 0xc0, 0xd2, 0x38,
-	0x3e, 0x66, 0xbb, 0x85, 0x84, 0xed, 0xaa, 0x3f, 0x81, 0xc6, 0xd0, 0x98, 0xcd, 0x39, 0x73, 0xa6,
-	0x8e, 0x31, 0xc8, 0xe3, 0x9a, 0x10, 0x8b, 0x91, 0xbe, 0x71, 0x8b, 0x1d, 0x58, 0xfe, 0xc4, 0x72,
-	0xa3, 0x1d, 0x19, 0x25, 0x91, 0xd9, 0x1e, 0x06, 0xb6, 0x7b, 0xa4, 0x79, 0xa7, 0x51, 0xdc, 0x4b,
-	0x94, 0x56, 0xff, 0x71, 0x06, 0xaa, 0x52, 0x33, 0xd8, 0xbb, 0x29, 0xe3, 0xf1, 0x95, 0x95, 0x76,
-	0xf2, 0x6f, 0xc9, 0x88, 0x7c, 0x13, 0x0a, 0x41, 0x68, 0xf8, 0xd1, 0x59, 0x8c, 0x22, 0xe5, 0xd8,
-	0xf1, 0x16, 0xae, 0xa9, 0x71, 0x34, 0x53, 0x21, 0x67, 0xb9, 0xa6, 0xd8, 0x16, 0xab, 0x54, 0x88,
-	0x54, 0xb7, 0xa1, 0x12, 0x17, 0x8f, 0x4b, 0x40, 0x1b, 0x3c, 0x1f, 0x2a, 0x1b, 0xac, 0x02, 0x05,
-	0xad, 0xd5, 0x7f, 0xd2, 0x51, 0x32, 0xea, 0x9f, 0x66, 0x00, 0x92, 0x5c, 0xec, 0x7e, 0xaa, 0xb5,
-	0x37, 0x96, 0x4b, 0xbd, 0x4f, 0x7f, 0xa5, 0xc6, 0xde, 0x84, 0xca, 0xc2, 0x25, 0xa0, 0x65, 0x0a,
-	0xb9, 0x93, 0x00, 0xd8, 0x4d, 0xc8, 0x45, 0x11, 0x32, 0x4b, 0x51, 0x09, 0x2f, 0x0c, 0x47, 0xfd,
-	0x14, 0x2a, 0x71, 0x71, 0xac, 0x0e, 0x95, 0xc7, 0x83, 0x5e, 0x6f, 0xf0, 0xbc, 0xdb, 0x7f, 0xa2,
-	0x6c, 0x60, 0xf2, 0x40, 0xeb, 0xb4, 0x3b, 0xbb, 0x98, 0xcc, 0xe0, 0x9a, 0x6d, 0x1f, 0x6a, 0x5a,
-	0xa7, 0x3f, 0xd2, 0xb5, 0xc1, 0x73, 0x25, 0xab, 0xfe, 0x5e, 0x1e, 0xb6, 0x06, 0xee, 0xee, 0x62,
-	0xee, 0xd8, 0x13, 0x23, 0xb4, 0x9e, 0x5a, 0xe7, 0xed, 0xf0, 0x0c, 0xc5, 0xa9, 0x11, 0x86, 0x3e,
-	0xdf, 0xcc, 0x15, 0x8d, 0x27, 0xb8, 0x2f, 0x2e, 0xb0, 0xfc, 0x90, 0x5c, 0x8d, 0xf2, 0x2e, 0x6e,
-	0x70, 0x78, 0xdb, 0x73, 0x68, 0x2f, 0xb3, 0x1f, 0xc2, 0x15, 0xee, 0xbf, 0xe3, 0x94, 0xa8, 0x5f,
-	0xea, 0x82, 0xf7, 0x2c, 0x2f, 0x5d, 0xc6, 0x09, 0x31, 0x2b, 0x92, 0x11, 0x53, 0xbb, 0x0d, 0xd5,
-	0x24, 0x3b, 0xb7, 0x02, 0x2a, 0x1a, 0xc4, 0x84, 0xd4, 0x12, 0xcf, 0xd5, 0xcd, 0xa8, 0xd5, 0xba,
-	0x6d, 0x9e, 0x91, 0x65, 0x54, 0xd0, 0x1a, 0x5e, 0xd2, 0x19, 0x14, 0xb9, 0x5f, 0xc0, 0x56, 0x8a,
-	0x92, 0x5a, 0xc1, 0x6d, 0xa3, 0x77, 0x22, 0x5f, 0xfe, 0x52, 0xef, 0x65, 0x08, 0x36, 0x87, 0x2b,
-	0x7f, 0x9b, 0x5e, 0x1a, 0x8a, 0xcc, 0xcc, 0x0e, 0x74, 0xfb, 0xc8, 0xf5, 0x7c, 0x4b, 0xb0, 0xf7,
-	0xb2, 0x1d, 0x74, 0x29, 0x9d, 0x98, 0x27, 0xd2, 0x91, 0x34, 0x97, 0x26, 0xd1, 0x89, 0x2c, 0x47,
-	0xdb, 0x5c, 0x5e, 0xe6, 0xb5, 0x12, 0xa5, 0xbb, 0x26, 0x5a, 0xe6, 0x1c, 0x15, 0x59, 0x1c, 0x40,
-	0x16, 0x47, 0x8d, 0x80, 0xcf, 0x38, 0xec, 0x46, 0x1f, 0x2e, 0xaf, 0x6b, 0xe4, 0x1a, 0xbd, 0x6a,
-	0x5b, 0xd6, 0xab, 0x96, 0x7c, 0x55, 0x89, 0x8e, 0xf5, 0xcf, 0xb3, 0x50, 0xe9, 0xf2, 0x29, 0x0c,
-	0xcf, 0xd8, 0x6b, 0x90, 0xf3, 0xad, 0xe9, 0x45, 0xc7, 0xbd, 0x88, 0x63, 0xf7, 0x60, 0xcb, 0x30,
-	0x4d, 0xdd, 0x98, 0x4e, 0xad, 0x49, 0x68, 0x99, 0x3a, 0xca, 0x4c, 0xb1, 0x6c, 0x37, 0x0d, 0xd3,
-	0x6c, 0x09, 0x38, 0x6d, 0x7f, 0xee, 0x95, 0x88, 0xcc, 0x04, 0xee, 0x3c, 0xcf, 0x45, 0x5e, 0x09,
-	0x61, 0x25, 0x90, 0x86, 0xc7, 0xbe, 0x17, 0x49, 0x5c, 0xd3, 0x9a, 0x0a, 0x7e, 0xd4, 0x48, 0xab,
-	0xe5, 0x42, 0x02, 0x73, 0x7f, 0xd4, 0xa5, 0x65, 0x23, 0xd6, 0x36, 0xb9, 0x83, 0x3b, 0xaf, 0x6d,
-	0xa5, 0x6d, 0xd8, 0xae, 0x19, 0x5c, 0xec, 0xcd, 0x28, 0x5e, 0xe8, 0xcd, 0x48, 0xbb, 0x49, 0x70,
-	0x91, 0x95, 0x68, 0xb9, 0x27, 0xec, 0xb8, 0x6b, 0x9e, 0xa9, 0x7f, 0x2f, 0x07, 0xa0, 0x59, 0x73,
-	0xc7, 0x98, 0x58, 0xff, 0xef, 0x8c, 0xde, 0x6d, 0xa8, 0x72, 0x77, 0xad, 0x3e, 0xf1, 0x5c, 0x33,
-	0x0a, 0xc6, 0xe0, 0xa0, 0xb6, 0x47, 0x0c, 0x6c, 0xed, 0xf0, 0x16, 0xbf, 0xf3, 0xf0, 0x96, 0xbe,
-	0xc3, 0xf0, 0x96, 0x57, 0x87, 0x97, 0x7d, 0x06, 0xaf, 0xfa, 0xd6, 0xa9, 0x6f, 0x87, 0x96, 0x3e,
-	0xf5, 0xbd, 0x99, 0x9e, 0xda, 0xce, 0xb8, 0xda, 0x2b, 0x34, 0x1a, 0xd7, 0x05, 0xd1, 0x63, 0xdf,
-	0x9b, 0xa5, 0xb7, 0xb4, 0xfa, 0x57, 0x79, 0xa8, 0xb6, 0x5c, 0xc3, 0x39, 0xff, 0xc6, 0xa2, 0x80,
-	0x0d, 0xf2, 0xd4, 0xcf, 0x17, 0x21, 0x1f, 0x77, 0x7e, 0x60, 0x5a, 0x21, 0x08, 0x8d, 0xf8, 0x6d,
-	0xa8, 0x7a, 0x8b, 0x30, 0xc6, 0xf3, 0x23, 0x54, 0xe0, 0x20, 0x22, 0x88, 0xf3, 0x93, 0xd6, 0x98,
-	0x93, 0xf2, 0x93, 0x05, 0x91, 0xe4, 0x8f, 0xb5, 0xca, 0x38, 0x3f, 0x11, 0xe0, 0x16, 0xb7, 0x67,
-	0x34, 0xf2, 0xc1, 0x62, 0x66, 0xf1, 0xd1, 0xcf, 0xf1, 0xc0, 0xb8, 0xb6, 0x80, 0x61, 0x29, 0x33,
-	0x6b, 0xe6, 0xf9, 0xe7, 0xbc, 0x94, 0x22, 0x2f, 0x85, 0x83, 0xa8, 0x94, 0x77, 0x80, 0x9d, 0x1a,
-	0x76, 0xa8, 0xa7, 0x8b, 0xe2, 0x9a, 0xbc, 0x82, 0x98, 0x91, 0x5c, 0xdc, 0x55, 0x28, 0x9a, 0x76,
-	0x70, 0xd2, 0x1d, 0x08, 0x2d, 0x5e, 0xa4, 0x90, 0x8b, 0x05, 0x0f, 0xbb, 0x03, 0x7d, 0x7c, 0x2e,
-	0xce, 0x38, 0x73, 0x5a, 0x19, 0x01, 0x3b, 0xe7, 0x21, 0x1d, 0xbe, 0x10, 0x92, 0xf7, 0x96, 0x33,
-	0x7c, 0xae, 0xa9, 0x37, 0x10, 0xde, 0x45, 0x30, 0x67, 0xf8, 0xf7, 0x60, 0x8b, 0x28, 0x45, 0xc7,
-	0x39, 0x69, 0x95, 0x48, 0x37, 0x11, 0x31, 0x20, 0x38, 0xa7, 0xbd, 0x09, 0x15, 0xd7, 0x0a, 0x4f,
-	0x3d, 0x1f, 0x5b, 0x53, 0xe3, 0xa3, 0x17, 0x03, 0x50, 0x25, 0x08, 0x26, 0x86, 0x8b, 0x8d, 0xa7,
-	0x73, 0x4f, 0x6c, 0x8f, 0x48, 0xa3, 0x4a, 0xcd, 0x05, 0x0d, 0x61, 0x1b, 0x7c, 0x48, 0x12, 0x08,
-	0xfb, 0x04, 0xae, 0xa7, 0x46, 0x43, 0x37, 0x7c, 0xdf, 0x38, 0xd7, 0x67, 0xc6, 0x57, 0x9e, 0x4f,
-	0xce, 0x8f, 0x9c, 0x76, 0x55, 0x1e, 0xe4, 0x16, 0xa2, 0xf7, 0x11, 0x7b, 0x61, 0x56, 0xdb, 0xf5,
-	0xfc, 0xa6, 0x72, 0x51, 0x56, 0xc4, 0x92, 0xc1, 0x4e, 0x03, 0x44, 0xf6, 0x47, 0x40, 0x47, 0xa9,
-	0x39, 0xad, 0x4a, 0xb0, 0x1d, 0x02, 0xa9, 0xbe, 0xe4, 0x0a, 0x3f, 0xf0, 0x17, 0xae, 0xc5, 0x9d,
-	0x07, 0xf4, 0x69, 0x8a, 0x93, 0xc4, 0x38, 0xcd, 0x76, 0xe1, 0x12, 0x37, 0x24, 0x2c, 0x53, 0x97,
-	0x5c, 0xc4, 0xd9, 0x8b, 0x5d, 0xc4, 0x2c, 0xa2, 0x8f, 0xc1, 0x81, 0xfa, 0xd3, 0x0c, 0xdc, 0x18,
-	0xd0, 0xa9, 0x26, 0xed, 0xb8, 0x7d, 0x2b, 0x08, 0x8c, 0x23, 0xb4, 0x02, 0x1f, 0x2f, 0xbe, 0xf9,
-	0xe6, 0x9c, 0xdd, 0x85, 0xcd, 0x03, 0xc3, 0xb7, 0xdc, 0x30, 0xde, 0x8f, 0x42, 0x6c, 0x2c, 0x83,
-	0xd9, 0x23, 0x72, 0xc3, 0x5a, 0x6e, 0x78, 0x18, 0x0b, 0x60, 0xd1, 0x96, 0xb4, 0x63, 0x6e, 0x85,
-	0x4a, 0xfd, 0x07, 0x37, 0x21, 0xdf, 0xf7, 0x4c, 0x8b, 0xbd, 0x07, 0x15, 0x0a, 0x8b, 0x5b, 0xf5,
-	0xfe, 0x23, 0x9a, 0xfe, 0x90, 0x2e, 0x54, 0x76, 0xc5, 0xd7, 0xc5, 0x81, 0x74, 0xaf, 0x91, 0x56,
-	0x47, 0xc7, 0x87, 0xc8, 0xe1, 0xaa, 0xc2, 0xce, 0x24, 0x43, 0x89, 0x63, 0x70, 0x6c, 0xc9, 0x25,
-	0xe6, 0x5b, 0x2e, 0xe9, 0x0e, 0x05, 0x2d, 0x4e, 0x93, 0x2e, 0xed, 0x7b, 0xc8, 0x8d, 0x75, 0x8a,
-	0x25, 0x29, 0xac, 0xd1, 0xa5, 0x39, 0x9e, 0x22, 0x0b, 0xdf, 0x83, 0xca, 0x57, 0x9e, 0xed, 0xf2,
-	0x86, 0x17, 0x57, 0x1a, 0xfe, 0xb9, 0x67, 0xf3, 0x63, 0x8b, 0xf2, 0x57, 0xe2, 0x8b, 0xbd, 0x0e,
-	0x25, 0xcf, 0xe5, 0x65, 0x97, 0x56, 0xca, 0x2e, 0x7a, 0x6e, 0x8f, 0xc7, 0xa8, 0xd4, 0xc7, 0x0b,
-	0xdb, 0x31, 0x91, 0x99, 0x39, 0xd6, 0x34, 0x14, 0x5e, 0xfa, 0x2a, 0x01, 0x07, 0x6e, 0xcf, 0x9a,
-	0x86, 0x68, 0x22, 0x4c, 0x6d, 0x07, 0x99, 0x3e, 0x15, 0x56, 0x59, 0x29, 0x0c, 0x38, 0x9a, 0x0a,
-	0x7c, 0x03, 0xca, 0x47, 0xbe, 0xb7, 0x98, 0xa3, 0xce, 0x0f, 0xab, 0x7e, 0x71, 0xc2, 0xed, 0x9c,
-	0x63, 0xef, 0xe9, 0xd3, 0x76, 0x8f, 0xf4, 0xc0, 0x0a, 0xc9, 0xba, 0x5e, 0xea, 0x7d, 0x84, 0x1f,
-	0x5a, 0x54, 0xaa, 0x71, 0x74, 0xa4, 0x8b, 0xa0, 0x9b, 0x95, 0x52, 0x8d, 0xa3, 0x23, 0xaa, 0xfc,
-	0x3e, 0xd4, 0x4f, 0x6d, 0x57, 0x0f, 0xe6, 0xd6, 0x84, 0xd3, 0xd6, 0x57, 0x8b, 0x3d, 0xb5, 0x5d,
-	0xb4, 0x0f, 0x88, 0x5e, 0x36, 0x50, 0x1a, 0x2f, 0x35, 0x50, 0xb6, 0xa1, 0xe0, 0xd8, 0x33, 0x3b,
-	0xa4, 0xa8, 0x86, 0x25, 0x0d, 0x86, 0x10, 0x4c, 0x85, 0xa2, 0x70, 0x82, 0x29, 0x2b, 0x24, 0x02,
-	0x93, 0x16, 0x8e, 0x5b, 0x2f, 0x11, 0x8e, 0x77, 0xa1, 0xe4, 0x8d, 0xbf, 0xd2, 0x51, 0x8c, 0xb3,
-	0xf5, 0x62, 0xbc, 0xe8, 0x8d, 0xbf, 0xd2, 0xac, 0x29, 0xfb, 0x90, 0x4e, 0x0a, 0x2c, 0x37, 0xd4,
-	0xa3, 0x0c, 0x97, 0xd6, 0x67, 0xa8, 0x71, 0xb2, 0x01, 0xcf, 0xf6, 0x3e, 0x54, 0x7d, 0xb2, 0x9c,
-	0x75, 0x32, 0xb3, 0x2f, 0xcb, 0xa6, 0x47, 0x62, 0x52, 0x6b, 0xe0, 0x27, 0xe6, 0xf5, 0xeb, 0x50,
-	0xe7, 0xd1, 0x07, 0xfc, 0xb8, 0x39, 0x20, 0x67, 0x6b, 0x45, 0xab, 0x11, 0x90, 0x1f, 0x45, 0x07,
-	0xec, 0x3e, 0x40, 0x24, 0xd5, 0xc3, 0x33, 0x0a, 0x79, 0x8e, 0x9b, 0xc2, 0x4f, 0x5b, 0xdb, 0xe1,
-	0x99, 0x56, 0x31, 0xa3, 0x4f, 0x64, 0x5d, 0x63, 0xdb, 0x35, 0x71, 0x39, 0x84, 0xc6, 0x51, 0xd0,
-	0x6c, 0xd2, 0x6e, 0xa9, 0x0a, 0xd8, 0xc8, 0x38, 0x0a, 0xd8, 0x07, 0x50, 0x33, 0xb8, 0xec, 0xe4,
-	0x61, 0x91, 0xd7, 0x65, 0x33, 0x51, 0x92, 0xaa, 0x5a, 0xd5, 0x90, 0x44, 0xec, 0xc7, 0xc0, 0x22,
-	0x0f, 0x3b, 0xa9, 0xdc, 0x7c, 0x5d, 0xdc, 0x58, 0x59, 0x17, 0x9b, 0xc2, 0xc5, 0x1e, 0x87, 0xf2,
-	0x7e, 0x0c, 0xf5, 0xb4, 0xae, 0x73, 0x73, 0x8d, 0x4f, 0x99, 0xa6, 0x4c, 0xab, 0x4d, 0x64, 0xed,
-	0xe7, 0x75, 0xa8, 0xbb, 0x5e, 0xa8, 0x4f, 0x8c, 0xc9, 0xb1, 0x45, 0x19, 0xb9, 0xdf, 0xb4, 0xe6,
-	0x7a, 0x61, 0x3b, 0x82, 0xe1, 0xf8, 0x44, 0x16, 0x4c, 0x78, 0x46, 0x2e, 0xd3, 0x78, 0x7c, 0x62,
-	0xf5, 0x17, 0x45, 0x79, 0xa4, 0x09, 0xe3, 0x3c, 0x71, 0xcd, 0x8e, 0x32, 0xdc, 0x4e, 0xcd, 0x53,
-	0xac, 0xf2, 0x69, 0xe0, 0x27, 0xea, 0xdf, 0x6d, 0xa8, 0x06, 0xde, 0xc2, 0x9f, 0x58, 0x7a, 0x10,
-	0x5a, 0xf3, 0xe6, 0x36, 0x8d, 0x28, 0x70, 0xd0, 0x30, 0xb4, 0xe6, 0xec, 0x11, 0x34, 0xe6, 0xbe,
-	0xa5, 0x4b, 0xf3, 0xf4, 0x9a, 0xdc, 0xc5, 0x03, 0xdf, 0x4a, 0xa6, 0xaa, 0x36, 0x97, 0x52, 0x51,
-	0x4e, 0xa9, 0x07, 0xea, 0x52, 0xce, 0xa4, 0x13, 0x98, 0x33, 0xd1, 0xe8, 0x7f, 0x04, 0x5b, 0x52,
-	0xce, 0xc5, 0x09, 0x65, 0x7e, 0x3d, 0xe5, 0xe2, 0x8f, 0xc8, 0x0f, 0x4f, 0x30, 0x7b, 0x63, 0x9e,
-	0x4a, 0xb3, 0xd6, 0x92, 0xc1, 0x85, 0x7a, 0xd7, 0x1d, 0xca, 0x7f, 0xed, 0x02, 0x2b, 0x2a, 0x65,
-	0x89, 0x3d, 0xe5, 0x1e, 0xde, 0x6e, 0xd0, 0x71, 0xcd, 0xe6, 0x1b, 0x3c, 0xde, 0x9e, 0x12, 0xec,
-	0x21, 0xd4, 0xc8, 0x8d, 0x17, 0x52, 0xac, 0x5f, 0xd0, 0x7c, 0x53, 0xf6, 0x38, 0x91, 0x4f, 0x9c,
-	0x10, 0x5a, 0xd5, 0x89, 0xbf, 0x03, 0xf6, 0x11, 0x6c, 0x71, 0xe7, 0x9f, 0xcc, 0x20, 0xdf, 0x5a,
-	0x5d, 0x5c, 0x44, 0xf4, 0x38, 0xe1, 0x92, 0x1a, 0x5c, 0xf7, 0x17, 0x2e, 0xc9, 0x79, 0x91, 0x73,
-	0xee, 0x7b, 0x63, 0x8b, 0xe7, 0xbf, 0x4b, 0xf9, 0x45, 0x77, 0x34, 0x4e, 0xc6, 0xf3, 0x12, 0x3f,
-	0xba, 0xea, 0xcb, 0xa0, 0x03, 0xcc, 0x77, 0x41, 0x99, 0x9c, 0xb3, 0x53, 0x99, 0x6f, 0x7f, 0x97,
-	0x32, 0x77, 0x30, 0x1f, 0x95, 0xc9, 0x20, 0xbf, 0x58, 0xd8, 0x66, 0xf3, 0x1e, 0x8f, 0x02, 0xc4,
-	0x6f, 0xf6, 0x06, 0x34, 0x7c, 0x6b, 0xb2, 0xf0, 0x03, 0xfb, 0x85, 0xa5, 0x07, 0xb6, 0x7b, 0xd2,
-	0xfc, 0x1e, 0x8d, 0x63, 0x3d, 0x86, 0x0e, 0x6d, 0xf7, 0x04, 0x57, 0xac, 0x75, 0x16, 0x5a, 0xbe,
-	0xab, 0xa3, 0xd6, 0xd4, 0x7c, 0x47, 0x5e, 0xb1, 0x1d, 0x42, 0x0c, 0x27, 0x86, 0xab, 0x81, 0x15,
-	0x7f, 0xb3, 0x1f, 0xc2, 0x66, 0xa2, 0x85, 0xcf, 0x51, 0x05, 0x69, 0x7e, 0x7f, 0xed, 0xe9, 0x0f,
-	0xa9, 0x27, 0x5a, 0x72, 0x34, 0xca, 0x35, 0x99, 0xf4, 0xda, 0x0a, 0xf8, 0xda, 0xba, 0xff, 0xad,
-	0xd6, 0xd6, 0x90, 0xd6, 0xd6, 0x9b, 0x50, 0xb6, 0xdd, 0xd0, 0xf2, 0x5f, 0x18, 0x4e, 0xf3, 0xdd,
-	0x15, 0x06, 0x1e, 0xe3, 0xd8, 0x1d, 0x28, 0x05, 0x8e, 0x8d, 0x8c, 0xa9, 0xf9, 0xde, 0x0a, 0x59,
-	0x84, 0x42, 0x89, 0x3d, 0xb5, 0x1d, 0x87, 0x4b, 0xec, 0xf7, 0x57, 0x24, 0xf6, 0x63, 0xdb, 0x71,
-	0xb8, 0xc4, 0x9e, 0x8a, 0x2f, 0x94, 0x72, 0x94, 0x03, 0xeb, 0x7f, 0xb0, 0x2a, 0xe5, 0x10, 0xf7,
-	0x8c, 0x2e, 0xd0, 0x54, 0x03, 0xf2, 0x75, 0x71, 0x97, 0xdd, 0x43, 0xb9, 0x87, 0x69, 0x27, 0x98,
-	0x06, 0x41, 0x9c, 0x46, 0x63, 0x41, 0x78, 0xfa, 0xd0, 0xc0, 0xf9, 0x80, 0xc7, 0x75, 0x73, 0x08,
-	0x5a, 0x37, 0xef, 0x41, 0x3d, 0x8a, 0x66, 0xc1, 0xea, 0x82, 0xe6, 0x87, 0x2b, 0x2d, 0x48, 0x13,
-	0xb0, 0x5d, 0xa8, 0x4d, 0x51, 0x83, 0x9b, 0x71, 0x85, 0xae, 0xf9, 0x11, 0x35, 0x64, 0x3b, 0x92,
-	0xa0, 0x17, 0x29, 0x7c, 0x5a, 0x2a, 0x17, 0xbb, 0x0f, 0xcc, 0x9e, 0xf2, 0x59, 0x40, 0x8b, 0x89,
-	0x2b, 0x6d, 0xcd, 0x8f, 0x69, 0x49, 0xad, 0xc1, 0xb0, 0x87, 0x50, 0x0f, 0x2c, 0xd7, 0xd4, 0x67,
-	0x81, 0xd0, 0x07, 0x1e, 0x51, 0x3b, 0x05, 0xf3, 0x8c, 0xaf, 0x8f, 0x69, 0x55, 0xa4, 0xda, 0x0f,
-	0xb8, 0x62, 0xf0, 0x10, 0x70, 0x75, 0xbe, 0x48, 0x32, 0x7d, 0x72, 0x41, 0x26, 0xa4, 0x92, 0x32,
-	0xe1, 0xd2, 0xd5, 0x03, 0xd7, 0x98, 0x07, 0xc7, 0x5e, 0xd8, 0xfc, 0x54, 0x96, 0xd6, 0x43, 0x01,
-	0xd5, 0x6a, 0x48, 0x14, 0xa5, 0x90, 0xfb, 0x27, 0xbb, 0x63, 0x12, 0x5a, 0xcd, 0xdf, 0xe2, 0xdc,
-	0x3f, 0x06, 0xb6, 0x43, 0x4b, 0xfd, 0x47, 0x05, 0x28, 0x47, 0xaa, 0x26, 0xab, 0x42, 0xe9, 0xb0,
-	0xff, 0xb4, 0x3f, 0x78, 0xde, 0x57, 0x36, 0x58, 0x03, 0x80, 0x02, 0xc2, 0xf5, 0x61, 0xbb, 0xd5,
-	0xe7, 0x17, 0x28, 0x28, 0x0c, 0x9d, 0xa7, 0xb3, 0x6c, 0x0b, 0xea, 0x8f, 0x0f, 0xfb, 0x14, 0x1f,
-	0xc4, 0x41, 0x39, 0x04, 0x75, 0xbe, 0xe0, 0xee, 0x61, 0x0e, 0xca, 0x23, 0x68, 0xbf, 0x35, 0xea,
-	0x68, 0xdd, 0x08, 0x54, 0xa0, 0x50, 0xa3, 0xc1, 0xa1, 0xd6, 0x16, 0x25, 0x15, 0xb1, 0xda, 0x03,
-	0x6d, 0xf0, 0x79, 0xa7, 0x3d, 0x52, 0x80, 0x5d, 0x81, 0xad, 0xb8, 0x8c, 0xa8, 0x7c, 0xa5, 0xca,
-	0x6a, 0x50, 0x8e, 0xca, 0x51, 0x2e, 0x63, 0xa9, 0x5a, 0xa7, 0x7d, 0xa8, 0x0d, 0xbb, 0xcf, 0x3a,
-	0x7a, 0x7b, 0xd4, 0x51, 0xae, 0xb0, 0x32, 0xe4, 0x87, 0xdd, 0xfe, 0x53, 0xe5, 0x2a, 0xab, 0x43,
-	0x05, 0xbf, 0x78, 0xe9, 0xd7, 0x18, 0x83, 0x46, 0x42, 0x4b, 0xb0, 0x26, 0x79, 0xae, 0x9f, 0x3c,
-	0x51, 0x6e, 0x61, 0xb1, 0xbb, 0xdd, 0xe1, 0xa8, 0xdb, 0x6f, 0x8f, 0x94, 0xdb, 0x0c, 0xa0, 0xf8,
-	0xb8, 0xdb, 0x1b, 0x75, 0x34, 0x65, 0x1b, 0xcb, 0xfb, 0x7c, 0xd0, 0xed, 0x2b, 0xaf, 0x51, 0x98,
-	0x7d, 0x6b, 0xff, 0xa0, 0xd7, 0x51, 0x54, 0xaa, 0x65, 0xa0, 0x8d, 0x94, 0xd7, 0x59, 0x05, 0x0a,
-	0x87, 0x7d, 0x6c, 0xdb, 0x1d, 0xac, 0x90, 0x3e, 0xf5, 0x56, 0xaf, 0xa7, 0xbc, 0x21, 0xb9, 0xb8,
-	0xdf, 0xc4, 0xef, 0xe7, 0xdd, 0xfe, 0xee, 0xe0, 0xb9, 0xf2, 0x16, 0x92, 0xed, 0x68, 0x83, 0xd6,
-	0x6e, 0xbb, 0x35, 0x1c, 0x29, 0x77, 0xb1, 0x80, 0xe1, 0x41, 0xaf, 0x3b, 0x52, 0xde, 0x46, 0xaa,
-	0x27, 0xad, 0xd1, 0x5e, 0x47, 0x53, 0xee, 0xe1, 0x77, 0x6b, 0x38, 0xec, 0x68, 0x23, 0xe5, 0x01,
-	0x7e, 0x77, 0xfb, 0xf4, 0xfd, 0x10, 0xbf, 0x77, 0x3b, 0xbd, 0xce, 0xa8, 0xa3, 0x7c, 0x80, 0x03,
-	0xa6, 0x75, 0x0e, 0x7a, 0xad, 0x76, 0x47, 0xf9, 0x10, 0x13, 0xbd, 0x41, 0xfb, 0xa9, 0x3e, 0x38,
-	0x50, 0x3e, 0xc2, 0x3a, 0xc8, 0x41, 0x3f, 0xc4, 0xc1, 0xfc, 0x18, 0xc7, 0x29, 0x4e, 0x52, 0xeb,
-	0x1e, 0x61, 0xb5, 0xfb, 0xdd, 0xfe, 0xe1, 0x50, 0xf9, 0x04, 0x89, 0xe9, 0x93, 0x30, 0x9f, 0xb2,
-	0xcb, 0xa0, 0x0c, 0xfa, 0xfa, 0xee, 0xe1, 0x41, 0xaf, 0xdb, 0x6e, 0x8d, 0x3a, 0xfa, 0xd3, 0xce,
-	0x97, 0xca, 0x6f, 0xe1, 0xb4, 0x1f, 0x68, 0x1d, 0x5d, 0xb4, 0xe3, 0x07, 0x51, 0x5a, 0xb4, 0xe5,
-	0x87, 0x58, 0x45, 0x82, 0xd7, 0x0f, 0x9f, 0x2a, 0xbf, 0xbd, 0x04, 0x1a, 0x3e, 0x55, 0x7e, 0x84,
-	0x73, 0x3e, 0xea, 0xee, 0x77, 0x74, 0x31, 0x18, 0x9f, 0xe1, 0x40, 0x3e, 0xee, 0xf6, 0x7a, 0x4a,
-	0x8b, 0xbc, 0xb1, 0x2d, 0x6d, 0xd4, 0xa5, 0x89, 0xde, 0x61, 0x0a, 0xd4, 0x1e, 0x1f, 0xfe, 0xe4,
-	0x27, 0x5f, 0xea, 0x62, 0x26, 0xda, 0xd8, 0xe2, 0xd6, 0xc1, 0x41, 0xef, 0x4b, 0x65, 0x57, 0xf5,
-	0xa1, 0x1c, 0x99, 0x17, 0x08, 0xee, 0xf6, 0xfb, 0x1d, 0x4d, 0xd9, 0xc0, 0xc2, 0x7a, 0x9d, 0xc7,
-	0x23, 0x25, 0x43, 0xce, 0xe7, 0xee, 0x93, 0xbd, 0x91, 0x92, 0xc5, 0xcf, 0xc1, 0x21, 0x96, 0x90,
-	0xa3, 0x59, 0xeb, 0xec, 0x77, 0x95, 0x3c, 0x7e, 0xb5, 0xfa, 0xa3, 0xae, 0x52, 0xa0, 0x59, 0xed,
-	0xf6, 0x9f, 0xf4, 0x3a, 0x4a, 0x11, 0xa1, 0xfb, 0x2d, 0xed, 0xa9, 0x52, 0xe2, 0x85, 0xee, 0x76,
-	0xbe, 0x50, 0xca, 0xac, 0x08, 0xd9, 0xde, 0x03, 0xa5, 0xa2, 0xde, 0x85, 0x52, 0xeb, 0xe8, 0x68,
-	0x1f, 0x4d, 0x36, 0x6c, 0xf4, 0x61, 0xaf, 0xc7, 0x6f, 0x16, 0xed, 0x0c, 0x46, 0xa3, 0xc1, 0xbe,
-	0x92, 0xc1, 0xc5, 0x34, 0x1a, 0x1c, 0x28, 0x59, 0xb5, 0x0b, 0xe5, 0x88, 0x95, 0x4a, 0xb7, 0x39,
-	0xca, 0x90, 0x3f, 0xd0, 0x3a, 0xcf, 0xf8, 0x31, 0x49, 0xbf, 0xf3, 0x05, 0xb6, 0x0d, 0xbf, 0xb0,
-	0xa0, 0x1c, 0x56, 0xc8, 0xaf, 0x5d, 0xd0, 0x75, 0x8e, 0x5e, 0xb7, 0xdf, 0x69, 0x69, 0x4a, 0x41,
-	0xfd, 0xff, 0xa1, 0x1c, 0xef, 0xe3, 0x3b, 0x90, 0x1d, 0x0d, 0x85, 0xef, 0xec, 0xf2, 0xfd, 0xe4,
-	0x8a, 0xed, 0x28, 0xfa, 0xd2, 0xb2, 0xa3, 0x21, 0x7b, 0x07, 0x8a, 0xfc, 0x82, 0x8d, 0xf0, 0x6a,
-	0x5e, 0x4e, 0xf3, 0x86, 0x11, 0xe1, 0x34, 0x41, 0xa3, 0xf6, 0xa0, 0x91, 0xc6, 0xb0, 0x5b, 0x00,
-	0x1c, 0x27, 0x99, 0xbd, 0x12, 0x04, 0x0d, 0x48, 0x71, 0x81, 0x67, 0x57, 0x04, 0xf4, 0xc4, 0x69,
-	0xf5, 0xaf, 0xb2, 0x00, 0x89, 0x20, 0x45, 0x51, 0x1d, 0x1b, 0xb5, 0x05, 0xe1, 0xcb, 0x97, 0x83,
-	0xf8, 0x2b, 0xfc, 0xac, 0x8c, 0x5d, 0x85, 0xe2, 0xd4, 0xf3, 0x67, 0x46, 0x18, 0x5d, 0xdf, 0xe1,
-	0x29, 0x64, 0x5c, 0xdc, 0x85, 0x8c, 0x1a, 0x83, 0x6b, 0xf1, 0x50, 0xb3, 0xbc, 0x56, 0x13, 0xc0,
-	0x1e, 0xc2, 0x50, 0xa7, 0xb4, 0xdc, 0x89, 0xe3, 0x05, 0x96, 0x89, 0x36, 0x53, 0x81, 0xd4, 0x02,
-	0x88, 0x40, 0x3b, 0xe7, 0xbc, 0x43, 0xfe, 0xcc, 0x76, 0x8d, 0xd0, 0x32, 0x45, 0xbc, 0x8b, 0x04,
-	0x61, 0xaf, 0x40, 0xe5, 0xab, 0xc0, 0x13, 0x66, 0x2c, 0x8f, 0xf2, 0x29, 0x23, 0x80, 0xa6, 0xef,
-	0x55, 0x00, 0x2b, 0x98, 0x18, 0x73, 0x5e, 0x78, 0x99, 0x0a, 0xaf, 0x08, 0xc8, 0xce, 0x39, 0xeb,
-	0x41, 0x63, 0x34, 0x6e, 0x7b, 0xce, 0xc8, 0x43, 0x3b, 0xa4, 0xed, 0x39, 0xc2, 0x14, 0xbd, 0xb3,
-	0xac, 0x54, 0xdc, 0x4f, 0x93, 0x71, 0xb7, 0xf9, 0x52, 0xde, 0x1b, 0x2d, 0xb8, 0xb4, 0x86, 0xec,
-	0x3b, 0x05, 0x04, 0xfc, 0x93, 0x1c, 0x40, 0xa2, 0x19, 0xa6, 0x7c, 0xe9, 0x99, 0xb4, 0x2f, 0xfd,
-	0x01, 0x5c, 0x15, 0x31, 0xf3, 0x22, 0xce, 0xfa, 0x4c, 0xb7, 0x5d, 0x7d, 0x6c, 0x44, 0xc7, 0x16,
-	0x4c, 0x60, 0xf9, 0xf1, 0x7c, 0xd7, 0xdd, 0x31, 0x42, 0xf6, 0x08, 0x36, 0xe5, 0x3c, 0xe1, 0xf9,
-	0x3c, 0x7d, 0xec, 0x22, 0x5d, 0x41, 0xa8, 0x27, 0xd9, 0x47, 0xe7, 0x73, 0xf6, 0x1e, 0x5c, 0xf1,
-	0xad, 0xa9, 0x6f, 0x05, 0xc7, 0x7a, 0x18, 0xc8, 0x95, 0xf1, 0x58, 0x80, 0x2d, 0x81, 0x1c, 0x05,
-	0x71, 0x5d, 0xef, 0xc1, 0x15, 0xa1, 0x33, 0x2e, 0x35, 0x8f, 0xdf, 0xf7, 0xdb, 0xe2, 0x48, 0xb9,
-	0x75, 0xaf, 0x02, 0x08, 0x75, 0x39, 0xba, 0xe5, 0x5d, 0xd6, 0x2a, 0x5c, 0x35, 0x46, 0xfb, 0xe6,
-	0x1d, 0x60, 0x76, 0xa0, 0x2f, 0xf9, 0x61, 0xc5, 0xe1, 0x84, 0x62, 0x07, 0x07, 0x29, 0x1f, 0xec,
-	0x45, 0x2e, 0xde, 0xf2, 0x45, 0x2e, 0xde, 0xcb, 0x50, 0x20, 0x8d, 0x5a, 0x78, 0x5c, 0x79, 0x82,
-	0xa9, 0x90, 0x47, 0x86, 0x41, 0x8e, 0xc1, 0xc6, 0x83, 0xc6, 0x7d, 0xba, 0x23, 0x8f, 0xf3, 0x83,
-	0x50, 0x8d, 0x70, 0xea, 0x1f, 0x66, 0xa0, 0x91, 0xd6, 0x02, 0x79, 0xbc, 0x5b, 0x12, 0xc8, 0x57,
-	0x48, 0x82, 0xf7, 0x5e, 0x81, 0xca, 0xfc, 0x44, 0x44, 0xed, 0x45, 0xa7, 0xc4, 0xf3, 0x13, 0x1e,
-	0xad, 0xc7, 0xde, 0x86, 0xd2, 0xfc, 0x84, 0xaf, 0xe3, 0x8b, 0xa6, 0xa5, 0x38, 0xe7, 0x81, 0x34,
-	0x6f, 0x43, 0x69, 0x21, 0x48, 0xf3, 0x17, 0x91, 0x2e, 0x88, 0x54, 0xdd, 0x86, 0x9a, 0x6c, 0x77,
-	0xe1, 0x72, 0x44, 0x6d, 0x8d, 0x37, 0x0c, 0x3f, 0xd5, 0xdf, 0xcb, 0x12, 0xc9, 0x77, 0x3a, 0x24,
-	0x49, 0xf9, 0x1c, 0xb2, 0x2f, 0xf1, 0x39, 0x6c, 0x53, 0x38, 0x80, 0x4e, 0x71, 0x3d, 0x13, 0x2f,
-	0xba, 0x03, 0x0c, 0xc7, 0x46, 0xd0, 0x5a, 0x84, 0x5e, 0xdb, 0x73, 0xc4, 0x81, 0x93, 0x08, 0x94,
-	0xce, 0x47, 0x3e, 0x43, 0x71, 0xef, 0xe1, 0x3d, 0x11, 0x4d, 0x4c, 0xa1, 0xfc, 0x74, 0xc8, 0x55,
-	0x58, 0xd1, 0x91, 0x6b, 0x51, 0x24, 0x3f, 0x9d, 0x5f, 0x3d, 0x80, 0xcd, 0x24, 0x74, 0x2b, 0x3a,
-	0x17, 0x5b, 0xce, 0x52, 0x8f, 0xe3, 0xb6, 0x30, 0xa9, 0xfe, 0x7e, 0x06, 0xb6, 0x56, 0xcc, 0x18,
-	0x1c, 0xad, 0xe4, 0xb9, 0x00, 0xfc, 0x64, 0xaf, 0x41, 0x6d, 0x66, 0x84, 0x93, 0x63, 0x7d, 0xee,
-	0x5b, 0x53, 0xfb, 0x2c, 0x7a, 0xf3, 0x80, 0x60, 0x07, 0x04, 0xa2, 0x33, 0xbe, 0xf9, 0x9c, 0x8c,
-	0xb7, 0x99, 0x1d, 0x8a, 0xbb, 0xbd, 0x40, 0xa0, 0x1e, 0x79, 0x75, 0xa2, 0xf3, 0xff, 0xfc, 0x05,
-	0xe1, 0x0a, 0x37, 0xa1, 0xd8, 0x8d, 0xcd, 0xa5, 0xf8, 0xfa, 0x6f, 0x4e, 0x5c, 0xf9, 0xf5, 0xa0,
-	0xd2, 0xa6, 0xeb, 0xc3, 0xfb, 0xc6, 0x9c, 0xdd, 0x83, 0xdc, 0xcc, 0x98, 0x8b, 0xc8, 0x84, 0x66,
-	0xec, 0xb4, 0xe4, 0xd8, 0xfb, 0xfb, 0xc6, 0x9c, 0xf3, 0x2a, 0x24, 0xba, 0xf1, 0x11, 0x94, 0x23,
-	0xc0, 0x77, 0xe2, 0x4a, 0xff, 0x35, 0x07, 0x95, 0x5d, 0xd9, 0xb1, 0x82, 0x3a, 0x6c, 0xe8, 0x2f,
-	0x5c, 0xb4, 0x7f, 0x85, 0x8b, 0xb7, 0x3a, 0x31, 0xdc, 0x91, 0x00, 0x45, 0x0b, 0x28, 0xfb, 0x4b,
-	0x16, 0xd0, 0x4d, 0x00, 0x9f, 0x0c, 0x07, 0xb2, 0x1d, 0x72, 0x71, 0xc0, 0x44, 0xd7, 0x44, 0xd3,
-	0x61, 0xed, 0x29, 0x52, 0xfe, 0xdb, 0x9f, 0x22, 0x15, 0xd6, 0x9e, 0x22, 0xfd, 0x5f, 0x73, 0xee,
-	0xf3, 0x66, 0xc2, 0x88, 0x71, 0x4d, 0x23, 0x59, 0x85, 0x07, 0x55, 0xcd, 0xe3, 0xf8, 0x4a, 0xa4,
-	0xfb, 0x14, 0x1a, 0xd1, 0x30, 0x8b, 0x8e, 0x41, 0x2a, 0xa4, 0x53, 0xe0, 0xb8, 0xcf, 0xa8, 0x1e,
-	0xca, 0xc9, 0xf4, 0x0e, 0xad, 0xfe, 0xf2, 0x1d, 0xaa, 0xfe, 0xaf, 0x2c, 0x14, 0x7e, 0xbc, 0xb0,
-	0xfc, 0x73, 0xf6, 0x11, 0x54, 0x82, 0x70, 0x16, 0xca, 0xee, 0xec, 0xeb, 0x3c, 0x1b, 0xe1, 0xc9,
-	0x1b, 0x6d, 0xcd, 0x2c, 0x37, 0xe4, 0x96, 0x26, 0xd2, 0x12, 0x47, 0xba, 0x0c, 0x85, 0x20, 0xb4,
-	0xe6, 0xdc, 0x7d, 0x5e, 0xd0, 0x78, 0x82, 0x6d, 0x43, 0xc1, 0xf5, 0x4c, 0x2b, 0x48, 0x1f, 0x8e,
-	0xa3, 0xa1, 0xa2, 0x71, 0x04, 0x53, 0xa1, 0x28, 0x6e, 0x7f, 0xe4, 0x57, 0x5d, 0xca, 0x1c, 0x43,
-	0x71, 0x6b, 0x96, 0x81, 0x26, 0x70, 0x74, 0xc9, 0x27, 0x4e, 0x23, 0xaf, 0x75, 0x3c, 0xc3, 0x1c,
-	0x19, 0x47, 0xd1, 0x2d, 0x39, 0x91, 0x64, 0xdb, 0x50, 0xc5, 0xcf, 0xe7, 0xbe, 0x1d, 0x5a, 0xc3,
-	0x87, 0x42, 0x52, 0xc8, 0x20, 0x54, 0x2d, 0x4c, 0x2b, 0xb4, 0x26, 0xe1, 0xf0, 0x6b, 0x87, 0xcb,
-	0x06, 0x3a, 0x27, 0x8c, 0x20, 0xaa, 0x09, 0xf5, 0x54, 0x77, 0xd3, 0x86, 0x15, 0x6a, 0x9e, 0x9d,
-	0x1e, 0x2a, 0xe8, 0x19, 0x49, 0xc3, 0xcf, 0xca, 0x5a, 0x7d, 0x4e, 0x52, 0xf7, 0x49, 0x31, 0x3c,
-	0x3c, 0xd8, 0x6d, 0x8d, 0x3a, 0x4a, 0x81, 0xd4, 0xf7, 0x8e, 0xf6, 0xa4, 0xa3, 0x14, 0xd5, 0x3f,
-	0xca, 0xc2, 0xd6, 0xc8, 0x37, 0xdc, 0xc0, 0xe0, 0x91, 0xdb, 0x6e, 0xe8, 0x7b, 0x0e, 0xfb, 0x14,
-	0xca, 0xe1, 0xc4, 0x91, 0xa7, 0xe1, 0x76, 0x34, 0xe9, 0x4b, 0xa4, 0xf7, 0x47, 0x13, 0x6e, 0xf6,
-	0x97, 0x42, 0xfe, 0xc1, 0xbe, 0x0f, 0x85, 0xb1, 0x75, 0x64, 0xbb, 0x62, 0x03, 0x5e, 0x59, 0xce,
-	0xb8, 0x83, 0xc8, 0xbd, 0x0d, 0x8d, 0x53, 0xb1, 0xf7, 0xa0, 0x38, 0xf1, 0x66, 0x11, 0xa7, 0x4a,
-	0x82, 0x4c, 0xa5, 0x8a, 0x10, 0xbb, 0xb7, 0xa1, 0x09, 0x3a, 0xf6, 0x11, 0x94, 0x7d, 0xcf, 0x71,
-	0xc6, 0xc6, 0xe4, 0x44, 0xf0, 0xb0, 0xe6, 0x72, 0x1e, 0x4d, 0xe0, 0xf7, 0x36, 0xb4, 0x98, 0x56,
-	0xbd, 0x0f, 0x25, 0xd1, 0x58, 0x1c, 0x80, 0x9d, 0xce, 0x93, 0xae, 0x18, 0xc8, 0xf6, 0x60, 0x7f,
-	0xbf, 0x3b, 0xe2, 0xb7, 0x59, 0xb4, 0x41, 0xaf, 0xb7, 0xd3, 0x6a, 0x3f, 0x55, 0xb2, 0x3b, 0x65,
-	0x28, 0x1a, 0x14, 0x18, 0xa9, 0xfe, 0xad, 0x0c, 0x6c, 0x2e, 0x75, 0x80, 0x3d, 0x82, 0xfc, 0x0c,
-	0xa5, 0x33, 0x1f, 0x9e, 0x3b, 0x6b, 0x7b, 0x29, 0xa5, 0xb9, 0xcc, 0xc6, 0x1c, 0xea, 0x27, 0xd0,
-	0x48, 0xc3, 0x25, 0x3d, 0xbf, 0x0e, 0x15, 0xad, 0xd3, 0xda, 0xd5, 0x07, 0xfd, 0xde, 0x97, 0xdc,
-	0x5c, 0xa6, 0xe4, 0x73, 0xad, 0x3b, 0xea, 0x28, 0x59, 0xf5, 0x77, 0x40, 0x59, 0x1e, 0x18, 0xf6,
-	0x04, 0x36, 0x51, 0xfc, 0x38, 0x16, 0x67, 0x14, 0xc9, 0x94, 0xdd, 0x5a, 0x33, 0x92, 0x82, 0x8c,
-	0x66, 0xac, 0x31, 0x49, 0xa5, 0xd5, 0xff, 0x0f, 0xd8, 0xea, 0x08, 0xfe, 0xe6, 0x8a, 0xff, 0x9f,
-	0x19, 0xc8, 0x1f, 0x38, 0x86, 0xcb, 0x5e, 0x87, 0x02, 0x5d, 0x65, 0x16, 0x22, 0xbe, 0x2a, 0x6d,
-	0x70, 0x5c, 0x16, 0x84, 0x63, 0xdf, 0x83, 0x5c, 0x38, 0x89, 0x6e, 0xee, 0x5c, 0xbb, 0x60, 0xf1,
-	0xed, 0x6d, 0x68, 0x48, 0xc5, 0xee, 0x42, 0xce, 0x34, 0xa3, 0x28, 0x1e, 0x61, 0xb3, 0xa0, 0x16,
-	0xbc, 0x6b, 0x4d, 0x6d, 0xd7, 0x16, 0x57, 0xaf, 0x91, 0x84, 0xbd, 0x01, 0x39, 0x73, 0xe2, 0xa4,
-	0x43, 0xb2, 0xb8, 0xbe, 0x1c, 0x17, 0x68, 0x4e, 0x1c, 0xa6, 0x42, 0x3d, 0xf4, 0xcf, 0x75, 0x7f,
-	0xe1, 0xd2, 0x29, 0x70, 0x20, 0xf4, 0xc6, 0x2a, 0x0a, 0xb3, 0x05, 0x1d, 0x99, 0x06, 0x22, 0x02,
-	0x78, 0xee, 0x5b, 0x73, 0xc3, 0x8f, 0x35, 0x46, 0x3b, 0x38, 0xe0, 0x80, 0x9d, 0x22, 0xd0, 0x4b,
-	0x48, 0xea, 0x3b, 0x74, 0xad, 0x17, 0x35, 0x2c, 0x35, 0xfa, 0x5a, 0x73, 0xc1, 0x42, 0x60, 0xd4,
-	0x3f, 0xcf, 0x41, 0x55, 0x6a, 0x0f, 0xfb, 0x00, 0xca, 0x66, 0x7a, 0x23, 0x5e, 0x5f, 0x69, 0xf4,
-	0xfd, 0xdd, 0x68, 0x0b, 0x9a, 0x62, 0x79, 0x7f, 0x02, 0xf5, 0xc0, 0x0a, 0xf5, 0x17, 0x86, 0x6f,
-	0xf3, 0x37, 0x08, 0xb2, 0xb2, 0x3b, 0x7b, 0x68, 0x85, 0xcf, 0x22, 0xcc, 0xde, 0x86, 0x56, 0x0b,
-	0xa4, 0x34, 0xa9, 0x81, 0xa2, 0x4b, 0xb9, 0xd4, 0x3b, 0x0d, 0x1c, 0xb8, 0xb7, 0xa1, 0x45, 0x78,
-	0x24, 0xb5, 0xce, 0xac, 0xc9, 0x22, 0x8c, 0xd4, 0xc0, 0x7a, 0xd4, 0x21, 0x02, 0xd2, 0x93, 0x30,
-	0xfc, 0x93, 0x3d, 0x40, 0x5e, 0x67, 0x38, 0x8e, 0x47, 0x32, 0xbb, 0x20, 0xfb, 0x4e, 0x77, 0x63,
-	0x38, 0x7f, 0x82, 0x26, 0x4a, 0xb1, 0x37, 0xa1, 0xe0, 0x85, 0xc7, 0x56, 0xa4, 0x3c, 0x45, 0x17,
-	0x84, 0x11, 0xb4, 0xdb, 0xee, 0xe1, 0x4a, 0x21, 0xb4, 0xfa, 0xb3, 0x0c, 0x94, 0xc4, 0x08, 0xb0,
-	0x2d, 0xa8, 0x0f, 0x3b, 0x23, 0xfd, 0x59, 0x4b, 0xeb, 0xb6, 0x76, 0x7a, 0x1d, 0x11, 0x49, 0xf6,
-	0x44, 0x6b, 0xf5, 0x05, 0x9f, 0xd4, 0x3a, 0xcf, 0x06, 0x4f, 0x3b, 0xdc, 0x78, 0xde, 0xed, 0xf4,
-	0xbf, 0x54, 0x72, 0xdc, 0x71, 0xd4, 0x39, 0x68, 0x69, 0xc8, 0x25, 0xab, 0x50, 0xea, 0x7c, 0xd1,
-	0x69, 0x1f, 0x12, 0x9b, 0x6c, 0x00, 0xec, 0x76, 0x5a, 0xbd, 0xde, 0xa0, 0x8d, 0x6c, 0xb3, 0xc8,
-	0x18, 0x34, 0xda, 0x5a, 0xa7, 0x35, 0xea, 0xe8, 0xad, 0x76, 0x7b, 0x70, 0xd8, 0x1f, 0x29, 0x25,
-	0xac, 0xb1, 0xd5, 0x1b, 0x75, 0xb4, 0x18, 0x44, 0xaf, 0x28, 0xec, 0x6a, 0x83, 0x83, 0x18, 0x52,
-	0xd9, 0xa9, 0xa0, 0x4a, 0x4e, 0x73, 0xa5, 0xfe, 0x8f, 0x06, 0x34, 0xd2, 0x4b, 0x93, 0x7d, 0x0c,
-	0x65, 0xd3, 0x4c, 0xcd, 0xf1, 0xcd, 0x75, 0x4b, 0xf8, 0xfe, 0xae, 0x19, 0x4d, 0x33, 0xff, 0x60,
-	0xaf, 0x45, 0x1b, 0x29, 0xbb, 0xb2, 0x91, 0xa2, 0x6d, 0xf4, 0x23, 0xd8, 0x14, 0x37, 0x6c, 0xd1,
-	0x58, 0x1e, 0x1b, 0x81, 0x95, 0xde, 0x25, 0x6d, 0x42, 0xee, 0x0a, 0xdc, 0xde, 0x86, 0xd6, 0x98,
-	0xa4, 0x20, 0xec, 0x07, 0xd0, 0x30, 0xc8, 0x90, 0x8a, 0xf3, 0xe7, 0x65, 0x25, 0xa0, 0x85, 0x38,
-	0x29, 0x7b, 0xdd, 0x90, 0x01, 0xb8, 0x10, 0x4d, 0xdf, 0x9b, 0x27, 0x99, 0x0b, 0xf2, 0x42, 0xdc,
-	0xf5, 0xbd, 0xb9, 0x94, 0xb7, 0x66, 0x4a, 0x69, 0xf6, 0x11, 0xd4, 0x44, 0xcb, 0x13, 0x93, 0x2c,
-	0xde, 0xb2, 0xbc, 0xd9, 0xa4, 0x4a, 0xec, 0x6d, 0x68, 0xd5, 0x49, 0x92, 0x64, 0x0f, 0xa1, 0xca,
-	0x1b, 0x9c, 0x98, 0x68, 0xf1, 0x5a, 0xa3, 0xd6, 0x46, 0xb9, 0xc0, 0x88, 0x53, 0xec, 0x3d, 0x00,
-	0x6a, 0x27, 0xcf, 0x53, 0x4e, 0x1d, 0xef, 0xf9, 0xde, 0x3c, 0xca, 0x52, 0x31, 0xa3, 0x84, 0xd4,
-	0x3c, 0x7e, 0xd7, 0xa1, 0xb2, 0xda, 0x3c, 0x0a, 0xcb, 0x4f, 0x9a, 0xc7, 0xef, 0x36, 0xc4, 0xcd,
-	0xe3, 0xd9, 0x60, 0xa5, 0x79, 0x51, 0x2e, 0xde, 0x3c, 0x9e, 0x29, 0x6a, 0x1e, 0xcf, 0x53, 0x5d,
-	0x6e, 0x5e, 0x94, 0x85, 0x9a, 0xc7, 0x73, 0xfc, 0x60, 0x45, 0x77, 0xab, 0x5d, 0xa8, 0xbb, 0xe1,
-	0xb4, 0xa5, 0xb5, 0xb7, 0x1f, 0x40, 0x23, 0x38, 0xf6, 0x4e, 0x25, 0x06, 0x52, 0x97, 0x73, 0x0f,
-	0x8f, 0xbd, 0x53, 0x99, 0x83, 0xd4, 0x03, 0x19, 0x80, 0xad, 0xe5, 0x5d, 0xa4, 0xdb, 0x4c, 0x0d,
-	0xb9, 0xb5, 0xd4, 0xc3, 0x67, 0xb6, 0x75, 0x8a, 0xad, 0x35, 0xa2, 0x04, 0x0e, 0x4a, 0x62, 0x7c,
-	0x07, 0xe2, 0x3c, 0x3a, 0x75, 0x54, 0x25, 0x6a, 0x82, 0xd8, 0x22, 0x0f, 0x70, 0x6d, 0x2d, 0x5c,
-	0x39, 0x9b, 0x22, 0xaf, 0xad, 0x43, 0x37, 0x95, 0xb1, 0xc6, 0x49, 0x45, 0xd6, 0x64, 0x57, 0x04,
-	0xd6, 0xd7, 0x0b, 0xcb, 0x9d, 0x58, 0xe2, 0xe4, 0x3a, 0xb5, 0x2b, 0x86, 0x02, 0x97, 0xec, 0x8a,
-	0x08, 0x12, 0xaf, 0xeb, 0x38, 0x3b, 0x5b, 0x5e, 0xd7, 0x52, 0x66, 0x5a, 0xd7, 0x71, 0xd6, 0x78,
-	0x43, 0xc5, 0x79, 0x2f, 0xad, 0x6c, 0x28, 0x29, 0x33, 0xdf, 0x50, 0x71, 0xee, 0x87, 0x20, 0x56,
-	0x13, 0x1f, 0xdc, 0xd4, 0xf9, 0x36, 0x6f, 0xb5, 0x18, 0x5d, 0x98, 0xc4, 0x29, 0x5c, 0xab, 0xbe,
-	0x85, 0xb6, 0x83, 0x58, 0x0a, 0x57, 0xe4, 0xb5, 0xaa, 0x11, 0x26, 0xde, 0x4a, 0x7e, 0x92, 0x54,
-	0xff, 0xa4, 0x00, 0x25, 0xc1, 0x74, 0xd8, 0x25, 0xd8, 0x14, 0xbc, 0x6f, 0xb7, 0x35, 0x6a, 0xed,
-	0xb4, 0x86, 0xa8, 0xad, 0x30, 0x68, 0x70, 0xe6, 0x17, 0xc3, 0x32, 0xc8, 0x10, 0x89, 0xfb, 0xc5,
-	0xa0, 0x2c, 0x32, 0x44, 0x91, 0x97, 0x3f, 0x41, 0x93, 0x63, 0x9b, 0x50, 0xe5, 0x19, 0x39, 0x80,
-	0x22, 0xbc, 0x29, 0x17, 0x4f, 0x17, 0xa4, 0x2c, 0xdc, 0x93, 0x5a, 0x4c, 0xb2, 0x70, 0x40, 0x29,
-	0xce, 0x12, 0xb9, 0x5a, 0x19, 0x34, 0x46, 0xda, 0x61, 0xbf, 0x9d, 0xd4, 0x53, 0xa1, 0xa8, 0x5c,
-	0x5e, 0xcc, 0xb3, 0x6e, 0xe7, 0xb9, 0x02, 0x98, 0x89, 0x97, 0x42, 0xe9, 0x2a, 0xea, 0x5b, 0x54,
-	0x08, 0x25, 0x6b, 0xec, 0x1a, 0x5c, 0x1a, 0xee, 0x0d, 0x9e, 0xeb, 0x3c, 0x53, 0xdc, 0x85, 0x3a,
-	0xbb, 0x0c, 0x8a, 0x84, 0xe0, 0xc5, 0x37, 0xb0, 0x4a, 0x82, 0x46, 0x84, 0x43, 0x65, 0x93, 0x0e,
-	0x26, 0x10, 0x36, 0xe2, 0x02, 0x48, 0xc1, 0xae, 0xf0, 0xac, 0x83, 0xde, 0xe1, 0x7e, 0x7f, 0xa8,
-	0x6c, 0x61, 0x23, 0x08, 0xc2, 0x5b, 0xce, 0xe2, 0x62, 0x12, 0xb1, 0x75, 0x89, 0x24, 0x19, 0xc2,
-	0x9e, 0xb7, 0xb4, 0x7e, 0xb7, 0xff, 0x64, 0xa8, 0x5c, 0x8e, 0x4b, 0xee, 0x68, 0xda, 0x40, 0x1b,
-	0x2a, 0x57, 0x62, 0xc0, 0x70, 0xd4, 0x1a, 0x1d, 0x0e, 0x95, 0xab, 0x71, 0x2b, 0x0f, 0xb4, 0x41,
-	0xbb, 0x33, 0x1c, 0xf6, 0xba, 0xc3, 0x91, 0x72, 0x8d, 0x5d, 0x81, 0xad, 0xa4, 0x45, 0x11, 0x71,
-	0x53, 0x6a, 0xa8, 0xf6, 0xa4, 0x33, 0x52, 0xae, 0xc7, 0xcd, 0x68, 0x0f, 0x7a, 0xbd, 0x16, 0x39,
-	0xd2, 0x6f, 0x20, 0x11, 0x9d, 0x0b, 0x88, 0xde, 0xbc, 0x82, 0xed, 0x3a, 0xec, 0xcb, 0xa0, 0x9b,
-	0xd2, 0xd2, 0x18, 0x76, 0x7e, 0x7c, 0xd8, 0xe9, 0xb7, 0x3b, 0xca, 0xab, 0xc9, 0xd2, 0x88, 0x61,
-	0xb7, 0xe2, 0xa5, 0x11, 0x83, 0x6e, 0xc7, 0x75, 0x46, 0xa0, 0xa1, 0xb2, 0x8d, 0xe5, 0x89, 0x76,
-	0xf4, 0xfb, 0x9d, 0xf6, 0x08, 0xfb, 0xfa, 0x5a, 0x3c, 0x8a, 0x87, 0x07, 0x4f, 0xb4, 0xd6, 0x6e,
-	0x47, 0x51, 0x11, 0xa2, 0x75, 0xfa, 0xad, 0xfd, 0x68, 0xb6, 0x5f, 0xdf, 0xa9, 0xd1, 0xb3, 0x76,
-	0x42, 0x5c, 0xaa, 0x9f, 0x03, 0x93, 0xdf, 0x87, 0x12, 0x4f, 0x4e, 0x30, 0xc8, 0x4f, 0x7d, 0x6f,
-	0x16, 0xdd, 0x71, 0xc2, 0x6f, 0xb4, 0xd5, 0xe6, 0x8b, 0x31, 0x1d, 0xa8, 0x27, 0x57, 0x1e, 0x64,
-	0x90, 0xfa, 0x27, 0x19, 0x68, 0xa4, 0x45, 0x25, 0xaa, 0x88, 0xf6, 0x54, 0x77, 0xbd, 0x90, 0x3f,
-	0x8b, 0x10, 0x44, 0x9e, 0x08, 0x7b, 0xda, 0xf7, 0x42, 0x7a, 0x17, 0x81, 0x4c, 0xc7, 0x58, 0xf2,
-	0xf1, 0x52, 0xe3, 0x34, 0xeb, 0xc2, 0xa5, 0xd4, 0xf3, 0x59, 0xa9, 0x47, 0x29, 0x9a, 0xf1, 0xa3,
-	0x3f, 0x4b, 0xed, 0xd7, 0x58, 0xb0, 0xda, 0x27, 0x05, 0x72, 0xc1, 0xd7, 0x8e, 0xb8, 0xdd, 0x8a,
-	0x9f, 0xea, 0x1e, 0xd4, 0x53, 0x92, 0x99, 0x5c, 0x5c, 0xd3, 0x74, 0x4b, 0xcb, 0xf6, 0xf4, 0xe5,
-	0xcd, 0x54, 0xff, 0x38, 0x03, 0x35, 0x59, 0x4e, 0xff, 0xca, 0x25, 0x51, 0x60, 0xac, 0xf8, 0xd6,
-	0x6d, 0x33, 0x7a, 0x0e, 0x21, 0x02, 0x75, 0xe9, 0x39, 0x4f, 0xee, 0x83, 0x7b, 0x7c, 0x32, 0x8c,
-	0xbb, 0x23, 0x83, 0xd0, 0x64, 0xa6, 0x90, 0xf7, 0xc7, 0x4f, 0x91, 0x40, 0x84, 0xd6, 0x26, 0x10,
-	0xf5, 0x36, 0x54, 0x1e, 0x9f, 0x44, 0x2f, 0x73, 0xc8, 0x8f, 0x83, 0x54, 0xf8, 0x3d, 0x19, 0xf5,
-	0xcf, 0x32, 0xd0, 0x48, 0x2e, 0x7c, 0x52, 0x40, 0x0d, 0x7f, 0x76, 0x8d, 0x2f, 0x87, 0xac, 0x39,
-	0x4e, 0x5e, 0xfa, 0xcc, 0xca, 0x2f, 0x7d, 0xbe, 0x2e, 0x0a, 0xcb, 0xc9, 0xd2, 0x2c, 0xae, 0x4b,
-	0xdc, 0xc2, 0x79, 0x08, 0x35, 0xfc, 0xaf, 0x59, 0x53, 0xcb, 0xf7, 0xad, 0xe8, 0x05, 0xba, 0x15,
-	0xe2, 0x14, 0x11, 0x59, 0x24, 0xd6, 0x54, 0x28, 0x46, 0x6b, 0xef, 0xa4, 0x22, 0x5e, 0xfd, 0x17,
-	0x79, 0xa8, 0x4a, 0x5a, 0xcf, 0xb7, 0x5a, 0x7e, 0x37, 0xa1, 0x92, 0xdc, 0x76, 0x14, 0x57, 0x1f,
-	0x62, 0x40, 0x6a, 0xae, 0x72, 0x4b, 0x73, 0xd5, 0x84, 0x92, 0x88, 0xbc, 0x11, 0x7e, 0xaf, 0x28,
-	0x99, 0x76, 0xec, 0x14, 0x5e, 0xe2, 0x7a, 0x7d, 0x1f, 0x6a, 0xd2, 0x1b, 0x23, 0xd1, 0xd5, 0xe9,
-	0x65, 0xfa, 0x6a, 0xf2, 0xde, 0x48, 0xc0, 0xae, 0x40, 0x71, 0x7a, 0xa2, 0x9b, 0xe3, 0xc8, 0xcd,
-	0x55, 0x98, 0x9e, 0xec, 0x8e, 0xc9, 0x75, 0x3d, 0x8d, 0x05, 0x3d, 0xf7, 0x95, 0x94, 0xa7, 0x91,
-	0x38, 0xbf, 0x0b, 0xa5, 0xe9, 0x09, 0xbf, 0xd1, 0x50, 0x91, 0xcf, 0xc1, 0x93, 0x21, 0x2f, 0x4e,
-	0x4f, 0xe8, 0x7a, 0xc3, 0x27, 0xa0, 0x2c, 0xf9, 0xd4, 0x02, 0x11, 0xd5, 0xb7, 0xdc, 0xa8, 0xcd,
-	0xb4, 0x7b, 0x2d, 0x60, 0xef, 0xc2, 0x65, 0x21, 0x79, 0x8d, 0x40, 0xe7, 0x51, 0xa1, 0x74, 0x81,
-	0x96, 0xbf, 0x32, 0xb2, 0xc5, 0x71, 0xad, 0x60, 0x48, 0x18, 0x5c, 0xac, 0x2a, 0xd4, 0xa4, 0xb5,
-	0xcb, 0x6f, 0x27, 0x57, 0xb4, 0x14, 0x8c, 0x3d, 0x82, 0xda, 0xf4, 0x84, 0xaf, 0x85, 0x91, 0xb7,
-	0x6f, 0x89, 0xf8, 0xbe, 0xcb, 0xcb, 0xab, 0x80, 0xc2, 0xc0, 0x52, 0x94, 0xec, 0xfb, 0xc0, 0x7c,
-	0x2b, 0xb4, 0x5c, 0xea, 0x89, 0x69, 0x19, 0xa6, 0x63, 0xbb, 0x51, 0xe4, 0xee, 0x56, 0x8c, 0xd9,
-	0x15, 0x08, 0xf5, 0x5f, 0x66, 0xa0, 0x91, 0x68, 0xbf, 0xb8, 0xa1, 0xd9, 0x3d, 0xf9, 0xed, 0xc5,
-	0xe6, 0xb2, 0x82, 0x8c, 0x24, 0xf7, 0x47, 0xe7, 0x73, 0xfe, 0x1c, 0xd4, 0xba, 0x2b, 0xe6, 0xeb,
-	0x1e, 0x90, 0xc9, 0xad, 0x7b, 0x40, 0x46, 0x7d, 0x02, 0xb9, 0xd1, 0xf9, 0x9c, 0x7b, 0x5a, 0x50,
-	0x06, 0x72, 0xab, 0x8c, 0x4b, 0x3f, 0x3a, 0xf9, 0x7c, 0xda, 0xf9, 0x92, 0x5f, 0xfb, 0x3a, 0xd0,
-	0xba, 0xfb, 0x2d, 0xed, 0x4b, 0x3a, 0x35, 0x26, 0x2d, 0xe1, 0xf1, 0x40, 0xeb, 0x74, 0x9f, 0xf4,
-	0x09, 0x90, 0x27, 0x3f, 0x4c, 0xd2, 0xc4, 0x96, 0x69, 0x3e, 0x3e, 0x91, 0x6f, 0xda, 0x66, 0x52,
-	0x0f, 0x2a, 0xa5, 0x6f, 0x8a, 0x64, 0x97, 0x6f, 0x8a, 0xb0, 0x78, 0x47, 0xc7, 0xec, 0x81, 0xbd,
-	0x05, 0xf9, 0xe9, 0x89, 0x75, 0x9e, 0x36, 0x71, 0xd2, 0x9b, 0x91, 0x08, 0xd4, 0x5f, 0x64, 0x80,
-	0xa5, 0x1a, 0xc2, 0xb5, 0xee, 0x5f, 0xb5, 0x2d, 0x1f, 0x43, 0x53, 0xbc, 0x9d, 0xc4, 0xa9, 0x24,
-	0xf7, 0xac, 0x18, 0xd2, 0x2b, 0x5e, 0x12, 0x7f, 0x92, 0xdc, 0x82, 0x67, 0xef, 0x02, 0x7f, 0x08,
-	0x07, 0x17, 0x48, 0xda, 0xa9, 0x21, 0xf1, 0x0a, 0x2d, 0xa1, 0x49, 0x5e, 0xbe, 0x91, 0x5f, 0xf4,
-	0xe1, 0xfe, 0xea, 0xcd, 0x64, 0xd6, 0x88, 0x7f, 0xa8, 0x7f, 0x90, 0x81, 0x4b, 0xe9, 0x05, 0xf1,
-	0xeb, 0xf5, 0x32, 0xfd, 0x7c, 0x51, 0x6e, 0xf9, 0xf9, 0xa2, 0x75, 0xeb, 0x29, 0xbf, 0x76, 0x3d,
-	0xfd, 0xed, 0x0c, 0x5c, 0x96, 0x46, 0x3f, 0xb1, 0x93, 0xfe, 0x9a, 0x5a, 0x26, 0xbd, 0x62, 0x94,
-	0x4f, 0xbd, 0x62, 0xa4, 0xfe, 0x51, 0x06, 0xae, 0x2e, 0xb5, 0x44, 0xb3, 0xfe, 0x5a, 0xdb, 0x92,
-	0x7e, 0xed, 0x88, 0x5c, 0xd4, 0x3c, 0x02, 0x88, 0xdf, 0x86, 0x60, 0xe9, 0xe7, 0x8b, 0x7a, 0x38,
-	0x8f, 0xff, 0x2a, 0xdd, 0x48, 0x33, 0x89, 0x65, 0x67, 0x1f, 0x42, 0x35, 0xd1, 0x98, 0xa2, 0x1b,
-	0xa6, 0x6b, 0x03, 0xe1, 0x65, 0xba, 0xb5, 0x6c, 0x34, 0xfb, 0xed, 0xd8, 0xe8, 0x23, 0xa8, 0xc5,
-	0x05, 0xef, 0x5a, 0xd3, 0xb4, 0x37, 0x62, 0xe9, 0x39, 0x84, 0x14, 0xa5, 0xfa, 0x01, 0x6c, 0x25,
-	0xbd, 0x68, 0x8b, 0x27, 0x3c, 0x6e, 0x43, 0xd5, 0xb5, 0x4e, 0xf5, 0xe8, 0x81, 0x0f, 0x11, 0x71,
-	0xe0, 0x5a, 0xa7, 0x82, 0x40, 0x7d, 0x2c, 0xf3, 0xbd, 0xf8, 0x49, 0x53, 0xc7, 0x94, 0x67, 0xa6,
-	0xe4, 0x39, 0x66, 0x84, 0xc2, 0xd2, 0xa4, 0x89, 0x29, 0xb9, 0xd6, 0x29, 0xad, 0xb9, 0x53, 0x51,
-	0x4e, 0xcb, 0x34, 0xc5, 0x81, 0xe9, 0xba, 0xdb, 0xf2, 0xd7, 0xa1, 0x3c, 0xf7, 0x53, 0x33, 0x5b,
-	0x9a, 0xfb, 0xbc, 0xda, 0x3b, 0x22, 0x9e, 0xe1, 0xa2, 0xc3, 0x55, 0x1e, 0xe1, 0x20, 0x2e, 0x17,
-	0xe7, 0x93, 0x27, 0x8f, 0x3f, 0x14, 0x2c, 0x0f, 0xf7, 0x9f, 0xa8, 0x39, 0x3e, 0x44, 0xcd, 0xdc,
-	0xad, 0xd3, 0x21, 0x2a, 0x69, 0x80, 0xd6, 0xd7, 0x22, 0xa4, 0x02, 0x3f, 0xd5, 0x1d, 0xa8, 0x4a,
-	0x96, 0x1d, 0xaa, 0x26, 0x92, 0x57, 0x24, 0x48, 0xdf, 0x3f, 0x4e, 0x06, 0x48, 0xab, 0x26, 0x4e,
-	0x91, 0x40, 0xfd, 0x43, 0x00, 0x48, 0x70, 0x29, 0x85, 0x21, 0xb3, 0xa4, 0x30, 0x7c, 0xa7, 0x13,
-	0xd9, 0x0f, 0xa0, 0x31, 0xf1, 0xe6, 0xe7, 0x7a, 0x92, 0x23, 0xb7, 0x36, 0x47, 0x0d, 0xa9, 0x46,
-	0x49, 0xec, 0xf8, 0xea, 0x49, 0x5b, 0x7e, 0xed, 0x49, 0xdb, 0xfb, 0x50, 0xe2, 0x8e, 0xfb, 0x40,
-	0xdc, 0x42, 0xb8, 0xb6, 0xdc, 0xcf, 0xfb, 0xe2, 0xc1, 0xab, 0x88, 0x8e, 0x75, 0xd0, 0x2a, 0x17,
-	0xaf, 0xfd, 0xc8, 0x77, 0x12, 0x6e, 0xad, 0xe6, 0x8c, 0xc8, 0xf8, 0x13, 0x13, 0x86, 0x9c, 0x94,
-	0x94, 0x84, 0x70, 0x26, 0xbc, 0x49, 0xa4, 0x24, 0x94, 0x64, 0x25, 0x61, 0x34, 0xe3, 0x3e, 0x24,
-	0x54, 0x12, 0xbe, 0x0f, 0x97, 0x44, 0x7c, 0x27, 0x66, 0xc0, 0xe1, 0x24, 0x7a, 0x7e, 0xaf, 0x51,
-	0x5c, 0x0a, 0x1d, 0xcd, 0x48, 0xfb, 0x46, 0xf2, 0x2f, 0xe0, 0xf2, 0xe4, 0xd8, 0x70, 0x8f, 0x2c,
-	0x3d, 0x1c, 0x3b, 0x3a, 0x3d, 0x10, 0xa9, 0xcf, 0x8c, 0xb9, 0x50, 0x7b, 0xde, 0x5a, 0x69, 0x6c,
-	0x9b, 0x88, 0x47, 0x63, 0x87, 0x42, 0x1d, 0xe2, 0xf3, 0xd8, 0xad, 0xc9, 0x32, 0x7c, 0xe9, 0x34,
-	0x0a, 0x96, 0x4f, 0xa3, 0x56, 0xb4, 0x99, 0xea, 0xaa, 0x36, 0x73, 0xe3, 0x3f, 0xe6, 0xa1, 0x28,
-	0x9e, 0x0c, 0xbb, 0x07, 0x79, 0xd3, 0xf7, 0xe6, 0x71, 0xc0, 0xd1, 0x1a, 0xed, 0x82, 0x9e, 0x77,
-	0x47, 0x45, 0xe4, 0x3e, 0x14, 0x0d, 0xd3, 0xd4, 0xa7, 0x27, 0xe9, 0x13, 0xa3, 0x25, 0x41, 0xbf,
-	0xb7, 0xa1, 0x15, 0x0c, 0x92, 0xf8, 0x1f, 0x43, 0x05, 0xe9, 0xb9, 0x33, 0x2c, 0x65, 0x2f, 0xad,
-	0x8a, 0xe4, 0xbd, 0x0d, 0xad, 0x6c, 0x44, 0xe2, 0xf9, 0x87, 0x69, 0xdf, 0x1b, 0x97, 0x97, 0x37,
-	0x56, 0xb2, 0x5e, 0xe4, 0x85, 0xfb, 0x6d, 0xe0, 0xce, 0x98, 0x98, 0xdb, 0x14, 0xe4, 0xc3, 0x89,
-	0x15, 0xde, 0xb4, 0xb7, 0xa1, 0xf1, 0x3d, 0x17, 0xf1, 0xaa, 0x0f, 0x23, 0xbf, 0x58, 0xfc, 0x10,
-	0xf3, 0x9a, 0x91, 0x41, 0x5e, 0x11, 0x3b, 0xc7, 0x88, 0x71, 0x60, 0x36, 0xd3, 0x8c, 0xc2, 0x36,
-	0x4a, 0x2b, 0xd9, 0x62, 0x8e, 0x44, 0xd9, 0x62, 0xf6, 0xf4, 0x08, 0xaa, 0xe4, 0xa2, 0x12, 0xf9,
-	0xca, 0x2b, 0x43, 0x9b, 0x30, 0x14, 0x72, 0xbc, 0x27, 0xec, 0xa5, 0x1d, 0xf5, 0xd3, 0xb7, 0x64,
-	0xdf, 0xe6, 0xcd, 0xb5, 0x03, 0xa5, 0xc5, 0x6e, 0x4e, 0xde, 0x59, 0x8d, 0xe7, 0x61, 0x3b, 0x50,
-	0x33, 0x24, 0x49, 0x23, 0x1c, 0x9d, 0x37, 0xd7, 0xcc, 0x53, 0x4c, 0x43, 0x65, 0x48, 0xe9, 0xe4,
-	0x00, 0xee, 0x86, 0x06, 0x57, 0xd7, 0x2f, 0x65, 0x39, 0x92, 0x20, 0xcf, 0x23, 0x09, 0xd4, 0xf4,
-	0xc5, 0xdc, 0xf4, 0x55, 0x2a, 0x29, 0xae, 0xe0, 0x33, 0xb4, 0x91, 0xe5, 0xcd, 0x5b, 0x85, 0x52,
-	0xf4, 0x72, 0x1d, 0x05, 0xdc, 0xb5, 0x07, 0x07, 0x5f, 0x2a, 0x19, 0x04, 0x77, 0xfb, 0xc3, 0x51,
-	0xab, 0x2f, 0x8e, 0x57, 0xbb, 0x7d, 0x71, 0xbc, 0xaa, 0xfe, 0xdb, 0x1c, 0x54, 0x62, 0xf7, 0xf0,
-	0xaf, 0x6e, 0x18, 0xc7, 0x16, 0x67, 0x4e, 0xb6, 0x38, 0x97, 0x34, 0x35, 0x7e, 0xf4, 0xcf, 0x2f,
-	0x6c, 0x6f, 0xa6, 0xf5, 0xa1, 0x60, 0xf5, 0x6e, 0x47, 0xe1, 0x5b, 0xde, 0xed, 0x90, 0x43, 0xbc,
-	0x8a, 0xe9, 0x10, 0xaf, 0xa5, 0xd7, 0x0b, 0x4b, 0x14, 0xa6, 0x20, 0xbf, 0x5e, 0x78, 0x61, 0x7c,
-	0x42, 0xf9, 0xe2, 0xf8, 0x04, 0xfa, 0x0d, 0x8b, 0x67, 0xb6, 0x75, 0x2a, 0x22, 0x9d, 0x44, 0x2a,
-	0x2d, 0x3e, 0xe0, 0x25, 0xe2, 0xe3, 0x5b, 0xb0, 0x22, 0xf6, 0x00, 0x2e, 0x4f, 0x4f, 0xe2, 0x97,
-	0x9a, 0x12, 0x03, 0xab, 0x46, 0xdd, 0x58, 0x8b, 0x53, 0xff, 0x6e, 0x06, 0x20, 0xf1, 0xa1, 0xfe,
-	0xda, 0x0e, 0x1e, 0xc9, 0x86, 0xce, 0xfd, 0x12, 0x1b, 0xfa, 0x25, 0xf7, 0x89, 0xd5, 0xaf, 0xa1,
-	0x12, 0x7b, 0xcd, 0x7f, 0xf5, 0x35, 0xf6, 0x9d, 0xaa, 0xfc, 0xdd, 0xc8, 0xd9, 0x15, 0xbb, 0x9d,
-	0x7f, 0xdd, 0xb1, 0x48, 0x55, 0x9f, 0x7b, 0x49, 0xf5, 0x67, 0xdc, 0xe3, 0x14, 0x57, 0xfe, 0x1b,
-	0xde, 0x58, 0xf2, 0x9a, 0xcf, 0xa7, 0xd6, 0xbc, 0xba, 0x10, 0x6e, 0xb3, 0x5f, 0xbf, 0xea, 0xef,
-	0xd4, 0xe1, 0xbf, 0xc8, 0x44, 0xbe, 0x9d, 0xf8, 0xfd, 0xab, 0x0b, 0x15, 0xad, 0xf5, 0xee, 0xa9,
-	0xef, 0x52, 0xdd, 0x2f, 0xb5, 0x36, 0xf3, 0xbf, 0xcc, 0xda, 0x7c, 0x0b, 0x0a, 0x5c, 0x20, 0x14,
-	0x2e, 0xb2, 0x34, 0x39, 0xfe, 0xa5, 0x2f, 0xc6, 0xaa, 0xaa, 0x50, 0x2c, 0x79, 0x7f, 0x2f, 0x47,
-	0xe5, 0x46, 0xaf, 0xdd, 0x62, 0x02, 0x8d, 0xfd, 0x4a, 0x62, 0x74, 0x7e, 0xf7, 0x31, 0xf9, 0x8d,
-	0x99, 0x9b, 0xff, 0x34, 0x0b, 0xf5, 0xd4, 0x81, 0xd9, 0xaf, 0xd0, 0x98, 0xb5, 0xdc, 0x3c, 0xb7,
-	0x9e, 0x9b, 0x5f, 0xc8, 0x58, 0xf3, 0x17, 0x33, 0xd6, 0xff, 0x23, 0x12, 0x80, 0xc7, 0x4d, 0x8a,
-	0xc7, 0x69, 0xcb, 0x51, 0xdc, 0x24, 0x8f, 0xd5, 0x43, 0x6e, 0x5a, 0x4b, 0x69, 0xe5, 0xeb, 0xf4,
-	0xf7, 0xcc, 0x5a, 0xfd, 0xfd, 0x56, 0xfc, 0x8b, 0x0d, 0xdd, 0x5d, 0x6e, 0x58, 0xd6, 0x35, 0x09,
-	0xc2, 0x3e, 0x81, 0xeb, 0x5c, 0xab, 0xe1, 0x8a, 0x9c, 0xee, 0x4d, 0xf5, 0xf8, 0xf7, 0x1c, 0x44,
-	0x30, 0xdf, 0x55, 0x4e, 0xc0, 0x9f, 0x13, 0x9e, 0xb6, 0x22, 0xac, 0xda, 0x85, 0x7a, 0xea, 0xf4,
-	0x52, 0xfa, 0x6d, 0x98, 0x8c, 0xfc, 0xdb, 0x30, 0x6c, 0x1b, 0x0a, 0xa7, 0xc7, 0x96, 0x6f, 0xad,
-	0x79, 0x13, 0x88, 0x23, 0xd4, 0x1f, 0x40, 0x4d, 0x8e, 0xa4, 0x60, 0xef, 0x40, 0xc1, 0x0e, 0xad,
-	0x59, 0x64, 0x5b, 0x5d, 0x5d, 0x0d, 0xb6, 0x20, 0x43, 0x9a, 0x13, 0xa9, 0x3f, 0xcb, 0x80, 0xb2,
-	0x8c, 0x93, 0x7e, 0xc0, 0x26, 0x73, 0xc1, 0x0f, 0xd8, 0x64, 0x53, 0x8d, 0x5c, 0xf7, 0x1b, 0x34,
-	0xf1, 0xbb, 0x24, 0xf9, 0x0b, 0xde, 0x25, 0x61, 0x6f, 0x42, 0xd9, 0xb7, 0xe8, 0xd7, 0x41, 0xcc,
-	0x35, 0xb1, 0xac, 0x31, 0x4e, 0xfd, 0xfd, 0x0c, 0x94, 0x44, 0xd8, 0xc7, 0x5a, 0x63, 0xf7, 0x6d,
-	0x28, 0xf1, 0x5f, 0x0a, 0x89, 0x8c, 0xff, 0x95, 0x58, 0xcb, 0x08, 0xcf, 0x6e, 0xf1, 0x60, 0x98,
-	0xb4, 0xf1, 0x7b, 0xe0, 0x18, 0xae, 0x46, 0x70, 0x5c, 0x6a, 0xdc, 0x95, 0x81, 0xa6, 0x57, 0x20,
-	0xee, 0x96, 0x03, 0x81, 0x50, 0x35, 0x0b, 0xd4, 0x1f, 0x42, 0x49, 0x84, 0x95, 0xac, 0x6d, 0xca,
-	0xcb, 0x7e, 0x23, 0x63, 0x1b, 0x20, 0x89, 0x33, 0x59, 0x57, 0x82, 0x7a, 0x0f, 0xca, 0x51, 0x68,
-	0x09, 0xae, 0xbf, 0xa4, 0x6a, 0x11, 0xab, 0x2c, 0x37, 0xc6, 0x11, 0x0f, 0xe7, 0xf5, 0xbc, 0xc9,
-	0x09, 0x79, 0xd5, 0xde, 0x85, 0xb2, 0x13, 0xbd, 0x47, 0x98, 0xb9, 0xf8, 0x3d, 0xc2, 0x98, 0x88,
-	0xdd, 0x83, 0x98, 0x1d, 0xbf, 0xcc, 0x5a, 0x56, 0x5b, 0x51, 0x50, 0x3e, 0xad, 0xb2, 0x87, 0xc2,
-	0x7b, 0xd4, 0xa3, 0x17, 0x11, 0x52, 0x0e, 0x9b, 0x54, 0x9b, 0x34, 0x89, 0x4c, 0x6d, 0x40, 0x4d,
-	0x3e, 0x0f, 0x57, 0x5b, 0xb0, 0xb5, 0x6f, 0x85, 0x06, 0xf2, 0xac, 0xe1, 0xc4, 0x70, 0x91, 0x9e,
-	0xaf, 0x5f, 0xfc, 0x48, 0xaf, 0xdf, 0x65, 0x3a, 0x8d, 0x13, 0xa9, 0x3f, 0xcb, 0x83, 0xb2, 0x8c,
-	0x43, 0x66, 0x12, 0xbf, 0x95, 0x9e, 0x89, 0xde, 0x5a, 0x75, 0xe2, 0xe7, 0xed, 0x69, 0x5d, 0xc8,
-	0xce, 0x11, 0xe0, 0x20, 0x22, 0xe0, 0xcc, 0x24, 0xf5, 0x68, 0x69, 0xd9, 0x0e, 0xf6, 0xf8, 0xb3,
-	0xa5, 0xd7, 0xf8, 0xbd, 0x71, 0xc7, 0x9b, 0xd0, 0xb2, 0xae, 0xd1, 0x35, 0xf1, 0x9e, 0x37, 0xc1,
-	0x5c, 0x91, 0xc1, 0x1d, 0x88, 0xeb, 0x1b, 0x65, 0x61, 0x65, 0xd3, 0xa1, 0x81, 0xb8, 0x0c, 0x1c,
-	0xf2, 0x9f, 0x61, 0xa9, 0x69, 0x65, 0x0e, 0x18, 0x05, 0xd1, 0xfb, 0x6e, 0x13, 0xf1, 0x68, 0x79,
-	0x8e, 0xde, 0x77, 0x6b, 0xbb, 0x74, 0xed, 0x81, 0xde, 0xc5, 0x9f, 0x88, 0xdf, 0x2d, 0x10, 0xaf,
-	0xe7, 0x21, 0xea, 0x75, 0xfe, 0xac, 0xbb, 0x6f, 0x05, 0x01, 0x7f, 0x3c, 0x84, 0xbf, 0xeb, 0x51,
-	0x8b, 0x80, 0xf1, 0x2b, 0x25, 0xe2, 0x21, 0x7c, 0x24, 0x01, 0xf1, 0x4a, 0x09, 0x7f, 0x06, 0x1f,
-	0x09, 0xae, 0x43, 0xf9, 0x1b, 0xcf, 0xb5, 0xc8, 0x70, 0xaf, 0x52, 0xab, 0x4a, 0x98, 0xde, 0x37,
-	0xe6, 0xea, 0xbf, 0xc9, 0xc0, 0xe5, 0xe5, 0x51, 0xa5, 0x05, 0x53, 0x83, 0x72, 0x7b, 0xd0, 0xd3,
-	0xfb, 0xad, 0xfd, 0x8e, 0xb2, 0xc1, 0x36, 0xa1, 0x3a, 0xd8, 0xf9, 0xbc, 0xd3, 0x1e, 0x71, 0x40,
-	0x86, 0x6e, 0x6c, 0x0d, 0xf5, 0xbd, 0xee, 0xee, 0x6e, 0xa7, 0xcf, 0xad, 0x94, 0xc1, 0xce, 0xe7,
-	0x7a, 0x6f, 0xd0, 0xe6, 0x6f, 0x70, 0x47, 0xa7, 0xef, 0x43, 0x25, 0x4f, 0x27, 0xde, 0x14, 0x13,
-	0x8a, 0xc9, 0x02, 0x0f, 0x79, 0x7c, 0x3e, 0xd4, 0xdb, 0xfd, 0x91, 0x52, 0xc4, 0x54, 0xff, 0xb0,
-	0xd7, 0xa3, 0x14, 0xc5, 0x36, 0xb5, 0x07, 0xfb, 0x07, 0x5a, 0x67, 0x38, 0xd4, 0x87, 0xdd, 0x9f,
-	0x74, 0x94, 0x32, 0xd5, 0xac, 0x75, 0x9f, 0x74, 0xfb, 0x1c, 0x50, 0x61, 0x25, 0xc8, 0xed, 0x77,
-	0xfb, 0x0a, 0xd0, 0x47, 0xeb, 0x0b, 0xa5, 0x8a, 0x1f, 0xc3, 0xc3, 0x7d, 0xa5, 0x76, 0xef, 0x35,
-	0xa8, 0xc9, 0x3f, 0x3e, 0x41, 0x51, 0x8e, 0x9e, 0x6b, 0xf1, 0x37, 0xdf, 0x7a, 0xdf, 0x7c, 0xa0,
-	0x64, 0xee, 0xfd, 0xae, 0xf4, 0x40, 0x30, 0xd1, 0x88, 0xc3, 0x00, 0xba, 0x38, 0xc8, 0xef, 0x31,
-	0x91, 0xeb, 0x9f, 0xae, 0x3d, 0xed, 0xb5, 0x86, 0x7b, 0xfc, 0x98, 0x40, 0x60, 0x08, 0x90, 0x4b,
-	0xde, 0x0a, 0xa3, 0x8b, 0x82, 0xf4, 0x19, 0x1f, 0xb6, 0x17, 0xe8, 0x5a, 0x57, 0x77, 0x88, 0x9d,
-	0x53, 0xa0, 0x86, 0x5f, 0x31, 0xae, 0x74, 0x4f, 0x85, 0xaa, 0xf4, 0xbc, 0x23, 0xd5, 0x61, 0x04,
-	0xc7, 0xe2, 0xf9, 0x31, 0x34, 0x37, 0x95, 0xcc, 0xbd, 0x37, 0x51, 0x62, 0xc8, 0x8f, 0x2b, 0x02,
-	0x14, 0xfb, 0x9e, 0x3f, 0x33, 0x1c, 0x41, 0x67, 0x2d, 0x02, 0xa4, 0x7b, 0x17, 0xae, 0xac, 0x7d,
-	0x2a, 0x92, 0x22, 0x75, 0xed, 0xd9, 0xdc, 0xb1, 0x78, 0xb0, 0xe9, 0xde, 0xf9, 0xd8, 0xb7, 0x4d,
-	0x25, 0x73, 0xef, 0x33, 0x68, 0x5e, 0x14, 0x3b, 0x89, 0xe5, 0xb6, 0xf7, 0x5a, 0x14, 0x9f, 0x8a,
-	0x53, 0x32, 0xd0, 0x79, 0x2a, 0xc3, 0xc3, 0x7b, 0x7b, 0x1d, 0x8a, 0xab, 0xb8, 0xf7, 0xd3, 0x8c,
-	0xc4, 0x88, 0xa2, 0xf8, 0xb7, 0x18, 0x20, 0xc6, 0x5a, 0x06, 0x69, 0x96, 0x61, 0x2a, 0x19, 0x76,
-	0x15, 0x58, 0x0a, 0xd4, 0xf3, 0x26, 0x86, 0xa3, 0x64, 0x29, 0x82, 0x22, 0x82, 0x53, 0x94, 0xb2,
-	0x92, 0x63, 0xaf, 0xc2, 0xf5, 0x18, 0xd6, 0xf3, 0x4e, 0x0f, 0x7c, 0x1b, 0x2d, 0xe6, 0x73, 0x8e,
-	0xce, 0xef, 0xfc, 0xe8, 0xe7, 0xbf, 0xb8, 0x95, 0xf9, 0xf7, 0xbf, 0xb8, 0x95, 0xf9, 0x6f, 0xbf,
-	0xb8, 0xb5, 0xf1, 0xb3, 0xff, 0x7e, 0x2b, 0xf3, 0x13, 0xf9, 0x97, 0x1f, 0x67, 0x46, 0xe8, 0xdb,
-	0x67, 0x7c, 0xe9, 0x47, 0x09, 0xd7, 0x7a, 0x77, 0x7e, 0x72, 0xf4, 0xee, 0x7c, 0xfc, 0x2e, 0xf2,
-	0x97, 0x71, 0x91, 0x7e, 0xe3, 0xf1, 0xe1, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x92, 0x76, 0xed,
-	0x9d, 0x43, 0x72, 0x00, 0x00,
+	// 11231 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x7d, 0x5b, 0x8c, 0x23, 0xc7,
+	0x76, 0xd8, 0xf2, 0x4d, 0x1e, 0x3e, 0xa6, 0xa7, 0xf7, 0xc5, 0x5d, 0xad, 0x56, 0xa3, 0xd6, 0x5e,
+	0x69, 0xb5, 0x57, 0xda, 0x95, 0x66, 0xf5, 0x58, 0xc9, 0xf7, 0x5a, 0x97, 0xc3, 0xe1, 0xee, 0x52,
+	0xcb, 0x21, 0xe7, 0x16, 0x39, 0xbb, 0x92, 0x8c, 0xa4, 0xd1, 0x64, 0x37, 0x67, 0x5a, 0xd3, 0xec,
+	0xa6, 0xba, 0x9b, 0x3b, 0x33, 0x02, 0x0c, 0xc8, 0x49, 0x10, 0x23, 0xfe, 0x0d, 0xe0, 0x8f, 0x00,
+	0x09, 0x1c, 0xfb, 0x27, 0xb0, 0xe3, 0x20, 0x40, 0x02, 0x24, 0x08, 0xf2, 0xe9, 0x0f, 0x27, 0x08,
+	0x8c, 0x00, 0xf9, 0x4b, 0x00, 0x27, 0xb8, 0xfe, 0xc8, 0xa7, 0x3f, 0x9c, 0x7f, 0x07, 0xe7, 0x54,
+	0x75, 0x77, 0x35, 0xc9, 0xd1, 0x4a, 0xba, 0xd7, 0x79, 0xfc, 0xcc, 0x54, 0x9d, 0x73, 0xea, 0xd9,
+	0x55, 0xa7, 0xce, 0xab, 0x8a, 0x00, 0x73, 0xc7, 0x70, 0xef, 0xce, 0x7d, 0x2f, 0xf4, 0xd4, 0x3c,
+	0xa6, 0xaf, 0xbf, 0x7d, 0x68, 0x87, 0x47, 0x8b, 0xf1, 0xdd, 0x89, 0x37, 0xbb, 0x77, 0xe8, 0x1d,
+	0x7a, 0xf7, 0x08, 0x39, 0x5e, 0x4c, 0x29, 0x47, 0x19, 0x4a, 0xf1, 0x42, 0xd7, 0xc1, 0xf1, 0x26,
+	0xc7, 0x22, 0xbd, 0x11, 0xda, 0x33, 0x2b, 0x08, 0x8d, 0xd9, 0x9c, 0x03, 0xb4, 0x7f, 0x93, 0x81,
+	0xfc, 0xe8, 0x6c, 0x6e, 0xa9, 0x0d, 0xc8, 0xda, 0x66, 0x33, 0xb3, 0x95, 0xb9, 0x5d, 0x60, 0x59,
+	0xdb, 0x54, 0xb7, 0xa0, 0xea, 0x7a, 0x61, 0x7f, 0xe1, 0x38, 0xc6, 0xd8, 0xb1, 0x9a, 0xd9, 0xad,
+	0xcc, 0xed, 0x32, 0x93, 0x41, 0xea, 0x4b, 0x50, 0x31, 0x16, 0xa1, 0xa7, 0xdb, 0xee, 0xc4, 0x6f,
+	0xe6, 0x08, 0x5f, 0x46, 0x40, 0xd7, 0x9d, 0xf8, 0xea, 0x25, 0x28, 0x9c, 0xd8, 0x66, 0x78, 0xd4,
+	0xcc, 0x53, 0x8d, 0x3c, 0x83, 0xd0, 0x60, 0x62, 0x38, 0x56, 0xb3, 0xc0, 0xa1, 0x94, 0x41, 0x68,
+	0x48, 0x8d, 0x14, 0xb7, 0x32, 0xb7, 0x2b, 0x8c, 0x67, 0xd4, 0x9b, 0x00, 0x96, 0xbb, 0x98, 0x3d,
+	0x37, 0x9c, 0x85, 0x15, 0x34, 0x4b, 0x84, 0x92, 0x20, 0xda, 0x27, 0x50, 0x99, 0x05, 0x87, 0x8f,
+	0x2d, 0xc3, 0xb4, 0x7c, 0xf5, 0x2a, 0x94, 0x66, 0xc1, 0xa1, 0x1e, 0x1a, 0x87, 0x62, 0x08, 0xc5,
+	0x59, 0x70, 0x38, 0x32, 0x0e, 0xd5, 0x6b, 0x50, 0x26, 0xc4, 0xd9, 0x9c, 0x8f, 0xa1, 0xc0, 0x90,
+	0x10, 0x47, 0xac, 0xfd, 0x65, 0x01, 0x4a, 0x3d, 0x3b, 0xb4, 0x7c, 0xc3, 0x51, 0xaf, 0x40, 0xd1,
+	0x0e, 0xdc, 0x85, 0xe3, 0x50, 0xf1, 0x32, 0x13, 0x39, 0xf5, 0x0a, 0x14, 0xec, 0x07, 0xcf, 0x0d,
+	0x87, 0x97, 0x7d, 0x7c, 0x81, 0xf1, 0xac, 0xda, 0x84, 0xa2, 0xfd, 0xee, 0x07, 0x88, 0xc8, 0x09,
+	0x84, 0xc8, 0x13, 0xe6, 0xfe, 0x36, 0x62, 0xf2, 0x31, 0xe6, 0xfe, 0x76, 0x84, 0xf9, 0xe0, 0x3d,
+	0xc4, 0xe0, 0xe8, 0x73, 0x84, 0xa1, 0x3c, 0xb6, 0xb2, 0xa0, 0x56, 0x70, 0x02, 0xea, 0xd8, 0xca,
+	0x22, 0x6a, 0x65, 0xc1, 0x5b, 0x29, 0x09, 0x84, 0xc8, 0x13, 0x86, 0xb7, 0x52, 0x8e, 0x31, 0x71,
+	0x2b, 0x0b, 0xde, 0x4a, 0x65, 0x2b, 0x73, 0x3b, 0x4f, 0x18, 0xde, 0xca, 0x25, 0xc8, 0x9b, 0x08,
+	0x87, 0xad, 0xcc, 0xed, 0xcc, 0xe3, 0x0b, 0x2c, 0x6f, 0x0a, 0x68, 0x80, 0xd0, 0x2a, 0x4e, 0x30,
+	0x42, 0x03, 0x01, 0x1d, 0x23, 0xb4, 0x86, 0xb3, 0x81, 0xd0, 0xb1, 0x80, 0x4e, 0x11, 0x5a, 0xdf,
+	0xca, 0xdc, 0xce, 0x22, 0x14, 0x73, 0xea, 0x75, 0x28, 0x99, 0x46, 0x68, 0x21, 0xa2, 0x21, 0x86,
+	0x1c, 0x01, 0x10, 0x87, 0x2b, 0x0e, 0x71, 0x1b, 0x62, 0xd0, 0x11, 0x40, 0xd5, 0xa0, 0x8a, 0x64,
+	0x11, 0x5e, 0x11, 0x78, 0x19, 0xa8, 0xbe, 0x0f, 0x35, 0xd3, 0x9a, 0xd8, 0x33, 0xc3, 0xe1, 0x63,
+	0xda, 0xdc, 0xca, 0xdc, 0xae, 0x6e, 0x6f, 0xdc, 0xa5, 0x3d, 0x11, 0x63, 0x1e, 0x5f, 0x60, 0x29,
+	0x32, 0xf5, 0x01, 0xd4, 0x45, 0xfe, 0xdd, 0x6d, 0x9a, 0x58, 0x95, 0xca, 0x29, 0xa9, 0x72, 0xef,
+	0x6e, 0x3f, 0x78, 0x7c, 0x81, 0xa5, 0x09, 0xd5, 0x5b, 0x50, 0x8b, 0xb7, 0x08, 0x16, 0xbc, 0x28,
+	0x7a, 0x95, 0x82, 0xe2, 0xb0, 0xbe, 0x0c, 0x3c, 0x17, 0x09, 0x2e, 0x89, 0x79, 0x8b, 0x00, 0xea,
+	0x16, 0x80, 0x69, 0x4d, 0x8d, 0x85, 0x13, 0x22, 0xfa, 0xb2, 0x98, 0x40, 0x09, 0xa6, 0xde, 0x84,
+	0xca, 0x62, 0x8e, 0xa3, 0x7c, 0x6a, 0x38, 0xcd, 0x2b, 0x82, 0x20, 0x01, 0x61, 0xed, 0xb8, 0xce,
+	0x11, 0x7b, 0x55, 0x7c, 0xdd, 0x08, 0x80, 0x7b, 0xc5, 0x0e, 0x76, 0x6c, 0xb7, 0xd9, 0xa4, 0x75,
+	0xca, 0x33, 0xea, 0x0d, 0xc8, 0x05, 0xfe, 0xa4, 0x79, 0x8d, 0x46, 0x09, 0x7c, 0x94, 0x9d, 0xd3,
+	0xb9, 0xcf, 0x10, 0xbc, 0x53, 0x82, 0x02, 0xed, 0x19, 0xed, 0x06, 0x94, 0xf7, 0x0d, 0xdf, 0x98,
+	0x31, 0x6b, 0xaa, 0x2a, 0x90, 0x9b, 0x7b, 0x81, 0xd8, 0x2d, 0x98, 0xd4, 0x7a, 0x50, 0x7c, 0x6a,
+	0xf8, 0x88, 0x53, 0x21, 0xef, 0x1a, 0x33, 0x8b, 0x90, 0x15, 0x46, 0x69, 0xdc, 0x21, 0xc1, 0x59,
+	0x10, 0x5a, 0x33, 0xc1, 0x0a, 0x44, 0x0e, 0xe1, 0x87, 0x8e, 0x37, 0x16, 0x3b, 0xa1, 0xcc, 0x44,
+	0x4e, 0xfb, 0x3b, 0x19, 0x28, 0xb6, 0x3d, 0x07, 0xab, 0xbb, 0x0a, 0x25, 0xdf, 0x72, 0xf4, 0xa4,
+	0xb9, 0xa2, 0x6f, 0x39, 0xfb, 0x5e, 0x80, 0x88, 0x89, 0xc7, 0x11, 0x7c, 0x6f, 0x16, 0x27, 0x1e,
+	0x21, 0xa2, 0x0e, 0xe4, 0xa4, 0x0e, 0x5c, 0x83, 0x72, 0x38, 0x76, 0x74, 0x82, 0xe7, 0x09, 0x5e,
+	0x0a, 0xc7, 0x4e, 0x1f, 0x51, 0x57, 0xa1, 0x64, 0x8e, 0x39, 0xa6, 0x40, 0x98, 0xa2, 0x39, 0x46,
+	0x84, 0xf6, 0x11, 0x54, 0x98, 0x71, 0x22, 0xba, 0x71, 0x19, 0x8a, 0x58, 0x81, 0xe0, 0x72, 0x79,
+	0x56, 0x08, 0xc7, 0x4e, 0xd7, 0x44, 0x30, 0x76, 0xc2, 0x36, 0xa9, 0x0f, 0x79, 0x56, 0x98, 0x78,
+	0x4e, 0xd7, 0xd4, 0x46, 0x00, 0x6d, 0xcf, 0xf7, 0x7f, 0xf0, 0x10, 0x2e, 0x41, 0xc1, 0xb4, 0xe6,
+	0xe1, 0x11, 0x67, 0x10, 0x8c, 0x67, 0xb4, 0x3b, 0x50, 0xc6, 0xef, 0xd2, 0xb3, 0x83, 0x50, 0xbd,
+	0x09, 0x79, 0xc7, 0x0e, 0xc2, 0x66, 0x66, 0x2b, 0xb7, 0xf4, 0xd5, 0x08, 0xae, 0x6d, 0x41, 0x79,
+	0xcf, 0x38, 0x7d, 0x8a, 0x5f, 0x4e, 0xbd, 0x24, 0x3e, 0xa1, 0xf8, 0x24, 0xe2, 0x7b, 0xd6, 0x00,
+	0x46, 0x86, 0x7f, 0x68, 0x85, 0xc4, 0xcf, 0xfe, 0x2a, 0x03, 0xd5, 0xe1, 0x62, 0xfc, 0xd5, 0xc2,
+	0xf2, 0xcf, 0xb0, 0xcf, 0xb7, 0x21, 0x17, 0x9e, 0xcd, 0xa9, 0x44, 0x63, 0xfb, 0x0a, 0xaf, 0x5e,
+	0xc2, 0xdf, 0xc5, 0x42, 0x0c, 0x49, 0x70, 0x10, 0xae, 0x67, 0x5a, 0xd1, 0x1c, 0x14, 0x58, 0x11,
+	0xb3, 0x5d, 0x13, 0x0f, 0x05, 0x6f, 0x2e, 0xbe, 0x42, 0xd6, 0x9b, 0xab, 0x5b, 0x50, 0x98, 0x1c,
+	0xd9, 0x8e, 0x49, 0x1f, 0x20, 0xdd, 0x67, 0x8e, 0xc0, 0xaf, 0xe4, 0x7b, 0x27, 0x7a, 0x60, 0x7f,
+	0x1d, 0x31, 0xf9, 0x92, 0xef, 0x9d, 0x0c, 0xed, 0xaf, 0x2d, 0x6d, 0x24, 0x4e, 0x1a, 0x80, 0xe2,
+	0xb0, 0xdd, 0xea, 0xb5, 0x98, 0x72, 0x01, 0xd3, 0x9d, 0xcf, 0xba, 0xc3, 0xd1, 0x50, 0xc9, 0xa8,
+	0x0d, 0x80, 0xfe, 0x60, 0xa4, 0x8b, 0x7c, 0x56, 0x2d, 0x42, 0xb6, 0xdb, 0x57, 0x72, 0x48, 0x83,
+	0xf0, 0x6e, 0x5f, 0xc9, 0xab, 0x25, 0xc8, 0xb5, 0xfa, 0x9f, 0x2b, 0x05, 0x4a, 0xf4, 0x7a, 0x4a,
+	0x51, 0xfb, 0xc3, 0x2c, 0x54, 0x06, 0xe3, 0x2f, 0xad, 0x49, 0x88, 0x63, 0xc6, 0x55, 0x6a, 0xf9,
+	0xcf, 0x2d, 0x9f, 0x86, 0x9d, 0x63, 0x22, 0x87, 0x03, 0x31, 0xc7, 0x34, 0xb8, 0x1c, 0xcb, 0x9a,
+	0x63, 0xa2, 0x9b, 0x1c, 0x59, 0x33, 0xa3, 0x99, 0x13, 0x74, 0x94, 0xc3, 0x5d, 0xe1, 0x8d, 0xbf,
+	0xa4, 0xe1, 0xe5, 0x18, 0x26, 0xd5, 0x57, 0xa0, 0xca, 0xeb, 0x90, 0xd7, 0x17, 0x70, 0xd0, 0xf2,
+	0xe2, 0x2b, 0xca, 0x8b, 0x8f, 0x4a, 0x52, 0xad, 0x1c, 0x29, 0x4e, 0x30, 0x0e, 0xea, 0x8b, 0x15,
+	0xed, 0x8d, 0xbf, 0xe4, 0xd8, 0x32, 0x5f, 0xd1, 0xde, 0xf8, 0x4b, 0x42, 0xfd, 0x18, 0x36, 0x83,
+	0xc5, 0x38, 0x98, 0xf8, 0xf6, 0x3c, 0xb4, 0x3d, 0x97, 0xd3, 0x54, 0x88, 0x46, 0x91, 0x11, 0x44,
+	0x7c, 0x1b, 0xca, 0xf3, 0xc5, 0x58, 0xb7, 0xdd, 0xa9, 0x47, 0xcc, 0xbd, 0xba, 0x5d, 0xe7, 0x1f,
+	0x66, 0x7f, 0x31, 0xee, 0xba, 0x53, 0x8f, 0x95, 0xe6, 0x3c, 0xa1, 0xbd, 0x0e, 0x25, 0x01, 0xc3,
+	0xd3, 0x3b, 0xb4, 0x5c, 0xc3, 0x0d, 0xf5, 0xf8, 0xd8, 0x2f, 0x73, 0x40, 0xd7, 0xd4, 0xfe, 0x75,
+	0x06, 0x94, 0xa1, 0xd4, 0xcc, 0x9e, 0x15, 0x1a, 0x6b, 0xb9, 0xc2, 0xcb, 0x00, 0xc6, 0x64, 0xe2,
+	0x2d, 0x78, 0x35, 0x7c, 0xf1, 0x54, 0x04, 0xa4, 0x6b, 0xca, 0x73, 0x93, 0x4b, 0xcd, 0xcd, 0xab,
+	0x50, 0x8b, 0xca, 0x49, 0x1b, 0xba, 0x2a, 0x60, 0xd1, 0xec, 0x04, 0x8b, 0xd4, 0xae, 0x2e, 0x05,
+	0x0b, 0x5e, 0xfa, 0x0a, 0x14, 0x49, 0x46, 0x08, 0xa2, 0x19, 0xe7, 0x39, 0xed, 0x77, 0xb2, 0x50,
+	0x7e, 0xb8, 0x70, 0x27, 0xd8, 0x65, 0xf5, 0x35, 0xc8, 0x4f, 0x17, 0xee, 0xa4, 0x99, 0x91, 0x8f,
+	0x8c, 0x78, 0xa5, 0x30, 0x42, 0xe2, 0x1e, 0x34, 0xfc, 0x43, 0xdc, 0xbb, 0x2b, 0x7b, 0x10, 0xe1,
+	0xda, 0xbf, 0xcd, 0xf0, 0x1a, 0x1f, 0x3a, 0xc6, 0xa1, 0x5a, 0x86, 0x7c, 0x7f, 0xd0, 0xef, 0x28,
+	0x17, 0xd4, 0x1a, 0x94, 0xbb, 0xfd, 0x51, 0x87, 0xf5, 0x5b, 0x3d, 0x25, 0x43, 0x0b, 0x7a, 0xd4,
+	0xda, 0xe9, 0x75, 0x94, 0x2c, 0x62, 0x9e, 0x0e, 0x7a, 0xad, 0x51, 0xb7, 0xd7, 0x51, 0xf2, 0x1c,
+	0xc3, 0xba, 0xed, 0x91, 0x52, 0x56, 0x15, 0xa8, 0xed, 0xb3, 0xc1, 0xee, 0x41, 0xbb, 0xa3, 0xf7,
+	0x0f, 0x7a, 0x3d, 0x45, 0x51, 0x2f, 0xc2, 0x46, 0x0c, 0x19, 0x70, 0xe0, 0x16, 0x16, 0x79, 0xda,
+	0x62, 0x2d, 0xf6, 0x48, 0xf9, 0x99, 0x5a, 0x86, 0x5c, 0xeb, 0xd1, 0x23, 0xe5, 0x1b, 0xdc, 0x1b,
+	0x95, 0x67, 0xdd, 0xbe, 0xfe, 0xb4, 0xd5, 0x3b, 0xe8, 0x28, 0xdf, 0x64, 0xa3, 0xfc, 0x80, 0xed,
+	0x76, 0x98, 0xf2, 0x4d, 0x5e, 0xdd, 0x84, 0xda, 0x17, 0x83, 0x7e, 0x67, 0xaf, 0xb5, 0xbf, 0x4f,
+	0x1d, 0xf9, 0xa6, 0xac, 0xfd, 0x69, 0x1e, 0xf2, 0x38, 0x12, 0x55, 0x4b, 0xf8, 0x40, 0x3c, 0x44,
+	0xdc, 0x88, 0x3b, 0xf9, 0x3f, 0xfd, 0xf3, 0x57, 0x2e, 0x70, 0x0e, 0xf0, 0x2a, 0xe4, 0x1c, 0x3b,
+	0x6c, 0x66, 0xe5, 0xd5, 0x23, 0x64, 0xa3, 0xc7, 0x17, 0x18, 0xe2, 0xd4, 0x9b, 0x90, 0xe1, 0xac,
+	0xa0, 0xba, 0xdd, 0x10, 0xcb, 0x4b, 0x9c, 0x25, 0x8f, 0x2f, 0xb0, 0xcc, 0x5c, 0xbd, 0x01, 0x99,
+	0xe7, 0x82, 0x2f, 0xd4, 0x38, 0x9e, 0x9f, 0x26, 0x88, 0x7d, 0xae, 0x6e, 0x41, 0x6e, 0xe2, 0x71,
+	0xc9, 0x27, 0xc6, 0x73, 0xde, 0x8a, 0xf5, 0x4f, 0x3c, 0x47, 0x7d, 0x0d, 0x72, 0xbe, 0x71, 0xd2,
+	0x2c, 0xca, 0x9f, 0x2b, 0x66, 0xde, 0x48, 0xe4, 0x1b, 0x27, 0xd8, 0x89, 0x69, 0xb3, 0x24, 0x77,
+	0x22, 0xfa, 0xde, 0xd8, 0xcc, 0x54, 0xdd, 0x82, 0xcc, 0x49, 0xb3, 0x2c, 0x1f, 0xf6, 0xcf, 0x6c,
+	0xd7, 0xf4, 0x4e, 0x86, 0x73, 0x6b, 0x82, 0x14, 0x27, 0xea, 0x8f, 0x20, 0x17, 0x2c, 0xc6, 0xb4,
+	0x97, 0xaa, 0xdb, 0x9b, 0x2b, 0x5c, 0x11, 0x1b, 0x0a, 0x16, 0x63, 0xf5, 0x75, 0xc8, 0x4f, 0x3c,
+	0xdf, 0x6f, 0x82, 0x5c, 0x57, 0x72, 0x20, 0xa0, 0xf0, 0x83, 0x78, 0x6c, 0x30, 0x6c, 0x56, 0x65,
+	0xa2, 0x84, 0x23, 0x63, 0x83, 0xa1, 0x7a, 0x4b, 0xb0, 0xf9, 0x9a, 0xdc, 0xeb, 0xe8, 0x10, 0xc0,
+	0x7a, 0x10, 0x8b, 0x1f, 0x69, 0x66, 0x9c, 0x36, 0xeb, 0x32, 0x51, 0xc4, 0xfd, 0xb1, 0x4f, 0x33,
+	0xe3, 0x54, 0xbd, 0x05, 0xb9, 0xe7, 0xd6, 0xa4, 0xd9, 0x90, 0x5b, 0x13, 0x1f, 0xe9, 0x29, 0x0d,
+	0x0f, 0xd1, 0x78, 0x9e, 0x19, 0x8b, 0x53, 0xdc, 0x8e, 0x1b, 0xfc, 0xe4, 0x31, 0x16, 0xa7, 0x5d,
+	0x13, 0x39, 0x9b, 0x6b, 0x3e, 0x27, 0x29, 0x2b, 0xc3, 0x30, 0x89, 0x12, 0x7e, 0x60, 0x39, 0xd6,
+	0x24, 0xb4, 0x9f, 0xdb, 0xe1, 0x19, 0x89, 0x56, 0x19, 0x26, 0x83, 0x76, 0x8a, 0x90, 0xb7, 0x4e,
+	0xe7, 0xbe, 0xb6, 0x0d, 0x90, 0xb4, 0x83, 0x35, 0x39, 0x96, 0x1b, 0x49, 0x0e, 0x8e, 0xe5, 0x22,
+	0x67, 0x30, 0x8d, 0xd0, 0xa0, 0xe5, 0x53, 0x63, 0x94, 0xd6, 0xae, 0x41, 0x25, 0x16, 0xc9, 0xd4,
+	0x1a, 0x64, 0x0c, 0xc1, 0x91, 0x33, 0x86, 0x76, 0x1b, 0x40, 0xa0, 0xde, 0xdd, 0x7e, 0x90, 0xc6,
+	0x61, 0x2e, 0xe2, 0xd3, 0x99, 0xb1, 0xf6, 0x13, 0xa8, 0x31, 0x2b, 0x58, 0x38, 0x61, 0xdb, 0x73,
+	0x76, 0xad, 0xa9, 0xfa, 0x16, 0x40, 0x9c, 0x0f, 0xc4, 0xc1, 0x99, 0x2c, 0xa6, 0x5d, 0x6b, 0xca,
+	0x24, 0xbc, 0xf6, 0xcf, 0xf2, 0x50, 0x14, 0x05, 0x93, 0x43, 0x3e, 0x23, 0x1d, 0xf2, 0x31, 0x4b,
+	0xcb, 0xa6, 0x05, 0x9d, 0x23, 0xdb, 0x34, 0x2d, 0x37, 0x12, 0x68, 0x78, 0x0e, 0x67, 0xdf, 0x70,
+	0x0e, 0x69, 0x85, 0x37, 0xb6, 0xd5, 0xa8, 0xd1, 0xd9, 0xdc, 0xb7, 0x82, 0x80, 0x1f, 0xa5, 0x86,
+	0x73, 0x18, 0x6d, 0xb6, 0xc2, 0xb7, 0x6d, 0xb6, 0x6b, 0x50, 0x76, 0xbd, 0x50, 0x27, 0x75, 0xa3,
+	0x48, 0x6d, 0x94, 0x84, 0x5e, 0xa5, 0xbe, 0x01, 0x25, 0x21, 0x28, 0x36, 0x4b, 0xf2, 0x5e, 0xdc,
+	0xe5, 0x40, 0x16, 0x61, 0xd5, 0x26, 0xca, 0x1d, 0xb3, 0x99, 0xe5, 0x86, 0xd1, 0xd1, 0x21, 0xb2,
+	0xea, 0x8f, 0xa1, 0xe2, 0xb9, 0x3a, 0x97, 0x26, 0x9b, 0x15, 0x79, 0x3d, 0x0d, 0xdc, 0x03, 0x82,
+	0xb2, 0xb2, 0x27, 0x52, 0xd8, 0x15, 0xc7, 0x3b, 0xd1, 0x27, 0x86, 0x6f, 0xd2, 0x52, 0x2f, 0xb3,
+	0x92, 0xe3, 0x9d, 0xb4, 0x0d, 0xdf, 0xe4, 0x47, 0xe9, 0x57, 0xee, 0x62, 0x46, 0xcb, 0xbb, 0xce,
+	0x44, 0x4e, 0xbd, 0x01, 0x95, 0x89, 0xb3, 0x08, 0x42, 0xcb, 0xdf, 0x39, 0xe3, 0xfa, 0x01, 0x4b,
+	0x00, 0xd8, 0xaf, 0xb9, 0x6f, 0xcf, 0x0c, 0xff, 0x8c, 0xd6, 0x72, 0x99, 0x45, 0x59, 0x14, 0x61,
+	0xe6, 0xc7, 0xb6, 0x79, 0xca, 0x95, 0x04, 0xc6, 0x33, 0x48, 0x7f, 0x44, 0x2a, 0x5c, 0x40, 0xcb,
+	0xb5, 0xcc, 0xa2, 0x2c, 0x7d, 0x07, 0x4a, 0xd2, 0x9a, 0xad, 0x30, 0x91, 0x4b, 0xc9, 0x81, 0x9b,
+	0xe7, 0xca, 0x81, 0xea, 0xf2, 0x51, 0xec, 0xf9, 0xf6, 0xa1, 0x2d, 0x0e, 0xd2, 0x8b, 0x84, 0x04,
+	0x0e, 0x22, 0x41, 0xf1, 0x0f, 0x32, 0x50, 0x12, 0x73, 0xac, 0xde, 0xe4, 0xab, 0x3e, 0xcd, 0x30,
+	0xf9, 0x99, 0x80, 0x70, 0xf5, 0x35, 0xa8, 0x8b, 0xca, 0x82, 0xd0, 0xb7, 0xdd, 0x43, 0xb1, 0x7a,
+	0x6a, 0x1c, 0x38, 0x24, 0x18, 0x1e, 0x70, 0xf8, 0x7d, 0x75, 0x63, 0x6c, 0x3b, 0xb8, 0xbb, 0x72,
+	0x42, 0x7f, 0x5e, 0x38, 0x4e, 0x8b, 0x83, 0xd4, 0x77, 0xa0, 0x72, 0x68, 0xb9, 0x96, 0x6f, 0x84,
+	0x56, 0x24, 0x50, 0x89, 0x65, 0xf5, 0x28, 0x02, 0x23, 0xb3, 0x49, 0x88, 0xb4, 0x27, 0x50, 0x93,
+	0x51, 0xab, 0x3d, 0xc9, 0xac, 0xe9, 0x09, 0x7e, 0xc7, 0xd0, 0xf3, 0x2d, 0x33, 0x16, 0xdc, 0x29,
+	0xa7, 0x0d, 0xa0, 0x1c, 0x2d, 0x88, 0x5f, 0xc9, 0x90, 0xb5, 0x5f, 0x83, 0x6a, 0xd7, 0x35, 0xad,
+	0xd3, 0x01, 0x89, 0x0c, 0xea, 0x5b, 0xa0, 0x4e, 0x7c, 0xcb, 0x08, 0x2d, 0xdd, 0x3a, 0x0d, 0x7d,
+	0x43, 0xe7, 0x2a, 0x3e, 0x57, 0xaf, 0x15, 0x8e, 0xe9, 0x20, 0x62, 0x84, 0x70, 0xed, 0xbf, 0x66,
+	0xa0, 0xbe, 0xcf, 0x57, 0xca, 0x13, 0xeb, 0x6c, 0x97, 0x2b, 0x21, 0x93, 0x68, 0x97, 0xe7, 0x19,
+	0xa5, 0xd5, 0x9b, 0x50, 0x9d, 0x1f, 0x5b, 0x67, 0x7a, 0x4a, 0x60, 0xaf, 0x20, 0xa8, 0x4d, 0xfb,
+	0xf9, 0x4d, 0x28, 0x7a, 0xd4, 0x7a, 0x33, 0x27, 0xf3, 0x77, 0xa9, 0x5b, 0x4c, 0x10, 0xa8, 0x1a,
+	0xd4, 0xe3, 0xaa, 0x64, 0x11, 0x44, 0x54, 0x46, 0xcb, 0xe6, 0x12, 0x14, 0x10, 0x15, 0x34, 0x0b,
+	0x5b, 0x39, 0x94, 0xba, 0x29, 0xa3, 0xbe, 0x03, 0xf5, 0x89, 0x37, 0x9b, 0xeb, 0x51, 0x71, 0x71,
+	0x64, 0xa5, 0xf9, 0x50, 0x15, 0x49, 0xf6, 0x79, 0x5d, 0xda, 0xef, 0xe6, 0xa0, 0x4c, 0x7d, 0x10,
+	0xac, 0xc8, 0x36, 0x4f, 0x23, 0x56, 0x54, 0x61, 0x05, 0xdb, 0x44, 0xfe, 0xfc, 0x32, 0x80, 0x8d,
+	0x24, 0xba, 0xc4, 0x90, 0x2a, 0x04, 0x89, 0xba, 0x32, 0x37, 0xfc, 0x30, 0x68, 0xe6, 0x78, 0x57,
+	0x28, 0x83, 0xdf, 0x76, 0xe1, 0xda, 0x5f, 0x2d, 0x78, 0xef, 0xcb, 0x4c, 0xe4, 0xd4, 0xdb, 0xa0,
+	0xf0, 0xca, 0x68, 0xd2, 0x65, 0x19, 0xaa, 0x41, 0x70, 0x9a, 0xf3, 0x68, 0x67, 0x70, 0x1a, 0xeb,
+	0x14, 0x0f, 0x29, 0xce, 0x8e, 0x80, 0x40, 0x1d, 0x84, 0xc8, 0x8c, 0xa6, 0x94, 0x66, 0x34, 0x4d,
+	0x28, 0x3d, 0xb7, 0x03, 0x1b, 0xbf, 0x6a, 0x99, 0x6f, 0x5d, 0x91, 0x95, 0x3e, 0x43, 0xe5, 0x45,
+	0x9f, 0x21, 0x1e, 0xb6, 0xe1, 0x1c, 0x72, 0xe9, 0x35, 0x1a, 0x76, 0xcb, 0x39, 0xf4, 0xd4, 0x77,
+	0xe1, 0x72, 0x82, 0x16, 0xa3, 0x21, 0x5b, 0x0e, 0x99, 0x2b, 0x98, 0x1a, 0x53, 0xd2, 0x88, 0x48,
+	0xbd, 0xb8, 0x03, 0x9b, 0x52, 0x91, 0x39, 0xca, 0x28, 0x01, 0xf1, 0xa9, 0x0a, 0xdb, 0x88, 0xc9,
+	0x49, 0x74, 0x09, 0xb4, 0xff, 0x90, 0x85, 0xfa, 0x43, 0xcf, 0xb7, 0xec, 0x43, 0x37, 0x59, 0x75,
+	0x2b, 0x42, 0x6e, 0xb4, 0x12, 0xb3, 0xd2, 0x4a, 0x7c, 0x05, 0xaa, 0x53, 0x5e, 0x50, 0x0f, 0xc7,
+	0x5c, 0xf7, 0xcd, 0x33, 0x10, 0xa0, 0xd1, 0xd8, 0x41, 0x06, 0x10, 0x11, 0x50, 0xe1, 0x3c, 0x15,
+	0x8e, 0x0a, 0xe1, 0xf9, 0xa4, 0x7e, 0x4c, 0x9c, 0xda, 0xb4, 0x1c, 0x2b, 0xe4, 0x9f, 0xa7, 0xb1,
+	0xfd, 0xb2, 0x10, 0x6a, 0xe4, 0x3e, 0xdd, 0x65, 0xd6, 0xb4, 0x45, 0x32, 0x0e, 0x32, 0xee, 0x5d,
+	0x22, 0x57, 0x3f, 0x96, 0xb9, 0x7c, 0xf1, 0x3b, 0x96, 0xe5, 0xbb, 0x5d, 0x1b, 0x41, 0x25, 0x06,
+	0xa3, 0xc0, 0xca, 0x3a, 0x42, 0x48, 0xbd, 0xa0, 0x56, 0xa1, 0xd4, 0x6e, 0x0d, 0xdb, 0xad, 0xdd,
+	0x8e, 0x92, 0x41, 0xd4, 0xb0, 0x33, 0xe2, 0x82, 0x69, 0x56, 0xdd, 0x80, 0x2a, 0xe6, 0x76, 0x3b,
+	0x0f, 0x5b, 0x07, 0xbd, 0x91, 0x92, 0x53, 0xeb, 0x50, 0xe9, 0x0f, 0xf4, 0x56, 0x7b, 0xd4, 0x1d,
+	0xf4, 0x95, 0xbc, 0xf6, 0x5b, 0x19, 0x28, 0xb7, 0x8f, 0xac, 0xc9, 0xf1, 0x79, 0xd3, 0x48, 0xca,
+	0xa3, 0x35, 0x39, 0x6e, 0x66, 0x57, 0xb8, 0x0c, 0x47, 0xac, 0xb2, 0x99, 0xdc, 0x1a, 0x7e, 0x76,
+	0x1d, 0xca, 0x96, 0x3b, 0xf5, 0xfc, 0x89, 0xe0, 0x9a, 0x65, 0x16, 0xe7, 0xb5, 0x7f, 0x91, 0x01,
+	0x18, 0xf9, 0xf6, 0xe1, 0xa1, 0xe5, 0x7f, 0xcb, 0xc7, 0x44, 0x53, 0x4e, 0x74, 0xe4, 0x87, 0x36,
+	0xdf, 0x5c, 0xd6, 0x73, 0x5c, 0xe1, 0xbc, 0x3d, 0x9e, 0x41, 0xca, 0xb1, 0x67, 0x9e, 0x09, 0xc6,
+	0x40, 0x69, 0xf5, 0x75, 0xd8, 0x30, 0xad, 0xa9, 0xed, 0x5a, 0xbe, 0xbe, 0x08, 0x2c, 0x1f, 0x77,
+	0x71, 0x81, 0x4e, 0xc7, 0xba, 0x00, 0x1f, 0x04, 0x96, 0xdf, 0x35, 0x65, 0x3a, 0xdf, 0x73, 0x48,
+	0xb3, 0x2e, 0xa6, 0xe8, 0x98, 0xe7, 0x58, 0x5d, 0x53, 0x7b, 0x0a, 0xb5, 0x76, 0x74, 0x76, 0x9e,
+	0xd7, 0xe3, 0x6d, 0x68, 0x10, 0xbf, 0x99, 0x8c, 0x23, 0x86, 0x93, 0x5d, 0xc3, 0x70, 0x6a, 0x48,
+	0xd3, 0x1e, 0x0b, 0x8e, 0xf3, 0x3e, 0x54, 0xf7, 0x7d, 0x6f, 0x6e, 0xf9, 0x21, 0x55, 0xab, 0x40,
+	0xee, 0xd8, 0x3a, 0x13, 0xb5, 0x62, 0x32, 0x31, 0x28, 0x64, 0x65, 0x83, 0xc2, 0x36, 0x94, 0xa3,
+	0x62, 0xdf, 0xb9, 0xcc, 0x27, 0x50, 0x17, 0x65, 0x6c, 0x2b, 0xc0, 0xc6, 0xee, 0x02, 0xcc, 0x63,
+	0x80, 0x10, 0xd2, 0x22, 0x8d, 0x41, 0x54, 0xce, 0x24, 0x0a, 0xed, 0xaf, 0x72, 0xd0, 0xd8, 0x37,
+	0xfc, 0xd0, 0xc6, 0xf5, 0xc8, 0xa7, 0xe1, 0x0d, 0xc8, 0xd3, 0x2e, 0xe7, 0xb6, 0x8b, 0x8b, 0xb1,
+	0xba, 0xc1, 0x69, 0x48, 0xda, 0x22, 0x02, 0xf5, 0x63, 0x68, 0xcc, 0x23, 0xb0, 0x4e, 0x47, 0x18,
+	0x9f, 0x9b, 0xe5, 0x22, 0xb4, 0xca, 0xea, 0x73, 0x39, 0xab, 0xfe, 0x14, 0x2e, 0xa5, 0xcb, 0x5a,
+	0x41, 0x90, 0x1c, 0x1d, 0xf2, 0xf2, 0xbc, 0x98, 0x2a, 0xc8, 0xc9, 0xd4, 0x36, 0x6c, 0x26, 0xc5,
+	0x27, 0x9e, 0xb3, 0x98, 0xb9, 0x81, 0x38, 0xc6, 0xaf, 0x2c, 0xb5, 0xde, 0xe6, 0x58, 0xa6, 0xcc,
+	0x97, 0x20, 0xaa, 0x06, 0xb5, 0x18, 0xd6, 0x5f, 0xcc, 0x68, 0x31, 0xe5, 0x59, 0x0a, 0xa6, 0xde,
+	0x07, 0x88, 0xf3, 0xa8, 0xf1, 0xe6, 0xd6, 0x8c, 0xaf, 0x1b, 0x5a, 0x33, 0x26, 0x91, 0xa1, 0x94,
+	0x86, 0xfc, 0xcf, 0xb7, 0xc3, 0xa3, 0x19, 0x31, 0xee, 0x1c, 0x4b, 0x00, 0x74, 0x3e, 0x04, 0x3a,
+	0xaa, 0xd7, 0x71, 0x11, 0xc1, 0xc3, 0x1b, 0x76, 0x30, 0x5c, 0x8c, 0xe3, 0x7a, 0x71, 0x4b, 0x26,
+	0xa3, 0x9c, 0x05, 0x87, 0xc2, 0x08, 0x91, 0xf4, 0x70, 0x2f, 0x38, 0x54, 0xb7, 0xe1, 0x72, 0x42,
+	0x94, 0x1c, 0x39, 0x41, 0x13, 0xe8, 0xb0, 0x4a, 0xa6, 0x2f, 0x3e, 0x77, 0x02, 0xed, 0x53, 0xa8,
+	0xa7, 0xbe, 0xce, 0x0b, 0x65, 0x90, 0x6b, 0x50, 0xc6, 0xff, 0xc8, 0x1a, 0xc4, 0x02, 0x2c, 0x61,
+	0x7e, 0x18, 0xfa, 0x9a, 0x05, 0xca, 0xf2, 0x5c, 0xab, 0xb7, 0xc8, 0x30, 0x87, 0xc9, 0x35, 0x06,
+	0xb6, 0x08, 0x85, 0x76, 0x96, 0xd5, 0x8f, 0x98, 0xa5, 0x5e, 0xaf, 0x7c, 0x2c, 0xed, 0x9f, 0x66,
+	0xa1, 0x9e, 0x9a, 0x71, 0xf5, 0x47, 0xf2, 0xf2, 0x93, 0x36, 0x6e, 0x32, 0x67, 0x74, 0xc8, 0xbe,
+	0x09, 0x8a, 0xe7, 0x9b, 0xb6, 0x6b, 0x90, 0xa1, 0x90, 0x4f, 0x77, 0x96, 0xd8, 0xc1, 0x86, 0x80,
+	0xef, 0x0b, 0x30, 0x2a, 0x65, 0xa6, 0x15, 0xdb, 0x5d, 0x04, 0x43, 0x92, 0x41, 0xf2, 0x81, 0x9c,
+	0x4f, 0x1f, 0xc8, 0x6f, 0x40, 0xc5, 0xb1, 0x82, 0x40, 0x0f, 0x8f, 0x0c, 0xb7, 0x59, 0x58, 0x19,
+	0x74, 0x19, 0x91, 0xa3, 0x23, 0xc3, 0x45, 0x42, 0xdb, 0xd5, 0x85, 0x67, 0xa5, 0xb8, 0x4a, 0x68,
+	0xbb, 0xa4, 0x77, 0xa2, 0xa8, 0x73, 0x69, 0xdd, 0x87, 0x15, 0x92, 0x80, 0xba, 0xfa, 0x5d, 0xb5,
+	0x97, 0xa1, 0xf4, 0xd4, 0xb6, 0x4e, 0x04, 0x2f, 0x7b, 0x6e, 0x5b, 0x27, 0x11, 0x2f, 0xc3, 0xb4,
+	0xf6, 0xdb, 0x15, 0x28, 0x13, 0xf1, 0xee, 0xf9, 0x06, 0xd9, 0xef, 0xa3, 0x94, 0x6d, 0x41, 0x3e,
+	0x3e, 0x5d, 0x97, 0x39, 0x22, 0x61, 0x50, 0xc0, 0x90, 0xc4, 0x06, 0x2e, 0x04, 0x55, 0xc2, 0x58,
+	0x5a, 0x40, 0x6d, 0x86, 0x64, 0xd1, 0xe0, 0x2b, 0x47, 0x58, 0x93, 0x12, 0x80, 0x7a, 0x97, 0xeb,
+	0x1a, 0x64, 0x47, 0x2a, 0xc9, 0x8c, 0x85, 0xc6, 0x10, 0x99, 0x1e, 0x48, 0x01, 0xc1, 0x0c, 0x89,
+	0x44, 0x96, 0x1f, 0x44, 0xdb, 0xa9, 0xce, 0xa2, 0x2c, 0x72, 0x34, 0x94, 0x17, 0x9b, 0x55, 0xb9,
+	0x96, 0x94, 0xc0, 0xcb, 0x88, 0x40, 0xbd, 0x0d, 0x25, 0x92, 0x52, 0x2c, 0x14, 0x5a, 0x24, 0xd6,
+	0x19, 0xc9, 0x8f, 0x2c, 0x42, 0xab, 0x6f, 0x42, 0x61, 0x7a, 0x6c, 0x9d, 0x05, 0xcd, 0xba, 0xcc,
+	0x12, 0x52, 0xc7, 0x3f, 0xe3, 0x14, 0xea, 0x2d, 0x68, 0xf8, 0xd6, 0x54, 0x27, 0x13, 0x2d, 0xca,
+	0x2b, 0x41, 0xb3, 0x41, 0xe2, 0x48, 0xcd, 0xb7, 0xa6, 0x6d, 0x04, 0x8e, 0xc6, 0x4e, 0xa0, 0xbe,
+	0x0e, 0x45, 0x3a, 0x87, 0x51, 0x15, 0x93, 0x5a, 0x8e, 0x0e, 0x75, 0x26, 0xb0, 0xea, 0x5b, 0x50,
+	0x0e, 0xf9, 0x21, 0x1b, 0x34, 0x95, 0xad, 0x5c, 0x62, 0x8c, 0x48, 0x8e, 0x5e, 0x16, 0x53, 0xa8,
+	0xdb, 0x50, 0x49, 0x98, 0xcc, 0x65, 0x1a, 0xfe, 0xa5, 0x25, 0xee, 0x45, 0x4c, 0x9f, 0x25, 0x64,
+	0xea, 0xbb, 0x00, 0x42, 0xa5, 0xd4, 0xc7, 0x67, 0xcd, 0x2b, 0xb2, 0x6e, 0x24, 0x1f, 0x97, 0xb2,
+	0xe2, 0xf9, 0x06, 0x14, 0xf0, 0x4c, 0x09, 0x9a, 0x57, 0xb7, 0x72, 0x89, 0xc8, 0x29, 0x1d, 0x82,
+	0x8c, 0xe3, 0xd1, 0x5a, 0x8a, 0x4b, 0x51, 0xc7, 0x0f, 0xde, 0x94, 0x75, 0x6c, 0xb1, 0x6e, 0x51,
+	0x8c, 0xb5, 0x4e, 0x86, 0x5f, 0x39, 0xea, 0x1d, 0xc8, 0x9b, 0xd6, 0x34, 0x68, 0x5e, 0xdb, 0xca,
+	0x25, 0x4c, 0x3d, 0x5a, 0xbd, 0xa8, 0x92, 0xf3, 0x83, 0x08, 0x69, 0xd4, 0xc7, 0xd0, 0xc0, 0x85,
+	0xba, 0x4d, 0x9a, 0x09, 0x7e, 0xa0, 0xe6, 0x75, 0x2a, 0xf5, 0xea, 0x52, 0xa9, 0xbe, 0x20, 0xa2,
+	0xcf, 0xd9, 0x71, 0x43, 0xff, 0x8c, 0xd5, 0x5d, 0x19, 0x86, 0xf2, 0x8d, 0x1d, 0xf4, 0xbc, 0xc9,
+	0xb1, 0x65, 0x36, 0x5f, 0xe2, 0xf2, 0x4d, 0x94, 0x57, 0x3f, 0x82, 0x3a, 0x2d, 0x5d, 0xcc, 0x62,
+	0xe3, 0xcd, 0x1b, 0xf2, 0x01, 0x39, 0x92, 0x51, 0x2c, 0x4d, 0x89, 0xf2, 0xa8, 0x1d, 0xe8, 0xa1,
+	0x35, 0x9b, 0x7b, 0x3e, 0x6a, 0xe7, 0x2f, 0x73, 0x85, 0xd4, 0x0e, 0x46, 0x11, 0x08, 0x4f, 0x85,
+	0xd8, 0xa1, 0xab, 0x7b, 0xd3, 0x69, 0x60, 0x85, 0xcd, 0x9b, 0xb4, 0x33, 0x1b, 0x91, 0x5f, 0x77,
+	0x40, 0x50, 0x92, 0xda, 0x03, 0xdd, 0x3c, 0x73, 0x8d, 0x99, 0x3d, 0x69, 0xbe, 0xc2, 0x8d, 0x00,
+	0x76, 0xb0, 0xcb, 0x01, 0xb2, 0x1e, 0xbe, 0x25, 0xeb, 0xe1, 0xd7, 0x1f, 0x91, 0x96, 0x4d, 0xfd,
+	0x79, 0x7f, 0x49, 0x4a, 0x48, 0x6d, 0x0b, 0x49, 0x9c, 0x40, 0xdf, 0x59, 0x42, 0xb8, 0x53, 0x80,
+	0x9c, 0x69, 0x4d, 0xaf, 0xff, 0x0c, 0xd4, 0xd5, 0x99, 0x7c, 0x91, 0xc8, 0x52, 0x10, 0x22, 0xcb,
+	0xc7, 0xd9, 0x07, 0x19, 0xed, 0x23, 0xa8, 0xa7, 0x36, 0xf1, 0x5a, 0xd1, 0x8b, 0x6b, 0x5d, 0xc6,
+	0x4c, 0x58, 0xb6, 0x78, 0x46, 0xfb, 0xb3, 0x1c, 0xd4, 0x1e, 0x1b, 0xc1, 0xd1, 0x9e, 0x31, 0x1f,
+	0x86, 0x46, 0x18, 0xe0, 0xdc, 0x1e, 0x19, 0xc1, 0xd1, 0xcc, 0x98, 0x73, 0xc7, 0x47, 0x86, 0x9b,
+	0xd2, 0x04, 0x0c, 0x9d, 0x1f, 0xf8, 0x55, 0x31, 0x3b, 0x70, 0xf7, 0x9f, 0x08, 0x3d, 0x3c, 0xce,
+	0x23, 0xd7, 0x08, 0x8e, 0x16, 0xd3, 0xa9, 0x63, 0x09, 0xee, 0x16, 0x65, 0xd5, 0x5b, 0x50, 0x17,
+	0x49, 0xd2, 0x6f, 0x4f, 0x85, 0x37, 0x3d, 0x0d, 0x54, 0xef, 0x43, 0x55, 0x00, 0x46, 0x11, 0x8f,
+	0x6b, 0xc4, 0xa6, 0xcd, 0x04, 0xc1, 0x64, 0x2a, 0xf5, 0xe7, 0x70, 0x59, 0xca, 0x3e, 0xf4, 0xfc,
+	0xbd, 0x85, 0x13, 0xda, 0xed, 0xbe, 0x50, 0x26, 0x5e, 0x5a, 0x29, 0x9e, 0x90, 0xb0, 0xf5, 0x25,
+	0xd3, 0xbd, 0xdd, 0xb3, 0x5d, 0x21, 0x77, 0xa4, 0x81, 0x4b, 0x54, 0xc6, 0x69, 0xb3, 0xbc, 0x42,
+	0x65, 0x9c, 0xe2, 0x4a, 0x17, 0x80, 0x3d, 0x2b, 0x3c, 0xf2, 0xcc, 0x66, 0x45, 0x5e, 0xe9, 0x43,
+	0x19, 0xc5, 0xd2, 0x94, 0x38, 0x9d, 0x68, 0x66, 0x99, 0xb8, 0x21, 0xe9, 0x93, 0x39, 0x16, 0x65,
+	0xf1, 0x14, 0xf1, 0x0d, 0xf7, 0xd0, 0x0a, 0x9a, 0xd5, 0xad, 0xdc, 0xed, 0x0c, 0x13, 0x39, 0xed,
+	0xb7, 0xb2, 0x50, 0xe0, 0x5f, 0xf2, 0x25, 0xa8, 0x8c, 0x31, 0x5c, 0x42, 0x47, 0xbb, 0x97, 0xf0,
+	0x8a, 0x10, 0x00, 0x05, 0x31, 0xd2, 0x03, 0x03, 0x6e, 0x25, 0xcf, 0x30, 0x4a, 0x63, 0x95, 0xde,
+	0x22, 0x9c, 0x08, 0xdd, 0x21, 0xc3, 0x44, 0x0e, 0x3b, 0xe1, 0x7b, 0x27, 0xb4, 0x1a, 0xf2, 0x84,
+	0x88, 0xb2, 0xd8, 0x04, 0x3f, 0x90, 0xb0, 0x50, 0x81, 0x70, 0x65, 0x02, 0xb4, 0xdd, 0x70, 0xd9,
+	0x26, 0x5b, 0x5c, 0xb1, 0xc9, 0x62, 0x58, 0x04, 0x29, 0x3b, 0x03, 0xd7, 0x6a, 0xf7, 0x69, 0x86,
+	0xcb, 0x4c, 0x82, 0xa8, 0x1f, 0xc4, 0x6b, 0x91, 0x46, 0xd4, 0x2c, 0xcb, 0xcc, 0x53, 0x5e, 0xb5,
+	0x2c, 0x45, 0xa7, 0x3d, 0x03, 0x60, 0xde, 0x49, 0x60, 0x85, 0x24, 0x8c, 0x5d, 0xa5, 0xee, 0xa7,
+	0xfc, 0x9d, 0xde, 0x09, 0xba, 0x35, 0x85, 0xdb, 0x38, 0x1b, 0xbb, 0x8d, 0x63, 0xb9, 0x2d, 0xb7,
+	0x5e, 0x6e, 0xd3, 0xee, 0x41, 0x09, 0x0f, 0x64, 0x23, 0x34, 0xd0, 0x14, 0x4e, 0x76, 0xe2, 0x8c,
+	0x7c, 0x68, 0x24, 0xad, 0x0a, 0xcb, 0x71, 0x2f, 0xea, 0x09, 0x95, 0x79, 0x55, 0x32, 0x03, 0xc5,
+	0xac, 0x5a, 0x54, 0x28, 0x8e, 0xf8, 0x97, 0xa0, 0x82, 0x9d, 0x25, 0xd7, 0x91, 0xe8, 0x19, 0x3a,
+	0x21, 0xdb, 0x98, 0xd7, 0xfe, 0x5b, 0x06, 0xaa, 0x03, 0xdf, 0xc4, 0x33, 0x02, 0x9d, 0x00, 0x2f,
+	0x14, 0x33, 0x51, 0x20, 0xf0, 0x1c, 0xc7, 0x88, 0x85, 0xb4, 0x0a, 0x4b, 0x00, 0xea, 0xbb, 0x90,
+	0x9f, 0x3a, 0x06, 0x57, 0x4c, 0x63, 0x8d, 0x5b, 0xaa, 0x3e, 0x4a, 0xa3, 0xbf, 0x88, 0x11, 0xa9,
+	0xf6, 0x1b, 0x50, 0x95, 0x80, 0x29, 0xd7, 0xd1, 0x05, 0x72, 0x63, 0x0e, 0xdb, 0x4a, 0x06, 0x7d,
+	0x4b, 0xbb, 0x9d, 0x61, 0x9b, 0xeb, 0xd9, 0xa8, 0x71, 0x0f, 0xf5, 0x87, 0x5d, 0x36, 0x1c, 0x29,
+	0x79, 0xf2, 0x8b, 0x12, 0xa0, 0xd7, 0x1a, 0xa2, 0x23, 0x09, 0xa0, 0x78, 0xd0, 0xef, 0xfe, 0xfc,
+	0xa0, 0xa3, 0x28, 0xda, 0x7f, 0xc9, 0x00, 0x24, 0x1e, 0x0e, 0xf5, 0xc7, 0x50, 0x3d, 0xa1, 0x9c,
+	0x2e, 0xb9, 0xbe, 0xe4, 0x31, 0x02, 0x47, 0x93, 0xb0, 0xf2, 0xb6, 0xa4, 0x7b, 0xe0, 0x31, 0xbb,
+	0xea, 0x03, 0xab, 0xce, 0x93, 0x13, 0x1a, 0x4f, 0x7d, 0x0f, 0xc7, 0x81, 0xa4, 0x39, 0xf9, 0x8c,
+	0x95, 0x86, 0xcf, 0x4a, 0x9e, 0x6f, 0x46, 0xc7, 0xf1, 0xd4, 0x8f, 0xcc, 0x6a, 0x31, 0xe9, 0x43,
+	0x04, 0xb5, 0x1d, 0x63, 0x11, 0x58, 0x8c, 0xe3, 0x63, 0xb6, 0x5b, 0x48, 0xd8, 0xae, 0xf6, 0x05,
+	0x34, 0x86, 0xc6, 0x6c, 0xce, 0x99, 0x33, 0x0d, 0x4c, 0x85, 0x3c, 0xae, 0x09, 0xb1, 0x18, 0x29,
+	0x8d, 0x5b, 0x6c, 0xdf, 0xf2, 0x27, 0x96, 0x1b, 0xed, 0xc8, 0x28, 0x8b, 0xcc, 0xf6, 0x20, 0xb0,
+	0xdd, 0x43, 0xe6, 0x9d, 0x44, 0x81, 0x49, 0x51, 0x5e, 0xfb, 0xe7, 0x19, 0xa8, 0x4a, 0xdd, 0x50,
+	0xef, 0xa5, 0x54, 0xcd, 0x97, 0x56, 0xfa, 0xc9, 0xd3, 0x92, 0xca, 0xf9, 0x3a, 0x14, 0x82, 0xd0,
+	0xf0, 0x23, 0x67, 0x99, 0x22, 0x95, 0xd8, 0xf1, 0x16, 0xae, 0xc9, 0x38, 0x1a, 0x3d, 0x01, 0x96,
+	0x6b, 0x36, 0x73, 0xe7, 0x50, 0x21, 0x52, 0xdb, 0x82, 0x4a, 0x5c, 0x3d, 0x2e, 0x01, 0x36, 0x78,
+	0x36, 0x54, 0x2e, 0xa8, 0x15, 0x28, 0xb0, 0x56, 0xff, 0x51, 0x47, 0xc9, 0xa0, 0x27, 0x16, 0x92,
+	0x52, 0xea, 0xdd, 0x54, 0x6f, 0xaf, 0x2f, 0xd7, 0x7a, 0x97, 0xfe, 0x4a, 0x9d, 0xbd, 0x01, 0x95,
+	0x85, 0x4b, 0xc0, 0xd8, 0xfe, 0x9b, 0x00, 0x30, 0x6c, 0x24, 0x0a, 0x61, 0x5a, 0x0a, 0x1b, 0x79,
+	0x6e, 0x38, 0xda, 0xc7, 0x50, 0x89, 0xab, 0x43, 0x63, 0xcf, 0xc3, 0x41, 0xaf, 0x37, 0x78, 0xd6,
+	0xed, 0x3f, 0x52, 0x2e, 0x60, 0x76, 0x9f, 0x75, 0xda, 0x9d, 0x5d, 0xcc, 0x66, 0x70, 0xcd, 0xb6,
+	0x0f, 0x18, 0xeb, 0xf4, 0x47, 0x3a, 0x1b, 0x3c, 0x53, 0xb2, 0xda, 0xdf, 0xcd, 0xc3, 0xe6, 0xc0,
+	0xdd, 0x5d, 0xcc, 0x1d, 0x7b, 0x62, 0x84, 0xd6, 0x13, 0xeb, 0xac, 0x1d, 0x9e, 0xe2, 0x71, 0x6a,
+	0x84, 0xa1, 0xcf, 0x37, 0x73, 0x85, 0xf1, 0x0c, 0x37, 0x56, 0x06, 0x96, 0x1f, 0x92, 0x2d, 0x56,
+	0xde, 0xc5, 0x0d, 0x0e, 0x6f, 0x7b, 0x0e, 0xed, 0x65, 0xf5, 0xa7, 0x70, 0x99, 0x1b, 0x38, 0x39,
+	0x25, 0x4a, 0xa3, 0xba, 0xe0, 0x3d, 0xcb, 0x4b, 0x57, 0xe5, 0x84, 0x58, 0x14, 0xc9, 0x10, 0x86,
+	0x36, 0xbb, 0xa4, 0x38, 0xd7, 0x19, 0x2a, 0x0c, 0x62, 0x42, 0xea, 0x09, 0x1a, 0xe4, 0xa2, 0x5e,
+	0xeb, 0xe8, 0xad, 0x40, 0x3d, 0xaa, 0xc0, 0x1a, 0x5e, 0x32, 0x18, 0x3c, 0x72, 0x3f, 0x83, 0xcd,
+	0x14, 0x25, 0xf5, 0x82, 0x6b, 0x52, 0x6f, 0x45, 0xce, 0x96, 0xa5, 0xd1, 0xcb, 0x10, 0xec, 0x0e,
+	0x17, 0xfe, 0x36, 0xbc, 0x34, 0x14, 0x99, 0x99, 0x1d, 0xe8, 0xf6, 0xa1, 0xeb, 0xf9, 0x96, 0x60,
+	0xef, 0x65, 0x3b, 0xe8, 0x52, 0x3e, 0x51, 0x66, 0xa4, 0x98, 0x01, 0x7e, 0x9a, 0x44, 0x2e, 0x73,
+	0x8e, 0xb6, 0xf9, 0x79, 0x99, 0x67, 0x25, 0xca, 0x77, 0x4d, 0xd4, 0xe3, 0x39, 0x2a, 0xd2, 0x4f,
+	0x80, 0xf4, 0x93, 0x1a, 0x01, 0x9f, 0x72, 0xd8, 0xf5, 0x3e, 0x5c, 0x5a, 0xd7, 0xc9, 0x35, 0x72,
+	0xd5, 0x96, 0x2c, 0x57, 0x2d, 0xd9, 0xf2, 0x12, 0x19, 0xeb, 0xdf, 0x65, 0xa1, 0xd2, 0xe5, 0x9f,
+	0x30, 0x3c, 0x45, 0x1f, 0xb3, 0x6f, 0x4d, 0xcf, 0xf3, 0xc7, 0x23, 0x0e, 0x6d, 0xb7, 0x86, 0x69,
+	0xea, 0xc6, 0x74, 0x6a, 0x4d, 0x42, 0xcb, 0xd4, 0xf1, 0xcc, 0x14, 0xcb, 0x76, 0xc3, 0x30, 0xcd,
+	0x96, 0x80, 0xd3, 0xf6, 0xe7, 0x36, 0x8c, 0x48, 0x4d, 0xa0, 0x71, 0x88, 0xcd, 0xde, 0xb0, 0x03,
+	0xa1, 0x25, 0x90, 0x84, 0x87, 0x1e, 0x31, 0x3e, 0x76, 0xd3, 0x9a, 0x0a, 0x7e, 0xd4, 0x48, 0x8b,
+	0xe5, 0xe2, 0x04, 0xe6, 0xd6, 0xab, 0x8b, 0xcb, 0x2a, 0xaf, 0x6d, 0x72, 0x0f, 0x40, 0x9e, 0x6d,
+	0xa6, 0x35, 0xde, 0xae, 0x19, 0x9c, 0x6f, 0xfb, 0x28, 0x9e, 0x6b, 0xfb, 0x48, 0x1b, 0x55, 0x70,
+	0x91, 0x95, 0x68, 0xb9, 0x27, 0xec, 0xb8, 0x6b, 0x9e, 0x6a, 0xff, 0x24, 0x87, 0xce, 0xce, 0xb9,
+	0x63, 0x4c, 0xac, 0xff, 0x7f, 0x66, 0xef, 0x15, 0x34, 0x5f, 0x38, 0x56, 0x88, 0x5b, 0xcc, 0x35,
+	0xa3, 0x68, 0x19, 0x0e, 0x6a, 0x7b, 0xc4, 0xc0, 0xd6, 0x4e, 0x6f, 0xf1, 0x7b, 0x4f, 0x6f, 0xe9,
+	0x7b, 0x4c, 0x6f, 0x79, 0x75, 0x7a, 0xd5, 0x9f, 0xc1, 0xcb, 0xbe, 0x75, 0xe2, 0xdb, 0xa1, 0xa5,
+	0x4f, 0x7d, 0x6f, 0xa6, 0xa7, 0xb6, 0x33, 0xae, 0xf6, 0x0a, 0xcd, 0xc6, 0x35, 0x41, 0xf4, 0xd0,
+	0xf7, 0x66, 0xe9, 0x2d, 0xad, 0xfd, 0x75, 0x1e, 0xaa, 0x2d, 0xd7, 0x70, 0xce, 0xbe, 0xb6, 0x28,
+	0xa2, 0x86, 0x5c, 0x19, 0xf3, 0x45, 0xc8, 0xe7, 0x9d, 0x7b, 0xb4, 0x2b, 0x04, 0xa1, 0x19, 0x47,
+	0x1f, 0xe4, 0x22, 0x8c, 0xf1, 0xdc, 0xc7, 0x0d, 0x1c, 0x44, 0x04, 0x71, 0x79, 0x92, 0x1a, 0x73,
+	0x52, 0x79, 0xd2, 0x20, 0x92, 0xf2, 0xb1, 0x54, 0x19, 0x97, 0x27, 0x02, 0xdc, 0xe2, 0xf6, 0x8c,
+	0x66, 0x3e, 0x58, 0xcc, 0x2c, 0x3e, 0xfb, 0x39, 0x1e, 0xb9, 0xd8, 0x16, 0x30, 0xac, 0x65, 0x66,
+	0xcd, 0x3c, 0xff, 0x8c, 0xd7, 0x52, 0xe4, 0xb5, 0x70, 0x10, 0xd5, 0xf2, 0x16, 0xa8, 0x27, 0x86,
+	0x1d, 0xea, 0xe9, 0xaa, 0xb8, 0x24, 0xaf, 0x20, 0x66, 0x24, 0x57, 0x77, 0x05, 0x8a, 0xa6, 0x1d,
+	0x1c, 0x77, 0x07, 0x42, 0x8a, 0x17, 0x39, 0xe4, 0x62, 0xc1, 0xfd, 0xee, 0x40, 0x1f, 0x9f, 0x09,
+	0x27, 0x74, 0x8e, 0x95, 0x11, 0xb0, 0x73, 0x16, 0x92, 0x77, 0x8a, 0x90, 0x7c, 0xb4, 0x9c, 0xe1,
+	0x73, 0x49, 0xbd, 0x81, 0xf0, 0x2e, 0x82, 0x39, 0xc3, 0xbf, 0x03, 0x9b, 0x44, 0x29, 0x06, 0xce,
+	0x49, 0xab, 0x44, 0xba, 0x81, 0x88, 0xc1, 0x22, 0x8c, 0x69, 0x6f, 0x40, 0xc5, 0xb5, 0xc2, 0x13,
+	0xcf, 0xc7, 0xde, 0xd4, 0xf8, 0xec, 0xc5, 0x00, 0x14, 0x09, 0x82, 0x89, 0xe1, 0x62, 0xe7, 0x9b,
+	0x75, 0xd1, 0x1f, 0x91, 0x47, 0x91, 0x9a, 0x1f, 0x34, 0x84, 0x6d, 0xf0, 0x29, 0x49, 0x20, 0xea,
+	0x47, 0x70, 0x2d, 0x35, 0x1b, 0xba, 0xe1, 0xfb, 0xc6, 0x99, 0x3e, 0x33, 0xbe, 0xf4, 0x7c, 0x32,
+	0x95, 0xe4, 0xd8, 0x15, 0x79, 0x92, 0x5b, 0x88, 0xde, 0x43, 0xec, 0xb9, 0x45, 0x6d, 0xd7, 0xf3,
+	0x9b, 0xca, 0x79, 0x45, 0x11, 0x4b, 0x0a, 0x3b, 0x4d, 0x10, 0xe9, 0x1f, 0x01, 0xf9, 0xba, 0x73,
+	0xac, 0x4a, 0xb0, 0x1d, 0x02, 0x69, 0xbe, 0x64, 0x38, 0xdf, 0xf7, 0x17, 0xae, 0xc5, 0x8d, 0x07,
+	0x94, 0x34, 0x85, 0xab, 0x35, 0xce, 0xab, 0xbb, 0x70, 0x91, 0x2b, 0x12, 0x96, 0xa9, 0x4b, 0x06,
+	0xe5, 0xec, 0xf9, 0x06, 0x65, 0x35, 0xa2, 0x8f, 0xc1, 0x81, 0xf6, 0x4d, 0x06, 0xae, 0x0f, 0xc8,
+	0x1f, 0x43, 0x3b, 0x6e, 0xcf, 0x0a, 0x02, 0xe3, 0x10, 0xb5, 0xc0, 0x87, 0x8b, 0xaf, 0xbf, 0x46,
+	0x1b, 0xc2, 0xc6, 0xbe, 0xe1, 0x5b, 0x6e, 0x18, 0xef, 0x47, 0x71, 0x6c, 0x2c, 0x83, 0xd5, 0x07,
+	0x64, 0xb4, 0xb5, 0xdc, 0xf0, 0x20, 0x3e, 0x80, 0x9b, 0xd9, 0x35, 0x66, 0xbc, 0x15, 0x2a, 0xed,
+	0x0f, 0x6e, 0x40, 0xbe, 0xef, 0x99, 0x16, 0x7a, 0xd0, 0x29, 0x6e, 0x71, 0xd5, 0x57, 0x80, 0x68,
+	0xfa, 0x43, 0xb2, 0x50, 0xd9, 0x15, 0xa9, 0xf3, 0x23, 0x1d, 0x5f, 0x25, 0xa9, 0x8e, 0xfc, 0xab,
+	0xc8, 0xe1, 0xaa, 0x42, 0xcf, 0x44, 0x10, 0xe3, 0x18, 0x9c, 0x5b, 0x32, 0xa0, 0xf9, 0x96, 0x4b,
+	0xb2, 0x43, 0x81, 0xc5, 0x79, 0x92, 0xa5, 0x7d, 0x0f, 0xb9, 0xb1, 0x4e, 0xc1, 0x3e, 0x85, 0x35,
+	0xb2, 0x34, 0xc7, 0x53, 0xe8, 0xe7, 0x3b, 0x50, 0xf9, 0xd2, 0xb3, 0x5d, 0xde, 0xf1, 0xe2, 0x4a,
+	0xc7, 0x3f, 0xf5, 0x6c, 0xee, 0xe4, 0x28, 0x7f, 0x29, 0x52, 0xea, 0x6b, 0x50, 0xf2, 0x5c, 0x5e,
+	0x77, 0x69, 0xa5, 0xee, 0xa2, 0xe7, 0xf6, 0x78, 0x10, 0x51, 0x7d, 0xbc, 0x40, 0x13, 0x1f, 0x92,
+	0x5a, 0xd3, 0x50, 0xd8, 0xf4, 0xab, 0x04, 0x1c, 0xb8, 0x3d, 0x6b, 0x8a, 0xe1, 0x21, 0xd5, 0xa9,
+	0xed, 0x20, 0xd3, 0xa7, 0xca, 0x2a, 0x2b, 0x95, 0x01, 0x47, 0x53, 0x85, 0x3f, 0x82, 0xf2, 0xa1,
+	0xef, 0x2d, 0xe6, 0x28, 0xf3, 0xc3, 0x0a, 0x65, 0x89, 0x70, 0x3b, 0x67, 0x38, 0x7a, 0x4a, 0xda,
+	0xee, 0xa1, 0x8e, 0x46, 0xa3, 0xea, 0xea, 0xe8, 0x23, 0xfc, 0xd0, 0xa2, 0x5a, 0x8d, 0xc3, 0x43,
+	0x5d, 0x44, 0x45, 0xad, 0xd4, 0x6a, 0x1c, 0x1e, 0x52, 0xe3, 0x77, 0xa1, 0x7e, 0x82, 0xae, 0xc0,
+	0xb9, 0x35, 0xe1, 0xb4, 0xf5, 0xd5, 0x6a, 0x4f, 0x6c, 0x17, 0xf5, 0x03, 0xa2, 0x97, 0x15, 0x94,
+	0xc6, 0x0b, 0x15, 0x94, 0x2d, 0x28, 0x38, 0xf6, 0xcc, 0x0e, 0x29, 0xec, 0x64, 0x49, 0x82, 0x21,
+	0x84, 0xaa, 0x41, 0x51, 0x18, 0xc1, 0x94, 0x15, 0x12, 0x81, 0x49, 0x1f, 0x8e, 0x9b, 0x2f, 0x38,
+	0x1c, 0x6f, 0x03, 0xc6, 0x77, 0xea, 0x78, 0x8c, 0xab, 0xeb, 0x8f, 0xf1, 0xa2, 0x37, 0xfe, 0x12,
+	0xc3, 0x58, 0xdf, 0x27, 0xbf, 0x82, 0xe5, 0x86, 0x7a, 0x54, 0xe0, 0xe2, 0xfa, 0x02, 0x35, 0x4e,
+	0x36, 0xe0, 0xc5, 0xde, 0x85, 0xaa, 0x4f, 0x9a, 0xb3, 0x4e, 0x6a, 0xf6, 0x25, 0x59, 0xf5, 0x48,
+	0x54, 0x6a, 0x06, 0x7e, 0x9c, 0xc6, 0x43, 0x83, 0x87, 0x67, 0x70, 0x7f, 0x7c, 0x40, 0xc6, 0xd6,
+	0x0a, 0xab, 0x11, 0x90, 0xfb, 0xea, 0x03, 0xf4, 0xe8, 0x45, 0xa7, 0x7a, 0x78, 0xda, 0xbc, 0x2a,
+	0x77, 0x85, 0xbb, 0xa3, 0xdb, 0xe1, 0x29, 0xab, 0x98, 0x51, 0x12, 0x59, 0xd7, 0xd8, 0x76, 0x4d,
+	0x5c, 0x0e, 0xa1, 0x71, 0x18, 0x34, 0x9b, 0xb4, 0x5b, 0xaa, 0x02, 0x36, 0x32, 0x0e, 0x03, 0xf5,
+	0x3d, 0xa8, 0x19, 0xfc, 0xec, 0xe4, 0x71, 0xab, 0xd7, 0x64, 0x35, 0x51, 0x3a, 0x55, 0x59, 0xd5,
+	0x48, 0x32, 0xea, 0x87, 0xa0, 0x46, 0xf6, 0x78, 0x12, 0xb9, 0xf9, 0xba, 0xb8, 0xbe, 0xb2, 0x2e,
+	0x36, 0x84, 0x41, 0x3e, 0x8e, 0xb5, 0xfe, 0x10, 0xea, 0x69, 0x59, 0xe7, 0xc6, 0x1a, 0x9b, 0x32,
+	0x7d, 0x32, 0x56, 0x9b, 0x48, 0x39, 0x9c, 0x1f, 0x8c, 0xd5, 0x9a, 0x18, 0x93, 0x23, 0x8b, 0x0a,
+	0x72, 0xbb, 0x69, 0xcd, 0xf5, 0xc2, 0x76, 0x04, 0xc3, 0xf9, 0x89, 0x34, 0x98, 0xf0, 0xb4, 0x79,
+	0x53, 0x9e, 0x9f, 0x58, 0xfc, 0xc5, 0xa3, 0x5c, 0x24, 0xe9, 0x3b, 0x71, 0xc9, 0x8e, 0x0a, 0xbc,
+	0x92, 0xfa, 0x4e, 0xb1, 0xc8, 0xc7, 0xc0, 0x8f, 0xd3, 0x14, 0x4c, 0xec, 0x2d, 0xfc, 0x89, 0xa5,
+	0x07, 0xa1, 0x35, 0x6f, 0x6e, 0xd1, 0x8c, 0x02, 0x07, 0x0d, 0x43, 0x6b, 0xae, 0x3e, 0x80, 0xc6,
+	0xdc, 0xb7, 0x74, 0xe9, 0x3b, 0xbd, 0x2a, 0x0f, 0x71, 0xdf, 0xb7, 0x92, 0x4f, 0x55, 0x9b, 0x4b,
+	0xb9, 0xa8, 0xa4, 0x34, 0x02, 0x6d, 0xa9, 0x64, 0x32, 0x88, 0xda, 0x5c, 0xca, 0xa9, 0x9f, 0xc0,
+	0xa6, 0x54, 0x72, 0x71, 0x4c, 0x85, 0x5f, 0x4b, 0x99, 0xf8, 0x23, 0xf2, 0x83, 0x63, 0x2c, 0xde,
+	0x98, 0xa7, 0xf2, 0x6a, 0x0b, 0x94, 0x15, 0xb9, 0xeb, 0x16, 0x95, 0xbf, 0x7a, 0x8e, 0x16, 0x95,
+	0xd2, 0xc4, 0x9e, 0x70, 0x0b, 0x6f, 0x37, 0xe8, 0xb8, 0x66, 0xf3, 0x47, 0xfc, 0x42, 0x04, 0x65,
+	0xd4, 0xfb, 0x50, 0x23, 0x33, 0x5e, 0x48, 0xc1, 0x98, 0x41, 0xf3, 0x75, 0xd9, 0xe2, 0x44, 0x36,
+	0x71, 0x42, 0xb0, 0xaa, 0x13, 0xa7, 0x03, 0xf5, 0x03, 0xd8, 0xe4, 0xc6, 0x3f, 0x99, 0x41, 0xbe,
+	0xb1, 0xba, 0xb8, 0x88, 0xe8, 0x61, 0xc2, 0x25, 0x19, 0x5c, 0xf3, 0x17, 0x2e, 0x9d, 0xf3, 0xa2,
+	0xe4, 0xdc, 0xf7, 0xc6, 0x16, 0x2f, 0x7f, 0x7b, 0x2b, 0x97, 0x0c, 0x87, 0x71, 0x32, 0x5e, 0x96,
+	0xf8, 0xd1, 0x15, 0x5f, 0x06, 0xed, 0x63, 0xb9, 0x73, 0xea, 0xe4, 0x9c, 0x9d, 0xea, 0x7c, 0xf3,
+	0xfb, 0xd4, 0xb9, 0x83, 0xe5, 0xa8, 0x4e, 0x15, 0xf2, 0x8b, 0x85, 0x6d, 0x36, 0xef, 0xf0, 0x30,
+	0x4d, 0x4c, 0xa3, 0x07, 0xd3, 0xb7, 0x26, 0x0b, 0x3f, 0xb0, 0x9f, 0x5b, 0x7a, 0x60, 0xbb, 0xc7,
+	0xcd, 0x1f, 0xd3, 0x3c, 0xd6, 0x63, 0xe8, 0xd0, 0x76, 0x8f, 0x71, 0xc5, 0x5a, 0xa7, 0xa1, 0xe5,
+	0xbb, 0x3a, 0x4a, 0x4d, 0xcd, 0xb7, 0xe4, 0x15, 0xdb, 0x21, 0xc4, 0x70, 0x62, 0xb8, 0x0c, 0xac,
+	0x38, 0xad, 0xfe, 0x14, 0x36, 0x12, 0x29, 0x7c, 0x8e, 0x22, 0x48, 0xf3, 0xed, 0xb5, 0xde, 0x1f,
+	0x12, 0x4f, 0x58, 0x63, 0x9e, 0xca, 0x2f, 0xad, 0xad, 0x80, 0xaf, 0xad, 0xbb, 0xdf, 0x69, 0x6d,
+	0x0d, 0x31, 0xaf, 0xbe, 0x0e, 0x65, 0xdb, 0x0d, 0x2d, 0x1f, 0x2d, 0x1c, 0xf7, 0x56, 0x18, 0x78,
+	0x8c, 0x43, 0x47, 0x71, 0xe0, 0xd8, 0xc8, 0x98, 0x9a, 0xef, 0xac, 0x90, 0x45, 0x28, 0x3c, 0xb1,
+	0xa7, 0xb6, 0xe3, 0xf0, 0x13, 0xfb, 0xdd, 0x95, 0x13, 0xfb, 0xa1, 0xed, 0x38, 0xfc, 0xc4, 0x9e,
+	0x8a, 0x14, 0x9e, 0x72, 0x54, 0x02, 0xdb, 0xdf, 0x5e, 0x3d, 0xe5, 0x10, 0xf7, 0x94, 0x6e, 0x38,
+	0x55, 0x03, 0xb2, 0x75, 0x71, 0x93, 0xdd, 0x7d, 0x79, 0x84, 0x69, 0x23, 0x18, 0x83, 0x20, 0xce,
+	0xa3, 0xb2, 0x20, 0x2c, 0x7d, 0xa8, 0xe0, 0xbc, 0xc7, 0x03, 0xef, 0x39, 0x04, 0xb5, 0x9b, 0x77,
+	0xa0, 0x1e, 0x85, 0xfb, 0x60, 0x73, 0x41, 0xf3, 0xfd, 0x95, 0x1e, 0xa4, 0x09, 0xd4, 0x5d, 0xa8,
+	0x4d, 0x51, 0x82, 0x9b, 0x71, 0x81, 0xae, 0xf9, 0x01, 0x75, 0x64, 0x2b, 0x3a, 0x41, 0xcf, 0x13,
+	0xf8, 0x58, 0xaa, 0x94, 0x7a, 0x17, 0x54, 0x7b, 0xca, 0xbf, 0x02, 0x6a, 0x4c, 0x5c, 0x68, 0x6b,
+	0x7e, 0x48, 0x4b, 0x6a, 0x0d, 0x46, 0xbd, 0x0f, 0xf5, 0xc0, 0x72, 0x4d, 0x8c, 0x2c, 0xe0, 0x4b,
+	0xfb, 0xc1, 0x56, 0x2e, 0x61, 0x9e, 0xf1, 0xfd, 0x3e, 0x34, 0x81, 0xbb, 0xe6, 0x5e, 0xc0, 0x05,
+	0x83, 0xfb, 0x80, 0xab, 0xf3, 0x79, 0x52, 0xe8, 0xa3, 0x73, 0x0a, 0x21, 0x95, 0x54, 0x08, 0x97,
+	0xae, 0x1e, 0xb8, 0xc6, 0x3c, 0x38, 0xf2, 0xc2, 0xe6, 0xc7, 0xf2, 0x69, 0x3d, 0x14, 0x50, 0x56,
+	0x43, 0xa2, 0x28, 0x87, 0xdc, 0x3f, 0xd9, 0x1d, 0x93, 0xd0, 0x6a, 0xfe, 0x1a, 0xe7, 0xfe, 0x31,
+	0xb0, 0x1d, 0x5a, 0xda, 0x1f, 0x15, 0xa0, 0x1c, 0x89, 0x9a, 0x18, 0x40, 0x75, 0xd0, 0x7f, 0xd2,
+	0x1f, 0x3c, 0xeb, 0x2b, 0x17, 0xd0, 0x72, 0x4b, 0x11, 0xfb, 0xfa, 0xb0, 0xdd, 0xea, 0xf3, 0x1b,
+	0x2e, 0x74, 0x4f, 0x80, 0xe7, 0xb3, 0xea, 0x26, 0xd4, 0x1f, 0x1e, 0xf4, 0x29, 0x80, 0x8a, 0x83,
+	0x72, 0x08, 0xea, 0x7c, 0xc6, 0xcd, 0xc3, 0x1c, 0x84, 0xb1, 0xfd, 0xf5, 0xbd, 0xd6, 0xa8, 0xc3,
+	0xba, 0x11, 0xa8, 0x40, 0xb1, 0x58, 0x83, 0x03, 0xd6, 0x16, 0x35, 0x15, 0xb1, 0xd9, 0x7d, 0x36,
+	0xf8, 0xb4, 0xd3, 0x1e, 0x29, 0xa0, 0x5e, 0x86, 0xcd, 0xb8, 0x8e, 0xa8, 0x7e, 0xa5, 0x8a, 0x96,
+	0xe7, 0xa8, 0x1e, 0xe5, 0x12, 0xd6, 0xca, 0x3a, 0xed, 0x03, 0x36, 0xec, 0x3e, 0xed, 0xe8, 0xed,
+	0x51, 0x47, 0xb9, 0x8c, 0x06, 0xc8, 0x61, 0xb7, 0xff, 0x44, 0xb9, 0x82, 0xe6, 0x3d, 0x4c, 0xf1,
+	0xda, 0xaf, 0xaa, 0x2a, 0x34, 0x12, 0x5a, 0x82, 0x35, 0xc9, 0x72, 0xfd, 0xe8, 0x91, 0x72, 0x13,
+	0xab, 0xdd, 0xed, 0x0e, 0x47, 0xdd, 0x7e, 0x7b, 0xa4, 0xbc, 0x82, 0xc6, 0xe9, 0x87, 0xdd, 0xde,
+	0xa8, 0xc3, 0x94, 0x2d, 0xac, 0xef, 0xd3, 0x41, 0xb7, 0xaf, 0xbc, 0x8a, 0xd0, 0x61, 0x6b, 0x6f,
+	0xbf, 0xd7, 0x51, 0x34, 0x6a, 0x65, 0xc0, 0x46, 0xca, 0x6b, 0x68, 0xe6, 0x3c, 0xe8, 0x63, 0xdf,
+	0x6e, 0x61, 0x83, 0x94, 0xd4, 0xf1, 0x52, 0xcf, 0x8f, 0x24, 0x13, 0xf7, 0xeb, 0x98, 0x7e, 0xd6,
+	0xed, 0xef, 0x0e, 0x9e, 0x29, 0x6f, 0x20, 0xd9, 0x0e, 0x1b, 0xb4, 0x76, 0xdb, 0x68, 0x09, 0xbf,
+	0x8d, 0x15, 0x0c, 0xf7, 0x7b, 0xdd, 0x91, 0xf2, 0x26, 0x52, 0x3d, 0x6a, 0x8d, 0x1e, 0x77, 0x98,
+	0x72, 0x07, 0xd3, 0xad, 0xe1, 0xb0, 0xc3, 0x46, 0xca, 0x36, 0xa6, 0xbb, 0x7d, 0x4a, 0xdf, 0xc7,
+	0xf4, 0x6e, 0xa7, 0xd7, 0x19, 0x75, 0x94, 0xf7, 0x70, 0xc2, 0x58, 0x67, 0xbf, 0xd7, 0x6a, 0x77,
+	0x94, 0xf7, 0x31, 0xd3, 0x1b, 0xb4, 0x9f, 0xe8, 0x83, 0x7d, 0xe5, 0x03, 0x6c, 0x83, 0x0c, 0xf4,
+	0x43, 0x9c, 0xcc, 0x0f, 0x71, 0x9e, 0xe2, 0x2c, 0xf5, 0xee, 0x01, 0x36, 0xbb, 0xd7, 0xed, 0x1f,
+	0x0c, 0x95, 0x8f, 0x90, 0x98, 0x92, 0x84, 0xf9, 0x58, 0xbd, 0x04, 0xca, 0xa0, 0xaf, 0xef, 0x1e,
+	0xec, 0xf7, 0xba, 0xed, 0xd6, 0xa8, 0xa3, 0x3f, 0xe9, 0x7c, 0xae, 0xfc, 0x1a, 0x7e, 0xf6, 0x7d,
+	0xd6, 0xd1, 0x45, 0x3f, 0x7e, 0x12, 0xe5, 0x45, 0x5f, 0x7e, 0x8a, 0x4d, 0x24, 0x78, 0xfd, 0xe0,
+	0x89, 0xf2, 0xeb, 0x4b, 0xa0, 0xe1, 0x13, 0xe5, 0x13, 0xfc, 0xe6, 0xa3, 0xee, 0x5e, 0x47, 0x17,
+	0x93, 0x81, 0xb7, 0x43, 0xf2, 0x0f, 0xbb, 0xbd, 0x9e, 0xd2, 0x22, 0x6b, 0x6c, 0x8b, 0x8d, 0xba,
+	0xf4, 0xa1, 0x77, 0xf0, 0xa6, 0xc9, 0xc3, 0x83, 0x2f, 0xbe, 0xf8, 0x5c, 0x17, 0x5f, 0xa2, 0x8d,
+	0x3d, 0x6e, 0xed, 0xef, 0xf7, 0x3e, 0x57, 0x76, 0x35, 0x1f, 0xca, 0x91, 0x7a, 0x81, 0xe0, 0x6e,
+	0xbf, 0xdf, 0xc1, 0x8b, 0x58, 0x65, 0xc8, 0xf7, 0x3a, 0x0f, 0x47, 0x4a, 0x06, 0x81, 0xac, 0xfb,
+	0xe8, 0xf1, 0x48, 0xc9, 0x62, 0x72, 0x70, 0x80, 0x35, 0xe4, 0xe8, 0xab, 0x75, 0xf6, 0xba, 0x4a,
+	0x1e, 0x53, 0xad, 0xfe, 0xa8, 0xab, 0x14, 0xe8, 0xab, 0x76, 0xfb, 0x8f, 0x7a, 0x1d, 0xa5, 0x88,
+	0xd0, 0xbd, 0x16, 0x7b, 0xa2, 0x94, 0x78, 0xa5, 0xbb, 0x9d, 0xcf, 0x94, 0x32, 0xde, 0xe0, 0xea,
+	0x6d, 0x2b, 0x15, 0xed, 0x36, 0x94, 0x5a, 0x87, 0x87, 0x7b, 0xa8, 0xb2, 0x61, 0xa7, 0x31, 0x9e,
+	0x90, 0xae, 0x7e, 0xed, 0x0c, 0x46, 0xa3, 0xc1, 0x9e, 0x92, 0xc1, 0xc5, 0x34, 0x1a, 0xec, 0x2b,
+	0x59, 0xad, 0x0b, 0xe5, 0x88, 0x95, 0x4a, 0xd7, 0x6d, 0xca, 0x90, 0xdf, 0x67, 0x9d, 0xa7, 0xdc,
+	0x4d, 0xd2, 0xef, 0x7c, 0x86, 0x7d, 0xc3, 0x14, 0x56, 0x94, 0xc3, 0x06, 0xf9, 0xbd, 0x18, 0xba,
+	0x6f, 0xd3, 0xeb, 0xf6, 0x3b, 0x2d, 0xa6, 0x14, 0xb4, 0xbf, 0x0d, 0xe5, 0x78, 0x1f, 0xdf, 0x82,
+	0xec, 0x68, 0x28, 0x6c, 0x67, 0x97, 0xee, 0x26, 0x77, 0xa0, 0x47, 0x51, 0x8a, 0x65, 0x47, 0x43,
+	0xf5, 0x2d, 0x28, 0xf2, 0x1b, 0x50, 0xcd, 0x6c, 0x8a, 0x0b, 0x8b, 0x5a, 0x46, 0x84, 0x63, 0x82,
+	0x46, 0xeb, 0x41, 0x23, 0x8d, 0x41, 0x3b, 0x02, 0xc7, 0x49, 0x6a, 0xaf, 0x04, 0x41, 0x05, 0x92,
+	0xe7, 0xba, 0xbb, 0x22, 0xfc, 0x27, 0xce, 0x6b, 0x7f, 0x9d, 0x05, 0x48, 0x0e, 0x52, 0x8a, 0x52,
+	0x8c, 0x94, 0xda, 0x82, 0xb0, 0xe5, 0xcb, 0xb7, 0x2c, 0x2a, 0xdc, 0x57, 0x86, 0xf6, 0x97, 0xa9,
+	0xe7, 0xcf, 0x8c, 0x28, 0x74, 0x51, 0xe4, 0x90, 0x71, 0x71, 0x13, 0x32, 0x4a, 0x0c, 0xae, 0xc5,
+	0x03, 0xd3, 0xf2, 0xac, 0x26, 0x80, 0x3d, 0x84, 0xa1, 0x4c, 0x69, 0xb9, 0x13, 0xc7, 0x0b, 0x2c,
+	0x13, 0x75, 0xa6, 0x02, 0x89, 0x05, 0x10, 0x81, 0x76, 0xce, 0xf8, 0x80, 0xfc, 0x99, 0xed, 0x52,
+	0x88, 0x7a, 0x31, 0x1a, 0x50, 0x04, 0x41, 0x2b, 0x0f, 0xde, 0x7a, 0xe5, 0x87, 0x22, 0x8f, 0x09,
+	0x2a, 0x23, 0x80, 0x3e, 0xdf, 0xcb, 0x00, 0x56, 0x30, 0x31, 0xe6, 0xbc, 0xf2, 0x32, 0x55, 0x5e,
+	0x11, 0x90, 0x9d, 0x33, 0xb5, 0x07, 0x8d, 0xd1, 0xb8, 0xed, 0x39, 0x23, 0x0f, 0xf5, 0x90, 0xb6,
+	0xe7, 0x08, 0x55, 0xf4, 0xd6, 0xb2, 0x50, 0x71, 0x37, 0x4d, 0xc6, 0xcd, 0xe6, 0x4b, 0x65, 0xaf,
+	0xb7, 0xe0, 0xe2, 0x1a, 0xb2, 0xef, 0x15, 0x10, 0xf0, 0x17, 0x39, 0x80, 0x44, 0x32, 0x4c, 0xd9,
+	0xd2, 0x33, 0x69, 0x5b, 0xfa, 0x36, 0x5c, 0x11, 0x97, 0x1a, 0x44, 0x20, 0xfa, 0xa9, 0x6e, 0xbb,
+	0xfa, 0xd8, 0x88, 0xdc, 0x16, 0xaa, 0xc0, 0x72, 0xf7, 0x7c, 0xd7, 0xdd, 0x31, 0x42, 0xf5, 0x01,
+	0x6c, 0xc8, 0x65, 0xf0, 0x8e, 0x48, 0xee, 0x9c, 0x3b, 0x22, 0xf5, 0xa4, 0xf8, 0xe8, 0x6c, 0xae,
+	0xbe, 0x03, 0x97, 0x7d, 0x6b, 0xea, 0x5b, 0xc1, 0x91, 0x1e, 0x06, 0x72, 0x63, 0x3c, 0x16, 0x60,
+	0x53, 0x20, 0x47, 0x41, 0xdc, 0xd6, 0x3b, 0x70, 0x59, 0xc8, 0x8c, 0x4b, 0xdd, 0xe3, 0x17, 0x32,
+	0x37, 0x39, 0x52, 0xee, 0xdd, 0xcb, 0x00, 0x42, 0x5c, 0x8e, 0xae, 0xe1, 0x97, 0x59, 0x85, 0x8b,
+	0xc6, 0xa8, 0xdf, 0xbc, 0x05, 0xaa, 0x1d, 0xe8, 0x4b, 0x76, 0x58, 0xe1, 0x9c, 0x50, 0xec, 0x60,
+	0x3f, 0x65, 0x83, 0x3d, 0xcf, 0xc4, 0x5b, 0x3e, 0xcf, 0xc4, 0x7b, 0x09, 0x0a, 0x24, 0x51, 0x0b,
+	0x8b, 0x2b, 0xcf, 0xa8, 0x1a, 0xe4, 0x91, 0x61, 0x90, 0x61, 0xb0, 0xb1, 0xdd, 0xb8, 0x8b, 0x40,
+	0x92, 0xdc, 0x11, 0xca, 0x08, 0x87, 0x52, 0x29, 0xd9, 0x2a, 0xe7, 0x9e, 0x63, 0x4f, 0x78, 0x6c,
+	0x55, 0x63, 0x5b, 0xe1, 0xa4, 0xcf, 0x0c, 0x3b, 0xdc, 0x27, 0x38, 0x83, 0x93, 0x38, 0xad, 0xfd,
+	0xe3, 0x0c, 0x34, 0xd2, 0x82, 0x23, 0x0f, 0xa8, 0x4b, 0x22, 0x05, 0x0b, 0x49, 0x74, 0xe0, 0x4b,
+	0x50, 0x99, 0x1f, 0x8b, 0xb0, 0xc0, 0xc8, 0xb1, 0x3c, 0x3f, 0xe6, 0xe1, 0x80, 0xea, 0x9b, 0x50,
+	0x9a, 0x1f, 0xf3, 0xa5, 0x7f, 0xde, 0x97, 0x2c, 0xce, 0x79, 0xec, 0xcd, 0x9b, 0x50, 0x5a, 0x08,
+	0xd2, 0xfc, 0x79, 0xa4, 0x0b, 0x22, 0xd5, 0xb6, 0xa0, 0x26, 0xab, 0x6a, 0xb8, 0x82, 0x51, 0xc0,
+	0xe3, 0x1d, 0xc3, 0xa4, 0xf6, 0x47, 0x59, 0xa8, 0xc5, 0x23, 0xf8, 0x8e, 0x9e, 0x81, 0x94, 0x99,
+	0x22, 0xfb, 0x02, 0x33, 0xc5, 0x16, 0x45, 0x10, 0xe8, 0x14, 0x0a, 0x84, 0xd1, 0xc6, 0xdc, 0x2d,
+	0x00, 0x47, 0x46, 0xd0, 0x5a, 0x84, 0x1e, 0xde, 0x3b, 0xe1, 0x3e, 0x2a, 0x11, 0x7c, 0x9e, 0x8f,
+	0xcc, 0x8c, 0xe2, 0x2e, 0xc9, 0x3b, 0x22, 0x5c, 0x99, 0xae, 0x47, 0x90, 0x5f, 0xac, 0xb0, 0x22,
+	0x56, 0xd7, 0xa2, 0xdb, 0x11, 0x98, 0x53, 0xb7, 0x61, 0x23, 0x89, 0xf6, 0x8a, 0x5c, 0x69, 0xcb,
+	0x45, 0xea, 0x71, 0xa8, 0x17, 0x95, 0x49, 0x62, 0xd5, 0x4a, 0xdf, 0x16, 0xab, 0xa6, 0xfd, 0x83,
+	0x0c, 0x6c, 0xae, 0x68, 0x48, 0x38, 0xab, 0xc9, 0x53, 0x11, 0x98, 0x44, 0x93, 0xc5, 0xcc, 0x08,
+	0x27, 0x47, 0xfa, 0xdc, 0xb7, 0xa6, 0xf6, 0x69, 0xf4, 0xde, 0x05, 0xc1, 0xf6, 0x09, 0x44, 0xee,
+	0xc3, 0xf9, 0x9c, 0xf4, 0x42, 0xb4, 0x1b, 0xf1, 0x7b, 0xdd, 0x40, 0xa0, 0x1e, 0x42, 0xe2, 0xd0,
+	0x82, 0xfc, 0x39, 0x91, 0x10, 0x37, 0xa0, 0xd8, 0x8d, 0x35, 0xb1, 0xf8, 0xea, 0x77, 0x4e, 0x5c,
+	0xf7, 0xf6, 0xa0, 0xd2, 0xa6, 0xab, 0xe3, 0x7b, 0xc6, 0x5c, 0xbd, 0x83, 0xd7, 0x01, 0xe7, 0x22,
+	0xe8, 0xa1, 0x19, 0xdb, 0x43, 0x39, 0xf6, 0xee, 0x9e, 0x31, 0xe7, 0x6c, 0x10, 0x89, 0xae, 0x7f,
+	0x00, 0xe5, 0x08, 0xf0, 0xbd, 0x18, 0xde, 0x7f, 0xcf, 0x41, 0x65, 0x57, 0xb6, 0xd9, 0xa0, 0x78,
+	0x1c, 0xfa, 0x0b, 0x17, 0x55, 0x6b, 0x61, 0x3d, 0xae, 0xa2, 0x8d, 0x5c, 0x80, 0xa2, 0x85, 0x96,
+	0xfd, 0x96, 0x85, 0x76, 0x03, 0xd0, 0xb8, 0xa4, 0xdb, 0x26, 0xa9, 0x25, 0xb9, 0x38, 0x16, 0xa3,
+	0x6b, 0xa2, 0x56, 0xb2, 0xd6, 0x41, 0x95, 0xff, 0xee, 0x0e, 0xaa, 0xc2, 0x5a, 0x07, 0xd5, 0xff,
+	0x33, 0x2e, 0xa5, 0xd7, 0x13, 0x1e, 0x8f, 0x6b, 0x1f, 0xc9, 0x2a, 0x44, 0x16, 0x71, 0xf4, 0x27,
+	0xd6, 0x19, 0xd2, 0x7d, 0x0c, 0x8d, 0x68, 0x9a, 0xc5, 0xc0, 0x20, 0x15, 0x5b, 0x2a, 0x70, 0xd4,
+	0x3c, 0xab, 0x87, 0x72, 0x36, 0xbd, 0x93, 0xab, 0xdf, 0xbe, 0x93, 0xb5, 0x3f, 0xce, 0x41, 0xe1,
+	0xe7, 0x78, 0xb1, 0x55, 0xfd, 0x00, 0x2a, 0x41, 0x38, 0x0b, 0x65, 0x4b, 0xf9, 0x35, 0x5e, 0x8c,
+	0xf0, 0x64, 0xe8, 0xb6, 0x30, 0x88, 0x98, 0x2b, 0xb1, 0x48, 0x8b, 0x29, 0x5c, 0x3d, 0x68, 0x6f,
+	0xe2, 0x96, 0xf9, 0x02, 0xe3, 0x19, 0xb4, 0x9d, 0xa2, 0xd9, 0x3c, 0x48, 0xfb, 0xdd, 0x51, 0x07,
+	0x62, 0x1c, 0x81, 0xb6, 0x53, 0x71, 0xf3, 0x26, 0xbf, 0x6a, 0xad, 0xe6, 0x18, 0x0a, 0x89, 0xb3,
+	0x0c, 0xd4, 0xae, 0xa3, 0x0b, 0x56, 0x71, 0x1e, 0x79, 0xb2, 0xe3, 0x19, 0xe6, 0xc8, 0x38, 0x8c,
+	0x6e, 0x48, 0x8a, 0x2c, 0x46, 0x48, 0x61, 0xf2, 0x19, 0x3a, 0xe5, 0x86, 0xf7, 0xc5, 0x21, 0x24,
+	0x83, 0x50, 0x6a, 0x31, 0xad, 0xd0, 0x9a, 0x84, 0xc3, 0xaf, 0x1c, 0x7e, 0xec, 0x54, 0x98, 0x04,
+	0x51, 0xb7, 0xc9, 0x4a, 0x3a, 0x8a, 0x22, 0x58, 0x2b, 0x4b, 0xc1, 0x48, 0x02, 0xc1, 0x64, 0x22,
+	0xcd, 0x84, 0x7a, 0x6a, 0x8a, 0xd2, 0x7a, 0x1e, 0x0a, 0xc2, 0x9d, 0x1e, 0xea, 0x0b, 0x19, 0x49,
+	0xe1, 0xc8, 0xca, 0x4a, 0x46, 0x4e, 0xd2, 0x3e, 0x48, 0x4e, 0x3d, 0xd8, 0xdf, 0x6d, 0x8d, 0x3a,
+	0x4a, 0x81, 0xb4, 0x89, 0x0e, 0x7b, 0xd4, 0x51, 0x8a, 0xda, 0x3f, 0xca, 0x52, 0xe8, 0x93, 0x68,
+	0x55, 0x0e, 0xa3, 0xcc, 0xa4, 0xae, 0x33, 0xa6, 0xc3, 0x00, 0xb2, 0xcb, 0x61, 0x00, 0xaf, 0x42,
+	0x4d, 0x44, 0xdf, 0xca, 0x57, 0xef, 0xab, 0x02, 0xd6, 0x17, 0x51, 0x37, 0x2b, 0x77, 0x5b, 0x24,
+	0xdf, 0x48, 0x21, 0xe5, 0x1b, 0xc1, 0x77, 0x0a, 0x1c, 0x93, 0x07, 0x4d, 0x70, 0xaf, 0x76, 0xc9,
+	0x73, 0x4c, 0x8a, 0x98, 0xc0, 0xab, 0xac, 0xd6, 0x09, 0x47, 0xf1, 0xed, 0x53, 0x72, 0xad, 0x13,
+	0x42, 0xad, 0xb9, 0x2a, 0x53, 0xfe, 0x8e, 0x57, 0x65, 0x2a, 0xeb, 0xae, 0xca, 0xfc, 0x7e, 0x16,
+	0x36, 0x47, 0xbe, 0xe1, 0x06, 0x06, 0x8f, 0xc9, 0x77, 0x43, 0xdf, 0x73, 0xd4, 0x8f, 0xa1, 0x1c,
+	0x4e, 0x1c, 0x79, 0x5d, 0xbf, 0x12, 0xed, 0xa2, 0x25, 0xd2, 0xbb, 0xa3, 0x09, 0x37, 0xd1, 0x94,
+	0x42, 0x9e, 0x50, 0xdf, 0x86, 0xc2, 0xd8, 0x3a, 0xb4, 0x5d, 0xc1, 0xd1, 0x2e, 0x2f, 0x17, 0xdc,
+	0x41, 0x24, 0xbe, 0xc6, 0x43, 0x54, 0xea, 0x3b, 0x78, 0x87, 0x78, 0x16, 0xb1, 0xfe, 0x24, 0x20,
+	0x58, 0x6a, 0x08, 0xb1, 0xf8, 0xe2, 0x0e, 0xa7, 0x53, 0x3f, 0xc0, 0xc7, 0x30, 0x1c, 0x67, 0x6c,
+	0x4c, 0x8e, 0xc5, 0xa1, 0xd0, 0x5c, 0x2e, 0xc3, 0x04, 0xfe, 0xf1, 0x05, 0x16, 0xd3, 0x6a, 0x77,
+	0xa1, 0x24, 0x3a, 0x8b, 0xab, 0x63, 0xa7, 0xf3, 0xa8, 0x2b, 0x56, 0x59, 0x7b, 0xb0, 0xb7, 0xd7,
+	0x1d, 0xf1, 0xab, 0x59, 0x6c, 0xd0, 0xeb, 0xed, 0xb4, 0xda, 0x4f, 0x94, 0xec, 0x4e, 0x19, 0x8a,
+	0x06, 0x05, 0xb1, 0x6a, 0x7f, 0x3f, 0x03, 0x1b, 0x4b, 0x03, 0x50, 0x1f, 0x40, 0x7e, 0xe6, 0x99,
+	0xd1, 0xf4, 0xdc, 0x5a, 0x3b, 0x4a, 0x29, 0xcf, 0xe5, 0x2b, 0x2c, 0xa1, 0x7d, 0x04, 0x8d, 0x34,
+	0x5c, 0xd2, 0xc9, 0xea, 0x50, 0x61, 0x9d, 0xd6, 0xae, 0x3e, 0xe8, 0xf7, 0x3e, 0xe7, 0xa6, 0x0d,
+	0xca, 0x3e, 0x63, 0xdd, 0x51, 0x47, 0xc9, 0x6a, 0xbf, 0x01, 0xca, 0xf2, 0xc4, 0xa8, 0x8f, 0x60,
+	0x03, 0xcf, 0x7d, 0xc7, 0xe2, 0x9c, 0x37, 0xf9, 0x64, 0x37, 0xd7, 0xcc, 0xa4, 0x20, 0xa3, 0x2f,
+	0xd6, 0x98, 0xa4, 0xf2, 0xda, 0xdf, 0x02, 0x75, 0x75, 0x06, 0x7f, 0x75, 0xd5, 0xff, 0xaf, 0x0c,
+	0xe4, 0xf7, 0x1d, 0x03, 0x2f, 0xbf, 0x14, 0xe8, 0x5d, 0x80, 0x66, 0x46, 0x76, 0x0a, 0x12, 0xc7,
+	0xc4, 0x65, 0x41, 0x38, 0xf5, 0xc7, 0x90, 0x0b, 0x27, 0xd1, 0x9d, 0xac, 0xab, 0xe7, 0x2c, 0x3e,
+	0xbc, 0x9c, 0x1f, 0x4e, 0x1c, 0x7c, 0x93, 0xc5, 0x34, 0xa3, 0x88, 0x2b, 0xa1, 0x5f, 0xa2, 0xc6,
+	0xb2, 0x8b, 0x4b, 0xdd, 0x16, 0xef, 0x18, 0x20, 0x09, 0xbe, 0x53, 0x60, 0x4e, 0x9c, 0x74, 0xf8,
+	0x1c, 0xd7, 0x6d, 0xe2, 0x0a, 0xcd, 0x09, 0x3e, 0xa2, 0x54, 0x0f, 0xfd, 0x33, 0xdd, 0x5f, 0xb8,
+	0xe4, 0xb1, 0x0f, 0xc4, 0xd6, 0xad, 0xa2, 0x74, 0xb0, 0x20, 0xf7, 0x76, 0x20, 0xa2, 0xb5, 0xe7,
+	0xbe, 0x35, 0x37, 0xfc, 0x58, 0xba, 0x47, 0xb7, 0x30, 0x01, 0xf0, 0x96, 0x3f, 0xd6, 0xae, 0xbd,
+	0x45, 0x77, 0xe4, 0x51, 0xb4, 0xd5, 0xa2, 0xd4, 0x9a, 0xab, 0x33, 0x02, 0xa3, 0xfd, 0x79, 0x0e,
+	0xaa, 0x52, 0x7f, 0xd4, 0xf7, 0xa0, 0x6c, 0x4e, 0x9c, 0x35, 0x07, 0x8c, 0x44, 0x74, 0x77, 0x37,
+	0xda, 0x82, 0x26, 0x4f, 0x50, 0x98, 0xaf, 0x15, 0xea, 0xcf, 0x0d, 0xdf, 0xe6, 0x0f, 0x7a, 0x64,
+	0x65, 0xd7, 0xc3, 0xd0, 0x0a, 0x9f, 0x46, 0x18, 0x7c, 0x83, 0x29, 0x90, 0xf2, 0x24, 0x7f, 0x8b,
+	0x21, 0xe5, 0x52, 0x8f, 0x9e, 0x70, 0x20, 0x3e, 0x9a, 0x24, 0xf0, 0x48, 0x6a, 0x9d, 0x5a, 0x93,
+	0x45, 0x18, 0xc9, 0xdf, 0xf5, 0x68, 0x40, 0x04, 0x44, 0x52, 0x81, 0x57, 0xb7, 0xf1, 0xf0, 0x30,
+	0x1c, 0xc7, 0x23, 0x21, 0xa8, 0x20, 0xdb, 0xb9, 0x77, 0x63, 0x38, 0x7f, 0xcf, 0x29, 0xca, 0x61,
+	0x44, 0xa0, 0x17, 0x1e, 0x59, 0x91, 0xd4, 0x1a, 0xdd, 0xb6, 0x47, 0xd0, 0x6e, 0xbb, 0x87, 0x2b,
+	0x85, 0xd0, 0xda, 0xef, 0xe1, 0x25, 0x73, 0x31, 0xf0, 0x4d, 0xa8, 0xe3, 0x6d, 0xca, 0xa7, 0x2d,
+	0xd6, 0x45, 0x8b, 0xa0, 0x88, 0xfa, 0x7b, 0xc4, 0x5a, 0x7d, 0x71, 0x88, 0xb0, 0xce, 0xd3, 0xc1,
+	0x93, 0x0e, 0x37, 0x74, 0xec, 0x76, 0xfa, 0x9f, 0x2b, 0x39, 0x6e, 0xe4, 0xeb, 0xec, 0xb7, 0x18,
+	0x1e, 0x21, 0x55, 0x28, 0x75, 0x3e, 0xeb, 0xb4, 0x0f, 0xe8, 0x0c, 0x69, 0x00, 0xec, 0x76, 0x5a,
+	0xbd, 0xde, 0x00, 0xad, 0x4e, 0x4a, 0x11, 0x0d, 0x76, 0x6d, 0xd6, 0x41, 0x0b, 0x54, 0xab, 0xdd,
+	0x1e, 0x1c, 0xf4, 0x47, 0x4a, 0x09, 0x5b, 0x6c, 0xa1, 0x39, 0x28, 0x06, 0xd1, 0x93, 0x24, 0xbb,
+	0x6c, 0xb0, 0x1f, 0x43, 0x2a, 0x3b, 0x15, 0xd4, 0x85, 0xe8, 0x5b, 0x69, 0xff, 0xb3, 0x01, 0x8d,
+	0xf4, 0xd2, 0x54, 0x3f, 0x84, 0xb2, 0x69, 0xa6, 0xbe, 0xf1, 0x8d, 0x75, 0x4b, 0xf8, 0xee, 0xae,
+	0x19, 0x7d, 0x66, 0x9e, 0x40, 0xef, 0x3a, 0xdf, 0x48, 0xd9, 0x95, 0x8d, 0x14, 0x6d, 0xa3, 0x4f,
+	0x60, 0x43, 0x5c, 0x17, 0x47, 0xc3, 0xc6, 0xd8, 0x08, 0xac, 0xf4, 0x2e, 0x69, 0x13, 0x72, 0x57,
+	0xe0, 0x1e, 0x5f, 0x60, 0x8d, 0x49, 0x0a, 0xa2, 0xfe, 0x04, 0x1a, 0x06, 0x29, 0xbd, 0x71, 0xf9,
+	0xbc, 0x2c, 0x55, 0xb5, 0x10, 0x27, 0x15, 0xaf, 0x1b, 0x32, 0x00, 0x17, 0xa2, 0xe9, 0x7b, 0xf3,
+	0xa4, 0x70, 0x41, 0x5e, 0x88, 0xbb, 0xbe, 0x37, 0x97, 0xca, 0xd6, 0x4c, 0x29, 0x8f, 0x11, 0xd7,
+	0xa2, 0xe7, 0x89, 0xfa, 0x1c, 0x6f, 0x59, 0xde, 0x6d, 0x92, 0xcd, 0xf0, 0x6d, 0xb3, 0x49, 0x92,
+	0xc5, 0xb0, 0x7d, 0xde, 0xe1, 0x44, 0x9d, 0x8e, 0xd7, 0x1a, 0xf5, 0x36, 0x2a, 0x05, 0x46, 0x9c,
+	0x53, 0xdf, 0x01, 0xa0, 0x7e, 0xf2, 0x32, 0xe5, 0x94, 0x2b, 0xd6, 0xf7, 0xe6, 0x51, 0x91, 0x8a,
+	0x19, 0x65, 0xa4, 0xee, 0xf1, 0x7b, 0x29, 0x95, 0xd5, 0xee, 0xd1, 0x15, 0x8a, 0xa4, 0x7b, 0x94,
+	0x4d, 0xba, 0xc7, 0x8b, 0xc1, 0x4a, 0xf7, 0xa2, 0x52, 0x60, 0xc4, 0xb9, 0xb8, 0x7b, 0xbc, 0x4c,
+	0x75, 0xb9, 0x7b, 0x51, 0x91, 0x8a, 0x19, 0x65, 0xf0, 0xb3, 0x2d, 0x09, 0xc3, 0xb5, 0x73, 0x85,
+	0x61, 0xfc, 0x6c, 0x69, 0x71, 0xf8, 0x27, 0xd0, 0x08, 0x8e, 0xbc, 0x13, 0x89, 0x81, 0xd4, 0xe5,
+	0xd2, 0xc3, 0x23, 0xef, 0x44, 0xe6, 0x20, 0xf5, 0x40, 0x06, 0x60, 0x6f, 0xf9, 0x10, 0xe9, 0x9e,
+	0x5a, 0x43, 0xee, 0x2d, 0x8d, 0x10, 0x6f, 0x04, 0x61, 0x6f, 0x8d, 0x28, 0x83, 0x93, 0x92, 0x18,
+	0x4a, 0x82, 0xe6, 0x86, 0x3c, 0x29, 0xbd, 0xc8, 0x5e, 0x82, 0x2d, 0x41, 0x6c, 0x3d, 0x09, 0x70,
+	0x6d, 0x2d, 0x5c, 0xb9, 0x98, 0x22, 0xaf, 0xad, 0x03, 0x37, 0x55, 0xb0, 0xc6, 0x49, 0x45, 0xd1,
+	0x64, 0x57, 0x04, 0xd6, 0x57, 0x0b, 0xcb, 0x9d, 0x58, 0xcd, 0xcd, 0xd5, 0x5d, 0x31, 0x14, 0xb8,
+	0x64, 0x57, 0x44, 0x90, 0x78, 0x5d, 0xc7, 0xc5, 0xd5, 0xe5, 0x75, 0x2d, 0x15, 0xae, 0x99, 0x52,
+	0x3e, 0xd9, 0x50, 0x71, 0xd9, 0x8b, 0x2b, 0x1b, 0x4a, 0x2a, 0x5c, 0x37, 0x64, 0x00, 0xce, 0x94,
+	0xe8, 0x39, 0x4d, 0x6e, 0x2a, 0x16, 0x81, 0xf7, 0x5a, 0xcc, 0x2e, 0x4c, 0xe2, 0x1c, 0xae, 0x55,
+	0xdf, 0x42, 0x99, 0x55, 0x2c, 0x85, 0xcb, 0xf2, 0x5a, 0x65, 0x84, 0x89, 0xb7, 0x92, 0x9f, 0x64,
+	0xb5, 0x3f, 0x2e, 0x40, 0x49, 0x30, 0x1d, 0x7c, 0x55, 0x49, 0xf0, 0xbe, 0xdd, 0xd6, 0xa8, 0xb5,
+	0xd3, 0x1a, 0xa2, 0xb4, 0xa2, 0x42, 0x83, 0x33, 0xbf, 0x18, 0x96, 0x41, 0x86, 0x48, 0xdc, 0x2f,
+	0x06, 0x65, 0x91, 0x21, 0x8a, 0xb2, 0xfc, 0x3d, 0xa7, 0x1c, 0x5a, 0xdd, 0x79, 0x41, 0x0e, 0xa0,
+	0x68, 0x7c, 0x2a, 0xc5, 0xf3, 0x05, 0xa9, 0x08, 0xb7, 0x7a, 0x17, 0x93, 0x22, 0x1c, 0x50, 0x8a,
+	0x8b, 0x44, 0x66, 0x71, 0x15, 0x1a, 0x23, 0x76, 0xd0, 0x6f, 0x27, 0xed, 0x54, 0xb0, 0x90, 0xa8,
+	0xe6, 0x69, 0xb7, 0xf3, 0x4c, 0x01, 0x2c, 0xc4, 0x6b, 0xa1, 0x7c, 0x15, 0xe5, 0x2d, 0xaa, 0x84,
+	0xb2, 0x35, 0xf5, 0x2a, 0x5c, 0x1c, 0x3e, 0x1e, 0x3c, 0xd3, 0x79, 0xa1, 0x78, 0x08, 0x75, 0x74,
+	0x41, 0x48, 0x08, 0x5e, 0x7d, 0x03, 0x9b, 0x24, 0x68, 0x44, 0x38, 0x54, 0x36, 0xc8, 0x89, 0x84,
+	0xb0, 0x11, 0x3f, 0x80, 0x14, 0x1c, 0x0a, 0x2f, 0x3a, 0xe8, 0x1d, 0xec, 0xf5, 0x87, 0xca, 0x26,
+	0x76, 0x82, 0x20, 0xbc, 0xe7, 0x6a, 0x5c, 0x4d, 0x72, 0x6c, 0x5d, 0xa4, 0x93, 0x0c, 0x61, 0xcf,
+	0x5a, 0xac, 0xdf, 0xed, 0x3f, 0x1a, 0x2a, 0x97, 0xe2, 0x9a, 0x3b, 0x8c, 0x0d, 0xd8, 0x50, 0xb9,
+	0x1c, 0x03, 0x86, 0xa3, 0xd6, 0xe8, 0x60, 0xa8, 0x5c, 0x89, 0x7b, 0xb9, 0xcf, 0x06, 0xed, 0xce,
+	0x70, 0xd8, 0xeb, 0x0e, 0x47, 0xca, 0x55, 0x74, 0x5c, 0x25, 0x3d, 0x8a, 0x88, 0x9b, 0x52, 0x47,
+	0xd9, 0xa3, 0xce, 0x48, 0xb9, 0x16, 0x77, 0xa3, 0x3d, 0xe8, 0xe1, 0x53, 0x5b, 0x83, 0xbe, 0x72,
+	0x1d, 0x89, 0xc8, 0x87, 0x23, 0x46, 0xf3, 0x12, 0xf6, 0xeb, 0xa0, 0x2f, 0x83, 0x6e, 0x48, 0x4b,
+	0x63, 0xd8, 0xf9, 0xf9, 0x41, 0xa7, 0xdf, 0xee, 0x28, 0x2f, 0x27, 0x4b, 0x23, 0x86, 0xdd, 0x8c,
+	0x97, 0x46, 0x0c, 0x7a, 0x25, 0x6e, 0x33, 0x02, 0x0d, 0x95, 0x2d, 0xac, 0x4f, 0xf4, 0xa3, 0xdf,
+	0xef, 0xb4, 0x47, 0x38, 0xd6, 0x57, 0xe3, 0x59, 0x3c, 0xd8, 0x7f, 0xc4, 0xf0, 0x1d, 0x05, 0x0d,
+	0x21, 0xac, 0xd3, 0x6f, 0xed, 0x45, 0x5f, 0xfb, 0xb5, 0x9d, 0x1a, 0xbd, 0x11, 0x29, 0x8e, 0x4b,
+	0xed, 0x53, 0x50, 0xe5, 0xc7, 0xd6, 0xc4, 0xfb, 0x29, 0x2a, 0xe4, 0x31, 0x30, 0x35, 0xba, 0x8f,
+	0x86, 0x69, 0x54, 0x7e, 0xe7, 0x8b, 0x31, 0x05, 0x3f, 0x24, 0xd7, 0x53, 0x64, 0x90, 0xf6, 0xc7,
+	0x19, 0x68, 0xa4, 0x8f, 0x4a, 0x14, 0x11, 0xed, 0xa9, 0x8e, 0x51, 0x2c, 0xf4, 0xc6, 0x47, 0x10,
+	0x99, 0x76, 0xec, 0x69, 0xdf, 0x0b, 0xe9, 0x91, 0x0f, 0xd2, 0xc5, 0xe3, 0x93, 0x8f, 0xd7, 0x1a,
+	0xe7, 0xd5, 0x2e, 0x5c, 0x4c, 0xbd, 0x45, 0x97, 0x7a, 0x61, 0xa5, 0x19, 0xbf, 0xa0, 0xb5, 0xd4,
+	0x7f, 0xa6, 0x06, 0xab, 0x63, 0x52, 0x20, 0x87, 0xd7, 0x2e, 0xb9, 0xd6, 0x89, 0x49, 0xed, 0x31,
+	0xd4, 0x53, 0x27, 0x33, 0xd9, 0x16, 0xa7, 0xe9, 0x9e, 0x96, 0xed, 0xe9, 0x8b, 0xbb, 0xa9, 0xfd,
+	0x61, 0x06, 0x6a, 0xf2, 0x39, 0xfd, 0x83, 0x6b, 0xa2, 0x20, 0x66, 0x91, 0x46, 0x6d, 0x54, 0xbc,
+	0xed, 0x11, 0x81, 0xba, 0xf4, 0x36, 0x2e, 0x37, 0x7e, 0x3e, 0x3c, 0x1e, 0xc6, 0xc3, 0x91, 0x41,
+	0x68, 0x83, 0x20, 0x03, 0xe4, 0xc3, 0x27, 0x48, 0x20, 0xc2, 0xa0, 0x13, 0x88, 0xf6, 0x0a, 0x54,
+	0x1e, 0x1e, 0x47, 0xcf, 0xcc, 0xc8, 0x2f, 0xdd, 0x54, 0xf8, 0x9d, 0x26, 0x7c, 0x97, 0xb7, 0x91,
+	0x5c, 0xe5, 0xa5, 0xe0, 0x27, 0xfe, 0x86, 0x21, 0x5f, 0x0e, 0xf8, 0x86, 0x61, 0xfc, 0x6c, 0x6e,
+	0x56, 0x7e, 0x36, 0xf7, 0x35, 0x51, 0x59, 0x4e, 0x3e, 0xcd, 0xe2, 0xb6, 0x78, 0xed, 0x18, 0x1e,
+	0x83, 0xff, 0x99, 0x35, 0xb5, 0x7c, 0x3f, 0x7e, 0x7d, 0x68, 0x85, 0x38, 0x45, 0x44, 0x1a, 0x89,
+	0x35, 0x6d, 0x16, 0xe4, 0x43, 0x20, 0x7d, 0xdb, 0x18, 0xf1, 0xda, 0xbf, 0xcf, 0x43, 0x55, 0x92,
+	0x7a, 0xbe, 0xd3, 0xf2, 0xbb, 0x81, 0x8f, 0x11, 0x46, 0x37, 0x53, 0xc5, 0x35, 0x95, 0x18, 0x90,
+	0xfa, 0x56, 0xb9, 0xa5, 0x6f, 0x85, 0xf7, 0xec, 0x78, 0x94, 0x94, 0x30, 0x24, 0x46, 0xd9, 0xb4,
+	0xa5, 0xac, 0xf0, 0x02, 0x9b, 0xf7, 0xbb, 0x50, 0xe3, 0x8f, 0xc6, 0xc4, 0xef, 0x0a, 0xe6, 0xd6,
+	0xd0, 0x57, 0x93, 0xc7, 0x73, 0x02, 0xbc, 0xbd, 0x3e, 0x3d, 0xd6, 0xcd, 0x71, 0x64, 0xf8, 0x28,
+	0x4c, 0x8f, 0x77, 0xc7, 0xe4, 0x33, 0x98, 0xc6, 0x07, 0x3d, 0x37, 0x3e, 0x95, 0xa7, 0xd1, 0x71,
+	0x7e, 0x1b, 0x4a, 0xd3, 0x63, 0x6e, 0x2d, 0xa9, 0x6c, 0xe5, 0xd6, 0x4d, 0x79, 0x71, 0x7a, 0x4c,
+	0xd6, 0x93, 0x8f, 0x40, 0x59, 0x32, 0x52, 0x06, 0x4d, 0x58, 0xdb, 0xa9, 0x8d, 0xb4, 0xbd, 0x32,
+	0x50, 0xef, 0xc1, 0x25, 0x71, 0xf2, 0x1a, 0x81, 0xce, 0x23, 0x78, 0xe9, 0xb2, 0x33, 0x7f, 0x32,
+	0x67, 0x93, 0xe3, 0x5a, 0xc1, 0x90, 0x30, 0xb8, 0x58, 0x35, 0xa8, 0x49, 0x6b, 0x97, 0xdf, 0x3b,
+	0xaf, 0xb0, 0x14, 0x4c, 0x7d, 0x00, 0xb5, 0xe9, 0x31, 0x5f, 0x0b, 0x23, 0x6f, 0xcf, 0x12, 0xb1,
+	0x98, 0x97, 0x96, 0x57, 0x01, 0x85, 0xec, 0xa5, 0x28, 0xd5, 0xb7, 0x41, 0xf5, 0xad, 0xd0, 0x72,
+	0x69, 0x24, 0xa6, 0x65, 0x98, 0xe8, 0x90, 0x14, 0x51, 0xd6, 0x9b, 0x31, 0x66, 0x57, 0x20, 0xb4,
+	0x3f, 0xcb, 0x40, 0x23, 0x91, 0x7e, 0x71, 0x43, 0xa3, 0x31, 0x3c, 0x79, 0xc8, 0xb4, 0xb9, 0x2c,
+	0x20, 0x23, 0x09, 0x7a, 0x52, 0xf8, 0xdb, 0x6a, 0xeb, 0x1e, 0x0f, 0x58, 0xf7, 0x1a, 0x52, 0x6e,
+	0xdd, 0x6b, 0x48, 0x1a, 0x83, 0x1c, 0xba, 0xdc, 0xc8, 0xd2, 0x82, 0x67, 0x20, 0xd7, 0xca, 0xf8,
+	0xe9, 0x47, 0x5e, 0x6a, 0x74, 0xe8, 0xd3, 0x15, 0xbd, 0x7d, 0xd6, 0xdd, 0x6b, 0xb1, 0xcf, 0xc9,
+	0xc3, 0x4f, 0x52, 0xc2, 0xc3, 0x01, 0xeb, 0x74, 0x1f, 0xf5, 0x09, 0x90, 0xc7, 0x52, 0xed, 0xc7,
+	0x9d, 0xf6, 0x13, 0xa5, 0x40, 0x26, 0x99, 0xa4, 0xb7, 0x2d, 0xd3, 0x7c, 0x78, 0xfc, 0x83, 0x2d,
+	0x7b, 0x6a, 0xbc, 0xb9, 0x63, 0x4e, 0x81, 0x2f, 0x0b, 0xe0, 0x25, 0xff, 0xb4, 0xb6, 0x93, 0xde,
+	0x97, 0x44, 0xa0, 0xfd, 0x22, 0x03, 0x6a, 0xaa, 0x23, 0x5c, 0x00, 0xff, 0xa1, 0x7d, 0xf9, 0x10,
+	0x9a, 0xe2, 0xb1, 0x1e, 0x4e, 0x25, 0x99, 0xbe, 0xc5, 0xec, 0x5e, 0xf6, 0x92, 0xb0, 0xa1, 0xe4,
+	0xa9, 0x03, 0xf5, 0x1e, 0xf0, 0x07, 0x9e, 0x70, 0xad, 0xa4, 0xed, 0x1b, 0x12, 0xdb, 0x60, 0x09,
+	0x4d, 0xf2, 0xa2, 0x93, 0xfc, 0x52, 0x15, 0xf7, 0x05, 0x6c, 0x24, 0x1f, 0x90, 0x58, 0x89, 0xf6,
+	0xbb, 0x19, 0xb8, 0x98, 0x5e, 0x1b, 0xbf, 0xdc, 0x28, 0xd3, 0xcf, 0x72, 0xe5, 0x96, 0x9f, 0xe5,
+	0x5a, 0xb7, 0xb4, 0xf2, 0x6b, 0x97, 0xd6, 0x6f, 0x67, 0xe0, 0x92, 0x34, 0xfb, 0x89, 0xca, 0xf4,
+	0x37, 0xd4, 0x33, 0xe9, 0x75, 0xae, 0x7c, 0xea, 0x75, 0x2e, 0xed, 0xf7, 0x33, 0x70, 0x65, 0xa9,
+	0x27, 0xcc, 0xfa, 0x1b, 0xed, 0x4b, 0xfa, 0x15, 0x2f, 0x32, 0xff, 0xf3, 0xc0, 0x2d, 0x7e, 0x89,
+	0x45, 0x4d, 0x3f, 0xcb, 0x85, 0x1e, 0x32, 0xed, 0x4f, 0xd2, 0x9d, 0x34, 0x93, 0x2b, 0x08, 0x18,
+	0x31, 0x97, 0x08, 0x4f, 0xd1, 0xc5, 0xe0, 0xb5, 0xf7, 0x17, 0x64, 0xba, 0xb5, 0x1c, 0x35, 0xfb,
+	0xdd, 0x38, 0xea, 0x03, 0xa8, 0xc5, 0x15, 0xef, 0x5a, 0xd3, 0xb4, 0x61, 0x62, 0xe9, 0x15, 0x8b,
+	0x14, 0xa5, 0xf6, 0x2f, 0x33, 0x70, 0x35, 0xbd, 0x1c, 0x93, 0x71, 0xbc, 0x21, 0x07, 0x48, 0x72,
+	0x0f, 0x14, 0x97, 0x00, 0x1a, 0xa9, 0xd7, 0x63, 0xbe, 0xc5, 0x61, 0x95, 0x3d, 0xdf, 0x61, 0xf5,
+	0xc3, 0xbb, 0xfc, 0x35, 0xbc, 0x94, 0xf4, 0x38, 0x52, 0xbf, 0xff, 0xcf, 0xf4, 0x5a, 0xfb, 0x7b,
+	0x59, 0xb9, 0xf1, 0xce, 0xe9, 0xe4, 0x08, 0x2f, 0xe8, 0x27, 0x8d, 0x7f, 0xc7, 0xf7, 0x76, 0xce,
+	0x7b, 0xb6, 0x26, 0x7b, 0xde, 0xb3, 0x35, 0xdf, 0x2a, 0x62, 0xc4, 0x72, 0x56, 0x5e, 0x96, 0xb3,
+	0xde, 0x06, 0xf5, 0xc4, 0x0e, 0x8f, 0xbc, 0x05, 0x5a, 0x2f, 0x1d, 0xdb, 0xe4, 0x12, 0x39, 0xe7,
+	0x4a, 0x9b, 0x02, 0xf3, 0x34, 0x46, 0xe0, 0x43, 0x43, 0x09, 0x99, 0x08, 0xe9, 0x15, 0x11, 0x35,
+	0x4a, 0x82, 0xe0, 0x2e, 0x6a, 0xed, 0x3d, 0xd8, 0x4c, 0x66, 0xa1, 0x2d, 0x5e, 0xf7, 0x79, 0x05,
+	0xaa, 0xdc, 0xd5, 0x42, 0x59, 0x31, 0x70, 0x20, 0x6f, 0x0b, 0x41, 0xb4, 0x87, 0xf2, 0xc1, 0x19,
+	0x3f, 0x30, 0xed, 0x98, 0xf2, 0x44, 0xa1, 0xe3, 0x26, 0x42, 0x61, 0x6d, 0xd2, 0xb4, 0xa0, 0xe3,
+	0x86, 0x38, 0xd5, 0x89, 0xa8, 0xa7, 0x65, 0x9a, 0x22, 0xd4, 0x61, 0xdd, 0xd3, 0x18, 0xd7, 0xa0,
+	0x8c, 0xf1, 0xb9, 0x72, 0x05, 0x73, 0x9f, 0x37, 0x7b, 0x4b, 0x04, 0x2f, 0x9d, 0x17, 0x16, 0x41,
+	0xd8, 0xe8, 0x25, 0x81, 0x7c, 0xf2, 0x00, 0xfd, 0xfb, 0xe2, 0xa0, 0xc4, 0x6d, 0x22, 0x5a, 0x8e,
+	0xc3, 0x1f, 0xd0, 0x21, 0x84, 0x49, 0x84, 0x04, 0xd6, 0x57, 0x22, 0x7e, 0x0a, 0x93, 0xda, 0x0e,
+	0x54, 0x25, 0xd3, 0x00, 0xca, 0xb6, 0x92, 0x59, 0x2d, 0x48, 0x3f, 0x36, 0x90, 0x4c, 0x10, 0xab,
+	0x26, 0x56, 0xb5, 0x40, 0xfb, 0x9d, 0x1a, 0x40, 0x82, 0x4b, 0x2d, 0x87, 0xcc, 0xd2, 0x72, 0xf8,
+	0x5e, 0xb1, 0x14, 0xef, 0x61, 0x30, 0xc4, 0xfc, 0x4c, 0x4f, 0x4a, 0xe4, 0xd6, 0x96, 0xa8, 0x21,
+	0xd5, 0x28, 0xb9, 0x28, 0xb2, 0xea, 0xfb, 0xce, 0xaf, 0xf5, 0x7d, 0xbf, 0x0b, 0x25, 0xee, 0xf9,
+	0x09, 0xc4, 0x95, 0xa3, 0xab, 0xcb, 0xe3, 0xbc, 0x2b, 0x9e, 0xff, 0x8b, 0xe8, 0xd4, 0x0e, 0x34,
+	0xe2, 0x87, 0xc0, 0xe4, 0x0b, 0x48, 0x37, 0x57, 0x4b, 0x46, 0x64, 0xfc, 0x3d, 0x19, 0x43, 0xce,
+	0x4a, 0x52, 0x66, 0x38, 0x13, 0xe6, 0x48, 0x92, 0x32, 0x4b, 0xb2, 0x94, 0x39, 0x9a, 0x71, 0x23,
+	0x24, 0x4a, 0x99, 0x6f, 0xc3, 0x45, 0x11, 0xcc, 0x8d, 0x05, 0x70, 0x3a, 0x89, 0x9e, 0x5f, 0x62,
+	0x16, 0x37, 0xc0, 0x47, 0x33, 0x52, 0xdf, 0x90, 0xfc, 0x33, 0xb8, 0xc4, 0x77, 0x3f, 0xbe, 0x57,
+	0xa4, 0xd3, 0x73, 0xbd, 0x3a, 0x86, 0x44, 0x70, 0xb9, 0xf9, 0x8d, 0x95, 0xce, 0xb6, 0x89, 0x78,
+	0x34, 0x76, 0x28, 0xae, 0x29, 0x8e, 0x90, 0xd8, 0x9c, 0x2c, 0xc3, 0x97, 0xfc, 0xc3, 0xb0, 0xe2,
+	0x1f, 0x5e, 0x16, 0x87, 0xab, 0xab, 0xe2, 0xf0, 0xf5, 0x3f, 0x29, 0x42, 0x91, 0x4f, 0x2c, 0xbd,
+	0x12, 0xe4, 0x7b, 0xf3, 0x38, 0xba, 0x70, 0x8d, 0x78, 0x4a, 0x3f, 0xb6, 0x81, 0x92, 0xec, 0x5d,
+	0x28, 0x62, 0x00, 0xc4, 0xf4, 0x38, 0xed, 0x72, 0x5c, 0x12, 0x0f, 0xd1, 0x63, 0x60, 0x60, 0x42,
+	0xfd, 0x10, 0x2a, 0x48, 0xcf, 0xad, 0xa9, 0x29, 0x85, 0x7b, 0x55, 0x90, 0x43, 0x0f, 0xa2, 0x21,
+	0xd2, 0xea, 0x4f, 0xd3, 0xc6, 0x5b, 0x2e, 0x65, 0x5d, 0x5f, 0x29, 0x7a, 0x9e, 0x19, 0xf7, 0xd7,
+	0x81, 0x5b, 0xf3, 0x62, 0x6e, 0x53, 0x90, 0xbd, 0x5b, 0x2b, 0xbc, 0x09, 0x4d, 0x87, 0x06, 0x8f,
+	0x29, 0xa3, 0x3c, 0x3e, 0xee, 0xc3, 0xcb, 0xc7, 0xcf, 0xe2, 0xaf, 0x99, 0x19, 0xe4, 0x15, 0xb1,
+	0x75, 0x15, 0x33, 0x54, 0xcc, 0x34, 0xa3, 0x80, 0xab, 0xd2, 0x4a, 0xb1, 0x98, 0x23, 0x51, 0xb1,
+	0x28, 0xa3, 0x3e, 0x80, 0x2a, 0xd9, 0x38, 0x45, 0xb9, 0xf2, 0xca, 0xd4, 0x26, 0x0c, 0x85, 0x3c,
+	0x37, 0x71, 0x4e, 0x6d, 0x47, 0xe3, 0xf4, 0x2d, 0xd9, 0x38, 0x7e, 0x63, 0xed, 0x44, 0xb1, 0xd8,
+	0x4e, 0xce, 0x07, 0xcb, 0x78, 0x19, 0x75, 0x07, 0x6a, 0x86, 0x24, 0x9f, 0x34, 0xe1, 0x9c, 0x3a,
+	0x24, 0x1a, 0xaa, 0x43, 0xca, 0xab, 0x1d, 0x6e, 0xa6, 0x4d, 0x2a, 0xe1, 0xa6, 0xf3, 0x97, 0xd7,
+	0xad, 0x26, 0xb9, 0x96, 0x74, 0x29, 0xf5, 0xe7, 0xb0, 0x19, 0x2e, 0x9f, 0xd8, 0xc2, 0x9e, 0xfe,
+	0xea, 0x72, 0x55, 0x2b, 0x47, 0xfb, 0xe3, 0x0b, 0x6c, 0xb5, 0x34, 0x56, 0x69, 0x2d, 0x9f, 0xc3,
+	0xcd, 0xfa, 0xfa, 0x2a, 0x57, 0x0e, 0x6c, 0xac, 0x72, 0xa5, 0x74, 0xe2, 0xae, 0xbe, 0xce, 0xe0,
+	0xca, 0xfa, 0x7d, 0x2b, 0x07, 0x32, 0xe5, 0x79, 0x20, 0x93, 0x96, 0x7e, 0x72, 0x20, 0x7d, 0x49,
+	0x54, 0x0a, 0x6b, 0xfa, 0x19, 0x5a, 0x94, 0x64, 0x4e, 0x55, 0x85, 0x52, 0xf4, 0x68, 0x29, 0x85,
+	0x12, 0xb7, 0x07, 0xfb, 0xe8, 0xb1, 0xae, 0x42, 0xa9, 0xdb, 0x1f, 0x8e, 0x5a, 0x7d, 0x11, 0xa9,
+	0xd1, 0xed, 0x8b, 0x48, 0x0d, 0xed, 0x3f, 0x61, 0x60, 0x54, 0xec, 0x3f, 0xf9, 0xc1, 0x66, 0xa4,
+	0x58, 0x6e, 0xc8, 0xc9, 0x72, 0xc3, 0x92, 0x32, 0xc3, 0x45, 0x22, 0xfe, 0x14, 0xc5, 0x46, 0x5a,
+	0x65, 0x08, 0x56, 0x6f, 0xad, 0x15, 0xbe, 0xe3, 0xad, 0x35, 0x39, 0x78, 0xb5, 0x98, 0x0e, 0x5e,
+	0x5d, 0x7a, 0xb8, 0xb6, 0x44, 0x51, 0x52, 0xf2, 0xc3, 0xb5, 0xe7, 0xca, 0x6d, 0xe5, 0xf3, 0xa5,
+	0x4d, 0xfa, 0xf9, 0x24, 0xb4, 0xe0, 0x8b, 0x18, 0x4e, 0x91, 0x4b, 0x9f, 0x95, 0xf0, 0x82, 0xb3,
+	0xf2, 0x3b, 0xf0, 0x5d, 0x75, 0x1b, 0x2e, 0x4d, 0x8f, 0xe3, 0x17, 0xeb, 0x12, 0x73, 0x44, 0x8d,
+	0x86, 0xb1, 0x16, 0xa7, 0xfd, 0xc3, 0x0c, 0x40, 0xe2, 0x71, 0xf8, 0xa5, 0xcd, 0xa1, 0x92, 0xc5,
+	0x29, 0xf7, 0x2d, 0x16, 0xa7, 0x17, 0xbc, 0x94, 0xa0, 0x7d, 0x05, 0x95, 0xd8, 0xc7, 0xf4, 0xc3,
+	0xd7, 0xd8, 0xf7, 0x6a, 0xf2, 0x37, 0x23, 0xd3, 0x70, 0xec, 0xa4, 0xf9, 0x65, 0xe7, 0x22, 0xd5,
+	0x7c, 0xee, 0x05, 0xcd, 0x9f, 0x72, 0xfb, 0x6c, 0xdc, 0xf8, 0xaf, 0x78, 0x63, 0xc9, 0x6b, 0x3e,
+	0x9f, 0x5a, 0xf3, 0xda, 0x42, 0x18, 0x99, 0x7f, 0xf9, 0xa6, 0xbf, 0xd7, 0x80, 0xff, 0x32, 0x13,
+	0x59, 0x42, 0xe3, 0x97, 0xfd, 0xce, 0x95, 0x2a, 0xd7, 0x1b, 0x73, 0xbf, 0x4f, 0x73, 0xdf, 0x6a,
+	0x90, 0xc9, 0x7f, 0x9b, 0x41, 0xe6, 0x0d, 0x28, 0xf0, 0xd3, 0xaf, 0x70, 0x9e, 0x31, 0x86, 0xe3,
+	0x5f, 0xf8, 0x58, 0xb8, 0xa6, 0x09, 0x29, 0x9a, 0x8f, 0xf7, 0x52, 0x54, 0x6f, 0xf4, 0xd0, 0x39,
+	0x66, 0xd0, 0x1e, 0x56, 0x49, 0xec, 0x32, 0xdf, 0x7f, 0x4e, 0x7e, 0x65, 0x16, 0x99, 0x7f, 0x95,
+	0x85, 0x7a, 0xca, 0xbd, 0xfc, 0x03, 0x3a, 0xb3, 0x96, 0x9b, 0xe7, 0xd6, 0x73, 0xf3, 0x73, 0x19,
+	0x6b, 0xfe, 0x7c, 0xc6, 0xfa, 0x7f, 0xe5, 0x04, 0xe0, 0xe1, 0xdd, 0xe2, 0x5d, 0xf2, 0x72, 0x14,
+	0xde, 0xcd, 0x43, 0x85, 0x91, 0x9b, 0xd6, 0xe4, 0x76, 0xd7, 0x2a, 0x2b, 0x99, 0xb5, 0xca, 0xca,
+	0xcd, 0xf8, 0xc7, 0x82, 0xba, 0xbb, 0xdc, 0x0c, 0x50, 0x67, 0x12, 0x04, 0x1f, 0xcb, 0xe0, 0x22,
+	0x1c, 0x97, 0x5a, 0x75, 0x6f, 0xaa, 0x47, 0x58, 0x53, 0xc4, 0x12, 0x5f, 0xe1, 0x04, 0xfc, 0x25,
+	0xf9, 0x69, 0x2b, 0xc2, 0x6a, 0x5d, 0xa8, 0xa7, 0x7c, 0xfd, 0xd2, 0xcf, 0x92, 0x65, 0xe4, 0x9f,
+	0x25, 0xc3, 0xd0, 0xd5, 0x93, 0x23, 0xcb, 0xb7, 0xd6, 0xbc, 0x76, 0xc6, 0x11, 0xf8, 0x9b, 0x23,
+	0x72, 0xdc, 0x91, 0xfa, 0x16, 0x14, 0xec, 0xd0, 0x9a, 0x45, 0x8a, 0xe4, 0x95, 0xd5, 0xd0, 0x24,
+	0xb2, 0x35, 0x71, 0x22, 0x8c, 0xf1, 0x51, 0x96, 0x71, 0xd2, 0x6f, 0xa7, 0x65, 0xce, 0xf9, 0xed,
+	0xb4, 0x6c, 0xaa, 0x93, 0xeb, 0x7e, 0xfe, 0x2c, 0x7e, 0x71, 0x29, 0x7f, 0xce, 0x8b, 0x4b, 0x78,
+	0xe1, 0xd5, 0xb7, 0xe8, 0x87, 0xa9, 0xcc, 0x35, 0x21, 0xf7, 0x31, 0x0e, 0x43, 0xe2, 0x4b, 0x22,
+	0x48, 0x6a, 0xad, 0x66, 0xff, 0x26, 0x94, 0xf8, 0x8f, 0x54, 0x45, 0xf6, 0xb1, 0x95, 0x50, 0xef,
+	0x08, 0x8f, 0x11, 0xef, 0x88, 0x4a, 0x6b, 0xfa, 0x18, 0x3a, 0xc7, 0x08, 0x8e, 0x4b, 0x8d, 0x5b,
+	0xfb, 0x50, 0xcf, 0x0c, 0xc4, 0xab, 0x19, 0x40, 0x20, 0x14, 0xcd, 0x02, 0xed, 0xa7, 0x50, 0x12,
+	0x41, 0x58, 0x6b, 0xbb, 0xf2, 0xa2, 0x9f, 0x67, 0xda, 0x02, 0x48, 0xa2, 0xb2, 0xd6, 0xd5, 0x80,
+	0x3f, 0xb8, 0x16, 0x05, 0x62, 0xe1, 0xfa, 0x4b, 0x9a, 0x16, 0x57, 0x2a, 0xe4, 0xce, 0x38, 0xe2,
+	0x49, 0x50, 0x8c, 0xc7, 0x20, 0xc3, 0xf3, 0x3d, 0xfc, 0x75, 0x14, 0xf1, 0xd2, 0x6a, 0xe6, 0xfc,
+	0x97, 0x56, 0x63, 0x22, 0xf5, 0x0e, 0xc4, 0xec, 0xf8, 0x45, 0xa6, 0x01, 0xad, 0x15, 0x5d, 0x37,
+	0xa2, 0x55, 0x76, 0x5f, 0x18, 0x58, 0x7b, 0xf4, 0xd6, 0x4b, 0xca, 0xa6, 0x99, 0xea, 0x13, 0x93,
+	0xc8, 0xb4, 0x06, 0xd4, 0xe4, 0xe8, 0x11, 0xad, 0x05, 0x9b, 0xf8, 0x4b, 0x5d, 0xc8, 0xb3, 0xf0,
+	0xe6, 0x14, 0xd2, 0xf3, 0xf5, 0x8b, 0x89, 0xf4, 0xfa, 0x5d, 0xa6, 0x63, 0x9c, 0x48, 0xfb, 0xbd,
+	0x3c, 0x28, 0xcb, 0x38, 0x64, 0x26, 0xf1, 0xcf, 0x64, 0x64, 0xa2, 0x37, 0xa7, 0x9d, 0xf8, 0x97,
+	0x55, 0x68, 0x5d, 0xc8, 0x96, 0x20, 0xe0, 0x20, 0x22, 0xe0, 0xcc, 0x24, 0xf5, 0x78, 0x73, 0xd9,
+	0x0e, 0x1e, 0x53, 0x1e, 0xed, 0xcd, 0xf8, 0xc0, 0x85, 0xe3, 0x4d, 0x68, 0x59, 0xd7, 0xe8, 0x01,
+	0x8c, 0x9e, 0x37, 0xc1, 0x52, 0x91, 0x75, 0x21, 0x10, 0x17, 0xd3, 0xca, 0x1c, 0x30, 0x22, 0x17,
+	0x9b, 0x78, 0xe6, 0x20, 0xe4, 0xbf, 0x00, 0x56, 0x63, 0x65, 0x0e, 0x18, 0x05, 0xd1, 0xcb, 0x95,
+	0x13, 0xf1, 0x7b, 0x15, 0x39, 0x7a, 0xb9, 0x12, 0x9f, 0xd6, 0x44, 0x8b, 0x17, 0xfe, 0x22, 0xcb,
+	0x44, 0xfc, 0x64, 0x8e, 0x78, 0x17, 0x14, 0x51, 0xaf, 0xf1, 0x5f, 0xf4, 0xf0, 0xad, 0x20, 0xe0,
+	0xcf, 0x22, 0xf1, 0x17, 0x8b, 0x6a, 0x11, 0x30, 0x7e, 0x7f, 0x49, 0xfc, 0x38, 0x01, 0x92, 0x80,
+	0x78, 0x7f, 0x89, 0x40, 0x44, 0x70, 0x0d, 0xca, 0x5f, 0x7b, 0xae, 0x45, 0x56, 0x8a, 0x2a, 0xf5,
+	0xaa, 0x84, 0xf9, 0x3d, 0x63, 0xae, 0xfd, 0xc7, 0x0c, 0x5c, 0x5a, 0x9e, 0x55, 0x5a, 0x30, 0x35,
+	0x28, 0xb7, 0x07, 0x3d, 0x1d, 0x83, 0x03, 0x94, 0x0b, 0xe8, 0x46, 0x1a, 0xec, 0xe0, 0x25, 0x5e,
+	0x0e, 0xc8, 0xd0, 0x5d, 0xd4, 0xa1, 0xfe, 0xb8, 0xbb, 0xbb, 0xdb, 0xe9, 0x73, 0x2d, 0x65, 0xb0,
+	0xf3, 0xa9, 0xde, 0x1b, 0xb4, 0xf9, 0xcf, 0x2f, 0x44, 0xb1, 0x2a, 0x43, 0x25, 0x8f, 0x59, 0x1e,
+	0x5e, 0x8e, 0xd9, 0x02, 0x0f, 0x10, 0x7e, 0x36, 0xd4, 0xdb, 0xfd, 0x91, 0x52, 0xc4, 0x1c, 0x5e,
+	0x96, 0xd4, 0xdb, 0x51, 0x24, 0x60, 0x7b, 0xb0, 0xb7, 0xcf, 0x3a, 0xc3, 0xa1, 0x3e, 0xec, 0x7e,
+	0xd1, 0x51, 0xca, 0xd4, 0x32, 0xeb, 0x3e, 0xea, 0xf6, 0x39, 0xa0, 0x82, 0xbe, 0xae, 0xbd, 0x6e,
+	0x5f, 0x01, 0x4a, 0xb4, 0x3e, 0x53, 0xaa, 0x98, 0x18, 0x1e, 0xec, 0x29, 0xb5, 0x3b, 0xaf, 0x42,
+	0x4d, 0xfe, 0xdd, 0x23, 0x8a, 0x09, 0xf6, 0x5c, 0x8b, 0xbf, 0x66, 0xd9, 0xfb, 0xfa, 0x3d, 0x25,
+	0x73, 0xe7, 0x37, 0xa5, 0x87, 0xd2, 0x89, 0x46, 0xb8, 0xce, 0xe8, 0x4a, 0x34, 0xbf, 0xa1, 0x49,
+	0x8e, 0x32, 0xba, 0xd0, 0xf9, 0xb8, 0x35, 0x7c, 0xcc, 0x9d, 0x6a, 0x02, 0x43, 0x80, 0x5c, 0xf2,
+	0x0a, 0x22, 0x5d, 0x81, 0xa6, 0x64, 0x1c, 0x9a, 0x52, 0xc0, 0x82, 0x14, 0x35, 0x52, 0xc4, 0xf0,
+	0x0a, 0x4c, 0xc5, 0xb8, 0xd2, 0x1d, 0x0d, 0xaa, 0xd2, 0xc3, 0xb5, 0xd4, 0x86, 0x11, 0x1c, 0x89,
+	0x87, 0x15, 0x51, 0xdd, 0x54, 0x32, 0x77, 0x5e, 0x87, 0xba, 0xa0, 0x11, 0xcf, 0xc6, 0xe2, 0xcf,
+	0x0c, 0xe2, 0xe5, 0x49, 0x47, 0xd0, 0x59, 0x8b, 0x00, 0xe9, 0xee, 0xc1, 0xe5, 0xb5, 0x8f, 0xe0,
+	0x22, 0xfd, 0xd0, 0xc6, 0xb8, 0x61, 0x1e, 0x9a, 0xfd, 0xf8, 0x6c, 0xec, 0xdb, 0xa6, 0x92, 0xb9,
+	0xf3, 0x33, 0x68, 0x9e, 0x17, 0x69, 0xcc, 0xdd, 0x82, 0x2d, 0x8a, 0xe6, 0xc6, 0x4f, 0x32, 0xd0,
+	0x79, 0x2e, 0xc3, 0x6f, 0x0a, 0xf4, 0x3a, 0x14, 0x85, 0x74, 0xe7, 0x9b, 0x8c, 0xc4, 0x88, 0xa2,
+	0x68, 0xd1, 0x18, 0x20, 0xe6, 0x5a, 0x06, 0x31, 0xcb, 0x30, 0x95, 0x8c, 0x7a, 0x05, 0xd4, 0x14,
+	0xa8, 0xe7, 0x4d, 0x0c, 0x47, 0xc9, 0x52, 0xbc, 0x51, 0x04, 0xa7, 0x4b, 0x12, 0x4a, 0x4e, 0x7d,
+	0x19, 0xae, 0xc5, 0xb0, 0x9e, 0x77, 0xb2, 0xef, 0xdb, 0xa8, 0x31, 0x9f, 0x71, 0x74, 0x7e, 0xe7,
+	0x93, 0x3f, 0xfd, 0xc5, 0xcd, 0xcc, 0x7f, 0xfe, 0xc5, 0xcd, 0xcc, 0xff, 0xf8, 0xc5, 0xcd, 0x0b,
+	0xbf, 0xf7, 0x17, 0x37, 0x33, 0x5f, 0xc8, 0x3f, 0x3a, 0x3c, 0x33, 0x42, 0xdf, 0x3e, 0xe5, 0x4b,
+	0x3f, 0xca, 0xb8, 0xd6, 0xbd, 0xf9, 0xf1, 0xe1, 0xbd, 0xf9, 0xf8, 0x1e, 0xf2, 0x97, 0x71, 0x91,
+	0x7e, 0x5e, 0xf8, 0xfe, 0xff, 0x1e, 0x00, 0xae, 0x52, 0xe1, 0x5b, 0xbe, 0x78, 0x00, 0x00,
 }
 
 func (m *Type) Marshal() (dAtA []byte, err error) {
@@ -14262,6 +14865,18 @@ func (m *Default) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Generated != nil {
+		{
+			size, err := m.Generated.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.NullAbility {
 		i--
 		if m.NullAbility {
@@ -14294,6 +14909,50 @@ func (m *Default) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GeneratedCol) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GeneratedCol) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GeneratedCol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Stored {
+		i--
+		if m.Stored {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.OriginString) > 0 {
+		i -= len(m.OriginString)
+		copy(dAtA[i:], m.OriginString)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.OriginString)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *OnUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -14447,20 +15106,20 @@ func (m *PrimaryKeyDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x10
 	}
 	if len(m.Cols) > 0 {
-		dAtA29 := make([]byte, len(m.Cols)*10)
-		var j28 int
+		dAtA30 := make([]byte, len(m.Cols)*10)
+		var j29 int
 		for _, num := range m.Cols {
 			for num >= 1<<7 {
-				dAtA29[j28] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA30[j29] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j28++
+				j29++
 			}
-			dAtA29[j28] = uint8(num)
-			j28++
+			dAtA30[j29] = uint8(num)
+			j29++
 		}
-		i -= j28
-		copy(dAtA[i:], dAtA29[:j28])
-		i = encodeVarintPlan(dAtA, i, uint64(j28))
+		i -= j29
+		copy(dAtA[i:], dAtA30[:j29])
+		i = encodeVarintPlan(dAtA, i, uint64(j29))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -14629,20 +15288,20 @@ func (m *ForeignKeyDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x28
 	}
 	if len(m.ForeignCols) > 0 {
-		dAtA32 := make([]byte, len(m.ForeignCols)*10)
-		var j31 int
+		dAtA33 := make([]byte, len(m.ForeignCols)*10)
+		var j32 int
 		for _, num := range m.ForeignCols {
 			for num >= 1<<7 {
-				dAtA32[j31] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA33[j32] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j31++
+				j32++
 			}
-			dAtA32[j31] = uint8(num)
-			j31++
+			dAtA33[j32] = uint8(num)
+			j32++
 		}
-		i -= j31
-		copy(dAtA[i:], dAtA32[:j31])
-		i = encodeVarintPlan(dAtA, i, uint64(j31))
+		i -= j32
+		copy(dAtA[i:], dAtA33[:j32])
+		i = encodeVarintPlan(dAtA, i, uint64(j32))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -14652,20 +15311,20 @@ func (m *ForeignKeyDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x18
 	}
 	if len(m.Cols) > 0 {
-		dAtA34 := make([]byte, len(m.Cols)*10)
-		var j33 int
+		dAtA35 := make([]byte, len(m.Cols)*10)
+		var j34 int
 		for _, num := range m.Cols {
 			for num >= 1<<7 {
-				dAtA34[j33] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA35[j34] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j33++
+				j34++
 			}
-			dAtA34[j33] = uint8(num)
-			j33++
+			dAtA35[j34] = uint8(num)
+			j34++
 		}
-		i -= j33
-		copy(dAtA[i:], dAtA34[:j33])
-		i = encodeVarintPlan(dAtA, i, uint64(j33))
+		i -= j34
+		copy(dAtA[i:], dAtA35[:j34])
+		i = encodeVarintPlan(dAtA, i, uint64(j34))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -14703,6 +15362,23 @@ func (m *CheckDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Enforced {
+		i--
+		if m.Enforced {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.OriginString) > 0 {
+		i -= len(m.OriginString)
+		copy(dAtA[i:], m.OriginString)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.OriginString)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Check != nil {
 		{
 			size, err := m.Check.MarshalToSizedBuffer(dAtA[:i])
@@ -14725,6 +15401,71 @@ func (m *CheckDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TriggerDef) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TriggerDef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TriggerDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DefinerRoleId != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.DefinerRoleId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.DefinerUserId != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.DefinerUserId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Body) > 0 {
+		i -= len(m.Body)
+		copy(dAtA[i:], m.Body)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Body)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Event) > 0 {
+		i -= len(m.Event)
+		copy(dAtA[i:], m.Event)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Event)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Time) > 0 {
+		i -= len(m.Time)
+		copy(dAtA[i:], m.Time)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Time)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ClusterByDef) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -15406,6 +16147,22 @@ func (m *TableDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xaa
 	}
+	if len(m.Triggers) > 0 {
+		for iNdEx := len(m.Triggers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Triggers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
 	if len(m.Checks) > 0 {
 		for iNdEx := len(m.Checks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -15421,20 +16178,20 @@ func (m *TableDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.RefChildTbls) > 0 {
-		dAtA45 := make([]byte, len(m.RefChildTbls)*10)
-		var j44 int
+		dAtA46 := make([]byte, len(m.RefChildTbls)*10)
+		var j45 int
 		for _, num := range m.RefChildTbls {
 			for num >= 1<<7 {
-				dAtA45[j44] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA46[j45] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j44++
+				j45++
 			}
-			dAtA45[j44] = uint8(num)
-			j44++
+			dAtA46[j45] = uint8(num)
+			j45++
 		}
-		i -= j44
-		copy(dAtA[i:], dAtA45[:j44])
-		i = encodeVarintPlan(dAtA, i, uint64(j44))
+		i -= j45
+		copy(dAtA[i:], dAtA46[:j45])
+		i = encodeVarintPlan(dAtA, i, uint64(j45))
 		i--
 		dAtA[i] = 0x72
 	}
@@ -15672,9 +16429,9 @@ func (m *HashMapStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	if len(m.Ranges) > 0 {
 		for iNdEx := len(m.Ranges) - 1; iNdEx >= 0; iNdEx-- {
-			f49 := math.Float64bits(float64(m.Ranges[iNdEx]))
+			f50 := math.Float64bits(float64(m.Ranges[iNdEx]))
 			i -= 8
-			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f49))
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f50))
 		}
 		i = encodeVarintPlan(dAtA, i, uint64(len(m.Ranges)*8))
 		i--
@@ -16337,21 +17094,21 @@ func (m *OnDuplicateKeyCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.OnDuplicateIdx) > 0 {
-		dAtA60 := make([]byte, len(m.OnDuplicateIdx)*10)
-		var j59 int
+		dAtA61 := make([]byte, len(m.OnDuplicateIdx)*10)
+		var j60 int
 		for _, num1 := range m.OnDuplicateIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA60[j59] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA61[j60] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j59++
+				j60++
 			}
-			dAtA60[j59] = uint8(num)
-			j59++
+			dAtA61[j60] = uint8(num)
+			j60++
 		}
-		i -= j59
-		copy(dAtA[i:], dAtA60[:j59])
-		i = encodeVarintPlan(dAtA, i, uint64(j59))
+		i -= j60
+		copy(dAtA[i:], dAtA61[:j60])
+		i = encodeVarintPlan(dAtA, i, uint64(j60))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -16434,20 +17191,20 @@ func (m *InsertCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.PartitionTableIds) > 0 {
-		dAtA62 := make([]byte, len(m.PartitionTableIds)*10)
-		var j61 int
+		dAtA63 := make([]byte, len(m.PartitionTableIds)*10)
+		var j62 int
 		for _, num := range m.PartitionTableIds {
 			for num >= 1<<7 {
-				dAtA62[j61] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA63[j62] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j61++
+				j62++
 			}
-			dAtA62[j61] = uint8(num)
-			j61++
+			dAtA63[j62] = uint8(num)
+			j62++
 		}
-		i -= j61
-		copy(dAtA[i:], dAtA62[:j61])
-		i = encodeVarintPlan(dAtA, i, uint64(j61))
+		i -= j62
+		copy(dAtA[i:], dAtA63[:j62])
+		i = encodeVarintPlan(dAtA, i, uint64(j62))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -16547,20 +17304,20 @@ func (m *ReplaceCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.PartitionTableIds) > 0 {
-		dAtA66 := make([]byte, len(m.PartitionTableIds)*10)
-		var j65 int
+		dAtA67 := make([]byte, len(m.PartitionTableIds)*10)
+		var j66 int
 		for _, num := range m.PartitionTableIds {
 			for num >= 1<<7 {
-				dAtA66[j65] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA67[j66] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j65++
+				j66++
 			}
-			dAtA66[j65] = uint8(num)
-			j65++
+			dAtA67[j66] = uint8(num)
+			j66++
 		}
-		i -= j65
-		copy(dAtA[i:], dAtA66[:j65])
-		i = encodeVarintPlan(dAtA, i, uint64(j65))
+		i -= j66
+		copy(dAtA[i:], dAtA67[:j66])
+		i = encodeVarintPlan(dAtA, i, uint64(j66))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -16650,42 +17407,42 @@ func (m *AnalyzeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x88
 	}
 	if len(m.TimeConsumedArrayMinor) > 0 {
-		dAtA70 := make([]byte, len(m.TimeConsumedArrayMinor)*10)
-		var j69 int
+		dAtA71 := make([]byte, len(m.TimeConsumedArrayMinor)*10)
+		var j70 int
 		for _, num1 := range m.TimeConsumedArrayMinor {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA70[j69] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA71[j70] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j69++
+				j70++
 			}
-			dAtA70[j69] = uint8(num)
-			j69++
+			dAtA71[j70] = uint8(num)
+			j70++
 		}
-		i -= j69
-		copy(dAtA[i:], dAtA70[:j69])
-		i = encodeVarintPlan(dAtA, i, uint64(j69))
+		i -= j70
+		copy(dAtA[i:], dAtA71[:j70])
+		i = encodeVarintPlan(dAtA, i, uint64(j70))
 		i--
 		dAtA[i] = 0x1
 		i--
 		dAtA[i] = 0x82
 	}
 	if len(m.TimeConsumedArrayMajor) > 0 {
-		dAtA72 := make([]byte, len(m.TimeConsumedArrayMajor)*10)
-		var j71 int
+		dAtA73 := make([]byte, len(m.TimeConsumedArrayMajor)*10)
+		var j72 int
 		for _, num1 := range m.TimeConsumedArrayMajor {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA72[j71] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA73[j72] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j71++
+				j72++
 			}
-			dAtA72[j71] = uint8(num)
-			j71++
+			dAtA73[j72] = uint8(num)
+			j72++
 		}
-		i -= j71
-		copy(dAtA[i:], dAtA72[:j71])
-		i = encodeVarintPlan(dAtA, i, uint64(j71))
+		i -= j72
+		copy(dAtA[i:], dAtA73[:j72])
+		i = encodeVarintPlan(dAtA, i, uint64(j72))
 		i--
 		dAtA[i] = 0x7a
 	}
@@ -17253,21 +18010,21 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x8a
 	}
 	if len(m.SourceStep) > 0 {
-		dAtA86 := make([]byte, len(m.SourceStep)*10)
-		var j85 int
+		dAtA87 := make([]byte, len(m.SourceStep)*10)
+		var j86 int
 		for _, num1 := range m.SourceStep {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA86[j85] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA87[j86] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j85++
+				j86++
 			}
-			dAtA86[j85] = uint8(num)
-			j85++
+			dAtA87[j86] = uint8(num)
+			j86++
 		}
-		i -= j85
-		copy(dAtA[i:], dAtA86[:j85])
-		i = encodeVarintPlan(dAtA, i, uint64(j85))
+		i -= j86
+		copy(dAtA[i:], dAtA87[:j86])
+		i = encodeVarintPlan(dAtA, i, uint64(j86))
 		i--
 		dAtA[i] = 0x2
 		i--
@@ -17358,21 +18115,21 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xca
 	}
 	if len(m.BindingTags) > 0 {
-		dAtA92 := make([]byte, len(m.BindingTags)*10)
-		var j91 int
+		dAtA93 := make([]byte, len(m.BindingTags)*10)
+		var j92 int
 		for _, num1 := range m.BindingTags {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA92[j91] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA93[j92] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j91++
+				j92++
 			}
-			dAtA92[j91] = uint8(num)
-			j91++
+			dAtA93[j92] = uint8(num)
+			j92++
 		}
-		i -= j91
-		copy(dAtA[i:], dAtA92[:j91])
-		i = encodeVarintPlan(dAtA, i, uint64(j91))
+		i -= j92
+		copy(dAtA[i:], dAtA93[:j92])
+		i = encodeVarintPlan(dAtA, i, uint64(j92))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -17611,21 +18368,21 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.Children) > 0 {
-		dAtA101 := make([]byte, len(m.Children)*10)
-		var j100 int
+		dAtA102 := make([]byte, len(m.Children)*10)
+		var j101 int
 		for _, num1 := range m.Children {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA101[j100] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA102[j101] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j100++
+				j101++
 			}
-			dAtA101[j100] = uint8(num)
-			j100++
+			dAtA102[j101] = uint8(num)
+			j101++
 		}
-		i -= j100
-		copy(dAtA[i:], dAtA101[:j100])
-		i = encodeVarintPlan(dAtA, i, uint64(j100))
+		i -= j101
+		copy(dAtA[i:], dAtA102[:j101])
+		i = encodeVarintPlan(dAtA, i, uint64(j101))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -17864,6 +18621,11 @@ func (m *LockTarget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.WaitPolicy != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.WaitPolicy))
+		i--
+		dAtA[i] = 0x58
+	}
 	if m.Mode != 0 {
 		i = encodeVarintPlan(dAtA, i, uint64(m.Mode))
 		i--
@@ -17880,20 +18642,20 @@ func (m *LockTarget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x48
 	}
 	if len(m.PartitionTableIds) > 0 {
-		dAtA106 := make([]byte, len(m.PartitionTableIds)*10)
-		var j105 int
+		dAtA107 := make([]byte, len(m.PartitionTableIds)*10)
+		var j106 int
 		for _, num := range m.PartitionTableIds {
 			for num >= 1<<7 {
-				dAtA106[j105] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA107[j106] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j105++
+				j106++
 			}
-			dAtA106[j105] = uint8(num)
-			j105++
+			dAtA107[j106] = uint8(num)
+			j106++
 		}
-		i -= j105
-		copy(dAtA[i:], dAtA106[:j105])
-		i = encodeVarintPlan(dAtA, i, uint64(j105))
+		i -= j106
+		copy(dAtA[i:], dAtA107[:j106])
+		i = encodeVarintPlan(dAtA, i, uint64(j106))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -18000,21 +18762,21 @@ func (m *PreInsertUkCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x10
 	}
 	if len(m.Columns) > 0 {
-		dAtA111 := make([]byte, len(m.Columns)*10)
-		var j110 int
+		dAtA112 := make([]byte, len(m.Columns)*10)
+		var j111 int
 		for _, num1 := range m.Columns {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA111[j110] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA112[j111] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j110++
+				j111++
 			}
-			dAtA111[j110] = uint8(num)
-			j110++
+			dAtA112[j111] = uint8(num)
+			j111++
 		}
-		i -= j110
-		copy(dAtA[i:], dAtA111[:j110])
-		i = encodeVarintPlan(dAtA, i, uint64(j110))
+		i -= j111
+		copy(dAtA[i:], dAtA112[:j111])
+		i = encodeVarintPlan(dAtA, i, uint64(j111))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -18046,21 +18808,21 @@ func (m *PreDeleteCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.Idx) > 0 {
-		dAtA113 := make([]byte, len(m.Idx)*10)
-		var j112 int
+		dAtA114 := make([]byte, len(m.Idx)*10)
+		var j113 int
 		for _, num1 := range m.Idx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA113[j112] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA114[j113] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j112++
+				j113++
 			}
-			dAtA113[j112] = uint8(num)
-			j112++
+			dAtA114[j113] = uint8(num)
+			j113++
 		}
-		i -= j112
-		copy(dAtA[i:], dAtA113[:j112])
-		i = encodeVarintPlan(dAtA, i, uint64(j112))
+		i -= j113
+		copy(dAtA[i:], dAtA114[:j113])
+		i = encodeVarintPlan(dAtA, i, uint64(j113))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -18091,6 +18853,20 @@ func (m *PreInsertCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Checks) > 0 {
+		for iNdEx := len(m.Checks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Checks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.ClusterByExpr != nil {
 		{
 			size, err := m.ClusterByExpr.MarshalToSizedBuffer(dAtA[:i])
@@ -18246,21 +19022,21 @@ func (m *IdList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.List) > 0 {
-		dAtA120 := make([]byte, len(m.List)*10)
-		var j119 int
+		dAtA121 := make([]byte, len(m.List)*10)
+		var j120 int
 		for _, num1 := range m.List {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA120[j119] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA121[j120] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j119++
+				j120++
 			}
-			dAtA120[j119] = uint8(num)
-			j119++
+			dAtA121[j120] = uint8(num)
+			j120++
 		}
-		i -= j119
-		copy(dAtA[i:], dAtA120[:j119])
-		i = encodeVarintPlan(dAtA, i, uint64(j119))
+		i -= j120
+		copy(dAtA[i:], dAtA121[:j120])
+		i = encodeVarintPlan(dAtA, i, uint64(j120))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -18379,20 +19155,20 @@ func (m *DeleteCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.PartitionTableIds) > 0 {
-		dAtA124 := make([]byte, len(m.PartitionTableIds)*10)
-		var j123 int
+		dAtA125 := make([]byte, len(m.PartitionTableIds)*10)
+		var j124 int
 		for _, num := range m.PartitionTableIds {
 			for num >= 1<<7 {
-				dAtA124[j123] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA125[j124] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j123++
+				j124++
 			}
-			dAtA124[j123] = uint8(num)
-			j123++
+			dAtA125[j124] = uint8(num)
+			j124++
 		}
-		i -= j123
-		copy(dAtA[i:], dAtA124[:j123])
-		i = encodeVarintPlan(dAtA, i, uint64(j123))
+		i -= j124
+		copy(dAtA[i:], dAtA125[:j124])
+		i = encodeVarintPlan(dAtA, i, uint64(j124))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -18470,6 +19246,20 @@ func (m *Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.RowTriggers) > 0 {
+		for iNdEx := len(m.RowTriggers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RowTriggers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.DetectSqls) > 0 {
 		for iNdEx := len(m.DetectSqls) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.DetectSqls[iNdEx])
@@ -18537,21 +19327,21 @@ func (m *Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.Steps) > 0 {
-		dAtA127 := make([]byte, len(m.Steps)*10)
-		var j126 int
+		dAtA128 := make([]byte, len(m.Steps)*10)
+		var j127 int
 		for _, num1 := range m.Steps {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA127[j126] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA128[j127] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j126++
+				j127++
 			}
-			dAtA127[j126] = uint8(num)
-			j126++
+			dAtA128[j127] = uint8(num)
+			j127++
 		}
-		i -= j126
-		copy(dAtA[i:], dAtA127[:j126])
-		i = encodeVarintPlan(dAtA, i, uint64(j126))
+		i -= j127
+		copy(dAtA[i:], dAtA128[:j127])
+		i = encodeVarintPlan(dAtA, i, uint64(j127))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -18563,6 +19353,94 @@ func (m *Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RowTrigger) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RowTrigger) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RowTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DefinerRoleId != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.DefinerRoleId))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.DefinerUserId != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.DefinerUserId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.NewCols) > 0 {
+		for iNdEx := len(m.NewCols) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NewCols[iNdEx])
+			copy(dAtA[i:], m.NewCols[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.NewCols[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.OldCols) > 0 {
+		for iNdEx := len(m.OldCols) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OldCols[iNdEx])
+			copy(dAtA[i:], m.OldCols[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.OldCols[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.NodeId != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.NodeId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Body) > 0 {
+		i -= len(m.Body)
+		copy(dAtA[i:], m.Body)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Body)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TriggerName) > 0 {
+		i -= len(m.TriggerName)
+		copy(dAtA[i:], m.TriggerName)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.TriggerName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TableName) > 0 {
+		i -= len(m.TableName)
+		copy(dAtA[i:], m.TableName)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.TableName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DbName) > 0 {
+		i -= len(m.DbName)
+		copy(dAtA[i:], m.DbName)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.DbName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TransationControl) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -20455,6 +21333,180 @@ func (m *AlterTableAddPartition) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *AlterTableDropPartition) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlterTableDropPartition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableDropPartition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.PartitionDef != nil {
+		{
+			size, err := m.PartitionDef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PartitionTableNames) > 0 {
+		for iNdEx := len(m.PartitionTableNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PartitionTableNames[iNdEx])
+			copy(dAtA[i:], m.PartitionTableNames[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.PartitionTableNames[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.PartitionNames) > 0 {
+		for iNdEx := len(m.PartitionNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PartitionNames[iNdEx])
+			copy(dAtA[i:], m.PartitionNames[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.PartitionNames[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AlterTableTruncatePartition) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlterTableTruncatePartition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableTruncatePartition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PartitionTableNames) > 0 {
+		for iNdEx := len(m.PartitionTableNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PartitionTableNames[iNdEx])
+			copy(dAtA[i:], m.PartitionTableNames[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.PartitionTableNames[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.PartitionNames) > 0 {
+		for iNdEx := len(m.PartitionNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PartitionNames[iNdEx])
+			copy(dAtA[i:], m.PartitionNames[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.PartitionNames[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AlterTableExchangePartition) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlterTableExchangePartition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableExchangePartition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ValidationFilter) > 0 {
+		i -= len(m.ValidationFilter)
+		copy(dAtA[i:], m.ValidationFilter)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.ValidationFilter)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.WithoutValidation {
+		i--
+		if m.WithoutValidation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Table) > 0 {
+		i -= len(m.Table)
+		copy(dAtA[i:], m.Table)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Table)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Database) > 0 {
+		i -= len(m.Database)
+		copy(dAtA[i:], m.Database)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Database)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PartitionTableName) > 0 {
+		i -= len(m.PartitionTableName)
+		copy(dAtA[i:], m.PartitionTableName)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.PartitionTableName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PartitionName) > 0 {
+		i -= len(m.PartitionName)
+		copy(dAtA[i:], m.PartitionName)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.PartitionName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AlterTableComment) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -21053,6 +22105,69 @@ func (m *AlterTable_Action_AddPartition) MarshalToSizedBuffer(dAtA []byte) (int,
 	}
 	return len(dAtA) - i, nil
 }
+func (m *AlterTable_Action_DropPartition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTable_Action_DropPartition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DropPartition != nil {
+		{
+			size, err := m.DropPartition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AlterTable_Action_TruncatePartition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTable_Action_TruncatePartition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.TruncatePartition != nil {
+		{
+			size, err := m.TruncatePartition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AlterTable_Action_ExchangePartition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTable_Action_ExchangePartition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ExchangePartition != nil {
+		{
+			size, err := m.ExchangePartition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *DropTable) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -21078,20 +22193,20 @@ func (m *DropTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.FkChildTblsReferToMe) > 0 {
-		dAtA183 := make([]byte, len(m.FkChildTblsReferToMe)*10)
-		var j182 int
+		dAtA188 := make([]byte, len(m.FkChildTblsReferToMe)*10)
+		var j187 int
 		for _, num := range m.FkChildTblsReferToMe {
 			for num >= 1<<7 {
-				dAtA183[j182] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA188[j187] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j182++
+				j187++
 			}
-			dAtA183[j182] = uint8(num)
-			j182++
+			dAtA188[j187] = uint8(num)
+			j187++
 		}
-		i -= j182
-		copy(dAtA[i:], dAtA183[:j182])
-		i = encodeVarintPlan(dAtA, i, uint64(j182))
+		i -= j187
+		copy(dAtA[i:], dAtA188[:j187])
+		i = encodeVarintPlan(dAtA, i, uint64(j187))
 		i--
 		dAtA[i] = 0x62
 	}
@@ -21136,20 +22251,20 @@ func (m *DropTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.ForeignTbl) > 0 {
-		dAtA186 := make([]byte, len(m.ForeignTbl)*10)
-		var j185 int
+		dAtA191 := make([]byte, len(m.ForeignTbl)*10)
+		var j190 int
 		for _, num := range m.ForeignTbl {
 			for num >= 1<<7 {
-				dAtA186[j185] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA191[j190] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j185++
+				j190++
 			}
-			dAtA186[j185] = uint8(num)
-			j185++
+			dAtA191[j190] = uint8(num)
+			j190++
 		}
-		i -= j185
-		copy(dAtA[i:], dAtA186[:j185])
-		i = encodeVarintPlan(dAtA, i, uint64(j185))
+		i -= j190
+		copy(dAtA[i:], dAtA191[:j190])
+		i = encodeVarintPlan(dAtA, i, uint64(j190))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -21702,20 +22817,20 @@ func (m *TruncateTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x40
 	}
 	if len(m.ForeignTbl) > 0 {
-		dAtA195 := make([]byte, len(m.ForeignTbl)*10)
-		var j194 int
+		dAtA200 := make([]byte, len(m.ForeignTbl)*10)
+		var j199 int
 		for _, num := range m.ForeignTbl {
 			for num >= 1<<7 {
-				dAtA195[j194] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA200[j199] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j194++
+				j199++
 			}
-			dAtA195[j194] = uint8(num)
-			j194++
+			dAtA200[j199] = uint8(num)
+			j199++
 		}
-		i -= j194
-		copy(dAtA[i:], dAtA195[:j194])
-		i = encodeVarintPlan(dAtA, i, uint64(j194))
+		i -= j199
+		copy(dAtA[i:], dAtA200[:j199])
+		i = encodeVarintPlan(dAtA, i, uint64(j199))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -21801,20 +22916,20 @@ func (m *ClusterTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x18
 	}
 	if len(m.AccountIDs) > 0 {
-		dAtA198 := make([]byte, len(m.AccountIDs)*10)
-		var j197 int
+		dAtA203 := make([]byte, len(m.AccountIDs)*10)
+		var j202 int
 		for _, num := range m.AccountIDs {
 			for num >= 1<<7 {
-				dAtA198[j197] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA203[j202] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j197++
+				j202++
 			}
-			dAtA198[j197] = uint8(num)
-			j197++
+			dAtA203[j202] = uint8(num)
+			j202++
 		}
-		i -= j197
-		copy(dAtA[i:], dAtA198[:j197])
-		i = encodeVarintPlan(dAtA, i, uint64(j197))
+		i -= j202
+		copy(dAtA[i:], dAtA203[:j202])
+		i = encodeVarintPlan(dAtA, i, uint64(j202))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -22026,21 +23141,21 @@ func (m *Prepare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.ParamTypes) > 0 {
-		dAtA202 := make([]byte, len(m.ParamTypes)*10)
-		var j201 int
+		dAtA207 := make([]byte, len(m.ParamTypes)*10)
+		var j206 int
 		for _, num1 := range m.ParamTypes {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA202[j201] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA207[j206] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j201++
+				j206++
 			}
-			dAtA202[j201] = uint8(num)
-			j201++
+			dAtA207[j206] = uint8(num)
+			j206++
 		}
-		i -= j201
-		copy(dAtA[i:], dAtA202[:j201])
-		i = encodeVarintPlan(dAtA, i, uint64(j201))
+		i -= j206
+		copy(dAtA[i:], dAtA207[:j206])
+		i = encodeVarintPlan(dAtA, i, uint64(j206))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -22187,21 +23302,21 @@ func (m *OtherDCL) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.ParamTypes) > 0 {
-		dAtA205 := make([]byte, len(m.ParamTypes)*10)
-		var j204 int
+		dAtA210 := make([]byte, len(m.ParamTypes)*10)
+		var j209 int
 		for _, num1 := range m.ParamTypes {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA205[j204] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA210[j209] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j204++
+				j209++
 			}
-			dAtA205[j204] = uint8(num)
-			j204++
+			dAtA210[j209] = uint8(num)
+			j209++
 		}
-		i -= j204
-		copy(dAtA[i:], dAtA205[:j204])
-		i = encodeVarintPlan(dAtA, i, uint64(j204))
+		i -= j209
+		copy(dAtA[i:], dAtA210[:j209])
+		i = encodeVarintPlan(dAtA, i, uint64(j209))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -23401,6 +24516,29 @@ func (m *Default) ProtoSize() (n int) {
 	if m.NullAbility {
 		n += 2
 	}
+	if m.Generated != nil {
+		l = m.Generated.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GeneratedCol) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OriginString)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.Stored {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -23594,6 +24732,47 @@ func (m *CheckDef) ProtoSize() (n int) {
 		l = m.Check.ProtoSize()
 		n += 1 + l + sovPlan(uint64(l))
 	}
+	l = len(m.OriginString)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.Enforced {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TriggerDef) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.Time)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.Event)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.Body)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.DefinerUserId != 0 {
+		n += 1 + sovPlan(uint64(m.DefinerUserId))
+	}
+	if m.DefinerRoleId != 0 {
+		n += 1 + sovPlan(uint64(m.DefinerRoleId))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -23899,6 +25078,12 @@ func (m *TableDef) ProtoSize() (n int) {
 			n += 1 + l + sovPlan(uint64(l))
 		}
 	}
+	if len(m.Triggers) > 0 {
+		for _, e := range m.Triggers {
+			l = e.ProtoSize()
+			n += 2 + l + sovPlan(uint64(l))
+		}
+	}
 	if m.Partition != nil {
 		l = m.Partition.ProtoSize()
 		n += 2 + l + sovPlan(uint64(l))
@@ -24942,6 +26127,9 @@ func (m *LockTarget) ProtoSize() (n int) {
 	if m.Mode != 0 {
 		n += 1 + sovPlan(uint64(m.Mode))
 	}
+	if m.WaitPolicy != 0 {
+		n += 1 + sovPlan(uint64(m.WaitPolicy))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -25021,6 +26209,12 @@ func (m *PreInsertCtx) ProtoSize() (n int) {
 		l = m.ClusterByExpr.ProtoSize()
 		n += 1 + l + sovPlan(uint64(l))
 	}
+	if len(m.Checks) > 0 {
+		for _, e := range m.Checks {
+			l = e.ProtoSize()
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -25192,6 +26386,61 @@ func (m *Query) ProtoSize() (n int) {
 			n += 1 + l + sovPlan(uint64(l))
 		}
 	}
+	if len(m.RowTriggers) > 0 {
+		for _, e := range m.RowTriggers {
+			l = e.ProtoSize()
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RowTrigger) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DbName)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.TableName)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.TriggerName)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.Body)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.NodeId != 0 {
+		n += 1 + sovPlan(uint64(m.NodeId))
+	}
+	if len(m.OldCols) > 0 {
+		for _, s := range m.OldCols {
+			l = len(s)
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if len(m.NewCols) > 0 {
+		for _, s := range m.NewCols {
+			l = len(s)
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if m.DefinerUserId != 0 {
+		n += 1 + sovPlan(uint64(m.DefinerUserId))
+	}
+	if m.DefinerRoleId != 0 {
+		n += 1 + sovPlan(uint64(m.DefinerRoleId))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -26130,6 +27379,93 @@ func (m *AlterTableAddPartition) ProtoSize() (n int) {
 	return n
 }
 
+func (m *AlterTableDropPartition) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PartitionNames) > 0 {
+		for _, s := range m.PartitionNames {
+			l = len(s)
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if len(m.PartitionTableNames) > 0 {
+		for _, s := range m.PartitionTableNames {
+			l = len(s)
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if m.PartitionDef != nil {
+		l = m.PartitionDef.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AlterTableTruncatePartition) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PartitionNames) > 0 {
+		for _, s := range m.PartitionNames {
+			l = len(s)
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if len(m.PartitionTableNames) > 0 {
+		for _, s := range m.PartitionTableNames {
+			l = len(s)
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AlterTableExchangePartition) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PartitionName)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.PartitionTableName)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.Database)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.Table)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.WithoutValidation {
+		n += 2
+	}
+	l = len(m.ValidationFilter)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *AlterTableComment) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -26431,6 +27767,42 @@ func (m *AlterTable_Action_AddPartition) ProtoSize() (n int) {
 	}
 	return n
 }
+func (m *AlterTable_Action_DropPartition) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DropPartition != nil {
+		l = m.DropPartition.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
+func (m *AlterTable_Action_TruncatePartition) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TruncatePartition != nil {
+		l = m.TruncatePartition.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
+func (m *AlterTable_Action_ExchangePartition) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExchangePartition != nil {
+		l = m.ExchangePartition.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
 func (m *DropTable) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -31267,6 +32639,145 @@ func (m *Default) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.NullAbility = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Generated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Generated == nil {
+				m.Generated = &GeneratedCol{}
+			}
+			if err := m.Generated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GeneratedCol) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GeneratedCol: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GeneratedCol: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginString = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stored", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stored = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -32553,6 +34064,58 @@ func (m *CheckDef) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginString = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enforced", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enforced = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -32575,7 +34138,7 @@ func (m *CheckDef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterByDef) Unmarshal(dAtA []byte) error {
+func (m *TriggerDef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32598,10 +34161,10 @@ func (m *ClusterByDef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterByDef: wiretype end group for non-group")
+			return fmt.Errorf("proto: TriggerDef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterByDef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TriggerDef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -32638,9 +34201,9 @@ func (m *ClusterByDef) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompCbkeyCol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -32650,82 +34213,27 @@ func (m *ClusterByDef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CompCbkeyCol == nil {
-				m.CompCbkeyCol = &ColDef{}
-			}
-			if err := m.CompCbkeyCol.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Time = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPlan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PropertyDef) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPlan
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PropertyDef: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PropertyDef: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32753,11 +34261,283 @@ func (m *PropertyDef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Event = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Body = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefinerUserId", wireType)
+			}
+			m.DefinerUserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DefinerUserId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefinerRoleId", wireType)
+			}
+			m.DefinerRoleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DefinerRoleId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterByDef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterByDef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterByDef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompCbkeyCol", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CompCbkeyCol == nil {
+				m.CompCbkeyCol = &ColDef{}
+			}
+			if err := m.CompCbkeyCol.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PropertyDef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PropertyDef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PropertyDef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34395,6 +36175,40 @@ func (m *TableDef) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Triggers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Triggers = append(m.Triggers, &TriggerDef{})
+			if err := m.Triggers[len(m.Triggers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 21:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
@@ -41276,6 +43090,25 @@ func (m *LockTarget) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitPolicy", wireType)
+			}
+			m.WaitPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WaitPolicy |= lock.WaitPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -41850,6 +43683,40 @@ func (m *PreInsertCtx) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checks = append(m.Checks, &CheckDef{})
+			if err := m.Checks[len(m.Checks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -42988,6 +44855,40 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 			}
 			m.DetectSqls = append(m.DetectSqls, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowTriggers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RowTriggers = append(m.RowTriggers, &RowTrigger{})
+			if err := m.RowTriggers[len(m.RowTriggers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -43010,7 +44911,7 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TransationControl) Unmarshal(dAtA []byte) error {
+func (m *RowTrigger) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -43033,17 +44934,17 @@ func (m *TransationControl) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TransationControl: wiretype end group for non-group")
+			return fmt.Errorf("proto: RowTrigger: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransationControl: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RowTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TclType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
 			}
-			m.TclType = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -43053,16 +44954,29 @@ func (m *TransationControl) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TclType |= TransationControl_TclType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DbName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Begin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -43072,32 +44986,29 @@ func (m *TransationControl) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &TransationBegin{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Action = &TransationControl_Begin{v}
+			m.TableName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -43107,32 +45018,29 @@ func (m *TransationControl) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &TransationCommit{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Action = &TransationControl_Commit{v}
+			m.TriggerName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rollback", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -43142,26 +45050,319 @@ func (m *TransationControl) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &TransationRollback{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Body = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
 			}
-			m.Action = &TransationControl_Rollback{v}
+			m.NodeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NodeId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldCols", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldCols = append(m.OldCols, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewCols", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewCols = append(m.NewCols, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefinerUserId", wireType)
+			}
+			m.DefinerUserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DefinerUserId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefinerRoleId", wireType)
+			}
+			m.DefinerRoleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DefinerRoleId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransationControl) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransationControl: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransationControl: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TclType", wireType)
+			}
+			m.TclType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TclType |= TransationControl_TclType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Begin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &TransationBegin{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &TransationControl_Begin{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &TransationCommit{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &TransationControl_Commit{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rollback", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &TransationRollback{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &TransationControl_Rollback{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -45706,101 +47907,567 @@ func (m *CreateTable) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Database = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replace", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Replace = bool(v != 0)
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableDef", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TableDef == nil {
-				m.TableDef = &TableDef{}
-			}
-			if err := m.TableDef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexTables", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.IndexTables = append(m.IndexTables, &TableDef{})
-			if err := m.IndexTables[len(m.IndexTables)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Database = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replace", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Replace = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableDef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TableDef == nil {
+				m.TableDef = &TableDef{}
+			}
+			if err := m.TableDef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexTables", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexTables = append(m.IndexTables, &TableDef{})
+			if err := m.IndexTables[len(m.IndexTables)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FkDbs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FkDbs = append(m.FkDbs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FkTables", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FkTables = append(m.FkTables, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FkCols", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FkCols = append(m.FkCols, &FkColName{})
+			if err := m.FkCols[len(m.FkCols)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionTables", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PartitionTables = append(m.PartitionTables, &TableDef{})
+			if err := m.PartitionTables[len(m.PartitionTables)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateAsSelectSql", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreateAsSelectSql = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateFkSqls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdateFkSqls = append(m.UpdateFkSqls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FksReferToMe", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FksReferToMe = append(m.FksReferToMe, &ForeignKeyInfo{})
+			if err := m.FksReferToMe[len(m.FksReferToMe)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionDeadline", wireType)
+			}
+			m.RetentionDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetentionDeadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlterTableDrop) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterTableDrop: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterTableDrop: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Typ", wireType)
+			}
+			m.Typ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Typ |= AlterTableDrop_Typ(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexTableName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexTableName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlterTableAddFk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterTableAddFk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterTableAddFk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DbName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FkDbs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -45828,11 +48495,11 @@ func (m *CreateTable) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FkDbs = append(m.FkDbs, string(dAtA[iNdEx:postIndex]))
+			m.TableName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FkTables", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cols", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -45860,11 +48527,11 @@ func (m *CreateTable) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FkTables = append(m.FkTables, string(dAtA[iNdEx:postIndex]))
+			m.Cols = append(m.Cols, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FkCols", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fkey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -45891,16 +48558,69 @@ func (m *CreateTable) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FkCols = append(m.FkCols, &FkColName{})
-			if err := m.FkCols[len(m.FkCols)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Fkey == nil {
+				m.Fkey = &ForeignKeyDef{}
+			}
+			if err := m.Fkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlterTableAddIndex) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterTableAddIndex: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterTableAddIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartitionTables", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -45910,29 +48630,27 @@ func (m *CreateTable) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PartitionTables = append(m.PartitionTables, &TableDef{})
-			if err := m.PartitionTables[len(m.PartitionTables)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DbName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateAsSelectSql", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -45960,11 +48678,11 @@ func (m *CreateTable) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreateAsSelectSql = string(dAtA[iNdEx:postIndex])
+			m.TableName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateFkSqls", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginTablePrimaryKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -45992,11 +48710,11 @@ func (m *CreateTable) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdateFkSqls = append(m.UpdateFkSqls, string(dAtA[iNdEx:postIndex]))
+			m.OriginTablePrimaryKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FksReferToMe", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -46023,16 +48741,18 @@ func (m *CreateTable) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FksReferToMe = append(m.FksReferToMe, &ForeignKeyInfo{})
-			if err := m.FksReferToMe[len(m.FksReferToMe)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.IndexInfo == nil {
+				m.IndexInfo = &CreateTable{}
+			}
+			if err := m.IndexInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetentionDeadline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexTableExist", wireType)
 			}
-			m.RetentionDeadline = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -46042,11 +48762,12 @@ func (m *CreateTable) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RetentionDeadline |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.IndexTableExist = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -46069,7 +48790,7 @@ func (m *CreateTable) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AlterTableDrop) Unmarshal(dAtA []byte) error {
+func (m *AlterTableDropIndex) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -46092,17 +48813,17 @@ func (m *AlterTableDrop) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AlterTableDrop: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterTableDropIndex: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AlterTableDrop: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterTableDropIndex: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Typ", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
 			}
-			m.Typ = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -46112,14 +48833,27 @@ func (m *AlterTableDrop) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Typ |= AlterTableDrop_Typ(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DbName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -46147,9 +48881,41 @@ func (m *AlterTableDrop) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.TableName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field IndexTableName", wireType)
 			}
@@ -46203,7 +48969,7 @@ func (m *AlterTableDrop) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AlterTableAddFk) Unmarshal(dAtA []byte) error {
+func (m *AlterTableAlterIndex) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -46226,10 +48992,10 @@ func (m *AlterTableAddFk) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AlterTableAddFk: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterTableAlterIndex: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AlterTableAddFk: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterTableAlterIndex: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -46298,7 +49064,7 @@ func (m *AlterTableAddFk) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cols", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -46326,13 +49092,13 @@ func (m *AlterTableAddFk) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cols = append(m.Cols, string(dAtA[iNdEx:postIndex]))
+			m.IndexName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fkey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Visible", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -46342,28 +49108,12 @@ func (m *AlterTableAddFk) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Fkey == nil {
-				m.Fkey = &ForeignKeyDef{}
-			}
-			if err := m.Fkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Visible = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -46386,7 +49136,7 @@ func (m *AlterTableAddFk) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AlterTableAddIndex) Unmarshal(dAtA []byte) error {
+func (m *AlterTableAlterReIndex) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -46409,10 +49159,10 @@ func (m *AlterTableAddIndex) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AlterTableAddIndex: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterTableAlterReIndex: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AlterTableAddIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterTableAlterReIndex: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -46481,7 +49231,7 @@ func (m *AlterTableAddIndex) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginTablePrimaryKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -46509,11 +49259,81 @@ func (m *AlterTableAddIndex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginTablePrimaryKey = string(dAtA[iNdEx:postIndex])
+			m.IndexName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexAlgoParamList", wireType)
+			}
+			m.IndexAlgoParamList = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexAlgoParamList |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlterTableAddPartition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterTableAddPartition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterTableAddPartition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Definitions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -46540,18 +49360,50 @@ func (m *AlterTableAddIndex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.IndexInfo == nil {
-				m.IndexInfo = &CreateTable{}
+			m.Definitions = append(m.Definitions, &PartitionItem{})
+			if err := m.Definitions[len(m.Definitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.IndexInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionTables", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PartitionTables = append(m.PartitionTables, &TableDef{})
+			if err := m.PartitionTables[len(m.PartitionTables)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexTableExist", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionDef", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -46561,12 +49413,28 @@ func (m *AlterTableAddIndex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IndexTableExist = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PartitionDef == nil {
+				m.PartitionDef = &PartitionByDef{}
+			}
+			if err := m.PartitionDef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -46589,7 +49457,7 @@ func (m *AlterTableAddIndex) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AlterTableDropIndex) Unmarshal(dAtA []byte) error {
+func (m *AlterTableDropPartition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -46612,15 +49480,15 @@ func (m *AlterTableDropIndex) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AlterTableDropIndex: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterTableDropPartition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AlterTableDropIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterTableDropPartition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionNames", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -46648,11 +49516,11 @@ func (m *AlterTableDropIndex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DbName = string(dAtA[iNdEx:postIndex])
+			m.PartitionNames = append(m.PartitionNames, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionTableNames", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -46680,13 +49548,13 @@ func (m *AlterTableDropIndex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TableName = string(dAtA[iNdEx:postIndex])
+			m.PartitionTableNames = append(m.PartitionTableNames, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionDef", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -46696,55 +49564,27 @@ func (m *AlterTableDropIndex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IndexName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexTableName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
+			if m.PartitionDef == nil {
+				m.PartitionDef = &PartitionByDef{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.PartitionDef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.IndexTableName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -46768,7 +49608,7 @@ func (m *AlterTableDropIndex) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AlterTableAlterIndex) Unmarshal(dAtA []byte) error {
+func (m *AlterTableTruncatePartition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -46791,15 +49631,15 @@ func (m *AlterTableAlterIndex) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AlterTableAlterIndex: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterTableTruncatePartition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AlterTableAlterIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterTableTruncatePartition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionNames", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -46827,43 +49667,11 @@ func (m *AlterTableAlterIndex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DbName = string(dAtA[iNdEx:postIndex])
+			m.PartitionNames = append(m.PartitionNames, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TableName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionTableNames", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -46891,28 +49699,8 @@ func (m *AlterTableAlterIndex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IndexName = string(dAtA[iNdEx:postIndex])
+			m.PartitionTableNames = append(m.PartitionTableNames, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Visible", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Visible = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -46935,7 +49723,7 @@ func (m *AlterTableAlterIndex) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AlterTableAlterReIndex) Unmarshal(dAtA []byte) error {
+func (m *AlterTableExchangePartition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -46958,15 +49746,15 @@ func (m *AlterTableAlterReIndex) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AlterTableAlterReIndex: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterTableExchangePartition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AlterTableAlterReIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterTableExchangePartition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -46994,11 +49782,11 @@ func (m *AlterTableAlterReIndex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DbName = string(dAtA[iNdEx:postIndex])
+			m.PartitionName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionTableName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -47026,11 +49814,11 @@ func (m *AlterTableAlterReIndex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TableName = string(dAtA[iNdEx:postIndex])
+			m.PartitionTableName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -47058,83 +49846,13 @@ func (m *AlterTableAlterReIndex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IndexName = string(dAtA[iNdEx:postIndex])
+			m.Database = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexAlgoParamList", wireType)
-			}
-			m.IndexAlgoParamList = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.IndexAlgoParamList |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPlan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AlterTableAddPartition) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPlan
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AlterTableAddPartition: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AlterTableAddPartition: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Definitions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -47144,31 +49862,29 @@ func (m *AlterTableAddPartition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Definitions = append(m.Definitions, &PartitionItem{})
-			if err := m.Definitions[len(m.Definitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Table = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartitionTables", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithoutValidation", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -47178,31 +49894,17 @@ func (m *AlterTableAddPartition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PartitionTables = append(m.PartitionTables, &TableDef{})
-			if err := m.PartitionTables[len(m.PartitionTables)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
+			m.WithoutValidation = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartitionDef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidationFilter", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -47212,27 +49914,23 @@ func (m *AlterTableAddPartition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PartitionDef == nil {
-				m.PartitionDef = &PartitionByDef{}
-			}
-			if err := m.PartitionDef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidationFilter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -48645,6 +51343,111 @@ func (m *AlterTable_Action) Unmarshal(dAtA []byte) error {
 			}
 			m.Action = &AlterTable_Action_AddPartition{v}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DropPartition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AlterTableDropPartition{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &AlterTable_Action_DropPartition{v}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TruncatePartition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AlterTableTruncatePartition{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &AlterTable_Action_TruncatePartition{v}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangePartition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AlterTableExchangePartition{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &AlterTable_Action_ExchangePartition{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
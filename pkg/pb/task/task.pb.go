@@ -106,6 +106,10 @@ const (
 	TaskCode_MergeObject TaskCode = 5
 	// Retention is for table retention
 	TaskCode_Retention TaskCode = 6
+	// InitCdc is for the change data capture task.
+	TaskCode_InitCdc TaskCode = 7
+	// SQLEvent is for the scheduled sql event created by CREATE EVENT.
+	TaskCode_SQLEvent TaskCode = 8
 )
 
 var TaskCode_name = map[int32]string{
@@ -116,6 +120,8 @@ var TaskCode_name = map[int32]string{
 	4: "ConnectorKafkaSink",
 	5: "MergeObject",
 	6: "Retention",
+	7: "InitCdc",
+	8: "SQLEvent",
 }
 
 var TaskCode_value = map[string]int32{
@@ -126,6 +132,8 @@ var TaskCode_value = map[string]int32{
 	"ConnectorKafkaSink": 4,
 	"MergeObject":        5,
 	"Retention":          6,
+	"InitCdc":            7,
+	"SQLEvent":           8,
 }
 
 func (x TaskCode) String() string {
@@ -170,18 +178,21 @@ const (
 	TaskType_TypeUnknown            TaskType = 0
 	TaskType_TypeKafkaSinkConnector TaskType = 1
 	TaskType_TypeRetention          TaskType = 2
+	TaskType_TypeCdc                TaskType = 3
 )
 
 var TaskType_name = map[int32]string{
 	0: "Unknown",
 	1: "KafkaSinkConnector",
 	2: "RetentionType",
+	3: "CreateCdc",
 }
 
 var TaskType_value = map[string]int32{
 	"Unknown":            0,
 	"KafkaSinkConnector": 1,
 	"RetentionType":      2,
+	"CreateCdc":          3,
 }
 
 func (x TaskType) String() string {
@@ -805,6 +816,122 @@ func (m *RetentionDetails) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RetentionDetails proto.InternalMessageInfo
 
+// SQLEventContext is the context of the cron task of a sql event.
+type SQLEventContext struct {
+	// AccountID is the account which the event belongs to.
+	AccountID uint32 `protobuf:"varint,1,opt,name=AccountID,proto3" json:"AccountID,omitempty"`
+	// EventID is the key of the event in mo_catalog.mo_events of the account.
+	EventID              string   `protobuf:"bytes,2,opt,name=EventID,proto3" json:"EventID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SQLEventContext) Reset()         { *m = SQLEventContext{} }
+func (m *SQLEventContext) String() string { return proto.CompactTextString(m) }
+func (*SQLEventContext) ProtoMessage()    {}
+func (*SQLEventContext) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce5d8dd45b4a91ff, []int{8}
+}
+func (m *SQLEventContext) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SQLEventContext) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SQLEventContext.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SQLEventContext) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SQLEventContext.Merge(m, src)
+}
+func (m *SQLEventContext) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *SQLEventContext) XXX_DiscardUnknown() {
+	xxx_messageInfo_SQLEventContext.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SQLEventContext proto.InternalMessageInfo
+
+func (m *SQLEventContext) GetAccountID() uint32 {
+	if m != nil {
+		return m.AccountID
+	}
+	return 0
+}
+
+func (m *SQLEventContext) GetEventID() string {
+	if m != nil {
+		return m.EventID
+	}
+	return ""
+}
+
+type CreateCdcDetails struct {
+	// TaskId is the uuid of the cdc task, which is the key of the task
+	// in mo_catalog.mo_cdc_task and mo_catalog.mo_cdc_watermark.
+	TaskId string `protobuf:"bytes,1,opt,name=TaskId,proto3" json:"TaskId,omitempty"`
+	// TaskName is the name of the cdc task, unique in the account.
+	TaskName             string   `protobuf:"bytes,2,opt,name=TaskName,proto3" json:"TaskName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateCdcDetails) Reset()         { *m = CreateCdcDetails{} }
+func (m *CreateCdcDetails) String() string { return proto.CompactTextString(m) }
+func (*CreateCdcDetails) ProtoMessage()    {}
+func (*CreateCdcDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce5d8dd45b4a91ff, []int{9}
+}
+func (m *CreateCdcDetails) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateCdcDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateCdcDetails.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateCdcDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateCdcDetails.Merge(m, src)
+}
+func (m *CreateCdcDetails) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *CreateCdcDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateCdcDetails.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateCdcDetails proto.InternalMessageInfo
+
+func (m *CreateCdcDetails) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+func (m *CreateCdcDetails) GetTaskName() string {
+	if m != nil {
+		return m.TaskName
+	}
+	return ""
+}
+
 type Details struct {
 	Description string `protobuf:"bytes,1,opt,name=Description,proto3" json:"Description,omitempty"`
 	AccountID   uint32 `protobuf:"varint,2,opt,name=AccountID,proto3" json:"AccountID,omitempty"`
@@ -815,6 +942,7 @@ type Details struct {
 	//
 	//	*Details_Connector
 	//	*Details_Retention
+	//	*Details_CreateCdc
 	Details              isDetails_Details `protobuf_oneof:"Details"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
@@ -825,7 +953,7 @@ func (m *Details) Reset()         { *m = Details{} }
 func (m *Details) String() string { return proto.CompactTextString(m) }
 func (*Details) ProtoMessage()    {}
 func (*Details) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce5d8dd45b4a91ff, []int{8}
+	return fileDescriptor_ce5d8dd45b4a91ff, []int{10}
 }
 func (m *Details) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -866,9 +994,13 @@ type Details_Connector struct {
 type Details_Retention struct {
 	Retention *RetentionDetails `protobuf:"bytes,11,opt,name=Retention,proto3,oneof" json:"Retention,omitempty"`
 }
+type Details_CreateCdc struct {
+	CreateCdc *CreateCdcDetails `protobuf:"bytes,12,opt,name=CreateCdc,proto3,oneof" json:"CreateCdc,omitempty"`
+}
 
 func (*Details_Connector) isDetails_Details() {}
 func (*Details_Retention) isDetails_Details() {}
+func (*Details_CreateCdc) isDetails_Details() {}
 
 func (m *Details) GetDetails() isDetails_Details {
 	if m != nil {
@@ -926,11 +1058,19 @@ func (m *Details) GetRetention() *RetentionDetails {
 	return nil
 }
 
+func (m *Details) GetCreateCdc() *CreateCdcDetails {
+	if x, ok := m.GetDetails().(*Details_CreateCdc); ok {
+		return x.CreateCdc
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Details) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*Details_Connector)(nil),
 		(*Details_Retention)(nil),
+		(*Details_CreateCdc)(nil),
 	}
 }
 
@@ -957,7 +1097,7 @@ func (m *DaemonTask) Reset()         { *m = DaemonTask{} }
 func (m *DaemonTask) String() string { return proto.CompactTextString(m) }
 func (*DaemonTask) ProtoMessage()    {}
 func (*DaemonTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce5d8dd45b4a91ff, []int{9}
+	return fileDescriptor_ce5d8dd45b4a91ff, []int{11}
 }
 func (m *DaemonTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1092,6 +1232,8 @@ func init() {
 	proto.RegisterType((*ConnectorDetails)(nil), "task.ConnectorDetails")
 	proto.RegisterMapType((map[string]string)(nil), "task.ConnectorDetails.OptionsEntry")
 	proto.RegisterType((*RetentionDetails)(nil), "task.RetentionDetails")
+	proto.RegisterType((*SQLEventContext)(nil), "task.SQLEventContext")
+	proto.RegisterType((*CreateCdcDetails)(nil), "task.CreateCdcDetails")
 	proto.RegisterType((*Details)(nil), "task.Details")
 	proto.RegisterType((*DaemonTask)(nil), "task.DaemonTask")
 }
@@ -1099,87 +1241,92 @@ func init() {
 func init() { proto.RegisterFile("task.proto", fileDescriptor_ce5d8dd45b4a91ff) }
 
 var fileDescriptor_ce5d8dd45b4a91ff = []byte{
-	// 1265 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4b, 0x8f, 0x1b, 0xc5,
-	0x16, 0x76, 0xfb, 0xed, 0xe3, 0x47, 0x3a, 0x95, 0xab, 0x51, 0xcb, 0xca, 0x9d, 0x58, 0xbe, 0xb9,
-	0xba, 0xa3, 0x91, 0xae, 0x07, 0x4c, 0x40, 0x24, 0x12, 0x28, 0x13, 0x7b, 0x50, 0x86, 0x64, 0x92,
-	0xa8, 0xc6, 0xb3, 0x61, 0x57, 0x6e, 0x9f, 0x74, 0x1a, 0xdb, 0xd5, 0xa6, 0xba, 0x3a, 0x8c, 0xb7,
-	0x2c, 0x23, 0x16, 0xd9, 0xc1, 0x26, 0x12, 0x7b, 0x7e, 0x04, 0xdb, 0x2c, 0xf3, 0x0b, 0x78, 0x04,
-	0x7e, 0x02, 0x5b, 0x24, 0x54, 0x55, 0xfd, 0xb2, 0x03, 0x48, 0x23, 0x65, 0xd7, 0xe7, 0x3b, 0x8f,
-	0x3e, 0xe7, 0x3b, 0x8f, 0x6e, 0x00, 0xc9, 0xc2, 0xf9, 0x60, 0x25, 0x02, 0x19, 0x90, 0xb2, 0x7a,
-	0xee, 0xfe, 0xdf, 0xf3, 0xe5, 0x93, 0x68, 0x3a, 0x70, 0x83, 0xe5, 0x81, 0x17, 0x78, 0xc1, 0x81,
-	0x56, 0x4e, 0xa3, 0xc7, 0x5a, 0xd2, 0x82, 0x7e, 0x32, 0x4e, 0xdd, 0x6b, 0x5e, 0x10, 0x78, 0x0b,
-	0xcc, 0xac, 0xa4, 0xbf, 0xc4, 0x50, 0xb2, 0xe5, 0x2a, 0x36, 0xe8, 0x2c, 0x51, 0xb2, 0x19, 0x93,
-	0xcc, 0xc8, 0xfd, 0x6f, 0x2c, 0x68, 0x4d, 0x58, 0x38, 0x3f, 0x89, 0x61, 0xd2, 0x81, 0xe2, 0xf1,
-	0xd8, 0xb1, 0x7a, 0xd6, 0x5e, 0x83, 0x16, 0x8f, 0xc7, 0x64, 0x1f, 0xea, 0x47, 0xe7, 0xe8, 0x46,
-	0x32, 0x10, 0x4e, 0xb1, 0x67, 0xed, 0x75, 0x86, 0x9d, 0x81, 0xce, 0x52, 0x79, 0x8d, 0x82, 0x19,
-	0xd2, 0x54, 0x4f, 0x1c, 0xa8, 0x8d, 0x02, 0x2e, 0xf1, 0x5c, 0x3a, 0xa5, 0x9e, 0xb5, 0xd7, 0xa2,
-	0x89, 0x48, 0xde, 0x85, 0xda, 0xc3, 0x95, 0xf4, 0x03, 0x1e, 0x3a, 0xe5, 0x9e, 0xb5, 0xd7, 0x1c,
-	0x5e, 0xce, 0x82, 0xc4, 0x8a, 0x3b, 0xe5, 0x97, 0x3f, 0x5e, 0x2b, 0xd0, 0xc4, 0xae, 0xff, 0x43,
-	0x11, 0x9a, 0x39, 0x35, 0xb9, 0x0e, 0xed, 0x13, 0x76, 0x4e, 0x51, 0x8a, 0xf5, 0x44, 0x15, 0xa5,
-	0x73, 0x6c, 0xd3, 0x4d, 0x50, 0x59, 0x69, 0xe9, 0x98, 0x4b, 0x14, 0x4f, 0xd9, 0x42, 0xe7, 0x5c,
-	0xa2, 0x9b, 0xa0, 0xb2, 0x1a, 0xe3, 0x82, 0xad, 0xc7, 0x91, 0x60, 0x2a, 0xba, 0x4e, 0xb7, 0x44,
-	0x37, 0x41, 0xd2, 0x83, 0xe6, 0x28, 0xe0, 0x6e, 0x24, 0x04, 0x72, 0x77, 0xad, 0x13, 0x6f, 0xd3,
-	0x3c, 0x44, 0xde, 0x87, 0xea, 0x7d, 0x36, 0xc5, 0x45, 0xe8, 0x54, 0x7a, 0xa5, 0xbd, 0xe6, 0xf0,
-	0xdf, 0x6f, 0x54, 0x35, 0x30, 0xfa, 0x23, 0x2e, 0xc5, 0x9a, 0xc6, 0xc6, 0x8a, 0x53, 0x8a, 0x61,
-	0x10, 0x09, 0x17, 0x9d, 0xaa, 0xa6, 0x23, 0xe6, 0x34, 0x41, 0x69, 0xaa, 0xef, 0xde, 0x84, 0x66,
-	0x2e, 0x04, 0xb1, 0xa1, 0x34, 0xc7, 0x75, 0xdc, 0x1f, 0xf5, 0x48, 0xfe, 0x05, 0x95, 0xa7, 0x6c,
-	0x11, 0xa1, 0xae, 0xb4, 0x41, 0x8d, 0x70, 0xab, 0xf8, 0xa1, 0xd5, 0xbf, 0x91, 0xbd, 0x46, 0xf9,
-	0x8d, 0x1e, 0x9d, 0x69, 0xbf, 0x32, 0x55, 0x8f, 0x64, 0x07, 0xaa, 0x27, 0xb8, 0x0c, 0xc4, 0x5a,
-	0x3b, 0x96, 0x69, 0x2c, 0xf5, 0xef, 0x41, 0xdb, 0x34, 0x14, 0x29, 0x86, 0xd1, 0x42, 0x92, 0xeb,
-	0x50, 0x56, 0x7d, 0xd6, 0xbe, 0x9d, 0xa1, 0x9d, 0x66, 0x1a, 0x2d, 0xa4, 0xee, 0xbf, 0xd6, 0xaa,
-	0x34, 0x8e, 0x84, 0x88, 0x87, 0xa4, 0x41, 0x8d, 0xd0, 0xff, 0xbd, 0x08, 0x8d, 0xc3, 0x70, 0xcd,
-	0x5d, 0x45, 0x49, 0x6e, 0xb6, 0xca, 0x7a, 0xb6, 0x6e, 0x40, 0x3d, 0x99, 0x3b, 0xed, 0xd6, 0x1c,
-	0x92, 0x8c, 0xc0, 0x44, 0x13, 0xcf, 0x45, 0x6a, 0x49, 0xfa, 0xd0, 0x7a, 0xc4, 0x04, 0x72, 0xa9,
-	0xac, 0x8e, 0xc7, 0xba, 0x77, 0x0d, 0xba, 0x81, 0x91, 0x3d, 0xa8, 0x9e, 0x4a, 0x26, 0x23, 0x33,
-	0x6e, 0x69, 0xd6, 0x4a, 0x6b, 0x70, 0x1a, 0xeb, 0xc9, 0x2e, 0x80, 0x42, 0x69, 0xc4, 0x39, 0x0a,
-	0xa7, 0xa2, 0x63, 0xe5, 0x10, 0x5d, 0xd7, 0x2a, 0x70, 0x9f, 0xe8, 0x46, 0xb5, 0xa9, 0x11, 0xd4,
-	0x00, 0xdd, 0x67, 0xa1, 0xbc, 0x8b, 0x4c, 0xc8, 0x29, 0x32, 0xe9, 0xd4, 0xcc, 0x00, 0x6d, 0x80,
-	0xa4, 0x0b, 0xf5, 0x91, 0x40, 0x26, 0xf1, 0x50, 0x3a, 0x75, 0x6d, 0x90, 0xca, 0x66, 0xb8, 0x96,
-	0xab, 0x05, 0x4a, 0x9c, 0x1d, 0x4a, 0xa7, 0xa1, 0xd5, 0x79, 0x88, 0xdc, 0xdc, 0x6a, 0x84, 0x03,
-	0x9a, 0xa2, 0x2b, 0xa6, 0x94, 0x0d, 0x15, 0xdd, 0xb4, 0xec, 0xff, 0x66, 0xa9, 0x37, 0x07, 0xfc,
-	0x2d, 0xb2, 0xde, 0x35, 0x11, 0x8f, 0xce, 0x57, 0x22, 0x66, 0x3c, 0x95, 0x95, 0xee, 0x01, 0x9e,
-	0x4b, 0xb5, 0x81, 0x9a, 0xef, 0x12, 0x4d, 0x65, 0xd5, 0xad, 0x89, 0xf0, 0x3d, 0x0f, 0x85, 0xd9,
-	0xda, 0x8a, 0xce, 0x63, 0x03, 0xdb, 0xe0, 0xa9, 0xba, 0xc5, 0x53, 0x17, 0xea, 0x67, 0xab, 0x99,
-	0xd1, 0x19, 0x92, 0x53, 0xb9, 0xff, 0xbd, 0x05, 0xf6, 0x28, 0xe0, 0x1c, 0x5d, 0x19, 0x88, 0x31,
-	0x4a, 0xe6, 0x2f, 0x42, 0x72, 0x15, 0x1a, 0x13, 0x36, 0x5d, 0xe0, 0x03, 0xb6, 0xc4, 0x78, 0x4f,
-	0x32, 0x80, 0x7c, 0x94, 0x1d, 0xa2, 0xa2, 0x5e, 0xd9, 0xff, 0x98, 0xda, 0xb7, 0xc3, 0x0c, 0x62,
-	0x2b, 0xb3, 0xb8, 0x89, 0x4f, 0xf7, 0x16, 0xb4, 0xf2, 0x8a, 0x0b, 0xad, 0x23, 0x01, 0x9b, 0xa2,
-	0x44, 0xae, 0xdc, 0xe3, 0xb7, 0xf4, 0x9f, 0x17, 0xa1, 0x96, 0x24, 0xde, 0x83, 0xe6, 0x18, 0x43,
-	0x57, 0xf8, 0xfa, 0x05, 0x71, 0xcc, 0x3c, 0xa4, 0x4a, 0x3b, 0x74, 0xdd, 0x20, 0xe2, 0xf2, 0x78,
-	0xac, 0xe3, 0xb7, 0x69, 0x06, 0xa8, 0xeb, 0x1b, 0x0b, 0x71, 0x83, 0x12, 0x51, 0x73, 0x18, 0xa2,
-	0xe0, 0x2c, 0xee, 0x4f, 0x83, 0xa6, 0x72, 0xb6, 0xb7, 0x95, 0xdc, 0xde, 0x92, 0x0f, 0xa0, 0x91,
-	0x32, 0x12, 0xcf, 0xdd, 0xce, 0x5f, 0x13, 0x75, 0xb7, 0x40, 0x33, 0x53, 0xe5, 0x97, 0xd6, 0xe8,
-	0x34, 0xf3, 0x7e, 0xdb, 0xa5, 0x2b, 0xbf, 0x14, 0xbb, 0xd3, 0x48, 0x69, 0xe8, 0xff, 0x51, 0x06,
-	0x18, 0x33, 0x5c, 0xbe, 0xd5, 0xe9, 0xdd, 0x60, 0xae, 0xf4, 0x0f, 0xcc, 0x95, 0x37, 0x99, 0xdb,
-	0x87, 0xba, 0x8a, 0x3b, 0x59, 0xaf, 0x50, 0x13, 0xb4, 0xf1, 0xf5, 0x53, 0x28, 0x4d, 0xf5, 0x5b,
-	0x97, 0xa4, 0xfa, 0xc6, 0x25, 0x79, 0xc7, 0xe8, 0xe3, 0xbb, 0x54, 0xfb, 0x9b, 0xbb, 0x94, 0xb3,
-	0x21, 0x9f, 0x6e, 0x5f, 0x99, 0xba, 0x2e, 0xb8, 0x3b, 0x30, 0x5f, 0xf9, 0x41, 0xf2, 0x95, 0x1f,
-	0x4c, 0x92, 0xaf, 0xfc, 0x9d, 0xba, 0x2a, 0xfc, 0xf9, 0x4f, 0xd7, 0xac, 0xed, 0x5b, 0xf4, 0xbf,
-	0x94, 0x61, 0x7d, 0x6b, 0x9a, 0xc3, 0xb6, 0x79, 0x75, 0x0c, 0xd2, 0x74, 0x0c, 0x6f, 0xe7, 0x96,
-	0x11, 0x2e, 0xf0, 0xbe, 0x6c, 0x65, 0x6f, 0xe7, 0x56, 0xb6, 0x79, 0x91, 0x08, 0x89, 0x17, 0xb9,
-	0x05, 0x95, 0x23, 0xae, 0xce, 0x62, 0xeb, 0x02, 0xee, 0xc6, 0x85, 0x7c, 0x0c, 0x35, 0x55, 0x39,
-	0x8d, 0xb8, 0xd3, 0xbe, 0x80, 0x77, 0xe2, 0xb4, 0xff, 0xad, 0x95, 0xef, 0x13, 0x69, 0x42, 0xcd,
-	0x14, 0x36, 0xb3, 0x0b, 0x4a, 0x50, 0xcd, 0xf4, 0xb9, 0x67, 0x5b, 0xa4, 0xad, 0x76, 0x24, 0x3e,
-	0xd7, 0x76, 0x91, 0x00, 0x54, 0x1f, 0xb1, 0x28, 0xc4, 0x99, 0x5d, 0x22, 0x8d, 0x78, 0xa9, 0xec,
-	0x32, 0x69, 0x41, 0x7d, 0xc4, 0xb8, 0x8b, 0x0b, 0x9c, 0xd9, 0x15, 0x72, 0x05, 0x2e, 0xa9, 0x13,
-	0xbd, 0x44, 0x8a, 0x5f, 0x44, 0x18, 0x2a, 0xcf, 0x2a, 0x21, 0xd0, 0xd1, 0x9e, 0x19, 0x56, 0x53,
-	0x86, 0xc6, 0x2d, 0x03, 0xeb, 0xfb, 0x5f, 0x5b, 0x66, 0x1c, 0xf5, 0x07, 0xb7, 0x05, 0xf5, 0x09,
-	0x86, 0xf2, 0x21, 0x5f, 0xac, 0xed, 0x02, 0xe9, 0x00, 0x9c, 0xae, 0x43, 0x89, 0xcb, 0x63, 0xee,
-	0x4b, 0xdb, 0x52, 0x31, 0x4f, 0x50, 0x0a, 0xdf, 0xbd, 0x1f, 0x78, 0x27, 0x28, 0x3c, 0xb4, 0x8b,
-	0x64, 0x07, 0x88, 0xc1, 0x4e, 0x65, 0x20, 0x98, 0x87, 0x67, 0x21, 0xf3, 0xd0, 0x2e, 0x29, 0x3c,
-	0xdd, 0xe0, 0x7b, 0xec, 0xf1, 0x9c, 0x9d, 0xfa, 0x7c, 0x6e, 0x97, 0xc9, 0x25, 0x68, 0x6a, 0xd7,
-	0x87, 0xd3, 0xcf, 0xd1, 0x95, 0x76, 0x45, 0x55, 0x9c, 0xae, 0xac, 0x5d, 0xdd, 0xff, 0x2f, 0x40,
-	0xf6, 0x1b, 0xa0, 0xb8, 0x39, 0x8d, 0x5c, 0x17, 0xc3, 0xd0, 0x2e, 0x28, 0x32, 0x3e, 0x61, 0xbe,
-	0xaa, 0xd9, 0xda, 0xff, 0xca, 0xca, 0x96, 0x88, 0x5c, 0x85, 0xda, 0x19, 0x9f, 0xf3, 0xe0, 0x4b,
-	0x6e, 0x17, 0xba, 0x97, 0x9e, 0xbd, 0xe8, 0x35, 0x15, 0x1c, 0x43, 0x64, 0x08, 0x24, 0x4d, 0x20,
-	0x4d, 0xc9, 0xb6, 0xba, 0xdd, 0x67, 0x2f, 0x7a, 0x3b, 0xca, 0xf0, 0x4d, 0x6d, 0xfc, 0xc7, 0x67,
-	0x92, 0x52, 0x26, 0x76, 0xb1, 0x7b, 0xf9, 0xd9, 0x8b, 0x5e, 0x5b, 0x6f, 0x67, 0x7a, 0x60, 0x46,
-	0xaf, 0x7e, 0xd9, 0xb5, 0x5e, 0xbe, 0xde, 0xb5, 0x5e, 0xbd, 0xde, 0xb5, 0x7e, 0x7e, 0xbd, 0x5b,
-	0xf8, 0xee, 0xd7, 0x5d, 0xeb, 0xb3, 0xfc, 0xdf, 0xf5, 0x92, 0x49, 0xe1, 0x9f, 0x07, 0xc2, 0xf7,
-	0x7c, 0x9e, 0x08, 0x1c, 0x0f, 0x56, 0x73, 0xef, 0x60, 0x35, 0x3d, 0x50, 0xbb, 0x32, 0xad, 0xea,
-	0x09, 0x7a, 0xef, 0xcf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x40, 0xe6, 0xab, 0xe1, 0xa7, 0x0b, 0x00,
-	0x00,
+	// 1354 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcd, 0x6e, 0x1b, 0x55,
+	0x14, 0xce, 0xf8, 0xdf, 0x67, 0xec, 0x64, 0x7a, 0x8b, 0xa2, 0xd1, 0xa8, 0xa4, 0x96, 0x29, 0x22,
+	0x8a, 0x84, 0x03, 0xa1, 0x20, 0x5a, 0x09, 0x44, 0x6a, 0xa7, 0x6a, 0x68, 0xd2, 0x96, 0x9b, 0x64,
+	0xc3, 0xee, 0x7a, 0x7c, 0x3a, 0x1d, 0x62, 0xdf, 0x31, 0x33, 0x77, 0x4a, 0xfc, 0x0a, 0x59, 0xb1,
+	0x83, 0x4d, 0x10, 0x3b, 0x16, 0x3c, 0x04, 0xdb, 0x2e, 0xfb, 0x04, 0xfc, 0x14, 0x1e, 0x81, 0x2d,
+	0x12, 0xba, 0x3f, 0x33, 0x1e, 0xbb, 0x05, 0x29, 0x52, 0x77, 0x73, 0xbe, 0x73, 0xce, 0xbd, 0xe7,
+	0x7c, 0xe7, 0xc7, 0xd7, 0x00, 0x82, 0x25, 0xa7, 0xbd, 0x69, 0x1c, 0x89, 0x88, 0x54, 0xe4, 0xb7,
+	0xf7, 0x6e, 0x10, 0x8a, 0x27, 0xe9, 0xb0, 0xe7, 0x47, 0x93, 0xed, 0x20, 0x0a, 0xa2, 0x6d, 0xa5,
+	0x1c, 0xa6, 0x8f, 0x95, 0xa4, 0x04, 0xf5, 0xa5, 0x9d, 0xbc, 0xeb, 0x41, 0x14, 0x05, 0x63, 0x9c,
+	0x5b, 0x89, 0x70, 0x82, 0x89, 0x60, 0x93, 0xa9, 0x31, 0x58, 0x9d, 0xa0, 0x60, 0x23, 0x26, 0x98,
+	0x96, 0xbb, 0xdf, 0x59, 0xd0, 0x3a, 0x66, 0xc9, 0xe9, 0xa1, 0x81, 0xc9, 0x2a, 0x94, 0xf6, 0x07,
+	0xae, 0xd5, 0xb1, 0x36, 0x9b, 0xb4, 0xb4, 0x3f, 0x20, 0x5b, 0xd0, 0xd8, 0x3b, 0x43, 0x3f, 0x15,
+	0x51, 0xec, 0x96, 0x3a, 0xd6, 0xe6, 0xea, 0xce, 0x6a, 0x4f, 0x45, 0x29, 0xbd, 0xfa, 0xd1, 0x08,
+	0x69, 0xae, 0x27, 0x2e, 0xd4, 0xfb, 0x11, 0x17, 0x78, 0x26, 0xdc, 0x72, 0xc7, 0xda, 0x6c, 0xd1,
+	0x4c, 0x24, 0xef, 0x43, 0xfd, 0xe1, 0x54, 0x84, 0x11, 0x4f, 0xdc, 0x4a, 0xc7, 0xda, 0xb4, 0x77,
+	0xae, 0xcc, 0x0f, 0x31, 0x8a, 0x3b, 0x95, 0x67, 0xbf, 0x5e, 0x5f, 0xa1, 0x99, 0x5d, 0xf7, 0x97,
+	0x12, 0xd8, 0x05, 0x35, 0xb9, 0x01, 0xed, 0x43, 0x76, 0x46, 0x51, 0xc4, 0xb3, 0x63, 0x99, 0x94,
+	0x8a, 0xb1, 0x4d, 0x17, 0x41, 0x69, 0xa5, 0xa4, 0x7d, 0x2e, 0x30, 0x7e, 0xca, 0xc6, 0x2a, 0xe6,
+	0x32, 0x5d, 0x04, 0xa5, 0xd5, 0x00, 0xc7, 0x6c, 0x36, 0x48, 0x63, 0x26, 0x4f, 0x57, 0xe1, 0x96,
+	0xe9, 0x22, 0x48, 0x3a, 0x60, 0xf7, 0x23, 0xee, 0xa7, 0x71, 0x8c, 0xdc, 0x9f, 0xa9, 0xc0, 0xdb,
+	0xb4, 0x08, 0x91, 0x0f, 0xa1, 0x76, 0xc0, 0x86, 0x38, 0x4e, 0xdc, 0x6a, 0xa7, 0xbc, 0x69, 0xef,
+	0xbc, 0xf9, 0x52, 0x56, 0x3d, 0xad, 0xdf, 0xe3, 0x22, 0x9e, 0x51, 0x63, 0x2c, 0x39, 0xa5, 0x98,
+	0x44, 0x69, 0xec, 0xa3, 0x5b, 0x53, 0x74, 0x18, 0x4e, 0x33, 0x94, 0xe6, 0x7a, 0xef, 0x16, 0xd8,
+	0x85, 0x23, 0x88, 0x03, 0xe5, 0x53, 0x9c, 0x99, 0xfa, 0xc8, 0x4f, 0xf2, 0x06, 0x54, 0x9f, 0xb2,
+	0x71, 0x8a, 0x2a, 0xd3, 0x26, 0xd5, 0xc2, 0xed, 0xd2, 0xc7, 0x56, 0xf7, 0xe6, 0xfc, 0x1a, 0xe9,
+	0xd7, 0x7f, 0x74, 0xa2, 0xfc, 0x2a, 0x54, 0x7e, 0x92, 0x75, 0xa8, 0x1d, 0xe2, 0x24, 0x8a, 0x67,
+	0xca, 0xb1, 0x42, 0x8d, 0xd4, 0xbd, 0x0f, 0x6d, 0x5d, 0x50, 0xa4, 0x98, 0xa4, 0x63, 0x41, 0x6e,
+	0x40, 0x45, 0xd6, 0x59, 0xf9, 0xae, 0xee, 0x38, 0x79, 0xa4, 0xe9, 0x58, 0x48, 0x9c, 0x2a, 0xad,
+	0x0c, 0x63, 0x2f, 0x8e, 0x4d, 0x93, 0x34, 0xa9, 0x16, 0xba, 0x7f, 0x97, 0xa0, 0xb9, 0x9b, 0xcc,
+	0xb8, 0x2f, 0x29, 0x29, 0xf4, 0x56, 0x45, 0xf5, 0xd6, 0x4d, 0x68, 0x64, 0x7d, 0xa7, 0xdc, 0xec,
+	0x1d, 0x32, 0x27, 0x30, 0xd3, 0x98, 0xbe, 0xc8, 0x2d, 0x49, 0x17, 0x5a, 0x8f, 0x58, 0x8c, 0x5c,
+	0x48, 0xab, 0xfd, 0x81, 0xaa, 0x5d, 0x93, 0x2e, 0x60, 0x64, 0x13, 0x6a, 0x47, 0x82, 0x89, 0x54,
+	0xb7, 0x5b, 0x1e, 0xb5, 0xd4, 0x6a, 0x9c, 0x1a, 0x3d, 0xd9, 0x00, 0x90, 0x28, 0x4d, 0x39, 0xc7,
+	0xd8, 0xad, 0xaa, 0xb3, 0x0a, 0x88, 0xca, 0x6b, 0x1a, 0xf9, 0x4f, 0x54, 0xa1, 0xda, 0x54, 0x0b,
+	0xb2, 0x81, 0x0e, 0x58, 0x22, 0xee, 0x21, 0x8b, 0xc5, 0x10, 0x99, 0x70, 0xeb, 0xba, 0x81, 0x16,
+	0x40, 0xe2, 0x41, 0xa3, 0x1f, 0x23, 0x13, 0xb8, 0x2b, 0xdc, 0x86, 0x32, 0xc8, 0x65, 0xdd, 0x5c,
+	0x93, 0xe9, 0x18, 0x05, 0x8e, 0x76, 0x85, 0xdb, 0x54, 0xea, 0x22, 0x44, 0x6e, 0x2d, 0x15, 0xc2,
+	0x05, 0x45, 0xd1, 0x55, 0x9d, 0xca, 0x82, 0x8a, 0x2e, 0x5a, 0x76, 0xff, 0xb2, 0xe4, 0xcd, 0x11,
+	0x7f, 0x8d, 0xac, 0x7b, 0xfa, 0xc4, 0xbd, 0xb3, 0x69, 0x6c, 0x18, 0xcf, 0x65, 0xa9, 0x7b, 0x80,
+	0x67, 0x42, 0x4e, 0xa0, 0xe2, 0xbb, 0x4c, 0x73, 0x59, 0x56, 0xeb, 0x38, 0x0e, 0x83, 0x00, 0x63,
+	0x3d, 0xb5, 0x55, 0x15, 0xc7, 0x02, 0xb6, 0xc0, 0x53, 0x6d, 0x89, 0x27, 0x0f, 0x1a, 0x27, 0xd3,
+	0x91, 0xd6, 0x69, 0x92, 0x73, 0xb9, 0xfb, 0xb3, 0x05, 0x4e, 0x3f, 0xe2, 0x1c, 0x7d, 0x11, 0xc5,
+	0x03, 0x14, 0x2c, 0x1c, 0x27, 0xe4, 0x1a, 0x34, 0x8f, 0xd9, 0x70, 0x8c, 0x0f, 0xd8, 0x04, 0xcd,
+	0x9c, 0xcc, 0x01, 0xf2, 0xc9, 0x7c, 0x11, 0x95, 0xd4, 0xc8, 0xbe, 0xa5, 0x73, 0x5f, 0x3e, 0xa6,
+	0x67, 0xac, 0xf4, 0xe0, 0x66, 0x3e, 0xde, 0x6d, 0x68, 0x15, 0x15, 0x97, 0x1a, 0x47, 0x02, 0x0e,
+	0x45, 0x81, 0x5c, 0xba, 0x9b, 0x5b, 0xba, 0xfb, 0xb0, 0x76, 0xf4, 0xc5, 0xc1, 0xde, 0x53, 0xe4,
+	0x22, 0x5b, 0x95, 0xd7, 0xa0, 0xb9, 0xeb, 0xfb, 0x51, 0xca, 0x85, 0xa9, 0x5a, 0x9b, 0xce, 0x01,
+	0xb9, 0x62, 0x95, 0xf5, 0xfe, 0xc0, 0x5c, 0x90, 0x89, 0xdd, 0xbb, 0xe0, 0x68, 0xd2, 0xfa, 0x23,
+	0x3f, 0xe3, 0x62, 0x1d, 0x6a, 0x6a, 0x20, 0x46, 0x26, 0x42, 0x23, 0x49, 0x52, 0xe5, 0x97, 0xa2,
+	0x48, 0x1f, 0x93, 0xcb, 0xdd, 0x67, 0x25, 0xa8, 0x67, 0xfe, 0x1d, 0xb0, 0x07, 0x98, 0xf8, 0x71,
+	0xa8, 0x72, 0x36, 0x87, 0x14, 0xa1, 0xc5, 0x68, 0x4b, 0xaf, 0x88, 0xd6, 0x08, 0xa6, 0x67, 0x32,
+	0x51, 0x95, 0x35, 0xc1, 0x98, 0x33, 0xd3, 0x32, 0x4d, 0x9a, 0xcb, 0xf3, 0x55, 0x52, 0x2d, 0xac,
+	0x12, 0xf2, 0x11, 0x34, 0xf3, 0x22, 0x99, 0x51, 0x58, 0x7f, 0x75, 0xed, 0xee, 0xad, 0xd0, 0xb9,
+	0xa9, 0xf4, 0xcb, 0x69, 0x77, 0xed, 0xa2, 0xdf, 0x72, 0x35, 0xa4, 0x5f, 0x8e, 0xa9, 0xfb, 0x32,
+	0x3e, 0xdd, 0xd6, 0xc2, 0x7d, 0x4b, 0x34, 0xab, 0xfb, 0x32, 0xec, 0x4e, 0x33, 0xa7, 0xaf, 0xfb,
+	0x4f, 0x05, 0x60, 0xc0, 0x70, 0xf2, 0x5a, 0x07, 0x71, 0x81, 0xf1, 0xf2, 0xff, 0x30, 0x5e, 0x59,
+	0x64, 0x7c, 0x4b, 0xd7, 0xfc, 0x78, 0x36, 0x45, 0xb7, 0xba, 0xfc, 0x43, 0x2e, 0x51, 0x9a, 0xeb,
+	0x97, 0x96, 0x62, 0xed, 0xa5, 0xa5, 0xf8, 0x9e, 0xd6, 0x9b, 0x15, 0x5b, 0xff, 0x8f, 0x15, 0x5b,
+	0xb0, 0x21, 0x9f, 0x2f, 0x2f, 0xcc, 0x86, 0x4a, 0xd8, 0xeb, 0xe9, 0x07, 0x4b, 0x2f, 0x7b, 0xb0,
+	0xf4, 0x8e, 0xb3, 0x07, 0xcb, 0x9d, 0x86, 0x4c, 0xfc, 0xdb, 0xdf, 0xae, 0x5b, 0xcb, 0x6b, 0xf5,
+	0x9d, 0x9c, 0x61, 0xb5, 0x36, 0xed, 0x9d, 0xb6, 0xbe, 0xda, 0x80, 0x34, 0xd3, 0x92, 0xcf, 0x0a,
+	0x7b, 0x05, 0x2e, 0x71, 0x5f, 0xee, 0x25, 0x4f, 0xc8, 0xb7, 0x8f, 0x7d, 0x99, 0x13, 0x32, 0x2f,
+	0x72, 0x1b, 0xaa, 0x7b, 0x5c, 0x6e, 0xf8, 0xd6, 0x25, 0xdc, 0xb5, 0x0b, 0xf9, 0x14, 0xea, 0x32,
+	0x73, 0x9a, 0x72, 0xb7, 0x7d, 0x09, 0xef, 0xcc, 0x69, 0xeb, 0x7b, 0xab, 0x58, 0x27, 0x62, 0x43,
+	0x5d, 0x27, 0x36, 0x72, 0x56, 0xa4, 0x20, 0x8b, 0x19, 0xf2, 0xc0, 0xb1, 0x48, 0x1b, 0x9a, 0xf9,
+	0x2f, 0x8f, 0x53, 0x22, 0x00, 0xb5, 0x47, 0x2c, 0x4d, 0x70, 0xe4, 0x94, 0x49, 0xd3, 0x0c, 0xa3,
+	0x53, 0x21, 0x2d, 0x68, 0xf4, 0x19, 0xf7, 0x71, 0x8c, 0x23, 0xa7, 0x4a, 0xae, 0xc2, 0x9a, 0xfc,
+	0xb5, 0x99, 0x20, 0xc5, 0xaf, 0x53, 0x4c, 0xa4, 0x67, 0x8d, 0x10, 0x58, 0x55, 0x9e, 0x73, 0xac,
+	0x2e, 0x0d, 0xb5, 0xdb, 0x1c, 0x6c, 0x6c, 0xfd, 0x64, 0xe9, 0x76, 0x54, 0x6f, 0x87, 0x16, 0x34,
+	0x8e, 0x31, 0x11, 0x0f, 0xf9, 0x78, 0xe6, 0xac, 0x90, 0x55, 0x80, 0xa3, 0x59, 0x22, 0x70, 0xb2,
+	0xcf, 0x43, 0xe1, 0x58, 0xf2, 0xcc, 0x43, 0x14, 0x71, 0xe8, 0x1f, 0x44, 0xc1, 0x21, 0xc6, 0x01,
+	0x3a, 0x25, 0xb2, 0x0e, 0x44, 0x63, 0x47, 0x22, 0x8a, 0x59, 0x80, 0x27, 0x09, 0x0b, 0xd0, 0x29,
+	0x4b, 0x3c, 0x9f, 0xfc, 0xfb, 0xec, 0xf1, 0x29, 0x3b, 0x0a, 0xf9, 0xa9, 0x53, 0x21, 0x6b, 0x60,
+	0x2b, 0xd7, 0x87, 0xc3, 0xaf, 0xd0, 0x17, 0x4e, 0x55, 0x66, 0x9c, 0x8f, 0xba, 0x53, 0x93, 0x6c,
+	0xc8, 0xdb, 0xfa, 0x23, 0xdf, 0xa9, 0xcb, 0x70, 0xb2, 0xa5, 0xec, 0x34, 0xb6, 0xde, 0x06, 0x98,
+	0x3f, 0x76, 0xa4, 0xe1, 0x51, 0xea, 0xfb, 0x98, 0x24, 0xce, 0x8a, 0xe4, 0xe9, 0x2e, 0x0b, 0x25,
+	0x1d, 0xd6, 0xd6, 0x0f, 0xd6, 0x7c, 0xbe, 0xc8, 0x35, 0xa8, 0x9f, 0xf0, 0x53, 0x1e, 0x7d, 0xc3,
+	0x9d, 0x15, 0x6f, 0xed, 0xfc, 0xa2, 0x63, 0x4b, 0xd8, 0x40, 0x64, 0x07, 0x48, 0x1e, 0x5b, 0x1e,
+	0xad, 0x63, 0x79, 0xde, 0xf9, 0x45, 0x67, 0x5d, 0x1a, 0xbe, 0xac, 0x35, 0xef, 0x5a, 0x1d, 0xaf,
+	0x34, 0x71, 0x4a, 0xde, 0x95, 0xf3, 0x8b, 0x4e, 0x5b, 0x7e, 0xe7, 0x0a, 0xe2, 0x15, 0x76, 0x96,
+	0x53, 0xf6, 0xec, 0xf3, 0x8b, 0x4e, 0x5d, 0x5a, 0xc8, 0xbd, 0xd4, 0x7f, 0xfe, 0xc7, 0x86, 0xf5,
+	0xec, 0xc5, 0x86, 0xf5, 0xfc, 0xc5, 0x86, 0xf5, 0xfb, 0x8b, 0x8d, 0x95, 0x1f, 0xff, 0xdc, 0xb0,
+	0xbe, 0x2c, 0xfe, 0xbf, 0x98, 0x30, 0x11, 0x87, 0x67, 0x51, 0x1c, 0x06, 0x21, 0xcf, 0x04, 0x8e,
+	0xdb, 0xd3, 0xd3, 0x60, 0x7b, 0x3a, 0xdc, 0x96, 0x23, 0x36, 0xac, 0xa9, 0xc6, 0xfb, 0xe0, 0xdf,
+	0x01, 0x00, 0x92, 0x86, 0xe9, 0x04, 0xa9, 0x0c, 0x00, 0x00,
 }
 
 func (m *TaskMetadata) Marshal() (dAtA []byte, err error) {
@@ -1634,6 +1781,86 @@ func (m *RetentionDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SQLEventContext) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SQLEventContext) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SQLEventContext) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.EventID) > 0 {
+		i -= len(m.EventID)
+		copy(dAtA[i:], m.EventID)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.EventID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AccountID != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.AccountID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateCdcDetails) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateCdcDetails) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateCdcDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.TaskName) > 0 {
+		i -= len(m.TaskName)
+		copy(dAtA[i:], m.TaskName)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.TaskName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Details) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -1745,6 +1972,27 @@ func (m *Details_Retention) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Details_CreateCdc) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Details_CreateCdc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CreateCdc != nil {
+		{
+			size, err := m.CreateCdc.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTask(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	return len(dAtA) - i, nil
+}
 func (m *DaemonTask) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -1769,37 +2017,37 @@ func (m *DaemonTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastRun, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastRun):])
-	if err8 != nil {
-		return 0, err8
-	}
-	i -= n8
-	i = encodeVarintTask(dAtA, i, uint64(n8))
-	i--
-	dAtA[i] = 0x6a
-	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndAt):])
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastRun, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastRun):])
 	if err9 != nil {
 		return 0, err9
 	}
 	i -= n9
 	i = encodeVarintTask(dAtA, i, uint64(n9))
 	i--
-	dAtA[i] = 0x62
-	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdateAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdateAt):])
+	dAtA[i] = 0x6a
+	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndAt):])
 	if err10 != nil {
 		return 0, err10
 	}
 	i -= n10
 	i = encodeVarintTask(dAtA, i, uint64(n10))
 	i--
-	dAtA[i] = 0x5a
-	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreateAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreateAt):])
+	dAtA[i] = 0x62
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdateAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdateAt):])
 	if err11 != nil {
 		return 0, err11
 	}
 	i -= n11
 	i = encodeVarintTask(dAtA, i, uint64(n11))
 	i--
+	dAtA[i] = 0x5a
+	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreateAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreateAt):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintTask(dAtA, i, uint64(n12))
+	i--
 	dAtA[i] = 0x52
 	if m.Details != nil {
 		{
@@ -1813,12 +2061,12 @@ func (m *DaemonTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x4a
 	}
-	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastHeartbeat, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastHeartbeat):])
-	if err13 != nil {
-		return 0, err13
+	n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastHeartbeat, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastHeartbeat):])
+	if err14 != nil {
+		return 0, err14
 	}
-	i -= n13
-	i = encodeVarintTask(dAtA, i, uint64(n13))
+	i -= n14
+	i = encodeVarintTask(dAtA, i, uint64(n14))
 	i--
 	dAtA[i] = 0x42
 	if m.TaskStatus != 0 {
@@ -2090,6 +2338,45 @@ func (m *RetentionDetails) ProtoSize() (n int) {
 	return n
 }
 
+func (m *SQLEventContext) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AccountID != 0 {
+		n += 1 + sovTask(uint64(m.AccountID))
+	}
+	l = len(m.EventID)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateCdcDetails) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	l = len(m.TaskName)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *Details) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -2148,6 +2435,18 @@ func (m *Details_Retention) ProtoSize() (n int) {
 	}
 	return n
 }
+func (m *Details_CreateCdc) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CreateCdc != nil {
+		l = m.CreateCdc.ProtoSize()
+		n += 1 + l + sovTask(uint64(l))
+	}
+	return n
+}
 func (m *DaemonTask) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -3622,6 +3921,223 @@ func (m *RetentionDetails) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SQLEventContext) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SQLEventContext: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SQLEventContext: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountID", wireType)
+			}
+			m.AccountID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateCdcDetails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateCdcDetails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateCdcDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Details) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3868,6 +4384,41 @@ func (m *Details) Unmarshal(dAtA []byte) error {
 			}
 			m.Details = &Details_Retention{v}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateCdc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &CreateCdcDetails{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Details = &Details_CreateCdc{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTask(dAtA[iNdEx:])
@@ -301,6 +301,11 @@ func (m TxnMeta) IsRCIsolation() bool {
 	return m.Isolation == TxnIsolation_RC
 }
 
+// IsSSIIsolation returns true if txn is in serializable snapshot isolation
+func (m TxnMeta) IsSSIIsolation() bool {
+	return m.Isolation == TxnIsolation_SSI
+}
+
 // IsPessimistic returns true if txn is in pessimistic mode
 func (m TxnMeta) IsPessimistic() bool {
 	return m.Mode == TxnMode_Pessimistic
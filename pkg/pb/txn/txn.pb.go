@@ -35,16 +35,20 @@ const (
 	TxnIsolation_SI TxnIsolation = 0
 	// RC read committed
 	TxnIsolation_RC TxnIsolation = 1
+	// SSI serializable snapshot isolation
+	TxnIsolation_SSI TxnIsolation = 2
 )
 
 var TxnIsolation_name = map[int32]string{
 	0: "SI",
 	1: "RC",
+	2: "SSI",
 }
 
 var TxnIsolation_value = map[string]int32{
-	"SI": 0,
-	"RC": 1,
+	"SI":  0,
+	"RC":  1,
+	"SSI": 2,
 }
 
 func (x TxnIsolation) String() string {
@@ -1867,104 +1871,104 @@ func init() {
 func init() { proto.RegisterFile("txn.proto", fileDescriptor_4f782e76b37adb9a) }
 
 var fileDescriptor_4f782e76b37adb9a = []byte{
-	// 1548 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0xdd, 0x6e, 0xdb, 0x46,
-	0x16, 0x36, 0xf5, 0x4b, 0x1e, 0xfd, 0x98, 0x9e, 0x38, 0x0e, 0xe3, 0xcd, 0x2a, 0x02, 0x11, 0x04,
-	0x8a, 0x91, 0xb5, 0x36, 0x09, 0xb2, 0x17, 0xbb, 0x40, 0x00, 0x5b, 0xb6, 0xb3, 0x06, 0x62, 0xd9,
-	0x18, 0x29, 0xbb, 0x48, 0x6f, 0x0a, 0x4a, 0x9a, 0xc8, 0x84, 0x25, 0x52, 0x21, 0x29, 0x43, 0x7e,
-	0x88, 0x3e, 0x41, 0x6f, 0xfa, 0x38, 0xb9, 0xcc, 0x13, 0x14, 0x6d, 0x8a, 0xde, 0xf4, 0xb6, 0x2f,
-	0x50, 0xcc, 0xe1, 0x0c, 0xff, 0x24, 0x25, 0x85, 0x7b, 0x25, 0x9e, 0xbf, 0x6f, 0x66, 0xce, 0x9c,
-	0xef, 0xcc, 0x8c, 0x40, 0x0b, 0x16, 0xce, 0xfe, 0xcc, 0x73, 0x03, 0x97, 0xe4, 0x83, 0x85, 0xb3,
-	0xfb, 0x8f, 0xb1, 0x1d, 0x5c, 0xce, 0x07, 0xfb, 0x43, 0x77, 0xda, 0x1e, 0xbb, 0x63, 0xb7, 0x8d,
-	0xb6, 0xc1, 0xfc, 0x3d, 0x4a, 0x28, 0xe0, 0x57, 0x18, 0xb3, 0xbb, 0x19, 0xd8, 0x53, 0xe6, 0x07,
-	0xd6, 0x74, 0x26, 0x14, 0xf5, 0x29, 0x0b, 0xac, 0x91, 0x15, 0x58, 0x42, 0x86, 0x89, 0x3b, 0xbc,
-	0x0a, 0xbf, 0xcd, 0xdf, 0xf2, 0x50, 0xee, 0x2f, 0x9c, 0x33, 0x16, 0x58, 0xa4, 0x0e, 0xb9, 0xd3,
-	0x23, 0x43, 0x69, 0x2a, 0xad, 0x2a, 0xcd, 0x9d, 0x1e, 0x91, 0xc7, 0x50, 0xea, 0x05, 0x56, 0x30,
-	0xf7, 0x8d, 0x5c, 0x53, 0x69, 0xd5, 0x9f, 0xd7, 0xf7, 0xf9, 0xc4, 0xfa, 0x0b, 0x27, 0xd4, 0x52,
-	0x61, 0x25, 0xff, 0x06, 0xe8, 0x39, 0xd6, 0xcc, 0xbf, 0x74, 0x83, 0x7e, 0xcf, 0xc8, 0x37, 0x95,
-	0x56, 0xe5, 0xf9, 0xf6, 0x7e, 0x3c, 0x8b, 0xbe, 0xfc, 0x3a, 0x2c, 0x7c, 0xfc, 0xf1, 0xe1, 0x06,
-	0x4d, 0x78, 0xf3, 0xd8, 0x0b, 0x8f, 0xcd, 0x2c, 0x8f, 0x8d, 0xfa, 0x3d, 0xa3, 0xf0, 0xf5, 0xd8,
-	0xd8, 0x9b, 0xfc, 0x0b, 0xd4, 0x8e, 0x3b, 0x9d, 0xda, 0x7c, 0xd4, 0xe2, 0x57, 0x23, 0x23, 0x5f,
-	0xf2, 0x02, 0xd4, 0x7e, 0xb7, 0x77, 0x69, 0x79, 0x23, 0xdf, 0x28, 0x35, 0xf3, 0xad, 0xca, 0xf3,
-	0xad, 0xfd, 0x28, 0x45, 0xc2, 0x22, 0x83, 0xa4, 0x23, 0x79, 0x09, 0xf0, 0xc6, 0x1d, 0x5e, 0xf5,
-	0xad, 0xc1, 0x84, 0xf9, 0x46, 0x19, 0xc3, 0x36, 0xf7, 0x31, 0x93, 0x91, 0x5e, 0xce, 0x31, 0x76,
-	0x24, 0x4d, 0x28, 0x9c, 0xb9, 0x23, 0x66, 0xa8, 0x98, 0xc1, 0xaa, 0xcc, 0x20, 0xd7, 0x51, 0xb4,
-	0x90, 0x36, 0x68, 0xa7, 0xbe, 0x3b, 0xb1, 0x02, 0xdb, 0x75, 0x0c, 0x0d, 0xdd, 0xb6, 0xa4, 0x5b,
-	0x64, 0xa0, 0xb1, 0x0f, 0xd9, 0x81, 0xd2, 0x99, 0xed, 0x79, 0xae, 0x67, 0x40, 0x53, 0x69, 0xa9,
-	0x54, 0x48, 0xa4, 0x09, 0x15, 0x3e, 0x70, 0x8f, 0x79, 0xd7, 0xf6, 0x90, 0x19, 0x95, 0xa6, 0xd2,
-	0xd2, 0x68, 0x52, 0x65, 0x7e, 0x97, 0x83, 0x5a, 0xa7, 0xcb, 0x37, 0x50, 0x6c, 0x00, 0x79, 0x04,
-	0xf9, 0xfe, 0xc2, 0xc1, 0x3d, 0xaf, 0x24, 0x66, 0xc7, 0x02, 0x4b, 0xac, 0x85, 0x9b, 0xc9, 0x03,
-	0xd0, 0x28, 0xb3, 0x46, 0x37, 0xe7, 0xce, 0xe4, 0x06, 0x6b, 0x41, 0xa5, 0xb1, 0x82, 0xec, 0x81,
-	0x7e, 0xec, 0xf0, 0xd5, 0x76, 0xac, 0xe1, 0x25, 0xfb, 0xbf, 0x67, 0x07, 0x0c, 0x8b, 0x40, 0xa5,
-	0x4b, 0x7a, 0xf2, 0x08, 0x6a, 0x47, 0xb6, 0xcf, 0x95, 0xcf, 0x2e, 0x3a, 0xe7, 0xb3, 0x00, 0x77,
-	0x5c, 0xa5, 0x69, 0x65, 0x26, 0xd7, 0xc5, 0x3f, 0x9b, 0xeb, 0x36, 0x94, 0xcf, 0x67, 0x3c, 0x45,
-	0x7c, 0x5b, 0x15, 0x8c, 0x11, 0x0b, 0x12, 0x6a, 0x11, 0x23, 0xbd, 0xcc, 0x19, 0x54, 0x3a, 0xdd,
-	0xf3, 0x19, 0x65, 0x1f, 0xe6, 0xcc, 0x0f, 0x78, 0x62, 0xcf, 0x67, 0x1d, 0xbe, 0x5b, 0x3c, 0x1f,
-	0x35, 0x2a, 0x24, 0x62, 0x40, 0xf9, 0xc2, 0xba, 0x99, 0xb8, 0xd6, 0x08, 0x17, 0x5f, 0xa5, 0x52,
-	0x24, 0x6d, 0x28, 0xf5, 0x2d, 0x6f, 0xcc, 0x02, 0x51, 0xf5, 0x6b, 0xeb, 0x48, 0xb8, 0x99, 0x2d,
-	0xa8, 0x86, 0x23, 0xfa, 0x33, 0xd7, 0xf1, 0x53, 0xd0, 0x4a, 0x0a, 0xda, 0xfc, 0xb5, 0x08, 0xd0,
-	0x5f, 0x38, 0x72, 0x6e, 0xb8, 0x05, 0xf8, 0x29, 0x28, 0x5a, 0xa0, 0xb1, 0x42, 0x6e, 0x63, 0xee,
-	0xcb, 0xdb, 0xf8, 0x18, 0x4a, 0x67, 0x2c, 0xb8, 0x74, 0x47, 0x38, 0xdb, 0x04, 0x9f, 0x43, 0x2d,
-	0x15, 0x56, 0x42, 0xa0, 0x70, 0x32, 0xb1, 0xc6, 0xb8, 0x37, 0x35, 0x8a, 0xdf, 0x64, 0x1f, 0xb4,
-	0x4e, 0x57, 0x0c, 0x28, 0xc8, 0xa6, 0x63, 0x78, 0x22, 0x81, 0x34, 0x76, 0x21, 0xff, 0x81, 0x5a,
-	0xc8, 0x37, 0x19, 0x13, 0xee, 0xc8, 0x5d, 0x39, 0x64, 0xca, 0x48, 0xd3, 0xbe, 0xe4, 0x00, 0x36,
-	0xa9, 0x3b, 0x99, 0x0c, 0xac, 0xe1, 0x95, 0x0c, 0x2f, 0x63, 0xf8, 0x3d, 0x19, 0x9e, 0x31, 0xd3,
-	0xac, 0x3f, 0x79, 0x05, 0x75, 0xd1, 0x29, 0x24, 0x82, 0x8a, 0x08, 0x3b, 0x12, 0x21, 0x6d, 0xa5,
-	0x19, 0x6f, 0x72, 0x04, 0xfa, 0x6b, 0x16, 0x88, 0x46, 0x27, 0x10, 0x34, 0x44, 0x30, 0x24, 0x42,
-	0xd6, 0x4e, 0x97, 0x22, 0xc8, 0x05, 0x6c, 0x8b, 0xae, 0x13, 0x56, 0x83, 0x44, 0x02, 0x44, 0x7a,
-	0x90, 0x4e, 0x46, 0xda, 0x87, 0xae, 0x8c, 0x24, 0xff, 0x83, 0x1d, 0xb9, 0xd4, 0x0c, 0x66, 0x05,
-	0x31, 0x1b, 0xd9, 0x0c, 0x65, 0x50, 0xd7, 0x44, 0x93, 0x63, 0xa8, 0x53, 0x36, 0x75, 0xaf, 0xd9,
-	0x99, 0x28, 0x60, 0xa3, 0x8a, 0x78, 0x7f, 0x8f, 0xf0, 0x52, 0xd6, 0x28, 0x6d, 0x69, 0x35, 0xf9,
-	0x67, 0x4c, 0xc1, 0x5a, 0x3a, 0xdf, 0x22, 0x42, 0x58, 0x63, 0x0e, 0xbe, 0x83, 0xad, 0x25, 0x2b,
-	0x69, 0x00, 0x50, 0x16, 0x78, 0x37, 0x9c, 0x7e, 0xbe, 0xa1, 0x34, 0xf3, 0xad, 0x22, 0x4d, 0x68,
-	0x78, 0x1b, 0x41, 0xe9, 0xd4, 0x09, 0x98, 0x77, 0x6d, 0x4d, 0xb0, 0xf2, 0xf3, 0x34, 0xad, 0x34,
-	0x7f, 0x2f, 0x42, 0x05, 0xb1, 0x05, 0xd9, 0xbe, 0xcc, 0xa1, 0xc6, 0x5a, 0x0e, 0xfd, 0x75, 0xf6,
-	0x3c, 0x01, 0xb5, 0xbf, 0x70, 0x8e, 0xb1, 0x69, 0x87, 0xe4, 0xa9, 0xc9, 0x68, 0x54, 0xd2, 0xc8,
-	0x4c, 0x5e, 0xa6, 0x3b, 0x84, 0xe0, 0xcd, 0x56, 0x82, 0x6b, 0xa1, 0x81, 0xa6, 0x1b, 0xc9, 0x2b,
-	0xa8, 0x4b, 0x0e, 0x89, 0xc0, 0x72, 0x3a, 0xff, 0x69, 0x2b, 0xcd, 0x78, 0xf3, 0x7a, 0x8f, 0x29,
-	0x24, 0x10, 0xd4, 0x74, 0xbd, 0x67, 0xed, 0x74, 0x29, 0x82, 0x13, 0x37, 0xe2, 0x91, 0x00, 0xd1,
-	0xd2, 0xc4, 0xcd, 0x98, 0x69, 0xd6, 0x9f, 0xbc, 0x86, 0xad, 0x04, 0x8d, 0x04, 0x48, 0xc8, 0x97,
-	0xfb, 0x2b, 0x98, 0x27, 0x60, 0x96, 0x63, 0x48, 0x0f, 0xee, 0x66, 0x18, 0x24, 0xc0, 0x2a, 0xe9,
-	0xc2, 0x5e, 0xe9, 0x44, 0x57, 0xc7, 0x92, 0x77, 0x70, 0x6f, 0x89, 0x40, 0x02, 0x36, 0xe4, 0xcb,
-	0xc3, 0xb5, 0xfc, 0x13, 0xc0, 0xeb, 0xe2, 0xc9, 0xc9, 0x12, 0x03, 0x6b, 0x19, 0x46, 0x67, 0x18,
-	0x28, 0x77, 0x32, 0xad, 0x37, 0x87, 0xa0, 0x67, 0xfb, 0x2b, 0x79, 0x92, 0x3c, 0x66, 0xf2, 0xc9,
-	0x93, 0x51, 0x12, 0x39, 0x3a, 0xd2, 0x96, 0x4e, 0xe8, 0xdc, 0x8a, 0x13, 0xda, 0x3c, 0x40, 0xd6,
-	0x66, 0x6a, 0xe8, 0x29, 0x6c, 0x9d, 0x3a, 0xd7, 0xd6, 0xc4, 0x1e, 0x25, 0x4e, 0x6f, 0x3e, 0x5e,
-	0x81, 0x2e, 0x1b, 0xcc, 0x6d, 0x20, 0xcb, 0x8d, 0xdc, 0xbc, 0x0b, 0x77, 0x56, 0x94, 0x9a, 0x79,
-	0x82, 0xe3, 0x65, 0x7a, 0xf4, 0x33, 0x28, 0x8b, 0x24, 0x8a, 0x0b, 0xcc, 0xda, 0xe3, 0x57, 0xfa,
-	0x89, 0x41, 0x33, 0x35, 0x67, 0xfe, 0x17, 0x07, 0x5d, 0xea, 0xde, 0xb7, 0xc0, 0xdf, 0x81, 0xed,
-	0x55, 0xf5, 0x69, 0xbe, 0x81, 0x7b, 0x6b, 0xfa, 0xfc, 0x6d, 0x46, 0xd9, 0x05, 0x63, 0x5d, 0xe1,
-	0x9a, 0x5d, 0xb8, 0xbf, 0xb6, 0xfb, 0xdf, 0x66, 0xac, 0x07, 0xb0, 0xbb, 0xbe, 0x9a, 0xcd, 0x33,
-	0x9c, 0xc9, 0xca, 0xb3, 0xe1, 0x36, 0x83, 0xfd, 0x2d, 0x9c, 0xfc, 0xca, 0x42, 0x37, 0xfb, 0x71,
-	0x13, 0xe5, 0x4d, 0x36, 0x71, 0x51, 0xc3, 0x6f, 0xb2, 0x0d, 0xc5, 0xb0, 0xc3, 0x86, 0x97, 0xb4,
-	0x50, 0xe0, 0x47, 0x49, 0x18, 0x85, 0xfe, 0x79, 0xf4, 0x4f, 0x68, 0xf8, 0x03, 0x08, 0xe2, 0x1b,
-	0x22, 0xd9, 0x05, 0xf5, 0x84, 0x59, 0xc1, 0xdc, 0xc3, 0xd2, 0xe5, 0xce, 0x91, 0xcc, 0xdf, 0x47,
-	0x9d, 0x2e, 0xa2, 0x6b, 0x34, 0xd7, 0xe9, 0xf2, 0xf3, 0xa4, 0xc7, 0x7c, 0xdf, 0x76, 0x9d, 0xd3,
-	0x23, 0x44, 0xd6, 0x68, 0xac, 0xe0, 0xd6, 0x83, 0xe1, 0xd0, 0x9d, 0x3b, 0xfc, 0xb4, 0x09, 0x0f,
-	0x83, 0x58, 0x41, 0x4c, 0xa8, 0x76, 0x5c, 0xc7, 0x61, 0xc3, 0x20, 0x0c, 0x2f, 0xa2, 0x43, 0x4a,
-	0xc7, 0xe7, 0xf2, 0xd6, 0x67, 0x5e, 0xd7, 0x9a, 0x86, 0xc7, 0x80, 0x46, 0x23, 0x99, 0x3c, 0x86,
-	0x7a, 0xef, 0xca, 0x9e, 0x65, 0x9e, 0x24, 0x05, 0x9a, 0xd1, 0x92, 0x57, 0x40, 0x52, 0x9a, 0x33,
-	0x3c, 0x51, 0xd5, 0x66, 0x1e, 0x4f, 0xb0, 0xe8, 0x4a, 0x8d, 0xef, 0x91, 0x15, 0x9e, 0xfc, 0x82,
-	0x8a, 0x53, 0x66, 0x1e, 0x76, 0x72, 0x8d, 0x4a, 0x91, 0x3f, 0x37, 0x7c, 0xb1, 0x58, 0xe7, 0xbd,
-	0x8b, 0x2d, 0x5a, 0xa3, 0x49, 0x15, 0x9f, 0xbf, 0xed, 0xd0, 0xb9, 0xd3, 0xfb, 0x30, 0xc1, 0xa6,
-	0xab, 0xd2, 0x48, 0x0e, 0x6d, 0x61, 0x05, 0x63, 0xe7, 0x44, 0x5b, 0x28, 0xf3, 0x2d, 0xb3, 0xa3,
-	0x8a, 0xc3, 0x2e, 0xa8, 0xd2, 0x84, 0x86, 0xcf, 0x69, 0x70, 0x73, 0xc8, 0xc6, 0xb6, 0x63, 0xd4,
-	0xd1, 0x28, 0x45, 0x1e, 0x69, 0xcd, 0x03, 0x77, 0x18, 0xe2, 0x6e, 0x86, 0x91, 0xb1, 0x66, 0xaf,
-	0x01, 0xd5, 0xe4, 0xab, 0x8a, 0x94, 0x20, 0xd7, 0x3b, 0xd5, 0x37, 0xf8, 0x2f, 0xed, 0xe8, 0xca,
-	0xde, 0x5e, 0xf8, 0x18, 0xe6, 0xd5, 0x54, 0x07, 0xe0, 0x35, 0x31, 0xb5, 0xfd, 0xc0, 0x1e, 0xea,
-	0x1b, 0x64, 0x13, 0x2a, 0x17, 0x7c, 0x6d, 0x42, 0xa1, 0xec, 0x7d, 0x0b, 0x5a, 0xf4, 0x14, 0x26,
-	0x00, 0xa5, 0x83, 0x61, 0x60, 0x5f, 0x33, 0x7d, 0x83, 0x54, 0x41, 0x95, 0x8f, 0x54, 0x5d, 0xe1,
-	0x38, 0xe1, 0xb2, 0x02, 0xdb, 0x19, 0xeb, 0x39, 0x52, 0x03, 0x4d, 0xc8, 0x6c, 0xa4, 0xe7, 0xb9,
-	0xf3, 0xc1, 0xc0, 0xf5, 0xd0, 0x58, 0x20, 0x15, 0x28, 0xa3, 0xc4, 0x46, 0x7a, 0x71, 0xef, 0x7b,
-	0x05, 0x47, 0x10, 0xd7, 0x0a, 0x15, 0x0a, 0xfc, 0xc9, 0xa5, 0x6f, 0x10, 0x0d, 0x8a, 0xf8, 0x98,
-	0xd2, 0x15, 0x3e, 0x6c, 0x08, 0xa6, 0xe7, 0x38, 0x92, 0xcc, 0x90, 0x9e, 0xe7, 0x48, 0x62, 0x12,
-	0x7a, 0x81, 0x8f, 0x19, 0xb5, 0x24, 0xbd, 0x48, 0xb6, 0xe4, 0xdd, 0x5c, 0xd0, 0x4e, 0x2f, 0x91,
-	0x3b, 0xf1, 0x8d, 0x5b, 0x2a, 0xcb, 0x44, 0x87, 0xaa, 0xa4, 0x22, 0x27, 0xa2, 0xae, 0xf2, 0xa1,
-	0x8f, 0x8e, 0x0f, 0xdf, 0xbe, 0xd6, 0xb5, 0xc3, 0xc3, 0x4f, 0x3f, 0x37, 0x94, 0x8f, 0x9f, 0x1b,
-	0xca, 0xa7, 0xcf, 0x0d, 0xe5, 0xa7, 0xcf, 0x8d, 0x8d, 0x1f, 0x7e, 0x69, 0x28, 0xdf, 0x3c, 0x4d,
-	0xfc, 0x55, 0x31, 0xb5, 0x02, 0xcf, 0x5e, 0xb8, 0x9e, 0x3d, 0xb6, 0x1d, 0x29, 0x38, 0xac, 0x3d,
-	0xbb, 0x1a, 0xb7, 0x67, 0x83, 0x76, 0xb0, 0x70, 0x06, 0x25, 0xfc, 0x0f, 0xe2, 0xc5, 0x1f, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x1a, 0x55, 0x5c, 0x8d, 0xf1, 0x10, 0x00, 0x00,
+	// 1547 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0xcb, 0x6e, 0xdb, 0x46,
+	0x17, 0x36, 0x75, 0x25, 0x8f, 0x2e, 0xa6, 0x26, 0x8e, 0xc3, 0xf8, 0xcf, 0xaf, 0x08, 0x44, 0x90,
+	0x5f, 0x31, 0xf2, 0x5b, 0x4d, 0x82, 0x74, 0xd1, 0x02, 0x01, 0x6c, 0xd9, 0x4e, 0x05, 0x44, 0xb2,
+	0x31, 0x52, 0x5a, 0xa4, 0x9b, 0x82, 0x92, 0x26, 0x32, 0x61, 0x89, 0x54, 0xc8, 0x91, 0x21, 0x3f,
+	0x44, 0x9f, 0xa0, 0x9b, 0x3e, 0x4e, 0x96, 0x79, 0x82, 0xa2, 0x4d, 0xd1, 0x4d, 0xb7, 0x7d, 0x81,
+	0x62, 0x86, 0x33, 0xbc, 0x49, 0x4a, 0x0a, 0x77, 0x65, 0x9d, 0xdb, 0x77, 0x66, 0xce, 0x9c, 0xef,
+	0x0c, 0xc7, 0xa0, 0xd1, 0xa5, 0x73, 0x30, 0xf7, 0x5c, 0xea, 0xa2, 0x2c, 0x5d, 0x3a, 0x7b, 0xff,
+	0x9f, 0xd8, 0xf4, 0x62, 0x31, 0x3c, 0x18, 0xb9, 0xb3, 0xd6, 0xc4, 0x9d, 0xb8, 0x2d, 0x6e, 0x1b,
+	0x2e, 0xde, 0x72, 0x89, 0x0b, 0xfc, 0x57, 0x10, 0xb3, 0xb7, 0x4d, 0xed, 0x19, 0xf1, 0xa9, 0x35,
+	0x9b, 0x0b, 0x45, 0x75, 0x46, 0xa8, 0x35, 0xb6, 0xa8, 0x25, 0x64, 0x98, 0xba, 0xa3, 0xcb, 0xe0,
+	0xb7, 0xf9, 0x67, 0x16, 0x8a, 0x83, 0xa5, 0xd3, 0x25, 0xd4, 0x42, 0x55, 0xc8, 0x74, 0x8e, 0x0d,
+	0xa5, 0xa1, 0x34, 0xcb, 0x38, 0xd3, 0x39, 0x46, 0x0f, 0xa1, 0xd0, 0xa7, 0x16, 0x5d, 0xf8, 0x46,
+	0xa6, 0xa1, 0x34, 0xab, 0x4f, 0xab, 0x07, 0x6c, 0x61, 0x83, 0xa5, 0x13, 0x68, 0xb1, 0xb0, 0xa2,
+	0xaf, 0x00, 0xfa, 0x8e, 0x35, 0xf7, 0x2f, 0x5c, 0x3a, 0xe8, 0x1b, 0xd9, 0x86, 0xd2, 0x2c, 0x3d,
+	0xdd, 0x39, 0x88, 0x56, 0x31, 0x90, 0xbf, 0x8e, 0x72, 0xef, 0x7f, 0xb9, 0xbf, 0x85, 0x63, 0xde,
+	0x2c, 0xf6, 0xdc, 0x23, 0x73, 0xcb, 0x23, 0xe3, 0x41, 0xdf, 0xc8, 0x7d, 0x3e, 0x36, 0xf2, 0x46,
+	0x5f, 0x82, 0xda, 0x76, 0x67, 0x33, 0x9b, 0x65, 0xcd, 0x7f, 0x36, 0x32, 0xf4, 0x45, 0xcf, 0x40,
+	0x1d, 0xf4, 0xfa, 0x17, 0x96, 0x37, 0xf6, 0x8d, 0x42, 0x23, 0xdb, 0x2c, 0x3d, 0xad, 0x1d, 0x84,
+	0x25, 0x12, 0x16, 0x19, 0x24, 0x1d, 0xd1, 0x73, 0x80, 0x57, 0xee, 0xe8, 0x72, 0x60, 0x0d, 0xa7,
+	0xc4, 0x37, 0x8a, 0x3c, 0x6c, 0xfb, 0x80, 0x57, 0x32, 0xd4, 0xcb, 0x35, 0x46, 0x8e, 0xa8, 0x01,
+	0xb9, 0xae, 0x3b, 0x26, 0x86, 0xca, 0x2b, 0x58, 0x96, 0x15, 0x64, 0x3a, 0xcc, 0x2d, 0xa8, 0x05,
+	0x5a, 0xc7, 0x77, 0xa7, 0x16, 0xb5, 0x5d, 0xc7, 0xd0, 0xb8, 0x5b, 0x4d, 0xba, 0x85, 0x06, 0x1c,
+	0xf9, 0xa0, 0x5d, 0x28, 0x74, 0x6d, 0xcf, 0x73, 0x3d, 0x03, 0x1a, 0x4a, 0x53, 0xc5, 0x42, 0x42,
+	0x0d, 0x28, 0xb1, 0xc4, 0x7d, 0xe2, 0x5d, 0xd9, 0x23, 0x62, 0x94, 0x1a, 0x4a, 0x53, 0xc3, 0x71,
+	0x95, 0xf9, 0x63, 0x06, 0x2a, 0xed, 0x1e, 0x3b, 0x40, 0x71, 0x00, 0xe8, 0x01, 0x64, 0x07, 0x4b,
+	0x87, 0x9f, 0x79, 0x29, 0xb6, 0x3a, 0x42, 0x2d, 0xb1, 0x17, 0x66, 0x46, 0xf7, 0x40, 0xc3, 0xc4,
+	0x1a, 0x5f, 0x9f, 0x39, 0xd3, 0x6b, 0xde, 0x0b, 0x2a, 0x8e, 0x14, 0x68, 0x1f, 0xf4, 0x13, 0x87,
+	0xed, 0xb6, 0x6d, 0x8d, 0x2e, 0xc8, 0x77, 0x9e, 0x4d, 0x09, 0x6f, 0x02, 0x15, 0xaf, 0xe8, 0xd1,
+	0x03, 0xa8, 0x1c, 0xdb, 0x3e, 0x53, 0x3e, 0x39, 0x6f, 0x9f, 0xcd, 0x29, 0x3f, 0x71, 0x15, 0x27,
+	0x95, 0xa9, 0x5a, 0xe7, 0xff, 0x69, 0xad, 0x5b, 0x50, 0x3c, 0x9b, 0xb3, 0x12, 0xb1, 0x63, 0x55,
+	0x78, 0x8c, 0xd8, 0x90, 0x50, 0x8b, 0x18, 0xe9, 0x65, 0xce, 0xa1, 0xd4, 0xee, 0x9d, 0xcd, 0x31,
+	0x79, 0xb7, 0x20, 0x3e, 0x65, 0x85, 0x3d, 0x9b, 0xb7, 0xd9, 0x69, 0xb1, 0x7a, 0x54, 0xb0, 0x90,
+	0x90, 0x01, 0xc5, 0x73, 0xeb, 0x7a, 0xea, 0x5a, 0x63, 0xbe, 0xf9, 0x32, 0x96, 0x22, 0x6a, 0x41,
+	0x61, 0x60, 0x79, 0x13, 0x42, 0x45, 0xd7, 0x6f, 0xec, 0x23, 0xe1, 0x66, 0x36, 0xa1, 0x1c, 0x64,
+	0xf4, 0xe7, 0xae, 0xe3, 0x27, 0xa0, 0x95, 0x04, 0xb4, 0xf9, 0x47, 0x1e, 0x60, 0xb0, 0x74, 0xe4,
+	0xda, 0xf8, 0x11, 0xf0, 0x9f, 0x82, 0xa2, 0x39, 0x1c, 0x29, 0xe4, 0x31, 0x66, 0x3e, 0x7d, 0x8c,
+	0x0f, 0xa1, 0xd0, 0x25, 0xf4, 0xc2, 0x1d, 0x1b, 0xd9, 0x24, 0x9f, 0x03, 0x2d, 0x16, 0x56, 0x84,
+	0x20, 0x77, 0x3a, 0xb5, 0x26, 0xfc, 0x6c, 0x2a, 0x98, 0xff, 0x46, 0x07, 0xa0, 0xb5, 0x7b, 0x22,
+	0xa1, 0x20, 0x9b, 0xce, 0xc3, 0x63, 0x05, 0xc4, 0x91, 0x0b, 0xfa, 0x1a, 0x2a, 0x01, 0xdf, 0x64,
+	0x4c, 0x70, 0x22, 0xb7, 0x65, 0xca, 0x84, 0x11, 0x27, 0x7d, 0xd1, 0x21, 0x6c, 0x63, 0x77, 0x3a,
+	0x1d, 0x5a, 0xa3, 0x4b, 0x19, 0x5e, 0xe4, 0xe1, 0x77, 0x64, 0x78, 0xca, 0x8c, 0xd3, 0xfe, 0xe8,
+	0x05, 0x54, 0xc5, 0xa4, 0x90, 0x08, 0x2a, 0x47, 0xd8, 0x95, 0x08, 0x49, 0x2b, 0x4e, 0x79, 0xa3,
+	0x63, 0xd0, 0x5f, 0x12, 0x2a, 0x06, 0x9d, 0x40, 0xd0, 0x38, 0x82, 0x21, 0x11, 0xd2, 0x76, 0xbc,
+	0x12, 0x81, 0xce, 0x61, 0x47, 0x4c, 0x9d, 0xa0, 0x1b, 0x24, 0x12, 0x70, 0xa4, 0x7b, 0xc9, 0x62,
+	0x24, 0x7d, 0xf0, 0xda, 0x48, 0xf4, 0x2d, 0xec, 0xca, 0xad, 0xa6, 0x30, 0x4b, 0x1c, 0xb3, 0x9e,
+	0xae, 0x50, 0x0a, 0x75, 0x43, 0x34, 0x3a, 0x81, 0x2a, 0x26, 0x33, 0xf7, 0x8a, 0x74, 0x45, 0x03,
+	0x1b, 0x65, 0x8e, 0xf7, 0xdf, 0x10, 0x2f, 0x61, 0x0d, 0xcb, 0x96, 0x54, 0xa3, 0x2f, 0x22, 0x0a,
+	0x56, 0x92, 0xf5, 0x16, 0x11, 0xc2, 0x1a, 0x71, 0xf0, 0x0d, 0xd4, 0x56, 0xac, 0xa8, 0x0e, 0x80,
+	0x09, 0xf5, 0xae, 0x19, 0xfd, 0x7c, 0x43, 0x69, 0x64, 0x9b, 0x79, 0x1c, 0xd3, 0xb0, 0x31, 0xc2,
+	0xa5, 0x8e, 0x43, 0x89, 0x77, 0x65, 0x4d, 0x79, 0xe7, 0x67, 0x71, 0x52, 0x69, 0xfe, 0x95, 0x87,
+	0x12, 0xc7, 0x16, 0x64, 0xfb, 0x34, 0x87, 0xea, 0x1b, 0x39, 0xf4, 0xef, 0xd9, 0xf3, 0x08, 0xd4,
+	0xc1, 0xd2, 0x39, 0xe1, 0x43, 0x3b, 0x20, 0x4f, 0x45, 0x46, 0x73, 0x25, 0x0e, 0xcd, 0xe8, 0x79,
+	0x72, 0x42, 0x08, 0xde, 0xd4, 0x62, 0x5c, 0x0b, 0x0c, 0x38, 0xe1, 0xc6, 0xfa, 0x5d, 0x72, 0x48,
+	0x04, 0x16, 0x93, 0xf5, 0x4f, 0x5a, 0x71, 0xca, 0x9b, 0xf5, 0x7b, 0x44, 0x21, 0x81, 0xa0, 0x26,
+	0xfb, 0x3d, 0x6d, 0xc7, 0x2b, 0x11, 0x8c, 0xb8, 0x21, 0x8f, 0x04, 0x88, 0x96, 0x24, 0x6e, 0xca,
+	0x8c, 0xd3, 0xfe, 0xe8, 0x25, 0xd4, 0x62, 0x34, 0x12, 0x20, 0x01, 0x5f, 0xee, 0xae, 0x61, 0x9e,
+	0x80, 0x59, 0x8d, 0x41, 0x7d, 0xb8, 0x9d, 0x62, 0x90, 0x00, 0x2b, 0x25, 0x1b, 0x7b, 0xad, 0x13,
+	0x5e, 0x1f, 0x8b, 0xde, 0xc0, 0x9d, 0x15, 0x02, 0x09, 0xd8, 0x80, 0x2f, 0xf7, 0x37, 0xf2, 0x4f,
+	0x00, 0x6f, 0x8a, 0x47, 0xa7, 0x2b, 0x0c, 0xac, 0xa4, 0x18, 0x9d, 0x62, 0xa0, 0x3c, 0xc9, 0xa4,
+	0xde, 0x1c, 0x81, 0x9e, 0x9e, 0xaf, 0xe8, 0x51, 0xfc, 0x9a, 0xc9, 0xc6, 0x6f, 0x46, 0x49, 0x64,
+	0x69, 0x5f, 0xbd, 0xa1, 0x33, 0x6b, 0x6e, 0x68, 0xf3, 0x10, 0x6a, 0xb1, 0x24, 0x62, 0x07, 0x8f,
+	0xa1, 0xd6, 0x71, 0xae, 0xac, 0xa9, 0x3d, 0x8e, 0xdd, 0xde, 0x2c, 0x5f, 0x0e, 0xaf, 0x1a, 0xcc,
+	0x1d, 0x40, 0xab, 0x83, 0xdc, 0xbc, 0x0d, 0xb7, 0xd6, 0xb4, 0x9a, 0x79, 0xca, 0xf3, 0xa5, 0x66,
+	0xf4, 0x13, 0x28, 0x8a, 0x22, 0x1a, 0xca, 0xa7, 0xaf, 0x5f, 0xe9, 0x27, 0x92, 0xa6, 0x7a, 0xce,
+	0xfc, 0x86, 0x27, 0x5d, 0x99, 0xde, 0x37, 0xc0, 0xdf, 0x85, 0x9d, 0x75, 0xfd, 0x69, 0xbe, 0x82,
+	0x3b, 0x1b, 0xe6, 0xfc, 0x4d, 0xb2, 0xec, 0x81, 0xb1, 0xa9, 0x71, 0xcd, 0x1e, 0xdc, 0xdd, 0x38,
+	0xfd, 0x6f, 0x92, 0xeb, 0x1e, 0xec, 0x6d, 0xee, 0x66, 0xb3, 0xcb, 0x57, 0xb2, 0xf6, 0x6e, 0xb8,
+	0x49, 0xb2, 0xff, 0xc0, 0xdd, 0x35, 0x70, 0x22, 0xd7, 0x20, 0x1a, 0xa2, 0x6c, 0xc8, 0xc6, 0x3e,
+	0xd4, 0xf8, 0x6f, 0xb4, 0x03, 0x79, 0x6e, 0x14, 0x1f, 0x69, 0x81, 0xc0, 0xae, 0x92, 0x20, 0x8a,
+	0xfb, 0x67, 0xb9, 0x7f, 0x4c, 0xc3, 0x1e, 0x40, 0x10, 0x7d, 0x21, 0xa2, 0x3d, 0x50, 0x4f, 0x89,
+	0x45, 0x17, 0x1e, 0x6f, 0x5d, 0xe6, 0x1c, 0xca, 0xec, 0x7d, 0xd4, 0xee, 0x71, 0x74, 0x0d, 0x67,
+	0xda, 0x3d, 0x76, 0x9f, 0xf4, 0x89, 0xef, 0xdb, 0xae, 0xd3, 0x39, 0xe6, 0xc8, 0x1a, 0x8e, 0x14,
+	0xcc, 0x7a, 0x38, 0x1a, 0xb9, 0x0b, 0x87, 0xdd, 0x36, 0xc1, 0x65, 0x10, 0x29, 0x90, 0x09, 0xe5,
+	0xb6, 0xeb, 0x38, 0x64, 0x44, 0x83, 0xf0, 0x3c, 0x77, 0x48, 0xe8, 0xd8, 0x5a, 0x5e, 0xfb, 0xc4,
+	0xeb, 0x59, 0xb3, 0xe0, 0x1a, 0xd0, 0x70, 0x28, 0xa3, 0x87, 0x50, 0xed, 0x5f, 0xda, 0xf3, 0xd4,
+	0x93, 0x24, 0x87, 0x53, 0x5a, 0xf4, 0x02, 0x50, 0x42, 0xd3, 0xe5, 0x37, 0xaa, 0xda, 0xc8, 0xf2,
+	0x1b, 0x2c, 0xfc, 0xa4, 0x66, 0x6a, 0xbc, 0xc6, 0x93, 0x7d, 0xa0, 0xf2, 0x25, 0x13, 0x8f, 0x4f,
+	0x72, 0x0d, 0x4b, 0x91, 0x3d, 0x37, 0x7c, 0xb1, 0x59, 0xe7, 0xad, 0xcb, 0x47, 0xb4, 0x86, 0xe3,
+	0x2a, 0xb6, 0x7e, 0xdb, 0xc1, 0x0b, 0xa7, 0xff, 0x6e, 0xca, 0x87, 0xae, 0x8a, 0x43, 0x39, 0xb0,
+	0x05, 0x1d, 0x6c, 0x94, 0xa5, 0x2d, 0x90, 0xd9, 0x91, 0xd9, 0x61, 0xc7, 0xf1, 0x29, 0xa8, 0xe2,
+	0x98, 0x86, 0xad, 0x69, 0x78, 0x7d, 0x44, 0x26, 0xb6, 0x63, 0x54, 0xb9, 0x51, 0x8a, 0x2c, 0xd2,
+	0x5a, 0x50, 0x77, 0x14, 0xe0, 0x6e, 0x07, 0x91, 0x91, 0x66, 0xff, 0x7f, 0x50, 0x8e, 0xbf, 0xaa,
+	0x50, 0x01, 0x32, 0xfd, 0x8e, 0xbe, 0xc5, 0xfe, 0xe2, 0xb6, 0xae, 0xa0, 0x22, 0x64, 0xfb, 0xfd,
+	0x8e, 0x9e, 0xd9, 0xdf, 0x0f, 0x5e, 0xc5, 0xac, 0xad, 0xaa, 0x00, 0xac, 0x39, 0x66, 0xb6, 0x4f,
+	0xed, 0x91, 0xbe, 0x85, 0xb6, 0xa1, 0x74, 0xce, 0x36, 0x29, 0x14, 0xca, 0xfe, 0x0f, 0xa0, 0x85,
+	0x6f, 0x62, 0x04, 0x50, 0x38, 0x1c, 0x51, 0xfb, 0x8a, 0xe8, 0x5b, 0xa8, 0x0c, 0xaa, 0x7c, 0xad,
+	0xea, 0x0a, 0xc3, 0x09, 0xf6, 0x47, 0x6d, 0x67, 0xa2, 0x67, 0x50, 0x05, 0x34, 0x21, 0x93, 0xb1,
+	0x9e, 0x65, 0xce, 0x87, 0x43, 0xd7, 0xe3, 0xc6, 0x1c, 0x2a, 0x41, 0x91, 0x4b, 0x64, 0xac, 0xe7,
+	0xf7, 0x7f, 0x52, 0x78, 0x06, 0xf1, 0x7d, 0xa1, 0x42, 0x8e, 0xbd, 0xbd, 0xf4, 0x2d, 0xa4, 0x41,
+	0x9e, 0xbf, 0xaa, 0x74, 0x85, 0xa5, 0x0d, 0xc0, 0xf4, 0x0c, 0x43, 0x92, 0xa5, 0xd2, 0xb3, 0x0c,
+	0x49, 0x2c, 0x42, 0xcf, 0xb1, 0x9c, 0xe1, 0x6c, 0xd2, 0xf3, 0xa8, 0x26, 0x3f, 0xd2, 0x05, 0xff,
+	0xf4, 0x02, 0xba, 0x15, 0x7d, 0x7a, 0x4b, 0x65, 0x11, 0xe9, 0x50, 0x96, 0x9c, 0x64, 0x8c, 0xd4,
+	0x55, 0x96, 0xfa, 0xf8, 0xe4, 0xe8, 0xf5, 0x4b, 0x5d, 0x3b, 0x3a, 0xfa, 0xf0, 0x5b, 0x5d, 0x79,
+	0xff, 0xb1, 0xae, 0x7c, 0xf8, 0x58, 0x57, 0x7e, 0xfd, 0x58, 0xdf, 0xfa, 0xf9, 0xf7, 0xba, 0xf2,
+	0xfd, 0xe3, 0xd8, 0xff, 0x2c, 0x66, 0x16, 0xf5, 0xec, 0xa5, 0xeb, 0xd9, 0x13, 0xdb, 0x91, 0x82,
+	0x43, 0x5a, 0xf3, 0xcb, 0x49, 0x6b, 0x3e, 0x6c, 0xd1, 0xa5, 0x33, 0x2c, 0xf0, 0x7f, 0x46, 0x3c,
+	0xfb, 0x7b, 0x00, 0x6c, 0xc1, 0xd2, 0x3a, 0xfa, 0x10, 0x00, 0x00,
 }
 
 func (m *TxnMeta) Marshal() (dAtA []byte, err error) {
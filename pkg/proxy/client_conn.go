@@ -152,6 +152,11 @@ type clientConn struct {
 	sc ServerConn
 	// connCache is the cache of the connections.
 	connCache ConnCache
+	// authReplies keeps the replies of the client in the handshake phase.
+	authReplies authReplies
+	// readRoute routes the read-only statements to the read CN server,
+	// it is nil if read/write splitting is disabled.
+	readRoute *readRoute
 }
 
 // internalStmt is used internally in proxy, which indicates the stmt
@@ -203,6 +208,7 @@ func newClientConn(
 		tlsConnectTimeout: cfg.TLSConnectTimeout.Duration,
 		queryClient:       qc,
 		connCache:         connCache,
+		readRoute:         newReadRoute(cfg.ReadWriteSplit),
 	}
 	c.connID, err = c.genConnID()
 	if err != nil {
@@ -302,6 +308,15 @@ func (c *clientConn) BuildConnWithServer(prevAddr string) (ServerConn, error) {
 	// bind the server connection to the client connection.
 	c.sc = conn
 
+	// The client has received the OK packet of login, the packets after
+	// it are compressed if the compression is negotiated.
+	if prevAddr == "" {
+		if err := c.enableCompression(); err != nil {
+			c.log.Error("failed to enable compression", zap.Error(err))
+			return nil, err
+		}
+	}
+
 	return conn, nil
 }
 
@@ -324,6 +339,8 @@ func (c *clientConn) HandleEvent(ctx context.Context, e IEvent, resp chan<- []by
 		return nil
 	case *upgradeEvent:
 		return c.handleUpgradeEvent(ev, resp)
+	case *readEvent:
+		return c.handleRead(ev)
 	default:
 	}
 	return nil
@@ -388,6 +405,7 @@ func (c *clientConn) handleKill(e *killEvent, resp chan<- []byte) error {
 	}
 	// Before connect to backend server, update the salt.
 	cn.salt = c.mysqlProto.GetSalt()
+	cn.authReply = c.replyAuth
 
 	// And also update the connection ID.
 	cid, err := c.genConnID()
@@ -447,6 +465,7 @@ func (c *clientConn) handleUpgradeEvent(e *upgradeEvent, resp chan<- []byte) err
 	for _, cn := range servers {
 		// Before connect to backend server, update the salt.
 		cn.salt = c.mysqlProto.GetSalt()
+		cn.authReply = c.replyAuth
 
 		// And also update the connection ID.
 		cid, err := c.genConnID()
@@ -473,6 +492,7 @@ func (c *clientConn) handleUpgradeEvent(e *upgradeEvent, resp chan<- []byte) err
 
 // Close implements the ClientConn interface.
 func (c *clientConn) Close() error {
+	c.closeReadConn()
 	if c.mysqlProto != nil {
 		tcpConn := c.mysqlProto.GetTcpConnection()
 		if tcpConn != nil {
@@ -503,7 +523,7 @@ func (c *clientConn) connectToBackend(prevAdd string) (ServerConn, error) {
 	var sc ServerConn
 	// If connCache is enabled, try to get connection from the cache.
 	if c.connCache != nil {
-		sc = c.connCache.Pop(c.clientInfo.hash, c.connID, c.mysqlProto.GetSalt(), c.mysqlProto.GetAuthResponse())
+		sc = c.connCache.Pop(c.clientInfo.hash, c.connID, c.mysqlProto.GetSalt(), c.authResponse())
 		if sc != nil {
 			// get the response from the cn server.
 			re := sc.GetConnResponse()
@@ -548,6 +568,7 @@ func (c *clientConn) connectToBackend(prevAdd string) (ServerConn, error) {
 
 		// Set the salt value of cn server.
 		cn.salt = c.mysqlProto.GetSalt()
+		cn.authReply = c.replyAuth
 
 		// Update the internal connection.
 		cn.internalConn = containIP(c.ipNetList, c.clientInfo.originIP)
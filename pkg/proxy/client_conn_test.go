@@ -539,3 +539,51 @@ func TestClientConn_SendErrToClient(t *testing.T) {
 	cc.SendErrToClient(moerr.NewInternalErrorNoCtx("msg1"))
 	wg.Wait()
 }
+
+func TestClientConn_ReplyAuth(t *testing.T) {
+	c := &clientConn{sc: newMockServerConn(nil)}
+
+	// no reply is needed for the fast authentication success.
+	reply, err := c.replyAuth([]byte{authMoreData, sha2FastAuthSuccess})
+	require.NoError(t, err)
+	require.Nil(t, reply)
+
+	// the client has logged in, and there is no reply recorded.
+	switchReq := append([]byte{authSwitchRequest}, []byte("caching_sha2_password\x00")...)
+	_, err = c.replyAuth(switchReq)
+	require.Error(t, err)
+
+	// the recorded replies are replayed, the password is encrypted.
+	c.authReplies.m = map[string][]byte{
+		"switch:caching_sha2_password": []byte("scramble"),
+		"full":                         {sha2RequestPublicKey},
+		"public_key":                   []byte("encrypted"),
+	}
+	reply, err = c.replyAuth(switchReq)
+	require.NoError(t, err)
+	require.Equal(t, []byte("scramble"), reply)
+	reply, err = c.replyAuth([]byte{authMoreData, sha2PerformFullAuth})
+	require.NoError(t, err)
+	require.Equal(t, []byte{sha2RequestPublicKey}, reply)
+	reply, err = c.replyAuth(append([]byte{authMoreData}, []byte("-----BEGIN PUBLIC KEY-----")...))
+	require.NoError(t, err)
+	require.Equal(t, []byte("encrypted"), reply)
+
+	_, err = c.replyAuth([]byte{authMoreData, 0x09})
+	require.Error(t, err)
+}
+
+func TestDisableCompression(t *testing.T) {
+	payload := make([]byte, 32)
+	binary.LittleEndian.PutUint32(payload, frontend.CLIENT_PROTOCOL_41|
+		frontend.CLIENT_COMPRESS|frontend.CLIENT_ZSTD_COMPRESSION_ALGORITHM)
+	disableCompression(payload)
+	require.Equal(t, frontend.CLIENT_PROTOCOL_41, binary.LittleEndian.Uint32(payload))
+
+	// the capabilities of the old protocol are 2 bytes.
+	payload = []byte{byte(frontend.CLIENT_COMPRESS | frontend.CLIENT_LONG_PASSWORD), 0, 0xff, 0xff, 0xff}
+	disableCompression(payload)
+	require.Equal(t, []byte{byte(frontend.CLIENT_LONG_PASSWORD), 0, 0xff, 0xff, 0xff}, payload)
+
+	disableCompression([]byte{1})
+}
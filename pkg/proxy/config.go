@@ -48,6 +48,8 @@ var (
 	defaultAuthTimeout = time.Second * 10
 	// The default value of TSL connect timeout.
 	defaultTLSConnectTimeout = time.Second * 10
+	// The default value of the timeout to sync consistency token to read CN servers.
+	defaultReadSyncTimeout = time.Second * 3
 )
 
 type RebalancePolicy int
@@ -129,6 +131,9 @@ type Config struct {
 	// are responsible for ensuring the stability of rpc tunnels, for example, by deploying proxy and
 	// plugin in a same machine and communicate through local loopback address
 	Plugin *PluginConfig `toml:"plugin"`
+	// ReadWriteSplit is the configuration of routing read-only statements
+	// to a separate pool of CN servers.
+	ReadWriteSplit ReadWriteSplitConfig `toml:"read-write-split"`
 }
 
 type PluginConfig struct {
@@ -138,6 +143,23 @@ type PluginConfig struct {
 	Timeout time.Duration `toml:"timeout"`
 }
 
+// ReadWriteSplitConfig is the configuration of read/write splitting.
+type ReadWriteSplitConfig struct {
+	// Enabled indicates that the autocommit read-only statements and the
+	// statements in transactions started by START TRANSACTION READ ONLY
+	// are routed to the read CN servers. The other statements stay on
+	// the primary CN server of the session, and so do all the statements
+	// of the sessions which have created temporary tables. Default is false.
+	Enabled bool `toml:"enabled"`
+	// ReadLabels are the labels of the read CN servers. They are added to
+	// the labels of the session when selecting a read CN server.
+	ReadLabels map[string]string `toml:"read-labels"`
+	// SyncTimeout is the timeout of syncing the consistency token of the
+	// session to the read CN server. If it times out, the statement is
+	// executed on the primary CN server.
+	SyncTimeout toml.Duration `toml:"sync-timeout"`
+}
+
 // Option is used to set up configuration.
 type Option func(*Server)
 
@@ -221,6 +243,9 @@ func (c *Config) FillDefault() {
 			c.Plugin.Timeout = time.Second
 		}
 	}
+	if c.ReadWriteSplit.SyncTimeout.Duration == 0 {
+		c.ReadWriteSplit.SyncTimeout.Duration = defaultReadSyncTimeout
+	}
 	if c.HAKeeper.HeartbeatInterval.Duration == 0 {
 		c.HAKeeper.HeartbeatInterval.Duration = defaultHeartbeatInterval
 	}
@@ -240,6 +265,9 @@ func (c *Config) Validate() error {
 			return moerr.NewInternalError(noReport, "proxy plugin backend timeout must be set")
 		}
 	}
+	if c.ReadWriteSplit.Enabled && len(c.ReadWriteSplit.ReadLabels) == 0 {
+		return moerr.NewInternalError(noReport, "proxy read labels must be set when read write split is enabled")
+	}
 	if _, ok := RebalancePolicyMapping[c.RebalancePolicy]; !ok {
 		c.RebalancePolicy = defaultRebalancePolicy
 	}
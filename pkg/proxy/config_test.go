@@ -30,6 +30,7 @@ func TestFillDefault(t *testing.T) {
 	require.NotEqual(t, 0, c.RebalanceTolerance)
 	require.Less(t, c.RebalanceTolerance, float64(1))
 	require.NotEqual(t, 0, c.Cluster.RefreshInterval.Duration)
+	require.Equal(t, defaultReadSyncTimeout, c.ReadWriteSplit.SyncTimeout.Duration)
 }
 
 func TestValidate(t *testing.T) {
@@ -62,6 +63,22 @@ func TestValidate(t *testing.T) {
 				Timeout: time.Second,
 			},
 		},
+	}, {
+		name: "read write split enabled but no read labels",
+		cfg: Config{
+			ReadWriteSplit: ReadWriteSplitConfig{
+				Enabled: true,
+			},
+		},
+		wantErr: true,
+	}, {
+		name: "read write split valid",
+		cfg: Config{
+			ReadWriteSplit: ReadWriteSplitConfig{
+				Enabled:    true,
+				ReadLabels: map[string]string{"role": "read"},
+			},
+		},
 	}}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -16,6 +16,7 @@ package proxy
 
 import (
 	"context"
+	"crypto/sha256"
 	"fmt"
 	"sync"
 	"time"
@@ -125,6 +126,10 @@ func newPwdAuthenticator(authString []byte) Authenticator {
 
 // Authenticate implements the Authenticator interface.
 func (a *pwdAuthenticator) Authenticate(salt, authResp []byte) bool {
+	// The authString of caching_sha2_password is SHA256(SHA256(password)).
+	if len(a.authString) == sha256.Size {
+		return frontend.CheckSha2Scramble(a.authString, salt, authResp)
+	}
 	return frontend.CheckPassword(a.authString, salt, authResp)
 }
 
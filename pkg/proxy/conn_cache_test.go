@@ -17,6 +17,7 @@ package proxy
 import (
 	"context"
 	"crypto/sha1"
+	"crypto/sha256"
 	"fmt"
 	"math/rand"
 	"net"
@@ -92,6 +93,19 @@ func TestAuthentication(t *testing.T) {
 	assert.False(t, au.Authenticate(salt, authRespWrong))
 }
 
+func TestSha2Authentication(t *testing.T) {
+	pw := "mypassword"
+	// the authString of caching_sha2_password is SHA256(SHA256(password)).
+	stage1 := sha256.Sum256([]byte(pw))
+	stage2 := sha256.Sum256(stage1[:])
+	au := newPwdAuthenticator(stage2[:])
+
+	salt := mockGenSalt(20)
+	assert.True(t, au.Authenticate(salt, frontend.ScrambleSha2([]byte(pw), salt)))
+	assert.False(t, au.Authenticate(salt, frontend.ScrambleSha2([]byte(pw+"wrong"), salt)))
+	assert.False(t, au.Authenticate(salt, simulateScramble(pw, salt)))
+}
+
 func mockGenSalt(n int) []byte {
 	buf := make([]byte, n)
 	r := rand.New(rand.NewSource(time.Now().UTC().UnixNano()))
@@ -36,6 +36,8 @@ func (t eventType) String() string {
 		return "Quit"
 	case TypeUpgrade:
 		return "Upgrade"
+	case TypeRead:
+		return "Read"
 	}
 	return "Unknown"
 }
@@ -49,6 +51,9 @@ const (
 	TypeQuit eventType = 3
 	// TypeUpgrade indicates the "upgrade account all" statement.
 	TypeUpgrade eventType = 4
+	// TypeRead indicates the statement which could be routed to the
+	// read CN servers.
+	TypeRead eventType = 5
 )
 
 // IEvent is the event interface.
@@ -98,21 +103,27 @@ func makeEvent(msg []byte, b *msgBuf) (IEvent, bool) {
 	if isCmdQuery(msg) {
 		sql := getStatement(msg)
 		stmts, err := parsers.Parse(context.Background(), dialect.MYSQL, sql, 0)
-		if err != nil {
-			return nil, false
-		}
-		if len(stmts) != 1 {
+		if err != nil || len(stmts) != 1 {
+			if b != nil && b.rws != nil {
+				return b.rws.makeTxnEvent(sql, stmts)
+			}
 			return nil, false
 		}
 		switch s := stmts[0].(type) {
 		case *tree.Kill:
 			return makeKillEvent(sql, s.ConnectionId), true
 		case *tree.SetVar:
+			if b != nil && b.rws != nil {
+				b.rws.trackSetVar(s)
+			}
 			// This event should be sent to dst, so return false,
 			return makeSetVarEvent(sql), false
 		case *tree.UpgradeStatement:
 			return makeUpgradeEvent(sql), true
 		default:
+			if b != nil && b.rws != nil {
+				return b.rws.makeEvent(sql, s)
+			}
 			return nil, false
 		}
 	} else if b.connCacheEnabled && isCmdQuit(msg) {
@@ -120,6 +131,10 @@ func makeEvent(msg []byte, b *msgBuf) (IEvent, bool) {
 		// handled in the event handler. According to the config,
 		// the quit command will be sent to server or not.
 		return makeQuitEvent(), true
+	} else if b != nil && b.rws != nil && isCmdInitDB(msg) {
+		b.rws.trackDB(string(msg[preRecvLen:]))
+	} else if b != nil && b.rws != nil {
+		return b.rws.makeCmdEvent(MySQLCmd(msg[4]))
 	}
 	return nil, false
 }
@@ -202,3 +217,47 @@ func makeUpgradeEvent(stmt string) IEvent {
 	e.typ = TypeUpgrade
 	return e
 }
+
+// readStmtType is the type of the statement in readEvent.
+type readStmtType uint8
+
+const (
+	// readStmtAutocommit is an autocommit read-only statement.
+	readStmtAutocommit readStmtType = iota
+	// readStmtBegin is the START TRANSACTION READ ONLY statement.
+	readStmtBegin
+	// readStmtInTxn is a statement in the read-only transaction.
+	readStmtInTxn
+	// readStmtEnd is the statement which ends the read-only transaction.
+	readStmtEnd
+	// readCmdUnsupported is the command which cannot be executed in the
+	// read-only transaction, it is rejected.
+	readCmdUnsupported
+)
+
+// readEvent is the event that a statement which could be routed to the
+// read CN servers is captured. If it is not handled by the read CN server,
+// it is sent to the primary CN server.
+type readEvent struct {
+	baseEvent
+	// stmt is the statement that will be sent to server.
+	stmt string
+	// stmtType is the type of the statement.
+	stmtType readStmtType
+	// handled indicates that the statement has been executed on
+	// the read CN server.
+	handled bool
+}
+
+// makeReadEvent creates an event with TypeRead type.
+func makeReadEvent(stmt string, typ readStmtType) IEvent {
+	e := &readEvent{
+		baseEvent: baseEvent{
+			waitC: make(chan struct{}),
+		},
+		stmt:     stmt,
+		stmtType: typ,
+	}
+	e.typ = TypeRead
+	return e
+}
@@ -37,6 +37,8 @@ func TestEventTypeDesc(t *testing.T) {
 	assert.Equal(t, "Quit", e1.String())
 	e1 = TypeUpgrade
 	assert.Equal(t, "Upgrade", e1.String())
+	e1 = TypeRead
+	assert.Equal(t, "Read", e1.String())
 }
 
 func TestMakeEvent(t *testing.T) {
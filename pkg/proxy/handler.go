@@ -165,6 +165,7 @@ func (h *handler) handle(c goetty.IOSession) error {
 		withRebalancePolicy(RebalancePolicyMapping[h.config.RebalancePolicy]),
 		withRebalancer(h.rebalancer),
 		withConnCacheEnabled(h.connCache != nil),
+		withReadWriteSplit(h.config.ReadWriteSplit.Enabled),
 	)
 	defer func() {
 		_ = t.Close()
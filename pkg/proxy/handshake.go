@@ -19,11 +19,52 @@ import (
 	"context"
 	"crypto/tls"
 	"encoding/binary"
+	"sync"
+
 	"github.com/fagongzi/goetty/v2"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/frontend"
 )
 
+const (
+	// authSwitchRequest is the header of AuthSwitchRequest packet.
+	authSwitchRequest byte = 0xFE
+	// authMoreData is the header of AuthMoreData packet.
+	authMoreData byte = 0x01
+	// the status in AuthMoreData packet of caching_sha2_password.
+	sha2RequestPublicKey byte = 0x02
+	sha2FastAuthSuccess  byte = 0x03
+	sha2PerformFullAuth  byte = 0x04
+)
+
+// authReplies keeps the replies of the client to the auth requests sent by
+// CN server in the handshake phase. They are replayed when proxy connects
+// to other CN servers without the client.
+type authReplies struct {
+	sync.Mutex
+	m map[string][]byte
+	// switched is the reply of the last AuthSwitchRequest.
+	switched []byte
+	// password is the clear text password sent by the client on the secure
+	// connection in the full authentication, it is kept only until it is
+	// encrypted with the public key of CN server.
+	password []byte
+}
+
+// clearPassword wipes the clear text password.
+func (r *authReplies) clearPassword() {
+	for i := range r.password {
+		r.password[i] = 0
+	}
+	r.password = nil
+}
+
+// isAuthRequest returns true if the packet from CN server in handshake
+// phase asks for more data to do the authentication.
+func isAuthRequest(p []byte) bool {
+	return len(p) > 0 && (p[0] == authSwitchRequest || p[0] == authMoreData)
+}
+
 // writeInitialHandshake sends the initial handshake to client.
 func (c *clientConn) writeInitialHandshake() error {
 	return c.mysqlProto.WritePacket(c.mysqlProto.MakeHandshakePayload())
@@ -56,6 +97,10 @@ func (c *clientConn) handleHandshakeResp() error {
 		return c.handleHandshakeResp()
 	}
 
+	// The compression of the protocol terminates at proxy, the connections
+	// between proxy and CN servers are never compressed.
+	disableCompression(pack.Payload)
+
 	// parse tenant information from client login request.
 	if err := c.clientInfo.parse(c.mysqlProto.GetUserName()); err != nil {
 		return err
@@ -86,9 +131,142 @@ func (c *clientConn) upgradeToTLS() error {
 	}
 	c.conn.UseConn(tlsConn)
 	c.mysqlProto.UseConn(tlsConn)
+	c.mysqlProto.SetTlsEstablished()
 	return nil
 }
 
+// disableCompression clears the compression capabilities in the login
+// request which is sent to CN servers.
+func disableCompression(payload []byte) {
+	if len(payload) < 4 {
+		return
+	}
+	capabilities := binary.LittleEndian.Uint32(payload)
+	if capabilities&frontend.CLIENT_PROTOCOL_41 == 0 {
+		// the capabilities are 2 bytes in the old protocol.
+		capabilities &^= frontend.CLIENT_COMPRESS
+		binary.LittleEndian.PutUint16(payload, uint16(capabilities))
+		return
+	}
+	capabilities &^= frontend.CLIENT_COMPRESS | frontend.CLIENT_ZSTD_COMPRESSION_ALGORITHM
+	binary.LittleEndian.PutUint32(payload, capabilities)
+}
+
+// enableCompression switches the client connection to the compressed
+// protocol if it is negotiated in the handshake.
+func (c *clientConn) enableCompression() error {
+	raw := c.RawConn()
+	// the data from client might have been read into the buffer.
+	if bio, ok := c.conn.(goetty.BufferedIOSession); ok {
+		raw = bio.BufferedConn()
+	}
+	conn, err := c.mysqlProto.NewCompressedConn(raw)
+	if err != nil || conn == nil {
+		return err
+	}
+	c.conn.UseConn(conn)
+	c.mysqlProto.UseConn(conn)
+	return nil
+}
+
+// replyAuth replies the auth request sent by CN server in the handshake
+// phase. The request is passed through to the client when it is logging
+// in, otherwise, the recorded reply is used. The password of the full
+// authentication of caching_sha2_password is never kept in clear text,
+// only the one encrypted with the RSA public key of CN server is recorded.
+func (c *clientConn) replyAuth(req []byte) ([]byte, error) {
+	var key string
+	switch {
+	case req[0] == authSwitchRequest:
+		plugin := req[1:]
+		if i := bytes.IndexByte(plugin, 0); i >= 0 {
+			plugin = plugin[:i]
+		}
+		key = "switch:" + string(plugin)
+	case len(req) == 2 && req[1] == sha2FastAuthSuccess:
+		// No reply is needed, the OK packet follows.
+		if c.sc == nil {
+			return nil, c.mysqlProto.WritePacket(req)
+		}
+		return nil, nil
+	case len(req) == 2 && req[1] == sha2PerformFullAuth:
+		key = "full"
+	case len(req) > 2 && req[0] == authMoreData:
+		// The public key of CN server in the full authentication.
+		key = "public_key"
+	default:
+		return nil, moerr.NewInternalErrorNoCtxf("unexpected auth request %v", req)
+	}
+
+	c.authReplies.Lock()
+	defer c.authReplies.Unlock()
+	if key != "public_key" {
+		c.authReplies.clearPassword()
+	}
+	if reply, ok := c.authReplies.m[key]; ok {
+		return reply, nil
+	}
+
+	var reply []byte
+	var err error
+	if key == "public_key" && c.authReplies.password != nil {
+		// The password has been sent by the client on the secure connection,
+		// encrypt it with the public key of CN server.
+		reply, err = frontend.EncryptSha2Password(req[1:], c.mysqlProto.GetSalt(), c.authReplies.password)
+		c.authReplies.clearPassword()
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// The client has logged in, and cannot be asked any more.
+		if c.sc != nil {
+			return nil, moerr.NewInternalErrorNoCtxf("no reply of the auth request %s", key)
+		}
+		if reply, err = c.exchangeAuth(req); err != nil {
+			return nil, err
+		}
+		if key == "full" && c.mysqlProto.IsTlsEstablished() {
+			// The password is in clear text on the secure connection, but the
+			// connection to CN server is not, ask CN server for its public key.
+			c.authReplies.password = reply
+			reply = []byte{sha2RequestPublicKey}
+		}
+	}
+	if c.authReplies.m == nil {
+		c.authReplies.m = make(map[string][]byte)
+	}
+	c.authReplies.m[key] = reply
+	if req[0] == authSwitchRequest {
+		c.authReplies.switched = reply
+	}
+	return reply, nil
+}
+
+// authResponse returns the auth response of the client, which is the
+// reply of the last AuthSwitchRequest if the client has been asked to
+// switch the authentication method.
+func (c *clientConn) authResponse() []byte {
+	c.authReplies.Lock()
+	defer c.authReplies.Unlock()
+	if c.authReplies.switched != nil {
+		return c.authReplies.switched
+	}
+	return c.mysqlProto.GetAuthResponse()
+}
+
+// exchangeAuth sends the auth request to the client and reads the reply.
+func (c *clientConn) exchangeAuth(req []byte) ([]byte, error) {
+	if err := c.mysqlProto.WritePacket(req); err != nil {
+		return nil, err
+	}
+	pack, err := c.readPacket()
+	if err != nil {
+		return nil, err
+	}
+	c.mysqlProto.AddSequenceId(1)
+	return pack.Payload, nil
+}
+
 func (s *serverConn) parseConnID(p *frontend.Packet) error {
 	if len(p.Payload) < 2 {
 		return moerr.NewInternalErrorNoCtx("protocol error: payload is too short")
@@ -128,11 +306,32 @@ func (s *serverConn) writeHandshakeResp(handshakeResp *frontend.Packet) (*fronte
 	if err := s.mysqlProto.WritePacket(handshakeResp.Payload); err != nil {
 		return nil, err
 	}
-	// The CN server send a response back to indicate if the auth packet
-	// is OK to login.
-	data, err := s.readPacket()
-	if err != nil {
-		return nil, err
+	for {
+		// The CN server send a response back to indicate if the auth packet
+		// is OK to login.
+		data, err := s.readPacket()
+		if err != nil {
+			return nil, err
+		}
+		// The CN server may ask for more data to do the authentication, such
+		// as switching the authentication method, or the full authentication
+		// of caching_sha2_password.
+		if !isAuthRequest(data.Payload) {
+			return data, nil
+		}
+		if s.cnServer.authReply == nil {
+			return nil, moerr.NewInternalErrorNoCtx("cannot reply the auth request")
+		}
+		reply, err := s.cnServer.authReply(data.Payload)
+		if err != nil {
+			return nil, err
+		}
+		if reply == nil {
+			continue
+		}
+		s.mysqlProto.SetSequenceID(uint8(data.SequenceID + 1))
+		if err = s.mysqlProto.WritePacket(reply); err != nil {
+			return nil, err
+		}
 	}
-	return data, nil
 }
@@ -37,6 +37,9 @@ const (
 	cmdLen = 1
 	// The header and cmd must be received first.
 	preRecvLen = mysqlHeadLen + cmdLen
+	// The max payload length of EOF packet, the packets starting with 0xFE
+	// and longer than it are rows.
+	maxEOFPacketLen = 9
 )
 
 // MySQLCmd is the type indicate the cmd of statement.
@@ -49,7 +52,10 @@ const (
 	cmdInitDB MySQLCmd = 0x02
 	// For stmt prepare and execute cmd from JDBC.
 	cmdStmtPrepare MySQLCmd = 0x16
+	cmdStmtExecute MySQLCmd = 0x17
 	cmdStmtClose   MySQLCmd = 0x19
+	cmdStmtReset   MySQLCmd = 0x1a
+	cmdStmtFetch   MySQLCmd = 0x1c
 )
 
 // MySQLConn contains a buffer to save data which may be only part
@@ -105,6 +111,9 @@ type msgBuf struct {
 	respC chan []byte
 	// connCacheEnabled is a function returns if the connection cache is enabled.
 	connCacheEnabled bool
+	// rws is the read/write splitting state of the session. It is only set
+	// for the client connection when read/write splitting is enabled.
+	rws *rwSplitState
 }
 
 // newMsgBuf creates a new message buffer.
@@ -198,6 +207,11 @@ func (b *msgBuf) consumeClient(msg []byte) bool {
 	// after send, wait the event finished.
 	e.wait()
 
+	// The read event may fall back to the primary CN server.
+	if re, ok := e.(*readEvent); ok {
+		return re.handled
+	}
+
 	// We cannot write to b.src directly here. The response has
 	// to go to the server conn buf, and lock writeMu then
 	// write to client.
@@ -263,6 +277,10 @@ func (b *msgBuf) sendTo(dst io.Writer) error {
 			return nil
 		}
 	}
+	// The command goes to the primary CN server, and it may change data.
+	if b.rws != nil {
+		b.rws.setWritten()
+	}
 
 	b.writeMu.Lock()
 	defer b.writeMu.Unlock()
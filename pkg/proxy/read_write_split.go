@@ -0,0 +1,513 @@
+// Copyright 2021 - 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/frontend"
+	"github.com/matrixorigin/matrixone/pkg/pb/query"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	v2 "github.com/matrixorigin/matrixone/pkg/util/metric/v2"
+	"go.uber.org/zap"
+)
+
+// sessionFuncs are the functions which read or change the state of the
+// session or the cluster. The statements calling them are not routed to
+// the read CN servers.
+var sessionFuncs = []string{
+	"nextval(",
+	"setval(",
+	"lastval(",
+	"last_insert_id(",
+	"last_query_id(",
+	"found_rows(",
+	"row_count(",
+	"mo_ctl(",
+	"sleep(",
+}
+
+// userVarAssignRe matches the assignments of the user variables in the select
+// statements, such as SELECT ... INTO @var and SELECT @var := expr.
+var userVarAssignRe = regexp.MustCompile(`(?i)\binto\s+@|:=`)
+
+// rwSplitState is the read/write splitting state of a session. It is
+// updated by the client connection buffer, which sees all the commands
+// from the client, and by the event handler, which routes the statements
+// to the read CN server.
+type rwSplitState struct {
+	mu sync.Mutex
+	// autocommitOff indicates that autocommit is disabled in the session,
+	// the statements are not routed to read CN servers then.
+	autocommitOff bool
+	// readOnlyTxn indicates that the session is in a read-only transaction,
+	// which runs on the read CN server.
+	readOnlyTxn bool
+	// written indicates that commands have been sent to the primary CN server
+	// since the consistency token of the session was taken last time.
+	written bool
+	// db is the current database of the session, it is empty if the
+	// database has not been changed since login.
+	db string
+	// tempTables indicates that temporary tables have been created in the
+	// session. They only exist in the primary CN server, so the session is
+	// pinned to it then.
+	tempTables bool
+}
+
+// makeEvent makes a read event if the statement could be routed to the read
+// CN server. The second return value is true if the event is made.
+func (s *rwSplitState) makeEvent(sql string, stmt tree.Statement) (IEvent, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.readOnlyTxn {
+		switch stmt.(type) {
+		case *tree.CommitTransaction, *tree.RollbackTransaction:
+			return makeReadEvent(sql, readStmtEnd), true
+		default:
+			return makeReadEvent(sql, readStmtInTxn), true
+		}
+	}
+	switch st := stmt.(type) {
+	case *tree.BeginTransaction:
+		if s.routable() && st.Modes.RwMode == tree.READ_WRITE_MODE_READ_ONLY {
+			return makeReadEvent(sql, readStmtBegin), true
+		}
+	case *tree.Select:
+		if s.routable() && isReadOnlySelect(sql, st) {
+			return makeReadEvent(sql, readStmtAutocommit), true
+		}
+	case *tree.CreateTable:
+		if st.Temporary {
+			s.tempTables = true
+		}
+	case *tree.Use:
+		if st.Name != nil && st.Role == nil {
+			s.db = st.Name.Origin()
+		}
+	}
+	return nil, false
+}
+
+// makeTxnEvent makes a read event for the query in the read-only transaction,
+// which has multiple statements or cannot be parsed. It is executed on the
+// read CN server where the transaction runs, which reports the errors.
+func (s *rwSplitState) makeTxnEvent(sql string, stmts []tree.Statement) (IEvent, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.readOnlyTxn {
+		return nil, false
+	}
+	typ := readStmtInTxn
+	for _, stmt := range stmts {
+		switch stmt.(type) {
+		case *tree.CommitTransaction, *tree.RollbackTransaction:
+			typ = readStmtEnd
+		}
+	}
+	return makeReadEvent(sql, typ), true
+}
+
+// makeCmdEvent makes a read event which rejects the prepared statement
+// commands in the read-only transaction, as the statements are prepared on
+// the primary CN server and cannot be executed in the transaction.
+func (s *rwSplitState) makeCmdEvent(cmd MySQLCmd) (IEvent, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.readOnlyTxn {
+		return nil, false
+	}
+	switch cmd {
+	case cmdStmtPrepare, cmdStmtExecute, cmdStmtFetch, cmdStmtReset:
+		return makeReadEvent("", readCmdUnsupported), true
+	}
+	return nil, false
+}
+
+// routable returns true if the statements out of transactions could be
+// routed to the read CN server. The caller must hold the lock.
+func (s *rwSplitState) routable() bool {
+	return !s.autocommitOff && !s.tempTables
+}
+
+// trackSetVar keeps the autocommit mode of the session.
+func (s *rwSplitState) trackSetVar(stmt *tree.SetVar) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, a := range stmt.Assignments {
+		if !a.Global && strings.EqualFold(a.Name, "autocommit") && a.Value != nil {
+			v := strings.Trim(strings.ToLower(tree.String(a.Value, dialect.MYSQL)), "'\"")
+			s.autocommitOff = v == "0" || v == "off" || v == "false"
+		}
+	}
+}
+
+// trackDB keeps the current database of the session.
+func (s *rwSplitState) trackDB(db string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.db = db
+}
+
+func (s *rwSplitState) getDB() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.db
+}
+
+func (s *rwSplitState) setReadOnlyTxn(v bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.readOnlyTxn = v
+}
+
+func (s *rwSplitState) setWritten() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.written = true
+}
+
+// takeWritten returns the written flag and clears it.
+func (s *rwSplitState) takeWritten() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	written := s.written
+	s.written = false
+	return written
+}
+
+// isReadOnlySelect returns true if the select statement does not change
+// anything and does not depend on the state of the session.
+func isReadOnlySelect(sql string, stmt *tree.Select) bool {
+	if stmt.Ep != nil || stmt.SelectLockInfo != nil {
+		return false
+	}
+	if userVarAssignRe.MatchString(sql) {
+		return false
+	}
+	lower := strings.ToLower(sql)
+	for _, f := range sessionFuncs {
+		if strings.Contains(lower, f) {
+			return false
+		}
+	}
+	return true
+}
+
+// readRoute keeps the connection to the read CN server of a client connection.
+// It is only accessed in the event handler.
+type readRoute struct {
+	// labels are the labels of the read CN servers.
+	labels map[string]string
+	// syncTimeout is the timeout to sync the consistency token.
+	syncTimeout time.Duration
+	// sc is the connection to the read CN server.
+	sc ServerConn
+	// db is the current database of sc.
+	db string
+	// setVarNum is the number of set variable statements executed on sc.
+	setVarNum int
+	// token is the consistency token of the session, which is the latest
+	// commit timestamp of the primary CN server after the last write.
+	token timestamp.Timestamp
+	// syncedToken is the token which has been synced to the read CN server.
+	syncedToken timestamp.Timestamp
+}
+
+// newReadRoute creates a readRoute if read/write splitting is enabled.
+func newReadRoute(cfg ReadWriteSplitConfig) *readRoute {
+	if !cfg.Enabled {
+		return nil
+	}
+	return &readRoute{
+		labels:      cfg.ReadLabels,
+		syncTimeout: cfg.SyncTimeout.Duration,
+	}
+}
+
+// handleRead handles the read event. If the statement cannot be executed on
+// the read CN server, the event is not handled and the statement goes to the
+// primary CN server, except the ones in the read-only transaction.
+func (c *clientConn) handleRead(e *readEvent) error {
+	defer e.notify()
+	if c.readRoute == nil || c.tun == nil || c.tun.rws == nil {
+		return nil
+	}
+	if e.stmtType == readCmdUnsupported {
+		e.handled = true
+		return c.writeErrToClient(moerr.NewNotSupportedNoCtx(
+			"prepared statements in the read-only transaction on the read CN server"))
+	}
+	inTxn := e.stmtType == readStmtInTxn || e.stmtType == readStmtEnd
+	// The statement belongs to the transaction on the primary CN server.
+	if !inTxn {
+		if _, scp := c.tun.getPipes(); scp != nil && !scp.safeToTransfer() {
+			return nil
+		}
+	}
+
+	sc, err := c.prepareReadConn()
+	if err != nil {
+		c.log.Error("failed to prepare read connection", zap.Error(err))
+		if !inTxn {
+			v2.ProxyReadRouteFallbackCounter.Inc()
+			return nil
+		}
+		// The read-only transaction cannot go on in other CN servers.
+		e.handled = true
+		c.tun.rws.setReadOnlyTxn(false)
+		v2.ProxyReadRouteFailCounter.Inc()
+		return c.writeErrToClient(err)
+	}
+
+	var sent bool
+	ok, err := sc.ExecQuery(
+		internalStmt{cmdType: cmdQuery, s: e.stmt},
+		c.deprecateEOF(),
+		func(bs []byte) error {
+			sent = true
+			return c.writeToClient(bs)
+		},
+	)
+	if err != nil {
+		c.log.Error("failed to execute statement on read CN server", zap.Error(err))
+		c.closeReadConn()
+		v2.ProxyReadRouteFailCounter.Inc()
+		if !sent && !inTxn {
+			return nil
+		}
+		e.handled = true
+		if inTxn || e.stmtType == readStmtBegin {
+			c.tun.rws.setReadOnlyTxn(false)
+		}
+		if !sent {
+			return c.writeErrToClient(err)
+		}
+		// The client has received part of the response, the session is broken.
+		c.tun.setError(withCode(err, codeServerDisconnect))
+		return err
+	}
+
+	e.handled = true
+	switch e.stmtType {
+	case readStmtBegin:
+		c.tun.rws.setReadOnlyTxn(ok)
+	case readStmtEnd:
+		c.tun.rws.setReadOnlyTxn(false)
+	}
+	v2.ProxyReadRouteSuccessCounter.Inc()
+	return nil
+}
+
+// prepareReadConn returns the connection to the read CN server, which has
+// the same session state with the primary CN server and has caught up with
+// the writes of the session.
+func (c *clientConn) prepareReadConn() (ServerConn, error) {
+	rr := c.readRoute
+	if rr.sc == nil {
+		sc, err := c.connectToReadServer()
+		if err != nil {
+			return nil, err
+		}
+		rr.sc = sc
+		rr.db = ""
+		rr.setVarNum = 0
+		rr.syncedToken = timestamp.Timestamp{}
+	}
+
+	// Replay the set variable statements executed on the primary CN server.
+	for ; rr.setVarNum < len(c.migration.setVarStmts); rr.setVarNum++ {
+		if _, err := rr.sc.ExecStmt(internalStmt{
+			cmdType: cmdQuery,
+			s:       c.migration.setVarStmts[rr.setVarNum],
+		}, nil); err != nil {
+			c.closeReadConn()
+			return nil, err
+		}
+	}
+
+	if db := c.tun.rws.getDB(); db != rr.db {
+		ok, err := rr.sc.ExecStmt(internalStmt{cmdType: cmdInitDB, s: db}, nil)
+		if err != nil {
+			c.closeReadConn()
+			return nil, err
+		}
+		if !ok {
+			return nil, moerr.NewInternalErrorNoCtxf("failed to use database %s on read CN server", db)
+		}
+		rr.db = db
+	}
+
+	if err := c.syncConsistencyToken(); err != nil {
+		return nil, err
+	}
+	return rr.sc, nil
+}
+
+// connectToReadServer selects a read CN server and connects to it.
+func (c *clientConn) connectToReadServer() (ServerConn, error) {
+	ci := c.clientInfo
+	labels := ci.commonLabels()
+	for k, v := range c.readRoute.labels {
+		labels[k] = v
+	}
+	ci.labelInfo = newLabelInfo(ci.Tenant, labels)
+	hash, err := ci.getHash()
+	if err != nil {
+		return nil, err
+	}
+	ci.hash = hash
+
+	cn, err := c.router.Route(c.ctx, c.sid, ci, nil)
+	if err != nil {
+		return nil, err
+	}
+	// Before connect to backend server, update the salt.
+	cn.salt = c.mysqlProto.GetSalt()
+	cn.authReply = c.replyAuth
+	// And also update the connection ID.
+	cn.connID, err = c.genConnID()
+	if err != nil {
+		return nil, err
+	}
+	cn.internalConn = containIP(c.ipNetList, c.clientInfo.originIP)
+	cn.clientAddr = fmt.Sprintf("%s:%d", c.clientInfo.originIP.String(), c.clientInfo.originPort)
+
+	sc, r, err := c.router.Connect(cn, c.handshakePack, c.tun)
+	if err != nil {
+		return nil, err
+	}
+	if !isOKPacket(r) {
+		_ = sc.Close()
+		return nil, moerr.NewInternalErrorNoCtx("access error")
+	}
+	c.log.Info("connect to read CN server",
+		zap.String("uuid", cn.uuid), zap.String("addr", cn.addr))
+	return sc, nil
+}
+
+// closeReadConn closes the connection to the read CN server.
+func (c *clientConn) closeReadConn() {
+	if c.readRoute == nil || c.readRoute.sc == nil {
+		return
+	}
+	if err := c.readRoute.sc.Quit(); err != nil {
+		c.log.Error("failed to quit from read CN server", zap.Error(err))
+	}
+	c.readRoute.sc = nil
+}
+
+// syncConsistencyToken makes the read CN server catch up with the writes of
+// the session, so the session could read its own writes. The token is the
+// latest commit timestamp of the primary CN server after the last write, and
+// the read CN server waits for the logtail until the token when it is synced.
+func (c *clientConn) syncConsistencyToken() error {
+	if c.queryClient == nil {
+		return nil
+	}
+	rr := c.readRoute
+	if c.tun.rws.takeWritten() {
+		_, primary := c.tun.getConns()
+		ts, err := c.getCommitTS(primary.RemoteAddr().String())
+		if err != nil {
+			c.tun.rws.setWritten()
+			return err
+		}
+		if rr.token.Less(ts) {
+			rr.token = ts
+		}
+	}
+	if !rr.syncedToken.Less(rr.token) {
+		return nil
+	}
+	if err := c.syncCommitTS(rr.sc.GetCNServer().addr, rr.token); err != nil {
+		return err
+	}
+	rr.syncedToken = rr.token
+	return nil
+}
+
+// getCommitTS gets the latest commit timestamp of the CN server.
+func (c *clientConn) getCommitTS(sqlAddr string) (timestamp.Timestamp, error) {
+	addr := getQueryAddress(c.moCluster, sqlAddr)
+	if addr == "" {
+		return timestamp.Timestamp{}, moerr.NewInternalError(c.ctx, "cannot get query service address")
+	}
+	ctx, cancel := context.WithTimeout(c.ctx, c.readRoute.syncTimeout)
+	defer cancel()
+	req := c.queryClient.NewRequest(query.CmdMethod_GetCommit)
+	resp, err := c.queryClient.SendMessage(ctx, addr, req)
+	if err != nil {
+		return timestamp.Timestamp{}, err
+	}
+	defer c.queryClient.Release(resp)
+	return resp.GetCommit.CurrentCommitTS, nil
+}
+
+// syncCommitTS syncs the latest commit timestamp to the CN server, it returns
+// after the CN server has received the logtail until the timestamp.
+func (c *clientConn) syncCommitTS(sqlAddr string, ts timestamp.Timestamp) error {
+	addr := getQueryAddress(c.moCluster, sqlAddr)
+	if addr == "" {
+		return moerr.NewInternalError(c.ctx, "cannot get query service address")
+	}
+	ctx, cancel := context.WithTimeout(c.ctx, c.readRoute.syncTimeout)
+	defer cancel()
+	req := c.queryClient.NewRequest(query.CmdMethod_SyncCommit)
+	req.SycnCommit = &query.SyncCommitRequest{LatestCommitTS: ts}
+	resp, err := c.queryClient.SendMessage(ctx, addr, req)
+	if err != nil {
+		return err
+	}
+	c.queryClient.Release(resp)
+	return nil
+}
+
+// deprecateEOF returns true if the client sets the CLIENT_DEPRECATE_EOF
+// capability in the handshake.
+func (c *clientConn) deprecateEOF() bool {
+	if c.handshakePack == nil || len(c.handshakePack.Payload) < 4 {
+		return false
+	}
+	capabilities := binary.LittleEndian.Uint32(c.handshakePack.Payload)
+	return capabilities&frontend.CLIENT_PROTOCOL_41 != 0 &&
+		capabilities&frontend.CLIENT_DEPRECATE_EOF != 0
+}
+
+// writeToClient writes a whole MySQL packet to the client.
+func (c *clientConn) writeToClient(bs []byte) error {
+	// The server conn buf must be used because it locks writeMu.
+	_, sc := c.tun.getConns()
+	return sc.writeDataDirectly(c.RawConn(), bs)
+}
+
+// writeErrToClient writes an ERR packet to the client.
+func (c *clientConn) writeErrToClient(err error) error {
+	errCode, sqlState, errMsg := frontend.RewriteError(err, "")
+	r := &frontend.Packet{
+		SequenceID: 1,
+		Payload:    c.mysqlProto.MakeErrPayload(errCode, sqlState, errMsg),
+	}
+	return c.writeToClient(packetToBytes(r))
+}
@@ -0,0 +1,262 @@
+// Copyright 2021 - 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lni/goutils/leaktest"
+	"github.com/matrixorigin/matrixone/pkg/frontend"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRWSplitStateMakeEvent(t *testing.T) {
+	b := &msgBuf{rws: &rwSplitState{}}
+
+	readType := func(sql string) (readStmtType, bool) {
+		e, r := makeEvent(makeSimplePacket(sql), b)
+		re, ok := e.(*readEvent)
+		if !ok {
+			return 0, false
+		}
+		require.True(t, r)
+		return re.stmtType, true
+	}
+
+	t.Run("autocommit", func(t *testing.T) {
+		typ, ok := readType("select a from t1 where b = 1")
+		require.True(t, ok)
+		require.Equal(t, readStmtAutocommit, typ)
+
+		for _, sql := range []string{
+			"select a from t1 for update",
+			"select a from t1 into outfile '/tmp/a.csv'",
+			"select nextval('s1')",
+			"select LAST_INSERT_ID()",
+			"insert into t1 values (1)",
+			"update t1 set a = 1",
+			"start transaction",
+			"kill query 12",
+		} {
+			_, ok = readType(sql)
+			require.False(t, ok, sql)
+		}
+	})
+
+	t.Run("autocommit off", func(t *testing.T) {
+		_, ok := readType("set autocommit = 0")
+		require.False(t, ok)
+		_, ok = readType("select a from t1")
+		require.False(t, ok)
+		_, ok = readType("start transaction read only")
+		require.False(t, ok)
+		_, ok = readType("set autocommit = on")
+		require.False(t, ok)
+		_, ok = readType("select a from t1")
+		require.True(t, ok)
+	})
+
+	t.Run("read only txn", func(t *testing.T) {
+		typ, ok := readType("start transaction read only")
+		require.True(t, ok)
+		require.Equal(t, readStmtBegin, typ)
+
+		b.rws.setReadOnlyTxn(true)
+		typ, ok = readType("select a from t1 for update")
+		require.True(t, ok)
+		require.Equal(t, readStmtInTxn, typ)
+		typ, ok = readType("commit")
+		require.True(t, ok)
+		require.Equal(t, readStmtEnd, typ)
+		b.rws.setReadOnlyTxn(false)
+
+		_, ok = readType("commit")
+		require.False(t, ok)
+	})
+
+	t.Run("multiple statements in read only txn", func(t *testing.T) {
+		_, ok := readType("select a from t1; select b from t1")
+		require.False(t, ok)
+
+		b.rws.setReadOnlyTxn(true)
+		defer b.rws.setReadOnlyTxn(false)
+		typ, ok := readType("select a from t1; select b from t1")
+		require.True(t, ok)
+		require.Equal(t, readStmtInTxn, typ)
+		typ, ok = readType("select a into @a from t1")
+		require.True(t, ok)
+		require.Equal(t, readStmtInTxn, typ)
+		typ, ok = readType("select a from t1; commit")
+		require.True(t, ok)
+		require.Equal(t, readStmtEnd, typ)
+	})
+
+	t.Run("prepared statement in read only txn", func(t *testing.T) {
+		prepare := append([]byte{9, 0, 0, 0, byte(cmdStmtPrepare)}, "select 1"...)
+		execute := []byte{10, 0, 0, 0, byte(cmdStmtExecute), 1, 0, 0, 0, 0, 1, 0, 0, 0}
+		closeStmt := []byte{5, 0, 0, 0, byte(cmdStmtClose), 1, 0, 0, 0}
+		for _, msg := range [][]byte{prepare, execute, closeStmt} {
+			e, r := makeEvent(msg, b)
+			require.Nil(t, e)
+			require.False(t, r)
+		}
+
+		b.rws.setReadOnlyTxn(true)
+		defer b.rws.setReadOnlyTxn(false)
+		for _, msg := range [][]byte{prepare, execute} {
+			e, r := makeEvent(msg, b)
+			require.True(t, r)
+			require.Equal(t, readCmdUnsupported, e.(*readEvent).stmtType)
+		}
+		// the statement closed has no response, it goes to the primary CN server.
+		e, r := makeEvent(closeStmt, b)
+		require.Nil(t, e)
+		require.False(t, r)
+	})
+
+	t.Run("database", func(t *testing.T) {
+		_, ok := readType("use db1")
+		require.False(t, ok)
+		require.Equal(t, "db1", b.rws.getDB())
+
+		e, r := makeEvent(append([]byte{3, 0, 0, 0, byte(cmdInitDB)}, "db2"...), b)
+		require.Nil(t, e)
+		require.False(t, r)
+		require.Equal(t, "db2", b.rws.getDB())
+	})
+
+	t.Run("written", func(t *testing.T) {
+		require.False(t, b.rws.takeWritten())
+		b.rws.setWritten()
+		require.True(t, b.rws.takeWritten())
+		require.False(t, b.rws.takeWritten())
+	})
+
+	t.Run("temporary table", func(t *testing.T) {
+		_, ok := readType("create table t2 (a int)")
+		require.False(t, ok)
+		_, ok = readType("select a from t2")
+		require.True(t, ok)
+
+		_, ok = readType("create temporary table t3 (a int)")
+		require.False(t, ok)
+		_, ok = readType("select a from t3")
+		require.False(t, ok)
+		_, ok = readType("select a from t1")
+		require.False(t, ok)
+		_, ok = readType("start transaction read only")
+		require.False(t, ok)
+	})
+}
+
+func TestIsReadOnlySelect(t *testing.T) {
+	for sql, expected := range map[string]bool{
+		"select a from t1":                     true,
+		"select @a":                            true,
+		"select a into @a from t1":             false,
+		"select a, b INTO @a, @b from t1":      false,
+		"select a from t1 limit 1 into  @a":    false,
+		"select @a := a from t1":               false,
+		"select a from t1 where b = @a":        true,
+		"select a from t1 into outfile '/tmp'": false,
+	} {
+		stmt := &tree.Select{}
+		if strings.Contains(sql, "outfile") {
+			stmt.Ep = &tree.ExportParam{}
+		}
+		require.Equal(t, expected, isReadOnlySelect(sql, stmt), sql)
+	}
+}
+
+func TestReadEventFallback(t *testing.T) {
+	reqC := make(chan IEvent)
+	b := newMsgBuf("client", nil, 0, reqC, nil, false, 0)
+	b.rws = &rwSplitState{}
+
+	for _, handled := range []bool{true, false} {
+		go func() {
+			e := <-reqC
+			re, ok := e.(*readEvent)
+			require.True(t, ok)
+			re.handled = handled
+			re.notify()
+		}()
+		require.Equal(t, handled, b.consumeClient(makeSimplePacket("select 1")))
+	}
+}
+
+func TestHasMoreResults(t *testing.T) {
+	ok := []byte{7, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0}
+	require.False(t, hasMoreResults(ok, true))
+	ok[7] = byte(frontend.SERVER_MORE_RESULTS_EXISTS)
+	require.True(t, hasMoreResults(ok, true))
+
+	eof := []byte{5, 0, 0, 5, 0xFE, 0, 0, 0, 0}
+	require.False(t, hasMoreResults(eof, false))
+	eof[7] = byte(frontend.SERVER_MORE_RESULTS_EXISTS)
+	require.True(t, hasMoreResults(eof, false))
+	require.False(t, hasMoreResults(eof[:6], false))
+}
+
+func TestServerConn_ExecQuery(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	temp := os.TempDir()
+	addr := fmt.Sprintf("%s/%d.sock", temp, time.Now().Nanosecond())
+	require.NoError(t, os.RemoveAll(addr))
+	cn1 := testMakeCNServer("cn11", addr, 0, "", labelInfo{})
+	tp := newTestProxyHandler(t)
+	defer tp.closeFn()
+	stopFn := startTestCNServer(t, tp.ctx, addr, nil)
+	defer func() {
+		require.NoError(t, stopFn())
+	}()
+
+	sc, err := newServerConn(cn1, nil, tp.re, 0)
+	require.NoError(t, err)
+	_, err = sc.HandleHandshake(&frontend.Packet{Payload: []byte{1}}, time.Second*3)
+	require.NoError(t, err)
+
+	var packets [][]byte
+	collect := func(bs []byte) error {
+		packets = append(packets, bs)
+		return nil
+	}
+	ok, err := sc.ExecQuery(internalStmt{cmdType: cmdQuery, s: "set session a=1"}, false, collect)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, 1, len(packets))
+	require.True(t, isOKPacket(packets[0]))
+
+	// column count, 2 columns, EOF, 1 row and EOF.
+	packets = packets[:0]
+	ok, err = sc.ExecQuery(internalStmt{cmdType: cmdQuery, s: "show session variables"}, false, collect)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, 6, len(packets))
+	require.True(t, isEOFPacket(packets[5]))
+
+	// The connection could be used after the result set.
+	packets = packets[:0]
+	ok, err = sc.ExecQuery(internalStmt{cmdType: cmdQuery, s: "begin"}, false, collect)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, 1, len(packets))
+}
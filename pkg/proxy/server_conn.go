@@ -51,6 +51,13 @@ type ServerConn interface {
 	// The first return value indicates that if the execution result is OK.
 	// NB: the stmt can only be simple stmt, which returns OK or Err only.
 	ExecStmt(stmt internalStmt, resp chan<- []byte) (bool, error)
+	// ExecQuery executes a query on backend server, the query may return
+	// result sets. Each packet of the response is passed to fn, and the
+	// connection could be used to execute other queries after it.
+	// deprecateEOF indicates that the CLIENT_DEPRECATE_EOF capability is
+	// set, in which case the EOF packets are replaced by OK packets.
+	// The first return value indicates that if the execution result is OK.
+	ExecQuery(stmt internalStmt, deprecateEOF bool, fn func([]byte) error) (bool, error)
 	// GetCNServer returns the cn server instance of the server connection.
 	GetCNServer() *CNServer
 	// SetConnResponse sets the login response which is returned from a cn server.
@@ -222,6 +229,80 @@ func (s *serverConn) ExecStmt(stmt internalStmt, resp chan<- []byte) (bool, erro
 	return execOK, nil
 }
 
+// ExecQuery implements the ServerConn interface.
+func (s *serverConn) ExecQuery(stmt internalStmt, deprecateEOF bool, fn func([]byte) error) (bool, error) {
+	req := make([]byte, 1, len(stmt.s)+1)
+	req[0] = byte(stmt.cmdType)
+	req = append(req, []byte(stmt.s)...)
+	s.mysqlProto.SetSequenceID(0)
+	if err := s.mysqlProto.WritePacket(req); err != nil {
+		return false, err
+	}
+	for {
+		more, ok, err := s.readResult(deprecateEOF, fn)
+		if err != nil || !ok || !more {
+			return ok, err
+		}
+	}
+}
+
+// readResult reads a result of the query, which is an OK packet, an ERR
+// packet or a result set. The first return value indicates that there are
+// more results following, the second one indicates that if the result is OK.
+func (s *serverConn) readResult(deprecateEOF bool, fn func([]byte) error) (bool, bool, error) {
+	next := func() ([]byte, error) {
+		res, err := s.readPacket()
+		if err != nil {
+			return nil, err
+		}
+		bs := packetToBytes(res)
+		if err := fn(bs); err != nil {
+			return nil, err
+		}
+		return bs, nil
+	}
+
+	bs, err := next()
+	if err != nil {
+		return false, false, err
+	}
+	if isErrPacket(bs) {
+		return false, false, nil
+	}
+	if isOKPacket(bs) {
+		return hasMoreResults(bs, true), true, nil
+	}
+
+	// A result set starts with the column count packet, then the column
+	// definition packets, an EOF packet if EOF is not deprecated, and the
+	// rows, and it ends with an EOF or OK packet.
+	var mp *frontend.MysqlProtocolImpl
+	count, _, ok := mp.ReadIntLenEnc(bs, mysqlHeadLen)
+	if !ok {
+		return false, false, moerr.NewInternalErrorNoCtx("invalid column count packet")
+	}
+	if !deprecateEOF {
+		count++
+	}
+	for i := uint64(0); i < count; i++ {
+		if _, err = next(); err != nil {
+			return false, false, err
+		}
+	}
+	for {
+		if bs, err = next(); err != nil {
+			return false, false, err
+		}
+		if isErrPacket(bs) {
+			return false, false, nil
+		}
+		// A row may start with 0xFE, but it is longer than the EOF packet.
+		if isEOFPacket(bs) && len(bs) < mysqlHeadLen+maxEOFPacketLen {
+			return hasMoreResults(bs, deprecateEOF), true, nil
+		}
+	}
+}
+
 // GetCNServer implements the ServerConn interface.
 func (s *serverConn) GetCNServer() *CNServer {
 	return s.cnServer
@@ -314,6 +395,9 @@ type CNServer struct {
 	internalConn bool
 	// clientAddr is the real client address.
 	clientAddr string
+	// authReply replies the auth requests sent by CN server in the
+	// handshake phase, such as AuthSwitchRequest and AuthMoreData.
+	authReply func(req []byte) ([]byte, error)
 }
 
 // Connect connects to backend server and returns IOSession.
@@ -86,6 +86,9 @@ func (s *mockServerConn) ExecStmt(stmt internalStmt, resp chan<- []byte) (bool,
 	}
 	return true, nil
 }
+func (s *mockServerConn) ExecQuery(stmt internalStmt, _ bool, fn func([]byte) error) (bool, error) {
+	return true, fn(makeOKPacket(8))
+}
 func (s *mockServerConn) GetCNServer() *CNServer   { return nil }
 func (s *mockServerConn) SetConnResponse(_ []byte) {}
 func (s *mockServerConn) GetConnResponse() []byte  { return nil }
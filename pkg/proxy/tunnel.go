@@ -77,6 +77,14 @@ func withConnCacheEnabled(v bool) tunnelOption {
 	}
 }
 
+func withReadWriteSplit(v bool) tunnelOption {
+	return func(t *tunnel) {
+		if v {
+			t.rws = &rwSplitState{}
+		}
+	}
+}
+
 type transferType int
 
 const (
@@ -108,6 +116,9 @@ type tunnel struct {
 	rebalancePolicy RebalancePolicy
 	// connCacheEnabled indicates if the connection cache is enabled.
 	connCacheEnabled bool
+	// rws is the read/write splitting state of the session, it is nil if
+	// read/write splitting is disabled.
+	rws *rwSplitState
 	// transferType is the type for transferring: rebalancing and scaling.
 	transferType transferType
 	// realConn indicates the connection in the tunnel is a real network
@@ -188,6 +199,7 @@ func (t *tunnel) run(cc ClientConn, sc ServerConn) error {
 			t.connCacheEnabled,
 			cc.ConnID(),
 		)
+		t.mu.clientConn.rws = t.rws
 		t.mu.serverConn = newMySQLConn(
 			connServerName,
 			sc.RawConn(),
@@ -16,6 +16,7 @@ package proxy
 
 import (
 	"crypto/md5"
+	"encoding/binary"
 	"encoding/hex"
 	"encoding/json"
 	"net"
@@ -115,6 +116,31 @@ func isErrPacket(p []byte) bool {
 	return false
 }
 
+// hasMoreResults returns true if the SERVER_MORE_RESULTS_EXISTS flag is set
+// in the packet which ends a result. okFormat indicates that the packet is an
+// OK packet, otherwise, it is an EOF packet.
+func hasMoreResults(p []byte, okFormat bool) bool {
+	var status uint16
+	if okFormat {
+		var mp *frontend.MysqlProtocolImpl
+		_, pos, ok := mp.ReadIntLenEnc(p, mysqlHeadLen+1)
+		if !ok {
+			return false
+		}
+		_, pos, ok = mp.ReadIntLenEnc(p, pos)
+		if !ok || len(p[pos:]) < 2 {
+			return false
+		}
+		status = binary.LittleEndian.Uint16(p[pos:])
+	} else {
+		if len(p) < mysqlHeadLen+5 {
+			return false
+		}
+		status = binary.LittleEndian.Uint16(p[mysqlHeadLen+3:])
+	}
+	return status&frontend.SERVER_MORE_RESULTS_EXISTS != 0
+}
+
 // packetToBytes convert Packet to bytes.
 func packetToBytes(p *frontend.Packet) []byte {
 	if p == nil || len(p.Payload) == 0 {
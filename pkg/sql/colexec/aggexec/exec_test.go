@@ -370,6 +370,66 @@ func TestGroupConcatExec(t *testing.T) {
 
 // TestEmptyNullFlag test if the emptyNull flag is working.
 // if the emptyNull flag is true, empty groups will return NULL as the result.
+func TestJsonAggExec(t *testing.T) {
+	mg := newTestAggMemoryManager()
+	arrayAggId, objectAggId := gUniqueAggIdForTest(), gUniqueAggIdForTest()
+	RegisterJsonArrayAgg(arrayAggId)
+	RegisterJsonObjectAgg(objectAggId)
+
+	keys := vector.NewVec(types.T_varchar.ToType())
+	values := vector.NewVec(types.T_int64.ToType())
+	require.NoError(t, vector.AppendStringList(keys, []string{"a", "b", "c", "a"}, nil, mg.Mp()))
+	require.NoError(t, vector.AppendFixedList(values, []int64{1, 2, 0, 4}, []bool{false, false, true, false}, mg.Mp()))
+
+	{
+		// json_arrayagg of the values, rows 0 and 1 are of group 0, rows 2 and 3 are filled into
+		// another executor and merged into group 0, group 1 is empty.
+		// the result is ["[1, 2, null, 4]", null].
+		executor1 := MakeAgg(mg, arrayAggId, false, types.T_int64.ToType())
+		executor2 := MakeAgg(mg, arrayAggId, false, types.T_int64.ToType())
+		require.NoError(t, executor1.GroupGrow(2))
+		require.NoError(t, executor2.GroupGrow(1))
+		require.NoError(t, executor1.BatchFill(0, []uint64{1, 1}, []*vector.Vector{values}))
+		require.NoError(t, executor2.BatchFill(2, []uint64{1, 1}, []*vector.Vector{values}))
+		require.NoError(t, executor1.Merge(executor2, 0, 0))
+
+		v, err := executor1.Flush()
+		require.NoError(t, err)
+		require.Equal(t, 2, v.Length())
+		require.Equal(t, "[1, 2, null, 4]", types.DecodeJson(v.GetBytesAt(0)).String())
+		require.True(t, v.IsNull(1))
+		v.Free(mg.Mp())
+		executor1.Free()
+		executor2.Free()
+	}
+	{
+		// json_objectagg of the keys and values, the last value of key 'a' wins.
+		executor := MakeAgg(mg, objectAggId, false, types.T_varchar.ToType(), types.T_int64.ToType())
+		require.NoError(t, executor.GroupGrow(1))
+		require.NoError(t, executor.BulkFill(0, []*vector.Vector{keys, values}))
+
+		v, err := executor.Flush()
+		require.NoError(t, err)
+		require.Equal(t, `{"a": 4, "b": 2, "c": null}`, types.DecodeJson(v.GetBytesAt(0)).String())
+		v.Free(mg.Mp())
+		executor.Free()
+	}
+	{
+		// a null key is not allowed.
+		nullKeys := vector.NewVec(types.T_varchar.ToType())
+		require.NoError(t, vector.AppendStringList(nullKeys, []string{""}, []bool{true}, mg.Mp()))
+		executor := MakeAgg(mg, objectAggId, false, types.T_varchar.ToType(), types.T_int64.ToType())
+		require.NoError(t, executor.GroupGrow(1))
+		require.Error(t, executor.Fill(0, 0, []*vector.Vector{nullKeys, values}))
+		executor.Free()
+		nullKeys.Free(mg.Mp())
+	}
+
+	keys.Free(mg.Mp())
+	values.Free(mg.Mp())
+	require.Equal(t, int64(0), mg.Mp().CurrNB())
+}
+
 func TestEmptyNullFlag(t *testing.T) {
 	mg := newTestAggMemoryManager()
 	{
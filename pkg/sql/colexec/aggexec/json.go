@@ -0,0 +1,244 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggexec
+
+import (
+	"slices"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+func JsonAggReturnType(_ []types.Type) types.Type {
+	return types.T_json.ToType()
+}
+
+// json_arrayagg(value) and json_objectagg(key, value) are special aggregation functions.
+//
+// the result of a group is a list of length-prefixed entries until flush,
+// an entry is one encoded json value for json_arrayagg, and a key followed by
+// an encoded json value for json_objectagg.
+// the list is built into one json document at flush, so that neither filling nor merging
+// needs to decode the document.
+type jsonAggExec struct {
+	multiAggInfo
+	ret aggFuncBytesResult
+	distinctHash
+
+	object bool
+}
+
+func newJsonAggExec(mg AggMemoryManager, info multiAggInfo, object bool) AggFuncExec {
+	exec := &jsonAggExec{
+		multiAggInfo: info,
+		ret:          initBytesAggFuncResult(mg, info.retType, info.emptyNull),
+		object:       object,
+	}
+	if info.distinct {
+		exec.distinctHash = newDistinctHash(mg.Mp(), true)
+	}
+	return exec
+}
+
+func (exec *jsonAggExec) marshal() ([]byte, error) {
+	d := exec.multiAggInfo.getEncoded()
+	r, err := exec.ret.marshal()
+	if err != nil {
+		return nil, err
+	}
+	encoded := &EncodedAgg{
+		Info:   d,
+		Result: r,
+		Groups: nil,
+	}
+	return encoded.Marshal()
+}
+
+func (exec *jsonAggExec) unmarshal(mp *mpool.MPool, result []byte, groups [][]byte) error {
+	return exec.ret.unmarshal(result)
+}
+
+func (exec *jsonAggExec) GroupGrow(more int) error {
+	if exec.IsDistinct() {
+		if err := exec.distinctHash.grows(more); err != nil {
+			return err
+		}
+	}
+	return exec.ret.grows(more)
+}
+
+func (exec *jsonAggExec) PreAllocateGroups(more int) error {
+	return exec.ret.preAllocate(more)
+}
+
+func appendJsonAggEntry(r []byte, entry []byte) []byte {
+	n := uint32(len(entry))
+	r = append(r, types.EncodeUint32(&n)...)
+	return append(r, entry...)
+}
+
+func (exec *jsonAggExec) Fill(groupIndex int, row int, vectors []*vector.Vector) error {
+	u64Row := uint64(row)
+	if exec.object && vectors[0].IsNull(u64Row) {
+		return moerr.NewInvalidInputNoCtx("JSON documents may not contain NULL member names")
+	}
+
+	if exec.IsDistinct() {
+		if need, err := exec.distinctHash.fill(groupIndex, vectors, row); err != nil || !need {
+			return err
+		}
+	}
+
+	exec.ret.groupToSet = groupIndex
+	exec.ret.setGroupNotEmpty(groupIndex)
+	// clip it, the bytes are in the area of the result vector.
+	r := slices.Clip(exec.ret.aggGet())
+
+	value := vectors[0]
+	if exec.object {
+		r = appendJsonAggEntry(r, vectors[0].GetBytesAt(row))
+		value = vectors[1]
+	}
+	bj := bytejson.Null
+	if !value.IsNull(u64Row) {
+		var err error
+		if bj, err = types.ValueToByteJson(*value.GetType(), value.GetRawBytesAt(row), time.Local); err != nil {
+			return err
+		}
+	}
+	data, err := bj.Marshal()
+	if err != nil {
+		return err
+	}
+	r = appendJsonAggEntry(r, data)
+	return exec.ret.aggSet(r)
+}
+
+func (exec *jsonAggExec) BulkFill(groupIndex int, vectors []*vector.Vector) error {
+	for row, end := 0, vectors[0].Length(); row < end; row++ {
+		if err := exec.Fill(groupIndex, row, vectors); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (exec *jsonAggExec) BatchFill(offset int, groups []uint64, vectors []*vector.Vector) error {
+	for i, j, idx := offset, offset+len(groups), 0; i < j; i++ {
+		if groups[idx] != GroupNotMatched {
+			if err := exec.Fill(int(groups[idx]-1), i, vectors); err != nil {
+				return err
+			}
+		}
+		idx++
+	}
+	return nil
+}
+
+func (exec *jsonAggExec) SetExtraInformation(partialResult any, groupIndex int) error {
+	panic("json aggregation function does not support extra information")
+}
+
+func (exec *jsonAggExec) merge(other *jsonAggExec, idx1, idx2 int) error {
+	exec.ret.groupToSet = idx1
+	other.ret.groupToSet = idx2
+	exec.ret.mergeEmpty(other.ret.basicResult, idx1, idx2)
+	if err := exec.distinctHash.merge(&other.distinctHash); err != nil {
+		return err
+	}
+
+	v2 := other.ret.aggGet()
+	if len(v2) == 0 {
+		return nil
+	}
+	v1 := slices.Clip(exec.ret.aggGet())
+	return exec.ret.aggSet(append(v1, v2...))
+}
+
+func (exec *jsonAggExec) Merge(next AggFuncExec, groupIdx1, groupIdx2 int) error {
+	return exec.merge(next.(*jsonAggExec), groupIdx1, groupIdx2)
+}
+
+func (exec *jsonAggExec) BatchMerge(next AggFuncExec, offset int, groups []uint64) error {
+	other := next.(*jsonAggExec)
+	for i := range groups {
+		if groups[i] == GroupNotMatched {
+			continue
+		}
+		if err := exec.merge(other, int(groups[i])-1, i+offset); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// build turns the entry list of a group into the json document.
+func (exec *jsonAggExec) build(entries []byte) ([]byte, error) {
+	var keys []string
+	var vals []bytejson.ByteJson
+	next := func() []byte {
+		n := types.DecodeUint32(entries[:4])
+		entry := entries[4 : 4+n]
+		entries = entries[4+n:]
+		return entry
+	}
+	for len(entries) > 0 {
+		if exec.object {
+			keys = append(keys, string(next()))
+		}
+		var bj bytejson.ByteJson
+		if err := bj.Unmarshal(next()); err != nil {
+			return nil, err
+		}
+		vals = append(vals, bj)
+	}
+
+	var doc bytejson.ByteJson
+	if exec.object {
+		var err error
+		if doc, err = bytejson.BuildObject(keys, vals); err != nil {
+			return nil, err
+		}
+	} else {
+		doc = bytejson.BuildArray(vals)
+	}
+	return doc.Marshal()
+}
+
+func (exec *jsonAggExec) Flush() (*vector.Vector, error) {
+	for i, j := 0, exec.ret.res.Length(); i < j; i++ {
+		if exec.ret.groupIsEmpty(i) {
+			continue
+		}
+		exec.ret.groupToSet = i
+		doc, err := exec.build(exec.ret.aggGet())
+		if err != nil {
+			return nil, err
+		}
+		if err = exec.ret.aggSet(doc); err != nil {
+			return nil, err
+		}
+	}
+	return exec.ret.flush(), nil
+}
+
+func (exec *jsonAggExec) Free() {
+	exec.distinctHash.free()
+	exec.ret.free()
+}
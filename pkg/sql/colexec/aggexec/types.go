@@ -119,6 +119,7 @@ var (
 	_ AggFuncExec = (*multiAggFuncExec1[int8])(nil)
 	_ AggFuncExec = (*multiAggFuncExec2)(nil)
 	_ AggFuncExec = &groupConcatExec{}
+	_ AggFuncExec = &jsonAggExec{}
 )
 
 var (
@@ -246,12 +247,18 @@ func makeSpecialAggExec(
 			return makeGroupConcat(mg, id, isDistinct, params, getCroupConcatRet(params...), groupConcatSep), true, nil
 		case aggIdOfApproxCount:
 			return makeApproxCount(mg, id, params[0]), true, nil
+		case aggIdOfJsonArrayAgg, aggIdOfJsonObjectAgg:
+			return makeJsonAgg(mg, id, isDistinct, params), true, nil
 		case aggIdOfClusterCenters:
 			exec, err := makeClusterCenters(mg, id, isDistinct, params[0])
 			return exec, true, err
-		case winIdOfRowNumber, winIdOfRank, winIdOfDenseRank:
+		case winIdOfRowNumber, winIdOfRank, winIdOfDenseRank, winIdOfNtile,
+			winIdOfPercentRank, winIdOfCumeDist:
 			exec, err := makeWindowExec(mg, id, isDistinct)
 			return exec, true, err
+		case winIdOfLag, winIdOfLead, winIdOfFirstValue, winIdOfLastValue, winIdOfNthValue:
+			exec, err := makeValueWindowExec(mg, id, isDistinct, params[0])
+			return exec, true, err
 		}
 	}
 	return nil, false, nil
@@ -274,6 +281,22 @@ func makeGroupConcat(
 	return newGroupConcatExec(mg, info, separator)
 }
 
+// makeJsonAgg is one special case of makeMultiAgg.
+// it supports creating an aggregation function executor for `json_arrayagg()` and `json_objectagg()`.
+func makeJsonAgg(
+	mg AggMemoryManager,
+	aggID int64, isDistinct bool,
+	param []types.Type) AggFuncExec {
+	info := multiAggInfo{
+		aggID:     aggID,
+		distinct:  isDistinct,
+		argTypes:  param,
+		retType:   JsonAggReturnType(param),
+		emptyNull: true,
+	}
+	return newJsonAggExec(mg, info, aggID == aggIdOfJsonObjectAgg)
+}
+
 func makeCount(
 	mg AggMemoryManager, isStar bool,
 	aggID int64, isDistinct bool,
@@ -329,5 +352,25 @@ func makeWindowExec(
 		retType:   types.T_int64.ToType(),
 		emptyNull: false,
 	}
+	if aggID == winIdOfPercentRank || aggID == winIdOfCumeDist {
+		info.retType = WindowDistributionReturnType(nil)
+		return makePercentRankCumeDist(mg, info), nil
+	}
 	return makeRankDenseRankRowNumber(mg, info), nil
 }
+
+func makeValueWindowExec(
+	mg AggMemoryManager, aggID int64, isDistinct bool, param types.Type) (AggFuncExec, error) {
+	if isDistinct {
+		return nil, moerr.NewInternalErrorNoCtx("window function does not support `distinct`")
+	}
+
+	info := singleAggInfo{
+		aggID:     aggID,
+		distinct:  false,
+		argType:   param,
+		retType:   WindowValueReturnType([]types.Type{param}),
+		emptyNull: true,
+	}
+	return newValueWindowExec(mg, info), nil
+}
@@ -25,12 +25,22 @@ func SingleWindowReturnType(_ []types.Type) types.Type {
 	return types.T_int64.ToType()
 }
 
+func WindowDistributionReturnType(_ []types.Type) types.Type {
+	return types.T_float64.ToType()
+}
+
+func WindowValueReturnType(typs []types.Type) types.Type {
+	return typs[0]
+}
+
 // special structure for a single column window function.
 type singleWindowExec struct {
 	singleAggInfo
 	ret aggFuncResult[int64]
 
 	groups [][]int64
+	// bucket number of ntile(), read from the second vector of Fill.
+	buckets int64
 }
 
 func makeRankDenseRankRowNumber(mg AggMemoryManager, info singleAggInfo) AggFuncExec {
@@ -50,6 +60,17 @@ func (exec *singleWindowExec) PreAllocateGroups(more int) error {
 }
 
 func (exec *singleWindowExec) Fill(groupIndex int, row int, vectors []*vector.Vector) error {
+	if exec.singleAggInfo.aggID == winIdOfNtile && exec.buckets == 0 {
+		buckets, err := getWindowConstantArgument(vectors, 1, "ntile")
+		if err != nil {
+			return err
+		}
+		if buckets == 0 {
+			return moerr.NewInvalidInputNoCtx("incorrect arguments to ntile")
+		}
+		exec.buckets = buckets
+	}
+
 	value := vector.MustFixedColWithTypeCheck[int64](vectors[0])[row]
 	exec.groups[groupIndex] = append(exec.groups[groupIndex], value)
 	return nil
@@ -127,6 +148,8 @@ func (exec *singleWindowExec) Flush() (*vector.Vector, error) {
 		return exec.flushDenseRank()
 	case winIdOfRowNumber:
 		return exec.flushRowNumber()
+	case winIdOfNtile:
+		return exec.flushNtile()
 	}
 	return nil, moerr.NewInternalErrorNoCtx("invalid window function")
 }
@@ -196,3 +219,296 @@ func (exec *singleWindowExec) flushRowNumber() (*vector.Vector, error) {
 	}
 	return exec.ret.flush(), nil
 }
+
+func (exec *singleWindowExec) flushNtile() (*vector.Vector, error) {
+	values := exec.ret.values
+
+	idx := 0
+	for _, group := range exec.groups {
+		if len(group) == 0 {
+			continue
+		}
+
+		// the first (n % buckets) buckets have one more row than the others.
+		n := group[len(group)-1] - group[0]
+		size, extra := n/exec.buckets, n%exec.buckets
+		bound := extra * (size + 1)
+		for j := int64(0); j < n; j++ {
+			if j < bound {
+				values[idx] = j/(size+1) + 1
+			} else {
+				values[idx] = (j-bound)/size + extra + 1
+			}
+			idx++
+		}
+	}
+	return exec.ret.flush(), nil
+}
+
+// getWindowConstantArgument returns the value of a constant non-negative integer argument,
+// it was used by ntile(), lag(), lead() and nth_value().
+func getWindowConstantArgument(vectors []*vector.Vector, idx int, name string) (int64, error) {
+	if len(vectors) <= idx || !vectors[idx].IsConst() || vectors[idx].IsConstNull() {
+		return 0, moerr.NewInvalidInputNoCtxf("incorrect arguments to %s", name)
+	}
+	value := vector.MustFixedColWithTypeCheck[int64](vectors[idx])[0]
+	if value < 0 {
+		return 0, moerr.NewInvalidInputNoCtxf("incorrect arguments to %s", name)
+	}
+	return value, nil
+}
+
+// GetWindowOffset returns the offset of lag() and lead(), which is 1 if not given.
+func GetWindowOffset(vectors []*vector.Vector, name string) (int64, error) {
+	if len(vectors) < 2 {
+		return 1, nil
+	}
+	return getWindowConstantArgument(vectors, 1, name)
+}
+
+// special structure for percent_rank() and cume_dist().
+// they are computed from the peer groups like rank(), but return a float64 result.
+type windowDistributionExec struct {
+	singleAggInfo
+	ret aggFuncResult[float64]
+
+	groups [][]int64
+}
+
+func makePercentRankCumeDist(mg AggMemoryManager, info singleAggInfo) AggFuncExec {
+	return &windowDistributionExec{
+		singleAggInfo: info,
+		ret:           initFixedAggFuncResult[float64](mg, info.retType, info.emptyNull),
+	}
+}
+
+func (exec *windowDistributionExec) GroupGrow(more int) error {
+	exec.groups = append(exec.groups, make([][]int64, more)...)
+	return exec.ret.grows(more)
+}
+
+func (exec *windowDistributionExec) PreAllocateGroups(more int) error {
+	return exec.ret.preAllocate(more)
+}
+
+func (exec *windowDistributionExec) Fill(groupIndex int, row int, vectors []*vector.Vector) error {
+	value := vector.MustFixedColWithTypeCheck[int64](vectors[0])[row]
+	exec.groups[groupIndex] = append(exec.groups[groupIndex], value)
+	return nil
+}
+
+func (exec *windowDistributionExec) marshal() ([]byte, error) {
+	d := exec.singleAggInfo.getEncoded()
+	r, err := exec.ret.marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	encoded := &EncodedAgg{
+		Info:   d,
+		Result: r,
+		Groups: nil,
+	}
+	if len(exec.groups) > 0 {
+		encoded.Groups = make([][]byte, len(exec.groups))
+		for i := range encoded.Groups {
+			encoded.Groups[i] = types.EncodeSlice[int64](exec.groups[i])
+		}
+	}
+	return encoded.Marshal()
+}
+
+func (exec *windowDistributionExec) unmarshal(mp *mpool.MPool, result []byte, groups [][]byte) error {
+	if len(groups) > 0 {
+		exec.groups = make([][]int64, len(groups))
+		for i := range exec.groups {
+			if len(groups[i]) > 0 {
+				exec.groups[i] = types.DecodeSlice[int64](groups[i])
+			}
+		}
+	}
+	return exec.ret.unmarshal(result)
+}
+
+func (exec *windowDistributionExec) BulkFill(groupIndex int, vectors []*vector.Vector) error {
+	panic("implement me")
+}
+
+func (exec *windowDistributionExec) BatchFill(offset int, groups []uint64, vectors []*vector.Vector) error {
+	panic("implement me")
+}
+
+func (exec *windowDistributionExec) Merge(next AggFuncExec, groupIdx1, groupIdx2 int) error {
+	other := next.(*windowDistributionExec)
+	exec.groups[groupIdx1] = append(exec.groups[groupIdx1], other.groups[groupIdx2]...)
+	return nil
+}
+
+func (exec *windowDistributionExec) BatchMerge(next AggFuncExec, offset int, groups []uint64) error {
+	other := next.(*windowDistributionExec)
+	for i := range groups {
+		if groups[i] != GroupNotMatched {
+			groupIdx1 := int(groups[i] - 1)
+			groupIdx2 := i + offset
+
+			exec.groups[groupIdx1] = append(exec.groups[groupIdx1], other.groups[groupIdx2]...)
+		}
+	}
+	return nil
+}
+
+func (exec *windowDistributionExec) SetExtraInformation(partialResult any, groupIndex int) error {
+	panic("window function do not support the extra information")
+}
+
+func (exec *windowDistributionExec) Flush() (*vector.Vector, error) {
+	values := exec.ret.values
+	isPercentRank := exec.singleAggInfo.aggID == winIdOfPercentRank
+
+	idx := 0
+	for _, group := range exec.groups {
+		if len(group) == 0 {
+			continue
+		}
+
+		n := float64(group[len(group)-1] - group[0])
+		for i := 1; i < len(group); i++ {
+			var v float64
+			if isPercentRank {
+				// (rank - 1) / (rows - 1), and 0 for a partition with only one row.
+				if n > 1 {
+					v = float64(group[i-1]-group[0]) / (n - 1)
+				}
+			} else {
+				// rows preceding or peer with the current row / rows.
+				v = float64(group[i]-group[0]) / n
+			}
+
+			for k := idx + int(group[i]-group[i-1]); idx < k; idx++ {
+				values[idx] = v
+			}
+		}
+	}
+	return exec.ret.flush(), nil
+}
+
+func (exec *windowDistributionExec) Free() {
+	exec.ret.free()
+}
+
+// special structure for the value window functions, lag(), lead(), first_value(), last_value() and nth_value().
+//
+// the window operator fills the rows of each group in order, and the executor keeps the value of
+// the first row, the last row or the n-th row.
+// for lag() and lead(), the operator only fills the target row or the default value.
+type valueWindowExec struct {
+	singleAggInfo
+	ret basicResult
+
+	// number of rows filled for each group.
+	counts []int64
+	// the row number of nth_value(), read from the second vector of Fill.
+	nth int64
+}
+
+func newValueWindowExec(mg AggMemoryManager, info singleAggInfo) AggFuncExec {
+	exec := &valueWindowExec{
+		singleAggInfo: info,
+	}
+	exec.ret.init(mg, info.retType, info.emptyNull)
+	return exec
+}
+
+func (exec *valueWindowExec) GroupGrow(more int) error {
+	exec.counts = append(exec.counts, make([]int64, more)...)
+	_, _, err := exec.ret.extend(more)
+	return err
+}
+
+func (exec *valueWindowExec) PreAllocateGroups(more int) error {
+	return exec.ret.preAllocate(more)
+}
+
+func (exec *valueWindowExec) Fill(groupIndex int, row int, vectors []*vector.Vector) error {
+	exec.counts[groupIndex]++
+
+	switch exec.singleAggInfo.aggID {
+	case winIdOfLastValue:
+	case winIdOfNthValue:
+		if exec.nth == 0 {
+			nth, err := getWindowConstantArgument(vectors, 1, "nth_value")
+			if err != nil {
+				return err
+			}
+			if nth == 0 {
+				return moerr.NewInvalidInputNoCtx("incorrect arguments to nth_value")
+			}
+			exec.nth = nth
+		}
+		if exec.counts[groupIndex] != exec.nth {
+			return nil
+		}
+	default:
+		if exec.counts[groupIndex] != 1 {
+			return nil
+		}
+	}
+
+	if vectors[0].IsNull(uint64(row)) {
+		exec.ret.empty[groupIndex] = true
+		return nil
+	}
+	exec.ret.setGroupNotEmpty(groupIndex)
+	return exec.ret.res.Copy(vectors[0], int64(groupIndex), int64(row), exec.ret.mp)
+}
+
+func (exec *valueWindowExec) marshal() ([]byte, error) {
+	d := exec.singleAggInfo.getEncoded()
+	r, err := exec.ret.marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	encoded := &EncodedAgg{
+		Info:   d,
+		Result: r,
+		Groups: [][]byte{types.EncodeSlice[int64](exec.counts), types.EncodeInt64(&exec.nth)},
+	}
+	return encoded.Marshal()
+}
+
+func (exec *valueWindowExec) unmarshal(mp *mpool.MPool, result []byte, groups [][]byte) error {
+	if len(groups) == 2 {
+		exec.counts = append(exec.counts[:0], types.DecodeSlice[int64](groups[0])...)
+		exec.nth = types.DecodeInt64(groups[1])
+	}
+	return exec.ret.unmarshal0(result)
+}
+
+func (exec *valueWindowExec) BulkFill(groupIndex int, vectors []*vector.Vector) error {
+	panic("implement me")
+}
+
+func (exec *valueWindowExec) BatchFill(offset int, groups []uint64, vectors []*vector.Vector) error {
+	panic("implement me")
+}
+
+func (exec *valueWindowExec) Merge(next AggFuncExec, groupIdx1, groupIdx2 int) error {
+	return moerr.NewInternalErrorNoCtx("value window function does not support merge")
+}
+
+func (exec *valueWindowExec) BatchMerge(next AggFuncExec, offset int, groups []uint64) error {
+	return moerr.NewInternalErrorNoCtx("value window function does not support merge")
+}
+
+func (exec *valueWindowExec) SetExtraInformation(partialResult any, groupIndex int) error {
+	panic("window function do not support the extra information")
+}
+
+func (exec *valueWindowExec) Flush() (*vector.Vector, error) {
+	return exec.ret.flush(), nil
+}
+
+func (exec *valueWindowExec) Free() {
+	exec.ret.free()
+}
@@ -0,0 +1,137 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggexec
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/stretchr/testify/require"
+)
+
+// fillPeerGroups fills the peer group boundaries of one partition like the window operator.
+func fillPeerGroups(t *testing.T, executor AggFuncExec, rows int, bounds []int64, args ...*vector.Vector) {
+	mg := newTestAggMemoryManager()
+	vec := vector.NewVec(types.T_int64.ToType())
+	require.NoError(t, vector.AppendFixedList(vec, bounds, nil, mg.Mp()))
+	vecs := append([]*vector.Vector{vec}, args...)
+
+	require.NoError(t, executor.GroupGrow(rows))
+	for i := range bounds {
+		require.NoError(t, executor.Fill(0, i, vecs))
+	}
+	vec.Free(mg.Mp())
+}
+
+func TestWindowNtile(t *testing.T) {
+	mg := newTestAggMemoryManager()
+	id := gUniqueAggIdForTest()
+	RegisterNtileWin(id)
+
+	// ntile(3) of 7 rows.
+	buckets, err := vector.NewConstFixed(types.T_int64.ToType(), int64(3), 1, mg.Mp())
+	require.NoError(t, err)
+	executor := MakeAgg(mg, id, false, types.T_int64.ToType())
+	fillPeerGroups(t, executor, 7, []int64{0, 2, 5, 7}, buckets)
+
+	v, err := executor.Flush()
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 1, 1, 2, 2, 3, 3}, vector.MustFixedColWithTypeCheck[int64](v))
+	v.Free(mg.Mp())
+	executor.Free()
+
+	// ntile(0) is invalid.
+	zero, err := vector.NewConstFixed(types.T_int64.ToType(), int64(0), 1, mg.Mp())
+	require.NoError(t, err)
+	executor = MakeAgg(mg, id, false, types.T_int64.ToType())
+	require.NoError(t, executor.GroupGrow(1))
+	require.Error(t, executor.Fill(0, 0, []*vector.Vector{zero, zero}))
+	executor.Free()
+
+	buckets.Free(mg.Mp())
+	zero.Free(mg.Mp())
+	require.Equal(t, int64(0), mg.Mp().CurrNB())
+}
+
+func TestWindowPercentRankAndCumeDist(t *testing.T) {
+	mg := newTestAggMemoryManager()
+	percentRank, cumeDist := gUniqueAggIdForTest(), gUniqueAggIdForTest()
+	RegisterPercentRankWin(percentRank)
+	RegisterCumeDistWin(cumeDist)
+
+	// peer groups of the partition are [0], [1, 2] and [3].
+	for id, expected := range map[int64][]float64{
+		percentRank: {0, 1.0 / 3, 1.0 / 3, 1},
+		cumeDist:    {0.25, 0.75, 0.75, 1},
+	} {
+		executor := MakeAgg(mg, id, false, types.T_int64.ToType())
+		fillPeerGroups(t, executor, 4, []int64{0, 1, 3, 4})
+
+		v, err := executor.Flush()
+		require.NoError(t, err)
+		require.Equal(t, types.T_float64, v.GetType().Oid)
+		require.Equal(t, expected, vector.MustFixedColWithTypeCheck[float64](v))
+		v.Free(mg.Mp())
+		executor.Free()
+	}
+	require.Equal(t, int64(0), mg.Mp().CurrNB())
+}
+
+func TestWindowValueFunctions(t *testing.T) {
+	mg := newTestAggMemoryManager()
+	firstValue, lastValue, nthValue := gUniqueAggIdForTest(), gUniqueAggIdForTest(), gUniqueAggIdForTest()
+	RegisterFirstValueWin(firstValue)
+	RegisterLastValueWin(lastValue)
+	RegisterNthValueWin(nthValue)
+
+	typ := types.T_varchar.ToType()
+	input := vector.NewVec(typ)
+	require.NoError(t, vector.AppendStringList(input,
+		[]string{"a", "", "a very long string over the inline size", "d"}, []bool{false, true, false, false}, mg.Mp()))
+	nth, err := vector.NewConstFixed(types.T_int64.ToType(), int64(3), 1, mg.Mp())
+	require.NoError(t, err)
+
+	// the frame of each row is from the previous row to the next row.
+	frames := [][2]int{{0, 2}, {0, 3}, {1, 4}, {2, 4}}
+	for id, expected := range map[int64][]string{
+		firstValue: {"a", "a", "", "a very long string over the inline size"},
+		lastValue:  {"", "a very long string over the inline size", "d", "d"},
+		nthValue:   {"", "a very long string over the inline size", "d", ""},
+	} {
+		executor := MakeAgg(mg, id, false, typ)
+		require.NoError(t, executor.GroupGrow(len(frames)))
+		for i, frame := range frames {
+			for k := frame[0]; k < frame[1]; k++ {
+				require.NoError(t, executor.Fill(i, k, []*vector.Vector{input, nth}))
+			}
+		}
+
+		v, err := executor.Flush()
+		require.NoError(t, err)
+		for i := range expected {
+			if expected[i] == "" {
+				require.True(t, v.IsNull(uint64(i)))
+			} else {
+				require.Equal(t, expected[i], v.GetStringAt(i))
+			}
+		}
+		v.Free(mg.Mp())
+		executor.Free()
+	}
+	input.Free(mg.Mp())
+	nth.Free(mg.Mp())
+	require.Equal(t, int64(0), mg.Mp().CurrNB())
+}
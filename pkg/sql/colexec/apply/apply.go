@@ -17,8 +17,9 @@ package apply
 import (
 	"bytes"
 
-	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
@@ -36,17 +37,162 @@ func (apply *Apply) String(buf *bytes.Buffer) {
 }
 
 func (apply *Apply) OpType() vm.OpType {
-	return vm.Anti
+	return vm.Apply
 }
 
 func (apply *Apply) Prepare(proc *process.Process) (err error) {
+	if apply.OpAnalyzer == nil {
+		apply.OpAnalyzer = process.NewAnalyzer(apply.GetIdx(), apply.IsFirst, apply.IsLast, "apply")
+	} else {
+		apply.OpAnalyzer.Reset()
+	}
+
+	if err = apply.TableFunction.ApplyPrepare(proc); err != nil {
+		return err
+	}
+	if apply.ProjectList != nil {
+		return apply.PrepareProjection(proc)
+	}
 	return nil
 }
 
 func (apply *Apply) Call(proc *process.Process) (vm.CallResult, error) {
+	if err, isCancel := vm.CancelCheck(proc); isCancel {
+		return vm.CancelResult, err
+	}
+
+	analyzer := apply.OpAnalyzer
+	analyzer.Start()
+	defer analyzer.Stop()
+
 	ctr := &apply.ctr
 	result := vm.NewCallResult()
-	ctr.batchRowCount = 0
-	ctr.rbat = batch.NewWithSize(len(apply.Result))
-	return result, moerr.NewInternalErrorNoCtx("apply call")
+	var err error
+	for {
+		switch ctr.state {
+		case Probe:
+			if ctr.inbat == nil {
+				result, err = vm.ChildrenCall(apply.GetChildren(0), proc, analyzer)
+				if err != nil {
+					return result, err
+				}
+				if result.Batch == nil {
+					ctr.state = End
+					continue
+				}
+				if result.Batch.IsEmpty() {
+					continue
+				}
+				ctr.inbat = result.Batch
+				if err = apply.TableFunction.ApplyArgsEval(ctr.inbat, proc); err != nil {
+					return result, err
+				}
+				ctr.probeIdx = 0
+				ctr.matched = false
+				if err = apply.TableFunction.ApplyStart(ctr.probeIdx, proc); err != nil {
+					return result, err
+				}
+			}
+
+			if ctr.rbat == nil {
+				ctr.rbat = batch.NewWithSize(len(apply.Result))
+				for i, rp := range apply.Result {
+					if rp.Rel == 0 {
+						ctr.rbat.Vecs[i] = vector.NewVec(*ctr.inbat.Vecs[rp.Pos].GetType())
+					} else {
+						ctr.rbat.Vecs[i] = vector.NewVec(apply.TableFunction.ApplyRetType(rp.Pos))
+					}
+				}
+			} else {
+				ctr.rbat.CleanOnlyData()
+			}
+
+			if err = ctr.probe(apply, proc, &result); err != nil {
+				return result, err
+			}
+			if result.Batch.RowCount() == 0 {
+				continue
+			}
+			if apply.ProjectList != nil {
+				result.Batch, err = apply.EvalProjection(result.Batch, proc)
+				if err != nil {
+					return result, err
+				}
+			}
+			analyzer.Output(result.Batch)
+			return result, nil
+
+		default:
+			result.Batch = nil
+			result.Status = vm.ExecStop
+			return result, nil
+		}
+	}
+}
+
+// probe joins the rows of the left batch with the results of the table function
+// evaluated on them, until the result batch is full or the left batch is exhausted.
+func (ctr *container) probe(apply *Apply, proc *process.Process, result *vm.CallResult) error {
+	count := ctr.inbat.RowCount()
+	for ctr.probeIdx < count {
+		res, err := apply.TableFunction.ApplyCall(proc)
+		if err != nil {
+			return err
+		}
+
+		if res.Batch.IsDone() {
+			if apply.ApplyType == OUTER && !ctr.matched {
+				if err = ctr.appendRows(apply, proc, nil, 1); err != nil {
+					return err
+				}
+			}
+			ctr.probeIdx++
+			ctr.matched = false
+			if ctr.probeIdx < count {
+				if err = apply.TableFunction.ApplyStart(ctr.probeIdx, proc); err != nil {
+					return err
+				}
+			}
+			if ctr.rbat.RowCount() >= colexec.DefaultBatchSize {
+				break
+			}
+			continue
+		}
+
+		ctr.matched = true
+		if err = ctr.appendRows(apply, proc, res.Batch, res.Batch.RowCount()); err != nil {
+			return err
+		}
+		if ctr.rbat.RowCount() >= colexec.DefaultBatchSize {
+			break
+		}
+	}
+
+	if ctr.probeIdx >= count {
+		ctr.inbat = nil
+	}
+	result.Batch = ctr.rbat
+	return nil
+}
+
+// appendRows appends the current left row joined with n rows of the table function
+// result, a nil result joins the left row with nulls.
+func (ctr *container) appendRows(apply *Apply, proc *process.Process, tfBat *batch.Batch, n int) error {
+	for i, rp := range apply.Result {
+		var err error
+		if rp.Rel == 0 {
+			err = ctr.rbat.Vecs[i].UnionMulti(ctr.inbat.Vecs[rp.Pos], int64(ctr.probeIdx), n, proc.Mp())
+		} else if tfBat == nil {
+			for j := 0; j < n && err == nil; j++ {
+				err = ctr.rbat.Vecs[i].UnionNull(proc.Mp())
+			}
+		} else {
+			err = ctr.rbat.Vecs[i].UnionBatch(tfBat.Vecs[rp.Pos], 0, n, nil, proc.Mp())
+		}
+		if err != nil {
+			return err
+		}
+	}
+	ctr.rbat.AddRowCount(n)
+	return nil
 }
@@ -19,13 +19,20 @@ import (
 	"testing"
 
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
 )
 
 type applyTestCase struct {
 	arg  *Apply
 	proc *process.Process
+	// rows is the number of rows expected to be returned
+	rows int
 }
 
 var (
@@ -34,8 +41,9 @@ var (
 
 func init() {
 	tcs = []applyTestCase{
-		newTestCase(CROSS),
-		newTestCase(OUTER),
+		// generate_series(1, a, 1) returns 1 row for a = 1, 2 rows for a = 2 and no row for a = 0
+		newTestCase(CROSS, 3),
+		newTestCase(OUTER, 4),
 	}
 }
 
@@ -48,19 +56,72 @@ func TestString(t *testing.T) {
 
 func TestApply(t *testing.T) {
 	for _, tc := range tcs {
-		_ = tc.arg.Prepare(tc.proc)
-		_, _ = tc.arg.Call(tc.proc)
+		require.NoError(t, tc.arg.Prepare(tc.proc))
+
+		bat := newLeftBatch(t, tc.proc)
+		op := colexec.NewMockOperator().WithBatchs([]*batch.Batch{bat})
+		tc.arg.AppendChild(op)
+
+		rows := 0
+		for {
+			res, err := tc.arg.Call(tc.proc)
+			require.NoError(t, err)
+			if res.Batch == nil {
+				break
+			}
+			require.Equal(t, 2, len(res.Batch.Vecs))
+			rows += res.Batch.RowCount()
+		}
+		require.Equal(t, tc.rows, rows)
+
 		tc.arg.Reset(tc.proc, false, nil)
+		tc.arg.GetChildren(0).Free(tc.proc, false, nil)
 		tc.arg.Free(tc.proc, false, nil)
+		bat.Clean(tc.proc.Mp())
+		require.Equal(t, int64(0), tc.proc.Mp().CurrNB())
 	}
 }
 
-func newTestCase(applyType int) applyTestCase {
+func newLeftBatch(t *testing.T, proc *process.Process) *batch.Batch {
+	bat := batch.NewWithSize(1)
+	bat.Vecs[0] = testutil.NewInt64Vector(3, types.T_int64.ToType(), proc.Mp(), false, []int64{1, 2, 0})
+	bat.SetRowCount(3)
+	return bat
+}
+
+func newTestCase(applyType int, rows int) applyTestCase {
 	proc := testutil.NewProcessWithMPool("", mpool.MustNewZero())
 	arg := NewArgument()
 	arg.ApplyType = applyType
+	arg.Result = []colexec.ResultPos{colexec.NewResultPos(0, 0), colexec.NewResultPos(1, 0)}
+	arg.FuncName = "generate_series"
+	arg.Attrs = []string{"result"}
+	arg.Rets = []*plan.ColDef{
+		{Name: "result", Typ: plan.Type{Id: int32(types.T_int64)}},
+	}
+	arg.Args = []*plan.Expr{
+		{
+			Typ: plan.Type{Id: int32(types.T_int64)},
+			Expr: &plan.Expr_Lit{
+				Lit: &plan.Literal{Value: &plan.Literal_I64Val{I64Val: 1}},
+			},
+		},
+		{
+			Typ: plan.Type{Id: int32(types.T_int64)},
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{RelPos: 0, ColPos: 0},
+			},
+		},
+		{
+			Typ: plan.Type{Id: int32(types.T_int64)},
+			Expr: &plan.Expr_Lit{
+				Lit: &plan.Literal{Value: &plan.Literal_I64Val{I64Val: 1}},
+			},
+		},
+	}
 	return applyTestCase{
 		arg:  arg,
 		proc: proc,
+		rows: rows,
 	}
 }
@@ -27,8 +27,7 @@ import (
 var _ vm.Operator = new(Apply)
 
 const (
-	Build = iota
-	Probe
+	Probe = iota
 	End
 )
 
@@ -40,8 +39,13 @@ const (
 type container struct {
 	state int
 
-	batchRowCount int64
-	rbat          *batch.Batch
+	// inbat is the current batch of the left child, we do not own it.
+	inbat *batch.Batch
+	// probeIdx is the row of inbat whose table function results are being joined.
+	probeIdx int
+	// matched is whether the table function returned any row for the current row.
+	matched bool
+	rbat    *batch.Batch
 }
 
 type Apply struct {
@@ -88,26 +92,28 @@ func (apply *Apply) Release() {
 func (apply *Apply) Reset(proc *process.Process, pipelineFailed bool, err error) {
 	ctr := &apply.ctr
 
-	ctr.state = Build
-
-	/*
-		if apply.ProjectList != nil {
+	ctr.state = Probe
+	ctr.inbat = nil
+	ctr.probeIdx = 0
+	ctr.matched = false
+	if ctr.rbat != nil {
+		ctr.rbat.CleanOnlyData()
+	}
+	apply.TableFunction.ApplyReset(proc, pipelineFailed, err)
+	if apply.ProjectList != nil {
+		if apply.OpAnalyzer != nil {
 			apply.OpAnalyzer.Alloc(apply.ProjectAllocSize)
-			apply.ResetProjection(proc)
 		}
-	*/
+		apply.ResetProjection(proc)
+	}
 }
 
 func (apply *Apply) Free(proc *process.Process, pipelineFailed bool, err error) {
 	ctr := &apply.ctr
 
 	ctr.cleanBatch(proc.Mp())
-
-	/*
-		if apply.ProjectList != nil {
-				apply.FreeProjection(proc)
-			}
-	*/
+	apply.TableFunction.ApplyFree(proc, pipelineFailed, err)
+	apply.FreeProjection(proc)
 }
 
 func (ctr *container) cleanBatch(mp *mpool.MPool) {
@@ -115,4 +121,5 @@ func (ctr *container) cleanBatch(mp *mpool.MPool) {
 		ctr.rbat.Clean(mp)
 		ctr.rbat = nil
 	}
+	ctr.inbat = nil
 }
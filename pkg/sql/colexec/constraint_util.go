@@ -119,6 +119,23 @@ func BatchDataNotNullCheck(vecs []*vector.Vector, attrs []string, tableDef *plan
 	return nil
 }
 
+// BatchDataCheckConstraintCheck checks the rows of a batch against the check
+// constraints, vecs holds the boolean result of every constraint. A row
+// violates a constraint only when the result is false, NULL is accepted.
+func BatchDataCheckConstraintCheck(vecs []*vector.Vector, checks []*plan.CheckDef, ctx context.Context) error {
+	for j, vec := range vecs {
+		if vec.IsConstNull() {
+			continue
+		}
+		for i, ok := range vector.MustFixedColWithTypeCheck[bool](vec) {
+			if !ok && !vec.IsNull(uint64(i)) {
+				return moerr.NewConstraintViolation(ctx, fmt.Sprintf("Check constraint '%s' is violated.", checks[j].Name))
+			}
+		}
+	}
+	return nil
+}
+
 func getRelationByObjRef(ctx context.Context, proc *process.Process, eg engine.Engine, ref *plan.ObjectRef) (engine.Relation, error) {
 	dbSource, err := eg.Database(ctx, ref.SchemaName, proc.GetTxnOperator())
 	if err != nil {
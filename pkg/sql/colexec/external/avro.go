@@ -0,0 +1,255 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/util/avro"
+	"github.com/matrixorigin/matrixone/pkg/sql/util/csvparser"
+	"github.com/matrixorigin/matrixone/pkg/util/trace"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+var maxAvroBatchCnt = 8192
+
+// scanAvroFile reads the records of an avro object container file. Like the
+// jsonline format, each record is converted into a line of fields in the
+// order of the columns, and the fields are parsed by the column types.
+func scanAvroFile(ctx context.Context, param *ExternalParam, proc *process.Process, bat *batch.Batch) error {
+	_, span := trace.Start(ctx, "scanAvroFile")
+	defer span.End()
+
+	if param.avroh == nil {
+		var err error
+		param.avroh, err = newAvroHandler(param, proc)
+		if err != nil || param.avroh == nil {
+			return err
+		}
+	}
+	return param.avroh.getData(bat, param, proc)
+}
+
+func newAvroHandler(param *ExternalParam, proc *process.Process) (*AvroHandler, error) {
+	var err error
+	param.reader, err = readFile(param, proc)
+	if err != nil || param.reader == nil {
+		return nil, err
+	}
+	param.reader, err = getUnCompressReader(param.Extern, param.Fileparam.Filepath, param.reader)
+	if err != nil {
+		return nil, err
+	}
+	h := AvroHandler{}
+	if h.file, err = avro.NewReader(param.reader); err != nil {
+		return nil, err
+	}
+	if err = h.prepare(param); err != nil {
+		h.file.Close()
+		return nil, err
+	}
+	return &h, nil
+}
+
+// prepare projects the fields of the columns, the other fields are skipped
+// without decoding.
+func (h *AvroHandler) prepare(param *ExternalParam) error {
+	fields := make(map[string]int, len(h.file.Schema().Fields))
+	for i, f := range h.file.Schema().Fields {
+		fields[f.Name] = i
+	}
+	var columns []int
+	h.schemas = make([]*avro.Schema, len(param.Attrs))
+	for colIdx, attr := range param.Attrs {
+		def := param.Cols[colIdx]
+		if def.Hidden {
+			continue
+		}
+		i, ok := fields[attr]
+		if !ok {
+			return moerr.NewInvalidInputf(param.Ctx, "column %s not found", attr)
+		}
+		s := h.file.Schema().Fields[i].Schema.NullableType()
+		switch s.Type {
+		case avro.Record, avro.Array, avro.Map, avro.Union:
+			// the complex values are loaded in json.
+			switch types.T(def.Typ.Id) {
+			case types.T_json, types.T_char, types.T_varchar, types.T_text:
+			default:
+				return moerr.NewNYIf(param.Ctx, "load avro %s to %s", s.Type, types.T(def.Typ.Id))
+			}
+		}
+		h.schemas[colIdx] = s
+		columns = append(columns, i)
+	}
+	h.file.Project(columns)
+	return nil
+}
+
+func (h *AvroHandler) getData(bat *batch.Batch, param *ExternalParam, proc *process.Process) error {
+	finish := false
+	size := uint64(0)
+	h.lines = h.lines[:0]
+	for len(h.lines) < maxAvroBatchCnt && size < param.maxBatchSize {
+		values, err := h.file.Next()
+		if err == io.EOF {
+			finish = true
+			break
+		}
+		if err != nil {
+			return err
+		}
+		line, err := h.transRecord2Line(param, values)
+		if err != nil {
+			return err
+		}
+		for _, field := range line {
+			size += uint64(len(field.Val))
+		}
+		h.lines = append(h.lines, line)
+	}
+
+	if finish {
+		h.file.Close()
+		if err := param.reader.Close(); err != nil {
+			logutil.Errorf("close file failed. err:%v", err)
+		}
+		param.avroh = nil
+		param.Fileparam.FileFin++
+		if param.Fileparam.FileFin >= param.Fileparam.FileCnt {
+			param.Fileparam.End = true
+		}
+	}
+
+	if err := initBatch(len(h.lines), proc, bat); err != nil {
+		return err
+	}
+	for rowIdx, line := range h.lines {
+		if err := checkLineValid(param, proc, line, rowIdx); err != nil {
+			return err
+		}
+		if err := getOneRowData(bat, line, rowIdx, param, proc.GetMPool()); err != nil {
+			return err
+		}
+	}
+	bat.SetRowCount(len(h.lines))
+	return nil
+}
+
+// transRecord2Line converts the projected values of a record into the fields
+// of the non-hidden columns.
+func (h *AvroHandler) transRecord2Line(param *ExternalParam, values []any) ([]csvparser.Field, error) {
+	line := make([]csvparser.Field, 0, len(values))
+	i := 0
+	for colIdx, s := range h.schemas {
+		if s == nil {
+			continue
+		}
+		field, err := avroField(param, values[i], s, types.T(param.Cols[colIdx].Typ.Id))
+		if err != nil {
+			return nil, err
+		}
+		line = append(line, field)
+		i++
+	}
+	return line, nil
+}
+
+// avroField formats the value v of the schema s into the field parsed into
+// the column type t.
+func avroField(param *ExternalParam, v any, s *avro.Schema, t types.T) (csvparser.Field, error) {
+	var val string
+	switch x := v.(type) {
+	case nil:
+		return csvparser.Field{IsNull: true}, nil
+	case bool:
+		val = strconv.FormatBool(x)
+	case int32:
+		val = strconv.FormatInt(int64(x), 10)
+	case int64:
+		val = strconv.FormatInt(x, 10)
+	case float32:
+		val = strconv.FormatFloat(float64(x), 'g', -1, 32)
+	case float64:
+		val = strconv.FormatFloat(x, 'g', -1, 64)
+	case string:
+		val = x
+	case []byte:
+		val = string(x)
+	case time.Time:
+		switch {
+		case s.LogicalType == avro.LogicalDate:
+			val = x.Format("2006-01-02")
+		case t == types.T_timestamp && (s.LogicalType == avro.LogicalTimestampMillis || s.LogicalType == avro.LogicalTimestampMicros):
+			// the timestamp columns are parsed in the local time zone.
+			val = x.In(time.Local).Format("2006-01-02 15:04:05.999999")
+		default:
+			val = x.Format("2006-01-02 15:04:05.999999")
+		}
+	case time.Duration:
+		val = formatAvroTime(x)
+	case map[string]any, []any:
+		data, err := json.Marshal(x)
+		if err != nil {
+			return csvparser.Field{}, err
+		}
+		val = string(data)
+	default:
+		val = fmt.Sprint(x)
+	}
+
+	if t == types.T_json {
+		// the strings are json texts, and the other scalars like enums and
+		// times are json strings. The json values of the formats other than
+		// csv are encoded already.
+		switch v.(type) {
+		case map[string]any, []any, bool, int32, int64, float32, float64:
+		default:
+			if s.Type != avro.String {
+				data, err := json.Marshal(val)
+				if err != nil {
+					return csvparser.Field{}, err
+				}
+				val = string(data)
+			}
+		}
+		bj, err := types.ParseStringToByteJson(val)
+		if err != nil {
+			return csvparser.Field{}, moerr.NewInvalidInputf(param.Ctx, "the input value '%v' is not json type", val)
+		}
+		data, err := types.EncodeJson(bj)
+		if err != nil {
+			return csvparser.Field{}, err
+		}
+		val = string(data)
+	}
+	return csvparser.Field{Val: val}, nil
+}
+
+// formatAvroTime formats the time since midnight like the time literals.
+func formatAvroTime(d time.Duration) string {
+	micros := d.Microseconds()
+	return fmt.Sprintf("%02d:%02d:%02d.%06d",
+		micros/3600000000, micros/60000000%60, micros/1000000%60, micros%1000000)
+}
@@ -0,0 +1,120 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/util/avro"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_scanAvroFile(t *testing.T) {
+	proc := testutil.NewProc()
+	cols := []*plan.ColDef{
+		testColDef("extra", types.T_json, 0, 0),
+		testColDef("id", types.T_int32, 0, 0),
+		testColDef("name", types.T_varchar, 20, 0),
+		testColDef("flag", types.T_bool, 0, 0),
+		testColDef("price", types.T_decimal64, 10, 2),
+		testColDef("day", types.T_date, 0, 0),
+		testColDef("ts", types.T_datetime, 0, 0),
+		testColDef("color", types.T_varchar, 10, 0),
+		testColDef("tags", types.T_json, 0, 0),
+	}
+	param := newInlineParam(t, tree.AVRO, "simple.avro", cols)
+	param.maxBatchSize = 1
+	bat := newTestBatch(proc, param)
+
+	var rows [][]string
+	for !param.Fileparam.End {
+		bat.CleanOnlyData()
+		require.NoError(t, scanAvroFile(context.Background(), param, proc, bat))
+		for i := 0; i < bat.RowCount(); i++ {
+			row := make([]string, len(bat.Vecs))
+			for j, vec := range bat.Vecs {
+				if vec.IsNull(uint64(i)) {
+					row[j] = "null"
+				} else {
+					row[j] = testValueString(vec, i)
+				}
+			}
+			rows = append(rows, row)
+		}
+	}
+	require.Nil(t, param.avroh)
+	require.Equal(t, [][]string{
+		{`{"k": 1}`, "1", "apple", "true", "123.45", "2024-01-01", "2024-01-01 10:00:00", "red", `["a", "b"]`},
+		{`{}`, "2", "null", "false", "-0.01", "1970-01-01", "1999-12-31 23:59:59", "green", `[]`},
+		{`{"x": -5, "y": 6}`, "3", "cherry", "true", "0.00", "2024-02-29", "2024-06-30 00:00:01", "blue", `["c"]`},
+	}, rows)
+	bat.Clean(proc.Mp())
+}
+
+func Test_scanAvroFileErrors(t *testing.T) {
+	proc := testutil.NewProc()
+
+	param := newInlineParam(t, tree.AVRO, "simple.avro", []*plan.ColDef{testColDef("missing", types.T_int64, 0, 0)})
+	err := scanAvroFile(context.Background(), param, proc, newTestBatch(proc, param))
+	require.Error(t, err)
+
+	param = newInlineParam(t, tree.AVRO, "simple.avro", []*plan.ColDef{testColDef("tags", types.T_int64, 0, 0)})
+	err = scanAvroFile(context.Background(), param, proc, newTestBatch(proc, param))
+	require.Error(t, err)
+
+	param = newInlineParam(t, tree.AVRO, "simple.orc", nil)
+	err = scanAvroFile(context.Background(), param, proc, newTestBatch(proc, param))
+	require.Error(t, err)
+}
+
+func Test_avroField(t *testing.T) {
+	param := &ExternalParam{ExParamConst: ExParamConst{Ctx: context.Background()}}
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 6000, time.UTC)
+	tests := []struct {
+		v        any
+		s        *avro.Schema
+		t        types.T
+		expected string
+	}{
+		{v: int64(-7), s: &avro.Schema{Type: avro.Long}, t: types.T_int64, expected: "-7"},
+		{v: float32(1.25), s: &avro.Schema{Type: avro.Float}, t: types.T_float32, expected: "1.25"},
+		{v: ts, s: &avro.Schema{Type: avro.Long, LogicalType: avro.LogicalLocalTimestampMicro}, t: types.T_datetime, expected: "2024-01-02 03:04:05.000006"},
+		{v: ts, s: &avro.Schema{Type: avro.Long, LogicalType: avro.LogicalTimestampMicros}, t: types.T_timestamp, expected: ts.In(time.Local).Format("2006-01-02 15:04:05.999999")},
+		{v: 25*time.Hour + 1500*time.Microsecond, s: &avro.Schema{Type: avro.Long, LogicalType: avro.LogicalTimeMicros}, t: types.T_time, expected: "25:00:00.001500"},
+		{v: []byte("xy"), s: &avro.Schema{Type: avro.Bytes}, t: types.T_blob, expected: "xy"},
+	}
+	for _, test := range tests {
+		field, err := avroField(param, test.v, test.s, test.t)
+		require.NoError(t, err)
+		require.Equal(t, test.expected, field.Val)
+	}
+
+	field, err := avroField(param, nil, &avro.Schema{Type: avro.Null}, types.T_int32)
+	require.NoError(t, err)
+	require.True(t, field.IsNull)
+
+	// the enum symbols are json strings, while the strings are json texts.
+	field, err = avroField(param, "red", &avro.Schema{Type: avro.Enum}, types.T_json)
+	require.NoError(t, err)
+	require.Equal(t, `"red"`, types.DecodeJson([]byte(field.Val)).String())
+	_, err = avroField(param, "red", &avro.Schema{Type: avro.String}, types.T_json)
+	require.Error(t, err)
+}
@@ -113,7 +113,7 @@ func (external *External) Prepare(proc *process.Process) error {
 		return moerr.NewNYIf(proc.Ctx, "load format '%s'", param.Extern.Format)
 	}
 
-	if param.Extern.Format != tree.PARQUET {
+	if !isBinaryFormat(param.Extern.Format) {
 		if param.Extern.Format == tree.JSONLINE {
 			if param.Extern.JsonData != tree.OBJECT && param.Extern.JsonData != tree.ARRAY {
 				param.Fileparam.End = true
@@ -150,7 +150,7 @@ func (external *External) Prepare(proc *process.Process) error {
 	if external.ctr.buf == nil {
 		external.ctr.buf = batch.New(false, param.Attrs)
 		var flag bool
-		if param.Extern.Format == tree.PARQUET {
+		if isBinaryFormat(param.Extern.Format) {
 			flag = false
 		} else {
 			flag = param.ParallelLoad
@@ -191,7 +191,7 @@ func (external *External) Call(proc *process.Process) (vm.CallResult, error) {
 		result.Status = vm.ExecStop
 		return result, nil
 	}
-	if (param.plh == nil && param.parqh == nil) && param.Extern.ScanType != tree.INLINE {
+	if (param.plh == nil && param.parqh == nil && param.orch == nil && param.avroh == nil) && param.Extern.ScanType != tree.INLINE {
 		if param.Fileparam.FileIndex >= len(param.FileList) {
 			result.Status = vm.ExecStop
 			return result, nil
@@ -1180,8 +1180,13 @@ func scanFileData(ctx context.Context, param *ExternalParam, proc *process.Proce
 	if param.Extern.QueryResult {
 		return scanZonemapFile(ctx, param, proc, bat)
 	}
-	if param.Extern.Format == tree.PARQUET {
+	switch param.Extern.Format {
+	case tree.PARQUET:
 		return scanParquetFile(ctx, param, proc, bat)
+	case tree.ORC:
+		return scanOrcFile(ctx, param, proc, bat)
+	case tree.AVRO:
+		return scanAvroFile(ctx, param, proc, bat)
 	}
 	return scanCsvFile(ctx, param, proc, bat)
 }
@@ -1821,8 +1826,14 @@ func readCountStringLimitSize(r *csvparser.CSVParser, ctx context.Context, size
 
 func loadFormatIsValid(param *tree.ExternParam) bool {
 	switch param.Format {
-	case tree.JSONLINE, tree.CSV, tree.PARQUET:
+	case tree.JSONLINE, tree.CSV, tree.PARQUET, tree.ORC, tree.AVRO:
 		return true
 	}
 	return false
 }
+
+// isBinaryFormat returns whether the files of the format are read by their
+// own handlers instead of the csv parser.
+func isBinaryFormat(format string) bool {
+	return format == tree.PARQUET || format == tree.ORC || format == tree.AVRO
+}
@@ -0,0 +1,452 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/util"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/util/orc"
+	"github.com/matrixorigin/matrixone/pkg/util/errutil"
+	"github.com/matrixorigin/matrixone/pkg/util/trace"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+var maxOrcBatchCnt = 8192
+
+var orcEpochDate = types.DateFromCalendar(1970, 1, 1)
+
+func scanOrcFile(ctx context.Context, param *ExternalParam, proc *process.Process, bat *batch.Batch) error {
+	_, span := trace.Start(ctx, "scanOrcFile")
+	defer span.End()
+
+	if param.orch == nil {
+		var err error
+		param.orch, err = newOrcHandler(param)
+		if err != nil {
+			return err
+		}
+	}
+	return param.orch.getData(bat, param, proc)
+}
+
+func newOrcHandler(param *ExternalParam) (*OrcHandler, error) {
+	h := OrcHandler{}
+	if err := h.openFile(param); err != nil {
+		return nil, err
+	}
+	if err := h.prepare(param); err != nil {
+		return nil, err
+	}
+	return &h, nil
+}
+
+func (h *OrcHandler) openFile(param *ExternalParam) error {
+	var r io.ReaderAt
+	var size int64
+	switch {
+	case param.Extern.ScanType == tree.INLINE:
+		r = bytes.NewReader(util.UnsafeStringToBytes(param.Extern.Data))
+		size = int64(len(param.Extern.Data))
+	case param.Extern.Local:
+		return moerr.NewNYI(param.Ctx, "load orc local")
+	default:
+		fs, readPath, err := plan.GetForETLWithType(param.Extern, param.Fileparam.Filepath)
+		if err != nil {
+			return err
+		}
+		r = &fsReaderAt{
+			fs:       fs,
+			readPath: readPath,
+			ctx:      param.Ctx,
+		}
+		size = param.FileSize[param.Fileparam.FileIndex-1]
+	}
+	var err error
+	h.file, err = orc.NewReader(r, size)
+	return moerr.ConvertGoError(param.Ctx, err)
+}
+
+// prepare finds the field of each column, only the streams of these fields
+// are read from the stripes.
+func (h *OrcHandler) prepare(param *ExternalParam) error {
+	fields := make(map[string]orc.Field, len(h.file.Fields()))
+	for _, f := range h.file.Fields() {
+		fields[f.Name] = f
+	}
+	h.columns = make([]uint32, len(param.Attrs))
+	h.mappers = make([]orcMapper, len(param.Attrs))
+	for colIdx, attr := range param.Attrs {
+		def := param.Cols[colIdx]
+		if def.Hidden {
+			continue
+		}
+		f, ok := fields[attr]
+		if !ok {
+			return moerr.NewInvalidInputf(param.Ctx, "column %s not found", attr)
+		}
+		switch f.Kind {
+		case orc.KindList, orc.KindMap, orc.KindStruct, orc.KindUnion:
+			return moerr.NewNYIf(param.Ctx, "load %s type column %s", f.Kind, attr)
+		}
+		fn := getOrcMapper(f, def.Typ)
+		if fn == nil {
+			return moerr.NewNYIf(param.Ctx, "load %s to %s", f.Kind, types.T(def.Typ.Id))
+		}
+		h.columns[colIdx] = f.Column
+		h.mappers[colIdx] = fn
+	}
+	return nil
+}
+
+func getOrcMapper(f orc.Field, dt plan.Type) orcMapper {
+	isInt := f.Kind == orc.KindByte || f.Kind == orc.KindShort || f.Kind == orc.KindInt || f.Kind == orc.KindLong
+	isFloat := f.Kind == orc.KindFloat || f.Kind == orc.KindDouble
+	isTime := f.Kind == orc.KindTimestamp || f.Kind == orc.KindTimestampInstant
+	switch types.T(dt.Id) {
+	case types.T_bool:
+		if f.Kind == orc.KindBoolean {
+			return func(col *orc.Column, start, end int, proc *process.Process, vec *vector.Vector) error {
+				return appendOrcValues(col, col.Ints, start, end, proc, vec, func(v int64) (bool, error) {
+					return v != 0, nil
+				})
+			}
+		}
+	case types.T_int8:
+		if isInt {
+			return orcIntMapper[int8](types.T_int8)
+		}
+	case types.T_int16:
+		if isInt {
+			return orcIntMapper[int16](types.T_int16)
+		}
+	case types.T_int32:
+		if isInt {
+			return orcIntMapper[int32](types.T_int32)
+		}
+	case types.T_int64:
+		if isInt {
+			return orcIntMapper[int64](types.T_int64)
+		}
+	case types.T_uint8:
+		if isInt {
+			return orcIntMapper[uint8](types.T_uint8)
+		}
+	case types.T_uint16:
+		if isInt {
+			return orcIntMapper[uint16](types.T_uint16)
+		}
+	case types.T_uint32:
+		if isInt {
+			return orcIntMapper[uint32](types.T_uint32)
+		}
+	case types.T_uint64:
+		if isInt {
+			return orcIntMapper[uint64](types.T_uint64)
+		}
+	case types.T_float32:
+		if f.Kind == orc.KindFloat {
+			return func(col *orc.Column, start, end int, proc *process.Process, vec *vector.Vector) error {
+				return appendOrcValues(col, col.Floats, start, end, proc, vec, func(v float64) (float32, error) {
+					return float32(v), nil
+				})
+			}
+		}
+	case types.T_float64:
+		if isFloat {
+			return func(col *orc.Column, start, end int, proc *process.Process, vec *vector.Vector) error {
+				return appendOrcValues(col, col.Floats, start, end, proc, vec, func(v float64) (float64, error) {
+					return v, nil
+				})
+			}
+		}
+	case types.T_char, types.T_varchar, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
+		if f.Kind == orc.KindString || f.Kind == orc.KindVarchar || f.Kind == orc.KindChar || f.Kind == orc.KindBinary {
+			return func(col *orc.Column, start, end int, proc *process.Process, vec *vector.Vector) error {
+				var isNulls []bool
+				if col.Nulls != nil {
+					isNulls = col.Nulls[start:end]
+				}
+				return vector.AppendBytesList(vec, col.Bytes[start:end], isNulls, proc.Mp())
+			}
+		}
+	case types.T_decimal64:
+		if f.Kind == orc.KindDecimal {
+			return func(col *orc.Column, start, end int, proc *process.Process, vec *vector.Vector) error {
+				return appendOrcValues(col, col.Decimals, start, end, proc, vec, func(v types.Decimal128) (types.Decimal64, error) {
+					d, err := v.Scale(dt.Scale - f.Scale)
+					if err != nil {
+						return 0, err
+					}
+					// the high 64 bits must be the sign extension of the low ones.
+					if d.B64_127 != uint64(int64(d.B0_63)>>63) {
+						return 0, moerr.NewOutOfRangef(proc.Ctx, types.T_decimal64.String(), "value '%s'", v.Format(f.Scale))
+					}
+					return types.Decimal64(d.B0_63), nil
+				})
+			}
+		}
+	case types.T_decimal128:
+		if f.Kind == orc.KindDecimal {
+			return func(col *orc.Column, start, end int, proc *process.Process, vec *vector.Vector) error {
+				return appendOrcValues(col, col.Decimals, start, end, proc, vec, func(v types.Decimal128) (types.Decimal128, error) {
+					return v.Scale(dt.Scale - f.Scale)
+				})
+			}
+		}
+	case types.T_date:
+		if f.Kind == orc.KindDate {
+			return func(col *orc.Column, start, end int, proc *process.Process, vec *vector.Vector) error {
+				return appendOrcValues(col, col.Ints, start, end, proc, vec, func(v int64) (types.Date, error) {
+					return orcEpochDate + types.Date(v), nil
+				})
+			}
+		}
+	case types.T_datetime:
+		if isTime {
+			// the wall clock of the values, which is the written one of the
+			// timestamp type, and the one in UTC of the timestamp with local
+			// time zone type.
+			return func(col *orc.Column, start, end int, proc *process.Process, vec *vector.Vector) error {
+				return appendOrcValues(col, col.Times, start, end, proc, vec, func(v time.Time) (types.Datetime, error) {
+					_, offset := v.Zone()
+					return types.Datetime(types.UnixMicroToTimestamp(v.UnixMicro() + int64(offset)*types.MicroSecsPerSec)), nil
+				})
+			}
+		}
+	case types.T_timestamp:
+		if isTime {
+			return func(col *orc.Column, start, end int, proc *process.Process, vec *vector.Vector) error {
+				return appendOrcValues(col, col.Times, start, end, proc, vec, func(v time.Time) (types.Timestamp, error) {
+					return types.UnixMicroToTimestamp(v.UnixMicro()), nil
+				})
+			}
+		}
+	}
+	return nil
+}
+
+func orcIntMapper[T int8 | int16 | int32 | int64 | uint8 | uint16 | uint32 | uint64](t types.T) orcMapper {
+	return func(col *orc.Column, start, end int, proc *process.Process, vec *vector.Vector) error {
+		return appendOrcValues(col, col.Ints, start, end, proc, vec, func(v int64) (T, error) {
+			r, ok := orcIntCast[T](v)
+			if !ok {
+				return 0, moerr.NewOutOfRangef(proc.Ctx, t.String(), "value '%d'", v)
+			}
+			return r, nil
+		})
+	}
+}
+
+// orcIntCast converts v to T, it returns false if v is out of the range of T.
+func orcIntCast[T int8 | int16 | int32 | int64 | uint8 | uint16 | uint32 | uint64](v int64) (T, bool) {
+	r := T(v)
+	return r, int64(r) == v && (v < 0) == (r < 0)
+}
+
+func appendOrcValues[S, T any](col *orc.Column, values []S, start, end int, proc *process.Process, vec *vector.Vector, fn func(S) (T, error)) error {
+	var isNulls []bool
+	if col.Nulls != nil {
+		isNulls = col.Nulls[start:end]
+	}
+	ws := make([]T, end-start)
+	for i := range ws {
+		if isNulls != nil && isNulls[i] {
+			continue
+		}
+		var err error
+		if ws[i], err = fn(values[start+i]); err != nil {
+			return err
+		}
+	}
+	return vector.AppendFixedList(vec, ws, isNulls, proc.Mp())
+}
+
+func (h *OrcHandler) getData(bat *batch.Batch, param *ExternalParam, proc *process.Process) error {
+	for h.stripe == nil && h.next < h.file.NumStripes() {
+		i := h.next
+		h.next++
+		if param.Filter.zonemappable && !h.needRead(param, proc, i) {
+			continue
+		}
+		if err := h.readStripe(param, i); err != nil {
+			return err
+		}
+	}
+
+	length := 0
+	if h.stripe != nil {
+		n := min(h.stripe.NumRows()-h.offset, maxOrcBatchCnt)
+		for colIdx, col := range h.data {
+			if col == nil {
+				continue
+			}
+			if err := h.mappers[colIdx](col, h.offset, h.offset+n, proc, bat.Vecs[colIdx]); err != nil {
+				return err
+			}
+		}
+		length = n
+		h.offset += n
+		if h.offset >= h.stripe.NumRows() {
+			h.stripe, h.data, h.offset = nil, nil, 0
+		}
+	}
+	bat.SetRowCount(length)
+
+	if h.stripe == nil && h.next >= h.file.NumStripes() {
+		param.orch = nil
+		param.Fileparam.FileFin++
+		if param.Fileparam.FileFin >= param.Fileparam.FileCnt {
+			param.Fileparam.End = true
+		}
+	}
+	return nil
+}
+
+func (h *OrcHandler) readStripe(param *ExternalParam, i int) error {
+	var columns []uint32
+	for colIdx, id := range h.columns {
+		if h.mappers[colIdx] != nil {
+			columns = append(columns, id)
+		}
+	}
+	stripe, err := h.file.ReadStripe(i, columns)
+	if err != nil {
+		return moerr.ConvertGoError(param.Ctx, err)
+	}
+	data := make([]*orc.Column, len(h.columns))
+	for colIdx, id := range h.columns {
+		if h.mappers[colIdx] == nil {
+			continue
+		}
+		if data[colIdx], err = stripe.Column(id); err != nil {
+			return moerr.ConvertGoError(param.Ctx, err)
+		}
+	}
+	h.stripe, h.data, h.offset = stripe, data, 0
+	return nil
+}
+
+// needRead evaluates the filter by the zonemaps built from the statistics of
+// the i-th stripe. The stripe is read if any column of the filter has no
+// usable statistics.
+func (h *OrcHandler) needRead(param *ExternalParam, proc *process.Process, i int) bool {
+	expr := param.Filter.FilterExpr
+	stats := h.file.StripeStatistics(i)
+	if expr == nil || stats == nil {
+		return true
+	}
+	meta := make(orcStripeMeta, len(param.Cols))
+	for _, colIdx := range param.Filter.columnMap {
+		if colIdx >= len(h.columns) || h.mappers[colIdx] == nil || int(h.columns[colIdx]) >= len(stats) {
+			return true
+		}
+		cm, ok := orcColumnMeta(stats[h.columns[colIdx]], param.Cols[colIdx].Typ)
+		if !ok {
+			return true
+		}
+		meta[colIdx] = cm
+	}
+
+	cnt := plan.AssignAuxIdForExpr(expr, 0)
+	zms := make([]objectio.ZoneMap, cnt)
+	vecs := make([]*vector.Vector, cnt)
+	return colexec.EvaluateFilterByZoneMap(
+		errutil.ContextWithNoReport(proc.Ctx, true), proc, expr, meta, param.Filter.columnMap, zms, vecs)
+}
+
+// orcStripeMeta holds the column metas of a stripe indexed by the columns of
+// the external table.
+type orcStripeMeta []objectio.ColumnMeta
+
+func (m orcStripeMeta) MustGetColumn(seqnum uint16) objectio.ColumnMeta {
+	return m[seqnum]
+}
+
+// orcColumnMeta builds the column meta of the column statistics in the type
+// of the table column. It returns false if the statistics can not be used.
+func orcColumnMeta(stats orc.ColumnStatistics, typ plan.Type) (objectio.ColumnMeta, bool) {
+	zm := index.NewZM(types.T(typ.Id), typ.Scale)
+	cm := objectio.BuildColumnMeta()
+	if stats.HasNull {
+		cm.SetNullCnt(1)
+	}
+	// a zonemap not inited means all the values are null.
+	if stats.NumberOfValues == 0 {
+		cm.SetZoneMap(zm)
+		return cm, stats.HasNull
+	}
+
+	var minv, maxv any
+	var ok bool
+	switch types.T(typ.Id) {
+	case types.T_int8:
+		minv, maxv, ok = orcIntRange[int8](stats)
+	case types.T_int16:
+		minv, maxv, ok = orcIntRange[int16](stats)
+	case types.T_int32:
+		minv, maxv, ok = orcIntRange[int32](stats)
+	case types.T_int64:
+		minv, maxv, ok = orcIntRange[int64](stats)
+	case types.T_uint8:
+		minv, maxv, ok = orcIntRange[uint8](stats)
+	case types.T_uint16:
+		minv, maxv, ok = orcIntRange[uint16](stats)
+	case types.T_uint32:
+		minv, maxv, ok = orcIntRange[uint32](stats)
+	case types.T_uint64:
+		minv, maxv, ok = orcIntRange[uint64](stats)
+	case types.T_float32:
+		minv, maxv, ok = float32(stats.DoubleMin), float32(stats.DoubleMax), stats.HasDouble
+	case types.T_float64:
+		minv, maxv, ok = stats.DoubleMin, stats.DoubleMax, stats.HasDouble
+	case types.T_char, types.T_varchar, types.T_text:
+		minv, maxv, ok = []byte(stats.StringMin), []byte(stats.StringMax), stats.HasString
+	case types.T_date:
+		minv, maxv, ok = orcEpochDate+types.Date(stats.DateMin), orcEpochDate+types.Date(stats.DateMax), stats.HasDate
+	}
+	if !ok {
+		return nil, false
+	}
+	if err := zm.Update(minv); err != nil {
+		return nil, false
+	}
+	if err := zm.Update(maxv); err != nil {
+		return nil, false
+	}
+	cm.SetZoneMap(zm)
+	return cm, true
+}
+
+func orcIntRange[T int8 | int16 | int32 | int64 | uint8 | uint16 | uint32 | uint64](stats orc.ColumnStatistics) (any, any, bool) {
+	if !stats.HasInt {
+		return nil, nil, false
+	}
+	minv, ok1 := orcIntCast[T](stats.IntMin)
+	maxv, ok2 := orcIntCast[T](stats.IntMax)
+	return minv, maxv, ok1 && ok2
+}
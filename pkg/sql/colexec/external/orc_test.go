@@ -0,0 +1,226 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+const testResourceDir = "../../../../test/distributed/resources/load_data/"
+
+// newInlineParam creates the param scanning the columns from the inline data
+// of the format.
+func newInlineParam(t *testing.T, format, file string, cols []*plan.ColDef) *ExternalParam {
+	data, err := os.ReadFile(testResourceDir + file)
+	require.NoError(t, err)
+	param := &ExternalParam{
+		ExParamConst: ExParamConst{
+			Ctx:          context.Background(),
+			maxBatchSize: 1 << 20,
+			Cols:         cols,
+			Extern: &tree.ExternParam{
+				ExParamConst: tree.ExParamConst{
+					ScanType: tree.INLINE,
+					Format:   format,
+					Data:     string(data),
+					Tail:     &tree.TailParameter{},
+				},
+			},
+			Name2ColIndex:  make(map[string]int32),
+			TbColToDataCol: make(map[string]int32),
+		},
+		ExParam: ExParam{
+			Fileparam: &ExFileparam{FileCnt: 1},
+			Filter:    &FilterParam{},
+		},
+	}
+	for i, col := range cols {
+		param.Attrs = append(param.Attrs, col.Name)
+		param.Name2ColIndex[col.Name] = int32(i)
+		param.TbColToDataCol[col.Name] = int32(i)
+	}
+	return param
+}
+
+func newTestBatch(proc *process.Process, param *ExternalParam) *batch.Batch {
+	bat := batch.New(false, param.Attrs)
+	for i, col := range param.Cols {
+		bat.Vecs[i] = vector.NewVec(makeType(&col.Typ, false))
+	}
+	return bat
+}
+
+// testValueString formats the i-th value of vec, which is not null.
+func testValueString(vec *vector.Vector, i int) string {
+	switch vec.GetType().Oid {
+	case types.T_bool:
+		return fmt.Sprint(vector.GetFixedAtNoTypeCheck[bool](vec, i))
+	case types.T_int32:
+		return fmt.Sprint(vector.GetFixedAtNoTypeCheck[int32](vec, i))
+	case types.T_int64:
+		return fmt.Sprint(vector.GetFixedAtNoTypeCheck[int64](vec, i))
+	case types.T_float64:
+		return fmt.Sprint(vector.GetFixedAtNoTypeCheck[float64](vec, i))
+	case types.T_decimal64:
+		return vector.GetFixedAtNoTypeCheck[types.Decimal64](vec, i).Format(vec.GetType().Scale)
+	case types.T_date:
+		return vector.GetFixedAtNoTypeCheck[types.Date](vec, i).String()
+	case types.T_datetime:
+		return vector.GetFixedAtNoTypeCheck[types.Datetime](vec, i).String()
+	case types.T_json:
+		return types.DecodeJson(vec.GetBytesAt(i)).String()
+	default:
+		return vec.GetStringAt(i)
+	}
+}
+
+func testColDef(name string, t types.T, width, scale int32) *plan.ColDef {
+	return &plan.ColDef{Name: name, Typ: plan.Type{Id: int32(t), Width: width, Scale: scale}}
+}
+
+func Test_scanOrcFile(t *testing.T) {
+	proc := testutil.NewProc()
+	cols := []*plan.ColDef{
+		testColDef("id", types.T_int64, 0, 0),
+		testColDef("name", types.T_varchar, 20, 0),
+		testColDef("city", types.T_char, 4, 0),
+		testColDef("flag", types.T_bool, 0, 0),
+		testColDef("score", types.T_float64, 0, 0),
+		testColDef("price", types.T_decimal64, 10, 3),
+		testColDef("day", types.T_date, 0, 0),
+		testColDef("ts", types.T_datetime, 0, 0),
+	}
+	param := newInlineParam(t, tree.ORC, "simple.orc", cols)
+	bat := newTestBatch(proc, param)
+
+	var rows [][]string
+	for !param.Fileparam.End {
+		bat.CleanOnlyData()
+		require.NoError(t, scanOrcFile(context.Background(), param, proc, bat))
+		for i := 0; i < bat.RowCount(); i++ {
+			row := make([]string, len(bat.Vecs))
+			for j, vec := range bat.Vecs {
+				if vec.IsNull(uint64(i)) {
+					row[j] = "null"
+				} else {
+					row[j] = testValueString(vec, i)
+				}
+			}
+			rows = append(rows, row)
+		}
+	}
+	require.Nil(t, param.orch)
+	require.Equal(t, [][]string{
+		{"1", "apple", "sh", "true", "1.5", "123.450", "2024-01-01", "2024-01-01 10:00:00"},
+		{"2", "banana", "bj", "false", "null", "-0.010", "2024-01-02", "2024-01-02 11:30:00"},
+		{"3", "null", "sh", "null", "-2.25", "null", "1970-01-01", "null"},
+		{"4", "cherry", "null", "true", "3", "0.000", "null", "1999-12-31 23:59:59"},
+		{"5", "durian", "bj", "false", "0", "0.990", "1969-12-31", "2024-06-30 00:00:01"},
+		{"6", "elder", "sz", "true", "100.125", "1000.000", "2024-10-04", "2024-12-31 12:00:00"},
+	}, rows)
+	bat.Clean(proc.Mp())
+}
+
+func Test_scanOrcFileFilter(t *testing.T) {
+	proc := testutil.NewProc()
+	cols := []*plan.ColDef{
+		testColDef("id", types.T_int32, 0, 0),
+		testColDef("name", types.T_varchar, 20, 0),
+	}
+	param := newInlineParam(t, tree.ORC, "simple.orc", cols)
+
+	// id > 3 skips the first stripe, which holds the ids 1 to 3.
+	expr, err := plan2.BindFuncExprImplByPlanExpr(context.Background(), ">", []*plan.Expr{
+		{
+			Typ:  plan.Type{Id: int32(types.T_int32)},
+			Expr: &plan.Expr_Col{Col: &plan.ColRef{ColPos: 0, Name: "id"}},
+		},
+		{
+			Typ:  plan.Type{Id: int32(types.T_int32), NotNullable: true},
+			Expr: &plan.Expr_Lit{Lit: &plan.Literal{Value: &plan.Literal_I32Val{I32Val: 3}}},
+		},
+	})
+	require.NoError(t, err)
+	param.Filter.FilterExpr = expr
+	param.tableDef = &plan.TableDef{Name2ColIndex: param.Name2ColIndex}
+	param.Filter.columnMap, _, _, _ = plan2.GetColumnsByExpr(expr, param.tableDef)
+	param.Filter.zonemappable = plan2.ExprIsZonemappable(proc.Ctx, expr)
+	require.True(t, param.Filter.zonemappable)
+
+	bat := newTestBatch(proc, param)
+	var ids []int32
+	for !param.Fileparam.End {
+		bat.CleanOnlyData()
+		require.NoError(t, scanOrcFile(context.Background(), param, proc, bat))
+		ids = append(ids, vector.MustFixedColWithTypeCheck[int32](bat.Vecs[0])...)
+	}
+	require.Equal(t, []int32{4, 5, 6}, ids)
+	bat.Clean(proc.Mp())
+}
+
+func Test_scanOrcFileErrors(t *testing.T) {
+	proc := testutil.NewProc()
+
+	param := newInlineParam(t, tree.ORC, "simple.orc", []*plan.ColDef{testColDef("missing", types.T_int64, 0, 0)})
+	err := scanOrcFile(context.Background(), param, proc, newTestBatch(proc, param))
+	require.Error(t, err)
+
+	// string can not be loaded to int.
+	param = newInlineParam(t, tree.ORC, "simple.orc", []*plan.ColDef{testColDef("name", types.T_int64, 0, 0)})
+	err = scanOrcFile(context.Background(), param, proc, newTestBatch(proc, param))
+	require.Error(t, err)
+
+	// 1000.00 is out of the range of decimal(5, 2).
+	param = newInlineParam(t, tree.ORC, "simple.orc", []*plan.ColDef{testColDef("price", types.T_decimal64, 5, 2)})
+	bat := newTestBatch(proc, param)
+	for err == nil && !param.Fileparam.End {
+		bat.CleanOnlyData()
+		err = scanOrcFile(context.Background(), param, proc, bat)
+	}
+	require.Error(t, err)
+	bat.Clean(proc.Mp())
+
+	param = newInlineParam(t, tree.ORC, "simple.parq", nil)
+	err = scanOrcFile(context.Background(), param, proc, newTestBatch(proc, param))
+	require.Error(t, err)
+}
+
+func Test_orcIntCast(t *testing.T) {
+	v, ok := orcIntCast[int8](127)
+	require.True(t, ok)
+	require.Equal(t, int8(127), v)
+	_, ok = orcIntCast[int8](128)
+	require.False(t, ok)
+	_, ok = orcIntCast[uint64](-1)
+	require.False(t, ok)
+	_, ok = orcIntCast[uint8](-1)
+	require.False(t, ok)
+	u, ok := orcIntCast[uint32](1 << 31)
+	require.True(t, ok)
+	require.Equal(t, uint32(1<<31), u)
+}
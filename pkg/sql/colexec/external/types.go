@@ -29,7 +29,9 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/util/avro"
 	"github.com/matrixorigin/matrixone/pkg/sql/util/csvparser"
+	"github.com/matrixorigin/matrixone/pkg/sql/util/orc"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/blockio"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -84,6 +86,8 @@ type ExParam struct {
 	Filter         *FilterParam
 	MoCsvLineArray [][]csvparser.Field
 	parqh          *ParquetHandler
+	orch           *OrcHandler
+	avroh          *AvroHandler
 }
 
 type ExFileparam struct {
@@ -254,3 +258,26 @@ type columnMapper struct {
 
 	mapper func(mp *columnMapper, page parquet.Page, proc *process.Process, vec *vector.Vector) error
 }
+
+type OrcHandler struct {
+	file *orc.Reader
+	// next is the index of the next stripe to read.
+	next int
+	// stripe is the current stripe, offset is the number of its rows read.
+	stripe  *orc.Stripe
+	offset  int
+	data    []*orc.Column
+	columns []uint32
+	mappers []orcMapper
+}
+
+// orcMapper appends the values of the rows [start, end) of the column to vec.
+type orcMapper func(col *orc.Column, start, end int, proc *process.Process, vec *vector.Vector) error
+
+type AvroHandler struct {
+	file *avro.Reader
+	// schemas are the schemas of the fields of the columns, nil for the
+	// hidden columns.
+	schemas []*avro.Schema
+	lines   [][]csvparser.Field
+}
@@ -18,6 +18,8 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"math"
+	"slices"
 	"strings"
 	"time"
 
@@ -153,9 +155,13 @@ func callBlocking(
 	result := vm.NewCallResult()
 	if lockOp.ctr.step == stepLock {
 		for {
-			bat, err := lockOp.getBatch(proc, analyzer, isFirst)
-			if err != nil {
-				return result, err
+			var bat *batch.Batch
+			var err error
+			// the rows up to the limit are locked, stop reading the input.
+			if !lockOp.limited || lockOp.ctr.lockedRows < lockOp.limit {
+				if bat, err = lockOp.getBatch(proc, analyzer, isFirst); err != nil {
+					return result, err
+				}
 			}
 
 			// no input batch any more, means all lock performed.
@@ -172,18 +178,9 @@ func callBlocking(
 				continue
 			}
 
-			if err = performLock(bat, proc, lockOp, analyzer); err != nil {
-				return result, err
-			}
-
-			// blocking lock node. Never pass the input batch into downstream operators before
-			// all lock are performed.
-			appendBat, err := bat.Dup(proc.GetMPool())
-			if err != nil {
+			if err = lockAndCache(bat, proc, lockOp, analyzer); err != nil {
 				return result, err
 			}
-			analyzer.Alloc(int64(appendBat.Size()))
-			lockOp.ctr.cachedBatches = append(lockOp.ctr.cachedBatches, appendBat)
 		}
 	}
 
@@ -218,6 +215,61 @@ func callBlocking(
 	panic("BUG")
 }
 
+// lockAndCache locks the rows of the input batch and caches the locked rows.
+// The blocking lock node never passes the input batch into downstream operators
+// before all lock are performed. If the lock op is limited, the rows are locked
+// part by part until the rows locked reach the limit, the rows skipped by SKIP
+// LOCKED are not counted.
+func lockAndCache(
+	bat *batch.Batch,
+	proc *process.Process,
+	lockOp *LockOp,
+	analyzer process.Analyzer,
+) error {
+	rows := bat.RowCount()
+	for start := 0; start < rows; {
+		end := rows
+		if lockOp.limited {
+			if lockOp.ctr.lockedRows >= lockOp.limit {
+				return nil
+			}
+			if n := lockOp.limit - lockOp.ctr.lockedRows; n < uint64(end-start) {
+				end = start + int(n)
+			}
+		}
+
+		appendBat, err := bat.Dup(proc.GetMPool())
+		if err != nil {
+			return err
+		}
+		if start > 0 || end < rows {
+			sels := make([]int64, 0, end-start)
+			for i := start; i < end; i++ {
+				sels = append(sels, int64(i))
+			}
+			appendBat.Shrink(sels, false)
+		}
+		start = end
+
+		if err = performLock(appendBat, proc, lockOp, analyzer); err != nil {
+			appendBat.Clean(proc.GetMPool())
+			return err
+		}
+		// rows held by other txns are skipped with SKIP LOCKED
+		if len(lockOp.ctr.skipped) > 0 {
+			appendBat.Shrink(lockOp.ctr.skipped, true)
+			if appendBat.IsEmpty() {
+				appendBat.Clean(proc.GetMPool())
+				continue
+			}
+		}
+		lockOp.ctr.lockedRows += uint64(appendBat.RowCount())
+		analyzer.Alloc(int64(appendBat.Size()))
+		lockOp.ctr.cachedBatches = append(lockOp.ctr.cachedBatches, appendBat)
+	}
+	return nil
+}
+
 func performLock(
 	bat *batch.Batch,
 	proc *process.Process,
@@ -225,6 +277,7 @@ func performLock(
 	analyzer process.Analyzer,
 ) error {
 	needRetry := false
+	lockOp.ctr.skipped = lockOp.ctr.skipped[:0]
 	for idx, target := range lockOp.targets {
 		if proc.GetTxnOperator().LockSkipped(target.tableID, target.mode) {
 			return nil
@@ -256,12 +309,21 @@ func performLock(
 			priVec,
 			target.primaryColumnType,
 			DefaultLockOptions(lockOp.ctr.parker).
-				WithLockMode(lock.LockMode_Exclusive).
+				WithLockMode(target.mode).
+				WithWaitPolicy(target.waitPolicy).
 				WithFetchLockRowsFunc(lockOp.ctr.fetchers[idx]).
 				WithMaxBytesPerLock(int(proc.GetLockService().GetConfig().MaxLockRowCount)).
 				WithFilterRows(target.filter, filterCols).
 				WithLockTable(target.lockTable, target.changeDef).
-				WithHasNewVersionInRangeFunc(lockOp.ctr.hasNewVersionInRange),
+				WithHasNewVersionInRangeFunc(lockOp.ctr.hasNewVersionInRange).
+				WithSkippedRowsFunc(func(skipped []uint32) {
+					lockOp.ctr.skipped = appendSkippedRows(
+						lockOp.ctr.skipped,
+						skipped,
+						priVec.Length(),
+						target.filter,
+						filterCols)
+				}),
 		)
 		if lockOp.logger.Enabled(zap.DebugLevel) {
 			lockOp.logger.Debug("lock result",
@@ -309,6 +371,10 @@ func performLock(
 	if lockOp.ctr.defChanged {
 		lockOp.ctr.retryError = retryWithDefChangedError
 	}
+	if len(lockOp.targets) > 1 {
+		slices.Sort(lockOp.ctr.skipped)
+		lockOp.ctr.skipped = slices.Compact(lockOp.ctr.skipped)
+	}
 	return nil
 }
 
@@ -453,6 +519,15 @@ func doLock(
 	if opts.maxCountPerLock == 0 {
 		opts.maxCountPerLock = int(lockService.GetConfig().MaxLockRowCount)
 	}
+	policy := opts.waitPolicy
+	if policy == lock.WaitPolicy_Wait {
+		policy = proc.GetWaitPolicy()
+	}
+	// the skipped rows must be mapped back to the input rows, so never
+	// convert them into a range lock.
+	if policy == lock.WaitPolicy_SkipLocked {
+		opts.maxCountPerLock = math.MaxInt
+	}
 	fetchFunc := opts.fetchFunc
 	if fetchFunc == nil {
 		fetchFunc = GetFetchRowsFunc(pkType)
@@ -473,7 +548,7 @@ func doLock(
 	txn := txnOp.Txn()
 	options := lock.LockOptions{
 		Granularity:     g,
-		Policy:          proc.GetWaitPolicy(),
+		Policy:          policy,
 		Mode:            opts.mode,
 		TableDefChanged: opts.changeDef,
 		Sharding:        opts.sharding,
@@ -515,11 +590,20 @@ func doLock(
 		}
 	}
 	if err != nil {
+		if opts.waitPolicy == lock.WaitPolicy_FastFail &&
+			moerr.IsMoErrCode(err, moerr.ErrLockConflict) {
+			err = moerr.NewLockNoWait(ctx)
+		}
 		return false, false, timestamp.Timestamp{}, err
 	}
 	// Record lock waiting time
 	analyzeLockWaitTime(analyzer, start)
 
+	if len(result.SkippedRows) > 0 &&
+		opts.skippedRowsFunc != nil {
+		opts.skippedRowsFunc(result.SkippedRows)
+	}
+
 	if runtime.InTesting(proc.GetService()) {
 		tc := runtime.MustGetTestingContext(proc.GetService())
 		tc.GetAdjustLockResultFunc()(txn.ID, tableID, &result)
@@ -691,6 +775,13 @@ func (opts LockOptions) WithLockMode(mode lock.LockMode) LockOptions {
 	return opts
 }
 
+// WithWaitPolicy set the wait policy, Wait means use the wait policy of
+// the current statement.
+func (opts LockOptions) WithWaitPolicy(policy lock.WaitPolicy) LockOptions {
+	opts.waitPolicy = policy
+	return opts
+}
+
 // WithLockTable set lock all table
 func (opts LockOptions) WithLockTable(lockTable, changeDef bool) LockOptions {
 	opts.lockTable = lockTable
@@ -729,6 +820,13 @@ func (opts LockOptions) WithHasNewVersionInRangeFunc(fn hasNewVersionInRangeFunc
 	return opts
 }
 
+// WithSkippedRowsFunc set the func to receive the index of the lock rows which
+// are skipped with SkipLocked wait policy.
+func (opts LockOptions) WithSkippedRowsFunc(fn func(skipped []uint32)) LockOptions {
+	opts.skippedRowsFunc = fn
+	return opts
+}
+
 // NewArgument create new lock op argument.
 func NewArgumentByEngine(engine engine.Engine) *LockOp {
 	lock := reuse.Alloc[LockOp](nil)
@@ -750,6 +848,14 @@ func (lockOp *LockOp) SetBlock(block bool) *LockOp {
 	return lockOp
 }
 
+// SetLimit set the blocking lock op to lock only the first limit rows, used by
+// select for update/share with SKIP LOCKED and LIMIT.
+func (lockOp *LockOp) SetLimit(limit uint64) *LockOp {
+	lockOp.limited = true
+	lockOp.limit = limit
+	return lockOp
+}
+
 // AddLockTarget add lock targets
 func (lockOp *LockOp) CopyToPipelineTarget() []*pipeline.LockTarget {
 	targets := make([]*pipeline.LockTarget, len(lockOp.targets))
@@ -763,6 +869,7 @@ func (lockOp *LockOp) CopyToPipelineTarget() []*pipeline.LockTarget {
 			LockTable:          target.lockTable,
 			ChangeDef:          target.changeDef,
 			Mode:               target.mode,
+			WaitPolicy:         target.waitPolicy,
 		}
 	}
 	return targets
@@ -826,6 +933,19 @@ func (lockOp *LockOp) LockTableWithMode(
 	return lockOp
 }
 
+// SetWaitPolicy set the wait policy of the lock target, used by select for
+// update/share with NOWAIT or SKIP LOCKED.
+func (lockOp *LockOp) SetWaitPolicy(
+	tableID uint64,
+	policy lock.WaitPolicy) *LockOp {
+	for idx := range lockOp.targets {
+		if lockOp.targets[idx].tableID == tableID {
+			lockOp.targets[idx].waitPolicy = policy
+		}
+	}
+	return lockOp
+}
+
 // AddLockTargetWithPartition add lock targets for partition tables. Our partitioned table implementation
 // has each partition as a separate table. So when modifying data, these rows may belong to different
 // partitions. For lock op does not care about the logic of data and partition mapping calculation, the
@@ -865,7 +985,8 @@ func (lockOp *LockOp) AddLockTargetWithPartitionAndMode(
 
 	// only one partition table, process as normal table
 	if len(tableIDs) == 1 {
-		return lockOp.AddLockTarget(tableIDs[0],
+		return lockOp.AddLockTargetWithMode(tableIDs[0],
+			mode,
 			primaryColumnIndexInBatch,
 			primaryColumnType,
 			refreshTimestampIndexInBatch,
@@ -892,6 +1013,7 @@ func (lockOp *LockOp) Reset(proc *process.Process, pipelineFailed bool, err erro
 	lockOp.ctr.retryError = nil
 	lockOp.ctr.step = stepLock
 	lockOp.ctr.defChanged = false
+	lockOp.ctr.lockedRows = 0
 }
 
 // Free free mem
@@ -943,6 +1065,36 @@ func (lockOp *LockOp) getBatch(
 	return input.Batch, nil
 }
 
+// appendSkippedRows maps the lock rows skipped by the lockservice to the rows
+// of the input batch. Lock rows are fetched in order from the rows accepted
+// by the filter.
+func appendSkippedRows(
+	sels []int64,
+	skipped []uint32,
+	n int,
+	filter RowsFilter,
+	filterCols []int32) []int64 {
+	if filter == nil {
+		for _, row := range skipped {
+			sels = append(sels, int64(row))
+		}
+		return sels
+	}
+
+	idx := uint32(0)
+	for row := 0; row < n && len(skipped) > 0; row++ {
+		if !filter(row, filterCols) {
+			continue
+		}
+		if idx == skipped[0] {
+			sels = append(sels, int64(row))
+			skipped = skipped[1:]
+		}
+		idx++
+	}
+	return sels
+}
+
 func getRowsFilter(
 	tableID uint64,
 	partitionTables []uint64) RowsFilter {
@@ -403,6 +403,210 @@ func TestLockWithBlockingWithConflict(t *testing.T) {
 	)
 }
 
+func TestLockWithBlockingWithSkipLocked(t *testing.T) {
+	tableID := uint64(10)
+	values := [][]int32{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}
+	var locked []int32
+	runLockBlockingOpTest(
+		t,
+		tableID,
+		values,
+		func(proc *process.Process) {
+			parker := types.NewPacker()
+			defer parker.Close()
+
+			var rows [][]byte
+			for _, v := range []int32{1, 5} {
+				parker.Reset()
+				parker.EncodeInt32(v)
+				rows = append(rows, parker.Bytes())
+			}
+			_, err := proc.GetLockService().Lock(
+				proc.Ctx,
+				tableID,
+				rows,
+				[]byte("txn01"),
+				lock.LockOptions{})
+			require.NoError(t, err)
+		},
+		func(
+			proc *process.Process,
+			arg *LockOp,
+			idx int,
+			isFirst, isLast bool) (bool, error) {
+			arg.SetWaitPolicy(tableID, lock.WaitPolicy_SkipLocked)
+			arg.ctr.hasNewVersionInRange = testFunc
+			arg.OperatorBase.OperatorInfo = vm.OperatorInfo{
+				Idx:     idx,
+				IsFirst: isFirst,
+				IsLast:  isLast,
+			}
+			end, err := arg.Call(proc)
+			require.NoError(t, err)
+			if end.Batch != nil {
+				locked = append(locked, vector.MustFixedColWithTypeCheck[int32](end.Batch.Vecs[0])...)
+			}
+			return end.Status == vm.ExecStop, nil
+		},
+		func(arg *LockOp, proc *process.Process) {
+			require.Equal(t, []int32{2, 3, 4, 6, 7, 8, 9}, locked)
+			require.NoError(t, proc.GetLockService().Unlock(
+				proc.Ctx,
+				[]byte("txn01"),
+				timestamp.Timestamp{}))
+			arg.Free(proc, false, nil)
+			proc.Free()
+		},
+	)
+}
+
+func TestLockWithBlockingWithSkipLockedAndLimit(t *testing.T) {
+	tableID := uint64(10)
+	values := [][]int32{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}
+	runLockOpTest(
+		t,
+		func(proc *process.Process) {
+			// each worker locks the 2 rows which are not locked by the others.
+			worker := func(proc *process.Process) ([]int32, int) {
+				pkType := types.New(types.T_int32, 0, 0)
+				arg := NewArgumentByEngine(nil).
+					SetBlock(true).
+					AddLockTarget(tableID, 0, pkType, -1).
+					SetWaitPolicy(tableID, lock.WaitPolicy_SkipLocked).
+					SetLimit(2)
+				require.NoError(t, arg.Prepare(proc))
+				arg.ctr.hasNewVersionInRange = testFunc
+
+				var batches []*batch.Batch
+				for _, vs := range values {
+					bat := batch.NewWithSize(1)
+					bat.SetRowCount(len(vs))
+					bat.Vecs[0] = vector.NewVec(pkType)
+					require.NoError(t, vector.AppendFixedList(bat.Vecs[0], vs, nil, proc.Mp()))
+					batches = append(batches, bat)
+				}
+				input := batches
+				arg.ctr.batchFetchFunc = func(*process.Process) (vm.CallResult, error) {
+					if len(input) == 0 {
+						return vm.NewCallResult(), nil
+					}
+					bat := input[0]
+					input = input[1:]
+					return vm.CallResult{Batch: bat}, nil
+				}
+
+				var locked []int32
+				for {
+					end, err := arg.Call(proc)
+					require.NoError(t, err)
+					if end.Batch != nil {
+						locked = append(locked, vector.MustFixedColWithTypeCheck[int32](end.Batch.Vecs[0])...)
+					}
+					if end.Status == vm.ExecStop {
+						break
+					}
+				}
+				for _, bat := range batches {
+					bat.Clean(proc.Mp())
+				}
+				arg.Free(proc, false, nil)
+				return locked, len(input)
+			}
+
+			txnOp2, err := proc.Base.TxnClient.New(proc.Ctx, timestamp.Timestamp{})
+			require.NoError(t, err)
+			proc2 := process.NewTopProcess(
+				proc.Ctx,
+				mpool.MustNewZero(),
+				proc.Base.TxnClient,
+				txnOp2,
+				nil,
+				proc.GetLockService(),
+				nil,
+				nil,
+				nil,
+				nil)
+
+			// the input after the limit is not read
+			locked, unread := worker(proc)
+			require.Equal(t, []int32{1, 2}, locked)
+			require.Equal(t, 2, unread)
+			locked, unread = worker(proc2)
+			require.Equal(t, []int32{3, 4}, locked)
+			require.Equal(t, 1, unread)
+
+			require.NoError(t, proc.GetLockService().Unlock(
+				proc.Ctx,
+				proc.GetTxnOperator().Txn().ID,
+				timestamp.Timestamp{}))
+			require.NoError(t, proc.GetLockService().Unlock(
+				proc.Ctx,
+				txnOp2.Txn().ID,
+				timestamp.Timestamp{}))
+			require.NoError(t, txnOp2.Rollback(proc.Ctx))
+			proc2.Free()
+			proc.Free()
+		},
+	)
+}
+
+func TestLockWithBlockingWithNoWait(t *testing.T) {
+	tableID := uint64(10)
+	values := [][]int32{{1, 2, 3}}
+	runLockBlockingOpTest(
+		t,
+		tableID,
+		values,
+		func(proc *process.Process) {
+			parker := types.NewPacker()
+			defer parker.Close()
+
+			parker.Reset()
+			parker.EncodeInt32(2)
+			_, err := proc.GetLockService().Lock(
+				proc.Ctx,
+				tableID,
+				[][]byte{parker.Bytes()},
+				[]byte("txn01"),
+				lock.LockOptions{})
+			require.NoError(t, err)
+		},
+		func(
+			proc *process.Process,
+			arg *LockOp,
+			idx int,
+			isFirst, isLast bool) (bool, error) {
+			arg.SetWaitPolicy(tableID, lock.WaitPolicy_FastFail)
+			arg.ctr.hasNewVersionInRange = testFunc
+			arg.OperatorBase.OperatorInfo = vm.OperatorInfo{
+				Idx:     idx,
+				IsFirst: isFirst,
+				IsLast:  isLast,
+			}
+			end, err := arg.Call(proc)
+			require.True(t, moerr.IsMoErrCode(err, moerr.ErrLockNoWait))
+			return end.Status == vm.ExecStop, err
+		},
+		func(arg *LockOp, proc *process.Process) {
+			require.NoError(t, proc.GetLockService().Unlock(
+				proc.Ctx,
+				[]byte("txn01"),
+				timestamp.Timestamp{}))
+			arg.Free(proc, false, nil)
+			proc.Free()
+		},
+	)
+}
+
+func TestAppendSkippedRows(t *testing.T) {
+	require.Equal(t, []int64{1, 3}, appendSkippedRows(nil, []uint32{1, 3}, 4, nil, nil))
+
+	// only the rows of partition 1 are locked
+	filter := getRowsFilter(1, []uint64{0, 1})
+	filterCols := []int32{1, 0, 1, 1, 0, 1}
+	require.Equal(t, []int64{2, 5}, appendSkippedRows(nil, []uint32{1, 3}, 6, filter, filterCols))
+}
+
 func TestLockWithHasNewVersionInLockedTS(t *testing.T) {
 	tw := client.NewTimestampWaiter(runtime.GetLogger(""))
 	stopper := stopper.NewStopper("")
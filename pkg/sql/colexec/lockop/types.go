@@ -50,6 +50,7 @@ type FetchLockRowsFunc func(
 type LockOptions struct {
 	maxCountPerLock          int
 	mode                     lock.LockMode
+	waitPolicy               lock.WaitPolicy
 	sharding                 lock.Sharding
 	group                    uint32
 	lockTable                bool
@@ -59,6 +60,7 @@ type LockOptions struct {
 	filter                   RowsFilter
 	filterCols               []int32
 	hasNewVersionInRangeFunc hasNewVersionInRangeFunc
+	skippedRowsFunc          func(skipped []uint32)
 }
 
 // LockOp lock op argument.
@@ -70,6 +72,11 @@ type LockOp struct {
 	engine  engine.Engine
 	targets []lockTarget
 	block   bool
+	// limited indicates that only the first limit rows are locked and passed
+	// to the downstream operators, it is set for the blocking lock op under
+	// the limit with SKIP LOCKED.
+	limited bool
+	limit   uint64
 }
 
 func (lockOp *LockOp) GetOperatorBase() *vm.OperatorBase {
@@ -113,6 +120,7 @@ type lockTarget struct {
 	lockTable                    bool
 	changeDef                    bool
 	mode                         lock.LockMode
+	waitPolicy                   lock.WaitPolicy
 }
 
 // RowsFilter used to filter row from primary vector. The row will not lock if filter return false.
@@ -136,6 +144,11 @@ type state struct {
 	buf                  *batch.Batch
 	batchFetchFunc       func(proc *process.Process) (vm.CallResult, error)
 	hasNewVersionInRange hasNewVersionInRangeFunc
+	// skipped is the rows of the current batch which are not locked
+	// because of the SkipLocked wait policy.
+	skipped []int64
+	// lockedRows is the number of the rows locked and cached.
+	lockedRows uint64
 }
 
 const (
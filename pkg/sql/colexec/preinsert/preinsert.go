@@ -63,6 +63,16 @@ func (preInsert *PreInsert) Prepare(proc *process.Process) (err error) {
 			return
 		}
 	}
+	if len(preInsert.Checks) > 0 && preInsert.ctr.checkExecutors == nil {
+		preInsert.ctr.checkExecutors = make([]colexec.ExpressionExecutor, len(preInsert.Checks))
+		preInsert.ctr.checkVecs = make([]*vector.Vector, len(preInsert.Checks))
+		for i, check := range preInsert.Checks {
+			preInsert.ctr.checkExecutors[i], err = colexec.NewExpressionExecutor(proc, check.Check)
+			if err != nil {
+				return
+			}
+		}
+	}
 	return
 }
 
@@ -215,6 +225,17 @@ func (preInsert *PreInsert) Call(proc *proc) (vm.CallResult, error) {
 	if err != nil {
 		return result, err
 	}
+	// check new rows satisfy the check constraints
+	if len(preInsert.ctr.checkExecutors) > 0 {
+		for i, executor := range preInsert.ctr.checkExecutors {
+			if preInsert.ctr.checkVecs[i], err = executor.Eval(proc, []*batch.Batch{preInsert.ctr.buf}, nil); err != nil {
+				return result, err
+			}
+		}
+		if err = colexec.BatchDataCheckConstraintCheck(preInsert.ctr.checkVecs, preInsert.Checks, proc.Ctx); err != nil {
+			return result, err
+		}
+	}
 
 	if err = preInsert.constructHiddenColBuf(proc, bat, first); err != nil {
 		return result, err
@@ -25,6 +25,7 @@ import (
 	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
@@ -316,3 +317,75 @@ func resetChildren2(arg *PreInsert) {
 	arg.Children = nil
 	arg.AppendChild(op)
 }
+
+func TestPreInsertCheckConstraint(t *testing.T) {
+	proc := testutil.NewProc()
+	i32 := plan.Type{Id: int32(types.T_int32)}
+	makeCheck := func(name string, limit int32) *plan.CheckDef {
+		fr, err := function.GetFunctionByName(proc.Ctx, "<", []types.Type{types.T_int32.ToType(), types.T_int32.ToType()})
+		require.NoError(t, err)
+		return &plan.CheckDef{
+			Name: name,
+			Check: &plan.Expr{
+				Typ: plan.Type{Id: int32(types.T_bool)},
+				Expr: &plan.Expr_F{
+					F: &plan.Function{
+						Func: &plan.ObjectRef{Obj: fr.GetEncodedOverloadID(), ObjName: "<"},
+						Args: []*plan.Expr{
+							{Typ: i32, Expr: &plan.Expr_Col{Col: &plan.ColRef{ColPos: 0}}},
+							{Typ: i32, Expr: &plan.Expr_Lit{Lit: &plan.Literal{Value: &plan.Literal_I32Val{I32Val: limit}}}},
+						},
+					},
+				},
+			},
+			Enforced: true,
+		}
+	}
+	newArg := func(checks ...*plan.CheckDef) *PreInsert {
+		return &PreInsert{
+			ctr:        container{},
+			SchemaName: "testDb",
+			TableDef: &plan.TableDef{
+				Cols: []*plan.ColDef{
+					{Name: "a", Typ: i32},
+					{Name: "b", Typ: plan.Type{Id: int32(types.T_uuid)}},
+					{Name: "c", Typ: varchartyp},
+					{Name: "d", Typ: plan.Type{Id: int32(types.T_json)}},
+					{Name: "e", Typ: plan.Type{Id: int32(types.T_datetime)}},
+				},
+				Pkey: &plan.PrimaryKeyDef{},
+			},
+			Attrs:  []string{"a", "b", "c", "d", "e"},
+			Checks: checks,
+		}
+	}
+
+	// the rows of the mock batch are (1, ...) and (1000, ...)
+	argument1 := newArg(makeCheck("chk_ok", 10000))
+	resetChildren2(argument1)
+	require.NoError(t, argument1.Prepare(proc))
+	_, err := argument1.Call(proc)
+	require.NoError(t, err)
+	argument1.Free(proc, false, nil)
+
+	argument2 := newArg(makeCheck("chk_ok", 10000), makeCheck("chk_a", 100))
+	resetChildren2(argument2)
+	require.NoError(t, argument2.Prepare(proc))
+	_, err = argument2.Call(proc)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Check constraint 'chk_a' is violated.")
+	argument2.Free(proc, false, nil)
+
+	// the executors of the checks after the failed one are not created.
+	bad := &plan.CheckDef{
+		Name:  "chk_bad",
+		Check: &plan.Expr{Typ: plan.Type{Id: int32(types.T_bool)}, Expr: &plan.Expr_Sub{Sub: &plan.SubqueryRef{}}},
+	}
+	argument3 := newArg(makeCheck("chk_ok", 10000), bad, makeCheck("chk_a", 100))
+	resetChildren2(argument3)
+	require.Error(t, argument3.Prepare(proc))
+	argument3.Reset(proc, true, nil)
+	argument3.Free(proc, true, nil)
+	proc.Free()
+	require.Equal(t, int64(0), proc.GetMPool().CurrNB())
+}
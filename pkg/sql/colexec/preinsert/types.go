@@ -17,6 +17,7 @@ package preinsert
 import (
 	"github.com/matrixorigin/matrixone/pkg/common/reuse"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan"
 
@@ -34,6 +35,8 @@ type container struct {
 	canFreeVecIdx     map[int]bool //auto incr & expand constant vecotr.need free
 	clusterByExecutor colexec.ExpressionExecutor
 	compPkExecutor    colexec.ExpressionExecutor
+	checkExecutors    []colexec.ExpressionExecutor
+	checkVecs         []*vector.Vector
 }
 type PreInsert struct {
 	ctr container
@@ -48,6 +51,9 @@ type PreInsert struct {
 	EstimatedRowCount int64
 	CompPkeyExpr      *plan.Expr
 	ClusterByExpr     *plan.Expr
+	// Checks are the enforced check constraints of the table, bound on the
+	// columns of Attrs.
+	Checks []*pb.CheckDef
 
 	vm.OperatorBase
 }
@@ -90,6 +96,11 @@ func (preInsert *PreInsert) Reset(proc *process.Process, pipelineFailed bool, er
 	if preInsert.ctr.clusterByExecutor != nil {
 		preInsert.ctr.clusterByExecutor.ResetForNextQuery()
 	}
+	for _, executor := range preInsert.ctr.checkExecutors {
+		if executor != nil {
+			executor.ResetForNextQuery()
+		}
+	}
 }
 
 func (preInsert *PreInsert) Free(proc *process.Process, pipelineFailed bool, err error) {
@@ -101,6 +112,13 @@ func (preInsert *PreInsert) Free(proc *process.Process, pipelineFailed bool, err
 		preInsert.ctr.clusterByExecutor.Free()
 		preInsert.ctr.clusterByExecutor = nil
 	}
+	for _, executor := range preInsert.ctr.checkExecutors {
+		if executor != nil {
+			executor.Free()
+		}
+	}
+	preInsert.ctr.checkExecutors = nil
+	preInsert.ctr.checkVecs = nil
 	if preInsert.ctr.buf != nil {
 		for idx := range preInsert.Attrs {
 			if _, ok := preInsert.ctr.canFreeVecIdx[idx]; !ok {
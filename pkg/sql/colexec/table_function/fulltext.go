@@ -0,0 +1,417 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table_function
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/runtime"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/fulltext"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+	"github.com/matrixorigin/matrixone/pkg/util/executor"
+	"github.com/matrixorigin/matrixone/pkg/vm"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// fulltextTokenizeState is the state of fulltext_index_tokenize(parser, doc_id, col1, col2, ...),
+// it returns the rows <serial(doc_id, pos), doc_id, word, pos> of the fulltext index table for one document.
+type fulltextTokenizeState struct {
+	simpleOneBatchState
+	tokenizer fulltext.Tokenizer
+	tokens    []fulltext.Token
+
+	// doc_id and pos of the tokens, used to build the serial key.
+	docs    *vector.Vector
+	poss    *vector.Vector
+	packers []*types.Packer
+}
+
+func fulltextIndexTokenizePrepare(proc *process.Process, tableFunction *TableFunction) (tvfState, error) {
+	var err error
+	if len(tableFunction.Args) < 3 {
+		return nil, moerr.NewInvalidInput(proc.Ctx, "fulltext_index_tokenize: parser, doc_id and at least one column are required")
+	}
+	tableFunction.ctr.executorsForArgs, err = colexec.NewExpressionExecutorsFromPlanExpressions(proc, tableFunction.Args)
+	tableFunction.ctr.argVecs = make([]*vector.Vector, len(tableFunction.Args))
+	for i := range tableFunction.Attrs {
+		tableFunction.Attrs[i] = strings.ToLower(tableFunction.Attrs[i])
+	}
+	return &fulltextTokenizeState{}, err
+}
+
+func (s *fulltextTokenizeState) start(tf *TableFunction, proc *process.Process, nthRow int) error {
+	s.startPreamble(tf, proc, nthRow)
+
+	var err error
+	if s.tokenizer == nil {
+		parser := tf.ctr.argVecs[0]
+		if !parser.IsConst() || !parser.GetType().Oid.IsMySQLString() {
+			return moerr.NewInvalidInput(proc.Ctx, "fulltext_index_tokenize: parser must be a constant string")
+		}
+		name := ""
+		if !parser.IsConstNull() {
+			name = parser.GetStringAt(0)
+		}
+		if s.tokenizer, err = fulltext.NewTokenizer(name); err != nil {
+			return err
+		}
+	}
+
+	doc := tf.ctr.argVecs[1]
+	if doc.IsNull(uint64(nthRow)) {
+		return nil
+	}
+
+	// positions go on across the columns of a document
+	s.tokens = s.tokens[:0]
+	pos := int32(0)
+	for _, col := range tf.ctr.argVecs[2:] {
+		if col.IsNull(uint64(nthRow)) {
+			continue
+		}
+		s.tokens = s.tokenizer.Tokenize(col.GetStringAt(nthRow), pos, s.tokens)
+		if len(s.tokens) > 0 {
+			pos = s.tokens[len(s.tokens)-1].Pos + 1
+		}
+	}
+	n := len(s.tokens)
+	if n == 0 {
+		return nil
+	}
+
+	mp := proc.Mp()
+	if s.docs == nil {
+		s.docs = vector.NewVec(*doc.GetType())
+		s.poss = vector.NewVec(types.T_int32.ToType())
+	} else {
+		s.docs.CleanOnlyData()
+		s.poss.CleanOnlyData()
+	}
+	if err = s.docs.UnionMulti(doc, int64(nthRow), n, mp); err != nil {
+		return err
+	}
+	for _, tok := range s.tokens {
+		if err = vector.AppendFixed(s.poss, tok.Pos, false, mp); err != nil {
+			return err
+		}
+	}
+
+	for i, attr := range tf.Attrs {
+		vec := s.batch.Vecs[i]
+		switch attr {
+		case catalog.FullTextIndexTableKeyColName:
+			err = s.appendKeys(vec, n, proc)
+		case catalog.FullTextIndexTableDocIdColName:
+			err = vec.UnionBatch(s.docs, 0, n, nil, mp)
+		case catalog.FullTextIndexTableWordColName:
+			for _, tok := range s.tokens {
+				if err = vector.AppendBytes(vec, []byte(tok.Word), false, mp); err != nil {
+					break
+				}
+			}
+		case catalog.FullTextIndexTablePosColName:
+			err = vec.UnionBatch(s.poss, 0, n, nil, mp)
+		default:
+			err = moerr.NewInvalidInputf(proc.Ctx, "%v is not supported by fulltext_index_tokenize()", attr)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	s.batch.SetRowCount(n)
+	return nil
+}
+
+// appendKeys appends serial(doc_id, pos) of the tokens to vec.
+func (s *fulltextTokenizeState) appendKeys(vec *vector.Vector, n int, proc *process.Process) error {
+	if n > len(s.packers) {
+		for _, p := range s.packers {
+			p.Close()
+		}
+		s.packers = types.NewPackerArray(n)
+	}
+	ps := s.packers[:n]
+	defer func() {
+		for _, p := range ps {
+			p.Reset()
+		}
+	}()
+
+	function.SerialHelper(s.docs, nil, ps, true)
+	function.SerialHelper(s.poss, nil, ps, true)
+	for _, p := range ps {
+		if err := vector.AppendBytes(vec, p.GetBuf(), false, proc.Mp()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *fulltextTokenizeState) free(tf *TableFunction, proc *process.Process, pipelineFailed bool, err error) {
+	s.simpleOneBatchState.free(tf, proc, pipelineFailed, err)
+	if s.docs != nil {
+		s.docs.Free(proc.Mp())
+		s.poss.Free(proc.Mp())
+		s.docs, s.poss = nil, nil
+	}
+	for _, p := range s.packers {
+		p.Close()
+	}
+	s.packers = nil
+}
+
+// fulltextIndexScanState is the state of fulltext_index_scan(db, index_table, parser, mode, pattern),
+// it searches the fulltext index table and returns <doc_id, score> of the matched documents.
+type fulltextIndexScanState struct {
+	docs   []string
+	scores []float64
+	next   int
+
+	// result batch, we own it.
+	batch *batch.Batch
+
+	// corpus is reused by the searches of the statement.
+	corpus fulltextCorpus
+}
+
+// fulltextCorpus is the statistics of all documents in a fulltext index table,
+// it is computed once per statement instead of once per search.
+type fulltextCorpus struct {
+	table     string
+	docCount  int64
+	avgDocLen float64
+}
+
+func fulltextIndexScanPrepare(proc *process.Process, tableFunction *TableFunction) (tvfState, error) {
+	var err error
+	if len(tableFunction.Args) != 5 {
+		return nil, moerr.NewInvalidInput(proc.Ctx, "fulltext_index_scan: db, index table, parser, mode and pattern are required")
+	}
+	tableFunction.ctr.executorsForArgs, err = colexec.NewExpressionExecutorsFromPlanExpressions(proc, tableFunction.Args)
+	tableFunction.ctr.argVecs = make([]*vector.Vector, len(tableFunction.Args))
+	for i := range tableFunction.Attrs {
+		tableFunction.Attrs[i] = strings.ToLower(tableFunction.Attrs[i])
+	}
+	return &fulltextIndexScanState{}, err
+}
+
+func (s *fulltextIndexScanState) reset(tf *TableFunction, proc *process.Process) {
+	if s.batch != nil {
+		s.batch.CleanOnlyData()
+	}
+	s.docs, s.scores, s.next = nil, nil, 0
+	s.corpus = fulltextCorpus{}
+}
+
+func (s *fulltextIndexScanState) start(tf *TableFunction, proc *process.Process, nthRow int) error {
+	if s.batch == nil {
+		s.batch = tf.createResultBatch()
+	}
+	s.docs, s.scores, s.next = s.docs[:0], s.scores[:0], 0
+
+	args := make([]string, 5)
+	for i, vec := range tf.ctr.argVecs {
+		row := uint64(nthRow)
+		if vec.IsConst() {
+			row = 0
+		}
+		if vec.IsNull(row) {
+			// MATCH ... AGAINST (NULL) matches nothing
+			return nil
+		}
+		if i == 3 {
+			continue
+		}
+		args[i] = vec.GetStringAt(int(row))
+	}
+	mode := tf.ctr.argVecs[3]
+	if mode.GetType().Oid != types.T_int64 {
+		return moerr.NewInvalidInput(proc.Ctx, "fulltext_index_scan: mode must be an integer")
+	}
+	scores, err := fulltextSearch(proc, &s.corpus, args[0], args[1], args[2],
+		fulltext.Mode(vector.GetFixedAtNoTypeCheck[int64](mode, 0)), args[4])
+	if err != nil {
+		return err
+	}
+
+	// output the documents in the order of their keys, so the result is stable.
+	for doc := range scores {
+		s.docs = append(s.docs, doc)
+	}
+	sort.Strings(s.docs)
+	for _, doc := range s.docs {
+		s.scores = append(s.scores, scores[doc])
+	}
+	return nil
+}
+
+func (s *fulltextIndexScanState) call(tf *TableFunction, proc *process.Process) (vm.CallResult, error) {
+	s.batch.CleanOnlyData()
+	end := min(s.next+8192, len(s.docs))
+	if s.next >= end {
+		return vm.CancelResult, nil
+	}
+
+	mp := proc.Mp()
+	for i, attr := range tf.Attrs {
+		vec := s.batch.Vecs[i]
+		switch attr {
+		case catalog.FullTextIndexTableDocIdColName:
+			appendDoc := vector.MakeAppendBytesFunc(vec)
+			for _, doc := range s.docs[s.next:end] {
+				if err := appendDoc([]byte(doc), false, mp); err != nil {
+					return vm.CancelResult, err
+				}
+			}
+		case catalog.FullTextIndexScanScoreColName:
+			if err := vector.AppendFixedList(vec, s.scores[s.next:end], nil, mp); err != nil {
+				return vm.CancelResult, err
+			}
+		default:
+			return vm.CancelResult, moerr.NewInvalidInputf(proc.Ctx, "%v is not supported by fulltext_index_scan()", attr)
+		}
+	}
+	s.batch.SetRowCount(end - s.next)
+	s.next = end
+	return vm.CallResult{Status: vm.ExecNext, Batch: s.batch}, nil
+}
+
+func (s *fulltextIndexScanState) free(tf *TableFunction, proc *process.Process, pipelineFailed bool, err error) {
+	if s.batch != nil {
+		s.batch.Clean(proc.Mp())
+		s.batch = nil
+	}
+	s.docs, s.scores = nil, nil
+}
+
+// fulltextSearch searches the fulltext index table and returns the BM25 relevance of
+// every matched document, the key of the map is the raw bytes of the primary key.
+// The statistics of all documents are kept in corpus for the later searches.
+func fulltextSearch(proc *process.Process, corpus *fulltextCorpus, db, indexTable, parser string, mode fulltext.Mode, pattern string) (map[string]float64, error) {
+	tokenizer, err := fulltext.NewTokenizer(parser)
+	if err != nil {
+		return nil, err
+	}
+	q, err := fulltext.ParseQuery(pattern, mode, tokenizer)
+	if err != nil {
+		return nil, err
+	}
+	words, prefixes := q.Words()
+	if len(words)+len(prefixes) == 0 {
+		return nil, nil
+	}
+
+	v, ok := runtime.ServiceRuntime(proc.GetService()).GetGlobalVariables(runtime.InternalSQLExecutor)
+	if !ok {
+		return nil, moerr.NewNotSupported(proc.Ctx, "no implement sqlExecutor")
+	}
+	exec := v.(executor.SQLExecutor)
+	opts := executor.Options{}.
+		WithDatabase(db).
+		WithTxn(proc.GetTxnOperator()).
+		WithTimeZone(proc.GetSessionInfo().TimeZone).
+		WithAccountID(proc.GetSessionInfo().AccountId)
+	if proc.GetTxnOperator() != nil {
+		opts = opts.WithDisableIncrStatement() // this option always with WithTxn()
+	}
+	query := func(sql string, fn func(rows int, cols []*vector.Vector) bool) error {
+		res, err := exec.Exec(proc.Ctx, sql, opts)
+		if err != nil {
+			return err
+		}
+		res.ReadRows(fn)
+		res.Close()
+		return nil
+	}
+
+	// words only contain letters, digits and '_', see the tokenizers.
+	conds := make([]string, 0, len(prefixes)+1)
+	if len(words) > 0 {
+		conds = append(conds, fmt.Sprintf("`%s` in ('%s')", catalog.FullTextIndexTableWordColName, strings.Join(words, "','")))
+	}
+	for _, prefix := range prefixes {
+		conds = append(conds, fmt.Sprintf("`%s` like '%s%%'", catalog.FullTextIndexTableWordColName, strings.ReplaceAll(prefix, "_", "\\_")))
+	}
+	where := strings.Join(conds, " or ")
+	table := fmt.Sprintf("`%s`.`%s`", db, indexTable)
+	docCol := catalog.FullTextIndexTableDocIdColName
+
+	// 1. statistics of all documents
+	if corpus.table != table {
+		var totalLen, docCount int64
+		sql := fmt.Sprintf("select count(*), count(distinct `%s`) from %s", docCol, table)
+		if err = query(sql, func(rows int, cols []*vector.Vector) bool {
+			totalLen = vector.GetFixedAtNoTypeCheck[int64](cols[0], 0)
+			docCount = vector.GetFixedAtNoTypeCheck[int64](cols[1], 0)
+			return true
+		}); err != nil {
+			return nil, err
+		}
+		*corpus = fulltextCorpus{table: table, docCount: docCount}
+		if docCount > 0 {
+			corpus.avgDocLen = float64(totalLen) / float64(docCount)
+		}
+	}
+	if corpus.docCount == 0 {
+		return nil, nil
+	}
+	stats := fulltext.Stats{
+		DocCount:  corpus.docCount,
+		AvgDocLen: corpus.avgDocLen,
+		DocLen:    make(map[string]int64),
+	}
+
+	// 2. postings of the query words
+	var postings []fulltext.Posting
+	sql := fmt.Sprintf("select `%s`, `%s`, `%s` from %s where %s",
+		docCol, catalog.FullTextIndexTableWordColName, catalog.FullTextIndexTablePosColName, table, where)
+	if err = query(sql, func(rows int, cols []*vector.Vector) bool {
+		for i := 0; i < rows; i++ {
+			postings = append(postings, fulltext.Posting{
+				Doc:  string(cols[0].GetRawBytesAt(i)),
+				Word: cols[1].GetStringAt(i),
+				Pos:  vector.GetFixedAtNoTypeCheck[int32](cols[2], i),
+			})
+		}
+		return true
+	}); err != nil {
+		return nil, err
+	}
+	if len(postings) == 0 {
+		return nil, nil
+	}
+
+	// 3. length of the documents which have postings
+	sql = fmt.Sprintf("select `%s`, count(*) from %s where `%s` in (select `%s` from %s where %s) group by `%s`",
+		docCol, table, docCol, docCol, table, where, docCol)
+	if err = query(sql, func(rows int, cols []*vector.Vector) bool {
+		for i := 0; i < rows; i++ {
+			stats.DocLen[string(cols[0].GetRawBytesAt(i))] = vector.GetFixedAtNoTypeCheck[int64](cols[1], i)
+		}
+		return true
+	}); err != nil {
+		return nil, err
+	}
+
+	return fulltext.NewScorer().Score(q, postings, stats), nil
+}
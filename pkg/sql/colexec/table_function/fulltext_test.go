@@ -0,0 +1,192 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table_function
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/runtime"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/fulltext"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/util/executor"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFullTextIndexTokenize(t *testing.T) {
+	proc := testutil.NewProc()
+	tf := &TableFunction{
+		Attrs: []string{
+			catalog.FullTextIndexTableKeyColName,
+			catalog.FullTextIndexTableDocIdColName,
+			catalog.FullTextIndexTableWordColName,
+			catalog.FullTextIndexTablePosColName,
+		},
+	}
+	tf.ctr.retSchema = []types.Type{
+		types.T_varchar.ToType(),
+		types.T_int64.ToType(),
+		types.T_varchar.ToType(),
+		types.T_int32.ToType(),
+	}
+
+	parser, err := vector.NewConstBytes(types.T_varchar.ToType(), []byte("default"), 1, proc.Mp())
+	require.NoError(t, err)
+	docs := vector.NewVec(types.T_int64.ToType())
+	require.NoError(t, vector.AppendFixedList(docs, []int64{7, 8}, nil, proc.Mp()))
+	titles := vector.NewVec(types.T_varchar.ToType())
+	require.NoError(t, vector.AppendStringList(titles, []string{"Red Apple", ""}, []bool{false, true}, proc.Mp()))
+	bodies := vector.NewVec(types.T_text.ToType())
+	require.NoError(t, vector.AppendStringList(bodies, []string{"pie", "blue sky"}, nil, proc.Mp()))
+	tf.ctr.argVecs = []*vector.Vector{parser, docs, titles, bodies}
+
+	s := &fulltextTokenizeState{}
+	defer s.free(tf, proc, false, nil)
+
+	require.NoError(t, s.start(tf, proc, 0))
+	bat := s.batch
+	require.Equal(t, 3, bat.RowCount())
+	require.Equal(t, []int64{7, 7, 7}, vector.MustFixedColNoTypeCheck[int64](bat.Vecs[1]))
+	require.Equal(t, []string{"red", "apple", "pie"}, vector.InefficientMustStrCol(bat.Vecs[2]))
+	require.Equal(t, []int32{0, 1, 2}, vector.MustFixedColNoTypeCheck[int32](bat.Vecs[3]))
+	keys := vector.InefficientMustStrCol(bat.Vecs[0])
+	require.Len(t, keys, 3)
+	require.NotEqual(t, keys[0], keys[1])
+
+	// null title is skipped
+	require.NoError(t, s.start(tf, proc, 1))
+	require.Equal(t, 2, s.batch.RowCount())
+	require.Equal(t, []int64{8, 8}, vector.MustFixedColNoTypeCheck[int64](s.batch.Vecs[1]))
+	require.Equal(t, []string{"blue", "sky"}, vector.InefficientMustStrCol(s.batch.Vecs[2]))
+}
+
+func TestFullTextIndexScan(t *testing.T) {
+	proc := testutil.NewProc()
+	tf := &TableFunction{
+		Attrs: []string{
+			catalog.FullTextIndexScanScoreColName,
+			catalog.FullTextIndexTableDocIdColName,
+		},
+	}
+	tf.ctr.retSchema = []types.Type{
+		types.T_float64.ToType(),
+		types.T_int64.ToType(),
+	}
+
+	s := &fulltextIndexScanState{}
+	defer s.free(tf, proc, false, nil)
+	s.batch = tf.createResultBatch()
+
+	n := 10000
+	for i := 0; i < n; i++ {
+		doc := int64(i)
+		s.docs = append(s.docs, string(types.EncodeInt64(&doc)))
+		s.scores = append(s.scores, float64(i)/2)
+	}
+
+	var docs []int64
+	var scores []float64
+	for {
+		res, err := s.call(tf, proc)
+		require.NoError(t, err)
+		if res.Batch.IsDone() {
+			break
+		}
+		require.LessOrEqual(t, res.Batch.RowCount(), 8192)
+		scores = append(scores, vector.MustFixedColNoTypeCheck[float64](res.Batch.Vecs[0])...)
+		docs = append(docs, vector.MustFixedColNoTypeCheck[int64](res.Batch.Vecs[1])...)
+	}
+	require.Len(t, docs, n)
+	require.Equal(t, int64(n-1), docs[n-1])
+	require.Equal(t, s.scores, scores)
+
+	// nothing is matched after reset
+	s.reset(tf, proc)
+	res, err := s.call(tf, proc)
+	require.NoError(t, err)
+	require.True(t, res.Batch.IsDone())
+}
+
+func TestFullTextSearchCorpus(t *testing.T) {
+	proc := testutil.NewProc()
+	mp := proc.Mp()
+
+	// the index table has 2 documents "a" and "b" of 2 and 4 words.
+	corpusQueries := 0
+	runtime.ServiceRuntime(proc.GetService()).SetGlobalVariables(runtime.InternalSQLExecutor,
+		executor.NewMemExecutor(func(sql string) (executor.Result, error) {
+			var res *executor.MemResult
+			switch {
+			case strings.Contains(sql, "count(distinct"):
+				corpusQueries++
+				res = executor.NewMemResult([]types.Type{types.T_int64.ToType(), types.T_int64.ToType()}, mp)
+				res.NewBatchWithRowCount(1)
+				if err := executor.AppendFixedRows(res, 0, []int64{6}); err != nil {
+					return executor.Result{}, err
+				}
+				if err := executor.AppendFixedRows(res, 1, []int64{2}); err != nil {
+					return executor.Result{}, err
+				}
+			case strings.Contains(sql, "group by"):
+				res = executor.NewMemResult([]types.Type{types.T_varchar.ToType(), types.T_int64.ToType()}, mp)
+				res.NewBatchWithRowCount(2)
+				if err := executor.AppendStringRows(res, 0, []string{"a", "b"}); err != nil {
+					return executor.Result{}, err
+				}
+				if err := executor.AppendFixedRows(res, 1, []int64{2, 4}); err != nil {
+					return executor.Result{}, err
+				}
+			default:
+				res = executor.NewMemResult([]types.Type{
+					types.T_varchar.ToType(), types.T_varchar.ToType(), types.T_int32.ToType()}, mp)
+				res.NewBatchWithRowCount(2)
+				if err := executor.AppendStringRows(res, 0, []string{"a", "b"}); err != nil {
+					return executor.Result{}, err
+				}
+				if err := executor.AppendStringRows(res, 1, []string{"hello", "hello"}); err != nil {
+					return executor.Result{}, err
+				}
+				if err := executor.AppendFixedRows(res, 2, []int32{0, 3}); err != nil {
+					return executor.Result{}, err
+				}
+			}
+			return res.GetResult(), nil
+		}))
+
+	// the statistics of all documents are queried once for the searches of the table.
+	var corpus fulltextCorpus
+	scores, err := fulltextSearch(proc, &corpus, "db", "idx", "", fulltext.ModeNaturalLanguage, "hello")
+	require.NoError(t, err)
+	require.Len(t, scores, 2)
+	require.Greater(t, scores["a"], scores["b"])
+	again, err := fulltextSearch(proc, &corpus, "db", "idx", "", fulltext.ModeNaturalLanguage, "hello")
+	require.NoError(t, err)
+	require.Equal(t, scores, again)
+	require.Equal(t, 1, corpusQueries)
+	require.Equal(t, int64(2), corpus.docCount)
+	require.Equal(t, float64(3), corpus.avgDocLen)
+
+	_, err = fulltextSearch(proc, &corpus, "db", "idx2", "", fulltext.ModeNaturalLanguage, "hello")
+	require.NoError(t, err)
+	require.Equal(t, 2, corpusQueries)
+
+	// the statistics are queried again by the next statement.
+	s := &fulltextIndexScanState{corpus: corpus}
+	s.reset(&TableFunction{}, proc)
+	require.Equal(t, fulltextCorpus{}, s.corpus)
+}
@@ -0,0 +1,435 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table_function
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// jsonTableState is the state of json_table(doc), the row path and the columns
+// are in the parameter, see plan.JsonTableParam.
+type jsonTableState struct {
+	simpleOneBatchState
+	root *jsonTableNode
+	loc  *time.Location
+
+	// rows of the current document, one cell for each output column.
+	rows [][]jsonTableCell
+}
+
+// jsonTableNode is the row path and the columns of JSON_TABLE or of a NESTED PATH.
+type jsonTableNode struct {
+	path   bytejson.Path
+	cols   []*jsonTableColumn
+	nested []*jsonTableNode
+}
+
+type jsonTableColumn struct {
+	kind tree.JsonTableColumnKind
+	name string
+	path bytejson.Path
+	// the output column, -1 if the column is not required.
+	pos     int
+	onEmpty jsonTableResponse
+	onError jsonTableResponse
+}
+
+type jsonTableResponse struct {
+	kind tree.JsonTableResponseKind
+	val  bytejson.ByteJson
+}
+
+// jsonTableCell is the json value of an output column in a row, a cell not set is NULL.
+type jsonTableCell struct {
+	set bool
+	val bytejson.ByteJson
+	col *jsonTableColumn
+}
+
+func jsonTablePrepare(proc *process.Process, tableFunction *TableFunction) (tvfState, error) {
+	var param plan2.JsonTableParam
+	if err := json.Unmarshal(tableFunction.Params, &param); err != nil {
+		return nil, err
+	}
+	pos := make(map[string]int, len(tableFunction.Attrs))
+	for i, attr := range tableFunction.Attrs {
+		pos[strings.ToLower(attr)] = i
+	}
+	root, err := newJsonTableNode(&param, pos)
+	if err != nil {
+		return nil, err
+	}
+
+	st := &jsonTableState{root: root, loc: time.Local}
+	if info := proc.GetSessionInfo(); info != nil && info.TimeZone != nil {
+		st.loc = info.TimeZone
+	}
+	tableFunction.ctr.executorsForArgs, err = colexec.NewExpressionExecutorsFromPlanExpressions(proc, tableFunction.Args)
+	tableFunction.ctr.argVecs = make([]*vector.Vector, len(tableFunction.Args))
+	return st, err
+}
+
+func newJsonTableNode(param *plan2.JsonTableParam, pos map[string]int) (*jsonTableNode, error) {
+	node := &jsonTableNode{}
+	var err error
+	if node.path, err = types.ParseStringToPath(param.Path); err != nil {
+		return nil, err
+	}
+	for _, c := range param.Columns {
+		if c.Kind == tree.JSON_TABLE_COLUMN_NESTED {
+			nested, err := newJsonTableNode(c.Nested, pos)
+			if err != nil {
+				return nil, err
+			}
+			node.nested = append(node.nested, nested)
+			continue
+		}
+
+		col := &jsonTableColumn{kind: c.Kind, name: c.Name, pos: -1}
+		if p, ok := pos[c.Name]; ok {
+			col.pos = p
+		}
+		if c.Kind != tree.JSON_TABLE_COLUMN_ORDINALITY {
+			if col.path, err = types.ParseStringToPath(c.Path); err != nil {
+				return nil, err
+			}
+		}
+		if col.onEmpty, err = newJsonTableResponse(c.OnEmpty); err != nil {
+			return nil, err
+		}
+		if col.onError, err = newJsonTableResponse(c.OnError); err != nil {
+			return nil, err
+		}
+		node.cols = append(node.cols, col)
+	}
+	return node, nil
+}
+
+func newJsonTableResponse(resp *tree.JsonTableResponse) (jsonTableResponse, error) {
+	if resp == nil {
+		return jsonTableResponse{kind: tree.JSON_TABLE_RESPONSE_NULL}, nil
+	}
+	r := jsonTableResponse{kind: resp.Kind}
+	if resp.Kind == tree.JSON_TABLE_RESPONSE_DEFAULT {
+		var err error
+		if r.val, err = types.ParseStringToByteJson(resp.Default); err != nil {
+			return r, err
+		}
+	}
+	return r, nil
+}
+
+func (s *jsonTableState) start(tf *TableFunction, proc *process.Process, nthRow int) error {
+	s.startPreamble(tf, proc, nthRow)
+
+	docVec := tf.ctr.argVecs[0]
+	if docVec.IsNull(uint64(nthRow)) {
+		return nil
+	}
+	var doc bytejson.ByteJson
+	var err error
+	if docVec.GetType().Oid == types.T_json {
+		doc = types.DecodeJson(docVec.GetBytesAt(nthRow))
+	} else if doc, err = types.ParseSliceToByteJson(docVec.GetBytesAt(nthRow)); err != nil {
+		return err
+	}
+
+	if s.rows, err = s.root.appendRows(proc.Ctx, s.rows[:0], doc, len(tf.Attrs)); err != nil {
+		return err
+	}
+	for _, row := range s.rows {
+		for i, vec := range s.batch.Vecs {
+			if err = s.appendCell(proc, vec, row[i]); err != nil {
+				return err
+			}
+		}
+	}
+	s.batch.SetRowCount(len(s.rows))
+	return nil
+}
+
+// appendRows appends the rows of the node for each value its path matches in cur.
+func (n *jsonTableNode) appendRows(ctx context.Context, rows [][]jsonTableCell, cur bytejson.ByteJson, width int) ([][]jsonTableCell, error) {
+	for i, v := range cur.Match(&n.path) {
+		row := make([]jsonTableCell, width)
+		for _, col := range n.cols {
+			if col.pos < 0 {
+				continue
+			}
+			cell, err := col.eval(ctx, v, i+1)
+			if err != nil {
+				return nil, err
+			}
+			row[col.pos] = cell
+		}
+
+		// the sibling nested paths are not joined with each other, each of their rows
+		// comes with the columns of this row, and this row is kept alone if none of
+		// them has a row.
+		joined := false
+		for _, nested := range n.nested {
+			from := len(rows)
+			var err error
+			if rows, err = nested.appendRows(ctx, rows, v, width); err != nil {
+				return nil, err
+			}
+			for _, nestedRow := range rows[from:] {
+				for j := range nestedRow {
+					if row[j].col != nil {
+						nestedRow[j] = row[j]
+					}
+				}
+			}
+			joined = joined || len(rows) > from
+		}
+		if !joined {
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+func (c *jsonTableColumn) eval(ctx context.Context, v bytejson.ByteJson, ordinality int) (jsonTableCell, error) {
+	var val bytejson.ByteJson
+	var err error
+	switch c.kind {
+	case tree.JSON_TABLE_COLUMN_ORDINALITY:
+		val, err = bytejson.CreateByteJSON(int64(ordinality))
+	case tree.JSON_TABLE_COLUMN_EXISTS:
+		exists := int64(0)
+		if len(v.Match(&c.path)) > 0 {
+			exists = 1
+		}
+		val, err = bytejson.CreateByteJSON(exists)
+	default:
+		matches := v.Match(&c.path)
+		switch len(matches) {
+		case 0:
+			return c.respond(ctx, c.onEmpty, "missing value for JSON_TABLE column '%s'")
+		case 1:
+			val = matches[0]
+		default:
+			return c.respond(ctx, c.onError, "more than one value for JSON_TABLE column '%s'")
+		}
+	}
+	return jsonTableCell{set: true, val: val, col: c}, err
+}
+
+// respond returns the cell of ON EMPTY or ON ERROR.
+func (c *jsonTableColumn) respond(ctx context.Context, resp jsonTableResponse, format string) (jsonTableCell, error) {
+	switch resp.kind {
+	case tree.JSON_TABLE_RESPONSE_ERROR:
+		return jsonTableCell{}, moerr.NewInvalidInputf(ctx, format, c.name)
+	case tree.JSON_TABLE_RESPONSE_DEFAULT:
+		return jsonTableCell{set: true, val: resp.val, col: c}, nil
+	}
+	return jsonTableCell{col: c}, nil
+}
+
+// appendCell appends the value of the cell as the type of the column,
+// ON ERROR of the column decides what to append if the value can not be converted.
+func (s *jsonTableState) appendCell(proc *process.Process, vec *vector.Vector, cell jsonTableCell) error {
+	mp := proc.Mp()
+	if !cell.set {
+		return vector.AppendAny(vec, nil, true, mp)
+	}
+	val, isNull, err := jsonToValue(proc.Ctx, cell.val, vec.GetType(), s.loc)
+	if err != nil {
+		switch cell.col.onError.kind {
+		case tree.JSON_TABLE_RESPONSE_ERROR:
+			return err
+		case tree.JSON_TABLE_RESPONSE_DEFAULT:
+			if val, isNull, err = jsonToValue(proc.Ctx, cell.col.onError.val, vec.GetType(), s.loc); err != nil {
+				return err
+			}
+		default:
+			isNull = true
+		}
+	}
+	return vector.AppendAny(vec, val, isNull, mp)
+}
+
+// jsonToValue converts a json value to the value of typ for vector.AppendAny,
+// a json null is NULL unless typ is json.
+func jsonToValue(ctx context.Context, bj bytejson.ByteJson, typ *types.Type, loc *time.Location) (any, bool, error) {
+	if typ.Oid == types.T_json {
+		data, err := bj.Marshal()
+		return data, false, err
+	}
+	if bj.IsNull() {
+		return nil, true, nil
+	}
+	if bj.Type == bytejson.TpCodeObject || bj.Type == bytejson.TpCodeArray {
+		return nil, false, moerr.NewInvalidInputf(ctx, "can't store an array or an object in the scalar column of %s", typ.String())
+	}
+	text := bj.String()
+	if bj.Type == bytejson.TpCodeString {
+		text = string(bj.GetString())
+	}
+
+	var val any
+	var err error
+	switch typ.Oid {
+	case types.T_bool:
+		if bj.Type == bytejson.TpCodeLiteral {
+			val = bj.Data[0] == bytejson.LiteralTrue
+		} else {
+			val, err = types.ParseBool(text)
+		}
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64:
+		var v int64
+		if v, err = jsonToInt64(ctx, bj, text, typ); err == nil {
+			switch typ.Oid {
+			case types.T_int8:
+				val = int8(v)
+			case types.T_int16:
+				val = int16(v)
+			case types.T_int32:
+				val = int32(v)
+			default:
+				val = v
+			}
+		}
+	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+		var v uint64
+		if v, err = jsonToUint64(ctx, bj, text, typ); err == nil {
+			switch typ.Oid {
+			case types.T_uint8:
+				val = uint8(v)
+			case types.T_uint16:
+				val = uint16(v)
+			case types.T_uint32:
+				val = uint32(v)
+			default:
+				val = v
+			}
+		}
+	case types.T_float32, types.T_float64:
+		var v float64
+		if v, err = jsonToFloat64(bj, text); err == nil {
+			if typ.Oid == types.T_float32 {
+				val = float32(v)
+			} else {
+				val = v
+			}
+		}
+	case types.T_decimal64:
+		val, err = types.ParseDecimal64(text, typ.Width, typ.Scale)
+	case types.T_decimal128:
+		val, err = types.ParseDecimal128(text, typ.Width, typ.Scale)
+	case types.T_char, types.T_varchar, types.T_text:
+		if typ.Oid != types.T_text && utf8.RuneCountInString(text) > int(typ.Width) {
+			err = moerr.NewDataTruncatedf(ctx, typ.String(), "value '%s' is too long", text)
+		}
+		val = []byte(text)
+	case types.T_date:
+		val, err = types.ParseDateCast(text)
+	case types.T_time:
+		val, err = types.ParseTime(text, typ.Scale)
+	case types.T_datetime:
+		val, err = types.ParseDatetime(text, typ.Scale)
+	case types.T_timestamp:
+		val, err = types.ParseTimestamp(loc, text, typ.Scale)
+	default:
+		err = moerr.NewNotSupportedf(ctx, "json_table: column of type %s", typ.String())
+	}
+	return val, false, err
+}
+
+func jsonToFloat64(bj bytejson.ByteJson, text string) (float64, error) {
+	switch bj.Type {
+	case bytejson.TpCodeInt64:
+		return float64(bj.GetInt64()), nil
+	case bytejson.TpCodeUint64:
+		return float64(bj.GetUint64()), nil
+	case bytejson.TpCodeFloat64:
+		return bj.GetFloat64(), nil
+	case bytejson.TpCodeLiteral:
+		if bj.Data[0] == bytejson.LiteralTrue {
+			return 1, nil
+		}
+		return 0, nil
+	}
+	return strconv.ParseFloat(strings.TrimSpace(text), 64)
+}
+
+func jsonToInt64(ctx context.Context, bj bytejson.ByteJson, text string, typ *types.Type) (int64, error) {
+	var v int64
+	switch {
+	case bj.Type == bytejson.TpCodeInt64:
+		v = bj.GetInt64()
+	default:
+		i, err := strconv.ParseInt(strings.TrimSpace(text), 10, 64)
+		if err != nil {
+			f, err := jsonToFloat64(bj, text)
+			if err != nil {
+				return 0, err
+			}
+			f = math.Round(f)
+			if f < math.MinInt64 || f >= math.MaxInt64 {
+				return 0, moerr.NewOutOfRangef(ctx, typ.String(), "value '%s'", text)
+			}
+			i = int64(f)
+		}
+		v = i
+	}
+	if bits := typ.Size * 8; bits < 64 && (v < -1<<(bits-1) || v > 1<<(bits-1)-1) {
+		return 0, moerr.NewOutOfRangef(ctx, typ.String(), "value '%s'", text)
+	}
+	return v, nil
+}
+
+func jsonToUint64(ctx context.Context, bj bytejson.ByteJson, text string, typ *types.Type) (uint64, error) {
+	var v uint64
+	switch {
+	case bj.Type == bytejson.TpCodeUint64:
+		v = bj.GetUint64()
+	case bj.Type == bytejson.TpCodeInt64 && bj.GetInt64() < 0:
+		return 0, moerr.NewOutOfRangef(ctx, typ.String(), "value '%s'", text)
+	default:
+		u, err := strconv.ParseUint(strings.TrimSpace(text), 10, 64)
+		if err != nil {
+			f, err := jsonToFloat64(bj, text)
+			if err != nil {
+				return 0, err
+			}
+			f = math.Round(f)
+			if f < 0 || f >= math.MaxUint64 {
+				return 0, moerr.NewOutOfRangef(ctx, typ.String(), "value '%s'", text)
+			}
+			u = uint64(f)
+		}
+		v = u
+	}
+	if bits := typ.Size * 8; bits < 64 && v > 1<<bits-1 {
+		return 0, moerr.NewOutOfRangef(ctx, typ.String(), "value '%s'", text)
+	}
+	return v, nil
+}
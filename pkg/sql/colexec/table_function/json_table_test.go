@@ -0,0 +1,161 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table_function
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func newJsonTableTestFunction(t *testing.T, param *plan2.JsonTableParam, attrs []string, typs []types.Type) *TableFunction {
+	data, err := json.Marshal(param)
+	require.NoError(t, err)
+	tf := &TableFunction{Attrs: attrs, Params: data}
+	tf.ctr.retSchema = typs
+	return tf
+}
+
+func TestJsonTable(t *testing.T) {
+	proc := testutil.NewProc()
+	param := &plan2.JsonTableParam{
+		Path: "$[*]",
+		Columns: []*plan2.JsonTableColumn{
+			{Kind: tree.JSON_TABLE_COLUMN_ORDINALITY, Name: "ord"},
+			{Kind: tree.JSON_TABLE_COLUMN_PATH, Name: "id", Path: "$.id",
+				OnEmpty: &tree.JsonTableResponse{Kind: tree.JSON_TABLE_RESPONSE_DEFAULT, Default: "-1"}},
+			{Kind: tree.JSON_TABLE_COLUMN_PATH, Name: "name", Path: "$.name"},
+			{Kind: tree.JSON_TABLE_COLUMN_EXISTS, Name: "has_p", Path: "$.p"},
+			{Kind: tree.JSON_TABLE_COLUMN_NESTED, Nested: &plan2.JsonTableParam{
+				Path:    "$.tags[*]",
+				Columns: []*plan2.JsonTableColumn{{Kind: tree.JSON_TABLE_COLUMN_PATH, Name: "tag", Path: "$"}},
+			}},
+			{Kind: tree.JSON_TABLE_COLUMN_NESTED, Nested: &plan2.JsonTableParam{
+				Path:    "$.p",
+				Columns: []*plan2.JsonTableColumn{{Kind: tree.JSON_TABLE_COLUMN_PATH, Name: "q", Path: "$.q"}},
+			}},
+		},
+	}
+	varchar1 := types.T_varchar.ToType()
+	varchar1.Width = 1
+	tf := newJsonTableTestFunction(t, param,
+		[]string{"ord", "id", "name", "has_p", "tag", "q"},
+		[]types.Type{
+			types.T_int64.ToType(),
+			types.T_int32.ToType(),
+			varchar1,
+			types.T_int8.ToType(),
+			types.T_varchar.ToType(),
+			types.T_json.ToType(),
+		})
+	st, err := jsonTablePrepare(proc, tf)
+	require.NoError(t, err)
+	s := st.(*jsonTableState)
+	defer s.free(tf, proc, false, nil)
+
+	docs := vector.NewVec(types.T_varchar.ToType())
+	require.NoError(t, vector.AppendStringList(docs, []string{
+		`[{"id": 1, "name": "a", "tags": ["x", "y"], "p": {"q": 5}}, {"id": "bad", "name": "bb", "tags": []}, {"name": null}]`,
+		``,
+	}, []bool{false, true}, proc.Mp()))
+	tf.ctr.argVecs = []*vector.Vector{docs}
+
+	require.NoError(t, s.start(tf, proc, 0))
+	bat := s.batch
+	require.Equal(t, 5, bat.RowCount())
+	require.Equal(t, []int64{1, 1, 1, 2, 3}, vector.MustFixedColNoTypeCheck[int64](bat.Vecs[0]))
+
+	ids := vector.MustFixedColNoTypeCheck[int32](bat.Vecs[1])
+	require.Equal(t, []int32{1, 1, 1}, ids[:3])
+	// "bad" can not be converted, it is NULL ON ERROR by default
+	require.True(t, bat.Vecs[1].IsNull(3))
+	require.Equal(t, int32(-1), ids[4])
+
+	names := vector.InefficientMustStrCol(bat.Vecs[2])
+	require.Equal(t, []string{"a", "a", "a"}, names[:3])
+	// too long for varchar(1), and json null
+	require.True(t, bat.Vecs[2].IsNull(3))
+	require.True(t, bat.Vecs[2].IsNull(4))
+
+	require.Equal(t, []int8{1, 1, 1, 0, 0}, vector.MustFixedColNoTypeCheck[int8](bat.Vecs[3]))
+
+	tags := vector.InefficientMustStrCol(bat.Vecs[4])
+	require.Equal(t, []string{"x", "y"}, tags[:2])
+	for _, i := range []uint64{2, 3, 4} {
+		require.True(t, bat.Vecs[4].IsNull(i))
+	}
+
+	for _, i := range []uint64{0, 1, 3, 4} {
+		require.True(t, bat.Vecs[5].IsNull(i))
+	}
+	require.Equal(t, "5", types.DecodeJson(bat.Vecs[5].GetBytesAt(2)).String())
+
+	// the document is NULL
+	require.NoError(t, s.start(tf, proc, 1))
+	require.Equal(t, 0, s.batch.RowCount())
+}
+
+func TestJsonTableOnError(t *testing.T) {
+	proc := testutil.NewProc()
+	param := &plan2.JsonTableParam{
+		Path: "$",
+		Columns: []*plan2.JsonTableColumn{
+			{Kind: tree.JSON_TABLE_COLUMN_PATH, Name: "a", Path: "$.a[*]",
+				OnError: &tree.JsonTableResponse{Kind: tree.JSON_TABLE_RESPONSE_DEFAULT, Default: `"many"`}},
+			{Kind: tree.JSON_TABLE_COLUMN_PATH, Name: "b", Path: "$.b",
+				OnEmpty: &tree.JsonTableResponse{Kind: tree.JSON_TABLE_RESPONSE_ERROR}},
+			{Kind: tree.JSON_TABLE_COLUMN_PATH, Name: "c", Path: "$.c",
+				OnError: &tree.JsonTableResponse{Kind: tree.JSON_TABLE_RESPONSE_ERROR}},
+		},
+	}
+
+	docs := vector.NewVec(types.T_json.ToType())
+	for _, doc := range []string{`{"a": [1, 2], "b": 1, "c": 2}`, `{"a": [1]}`, `{"b": 1, "c": [1]}`} {
+		bj, err := types.ParseStringToByteJson(doc)
+		require.NoError(t, err)
+		require.NoError(t, vector.AppendByteJson(docs, bj, false, proc.Mp()))
+	}
+
+	// only the required columns are evaluated
+	tf := newJsonTableTestFunction(t, param, []string{"a"}, []types.Type{types.T_varchar.ToType()})
+	st, err := jsonTablePrepare(proc, tf)
+	require.NoError(t, err)
+	s := st.(*jsonTableState)
+	defer s.free(tf, proc, false, nil)
+	tf.ctr.argVecs = []*vector.Vector{docs}
+	require.NoError(t, s.start(tf, proc, 0))
+	require.Equal(t, []string{"many"}, vector.InefficientMustStrCol(s.batch.Vecs[0]))
+	require.NoError(t, s.start(tf, proc, 1))
+	require.Equal(t, []string{"1"}, vector.InefficientMustStrCol(s.batch.Vecs[0]))
+
+	tf2 := newJsonTableTestFunction(t, param, []string{"b", "c"}, []types.Type{types.T_int64.ToType(), types.T_int64.ToType()})
+	st, err = jsonTablePrepare(proc, tf2)
+	require.NoError(t, err)
+	s2 := st.(*jsonTableState)
+	defer s2.free(tf2, proc, false, nil)
+	tf2.ctr.argVecs = []*vector.Vector{docs}
+	require.NoError(t, s2.start(tf2, proc, 0))
+	require.Equal(t, []int64{1}, vector.MustFixedColNoTypeCheck[int64](s2.batch.Vecs[0]))
+	// missing b is ERROR ON EMPTY
+	require.Error(t, s2.start(tf2, proc, 1))
+	// an array can not be stored in an int column
+	require.Error(t, s2.start(tf2, proc, 2))
+}
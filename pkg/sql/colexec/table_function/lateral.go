@@ -0,0 +1,57 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table_function
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// lateralState runs the plan of a LATERAL derived table for a row of the
+// correlated columns, and returns its result as one batch.
+type lateralState struct {
+	simpleOneBatchState
+}
+
+func lateralPrepare(proc *process.Process, tf *TableFunction) (tvfState, error) {
+	if tf.RunLateral == nil {
+		return nil, moerr.NewInternalError(proc.Ctx, "lateral table function without the runner of its plan")
+	}
+
+	var err error
+	tf.ctr.executorsForArgs, err = colexec.NewExpressionExecutorsFromPlanExpressions(proc, tf.Args)
+	tf.ctr.argVecs = make([]*vector.Vector, len(tf.Args))
+	return &lateralState{}, err
+}
+
+func (s *lateralState) start(tf *TableFunction, proc *process.Process, nthRow int) error {
+	s.startPreamble(tf, proc, nthRow)
+
+	return tf.RunLateral(proc, tf.ctr.argVecs, nthRow, func(bat *batch.Batch) error {
+		if bat == nil || bat.RowCount() == 0 {
+			return nil
+		}
+		for i, vec := range s.batch.Vecs {
+			if err := vec.UnionBatch(bat.Vecs[i], 0, bat.RowCount(), nil, proc.Mp()); err != nil {
+				return err
+			}
+		}
+		s.batch.AddRowCount(bat.RowCount())
+		return nil
+	})
+}
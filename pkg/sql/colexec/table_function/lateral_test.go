@@ -0,0 +1,77 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table_function
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLateral(t *testing.T) {
+	proc := testutil.NewProc()
+	int64Typ := types.T_int64.ToType()
+
+	tf := &TableFunction{
+		FuncName: "lateral",
+		Attrs:    []string{"x"},
+		Rets: []*plan.ColDef{
+			{Name: "x", Typ: plan.Type{Id: int32(types.T_int64)}},
+		},
+	}
+	require.Error(t, tf.ApplyPrepare(proc))
+
+	// the plan returns the rows 0, 1, ..., n-1 in two batches for the row n
+	tf.RunLateral = func(proc *process.Process, args []*vector.Vector, row int, fill func(*batch.Batch) error) error {
+		for i := 0; i < row; i += 2 {
+			bat := batch.NewWithSize(1)
+			bat.Vecs[0] = vector.NewVec(int64Typ)
+			for j := i; j < row && j < i+2; j++ {
+				require.NoError(t, vector.AppendFixed(bat.Vecs[0], int64(j), false, proc.Mp()))
+			}
+			bat.SetRowCount(bat.Vecs[0].Length())
+			if err := fill(bat); err != nil {
+				return err
+			}
+			bat.Clean(proc.Mp())
+		}
+		return nil
+	}
+	require.NoError(t, tf.ApplyPrepare(proc))
+
+	for _, n := range []int{3, 0, 4} {
+		require.NoError(t, tf.ApplyStart(n, proc))
+		res, err := tf.ApplyCall(proc)
+		require.NoError(t, err)
+		if n == 0 {
+			require.True(t, res.Batch.IsDone())
+			continue
+		}
+		require.Equal(t, n, res.Batch.RowCount())
+		require.Equal(t, []int64{0, 1, 2, 3}[:n], vector.MustFixedColWithTypeCheck[int64](res.Batch.Vecs[0]))
+		res, err = tf.ApplyCall(proc)
+		require.NoError(t, err)
+		require.True(t, res.Batch.IsDone())
+	}
+
+	tf.ctr.state.free(tf, proc, false, nil)
+	require.Equal(t, int64(0), proc.Mp().CurrNB())
+}
@@ -109,6 +109,11 @@ func (tableFunction *TableFunction) Prepare(proc *process.Process) error {
 		tableFunction.OpAnalyzer.Reset()
 	}
 
+	return tableFunction.prepareState(proc)
+}
+
+// prepareState builds the result schema and the state of the table function.
+func (tableFunction *TableFunction) prepareState(proc *process.Process) error {
 	var err error
 	tblArg := tableFunction
 
@@ -142,6 +147,14 @@ func (tableFunction *TableFunction) Prepare(proc *process.Process) error {
 		tblArg.ctr.state, err = moCachePrepare(proc, tblArg)
 	case "stage_list":
 		tblArg.ctr.state, err = stageListPrepare(proc, tblArg)
+	case "fulltext_index_tokenize":
+		tblArg.ctr.state, err = fulltextIndexTokenizePrepare(proc, tblArg)
+	case "fulltext_index_scan":
+		tblArg.ctr.state, err = fulltextIndexScanPrepare(proc, tblArg)
+	case "json_table":
+		tblArg.ctr.state, err = jsonTablePrepare(proc, tblArg)
+	case "lateral":
+		tblArg.ctr.state, err = lateralPrepare(proc, tblArg)
 	default:
 		tblArg.ctr.state = nil
 		err = moerr.NewNotSupported(proc.Ctx, fmt.Sprintf("table function %s is not supported", tblArg.FuncName))
@@ -158,3 +171,56 @@ func (tableFunction *TableFunction) createResultBatch() *batch.Batch {
 	}
 	return bat
 }
+
+// ApplyPrepare prepares the table function driven by the apply operator,
+// which evaluates the table function for each row of its left input.
+func (tableFunction *TableFunction) ApplyPrepare(proc *process.Process) error {
+	return tableFunction.prepareState(proc)
+}
+
+// ApplyRetType returns the type of the ith result column of the table function.
+func (tableFunction *TableFunction) ApplyRetType(i int32) types.Type {
+	return tableFunction.ctr.retSchema[i]
+}
+
+// ApplyArgsEval evaluates the arguments of the table function on a batch of the left input.
+func (tableFunction *TableFunction) ApplyArgsEval(inbat *batch.Batch, proc *process.Process) error {
+	var err error
+	tableFunction.ctr.inputBatch = inbat
+	for i := range tableFunction.ctr.executorsForArgs {
+		tableFunction.ctr.argVecs[i], err = tableFunction.ctr.executorsForArgs[i].Eval(proc, []*batch.Batch{inbat}, nil)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ApplyStart starts the table function for the nth row of the evaluated batch.
+func (tableFunction *TableFunction) ApplyStart(nthRow int, proc *process.Process) error {
+	tableFunction.ctr.nextRow = nthRow
+	return tableFunction.ctr.state.start(tableFunction, proc, nthRow)
+}
+
+// ApplyCall returns the next result batch of the current row, the batch is done
+// when all the results of the row have been returned.
+func (tableFunction *TableFunction) ApplyCall(proc *process.Process) (vm.CallResult, error) {
+	return tableFunction.ctr.state.call(tableFunction, proc)
+}
+
+// ApplyReset resets the table function driven by the apply operator.
+func (tableFunction *TableFunction) ApplyReset(proc *process.Process, pipelineFailed bool, err error) {
+	if tableFunction.ctr.state == nil {
+		return
+	}
+	tableFunction.Reset(proc, pipelineFailed, err)
+}
+
+// ApplyFree frees the table function driven by the apply operator.
+func (tableFunction *TableFunction) ApplyFree(proc *process.Process, pipelineFailed bool, err error) {
+	if tableFunction.ctr.state == nil {
+		tableFunction.ctr.cleanExecutors()
+		return
+	}
+	tableFunction.Free(proc, pipelineFailed, err)
+}
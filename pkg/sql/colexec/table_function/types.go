@@ -36,6 +36,11 @@ type TableFunction struct {
 	Params   []byte
 	FuncName string
 
+	// RunLateral runs the plan of a LATERAL derived table with the values of
+	// the correlated columns at row of args, it's set by the compile for the
+	// lateral table function.
+	RunLateral func(proc *process.Process, args []*vector.Vector, row int, fill func(*batch.Batch) error) error
+
 	vm.OperatorBase
 }
 
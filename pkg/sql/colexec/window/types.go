@@ -36,6 +36,11 @@ const (
 	receiveAll
 )
 
+const (
+	nameLag  = "lag"
+	nameLead = "lead"
+)
+
 type container struct {
 	status int
 
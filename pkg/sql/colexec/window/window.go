@@ -210,7 +210,8 @@ func (ctr *container) resetResultBatch(bat *batch.Batch, vec *vector.Vector) *ba
 func (ctr *container) processFunc(idx int, ap *Window, proc *process.Process, analyzer process.Analyzer) error {
 	var err error
 	n := ctr.bat.Vecs[0].Length()
-	isWinOrder := function.GetFunctionIsWinOrderFunByName(ap.WinSpecList[idx].Expr.(*plan.Expr_W).W.Name)
+	name := ap.WinSpecList[idx].Expr.(*plan.Expr_W).W.Name
+	isWinOrder := function.GetFunctionIsWinOrderFunByName(name)
 	if isWinOrder {
 		if ctr.ps == nil {
 			ctr.ps = append(ctr.ps, 0)
@@ -229,6 +230,8 @@ func (ctr *container) processFunc(idx int, ap *Window, proc *process.Process, an
 		if err = vector.AppendFixedList(vec, ctr.os, nil, proc.Mp()); err != nil {
 			return err
 		}
+		// the arguments are behind the peer group vector, e.g. the bucket number of ntile().
+		vecs := append([]*vector.Vector{vec}, ctr.aggVecs[idx].Vec...)
 
 		o := 0
 		for p := 1; p < len(ctr.ps); p++ {
@@ -236,7 +239,7 @@ func (ctr *container) processFunc(idx int, ap *Window, proc *process.Process, an
 
 				if ctr.os[o] <= ctr.ps[p] {
 
-					if err = ctr.bat.Aggs[idx].Fill(p-1, o, []*vector.Vector{vec}); err != nil {
+					if err = ctr.bat.Aggs[idx].Fill(p-1, o, vecs); err != nil {
 						return err
 					}
 
@@ -247,6 +250,10 @@ func (ctr *container) processFunc(idx int, ap *Window, proc *process.Process, an
 
 			}
 		}
+	} else if name == nameLag || name == nameLead {
+		if err = ctr.processOffsetFunc(idx, n, name); err != nil {
+			return err
+		}
 	} else {
 		//nullVec := vector.NewConstNull(*ctr.aggVecs[idx].Vec[0].GetType(), 1, proc.Mp())
 		//defer nullVec.Free(proc.Mp())
@@ -308,6 +315,36 @@ func (ctr *container) processFunc(idx int, ap *Window, proc *process.Process, an
 	return nil
 }
 
+// processOffsetFunc evaluates lag() and lead(), which take the value of the row at
+// the offset before or after the current row, or the default value if the row is out of the partition.
+func (ctr *container) processOffsetFunc(idx int, n int, name string) error {
+	vecs := ctr.aggVecs[idx].Vec
+	offset, err := aggexec.GetWindowOffset(vecs, name)
+	if err != nil {
+		return err
+	}
+	if name == nameLag {
+		offset = -offset
+	}
+
+	for j := 0; j < n; j++ {
+		start, end := 0, n
+		if ctr.ps != nil {
+			start, end = buildPartitionInterval(ctr.ps, j, n)
+		}
+
+		if k := j + int(offset); k >= start && k < end {
+			err = ctr.bat.Aggs[idx].Fill(j, k, vecs[:1])
+		} else if len(vecs) > 2 {
+			err = ctr.bat.Aggs[idx].Fill(j, j, vecs[2:3])
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (ctr *container) buildInterval(rowIdx, start, end int, frame *plan.FrameClause) (int, int, error) {
 	// FrameClause_ROWS
 	if frame.Type == plan.FrameClause_ROWS {
@@ -571,8 +608,8 @@ func (ctr *container) processOrder(idx int, ap *Window, bat *batch.Batch, proc *
 
 	// shuffle agg vector
 	for k := idx; k < len(ctr.aggVecs); k++ {
-		if len(ctr.aggVecs[k].Vec) > 0 {
-			if err := ctr.aggVecs[k].Vec[0].Shuffle(ctr.sels, proc.Mp()); err != nil {
+		for _, vec := range ctr.aggVecs[k].Vec {
+			if err := vec.Shuffle(ctr.sels, proc.Mp()); err != nil {
 				panic(err)
 			}
 		}
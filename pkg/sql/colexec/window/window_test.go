@@ -29,7 +29,9 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm"
 
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/group"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
@@ -156,3 +158,51 @@ func newFunExpr() *plan.Expr {
 		},
 	}
 }
+
+func TestProcessOffsetFunc(t *testing.T) {
+	proc := testutil.NewProcessWithMPool("", mpool.MustNewZero())
+	typ := types.T_int64.ToType()
+
+	input := vector.NewVec(typ)
+	require.NoError(t, vector.AppendFixedList(input, []int64{1, 2, 3, 4}, []bool{false, true, false, false}, proc.Mp()))
+	offset, err := vector.NewConstFixed(typ, int64(1), 4, proc.Mp())
+	require.NoError(t, err)
+	def, err := vector.NewConstFixed(typ, int64(-1), 4, proc.Mp())
+	require.NoError(t, err)
+
+	// lag(a, 1, -1) and lead(a) of 4 rows in 2 partitions, [0, 2) and [2, 4).
+	for name, expected := range map[string][]any{
+		nameLag:  {int64(-1), int64(1), int64(-1), int64(3)},
+		nameLead: {nil, nil, int64(4), nil},
+	} {
+		args := []*vector.Vector{input}
+		if name == nameLag {
+			args = append(args, offset, def)
+		}
+		e, err := function.GetFunctionByName(context.Background(), name, []types.Type{typ})
+		require.NoError(t, err)
+
+		ctr := &container{ps: []int64{0, 2}}
+		ctr.aggVecs = []group.ExprEvalVector{{Vec: args}}
+		ctr.bat = batch.NewWithSize(1)
+		ctr.bat.Aggs = []aggexec.AggFuncExec{aggexec.MakeAgg(proc, e.GetEncodedOverloadID(), false, typ)}
+		require.NoError(t, ctr.bat.Aggs[0].GroupGrow(4))
+		require.NoError(t, ctr.processOffsetFunc(0, 4, name))
+
+		result, err := ctr.bat.Aggs[0].Flush()
+		require.NoError(t, err)
+		for i := range expected {
+			if expected[i] == nil {
+				require.True(t, result.IsNull(uint64(i)))
+			} else {
+				require.Equal(t, expected[i], vector.GetFixedAtWithTypeCheck[int64](result, i))
+			}
+		}
+		result.Free(proc.Mp())
+		ctr.bat.Aggs[0].Free()
+	}
+	input.Free(proc.Mp())
+	offset.Free(proc.Mp())
+	def.Free(proc.Mp())
+	require.Equal(t, int64(0), proc.Mp().CurrNB())
+}
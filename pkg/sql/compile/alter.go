@@ -127,6 +127,20 @@ func (s *Scope) AlterTableCopy(c *Compile) error {
 		}
 	}
 
+	// 5.1.1 delete the check constraints of the original table in mo_catalog.mo_check_constraints,
+	// the ones of the copy table were inserted when it was created
+	if qry.Database != catalog.MO_CATALOG && len(qry.GetTableDef().GetChecks()) > 0 {
+		deleteSql := fmt.Sprintf(deleteMoCheckConstraintsWithTableIdFormat, qry.GetTableDef().TblId)
+		if err = c.runSql(deleteSql); err != nil {
+			c.proc.Error(c.proc.Ctx, "delete all check constraints of origin table in `mo_check_constraints` for alter table",
+				zap.String("databaseName", c.db),
+				zap.String("origin tableName", qry.GetTableDef().Name),
+				zap.String("delete all check constraints sql", deleteSql),
+				zap.Error(err))
+			return err
+		}
+	}
+
 	// 5.2 delete all index table of the original table
 	if qry.TableDef.Indexes != nil {
 		for _, indexdef := range qry.TableDef.Indexes {
@@ -143,6 +157,30 @@ func (s *Scope) AlterTableCopy(c *Compile) error {
 		}
 	}
 
+	// 5.3 delete all partition tables and partition objects of the original table
+	if partition := qry.TableDef.Partition; partition != nil {
+		for _, name := range partition.PartitionTableNames {
+			if err = dbSource.Delete(c.proc.Ctx, name); err != nil {
+				c.proc.Error(c.proc.Ctx, "delete all partition table of origin table for alter table",
+					zap.String("databaseName", c.db),
+					zap.String("origin tableName", qry.GetTableDef().Name),
+					zap.String("origin tableName partition table", name),
+					zap.Error(err))
+				return err
+			}
+		}
+
+		deleteSql := fmt.Sprintf(deleteMoTablePartitionsWithTableIdFormat, qry.GetTableDef().TblId)
+		if err = c.runSql(deleteSql); err != nil {
+			c.proc.Error(c.proc.Ctx, "delete all partition meta data of origin table in `mo_table_partitions` for alter table",
+				zap.String("databaseName", c.db),
+				zap.String("origin tableName", qry.GetTableDef().Name),
+				zap.String("delete all partition sql", deleteSql),
+				zap.Error(err))
+			return err
+		}
+	}
+
 	//6. obtain relation for new tables
 	newRel, err := dbSource.Relation(c.proc.Ctx, qry.CopyTableDef.Name, nil)
 	if err != nil {
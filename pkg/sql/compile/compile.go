@@ -48,6 +48,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/apply"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/connector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/deletion"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/dispatch"
@@ -204,6 +205,7 @@ func (c *Compile) clear() {
 		c.fuzzys[i].release()
 	}
 
+	c.triggerRunner = nil
 	c.MessageBoard = c.MessageBoard.Reset()
 	c.fuzzys = c.fuzzys[:0]
 	c.scopes = c.scopes[:0]
@@ -853,6 +855,11 @@ func (c *Compile) compileSteps(qry *plan.Query, ss []*Scope, step int32) (*Scope
 	if qry.Nodes[step].NodeType == plan.Node_SINK {
 		return ss[0], nil
 	}
+	for _, trigger := range qry.RowTriggers {
+		if trigger.NodeId == step {
+			return c.compileTriggerRowsStep(ss, trigger)
+		}
+	}
 
 	switch qry.StmtType {
 	case plan.Query_DELETE:
@@ -882,6 +889,40 @@ func (c *Compile) compileSteps(qry *plan.Query, ss []*Scope, step int32) (*Scope
 	}
 }
 
+// compileTriggerRowsStep passes the transition rows of the procedural
+// trigger to the trigger runner batch by batch, the body of the trigger is
+// executed for the rows before the next batch is produced.
+func (c *Compile) compileTriggerRowsStep(ss []*Scope, trigger *plan.RowTrigger) (*Scope, error) {
+	if c.triggerRunner == nil {
+		return nil, moerr.NewNotSupportedf(c.proc.Ctx, "procedural trigger %s fired by the statement in stored procedure or trigger", trigger.TriggerName)
+	}
+
+	var rs *Scope
+	if c.IsSingleScope(ss) {
+		rs = ss[0]
+	} else {
+		ss = c.mergeShuffleScopesIfNeeded(ss, false)
+		rs = c.newMergeScope(ss)
+	}
+	updateScopesLastFlag([]*Scope{rs})
+	c.setAnalyzeCurrent([]*Scope{rs}, c.anal.curNodeIdx)
+	rs.setRootOperator(
+		output.NewArgument().
+			WithFunc(func(bat *batch.Batch) error {
+				if bat == nil || bat.IsEmpty() {
+					return nil
+				}
+				return c.triggerRunner(c.proc.GetTopContext(), trigger, bat)
+			}),
+	)
+	return rs, nil
+}
+
+// SetTriggerRunner sets the runner of the procedural triggers of the query.
+func (c *Compile) SetTriggerRunner(runner TriggerRunner) {
+	c.triggerRunner = runner
+}
+
 func (c *Compile) compilePlanScope(step int32, curNodeIdx int32, ns []*plan.Node) ([]*Scope, error) {
 	start := time.Now()
 	defer func() {
@@ -1195,7 +1236,7 @@ func (c *Compile) compilePlanScope(step int32, curNodeIdx int32, ns []*plan.Node
 		if err != nil {
 			return nil, err
 		}
-		ss = c.compileProjection(n, ss)
+		ss = c.compileSort(n, c.compileProjection(n, ss))
 		return ss, nil
 	case plan.Node_FUNCTION_SCAN:
 		ss, err = c.compilePlanScope(step, n.Children[0], ns)
@@ -1232,15 +1273,15 @@ func (c *Compile) compilePlanScope(step int32, curNodeIdx int32, ns []*plan.Node
 
 		c.setAnalyzeCurrent(ss, int(curNodeIdx))
 		return c.compileSinkNode(n, ss, step)
-	/*case plan.Node_APPLY:
-	left, err = c.compilePlanScope(step, n.Children[0], ns)
-	if err != nil {
-		return nil, err
-	}
+	case plan.Node_APPLY:
+		left, err = c.compilePlanScope(step, n.Children[0], ns)
+		if err != nil {
+			return nil, err
+		}
 
-	c.setAnalyzeCurrent(left, int(curNodeIdx))
-	ss = c.compileSort(n, c.compileApply(n, ns[n.Children[1]], left))
-	return ss, nil*/
+		c.setAnalyzeCurrent(left, int(curNodeIdx))
+		ss = c.compileSort(n, c.compileApply(n, ns[n.Children[1]], left))
+		return ss, nil
 	default:
 		return nil, moerr.NewNYI(c.proc.Ctx, fmt.Sprintf("query '%s'", n))
 	}
@@ -1408,6 +1449,10 @@ func (c *Compile) compileExternScan(n *plan.Node) ([]*Scope, error) {
 		}
 		param.JsonData = n.ExternScan.JsonType
 	}
+	// the files of the binary formats can not be split by offsets.
+	if param.Format == tree.PARQUET || param.Format == tree.ORC || param.Format == tree.AVRO {
+		param.Parallel = false
+	}
 
 	err, strictSqlMode := StrictSqlMode(c.proc)
 	if err != nil {
@@ -2520,24 +2565,91 @@ func (c *Compile) compileBuildSideForBoradcastJoin(node *plan.Node, rs, buildSco
 	return rs
 }
 
-/*
-	func (c *Compile) compileApply(node, right *plan.Node, probeScopes []*Scope) []*Scope {
-		rs := c.mergeScopesByCN(probeScopes)
+// compileApply evaluates the table function of the right child for each row of the left child,
+// the apply operator runs on a merge scope of the current CN.
+func (c *Compile) compileApply(node, right *plan.Node, probeScopes []*Scope) []*Scope {
+	var applyType int
+	switch node.JoinType {
+	case plan.Node_INNER:
+		applyType = apply.CROSS
+	case plan.Node_OUTER:
+		applyType = apply.OUTER
+	default:
+		panic(moerr.NewNYIf(c.proc.Ctx, "apply type '%s'", node.JoinType))
+	}
 
-		switch node.JoinType {
-		case plan.Node_INNER:
-			for i := range rs {
-				op := constructApply(node, right, apply.CROSS, c.proc)
-				op.SetIdx(c.anal.curNodeIdx)
-				rs[i].setRootOperator(op)
+	currentFirstFlag := c.anal.isFirst
+	rs := c.newMergeScope(probeScopes)
+	op := constructApply(node, right, applyType, c.proc)
+	if op.FuncName == "lateral" {
+		op.RunLateral = c.lateralRunner(op.Params)
+	}
+	op.SetAnalyzeControl(c.anal.curNodeIdx, currentFirstFlag)
+	rs.setRootOperator(op)
+	c.anal.isFirst = false
+
+	return []*Scope{rs}
+}
+
+// lateralRunner returns the runner of the plan of a LATERAL derived table, it
+// binds the values of the correlated columns in the plan, then compiles and
+// runs it in the transaction of the statement.
+func (c *Compile) lateralRunner(param []byte) func(*process.Process, []*vector.Vector, int, func(*batch.Batch) error) error {
+	lateralQry := &plan.Query{}
+	if err := lateralQry.Unmarshal(param); err != nil {
+		panic(err)
+	}
+
+	addr, db, sql, tenant, uid := c.addr, c.db, c.sql, c.tenant, c.uid
+	e, isInternal, cnLabel, txnOffset := c.e, c.isInternal, c.cnLabel, c.TxnOffset
+	return func(proc *process.Process, args []*vector.Vector, row int, fill func(*batch.Batch) error) error {
+		vals := make([]*plan.Literal, len(args))
+		for i, vec := range args {
+			idx := uint64(row)
+			if vec.IsConst() {
+				idx = 0
+			}
+			if vals[i] = rule.GetConstantValue(vec, true, idx); vals[i] == nil {
+				return moerr.NewNotSupportedf(proc.Ctx, "correlated column of type %s in LATERAL derived table", vec.GetType().String())
 			}
-		default:
-			panic("unknown apply")
+		}
+		qry := plan2.DeepCopyQuery(lateralQry)
+		if err := plan2.BindLateralParams(qry, vals); err != nil {
+			return err
 		}
 
-		return rs
+		subProc := process.NewTopProcess(
+			proc.Ctx,
+			proc.Mp(),
+			proc.Base.TxnClient,
+			proc.GetTxnOperator(),
+			proc.Base.FileService,
+			proc.Base.LockService,
+			proc.Base.QueryClient,
+			proc.Base.Hakeeper,
+			proc.Base.UdfService,
+			proc.Base.Aicm,
+		)
+		subProc.Base.SessionInfo = proc.Base.SessionInfo
+		subProc.Base.WaitPolicy = proc.Base.WaitPolicy
+		subProc.SetResolveVariableFunc(proc.GetResolveVariableFunc())
+		subProc.SetPrepareParams(proc.GetPrepareParams())
+		defer subProc.Free()
+
+		subC := NewCompile(addr, db, sql, tenant, uid, e, subProc, nil, isInternal, cnLabel, time.Now())
+		defer subC.Release()
+		// read the same snapshot as the statement, and leave the retry to it.
+		subC.TxnOffset = txnOffset
+		subC.disableRetry = true
+
+		if err := subC.Compile(proc.Ctx, &plan.Plan{Plan: &plan.Plan_Query{Query: qry}}, fill); err != nil {
+			return err
+		}
+		_, err := subC.Run(0)
+		return err
 	}
-*/
+}
+
 func (c *Compile) compilePartition(n *plan.Node, ss []*Scope) []*Scope {
 	currentFirstFlag := c.anal.isFirst
 	for i := range ss {
@@ -3192,6 +3304,16 @@ func (c *Compile) compileLock(n *plan.Node, ss []*Scope) ([]*Scope, error) {
 			return nil, err
 		}
 		lockOpArg.SetBlock(block)
+		if block && n.Limit != nil {
+			// the lock node under the limit only locks the rows up to the limit
+			limit, ok, err := c.evalLockLimit(n)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				lockOpArg.SetLimit(limit)
+			}
+		}
 		lockOpArg.SetAnalyzeControl(c.anal.curNodeIdx, currentFirstFlag)
 		ss[i].doSetRootOperator(lockOpArg)
 
@@ -3200,6 +3322,31 @@ func (c *Compile) compileLock(n *plan.Node, ss []*Scope) ([]*Scope, error) {
 	return ss, nil
 }
 
+// evalLockLimit returns the number of rows to lock by the lock node with limit,
+// which is the sum of the limit and the offset if both are constant.
+func (c *Compile) evalLockLimit(n *plan.Node) (uint64, bool, error) {
+	var limit uint64
+	for _, expr := range []*plan.Expr{n.Limit, n.Offset} {
+		if expr == nil {
+			continue
+		}
+		if !rule.IsConstant(expr, false) {
+			return 0, false, nil
+		}
+		vec, err := colexec.EvalExpressionOnce(c.proc, expr, []*batch.Batch{constBat})
+		if err != nil {
+			return 0, false, err
+		}
+		v := vector.MustFixedColWithTypeCheck[uint64](vec)[0]
+		vec.Free(c.proc.Mp())
+		if limit+v < limit {
+			return 0, false, nil
+		}
+		limit += v
+	}
+	return limit, true, nil
+}
+
 func (c *Compile) compileRecursiveCte(n *plan.Node, curNodeIdx int32) ([]*Scope, error) {
 	receivers := make([]*process.WaitRegister, len(n.SourceStep))
 	for i, step := range n.SourceStep {
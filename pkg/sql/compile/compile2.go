@@ -305,6 +305,7 @@ func (c *Compile) prepareRetry(defChanged bool) (*Compile, error) {
 	var e error
 	runC := NewCompile(c.addr, c.db, c.sql, c.tenant, c.uid, c.e, c.proc, c.stmt, c.isInternal, c.cnLabel, c.startAt)
 	runC.SetOriginSQL(c.originSQL)
+	runC.SetTriggerRunner(c.triggerRunner)
 	defer func() {
 		if e != nil {
 			runC.Release()
@@ -74,6 +74,8 @@ func init() {
 		newTestCase("select * from R limit 10", new(testing.T)),
 		newTestCase("select count(*) from R group by uid", new(testing.T)),
 		newTestCase("select count(distinct uid) from R", new(testing.T)),
+		newTestCase("select * from R, lateral (select count(*) c from S where S.uid = R.uid) s", new(testing.T)),
+		newTestCase("select * from R left join lateral (select S.orderid from S where S.uid = R.uid order by 1 limit 1) s on s.orderid > R.orderid", new(testing.T)),
 		// xxx because memEngine can not handle Halloween Problem
 		// newTestCase("insert into R values('991', '992', '993')", new(testing.T)),
 		// newTestCase("insert into R select * from S", new(testing.T)),
@@ -101,6 +103,18 @@ func (w *Ws) RollbackLastStatement(ctx context.Context) error {
 	return nil
 }
 
+func (w *Ws) Savepoint(ctx context.Context) error {
+	return nil
+}
+
+func (w *Ws) RollbackToSavepoint(ctx context.Context, idx int) error {
+	return nil
+}
+
+func (w *Ws) ReleaseSavepoint(ctx context.Context, idx int) error {
+	return nil
+}
+
 func (w *Ws) Commit(ctx context.Context) ([]txn.TxnRequest, error) {
 	return nil, nil
 }
@@ -175,6 +189,39 @@ func TestCompile(t *testing.T) {
 	}
 }
 
+func TestCompileLateral(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := defines.AttachAccountId(context.TODO(), catalog.System_Account)
+	txnCli, txnOp := newTestTxnClientAndOp(ctrl)
+
+	run := func(sql string) int {
+		tc := newTestCase(sql, t)
+		tc.proc.Base.TxnClient = txnCli
+		tc.proc.Base.TxnOperator = txnOp
+		tc.proc.Ctx = ctx
+		tc.proc.ReplaceTopCtx(ctx)
+		c := NewCompile("test", "test", tc.sql, "", "", tc.e, tc.proc, tc.stmt, false, nil, time.Now())
+		defer c.Release()
+		var rows int
+		err := c.Compile(ctx, tc.pn, func(bat *batch.Batch) error {
+			if bat != nil {
+				rows += bat.RowCount()
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		_, err = c.Run(0)
+		require.NoError(t, err)
+		return rows
+	}
+
+	// the scalar aggregation returns one row for each row of the left side
+	require.Equal(t, 3, run("select g.result, s.c from generate_series(1, 3, 1) g, lateral (select count(*) c from S where S.uid = g.result) s"))
+	require.Equal(t, 3, run("select g.result, s.c from generate_series(1, 3, 1) g left join lateral (select count(*) c from S where S.uid = g.result) s on s.c > 0"))
+	require.Equal(t, 0, run("select g.result, s.c from generate_series(1, 3, 1) g, lateral (select count(*) c from S where S.uid = g.result) s where s.c > 0"))
+	require.Equal(t, 2, run("select g.result, s.x from generate_series(1, 3, 1) g, lateral (select g.result * 2 x) s where s.x > 2"))
+}
+
 func TestCompileWithFaults(t *testing.T) {
 	// Enable this line to trigger the Hung.
 	// fault.Enable()
@@ -246,3 +293,26 @@ func GetFilePath() string {
 	dir, _ := os.Getwd()
 	return dir
 }
+
+func TestTriggersConstraint(t *testing.T) {
+	triggers := []*plan.TriggerDef{
+		{Name: "t1", Time: "BEFORE", Event: "INSERT", Body: "set new.a = 1", DefinerUserId: 1, DefinerRoleId: 2},
+		{Name: "t2", Time: "AFTER", Event: "DELETE", Body: "insert into t2 values (old.a)"},
+	}
+	defs, err := planDefsToExeDefs(&plan.TableDef{Triggers: triggers})
+	require.NoError(t, err)
+	ct := GetConstraintDefFromTableDefs(defs)
+	data, err := ct.MarshalBinary()
+	require.NoError(t, err)
+
+	ct = &engine.ConstraintDef{}
+	require.NoError(t, ct.UnmarshalBinary(data))
+	require.Len(t, ct.Cts, 1)
+	require.Equal(t, triggers, ct.Cts[0].(*engine.TriggersDef).Triggers)
+
+	// the triggers are replaced by CREATE / DROP TRIGGER
+	ct, err = MakeNewCreateConstraint(ct, &engine.TriggersDef{Triggers: triggers[1:]})
+	require.NoError(t, err)
+	require.Len(t, ct.Cts, 1)
+	require.Equal(t, triggers[1:], ct.Cts[0].(*engine.TriggersDef).Triggers)
+}
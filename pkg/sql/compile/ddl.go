@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"math"
 	"strings"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
@@ -36,7 +37,9 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+	"github.com/matrixorigin/matrixone/pkg/sql/util"
 	"github.com/matrixorigin/matrixone/pkg/txn/client"
+	"github.com/matrixorigin/matrixone/pkg/util/executor"
 	"github.com/matrixorigin/matrixone/pkg/util/trace"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -127,13 +130,30 @@ func (s *Scope) DropDatabase(c *Compile) error {
 		return err
 	}
 
+	// 2.1 delete all check constraints under the database from mo_catalog.mo_check_constraints
+	deleteSql = fmt.Sprintf(deleteMoCheckConstraintsWithDatabaseIdFormat, s.Plan.GetDdl().GetDropDatabase().GetDatabaseId())
+	err = c.runSql(deleteSql)
+	if err != nil {
+		return err
+	}
+
 	// 3. delete fks
 	err = c.runSql(s.Plan.GetDdl().GetDropDatabase().GetUpdateFkSql())
 	if err != nil {
 		return err
 	}
 	// 4. delete retention info
-	return c.runSql(fmt.Sprintf(deleteMoRetentionWithDatabaseNameFormat, dbName))
+	err = c.runSql(fmt.Sprintf(deleteMoRetentionWithDatabaseNameFormat, dbName))
+	if err != nil {
+		return err
+	}
+	// 5. delete events, their cron tasks are removed when they are triggered
+	err = c.runSql(fmt.Sprintf(deleteMoEventsWithDatabaseNameFormat, dbName))
+	if err != nil {
+		return err
+	}
+	// 6. delete triggers
+	return c.runSql(fmt.Sprintf(deleteMoTriggersWithDatabaseNameFormat, dbName))
 }
 
 func (s *Scope) removeFkeysRelationships(c *Compile, dbName string) error {
@@ -354,6 +374,7 @@ func (s *Scope) AlterTableInplace(c *Compile) error {
 	var addIndex []*plan.IndexDef
 	var dropIndexMap = make(map[string]bool)
 	var alterIndex *plan.IndexDef
+	var dropCheckMap = make(map[string]bool)
 
 	var alterKinds []api.AlterKind
 	var comment string
@@ -410,6 +431,16 @@ func (s *Scope) AlterTableInplace(c *Compile) error {
 				}
 				// Avoid modifying slice directly during iteration
 				tableDef.Indexes = notDroppedIndex
+			} else if alterTableDrop.Typ == plan.AlterTableDrop_CHECK {
+				alterKinds = addAlterKind(alterKinds, api.AlterKind_UpdateConstraint)
+				dropCheckMap[constraintName] = true
+				tableDef.Checks = plan2.RemoveIf[*plan.CheckDef](tableDef.Checks, func(check *plan.CheckDef) bool {
+					return check.Name == constraintName
+				})
+				deleteSql := fmt.Sprintf(deleteMoCheckConstraintsWithTableIdAndNameFormat, tblId, util.QuoteSqlString(constraintName))
+				if err = c.runSql(deleteSql); err != nil {
+					return err
+				}
 			} else if alterTableDrop.Typ == plan.AlterTableDrop_COLUMN {
 				alterKinds = append(alterKinds, api.AlterKind_DropColumn)
 				var idx int
@@ -467,6 +498,9 @@ func (s *Scope) AlterTableInplace(c *Compile) error {
 				} else if !indexDef.Unique && catalog.IsMasterIndexAlgo(indexDef.IndexAlgo) {
 					// 3. Master index
 					err = s.handleMasterIndexTable(c, indexDef, qry.Database, tableDef, indexInfo)
+				} else if !indexDef.Unique && catalog.IsFullTextIndexAlgo(indexDef.IndexAlgo) {
+					// 3.1 FullText index
+					err = s.handleFullTextIndexTable(c, indexDef, qry.Database, tableDef, indexInfo)
 				} else if !indexDef.Unique && catalog.IsIvfIndexAlgo(indexDef.IndexAlgo) {
 					// 4. IVF indexDefs are aggregated and handled later
 					if _, ok := multiTableIndexes[indexDef.IndexName]; !ok {
@@ -643,6 +677,40 @@ func (s *Scope) AlterTableInplace(c *Compile) error {
 			if err != nil {
 				return err
 			}
+		case *plan.AlterTable_Action_DropPartition:
+			alterKinds = append(alterKinds, api.AlterKind_DropPartition)
+			changePartitionDef = act.DropPartition.PartitionDef
+			if err = clearPartitionIndexes(c, dbName, tableDef, act.DropPartition.PartitionTableNames); err != nil {
+				return err
+			}
+			for _, name := range act.DropPartition.PartitionTableNames {
+				if err = dbSource.Delete(c.proc.Ctx, name); err != nil {
+					return err
+				}
+			}
+
+			err = c.runSql(fmt.Sprintf(deleteMoTablePartitionsWithTableIdFormat, tblId))
+			if err != nil {
+				return err
+			}
+			insertMoTablePartitionSql := genInsertMoTablePartitionsSql(databaseId, tblId, changePartitionDef, changePartitionDef.Partitions)
+			err = c.runSql(insertMoTablePartitionSql)
+			if err != nil {
+				return err
+			}
+		case *plan.AlterTable_Action_TruncatePartition:
+			if err = clearPartitionIndexes(c, dbName, tableDef, act.TruncatePartition.PartitionTableNames); err != nil {
+				return err
+			}
+			for _, name := range act.TruncatePartition.PartitionTableNames {
+				if _, err = dbSource.Truncate(c.proc.Ctx, name); err != nil {
+					return err
+				}
+			}
+		case *plan.AlterTable_Action_ExchangePartition:
+			if err = exchangePartition(c, dbSource, dbName, tableDef, act.ExchangePartition); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -692,6 +760,13 @@ func (s *Scope) AlterTableInplace(c *Compile) error {
 			newCt.Cts = append(newCt.Cts, t)
 		case *engine.PrimaryKeyDef:
 			newCt.Cts = append(newCt.Cts, t)
+		case *engine.CheckDef:
+			t.Checks = plan2.RemoveIf[*plan.CheckDef](t.Checks, func(check *plan.CheckDef) bool {
+				return dropCheckMap[check.Name]
+			})
+			newCt.Cts = append(newCt.Cts, t)
+		case *engine.TriggersDef:
+			newCt.Cts = append(newCt.Cts, t)
 		}
 	}
 	if !originHasFkDef {
@@ -732,14 +807,19 @@ func (s *Scope) AlterTableInplace(c *Compile) error {
 			dropColIdx++
 		case api.AlterKind_AddPartition:
 			req = api.NewAddPartitionReq(rel.GetDBID(c.proc.Ctx), rel.GetTableID(c.proc.Ctx), changePartitionDef)
+		case api.AlterKind_DropPartition:
+			req = api.NewDropPartitionReq(rel.GetDBID(c.proc.Ctx), rel.GetTableID(c.proc.Ctx), changePartitionDef)
 		default:
 		}
 		reqs = append(reqs, req)
 	}
 
-	err = rel.AlterTable(c.proc.Ctx, newCt, reqs)
-	if err != nil {
-		return err
+	// truncate partition and exchange partition do not change the metadata of the table
+	if len(reqs) > 0 {
+		err = rel.AlterTable(c.proc.Ctx, newCt, reqs)
+		if err != nil {
+			return err
+		}
 	}
 
 	// remove refChildTbls for drop foreign key clause
@@ -785,6 +865,215 @@ func (s *Scope) AlterTableInplace(c *Compile) error {
 	return nil
 }
 
+// clearPartitionIndexes removes the index entries of the rows stored in the partition sub tables.
+// The index tables of a partitioned table are shared by all of its partitions, so they can not be
+// truncated together with the partition sub tables.
+func clearPartitionIndexes(c *Compile, dbName string, tableDef *plan.TableDef, partitionTableNames []string) error {
+	for _, indexDef := range tableDef.Indexes {
+		if !indexDef.TableExist {
+			continue
+		}
+		// the metadata and centroids tables of ivfflat index do not hold any row of the table
+		if catalog.IsIvfIndexAlgo(indexDef.IndexAlgo) && indexDef.IndexAlgoTableType != catalog.SystemSI_IVFFLAT_TblType_Entries {
+			continue
+		}
+		for _, name := range partitionTableNames {
+			deleteSql := fmt.Sprintf(deleteIndexEntriesOfPartitionFormat,
+				dbName, indexDef.IndexTableName, catalog.IndexTablePrimaryColName, tableDef.Pkey.PkeyColName, dbName, name)
+			if err := c.runSql(deleteSql); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// exchangePartition swaps the partition with a non-partitioned table. The rows are not moved, the
+// partition sub table and the table exchange their names, kinds, constraints and comments in the
+// catalog, and then the index entries of the rows are rebuilt on both sides.
+func exchangePartition(c *Compile, dbSource engine.Database, dbName string, tableDef *plan.TableDef, exchange *plan.AlterTableExchangePartition) error {
+	partitionRel, err := dbSource.Relation(c.proc.Ctx, exchange.PartitionTableName, nil)
+	if err != nil {
+		return err
+	}
+	exchangeRel, err := dbSource.Relation(c.proc.Ctx, exchange.Table, nil)
+	if err != nil {
+		return err
+	}
+	if c.proc.GetTxnOperator().Txn().IsPessimistic() {
+		for _, rel := range []engine.Relation{partitionRel, exchangeRel} {
+			if err = lockMoTable(c, dbName, rel.GetTableName(), lock.LockMode_Exclusive); err != nil {
+				return err
+			}
+			if err = lockTable(c.proc.Ctx, c.e, c.proc, rel, dbName, nil, true); err != nil {
+				return err
+			}
+		}
+	}
+
+	// 1. check that all the rows of the table belong to the partition before touching anything
+	if exchange.ValidationFilter != "" {
+		rows, err := countTableRows(c, dbName, exchange.Table, "not "+exchange.ValidationFilter)
+		if err != nil {
+			return err
+		}
+		if rows > 0 {
+			return moerr.NewErrRowDoesNotMatchPartition(c.proc.Ctx)
+		}
+	}
+
+	// 2. remove the index entries of the rows in the partition, they are looked up by the name of the partition
+	if err = clearPartitionIndexes(c, dbName, tableDef, []string{exchange.PartitionTableName}); err != nil {
+		return err
+	}
+
+	// 3. swap the two tables in the catalog
+	exchangeTableDef := exchangeRel.CopyTableDef(c.proc.Ctx)
+	if err = swapExchangeTables(c, partitionRel, exchangeRel, exchange); err != nil {
+		return err
+	}
+	if err = c.runSql(fmt.Sprintf(updateMoIndexesTruncateTableFormat,
+		partitionRel.GetTableID(c.proc.Ctx), exchangeRel.GetTableID(c.proc.Ctx))); err != nil {
+		return err
+	}
+	if err = exchangeAutoIncrement(c, tableDef, exchangeTableDef, partitionRel.GetTableID(c.proc.Ctx)); err != nil {
+		return err
+	}
+
+	// 4. rebuild the index entries of the rows which are swapped in on both sides
+	partitionDef := *tableDef
+	partitionDef.Name = exchange.PartitionTableName
+	for _, indexDef := range tableDef.Indexes {
+		if !indexDef.TableExist {
+			continue
+		}
+		if err = c.runSql(genInsertIndexTableSql(&partitionDef, indexDef, dbName, indexDef.Unique)); err != nil {
+			return err
+		}
+	}
+	for _, indexDef := range exchangeTableDef.Indexes {
+		if !indexDef.TableExist {
+			continue
+		}
+		if err = c.runSql(fmt.Sprintf("delete from `%s`.`%s`", dbName, indexDef.IndexTableName)); err != nil {
+			return err
+		}
+		if err = c.runSql(genInsertIndexTableSql(exchangeTableDef, indexDef, dbName, indexDef.Unique)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// swapExchangeTables swaps the names, kinds, constraints and comments of the partition sub table and
+// the table, so that each of them takes the place of the other one.
+func swapExchangeTables(c *Compile, partitionRel, exchangeRel engine.Relation, exchange *plan.AlterTableExchangePartition) error {
+	ctx := c.proc.Ctx
+	partitionCt, err := GetConstraintDef(ctx, partitionRel)
+	if err != nil {
+		return err
+	}
+	exchangeCt, err := GetConstraintDef(ctx, exchangeRel)
+	if err != nil {
+		return err
+	}
+	partitionComment, err := relationComment(ctx, partitionRel)
+	if err != nil {
+		return err
+	}
+	exchangeComment, err := relationComment(ctx, exchangeRel)
+	if err != nil {
+		return err
+	}
+
+	alter := func(rel engine.Relation, ct *engine.ConstraintDef, oldName, newName, kind, comment string) error {
+		did, tid := rel.GetDBID(ctx), rel.GetTableID(ctx)
+		reqs := []*api.AlterTableReq{api.NewRenameTableReq(did, tid, oldName, newName)}
+		if ct != nil {
+			bytes, err := ct.MarshalBinary()
+			if err != nil {
+				return err
+			}
+			reqs = append(reqs,
+				api.NewUpdateConstraintReq(did, tid, string(bytes)),
+				api.NewUpdateKindReq(did, tid, kind),
+				api.NewUpdateCommentReq(did, tid, comment))
+		}
+		return rel.AlterTable(ctx, ct, reqs)
+	}
+
+	tmpName := fmt.Sprintf("%s_exchange_%d", exchange.PartitionTableName, time.Now().UnixNano())
+	if err = alter(partitionRel, nil, exchange.PartitionTableName, tmpName, "", ""); err != nil {
+		return err
+	}
+	if err = alter(exchangeRel, partitionCt, exchange.Table, exchange.PartitionTableName, catalog.SystemPartitionRel, partitionComment); err != nil {
+		return err
+	}
+	return alter(partitionRel, exchangeCt, tmpName, exchange.Table, catalog.SystemOrdinaryRel, exchangeComment)
+}
+
+// relationComment returns the comment of the table.
+func relationComment(ctx context.Context, rel engine.Relation) (string, error) {
+	defs, err := rel.TableDefs(ctx)
+	if err != nil {
+		return "", err
+	}
+	for _, def := range defs {
+		if comment, ok := def.(*engine.CommentDef); ok {
+			return comment.Comment, nil
+		}
+	}
+	return "", nil
+}
+
+// exchangeAutoIncrement moves the auto increment columns of the table to the partition sub table
+// which takes its place. The partition sub tables do not own any auto increment columns, their
+// values are allocated by the partitioned table, so the new values of the table must start after
+// the values allocated by both of them.
+func exchangeAutoIncrement(c *Compile, tableDef, exchangeTableDef *plan.TableDef, newTableID uint64) error {
+	cols := incrservice.GetAutoColumnFromDef(exchangeTableDef)
+	if len(cols) == 0 {
+		return nil
+	}
+	incrService := incrservice.GetAutoIncrementService(c.proc.GetService())
+	for i := range cols {
+		offset, err := incrService.CurrentValue(c.proc.Ctx, exchangeTableDef.TblId, cols[i].ColName)
+		if err != nil {
+			return err
+		}
+		partitionOffset, err := incrService.CurrentValue(c.proc.Ctx, tableDef.TblId, cols[i].ColName)
+		if err != nil {
+			return err
+		}
+		cols[i].TableID = newTableID
+		cols[i].Offset = max(offset, partitionOffset)
+	}
+	if err := incrService.Delete(c.proc.Ctx, exchangeTableDef.TblId, c.proc.GetTxnOperator()); err != nil {
+		return err
+	}
+	return incrService.Create(c.proc.Ctx, newTableID, cols, c.proc.GetTxnOperator())
+}
+
+// countTableRows returns the number of the rows in the table which satisfy the filter.
+func countTableRows(c *Compile, dbName string, tblName string, filter string) (int64, error) {
+	sql := fmt.Sprintf("select count(*) from `%s`.`%s`", dbName, tblName)
+	if filter != "" {
+		sql += " where " + filter
+	}
+	res, err := c.runSqlWithResult(sql, NoAccountId)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Close()
+
+	var rows int64
+	res.ReadRows(func(_ int, cols []*vector.Vector) bool {
+		rows = executor.GetFixedRows[int64](cols[0])[0]
+		return false
+	})
+	return rows, nil
+}
+
 func (s *Scope) CreateTable(c *Compile) error {
 	qry := s.Plan.GetDdl().GetCreateTable()
 	// convert the plan's cols to the execution's cols
@@ -1267,6 +1556,24 @@ func (s *Scope) CreateTable(c *Compile) error {
 		return err
 	}
 
+	// insert the check constraints of the table into mo_catalog.mo_check_constraints
+	if dbName != catalog.MO_CATALOG && len(qry.GetTableDef().GetChecks()) > 0 {
+		newRelation, err := dbSource.Relation(c.proc.Ctx, tblName, nil)
+		if err != nil {
+			return err
+		}
+		insertSQL := genInsertMoCheckConstraintsSql(newRelation.GetDBID(c.proc.Ctx), newRelation.GetTableID(c.proc.Ctx), qry.GetTableDef().GetChecks())
+		if err = c.runSql(insertSQL); err != nil {
+			c.proc.Error(c.proc.Ctx, "createTable",
+				zap.String("insertSQL", insertSQL),
+				zap.String("databaseName", c.db),
+				zap.String("tableName", qry.GetTableDef().GetName()),
+				zap.Error(err),
+			)
+			return err
+		}
+	}
+
 	if qry.RetentionDeadline != 0 {
 		insertRetention := fmt.Sprintf("insert into `%s`.`%s` values ('%s','%s', %d)",
 			catalog.MO_CATALOG, catalog.MO_RETENTION, dbName, tblName, qry.RetentionDeadline)
@@ -1521,6 +1828,9 @@ func (s *Scope) CreateIndex(c *Compile) error {
 		} else if !indexDef.Unique && catalog.IsMasterIndexAlgo(indexAlgo) {
 			// 3. Master index
 			err = s.handleMasterIndexTable(c, indexDef, qry.Database, originalTableDef, indexInfo)
+		} else if !indexDef.Unique && catalog.IsFullTextIndexAlgo(indexAlgo) {
+			// 3.1 FullText index
+			err = s.handleFullTextIndexTable(c, indexDef, qry.Database, originalTableDef, indexInfo)
 		} else if !indexDef.Unique && catalog.IsIvfIndexAlgo(indexAlgo) {
 			// 4. IVF indexDefs are aggregated and handled later
 			if _, ok := multiTableIndexes[indexDef.IndexName]; !ok {
@@ -1758,6 +2068,13 @@ func MakeNewCreateConstraint(oldCt *engine.ConstraintDef, c engine.Constraint) (
 		if !ok {
 			oldCt.Cts = append(oldCt.Cts, c)
 		}
+	case *engine.TriggersDef:
+		pred = func(ct engine.Constraint) bool {
+			_, ok = ct.(*engine.TriggersDef)
+			return ok
+		}
+		oldCt.Cts = plan2.RemoveIf[engine.Constraint](oldCt.Cts, pred)
+		oldCt.Cts = append(oldCt.Cts, c)
 	}
 	return oldCt, nil
 }
@@ -2035,6 +2352,15 @@ func (s *Scope) TruncateTable(c *Compile) error {
 	if err != nil {
 		return err
 	}
+
+	// update check constraints information in mo_catalog.mo_check_constraints
+	if !isTemp && dbName != catalog.MO_CATALOG && len(rel.GetTableDef(c.proc.Ctx).GetChecks()) > 0 {
+		updateSql = fmt.Sprintf(updateMoCheckConstraintsTruncateTableFormat, newId, oldId)
+		err = c.runSql(updateSql)
+		if err != nil {
+			return err
+		}
+	}
 	c.addAffectedRows(uint64(affectedRows))
 	return nil
 }
@@ -2194,6 +2520,15 @@ func (s *Scope) DropTable(c *Compile) error {
 		}
 	}
 
+	// delete all check constraints of the table in mo_catalog.mo_check_constraints
+	if !qry.IsView && qry.Database != catalog.MO_CATALOG && len(qry.GetTableDef().GetChecks()) > 0 {
+		deleteSql := fmt.Sprintf(deleteMoCheckConstraintsWithTableIdFormat, qry.GetTableDef().TblId)
+		err = c.runSql(deleteSql)
+		if err != nil {
+			return err
+		}
+	}
+
 	// delete all partition objects record of the table in mo_catalog.mo_table_partitions
 	if !qry.IsView && qry.Database != catalog.MO_CATALOG && qry.Table != catalog.MO_TABLE_PARTITIONS {
 		if qry.TableDef.Partition != nil {
@@ -2304,7 +2639,11 @@ func (s *Scope) DropTable(c *Compile) error {
 		return nil
 	}
 	deleteRetentionSQL := fmt.Sprintf(deleteMoRetentionWithDatabaseNameAndTableNameFormat, dbName, tblName)
-	return c.runSql(deleteRetentionSQL)
+	if err := c.runSql(deleteRetentionSQL); err != nil {
+		return err
+	}
+	// the triggers are dropped with the table
+	return c.runSql(fmt.Sprintf(deleteMoTriggersWithDatabaseNameAndTableNameFormat, dbName, tblName))
 }
 
 func planDefsToExeDefs(tableDef *plan.TableDef) ([]engine.TableDef, error) {
@@ -2371,6 +2710,18 @@ func planDefsToExeDefs(tableDef *plan.TableDef) ([]engine.TableDef, error) {
 		})
 	}
 
+	if len(tableDef.Checks) > 0 {
+		c.Cts = append(c.Cts, &engine.CheckDef{
+			Checks: tableDef.Checks,
+		})
+	}
+
+	if len(tableDef.Triggers) > 0 {
+		c.Cts = append(c.Cts, &engine.TriggersDef{
+			Triggers: tableDef.Triggers,
+		})
+	}
+
 	if len(c.Cts) > 0 {
 		exeDefs = append(exeDefs, c)
 	}
@@ -91,6 +91,27 @@ func (s *Scope) handleMasterIndexTable(c *Compile, indexDef *plan.IndexDef, qryD
 	return nil
 }
 
+func (s *Scope) handleFullTextIndexTable(c *Compile, indexDef *plan.IndexDef, qryDatabase string,
+	originalTableDef *plan.TableDef, indexInfo *plan.CreateTable) error {
+
+	if len(indexInfo.GetIndexTables()) != 1 {
+		return moerr.NewInternalErrorNoCtx("index table count not equal to 1")
+	}
+
+	def := indexInfo.GetIndexTables()[0]
+	createSQL := genCreateIndexTableSql(def, indexDef, qryDatabase)
+	err := c.runSql(createSQL)
+	if err != nil {
+		return err
+	}
+
+	insertSQL, err := genInsertIndexTableSqlForFullTextIndex(originalTableDef, indexDef, qryDatabase)
+	if err != nil {
+		return err
+	}
+	return c.runSql(insertSQL)
+}
+
 func (s *Scope) handleIndexColCount(c *Compile, indexDef *plan.IndexDef, qryDatabase string, originalTableDef *plan.TableDef) (int64, error) {
 
 	indexColumnName := indexDef.Parts[0]
@@ -480,6 +480,7 @@ func dupOperator(sourceOp vm.Operator, index int, maxParallel int) vm.Operator {
 		op.EstimatedRowCount = t.EstimatedRowCount
 		op.CompPkeyExpr = t.CompPkeyExpr
 		op.ClusterByExpr = t.ClusterByExpr
+		op.Checks = t.Checks
 		op.SetInfo(&info)
 		return op
 	case vm.Deletion:
@@ -523,6 +524,14 @@ func dupOperator(sourceOp vm.Operator, index int, maxParallel int) vm.Operator {
 	case vm.Apply:
 		t := sourceOp.(*apply.Apply)
 		op := apply.NewArgument()
+		op.ApplyType = t.ApplyType
+		op.Result = t.Result
+		op.Attrs = t.Attrs
+		op.Rets = t.Rets
+		op.Args = t.Args
+		op.FuncName = t.FuncName
+		op.Params = t.Params
+		op.RunLateral = t.RunLateral
 		op.ProjectList = t.ProjectList
 		op.SetInfo(&info)
 		return op
@@ -668,6 +677,7 @@ func constructPreInsert(ns []*plan.Node, n *plan.Node, eg engine.Engine, proc *p
 	op.EstimatedRowCount = int64(ns[n.Children[0]].Stats.Outcnt)
 	op.CompPkeyExpr = preCtx.CompPkeyExpr
 	op.ClusterByExpr = preCtx.ClusterByExpr
+	op.Checks = preCtx.Checks
 
 	return op, nil
 }
@@ -698,9 +708,13 @@ func constructLockOp(n *plan.Node, eng engine.Engine) (*lockop.LockOp, error) {
 	for _, target := range n.LockTargets {
 		typ := plan2.MakeTypeByPlan2Type(target.PrimaryColTyp)
 		if target.IsPartitionTable {
-			arg.AddLockTargetWithPartition(target.GetPartitionTableIds(), target.GetPrimaryColIdxInBat(), typ, target.GetRefreshTsIdxInBat(), target.GetFilterColIdxInBat())
+			arg.AddLockTargetWithPartitionAndMode(target.GetPartitionTableIds(), target.GetMode(), target.GetPrimaryColIdxInBat(), typ, target.GetRefreshTsIdxInBat(), target.GetFilterColIdxInBat())
+			for _, pTblId := range target.PartitionTableIds {
+				arg.SetWaitPolicy(pTblId, target.GetWaitPolicy())
+			}
 		} else {
-			arg.AddLockTarget(target.GetTableId(), target.GetPrimaryColIdxInBat(), typ, target.GetRefreshTsIdxInBat())
+			arg.AddLockTargetWithMode(target.GetTableId(), target.GetMode(), target.GetPrimaryColIdxInBat(), typ, target.GetRefreshTsIdxInBat())
+			arg.SetWaitPolicy(target.GetTableId(), target.GetWaitPolicy())
 		}
 
 	}
@@ -1835,20 +1849,26 @@ func constructJoinCondition(expr *plan.Expr, proc *process.Process) (*plan.Expr,
 	return e.F.Args[0], e.F.Args[1]
 }
 
-/*
-	func constructApply(n, right *plan.Node, applyType int, proc *process.Process) *apply.Apply {
-		result := make([]colexec.ResultPos, len(n.ProjectList))
-		for i, expr := range n.ProjectList {
-			result[i].Rel, result[i].Pos = constructJoinResult(expr, proc)
-		}
-		arg := apply.NewArgument()
-		arg.ApplyType = applyType
-		arg.Result = result
-		arg.Args = plan2.DeepCopyExprList(right.TblFuncExprList)
-		arg.FuncName = right.TableDef.TblFunc.Name
-		return arg
+func constructApply(n, right *plan.Node, applyType int, proc *process.Process) *apply.Apply {
+	result := make([]colexec.ResultPos, len(n.ProjectList))
+	for i, expr := range n.ProjectList {
+		result[i].Rel, result[i].Pos = constructJoinResult(expr, proc)
 	}
-*/
+	attrs := make([]string, len(right.TableDef.Cols))
+	for j, col := range right.TableDef.Cols {
+		attrs[j] = col.GetOriginCaseName()
+	}
+	arg := apply.NewArgument()
+	arg.ApplyType = applyType
+	arg.Result = result
+	arg.Attrs = attrs
+	arg.Rets = right.TableDef.Cols
+	arg.Args = right.TblFuncExprList
+	arg.FuncName = right.TableDef.TblFunc.Name
+	arg.Params = right.TableDef.TblFunc.Param
+	return arg
+}
+
 func constructTableScan(n *plan.Node) *table_scan.TableScan {
 	types := make([]plan.Type, len(n.TableDef.Cols))
 	for j, col := range n.TableDef.Cols {
@@ -455,6 +455,7 @@ func convertToPipelineInstruction(op vm.Operator, proc *process.Process, ctx *sc
 			EstimatedRowCount: int64(t.EstimatedRowCount),
 			CompPkeyExpr:      t.CompPkeyExpr,
 			ClusterByExpr:     t.ClusterByExpr,
+			Checks:            t.Checks,
 		}
 	case *lockop.LockOp:
 		in.LockOp = &pipeline.LockOp{
@@ -860,6 +861,7 @@ func convertToVmOperator(opr *pipeline.Instruction, ctx *scopeContext, eng engin
 		arg.EstimatedRowCount = int64(t.GetEstimatedRowCount())
 		arg.CompPkeyExpr = t.CompPkeyExpr
 		arg.ClusterByExpr = t.ClusterByExpr
+		arg.Checks = t.Checks
 		op = arg
 	case vm.LockOp:
 		t := opr.GetLockOp()
@@ -867,7 +869,8 @@ func convertToVmOperator(opr *pipeline.Instruction, ctx *scopeContext, eng engin
 		lockArg.SetBlock(t.Block)
 		for _, target := range t.Targets {
 			typ := plan2.MakeTypeByPlan2Type(target.PrimaryColTyp)
-			lockArg.AddLockTarget(target.GetTableId(), target.GetPrimaryColIdxInBat(), typ, target.GetRefreshTsIdxInBat())
+			lockArg.AddLockTargetWithMode(target.GetTableId(), target.GetMode(), target.GetPrimaryColIdxInBat(), typ, target.GetRefreshTsIdxInBat())
+			lockArg.SetWaitPolicy(target.GetTableId(), target.GetWaitPolicy())
 		}
 		for _, target := range t.Targets {
 			if target.LockTable {
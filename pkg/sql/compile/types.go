@@ -301,8 +301,16 @@ type Compile struct {
 	disableRetry bool
 
 	isPrepare bool
+
+	// triggerRunner executes the procedural triggers of the query.
+	triggerRunner TriggerRunner
 }
 
+// TriggerRunner executes the body of the procedural trigger for every row of
+// the batch, whose columns are the OLD and the NEW columns of the trigger.
+// The batch is only valid during the call.
+type TriggerRunner func(ctx context.Context, trigger *plan.RowTrigger, bat *batch.Batch) error
+
 type RemoteReceivRegInfo struct {
 	Idx      int
 	Uuid     uuid.UUID
@@ -18,12 +18,14 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/util"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
@@ -66,6 +68,7 @@ var (
 	insertIntoSingleIndexTableWithoutPKeyFormat = "insert into  `%s`.`%s` select (%s) from `%s`.`%s` where (%s) is not null;"
 	insertIntoIndexTableWithoutPKeyFormat       = "insert into  `%s`.`%s` select serial(%s) from `%s`.`%s` where serial(%s) is not null;"
 	insertIntoMasterIndexTableFormat            = "insert into  `%s`.`%s` select serial_full('%s', %s, %s), %s from `%s`.`%s`;"
+	insertIntoFullTextIndexTableFormat          = "insert into  `%s`.`%s` select `f`.* from `%s`.`%s`, fulltext_index_tokenize('%s', %s, %s) as `f` where `f`.`%s` = %s;"
 	createIndexTableForamt                      = "create table `%s`.`%s` (%s);"
 )
 
@@ -75,11 +78,25 @@ var (
 	deleteMoIndexesWithTableIdAndIndexNameFormat        = `delete from mo_catalog.mo_indexes where table_id = %v and name = '%s';`
 	deleteMoRetentionWithDatabaseNameFormat             = `delete from mo_catalog.mo_retention where database_name = '%s';`
 	deleteMoRetentionWithDatabaseNameAndTableNameFormat = `delete from mo_catalog.mo_retention where database_name = '%s' and table_name = '%s';`
+	deleteMoEventsWithDatabaseNameFormat                = `delete from mo_catalog.mo_events where db_name = '%s';`
+	deleteMoTriggersWithDatabaseNameFormat              = `delete from mo_catalog.mo_triggers where db_name = '%s';`
+	deleteMoTriggersWithDatabaseNameAndTableNameFormat  = `delete from mo_catalog.mo_triggers where db_name = '%s' and table_name = '%s';`
 	updateMoIndexesVisibleFormat                        = `update mo_catalog.mo_indexes set is_visible = %v where table_id = %v and name = '%s';`
 	updateMoIndexesTruncateTableFormat                  = `update mo_catalog.mo_indexes set table_id = %v where table_id = %v`
 	updateMoIndexesAlgoParams                           = `update mo_catalog.mo_indexes set algo_params = '%s' where table_id = %v and name = '%s';`
 )
 
+var (
+	deleteMoCheckConstraintsWithDatabaseIdFormat     = `delete from mo_catalog.mo_check_constraints where database_id = %v;`
+	deleteMoCheckConstraintsWithTableIdFormat        = `delete from mo_catalog.mo_check_constraints where table_id = %v;`
+	deleteMoCheckConstraintsWithTableIdAndNameFormat = `delete from mo_catalog.mo_check_constraints where table_id = %v and name = '%s';`
+	updateMoCheckConstraintsTruncateTableFormat      = `update mo_catalog.mo_check_constraints set table_id = %v where table_id = %v;`
+)
+
+var (
+	deleteIndexEntriesOfPartitionFormat = "delete from `%s`.`%s` where `%s` in (select `%s` from `%s`.`%s`);"
+)
+
 var (
 	deleteMoTablePartitionsWithDatabaseIdFormat = `delete from mo_catalog.mo_table_partitions where database_id = %v;`
 	deleteMoTablePartitionsWithTableIdFormat    = `delete from mo_catalog.mo_table_partitions where table_id = %v;`
@@ -248,6 +265,55 @@ func genInsertIndexTableSqlForMasterIndex(originTableDef *plan.TableDef, indexDe
 	return insertSQLs
 }
 
+func genInsertIndexTableSqlForFullTextIndex(originTableDef *plan.TableDef, indexDef *plan.IndexDef, DBName string) (string, error) {
+	pkeyName := originTableDef.Pkey.PkeyColName
+	var pKeyMsg string
+	if pkeyName == catalog.CPrimaryKeyColName {
+		pKeyMsg = "serial("
+		for i, part := range originTableDef.Pkey.Names {
+			if i == 0 {
+				pKeyMsg += "`" + part + "`"
+			} else {
+				pKeyMsg += ",`" + part + "`"
+			}
+		}
+		pKeyMsg += ")"
+	} else {
+		pKeyMsg = "`" + pkeyName + "`"
+	}
+
+	parser, err := catalog.FullTextIndexParser(indexDef.IndexAlgoParams)
+	if err != nil {
+		return "", err
+	}
+
+	cols := make([]string, len(indexDef.Parts))
+	for i, part := range indexDef.Parts {
+		cols[i] = "`" + part + "`"
+	}
+
+	return fmt.Sprintf(insertIntoFullTextIndexTableFormat,
+		DBName, indexDef.IndexTableName,
+		DBName, originTableDef.Name,
+		parser, pKeyMsg, strings.Join(cols, ", "),
+		catalog.FullTextIndexTableDocIdColName, pKeyMsg), nil
+}
+
+// genInsertMoCheckConstraintsSql: Generate an insert statement for insert the check constraints of the table into `mo_catalog.mo_check_constraints`
+func genInsertMoCheckConstraintsSql(databaseId uint64, tableId uint64, checks []*plan.CheckDef) string {
+	buffer := bytes.NewBuffer(make([]byte, 0, 256))
+	buffer.WriteString("insert into mo_catalog.mo_check_constraints values")
+	for i, check := range checks {
+		if i > 0 {
+			buffer.WriteString(",")
+		}
+		fmt.Fprintf(buffer, " (%d, %d, '%s', '%s', %v)",
+			tableId, databaseId, util.QuoteSqlString(check.Name), util.QuoteSqlString(check.OriginString), check.Enforced)
+	}
+	buffer.WriteString(";")
+	return buffer.String()
+}
+
 // genInsertMOIndexesSql: Generate an insert statement for insert index metadata into `mo_catalog.mo_indexes`
 func genInsertMOIndexesSql(eg engine.Engine, proc *process.Process, databaseId string, tableId uint64, ct *engine.ConstraintDef, tableDef *plan.TableDef) (string, error) {
 	buffer := bytes.NewBuffer(make([]byte, 0, 1024))
@@ -326,7 +392,8 @@ func genInsertMOIndexesSql(eg engine.Engine, proc *process.Process, databaseId s
 					fmt.Fprintf(buffer, "'%s', ", indexDef.Comment)
 
 					// 12. index vec_column_name
-					fmt.Fprintf(buffer, "'%s', ", getOriginName(part))
+					// the expression key part may contain quotes
+					fmt.Fprintf(buffer, "'%s', ", strings.ReplaceAll(getOriginName(part), "'", "''"))
 
 					// 13. index vec_ordinal_position
 					fmt.Fprintf(buffer, "%d, ", i+1)
@@ -22,11 +22,16 @@ func init() {
 		"account":                    ACCOUNT,
 		"accounts":                   ACCOUNTS,
 		"add":                        ADD,
+		"at":                         AT,
+		"completion":                 COMPLETION,
+		"ends":                       ENDS,
+		"every":                      EVERY,
 		"modify":                     MODIFY,
 		"action":                     ACTION,
 		"against":                    AGAINST,
 		"all":                        ALL,
 		"alter":                      ALTER,
+		"always":                     ALWAYS,
 		"algorithm":                  ALGORITHM,
 		"analyze":                    ANALYZE,
 		"phyplan":                    PHYPLAN,
@@ -42,7 +47,7 @@ func init() {
 		"avg_row_length":             AVG_ROW_LENGTH,
 		"avg":                        AVG,
 		"bsi":                        BSI,
-		"before":                     UNUSED,
+		"before":                     BEFORE,
 		"begin":                      BEGIN,
 		"between":                    BETWEEN,
 		"bigint":                     BIGINT,
@@ -65,6 +70,8 @@ func init() {
 		"cascade":                    CASCADE,
 		"case":                       CASE,
 		"cast":                       CAST,
+		"preserve":                   PRESERVE,
+		"schedule":                   SCHEDULE,
 		"serial_extract":             SERIAL_EXTRACT,
 		"change":                     CHANGE,
 		"char":                       CHAR,
@@ -79,6 +86,12 @@ func init() {
 		"collation":                  COLLATION,
 		"column":                     COLUMN,
 		"columns":                    COLUMNS,
+		"json_table":                 JSON_TABLE,
+		"ordinality":                 ORDINALITY,
+		"nested":                     NESTED,
+		"path":                       PATH,
+		"error":                      ERROR,
+		"empty":                      EMPTY,
 		"column_format":              COLUMN_FORMAT,
 		"engine_attribute":           ENGINE_ATTRIBUTE,
 		"secondary_engine_attribute": SECONDARY_ENGINE_ATTRIBUTE,
@@ -101,6 +114,7 @@ func init() {
 		"chain":                      CHAIN,
 		"client":                     CLIENT,
 		"san":                        SAN,
+		"starts":                     STARTS,
 		"strict":                     STRICT,
 		"substr":                     SUBSTR,
 		"substring":                  SUBSTRING,
@@ -161,7 +175,7 @@ func init() {
 		"duplicate":                  DUPLICATE,
 		"delay_key_write":            DELAY_KEY_WRITE,
 		"drainer":                    DRAINER,
-		"each":                       UNUSED,
+		"each":                       EACH,
 		"else":                       ELSE,
 		"elseif":                     ELSEIF,
 		"enclosed":                   ENCLOSED,
@@ -179,6 +193,7 @@ func init() {
 		"extended":                   EXTENDED,
 		"expire":                     EXPIRE,
 		"except":                     EXCEPT,
+		"exchange":                   EXCHANGE,
 		"execute":                    EXECUTE,
 		"errors":                     ERRORS,
 		"event":                      EVENT,
@@ -202,7 +217,7 @@ func init() {
 		"fields":                     FIELDS,
 		"file":                       FILE,
 		"fixed":                      FIXED,
-		"generated":                  UNUSED,
+		"generated":                  GENERATED,
 		"geometry":                   GEOMETRY,
 		"geometrycollection":         GEOMETRYCOLLECTION,
 		"get":                        UNUSED,
@@ -255,6 +270,7 @@ func init() {
 		"kill":                       KILL,
 		"language":                   LANGUAGE,
 		"last":                       LAST,
+		"lateral":                    LATERAL,
 		"leading":                    LEADING,
 		"leave":                      LEAVE,
 		"left":                       LEFT,
@@ -278,6 +294,7 @@ func init() {
 		"localtimestamp":             LOCALTIMESTAMP,
 		"lock":                       LOCK,
 		"locks":                      LOCKS,
+		"locked":                     LOCKED,
 		"long":                       UNUSED,
 		"longblob":                   LONGBLOB,
 		"longtext":                   LONGTEXT,
@@ -316,6 +333,7 @@ func init() {
 		"not":                        NOT,
 		"no":                         NO,
 		"node":                       NODE,
+		"nowait":                     NOWAIT,
 		"no_write_to_binlog":         UNUSED,
 		"null":                       NULL,
 		"nulls":                      NULLS,
@@ -343,11 +361,14 @@ func init() {
 		"parallel":                   PARALLEL,
 		"max_file_size":              MAX_FILE_SIZE,
 		"force_quote":                FORCE_QUOTE,
+		"row_group_size":             ROW_GROUP_SIZE,
+		"savepoint":                  SAVEPOINT,
 		"external":                   EXTERNAL,
 		"url":                        URL,
 		"pause":                      PAUSE,
 		"parser":                     PARSER,
 		"partition":                  PARTITION,
+		"partitioning":               PARTITIONING,
 		"partitions":                 PARTITIONS,
 		"partial":                    PARTIAL,
 		"password":                   PASSWORD,
@@ -380,6 +401,7 @@ func init() {
 		"release":                    RELEASE,
 		"rename":                     RENAME,
 		"reorganize":                 REORGANIZE,
+		"remove":                     REMOVE,
 		"repair":                     REPAIR,
 		"repeat":                     REPEAT,
 		"repeatable":                 REPEATABLE,
@@ -420,6 +442,7 @@ func init() {
 		"signal":                     UNUSED,
 		"signed":                     SIGNED,
 		"simple":                     SIMPLE,
+		"skip":                       SKIP,
 		"smallint":                   SMALLINT,
 		"spatial":                    SPATIAL,
 		"specific":                   UNUSED,
@@ -442,7 +465,7 @@ func init() {
 		"stats_auto_recalc":          STATS_AUTO_RECALC,
 		"stats_persistent":           STATS_PERSISTENT,
 		"stats_sample_pages":         STATS_SAMPLE_PAGES,
-		"stored":                     UNUSED,
+		"stored":                     STORED,
 		"storage":                    STORAGE,
 		"straight_join":              STRAIGHT_JOIN,
 		"stream":                     STREAM,
@@ -468,6 +491,8 @@ func init() {
 		"trailing":                   TRAILING,
 		"transaction":                TRANSACTION,
 		"trigger":                    TRIGGER,
+		"follows":                    FOLLOWS,
+		"precedes":                   PRECEDES,
 		"triggers":                   TRIGGERS,
 		"true":                       TRUE,
 		"truncate":                   TRUNCATE,
@@ -493,7 +518,7 @@ func init() {
 		"varchar":                    VARCHAR,
 		"varcharacter":               UNUSED,
 		"varying":                    UNUSED,
-		"virtual":                    UNUSED,
+		"virtual":                    VIRTUAL,
 		"view":                       VIEW,
 		"visible":                    VISIBLE,
 		"week":                       WEEK,
@@ -566,6 +591,14 @@ func init() {
 		"prepare":                    PREPARE,
 		"deallocate":                 DEALLOCATE,
 		"dense_rank":                 DENSE_RANK,
+		"percent_rank":               PERCENT_RANK,
+		"cume_dist":                  CUME_DIST,
+		"ntile":                      NTILE,
+		"lag":                        LAG,
+		"lead":                       LEAD,
+		"first_value":                FIRST_VALUE,
+		"last_value":                 LAST_VALUE,
+		"nth_value":                  NTH_VALUE,
 		"reset":                      RESET,
 		"intersect":                  INTERSECT,
 		"minus":                      MINUS,
@@ -114,13 +114,23 @@ type Lexer struct {
 	stmts      []tree.Statement
 	paramIndex int
 	lower      int64
+	// eventBodyPos is the offset just after the first DO of the current
+	// statement, it is used to keep the original text of an event body.
+	eventBodyPos int
+	// triggerBodyPos is the offset just after the first FOR EACH ROW of the
+	// current statement, it is used to keep the original text of a trigger
+	// body.
+	triggerBodyPos int
+	lastTyp        int
 }
 
 func NewLexer(dialectType dialect.DialectType, sql string, lower int64) *Lexer {
 	return &Lexer{
-		scanner:    NewScanner(dialectType, sql),
-		paramIndex: 0,
-		lower:      lower,
+		scanner:        NewScanner(dialectType, sql),
+		paramIndex:     0,
+		lower:          lower,
+		eventBodyPos:   -1,
+		triggerBodyPos: -1,
 	}
 }
 
@@ -129,6 +139,8 @@ func (l *Lexer) setScanner(s *Scanner, lower int64) {
 	l.stmts = nil
 	l.paramIndex = 0
 	l.lower = lower
+	l.eventBodyPos = -1
+	l.triggerBodyPos = -1
 }
 
 func (l *Lexer) GetParamIndex() int {
@@ -145,7 +157,16 @@ func (l *Lexer) Lex(lval *yySymType) int {
 		return l.toInt(lval, str)
 	case FLOAT:
 		return l.toFloat(lval, str)
+	case DO:
+		if l.eventBodyPos < 0 {
+			l.eventBodyPos = l.scanner.Pos
+		}
+	case ROW:
+		if l.lastTyp == EACH && l.triggerBodyPos < 0 {
+			l.triggerBodyPos = l.scanner.Pos
+		}
 	}
+	l.lastTyp = typ
 
 	lval.str = str
 	return typ
@@ -177,6 +198,36 @@ func (l *Lexer) Error(err string) {
 
 func (l *Lexer) AppendStmt(stmt tree.Statement) {
 	l.stmts = append(l.stmts, stmt)
+	l.eventBodyPos = -1
+	l.triggerBodyPos = -1
+}
+
+// EventBody returns the original text after the DO of an event statement.
+// The parser may have read the ';' which ends the statement as lookahead,
+// so it is trimmed.
+func (l *Lexer) EventBody() string {
+	return l.bodyFrom(l.eventBodyPos)
+}
+
+// TriggerBody returns the original text after the FOR EACH ROW and the
+// optional FOLLOWS or PRECEDES clause of a trigger statement.
+func (l *Lexer) TriggerBody() string {
+	body := l.bodyFrom(l.triggerBodyPos)
+	s := NewScanner(l.scanner.dialectType, body)
+	if typ, _ := s.Scan(); typ == FOLLOWS || typ == PRECEDES {
+		s.Scan()
+		body = strings.TrimSpace(body[s.Pos:])
+	}
+	return body
+}
+
+func (l *Lexer) bodyFrom(pos int) string {
+	if pos < 0 || pos > l.scanner.Pos {
+		return ""
+	}
+	body := strings.TrimSpace(l.scanner.buf[pos:l.scanner.Pos])
+	body = strings.TrimSuffix(body, ";")
+	return strings.TrimSpace(body)
 }
 
 func (l *Lexer) toInt(lval *yySymType, str string) int {
@@ -72,6 +72,15 @@ import (
     funcReturn *tree.ReturnType
 
     procName *tree.ProcedureName
+    eventName *tree.EventName
+    eventSchedule *tree.EventSchedule
+    eventCompletion tree.EventCompletion
+    eventStatus tree.EventStatus
+    alterEvent *tree.AlterEvent
+    triggerName *tree.TriggerName
+    triggerTime tree.TriggerTime
+    triggerEvent tree.TriggerEvent
+    triggerOrder *tree.TriggerOrder
     procArg tree.ProcedureArg
     procArgs tree.ProcedureArgs
     procArgDecl *tree.ProcedureArgDecl
@@ -118,6 +127,7 @@ import (
     createOption tree.CreateOption
     createOptions []tree.CreateOption
     indexType tree.IndexType
+    fullTextSearchType tree.FullTextSearchType
     indexCategory tree.IndexCategory
     keyParts []*tree.KeyPart
     keyPart *tree.KeyPart
@@ -263,10 +273,14 @@ import (
     snapshotObject tree.ObjectInfo
     allCDCOption   *tree.AllOrNotCDC
 
+    jsonTableColumn    *tree.JsonTableColumn
+    jsonTableColumns   []*tree.JsonTableColumn
+    jsonTableResponse  *tree.JsonTableResponse
+
 }
 
 %token LEX_ERROR
-%nonassoc EMPTY
+%nonassoc <str> EMPTY
 %left <str> UNION EXCEPT INTERSECT MINUS
 %nonassoc LOWER_THAN_ORDER
 %nonassoc ORDER
@@ -278,8 +292,19 @@ import (
 %token <str> INSTANT INPLACE COPY DISABLE ENABLE UNDEFINED MERGE TEMPTABLE DEFINER INVOKER SQL SECURITY CASCADED
 %token <str> VALUES
 %token <str> NEXT VALUE SHARE MODE
+%token <str> NOWAIT SKIP LOCKED
+
+// Event
+%token <str> SCHEDULE COMPLETION PRESERVE EVERY STARTS ENDS AT
+
+// Trigger
+%token <str> BEFORE EACH FOLLOWS PRECEDES
+
+// Generated column
+%token <str> GENERATED ALWAYS STORED VIRTUAL
 %token <str> SQL_NO_CACHE SQL_CACHE
 %left <str> JOIN STRAIGHT_JOIN LEFT RIGHT INNER OUTER CROSS NATURAL USE FORCE CROSS_L2 APPLY
+%token <str> LATERAL
 %nonassoc LOWER_THAN_ON
 %nonassoc <str> ON USING
 %left <str> SUBQUERY_AS_EXPR
@@ -316,7 +341,7 @@ import (
 
 // Transaction
 %token <str> BEGIN START TRANSACTION COMMIT ROLLBACK WORK CONSISTENT SNAPSHOT
-%token <str> CHAIN NO RELEASE PRIORITY QUICK
+%token <str> CHAIN NO RELEASE PRIORITY QUICK SAVEPOINT
 
 // Type
 %token <str> BIT TINYINT SMALLINT MEDIUMINT INT INTEGER BIGINT INTNUM
@@ -336,7 +361,7 @@ import (
 %token <str> CREATE ALTER DROP RENAME ANALYZE PHYPLAN ADD RETURNS
 %token <str> SCHEMA TABLE SEQUENCE INDEX VIEW TO IGNORE IF PRIMARY COLUMN CONSTRAINT SPATIAL FULLTEXT FOREIGN KEY_BLOCK_SIZE
 %token <str> SHOW DESCRIBE EXPLAIN DATE ESCAPE REPAIR OPTIMIZE TRUNCATE
-%token <str> MAXVALUE PARTITION REORGANIZE LESS THAN PROCEDURE TRIGGER
+%token <str> MAXVALUE PARTITION REORGANIZE EXCHANGE REMOVE PARTITIONING LESS THAN PROCEDURE TRIGGER
 %token <str> STATUS VARIABLES ROLE PROXY AVG_ROW_LENGTH STORAGE DISK MEMORY
 %token <str> CHECKSUM COMPRESSION DATA DIRECTORY DELAY_KEY_WRITE ENCRYPTION ENGINE
 %token <str> MAX_ROWS MIN_ROWS PACK_KEYS ROW_FORMAT STATS_AUTO_RECALC STATS_PERSISTENT STATS_SAMPLE_PAGES
@@ -438,6 +463,7 @@ import (
 %token <str> STDDEV_POP STDDEV_SAMP SUBDATE SUBSTR SUBSTRING SUM SYSDATE
 %token <str> SYSTEM_USER TRANSLATE TRIM VARIANCE VAR_POP VAR_SAMP AVG RANK ROW_NUMBER
 %token <str> DENSE_RANK BIT_CAST
+%token <str> PERCENT_RANK CUME_DIST NTILE LAG LEAD FIRST_VALUE LAST_VALUE NTH_VALUE
 %token <str> BITMAP_BIT_POSITION BITMAP_BUCKET_NUMBER BITMAP_COUNT BITMAP_CONSTRUCT_AGG BITMAP_OR_AGG
 
 // Sequence function
@@ -445,9 +471,10 @@ import (
 
 //JSON function
 %token <str> ARROW
+%token <str> JSON_TABLE ORDINALITY NESTED PATH ERROR
 
 // Insert
-%token <str> ROW OUTFILE HEADER MAX_FILE_SIZE FORCE_QUOTE PARALLEL STRICT
+%token <str> ROW OUTFILE HEADER MAX_FILE_SIZE FORCE_QUOTE PARALLEL STRICT ROW_GROUP_SIZE
 
 %token <str> UNUSED BINDINGS
 
@@ -505,7 +532,7 @@ import (
 %type <statement> show_variables_stmt show_status_stmt show_index_stmt
 %type <statement> show_servers_stmt show_connectors_stmt
 %type <statement> alter_account_stmt alter_user_stmt alter_view_stmt update_stmt use_stmt update_no_with_stmt alter_database_config_stmt alter_table_stmt rename_stmt
-%type <statement> transaction_stmt begin_stmt commit_stmt rollback_stmt
+%type <statement> transaction_stmt begin_stmt commit_stmt rollback_stmt savepoint_stmt release_savepoint_stmt
 %type <statement> explain_stmt explainable_stmt
 %type <statement> set_stmt set_variable_stmt set_password_stmt set_role_stmt set_default_role_stmt set_transaction_stmt set_connection_id_stmt
 %type <statement> lock_stmt lock_table_stmt unlock_table_stmt
@@ -528,7 +555,7 @@ import (
 %type <exportParm> export_data_param_opt
 %type <loadParam> load_param_opt load_param_opt_2
 %type <tailParam> tail_param_opt
-%type <str> json_type_opt
+%type <str> json_type_opt export_format_opt export_compression_opt
 
 // case statement
 %type <statement> case_stmt
@@ -581,6 +608,20 @@ import (
 %type <str> func_lang extension_lang extension_name
 
 %type <procName> proc_name
+%type <eventName> event_name
+%type <eventSchedule> event_schedule
+%type <eventCompletion> event_completion_opt event_preserve
+%type <eventStatus> event_status_opt
+%type <alterEvent> alter_event_tail alter_event_rest
+%type <eventName> event_rename_opt
+%type <strs> alter_event_comment_opt
+%type <expr> event_starts_opt event_ends_opt
+%type <statement> create_event_stmt alter_event_stmt drop_event_stmt event_body_opt
+%type <triggerName> trigger_name
+%type <triggerTime> trigger_time
+%type <triggerEvent> trigger_event
+%type <triggerOrder> trigger_order_opt
+%type <statement> create_trigger_stmt drop_trigger_stmt trigger_body
 %type <procArgs> proc_args_list_opt proc_args_list
 %type <procArg> proc_arg
 %type <procArgDecl> proc_arg_decl
@@ -600,7 +641,7 @@ import (
 %type <tableOptions> table_option_list_opt table_option_list source_option_list_opt source_option_list
 %type <str> charset_name storage_opt collate_name column_format storage_media algorithm_type able_type space_type lock_type with_type rename_type algorithm_type_2 load_charset
 %type <rowFormatType> row_format_options
-%type <int64Val> field_length_opt max_file_size_opt
+%type <int64Val> field_length_opt max_file_size_opt row_group_size_opt
 %type <matchType> match match_opt
 %type <referenceOptionType> ref_opt on_delete on_update
 %type <referenceOnRecord> on_delete_update_opt
@@ -640,6 +681,9 @@ import (
 %type <str> backup_type_opt backup_timestamp_opt
 %type <str> not_keyword func_not_keyword
 %type <str> non_reserved_keyword
+%type <jsonTableColumn> json_table_column json_table_on_opt
+%type <jsonTableColumns> json_table_columns json_table_column_list
+%type <jsonTableResponse> json_table_response
 %type <str> equal_opt column_keyword_opt
 %type <str> as_opt_id name_string
 %type <cstr> ident as_name_opt db_name_ident
@@ -670,6 +714,7 @@ import (
 %type <sourceOptional> replace_opt
 %type <str> database_or_schema
 %type <indexType> using_opt
+%type <fullTextSearchType> fulltext_search_opt
 %type <indexCategory> index_prefix
 %type <keyParts> index_column_list index_column_list_opt
 %type <keyPart> index_column
@@ -688,7 +733,7 @@ import (
 %type <updateExprs> update_list on_duplicate_key_update_opt
 %type <completionType> completion_type
 %type <str> password_opt
-%type <boolVal> grant_option_opt enforce enforce_opt
+%type <boolVal> grant_option_opt enforce enforce_opt generated_column_type_opt
 
 %type <varAssignmentExpr> var_assignment
 %type <varAssignmentExprs> var_assignment_list
@@ -712,7 +757,7 @@ import (
 %type <frameType> frame_type
 %type <str> fields_or_columns
 %type <int64Val> algorithm_opt partition_num_opt sub_partition_num_opt opt_retry pitr_value
-%type <boolVal> linear_opt
+%type <boolVal> linear_opt without_validation_opt
 %type <partition> partition
 %type <partitions> partition_list_opt partition_list
 %type <values> values_opt
@@ -2676,12 +2721,42 @@ transaction_stmt:
     begin_stmt
 |   commit_stmt
 |   rollback_stmt
+|   savepoint_stmt
+|   release_savepoint_stmt
 
 rollback_stmt:
     ROLLBACK completion_type
     {
         $$ = &tree.RollbackTransaction{Type: $2}
     }
+|   ROLLBACK TO ident
+    {
+        $$ = tree.NewRollbackToSavepoint(tree.Identifier($3.Compare()))
+    }
+|   ROLLBACK TO SAVEPOINT ident
+    {
+        $$ = tree.NewRollbackToSavepoint(tree.Identifier($4.Compare()))
+    }
+|   ROLLBACK WORK TO ident
+    {
+        $$ = tree.NewRollbackToSavepoint(tree.Identifier($4.Compare()))
+    }
+|   ROLLBACK WORK TO SAVEPOINT ident
+    {
+        $$ = tree.NewRollbackToSavepoint(tree.Identifier($5.Compare()))
+    }
+
+savepoint_stmt:
+    SAVEPOINT ident
+    {
+        $$ = tree.NewSavepoint(tree.Identifier($2.Compare()))
+    }
+
+release_savepoint_stmt:
+    RELEASE SAVEPOINT ident
+    {
+        $$ = tree.NewReleaseSavepoint(tree.Identifier($3.Compare()))
+    }
 
 commit_stmt:
     COMMIT completion_type
@@ -3207,6 +3282,7 @@ alter_stmt:
 |   alter_stage_stmt
 |   alter_sequence_stmt
 |   alter_pitr_stmt
+|   alter_event_stmt
 |   rename_stmt
 // |    alter_ddl_stmt
 
@@ -3347,6 +3423,21 @@ partition_option:
 	    )
         $$ = tree.AlterPartitionOption(opt)
     }
+|   ADD PARTITION PARTITIONS INTEGRAL
+    {
+        var typ = tree.AlterPartitionAddPartition
+        res := $4.(int64)
+        if res == 0 {
+            yylex.Error("partitions can not be 0")
+            goto ret1
+        }
+        opt := tree.NewAlterPartitionAddPartitionClause(
+            typ,
+            nil,
+        )
+        opt.PartitionNum = uint64(res)
+        $$ = tree.AlterPartitionOption(opt)
+    }
 |    DROP PARTITION AllOrPartitionNameList
     {
         var typ = tree.AlterPartitionDropPartition
@@ -3379,6 +3470,60 @@ partition_option:
         }
         $$ = tree.AlterPartitionOption(opt)
     }
+|   REORGANIZE PARTITION PartitionNameList INTO '(' partition_list ')'
+    {
+        var typ = tree.AlterPartitionReorganizePartition
+        opt := tree.NewAlterPartitionReorganizePartitionClause(
+            typ,
+            $3,
+            $6,
+        )
+        $$ = tree.AlterPartitionOption(opt)
+    }
+|   COALESCE PARTITION INTEGRAL
+    {
+        var typ = tree.AlterPartitionCoalescePartition
+        res := $3.(int64)
+        if res == 0 {
+            yylex.Error("partitions can not be 0")
+            goto ret1
+        }
+        opt := tree.NewAlterPartitionCoalescePartitionClause(
+            typ,
+            uint64(res),
+        )
+        $$ = tree.AlterPartitionOption(opt)
+    }
+|   EXCHANGE PARTITION ident WITH TABLE table_name without_validation_opt
+    {
+        var typ = tree.AlterPartitionExchangePartition
+        opt := tree.NewAlterPartitionExchangePartitionClause(
+            typ,
+            tree.Identifier($3.Compare()),
+            $6,
+            $7,
+        )
+        $$ = tree.AlterPartitionOption(opt)
+    }
+|   REMOVE PARTITIONING
+    {
+        var typ = tree.AlterPartitionRemovePartitioning
+        opt := tree.NewAlterPartitionRemovePartitioningClause(typ)
+        $$ = tree.AlterPartitionOption(opt)
+    }
+
+without_validation_opt:
+    {
+        $$ = false
+    }
+|   WITH VALIDATION
+    {
+        $$ = false
+    }
+|   WITHOUT VALIDATION
+    {
+        $$ = true
+    }
 
 AllOrPartitionNameList:
 	ALL
@@ -3648,6 +3793,12 @@ alter_table_drop:
         $$ = tree.NewAlterOptionDrop(dropType, name);
 
     }
+|   CHECK ident
+    {
+        var dropType = tree.AlterTableDropCheck;
+        var name = tree.Identifier($2.Compare());
+        $$ = tree.NewAlterOptionDrop(dropType, name);
+    }
 |   CONSTRAINT ident
         {
             $$ = &tree.AlterOptionDrop{
@@ -4482,6 +4633,8 @@ drop_ddl_stmt:
 |   drop_sequence_stmt
 |   drop_publication_stmt
 |   drop_procedure_stmt
+|   drop_event_stmt
+|   drop_trigger_stmt
 |   drop_stage_stmt
 |   drop_connector_stmt
 |   drop_snapshot_stmt
@@ -4624,6 +4777,24 @@ drop_procedure_stmt:
         $$ = tree.NewDropProcedure(name, ifExists)
     }
 
+drop_event_stmt:
+    DROP EVENT exists_opt event_name
+    {
+        $$ = &tree.DropEvent{
+            IfExists: $3,
+            Name: $4,
+        }
+    }
+
+drop_trigger_stmt:
+    DROP TRIGGER exists_opt trigger_name
+    {
+        $$ = &tree.DropTrigger{
+            IfExists: $3,
+            Name: $4,
+        }
+    }
+
 delete_stmt:
     delete_without_using_stmt
 |    delete_with_using_stmt
@@ -5004,7 +5175,7 @@ export_data_param_opt:
     {
         $$ = nil
     }
-|   INTO OUTFILE STRING export_fields export_lines_opt header_opt max_file_size_opt force_quote_opt
+|   INTO OUTFILE STRING export_fields export_lines_opt header_opt max_file_size_opt force_quote_opt export_format_opt row_group_size_opt export_compression_opt
     {
         $$ = &tree.ExportParam{
             Outfile:    true,
@@ -5014,9 +5185,39 @@ export_data_param_opt:
             Header:     $6,
             MaxFileSize:uint64($7)*1024,
             ForceQuote: $8,
+            FileFormat: $9,
+            RowGroupSize: uint64($10),
+            Compression: $11,
         }
     }
 
+export_format_opt:
+    {
+        $$ = tree.CSV
+    }
+|   FORMAT ident
+    {
+        $$ = strings.ToLower($2.Compare())
+    }
+
+row_group_size_opt:
+    {
+        $$ = 0
+    }
+|   ROW_GROUP_SIZE INTEGRAL
+    {
+        $$ = $2.(int64)
+    }
+
+export_compression_opt:
+    {
+        $$ = ""
+    }
+|   COMPRESSION STRING
+    {
+        $$ = strings.ToLower($2)
+    }
+
 export_fields:
     {
         $$ = &tree.Fields{
@@ -5408,6 +5609,36 @@ select_lock_opt:
             LockType:tree.SelectLockForUpdate,
         }
     }
+|   FOR UPDATE NOWAIT
+    {
+        $$ = &tree.SelectLockInfo{
+            LockType:tree.SelectLockForUpdateNoWait,
+        }
+    }
+|   FOR UPDATE SKIP LOCKED
+    {
+        $$ = &tree.SelectLockInfo{
+            LockType:tree.SelectLockForUpdateSkipLocked,
+        }
+    }
+|   FOR SHARE
+    {
+        $$ = &tree.SelectLockInfo{
+            LockType:tree.SelectLockForShare,
+        }
+    }
+|   FOR SHARE NOWAIT
+    {
+        $$ = &tree.SelectLockInfo{
+            LockType:tree.SelectLockForShareNoWait,
+        }
+    }
+|   FOR SHARE SKIP LOCKED
+    {
+        $$ = &tree.SelectLockInfo{
+            LockType:tree.SelectLockForShareSkipLocked,
+        }
+    }
 
 select_with_parens:
     '(' select_no_parens ')'
@@ -5957,6 +6188,27 @@ table_factor:
             $$ = $1
         }
     }
+|   LATERAL table_subquery as_opt_id column_list_opt
+    {
+        $$ = &tree.AliasedTableExpr{
+            Expr: $2,
+            As: tree.AliasClause{
+                Alias: tree.Identifier($3),
+                Cols: $4,
+            },
+            Lateral: true,
+        }
+    }
+|   LATERAL table_function as_opt_id
+    {
+        $$ = &tree.AliasedTableExpr{
+            Expr: $2,
+            As: tree.AliasClause{
+                Alias: tree.Identifier($3),
+            },
+            Lateral: true,
+        }
+    }
 |   '(' table_references ')'
 	{
 		$$ = $2
@@ -5981,6 +6233,108 @@ table_function:
                 },
         }
     }
+|   JSON_TABLE '(' expression ',' STRING json_table_columns ')'
+    {
+        name := tree.NewUnresolvedName(tree.NewCStr($1, 1))
+        $$ = &tree.TableFunction{
+            Func: &tree.FuncExpr{
+                Func: tree.FuncName2ResolvableFunctionReference(name),
+                FuncName: tree.NewCStr($1, 1),
+                Exprs: tree.Exprs{$3, tree.NewNumVal($5, $5, false, tree.P_char)},
+                Type: tree.FUNC_TYPE_TABLE,
+            },
+            JsonColumns: $6,
+        }
+    }
+
+json_table_columns:
+    COLUMNS '(' json_table_column_list ')'
+    {
+        $$ = $3
+    }
+
+json_table_column_list:
+    json_table_column
+    {
+        $$ = []*tree.JsonTableColumn{$1}
+    }
+|   json_table_column_list ',' json_table_column
+    {
+        $$ = append($1, $3)
+    }
+
+json_table_column:
+    ident FOR ORDINALITY
+    {
+        $$ = &tree.JsonTableColumn{
+            Kind: tree.JSON_TABLE_COLUMN_ORDINALITY,
+            Name: tree.Identifier($1.Compare()),
+        }
+    }
+|   ident column_type PATH STRING json_table_on_opt
+    {
+        $$ = $5
+        $$.Kind = tree.JSON_TABLE_COLUMN_PATH
+        $$.Name = tree.Identifier($1.Compare())
+        $$.Type = $2
+        $$.Path = $4
+    }
+|   ident column_type EXISTS PATH STRING
+    {
+        $$ = &tree.JsonTableColumn{
+            Kind: tree.JSON_TABLE_COLUMN_EXISTS,
+            Name: tree.Identifier($1.Compare()),
+            Type: $2,
+            Path: $5,
+        }
+    }
+|   NESTED PATH STRING json_table_columns
+    {
+        $$ = &tree.JsonTableColumn{
+            Kind: tree.JSON_TABLE_COLUMN_NESTED,
+            Path: $3,
+            Columns: $4,
+        }
+    }
+|   NESTED STRING json_table_columns
+    {
+        $$ = &tree.JsonTableColumn{
+            Kind: tree.JSON_TABLE_COLUMN_NESTED,
+            Path: $2,
+            Columns: $3,
+        }
+    }
+
+json_table_on_opt:
+    {
+        $$ = &tree.JsonTableColumn{}
+    }
+|   json_table_response ON EMPTY
+    {
+        $$ = &tree.JsonTableColumn{OnEmpty: $1}
+    }
+|   json_table_response ON ERROR
+    {
+        $$ = &tree.JsonTableColumn{OnError: $1}
+    }
+|   json_table_response ON EMPTY json_table_response ON ERROR
+    {
+        $$ = &tree.JsonTableColumn{OnEmpty: $1, OnError: $4}
+    }
+
+json_table_response:
+    NULL
+    {
+        $$ = &tree.JsonTableResponse{Kind: tree.JSON_TABLE_RESPONSE_NULL}
+    }
+|   ERROR
+    {
+        $$ = &tree.JsonTableResponse{Kind: tree.JSON_TABLE_RESPONSE_ERROR}
+    }
+|   DEFAULT STRING
+    {
+        $$ = &tree.JsonTableResponse{Kind: tree.JSON_TABLE_RESPONSE_DEFAULT, Default: $2}
+    }
 
 aliased_table_name:
     table_name as_opt_id index_hint_list_opt
@@ -6152,6 +6506,8 @@ create_ddl_stmt:
 |   create_extension_stmt
 |   create_sequence_stmt
 |   create_procedure_stmt
+|   create_event_stmt
+|   create_trigger_stmt
 |   create_source_stmt
 |   create_connector_stmt
 |   pause_daemon_task_stmt
@@ -6196,6 +6552,277 @@ create_procedure_stmt:
         )
     }
 
+create_event_stmt:
+    CREATE EVENT not_exists_opt event_name ON SCHEDULE event_schedule event_completion_opt event_status_opt comment_opt DO normal_stmt
+    {
+        $$ = &tree.CreateEvent{
+            IfNotExists: $3,
+            Name: $4,
+            Schedule: $7,
+            OnCompletion: $8,
+            Status: $9,
+            Comment: $10,
+            Body: $12,
+            BodySQL: yylex.(*Lexer).EventBody(),
+        }
+    }
+|   CREATE DEFINER '=' user_name EVENT not_exists_opt event_name ON SCHEDULE event_schedule event_completion_opt event_status_opt comment_opt DO normal_stmt
+    {
+        $$ = &tree.CreateEvent{
+            Definer: $4,
+            IfNotExists: $6,
+            Name: $7,
+            Schedule: $10,
+            OnCompletion: $11,
+            Status: $12,
+            Comment: $13,
+            Body: $15,
+            BodySQL: yylex.(*Lexer).EventBody(),
+        }
+    }
+
+alter_event_stmt:
+    ALTER EVENT event_name alter_event_tail
+    {
+        $4.Name = $3
+        $$ = $4
+    }
+|   ALTER DEFINER '=' user_name EVENT event_name alter_event_tail
+    {
+        $7.Definer = $4
+        $7.Name = $6
+        $$ = $7
+    }
+
+alter_event_tail:
+    alter_event_rest
+|   ON SCHEDULE event_schedule alter_event_rest
+    {
+        $4.Schedule = $3
+        $$ = $4
+    }
+|   ON SCHEDULE event_schedule ON COMPLETION event_preserve alter_event_rest
+    {
+        $7.Schedule = $3
+        $7.OnCompletion = $6
+        $$ = $7
+    }
+|   ON COMPLETION event_preserve alter_event_rest
+    {
+        $4.OnCompletion = $3
+        $$ = $4
+    }
+
+alter_event_rest:
+    event_rename_opt event_status_opt alter_event_comment_opt event_body_opt
+    {
+        $$ = &tree.AlterEvent{
+            Rename: $1,
+            Status: $2,
+            Body: $4,
+        }
+        if $4 != nil {
+            $$.BodySQL = yylex.(*Lexer).EventBody()
+        }
+        if len($3) > 0 {
+            $$.HasComment = true
+            $$.Comment = $3[0]
+        }
+    }
+
+event_rename_opt:
+    {
+        $$ = nil
+    }
+|   RENAME TO event_name
+    {
+        $$ = $3
+    }
+
+alter_event_comment_opt:
+    {
+        $$ = nil
+    }
+|   COMMENT_KEYWORD STRING
+    {
+        $$ = []string{$2}
+    }
+
+event_body_opt:
+    {
+        $$ = nil
+    }
+|   DO normal_stmt
+    {
+        $$ = $2
+    }
+
+event_schedule:
+    AT expression
+    {
+        $$ = &tree.EventSchedule{At: $2}
+    }
+|   EVERY expression time_unit event_starts_opt event_ends_opt
+    {
+        $$ = &tree.EventSchedule{
+            IntervalValue: $2,
+            IntervalUnit: strings.ToUpper($3),
+            Starts: $4,
+            Ends: $5,
+        }
+    }
+
+event_starts_opt:
+    {
+        $$ = nil
+    }
+|   STARTS expression
+    {
+        $$ = $2
+    }
+
+event_ends_opt:
+    {
+        $$ = nil
+    }
+|   ENDS expression
+    {
+        $$ = $2
+    }
+
+event_completion_opt:
+    {
+        $$ = tree.EventCompletionUnspecified
+    }
+|   ON COMPLETION event_preserve
+    {
+        $$ = $3
+    }
+
+event_preserve:
+    PRESERVE
+    {
+        $$ = tree.EventCompletionPreserve
+    }
+|   NOT PRESERVE
+    {
+        $$ = tree.EventCompletionNotPreserve
+    }
+
+event_status_opt:
+    {
+        $$ = tree.EventStatusUnspecified
+    }
+|   ENABLE
+    {
+        $$ = tree.EventStatusEnable
+    }
+|   DISABLE
+    {
+        $$ = tree.EventStatusDisable
+    }
+|   DISABLE ON SLAVE
+    {
+        $$ = tree.EventStatusDisable
+    }
+
+event_name:
+    ident
+    {
+        prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
+        $$ = tree.NewEventName(tree.Identifier($1.Compare()), prefix)
+    }
+|   ident '.' ident
+    {
+        dbName := yylex.(*Lexer).GetDbOrTblName($1.Origin())
+        prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(dbName), ExplicitSchema: true}
+        $$ = tree.NewEventName(tree.Identifier($3.Compare()), prefix)
+    }
+
+create_trigger_stmt:
+    CREATE TRIGGER not_exists_opt trigger_name trigger_time trigger_event ON table_name FOR EACH ROW trigger_order_opt trigger_body
+    {
+        $$ = &tree.CreateTrigger{
+            IfNotExists: $3,
+            Name: $4,
+            Time: $5,
+            Event: $6,
+            Table: $8,
+            Order: $12,
+            Body: $13,
+            BodySQL: yylex.(*Lexer).TriggerBody(),
+        }
+    }
+|   CREATE DEFINER '=' user_name TRIGGER not_exists_opt trigger_name trigger_time trigger_event ON table_name FOR EACH ROW trigger_order_opt trigger_body
+    {
+        $$ = &tree.CreateTrigger{
+            Definer: $4,
+            IfNotExists: $6,
+            Name: $7,
+            Time: $8,
+            Event: $9,
+            Table: $11,
+            Order: $15,
+            Body: $16,
+            BodySQL: yylex.(*Lexer).TriggerBody(),
+        }
+    }
+
+trigger_time:
+    BEFORE
+    {
+        $$ = tree.TriggerTimeBefore
+    }
+|   AFTER
+    {
+        $$ = tree.TriggerTimeAfter
+    }
+
+trigger_event:
+    INSERT
+    {
+        $$ = tree.TriggerEventInsert
+    }
+|   UPDATE
+    {
+        $$ = tree.TriggerEventUpdate
+    }
+|   DELETE
+    {
+        $$ = tree.TriggerEventDelete
+    }
+
+trigger_order_opt:
+    {
+        $$ = nil
+    }
+|   FOLLOWS ident
+    {
+        $$ = &tree.TriggerOrder{Follows: true, Other: tree.Identifier($2.Compare())}
+    }
+|   PRECEDES ident
+    {
+        $$ = &tree.TriggerOrder{Other: tree.Identifier($2.Compare())}
+    }
+
+trigger_body:
+    block_stmt
+|   if_stmt
+|   normal_stmt
+
+trigger_name:
+    ident
+    {
+        prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
+        $$ = tree.NewTriggerName(tree.Identifier($1.Compare()), prefix)
+    }
+|   ident '.' ident
+    {
+        dbName := yylex.(*Lexer).GetDbOrTblName($1.Origin())
+        prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(dbName), ExplicitSchema: true}
+        $$ = tree.NewTriggerName(tree.Identifier($3.Compare()), prefix)
+    }
+
 proc_name:
     ident
     {
@@ -6552,6 +7179,15 @@ account_identified:
             tree.NewParamExpr(yylex.(*Lexer).GetParamIndex()),
         )
     }
+|   IDENTIFIED WITH name_string BY STRING
+    {
+        ai := tree.NewAccountIdentified(
+            tree.AccountIdentifiedByPassword,
+            tree.NewNumVal($5, $5, false, tree.P_char),
+        )
+        ai.Plugin = strings.ToLower($3)
+        $$ = *ai
+    }
 
 account_status_option:
     {
@@ -7110,6 +7746,14 @@ user_identified:
         Str: tree.NewNumVal($3, $3, false, tree.P_char),
     }
     }
+|   IDENTIFIED WITH name_string BY STRING
+    {
+    $$ = &tree.AccountIdentified{
+        Typ: tree.AccountIdentifiedByPassword,
+        Str: tree.NewNumVal($5, $5, false, tree.P_char),
+        Plugin: strings.ToLower($3),
+    }
+    }
 
 name_string:
     ident
@@ -8792,6 +9436,8 @@ constaint_def:
                 v.ConstraintSymbol = $1
             case *tree.UniqueIndex:
                 v.ConstraintSymbol = $1
+            case *tree.CheckIndex:
+                v.Name = $1
             }
         }
         $$ = $2
@@ -8881,7 +9527,7 @@ constraint_elem:
 
 enforce_opt:
     {
-        $$ = false
+        $$ = true
     }
 |    enforce
 
@@ -9078,9 +9724,17 @@ column_attribute_elem:
     {
         $$ = $1
     }
+|   GENERATED ALWAYS AS '(' expression ')' generated_column_type_opt
+    {
+        $$ = tree.NewAttributeGeneratedAlways($5, $7)
+    }
+|   AS '(' expression ')' generated_column_type_opt
+    {
+        $$ = tree.NewAttributeGeneratedAlways($3, $5)
+    }
 |   constraint_keyword_opt CHECK '(' expression ')'
     {
-        $$ = tree.NewAttributeCheckConstraint($4, false, $1)
+        $$ = tree.NewAttributeCheckConstraint($4, true, $1)
     }
 |   constraint_keyword_opt CHECK '(' expression ')' enforce
     {
@@ -9135,6 +9789,19 @@ enforce:
         $$ = false
     }
 
+generated_column_type_opt:
+    {
+        $$ = false
+    }
+|   VIRTUAL
+    {
+        $$ = false
+    }
+|   STORED
+    {
+        $$ = true
+    }
+
 constraint_keyword_opt:
     {
         $$ = ""
@@ -9496,6 +10163,10 @@ simple_expr:
     {
         $$ = $1
     }
+|   MATCH '(' column_name_list ')' AGAINST '(' bit_expr fulltext_search_opt ')'
+    {
+        $$ = tree.NewFullTextMatchExpr($3, $7, $8)
+    }
 |   sample_function_expr
     {
         $$ = $1
@@ -9505,6 +10176,27 @@ simple_expr:
         $$ = $1
     }
 
+fulltext_search_opt:
+    {
+        $$ = tree.FULLTEXT_DEFAULT
+    }
+|   IN NATURAL LANGUAGE MODE
+    {
+        $$ = tree.FULLTEXT_NL
+    }
+|   IN NATURAL LANGUAGE MODE WITH QUERY EXPANSION
+    {
+        $$ = tree.FULLTEXT_NL_QUERY_EXPANSION
+    }
+|   IN BOOLEAN MODE
+    {
+        $$ = tree.FULLTEXT_BOOLEAN
+    }
+|   WITH QUERY EXPANSION
+    {
+        $$ = tree.FULLTEXT_QUERY_EXPANSION
+    }
+
 function_call_window:
 	RANK '(' ')' window_spec
     {
@@ -9533,6 +10225,84 @@ function_call_window:
             WindowSpec: $4,
         }
     }
+|	PERCENT_RANK '(' ')' window_spec
+    {
+        name := tree.NewUnresolvedColName($1)
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            FuncName: tree.NewCStr($1, 1),
+            WindowSpec: $4,
+        }
+    }
+|	CUME_DIST '(' ')' window_spec
+    {
+        name := tree.NewUnresolvedColName($1)
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            FuncName: tree.NewCStr($1, 1),
+            WindowSpec: $4,
+        }
+    }
+|	NTILE '(' expression_list ')' window_spec
+    {
+        name := tree.NewUnresolvedColName($1)
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            FuncName: tree.NewCStr($1, 1),
+            Exprs: $3,
+            WindowSpec: $5,
+        }
+    }
+|	LAG '(' expression_list ')' window_spec
+    {
+        name := tree.NewUnresolvedColName($1)
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            FuncName: tree.NewCStr($1, 1),
+            Exprs: $3,
+            WindowSpec: $5,
+        }
+    }
+|	LEAD '(' expression_list ')' window_spec
+    {
+        name := tree.NewUnresolvedColName($1)
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            FuncName: tree.NewCStr($1, 1),
+            Exprs: $3,
+            WindowSpec: $5,
+        }
+    }
+|	FIRST_VALUE '(' expression_list ')' window_spec
+    {
+        name := tree.NewUnresolvedColName($1)
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            FuncName: tree.NewCStr($1, 1),
+            Exprs: $3,
+            WindowSpec: $5,
+        }
+    }
+|	LAST_VALUE '(' expression_list ')' window_spec
+    {
+        name := tree.NewUnresolvedColName($1)
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            FuncName: tree.NewCStr($1, 1),
+            Exprs: $3,
+            WindowSpec: $5,
+        }
+    }
+|	NTH_VALUE '(' expression_list ')' window_spec
+    {
+        name := tree.NewUnresolvedColName($1)
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            FuncName: tree.NewCStr($1, 1),
+            Exprs: $3,
+            WindowSpec: $5,
+        }
+    }
 
 sample_function_expr:
     SAMPLE '(' '*' ',' INTEGRAL ROWS ')'
@@ -12175,6 +12945,7 @@ non_reserved_keyword:
 |   EXPANSION
 |   EXTENDED
 |   EXPIRE
+|   EXCHANGE
 |   ERRORS
 |   ENFORCED
 |	ENABLE
@@ -12206,6 +12977,22 @@ non_reserved_keyword:
 |   LONGBLOB
 |   LONGTEXT
 |   LOCAL
+|   LOCKED
+|   SCHEDULE
+|   COMPLETION
+|   PRESERVE
+|   EVERY
+|   STARTS
+|   ENDS
+|   AT
+|   BEFORE
+|   EACH
+|   FOLLOWS
+|   PRECEDES
+|   GENERATED
+|   ALWAYS
+|   STORED
+|   VIRTUAL
 |   LINEAR
 |   LIST
 |   MEDIUMBLOB
@@ -12228,6 +13015,7 @@ non_reserved_keyword:
 |   NUMERIC
 |   NEVER
 |   NO
+|   NOWAIT
 |   OFFSET
 |   ONLY
 |   OPTIMIZE
@@ -12235,6 +13023,7 @@ non_reserved_keyword:
 |   OPTION
 |   PACK_KEYS
 |   PARTIAL
+|   PARTITIONING
 |   PARTITIONS
 |   POINT
 |   POLYGON
@@ -12253,6 +13042,7 @@ non_reserved_keyword:
 |   REPAIR
 |   REPEATABLE
 |   RELEASE
+|   REMOVE
 |   RESUME
 |   REVOKE
 |   REPLICATION
@@ -12263,6 +13053,7 @@ non_reserved_keyword:
 |   SERIALIZABLE
 |   SHARE
 |   SIGNED
+|   SKIP
 |   SMALLINT
 |   SNAPSHOT
 |   PITR
@@ -12305,6 +13096,8 @@ non_reserved_keyword:
 |   HEADER
 |   MAX_FILE_SIZE
 |   FORCE_QUOTE
+|   ROW_GROUP_SIZE
+|   SAVEPOINT
 |   QUARTER
 |   UNKNOWN
 |   ANY
@@ -12469,6 +13262,11 @@ non_reserved_keyword:
 |	OWNERSHIP
 |   MO_TS
 |   ROLLUP
+|   ORDINALITY
+|   NESTED
+|   PATH
+|   ERROR
+|   EMPTY
 
 func_not_keyword:
     DATE_ADD
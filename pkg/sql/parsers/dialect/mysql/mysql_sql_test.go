@@ -166,6 +166,33 @@ var (
 	}, {
 		input:  "select rank() over(partition by a order by b desc) from t1",
 		output: "select rank() over (partition by a order by b desc) from t1",
+	}, {
+		input:  "select percent_rank() over (order by b), cume_dist() over (order by b) from t1",
+		output: "select percent_rank() over (order by b), cume_dist() over (order by b) from t1",
+	}, {
+		input:  "select ntile(4) over(partition by a order by b) from t1",
+		output: "select ntile(4) over (partition by a order by b) from t1",
+	}, {
+		input:  "select lag(b) over (order by b), lead(b, 2, 0) over (partition by a order by b) from t1",
+		output: "select lag(b) over (order by b), lead(b, 2, 0) over (partition by a order by b) from t1",
+	}, {
+		input:  "select first_value(b) over (order by b rows between 1 preceding and 1 following), last_value(b) over (order by b), nth_value(b, 2) over (order by b) from t1",
+		output: "select first_value(b) over (order by b rows between 1 preceding and 1 following), last_value(b) over (order by b), nth_value(b, 2) over (order by b) from t1",
+	}, {
+		input:  "select * from t1 where match(body, title) against('red apple')",
+		output: "select * from t1 where match(body, title) against(red apple)",
+	}, {
+		input:  "select a, match(t1.body) against('+red -apple' IN BOOLEAN MODE) as score from t1",
+		output: "select a, match(t1.body) against(+red -apple in boolean mode) as score from t1",
+	}, {
+		input:  "select * from t1 where match(body) against('apple' in natural language mode) order by match(body) against('apple' in natural language mode) desc",
+		output: "select * from t1 where match(body) against(apple in natural language mode) order by match(body) against(apple in natural language mode) desc",
+	}, {
+		input:  "select * from t1 where match(body) against('apple' with query expansion)",
+		output: "select * from t1 where match(body) against(apple with query expansion)",
+	}, {
+		input:  "create table t1 (a int primary key, body text, fulltext key ftidx (body) with parser ngram)",
+		output: "create table t1 (a int primary key, body text, fulltext ftidx (body)  with parser ngram )",
 	}, {
 		input:  "load data url s3option {\"bucket\"='dan-test1', \"filepath\"='ex_table_dan_gzip.gz',\"role_arn\"='arn:aws:iam::468413122987:role/dev-cross-s3', \"external_id\"='5404f91c_4e59_4898_85b3', \"compression\"='auto'} into table hx3.t2 fields terminated by ',' enclosed by '\\\"' lines terminated by '\\n';\n",
 		output: "load data url s3option {'bucket'='dan-test1', 'filepath'='ex_table_dan_gzip.gz', 'role_arn'='arn:aws:iam::468413122987:role/dev-cross-s3', 'external_id'='5404f91c_4e59_4898_85b3', 'compression'='auto'} into table hx3.t2 fields terminated by , enclosed by \" lines terminated by \n",
@@ -227,6 +254,81 @@ var (
 	}, {
 		input:  "SELECT * FROM kv WHERE k = 1 FOR UPDATE",
 		output: "select * from kv where k = 1 for update",
+	}, {
+		input:  "SELECT * FROM kv WHERE k = 1 FOR UPDATE NOWAIT",
+		output: "select * from kv where k = 1 for update nowait",
+	}, {
+		input:  "SELECT * FROM kv WHERE k > 1 LIMIT 10 FOR UPDATE SKIP LOCKED",
+		output: "select * from kv where k > 1 limit 10 for update skip locked",
+	}, {
+		input:  "SELECT * FROM kv WHERE k = 1 FOR SHARE",
+		output: "select * from kv where k = 1 for share",
+	}, {
+		input:  "SELECT * FROM kv WHERE k = 1 FOR SHARE NOWAIT",
+		output: "select * from kv where k = 1 for share nowait",
+	}, {
+		input:  "SELECT * FROM kv WHERE k = 1 FOR SHARE SKIP LOCKED",
+		output: "select * from kv where k = 1 for share skip locked",
+	}, {
+		input:  "select nowait, skip, locked from t1",
+		output: "select nowait, skip, locked from t1",
+	}, {
+		input:  "create event e1 on schedule every 1 day do delete from t1 where ts < now() - interval 30 day",
+		output: "create event e1 on schedule every 1 day do delete from t1 where ts < now() - interval(30, day)",
+	}, {
+		input:  "create definer = u1 event if not exists db1.e1 on schedule every 2 hour starts '2024-01-01 00:00:00' ends '2024-12-31 00:00:00' + interval 1 day on completion preserve disable comment 'purge' do insert into t2 select * from t1",
+		output: "create definer = u1@% event if not exists db1.e1 on schedule every 2 hour starts 2024-01-01 00:00:00 ends 2024-12-31 00:00:00 + interval(1, day) on completion preserve disable comment 'purge' do insert into t2 select * from t1",
+	}, {
+		input:  "create event e1 on schedule at current_timestamp + interval 1 hour on completion not preserve do update t1 set a = 1",
+		output: "create event e1 on schedule at current_timestamp() + interval(1, hour) on completion not preserve do update t1 set a = 1",
+	}, {
+		input:  "alter event e1 on schedule every 10 minute on completion preserve rename to e2 enable comment '' do delete from t1",
+		output: "alter event e1 on schedule every 10 minute on completion preserve rename to e2 enable comment '' do delete from t1",
+	}, {
+		input:  "alter event e1 disable",
+		output: "alter event e1 disable",
+	}, {
+		input:  "alter event db1.e1 on completion not preserve",
+		output: "alter event db1.e1 on completion not preserve",
+	}, {
+		input:  "drop event if exists db1.e1",
+		output: "drop event if exists db1.e1",
+	}, {
+		input:  "drop event e1",
+		output: "drop event e1",
+	}, {
+		input:  "select at, every, starts, ends, schedule, completion, preserve from t1",
+		output: "select at, every, starts, ends, schedule, completion, preserve from t1",
+	}, {
+		input:  "create trigger trg1 before insert on t1 for each row set new.b = new.a * 2",
+		output: "create trigger trg1 before insert on t1 for each row set new.b = new.a * 2",
+	}, {
+		input:  "create definer = u1 trigger if not exists db1.trg1 after update on db1.t1 for each row follows trg0 insert into log values (old.a, new.a)",
+		output: "create definer = u1@% trigger if not exists db1.trg1 after update on db1.t1 for each row follows trg0 insert into log values (old.a, new.a)",
+	}, {
+		input:  "create trigger trg1 before update on t1 for each row precedes trg0 if new.a < 0 then set new.a = 0; elseif new.a > 10 then set new.a = 10; end if",
+		output: "create trigger trg1 before update on t1 for each row precedes trg0 if new.a < 0 then set new.a = 0; elseif new.a > 10 then set new.a = 10; end if",
+	}, {
+		input:  "create trigger trg1 after delete on t1 for each row begin insert into log values (old.a); delete from t2 where a = old.a; end",
+		output: "create trigger trg1 after delete on t1 for each row begin insert into log values (old.a); delete from t2 where a = old.a; end",
+	}, {
+		input:  "drop trigger if exists db1.trg1",
+		output: "drop trigger if exists db1.trg1",
+	}, {
+		input:  "drop trigger trg1",
+		output: "drop trigger trg1",
+	}, {
+		input:  "select before, each, follows, precedes from t1",
+		output: "select before, each, follows, precedes from t1",
+	}, {
+		input:  "create table t1 (a int, b int generated always as (a + 1) stored, c varchar(10) as (concat(b, 'x')) virtual not null)",
+		output: "create table t1 (a int, b int generated always as (a + 1) stored, c varchar(10) generated always as (concat(b, x)) virtual not null)",
+	}, {
+		input:  "create table t1 (a json, b int as (json_extract(a, '$.id')))",
+		output: "create table t1 (a json, b int generated always as (json_extract(a, $.id)) virtual)",
+	}, {
+		input:  "select generated, always, stored, virtual from t1",
+		output: "select generated, always, stored, virtual from t1",
 	}, {
 		input: "select a from t1 use index(b)",
 	}, {
@@ -787,6 +889,12 @@ var (
 			output: "create table t (a int) properties(a = b)",
 		}, {
 			input: "create table t (a int, b char, check (1 + 1) enforced)",
+		}, {
+			input:  "create table t (a int, b int, constraint chk_a check (a > b) not enforced, check (b > 0))",
+			output: "create table t (a int, b int, constraint chk_a check (a > b) not enforced, check (b > 0) enforced)",
+		}, {
+			input:  "create table t (a int check (a > 0))",
+			output: "create table t (a int constraint check (a > 0) enforced)",
 		}, {
 			input: "create table t (a int, b char, foreign key sdf (a, b) references b(a asc, b desc))",
 		}, {
@@ -1353,6 +1461,20 @@ var (
 		}, {
 			input:  "rollback and chain no release",
 			output: "rollback",
+		}, {
+			input: "savepoint sp1",
+		}, {
+			input: "savepoint savepoint",
+		}, {
+			input: "rollback to savepoint sp1",
+		}, {
+			input:  "rollback work to sp1",
+			output: "rollback to savepoint sp1",
+		}, {
+			input:  "ROLLBACK WORK TO SAVEPOINT SP1",
+			output: "rollback to savepoint sp1",
+		}, {
+			input: "release savepoint sp1",
 		}, {
 			input:  "commit and chain no release",
 			output: "commit",
@@ -1659,6 +1781,12 @@ var (
 		}, {
 			input:  "select $ from t into outfile '/Users/tmp/test' FIELDS TERMINATED BY ',' LINES TERMINATED BY '\n' header 'FALSE' MAX_FILE_SIZE 100 FORCE_QUOTE (a, b)",
 			output: "select $ from t into outfile /Users/tmp/test fields terminated by , enclosed by \" lines terminated by \n header false max_file_size 102400 force_quote a, b",
+		}, {
+			input:  "select a, b from t into outfile 'stage://s1/t.parquet' MAX_FILE_SIZE 1024 FORMAT PARQUET ROW_GROUP_SIZE 10000 COMPRESSION 'ZSTD'",
+			output: "select a, b from t into outfile stage://s1/t.parquet fields terminated by , enclosed by \" lines terminated by \n header true max_file_size 1048576 format parquet row_group_size 10000 compression 'zstd'",
+		}, {
+			input:  "select a, b from t into outfile '/Users/tmp/test.jsonl' format jsonline",
+			output: "select a, b from t into outfile /Users/tmp/test.jsonl fields terminated by , enclosed by \" lines terminated by \n header true format jsonline",
 		}, {
 			input: "drop prepare stmt_name1",
 		}, {
@@ -1717,6 +1845,15 @@ var (
 			output: "alter account nihao admin_name 'admin' identified with '******'",
 		}, {
 			input: "alter account ? admin_name ? identified with ?",
+		}, {
+			input:  "create user u1 identified with caching_sha2_password by '123', u2 identified with 'MYSQL_NATIVE_PASSWORD' by '234'",
+			output: "create user u1 identified with caching_sha2_password by '******', u2 identified with mysql_native_password by '******'",
+		}, {
+			input:  "alter user u1 identified with caching_sha2_password by '123'",
+			output: "alter user u1 identified with caching_sha2_password by '******'",
+		}, {
+			input:  "create account nihao admin_name 'admin' identified with caching_sha2_password by '123'",
+			output: "create account nihao admin_name 'admin' identified with caching_sha2_password by '******'",
 		}, {
 			input: "create user if not exists abc1 identified by '123', abc2 identified by '234', abc3 identified by '111' default role def_role " +
 				"password expire " +
@@ -1937,6 +2074,18 @@ var (
 			input:  `select * from unnest("a")`,
 			output: `select * from unnest(a)`,
 		},
+		{
+			input:  `select * from t1, lateral (select * from t2 where t2.a = t1.a) as d`,
+			output: `select * from t1 cross join lateral (select * from t2 where t2.a = t1.a) as d`,
+		},
+		{
+			input:  `select * from t1 left join lateral (select b from t2 where t2.a = t1.a order by b limit 2) d (x) on true`,
+			output: `select * from t1 left join lateral (select b from t2 where t2.a = t1.a order by b limit 2) as d(x) on true`,
+		},
+		{
+			input:  `select * from t1 cross join lateral generate_series(1, t1.a) g`,
+			output: `select * from t1 cross join lateral generate_series(1, t1.a) as g`,
+		},
 		{
 			input:  `select * from unnest("a", "b")`,
 			output: `select * from unnest(a, b)`,
@@ -1961,6 +2110,18 @@ var (
 			input:  `select * from unnest(t.a) as f`,
 			output: `select * from unnest(t.a) as f`,
 		},
+		{
+			input:  `select * from json_table('[{"a": 1}]', '$[*]' columns (id for ordinality, a int path '$.a')) as jt`,
+			output: `select * from json_table([{"a": 1}], $[*] columns (id for ordinality, a int path $.a)) as jt`,
+		},
+		{
+			input:  `select jt.* from t, json_table(t.doc, '$' columns (a varchar(10) path '$.a' default '"x"' on empty error on error, b int exists path '$.b', nested path '$.c[*]' columns (c json path '$'), nested '$.d' columns (d int path '$' null on error))) jt`,
+			output: `select jt.* from t cross join json_table(t.doc, $ columns (a varchar(10) path $.a default "x" on empty error on error, b int exists path $.b, nested path $.c[*] columns (c json path $), nested path $.d columns (d int path $ null on error))) as jt`,
+		},
+		{
+			input:  `select path, error, empty, nested, ordinality from t`,
+			output: `select path, error, empty, nested, ordinality from t`,
+		},
 		{
 			input:  `select * from unnest(t.a, "$.b") as f`,
 			output: `select * from unnest(t.a, $.b) as f`,
@@ -2304,6 +2465,13 @@ var (
 		{
 			input: "alter table tbl1 drop foreign key fk_name",
 		},
+		{
+			input: "alter table tbl1 drop check chk_name",
+		},
+		{
+			input:  "alter table tbl1 add constraint chk_name check (a > 1)",
+			output: "alter table tbl1 add constraint chk_name check (a > 1) enforced",
+		},
 		{
 			input: "alter table tbl1 add foreign key sdf (a, b) references b(a asc, b desc)",
 		},
@@ -2416,6 +2584,34 @@ var (
 			input:  "ALTER TABLE t1 TRUNCATE PARTITION ALL",
 			output: "alter table t1 truncate partition all",
 		},
+		{
+			input:  "ALTER TABLE t1 ADD PARTITION PARTITIONS 2",
+			output: "alter table t1 add partition partitions 2",
+		},
+		{
+			input:  "ALTER TABLE t1 COALESCE PARTITION 2",
+			output: "alter table t1 coalesce partition 2",
+		},
+		{
+			input:  "ALTER TABLE t1 REORGANIZE PARTITION p0, p1 INTO (PARTITION n0 VALUES LESS THAN (5), PARTITION n1 VALUES LESS THAN (20))",
+			output: "alter table t1 reorganize partition p0, p1 into (partition n0 values less than (5), partition n1 values less than (20))",
+		},
+		{
+			input:  "ALTER TABLE t1 EXCHANGE PARTITION p0 WITH TABLE db.t2",
+			output: "alter table t1 exchange partition p0 with table db.t2",
+		},
+		{
+			input:  "ALTER TABLE t1 EXCHANGE PARTITION p0 WITH TABLE t2 WITH VALIDATION",
+			output: "alter table t1 exchange partition p0 with table t2",
+		},
+		{
+			input:  "ALTER TABLE t1 EXCHANGE PARTITION p0 WITH TABLE t2 WITHOUT VALIDATION",
+			output: "alter table t1 exchange partition p0 with table t2 without validation",
+		},
+		{
+			input:  "ALTER TABLE t1 REMOVE PARTITIONING",
+			output: "alter table t1 remove partitioning",
+		},
 		{
 			input:  "ALTER TABLE titles partition by range(to_days(from_date)) (partition p01 values less than (to_days('1985-12-31')), partition p02 values less than (to_days('1986-12-31')), partition p03 values less than (to_days('1987-12-31')))",
 			output: "alter table titles partition by range(to_days(from_date)) (partition p01 values less than (to_days(1985-12-31)), partition p02 values less than (to_days(1986-12-31)), partition p03 values less than (to_days(1987-12-31)))",
@@ -3229,6 +3425,51 @@ func TestMulti(t *testing.T) {
 	}
 }
 
+func TestEventBody(t *testing.T) {
+	ctx := context.TODO()
+	input := "do 1; create event e1 on schedule every 1 hour do insert into t1 values ('a;b', now()) ; alter event e1 do do sleep(1)"
+	asts, err := Parse(ctx, input, 1)
+	if err != nil {
+		t.Fatalf("Parse(%q) err: %v", input, err)
+	}
+	if len(asts) != 3 {
+		t.Fatalf("Parse(%q) got %d statements", input, len(asts))
+	}
+	if body := asts[1].(*tree.CreateEvent).BodySQL; body != "insert into t1 values ('a;b', now())" {
+		t.Errorf("unexpected create event body: %q", body)
+	}
+	if body := asts[2].(*tree.AlterEvent).BodySQL; body != "do sleep(1)" {
+		t.Errorf("unexpected alter event body: %q", body)
+	}
+
+	ast, err := ParseOne(ctx, "alter event e1 disable", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if body := ast.(*tree.AlterEvent).BodySQL; body != "" {
+		t.Errorf("unexpected alter event body: %q", body)
+	}
+}
+
+func TestTriggerBody(t *testing.T) {
+	ctx := context.TODO()
+	input := "create trigger trg1 before insert on t1 for each row set new.b = concat(new.a, ';') ; " +
+		"create trigger trg2 after update on t1 for each row follows trg1 begin insert into log values (old.a, new.a); end"
+	asts, err := Parse(ctx, input, 1)
+	if err != nil {
+		t.Fatalf("Parse(%q) err: %v", input, err)
+	}
+	if len(asts) != 2 {
+		t.Fatalf("Parse(%q) got %d statements", input, len(asts))
+	}
+	if body := asts[0].(*tree.CreateTrigger).BodySQL; body != "set new.b = concat(new.a, ';')" {
+		t.Errorf("unexpected trigger body: %q", body)
+	}
+	if body := asts[1].(*tree.CreateTrigger).BodySQL; body != "begin insert into log values (old.a, new.a); end" {
+		t.Errorf("unexpected trigger body: %q", body)
+	}
+}
+
 // Fault tolerant use cases
 var (
 	invalidSQL = []struct {
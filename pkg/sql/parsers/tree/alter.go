@@ -177,6 +177,30 @@ func init() {
 		reuse.DefaultOptions[AlterPartitionTruncatePartitionClause](), //.
 	) // WithEnableChecker()
 
+	reuse.CreatePool[AlterPartitionReorganizePartitionClause](
+		func() *AlterPartitionReorganizePartitionClause { return &AlterPartitionReorganizePartitionClause{} },
+		func(a *AlterPartitionReorganizePartitionClause) { a.reset() },
+		reuse.DefaultOptions[AlterPartitionReorganizePartitionClause](), //.
+	) // WithEnableChecker()
+
+	reuse.CreatePool[AlterPartitionCoalescePartitionClause](
+		func() *AlterPartitionCoalescePartitionClause { return &AlterPartitionCoalescePartitionClause{} },
+		func(a *AlterPartitionCoalescePartitionClause) { a.reset() },
+		reuse.DefaultOptions[AlterPartitionCoalescePartitionClause](), //.
+	) // WithEnableChecker()
+
+	reuse.CreatePool[AlterPartitionExchangePartitionClause](
+		func() *AlterPartitionExchangePartitionClause { return &AlterPartitionExchangePartitionClause{} },
+		func(a *AlterPartitionExchangePartitionClause) { a.reset() },
+		reuse.DefaultOptions[AlterPartitionExchangePartitionClause](), //.
+	) // WithEnableChecker()
+
+	reuse.CreatePool[AlterPartitionRemovePartitioningClause](
+		func() *AlterPartitionRemovePartitioningClause { return &AlterPartitionRemovePartitioningClause{} },
+		func(a *AlterPartitionRemovePartitioningClause) { a.reset() },
+		reuse.DefaultOptions[AlterPartitionRemovePartitioningClause](), //.
+	) // WithEnableChecker()
+
 	reuse.CreatePool[AccountsSetOption](
 		func() *AccountsSetOption { return &AccountsSetOption{} },
 		func(a *AccountsSetOption) { a.reset() },
@@ -637,6 +661,14 @@ func (node *AlterTable) reset() {
 			opt.Free()
 		case *AlterPartitionTruncatePartitionClause:
 			opt.Free()
+		case *AlterPartitionReorganizePartitionClause:
+			opt.Free()
+		case *AlterPartitionCoalescePartitionClause:
+			opt.Free()
+		case *AlterPartitionExchangePartitionClause:
+			opt.Free()
+		case *AlterPartitionRemovePartitioningClause:
+			opt.Free()
 		default:
 			if opt != nil {
 				panic(fmt.Sprintf("miss Free for %v", node.PartitionOption))
@@ -852,6 +884,7 @@ const (
 	AlterTableDropKey
 	AlterTableDropPrimaryKey
 	AlterTableDropForeignKey
+	AlterTableDropCheck
 )
 
 type AlterOptionDrop struct {
@@ -886,6 +919,9 @@ func (node *AlterOptionDrop) Format(ctx *FmtCtx) {
 	case AlterTableDropForeignKey:
 		ctx.WriteString("foreign key ")
 		node.Name.Format(ctx)
+	case AlterTableDropCheck:
+		ctx.WriteString("check ")
+		node.Name.Format(ctx)
 	}
 }
 
@@ -1494,6 +1530,9 @@ type AlterPartitionAddPartitionClause struct {
 	alterPartitionOptionImpl
 	Typ        AlterPartitionOptionType
 	Partitions []*Partition
+	// PartitionNum is the number of partitions added by `ADD PARTITION PARTITIONS n`,
+	// which is only valid for HASH/KEY partitioned tables.
+	PartitionNum uint64
 }
 
 func NewAlterPartitionAddPartitionClause(typ AlterPartitionOptionType, partitions []*Partition) *AlterPartitionAddPartitionClause {
@@ -1508,6 +1547,10 @@ func (node *AlterPartitionAddPartitionClause) Free() {
 }
 
 func (node *AlterPartitionAddPartitionClause) Format(ctx *FmtCtx) {
+	if node.PartitionNum > 0 {
+		ctx.WriteString(fmt.Sprintf(" add partition partitions %d", node.PartitionNum))
+		return
+	}
 	ctx.WriteString(" add partition (")
 	isFirst := true
 	for _, partition := range node.Partitions {
@@ -1555,7 +1598,11 @@ func (node *AlterPartitionDropPartitionClause) Free() {
 
 func (node *AlterPartitionDropPartitionClause) Format(ctx *FmtCtx) {
 	ctx.WriteString(" drop partition ")
-	node.PartitionNames.Format(ctx)
+	if node.OnAllPartitions {
+		ctx.WriteString("all")
+	} else {
+		node.PartitionNames.Format(ctx)
+	}
 }
 
 func (node AlterPartitionDropPartitionClause) TypeName() string {
@@ -1600,3 +1647,145 @@ func (node AlterPartitionTruncatePartitionClause) TypeName() string {
 func (node *AlterPartitionTruncatePartitionClause) reset() {
 	*node = AlterPartitionTruncatePartitionClause{}
 }
+
+type AlterPartitionReorganizePartitionClause struct {
+	alterPartitionOptionImpl
+	Typ            AlterPartitionOptionType
+	PartitionNames IdentifierList
+	Partitions     []*Partition
+}
+
+func NewAlterPartitionReorganizePartitionClause(typ AlterPartitionOptionType, partitionNames IdentifierList, partitions []*Partition) *AlterPartitionReorganizePartitionClause {
+	a := reuse.Alloc[AlterPartitionReorganizePartitionClause](nil)
+	a.Typ = typ
+	a.PartitionNames = partitionNames
+	a.Partitions = partitions
+	return a
+}
+
+func (node *AlterPartitionReorganizePartitionClause) Free() {
+	reuse.Free[AlterPartitionReorganizePartitionClause](node, nil)
+}
+
+func (node *AlterPartitionReorganizePartitionClause) Format(ctx *FmtCtx) {
+	ctx.WriteString(" reorganize partition ")
+	node.PartitionNames.Format(ctx)
+	ctx.WriteString(" into (")
+	for i, partition := range node.Partitions {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		partition.Format(ctx)
+	}
+	ctx.WriteString(")")
+}
+
+func (node AlterPartitionReorganizePartitionClause) TypeName() string {
+	return "tree.AlterPartitionReorganizePartitionClause"
+}
+
+func (node *AlterPartitionReorganizePartitionClause) reset() {
+	if node.Partitions != nil {
+		for _, item := range node.Partitions {
+			item.Free()
+		}
+	}
+	*node = AlterPartitionReorganizePartitionClause{}
+}
+
+type AlterPartitionCoalescePartitionClause struct {
+	alterPartitionOptionImpl
+	Typ          AlterPartitionOptionType
+	PartitionNum uint64
+}
+
+func NewAlterPartitionCoalescePartitionClause(typ AlterPartitionOptionType, partitionNum uint64) *AlterPartitionCoalescePartitionClause {
+	a := reuse.Alloc[AlterPartitionCoalescePartitionClause](nil)
+	a.Typ = typ
+	a.PartitionNum = partitionNum
+	return a
+}
+
+func (node *AlterPartitionCoalescePartitionClause) Free() {
+	reuse.Free[AlterPartitionCoalescePartitionClause](node, nil)
+}
+
+func (node *AlterPartitionCoalescePartitionClause) Format(ctx *FmtCtx) {
+	ctx.WriteString(fmt.Sprintf(" coalesce partition %d", node.PartitionNum))
+}
+
+func (node AlterPartitionCoalescePartitionClause) TypeName() string {
+	return "tree.AlterPartitionCoalescePartitionClause"
+}
+
+func (node *AlterPartitionCoalescePartitionClause) reset() {
+	*node = AlterPartitionCoalescePartitionClause{}
+}
+
+type AlterPartitionRemovePartitioningClause struct {
+	alterPartitionOptionImpl
+	Typ AlterPartitionOptionType
+}
+
+func NewAlterPartitionRemovePartitioningClause(typ AlterPartitionOptionType) *AlterPartitionRemovePartitioningClause {
+	a := reuse.Alloc[AlterPartitionRemovePartitioningClause](nil)
+	a.Typ = typ
+	return a
+}
+
+func (node *AlterPartitionRemovePartitioningClause) Free() {
+	reuse.Free[AlterPartitionRemovePartitioningClause](node, nil)
+}
+
+func (node *AlterPartitionRemovePartitioningClause) Format(ctx *FmtCtx) {
+	ctx.WriteString(" remove partitioning")
+}
+
+func (node AlterPartitionRemovePartitioningClause) TypeName() string {
+	return "tree.AlterPartitionRemovePartitioningClause"
+}
+
+func (node *AlterPartitionRemovePartitioningClause) reset() {
+	*node = AlterPartitionRemovePartitioningClause{}
+}
+
+type AlterPartitionExchangePartitionClause struct {
+	alterPartitionOptionImpl
+	Typ           AlterPartitionOptionType
+	PartitionName Identifier
+	Table         *TableName
+	// WithoutValidation skips checking that every row of the exchanged table
+	// belongs to the partition.
+	WithoutValidation bool
+}
+
+func NewAlterPartitionExchangePartitionClause(typ AlterPartitionOptionType, partitionName Identifier, table *TableName, withoutValidation bool) *AlterPartitionExchangePartitionClause {
+	a := reuse.Alloc[AlterPartitionExchangePartitionClause](nil)
+	a.Typ = typ
+	a.PartitionName = partitionName
+	a.Table = table
+	a.WithoutValidation = withoutValidation
+	return a
+}
+
+func (node *AlterPartitionExchangePartitionClause) Free() {
+	reuse.Free[AlterPartitionExchangePartitionClause](node, nil)
+}
+
+func (node *AlterPartitionExchangePartitionClause) Format(ctx *FmtCtx) {
+	ctx.WriteString(" exchange partition ")
+	node.PartitionName.Format(ctx)
+	ctx.WriteString(" with table ")
+	node.Table.Format(ctx)
+	if node.WithoutValidation {
+		ctx.WriteString(" without validation")
+	}
+}
+
+func (node AlterPartitionExchangePartitionClause) TypeName() string {
+	return "tree.AlterPartitionExchangePartitionClause"
+}
+
+func (node *AlterPartitionExchangePartitionClause) reset() {
+	*node = AlterPartitionExchangePartitionClause{}
+}
@@ -1737,7 +1737,14 @@ type AttributeGeneratedAlways struct {
 }
 
 func (node *AttributeGeneratedAlways) Format(ctx *FmtCtx) {
+	ctx.WriteString("generated always as (")
 	node.Expr.Format(ctx)
+	ctx.WriteString(")")
+	if node.Stored {
+		ctx.WriteString(" stored")
+	} else {
+		ctx.WriteString(" virtual")
+	}
 }
 
 func (node AttributeGeneratedAlways) TypeName() string { return "tree.AttributeGeneratedAlways" }
@@ -2018,6 +2025,8 @@ func (it IndexType) ToString() string {
 		return "ivfflat"
 	case INDEX_TYPE_MASTER:
 		return "master"
+	case INDEX_TYPE_FULLTEXT:
+		return "fulltext"
 	case INDEX_TYPE_INVALID:
 		return ""
 	default:
@@ -2034,6 +2043,7 @@ const (
 	INDEX_TYPE_ZONEMAP
 	INDEX_TYPE_IVFFLAT
 	INDEX_TYPE_MASTER
+	INDEX_TYPE_FULLTEXT
 )
 
 type VisibleType int
@@ -2447,16 +2457,24 @@ func NewFullTextIndex(k []*KeyPart, n string, e bool, io *IndexOption) *FullText
 
 type CheckIndex struct {
 	tableDefImpl
+	Name     string
 	Expr     Expr
 	Enforced bool
 }
 
 func (node *CheckIndex) Format(ctx *FmtCtx) {
+	if node.Name != "" {
+		ctx.WriteString("constraint ")
+		ctx.WriteString(node.Name)
+		ctx.WriteByte(' ')
+	}
 	ctx.WriteString("check (")
 	node.Expr.Format(ctx)
 	ctx.WriteByte(')')
 	if node.Enforced {
 		ctx.WriteString(" enforced")
+	} else {
+		ctx.WriteString(" not enforced")
 	}
 }
 
@@ -5190,6 +5208,8 @@ const (
 type AccountIdentified struct {
 	Typ AccountIdentifiedOption
 	Str Expr
+	// Plugin is the authentication plugin of the password, empty for the default one.
+	Plugin string
 }
 
 func NewAccountIdentified(t AccountIdentifiedOption, s Expr) *AccountIdentified {
@@ -5205,10 +5225,17 @@ func (node *AccountIdentified) Format(ctx *FmtCtx) {
 
 	switch node.Typ {
 	case AccountIdentifiedByPassword:
+		if node.Plugin != "" {
+			ctx.WriteString(" identified with ")
+			ctx.WriteString(node.Plugin)
+			ctx.WriteString(" by")
+		} else {
+			ctx.WriteString(" identified by")
+		}
 		if isParam {
-			ctx.WriteString(" identified by ?")
+			ctx.WriteString(" ?")
 		} else {
-			ctx.WriteString(" identified by '******'")
+			ctx.WriteString(" '******'")
 		}
 	case AccountIdentifiedByRandomPassword:
 		ctx.WriteString(" identified by random password")
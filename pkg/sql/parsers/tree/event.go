@@ -0,0 +1,244 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+import (
+	"fmt"
+	"strings"
+)
+
+type EventStatus int
+
+const (
+	// EventStatusUnspecified means ENABLE or DISABLE is not specified.
+	EventStatusUnspecified EventStatus = iota
+	EventStatusEnable
+	EventStatusDisable
+)
+
+func (s EventStatus) String() string {
+	switch s {
+	case EventStatusEnable:
+		return "ENABLED"
+	case EventStatusDisable:
+		return "DISABLED"
+	}
+	return ""
+}
+
+type EventCompletion int
+
+const (
+	// EventCompletionUnspecified means ON COMPLETION is not specified.
+	EventCompletionUnspecified EventCompletion = iota
+	EventCompletionPreserve
+	EventCompletionNotPreserve
+)
+
+func (c EventCompletion) String() string {
+	switch c {
+	case EventCompletionPreserve:
+		return "PRESERVE"
+	case EventCompletionNotPreserve:
+		return "NOT PRESERVE"
+	}
+	return ""
+}
+
+type EventName struct {
+	Name objName
+}
+
+func NewEventName(name Identifier, prefix ObjectNamePrefix) *EventName {
+	return &EventName{
+		Name: objName{
+			ObjectName:       name,
+			ObjectNamePrefix: prefix,
+		},
+	}
+}
+
+func (node *EventName) Format(ctx *FmtCtx) {
+	if node.Name.ExplicitSchema {
+		ctx.WriteString(string(node.Name.SchemaName))
+		ctx.WriteByte('.')
+	}
+	ctx.WriteString(string(node.Name.ObjectName))
+}
+
+func (node *EventName) HasNoNameQualifier() bool {
+	return !node.Name.ExplicitCatalog && !node.Name.ExplicitSchema
+}
+
+// EventSchedule is the ON SCHEDULE clause of an event. An event with At is
+// executed once, otherwise it is executed every IntervalValue IntervalUnit
+// between Starts and Ends.
+type EventSchedule struct {
+	At Expr
+
+	IntervalValue Expr
+	IntervalUnit  string
+	Starts        Expr
+	Ends          Expr
+}
+
+func (node *EventSchedule) Format(ctx *FmtCtx) {
+	if node.At != nil {
+		ctx.WriteString("at ")
+		node.At.Format(ctx)
+		return
+	}
+	ctx.WriteString("every ")
+	node.IntervalValue.Format(ctx)
+	ctx.WriteByte(' ')
+	ctx.WriteString(strings.ToLower(node.IntervalUnit))
+	if node.Starts != nil {
+		ctx.WriteString(" starts ")
+		node.Starts.Format(ctx)
+	}
+	if node.Ends != nil {
+		ctx.WriteString(" ends ")
+		node.Ends.Format(ctx)
+	}
+}
+
+type CreateEvent struct {
+	statementImpl
+
+	Definer      *UsernameRecord
+	IfNotExists  bool
+	Name         *EventName
+	Schedule     *EventSchedule
+	OnCompletion EventCompletion
+	Status       EventStatus
+	Comment      string
+	Body         Statement
+	// BodySQL is the original text of Body.
+	BodySQL string
+}
+
+func (node *CreateEvent) Format(ctx *FmtCtx) {
+	ctx.WriteString("create ")
+	formatEventDefiner(ctx, node.Definer)
+	ctx.WriteString("event ")
+	if node.IfNotExists {
+		ctx.WriteString("if not exists ")
+	}
+	node.Name.Format(ctx)
+	ctx.WriteString(" on schedule ")
+	node.Schedule.Format(ctx)
+	formatEventOptions(ctx, node.OnCompletion, node.Status, node.Comment != "", node.Comment)
+	ctx.WriteString(" do ")
+	node.Body.Format(ctx)
+}
+
+func (node *CreateEvent) GetStatementType() string { return "Create Event" }
+func (node *CreateEvent) GetQueryType() string     { return QueryTypeDDL }
+
+func (node CreateEvent) TypeName() string { return "tree.CreateEvent" }
+
+type AlterEvent struct {
+	statementImpl
+
+	Definer *UsernameRecord
+	Name    *EventName
+	// Schedule, Rename and Body are nil if they are not changed.
+	Schedule     *EventSchedule
+	OnCompletion EventCompletion
+	Rename       *EventName
+	Status       EventStatus
+	HasComment   bool
+	Comment      string
+	Body         Statement
+	BodySQL      string
+}
+
+func (node *AlterEvent) Format(ctx *FmtCtx) {
+	ctx.WriteString("alter ")
+	formatEventDefiner(ctx, node.Definer)
+	ctx.WriteString("event ")
+	node.Name.Format(ctx)
+	if node.Schedule != nil {
+		ctx.WriteString(" on schedule ")
+		node.Schedule.Format(ctx)
+	}
+	if node.OnCompletion != EventCompletionUnspecified {
+		formatEventOptions(ctx, node.OnCompletion, EventStatusUnspecified, false, "")
+	}
+	if node.Rename != nil {
+		ctx.WriteString(" rename to ")
+		node.Rename.Format(ctx)
+	}
+	formatEventOptions(ctx, EventCompletionUnspecified, node.Status, node.HasComment, node.Comment)
+	if node.Body != nil {
+		ctx.WriteString(" do ")
+		node.Body.Format(ctx)
+	}
+}
+
+func (node *AlterEvent) GetStatementType() string { return "Alter Event" }
+func (node *AlterEvent) GetQueryType() string     { return QueryTypeDDL }
+
+func (node AlterEvent) TypeName() string { return "tree.AlterEvent" }
+
+type DropEvent struct {
+	statementImpl
+
+	IfExists bool
+	Name     *EventName
+}
+
+func (node *DropEvent) Format(ctx *FmtCtx) {
+	ctx.WriteString("drop event ")
+	if node.IfExists {
+		ctx.WriteString("if exists ")
+	}
+	node.Name.Format(ctx)
+}
+
+func (node *DropEvent) GetStatementType() string { return "Drop Event" }
+func (node *DropEvent) GetQueryType() string     { return QueryTypeDDL }
+
+func (node DropEvent) TypeName() string { return "tree.DropEvent" }
+
+func formatEventDefiner(ctx *FmtCtx, definer *UsernameRecord) {
+	if definer == nil {
+		return
+	}
+	ctx.WriteString("definer = ")
+	ctx.WriteString(definer.Username)
+	if definer.Hostname != "" {
+		ctx.WriteByte('@')
+		ctx.WriteString(definer.Hostname)
+	}
+	ctx.WriteByte(' ')
+}
+
+func formatEventOptions(ctx *FmtCtx, completion EventCompletion, status EventStatus, hasComment bool, comment string) {
+	if completion != EventCompletionUnspecified {
+		ctx.WriteString(" on completion ")
+		ctx.WriteString(strings.ToLower(completion.String()))
+	}
+	switch status {
+	case EventStatusEnable:
+		ctx.WriteString(" enable")
+	case EventStatusDisable:
+		ctx.WriteString(" disable")
+	}
+	if hasComment {
+		ctx.WriteString(" comment ")
+		ctx.WriteString(fmt.Sprintf("'%s'", strings.ReplaceAll(comment, "'", "''")))
+	}
+}
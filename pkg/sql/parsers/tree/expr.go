@@ -1182,6 +1182,80 @@ func NewSerialExtractExpr(serialExpr Expr, indexExpr Expr, typ ResolvableTypeRef
 	}
 }
 
+type FullTextSearchType int
+
+const (
+	FULLTEXT_DEFAULT FullTextSearchType = iota
+	FULLTEXT_NL
+	FULLTEXT_NL_QUERY_EXPANSION
+	FULLTEXT_BOOLEAN
+	FULLTEXT_QUERY_EXPANSION
+)
+
+func (m FullTextSearchType) ToString() string {
+	switch m {
+	case FULLTEXT_NL:
+		return "in natural language mode"
+	case FULLTEXT_NL_QUERY_EXPANSION:
+		return "in natural language mode with query expansion"
+	case FULLTEXT_BOOLEAN:
+		return "in boolean mode"
+	case FULLTEXT_QUERY_EXPANSION:
+		return "with query expansion"
+	default:
+		return ""
+	}
+}
+
+// FullTextMatchExpr is MATCH (col1, col2, ...) AGAINST (expr [search_modifier])
+type FullTextMatchExpr struct {
+	exprImpl
+	Columns []*UnresolvedName
+	Pattern Expr
+	Mode    FullTextSearchType
+}
+
+func (node *FullTextMatchExpr) Format(ctx *FmtCtx) {
+	ctx.WriteString("match(")
+	prefix := ""
+	for _, c := range node.Columns {
+		ctx.WriteString(prefix)
+		c.Format(ctx)
+		prefix = ", "
+	}
+	ctx.WriteString(") against(")
+	node.Pattern.Format(ctx)
+	if node.Mode != FULLTEXT_DEFAULT {
+		ctx.WriteByte(' ')
+		ctx.WriteString(node.Mode.ToString())
+	}
+	ctx.WriteByte(')')
+}
+
+// Accept implements NodeChecker interface
+func (node *FullTextMatchExpr) Accept(v Visitor) (Expr, bool) {
+	newNode, skipChildren := v.Enter(node)
+	if skipChildren {
+		return v.Exit(newNode)
+	}
+	node = newNode.(*FullTextMatchExpr)
+
+	tmpNode, ok := node.Pattern.Accept(v)
+	if !ok {
+		return node, false
+	}
+	node.Pattern = tmpNode
+	return v.Exit(node)
+}
+
+func NewFullTextMatchExpr(columns []*UnresolvedName, pattern Expr, mode FullTextSearchType) *FullTextMatchExpr {
+	return &FullTextMatchExpr{
+		Columns: columns,
+		Pattern: pattern,
+		Mode:    mode,
+	}
+}
+
 // the Cast expression
 type CastExpr struct {
 	exprImpl
@@ -0,0 +1,111 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+type JsonTableColumnKind int
+
+const (
+	// name FOR ORDINALITY
+	JSON_TABLE_COLUMN_ORDINALITY JsonTableColumnKind = iota
+	// name type PATH 'path' [on_empty] [on_error]
+	JSON_TABLE_COLUMN_PATH
+	// name type EXISTS PATH 'path'
+	JSON_TABLE_COLUMN_EXISTS
+	// NESTED [PATH] 'path' COLUMNS (...)
+	JSON_TABLE_COLUMN_NESTED
+)
+
+type JsonTableResponseKind int
+
+const (
+	JSON_TABLE_RESPONSE_NULL JsonTableResponseKind = iota
+	JSON_TABLE_RESPONSE_ERROR
+	JSON_TABLE_RESPONSE_DEFAULT
+)
+
+// JsonTableResponse is the NULL | ERROR | DEFAULT 'json' part of ON EMPTY and ON ERROR.
+type JsonTableResponse struct {
+	Kind    JsonTableResponseKind
+	Default string
+}
+
+func (node *JsonTableResponse) Format(ctx *FmtCtx) {
+	switch node.Kind {
+	case JSON_TABLE_RESPONSE_NULL:
+		ctx.WriteString("null")
+	case JSON_TABLE_RESPONSE_ERROR:
+		ctx.WriteString("error")
+	case JSON_TABLE_RESPONSE_DEFAULT:
+		ctx.WriteString("default ")
+		ctx.WriteValue(P_char, FormatString(node.Default))
+	}
+}
+
+// JsonTableColumn is one column definition in the COLUMNS clause of JSON_TABLE.
+type JsonTableColumn struct {
+	Kind JsonTableColumnKind
+	Name Identifier
+	Type ResolvableTypeReference
+	Path string
+
+	OnEmpty *JsonTableResponse
+	OnError *JsonTableResponse
+
+	// columns of NESTED PATH
+	Columns []*JsonTableColumn
+}
+
+func (node *JsonTableColumn) Format(ctx *FmtCtx) {
+	switch node.Kind {
+	case JSON_TABLE_COLUMN_ORDINALITY:
+		node.Name.Format(ctx)
+		ctx.WriteString(" for ordinality")
+	case JSON_TABLE_COLUMN_PATH, JSON_TABLE_COLUMN_EXISTS:
+		node.Name.Format(ctx)
+		ctx.WriteByte(' ')
+		node.Type.(*T).InternalType.Format(ctx)
+		if node.Kind == JSON_TABLE_COLUMN_EXISTS {
+			ctx.WriteString(" exists")
+		}
+		ctx.WriteString(" path ")
+		ctx.WriteValue(P_char, FormatString(node.Path))
+		if node.OnEmpty != nil {
+			ctx.WriteByte(' ')
+			node.OnEmpty.Format(ctx)
+			ctx.WriteString(" on empty")
+		}
+		if node.OnError != nil {
+			ctx.WriteByte(' ')
+			node.OnError.Format(ctx)
+			ctx.WriteString(" on error")
+		}
+	case JSON_TABLE_COLUMN_NESTED:
+		ctx.WriteString("nested path ")
+		ctx.WriteValue(P_char, FormatString(node.Path))
+		ctx.WriteByte(' ')
+		formatJsonTableColumns(ctx, node.Columns)
+	}
+}
+
+func formatJsonTableColumns(ctx *FmtCtx, cols []*JsonTableColumn) {
+	ctx.WriteString("columns (")
+	for i, col := range cols {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		col.Format(ctx)
+	}
+	ctx.WriteByte(')')
+}
@@ -587,9 +587,15 @@ type AliasedTableExpr struct {
 	Expr       TableExpr
 	As         AliasClause
 	IndexHints []*IndexHint
+	// Lateral is set for the LATERAL derived tables and table functions,
+	// which can reference the columns of the preceding tables in the FROM clause.
+	Lateral bool
 }
 
 func (node *AliasedTableExpr) Format(ctx *FmtCtx) {
+	if node.Lateral {
+		ctx.WriteString("lateral ")
+	}
 	node.Expr.Format(ctx)
 	if node.As.Alias != "" {
 		ctx.WriteString(" as ")
@@ -52,7 +52,8 @@ const (
 	// statement: Grant, Revoke
 	// CreateAccount, CreateUser, CreateRole, AlterAccount, AlterUser, DropAccount, DropUser, DropRole
 	QueryTypeDCL = "DCL"
-	// QueryTypeTCL (Transaction Control Language): BeginTransaction, RollbackTransaction, CommitTransaction, Savepoint(Not Support)
+	// QueryTypeTCL (Transaction Control Language): BeginTransaction, RollbackTransaction, CommitTransaction, Savepoint,
+	// RollbackToSavepoint, ReleaseSavepoint
 	QueryTypeTCL = "TCL"
 	// QueryTypeOth (Other.)
 	// statement: AnalyzeStmt(Not Support), ExplainStmt, ExplainAnalyze, ExplainFor,
@@ -165,6 +166,18 @@ func (node *RollbackTransaction) StmtKind() StmtKind {
 	return frontendStatusTyp
 }
 
+func (node *Savepoint) StmtKind() StmtKind {
+	return frontendStatusTyp
+}
+
+func (node *RollbackToSavepoint) StmtKind() StmtKind {
+	return frontendStatusTyp
+}
+
+func (node *ReleaseSavepoint) StmtKind() StmtKind {
+	return frontendStatusTyp
+}
+
 func (node *CreatePublication) StmtKind() StmtKind {
 	return frontendStatusTyp
 }
@@ -646,7 +659,7 @@ func (node *DropPitr) StmtKind() StmtKind {
 func (node *CreateCDC) StmtKind() StmtKind { return frontendStatusTyp }
 
 func (node *ShowCDC) StmtKind() StmtKind {
-	return defaultResRowTyp
+	return compositeResRowType
 }
 
 func (node *PauseCDC) StmtKind() StmtKind {
@@ -664,3 +677,23 @@ func (node *ResumeCDC) StmtKind() StmtKind {
 func (node *RestartCDC) StmtKind() StmtKind {
 	return frontendStatusTyp
 }
+
+func (node *CreateEvent) StmtKind() StmtKind {
+	return frontendStatusTyp
+}
+
+func (node *AlterEvent) StmtKind() StmtKind {
+	return frontendStatusTyp
+}
+
+func (node *DropEvent) StmtKind() StmtKind {
+	return frontendStatusTyp
+}
+
+func (node *CreateTrigger) StmtKind() StmtKind {
+	return frontendStatusTyp
+}
+
+func (node *DropTrigger) StmtKind() StmtKind {
+	return frontendStatusTyp
+}
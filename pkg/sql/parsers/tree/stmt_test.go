@@ -83,6 +83,9 @@ func TestQueryType(t *testing.T) {
 					&BeginTransaction{}:    QueryTypeTCL,
 					&CommitTransaction{}:   QueryTypeTCL,
 					&RollbackTransaction{}: QueryTypeTCL,
+					&Savepoint{}:           QueryTypeTCL,
+					&RollbackToSavepoint{}: QueryTypeTCL,
+					&ReleaseSavepoint{}:    QueryTypeTCL,
 					// Other
 					&AnalyzeStmt{}:    QueryTypeOth,
 					&ExplainAnalyze{}: QueryTypeOth,
@@ -18,9 +18,22 @@ type TableFunction struct {
 	statementImpl
 	Func       *FuncExpr
 	SelectStmt *Select
+	// the COLUMNS clause of JSON_TABLE(doc, path COLUMNS (...)),
+	// Func is json_table(doc, path) then.
+	JsonColumns []*JsonTableColumn
 }
 
 func (t *TableFunction) Format(ctx *FmtCtx) {
+	if t.JsonColumns != nil {
+		ctx.WriteString("json_table(")
+		t.Func.Exprs[0].Format(ctx)
+		ctx.WriteString(", ")
+		t.Func.Exprs[1].Format(ctx)
+		ctx.WriteByte(' ')
+		formatJsonTableColumns(ctx, t.JsonColumns)
+		ctx.WriteByte(')')
+		return
+	}
 	if t.Func != nil {
 		t.Func.Format(ctx)
 	}
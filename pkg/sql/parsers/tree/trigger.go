@@ -0,0 +1,159 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+type TriggerTime int
+
+const (
+	TriggerTimeBefore TriggerTime = iota
+	TriggerTimeAfter
+)
+
+func (t TriggerTime) String() string {
+	if t == TriggerTimeAfter {
+		return "AFTER"
+	}
+	return "BEFORE"
+}
+
+type TriggerEvent int
+
+const (
+	TriggerEventInsert TriggerEvent = iota
+	TriggerEventUpdate
+	TriggerEventDelete
+)
+
+func (e TriggerEvent) String() string {
+	switch e {
+	case TriggerEventUpdate:
+		return "UPDATE"
+	case TriggerEventDelete:
+		return "DELETE"
+	}
+	return "INSERT"
+}
+
+type TriggerName struct {
+	Name objName
+}
+
+func NewTriggerName(name Identifier, prefix ObjectNamePrefix) *TriggerName {
+	return &TriggerName{
+		Name: objName{
+			ObjectName:       name,
+			ObjectNamePrefix: prefix,
+		},
+	}
+}
+
+func (node *TriggerName) Format(ctx *FmtCtx) {
+	if node.Name.ExplicitSchema {
+		ctx.WriteString(string(node.Name.SchemaName))
+		ctx.WriteByte('.')
+	}
+	ctx.WriteString(string(node.Name.ObjectName))
+}
+
+func (node *TriggerName) HasNoNameQualifier() bool {
+	return !node.Name.ExplicitCatalog && !node.Name.ExplicitSchema
+}
+
+// TriggerOrder is the FOLLOWS or PRECEDES clause of a trigger.
+type TriggerOrder struct {
+	Follows bool
+	Other   Identifier
+}
+
+func (node *TriggerOrder) Format(ctx *FmtCtx) {
+	if node.Follows {
+		ctx.WriteString("follows ")
+	} else {
+		ctx.WriteString("precedes ")
+	}
+	ctx.WriteString(string(node.Other))
+}
+
+type CreateTrigger struct {
+	statementImpl
+
+	Definer     *UsernameRecord
+	IfNotExists bool
+	Name        *TriggerName
+	Time        TriggerTime
+	Event       TriggerEvent
+	Table       *TableName
+	// Order is nil if neither FOLLOWS nor PRECEDES is specified.
+	Order *TriggerOrder
+	Body  Statement
+	// BodySQL is the original text of Body.
+	BodySQL string
+}
+
+func (node *CreateTrigger) Format(ctx *FmtCtx) {
+	ctx.WriteString("create ")
+	formatEventDefiner(ctx, node.Definer)
+	ctx.WriteString("trigger ")
+	if node.IfNotExists {
+		ctx.WriteString("if not exists ")
+	}
+	node.Name.Format(ctx)
+	if node.Time == TriggerTimeAfter {
+		ctx.WriteString(" after ")
+	} else {
+		ctx.WriteString(" before ")
+	}
+	switch node.Event {
+	case TriggerEventInsert:
+		ctx.WriteString("insert")
+	case TriggerEventUpdate:
+		ctx.WriteString("update")
+	case TriggerEventDelete:
+		ctx.WriteString("delete")
+	}
+	ctx.WriteString(" on ")
+	node.Table.Format(ctx)
+	ctx.WriteString(" for each row ")
+	if node.Order != nil {
+		node.Order.Format(ctx)
+		ctx.WriteByte(' ')
+	}
+	node.Body.Format(ctx)
+}
+
+func (node *CreateTrigger) GetStatementType() string { return "Create Trigger" }
+func (node *CreateTrigger) GetQueryType() string     { return QueryTypeDDL }
+
+func (node CreateTrigger) TypeName() string { return "tree.CreateTrigger" }
+
+type DropTrigger struct {
+	statementImpl
+
+	IfExists bool
+	Name     *TriggerName
+}
+
+func (node *DropTrigger) Format(ctx *FmtCtx) {
+	ctx.WriteString("drop trigger ")
+	if node.IfExists {
+		ctx.WriteString("if exists ")
+	}
+	node.Name.Format(ctx)
+}
+
+func (node *DropTrigger) GetStatementType() string { return "Drop Trigger" }
+func (node *DropTrigger) GetQueryType() string     { return QueryTypeDDL }
+
+func (node DropTrigger) TypeName() string { return "tree.DropTrigger" }
@@ -103,3 +103,57 @@ func (node *RollbackTransaction) GetQueryType() string     { return QueryTypeTCL
 func NewRollbackTransaction(t CompletionType) *RollbackTransaction {
 	return &RollbackTransaction{Type: t}
 }
+
+// Savepoint statement
+type Savepoint struct {
+	statementImpl
+	Name Identifier
+}
+
+func (node *Savepoint) Format(ctx *FmtCtx) {
+	ctx.WriteString("savepoint ")
+	node.Name.Format(ctx)
+}
+
+func (node *Savepoint) GetStatementType() string { return "Savepoint" }
+func (node *Savepoint) GetQueryType() string     { return QueryTypeTCL }
+
+func NewSavepoint(name Identifier) *Savepoint {
+	return &Savepoint{Name: name}
+}
+
+// RollbackToSavepoint statement
+type RollbackToSavepoint struct {
+	statementImpl
+	Name Identifier
+}
+
+func (node *RollbackToSavepoint) Format(ctx *FmtCtx) {
+	ctx.WriteString("rollback to savepoint ")
+	node.Name.Format(ctx)
+}
+
+func (node *RollbackToSavepoint) GetStatementType() string { return "Rollback To Savepoint" }
+func (node *RollbackToSavepoint) GetQueryType() string     { return QueryTypeTCL }
+
+func NewRollbackToSavepoint(name Identifier) *RollbackToSavepoint {
+	return &RollbackToSavepoint{Name: name}
+}
+
+// ReleaseSavepoint statement
+type ReleaseSavepoint struct {
+	statementImpl
+	Name Identifier
+}
+
+func (node *ReleaseSavepoint) Format(ctx *FmtCtx) {
+	ctx.WriteString("release savepoint ")
+	node.Name.Format(ctx)
+}
+
+func (node *ReleaseSavepoint) GetStatementType() string { return "Release Savepoint" }
+func (node *ReleaseSavepoint) GetQueryType() string     { return QueryTypeTCL }
+
+func NewReleaseSavepoint(name Identifier) *ReleaseSavepoint {
+	return &ReleaseSavepoint{Name: name}
+}
@@ -128,6 +128,8 @@ const (
 	CSV      = "csv"
 	JSONLINE = "jsonline"
 	PARQUET  = "parquet"
+	ORC      = "orc"
+	AVRO     = "avro"
 )
 
 // if $format is jsonline
@@ -498,6 +500,12 @@ type ExportParam struct {
 	ForceQuote []string
 	// stage filename path
 	StageFilePath string
+	// file format: csv, jsonline or parquet
+	FileFormat string
+	// max rows of a parquet row group
+	RowGroupSize uint64
+	// compression codec of the parquet pages
+	Compression string
 }
 
 func (ep *ExportParam) Format(ctx *FmtCtx) {
@@ -541,6 +549,19 @@ func (ep *ExportParam) format(ctx *FmtCtx, withOutfile bool) {
 			prefix = ", "
 		}
 	}
+	if ep.FileFormat != "" && ep.FileFormat != CSV {
+		ctx.WriteString(" format ")
+		ctx.WriteString(ep.FileFormat)
+	}
+	if ep.RowGroupSize != 0 {
+		ctx.WriteString(" row_group_size ")
+		ctx.WriteString(strconv.FormatUint(ep.RowGroupSize, 10))
+	}
+	if ep.Compression != "" {
+		ctx.WriteString(" compression '")
+		ctx.WriteString(ep.Compression)
+		ctx.WriteByte('\'')
+	}
 }
 
 var _ LoadColumn = &UnresolvedName{}
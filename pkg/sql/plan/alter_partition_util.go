@@ -16,8 +16,15 @@ package plan
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 )
 
@@ -45,21 +52,10 @@ func AddTablePartitions(ctx CompilerContext, alterTable *plan.AlterTable, spec *
 		return nil, moerr.NewNotSupported(ctx.GetContext(), "Unsupported Add Partition")
 	}
 
-	builder := NewQueryBuilder(plan.Query_SELECT, ctx, false, false)
-	bindContext := NewBindContext(builder, nil)
-	nodeID := builder.appendNode(&plan.Node{
-		NodeType:    plan.Node_TABLE_SCAN,
-		Stats:       nil,
-		ObjRef:      nil,
-		TableDef:    alterTable.TableDef,
-		BindingTags: []int32{builder.genNewTag()},
-	}, bindContext)
-
-	err := builder.addBinding(nodeID, tree.AliasClause{}, bindContext)
+	partitionBinder, err := newAlterPartitionBinder(ctx, alterTable.TableDef)
 	if err != nil {
 		return nil, err
 	}
-	partitionBinder := NewPartitionBinder(builder, bindContext)
 	alterAddPartition, err := buildAddPartitionClause(ctx.GetContext(), partitionBinder, spec, alterTable.TableDef)
 	if err != nil {
 		return nil, err
@@ -100,3 +96,554 @@ func buildAddPartitionClause(ctx context.Context, partitionBinder *PartitionBind
 	}
 	return nil, nil
 }
+
+// newAlterPartitionBinder creates the partition binder used to rebuild the partition expression of the table.
+func newAlterPartitionBinder(ctx CompilerContext, tableDef *TableDef) (*PartitionBinder, error) {
+	builder := NewQueryBuilder(plan.Query_SELECT, ctx, false, false)
+	bindContext := NewBindContext(builder, nil)
+	nodeID := builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_TABLE_SCAN,
+		Stats:       nil,
+		ObjRef:      nil,
+		TableDef:    tableDef,
+		BindingTags: []int32{builder.genNewTag()},
+	}, bindContext)
+
+	err := builder.addBinding(nodeID, tree.AliasClause{}, bindContext)
+	if err != nil {
+		return nil, err
+	}
+	return NewPartitionBinder(builder, bindContext), nil
+}
+
+// DropTablePartitions will drop the partitions of a RANGE/LIST partitioned table.
+// Only the metadata of the table is changed, the partition sub tables are dropped directly.
+func DropTablePartitions(ctx CompilerContext, alterTable *plan.AlterTable, spec *tree.AlterPartitionDropPartitionClause) (*plan.AlterTableDropPartition, error) {
+	partInfo := alterTable.TableDef.GetPartition()
+	if partInfo == nil {
+		return nil, moerr.NewErrPartitionMgmtOnNonpartitioned(ctx.GetContext())
+	}
+
+	var builder partitionBuilder
+	switch partInfo.Type {
+	case plan.PartitionType_RANGE, plan.PartitionType_RANGE_COLUMNS:
+		builder = &rangePartitionBuilder{}
+	case plan.PartitionType_LIST, plan.PartitionType_LIST_COLUMNS:
+		builder = &listPartitionBuilder{}
+	default:
+		return nil, moerr.NewErrOnlyOnRangeListPartition(ctx.GetContext(), "DROP")
+	}
+
+	if spec.OnAllPartitions {
+		return nil, moerr.NewErrDropLastPartition(ctx.GetContext())
+	}
+	dropped, ok := findPartitionPositions(partInfo, spec.PartitionNames)
+	if !ok {
+		return nil, moerr.NewErrDropPartitionNonExistent(ctx.GetContext(), "DROP")
+	}
+	if len(dropped) == len(partInfo.Partitions) {
+		return nil, moerr.NewErrDropLastPartition(ctx.GetContext())
+	}
+
+	partitionBinder, err := newAlterPartitionBinder(ctx, alterTable.TableDef)
+	if err != nil {
+		return nil, err
+	}
+
+	// Regenerate the syntax tree for the partition by clause
+	partitionBy, err := restorePartitionOption(ctx.GetContext(), partInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	newPartitionDef := DeepCopyPartitionByDef(partInfo)
+	items, tableNames := newPartitionDef.Partitions, newPartitionDef.PartitionTableNames
+	newPartitionDef.Partitions = nil
+	newPartitionDef.PartitionTableNames = nil
+	partitions := make([]*tree.Partition, 0, len(partInfo.Partitions)-len(dropped))
+	alterDropPartition := &plan.AlterTableDropPartition{}
+	for i, item := range items {
+		if dropped[i] {
+			alterDropPartition.PartitionNames = append(alterDropPartition.PartitionNames, item.PartitionName)
+			alterDropPartition.PartitionTableNames = append(alterDropPartition.PartitionTableNames, tableNames[i])
+			continue
+		}
+		item.OrdinalPosition = uint32(len(newPartitionDef.Partitions) + 1)
+		newPartitionDef.Partitions = append(newPartitionDef.Partitions, item)
+		newPartitionDef.PartitionTableNames = append(newPartitionDef.PartitionTableNames, tableNames[i])
+		partitions = append(partitions, partitionBy.Partitions[i])
+	}
+	newPartitionDef.PartitionNum = uint64(len(newPartitionDef.Partitions))
+
+	partitionBy.Partitions = partitions
+	if partitionBy.PartBy.Num != 0 {
+		partitionBy.PartBy.Num = newPartitionDef.PartitionNum
+	}
+	// Regenerate partition calculation expression
+	if err = builder.buildEvalPartitionExpression(ctx.GetContext(), partitionBinder, partitionBy, newPartitionDef); err != nil {
+		return nil, err
+	}
+	// Generate a new partition by clause's restore string
+	newPartitionDef.PartitionMsg = tree.StringWithOpts(partitionBy, dialect.MYSQL, tree.WithSingleQuoteString())
+	alterDropPartition.PartitionDef = newPartitionDef
+	return alterDropPartition, nil
+}
+
+// TruncateTablePartitions will remove all the rows of the specified partitions.
+func TruncateTablePartitions(ctx CompilerContext, alterTable *plan.AlterTable, spec *tree.AlterPartitionTruncatePartitionClause) (*plan.AlterTableTruncatePartition, error) {
+	partInfo := alterTable.TableDef.GetPartition()
+	if partInfo == nil {
+		return nil, moerr.NewErrPartitionMgmtOnNonpartitioned(ctx.GetContext())
+	}
+
+	alterTruncatePartition := &plan.AlterTableTruncatePartition{}
+	if spec.OnAllPartitions {
+		for i, item := range partInfo.Partitions {
+			alterTruncatePartition.PartitionNames = append(alterTruncatePartition.PartitionNames, item.PartitionName)
+			alterTruncatePartition.PartitionTableNames = append(alterTruncatePartition.PartitionTableNames, partInfo.PartitionTableNames[i])
+		}
+		return alterTruncatePartition, nil
+	}
+
+	for _, name := range spec.PartitionNames {
+		i := findPartitionPosition(partInfo, string(name))
+		if i < 0 {
+			return nil, moerr.NewErrUnknownPartition(ctx.GetContext(), string(name), alterTable.TableDef.Name)
+		}
+		alterTruncatePartition.PartitionNames = append(alterTruncatePartition.PartitionNames, partInfo.Partitions[i].PartitionName)
+		alterTruncatePartition.PartitionTableNames = append(alterTruncatePartition.PartitionTableNames, partInfo.PartitionTableNames[i])
+	}
+	return alterTruncatePartition, nil
+}
+
+// ExchangeTablePartition will swap the rows of a partition with the rows of a non-partitioned table.
+// The two tables must have the same structure.
+func ExchangeTablePartition(ctx CompilerContext, alterTable *plan.AlterTable, spec *tree.AlterPartitionExchangePartitionClause) (*plan.AlterTableExchangePartition, error) {
+	tableDef := alterTable.TableDef
+	partInfo := tableDef.GetPartition()
+	if partInfo == nil {
+		return nil, moerr.NewErrPartitionMgmtOnNonpartitioned(ctx.GetContext())
+	}
+
+	i := findPartitionPosition(partInfo, string(spec.PartitionName))
+	if i < 0 {
+		return nil, moerr.NewErrUnknownPartition(ctx.GetContext(), string(spec.PartitionName), tableDef.Name)
+	}
+
+	schemaName, tableName := string(spec.Table.Schema()), string(spec.Table.Name())
+	if schemaName == "" {
+		schemaName = ctx.DefaultDatabase()
+	}
+	_, exchangeTableDef := ctx.Resolve(schemaName, tableName, nil)
+	if exchangeTableDef == nil {
+		return nil, moerr.NewNoSuchTable(ctx.GetContext(), schemaName, tableName)
+	}
+	// the partition and the table are exchanged by swapping their names in the catalog
+	if schemaName != alterTable.Database {
+		return nil, moerr.NewNotSupported(ctx.GetContext(), "exchange partition with a table in another database")
+	}
+	if exchangeTableDef.Partition != nil {
+		return nil, moerr.NewErrPartitionExchangePartTable(ctx.GetContext(), tableName)
+	}
+	if exchangeTableDef.IsTemporary {
+		return nil, moerr.NewErrPartitionExchangeTempTable(ctx.GetContext(), tableName)
+	}
+	if exchangeTableDef.ViewSql != nil || exchangeTableDef.TableType != catalog.SystemOrdinaryRel {
+		return nil, moerr.NewErrTablesDifferentMetadata(ctx.GetContext())
+	}
+	if len(tableDef.Fkeys) > 0 || len(tableDef.RefChildTbls) > 0 {
+		return nil, moerr.NewErrPartitionExchangeForeignKey(ctx.GetContext(), tableDef.Name)
+	}
+	if len(exchangeTableDef.Fkeys) > 0 || len(exchangeTableDef.RefChildTbls) > 0 {
+		return nil, moerr.NewErrPartitionExchangeForeignKey(ctx.GetContext(), tableName)
+	}
+	if !isSameTableStructure(tableDef, exchangeTableDef) {
+		return nil, moerr.NewErrTablesDifferentMetadata(ctx.GetContext())
+	}
+	for _, indexDef := range exchangeTableDef.Indexes {
+		// the index tables are rebuilt by the primary key, and the multi table indexes can not be rebuilt by sql
+		if exchangeTableDef.GetPkey().GetPkeyColName() == catalog.FakePrimaryKeyColName ||
+			!catalog.IsRegularIndexAlgo(indexDef.IndexAlgo) {
+			return nil, moerr.NewNotSupportedf(ctx.GetContext(), "exchange partition with the index %s", indexDef.IndexName)
+		}
+	}
+
+	exchange := &plan.AlterTableExchangePartition{
+		PartitionName:      partInfo.Partitions[i].PartitionName,
+		PartitionTableName: partInfo.PartitionTableNames[i],
+		Database:           schemaName,
+		Table:              tableName,
+		WithoutValidation:  spec.WithoutValidation,
+	}
+	if !spec.WithoutValidation {
+		filter, err := partitionFilter(ctx.GetContext(), partInfo, i)
+		if err != nil {
+			return nil, err
+		}
+		exchange.ValidationFilter = filter
+	}
+	return exchange, nil
+}
+
+// partitionFilter generates the filter of the rows which belong to the i-th partition,
+// it evaluates the partition expression in the same way as inserting into the partitioned table.
+func partitionFilter(ctx context.Context, partInfo *PartitionByDef, i int) (string, error) {
+	partitionBy, err := restorePartitionOption(ctx, partInfo)
+	if err != nil {
+		return "", err
+	}
+
+	var expr tree.Expr
+	switch partitionType := partitionBy.PartBy.PType.(type) {
+	case *tree.HashType:
+		expr = genPartitionAst(tree.Exprs{partitionType.Expr}, int64(partInfo.PartitionNum))
+	case *tree.KeyType:
+		exprs := make(tree.Exprs, len(partitionType.ColumnList))
+		for j, column := range partitionType.ColumnList {
+			exprs[j] = column
+		}
+		expr = genPartitionAst(exprs, int64(partInfo.PartitionNum))
+	case *tree.RangeType:
+		if partitionType.ColumnList == nil {
+			expr, err = buildRangeCaseWhenExpr(partitionType.Expr, partitionBy.Partitions)
+		} else {
+			expr, err = buildRangeColumnsCaseWhenExpr(partitionType.ColumnList, partitionBy.Partitions)
+		}
+	case *tree.ListType:
+		if partitionType.ColumnList == nil {
+			expr, err = buildListCaseWhenExpr(partitionType.Expr, partitionBy.Partitions)
+		} else {
+			expr, err = buildListColumnsCaseWhenExpr(partitionType.ColumnList, partitionBy.Partitions)
+		}
+	default:
+		return "", moerr.NewNotSupportedf(ctx, "partition type %s", partInfo.Type.String())
+	}
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("coalesce((%s) = %d, false)",
+		tree.StringWithOpts(expr, dialect.MYSQL, tree.WithSingleQuoteString()), i), nil
+}
+
+// RebuildTablePartitions generates the partition by clause of the copy table for the partition
+// operations which have to redistribute the rows among partitions, such as REORGANIZE PARTITION,
+// COALESCE PARTITION, ADD PARTITION of a HASH/KEY table, PARTITION BY and REMOVE PARTITIONING.
+func RebuildTablePartitions(ctx CompilerContext, alterTable *plan.AlterTable, option tree.AlterPartitionOption) error {
+	tableDef := alterTable.TableDef
+	partInfo := tableDef.GetPartition()
+
+	var partitionBy *tree.PartitionOption
+	var err error
+	switch spec := option.(type) {
+	case *tree.AlterPartitionRemovePartitioningClause:
+		if partInfo == nil {
+			return moerr.NewErrPartitionMgmtOnNonpartitioned(ctx.GetContext())
+		}
+		alterTable.CopyTableDef.Partition = nil
+		return nil
+	case *tree.AlterPartitionRedefinePartitionClause:
+		if len(tableDef.Fkeys) > 0 || len(tableDef.RefChildTbls) > 0 {
+			return moerr.NewErrForeignKeyOnPartitioned(ctx.GetContext())
+		}
+		partitionBy = spec.PartitionOption
+	default:
+		if partInfo == nil {
+			return moerr.NewErrPartitionMgmtOnNonpartitioned(ctx.GetContext())
+		}
+		if partitionBy, err = restorePartitionOption(ctx.GetContext(), partInfo); err != nil {
+			return err
+		}
+		switch spec := option.(type) {
+		case *tree.AlterPartitionReorganizePartitionClause:
+			err = reorganizePartitionOption(ctx.GetContext(), partInfo, partitionBy, spec)
+		case *tree.AlterPartitionCoalescePartitionClause:
+			err = coalescePartitionOption(ctx.GetContext(), partInfo, partitionBy, spec)
+		case *tree.AlterPartitionAddPartitionClause:
+			err = addHashPartitionOption(ctx.GetContext(), partInfo, partitionBy, spec)
+		default:
+			return moerr.NewNotSupported(ctx.GetContext(), "alter table partition clause")
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	// The partition by clause is checked again when the copy table is created.
+	alterTable.CopyTableDef.Partition = &plan.PartitionByDef{
+		PartitionMsg: tree.StringWithOpts(partitionBy, dialect.MYSQL, tree.WithSingleQuoteString()),
+	}
+	return nil
+}
+
+// isPartitionRebuild returns whether the partition option has to redistribute the rows of the table.
+func isPartitionRebuild(option tree.AlterPartitionOption, tableDef *TableDef) bool {
+	switch option.(type) {
+	case *tree.AlterPartitionRedefinePartitionClause,
+		*tree.AlterPartitionRemovePartitioningClause,
+		*tree.AlterPartitionReorganizePartitionClause,
+		*tree.AlterPartitionCoalescePartitionClause:
+		return true
+	case *tree.AlterPartitionAddPartitionClause:
+		return tableDef.Partition != nil && isHashOrKeyPartition(tableDef.Partition.Type)
+	}
+	return false
+}
+
+func isHashOrKeyPartition(typ plan.PartitionType) bool {
+	switch typ {
+	case plan.PartitionType_KEY, plan.PartitionType_LINEAR_KEY, plan.PartitionType_HASH, plan.PartitionType_LINEAR_HASH:
+		return true
+	}
+	return false
+}
+
+// reorganizePartitionOption replaces the reorganized partitions of a RANGE/LIST table with the new partitions.
+func reorganizePartitionOption(ctx context.Context, partInfo *PartitionByDef, partitionBy *tree.PartitionOption, spec *tree.AlterPartitionReorganizePartitionClause) error {
+	isRange := false
+	switch partInfo.Type {
+	case plan.PartitionType_RANGE, plan.PartitionType_RANGE_COLUMNS:
+		isRange = true
+	case plan.PartitionType_LIST, plan.PartitionType_LIST_COLUMNS:
+	default:
+		return moerr.NewErrOnlyOnRangeListPartition(ctx, "REORGANIZE")
+	}
+
+	reorganized, ok := findPartitionPositions(partInfo, spec.PartitionNames)
+	if !ok {
+		return moerr.NewErrDropPartitionNonExistent(ctx, "REORGANIZE")
+	}
+	positions := make([]int, 0, len(reorganized))
+	for i := range reorganized {
+		positions = append(positions, i)
+	}
+	sort.Ints(positions)
+	first, last := positions[0], positions[len(positions)-1]
+
+	if isRange {
+		if last-first+1 != len(positions) {
+			return moerr.NewErrConsecutiveReorgPartitions(ctx)
+		}
+		// Only the range of the last partition can be extended
+		if last != len(partInfo.Partitions)-1 {
+			oldValues := tree.String(partitionBy.Partitions[last].Values, dialect.MYSQL)
+			newValues := tree.String(spec.Partitions[len(spec.Partitions)-1].Values, dialect.MYSQL)
+			if !strings.EqualFold(oldValues, newValues) {
+				return moerr.NewErrReorgOutsideRange(ctx)
+			}
+		}
+	}
+
+	partitions := make([]*tree.Partition, 0, len(partitionBy.Partitions)-len(positions)+len(spec.Partitions))
+	for i, partition := range partitionBy.Partitions {
+		if i == first {
+			partitions = append(partitions, spec.Partitions...)
+		}
+		if !reorganized[i] {
+			partitions = append(partitions, partition)
+		}
+	}
+	setPartitions(partitionBy, partitions)
+	return nil
+}
+
+// coalescePartitionOption removes the last n partitions of a HASH/KEY table.
+func coalescePartitionOption(ctx context.Context, partInfo *PartitionByDef, partitionBy *tree.PartitionOption, spec *tree.AlterPartitionCoalescePartitionClause) error {
+	if !isHashOrKeyPartition(partInfo.Type) {
+		return moerr.NewErrCoalesceOnlyOnHashPartition(ctx, "COALESCE")
+	}
+	if spec.PartitionNum >= partInfo.PartitionNum {
+		return moerr.NewErrDropLastPartition(ctx)
+	}
+	num := partInfo.PartitionNum - spec.PartitionNum
+	if len(partitionBy.Partitions) > 0 {
+		setPartitions(partitionBy, partitionBy.Partitions[:num])
+	} else {
+		partitionBy.PartBy.Num = num
+	}
+	return nil
+}
+
+// addHashPartitionOption appends new partitions to a HASH/KEY table.
+func addHashPartitionOption(ctx context.Context, partInfo *PartitionByDef, partitionBy *tree.PartitionOption, spec *tree.AlterPartitionAddPartitionClause) error {
+	if len(spec.Partitions) == 0 {
+		if len(partitionBy.Partitions) == 0 {
+			partitionBy.PartBy.Num = partInfo.PartitionNum + spec.PartitionNum
+			return nil
+		}
+		// Name the new partitions after the existing ones
+		partitions := partitionBy.Partitions
+		for i := uint64(0); i < spec.PartitionNum; i++ {
+			name := fmt.Sprintf("p%d", len(partitions))
+			for findPartitionPosition(partInfo, name) >= 0 {
+				name += "_"
+			}
+			partitions = append(partitions, tree.NewPartition(tree.Identifier(name), nil, nil, nil))
+		}
+		setPartitions(partitionBy, partitions)
+		return nil
+	}
+
+	for _, partition := range spec.Partitions {
+		if partition.Values != nil {
+			return moerr.NewInvalidInput(ctx, "Only RANGE/LIST PARTITIONING can use VALUES in partition definition")
+		}
+	}
+	partitions := partitionBy.Partitions
+	if len(partitions) == 0 {
+		// Materialize the implicit partitions, so that the new partitions can be appended
+		for _, item := range partInfo.Partitions {
+			partitions = append(partitions, tree.NewPartition(tree.Identifier(item.PartitionName), nil, nil, nil))
+		}
+	}
+	setPartitions(partitionBy, append(partitions, spec.Partitions...))
+	return nil
+}
+
+// setPartitions sets the partition definitions and keeps the PARTITIONS num consistent with them.
+func setPartitions(partitionBy *tree.PartitionOption, partitions []*tree.Partition) {
+	partitionBy.Partitions = partitions
+	if partitionBy.PartBy.Num != 0 {
+		partitionBy.PartBy.Num = uint64(len(partitions))
+	}
+}
+
+// restorePartitionOption regenerates the syntax tree of the partition by clause of the table.
+func restorePartitionOption(ctx context.Context, partInfo *PartitionByDef) (*tree.PartitionOption, error) {
+	ast, err := mysql.ParseOne(ctx, "create table t1() "+partInfo.PartitionMsg, 1)
+	if err != nil {
+		return nil, err
+	}
+	createTable, ok := ast.(*tree.CreateTable)
+	if !ok || createTable.PartitionOption == nil {
+		return nil, moerr.NewInternalError(ctx, "Partition definition statement Parser restore failed")
+	}
+	return createTable.PartitionOption, nil
+}
+
+// findPartitionPosition returns the position of the partition in the partition definitions, or -1.
+func findPartitionPosition(partInfo *PartitionByDef, name string) int {
+	for i, item := range partInfo.Partitions {
+		if strings.EqualFold(item.PartitionName, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+// findPartitionPositions returns the positions of the named partitions, ok is false if any of them does not exist.
+func findPartitionPositions(partInfo *PartitionByDef, names tree.IdentifierList) (positions map[int]bool, ok bool) {
+	positions = make(map[int]bool, len(names))
+	for _, name := range names {
+		i := findPartitionPosition(partInfo, string(name))
+		if i < 0 {
+			return nil, false
+		}
+		positions[i] = true
+	}
+	return positions, true
+}
+
+// isSameTableStructure checks whether the rows of the two tables can be exchanged,
+// they must have the same columns, primary key and indexes.
+func isSameTableStructure(tableDef, other *TableDef) bool {
+	visibleCols := func(def *TableDef) []*ColDef {
+		cols := make([]*ColDef, 0, len(def.Cols))
+		for _, col := range def.Cols {
+			if !col.Hidden {
+				cols = append(cols, col)
+			}
+		}
+		return cols
+	}
+	cols, otherCols := visibleCols(tableDef), visibleCols(other)
+	if len(cols) != len(otherCols) {
+		return false
+	}
+	for i, col := range cols {
+		otherCol := otherCols[i]
+		if !strings.EqualFold(col.Name, otherCol.Name) ||
+			col.Typ.Id != otherCol.Typ.Id ||
+			col.Typ.Width != otherCol.Typ.Width ||
+			col.Typ.Scale != otherCol.Typ.Scale ||
+			col.Typ.AutoIncr != otherCol.Typ.AutoIncr ||
+			col.NotNull != otherCol.NotNull ||
+			col.Default.GetNullAbility() != otherCol.Default.GetNullAbility() {
+			return false
+		}
+	}
+
+	pkNames := func(def *TableDef) string {
+		if def.Pkey == nil || def.Pkey.PkeyColName == catalog.FakePrimaryKeyColName {
+			return ""
+		}
+		return strings.ToLower(strings.Join(def.Pkey.Names, ","))
+	}
+	if pkNames(tableDef) != pkNames(other) {
+		return false
+	}
+
+	indexes := func(def *TableDef) []string {
+		keys := make([]string, 0, len(def.Indexes))
+		for _, index := range def.Indexes {
+			keys = append(keys, fmt.Sprintf("%s|%v|%s|%s", strings.ToLower(index.IndexName), index.Unique,
+				strings.ToLower(strings.Join(index.Parts, ",")), index.IndexAlgo))
+		}
+		sort.Strings(keys)
+		return keys
+	}
+	indexKeys, otherIndexKeys := indexes(tableDef), indexes(other)
+	if len(indexKeys) != len(otherIndexKeys) {
+		return false
+	}
+	for i := range indexKeys {
+		if indexKeys[i] != otherIndexKeys[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// checkAlterPartitionKeys checks that the partitioning key of the table is still included in
+// the primary key and all the unique keys after the new unique indexes are added.
+func checkAlterPartitionKeys(ctx CompilerContext, tableDef *TableDef, indexes []*plan.IndexDef) error {
+	partInfo := tableDef.GetPartition()
+	if partInfo == nil {
+		return nil
+	}
+
+	partitionBinder, err := newAlterPartitionBinder(ctx, tableDef)
+	if err != nil {
+		return err
+	}
+	partitionDef := DeepCopyPartitionByDef(partInfo)
+	if partitionDef.PartitionColumns == nil {
+		// Rebind the partition expression, so that its columns can be resolved by the binder
+		partitionBy, err := restorePartitionOption(ctx.GetContext(), partInfo)
+		if err != nil {
+			return err
+		}
+		var expr tree.Expr
+		switch partitionType := partitionBy.PartBy.PType.(type) {
+		case *tree.HashType:
+			expr = partitionType.Expr
+		case *tree.RangeType:
+			expr = partitionType.Expr
+		case *tree.ListType:
+			expr = partitionType.Expr
+		}
+		if expr == nil {
+			return nil
+		}
+		if err = buildPartitionExpr(ctx.GetContext(), tableDef, partitionBinder, partitionDef, expr); err != nil {
+			return err
+		}
+	}
+
+	newTableDef := &TableDef{
+		Name:    tableDef.Name,
+		Cols:    tableDef.Cols,
+		Pkey:    tableDef.Pkey,
+		Indexes: append(append([]*plan.IndexDef{}, tableDef.Indexes...), indexes...),
+	}
+	return checkPartitionKeys(ctx.GetContext(), partitionBinder.builder.nameByColRef, newTableDef, partitionDef)
+}
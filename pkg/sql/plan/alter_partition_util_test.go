@@ -0,0 +1,226 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	moruntime "github.com/matrixorigin/matrixone/pkg/common/runtime"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/util/executor"
+	"github.com/stretchr/testify/require"
+)
+
+func newAlterPartitionMockOptimizer() *MockOptimizer {
+	rt := moruntime.DefaultRuntime()
+	moruntime.SetupServiceBasedRuntime("", rt)
+	rt.SetGlobalVariables(moruntime.InternalSQLExecutor, executor.NewMemExecutor(func(sql string) (executor.Result, error) {
+		return executor.Result{}, nil
+	}))
+	return NewMockOptimizer(false)
+}
+
+func buildPartitionTableForAlter(t *testing.T, mock *MockOptimizer, sql string) *plan.AlterTable {
+	logicPlan, err := buildSingleStmt(mock, t, sql)
+	require.NoError(t, err)
+	tableDef := logicPlan.GetDdl().GetCreateTable().GetTableDef()
+	copyTableDef := DeepCopyTableDef(tableDef, true)
+	copyTableDef.Name += "_copy"
+	return &plan.AlterTable{
+		TableDef:     tableDef,
+		CopyTableDef: copyTableDef,
+	}
+}
+
+func parseAlterPartitionOption(t *testing.T, mock *MockOptimizer, sql string) tree.AlterPartitionOption {
+	stmt, err := mysql.ParseOne(mock.CurrentContext().GetContext(), sql, 1)
+	require.NoError(t, err)
+	return stmt.(*tree.AlterTable).PartitionOption
+}
+
+func TestDropTablePartitions(t *testing.T) {
+	mock := newAlterPartitionMockOptimizer()
+	ctx := mock.CurrentContext()
+	alterTable := buildPartitionTableForAlter(t, mock,
+		"create table t (a int, b int) partition by range (a) (partition p0 values less than (10), partition p1 values less than (20), partition p2 values less than (30))")
+
+	option := parseAlterPartitionOption(t, mock, "alter table t drop partition p1")
+	dropPartition, err := DropTablePartitions(ctx, alterTable, option.(*tree.AlterPartitionDropPartitionClause))
+	require.NoError(t, err)
+	require.Equal(t, []string{"p1"}, dropPartition.PartitionNames)
+	require.Equal(t, []string{alterTable.TableDef.Partition.PartitionTableNames[1]}, dropPartition.PartitionTableNames)
+
+	newDef := dropPartition.PartitionDef
+	require.Equal(t, uint64(2), newDef.PartitionNum)
+	require.Equal(t, "p0", newDef.Partitions[0].PartitionName)
+	require.Equal(t, "p2", newDef.Partitions[1].PartitionName)
+	require.Equal(t, uint32(2), newDef.Partitions[1].OrdinalPosition)
+	require.Equal(t, alterTable.TableDef.Partition.PartitionTableNames[2], newDef.PartitionTableNames[1])
+	require.Equal(t, "partition by range(a) (partition p0 values less than (10), partition p2 values less than (30))", newDef.PartitionMsg)
+	// the origin partition definition is not changed
+	require.Equal(t, uint64(3), alterTable.TableDef.Partition.PartitionNum)
+
+	sqls := []struct {
+		sql  string
+		code uint16
+	}{
+		{"alter table t drop partition p9", moerr.ErrDropPartitionNonExistent},
+		{"alter table t drop partition p0, p1, p2", moerr.ErrDropLastPartition},
+		{"alter table t drop partition all", moerr.ErrDropLastPartition},
+	}
+	for _, sql := range sqls {
+		option = parseAlterPartitionOption(t, mock, sql.sql)
+		_, err = DropTablePartitions(ctx, alterTable, option.(*tree.AlterPartitionDropPartitionClause))
+		require.True(t, moerr.IsMoErrCode(err, sql.code), sql.sql)
+	}
+
+	hashTable := buildPartitionTableForAlter(t, mock, "create table t (a int, b int) partition by hash (a) partitions 2")
+	option = parseAlterPartitionOption(t, mock, "alter table t drop partition p0")
+	_, err = DropTablePartitions(ctx, hashTable, option.(*tree.AlterPartitionDropPartitionClause))
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrOnlyOnRangeListPartition))
+}
+
+func TestTruncateTablePartitions(t *testing.T) {
+	mock := newAlterPartitionMockOptimizer()
+	ctx := mock.CurrentContext()
+	alterTable := buildPartitionTableForAlter(t, mock, "create table t (a int, b int) partition by key (a) partitions 3")
+
+	option := parseAlterPartitionOption(t, mock, "alter table t truncate partition p2, p0")
+	truncatePartition, err := TruncateTablePartitions(ctx, alterTable, option.(*tree.AlterPartitionTruncatePartitionClause))
+	require.NoError(t, err)
+	require.Equal(t, []string{"p2", "p0"}, truncatePartition.PartitionNames)
+
+	option = parseAlterPartitionOption(t, mock, "alter table t truncate partition all")
+	truncatePartition, err = TruncateTablePartitions(ctx, alterTable, option.(*tree.AlterPartitionTruncatePartitionClause))
+	require.NoError(t, err)
+	require.Equal(t, alterTable.TableDef.Partition.PartitionTableNames, truncatePartition.PartitionTableNames)
+
+	option = parseAlterPartitionOption(t, mock, "alter table t truncate partition p3")
+	_, err = TruncateTablePartitions(ctx, alterTable, option.(*tree.AlterPartitionTruncatePartitionClause))
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrUnknownPartition))
+}
+
+func TestRebuildTablePartitions(t *testing.T) {
+	mock := newAlterPartitionMockOptimizer()
+	ctx := mock.CurrentContext()
+	rangeSql := "create table t (a int, b int) partition by range (a) (partition p0 values less than (10), partition p1 values less than (20), partition p2 values less than (30))"
+	hashSql := "create table t (a int, b int) partition by hash (a) partitions 2"
+
+	cases := []struct {
+		create string
+		alter  string
+		msg    string
+		code   uint16
+	}{
+		{rangeSql, "alter table t reorganize partition p0, p1 into (partition q0 values less than (5), partition q1 values less than (20))",
+			"partition by range(a) (partition q0 values less than (5), partition q1 values less than (20), partition p2 values less than (30))", 0},
+		{rangeSql, "alter table t reorganize partition p2 into (partition q2 values less than (40))",
+			"partition by range(a) (partition p0 values less than (10), partition p1 values less than (20), partition q2 values less than (40))", 0},
+		{rangeSql, "alter table t reorganize partition p0, p2 into (partition q0 values less than (30))", "", moerr.ErrConsecutiveReorgPartitions},
+		{rangeSql, "alter table t reorganize partition p0 into (partition q0 values less than (15))", "", moerr.ErrReorgOutsideRange},
+		{rangeSql, "alter table t reorganize partition p9 into (partition q0 values less than (10))", "", moerr.ErrDropPartitionNonExistent},
+		{rangeSql, "alter table t coalesce partition 1", "", moerr.ErrCoalesceOnlyOnHashPartition},
+		{rangeSql, "alter table t remove partitioning", "", 0},
+		{hashSql, "alter table t add partition partitions 2", "partition by hash (a) partitions 4", 0},
+		{hashSql, "alter table t add partition (partition x)", "partition by hash (a) partitions 3 (partition p0, partition p1, partition x)", 0},
+		{hashSql, "alter table t coalesce partition 1", "partition by hash (a) partitions 1", 0},
+		{hashSql, "alter table t coalesce partition 2", "", moerr.ErrDropLastPartition},
+		{hashSql, "alter table t reorganize partition p0 into (partition q0)", "", moerr.ErrOnlyOnRangeListPartition},
+	}
+	for _, c := range cases {
+		alterTable := buildPartitionTableForAlter(t, mock, c.create)
+		option := parseAlterPartitionOption(t, mock, c.alter)
+		require.True(t, isPartitionRebuild(option, alterTable.TableDef), c.alter)
+
+		err := RebuildTablePartitions(ctx, alterTable, option)
+		if c.code != 0 {
+			require.True(t, moerr.IsMoErrCode(err, c.code), c.alter)
+			continue
+		}
+		require.NoError(t, err, c.alter)
+		if c.msg == "" {
+			require.Nil(t, alterTable.CopyTableDef.Partition)
+		} else {
+			require.Equal(t, c.msg, alterTable.CopyTableDef.Partition.PartitionMsg)
+		}
+	}
+
+	alterTable := buildPartitionTableForAlter(t, mock, rangeSql)
+	option := parseAlterPartitionOption(t, mock, "alter table t add partition (partition p3 values less than (40))")
+	require.False(t, isPartitionRebuild(option, alterTable.TableDef))
+}
+
+func TestDropPrimaryKeyOfPartitionTable(t *testing.T) {
+	mock := newAlterPartitionMockOptimizer()
+	ctx := mock.CurrentContext()
+	alterTable := buildPartitionTableForAlter(t, mock,
+		"create table t (a int not null primary key, b int) partition by range columns (a) (partition p0 values less than (10), partition p1 values less than (20))")
+
+	// the table is copied without the primary key, and the partitions are kept.
+	require.NoError(t, DropPrimaryKey(ctx, alterTable, nil))
+	alterTable.CopyTableDef.TableType = catalog.SystemOrdinaryRel
+	sql, _, err := ConstructCreateTableSQL(ctx, alterTable.CopyTableDef, nil, false)
+	require.NoError(t, err)
+	require.Equal(t, "CREATE TABLE `t_copy` (\n  `a` int NOT NULL,\n  `b` int DEFAULT NULL\n) "+
+		"partition by range columns (a) (partition p0 values less than (10), partition p1 values less than (20))", sql)
+}
+
+func TestIsSameTableStructure(t *testing.T) {
+	mock := newAlterPartitionMockOptimizer()
+	tableDef := buildPartitionTableForAlter(t, mock,
+		"create table t (a int primary key, b varchar(10), key kb (b)) partition by hash (a) partitions 2").TableDef
+
+	sqls := []struct {
+		sql  string
+		same bool
+	}{
+		{"create table t (a int primary key, b varchar(10), key kb (b))", true},
+		{"create table t (a int primary key, b varchar(10))", false},
+		{"create table t (a int primary key, b varchar(20), key kb (b))", false},
+		{"create table t (a int, b varchar(10), key kb (b))", false},
+		{"create table t (b varchar(10), a int primary key, key kb (b))", false},
+	}
+	for _, sql := range sqls {
+		logicPlan, err := buildSingleStmt(mock, t, sql.sql)
+		require.NoError(t, err)
+		other := logicPlan.GetDdl().GetCreateTable().GetTableDef()
+		require.Equal(t, sql.same, isSameTableStructure(tableDef, other), sql.sql)
+	}
+}
+
+func TestPartitionFilter(t *testing.T) {
+	mock := newAlterPartitionMockOptimizer()
+	cases := []struct {
+		create string
+		filter string
+	}{
+		{"create table t (a int, b int) partition by range (a) (partition p0 values less than (10), partition p1 values less than (20), partition p2 values less than (30))",
+			"coalesce((case when a < 10 then 0 when a < 20 then 1 when a < 30 then 2 else -1 end) = 1, false)"},
+		{"create table t (a int, b int) partition by hash (a) partitions 2",
+			"coalesce((abs(hash_value(a)) % 2) = 1, false)"},
+		{"create table t (a int, b varchar(10)) partition by list columns (b) (partition p0 values in ('x'), partition p1 values in ('y'))",
+			"coalesce((case when b = 'x' then 0 when b = 'y' then 1 else -1 end) = 1, false)"},
+	}
+	for _, c := range cases {
+		alterTable := buildPartitionTableForAlter(t, mock, c.create)
+		filter, err := partitionFilter(mock.CurrentContext().GetContext(), alterTable.TableDef.Partition, 1)
+		require.NoError(t, err, c.create)
+		require.Equal(t, c.filter, filter, c.create)
+	}
+}
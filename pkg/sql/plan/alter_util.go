@@ -15,6 +15,7 @@
 package plan
 
 import (
+	"context"
 	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/catalog"
@@ -32,6 +33,9 @@ func checkDropColumnWithPrimaryKey(colName string, pkey *plan.PrimaryKeyDef, ctx
 }
 
 func checkDropColumnWithIndex(colName string, indexes []*plan.IndexDef, ctx CompilerContext) error {
+	if err := checkAlterColumnWithExprKeyPart(ctx.GetContext(), colName, indexes); err != nil {
+		return err
+	}
 	for _, indexInfo := range indexes {
 		if indexInfo.TableExist {
 			for _, column := range indexInfo.Parts {
@@ -45,6 +49,28 @@ func checkDropColumnWithIndex(colName string, indexes []*plan.IndexDef, ctx Comp
 	return nil
 }
 
+// checkAlterColumnWithExprKeyPart checks whether the column is referenced by an expression key part,
+// such a column can not be dropped or renamed.
+func checkAlterColumnWithExprKeyPart(ctx context.Context, colName string, indexes []*plan.IndexDef) error {
+	for _, indexInfo := range indexes {
+		for _, part := range indexInfo.Parts {
+			if !catalog.IsExprKeyPart(part) {
+				continue
+			}
+			cols, err := getExprKeyPartCols(ctx, part)
+			if err != nil {
+				return err
+			}
+			for _, col := range cols {
+				if strings.EqualFold(col, colName) {
+					return moerr.NewInvalidInputf(ctx, "column '%s' has a functional index dependency and cannot be dropped or renamed", colName)
+				}
+			}
+		}
+	}
+	return nil
+}
+
 func checkAlterColumnWithForeignKey(colName string, RefChildTbls []uint64, Fkeys []*ForeignKeyDef, ctx CompilerContext) error {
 	if len(RefChildTbls) > 0 || len(Fkeys) > 0 {
 		// We do not support drop column that dependent foreign keys constraints
@@ -19,6 +19,7 @@ import (
 	"sort"
 
 	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 )
@@ -309,11 +310,22 @@ func (builder *QueryBuilder) applyIndicesForFiltersRegularIndex(nodeID int32, no
 		pkPos = node.TableDef.Name2ColIndex[node.TableDef.Pkey.Names[0]]
 	}
 
-	indexes := node.TableDef.Indexes
-	sort.Slice(indexes, func(i, j int) bool {
+	sort.Slice(node.TableDef.Indexes, func(i, j int) bool {
+		indexes := node.TableDef.Indexes
 		return (indexes[i].Unique && !indexes[j].Unique) || (indexes[i].Unique == indexes[j].Unique && len(indexes[i].Parts) > len(indexes[j].Parts))
 	})
 
+	// the indices with expression key parts can only be applied by matching the expressions
+	indexes := make([]*plan.IndexDef, 0, len(node.TableDef.Indexes))
+	exprIndexes := make([]*plan.IndexDef, 0)
+	for _, idxDef := range node.TableDef.Indexes {
+		if catalog.HasExprKeyPart(idxDef.Parts) {
+			exprIndexes = append(exprIndexes, idxDef)
+		} else {
+			indexes = append(indexes, idxDef)
+		}
+	}
+
 	// Apply unique/secondary indices if only indexed column is referenced
 
 	{
@@ -584,6 +596,12 @@ func (builder *QueryBuilder) applyIndicesForFiltersRegularIndex(nodeID int32, no
 	}
 
 END0:
+	if len(exprIndexes) > 0 {
+		if joinNodeID, ok := builder.applyExprIndicesForPointSelect(nodeID, node, exprIndexes, scanSnapshot); ok {
+			return joinNodeID
+		}
+	}
+
 	if catalog.IsFakePkName(node.TableDef.Pkey.PkeyColName) {
 		// for cluster by table, make it less prone to go index
 		if node.Stats.Selectivity > 0.0001 || node.Stats.Outcnt > 1000 {
@@ -818,7 +836,7 @@ END0:
 		}
 
 		idxTag := builder.genNewTag()
-		idxDef := node.TableDef.Indexes[idxPos]
+		idxDef := indexes[idxPos]
 		//idxObjRef, idxTableDef := builder.compCtx.Resolve(node.ObjRef.SchemaName, idxDef.IndexTableName, *ts)
 		idxObjRef, idxTableDef := builder.compCtx.Resolve(node.ObjRef.SchemaName, idxDef.IndexTableName, scanSnapshot)
 		builder.addNameByColRef(idxTag, idxTableDef)
@@ -901,6 +919,233 @@ END0:
 	return nodeID
 }
 
+// applyExprIndicesForPointSelect applies the unique/secondary indices with expression key parts
+// for point select. A key part matches a filter like expr = const on the same expression, or is
+// evaluated on the constants if all its columns are filtered by col = const.
+func (builder *QueryBuilder) applyExprIndicesForPointSelect(nodeID int32, node *plan.Node, indexes []*plan.IndexDef, scanSnapshot *Snapshot) (int32, bool) {
+	sid := builder.compCtx.GetProcess().GetService()
+
+	col2Value := make(map[int32]*plan.Expr)
+	for _, expr := range node.FilterList {
+		fn := expr.GetF()
+		if fn == nil || fn.Func.ObjName != "=" {
+			continue
+		}
+		col := fn.Args[0].GetCol()
+		if col != nil && isRuntimeConstExpr(fn.Args[1]) && fn.Args[0].Typ.Id == fn.Args[1].Typ.Id {
+			col2Value[col.ColPos] = fn.Args[1]
+		}
+	}
+
+	filterOnPK := true
+	for _, part := range node.TableDef.Pkey.Names {
+		if _, ok := col2Value[node.TableDef.Name2ColIndex[part]]; !ok {
+			filterOnPK = false
+			break
+		}
+	}
+	if filterOnPK {
+		return nodeID, false
+	}
+
+	for _, idxDef := range indexes {
+		if !idxDef.TableExist {
+			continue
+		}
+
+		numParts := len(idxDef.Parts)
+		numKeyParts := numParts
+		if !idxDef.Unique {
+			numKeyParts--
+			if node.Stats.Selectivity > InFilterSelectivityLimit || node.Stats.Outcnt > float64(GetInFilterCardLimitOnPK(sid, node.Stats.TableCnt)) {
+				continue
+			}
+		}
+
+		keyValues := make([]*plan.Expr, 0, numKeyParts)
+		for i := 0; i < numKeyParts; i++ {
+			value := builder.matchIndexKeyPartValue(node, idxDef.Parts[i], col2Value)
+			if value == nil {
+				break
+			}
+			keyValues = append(keyValues, value)
+		}
+		if len(keyValues) == 0 || (idxDef.Unique && len(keyValues) < numParts) {
+			continue
+		}
+
+		idxTag := builder.genNewTag()
+		idxObjRef, idxTableDef := builder.compCtx.Resolve(node.ObjRef.SchemaName, idxDef.IndexTableName, scanSnapshot)
+		builder.addNameByColRef(idxTag, idxTableDef)
+
+		idxColExpr := &plan.Expr{
+			Typ: idxTableDef.Cols[0].Typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: idxTag,
+					ColPos: 0,
+				},
+			},
+		}
+
+		var idxFilter *plan.Expr
+		var err error
+		if numParts == 1 {
+			idxFilter, err = BindFuncExprImplByPlanExpr(builder.GetContext(), "=", []*plan.Expr{idxColExpr, keyValues[0]})
+		} else {
+			var rightArg *plan.Expr
+			if rightArg, err = BindFuncExprImplByPlanExpr(builder.GetContext(), "serial", keyValues); err != nil {
+				continue
+			}
+			funcName := "="
+			if len(keyValues) < numParts {
+				funcName = "prefix_eq"
+			}
+			idxFilter, err = BindFuncExprImplByPlanExpr(builder.GetContext(), funcName, []*plan.Expr{idxColExpr, rightArg})
+		}
+		if err != nil {
+			continue
+		}
+		estimateExprSelectivity(idxFilter, builder, nil)
+
+		idxTableNode := &plan.Node{
+			NodeType:     plan.Node_TABLE_SCAN,
+			TableDef:     idxTableDef,
+			ObjRef:       idxObjRef,
+			ParentObjRef: DeepCopyObjectRef(node.ObjRef),
+			FilterList:   []*plan.Expr{idxFilter},
+			BindingTags:  []int32{idxTag},
+			ScanSnapshot: node.ScanSnapshot,
+		}
+		idxTableNodeID := builder.appendNode(idxTableNode, builder.ctxByNode[nodeID])
+
+		pkIdx := node.TableDef.Name2ColIndex[node.TableDef.Pkey.PkeyColName]
+		pkExpr := &plan.Expr{
+			Typ: node.TableDef.Cols[pkIdx].Typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: node.BindingTags[0],
+					ColPos: pkIdx,
+				},
+			},
+		}
+
+		joinCond, _ := BindFuncExprImplByPlanExpr(builder.GetContext(), "=", []*plan.Expr{
+			pkExpr,
+			{
+				Typ: pkExpr.Typ,
+				Expr: &plan.Expr_Col{
+					Col: &plan.ColRef{
+						RelPos: idxTag,
+						ColPos: 1,
+					},
+				},
+			},
+		})
+		joinNode := &plan.Node{
+			NodeType: plan.Node_JOIN,
+			Children: []int32{nodeID, idxTableNodeID},
+			JoinType: plan.Node_INDEX,
+			OnList:   []*plan.Expr{joinCond},
+			Limit:    node.Limit,
+			Offset:   node.Offset,
+		}
+		joinNodeID := builder.appendNode(joinNode, builder.ctxByNode[nodeID])
+		node.Limit, node.Offset = nil, nil
+
+		return joinNodeID, true
+	}
+
+	return nodeID, false
+}
+
+// matchIndexKeyPartValue returns the value of the index key part given by the filters of the scan node,
+// or nil if the filters do not decide it.
+func (builder *QueryBuilder) matchIndexKeyPartValue(node *plan.Node, part string, col2Value map[int32]*plan.Expr) *plan.Expr {
+	if !catalog.IsExprKeyPart(part) {
+		if value, ok := col2Value[node.TableDef.Name2ColIndex[part]]; ok {
+			return DeepCopyExpr(value)
+		}
+		return nil
+	}
+
+	keyExpr, err := bindIndexExprKeyPart(builder, part, node.TableDef.Cols, node.BindingTags[0], nil)
+	if err != nil {
+		return nil
+	}
+
+	for _, expr := range node.FilterList {
+		fn := expr.GetF()
+		if fn == nil || fn.Func.ObjName != "=" {
+			continue
+		}
+		if isSameIndexKeyExpr(fn.Args[0], keyExpr) && isRuntimeConstExpr(fn.Args[1]) {
+			return DeepCopyExpr(fn.Args[1])
+		}
+		if isSameIndexKeyExpr(fn.Args[1], keyExpr) && isRuntimeConstExpr(fn.Args[0]) {
+			return DeepCopyExpr(fn.Args[0])
+		}
+	}
+
+	// all columns of the key part are filtered by col = const, evaluate the key part on the constants
+	projMap := make(map[[2]int32]*plan.Expr)
+	var collect func(e *plan.Expr) bool
+	collect = func(e *plan.Expr) bool {
+		switch impl := e.Expr.(type) {
+		case *plan.Expr_Col:
+			value, ok := col2Value[impl.Col.ColPos]
+			if !ok {
+				return false
+			}
+			projMap[[2]int32{impl.Col.RelPos, impl.Col.ColPos}] = value
+		case *plan.Expr_F:
+			for _, arg := range impl.F.Args {
+				if !collect(arg) {
+					return false
+				}
+			}
+		}
+		return true
+	}
+	if !collect(keyExpr) {
+		return nil
+	}
+	value := replaceColumnsForExpr(keyExpr, projMap)
+	if folded, err := ConstantFold(batch.EmptyForConstFoldBatch, value, builder.compCtx.GetProcess(), false, true); err == nil {
+		value = folded
+	}
+	return value
+}
+
+// isSameIndexKeyExpr checks whether the filter expression is the same as the bound index key part.
+func isSameIndexKeyExpr(expr, keyExpr *plan.Expr) bool {
+	if expr.Typ.Id != keyExpr.Typ.Id {
+		return false
+	}
+	switch impl := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		col := keyExpr.GetCol()
+		return col != nil && impl.Col.RelPos == col.RelPos && impl.Col.ColPos == col.ColPos
+	case *plan.Expr_Lit:
+		lit := keyExpr.GetLit()
+		return lit != nil && impl.Lit.String() == lit.String()
+	case *plan.Expr_T:
+		return keyExpr.GetT() != nil && expr.Typ.Width == keyExpr.Typ.Width && expr.Typ.Scale == keyExpr.Typ.Scale
+	case *plan.Expr_F:
+		fn := keyExpr.GetF()
+		if fn == nil || impl.F.Func.Obj != fn.Func.Obj || len(impl.F.Args) != len(fn.Args) {
+			return false
+		}
+		for i := range fn.Args {
+			if !isSameIndexKeyExpr(impl.F.Args[i], fn.Args[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
 func (builder *QueryBuilder) applyIndicesForJoins(nodeID int32, node *plan.Node, colRefCnt map[[2]int32]int, idxColMap map[[2]int32]*plan.Expr) int32 {
 	sid := builder.compCtx.GetProcess().GetService()
 
@@ -973,7 +1218,7 @@ func (builder *QueryBuilder) applyIndicesForJoins(nodeID int32, node *plan.Node,
 	indexes := leftChild.TableDef.Indexes
 	condIdx := make([]int, 0, len(col2Cond))
 	for _, idxDef := range indexes {
-		if !idxDef.TableExist {
+		if !idxDef.TableExist || catalog.HasExprKeyPart(idxDef.Parts) {
 			continue
 		}
 
@@ -91,15 +91,22 @@ func (b *baseBinder) baseBindExpr(astExpr tree.Expr, depth int32, isRoot bool) (
 		// check existence
 		if b.GetContext() != nil && b.GetContext().Value(defines.InSp{}) != nil && b.GetContext().Value(defines.InSp{}).(bool) {
 			tmpScope := b.GetContext().Value(defines.VarScopeKey{}).(*[]map[string]interface{})
+			varName := exprImpl.ColName()
+			// NEW.col and OLD.col of the trigger body
+			if exprImpl.NumParts == 2 {
+				if tblName := strings.ToLower(exprImpl.TblName()); tblName == "new" || tblName == "old" {
+					varName = tblName + "." + varName
+				}
+			}
 			for i := len(*tmpScope) - 1; i >= 0; i-- {
 				curScope := (*tmpScope)[i]
-				if _, ok := curScope[exprImpl.ColName()]; ok {
+				if _, ok := curScope[varName]; ok {
 					typ := types.T_text.ToType()
 					expr = &Expr{
 						Typ: makePlan2Type(&typ),
 						Expr: &plan.Expr_V{
 							V: &plan.VarRef{
-								Name:   exprImpl.ColName(),
+								Name:   varName,
 								System: false,
 								Global: false,
 							},
@@ -115,6 +122,9 @@ func (b *baseBinder) baseBindExpr(astExpr tree.Expr, depth int32, isRoot bool) (
 	case *tree.SerialExtractExpr:
 		expr, err = b.bindFuncExprImplByAstExpr("serial_extract", []tree.Expr{astExpr}, depth)
 
+	case *tree.FullTextMatchExpr:
+		expr, err = b.bindFullTextMatch(exprImpl, depth)
+
 	case *tree.CastExpr:
 		expr, err = b.impl.BindExpr(exprImpl.Expr, depth, false)
 		if err != nil {
@@ -379,6 +389,15 @@ func (b *baseBinder) baseBindColRef(astExpr *tree.UnresolvedName, depth int32, i
 	if colPos != NotFound {
 		b.boundCols = append(b.boundCols, table+"."+col)
 
+		if virtual := b.ctx.bindingByTag[relPos].virtualCols[colPos]; virtual != nil {
+			// the virtual generated column is computed from the other columns
+			expr = DeepCopyExpr(virtual)
+			if depth > 0 {
+				expr = toCorrColRefs(expr, depth)
+			}
+			return
+		}
+
 		expr = &plan.Expr{
 			Typ: *typ,
 		}
@@ -90,6 +90,9 @@ func buildAddColumnAndConstraint(ctx CompilerContext, alterPlan *plan.AlterTable
 	if err != nil {
 		return nil, err
 	}
+	if err = checkNoGeneratedAttr(ctx.GetContext(), specNewColumn); err != nil {
+		return nil, err
+	}
 
 	newCol := &ColDef{
 		ColId: math.MaxUint64,
@@ -320,6 +323,9 @@ func DropColumn(ctx CompilerContext, alterPlan *plan.AlterTable, colName string,
 		return moerr.NewErrCantDropFieldOrKey(ctx.GetContext(), colName)
 	}
 
+	if err := checkAlterColumnWithExprKeyPart(ctx.GetContext(), colName, tableDef.Indexes); err != nil {
+		return err
+	}
 	// We only support dropping column with single-value none Primary Key index covered now.
 	if err := handleDropColumnWithIndex(ctx.GetContext(), colName, tableDef); err != nil {
 		return err
@@ -404,7 +410,7 @@ func handleDropColumnWithIndex(ctx context.Context, colName string, tbInfo *Tabl
 					// remove 3 index records: metadata, centroids, entries
 					tbInfo.Indexes = append(tbInfo.Indexes[:i], tbInfo.Indexes[i+3:]...)
 				}
-			case catalog.MOIndexMasterAlgo.ToString():
+			case catalog.MOIndexMasterAlgo.ToString(), catalog.MOIndexFullTextAlgo.ToString():
 				if len(indexInfo.Parts) == 0 {
 					// TODO: verify this
 					tbInfo.Indexes = append(tbInfo.Indexes[:i], tbInfo.Indexes[i+1:]...)
@@ -477,7 +483,7 @@ func checkDropColumnWithPartition(ctx context.Context, tbInfo *TableDef, colName
 		partition := tbInfo.Partition
 		// TODO Implement this method in the future to obtain the partition column in the partition expression
 		// func (m *PartitionByDef) GetPartitionColumnNames() []string
-		for _, name := range partition.GetPartitionColumns().PartitionColumns {
+		for _, name := range partition.GetPartitionColumns().GetPartitionColumns() {
 			if strings.EqualFold(name, colName) {
 				return moerr.NewErrDependentByPartitionFunction(ctx, colName)
 			}
@@ -118,6 +118,9 @@ func buildChangeColumnAndConstraint(ctx CompilerContext, alterPlan *plan.AlterTa
 	if err != nil {
 		return nil, err
 	}
+	if err = checkNoGeneratedAttr(ctx.GetContext(), specNewColumn); err != nil {
+		return nil, err
+	}
 
 	newCol := &ColDef{
 		ColId:      oldCol.ColId,
@@ -228,6 +231,9 @@ func buildChangeColumnAndConstraint(ctx CompilerContext, alterPlan *plan.AlterTa
 	// If the column name of the table changes, it is necessary to check if it is associated
 	// with the index key. If it is an index key column, column name replacement is required.
 	if newColName != oldCol.Name {
+		if err := checkAlterColumnWithExprKeyPart(ctx.GetContext(), oldCol.Name, alterPlan.CopyTableDef.Indexes); err != nil {
+			return nil, err
+		}
 		for _, indexInfo := range alterPlan.CopyTableDef.Indexes {
 			for j, partCol := range indexInfo.Parts {
 				partCol = catalog.ResolveAlias(partCol)
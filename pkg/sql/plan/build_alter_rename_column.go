@@ -61,6 +61,10 @@ func RenameColumn(ctx CompilerContext, alterPlan *plan.AlterTable, spec *tree.Al
 			return moerr.NewErrDupFieldName(ctx.GetContext(), newColNameOrigin)
 		}
 
+		if err := checkAlterColumnWithExprKeyPart(ctx.GetContext(), oldColName, alterPlan.CopyTableDef.Indexes); err != nil {
+			return err
+		}
+
 		// If the column name of the table changes, it is necessary to check if it is associated
 		// with the index key. If it is an index key column, column name replacement is required.
 		for _, indexInfo := range alterPlan.CopyTableDef.Indexes {
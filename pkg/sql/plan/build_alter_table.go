@@ -94,6 +94,8 @@ func buildAlterTableCopy(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, err
 				return nil, moerr.NewInvalidInputf(ctx.GetContext(), "Do not support this stmt now. %v", optionAdd)
 			case *tree.ColumnTableDef:
 				return nil, moerr.NewInvalidInputf(ctx.GetContext(), "Do not support this stmt now. %v", optionAdd)
+			case *tree.CheckIndex:
+				err = AddCheck(ctx, alterTablePlan, optionAdd, alterTableCtx)
 			default:
 				return nil, moerr.NewInvalidInputf(ctx.GetContext(), "Do not support this stmt now. %v", optionAdd)
 			}
@@ -110,6 +112,8 @@ func buildAlterTableCopy(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, err
 				err = DropPrimaryKey(ctx, alterTablePlan, alterTableCtx)
 			case tree.AlterTableDropForeignKey:
 				return nil, moerr.NewInvalidInputf(ctx.GetContext(), "Do not support this stmt now. %v", option)
+			case tree.AlterTableDropCheck:
+				err = DropCheck(ctx, alterTablePlan, string(option.Name), alterTableCtx)
 			default:
 				return nil, moerr.NewInvalidInputf(ctx.GetContext(), "Do not support this stmt now. %v", option)
 			}
@@ -143,13 +147,24 @@ func buildAlterTableCopy(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, err
 		}
 	}
 
+	if stmt.PartitionOption != nil {
+		if err = RebuildTablePartitions(ctx, alterTablePlan, stmt.PartitionOption); err != nil {
+			return nil, err
+		}
+	}
+
+	// the check constraints must still be valid on the columns of the copy table
+	if err = checkCheckConstraints(ctx, copyTableDef); err != nil {
+		return nil, err
+	}
+
 	createTmpDdl, _, err := ConstructCreateTableSQL(ctx, copyTableDef, snapshot, true)
 	if err != nil {
 		return nil, err
 	}
 	alterTablePlan.CreateTmpTableSql = createTmpDdl
 
-	insertTmpDml, err := buildAlterInsertDataSQL(ctx, alterTableCtx)
+	insertTmpDml, err := buildAlterInsertDataSQL(ctx, alterTableCtx, copyTableDef)
 	if err != nil {
 		return nil, err
 	}
@@ -171,16 +186,27 @@ func buildAlterTableCopy(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, err
 	}, nil
 }
 
-func buildAlterInsertDataSQL(ctx CompilerContext, alterCtx *AlterTableContext) (string, error) {
+func buildAlterInsertDataSQL(ctx CompilerContext, alterCtx *AlterTableContext, copyTableDef *TableDef) (string, error) {
 	schemaName := alterCtx.schemaName
 	originTableName := alterCtx.originTableName
 	copyTableName := alterCtx.copyTableName
 
+	// the generated columns of the copy table are computed by the insert
+	generatedCols := make(map[string]bool)
+	for _, col := range copyTableDef.Cols {
+		if isGeneratedCol(col) {
+			generatedCols[col.Name] = true
+		}
+	}
+
 	insertBuffer := bytes.NewBufferString("")
 	selectBuffer := bytes.NewBufferString("")
 
 	isFirst := true
 	for key, value := range alterCtx.alterColMap {
+		if generatedCols[key] {
+			continue
+		}
 		if isFirst {
 			insertBuffer.WriteString("`" + key + "`")
 			if value.sexprType == columnName {
@@ -303,14 +329,14 @@ func buildAlterTable(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, error)
 		return nil, moerr.NewInternalError(ctx.GetContext(), "only the sys account can alter the cluster table")
 	}
 
-	if tableDef.Partition != nil && stmt.Options != nil {
-		return nil, moerr.NewInvalidInput(ctx.GetContext(), "can't add/drop column for partition table now")
-	}
-
 	if stmt.PartitionOption != nil {
 		if stmt.Options != nil {
 			return nil, moerr.NewParseError(ctx.GetContext(), "Unsupported multi schema change")
 		}
+		// The partition operations which redistribute rows among partitions rebuild the whole table
+		if isPartitionRebuild(stmt.PartitionOption, tableDef) {
+			return buildAlterTableCopy(stmt, ctx)
+		}
 		return buildAlterTableInplace(stmt, ctx)
 	}
 
@@ -338,6 +364,9 @@ func ResolveAlterTableAlgorithm(ctx context.Context, validAlterSpecs []tree.Alte
 				algorithm = plan.AlterTable_INPLACE
 			case *tree.ColumnTableDef:
 				algorithm = plan.AlterTable_INPLACE
+			case *tree.CheckIndex:
+				// the rows of the table are checked when they are copied
+				algorithm = plan.AlterTable_COPY
 			default:
 				algorithm = plan.AlterTable_INPLACE
 			}
@@ -353,6 +382,8 @@ func ResolveAlterTableAlgorithm(ctx context.Context, validAlterSpecs []tree.Alte
 				algorithm = plan.AlterTable_COPY
 			case tree.AlterTableDropForeignKey:
 				algorithm = plan.AlterTable_INPLACE
+			case tree.AlterTableDropCheck:
+				algorithm = plan.AlterTable_INPLACE
 			default:
 				algorithm = plan.AlterTable_INPLACE
 			}
@@ -179,6 +179,9 @@ func getUpdateTableInfo(ctx CompilerContext, stmt *tree.Update) (*dmlTableInfo,
 		newTblInfo.tableDefs = append(newTblInfo.tableDefs, tblDef)
 		newTblInfo.isClusterTable = append(newTblInfo.isClusterTable, tblInfo.isClusterTable[idx])
 		newTblInfo.alias[alias] = len(newTblInfo.tableDefs) - 1
+		if err = addGeneratedColsToUpdate(ctx.GetContext(), tblDef, columns); err != nil {
+			return nil, err
+		}
 		newTblInfo.updateKeys = append(newTblInfo.updateKeys, columns)
 
 		if !newTblInfo.haveConstraint {
@@ -390,6 +393,13 @@ func initInsertStmt(builder *QueryBuilder, bindCtx *BindContext, stmt *tree.Inse
 	if stmt.Columns != nil {
 		syntaxHasColumnNames = true
 	}
+	// generated columns only accept DEFAULT, their values are computed below
+	var generatedColIdxs []int
+	for i, column := range insertColumns {
+		if isGeneratedCol(tableDef.Cols[colToIdx[column]]) {
+			generatedColIdxs = append(generatedColIdxs, i)
+		}
+	}
 
 	var astSlt *tree.Select
 	switch slt := stmt.Rows.Select.(type) {
@@ -420,12 +430,27 @@ func initInsertStmt(builder *QueryBuilder, bindCtx *BindContext, stmt *tree.Inse
 		if isAllDefault && syntaxHasColumnNames {
 			return false, nil, nil, moerr.NewInvalidInput(builder.GetContext(), "insert values does not match the number of columns")
 		}
+		if len(generatedColIdxs) > 0 {
+			if !isAllDefault {
+				for _, row := range slt.Rows {
+					for _, idx := range generatedColIdxs {
+						if _, ok := row[idx].(*tree.DefaultVal); !ok {
+							return false, nil, nil, newGeneratedColWriteError(builder.GetContext(), insertColumns[idx], tableDef.Name)
+						}
+					}
+				}
+			}
+			slt, insertColumns = removeGeneratedColsFromValues(slt, insertColumns, generatedColIdxs, isAllDefault)
+		}
 		err = buildValueScan(isAllDefault, info, builder, bindCtx, tableDef, slt, insertColumns, colToIdx, stmt.OnDuplicateUpdate)
 		if err != nil {
 			return false, nil, nil, err
 		}
 
 	case *tree.SelectClause:
+		if len(generatedColIdxs) > 0 && !builder.isRestore {
+			return false, nil, nil, newGeneratedColWriteError(builder.GetContext(), insertColumns[generatedColIdxs[0]], tableDef.Name)
+		}
 		astSlt = stmt.Rows
 
 		subCtx := NewBindContext(builder, bindCtx)
@@ -436,6 +461,9 @@ func initInsertStmt(builder *QueryBuilder, bindCtx *BindContext, stmt *tree.Inse
 		ifInsertFromUniqueColMap = make(map[string]bool)
 
 	case *tree.ParenSelect:
+		if len(generatedColIdxs) > 0 && !builder.isRestore {
+			return false, nil, nil, newGeneratedColWriteError(builder.GetContext(), insertColumns[generatedColIdxs[0]], tableDef.Name)
+		}
 		astSlt = slt.Select
 
 		subCtx := NewBindContext(builder, bindCtx)
@@ -506,6 +534,10 @@ func initInsertStmt(builder *QueryBuilder, bindCtx *BindContext, stmt *tree.Inse
 	insertColToExpr := make(map[string]*Expr)
 	for i, column := range insertColumns {
 		colIdx := colToIdx[column]
+		if isGeneratedCol(tableDef.Cols[colIdx]) {
+			// the restored value is recomputed like any other insert
+			continue
+		}
 		projExpr := &plan.Expr{
 			Typ: oldProject[i].Typ,
 			Expr: &plan.Expr_Col{
@@ -545,6 +577,11 @@ func initInsertStmt(builder *QueryBuilder, bindCtx *BindContext, stmt *tree.Inse
 		if idx.Unique {
 			withoutUniqueCol := true
 			for _, name := range idx.Parts {
+				if catalog.IsExprKeyPart(name) || isGeneratedCol(tableDef.Cols[tableDef.Name2ColIndex[name]]) {
+					// the expression may have a value even if its columns are not inserted
+					withoutUniqueCol = false
+					break
+				}
 				_, ok := insertColToExpr[name]
 				if ok {
 					withoutUniqueCol = false
@@ -588,6 +625,9 @@ func initInsertStmt(builder *QueryBuilder, bindCtx *BindContext, stmt *tree.Inse
 			// 	}
 			// }
 			// }
+		} else if isGeneratedCol(col) {
+			// computed by computeGeneratedCols once all the columns are known
+			projectList = append(projectList, virtualColNullExpr(col))
 		} else {
 			defExpr, err := getDefaultExpr(builder.GetContext(), col)
 			if err != nil {
@@ -603,6 +643,11 @@ func initInsertStmt(builder *QueryBuilder, bindCtx *BindContext, stmt *tree.Inse
 			projectList = append(projectList, defExpr)
 		}
 	}
+	if hasGeneratedCols(tableDef) {
+		if err = computeGeneratedCols(builder, tableDef, projectList); err != nil {
+			return false, nil, nil, err
+		}
+	}
 
 	// append ProjectNode
 	projectCtx := NewBindContext(builder, bindCtx)
@@ -25,8 +25,10 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fulltext"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
@@ -784,6 +786,13 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 	if err = buildTableDefs(stmt, ctx, createTable, asSelectCols); err != nil {
 		return nil, err
 	}
+	if err = checkGeneratedCols(ctx, createTable.TableDef); err != nil {
+		return nil, err
+	}
+	nameCheckConstraints(createTable.TableDef.Checks, createTable.TableDef.Name)
+	if err = checkCheckConstraints(ctx, createTable.TableDef); err != nil {
+		return nil, err
+	}
 
 	v, ok := getAutoIncrementOffsetFromVariables(ctx)
 	if ok {
@@ -796,6 +805,9 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 		case *tree.TableOptionProperties:
 			properties := make([]*plan.Property, len(opt.Preperties))
 			for idx, property := range opt.Preperties {
+				if err = checkCompressProperty(ctx.GetContext(), property.Key, property.Value); err != nil {
+					return nil, err
+				}
 				properties[idx] = &plan.Property{
 					Key:   property.Key,
 					Value: property.Value,
@@ -831,6 +843,19 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 			if opt.Value != 0 {
 				createTable.TableDef.AutoIncrOffset = opt.Value - 1
 			}
+		case *tree.TableOptionCompression:
+			// the compression of the column data of the table
+			alg := strings.ToLower(opt.Compression)
+			if err = checkCompressProperty(ctx.GetContext(), catalog.PropCompressAlg, alg); err != nil {
+				return nil, err
+			}
+			createTable.TableDef.Defs = append(createTable.TableDef.Defs, &plan.TableDef_DefType{
+				Def: &plan.TableDef_DefType_Properties{
+					Properties: &plan.PropertiesDef{
+						Properties: []*plan.Property{{Key: catalog.PropCompressAlg, Value: alg}},
+					},
+				},
+			})
 		case *tree.RetentionOption:
 			duration, err := parseDuration(ctx.GetContext(), opt.Period, opt.Unit)
 			if err != nil {
@@ -850,7 +875,7 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 		// 	*tree.TableOptionUnion, *tree.TableOptionEncryption:
 		// 	return nil, moerr.NewNotSupported("statement: '%v'", tree.String(stmt, dialect.MYSQL))
 		case *tree.TableOptionAUTOEXTEND_SIZE, *tree.TableOptionAvgRowLength,
-			*tree.TableOptionCharset, *tree.TableOptionChecksum, *tree.TableOptionCollate, *tree.TableOptionCompression,
+			*tree.TableOptionCharset, *tree.TableOptionChecksum, *tree.TableOptionCollate,
 			*tree.TableOptionConnection, *tree.TableOptionDataDirectory, *tree.TableOptionIndexDirectory,
 			*tree.TableOptionDelayKeyWrite, *tree.TableOptionEncryption, *tree.TableOptionEngine, *tree.TableOptionEngineAttr,
 			*tree.TableOptionKeyBlockSize, *tree.TableOptionMaxRows, *tree.TableOptionMinRows, *tree.TableOptionPackKeys,
@@ -1124,6 +1149,8 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 						Name:     colName,
 					})
 					indexs = append(indexs, colName)
+				case *tree.AttributeCheckConstraint:
+					createTable.TableDef.Checks = append(createTable.TableDef.Checks, buildCheckDef(attribute.Name, attribute.Expr, attribute.Enforced))
 				}
 			}
 			if len(pks) > 0 {
@@ -1146,6 +1173,11 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 				return err
 			}
 
+			defaultValue.Generated, err = buildGeneratedCol(ctx.GetContext(), def, colType, defaultValue, onUpdateExpr, auto_incr)
+			if err != nil {
+				return err
+			}
+
 			if !checkTableColumnNameValid(colName) {
 				return moerr.NewInvalidInputf(ctx.GetContext(), "table column name '%s' is illegal and conflicts with internal keyword", colNameOrigin)
 			}
@@ -1197,24 +1229,21 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 				indexs = append(indexs, name)
 			}
 		case *tree.Index:
-			err := checkIndexKeypartSupportability(ctx.GetContext(), def.KeyParts)
-			if err != nil {
-				return err
-			}
-
 			secondaryIndexInfos = append(secondaryIndexInfos, def)
 			for _, key := range def.KeyParts {
+				// the expression and prefix key parts are checked when building the index
+				if key.Expr != nil || key.Length != 0 {
+					continue
+				}
 				name := key.ColName.ColName()
 				indexs = append(indexs, name)
 			}
 		case *tree.UniqueIndex:
-			err := checkIndexKeypartSupportability(ctx.GetContext(), def.KeyParts)
-			if err != nil {
-				return err
-			}
-
 			uniqueIndexInfos = append(uniqueIndexInfos, def)
 			for _, key := range def.KeyParts {
+				if key.Expr != nil || key.Length != 0 {
+					continue
+				}
 				name := key.ColName.ColName()
 				indexs = append(indexs, name)
 			}
@@ -1261,9 +1290,19 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 			if fkData.IsSelfRefer {
 				fkDatasOfFKSelfRefer = append(fkDatasOfFKSelfRefer, fkData)
 			}
-		case *tree.CheckIndex, *tree.FullTextIndex:
-			// unsupport in plan. will support in next version.
-			// return moerr.NewNYI(ctx.GetContext(), "table def: '%v'", def)
+		case *tree.FullTextIndex:
+			err := checkIndexKeypartSupportability(ctx.GetContext(), def.KeyParts)
+			if err != nil {
+				return err
+			}
+
+			secondaryIndexInfos = append(secondaryIndexInfos, fullTextIndexToIndex(def))
+			for _, key := range def.KeyParts {
+				name := key.ColName.ColName()
+				indexs = append(indexs, name)
+			}
+		case *tree.CheckIndex:
+			createTable.TableDef.Checks = append(createTable.TableDef.Checks, buildCheckDef(def.Name, def.Expr, def.Enforced))
 		default:
 			return moerr.NewNYIf(ctx.GetContext(), "table def: '%v'", def)
 		}
@@ -1587,42 +1626,26 @@ func buildUniqueIndexTable(createTable *plan.CreateTable, indexInfos []*tree.Uni
 		}
 		indexParts := make([]string, 0)
 
+		var partTyp Type
 		for _, keyPart := range indexInfo.KeyParts {
-			nameOrigin := keyPart.ColName.ColNameOrigin()
-			name := keyPart.ColName.ColName()
-			if _, ok := colMap[name]; !ok {
-				return moerr.NewInvalidInputf(ctx.GetContext(), "column '%s' is not exist", nameOrigin)
-			}
-			if colMap[name].Typ.Id == int32(types.T_blob) {
-				return moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("BLOB column '%s' cannot be in index", nameOrigin))
-			}
-			if colMap[name].Typ.Id == int32(types.T_text) {
-				return moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("TEXT column '%s' cannot be in index", nameOrigin))
-			}
-			if colMap[name].Typ.Id == int32(types.T_datalink) {
-				return moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("DATALINK column '%s' cannot be in index", nameOrigin))
-			}
-			if colMap[name].Typ.Id == int32(types.T_json) {
-				return moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("JSON column '%s' cannot be in index", nameOrigin))
-			}
-			if colMap[name].Typ.Id == int32(types.T_array_float32) || colMap[name].Typ.Id == int32(types.T_array_float64) {
-				return moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("VECTOR column '%s' cannot be in index", nameOrigin))
+			part, typ, err := buildIndexKeyPart(ctx, keyPart, colMap)
+			if err != nil {
+				return err
 			}
-
-			indexParts = append(indexParts, name)
+			partTyp = typ
+			indexParts = append(indexParts, part)
 		}
 
 		var keyName string
 		if len(indexInfo.KeyParts) == 1 {
 			keyName = catalog.IndexTableIndexColName
-			colName := indexInfo.KeyParts[0].ColName.ColName()
 			colDef := &ColDef{
 				Name: keyName,
 				Alg:  plan.CompressType_Lz4,
 				Typ: Type{
-					Id:    colMap[colName].Typ.Id,
-					Width: colMap[colName].Typ.Width,
-					Scale: colMap[colName].Typ.Scale,
+					Id:    partTyp.Id,
+					Width: partTyp.Width,
+					Scale: partTyp.Scale,
 				},
 				Default: &plan.Default{
 					NullAbility:  false,
@@ -1697,9 +1720,11 @@ func buildSecondaryIndexDef(createTable *plan.CreateTable, indexInfos []*tree.In
 	}
 
 	for _, indexInfo := range indexInfos {
-		err = checkIndexKeypartSupportability(ctx.GetContext(), indexInfo.KeyParts)
-		if err != nil {
-			return err
+		if indexInfo.KeyType != tree.INDEX_TYPE_BTREE && indexInfo.KeyType != tree.INDEX_TYPE_INVALID {
+			err = checkIndexKeypartSupportability(ctx.GetContext(), indexInfo.KeyParts)
+			if err != nil {
+				return err
+			}
 		}
 
 		var indexDef []*plan.IndexDef
@@ -1711,6 +1736,8 @@ func buildSecondaryIndexDef(createTable *plan.CreateTable, indexInfos []*tree.In
 			indexDef, tableDef, err = buildIvfFlatSecondaryIndexDef(ctx, indexInfo, colMap, pkeyName)
 		case tree.INDEX_TYPE_MASTER:
 			indexDef, tableDef, err = buildMasterSecondaryIndexDef(ctx, indexInfo, colMap, pkeyName)
+		case tree.INDEX_TYPE_FULLTEXT:
+			indexDef, tableDef, err = buildFullTextSecondaryIndexDef(ctx, indexInfo, colMap, pkeyName)
 		default:
 			return moerr.NewInvalidInputNoCtxf("unsupported index type: %s", indexInfo.KeyType.ToString())
 		}
@@ -1827,8 +1854,17 @@ func buildMasterSecondaryIndexDef(ctx CompilerContext, indexInfo *tree.Index, co
 	return []*plan.IndexDef{indexDef}, []*TableDef{tableDef}, nil
 }
 
-func buildRegularSecondaryIndexDef(ctx CompilerContext, indexInfo *tree.Index, colMap map[string]*ColDef, pkeyName string) ([]*plan.IndexDef, []*TableDef, error) {
+// fullTextIndexToIndex converts `FULLTEXT KEY` into a secondary index with the fulltext algo.
+func fullTextIndexToIndex(def *tree.FullTextIndex) *tree.Index {
+	return &tree.Index{
+		KeyParts:    def.KeyParts,
+		Name:        def.Name,
+		KeyType:     tree.INDEX_TYPE_FULLTEXT,
+		IndexOption: def.IndexOption,
+	}
+}
 
+func buildFullTextSecondaryIndexDef(ctx CompilerContext, indexInfo *tree.Index, colMap map[string]*ColDef, pkeyName string) ([]*plan.IndexDef, []*TableDef, error) {
 	// 1. indexDef init
 	indexDef := &plan.IndexDef{}
 	indexDef.Unique = false
@@ -1842,30 +1878,115 @@ func buildRegularSecondaryIndexDef(ctx CompilerContext, indexInfo *tree.Index, c
 		Name: indexTableName,
 	}
 
-	nameCount := make(map[string]int)
 	indexParts := make([]string, 0)
-
-	isPkAlreadyPresentInIndexParts := false
 	for _, keyPart := range indexInfo.KeyParts {
-		name := keyPart.ColName.ColName()
 		nameOrigin := keyPart.ColName.ColNameOrigin()
+		name := keyPart.ColName.ColName()
 		if _, ok := colMap[name]; !ok {
 			return nil, nil, moerr.NewInvalidInputf(ctx.GetContext(), "column '%s' is not exist", nameOrigin)
 		}
-		if colMap[name].Typ.Id == int32(types.T_blob) {
-			return nil, nil, moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("BLOB column '%s' cannot be in index", nameOrigin))
+		switch types.T(colMap[name].Typ.Id) {
+		case types.T_char, types.T_varchar, types.T_text:
+		default:
+			return nil, nil, moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("column '%s' is not char, varchar or text type.", nameOrigin))
 		}
-		if colMap[name].Typ.Id == int32(types.T_text) {
-			return nil, nil, moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("TEXT column '%s' cannot be in index", nameOrigin))
+		if keyPart.Length != 0 {
+			return nil, nil, moerr.NewNotSupported(ctx.GetContext(), "prefix length in fulltext index")
 		}
-		if colMap[name].Typ.Id == int32(types.T_datalink) {
-			return nil, nil, moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("DATALINK column '%s' cannot be in index", nameOrigin))
+		indexParts = append(indexParts, name)
+	}
+
+	if indexInfo.IndexOption != nil && !fulltext.IsValidParser(indexInfo.IndexOption.ParserName) {
+		return nil, nil, moerr.NewNotSupportedf(ctx.GetContext(), "fulltext parser '%s'", indexInfo.IndexOption.ParserName)
+	}
+
+	// 3. index table columns: <serial(doc_id, pos), doc_id, word, pos>
+	newColDef := func(name string, typ Type) *ColDef {
+		return &ColDef{
+			Name: name,
+			Alg:  plan.CompressType_Lz4,
+			Typ:  typ,
+			Default: &plan.Default{
+				NullAbility:  false,
+				Expr:         nil,
+				OriginString: "",
+			},
 		}
-		if colMap[name].Typ.Id == int32(types.T_json) {
-			return nil, nil, moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("JSON column '%s' cannot be in index", nameOrigin))
+	}
+	var keyName = catalog.FullTextIndexTableKeyColName
+	tableDef.Cols = append(tableDef.Cols, newColDef(keyName, Type{
+		Id:    int32(types.T_varchar),
+		Width: types.MaxVarcharLen,
+	}))
+	tableDef.Pkey = &PrimaryKeyDef{
+		Names:       []string{keyName},
+		PkeyColName: keyName,
+	}
+	tableDef.Cols = append(tableDef.Cols, newColDef(catalog.FullTextIndexTableDocIdColName, Type{
+		// don't copy auto increment
+		Id:    colMap[pkeyName].Typ.Id,
+		Width: colMap[pkeyName].Typ.Width,
+		Scale: colMap[pkeyName].Typ.Scale,
+	}))
+	tableDef.Cols = append(tableDef.Cols, newColDef(catalog.FullTextIndexTableWordColName, Type{
+		Id:    int32(types.T_varchar),
+		Width: types.MaxVarcharLen,
+	}))
+	tableDef.Cols = append(tableDef.Cols, newColDef(catalog.FullTextIndexTablePosColName, Type{
+		Id:    int32(types.T_int32),
+		Width: 32,
+	}))
+
+	if indexInfo.Name == "" {
+		indexDef.IndexName = indexInfo.KeyParts[0].ColName.ColName()
+	} else {
+		indexDef.IndexName = indexInfo.Name
+	}
+
+	indexDef.IndexTableName = indexTableName
+	indexDef.Parts = indexParts
+	indexDef.TableExist = true
+	indexDef.IndexAlgo = indexInfo.KeyType.ToString()
+	indexDef.IndexAlgoTableType = ""
+
+	if indexInfo.IndexOption != nil {
+		indexDef.Comment = indexInfo.IndexOption.Comment
+
+		params, err := catalog.IndexParamsToJsonString(indexInfo)
+		if err != nil {
+			return nil, nil, err
 		}
-		if colMap[name].Typ.Id == int32(types.T_array_float32) || colMap[name].Typ.Id == int32(types.T_array_float64) {
-			return nil, nil, moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("VECTOR column '%s' cannot be in index", nameOrigin))
+		indexDef.IndexAlgoParams = params
+	} else {
+		indexDef.Comment = ""
+		indexDef.IndexAlgoParams = ""
+	}
+	return []*plan.IndexDef{indexDef}, []*TableDef{tableDef}, nil
+}
+
+func buildRegularSecondaryIndexDef(ctx CompilerContext, indexInfo *tree.Index, colMap map[string]*ColDef, pkeyName string) ([]*plan.IndexDef, []*TableDef, error) {
+
+	// 1. indexDef init
+	indexDef := &plan.IndexDef{}
+	indexDef.Unique = false
+
+	// 2. tableDef init
+	indexTableName, err := util.BuildIndexTableName(ctx.GetContext(), false)
+	if err != nil {
+		return nil, nil, err
+	}
+	tableDef := &TableDef{
+		Name: indexTableName,
+	}
+
+	nameCount := make(map[string]int)
+	indexParts := make([]string, 0)
+
+	isPkAlreadyPresentInIndexParts := false
+	for _, keyPart := range indexInfo.KeyParts {
+		name, _, err := buildIndexKeyPart(ctx, keyPart, colMap)
+		if err != nil {
+			return nil, nil, err
 		}
 
 		if strings.Compare(name, pkeyName) == 0 || catalog.IsAlias(name) {
@@ -1943,7 +2064,7 @@ func buildRegularSecondaryIndexDef(ctx CompilerContext, indexInfo *tree.Index, c
 	}
 
 	if indexInfo.Name == "" {
-		firstPart := indexInfo.KeyParts[0].ColName.ColName()
+		firstPart := getKeyPartName(indexInfo.KeyParts[0])
 		nameCount[firstPart]++
 		count := nameCount[firstPart]
 		indexName := firstPart
@@ -2267,7 +2388,7 @@ func CreateIndexDef(indexInfo *tree.Index,
 		case catalog.MoIndexDefaultAlgo, catalog.MoIndexBTreeAlgo:
 			indexDef.Comment = ""
 			indexDef.IndexAlgoParams = ""
-		case catalog.MOIndexMasterAlgo:
+		case catalog.MOIndexMasterAlgo, catalog.MOIndexFullTextAlgo:
 			indexDef.Comment = ""
 			indexDef.IndexAlgoParams = ""
 		case catalog.MoIndexIvfFlatAlgo:
@@ -2363,6 +2484,8 @@ func buildTruncateTable(stmt *tree.TruncateTable, ctx CompilerContext) (*Plan, e
 					}
 				} else if indexdef.TableExist && catalog.IsMasterIndexAlgo(indexdef.IndexAlgo) {
 					truncateTable.IndexTableNames = append(truncateTable.IndexTableNames, indexdef.IndexTableName)
+				} else if indexdef.TableExist && catalog.IsFullTextIndexAlgo(indexdef.IndexAlgo) {
+					truncateTable.IndexTableNames = append(truncateTable.IndexTableNames, indexdef.IndexTableName)
 				}
 			}
 		}
@@ -2675,6 +2798,13 @@ func buildCreateIndex(stmt *tree.CreateIndex, ctx CompilerContext) (*Plan, error
 			IndexOption: stmt.IndexOption,
 			KeyType:     stmt.IndexOption.IType,
 		}
+	case tree.INDEX_CATEGORY_FULLTEXT:
+		sIdx = &tree.Index{
+			Name:        indexName,
+			KeyParts:    stmt.KeyParts,
+			IndexOption: stmt.IndexOption,
+			KeyType:     tree.INDEX_TYPE_FULLTEXT,
+		}
 	default:
 		return nil, moerr.NewNotSupportedf(ctx.GetContext(), "statement: '%v'", tree.String(stmt, dialect.MYSQL))
 	}
@@ -3030,6 +3160,18 @@ func buildAlterTableInplace(stmt *tree.AlterTable, ctx CompilerContext) (*Plan,
 						break
 					}
 				}
+				// DROP CONSTRAINT also drops a check constraint
+				if idx := findCheck(tableDef.Checks, constraintName); name_not_found && idx != -1 {
+					alterTableDrop.Typ = plan.AlterTableDrop_CHECK
+					alterTableDrop.Name = tableDef.Checks[idx].Name
+					name_not_found = false
+				}
+			case tree.AlterTableDropCheck:
+				alterTableDrop.Typ = plan.AlterTableDrop_CHECK
+				if idx := findCheck(tableDef.Checks, constraintName); idx != -1 {
+					alterTableDrop.Name = tableDef.Checks[idx].Name
+					name_not_found = false
+				}
 			}
 			if name_not_found {
 				return nil, moerr.NewInternalErrorf(ctx.GetContext(), "Can't DROP '%s'; check that column/key exists", constraintName)
@@ -3041,7 +3183,11 @@ func buildAlterTableInplace(stmt *tree.AlterTable, ctx CompilerContext) (*Plan,
 			}
 
 		case *tree.AlterOptionAdd:
-			switch def := opt.Def.(type) {
+			optDef := opt.Def
+			if ft, ok := optDef.(*tree.FullTextIndex); ok {
+				optDef = fullTextIndexToIndex(ft)
+			}
+			switch def := optDef.(type) {
 			case *tree.ForeignKey:
 				err = adjustConstraintName(ctx.GetContext(), def)
 				if err != nil {
@@ -3092,11 +3238,6 @@ func buildAlterTableInplace(stmt *tree.AlterTable, ctx CompilerContext) (*Plan,
 				}
 				updateSqls = append(updateSqls, fkData.UpdateSql)
 			case *tree.UniqueIndex:
-				err := checkIndexKeypartSupportability(ctx.GetContext(), def.KeyParts)
-				if err != nil {
-					return nil, err
-				}
-
 				indexName := def.GetIndexName()
 				constrNames := map[string]bool{}
 				// Check not empty constraint name whether is duplicated.
@@ -3105,7 +3246,7 @@ func buildAlterTableInplace(stmt *tree.AlterTable, ctx CompilerContext) (*Plan,
 					constrNames[nameLower] = true
 				}
 
-				err = checkDuplicateConstraint(constrNames, indexName, false, ctx.GetContext())
+				err := checkDuplicateConstraint(constrNames, indexName, false, ctx.GetContext())
 				if err != nil {
 					return nil, err
 				}
@@ -3119,6 +3260,9 @@ func buildAlterTableInplace(stmt *tree.AlterTable, ctx CompilerContext) (*Plan,
 				if err = buildUniqueIndexTable(indexInfo, []*tree.UniqueIndex{def}, colMap, oriPriKeyName, ctx); err != nil {
 					return nil, err
 				}
+				if err = checkAlterPartitionKeys(ctx, tableDef, indexInfo.TableDef.Indexes); err != nil {
+					return nil, err
+				}
 
 				alterTable.Actions[i] = &plan.AlterTable_Action{
 					Action: &plan.AlterTable_Action_AddIndex{
@@ -3132,11 +3276,6 @@ func buildAlterTableInplace(stmt *tree.AlterTable, ctx CompilerContext) (*Plan,
 					},
 				}
 			case *tree.Index:
-				err := checkIndexKeypartSupportability(ctx.GetContext(), def.KeyParts)
-				if err != nil {
-					return nil, err
-				}
-
 				indexName := def.Name
 
 				constrNames := map[string]bool{}
@@ -3146,7 +3285,7 @@ func buildAlterTableInplace(stmt *tree.AlterTable, ctx CompilerContext) (*Plan,
 					constrNames[nameLower] = true
 				}
 
-				err = checkDuplicateConstraint(constrNames, indexName, false, ctx.GetContext())
+				err := checkDuplicateConstraint(constrNames, indexName, false, ctx.GetContext())
 				if err != nil {
 					return nil, err
 				}
@@ -3175,13 +3314,8 @@ func buildAlterTableInplace(stmt *tree.AlterTable, ctx CompilerContext) (*Plan,
 					},
 				}
 			case *tree.CheckIndex:
-				alterTable.Actions[i] = &plan.AlterTable_Action{
-					Action: &plan.AlterTable_Action_AlterComment{
-						AlterComment: &plan.AlterTableComment{
-							NewComment: comment,
-						},
-					},
-				}
+				// ADD CHECK is done by copying the table, see ResolveAlterTableAlgorithm
+				return nil, moerr.NewNotSupported(ctx.GetContext(), "ADD CHECK together with the alter table options done in place")
 			default:
 				return nil, moerr.NewInternalErrorf(ctx.GetContext(), "unsupported alter option: %T", def)
 			}
@@ -3361,6 +3495,9 @@ func buildAlterTableInplace(stmt *tree.AlterTable, ctx CompilerContext) (*Plan,
 			if err != nil {
 				return nil, err
 			}
+			if err = checkNoGeneratedAttr(ctx.GetContext(), opt.Column); err != nil {
+				return nil, err
+			}
 
 			if !checkTableColumnNameValid(colName) {
 				return nil, moerr.NewInvalidInputf(ctx.GetContext(), "table column name '%s' is illegal and conflicts with internal keyword", colNameOrigin)
@@ -3425,11 +3562,40 @@ func buildAlterTableInplace(stmt *tree.AlterTable, ctx CompilerContext) (*Plan,
 				},
 			})
 		case *tree.AlterPartitionDropPartitionClause:
-			return nil, moerr.NewNotSupported(ctx.GetContext(), "alter table drop partition clause")
+			alterTableDropPartition, err := DropTablePartitions(ctx, alterTable, partitionOption)
+			if err != nil {
+				return nil, err
+			}
+
+			alterTable.Actions = append(alterTable.Actions, &plan.AlterTable_Action{
+				Action: &plan.AlterTable_Action_DropPartition{
+					DropPartition: alterTableDropPartition,
+				},
+			})
 		case *tree.AlterPartitionTruncatePartitionClause:
-			return nil, moerr.NewNotSupported(ctx.GetContext(), "alter table truncate partition clause")
-		case *tree.AlterPartitionRedefinePartitionClause:
-			return nil, moerr.NewNotSupported(ctx.GetContext(), "alter table partition by clause")
+			alterTableTruncatePartition, err := TruncateTablePartitions(ctx, alterTable, partitionOption)
+			if err != nil {
+				return nil, err
+			}
+
+			alterTable.Actions = append(alterTable.Actions, &plan.AlterTable_Action{
+				Action: &plan.AlterTable_Action_TruncatePartition{
+					TruncatePartition: alterTableTruncatePartition,
+				},
+			})
+		case *tree.AlterPartitionExchangePartitionClause:
+			alterTableExchangePartition, err := ExchangeTablePartition(ctx, alterTable, partitionOption)
+			if err != nil {
+				return nil, err
+			}
+
+			alterTable.Actions = append(alterTable.Actions, &plan.AlterTable_Action{
+				Action: &plan.AlterTable_Action_ExchangePartition{
+					ExchangePartition: alterTableExchangePartition,
+				},
+			})
+		default:
+			return nil, moerr.NewNotSupported(ctx.GetContext(), "alter table partition clause")
 		}
 	}
 
@@ -3839,6 +4005,27 @@ func getAutoIncrementOffsetFromVariables(ctx CompilerContext) (uint64, bool) {
 	return 0, false
 }
 
+// checkCompressProperty checks the value of the table property of the
+// compression of the column data, the other properties are not checked.
+func checkCompressProperty(ctx context.Context, key, value string) error {
+	switch key {
+	case catalog.PropCompressAlg:
+		if _, ok := compress.Algorithms[strings.ToLower(value)]; !ok {
+			return moerr.NewInvalidArg(ctx, "compression", value)
+		}
+	case catalog.PropCompressLevel:
+		// the levels of zstd are 1 to 22, 0 means the default level
+		if level, err := strconv.Atoi(value); err != nil || level < 0 || level > 22 {
+			return moerr.NewInvalidArg(ctx, key, value)
+		}
+	case catalog.PropColumnEncoding:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return moerr.NewInvalidArg(ctx, key, value)
+		}
+	}
+	return nil
+}
+
 var unitDurations = map[string]time.Duration{
 	"second": time.Second,
 	"minute": time.Minute,
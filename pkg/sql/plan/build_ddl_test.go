@@ -405,8 +405,13 @@ func TestBuildCreateTableError(t *testing.T) {
 			col2 DATE NOT NULL,
 			col3 INT NOT NULL,
 			col4 INT NOT NULL,
-			UNIQUE KEY uk1 ((col1 + col3))
+			UNIQUE KEY uk1 ((col1 + rand()))
 		);`,
+
+		"CREATE TABLE t4 (col1 INT) COMPRESSION = 'zlib';",
+		`CREATE TABLE t4 (col1 INT) PROPERTIES("compress_level" = "high");`,
+		`CREATE TABLE t4 (col1 INT) PROPERTIES("compress_level" = "23");`,
+		`CREATE TABLE t4 (col1 INT) PROPERTIES("column_encoding" = "yes");`,
 	}
 	runTestShouldError(mock, t, sqlerrs)
 }
@@ -420,19 +425,48 @@ func TestBuildAlterTable(t *testing.T) {
 		"ALTER TABLE emp ADD INDEX idx1 (ename, sal);",
 		"ALTER TABLE emp ADD INDEX idx2 (ename, sal DESC);",
 		"ALTER TABLE emp ADD UNIQUE INDEX idx1 (empno ASC);",
+		"ALTER TABLE emp ADD UNIQUE idx1 ((empno+1) DESC, ename);",
+		"ALTER TABLE emp ADD INDEX idx2 (ename, (sal*30) DESC);",
+		"ALTER TABLE emp ADD UNIQUE INDEX idx1 ((empno+20), (sal*30));",
 		//"alter table emp drop foreign key fk1",
 		//"alter table nation add FOREIGN KEY fk_t1(n_nationkey) REFERENCES nation2(n_nationkey)",
 	}
 	runTestShouldPass(mock, t, sqls, false, false)
 }
 
+func TestBuildIndexPrefixKeyPart(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	colMap := map[string]*ColDef{
+		"email": {Name: "email", Typ: Type{Id: int32(types.T_varchar), Width: 100}},
+		"code":  {Name: "code", Typ: Type{Id: int32(types.T_char), Width: 3}},
+		"data":  {Name: "data", Typ: Type{Id: int32(types.T_varbinary), Width: 20}},
+	}
+
+	// the values sharing the first n characters conflict in the unique index of col(n).
+	part, typ, err := buildIndexKeyPart(mock.CurrentContext(), &tree.KeyPart{ColName: tree.NewUnresolvedColName("email"), Length: 3}, colMap)
+	assert.NoError(t, err)
+	assert.Equal(t, catalog.CreatePrefixKeyPart("email", 3, false), part)
+	assert.Equal(t, int32(types.T_varchar), typ.Id)
+	assert.Equal(t, int32(3), typ.Width)
+
+	part, _, err = buildIndexKeyPart(mock.CurrentContext(), &tree.KeyPart{ColName: tree.NewUnresolvedColName("data"), Length: 5}, colMap)
+	assert.NoError(t, err)
+	assert.Equal(t, catalog.CreatePrefixKeyPart("data", 5, true), part)
+
+	// the prefix covers the whole value.
+	part, typ, err = buildIndexKeyPart(mock.CurrentContext(), &tree.KeyPart{ColName: tree.NewUnresolvedColName("code"), Length: 3}, colMap)
+	assert.NoError(t, err)
+	assert.Equal(t, "code", part)
+	assert.Equal(t, int32(types.T_char), typ.Id)
+}
+
 func TestBuildAlterTableError(t *testing.T) {
 	mock := NewMockOptimizer(false)
 	// should pass
 	sqls := []string{
-		"ALTER TABLE emp ADD UNIQUE idx1 ((empno+1) DESC, ename);",
-		"ALTER TABLE emp ADD INDEX idx2 (ename, (sal*30) DESC);",
-		"ALTER TABLE emp ADD UNIQUE INDEX idx1 ((empno+20), (sal*30));",
+		"ALTER TABLE emp ADD UNIQUE idx1 ((empno+rand()) DESC, ename);",
+		"ALTER TABLE emp ADD INDEX idx2 (ename, (sal) DESC);",
+		"ALTER TABLE emp ADD UNIQUE INDEX idx1 ((cast(ename as text)));",
 	}
 	runTestShouldError(mock, t, sqls)
 }
@@ -67,6 +67,18 @@ func buildDelete(stmt *tree.Delete, ctx CompilerContext, isPrepareStmt bool) (*P
 	// needLockTable := !tblInfo.isMulti && stmt.Where == nil && stmt.Limit == nil
 	// todo will do not lock table now.
 	isDeleteWithoutFilters := !tblInfo.isMulti && stmt.Where == nil && stmt.Limit == nil
+	triggers := make([]*tableTriggers, len(tblInfo.tableDefs))
+	for i, tableDef := range tblInfo.tableDefs {
+		triggers[i], err = builder.resolveTableTriggers(tableDef, tree.TriggerEventDelete)
+		if err != nil {
+			return nil, err
+		}
+		// the triggers are fired for every deleted row, so the table can
+		// not be truncated
+		if !triggers[i].isEmpty() {
+			isDeleteWithoutFilters = false
+		}
+	}
 	needLockTable := isDeleteWithoutFilters
 	for i, tableDef := range tblInfo.tableDefs {
 		deleteBindCtx := NewBindContext(builder, nil)
@@ -107,10 +119,22 @@ func buildDelete(stmt *tree.Delete, ctx CompilerContext, isPrepareStmt bool) (*P
 		nextSourceStep := builder.appendStep(lastNodeId)
 		delPlanCtx.sourceStep = nextSourceStep
 
+		transition := newTriggerTransition(tableDef.Cols)
+		transition.step = nextSourceStep
+		transition.oldPos = make(map[string]int32, len(transition.cols))
+		for colIdx, col := range tableDef.Cols {
+			if !col.Hidden {
+				transition.oldPos[col.Name] = int32(colIdx)
+			}
+		}
 		err = buildDeletePlans(ctx, builder, deleteBindCtx, delPlanCtx)
 		if err != nil {
 			return nil, err
 		}
+		err = appendRowTriggers(ctx, builder, tblInfo.objRef[i], tableDef, triggers[i], transition)
+		if err != nil {
+			return nil, err
+		}
 		beginIdx = beginIdx + len(tableDef.Cols)
 		putDmlPlanCtx(delPlanCtx)
 	}
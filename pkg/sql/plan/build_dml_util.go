@@ -122,12 +122,29 @@ func buildInsertPlans(
 	objRef *ObjectRef, tableDef *TableDef, lastNodeId int32, ifExistAutoPkCol bool,
 	insertWithoutUniqueKeyMap map[string]bool,
 	ifInsertFromUniqueColMap map[string]bool,
+	addAffectedRows bool,
 ) error {
 
 	var err error
 	var insertColsNameFromStmt []string
 	var pkFilterExpr []*Expr
 	var newPartitionExpr *Expr
+
+	triggers, err := builder.resolveTableTriggers(tableDef, tree.TriggerEventInsert)
+	if err != nil {
+		return err
+	}
+	if triggers.hasSets() {
+		// the inserted values may be changed by the triggers, so the pk
+		// filters and the unique keys can not be derived from the statement.
+		stmt = nil
+		insertWithoutUniqueKeyMap = nil
+		ifInsertFromUniqueColMap = nil
+		if lastNodeId, err = appendInsertSetTriggers(builder, bindCtx, tableDef, triggers, lastNodeId); err != nil {
+			return err
+		}
+	}
+
 	if stmt != nil {
 		insertColsNameFromStmt, err = getInsertColsFromStmt(ctx.GetContext(), stmt, tableDef)
 		if err != nil {
@@ -146,17 +163,34 @@ func buildInsertPlans(
 		}
 	}
 
+	// the NEW row is the output of preinsert, whose columns are in the
+	// order of tableDef.Cols before preinsert appends the hidden columns.
+	transition := newTriggerTransition(tableDef.Cols)
+	transition.newPos = make(map[string]int32, len(transition.cols))
+	for i, col := range tableDef.Cols {
+		if !col.Hidden {
+			transition.newPos[col.Name] = int32(i)
+		}
+	}
+
 	// add plan: -> preinsert -> sink
-	lastNodeId = appendPreInsertNode(builder, bindCtx, objRef, tableDef, lastNodeId, false)
+	lastNodeId, err = appendPreInsertNode(builder, bindCtx, objRef, tableDef, lastNodeId, false)
+	if err != nil {
+		return err
+	}
 
 	lastNodeId = appendSinkNode(builder, bindCtx, lastNodeId)
 	sourceStep := builder.appendStep(lastNodeId)
 
+	transition.step = sourceStep
+	if err = appendRowTriggers(ctx, builder, objRef, tableDef, triggers, transition); err != nil {
+		return err
+	}
+
 	// make insert plans for origin table and related index table
 	insertBindCtx := NewBindContext(builder, nil)
 	updateColLength := 0
 	updatePkCol := true
-	addAffectedRows := true
 	isFkRecursionCall := false
 	ifNeedCheckPkDup := !builder.qry.LoadTag
 	var indexSourceColTypes []*plan.Type
@@ -232,7 +266,10 @@ func buildUpdatePlans(ctx CompilerContext, builder *QueryBuilder, bindCtx *BindC
 	}
 	lastNodeId = builder.appendNode(projectNode, bindCtx)
 	//append preinsert node
-	lastNodeId = appendPreInsertNode(builder, bindCtx, updatePlanCtx.objRef, updatePlanCtx.tableDef, lastNodeId, true)
+	lastNodeId, err = appendPreInsertNode(builder, bindCtx, updatePlanCtx.objRef, updatePlanCtx.tableDef, lastNodeId, true)
+	if err != nil {
+		return err
+	}
 
 	//append sink node
 	lastNodeId = appendSinkNode(builder, bindCtx, lastNodeId)
@@ -376,21 +413,35 @@ func buildDeletePlans(ctx CompilerContext, builder *QueryBuilder, bindCtx *BindC
 				}
 
 				// Check if secondary key is being updated.
-				isSecondaryKeyUpdated := func() bool {
+				isSecondaryKeyUpdated := func() (bool, error) {
 					for _, colName := range indexdef.Parts {
-						resolvedColName := catalog.ResolveAlias(colName)
-						if colIdx, ok := posMap[resolvedColName]; ok {
-							col := delCtx.tableDef.Cols[colIdx]
-							if _, exists := delCtx.updateColPosMap[resolvedColName]; exists || col.OnUpdate != nil {
-								return true
+						colNames := []string{catalog.ResolveAlias(colName)}
+						if catalog.IsExprKeyPart(colName) {
+							var err error
+							if colNames, err = getExprKeyPartCols(builder.GetContext(), colName); err != nil {
+								return false, err
+							}
+						}
+						for _, resolvedColName := range colNames {
+							if colIdx, ok := posMap[resolvedColName]; ok {
+								col := delCtx.tableDef.Cols[colIdx]
+								if _, exists := delCtx.updateColPosMap[resolvedColName]; exists || col.OnUpdate != nil {
+									return true, nil
+								}
 							}
 						}
 					}
-					return false
+					return false, nil
 				}
 
-				if !isPrimaryKeyUpdated() && !isSecondaryKeyUpdated() {
-					continue
+				if !isPrimaryKeyUpdated() {
+					updated, err := isSecondaryKeyUpdated()
+					if err != nil {
+						return err
+					}
+					if !updated {
+						continue
+					}
 				}
 			}
 
@@ -515,6 +566,93 @@ func buildDeletePlans(ctx CompilerContext, builder *QueryBuilder, bindCtx *BindC
 					}
 				}
 				multiTableIndexes[indexdef.IndexName].IndexDefs[catalog.ToLower(indexdef.IndexAlgoTableType)] = indexdef
+			} else if indexdef.TableExist && catalog.IsFullTextIndexAlgo(indexdef.IndexAlgo) {
+				fullTextObjRef, fullTextTableDef := ctx.Resolve(delCtx.objRef.SchemaName, indexdef.IndexTableName, nil)
+				if fullTextTableDef == nil {
+					return moerr.NewNoSuchTable(builder.GetContext(), delCtx.objRef.SchemaName, indexdef.IndexName)
+				}
+
+				var lastNodeId int32
+				var err error
+				var fullTextDeleteIdx int
+				var fullTextTblPkPos int
+				var fullTextTblPkTyp Type
+
+				if delCtx.isDeleteWithoutFilters {
+					lastNodeId, err = appendDeleteIndexTablePlanWithoutFilters(builder, bindCtx, fullTextObjRef, fullTextTableDef)
+					fullTextDeleteIdx = getRowIdPos(fullTextTableDef)
+					fullTextTblPkPos, fullTextTblPkTyp = getPkPos(fullTextTableDef, false)
+				} else {
+					lastNodeId = appendSinkScanNode(builder, bindCtx, delCtx.sourceStep)
+					lastNodeId, err = appendDeleteFullTextTablePlan(builder, bindCtx, fullTextObjRef, fullTextTableDef, lastNodeId, delCtx.tableDef)
+					fullTextDeleteIdx = len(delCtx.tableDef.Cols) + delCtx.updateColLength
+					fullTextTblPkPos = fullTextDeleteIdx + 1
+					fullTextTblPkTyp = fullTextTableDef.Cols[0].Typ
+				}
+				if err != nil {
+					return err
+				}
+
+				// one document has many rows in the index table, delete all of them.
+				delNodeInfo := makeDeleteNodeInfo(builder.compCtx, fullTextObjRef, fullTextTableDef, fullTextDeleteIdx, -1, false, fullTextTblPkPos, fullTextTblPkTyp, delCtx.lockTable, delCtx.partitionInfos)
+				lastNodeId, err = makeOneDeletePlan(builder, bindCtx, lastNodeId, delNodeInfo, false, true, false)
+				putDeleteNodeInfo(delNodeInfo)
+				if err != nil {
+					return err
+				}
+				builder.appendStep(lastNodeId)
+
+				if isUpdate {
+					// re-tokenize the updated rows, they are read from the source step as the
+					// rows joined with the index table are duplicated for each word.
+					genLastNodeIdFn := func() int32 {
+						newLastNodeId := appendSinkScanNode(builder, bindCtx, delCtx.sourceStep)
+						lastProject := builder.qry.Nodes[newLastNodeId].ProjectList
+						projectProjection := make([]*Expr, len(delCtx.tableDef.Cols))
+						for j, uCols := range delCtx.tableDef.Cols {
+							if nIdx, ok := delCtx.updateColPosMap[uCols.Name]; ok {
+								projectProjection[j] = lastProject[nIdx]
+							} else {
+								projectProjection[j] = lastProject[j]
+							}
+						}
+						return builder.appendNode(&Node{
+							NodeType:    plan.Node_PROJECT,
+							Children:    []int32{newLastNodeId},
+							ProjectList: projectProjection,
+						}, bindCtx)
+					}
+
+					preInsertStep, err := appendPreInsertFullTextPlan(builder, bindCtx, delCtx.tableDef, idx, true, fullTextTableDef, genLastNodeIdFn)
+					if err != nil {
+						return err
+					}
+
+					insertTableDef := DeepCopyTableDef(fullTextTableDef, false)
+					for _, col := range fullTextTableDef.Cols {
+						if col.Name != catalog.Row_ID {
+							insertTableDef.Cols = append(insertTableDef.Cols, DeepCopyColDef(col))
+						}
+					}
+					updateColLength := 0
+					addAffectedRows := false
+					isFkRecursionCall := false
+					updatePkCol := true
+					ifExistAutoPkCol := false
+					ifCheckPkDup := false
+					ifInsertFromUnique := false
+					var pkFilterExprs []*Expr
+					var partitionExpr *Expr
+					var indexSourceColTypes []*Type
+					var fuzzymessage *OriginTableMessageForFuzzy
+					err = makeOneInsertPlan(ctx, builder, bindCtx, fullTextObjRef, insertTableDef,
+						updateColLength, preInsertStep, addAffectedRows, isFkRecursionCall, updatePkCol,
+						pkFilterExprs, partitionExpr, ifExistAutoPkCol, ifCheckPkDup, ifInsertFromUnique,
+						indexSourceColTypes, fuzzymessage)
+					if err != nil {
+						return err
+					}
+				}
 			} else if indexdef.TableExist && catalog.IsMasterIndexAlgo(indexdef.IndexAlgo) {
 				// Used by pre-insert vector index.
 				masterObjRef, masterTableDef := ctx.Resolve(delCtx.objRef.SchemaName, indexdef.IndexTableName, nil)
@@ -1302,7 +1440,8 @@ func buildInsertPlansWithRelatedHiddenTable(
 				// The way to guarantee the uniqueness of the unique key is to create a hidden table,
 				// with the primary key of the hidden table as the unique key.
 				// package contains some information needed by the fuzzy filter to run background SQL.
-				if indexdef.GetUnique() {
+				// the unique key with expression key parts is only checked on the hidden table.
+				if indexdef.GetUnique() && !catalog.HasExprKeyPart(indexdef.Parts) {
 					_, idxTableDef := ctx.Resolve(objRef.SchemaName, indexdef.IndexTableName, nil)
 					// remove row_id
 					idxTableDef.Cols = RemoveIf[*ColDef](idxTableDef.Cols, func(colVal *ColDef) bool {
@@ -1392,6 +1531,40 @@ func buildInsertPlansWithRelatedHiddenTable(
 					}
 				}
 				multiTableIndexes[indexdef.IndexName].IndexDefs[catalog.ToLower(indexdef.IndexAlgoTableType)] = indexdef
+			} else if indexdef.TableExist && catalog.IsFullTextIndexAlgo(indexdef.IndexAlgo) {
+
+				idxRef, idxTableDef := ctx.Resolve(objRef.SchemaName, indexdef.IndexTableName, nil)
+				// remove row_id
+				idxTableDef.Cols = RemoveIf[*ColDef](idxTableDef.Cols, func(colVal *ColDef) bool {
+					return colVal.Name == catalog.Row_ID
+				})
+				genLastNodeIdFn := func() int32 {
+					return appendSinkScanNode(builder, bindCtx, sourceStep)
+				}
+				newSourceStep, err := appendPreInsertFullTextPlan(builder, bindCtx, tableDef, idx, false, idxTableDef, genLastNodeIdFn)
+				if err != nil {
+					return err
+				}
+
+				updateColLength := 0
+				addAffectedRows := false
+				isFkRecursionCall := false
+				updatePkCol := true
+				ifExistAutoPkCol := false
+				ifCheckPkDup := false
+				ifInsertFromUnique := false
+				var pkFilterExprs []*Expr
+				var partitionExpr *Expr
+				var colTypes []*Type
+				var fuzzymessage *OriginTableMessageForFuzzy
+				err = makeOneInsertPlan(ctx, builder, bindCtx, idxRef, idxTableDef,
+					updateColLength, newSourceStep, addAffectedRows, isFkRecursionCall, updatePkCol,
+					pkFilterExprs, partitionExpr, ifExistAutoPkCol, ifCheckPkDup, ifInsertFromUnique,
+					colTypes, fuzzymessage)
+
+				if err != nil {
+					return err
+				}
 			} else if indexdef.TableExist && catalog.IsMasterIndexAlgo(indexdef.IndexAlgo) {
 
 				idxRef, idxTableDef := ctx.Resolve(objRef.SchemaName, indexdef.IndexTableName, nil)
@@ -1563,10 +1736,17 @@ func appendPureInsertBranch(ctx CompilerContext, builder *QueryBuilder, bindCtx
 
 	// append project node if necessary
 	projectProjection := getProjectionByLastNode(builder, lastNodeId)
-	if len(projectProjection) > len(tableDef.Cols) || tableDef.Partition != nil {
+	if len(projectProjection) > len(tableDef.Cols) || tableDef.Partition != nil || hasVirtualCols(tableDef) {
 		if len(projectProjection) > len(tableDef.Cols) {
 			projectProjection = projectProjection[:len(tableDef.Cols)]
 		}
+		// virtual columns are computed at scan time, only the other
+		// columns are kept in the table
+		for i, col := range tableDef.Cols {
+			if isVirtualCol(col) {
+				projectProjection[i] = virtualColNullExpr(col)
+			}
+		}
 		partitionIdx = len(tableDef.Cols)
 		if tableDef.Partition != nil {
 			partitionExpr := DeepCopyExpr(tableDef.Partition.PartitionExpression)
@@ -1892,7 +2072,7 @@ func makeDeleteNodeInfo(ctx CompilerContext, objRef *ObjectRef, tableDef *TableD
 					if indexdef.IndexAlgoTableType == catalog.SystemSI_IVFFLAT_TblType_Entries {
 						delNodeInfo.indexTableNames = append(delNodeInfo.indexTableNames, indexdef.IndexTableName)
 					}
-				} else if catalog.IsMasterIndexAlgo(indexdef.IndexAlgo) {
+				} else if catalog.IsMasterIndexAlgo(indexdef.IndexAlgo) || catalog.IsFullTextIndexAlgo(indexdef.IndexAlgo) {
 					delNodeInfo.indexTableNames = append(delNodeInfo.indexTableNames, indexdef.IndexTableName)
 				}
 			}
@@ -2345,7 +2525,7 @@ func makeClusterByExpr(tableDef *plan.TableDef, name2ColIndex map[string]int32)
 // appendPreInsertNode  append preinsert node
 func appendPreInsertNode(builder *QueryBuilder, bindCtx *BindContext,
 	objRef *ObjectRef, tableDef *TableDef,
-	lastNodeId int32, isUpdate bool) int32 {
+	lastNodeId int32, isUpdate bool) (int32, error) {
 
 	preInsertProjection := getProjectionByLastNode(builder, lastNodeId)
 	hiddenColumnTyp, hiddenColumnName := getHiddenColumnForPreInsert(tableDef)
@@ -2392,6 +2572,10 @@ func appendPreInsertNode(builder *QueryBuilder, bindCtx *BindContext,
 	for i, col := range tableDef.Cols {
 		name2ColIndex[col.Name] = int32(i)
 	}
+	checks, err := bindEnforcedChecks(builder, tableDef)
+	if err != nil {
+		return -1, err
+	}
 	preInsertNode := &Node{
 		NodeType:    plan.Node_PRE_INSERT,
 		Children:    []int32{lastNodeId},
@@ -2403,6 +2587,7 @@ func appendPreInsertNode(builder *QueryBuilder, bindCtx *BindContext,
 			IsUpdate:      isUpdate,
 			CompPkeyExpr:  makeCompPkeyExpr(tableDef, name2ColIndex),
 			ClusterByExpr: makeClusterByExpr(tableDef, name2ColIndex),
+			Checks:        checks,
 		},
 	}
 	lastNodeId = builder.appendNode(preInsertNode, bindCtx)
@@ -2450,7 +2635,7 @@ func appendPreInsertNode(builder *QueryBuilder, bindCtx *BindContext,
 		}
 	}
 
-	return lastNodeId
+	return lastNodeId, nil
 }
 
 // appendPreInsertSkMasterPlan  append preinsert node
@@ -2545,6 +2730,97 @@ func appendPreInsertSkMasterPlan(builder *QueryBuilder,
 	return newSourceStep, nil
 }
 
+// appendPreInsertFullTextPlan tokenizes the inserted rows into the rows of the fulltext index table by
+// fulltext_index_tokenize(parser, pk, col1, col2, ...).
+func appendPreInsertFullTextPlan(builder *QueryBuilder,
+	bindCtx *BindContext,
+	tableDef *TableDef,
+	indexIdx int,
+	isUpdate bool,
+	indexTableDef *TableDef,
+	genLastNodeIdFn func() int32) (int32, error) {
+
+	// 1. init details
+	idxDef := tableDef.Indexes[indexIdx]
+	originPkPos, originPkType := getPkPos(tableDef, false)
+	parser, err := catalog.FullTextIndexParser(idxDef.IndexAlgoParams)
+	if err != nil {
+		return -1, err
+	}
+
+	colsPos := make(map[string]int)
+	for i, colVal := range tableDef.Cols {
+		colsPos[colVal.Name] = i
+	}
+
+	// 2. get new source sink and recompute CP PK.
+	lastNodeId := recomputeMoCPKeyViaProjection(builder, bindCtx, tableDef, genLastNodeIdFn(), originPkPos)
+
+	// 3. add the table function node
+	args := make([]*Expr, 0, len(idxDef.Parts)+2)
+	args = append(args, makePlan2StringConstExprWithType(parser), &Expr{
+		Typ: originPkType,
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{
+				RelPos: 0,
+				ColPos: int32(originPkPos),
+				Name:   tableDef.Cols[originPkPos].Name,
+			},
+		},
+	})
+	for _, part := range idxDef.Parts {
+		pos, ok := colsPos[part]
+		if !ok {
+			return -1, moerr.NewInternalErrorf(builder.GetContext(), "column '%s' of fulltext index not found", part)
+		}
+		args = append(args, &Expr{
+			Typ: tableDef.Cols[pos].Typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: 0,
+					ColPos: int32(pos),
+					Name:   part,
+				},
+			},
+		})
+	}
+	lastNodeId = builder.appendFullTextIndexTokenizeNode(bindCtx, args, lastNodeId)
+	tokenizeNode := builder.qry.Nodes[lastNodeId]
+	tokenizeNode.ProjectList = make([]*Expr, len(tokenizeNode.TableDef.Cols))
+	for i, col := range tokenizeNode.TableDef.Cols {
+		tokenizeNode.ProjectList[i] = &Expr{
+			Typ: col.Typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: 0,
+					ColPos: int32(i),
+					Name:   col.Name,
+				},
+			},
+		}
+	}
+
+	// 4. add lock
+	if lockNodeId, ok := appendLockNode(
+		builder,
+		bindCtx,
+		lastNodeId,
+		indexTableDef,
+		false,
+		false,
+		-1,
+		nil,
+		isUpdate,
+	); ok {
+		lastNodeId = lockNodeId
+	}
+
+	lastNodeId = appendSinkNode(builder, bindCtx, lastNodeId)
+	newSourceStep := builder.appendStep(lastNodeId)
+
+	return newSourceStep, nil
+}
+
 func buildSerialFullAndPKColsProjMasterIndex(builder *QueryBuilder, bindCtx *BindContext, tableDef *TableDef, genLastNodeIdFn func() int32, originPkPos int, part string, colsType map[string]*Type, colsPos map[string]int, originPkType Type) (*Node, error) {
 	var err error
 	// 1. get new source sink
@@ -2789,18 +3065,42 @@ func appendPreInsertPlan(
 	for i, col := range tableDef.Cols {
 		colsMap[col.Name] = i
 	}
+	inputProjection := getProjectionByLastNode(builder, lastNodeId)
+	originRowIdIdx := len(inputProjection) - 1
+
+	// the values of the expression key parts are appended to the input by a projection,
+	// so the preinsert operator can take them as columns.
+	var exprParts []*Expr
 	for _, part := range idxDef.Parts {
+		if catalog.IsExprKeyPart(part) {
+			expr, err := bindIndexExprKeyPart(builder, part, tableDef.Cols, 0, nil)
+			if err != nil {
+				return -1, err
+			}
+			useColumns = append(useColumns, int32(len(inputProjection)+len(exprParts)))
+			exprParts = append(exprParts, expr)
+			continue
+		}
 		part = catalog.ResolveAlias(part)
 		if i, ok := colsMap[part]; ok {
 			useColumns = append(useColumns, int32(i))
 		}
 	}
+	if len(exprParts) > 0 {
+		lastNodeId = builder.appendNode(&Node{
+			NodeType:    plan.Node_PROJECT,
+			Children:    []int32{lastNodeId},
+			ProjectList: append(inputProjection, exprParts...),
+		}, bindCtx)
+	}
 
 	pkColumn, originPkType := getPkPos(tableDef, false)
 	lastNodeId = recomputeMoCPKeyViaProjection(builder, bindCtx, tableDef, lastNodeId, pkColumn)
 
 	var ukType Type
-	if len(idxDef.Parts) == 1 {
+	if len(idxDef.Parts) == 1 && len(exprParts) == 1 {
+		ukType = exprParts[0].Typ
+	} else if len(idxDef.Parts) == 1 {
 		ukType = tableDef.Cols[useColumns[0]].Typ
 	} else {
 		ukType = Type{
@@ -2831,7 +3131,6 @@ func appendPreInsertPlan(
 	})
 	if isUpddate {
 		lastProjection := builder.qry.Nodes[lastNodeId].ProjectList
-		originRowIdIdx := len(lastProjection) - 1
 		preinsertUkProjection = append(preinsertUkProjection, &plan.Expr{
 			Typ: lastProjection[originRowIdIdx].Typ,
 			Expr: &plan.Expr_Col{
@@ -2996,7 +3295,19 @@ func appendDeleteIndexTablePlan(
 	var joinConds []*Expr
 	var leftExpr *Expr
 	partsLength := len(indexdef.Parts)
-	if partsLength == 1 {
+	var keyPartCols []*ColDef
+	if catalog.HasExprKeyPart(indexdef.Parts) {
+		keyPartCols = make([]*ColDef, 0, len(typMap))
+		for name, typ := range typMap {
+			keyPartCols = append(keyPartCols, &ColDef{Name: name, Typ: typ})
+		}
+	}
+	if partsLength == 1 && catalog.IsExprKeyPart(indexdef.Parts[0]) {
+		leftExpr, err = bindIndexExprKeyPart(builder, indexdef.Parts[0], keyPartCols, 1, posMap)
+		if err != nil {
+			return -1, err
+		}
+	} else if partsLength == 1 {
 		orginIndexColumnName := indexdef.Parts[0]
 		typ := typMap[orginIndexColumnName]
 		leftExpr = &Expr{
@@ -3012,6 +3323,12 @@ func appendDeleteIndexTablePlan(
 	} else {
 		args := make([]*Expr, partsLength)
 		for i, column := range indexdef.Parts {
+			if catalog.IsExprKeyPart(column) {
+				if args[i], err = bindIndexExprKeyPart(builder, column, keyPartCols, 1, posMap); err != nil {
+					return -1, err
+				}
+				continue
+			}
 			column = catalog.ResolveAlias(column)
 			typ := typMap[column]
 			args[i] = &plan.Expr{
@@ -3210,6 +3527,108 @@ func appendDeleteMasterTablePlan(builder *QueryBuilder, bindCtx *BindContext,
 
 	return lastNodeId, nil
 }
+
+// appendDeleteFullTextTablePlan joins the deleted rows with the fulltext index table
+// to find all the words of the deleted documents.
+func appendDeleteFullTextTablePlan(builder *QueryBuilder, bindCtx *BindContext,
+	fullTextObjRef *ObjectRef, fullTextTableDef *TableDef,
+	baseNodeId int32, tableDef *TableDef) (int32, error) {
+
+	originPkColumnPos, originPkType := getPkPos(tableDef, false)
+
+	lastNodeId := baseNodeId
+	projectList := getProjectionByLastNode(builder, lastNodeId)
+
+	var rightRowIdPos int32 = -1
+	var rightPkPos int32 = -1
+	var rightDocIdPos int32 = -1
+	scanNodeProject := make([]*Expr, len(fullTextTableDef.Cols))
+	for colIdx, colVal := range fullTextTableDef.Cols {
+		switch colVal.Name {
+		case catalog.Row_ID:
+			rightRowIdPos = int32(colIdx)
+		case catalog.FullTextIndexTableKeyColName:
+			rightPkPos = int32(colIdx)
+		case catalog.FullTextIndexTableDocIdColName:
+			rightDocIdPos = int32(colIdx)
+		}
+
+		scanNodeProject[colIdx] = &plan.Expr{
+			Typ: colVal.Typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					ColPos: int32(colIdx),
+					Name:   colVal.Name,
+				},
+			},
+		}
+	}
+
+	rightId := builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_TABLE_SCAN,
+		Stats:       &plan.Stats{},
+		ObjRef:      fullTextObjRef,
+		TableDef:    fullTextTableDef,
+		ProjectList: scanNodeProject,
+	}, bindCtx)
+
+	// join conditions: pk = __mo_index_pri_col
+	joinCond, err := BindFuncExprImplByPlanExpr(builder.GetContext(), "=", []*Expr{
+		{
+			Typ: originPkType,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: 0,
+					ColPos: int32(originPkColumnPos),
+					Name:   tableDef.Pkey.PkeyColName,
+				},
+			},
+		},
+		{
+			Typ: fullTextTableDef.Cols[rightDocIdPos].Typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: 1,
+					ColPos: rightDocIdPos,
+					Name:   catalog.FullTextIndexTableDocIdColName,
+				},
+			},
+		},
+	})
+	if err != nil {
+		return -1, err
+	}
+
+	projectList = append(projectList, &plan.Expr{
+		Typ: fullTextTableDef.Cols[rightRowIdPos].Typ,
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{
+				RelPos: 1,
+				ColPos: rightRowIdPos,
+				Name:   catalog.Row_ID,
+			},
+		},
+	}, &plan.Expr{
+		Typ: fullTextTableDef.Cols[rightPkPos].Typ,
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{
+				RelPos: 1,
+				ColPos: rightPkPos,
+				Name:   catalog.FullTextIndexTableKeyColName,
+			},
+		},
+	})
+	lastNodeId = builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_JOIN,
+		JoinType:    plan.Node_LEFT,
+		Children:    []int32{lastNodeId, rightId},
+		OnList:      []*Expr{joinCond},
+		ProjectList: projectList,
+	}, bindCtx)
+
+	return lastNodeId, nil
+}
+
 func appendDeleteIvfTablePlan(builder *QueryBuilder, bindCtx *BindContext,
 	entriesObjRef *ObjectRef, entriesTableDef *TableDef,
 	baseNodeId int32, tableDef *TableDef) (int32, error) {
@@ -4103,6 +4522,12 @@ func getSqlForRenameTable(db, oldName, newName string) (ret []string) {
 	sb.WriteString(fmt.Sprintf("set refer_table_name = '%s' ", newName))
 	sb.WriteString(fmt.Sprintf("where refer_db_name = '%s' and refer_table_name = '%s' ; ", db, oldName))
 	ret = append(ret, sb.String())
+
+	sb.Reset()
+	sb.WriteString("update `mo_catalog`.`mo_triggers` ")
+	sb.WriteString(fmt.Sprintf("set table_name = '%s' ", newName))
+	sb.WriteString(fmt.Sprintf("where db_name = '%s' and table_name = '%s' ; ", db, oldName))
+	ret = append(ret, sb.String())
 	return
 }
 
@@ -19,8 +19,14 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
 )
 
 // checkConstraintNames Check whether the name of the constraint(index,unqiue etc) is legal, and handle constraints without a name
@@ -68,7 +74,7 @@ func checkDuplicateConstraint(namesMap map[string]bool, name string, foreign boo
 // setEmptyUniqueIndexName Set name for unqiue index constraint with an empty name
 func setEmptyUniqueIndexName(namesMap map[string]bool, indexConstr *tree.UniqueIndex) {
 	if indexConstr.Name == "" && len(indexConstr.KeyParts) > 0 {
-		colName := indexConstr.KeyParts[0].ColName.ColName()
+		colName := getKeyPartName(indexConstr.KeyParts[0])
 		constrName := colName
 		i := 2
 		if strings.EqualFold(constrName, "PRIMARY") {
@@ -88,7 +94,7 @@ func setEmptyUniqueIndexName(namesMap map[string]bool, indexConstr *tree.UniqueI
 // setEmptyIndexName Set name for index constraint with an empty name
 func setEmptyIndexName(namesMap map[string]bool, indexConstr *tree.Index) {
 	if indexConstr.Name == "" && len(indexConstr.KeyParts) > 0 {
-		colName := indexConstr.KeyParts[0].ColName.ColName()
+		colName := getKeyPartName(indexConstr.KeyParts[0])
 		constrName := colName
 		i := 2
 		if strings.EqualFold(constrName, "PRIMARY") {
@@ -105,8 +111,8 @@ func setEmptyIndexName(namesMap map[string]bool, indexConstr *tree.Index) {
 	}
 }
 
-// TODO
-// Currently, using expression as index keyparts are not supported in matrixone
+// checkIndexKeypartSupportability checks the key parts of the indexes which are not regular secondary
+// or unique index, using expression as index keyparts are only supported by the later two.
 func checkIndexKeypartSupportability(context context.Context, keyParts []*tree.KeyPart) error {
 	for _, key := range keyParts {
 		if key.Expr != nil {
@@ -115,3 +121,201 @@ func checkIndexKeypartSupportability(context context.Context, keyParts []*tree.K
 	}
 	return nil
 }
+
+// getKeyPartName returns the name of the key part used to name the index, as MySQL does,
+// the index is named functional_index if the key part is an expression.
+func getKeyPartName(keyPart *tree.KeyPart) string {
+	if keyPart.ColName == nil {
+		return "functional_index"
+	}
+	return keyPart.ColName.ColName()
+}
+
+// isExprKeyPart reports whether the key part of a regular secondary or unique index is kept as
+// an expression key part, ie a functional key part ((expr)), or a prefix key part col(n) of a
+// TEXT or BLOB column, or of a string column shorter than n. The prefix length of the other
+// columns is ignored and the whole value is indexed.
+func isExprKeyPart(keyPart *tree.KeyPart, colMap map[string]*ColDef) bool {
+	if keyPart.Expr != nil {
+		return true
+	}
+	if keyPart.Length == 0 {
+		return false
+	}
+	col, ok := colMap[keyPart.ColName.ColName()]
+	if !ok {
+		return false
+	}
+	switch types.T(col.Typ.Id) {
+	case types.T_text, types.T_blob:
+		return true
+	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary:
+		return keyPart.Length < 0 || keyPart.Length < int(col.Typ.Width)
+	}
+	return false
+}
+
+// buildIndexKeyPart checks the key part of a regular secondary or unique index, and returns the
+// part kept in IndexDef.Parts and the type of the values it indexes.
+func buildIndexKeyPart(ctx CompilerContext, keyPart *tree.KeyPart, colMap map[string]*ColDef) (string, Type, error) {
+	if isExprKeyPart(keyPart, colMap) {
+		return buildIndexExprKeyPart(ctx, keyPart, colMap)
+	}
+
+	name := keyPart.ColName.ColName()
+	nameOrigin := keyPart.ColName.ColNameOrigin()
+	col, ok := colMap[name]
+	if !ok {
+		return "", Type{}, moerr.NewInvalidInputf(ctx.GetContext(), "column '%s' is not exist", nameOrigin)
+	}
+	switch types.T(col.Typ.Id) {
+	case types.T_blob:
+		return "", Type{}, moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("BLOB column '%s' cannot be in index", nameOrigin))
+	case types.T_text:
+		return "", Type{}, moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("TEXT column '%s' cannot be in index", nameOrigin))
+	case types.T_datalink:
+		return "", Type{}, moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("DATALINK column '%s' cannot be in index", nameOrigin))
+	case types.T_json:
+		return "", Type{}, moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("JSON column '%s' cannot be in index", nameOrigin))
+	case types.T_array_float32, types.T_array_float64:
+		return "", Type{}, moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("VECTOR column '%s' cannot be in index", nameOrigin))
+	}
+	return name, col.Typ, nil
+}
+
+// buildIndexExprKeyPart builds the expression key part from a functional key part ((expr)) or
+// a prefix key part col(n), which is indexed as cast(substring(col, 1, n) as varchar(n)).
+func buildIndexExprKeyPart(ctx CompilerContext, keyPart *tree.KeyPart, colMap map[string]*ColDef) (string, Type, error) {
+	var part string
+	if keyPart.Expr != nil {
+		if _, ok := keyPart.Expr.(*tree.UnresolvedName); ok {
+			return "", Type{}, moerr.NewNotSupported(ctx.GetContext(), "functional index on a column, use a regular index instead")
+		}
+		fmtCtx := tree.NewFmtCtx(dialect.MYSQL, tree.WithQuoteString(true))
+		keyPart.Expr.Format(fmtCtx)
+		part = catalog.CreateExprKeyPart(fmtCtx.String())
+	} else {
+		if keyPart.Length < 0 {
+			return "", Type{}, moerr.NewInvalidInputf(ctx.GetContext(), "incorrect prefix key of column '%s'", keyPart.ColName.ColNameOrigin())
+		}
+		var binary bool
+		switch types.T(colMap[keyPart.ColName.ColName()].Typ.Id) {
+		case types.T_blob, types.T_binary, types.T_varbinary:
+			binary = true
+		}
+		part = catalog.CreatePrefixKeyPart(keyPart.ColName.ColName(), keyPart.Length, binary)
+	}
+
+	if len(part) > catalog.MaxExprKeyPartLen {
+		return "", Type{}, moerr.NewInvalidInputf(ctx.GetContext(), "the expression of index key part is longer than %d", catalog.MaxExprKeyPartLen)
+	}
+
+	cols := make([]*ColDef, 0, len(colMap))
+	for _, col := range colMap {
+		cols = append(cols, col)
+	}
+	builder := NewQueryBuilder(plan.Query_SELECT, ctx, false, false)
+	expr, err := bindIndexExprKeyPart(builder, part, cols, 0, nil)
+	if err != nil {
+		return "", Type{}, err
+	}
+	if err = checkIndexExprKeyPart(ctx.GetContext(), expr); err != nil {
+		return "", Type{}, err
+	}
+	return part, expr.Typ, nil
+}
+
+// checkIndexExprKeyPart checks the expression key part is deterministic, references at
+// least one column and has a type which can be indexed.
+func checkIndexExprKeyPart(ctx context.Context, expr *plan.Expr) error {
+	switch types.T(expr.Typ.Id) {
+	case types.T_any, types.T_blob, types.T_text, types.T_datalink, types.T_json, types.T_array_float32, types.T_array_float64:
+		return moerr.NewNotSupportedf(ctx, "expression of type %s in index key part, cast it to another type", types.T(expr.Typ.Id).String())
+	}
+
+	hasCol := false
+	var check func(e *plan.Expr) error
+	check = func(e *plan.Expr) error {
+		switch impl := e.Expr.(type) {
+		case *plan.Expr_Col:
+			hasCol = true
+		case *plan.Expr_F:
+			if fn, ok := function.GetFunctionByIdWithoutError(impl.F.Func.Obj); ok && (fn.CannotFold() || fn.IsRealTimeRelated()) {
+				return moerr.NewNotSupportedf(ctx, "non-deterministic function '%s' in index key part", impl.F.Func.ObjName)
+			}
+			for _, arg := range impl.F.Args {
+				if err := check(arg); err != nil {
+					return err
+				}
+			}
+		case *plan.Expr_Sub, *plan.Expr_P, *plan.Expr_V:
+			return moerr.NewNotSupported(ctx, "subquery or variable in index key part")
+		}
+		return nil
+	}
+	if err := check(expr); err != nil {
+		return err
+	}
+	if !hasCol {
+		return moerr.NewNotSupported(ctx, "index key part without any column")
+	}
+	return nil
+}
+
+// bindIndexExprKeyPart binds the expression key part on the columns of an input, the columns
+// are referenced by relPos and their position in colPos, or in cols if colPos is nil.
+func bindIndexExprKeyPart(builder *QueryBuilder, part string, cols []*ColDef, relPos int32, colPos map[string]int) (*plan.Expr, error) {
+	stmts, err := parsers.Parse(builder.GetContext(), dialect.MYSQL, "select "+catalog.ResolveExprKeyPart(part), 1)
+	if err != nil {
+		return nil, err
+	}
+	binder := NewUpdateBinder(builder.GetContext(), builder, nil, cols)
+	expr, err := binder.BindExpr(stmts[0].(*tree.Select).Select.(*tree.SelectClause).Exprs[0].Expr, 0, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var remap func(e *plan.Expr)
+	remap = func(e *plan.Expr) {
+		switch impl := e.Expr.(type) {
+		case *plan.Expr_Col:
+			impl.Col.RelPos = relPos
+			if colPos != nil {
+				impl.Col.ColPos = int32(colPos[impl.Col.Name])
+			}
+		case *plan.Expr_F:
+			for _, arg := range impl.F.Args {
+				remap(arg)
+			}
+		}
+	}
+	remap(expr)
+	return expr, nil
+}
+
+// exprKeyPartColsCollector collects the columns referenced by an expression key part.
+type exprKeyPartColsCollector struct {
+	cols []string
+}
+
+func (c *exprKeyPartColsCollector) Enter(n tree.Expr) (node tree.Expr, skipChildren bool) {
+	if name, ok := n.(*tree.UnresolvedName); ok {
+		c.cols = append(c.cols, name.ColName())
+	}
+	return n, false
+}
+
+func (c *exprKeyPartColsCollector) Exit(n tree.Expr) (node tree.Expr, ok bool) {
+	return n, true
+}
+
+// getExprKeyPartCols returns the columns referenced by the expression key part.
+func getExprKeyPartCols(ctx context.Context, part string) ([]string, error) {
+	stmts, err := parsers.Parse(ctx, dialect.MYSQL, "select "+catalog.ResolveExprKeyPart(part), 1)
+	if err != nil {
+		return nil, err
+	}
+	collector := &exprKeyPartColsCollector{}
+	stmts[0].(*tree.Select).Select.(*tree.SelectClause).Exprs[0].Expr.Accept(collector)
+	return collector.cols, nil
+}
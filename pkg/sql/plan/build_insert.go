@@ -93,6 +93,18 @@ func buildInsert(stmt *tree.Insert, ctx CompilerContext, isReplace bool, isPrepa
 		}()
 	}
 
+	if len(stmt.OnDuplicateUpdate) > 0 && !stmt.IsRestore {
+		err = checkNoTriggers(ctx, tableDef, "INSERT ... ON DUPLICATE KEY UPDATE",
+			tree.TriggerEventInsert, tree.TriggerEventUpdate)
+		if err != nil {
+			return nil, err
+		}
+		isIgnore := len(stmt.OnDuplicateUpdate) == 1 && stmt.OnDuplicateUpdate[0] == nil
+		if !isIgnore && hasGeneratedCols(tableDef) {
+			return nil, moerr.NewNotSupported(ctx.GetContext(), "INSERT ... ON DUPLICATE KEY UPDATE on a table with generated columns")
+		}
+	}
+
 	bindCtx := NewBindContext(builder, nil)
 	ifExistAutoPkCol, insertWithoutUniqueKeyMap, ifInsertFromUniqueColMap, err := initInsertStmt(builder, bindCtx, stmt, rewriteInfo)
 	if err != nil {
@@ -310,7 +322,7 @@ func buildInsert(stmt *tree.Insert, ctx CompilerContext, isReplace bool, isPrepa
 
 		query.StmtType = plan.Query_UPDATE
 	} else {
-		err = buildInsertPlans(ctx, builder, bindCtx, stmt, objRef, tableDef, rewriteInfo.rootId, ifExistAutoPkCol, insertWithoutUniqueKeyMap, ifInsertFromUniqueColMap)
+		err = buildInsertPlans(ctx, builder, bindCtx, stmt, objRef, tableDef, rewriteInfo.rootId, ifExistAutoPkCol, insertWithoutUniqueKeyMap, ifInsertFromUniqueColMap, true)
 		if err != nil {
 			return nil, err
 		}
@@ -403,6 +415,11 @@ func canUsePkFilter(builder *QueryBuilder, ctx CompilerContext, stmt *tree.Inser
 		return false // break condition 0
 	}
 
+	if hasGeneratedCols(tableDef) {
+		// the values of generated columns are not in the value scan
+		return false
+	}
+
 	if builder.qry.Nodes[0].NodeType != plan.Node_VALUE_SCAN {
 		return false // break condition 1
 	}
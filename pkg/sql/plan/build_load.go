@@ -73,6 +73,9 @@ func getExternalWithColListProject(stmt *tree.Load, ctx CompilerContext, tableDe
 				return nil, nil, nil, nil, moerr.NewInternalErrorf(ctx.GetContext(), "column '%s' does not exist", colName)
 			}
 			tbColIdx := newTableDef.Name2ColIndex[colName]
+			if isGeneratedCol(newTableDef.Cols[tbColIdx]) {
+				return nil, nil, nil, nil, newGeneratedColWriteError(ctx.GetContext(), colName, tblName)
+			}
 			colExpr := &plan.Expr{
 				Typ: newTableDef.Cols[tbColIdx].Typ,
 				Expr: &plan.Expr_Col{
@@ -248,7 +251,9 @@ func buildLoad(stmt *tree.Load, ctx CompilerContext, isPrepareStmt bool) (*Plan,
 		return nil, err
 	}
 
-	if stmt.Param.FileSize < LoadParallelMinSize {
+	// the files of the binary formats can not be split by offsets.
+	if stmt.Param.FileSize < LoadParallelMinSize || stmt.Param.Format == tree.PARQUET ||
+		stmt.Param.Format == tree.ORC || stmt.Param.Format == tree.AVRO {
 		stmt.Param.Parallel = false
 	}
 	noCompress := getCompressType(stmt.Param, fileName) == tree.NOCOMPRESS
@@ -338,6 +343,12 @@ func buildLoad(stmt *tree.Load, ctx CompilerContext, isPrepareStmt bool) (*Plan,
 	if stmt.Param.Parallel && (!noCompress || stmt.Local) {
 		projectNode.ProjectList = makeCastExpr(stmt, fileName, originTableDef, projectNode)
 	}
+	// the fields of the generated columns in the file are ignored
+	if hasGeneratedCols(originTableDef) {
+		if err = computeGeneratedCols(builder, originTableDef, projectNode.ProjectList); err != nil {
+			return nil, err
+		}
+	}
 	lastNodeId = builder.appendNode(projectNode, bindCtx)
 	builder.qry.LoadTag = true
 
@@ -358,7 +369,7 @@ func buildLoad(stmt *tree.Load, ctx CompilerContext, isPrepareStmt bool) (*Plan,
 
 	// append hidden column to tableDef
 	newTableDef := DeepCopyTableDef(originTableDef, true)
-	err = buildInsertPlans(ctx, builder, bindCtx, nil, objRef, newTableDef, lastNodeId, ifExistAutoPkCol, nil, nil)
+	err = buildInsertPlans(ctx, builder, bindCtx, nil, objRef, newTableDef, lastNodeId, ifExistAutoPkCol, nil, nil, true)
 	if err != nil {
 		return nil, err
 	}
@@ -448,6 +459,11 @@ func getProjectNode(stmt *tree.Load, ctx CompilerContext, node *plan.Node, table
 			node.ProjectList[i] = tmp
 			continue
 		}
+		if isGeneratedCol(tableDef.Cols[i]) {
+			// computed once all the columns are known
+			node.ProjectList[i] = virtualColNullExpr(tableDef.Cols[i])
+			continue
+		}
 
 		defExpr, err := getDefaultExpr(ctx.GetContext(), tableDef.Cols[i])
 		if err != nil {
@@ -40,7 +40,16 @@ func buildReplace(stmt *tree.Replace, ctx CompilerContext, isPrepareStmt bool, r
 		return nil, moerr.NewInvalidInput(ctx.GetContext(), "replace does not support multi-table")
 	}
 	tableDef := tblInfo.tableDefs[0]
+	if !rewriteFromOnDuplicateKey {
+		err = checkNoTriggers(ctx, tableDef, "REPLACE", tree.TriggerEventInsert, tree.TriggerEventDelete)
+		if err != nil {
+			return nil, err
+		}
+	}
 	keys := getAllKeys(tableDef)
+	if err = checkGeneratedColsNotInKeys(ctx.GetContext(), tableDef, keys, "REPLACE"); err != nil {
+		return nil, err
+	}
 	deleteCond := ""
 
 	if keys != nil {
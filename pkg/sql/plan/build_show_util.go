@@ -96,7 +96,17 @@ func ConstructCreateTableSQL(ctx CompilerContext, tableDef *plan.TableDef, snaps
 		fmt.Fprintf(buf, "  `%s` %s", formatStr(colNameOrigin), typeStr)
 
 		//-------------------------------------------------------------------------------------------------------------
-		if col.Typ.AutoIncr {
+		if isGeneratedCol(col) {
+			buf.WriteString(" GENERATED ALWAYS AS (" + col.Default.Generated.OriginString + ")")
+			if col.Default.Generated.Stored {
+				buf.WriteString(" STORED")
+			} else {
+				buf.WriteString(" VIRTUAL")
+			}
+			if !col.Default.NullAbility {
+				buf.WriteString(" NOT NULL")
+			}
+		} else if col.Typ.AutoIncr {
 			buf.WriteString(" NOT NULL AUTO_INCREMENT")
 		} else {
 			if !col.Default.NullAbility {
@@ -167,11 +177,13 @@ func ConstructCreateTableSQL(ctx CompilerContext, tableDef *plan.TableDef, snaps
 			var indexStr string
 			if indexdef.Unique {
 				indexStr = "  UNIQUE KEY "
+			} else if catalog.IsFullTextIndexAlgo(indexdef.IndexAlgo) {
+				indexStr = "  FULLTEXT KEY "
 			} else {
 				indexStr = "  KEY "
 			}
 			indexStr += fmt.Sprintf("`%s` ", formatStr(indexdef.IndexName))
-			if !catalog.IsNullIndexAlgo(indexdef.IndexAlgo) {
+			if !catalog.IsNullIndexAlgo(indexdef.IndexAlgo) && !catalog.IsFullTextIndexAlgo(indexdef.IndexAlgo) {
 				indexStr += fmt.Sprintf("USING %s ", indexdef.IndexAlgo)
 			}
 			indexStr += "("
@@ -184,8 +196,14 @@ func ConstructCreateTableSQL(ctx CompilerContext, tableDef *plan.TableDef, snaps
 					indexStr += ","
 				}
 
-				part = colNameToOriginName[part]
-				indexStr += fmt.Sprintf("`%s`", formatStr(part))
+				if col, length, ok := catalog.ResolvePrefixKeyPart(part); ok {
+					indexStr += fmt.Sprintf("`%s`(%d)", formatStr(colNameToOriginName[col]), length)
+				} else if catalog.IsExprKeyPart(part) {
+					indexStr += part
+				} else {
+					part = colNameToOriginName[part]
+					indexStr += fmt.Sprintf("`%s`", formatStr(part))
+				}
 				i++
 			}
 
@@ -261,6 +279,16 @@ func ConstructCreateTableSQL(ctx CompilerContext, tableDef *plan.TableDef, snaps
 			formatStr(fk.Name), strings.Join(colOriginNames, "`,`"), fkRefDbTblName, strings.Join(fkColOriginNames, "`,`"), fk.OnDelete.String(), fk.OnUpdate.String())
 	}
 
+	for _, check := range tableDef.Checks {
+		if rowCount != 0 {
+			createStr += ",\n"
+		}
+		createStr += fmt.Sprintf("  CONSTRAINT `%s` CHECK (%s)", formatStr(check.Name), check.OriginString)
+		if !check.Enforced {
+			createStr += " NOT ENFORCED"
+		}
+	}
+
 	if rowCount != 0 {
 		createStr += "\n"
 	}
@@ -268,21 +296,38 @@ func ConstructCreateTableSQL(ctx CompilerContext, tableDef *plan.TableDef, snaps
 
 	var comment string
 	var partition string
+	var compression string
+	compressProps := make(map[string]string)
 	for _, def := range tableDef.Defs {
 		if proDef, ok := def.Def.(*plan.TableDef_DefType_Properties); ok {
 			for _, kv := range proDef.Properties.Properties {
-				if kv.Key == catalog.SystemRelAttr_Comment {
+				switch kv.Key {
+				case catalog.SystemRelAttr_Comment:
 					comment = " COMMENT='" + kv.Value + "'"
+				case catalog.PropCompressAlg:
+					compression = " COMPRESSION='" + kv.Value + "'"
+				case catalog.PropCompressLevel, catalog.PropColumnEncoding:
+					compressProps[kv.Key] = kv.Value
 				}
 			}
 		}
 	}
+	if len(compressProps) > 0 {
+		var props []string
+		for _, key := range []string{catalog.PropCompressLevel, catalog.PropColumnEncoding} {
+			if value, ok := compressProps[key]; ok {
+				props = append(props, fmt.Sprintf("\"%s\" = \"%s\"", key, value))
+			}
+		}
+		compression += " PROPERTIES(" + strings.Join(props, ", ") + ")"
+	}
 
 	if tableDef.Partition != nil {
 		partition = ` ` + tableDef.Partition.PartitionMsg
 	}
 
 	createStr += comment
+	createStr += compression
 	createStr += partition
 
 	/**
@@ -145,6 +145,14 @@ func Test_SingleShowCreateTable(t *testing.T) {
 				);`,
 			want: "CREATE TABLE `example_table` (\n  `id` int NOT NULL,\n  `name` varchar(255) NOT NULL DEFAULT 'default_name',\n  `email` varchar(255) DEFAULT NULL,\n  `created_at` timestamp DEFAULT CURRENT_TIMESTAMP(),\n  `updated_at` timestamp DEFAULT CURRENT_TIMESTAMP() ON UPDATE CURRENT_TIMESTAMP(),\n  PRIMARY KEY (`id`),\n  UNIQUE KEY `uk1` (`name`),\n  UNIQUE KEY `uk2` (`email`)\n)",
 		},
+		{
+			name: "test8",
+			sql: `CREATE TABLE t_compress (
+				id INT NOT NULL,
+				PRIMARY KEY (id)
+				) COMMENT 'cold' COMPRESSION = 'ZSTD' PROPERTIES("column_encoding" = "true", "compress_level" = "9");`,
+			want: "CREATE TABLE `t_compress` (\n  `id` int NOT NULL,\n  PRIMARY KEY (`id`)\n) COMMENT='cold' COMPRESSION='zstd' PROPERTIES(\"compress_level\" = \"9\", \"column_encoding\" = \"true\")",
+		},
 	}
 
 	for _, tt := range tests {
@@ -23,8 +23,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/lock"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
@@ -515,7 +517,177 @@ func TestSingleTableSQLBuilder(t *testing.T) {
 	runTestShouldError(mock, t, sqls)
 }
 
+func TestSelectLockOptions(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	cases := []struct {
+		sql    string
+		mode   lock.LockMode
+		policy lock.WaitPolicy
+	}{
+		{"select * from nation where n_nationkey = 1 for update", lock.LockMode_Exclusive, lock.WaitPolicy_Wait},
+		{"select * from nation where n_nationkey = 1 for update nowait", lock.LockMode_Exclusive, lock.WaitPolicy_FastFail},
+		{"select * from nation where n_nationkey > 1 limit 1 for update skip locked", lock.LockMode_Exclusive, lock.WaitPolicy_SkipLocked},
+		{"select * from nation where n_nationkey = 1 for share", lock.LockMode_Shared, lock.WaitPolicy_Wait},
+		{"select * from nation where n_nationkey = 1 for share nowait", lock.LockMode_Shared, lock.WaitPolicy_FastFail},
+		{"select * from nation where n_nationkey = 1 for share skip locked", lock.LockMode_Shared, lock.WaitPolicy_SkipLocked},
+	}
+	for _, c := range cases {
+		logicPlan, err := runOneStmt(mock, t, c.sql)
+		require.NoError(t, err, c.sql)
+		var target *plan.LockTarget
+		for _, node := range logicPlan.GetQuery().Nodes {
+			if node.NodeType == plan.Node_LOCK_OP {
+				target = node.LockTargets[0]
+			}
+		}
+		require.NotNil(t, target, c.sql)
+		require.Equal(t, c.mode, target.Mode, c.sql)
+		require.Equal(t, c.policy, target.WaitPolicy, c.sql)
+	}
+}
+
+func TestSelectSkipLockedWithLimit(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	cases := []struct {
+		sql       string
+		childType plan.Node_NodeType
+	}{
+		{"select n_name from nation where n_nationkey > 1 limit 2 for update skip locked", plan.Node_TABLE_SCAN},
+		{"select n_name from nation where n_nationkey > 1 order by n_name limit 2 offset 1 for update skip locked", plan.Node_SORT},
+	}
+	for _, c := range cases {
+		logicPlan, err := runOneStmt(mock, t, c.sql)
+		require.NoError(t, err, c.sql)
+		nodes := logicPlan.GetQuery().Nodes
+		var lockNode *plan.Node
+		for _, node := range nodes {
+			if node.NodeType == plan.Node_LOCK_OP {
+				lockNode = node
+			}
+		}
+		// the lock node is under the limit, and locks the primary key passed up
+		// by the child.
+		require.NotNil(t, lockNode, c.sql)
+		require.NotNil(t, lockNode.Limit, c.sql)
+		child := nodes[lockNode.Children[0]]
+		require.Equal(t, c.childType, child.NodeType, c.sql)
+		target := lockNode.LockTargets[0]
+		require.Less(t, int(target.PrimaryColIdxInBat), len(child.ProjectList), c.sql)
+		require.Equal(t, target.PrimaryColTyp.Id, child.ProjectList[target.PrimaryColIdxInBat].Typ.Id, c.sql)
+	}
+
+	// the lock node is not under the limit without SKIP LOCKED
+	logicPlan, err := runOneStmt(mock, t, "select n_name from nation where n_nationkey > 1 limit 2 for update")
+	require.NoError(t, err)
+	for _, node := range logicPlan.GetQuery().Nodes {
+		if node.NodeType == plan.Node_LOCK_OP {
+			require.Nil(t, node.Limit)
+		}
+	}
+}
+
+func TestWindowFunction(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	// should pass
+	sqls := []string{
+		"SELECT N_NAME, rank() over (partition by N_REGIONKEY order by N_NATIONKEY) FROM NATION",
+		"SELECT N_NAME, percent_rank() over (order by N_NATIONKEY), cume_dist() over (order by N_NATIONKEY) FROM NATION",
+		"SELECT N_NAME, ntile(4) over (partition by N_REGIONKEY order by N_NATIONKEY) FROM NATION",
+		"SELECT N_NAME, lag(N_NAME) over (order by N_NATIONKEY), lead(N_NATIONKEY, 2, 0) over (order by N_NATIONKEY) FROM NATION",
+		"SELECT N_NAME, first_value(N_NAME) over (order by N_NATIONKEY), last_value(N_NAME) over (order by N_NATIONKEY rows between 1 preceding and 1 following) FROM NATION",
+		"SELECT N_NAME, nth_value(N_NAME, 2) over (partition by N_REGIONKEY order by N_NATIONKEY) FROM NATION",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// should error
+	sqls = []string{
+		"SELECT ntile(N_NAME) over (order by N_NATIONKEY) FROM NATION",
+		"SELECT lag(N_NAME, 'a') over (order by N_NATIONKEY) FROM NATION",
+		"SELECT first_value(N_NAME, 1) over (order by N_NATIONKEY) FROM NATION",
+		"SELECT nth_value(N_NAME) over (order by N_NATIONKEY) FROM NATION",
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
+func TestJsonFunction(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	// should pass
+	sqls := []string{
+		"SELECT json_object('name', N_NAME, 'key', N_NATIONKEY, 'none', null), json_array(N_NAME, 1.5, true) FROM NATION",
+		`SELECT json_set('{"a": 1}', '$.b', N_NATIONKEY), json_insert('[1]', '$[1]', N_NAME), json_replace('{"a": 1}', '$.a', null) FROM NATION`,
+		`SELECT json_remove('{"a": 1, "b": 2}', '$.a', '$.b'), json_keys('{"a": 1}'), json_length('[1, 2]', '$') FROM NATION`,
+		`SELECT N_NAME FROM NATION WHERE json_contains('[1, 2, 3]', cast(N_NATIONKEY as varchar))`,
+		"SELECT N_REGIONKEY, json_arrayagg(N_NAME), json_objectagg(N_NAME, N_NATIONKEY) FROM NATION GROUP BY N_REGIONKEY",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// should error
+	sqls = []string{
+		"SELECT json_object('a') FROM NATION",
+		"SELECT json_set('{}', '$.a') FROM NATION",
+		"SELECT json_remove(N_NATIONKEY, '$.a') FROM NATION",
+		"SELECT json_contains('[1]') FROM NATION",
+		"SELECT json_objectagg(N_NAME) FROM NATION",
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
+func TestJsonTable(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	// should pass
+	sqls := []string{
+		`SELECT * FROM json_table('[{"a": 1}, {"a": 2}]', '$[*]' columns (id for ordinality, a int path '$.a')) jt`,
+		`SELECT jt.b FROM json_table('{"b": [1, 2]}', '$' columns (b json path '$.b' default '[]' on empty null on error)) AS jt WHERE jt.b IS NOT NULL`,
+		`SELECT N_NAME, jt.* FROM NATION, json_table(N_COMMENT, '$' columns (x varchar(10) path '$.x', y int exists path '$.y', nested path '$.z[*]' columns (z decimal(10, 2) path '$'))) jt`,
+		`SELECT count(*) FROM NATION n JOIN json_table(n.N_COMMENT, '$[*]' columns (v datetime path '$')) jt`,
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// should error
+	sqls = []string{
+		`SELECT * FROM json_table('[]', '$[*]' columns (a int path '$.a', a int path '$.b')) jt`,
+		`SELECT * FROM json_table('[]', 'x' columns (a int path '$.a')) jt`,
+		`SELECT * FROM json_table('[]', '$' columns (a int path '$.a' default 'x' on empty)) jt`,
+		`SELECT * FROM NATION, json_table(N_NATIONKEY, '$' columns (a int path '$.a')) jt`,
+		`SELECT * FROM json_table('[]', '$' columns (a blob path '$.a')) jt`,
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
 // test join table plan building
+func TestFunctionalIndex(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	// should pass
+	sqls := []string{
+		"insert into contacts values (1, 'A@example.com', 'hello world')",
+		"insert into contacts select id + 100, email, notes from contacts",
+		"update contacts set email = 'b@example.com' where id = 1",
+		"update contacts set notes = 'bye' where id = 1",
+		"delete from contacts where id = 1",
+		"select * from contacts where lower(email) = 'a@example.com'",
+		"select * from contacts where notes = 'hello world'",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// the predicate on the indexed expression is applied by the unique index table
+	logicPlan, err := runOneStmt(mock, t, "select * from contacts where lower(email) = 'a@example.com'")
+	require.NoError(t, err)
+	indexJoin := false
+	for _, node := range logicPlan.GetQuery().Nodes {
+		if node.NodeType == plan.Node_JOIN && node.JoinType == plan.Node_INDEX {
+			indexJoin = true
+		}
+	}
+	require.True(t, indexJoin)
+
+	// should error
+	sqls = []string{
+		"alter table contacts drop column email",
+		"alter table contacts rename column email to mail",
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
 func TestJoinTableSqlBuilder(t *testing.T) {
 	mock := NewMockOptimizer(false)
 
@@ -563,6 +735,7 @@ func TestDerivedTableSqlBuilder(t *testing.T) {
 		"select c_custkey from (select c_custkey, count(C_NATIONKEY) ff from CUSTOMER group by c_custkey ) a join NATION b on a.c_custkey = b.N_REGIONKEY where b.N_NATIONKEY > 10",
 		"select a.* from (select c_custkey, count(C_NATIONKEY) ff from CUSTOMER group by c_custkey ) a join NATION b on a.c_custkey = b.N_REGIONKEY where b.N_NATIONKEY > 10",
 		"select * from (select c_custkey, count(C_NATIONKEY) ff from CUSTOMER group by c_custkey ) a join NATION b on a.c_custkey = b.N_REGIONKEY where b.N_NATIONKEY > 10",
+		"select n_name, r.x from nation, (select r_name x, r_regionkey k, row_number() over (partition by r_regionkey) rn from region) r where r.k = nation.n_regionkey and rn <= 2",
 	}
 	runTestShouldPass(mock, t, sqls, false, false)
 
@@ -577,6 +750,45 @@ func TestDerivedTableSqlBuilder(t *testing.T) {
 	runTestShouldError(mock, t, sqls)
 }
 
+func TestLateralSqlBuilder(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	// should pass
+	sqls := []string{
+		"select * from lateral (select 1) d",
+		"select n_name, r.r_name from nation, lateral (select r_name from region where r_regionkey = nation.n_regionkey) r",
+		"select n_name, r.r_name from nation, lateral (select r_name from region where r_regionkey > nation.n_regionkey) r",
+		"select n_name, r.r_name from nation left join lateral (select r_name from region where r_regionkey = nation.n_regionkey) r on true",
+		"select n_name, r.c from nation, lateral (select r_regionkey, count(*) c from region where r_regionkey = nation.n_regionkey group by r_regionkey) r",
+		"select n_name, r.x from nation, lateral (select distinct r_name x from region where r_regionkey = nation.n_regionkey) r",
+		"select n_name, r.x from nation, lateral (select r_name x from region where r_regionkey = nation.n_regionkey limit 2) r",
+		"select n_name, r.x from nation, lateral (select r_name x from region where r_regionkey = nation.n_regionkey order by 1 desc limit 1) r where r.x like 'A%'",
+		"select n_name, r.r_name from nation left join lateral (select r_name from region where r_regionkey = nation.n_regionkey order by r_name limit 2 offset 1) r on true",
+		"select n_name, g.* from nation cross join lateral generate_series(1, n_regionkey, 1) g",
+		"select n_name, g.* from nation, lateral generate_series(1, n_regionkey, 1) g",
+		"select n_name, g.* from nation left join lateral generate_series(1, n_regionkey, 1) g on true",
+		"select n_name, g.* from nation join lateral generate_series(1, n_regionkey, 1) g on g.result > 2",
+		// run by apply
+		"select n_name, r.* from nation, lateral (select nation.n_name nm from region) r",
+		"select n_name, r.c from nation, lateral (select count(*) c from region where r_regionkey = nation.n_regionkey) r",
+		"select n_name, r.c from nation left join lateral (select count(*) c from region where r_regionkey = nation.n_regionkey) r on r.c > 0 and n_nationkey > 1",
+		"select n_name, r.x from nation, lateral (select r_name x from region where r_regionkey > nation.n_regionkey limit 1) r",
+		"select n_name, r.x from nation, lateral (select r_name x from region where r_regionkey = nation.n_regionkey union select 'a') r",
+		"select n_name, r.x from nation, lateral (select r_name x from region left join nation n2 on n2.n_regionkey = r_regionkey and n2.n_nationkey = nation.n_nationkey) r",
+		"select n_name, r.x from nation, lateral (select distinct r_name x from region where r_regionkey = nation.n_regionkey order by 1 limit 1) r",
+		"select n_name, r.x from nation, lateral (select r_name x, r_regionkey + nation.n_nationkey y from region where r_regionkey = nation.n_regionkey order by y limit 1) r",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// should error
+	sqls = []string{
+		"select * from nation, lateral (select r_name from region where r_regionkey = nation.n_regionkey)",                                    // no alias
+		"select n_name, r.r_name from nation right join lateral (select r_name from region where r_regionkey = nation.n_regionkey) r on true", // right join
+		"select n_name, g.* from nation left join lateral generate_series(1, n_regionkey, 1) g on g.result > 2",                               // outer apply with condition
+		"select n_name, r.r_name from nation, (select r_name from region where r_regionkey = nation.n_regionkey) r",                           // not lateral
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
 // test derived table plan building
 func TestUnionSqlBuilder(t *testing.T) {
 	mock := NewMockOptimizer(false)
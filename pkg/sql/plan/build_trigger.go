@@ -0,0 +1,601 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+/*
+Row level triggers are compiled into the plan of the DML:
+
+	BEFORE trigger: SET NEW.col = expr
+		-> project of the rows to be written, before preinsert
+	BEFORE/AFTER trigger: INSERT INTO t VALUES (...)
+		[transition]sink_scan -> project -> preinsert -> sink -> insert plans of t
+	AFTER trigger: any other body
+		[transition]sink_scan -> project(OLD cols, NEW cols), the body is
+		interpreted by the frontend for every row of the output batches
+		while the DML is running.
+
+The triggers are kept in the table definition (TableDef.Triggers), they are
+updated by CREATE / DROP TRIGGER, which changes the version of the table and
+so the cached plans are rebuilt.
+
+The transition rows are the output of the step before the table is written,
+e.g. the sink after preinsert for INSERT and the sink of the preupdate /
+predelete plan for UPDATE / DELETE.
+
+The triggers are not fired by the foreign key actions.
+*/
+
+// tableTrigger is a parsed trigger of the table.
+type tableTrigger struct {
+	def  *TriggerDef
+	kind TriggerKind
+	body tree.Statement
+}
+
+// tableTriggers are the triggers of the table fired by an event.
+type tableTriggers struct {
+	event tree.TriggerEvent
+	// sets are the triggers assigning the NEW columns
+	sets []*tableTrigger
+	// rows are the other triggers, which are fired for every transition row
+	rows []*tableTrigger
+}
+
+func (tt *tableTriggers) hasSets() bool {
+	return tt != nil && len(tt.sets) > 0
+}
+
+func (tt *tableTriggers) isEmpty() bool {
+	return tt == nil || len(tt.sets)+len(tt.rows) == 0
+}
+
+// triggerTransition is where the transition rows of the triggering table
+// are. They are the output of step, OLD.col is the column oldPos[col] and
+// NEW.col is the column newPos[col].
+type triggerTransition struct {
+	step   int32
+	cols   []*ColDef
+	oldPos map[string]int32
+	newPos map[string]int32
+}
+
+func newTriggerTransition(cols []*ColDef) *triggerTransition {
+	t := &triggerTransition{}
+	for _, col := range cols {
+		if col.Hidden {
+			continue
+		}
+		t.cols = append(t.cols, col)
+	}
+	return t
+}
+
+// rowExprs returns the expressions of the NEW and the OLD row on the output
+// of the transition step.
+func (t *triggerTransition) rowExprs(builder *QueryBuilder) (newRow, oldRow map[string]*Expr) {
+	sink := builder.qry.Nodes[builder.qry.Steps[t.step]]
+	toExprs := func(pos map[string]int32) map[string]*Expr {
+		if pos == nil {
+			return nil
+		}
+		row := make(map[string]*Expr, len(pos))
+		for name, p := range pos {
+			row[name] = &plan.Expr{
+				Typ: sink.ProjectList[p].Typ,
+				Expr: &plan.Expr_Col{
+					Col: &plan.ColRef{
+						ColPos: p,
+						Name:   name,
+					},
+				},
+			}
+		}
+		return row
+	}
+	return toExprs(t.newPos), toExprs(t.oldPos)
+}
+
+// resolveTableTriggers returns the triggers of the table fired by the event.
+func (builder *QueryBuilder) resolveTableTriggers(tableDef *TableDef, event tree.TriggerEvent) (*tableTriggers, error) {
+	if builder.isRestore || tableDef.ViewSql != nil {
+		return nil, nil
+	}
+	ctx := builder.GetContext()
+	tt := &tableTriggers{event: event}
+	for _, def := range tableDef.Triggers {
+		if triggerEvent(def) != event {
+			continue
+		}
+		stmt, err := parsers.ParseOne(ctx, dialect.MYSQL, def.Body, builder.compCtx.GetLowerCaseTableNames())
+		if err != nil {
+			return nil, err
+		}
+		kind, err := ClassifyTrigger(ctx, triggerTime(def), event, stmt)
+		if err != nil {
+			return nil, err
+		}
+		t := &tableTrigger{def: def, kind: kind, body: stmt}
+		if kind == TriggerKindSet {
+			tt.sets = append(tt.sets, t)
+		} else {
+			tt.rows = append(tt.rows, t)
+		}
+	}
+	return tt, nil
+}
+
+// applySetTriggers evaluates the SET NEW.col = expr of the triggers, newRow
+// is updated in place by the assigned expressions.
+func applySetTriggers(builder *QueryBuilder, tableDef *TableDef, triggers *tableTriggers, newRow, oldRow map[string]*Expr) error {
+	cols := make(map[string]*ColDef, len(tableDef.Cols))
+	for _, col := range tableDef.Cols {
+		if !col.Hidden {
+			cols[col.Name] = col
+		}
+	}
+	for _, t := range triggers.sets {
+		if err := applySetTriggerStmt(builder, cols, triggers.event, t.body, newRow, oldRow); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func applySetTriggerStmt(builder *QueryBuilder, cols map[string]*ColDef, event tree.TriggerEvent, stmt tree.Statement, newRow, oldRow map[string]*Expr) error {
+	ctx := builder.GetContext()
+	bindExpr := func(astExpr tree.Expr) (*Expr, error) {
+		binder := NewTriggerBinder(ctx, builder, NewBindContext(builder, nil), event, newRow, oldRow)
+		return binder.BindExpr(astExpr, 0, true)
+	}
+
+	switch st := stmt.(type) {
+	case nil:
+		// the empty statement of the compound statement
+	case *tree.SetVar:
+		for _, assign := range st.Assignments {
+			name, _ := getNewColAssign(assign)
+			col, ok := cols[name]
+			if !ok {
+				return moerr.NewInvalidInputf(ctx, "unknown column '%s' in 'NEW'", name)
+			}
+			if isGeneratedCol(col) {
+				return moerr.NewInvalidInputf(ctx, "the value specified for generated column '%s' in 'NEW' is not allowed", name)
+			}
+			var expr *Expr
+			var err error
+			if _, ok := assign.Value.(*tree.DefaultVal); ok {
+				expr, err = getDefaultExpr(ctx, col)
+			} else {
+				expr, err = bindExpr(assign.Value)
+			}
+			if err != nil {
+				return err
+			}
+			if newRow[name], err = castToColType(builder, expr, col); err != nil {
+				return err
+			}
+		}
+
+	case *tree.CompoundStmt:
+		for _, s := range st.Stmts {
+			if err := applySetTriggerStmt(builder, cols, event, s, newRow, oldRow); err != nil {
+				return err
+			}
+		}
+
+	case *tree.IfStmt:
+		// rewrite the branches to NEW.col = case when cond1 then v1 ... else v end
+		// for every column assigned in any branch.
+		condAsts := []tree.Expr{st.Cond}
+		bodies := [][]tree.Statement{st.Body}
+		for _, elif := range st.Elifs {
+			condAsts = append(condAsts, elif.Cond)
+			bodies = append(bodies, elif.Body)
+		}
+		bodies = append(bodies, st.Else)
+
+		conds := make([]*Expr, len(condAsts))
+		for i, condAst := range condAsts {
+			cond, err := bindExpr(condAst)
+			if err != nil {
+				return err
+			}
+			if cond.Typ.Id != int32(types.T_bool) {
+				if cond, err = forceCastExpr(ctx, cond, Type{Id: int32(types.T_bool)}); err != nil {
+					return err
+				}
+			}
+			conds[i] = cond
+		}
+
+		branches := make([]map[string]*Expr, len(bodies))
+		for i, body := range bodies {
+			branches[i] = maps.Clone(newRow)
+			for _, s := range body {
+				if err := applySetTriggerStmt(builder, cols, event, s, branches[i], oldRow); err != nil {
+					return err
+				}
+			}
+		}
+
+		for name, expr := range newRow {
+			changed := false
+			for _, branch := range branches {
+				if branch[name] != expr {
+					changed = true
+					break
+				}
+			}
+			if !changed {
+				continue
+			}
+			args := make([]*Expr, 0, 2*len(conds)+1)
+			for i, cond := range conds {
+				args = append(args, DeepCopyExpr(cond), branches[i][name])
+			}
+			args = append(args, branches[len(conds)][name])
+			caseExpr, err := BindFuncExprImplByPlanExpr(ctx, "case", args)
+			if err != nil {
+				return err
+			}
+			if newRow[name], err = castToColType(builder, caseExpr, cols[name]); err != nil {
+				return err
+			}
+		}
+
+	default:
+		return moerr.NewNotSupportedf(ctx, "statement '%s' in the trigger assigning NEW row", tree.String(stmt, dialect.MYSQL))
+	}
+	return nil
+}
+
+func castToColType(builder *QueryBuilder, expr *Expr, col *ColDef) (*Expr, error) {
+	if col.Typ.Id == int32(types.T_enum) {
+		return funcCastForEnumType(builder.GetContext(), expr, col.Typ)
+	}
+	return forceCastExpr(builder.GetContext(), expr, col.Typ)
+}
+
+// appendInsertSetTriggers appends a project which evaluates the triggers
+// assigning the NEW columns of the rows to be inserted.
+func appendInsertSetTriggers(builder *QueryBuilder, bindCtx *BindContext, tableDef *TableDef, triggers *tableTriggers, lastNodeId int32) (int32, error) {
+	if !triggers.hasSets() {
+		return lastNodeId, nil
+	}
+	projection := getProjectionByLastNode(builder, lastNodeId)
+	newRow := make(map[string]*Expr, len(tableDef.Cols))
+	for i, col := range tableDef.Cols {
+		if !col.Hidden {
+			newRow[col.Name] = projection[i]
+		}
+	}
+	if err := applySetTriggers(builder, tableDef, triggers, newRow, nil); err != nil {
+		return -1, err
+	}
+	for i, col := range tableDef.Cols {
+		if expr, ok := newRow[col.Name]; ok {
+			projection[i] = expr
+		}
+	}
+	if hasGeneratedCols(tableDef) {
+		if err := computeGeneratedCols(builder, tableDef, projection[:len(tableDef.Cols)]); err != nil {
+			return -1, err
+		}
+	}
+	return builder.appendNode(&Node{
+		NodeType:    plan.Node_PROJECT,
+		Children:    []int32{lastNodeId},
+		ProjectList: projection,
+	}, bindCtx), nil
+}
+
+// applyUpdateSetTriggers evaluates the triggers assigning the NEW columns in
+// the project list of the update query. It must be called before
+// rewriteUpdateQueryLastNode. The assigned columns which are not updated by
+// the statement are appended to the update columns of the table.
+func applyUpdateSetTriggers(builder *QueryBuilder, planCtxs []*dmlPlanCtx, triggers []*tableTriggers, lastNodeId int32) error {
+	lastNode := builder.qry.Nodes[lastNodeId]
+	ctx := builder.GetContext()
+
+	idx := 0
+	for i, planCtx := range planCtxs {
+		tableDef := planCtx.tableDef
+		blockLen := len(tableDef.Cols) + planCtx.updateColLength
+		if !triggers[i].hasSets() {
+			idx += blockLen
+			continue
+		}
+
+		newRow := make(map[string]*Expr, len(tableDef.Cols))
+		oldRow := make(map[string]*Expr, len(tableDef.Cols))
+		for colIdx, col := range tableDef.Cols {
+			if col.Hidden {
+				continue
+			}
+			oldRow[col.Name] = lastNode.ProjectList[idx+colIdx]
+			if isGeneratedCol(col) {
+				// recomputed by rewriteUpdateQueryLastNode
+				newRow[col.Name] = oldRow[col.Name]
+			} else if offset, ok := planCtx.updateColPosMap[col.Name]; ok {
+				expr := lastNode.ProjectList[idx+offset]
+				var err error
+				if isDefaultValExpr(expr) {
+					if expr, err = getDefaultExpr(ctx, col); err != nil {
+						return err
+					}
+				}
+				if newRow[col.Name], err = castToColType(builder, expr, col); err != nil {
+					return err
+				}
+			} else if col.OnUpdate != nil && col.OnUpdate.Expr != nil {
+				newRow[col.Name] = DeepCopyExpr(col.OnUpdate.Expr)
+			} else {
+				newRow[col.Name] = oldRow[col.Name]
+			}
+		}
+		unchanged := maps.Clone(newRow)
+		if err := applySetTriggers(builder, tableDef, triggers[i], newRow, oldRow); err != nil {
+			return err
+		}
+		if err := markGeneratedColsChanged(ctx, tableDef, newRow, unchanged); err != nil {
+			return err
+		}
+
+		for colIdx, col := range tableDef.Cols {
+			expr, ok := newRow[col.Name]
+			if !ok || expr == unchanged[col.Name] {
+				continue
+			}
+			if offset, ok := planCtx.updateColPosMap[col.Name]; ok {
+				lastNode.ProjectList[idx+offset] = expr
+				continue
+			}
+
+			// the column becomes an update column of the table
+			offset := blockLen
+			lastNode.ProjectList = slices.Insert(lastNode.ProjectList, idx+offset, expr)
+			planCtx.updateColPosMap[col.Name] = offset
+			planCtx.updateColLength++
+			blockLen++
+			for j, pos := range planCtx.insertColPos {
+				if pos == colIdx {
+					planCtx.insertColPos[j] = offset
+				}
+			}
+			if tableDef.Pkey != nil && slices.Contains(tableDef.Pkey.Names, col.Name) {
+				planCtx.updatePkCol = true
+			}
+		}
+		idx += blockLen
+	}
+	return nil
+}
+
+// appendRowTriggers appends the plans of the triggers fired for every
+// transition row of the table.
+func appendRowTriggers(ctx CompilerContext, builder *QueryBuilder, objRef *ObjectRef, tableDef *TableDef, triggers *tableTriggers, transition *triggerTransition) error {
+	if triggers == nil || len(triggers.rows) == 0 {
+		return nil
+	}
+
+	// the tables whose triggers are being compiled can not be written by
+	// the triggers, which avoids the recursive triggers.
+	builder.triggerTables = append(builder.triggerTables, tableDef.TblId)
+	defer func() {
+		builder.triggerTables = builder.triggerTables[:len(builder.triggerTables)-1]
+	}()
+
+	for _, t := range triggers.rows {
+		if t.kind == TriggerKindInsert {
+			inserts, err := bindTriggerInserts(ctx, builder, objRef.SchemaName, triggers.event, t.body, transition)
+			if err == nil {
+				for _, insert := range inserts {
+					if err = appendTriggerInsertPlans(ctx, builder, insert, transition); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+			// the AFTER trigger which can not be compiled is interpreted
+			if triggerTime(t.def) == tree.TriggerTimeBefore || !moerr.IsMoErrCode(err, moerr.ErrNotSupported) {
+				return err
+			}
+		}
+		if triggerTime(t.def) == tree.TriggerTimeBefore {
+			return moerr.NewNotSupportedf(builder.GetContext(), "BEFORE trigger %s", t.def.Name)
+		}
+		appendProceduralTrigger(builder, objRef, tableDef, t, transition)
+	}
+	return nil
+}
+
+// triggerInsert is an INSERT ... VALUES of a trigger body bound to the
+// transition rows.
+type triggerInsert struct {
+	objRef           *ObjectRef
+	tableDef         *TableDef
+	projection       []*Expr
+	ifExistAutoPkCol bool
+}
+
+func bindTriggerInserts(ctx CompilerContext, builder *QueryBuilder, dbName string, event tree.TriggerEvent, stmt tree.Statement, transition *triggerTransition) ([]*triggerInsert, error) {
+	if stmt == nil {
+		return nil, nil
+	}
+	if compound, ok := stmt.(*tree.CompoundStmt); ok {
+		var inserts []*triggerInsert
+		for _, s := range compound.Stmts {
+			ret, err := bindTriggerInserts(ctx, builder, dbName, event, s, transition)
+			if err != nil {
+				return nil, err
+			}
+			inserts = append(inserts, ret...)
+		}
+		return inserts, nil
+	}
+
+	st := stmt.(*tree.Insert)
+	tbl := *st.Table.(*tree.TableName)
+	if len(tbl.SchemaName) == 0 {
+		tbl.SchemaName = tree.Identifier(dbName)
+		tbl.ExplicitSchema = true
+	}
+	tblInfo, err := getDmlTableInfo(ctx, tree.TableExprs{&tbl}, nil, nil, "insert")
+	if err != nil {
+		return nil, err
+	}
+	objRef := tblInfo.objRef[0]
+	tableDef := tblInfo.tableDefs[0]
+	if slices.Contains(builder.triggerTables, tableDef.TblId) {
+		return nil, moerr.NewInvalidInputf(builder.GetContext(),
+			"can't update table '%s' in trigger because it is already used by statement which invoked this trigger", tableDef.Name)
+	}
+
+	insertCols, err := getInsertColsFromStmt(builder.GetContext(), st, tableDef)
+	if err != nil {
+		return nil, err
+	}
+	row := st.Rows.Select.(*tree.ValuesClause).Rows[0]
+	if len(row) != len(insertCols) {
+		return nil, moerr.NewWrongValueCountOnRow(builder.GetContext(), 1)
+	}
+
+	newRow, oldRow := transition.rowExprs(builder)
+	binder := NewTriggerBinder(builder.GetContext(), builder, NewBindContext(builder, nil), event, newRow, oldRow)
+	values := make(map[string]*Expr, len(insertCols))
+	for i, name := range insertCols {
+		if _, ok := row[i].(*tree.DefaultVal); ok {
+			continue
+		}
+		if values[name], err = binder.BindExpr(row[i], 0, true); err != nil {
+			return nil, err
+		}
+	}
+
+	insert := &triggerInsert{
+		objRef:     objRef,
+		tableDef:   tableDef,
+		projection: make([]*Expr, len(tableDef.Cols)),
+	}
+	for i, col := range tableDef.Cols {
+		if expr, ok := values[col.Name]; ok {
+			if insert.projection[i], err = castToColType(builder, expr, col); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if insert.projection[i], err = getDefaultExpr(builder.GetContext(), col); err != nil {
+			return nil, err
+		}
+		if col.Typ.AutoIncr && tableDef.Pkey != nil && slices.Contains(tableDef.Pkey.Names, col.Name) {
+			insert.ifExistAutoPkCol = true
+		}
+	}
+	return []*triggerInsert{insert}, nil
+}
+
+// appendTriggerInsertPlans appends the plans inserting a row into the target
+// table for every transition row.
+func appendTriggerInsertPlans(ctx CompilerContext, builder *QueryBuilder, insert *triggerInsert, transition *triggerTransition) error {
+	bindCtx := NewBindContext(builder, nil)
+	lastNodeId := appendSinkScanNode(builder, bindCtx, transition.step)
+	lastNodeId = builder.appendNode(&Node{
+		NodeType:    plan.Node_PROJECT,
+		Children:    []int32{lastNodeId},
+		ProjectList: insert.projection,
+	}, bindCtx)
+
+	tableDef := insert.tableDef
+	tableDef.Name2ColIndex = make(map[string]int32, len(tableDef.Cols))
+	for i, col := range tableDef.Cols {
+		tableDef.Name2ColIndex[col.Name] = int32(i)
+	}
+	return buildInsertPlans(ctx, builder, bindCtx, nil, insert.objRef, tableDef, lastNodeId,
+		insert.ifExistAutoPkCol, nil, nil, false)
+}
+
+// appendProceduralTrigger appends a step which outputs the OLD and the NEW
+// columns of the transition rows, the trigger body is interpreted for every
+// row of the output by the frontend as the definer of the trigger.
+func appendProceduralTrigger(builder *QueryBuilder, objRef *ObjectRef, tableDef *TableDef, t *tableTrigger, transition *triggerTransition) {
+	bindCtx := NewBindContext(builder, nil)
+	lastNodeId := appendSinkScanNode(builder, bindCtx, transition.step)
+	sinkScan := builder.qry.Nodes[lastNodeId]
+
+	rowTrigger := &plan.RowTrigger{
+		DbName:        objRef.SchemaName,
+		TableName:     tableDef.Name,
+		TriggerName:   t.def.Name,
+		Body:          t.def.Body,
+		DefinerUserId: t.def.DefinerUserId,
+		DefinerRoleId: t.def.DefinerRoleId,
+	}
+	var projection []*Expr
+	appendCols := func(pos map[string]int32, names *[]string) {
+		if pos == nil {
+			return
+		}
+		for _, col := range transition.cols {
+			p := pos[col.Name]
+			projection = append(projection, &plan.Expr{
+				Typ: sinkScan.ProjectList[p].Typ,
+				Expr: &plan.Expr_Col{
+					Col: &plan.ColRef{
+						ColPos: p,
+						Name:   col.Name,
+					},
+				},
+			})
+			*names = append(*names, col.Name)
+		}
+	}
+	appendCols(transition.oldPos, &rowTrigger.OldCols)
+	appendCols(transition.newPos, &rowTrigger.NewCols)
+
+	lastNodeId = builder.appendNode(&Node{
+		NodeType:    plan.Node_PROJECT,
+		Children:    []int32{lastNodeId},
+		ProjectList: projection,
+	}, bindCtx)
+	builder.appendStep(lastNodeId)
+	rowTrigger.NodeId = lastNodeId
+	builder.qry.RowTriggers = append(builder.qry.RowTriggers, rowTrigger)
+}
+
+// checkNoTriggers returns an error if the table has triggers fired by the
+// events, it is used by the statements which do not fire triggers yet.
+func checkNoTriggers(ctx CompilerContext, tableDef *TableDef, stmtName string, events ...tree.TriggerEvent) error {
+	if tableDef.ViewSql != nil {
+		return nil
+	}
+	for _, def := range tableDef.Triggers {
+		if slices.Contains(events, triggerEvent(def)) {
+			return moerr.NewNotSupportedf(ctx.GetContext(), "%s on table '%s' with %s triggers", stmtName, tableDef.Name, def.Event)
+		}
+	}
+	return nil
+}
@@ -40,6 +40,17 @@ func buildTableUpdate(stmt *tree.Update, ctx CompilerContext, isPrepareStmt bool
 	if err != nil {
 		return nil, err
 	}
+	triggers := make([]*tableTriggers, len(tblInfo.tableDefs))
+	for i, tableDef := range tblInfo.tableDefs {
+		triggers[i], err = builder.resolveTableTriggers(tableDef, tree.TriggerEventUpdate)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = applyUpdateSetTriggers(builder, updatePlanCtxs, triggers, lastNodeId)
+	if err != nil {
+		return nil, err
+	}
 	err = rewriteUpdateQueryLastNode(builder, updatePlanCtxs, lastNodeId)
 	if err != nil {
 		return nil, err
@@ -71,10 +82,29 @@ func buildTableUpdate(stmt *tree.Update, ctx CompilerContext, isPrepareStmt bool
 
 		updateBindCtx := NewBindContext(builder, nil)
 		beginIdx = beginIdx + upPlanCtx.updateColLength + len(tableDef.Cols)
+		transition := newTriggerTransition(tableDef.Cols)
+		transition.oldPos = make(map[string]int32, len(transition.cols))
+		transition.newPos = make(map[string]int32, len(transition.cols))
+		for colIdx, col := range tableDef.Cols {
+			if col.Hidden {
+				continue
+			}
+			transition.oldPos[col.Name] = int32(colIdx)
+			transition.newPos[col.Name] = int32(colIdx)
+			if offset, ok := upPlanCtx.updateColPosMap[col.Name]; ok {
+				transition.newPos[col.Name] = int32(offset)
+			}
+		}
 		err = buildUpdatePlans(ctx, builder, updateBindCtx, upPlanCtx, false)
 		if err != nil {
 			return nil, err
 		}
+		// the pre update step has the old and the new values of the rows
+		transition.step = upPlanCtx.sourceStep
+		err = appendRowTriggers(ctx, builder, tblInfo.objRef[i], tableDef, triggers[i], transition)
+		if err != nil {
+			return nil, err
+		}
 		putDmlPlanCtx(upPlanCtx)
 		sqls, err := genSqlsForCheckFKSelfRefer(ctx.GetContext(),
 			tblInfo.objRef[i].SchemaName, tableDef.Name, tableDef.Cols, tableDef.Fkeys)
@@ -117,6 +147,10 @@ func rewriteUpdateQueryLastNode(builder *QueryBuilder, planCtxs []*dmlPlanCtx, l
 
 		for colIdx, col := range tableDef.Cols {
 			if offset, ok := planCtx.updateColPosMap[col.Name]; ok {
+				if isGeneratedCol(col) {
+					// computed below from the new values of the row
+					continue
+				}
 				pos := idx + offset
 				posExpr := lastNode.ProjectList[pos]
 				if isDefaultValExpr(posExpr) { // set col = default
@@ -161,6 +195,12 @@ func rewriteUpdateQueryLastNode(builder *QueryBuilder, planCtxs []*dmlPlanCtx, l
 				}
 			}
 		}
+		if hasGeneratedCols(tableDef) {
+			err = computeUpdatedGeneratedCols(builder, tableDef, planCtx.updateColPosMap, lastNode.ProjectList[idx:])
+			if err != nil {
+				return err
+			}
+		}
 		idx = planCtx.updateColLength + len(tableDef.Cols)
 	}
 	return nil
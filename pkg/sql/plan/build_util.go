@@ -25,6 +25,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/lock"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
@@ -54,7 +55,9 @@ func reCheckifNeedLockWholeTable(builder *QueryBuilder) {
 		if n.NodeType != plan.Node_LOCK_OP {
 			continue
 		}
-		if !n.LockTargets[0].LockTable {
+		// rows skipped by SKIP LOCKED must be locked one by one
+		if !n.LockTargets[0].LockTable &&
+			n.LockTargets[0].WaitPolicy != lock.WaitPolicy_SkipLocked {
 			reCheckIfNeed := n.Stats.Outcnt > float64(lockconfig.MaxLockRowCount)
 			if reCheckIfNeed {
 				logutil.Infof("Row lock upgraded to table lock for SQL : %s", builder.compCtx.GetRootSql())
@@ -65,6 +68,26 @@ func reCheckifNeedLockWholeTable(builder *QueryBuilder) {
 	}
 }
 
+// getSelectLockOptions returns the lock mode and wait policy of the select lock
+// type, false means the select does not need to lock rows.
+func getSelectLockOptions(lockType tree.SelectLockType) (lock.LockMode, lock.WaitPolicy, bool) {
+	switch lockType {
+	case tree.SelectLockForUpdate:
+		return lock.LockMode_Exclusive, lock.WaitPolicy_Wait, true
+	case tree.SelectLockForUpdateNoWait:
+		return lock.LockMode_Exclusive, lock.WaitPolicy_FastFail, true
+	case tree.SelectLockForUpdateSkipLocked:
+		return lock.LockMode_Exclusive, lock.WaitPolicy_SkipLocked, true
+	case tree.SelectLockForShare:
+		return lock.LockMode_Shared, lock.WaitPolicy_Wait, true
+	case tree.SelectLockForShareNoWait:
+		return lock.LockMode_Shared, lock.WaitPolicy_FastFail, true
+	case tree.SelectLockForShareSkipLocked:
+		return lock.LockMode_Shared, lock.WaitPolicy_SkipLocked, true
+	}
+	return lock.LockMode_Exclusive, lock.WaitPolicy_Wait, false
+}
+
 // GetFunctionArgTypeStrFromAst function arg type do not have scale and width, it depends on the data that it process
 func GetFunctionArgTypeStrFromAst(arg tree.FunctionArg) (string, error) {
 	argDecl := arg.(*tree.FunctionArgDecl)
@@ -0,0 +1,188 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// Check constraints are kept in TableDef.Checks with their expression as
+// text. The enforced ones are bound on the columns of the pre-insert batch
+// of INSERT and UPDATE and evaluated by the preinsert operator, a row
+// violates a constraint when the expression is false, NULL is accepted.
+
+// buildCheckDef builds the definition of a table or column CHECK clause, the
+// expression is checked by checkCheckConstraints once all the columns of the
+// table are known.
+func buildCheckDef(name string, expr tree.Expr, enforced bool) *plan.CheckDef {
+	fmtCtx := tree.NewFmtCtx(dialect.MYSQL, tree.WithSingleQuoteString())
+	fmtCtx.PrintExpr(expr, expr, false)
+	return &plan.CheckDef{
+		Name:         name,
+		OriginString: fmtCtx.String(),
+		Enforced:     enforced,
+	}
+}
+
+// findCheck returns the position of the check constraint in checks, the
+// names of the check constraints are case-insensitive.
+func findCheck(checks []*plan.CheckDef, name string) int {
+	for i, check := range checks {
+		if strings.EqualFold(check.Name, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+// nameCheckConstraints names the anonymous check constraints of the table
+// [TABLE_NAME]_chk_[N] like MySQL.
+func nameCheckConstraints(checks []*plan.CheckDef, tableName string) {
+	n := 0
+	for _, check := range checks {
+		if check.Name != "" {
+			continue
+		}
+		for {
+			n++
+			name := fmt.Sprintf("%s_chk_%d", tableName, n)
+			if findCheck(checks, name) == -1 {
+				check.Name = name
+				break
+			}
+		}
+	}
+}
+
+// checkCheckConstraints checks the names of the check constraints of the
+// table are unique and their expressions are deterministic expressions of
+// the columns of the table which are not auto_increment.
+func checkCheckConstraints(ctx CompilerContext, tableDef *TableDef) error {
+	if len(tableDef.Checks) == 0 {
+		return nil
+	}
+
+	colPos := make(map[string]int, len(tableDef.Cols))
+	for i, col := range tableDef.Cols {
+		colPos[col.Name] = i
+	}
+	builder := NewQueryBuilder(plan.Query_SELECT, ctx, false, false)
+	for i, check := range tableDef.Checks {
+		if findCheck(tableDef.Checks, check.Name) != i {
+			return moerr.NewInvalidInputf(ctx.GetContext(), "duplicate check constraint name '%s'", check.Name)
+		}
+		expr, err := bindCheckExpr(builder, check, tableDef)
+		if err != nil {
+			return err
+		}
+		subject := fmt.Sprintf("check constraint '%s'", check.Name)
+		err = checkExprDeterministic(ctx.GetContext(), expr, subject, func(colRef *plan.ColRef) error {
+			ref := tableDef.Cols[colPos[colRef.Name]]
+			if ref.Typ.AutoIncr {
+				return moerr.NewInvalidInputf(ctx.GetContext(), "check constraint '%s' cannot refer to auto_increment column '%s'", check.Name, ref.GetOriginCaseName())
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// bindCheckExpr binds the expression of the check constraint on the columns
+// of tableDef, the columns are referenced by their position in tableDef.Cols
+// and the virtual columns are replaced by their expression.
+func bindCheckExpr(builder *QueryBuilder, check *plan.CheckDef, tableDef *TableDef) (*plan.Expr, error) {
+	ctx := builder.GetContext()
+	stmts, err := parsers.Parse(ctx, dialect.MYSQL, "select "+check.OriginString, 1)
+	if err != nil {
+		return nil, err
+	}
+	binder := NewUpdateBinder(ctx, builder, nil, tableDef.Cols)
+	expr, err := binder.BindExpr(stmts[0].(*tree.Select).Select.(*tree.SelectClause).Exprs[0].Expr, 0, true)
+	if err != nil {
+		return nil, err
+	}
+	if expr, err = forceCastExpr(ctx, expr, Type{Id: int32(types.T_bool)}); err != nil {
+		return nil, err
+	}
+
+	if hasVirtualCols(tableDef) {
+		virtualCols, err := bindVirtualCols(builder, tableDef, 0)
+		if err != nil {
+			return nil, err
+		}
+		projMap := make(map[[2]int32]*Expr, len(virtualCols))
+		for colPos, colExpr := range virtualCols {
+			projMap[[2]int32{0, colPos}] = colExpr
+		}
+		expr = replaceColumnsForExpr(expr, projMap)
+	}
+	return expr, nil
+}
+
+// bindEnforcedChecks returns the enforced check constraints of the table
+// with their expression bound by bindCheckExpr, for the pre-insert node.
+func bindEnforcedChecks(builder *QueryBuilder, tableDef *TableDef) ([]*plan.CheckDef, error) {
+	var checks []*plan.CheckDef
+	for _, check := range tableDef.Checks {
+		if !check.Enforced {
+			continue
+		}
+		expr, err := bindCheckExpr(builder, check, tableDef)
+		if err != nil {
+			return nil, err
+		}
+		checks = append(checks, &plan.CheckDef{
+			Name:         check.Name,
+			Check:        expr,
+			OriginString: check.OriginString,
+			Enforced:     true,
+		})
+	}
+	return checks, nil
+}
+
+// AddCheck adds the check constraint to the copy table of ALTER TABLE, the
+// rows of the table are checked when they are copied.
+func AddCheck(ctx CompilerContext, alterPlan *plan.AlterTable, spec *tree.CheckIndex, alterCtx *AlterTableContext) error {
+	tableDef := alterPlan.CopyTableDef
+	if spec.Name != "" && findCheck(tableDef.Checks, spec.Name) != -1 {
+		return moerr.NewInvalidInputf(ctx.GetContext(), "duplicate check constraint name '%s'", spec.Name)
+	}
+	tableDef.Checks = append(tableDef.Checks, buildCheckDef(spec.Name, spec.Expr, spec.Enforced))
+	nameCheckConstraints(tableDef.Checks, alterCtx.originTableName)
+	return nil
+}
+
+// DropCheck drops the check constraint from the copy table of ALTER TABLE.
+func DropCheck(ctx CompilerContext, alterPlan *plan.AlterTable, name string, alterCtx *AlterTableContext) error {
+	tableDef := alterPlan.CopyTableDef
+	i := findCheck(tableDef.Checks, name)
+	if i == -1 {
+		return moerr.NewErrCantDropFieldOrKey(ctx.GetContext(), name)
+	}
+	tableDef.Checks = append(tableDef.Checks[:i], tableDef.Checks[i+1:]...)
+	return nil
+}
@@ -0,0 +1,190 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/stretchr/testify/require"
+)
+
+// makeCheckTestTable returns the table
+// ct (a int primary key, b int, c varchar(10),
+// constraint chk_b check (b > 0), constraint chk_ab check (a + b < 100) not enforced)
+func makeCheckTestTable() *TableDef {
+	makeCol := func(name string, typ Type) *ColDef {
+		return &ColDef{
+			Name:       name,
+			OriginName: name,
+			Typ:        typ,
+			Default:    &plan.Default{NullAbility: true},
+		}
+	}
+	tableDef := &TableDef{
+		TblId:     2100,
+		Name:      "ct",
+		TableType: catalog.SystemOrdinaryRel,
+		Cols: []*ColDef{
+			makeCol("a", Type{Id: int32(types.T_int32)}),
+			makeCol("b", Type{Id: int32(types.T_int32)}),
+			makeCol("c", Type{Id: int32(types.T_varchar), Width: 10}),
+		},
+		Pkey: &plan.PrimaryKeyDef{
+			PkeyColName: "a",
+			Names:       []string{"a"},
+		},
+		Checks: []*plan.CheckDef{
+			{Name: "chk_b", OriginString: "b > 0", Enforced: true},
+			{Name: "chk_ab", OriginString: "a + b < 100"},
+		},
+	}
+	tableDef.Cols[0].Primary = true
+	tableDef.Cols[0].Typ.NotNullable = true
+	tableDef.Cols[0].Default.NullAbility = false
+	tableDef.Pkey.CompPkeyCol = tableDef.Cols[0]
+	for i, col := range tableDef.Cols {
+		col.ColId = uint64(i)
+	}
+	return tableDef
+}
+
+func TestCheckCheckConstraints(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	ctx := mock.CurrentContext()
+	require.NoError(t, checkCheckConstraints(ctx, makeCheckTestTable()))
+
+	goodTables := []func(tableDef *TableDef){
+		func(tableDef *TableDef) { tableDef.Checks[0].OriginString = "c in ('x', 'y')" },
+		func(tableDef *TableDef) { tableDef.Checks[0].OriginString = "length(c) > a" },
+		// the expression is cast to bool
+		func(tableDef *TableDef) { tableDef.Checks[0].OriginString = "b" },
+	}
+	for i, good := range goodTables {
+		tableDef := makeCheckTestTable()
+		good(tableDef)
+		require.NoError(t, checkCheckConstraints(ctx, tableDef), "case %d", i)
+	}
+
+	badTables := []func(tableDef *TableDef){
+		// unknown column
+		func(tableDef *TableDef) { tableDef.Checks[0].OriginString = "x > 0" },
+		// non-deterministic function
+		func(tableDef *TableDef) { tableDef.Checks[0].OriginString = "b > rand()" },
+		// subquery
+		func(tableDef *TableDef) { tableDef.Checks[0].OriginString = "b > (select 1)" },
+		// duplicate name
+		func(tableDef *TableDef) { tableDef.Checks[1].Name = "CHK_B" },
+		// auto increment column
+		func(tableDef *TableDef) { tableDef.Cols[0].Typ.AutoIncr = true },
+	}
+	for i, bad := range badTables {
+		tableDef := makeCheckTestTable()
+		bad(tableDef)
+		require.Error(t, checkCheckConstraints(ctx, tableDef), "case %d", i)
+	}
+}
+
+func TestNameCheckConstraints(t *testing.T) {
+	checks := []*plan.CheckDef{
+		{OriginString: "a > 0"},
+		{Name: "ct_chk_1", OriginString: "b > 0"},
+		{OriginString: "c > 0"},
+	}
+	nameCheckConstraints(checks, "ct")
+	require.Equal(t, "ct_chk_2", checks[0].Name)
+	require.Equal(t, "ct_chk_1", checks[1].Name)
+	require.Equal(t, "ct_chk_3", checks[2].Name)
+}
+
+func TestShowCreateTableWithChecks(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	got, _, err := ConstructCreateTableSQL(&mock.ctxt, makeCheckTestTable(), nil, false)
+	require.NoError(t, err)
+	require.Equal(t, "CREATE TABLE `ct` (\n  `a` int NOT NULL,\n  `b` int DEFAULT NULL,\n  `c` varchar(10) DEFAULT NULL,\n  PRIMARY KEY (`a`),\n  CONSTRAINT `chk_b` CHECK (b > 0),\n  CONSTRAINT `chk_ab` CHECK (a + b < 100) NOT ENFORCED\n)", got)
+}
+
+func TestDmlWithChecks(t *testing.T) {
+	mock := NewMockOptimizer(true)
+	tableDef := makeCheckTestTable()
+	tableDef.Cols = append(tableDef.Cols, MakeRowIdColDef())
+	mock.ctxt.tables[tableDef.Name] = tableDef
+	mock.ctxt.id2name[tableDef.TblId] = tableDef.Name
+	mock.ctxt.objects[tableDef.Name] = &ObjectRef{
+		Obj:        int64(tableDef.TblId),
+		SchemaName: "tpch",
+		ObjName:    tableDef.Name,
+	}
+
+	sqls := []string{
+		"insert into ct values (1, 2, 'x')",
+		"insert into ct (a, b) select n_nationkey, n_regionkey from nation",
+		"update ct set b = b - 1 where a = 1",
+		"delete from ct where b > 1",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// only the enforced check constraints are evaluated by the pre-insert node
+	for _, sql := range sqls[:3] {
+		logicPlan, err := runOneStmt(mock, t, sql)
+		require.NoError(t, err)
+		var checks []*plan.CheckDef
+		for _, query := range logicPlan.GetQuery().GetNodes() {
+			if query.NodeType == plan.Node_PRE_INSERT {
+				checks = query.PreInsertCtx.Checks
+			}
+		}
+		require.Len(t, checks, 1, sql)
+		require.Equal(t, "chk_b", checks[0].Name)
+		require.NotNil(t, checks[0].Check.GetF(), sql)
+	}
+}
+
+func TestAlterTableWithChecks(t *testing.T) {
+	mock := NewMockOptimizer(true)
+	tableDef := makeCheckTestTable()
+	tableDef.Cols = append(tableDef.Cols, MakeRowIdColDef())
+	mock.ctxt.tables[tableDef.Name] = tableDef
+	mock.ctxt.id2name[tableDef.TblId] = tableDef.Name
+	mock.ctxt.objects[tableDef.Name] = &ObjectRef{
+		Obj:        int64(tableDef.TblId),
+		SchemaName: "tpch",
+		ObjName:    tableDef.Name,
+	}
+
+	// the rows are checked when they are copied to the new table
+	logicPlan, err := runOneStmt(mock, t, "alter table ct add check (b < 10)")
+	require.NoError(t, err)
+	alterTable := logicPlan.GetDdl().GetAlterTable()
+	require.Equal(t, plan.AlterTable_COPY, alterTable.AlgorithmType)
+	require.Contains(t, alterTable.CreateTmpTableSql, "CONSTRAINT `ct_chk_1` CHECK ((b < 10))")
+
+	for _, sql := range []string{"alter table ct drop check chk_b", "alter table ct drop constraint chk_ab"} {
+		logicPlan, err = runOneStmt(mock, t, sql)
+		require.NoError(t, err)
+		alterTable = logicPlan.GetDdl().GetAlterTable()
+		require.Equal(t, plan.AlterTable_INPLACE, alterTable.AlgorithmType)
+		require.Equal(t, plan.AlterTableDrop_CHECK, alterTable.Actions[0].GetDrop().Typ)
+	}
+
+	sqls := []string{
+		"alter table ct add constraint chk_b check (b < 10)",
+		"alter table ct add check (x > 1)",
+		"alter table ct drop check chk_x",
+	}
+	runTestShouldError(mock, t, sqls)
+}
@@ -129,6 +129,12 @@ func DeepCopyPreInsertCtx(ctx *plan.PreInsertCtx) *plan.PreInsertCtx {
 		HasAutoCol: ctx.HasAutoCol,
 		IsUpdate:   ctx.IsUpdate,
 	}
+	if ctx.Checks != nil {
+		newCtx.Checks = make([]*plan.CheckDef, len(ctx.Checks))
+		for i, check := range ctx.Checks {
+			newCtx.Checks[i] = DeepCopyCheck(check)
+		}
+	}
 
 	return newCtx
 }
@@ -172,6 +178,8 @@ func DeepCopyLockTarget(target *plan.LockTarget) *plan.LockTarget {
 		FilterColIdxInBat:  target.FilterColIdxInBat,
 		LockTable:          target.LockTable,
 		Block:              target.Block,
+		Mode:               target.Mode,
+		WaitPolicy:         target.WaitPolicy,
 	}
 }
 
@@ -319,6 +327,17 @@ func DeepCopyDefault(def *plan.Default) *plan.Default {
 		NullAbility:  def.NullAbility,
 		Expr:         DeepCopyExpr(def.Expr),
 		OriginString: def.OriginString,
+		Generated:    DeepCopyGeneratedCol(def.Generated),
+	}
+}
+
+func DeepCopyGeneratedCol(gen *plan.GeneratedCol) *plan.GeneratedCol {
+	if gen == nil {
+		return nil
+	}
+	return &plan.GeneratedCol{
+		OriginString: gen.OriginString,
+		Stored:       gen.Stored,
 	}
 }
 
@@ -479,6 +498,7 @@ func DeepCopyTableDef(table *plan.TableDef, withCols bool) *plan.TableDef {
 		Fkeys:          make([]*plan.ForeignKeyDef, len(table.Fkeys)),
 		RefChildTbls:   make([]uint64, len(table.RefChildTbls)),
 		Checks:         make([]*plan.CheckDef, len(table.Checks)),
+		Triggers:       make([]*plan.TriggerDef, len(table.Triggers)),
 		Props:          make([]*plan.PropertyDef, len(table.Props)),
 		Defs:           make([]*plan.TableDef_DefType, len(table.Defs)),
 		Name2ColIndex:  table.Name2ColIndex,
@@ -503,10 +523,11 @@ func DeepCopyTableDef(table *plan.TableDef, withCols bool) *plan.TableDef {
 	}
 
 	for idx, col := range table.Checks {
-		newTable.Checks[idx] = &plan.CheckDef{
-			Name:  col.Name,
-			Check: DeepCopyExpr(col.Check),
-		}
+		newTable.Checks[idx] = DeepCopyCheck(col)
+	}
+
+	for idx, trigger := range table.Triggers {
+		newTable.Triggers[idx] = DeepCopyTrigger(trigger)
 	}
 
 	for idx, prop := range table.Props {
@@ -845,6 +866,26 @@ func DeepCopyFkey(fkey *ForeignKeyDef) *ForeignKeyDef {
 	return def
 }
 
+func DeepCopyCheck(check *plan.CheckDef) *plan.CheckDef {
+	return &plan.CheckDef{
+		Name:         check.Name,
+		Check:        DeepCopyExpr(check.Check),
+		OriginString: check.OriginString,
+		Enforced:     check.Enforced,
+	}
+}
+
+func DeepCopyTrigger(trigger *plan.TriggerDef) *plan.TriggerDef {
+	return &plan.TriggerDef{
+		Name:          trigger.Name,
+		Time:          trigger.Time,
+		Event:         trigger.Event,
+		Body:          trigger.Body,
+		DefinerUserId: trigger.DefinerUserId,
+		DefinerRoleId: trigger.DefinerRoleId,
+	}
+}
+
 func DeepCopyRuntimeFilterSpec(rf *plan.RuntimeFilterSpec) *plan.RuntimeFilterSpec {
 	if rf == nil {
 		return nil
@@ -0,0 +1,301 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/fulltext"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+const (
+	fulltextIndexTokenizeFuncName = "fulltext_index_tokenize"
+	fulltextIndexScanFuncName     = "fulltext_index_scan"
+)
+
+// buildFullTextIndexTokenize builds fulltext_index_tokenize(parser, doc_id, col1, col2, ...),
+// which returns one row <serial(doc_id, pos), doc_id, word, pos> for each word of the columns,
+// the same as the columns of the fulltext index table.
+func (builder *QueryBuilder) buildFullTextIndexTokenize(tbl *tree.TableFunction, ctx *BindContext, exprs []*plan.Expr, childId int32) (int32, error) {
+	if len(exprs) < 3 {
+		return 0, moerr.NewInvalidInput(builder.GetContext(), "fulltext_index_tokenize: parser, doc_id and at least one column are required")
+	}
+	if exprs[0].GetLit() == nil {
+		return 0, moerr.NewInvalidInput(builder.GetContext(), "fulltext_index_tokenize: parser must be a constant string")
+	}
+	for _, expr := range exprs[2:] {
+		if !types.T(expr.Typ.Id).IsMySQLString() {
+			return 0, moerr.NewInvalidInput(builder.GetContext(), "fulltext_index_tokenize: only char, varchar and text columns can be tokenized")
+		}
+	}
+	return builder.appendFullTextIndexTokenizeNode(ctx, exprs, childId), nil
+}
+
+func (builder *QueryBuilder) appendFullTextIndexTokenizeNode(ctx *BindContext, exprs []*plan.Expr, childId int32) int32 {
+	docTyp := exprs[1].Typ
+	docTyp.AutoIncr = false
+	docTyp.NotNullable = false
+	colDefs := []*plan.ColDef{
+		{
+			Name: catalog.FullTextIndexTableKeyColName,
+			Typ:  plan.Type{Id: int32(types.T_varchar), Width: types.MaxVarcharLen},
+		},
+		{
+			Name: catalog.FullTextIndexTableDocIdColName,
+			Typ:  docTyp,
+		},
+		{
+			Name: catalog.FullTextIndexTableWordColName,
+			Typ:  plan.Type{Id: int32(types.T_varchar), Width: types.MaxVarcharLen},
+		},
+		{
+			Name: catalog.FullTextIndexTablePosColName,
+			Typ:  plan.Type{Id: int32(types.T_int32), Width: 32},
+		},
+	}
+	node := &plan.Node{
+		NodeType: plan.Node_FUNCTION_SCAN,
+		Stats:    &plan.Stats{},
+		TableDef: &plan.TableDef{
+			TableType: "func_table",
+			TblFunc: &plan.TableFunction{
+				Name: fulltextIndexTokenizeFuncName,
+			},
+			Cols: colDefs,
+		},
+		BindingTags:     []int32{builder.genNewTag()},
+		TblFuncExprList: exprs,
+	}
+	if childId >= 0 {
+		node.Children = []int32{childId}
+	}
+	return builder.appendNode(node, ctx)
+}
+
+// bindFullTextMatch binds MATCH (col1, col2, ...) AGAINST (pattern) into the score column of
+// a fulltext index scan joined with the table, see appendFullTextIndexScan. The score is the
+// BM25 relevance of the row, or 0 if the row is not matched.
+func (b *baseBinder) bindFullTextMatch(astExpr *tree.FullTextMatchExpr, depth int32) (*plan.Expr, error) {
+	var mode fulltext.Mode
+	switch astExpr.Mode {
+	case tree.FULLTEXT_DEFAULT, tree.FULLTEXT_NL:
+		mode = fulltext.ModeNaturalLanguage
+	case tree.FULLTEXT_BOOLEAN:
+		mode = fulltext.ModeBoolean
+	default:
+		return nil, moerr.NewNotSupportedf(b.GetContext(), "fulltext search %s", astExpr.Mode.ToString())
+	}
+
+	// all columns must be of one table
+	var binding *Binding
+	cols := make([]*plan.ColRef, len(astExpr.Columns))
+	for i, col := range astExpr.Columns {
+		expr, err := b.impl.BindColRef(col, depth, false)
+		if err != nil {
+			return nil, err
+		}
+		colRef := expr.GetCol()
+		if colRef == nil {
+			return nil, moerr.NewNotSupported(b.GetContext(), "MATCH on columns of an outer query")
+		}
+		if binding != nil && binding.tag != colRef.RelPos {
+			return nil, moerr.NewInvalidInput(b.GetContext(), "MATCH columns must be of the same table")
+		}
+		if binding = b.ctx.bindingByTag[colRef.RelPos]; binding == nil {
+			return nil, moerr.NewInvalidInputf(b.GetContext(), "MATCH column '%s' is not a table column", col.ColNameOrigin())
+		}
+		cols[i] = colRef
+	}
+
+	node := b.builder.qry.Nodes[binding.nodeId]
+	if node.NodeType != plan.Node_TABLE_SCAN {
+		return nil, moerr.NewNotSupported(b.GetContext(), "MATCH on a view or a derived table")
+	}
+	tableDef := node.TableDef
+
+	// the columns must be the same as the key parts of a fulltext index
+	var indexDef *plan.IndexDef
+	for _, idx := range tableDef.Indexes {
+		if !idx.TableExist || !catalog.IsFullTextIndexAlgo(idx.IndexAlgo) || len(idx.Parts) != len(cols) {
+			continue
+		}
+		parts := make(map[string]bool, len(idx.Parts))
+		for _, part := range idx.Parts {
+			parts[part] = true
+		}
+		matched := true
+		for _, col := range cols {
+			matched = matched && parts[tableDef.Cols[col.ColPos].Name]
+		}
+		if matched {
+			indexDef = idx
+			break
+		}
+	}
+	if indexDef == nil {
+		return nil, moerr.NewInvalidInput(b.GetContext(), "can't find FULLTEXT index matching the column list")
+	}
+
+	parser, err := catalog.FullTextIndexParser(indexDef.IndexAlgoParams)
+	if err != nil {
+		return nil, err
+	}
+
+	pattern, err := b.impl.BindExpr(astExpr.Pattern, depth, false)
+	if err != nil {
+		return nil, err
+	}
+	if !types.T(pattern.Typ.Id).IsMySQLString() {
+		pattern, err = appendCastBeforeExpr(b.GetContext(), pattern, plan.Type{Id: int32(types.T_varchar), Width: types.MaxVarcharLen})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !isRuntimeConstExpr(pattern) {
+		return nil, moerr.NewInvalidInput(b.GetContext(), "the search string of MATCH ... AGAINST must be a constant")
+	}
+
+	pkPos := binding.FindColumn(tableDef.Pkey.PkeyColName)
+	if pkPos == NotFound {
+		return nil, moerr.NewInternalErrorf(b.GetContext(), "primary key of table '%s' not found", tableDef.Name)
+	}
+	pk := &plan.Expr{
+		Typ: *binding.types[pkPos],
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{
+				RelPos: binding.tag,
+				ColPos: pkPos,
+				Name:   tableDef.Pkey.PkeyColName,
+			},
+		},
+	}
+
+	score, err := b.builder.appendFullTextIndexScan(binding, pk, []*plan.Expr{
+		makePlan2StringConstExprWithType(binding.db),
+		makePlan2StringConstExprWithType(indexDef.IndexTableName),
+		makePlan2StringConstExprWithType(parser),
+		makePlan2Int64ConstExprWithType(int64(mode)),
+		pattern,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return BindFuncExprImplByPlanExpr(b.GetContext(), "coalesce", []*plan.Expr{
+		score,
+		makePlan2Float64ConstExprWithType(0),
+	})
+}
+
+// appendFullTextIndexScan LEFT joins the table scan of binding with
+// fulltext_index_scan(db, index_table, parser, mode, pattern) on the primary key, and
+// returns the score column, which is null for the rows not matched.
+// A filter on MATCH rejects the nulls and turns the join into an inner join, then the
+// doc ids of the index scan prune the table scan by the runtime filter on the primary key.
+// The searches of the same table are shared, the index table is searched once for each.
+func (builder *QueryBuilder) appendFullTextIndexScan(binding *Binding, pk *plan.Expr, args []*plan.Expr) (*plan.Expr, error) {
+	keys := make([]string, 0, len(args)+1)
+	keys = append(keys, fmt.Sprintf("%d", binding.tag))
+	for _, arg := range args {
+		keys = append(keys, arg.String())
+	}
+	key := strings.Join(keys, "|")
+	if score, ok := builder.fulltextScores[key]; ok {
+		return DeepCopyExpr(score), nil
+	}
+
+	// move the table scan to a new node, and put the join at its place,
+	// so the parent of the table scan gets the join as its child.
+	scanID := binding.nodeId
+	ctx := builder.ctxByNode[scanID]
+	newScanID := builder.appendNode(builder.qry.Nodes[scanID], ctx)
+	binding.nodeId = newScanID
+
+	docTyp := pk.Typ
+	docTyp.AutoIncr = false
+	tag := builder.genNewTag()
+	tableDef := &plan.TableDef{
+		TableType: "func_table",
+		Name:      fulltextIndexScanFuncName,
+		TblFunc: &plan.TableFunction{
+			Name: fulltextIndexScanFuncName,
+		},
+		Cols: []*plan.ColDef{
+			{
+				Name: catalog.FullTextIndexTableDocIdColName,
+				Typ:  docTyp,
+			},
+			{
+				Name: catalog.FullTextIndexScanScoreColName,
+				Typ:  plan.Type{Id: int32(types.T_float64)},
+			},
+		},
+	}
+	childID := builder.appendNode(&plan.Node{
+		NodeType: plan.Node_VALUE_SCAN,
+	}, ctx)
+	indexScanID := builder.appendNode(&plan.Node{
+		NodeType:        plan.Node_FUNCTION_SCAN,
+		Stats:           &plan.Stats{},
+		TableDef:        tableDef,
+		BindingTags:     []int32{tag},
+		TblFuncExprList: args,
+		Children:        []int32{childID},
+	}, ctx)
+	builder.addNameByColRef(tag, tableDef)
+
+	doc := &plan.Expr{
+		Typ: docTyp,
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{
+				RelPos: tag,
+				ColPos: 0,
+			},
+		},
+	}
+	cond, err := BindFuncExprImplByPlanExpr(builder.GetContext(), "=", []*plan.Expr{DeepCopyExpr(pk), doc})
+	if err != nil {
+		return nil, err
+	}
+	builder.qry.Nodes[scanID] = &plan.Node{
+		NodeType: plan.Node_JOIN,
+		JoinType: plan.Node_LEFT,
+		NodeId:   scanID,
+		Children: []int32{newScanID, indexScanID},
+		OnList:   []*plan.Expr{cond},
+	}
+	ReCalcNodeStats(scanID, builder, false, true, true)
+
+	score := &plan.Expr{
+		Typ: tableDef.Cols[1].Typ,
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{
+				RelPos: tag,
+				ColPos: 1,
+			},
+		},
+	}
+	if builder.fulltextScores == nil {
+		builder.fulltextScores = make(map[string]*plan.Expr)
+	}
+	builder.fulltextScores[key] = score
+	return DeepCopyExpr(score), nil
+}
@@ -39,3 +39,11 @@ func RegisterMedian(id int64) {
 func RegisterClusterCenters(id int64) {
 	aggexec.RegisterClusterCenters(id)
 }
+
+func RegisterJsonArrayAgg(id int64) {
+	aggexec.RegisterJsonArrayAgg(id)
+}
+
+func RegisterJsonObjectAgg(id int64) {
+	aggexec.RegisterJsonObjectAgg(id)
+}
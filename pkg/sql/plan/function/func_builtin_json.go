@@ -15,6 +15,8 @@
 package function
 
 import (
+	"strings"
+
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
@@ -392,3 +394,449 @@ func (op *opBuiltInJsonExtract) jsonExtractFloat64(parameters []*vector.Vector,
 	}
 	return nil
 }
+
+const (
+	jsonArgDoc      = iota // a json document, of json or string type.
+	jsonArgPath            // a json path, of string type.
+	jsonArgValue           // a value which is converted into json.
+	jsonArgAggValue        // a value of an aggregation, NULL is cast to varchar.
+)
+
+// jsonValueTypes are the types which can be converted into json by types.ValueToByteJson.
+var jsonValueTypes = map[types.T]bool{
+	types.T_any: true, types.T_json: true, types.T_bool: true, types.T_bit: true,
+	types.T_int8: true, types.T_int16: true, types.T_int32: true, types.T_int64: true,
+	types.T_uint8: true, types.T_uint16: true, types.T_uint32: true, types.T_uint64: true,
+	types.T_float32: true, types.T_float64: true, types.T_decimal64: true, types.T_decimal128: true,
+	types.T_date: true, types.T_time: true, types.T_datetime: true, types.T_timestamp: true,
+	types.T_uuid: true, types.T_char: true, types.T_varchar: true, types.T_text: true,
+}
+
+// jsonArgsCheck checks the arguments by their kinds, the strings of paths and documents keep
+// their types, NULL is cast to varchar and numbers of paths are cast to varchar.
+func jsonArgsCheck(inputs []types.Type, kindOf func(i int) int) checkResult {
+	ts := make([]types.Type, len(inputs))
+	needCast := false
+	for i, input := range inputs {
+		ts[i] = input
+		switch kind := kindOf(i); kind {
+		case jsonArgDoc, jsonArgPath:
+			if input.Oid.IsMySQLString() || (kind == jsonArgDoc && input.Oid == types.T_json) {
+				continue
+			}
+			if input.Oid == types.T_any || kind == jsonArgPath {
+				if canCast, _ := fixedImplicitTypeCast(input, types.T_varchar); canCast {
+					ts[i] = types.T_varchar.ToType()
+					needCast = true
+					continue
+				}
+			}
+			return newCheckResultWithFailure(failedFunctionParametersWrong)
+		case jsonArgValue:
+			if !jsonValueTypes[input.Oid] {
+				return newCheckResultWithFailure(failedFunctionParametersWrong)
+			}
+		case jsonArgAggValue:
+			if input.Oid == types.T_any {
+				ts[i] = types.T_varchar.ToType()
+				needCast = true
+			} else if !jsonValueTypes[input.Oid] {
+				return newCheckResultWithFailure(failedAggParametersWrong)
+			}
+		}
+	}
+	if needCast {
+		return newCheckResultWithCast(0, ts)
+	}
+	return newCheckResultWithSuccess(0)
+}
+
+// JSON_OBJECT(key, value, ...)
+func jsonObjectCheckFn(_ []overload, inputs []types.Type) checkResult {
+	if len(inputs)%2 != 0 {
+		return newCheckResultWithFailure(failedFunctionParametersWrong)
+	}
+	return jsonArgsCheck(inputs, func(i int) int {
+		if i%2 == 0 {
+			return jsonArgPath
+		}
+		return jsonArgValue
+	})
+}
+
+// JSON_ARRAY(value, ...)
+func jsonArrayCheckFn(_ []overload, inputs []types.Type) checkResult {
+	return jsonArgsCheck(inputs, func(int) int { return jsonArgValue })
+}
+
+// JSON_SET, JSON_INSERT, JSON_REPLACE(doc, path, value, ...)
+func jsonModifyCheckFn(_ []overload, inputs []types.Type) checkResult {
+	if len(inputs) < 3 || len(inputs)%2 == 0 {
+		return newCheckResultWithFailure(failedFunctionParametersWrong)
+	}
+	return jsonArgsCheck(inputs, func(i int) int {
+		if i == 0 {
+			return jsonArgDoc
+		}
+		if i%2 == 1 {
+			return jsonArgPath
+		}
+		return jsonArgValue
+	})
+}
+
+// JSON_REMOVE(doc, path, ...)
+func jsonRemoveCheckFn(_ []overload, inputs []types.Type) checkResult {
+	if len(inputs) < 2 {
+		return newCheckResultWithFailure(failedFunctionParametersWrong)
+	}
+	return jsonArgsCheck(inputs, func(i int) int {
+		if i == 0 {
+			return jsonArgDoc
+		}
+		return jsonArgPath
+	})
+}
+
+// JSON_CONTAINS(target, candidate[, path])
+func jsonContainsCheckFn(_ []overload, inputs []types.Type) checkResult {
+	if len(inputs) != 2 && len(inputs) != 3 {
+		return newCheckResultWithFailure(failedFunctionParametersWrong)
+	}
+	return jsonArgsCheck(inputs, func(i int) int {
+		if i < 2 {
+			return jsonArgDoc
+		}
+		return jsonArgPath
+	})
+}
+
+// JSON_KEYS, JSON_LENGTH(doc[, path])
+func jsonDocPathCheckFn(_ []overload, inputs []types.Type) checkResult {
+	if len(inputs) != 1 && len(inputs) != 2 {
+		return newCheckResultWithFailure(failedFunctionParametersWrong)
+	}
+	return jsonArgsCheck(inputs, func(i int) int {
+		if i == 0 {
+			return jsonArgDoc
+		}
+		return jsonArgPath
+	})
+}
+
+// JSON_ARRAYAGG(value)
+func jsonArrayAggCheckFn(_ []overload, inputs []types.Type) checkResult {
+	if len(inputs) != 1 {
+		return newCheckResultWithFailure(failedAggParametersWrong)
+	}
+	return jsonArgsCheck(inputs, func(int) int { return jsonArgAggValue })
+}
+
+// JSON_OBJECTAGG(key, value)
+func jsonObjectAggCheckFn(_ []overload, inputs []types.Type) checkResult {
+	if len(inputs) != 2 {
+		return newCheckResultWithFailure(failedAggParametersWrong)
+	}
+	return jsonArgsCheck(inputs, func(i int) int {
+		if i == 0 {
+			return jsonArgPath
+		}
+		return jsonArgAggValue
+	})
+}
+
+// jsonDocAt returns the json document at row i of a json or string vector.
+func jsonDocAt(vec *vector.Vector, i int) (bytejson.ByteJson, bool, error) {
+	if vec.IsNull(uint64(i)) {
+		return bytejson.Null, true, nil
+	}
+	if vec.GetType().Oid == types.T_json {
+		return types.DecodeJson(vec.GetBytesAt(i)), false, nil
+	}
+	bj, err := types.ParseSliceToByteJson(vec.GetBytesAt(i))
+	return bj, false, err
+}
+
+// jsonValueAt converts the value at row i into json, NULL becomes json null.
+func jsonValueAt(vec *vector.Vector, i int, proc *process.Process) (bytejson.ByteJson, error) {
+	if vec.IsNull(uint64(i)) {
+		return bytejson.Null, nil
+	}
+	return types.ValueToByteJson(*vec.GetType(), vec.GetRawBytesAt(i), proc.GetSessionInfo().TimeZone)
+}
+
+// opBuiltInJson caches the parsed paths of the json functions by argument,
+// the paths are almost always constant.
+type opBuiltInJson struct {
+	pathStrs []string
+	paths    []*bytejson.Path
+}
+
+func newOpBuiltInJson() *opBuiltInJson {
+	return &opBuiltInJson{}
+}
+
+// pathAt returns the path of argument arg at row i, which must not contain any wildcard if simple is true.
+func (op *opBuiltInJson) pathAt(parameters []*vector.Vector, arg int, i int, simple bool) (*bytejson.Path, bool, error) {
+	if len(op.paths) != len(parameters) {
+		op.pathStrs = make([]string, len(parameters))
+		op.paths = make([]*bytejson.Path, len(parameters))
+	}
+	vec := parameters[arg]
+	if vec.IsNull(uint64(i)) {
+		return nil, true, nil
+	}
+	str := vec.UnsafeGetStringAt(i)
+	if op.paths[arg] == nil || op.pathStrs[arg] != str {
+		path, err := types.ParseStringToPath(str)
+		if err != nil {
+			return nil, false, err
+		}
+		op.pathStrs[arg] = strings.Clone(str)
+		op.paths[arg] = &path
+	}
+	if simple {
+		if err := bytejson.CheckPathNoWildcard(op.paths[arg]); err != nil {
+			return nil, false, err
+		}
+	}
+	return op.paths[arg], false, nil
+}
+
+func JsonObject(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	keys := make([]string, len(parameters)/2)
+	vals := make([]bytejson.ByteJson, len(parameters)/2)
+	var err error
+	for i := 0; i < length; i++ {
+		if selectList.Contains(uint64(i)) {
+			if err = rs.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+		for j := 0; j < len(parameters); j += 2 {
+			if parameters[j].IsNull(uint64(i)) {
+				return moerr.NewInvalidInput(proc.Ctx, "JSON documents may not contain NULL member names")
+			}
+			keys[j/2] = parameters[j].GetStringAt(i)
+			if vals[j/2], err = jsonValueAt(parameters[j+1], i, proc); err != nil {
+				return err
+			}
+		}
+		obj, err := bytejson.BuildObject(keys, vals)
+		if err != nil {
+			return err
+		}
+		if err = rs.AppendByteJson(obj, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func JsonArray(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	vals := make([]bytejson.ByteJson, len(parameters))
+	var err error
+	for i := 0; i < length; i++ {
+		if selectList.Contains(uint64(i)) {
+			if err = rs.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+		for j, param := range parameters {
+			if vals[j], err = jsonValueAt(param, i, proc); err != nil {
+				return err
+			}
+		}
+		if err = rs.AppendByteJson(bytejson.BuildArray(vals), false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (op *opBuiltInJson) jsonSet(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+	return op.jsonModify(bytejson.ModifySet, parameters, result, proc, length, selectList)
+}
+
+func (op *opBuiltInJson) jsonInsert(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+	return op.jsonModify(bytejson.ModifyInsert, parameters, result, proc, length, selectList)
+}
+
+func (op *opBuiltInJson) jsonReplace(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+	return op.jsonModify(bytejson.ModifyReplace, parameters, result, proc, length, selectList)
+}
+
+func (op *opBuiltInJson) jsonModify(tp bytejson.ModifyType, parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	paths := make([]*bytejson.Path, len(parameters)/2)
+	vals := make([]bytejson.ByteJson, len(parameters)/2)
+	for i := 0; i < length; i++ {
+		if selectList.Contains(uint64(i)) {
+			if err := rs.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+		doc, null, err := jsonDocAt(parameters[0], i)
+		if err != nil {
+			return err
+		}
+		for j := 1; j < len(parameters) && !null; j += 2 {
+			if paths[j/2], null, err = op.pathAt(parameters, j, i, true); err != nil {
+				return err
+			}
+			if vals[j/2], err = jsonValueAt(parameters[j+1], i, proc); err != nil {
+				return err
+			}
+		}
+		if null {
+			if err = rs.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+		if doc, err = doc.Modify(paths, vals, tp); err != nil {
+			return err
+		}
+		if err = rs.AppendByteJson(doc, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (op *opBuiltInJson) jsonRemove(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	paths := make([]*bytejson.Path, len(parameters)-1)
+	for i := 0; i < length; i++ {
+		if selectList.Contains(uint64(i)) {
+			if err := rs.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+		doc, null, err := jsonDocAt(parameters[0], i)
+		if err != nil {
+			return err
+		}
+		for j := 1; j < len(parameters) && !null; j++ {
+			if paths[j-1], null, err = op.pathAt(parameters, j, i, true); err != nil {
+				return err
+			}
+		}
+		if null {
+			if err = rs.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+		if doc, err = doc.Remove(paths); err != nil {
+			return err
+		}
+		if err = rs.AppendByteJson(doc, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// lookupAt returns the document of argument docArg at row i, or the value in it at the path of argument pathArg.
+// it returns null if the document or the path is null, or the path does not exist.
+func (op *opBuiltInJson) lookupAt(parameters []*vector.Vector, docArg, pathArg int, i int) (bytejson.ByteJson, bool, error) {
+	doc, null, err := jsonDocAt(parameters[docArg], i)
+	if err != nil || null || pathArg >= len(parameters) {
+		return doc, null, err
+	}
+	path, null, err := op.pathAt(parameters, pathArg, i, true)
+	if err != nil || null {
+		return doc, null, err
+	}
+	doc, found := doc.Lookup(path)
+	return doc, !found, nil
+}
+
+func (op *opBuiltInJson) jsonContains(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+	rs := vector.MustFunctionResult[bool](result)
+	for i := 0; i < length; i++ {
+		if selectList.Contains(uint64(i)) {
+			if err := rs.Append(false, true); err != nil {
+				return err
+			}
+			continue
+		}
+		candidate, null, err := jsonDocAt(parameters[1], i)
+		if err != nil {
+			return err
+		}
+		var target bytejson.ByteJson
+		if !null {
+			if target, null, err = op.lookupAt(parameters, 0, 2, i); err != nil {
+				return err
+			}
+		}
+		if err = rs.Append(!null && bytejson.Contains(target, candidate), null); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (op *opBuiltInJson) jsonKeys(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	for i := 0; i < length; i++ {
+		if selectList.Contains(uint64(i)) {
+			if err := rs.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+		doc, null, err := op.lookupAt(parameters, 0, 1, i)
+		if err != nil {
+			return err
+		}
+		var keys bytejson.ByteJson
+		if !null {
+			var ok bool
+			keys, ok = doc.Keys()
+			null = !ok
+		}
+		if null {
+			err = rs.AppendBytes(nil, true)
+		} else {
+			err = rs.AppendByteJson(keys, false)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (op *opBuiltInJson) jsonLength(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+	rs := vector.MustFunctionResult[int64](result)
+	for i := 0; i < length; i++ {
+		if selectList.Contains(uint64(i)) {
+			if err := rs.Append(0, true); err != nil {
+				return err
+			}
+			continue
+		}
+		doc, null, err := op.lookupAt(parameters, 0, 1, i)
+		if err != nil {
+			return err
+		}
+		if null {
+			err = rs.Append(0, true)
+		} else {
+			err = rs.Append(int64(doc.Length()), false)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
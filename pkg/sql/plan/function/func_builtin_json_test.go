@@ -0,0 +1,242 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+// jsonTestCase compares the json results by their text, the binary layout of a json
+// document is not a concern of the json functions.
+type jsonTestCase struct {
+	info    string
+	inputs  []FunctionTestInput
+	fn      fEvalFn
+	wantErr bool
+	// the text of the json results, "" means NULL
+	want []string
+}
+
+func runJsonTestCases(t *testing.T, testCases []jsonTestCase) {
+	proc := testutil.NewProcess()
+	for _, tc := range testCases {
+		fcTC := NewFunctionTestCase(proc, tc.inputs, NewFunctionTestResult(types.T_json.ToType(), false, nil, nil), tc.fn)
+		require.NoError(t, fcTC.result.PreExtendAndReset(fcTC.fnLength))
+		v, err := fcTC.DebugRun()
+		if tc.wantErr {
+			require.Error(t, err, tc.info)
+			continue
+		}
+		require.NoError(t, err, tc.info)
+		require.Equal(t, len(tc.want), v.Length(), tc.info)
+		for i, want := range tc.want {
+			if want == "" {
+				require.True(t, v.IsNull(uint64(i)), fmt.Sprintf("case is '%s', row %d", tc.info, i))
+				continue
+			}
+			require.False(t, v.IsNull(uint64(i)), fmt.Sprintf("case is '%s', row %d", tc.info, i))
+			require.Equal(t, want, types.DecodeJson(v.GetBytesAt(i)).String(), tc.info)
+		}
+	}
+}
+
+func TestJsonBuild(t *testing.T) {
+	runJsonTestCases(t, []jsonTestCase{
+		{
+			info: "json_object",
+			inputs: []FunctionTestInput{
+				NewFunctionTestInput(types.T_varchar.ToType(), []string{"id", "id"}, nil),
+				NewFunctionTestInput(types.T_int64.ToType(), []int64{1, 2}, nil),
+				NewFunctionTestConstInput(types.T_varchar.ToType(), []string{"name"}, nil),
+				NewFunctionTestInput(types.T_varchar.ToType(), []string{"a", ""}, []bool{false, true}),
+				NewFunctionTestConstInput(types.T_varchar.ToType(), []string{"tags"}, nil),
+				NewFunctionTestInput(types.T_json.ToType(), []string{jsonText(t, `["x"]`), jsonText(t, `{}`)}, nil),
+			},
+			fn:   JsonObject,
+			want: []string{`{"id": 1, "name": "a", "tags": ["x"]}`, `{"id": 2, "name": null, "tags": {}}`},
+		},
+		{
+			info: "json_object with a null key",
+			inputs: []FunctionTestInput{
+				NewFunctionTestInput(types.T_varchar.ToType(), []string{""}, []bool{true}),
+				NewFunctionTestInput(types.T_int64.ToType(), []int64{1}, nil),
+			},
+			fn:      JsonObject,
+			wantErr: true,
+		},
+		{
+			info: "json_array",
+			inputs: []FunctionTestInput{
+				NewFunctionTestInput(types.T_float64.ToType(), []float64{1.5, 0}, []bool{false, true}),
+				NewFunctionTestInput(types.T_bool.ToType(), []bool{true, false}, nil),
+				NewFunctionTestInput(types.T_varchar.ToType(), []string{`{"a": 1}`, "b"}, nil),
+			},
+			fn:   JsonArray,
+			want: []string{`[1.5, true, "{\"a\": 1}"]`, `[null, false, "b"]`},
+		},
+	})
+}
+
+func TestJsonModify(t *testing.T) {
+	runJsonTestCases(t, []jsonTestCase{
+		{
+			info: "json_set",
+			inputs: []FunctionTestInput{
+				NewFunctionTestInput(types.T_varchar.ToType(), []string{`{"a": 1}`, `[1, 2]`, ``}, []bool{false, false, true}),
+				NewFunctionTestConstInput(types.T_varchar.ToType(), []string{"$.b"}, nil),
+				NewFunctionTestInput(types.T_int64.ToType(), []int64{2, 3, 4}, nil),
+				NewFunctionTestConstInput(types.T_varchar.ToType(), []string{"$[1]"}, nil),
+				NewFunctionTestInput(types.T_varchar.ToType(), []string{"x", "y", "z"}, nil),
+			},
+			fn:   newOpBuiltInJson().jsonSet,
+			want: []string{`[{"a": 1, "b": 2}, "x"]`, `[1, "y"]`, ``},
+		},
+		{
+			info: "json_insert",
+			inputs: []FunctionTestInput{
+				NewFunctionTestInput(types.T_varchar.ToType(), []string{`{"a": 1}`, `{"b": 1}`}, nil),
+				NewFunctionTestConstInput(types.T_varchar.ToType(), []string{"$.a"}, nil),
+				NewFunctionTestInput(types.T_int64.ToType(), []int64{2, 3}, nil),
+			},
+			fn:   newOpBuiltInJson().jsonInsert,
+			want: []string{`{"a": 1}`, `{"a": 3, "b": 1}`},
+		},
+		{
+			info: "json_replace with a null path",
+			inputs: []FunctionTestInput{
+				NewFunctionTestInput(types.T_varchar.ToType(), []string{`{"a": 1}`, `{"b": 1}`}, nil),
+				NewFunctionTestInput(types.T_varchar.ToType(), []string{"$.a", ""}, []bool{false, true}),
+				NewFunctionTestInput(types.T_int64.ToType(), []int64{2, 3}, nil),
+			},
+			fn:   newOpBuiltInJson().jsonReplace,
+			want: []string{`{"a": 2}`, ``},
+		},
+		{
+			info: "json_set with a wildcard",
+			inputs: []FunctionTestInput{
+				NewFunctionTestInput(types.T_varchar.ToType(), []string{`{"a": 1}`}, nil),
+				NewFunctionTestInput(types.T_varchar.ToType(), []string{"$.*"}, nil),
+				NewFunctionTestInput(types.T_int64.ToType(), []int64{2}, nil),
+			},
+			fn:      newOpBuiltInJson().jsonSet,
+			wantErr: true,
+		},
+		{
+			info: "json_remove",
+			inputs: []FunctionTestInput{
+				NewFunctionTestInput(types.T_json.ToType(), []string{jsonText(t, `{"a": 1, "b": [1, 2]}`)}, nil),
+				NewFunctionTestInput(types.T_varchar.ToType(), []string{"$.a"}, nil),
+				NewFunctionTestInput(types.T_varchar.ToType(), []string{"$.b[0]"}, nil),
+			},
+			fn:   newOpBuiltInJson().jsonRemove,
+			want: []string{`{"b": [2]}`},
+		},
+		{
+			info: "json_remove of $",
+			inputs: []FunctionTestInput{
+				NewFunctionTestInput(types.T_varchar.ToType(), []string{`{"a": 1}`}, nil),
+				NewFunctionTestInput(types.T_varchar.ToType(), []string{"$"}, nil),
+			},
+			fn:      newOpBuiltInJson().jsonRemove,
+			wantErr: true,
+		},
+		{
+			info: "json_keys",
+			inputs: []FunctionTestInput{
+				NewFunctionTestInput(types.T_varchar.ToType(), []string{`{"b": 1, "a": {"c": 2}}`, `[1]`, `{"a": 1}`}, nil),
+				NewFunctionTestInput(types.T_varchar.ToType(), []string{"$", "$", "$.a"}, nil),
+			},
+			fn:   newOpBuiltInJson().jsonKeys,
+			want: []string{`["a", "b"]`, ``, ``},
+		},
+	})
+}
+
+func TestJsonContainsAndLength(t *testing.T) {
+	proc := testutil.NewProcess()
+	testCases := []tcTemp{
+		{
+			info: "json_contains",
+			inputs: []FunctionTestInput{
+				NewFunctionTestInput(types.T_varchar.ToType(), []string{`{"a": [1, 2], "b": 3}`, `[1, [2, 3]]`, `[1]`, `{"a": 1}`}, nil),
+				NewFunctionTestInput(types.T_varchar.ToType(), []string{`{"a": 2}`, `[3, 1]`, `2`, `1`}, nil),
+			},
+			expect: NewFunctionTestResult(types.T_bool.ToType(), false,
+				[]bool{true, true, false, false}, nil),
+		},
+		{
+			info: "json_contains with a path",
+			inputs: []FunctionTestInput{
+				NewFunctionTestInput(types.T_varchar.ToType(), []string{`{"a": [1, 2]}`, `{"a": [1, 2]}`}, nil),
+				NewFunctionTestInput(types.T_varchar.ToType(), []string{`2`, `2`}, nil),
+				NewFunctionTestInput(types.T_varchar.ToType(), []string{"$.a", "$.b"}, nil),
+			},
+			expect: NewFunctionTestResult(types.T_bool.ToType(), false,
+				[]bool{true, false}, []bool{false, true}),
+		},
+		{
+			info: "json_contains with an invalid candidate",
+			inputs: []FunctionTestInput{
+				NewFunctionTestInput(types.T_varchar.ToType(), []string{`[1]`}, nil),
+				NewFunctionTestInput(types.T_varchar.ToType(), []string{`a`}, nil),
+			},
+			expect: NewFunctionTestResult(types.T_bool.ToType(), true, nil, nil),
+		},
+	}
+	for _, tc := range testCases {
+		fcTC := NewFunctionTestCase(proc, tc.inputs, tc.expect, newOpBuiltInJson().jsonContains)
+		s, info := fcTC.Run()
+		require.True(t, s, fmt.Sprintf("case is '%s', err info is '%s'", tc.info, info))
+	}
+
+	testCases = []tcTemp{
+		{
+			info: "json_length",
+			inputs: []FunctionTestInput{
+				NewFunctionTestInput(types.T_varchar.ToType(), []string{`{"a": 1, "b": [1, 2, 3]}`, `"x"`, `[]`}, nil),
+			},
+			expect: NewFunctionTestResult(types.T_int64.ToType(), false,
+				[]int64{2, 1, 0}, nil),
+		},
+		{
+			info: "json_length with a path",
+			inputs: []FunctionTestInput{
+				NewFunctionTestInput(types.T_varchar.ToType(), []string{`{"a": null, "b": [1, 2, 3]}`, `{"a": null}`, `{"a": null}`}, nil),
+				NewFunctionTestInput(types.T_varchar.ToType(), []string{"$.b", "$.a", "$.c"}, nil),
+			},
+			expect: NewFunctionTestResult(types.T_int64.ToType(), false,
+				[]int64{3, 1, 0}, []bool{false, false, true}),
+		},
+	}
+	for _, tc := range testCases {
+		fcTC := NewFunctionTestCase(proc, tc.inputs, tc.expect, newOpBuiltInJson().jsonLength)
+		s, info := fcTC.Run()
+		require.True(t, s, fmt.Sprintf("case is '%s', err info is '%s'", tc.info, info))
+	}
+}
+
+// jsonText returns the storage bytes of a json text, as the input of a json vector.
+func jsonText(t *testing.T, s string) string {
+	bj, err := types.ParseStringToByteJson(s)
+	require.NoError(t, err)
+	bs, err := bj.Marshal()
+	require.NoError(t, err)
+	return string(bs)
+}
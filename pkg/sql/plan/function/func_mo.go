@@ -585,6 +585,9 @@ var (
 		"mo_stages":                   0,
 		"mo_snapshots":                0,
 		"mo_pitr":                     0,
+		"mo_cdc_task":                 0,
+		"mo_cdc_watermark":            0,
+		"mo_stream_offsets":           0,
 	}
 )
 
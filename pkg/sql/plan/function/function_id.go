@@ -388,6 +388,19 @@ const (
 	BITMAP_CONSTRUCT_AGG
 	BITMAP_OR_AGG
 
+	// json function
+	JSON_OBJECT
+	JSON_ARRAY
+	JSON_SET
+	JSON_INSERT
+	JSON_REPLACE
+	JSON_REMOVE
+	JSON_CONTAINS
+	JSON_KEYS
+	JSON_LENGTH
+	JSON_ARRAYAGG
+	JSON_OBJECTAGG
+
 	// FUNCTION_END_NUMBER is not a function, just a flag to record the max number of function.
 	// TODO: every one should put the new function id in front of this one if you want to make a new function.
 	FUNCTION_END_NUMBER
@@ -472,7 +485,16 @@ var functionIdRegister = map[string]int32{
 	"any_value":             ANY_VALUE,
 	"median":                MEDIAN,
 	// count window
-	"rank": RANK,
+	"rank":         RANK,
+	"percent_rank": PERCENT_RANK,
+	"cume_dist":    CUME_DIST,
+	"ntile":        NTILE,
+	// value window
+	"lag":         LAG,
+	"lead":        LEAD,
+	"first_value": FIRST_VALUE,
+	"last_value":  LAST_VALUE,
+	"nth_value":   NTH_VALUE,
 	// builtin
 	// whoever edit this, please follow the lexical order, or come up with a better ordering method
 	// binary functions
@@ -704,4 +726,16 @@ var functionIdRegister = map[string]int32{
 	"bitmap_count":         BITMAP_COUNT,
 	"bitmap_construct_agg": BITMAP_CONSTRUCT_AGG,
 	"bitmap_or_agg":        BITMAP_OR_AGG,
+	// json function
+	"json_object":    JSON_OBJECT,
+	"json_array":     JSON_ARRAY,
+	"json_set":       JSON_SET,
+	"json_insert":    JSON_INSERT,
+	"json_replace":   JSON_REPLACE,
+	"json_remove":    JSON_REMOVE,
+	"json_contains":  JSON_CONTAINS,
+	"json_keys":      JSON_KEYS,
+	"json_length":    JSON_LENGTH,
+	"json_arrayagg":  JSON_ARRAYAGG,
+	"json_objectagg": JSON_OBJECTAGG,
 }
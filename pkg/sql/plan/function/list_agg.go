@@ -484,4 +484,44 @@ var supportedAggInNewFramework = []FuncNew{
 			},
 		},
 	},
+
+	// function `JSON_ARRAYAGG`
+	{
+		functionId: JSON_ARRAYAGG,
+		class:      plan.Function_AGG,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    jsonArrayAggCheckFn,
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isAgg:      true,
+				retType:    aggexec.JsonAggReturnType,
+				aggFramework: aggregationLogicOfOverload{
+					str:         "json_arrayagg",
+					aggRegister: agg.RegisterJsonArrayAgg,
+				},
+			},
+		},
+	},
+
+	// function `JSON_OBJECTAGG`
+	{
+		functionId: JSON_OBJECTAGG,
+		class:      plan.Function_AGG,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    jsonObjectAggCheckFn,
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isAgg:      true,
+				retType:    aggexec.JsonAggReturnType,
+				aggFramework: aggregationLogicOfOverload{
+					str:         "json_objectagg",
+					aggRegister: agg.RegisterJsonObjectAgg,
+				},
+			},
+		},
+	},
 }
@@ -88,4 +88,193 @@ var supportedWindowInNewFramework = []FuncNew{
 			},
 		},
 	},
+	{
+		functionId: PERCENT_RANK,
+		class:      plan.Function_WIN_ORDER,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			if len(inputs) == 0 {
+				return newCheckResultWithSuccess(0)
+			}
+			return newCheckResultWithFailure(failedFunctionParametersWrong)
+		},
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType:    aggexec.WindowDistributionReturnType,
+				aggFramework: aggregationLogicOfOverload{
+					str:         "percent_rank",
+					aggRegister: agg.RegisterPercentRank,
+				},
+			},
+		},
+	},
+	{
+		functionId: CUME_DIST,
+		class:      plan.Function_WIN_ORDER,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			if len(inputs) == 0 {
+				return newCheckResultWithSuccess(0)
+			}
+			return newCheckResultWithFailure(failedFunctionParametersWrong)
+		},
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType:    aggexec.WindowDistributionReturnType,
+				aggFramework: aggregationLogicOfOverload{
+					str:         "cume_dist",
+					aggRegister: agg.RegisterCumeDist,
+				},
+			},
+		},
+	},
+	{
+		functionId: NTILE,
+		class:      plan.Function_WIN_ORDER,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			return windowFunctionTypeCheck(inputs, 1, 1, 0)
+		},
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType:    aggexec.SingleWindowReturnType,
+				aggFramework: aggregationLogicOfOverload{
+					str:         "ntile",
+					aggRegister: agg.RegisterNtile,
+				},
+			},
+		},
+	},
+	{
+		functionId: LAG,
+		class:      plan.Function_WIN_VALUE,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			return windowFunctionTypeCheck(inputs, 1, 3, 1)
+		},
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType:    aggexec.WindowValueReturnType,
+				aggFramework: aggregationLogicOfOverload{
+					str:         "lag",
+					aggRegister: agg.RegisterLag,
+				},
+			},
+		},
+	},
+	{
+		functionId: LEAD,
+		class:      plan.Function_WIN_VALUE,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			return windowFunctionTypeCheck(inputs, 1, 3, 1)
+		},
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType:    aggexec.WindowValueReturnType,
+				aggFramework: aggregationLogicOfOverload{
+					str:         "lead",
+					aggRegister: agg.RegisterLead,
+				},
+			},
+		},
+	},
+	{
+		functionId: FIRST_VALUE,
+		class:      plan.Function_WIN_VALUE,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			return windowFunctionTypeCheck(inputs, 1, 1, -1)
+		},
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType:    aggexec.WindowValueReturnType,
+				aggFramework: aggregationLogicOfOverload{
+					str:         "first_value",
+					aggRegister: agg.RegisterFirstValue,
+				},
+			},
+		},
+	},
+	{
+		functionId: LAST_VALUE,
+		class:      plan.Function_WIN_VALUE,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			return windowFunctionTypeCheck(inputs, 1, 1, -1)
+		},
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType:    aggexec.WindowValueReturnType,
+				aggFramework: aggregationLogicOfOverload{
+					str:         "last_value",
+					aggRegister: agg.RegisterLastValue,
+				},
+			},
+		},
+	},
+	{
+		functionId: NTH_VALUE,
+		class:      plan.Function_WIN_VALUE,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			return windowFunctionTypeCheck(inputs, 2, 2, 1)
+		},
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType:    aggexec.WindowValueReturnType,
+				aggFramework: aggregationLogicOfOverload{
+					str:         "nth_value",
+					aggRegister: agg.RegisterNthValue,
+				},
+			},
+		},
+	},
+}
+
+// windowFunctionTypeCheck checks the parameters of window functions which take 'minArgs' to 'maxArgs' parameters.
+// the parameter at 'intIdx' should be an integer and will be cast to int64,
+// and the parameters other than the first one will be cast to the type of the first one.
+func windowFunctionTypeCheck(inputs []types.Type, minArgs, maxArgs int, intIdx int) checkResult {
+	if len(inputs) < minArgs || len(inputs) > maxArgs {
+		return newCheckResultWithFailure(failedFunctionParametersWrong)
+	}
+
+	needCast := false
+	castTypes := make([]types.Type, len(inputs))
+	for i, t := range inputs {
+		castTypes[i] = t
+		switch {
+		case i == intIdx:
+			if !t.Oid.IsInteger() {
+				return newCheckResultWithFailure(failedFunctionParametersWrong)
+			}
+			castTypes[i] = types.T_int64.ToType()
+		case i > 0:
+			castTypes[i] = inputs[0]
+		}
+		if !castTypes[i].Eq(t) {
+			needCast = true
+		}
+	}
+	if needCast {
+		return newCheckResultWithCast(0, castTypes)
+	}
+	return newCheckResultWithSuccess(0)
 }
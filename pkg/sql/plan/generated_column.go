@@ -0,0 +1,471 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+	"github.com/matrixorigin/matrixone/pkg/sql/util"
+)
+
+// Generated columns are kept in ColDef.Default.Generated. Both kinds are
+// computed by the projections of INSERT, UPDATE and LOAD, so the secondary
+// indexes and the partition expressions see their values like the values of
+// any other column. The STORED columns are written to the table, the VIRTUAL
+// columns are written as NULL and computed from the other columns whenever
+// the table is scanned, see Binding.virtualCols.
+
+func isGeneratedCol(col *ColDef) bool {
+	return col.Default != nil && col.Default.Generated != nil
+}
+
+func isVirtualCol(col *ColDef) bool {
+	return isGeneratedCol(col) && !col.Default.Generated.Stored
+}
+
+func hasGeneratedCols(tableDef *TableDef) bool {
+	for _, col := range tableDef.Cols {
+		if isGeneratedCol(col) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasVirtualCols(tableDef *TableDef) bool {
+	for _, col := range tableDef.Cols {
+		if isVirtualCol(col) {
+			return true
+		}
+	}
+	return false
+}
+
+func newGeneratedColWriteError(ctx context.Context, colName, tblName string) error {
+	return moerr.NewInvalidInputf(ctx, "the value specified for generated column '%s' in table '%s' is not allowed", colName, tblName)
+}
+
+// buildGeneratedCol builds the definition of the generated column from the
+// GENERATED ALWAYS AS clause, the expression is checked by checkGeneratedCols
+// once all the columns of the table are known.
+func buildGeneratedCol(ctx context.Context, def *tree.ColumnTableDef, typ Type, dflt *plan.Default, onUpdate *plan.OnUpdate, autoIncr bool) (*plan.GeneratedCol, error) {
+	var attr *tree.AttributeGeneratedAlways
+	for _, a := range def.Attributes {
+		if g, ok := a.(*tree.AttributeGeneratedAlways); ok {
+			attr = g
+		}
+	}
+	if attr == nil {
+		return nil, nil
+	}
+
+	colNameOrigin := def.Name.ColNameOrigin()
+	if dflt.Expr != nil || autoIncr || onUpdate != nil {
+		return nil, moerr.NewInvalidInputf(ctx, "generated column '%s' cannot have a default value, auto_increment or on update", colNameOrigin)
+	}
+	switch types.T(typ.Id) {
+	case types.T_enum, types.T_datalink:
+		return nil, moerr.NewNotSupportedf(ctx, "generated column '%s' of type %s", colNameOrigin, types.T(typ.Id).String())
+	}
+
+	fmtCtx := tree.NewFmtCtx(dialect.MYSQL, tree.WithSingleQuoteString())
+	fmtCtx.PrintExpr(attr.Expr, attr.Expr, false)
+	return &plan.GeneratedCol{
+		OriginString: fmtCtx.String(),
+		Stored:       attr.Stored,
+	}, nil
+}
+
+// checkNoGeneratedAttr checks the column added or changed by ALTER TABLE is
+// not a generated column.
+func checkNoGeneratedAttr(ctx context.Context, def *tree.ColumnTableDef) error {
+	for _, attr := range def.Attributes {
+		if _, ok := attr.(*tree.AttributeGeneratedAlways); ok {
+			return moerr.NewNotSupported(ctx, "add or change a generated column by ALTER TABLE")
+		}
+	}
+	return nil
+}
+
+// checkGeneratedCols checks the expressions of the generated columns of a new
+// table. A generated column can only reference the columns of the table which
+// are not auto_increment and the generated columns defined before it, and the
+// expression must be deterministic.
+func checkGeneratedCols(ctx CompilerContext, tableDef *TableDef) error {
+	if !hasGeneratedCols(tableDef) {
+		return nil
+	}
+
+	pkCols := make(map[string]bool)
+	if tableDef.Pkey != nil {
+		for _, name := range tableDef.Pkey.Names {
+			pkCols[name] = true
+		}
+	}
+	clusterByCols := make(map[string]bool)
+	if tableDef.ClusterBy != nil {
+		if util.JudgeIsCompositeClusterByColumn(tableDef.ClusterBy.Name) {
+			for _, name := range util.SplitCompositeClusterByColumnName(tableDef.ClusterBy.Name) {
+				clusterByCols[name] = true
+			}
+		} else {
+			clusterByCols[tableDef.ClusterBy.Name] = true
+		}
+	}
+
+	builder := NewQueryBuilder(plan.Query_SELECT, ctx, false, false)
+	colPos := make(map[string]int, len(tableDef.Cols))
+	for i, col := range tableDef.Cols {
+		colPos[col.Name] = i
+	}
+	for i, col := range tableDef.Cols {
+		if !isGeneratedCol(col) {
+			continue
+		}
+		name := col.GetOriginCaseName()
+		if isVirtualCol(col) && (pkCols[col.Name] || clusterByCols[col.Name]) {
+			return moerr.NewNotSupportedf(ctx.GetContext(), "virtual generated column '%s' in primary key or cluster by, use a stored generated column instead", name)
+		}
+
+		expr, err := bindGeneratedColExpr(builder, col, tableDef.Cols, 0)
+		if err != nil {
+			return err
+		}
+		subject := fmt.Sprintf("expression of generated column '%s'", name)
+		err = checkExprDeterministic(ctx.GetContext(), expr, subject, func(colRef *plan.ColRef) error {
+			ref := tableDef.Cols[colPos[colRef.Name]]
+			if ref.Typ.AutoIncr {
+				return moerr.NewInvalidInputf(ctx.GetContext(), "generated column '%s' cannot refer to auto_increment column '%s'", name, ref.GetOriginCaseName())
+			}
+			if isGeneratedCol(ref) && colPos[ref.Name] >= i {
+				return moerr.NewInvalidInputf(ctx.GetContext(), "generated column '%s' cannot refer to itself or a generated column defined after it", name)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkExprDeterministic checks expr only calls the functions whose result
+// is determined by their arguments, and contains no subquery, parameter or
+// variable. checkCol is called on every column referenced by expr.
+func checkExprDeterministic(ctx context.Context, expr *plan.Expr, subject string, checkCol func(col *plan.ColRef) error) error {
+	switch impl := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		return checkCol(impl.Col)
+	case *plan.Expr_F:
+		if fn, ok := function.GetFunctionByIdWithoutError(impl.F.Func.Obj); ok && (fn.CannotFold() || fn.IsRealTimeRelated()) {
+			return moerr.NewInvalidInputf(ctx, "%s contains a disallowed function '%s'", subject, impl.F.Func.ObjName)
+		}
+		for _, arg := range impl.F.Args {
+			if err := checkExprDeterministic(ctx, arg, subject, checkCol); err != nil {
+				return err
+			}
+		}
+	case *plan.Expr_List:
+		for _, arg := range impl.List.List {
+			if err := checkExprDeterministic(ctx, arg, subject, checkCol); err != nil {
+				return err
+			}
+		}
+	case *plan.Expr_Sub, *plan.Expr_P, *plan.Expr_V:
+		return moerr.NewInvalidInputf(ctx, "%s cannot contain a subquery, parameter or variable", subject)
+	}
+	return nil
+}
+
+// bindGeneratedColExpr binds the expression of the generated column on the
+// columns of an input, the columns are referenced by relPos and their
+// position in cols. The expression is cast to the type of the column.
+func bindGeneratedColExpr(builder *QueryBuilder, col *ColDef, cols []*ColDef, relPos int32) (*plan.Expr, error) {
+	ctx := builder.GetContext()
+	stmts, err := parsers.Parse(ctx, dialect.MYSQL, "select "+col.Default.Generated.OriginString, 1)
+	if err != nil {
+		return nil, err
+	}
+	binder := NewUpdateBinder(ctx, builder, nil, cols)
+	expr, err := binder.BindExpr(stmts[0].(*tree.Select).Select.(*tree.SelectClause).Exprs[0].Expr, 0, true)
+	if err != nil {
+		return nil, err
+	}
+	if expr, err = forceCastExpr(ctx, expr, col.Typ); err != nil {
+		return nil, err
+	}
+
+	var remap func(e *plan.Expr)
+	remap = func(e *plan.Expr) {
+		switch impl := e.Expr.(type) {
+		case *plan.Expr_Col:
+			impl.Col.RelPos = relPos
+		case *plan.Expr_F:
+			for _, arg := range impl.F.Args {
+				remap(arg)
+			}
+		}
+	}
+	remap(expr)
+	return expr, nil
+}
+
+// computeGeneratedCols sets the values of the generated columns of tableDef,
+// values holds an expression for every column of tableDef. The columns are
+// computed in order, so a generated column can use the value of the
+// generated columns defined before it.
+func computeGeneratedCols(builder *QueryBuilder, tableDef *TableDef, values []*Expr) error {
+	projMap := make(map[[2]int32]*Expr, len(tableDef.Cols))
+	for i := range tableDef.Cols {
+		projMap[[2]int32{0, int32(i)}] = values[i]
+	}
+	for i, col := range tableDef.Cols {
+		if !isGeneratedCol(col) {
+			continue
+		}
+		expr, err := bindGeneratedColExpr(builder, col, tableDef.Cols, 0)
+		if err != nil {
+			return err
+		}
+		values[i] = replaceColumnsForExpr(expr, projMap)
+		projMap[[2]int32{0, int32(i)}] = values[i]
+	}
+	return nil
+}
+
+// bindVirtualCols returns the expressions computing the virtual columns of a
+// table scan from its other columns, by the position of the column.
+func bindVirtualCols(builder *QueryBuilder, tableDef *TableDef, tag int32) (map[int32]*Expr, error) {
+	virtualCols := make(map[int32]*Expr)
+	projMap := make(map[[2]int32]*Expr)
+	for i, col := range tableDef.Cols {
+		if !isVirtualCol(col) {
+			continue
+		}
+		expr, err := bindGeneratedColExpr(builder, col, tableDef.Cols, tag)
+		if err != nil {
+			return nil, err
+		}
+		// a virtual column may use the virtual columns defined before it
+		expr = replaceColumnsForExpr(expr, projMap)
+		virtualCols[int32(i)] = expr
+		projMap[[2]int32{tag, int32(i)}] = expr
+	}
+	return virtualCols, nil
+}
+
+// toCorrColRefs turns the column references of a virtual column expression
+// into correlated references when it is bound from a subquery.
+func toCorrColRefs(expr *Expr, depth int32) *Expr {
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		expr.Expr = &plan.Expr_Corr{
+			Corr: &plan.CorrColRef{
+				RelPos: e.Col.RelPos,
+				ColPos: e.Col.ColPos,
+				Depth:  depth,
+			},
+		}
+	case *plan.Expr_F:
+		for i, arg := range e.F.Args {
+			e.F.Args[i] = toCorrColRefs(arg, depth)
+		}
+	case *plan.Expr_List:
+		for i, arg := range e.List.List {
+			e.List.List[i] = toCorrColRefs(arg, depth)
+		}
+	}
+	return expr
+}
+
+// getGeneratedColDeps returns the columns which the value of the generated
+// column depends on, including the columns of the generated columns it
+// references.
+func getGeneratedColDeps(ctx context.Context, tableDef *TableDef, col *ColDef) (map[string]bool, error) {
+	deps := make(map[string]bool)
+	var collect func(col *ColDef) error
+	collect = func(col *ColDef) error {
+		stmts, err := parsers.Parse(ctx, dialect.MYSQL, "select "+col.Default.Generated.OriginString, 1)
+		if err != nil {
+			return err
+		}
+		collector := &exprKeyPartColsCollector{}
+		stmts[0].(*tree.Select).Select.(*tree.SelectClause).Exprs[0].Expr.Accept(collector)
+		for _, name := range collector.cols {
+			if deps[name] {
+				continue
+			}
+			deps[name] = true
+			for _, c := range tableDef.Cols {
+				if c.Name == name && isGeneratedCol(c) {
+					if err = collect(c); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	}
+	if err := collect(col); err != nil {
+		return nil, err
+	}
+	return deps, nil
+}
+
+// addGeneratedColsToUpdate checks the update does not assign the generated
+// columns of the table, and adds the generated columns whose value depends
+// on the updated columns to updateKeys, so they are computed again.
+func addGeneratedColsToUpdate(ctx context.Context, tableDef *TableDef, updateKeys map[string]tree.Expr) error {
+	for _, col := range tableDef.Cols {
+		if !isGeneratedCol(col) {
+			continue
+		}
+		if expr, ok := updateKeys[col.Name]; ok {
+			if _, ok = expr.(*tree.DefaultVal); !ok {
+				return newGeneratedColWriteError(ctx, col.GetOriginCaseName(), tableDef.Name)
+			}
+			continue
+		}
+		deps, err := getGeneratedColDeps(ctx, tableDef, col)
+		if err != nil {
+			return err
+		}
+		for _, c := range tableDef.Cols {
+			_, updated := updateKeys[c.Name]
+			if deps[c.Name] && (updated || (c.OnUpdate != nil && c.OnUpdate.Expr != nil)) {
+				updateKeys[col.Name] = tree.NewDefaultVal(nil)
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// checkGeneratedColsNotInKeys checks the keys used to find the conflicting
+// rows of REPLACE do not contain any generated column, the values of these
+// columns are not known before the row is inserted.
+func checkGeneratedColsNotInKeys(ctx context.Context, tableDef *TableDef, keys []map[string]struct{}, stmtName string) error {
+	for _, col := range tableDef.Cols {
+		if !isGeneratedCol(col) {
+			continue
+		}
+		for _, key := range keys {
+			if _, ok := key[col.Name]; ok {
+				return moerr.NewNotSupported(ctx, fmt.Sprintf("%s on a table with generated column '%s' in a unique key", stmtName, col.GetOriginCaseName()))
+			}
+		}
+	}
+	return nil
+}
+
+// virtualColNullExpr is the value written to the table for a virtual column.
+func virtualColNullExpr(col *ColDef) *Expr {
+	typ := col.Typ
+	typ.NotNullable = false
+	typ.AutoIncr = false
+	return &Expr{
+		Typ: typ,
+		Expr: &plan.Expr_Lit{
+			Lit: &plan.Literal{
+				Isnull: true,
+			},
+		},
+	}
+}
+
+// removeGeneratedColsFromValues returns a copy of the VALUES clause and its
+// columns without the generated columns at idxs, which only hold DEFAULT.
+func removeGeneratedColsFromValues(slt *tree.ValuesClause, columns []string, idxs []int, isAllDefault bool) (*tree.ValuesClause, []string) {
+	skip := make(map[int]bool, len(idxs))
+	for _, idx := range idxs {
+		skip[idx] = true
+	}
+	newColumns := make([]string, 0, len(columns)-len(idxs))
+	for i, column := range columns {
+		if !skip[i] {
+			newColumns = append(newColumns, column)
+		}
+	}
+	if isAllDefault {
+		return slt, newColumns
+	}
+	newSlt := &tree.ValuesClause{
+		RowWord: slt.RowWord,
+		Rows:    make([]tree.Exprs, len(slt.Rows)),
+	}
+	for j, row := range slt.Rows {
+		newRow := make(tree.Exprs, 0, len(newColumns))
+		for i, expr := range row {
+			if !skip[i] {
+				newRow = append(newRow, expr)
+			}
+		}
+		newSlt.Rows[j] = newRow
+	}
+	return newSlt, newColumns
+}
+
+// markGeneratedColsChanged replaces the values of the generated columns which
+// depend on a column changed in newRow, so they become update columns and
+// are recomputed with the other ones.
+func markGeneratedColsChanged(ctx context.Context, tableDef *TableDef, newRow, unchanged map[string]*Expr) error {
+	for _, col := range tableDef.Cols {
+		if !isGeneratedCol(col) {
+			continue
+		}
+		deps, err := getGeneratedColDeps(ctx, tableDef, col)
+		if err != nil {
+			return err
+		}
+		for name := range deps {
+			if newRow[name] != unchanged[name] {
+				newRow[col.Name] = virtualColNullExpr(col)
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// computeUpdatedGeneratedCols computes the updated generated columns of the
+// table in the project list of an update, which holds the old values of the
+// columns followed by the new values of the update columns.
+func computeUpdatedGeneratedCols(builder *QueryBuilder, tableDef *TableDef, updateColPosMap map[string]int, projectList []*Expr) error {
+	values := make([]*Expr, len(tableDef.Cols))
+	for i, col := range tableDef.Cols {
+		if offset, ok := updateColPosMap[col.Name]; ok {
+			values[i] = projectList[offset]
+		} else {
+			values[i] = projectList[i]
+		}
+	}
+	if err := computeGeneratedCols(builder, tableDef, values); err != nil {
+		return err
+	}
+	for i, col := range tableDef.Cols {
+		if offset, ok := updateColPosMap[col.Name]; ok && isGeneratedCol(col) {
+			projectList[offset] = values[i]
+		}
+	}
+	return nil
+}
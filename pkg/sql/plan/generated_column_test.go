@@ -0,0 +1,148 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/stretchr/testify/require"
+)
+
+// makeGeneratedColTestTable returns the table
+// gt (a int primary key, b int, c int as (a + b) stored, d bigint as (c * 2) virtual)
+func makeGeneratedColTestTable() *TableDef {
+	makeCol := func(name string, id types.T, generated *plan.GeneratedCol) *ColDef {
+		return &ColDef{
+			Name:       name,
+			OriginName: name,
+			Typ:        Type{Id: int32(id)},
+			Default: &plan.Default{
+				NullAbility: true,
+				Generated:   generated,
+			},
+		}
+	}
+	tableDef := &TableDef{
+		TblId:     2000,
+		Name:      "gt",
+		TableType: catalog.SystemOrdinaryRel,
+		Cols: []*ColDef{
+			makeCol("a", types.T_int32, nil),
+			makeCol("b", types.T_int32, nil),
+			makeCol("c", types.T_int32, &plan.GeneratedCol{OriginString: "a + b", Stored: true}),
+			makeCol("d", types.T_int64, &plan.GeneratedCol{OriginString: "c * 2"}),
+		},
+		Pkey: &plan.PrimaryKeyDef{
+			PkeyColName: "a",
+			Names:       []string{"a"},
+		},
+	}
+	tableDef.Cols[0].Primary = true
+	tableDef.Cols[0].Typ.NotNullable = true
+	tableDef.Cols[0].Default.NullAbility = false
+	tableDef.Pkey.CompPkeyCol = tableDef.Cols[0]
+	for i, col := range tableDef.Cols {
+		col.ColId = uint64(i)
+	}
+	return tableDef
+}
+
+func TestCheckGeneratedCols(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	ctx := mock.CurrentContext()
+	require.NoError(t, checkGeneratedCols(ctx, makeGeneratedColTestTable()))
+
+	badTables := []func(tableDef *TableDef){
+		// reference to a later generated column
+		func(tableDef *TableDef) { tableDef.Cols[2].Default.Generated.OriginString = "d + 1" },
+		// reference to itself
+		func(tableDef *TableDef) { tableDef.Cols[2].Default.Generated.OriginString = "c + 1" },
+		// unknown column
+		func(tableDef *TableDef) { tableDef.Cols[2].Default.Generated.OriginString = "x + 1" },
+		// non-deterministic function
+		func(tableDef *TableDef) { tableDef.Cols[2].Default.Generated.OriginString = "a + rand()" },
+		// subquery
+		func(tableDef *TableDef) { tableDef.Cols[2].Default.Generated.OriginString = "a + (select 1)" },
+		// auto increment column
+		func(tableDef *TableDef) { tableDef.Cols[0].Typ.AutoIncr = true },
+		// virtual primary key
+		func(tableDef *TableDef) {
+			tableDef.Pkey.PkeyColName = "d"
+			tableDef.Pkey.Names = []string{"d"}
+		},
+	}
+	for i, bad := range badTables {
+		tableDef := makeGeneratedColTestTable()
+		bad(tableDef)
+		require.Error(t, checkGeneratedCols(ctx, tableDef), "case %d", i)
+	}
+}
+
+func TestShowCreateTableWithGeneratedCols(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	tableDef := makeGeneratedColTestTable()
+	tableDef.Cols[2].Typ.NotNullable = true
+	tableDef.Cols[2].Default.NullAbility = false
+	got, _, err := ConstructCreateTableSQL(&mock.ctxt, tableDef, nil, false)
+	require.NoError(t, err)
+	require.Equal(t, "CREATE TABLE `gt` (\n  `a` int NOT NULL,\n  `b` int DEFAULT NULL,\n  `c` int GENERATED ALWAYS AS (a + b) STORED NOT NULL,\n  `d` bigint GENERATED ALWAYS AS (c * 2) VIRTUAL,\n  PRIMARY KEY (`a`)\n)", got)
+}
+
+func TestDmlWithGeneratedCols(t *testing.T) {
+	mock := NewMockOptimizer(true)
+	tableDef := makeGeneratedColTestTable()
+	tableDef.Cols = append(tableDef.Cols, MakeRowIdColDef())
+	mock.ctxt.tables[tableDef.Name] = tableDef
+	mock.ctxt.id2name[tableDef.TblId] = tableDef.Name
+	mock.ctxt.objects[tableDef.Name] = &ObjectRef{
+		Obj:        int64(tableDef.TblId),
+		SchemaName: "tpch",
+		ObjName:    tableDef.Name,
+	}
+
+	sqls := []string{
+		"insert into gt (a, b) values (1, 2)",
+		"insert into gt values (1, 2, default, default)",
+		"insert into gt (a, b) select n_nationkey, n_regionkey from nation",
+		"insert ignore into gt (a, b) values (1, 2)",
+		"update gt set b = b + 1 where d > 10",
+		"update gt set a = 3, c = default",
+		"delete from gt where d = 4",
+		"select a, c, d from gt where d > 1 order by d",
+		"select * from nation where n_nationkey in (select d from gt where gt.a = nation.n_regionkey)",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	sqls = []string{
+		"insert into gt values (1, 2, 3, default)",
+		"insert into gt (a, b, d) values (1, 2, 4)",
+		"insert into gt (a, b, c) select 1, 2, 3",
+		"insert into gt (a, b) values (1, 2) on duplicate key update b = 3",
+		"update gt set c = 1",
+		"update gt set d = a",
+	}
+	runTestShouldError(mock, t, sqls)
+
+	// the virtual column is computed at scan time from the stored ones
+	logicPlan, err := runOneStmt(mock, t, "select d from gt")
+	require.NoError(t, err)
+	scan := logicPlan.GetQuery().Nodes[0]
+	require.Equal(t, plan.Node_TABLE_SCAN, scan.NodeType)
+	project := logicPlan.GetQuery().Nodes[len(logicPlan.GetQuery().Nodes)-1]
+	require.NotNil(t, project.ProjectList[0].GetF())
+}
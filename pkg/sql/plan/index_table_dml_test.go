@@ -16,6 +16,9 @@ package plan
 
 import (
 	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/stretchr/testify/require"
 )
 
 // only use in developing
@@ -199,3 +202,76 @@ func TestMultiTableUpdate(t *testing.T) {
 
 	runTestShouldPass(mock, t, sqls, false, false)
 }
+
+func TestFullTextIndexSQL(t *testing.T) {
+	mock := NewMockOptimizer(true)
+
+	sqls := []string{
+		"insert into articles values (1, 'MySQL Tutorial', 'DBMS stands for DataBase')",
+		"delete from articles where id = 1",
+		"update articles set body = 'MySQL vs. YourSQL' where id = 1",
+		"update articles set id = 2 where id = 1",
+		"select id, match(title, body) against('database') as score from articles",
+		"select id from articles where match(body, title) against('+mysql -yoursql' in boolean mode)",
+		"select * from articles where match(title, body) against('tutorial' in natural language mode) order by 1 desc",
+		"select * from articles as a, fulltext_index_tokenize('ngram', a.id, a.title) as f where f.__mo_index_pri_col = a.id",
+		"select a.id, b.id from articles a join articles b on a.id = b.id where match(a.title, a.body) against('mysql') and not match(b.title, b.body) against('tutorial')",
+		"update articles set body = 'database internals' where match(title, body) against('security')",
+		"delete from articles where match(title, body) against('+yoursql' in boolean mode)",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	sqls = []string{
+		"select id from articles where match(title) against('database')",
+		"select id from articles where match(title, body) against('database' with query expansion)",
+		"select id from emp, articles where match(ename, title) against('database')",
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
+func TestFullTextIndexScan(t *testing.T) {
+	mock := NewMockOptimizer(true)
+
+	// fullTextJoins returns the joins of the table scan and the fulltext index scans.
+	fullTextJoins := func(sql string) []*plan.Node {
+		pn, err := runOneStmt(mock, t, sql)
+		require.NoError(t, err)
+		qry := pn.GetQuery()
+		var joins []*plan.Node
+		for _, node := range qry.Nodes {
+			if node.NodeType != plan.Node_JOIN {
+				continue
+			}
+			for _, child := range node.Children {
+				scan := qry.Nodes[child]
+				if scan.NodeType == plan.Node_FUNCTION_SCAN && scan.TableDef.TblFunc.Name == fulltextIndexScanFuncName {
+					joins = append(joins, node)
+				}
+			}
+		}
+		return joins
+	}
+
+	// the rows not matched are filtered, the index scan prunes the table scan
+	joins := fullTextJoins("select id from articles where match(title, body) against('database')")
+	require.Equal(t, 1, len(joins))
+	require.Equal(t, plan.Node_INNER, joins[0].JoinType)
+
+	// every row keeps its score
+	joins = fullTextJoins("select id, match(title, body) against('database') from articles")
+	require.Equal(t, 1, len(joins))
+	require.Equal(t, plan.Node_LEFT, joins[0].JoinType)
+	joins = fullTextJoins("select id from articles where not match(title, body) against('database')")
+	require.Equal(t, 1, len(joins))
+	require.Equal(t, plan.Node_LEFT, joins[0].JoinType)
+
+	// the same search is done once
+	joins = fullTextJoins("select id, match(title, body) against('tutorial') from articles where match(title, body) against('tutorial') order by match(title, body) against('tutorial') desc")
+	require.Equal(t, 1, len(joins))
+	require.Equal(t, plan.Node_INNER, joins[0].JoinType)
+	joins = fullTextJoins("select id from articles where match(title, body) against('tutorial') and match(title, body) against('+mysql' in boolean mode)")
+	require.Equal(t, 2, len(joins))
+
+	_, err := runOneStmt(mock, t, "select id from articles where match(title, body) against(title)")
+	require.Error(t, err)
+}
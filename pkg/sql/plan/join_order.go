@@ -557,7 +557,8 @@ func (builder *QueryBuilder) enumerateTags(nodeID int32) []int32 {
 	node := builder.qry.Nodes[nodeID]
 	if len(node.BindingTags) > 0 {
 		tags = append(tags, node.BindingTags...)
-		if node.NodeType != plan.Node_JOIN {
+		// WINDOW and PARTITION pass the columns of the child through
+		if node.NodeType != plan.Node_JOIN && node.NodeType != plan.Node_WINDOW && node.NodeType != plan.Node_PARTITION {
 			return tags
 		}
 	}
@@ -0,0 +1,162 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"encoding/json"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+const jsonTableFuncName = "json_table"
+
+// JsonTableParam is the parameter of the json_table table function, the row path and
+// the column definitions of JSON_TABLE(doc, path COLUMNS (...)).
+// the columns are matched with the output columns of the function scan by name.
+type JsonTableParam struct {
+	Path    string             `json:"path"`
+	Columns []*JsonTableColumn `json:"columns"`
+}
+
+type JsonTableColumn struct {
+	Kind    tree.JsonTableColumnKind `json:"kind"`
+	Name    string                   `json:"name,omitempty"`
+	Path    string                   `json:"path,omitempty"`
+	OnEmpty *tree.JsonTableResponse  `json:"onEmpty,omitempty"`
+	OnError *tree.JsonTableResponse  `json:"onError,omitempty"`
+	// rows of NESTED PATH
+	Nested *JsonTableParam `json:"nested,omitempty"`
+}
+
+// buildJsonTable builds JSON_TABLE(doc, path COLUMNS (...)), which returns one row for each value
+// the path matches in doc, the NESTED PATH columns are joined with the row they are nested in.
+func (builder *QueryBuilder) buildJsonTable(tbl *tree.TableFunction, ctx *BindContext, exprs []*plan.Expr, childId int32) (int32, error) {
+	if len(tbl.JsonColumns) == 0 || len(exprs) != 2 {
+		return 0, moerr.NewInvalidInput(builder.GetContext(), "json_table: a document, a path and the columns are required")
+	}
+	typ := types.T(exprs[0].Typ.Id)
+	if typ != types.T_json && typ != types.T_any && !typ.IsMySQLString() {
+		return 0, moerr.NewInvalidInputf(builder.GetContext(), "json_table: the document must be json or string, but got %s", typ.String())
+	}
+
+	var colDefs []*plan.ColDef
+	names := make(map[string]struct{})
+	var buildParam func(path string, cols []*tree.JsonTableColumn) (*JsonTableParam, error)
+	buildParam = func(path string, cols []*tree.JsonTableColumn) (*JsonTableParam, error) {
+		if _, err := types.ParseStringToPath(path); err != nil {
+			return nil, err
+		}
+		param := &JsonTableParam{Path: path}
+		for _, col := range cols {
+			if col.Kind == tree.JSON_TABLE_COLUMN_NESTED {
+				nested, err := buildParam(col.Path, col.Columns)
+				if err != nil {
+					return nil, err
+				}
+				param.Columns = append(param.Columns, &JsonTableColumn{Kind: col.Kind, Nested: nested})
+				continue
+			}
+
+			name := string(col.Name)
+			if _, ok := names[name]; ok {
+				return nil, moerr.NewErrDupFieldName(builder.GetContext(), name)
+			}
+			names[name] = struct{}{}
+
+			colTyp := plan.Type{Id: int32(types.T_int64), Width: 64}
+			if col.Kind != tree.JSON_TABLE_COLUMN_ORDINALITY {
+				var err error
+				if colTyp, err = getTypeFromAst(builder.GetContext(), col.Type); err != nil {
+					return nil, err
+				}
+				if !isJsonTableColumnType(types.T(colTyp.Id)) {
+					return nil, moerr.NewNotSupportedf(builder.GetContext(), "json_table: column '%s' of type %s", name, types.T(colTyp.Id).String())
+				}
+				if _, err = types.ParseStringToPath(col.Path); err != nil {
+					return nil, err
+				}
+				for _, resp := range []*tree.JsonTableResponse{col.OnEmpty, col.OnError} {
+					if resp != nil && resp.Kind == tree.JSON_TABLE_RESPONSE_DEFAULT {
+						if _, err = types.ParseStringToByteJson(resp.Default); err != nil {
+							return nil, err
+						}
+					}
+				}
+			}
+			colTyp.NotNullable = false
+			colDefs = append(colDefs, &plan.ColDef{Name: name, Typ: colTyp})
+			param.Columns = append(param.Columns, &JsonTableColumn{
+				Kind:    col.Kind,
+				Name:    name,
+				Path:    col.Path,
+				OnEmpty: col.OnEmpty,
+				OnError: col.OnError,
+			})
+		}
+		return param, nil
+	}
+
+	lit := exprs[1].GetLit()
+	if lit == nil || lit.GetSval() == "" {
+		return 0, moerr.NewInvalidInput(builder.GetContext(), "json_table: the path must be a constant string")
+	}
+	param, err := buildParam(lit.GetSval(), tbl.JsonColumns)
+	if err != nil {
+		return 0, err
+	}
+	if len(colDefs) == 0 {
+		return 0, moerr.NewInvalidInput(builder.GetContext(), "json_table: at least one column is required")
+	}
+	data, err := json.Marshal(param)
+	if err != nil {
+		return 0, err
+	}
+
+	node := &plan.Node{
+		NodeType: plan.Node_FUNCTION_SCAN,
+		Stats:    &plan.Stats{},
+		TableDef: &plan.TableDef{
+			TableType: "func_table",
+			TblFunc: &plan.TableFunction{
+				Name:  jsonTableFuncName,
+				Param: data,
+			},
+			Cols: colDefs,
+		},
+		BindingTags:     []int32{builder.genNewTag()},
+		TblFuncExprList: exprs[:1],
+	}
+	if childId >= 0 {
+		node.Children = []int32{childId}
+	}
+	return builder.appendNode(node, ctx), nil
+}
+
+func isJsonTableColumnType(typ types.T) bool {
+	switch typ {
+	case types.T_bool,
+		types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_float32, types.T_float64, types.T_decimal64, types.T_decimal128,
+		types.T_char, types.T_varchar, types.T_text,
+		types.T_date, types.T_time, types.T_datetime, types.T_timestamp,
+		types.T_json:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,833 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// lateralFuncName is the table function that runs the plan of a LATERAL
+// derived table which can't be decorrelated.
+const lateralFuncName = "lateral"
+
+// lateralSubquery returns the subquery of a LATERAL derived table, or nil.
+func lateralSubquery(stmt tree.TableExpr) *tree.Select {
+	tbl, ok := stmt.(*tree.AliasedTableExpr)
+	if !ok || !tbl.Lateral {
+		return nil
+	}
+	expr := tbl.Expr
+	if paren, ok := expr.(*tree.ParenTableExpr); ok {
+		expr = paren.Expr
+	}
+	sel, _ := expr.(*tree.Select)
+	return sel
+}
+
+// isLateralTableFunction reports whether stmt is a LATERAL table function.
+func isLateralTableFunction(stmt tree.TableExpr) bool {
+	tbl, ok := stmt.(*tree.AliasedTableExpr)
+	if !ok || !tbl.Lateral {
+		return false
+	}
+	_, ok = tbl.Expr.(*tree.TableFunction)
+	return ok
+}
+
+// buildLateralSubquery binds the subquery of a LATERAL derived table, the
+// columns of the preceding tables in leftCtx are bound as correlated columns.
+func (builder *QueryBuilder) buildLateralSubquery(stmt *tree.Select, ctx *BindContext, leftCtx *BindContext) (int32, error) {
+	if builder.isForUpdate {
+		return 0, moerr.NewInternalError(builder.GetContext(), "not support select from derived table for update")
+	}
+
+	if leftCtx.binder == nil {
+		leftCtx.binder = NewTableBinder(builder, leftCtx)
+	}
+	subCtx := NewBindContext(builder, leftCtx)
+	nodeID, err := builder.buildSelect(stmt, subCtx, false)
+	if err != nil {
+		return 0, err
+	}
+
+	if subCtx.hasSingleRow {
+		ctx.hasSingleRow = true
+	}
+	ctx.views = append(ctx.views, subCtx.views...)
+
+	return nodeID, nil
+}
+
+// buildLateralTableFunction builds a join with a LATERAL table function as an
+// APPLY node, the table function is evaluated for each row of the left side.
+func (builder *QueryBuilder) buildLateralTableFunction(tbl *tree.JoinTableExpr, ctx *BindContext) (int32, error) {
+	var applyType plan.Node_JoinType
+
+	switch tbl.JoinType {
+	case tree.JOIN_TYPE_CROSS, tree.JOIN_TYPE_INNER:
+		applyType = plan.Node_INNER
+	case tree.JOIN_TYPE_LEFT:
+		applyType = plan.Node_OUTER
+	default:
+		return 0, moerr.NewNotSupported(builder.GetContext(), "LATERAL table function can only be used in an inner or left join")
+	}
+
+	leftCtx := NewBindContext(builder, ctx)
+	rightCtx := NewBindContext(builder, ctx)
+
+	leftChildID, err := builder.buildTable(tbl.Left, leftCtx, -1, leftCtx)
+	if err != nil {
+		return 0, err
+	}
+	ctx.views = append(ctx.views, leftCtx.views...)
+
+	rightChildID, err := builder.buildTable(tbl.Right, rightCtx, -2, leftCtx)
+	if err != nil {
+		return 0, err
+	}
+	ctx.views = append(ctx.views, rightCtx.views...)
+
+	return builder.appendLateralApply(tbl.Cond, applyType, leftChildID, rightChildID, leftCtx, rightCtx, ctx)
+}
+
+// buildLateralApply builds a join with a LATERAL derived table that can't be
+// decorrelated as an APPLY node, the subquery is run for each row of the left
+// side. For a LEFT JOIN, the ON condition is moved into the subquery, since the
+// outer apply only joins on TRUE.
+func (builder *QueryBuilder) buildLateralApply(tbl *tree.JoinTableExpr, joinType plan.Node_JoinType, leftChildID int32, leftCtx, ctx *BindContext) (int32, error) {
+	right := tbl.Right.(*tree.AliasedTableExpr)
+	stmt := lateralSubquery(right)
+
+	switch tbl.Cond.(type) {
+	case nil, *tree.OnJoinCond:
+	default:
+		return 0, moerr.NewNotSupported(builder.GetContext(), "USING or NATURAL join with LATERAL derived table")
+	}
+
+	applyType := plan.Node_INNER
+	cond := tbl.Cond
+	var on tree.Expr
+	if joinType == plan.Node_LEFT {
+		applyType = plan.Node_OUTER
+		if onCond, ok := cond.(*tree.OnJoinCond); ok {
+			on = onCond.Expr
+			cond = nil
+		}
+	}
+
+	rightCtx := NewBindContext(builder, ctx)
+	rightChildID, err := builder.buildLateralQuery(stmt, right.As, on, leftCtx, rightCtx)
+	if err != nil {
+		return 0, err
+	}
+
+	err = builder.addBinding(rightChildID, right.As, rightCtx)
+	if err != nil {
+		return 0, err
+	}
+
+	return builder.appendLateralApply(cond, applyType, leftChildID, rightChildID, leftCtx, rightCtx, ctx)
+}
+
+// appendLateralApply joins the left side with the table function of the right
+// side by an APPLY node, a condition other than TRUE is only allowed for the
+// inner apply and is put in a FILTER above it.
+func (builder *QueryBuilder) appendLateralApply(joinCond tree.JoinCond, applyType plan.Node_JoinType, leftChildID, rightChildID int32, leftCtx, rightCtx, ctx *BindContext) (int32, error) {
+	err := ctx.mergeContexts(builder.GetContext(), leftCtx, rightCtx)
+	if err != nil {
+		return 0, err
+	}
+
+	nodeID := builder.appendNode(&plan.Node{
+		NodeType: plan.Node_APPLY,
+		Children: []int32{leftChildID, rightChildID},
+		JoinType: applyType,
+	}, ctx)
+
+	var conds []*plan.Expr
+	switch cond := joinCond.(type) {
+	case nil:
+	case *tree.OnJoinCond:
+		ctx.binder = NewTableBinder(builder, ctx)
+		conds, err = splitAndBindCondition(cond.Expr, NoAlias, ctx)
+		if err != nil {
+			return 0, err
+		}
+	default:
+		return 0, moerr.NewNotSupported(builder.GetContext(), "USING or NATURAL join with LATERAL table function")
+	}
+
+	var filters []*plan.Expr
+	for _, cond := range conds {
+		if isConstTrueExpr(cond) {
+			continue
+		}
+		if applyType == plan.Node_OUTER {
+			return 0, moerr.NewNotSupported(builder.GetContext(), "LEFT JOIN LATERAL table function only supports ON TRUE")
+		}
+		filters = append(filters, cond)
+	}
+
+	if len(filters) > 0 {
+		nodeID = builder.appendNode(&plan.Node{
+			NodeType:   plan.Node_FILTER,
+			Children:   []int32{nodeID},
+			FilterList: filters,
+		}, ctx)
+	}
+
+	return nodeID, nil
+}
+
+// buildLateralQuery plans the subquery of a LATERAL derived table on its own,
+// with the correlated columns of the preceding tables in leftCtx bound as
+// parameters, the rows of the subquery are filtered by on if it's not nil.
+// It returns a FUNCTION_SCAN of the lateral table function, which takes the
+// correlated columns as arguments and runs the plan with their values for
+// each row.
+func (builder *QueryBuilder) buildLateralQuery(stmt *tree.Select, alias tree.AliasClause, on tree.Expr, leftCtx, ctx *BindContext) (int32, error) {
+	if builder.isForUpdate {
+		return 0, moerr.NewInternalError(builder.GetContext(), "not support select from derived table for update")
+	}
+
+	subBuilder := NewQueryBuilder(plan.Query_SELECT, builder.compCtx, builder.isPrepareStatement, false)
+	// the tags of the subquery come after the ones of the outer query, so the
+	// bindings of the preceding tables are shared as they are.
+	subBuilder.nextTag = builder.nextTag
+
+	outerCtx := NewBindContext(subBuilder, nil)
+	outerCtx.defaultDatabase = leftCtx.defaultDatabase
+	outerCtx.snapshot = leftCtx.snapshot
+	outerCtx.cteByName = make(map[string]*CTERef)
+	for bc := leftCtx; bc != nil; bc = bc.parent {
+		for name, cte := range bc.cteByName {
+			if _, ok := outerCtx.cteByName[name]; !ok && !cte.isRecursive {
+				outerCtx.cteByName[name] = cte
+			}
+		}
+	}
+	for _, binding := range leftCtx.bindings {
+		outerCtx.bindings = append(outerCtx.bindings, binding)
+		outerCtx.bindingByTag[binding.tag] = binding
+		outerCtx.bindingByTable[binding.table] = binding
+	}
+	for col, binding := range leftCtx.bindingByCol {
+		outerCtx.bindingByCol[col] = binding
+	}
+	outerCtx.binder = NewTableBinder(subBuilder, outerCtx)
+
+	subCtx := NewBindContext(subBuilder, outerCtx)
+	rootID, err := subBuilder.buildSelect(stmt, subCtx, true)
+	if err != nil {
+		return 0, err
+	}
+	ctx.views = append(ctx.views, subCtx.views...)
+
+	if on != nil {
+		onCtx := NewBindContext(subBuilder, outerCtx)
+		if err = subBuilder.addBinding(rootID, alias, onCtx); err != nil {
+			return 0, err
+		}
+		onCtx.binder = NewTableBinder(subBuilder, onCtx)
+		conds, err := splitAndBindCondition(on, NoAlias, onCtx)
+		if err != nil {
+			return 0, err
+		}
+		rootID = subBuilder.appendNode(&plan.Node{
+			NodeType:   plan.Node_FILTER,
+			Children:   []int32{rootID},
+			FilterList: conds,
+		}, onCtx)
+
+		binding := onCtx.bindings[0]
+		projects := make([]*plan.Expr, len(binding.cols))
+		for i := range binding.cols {
+			projects[i] = &plan.Expr{
+				Typ: *binding.types[i],
+				Expr: &plan.Expr_Col{
+					Col: &plan.ColRef{
+						RelPos: binding.tag,
+						ColPos: int32(i),
+					},
+				},
+			}
+		}
+		rootID = subBuilder.appendNode(&plan.Node{
+			NodeType:    plan.Node_PROJECT,
+			Children:    []int32{rootID},
+			ProjectList: projects,
+			BindingTags: []int32{subBuilder.genNewTag()},
+		}, onCtx)
+	}
+
+	var args []*plan.Expr
+	paramByCol := make(map[[2]int32]int32)
+	toParam := func(expr *plan.Expr) (*plan.Expr, error) {
+		corr := expr.GetCorr()
+		if _, ok := outerCtx.bindingByTag[corr.RelPos]; !ok || corr.Depth != 1 {
+			return nil, moerr.NewNotSupported(builder.GetContext(), "correlated columns in LATERAL derived table deeper than 1 level")
+		}
+		colRef := [2]int32{corr.RelPos, corr.ColPos}
+		pos, ok := paramByCol[colRef]
+		if !ok {
+			pos = int32(len(args))
+			paramByCol[colRef] = pos
+			args = append(args, &plan.Expr{
+				Typ: expr.Typ,
+				Expr: &plan.Expr_Col{
+					Col: &plan.ColRef{
+						RelPos: corr.RelPos,
+						ColPos: corr.ColPos,
+						Name:   builder.nameByColRef[colRef],
+					},
+				},
+			})
+		}
+		return &plan.Expr{
+			Typ: expr.Typ,
+			Expr: &plan.Expr_P{
+				P: &plan.ParamRef{
+					Pos: lateralParamPos(pos),
+				},
+			},
+		}, nil
+	}
+	for _, node := range subBuilder.qry.Nodes {
+		err = rewriteNodeExprs(node, func(expr *plan.Expr) (*plan.Expr, error) {
+			return replaceCorrCols(expr, toParam)
+		})
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	subBuilder.qry.Steps = append(subBuilder.qry.Steps, rootID)
+	qry, err := subBuilder.createQuery()
+	if err != nil {
+		return 0, err
+	}
+	data, err := qry.Marshal()
+	if err != nil {
+		return 0, err
+	}
+
+	root := qry.Nodes[qry.Steps[len(qry.Steps)-1]]
+	cols := make([]*plan.ColDef, len(root.ProjectList))
+	for i, expr := range root.ProjectList {
+		cols[i] = &plan.ColDef{
+			Name: qry.Headings[i],
+			Typ:  expr.Typ,
+		}
+	}
+
+	return builder.appendNode(&plan.Node{
+		NodeType: plan.Node_FUNCTION_SCAN,
+		Stats:    &plan.Stats{},
+		TableDef: &plan.TableDef{
+			TableType: "func_table",
+			TblFunc: &plan.TableFunction{
+				Name:  lateralFuncName,
+				Param: data,
+			},
+			Cols: cols,
+		},
+		BindingTags:     []int32{builder.genNewTag()},
+		TblFuncExprList: args,
+	}, ctx), nil
+}
+
+// decorrelateLateral removes the correlated columns from the plan of a LATERAL
+// derived table, and returns the new root together with the join predicates.
+//
+// The correlated predicates of the WHERE clauses are pulled up as the join
+// predicates. When the subquery has a LIMIT, e.g. top-N per group, the limit
+// is rewritten to a ROW_NUMBER() window partitioned by the correlated keys.
+// It returns false for the other shapes, which are run by an APPLY instead.
+func (builder *QueryBuilder) decorrelateLateral(nodeID int32) (int32, []*plan.Expr, bool, error) {
+	subCtx := builder.ctxByNode[nodeID]
+
+	var limits []int32
+	var needEq bool
+	hasCorr, ok := builder.checkLateralCorrelation(nodeID, &limits, &needEq)
+	if !ok || len(limits) > 1 {
+		return 0, nil, false, nil
+	}
+	if !hasCorr {
+		return nodeID, nil, true, nil
+	}
+
+	var preds []*plan.Expr
+	var err error
+	if len(limits) == 0 {
+		nodeID, preds, err = builder.pullupCorrelatedPredicates(nodeID, subCtx)
+		if err != nil {
+			return 0, nil, false, err
+		}
+		if needEq {
+			if _, ok = lateralEqualityKeys(preds); !ok {
+				return 0, nil, false, nil
+			}
+		}
+	} else {
+		nodeID, preds, ok, err = builder.rewriteLateralLimit(nodeID, limits[0], subCtx)
+		if err != nil || !ok {
+			return 0, nil, false, err
+		}
+	}
+
+	filterPreds, joinPreds := decreaseDepthAndDispatch(preds)
+	if len(filterPreds) > 0 {
+		return 0, nil, false, moerr.NewNotSupported(builder.GetContext(), "correlated columns in LATERAL derived table deeper than 1 level")
+	}
+
+	return nodeID, joinPreds, true, nil
+}
+
+// checkLateralCorrelation checks that the correlated columns only appear in
+// the filters, and that the correlated predicates can be pulled up to the root.
+// The nodes with LIMIT above the correlated predicates are collected in limits,
+// needEq is set when the predicates have to be equalities to be pulled up.
+func (builder *QueryBuilder) checkLateralCorrelation(nodeID int32, limits *[]int32, needEq *bool) (hasCorr bool, ok bool) {
+	node := builder.qry.Nodes[nodeID]
+
+	if nodeHasCorrCol(node) {
+		return false, false
+	}
+
+	if node.NodeType == plan.Node_FILTER {
+		for _, cond := range node.FilterList {
+			if hasCorrCol(cond) {
+				hasCorr = true
+				break
+			}
+		}
+	}
+
+	for i, childID := range node.Children {
+		childHasCorr, ok := builder.checkLateralCorrelation(childID, limits, needEq)
+		if !ok {
+			return false, false
+		}
+		if childHasCorr && node.NodeType == plan.Node_JOIN && node.JoinType != plan.Node_INNER && i > 0 {
+			return false, false
+		}
+		hasCorr = hasCorr || childHasCorr
+	}
+
+	if !hasCorr {
+		return false, true
+	}
+
+	switch node.NodeType {
+	case plan.Node_FILTER, plan.Node_PROJECT, plan.Node_SORT:
+	case plan.Node_JOIN:
+		if node.JoinType != plan.Node_INNER && node.JoinType != plan.Node_LEFT {
+			return false, false
+		}
+	case plan.Node_AGG:
+		if len(node.GroupBy) == 0 {
+			return false, false
+		}
+		*needEq = true
+	case plan.Node_DISTINCT:
+		*needEq = true
+	default:
+		return false, false
+	}
+
+	if node.Limit != nil || node.Offset != nil {
+		*limits = append(*limits, nodeID)
+	}
+
+	return true, true
+}
+
+// rewriteLateralLimit pulls up the correlated predicates through the node with
+// LIMIT, which is replaced by a filter on ROW_NUMBER() partitioned by the inner
+// side of the correlated equalities. It returns false when the limit can't be
+// rewritten.
+func (builder *QueryBuilder) rewriteLateralLimit(rootID, limitID int32, subCtx *BindContext) (int32, []*plan.Expr, bool, error) {
+	root := builder.qry.Nodes[rootID]
+	limitNode := builder.qry.Nodes[limitID]
+
+	// The limit is either on the SORT under the result PROJECT, or on the
+	// PROJECT of the select list when there is no ORDER BY. The window is
+	// put right under the root PROJECT, so that the columns below it are
+	// still visible to the joins above.
+	var childID int32
+	var preds []*plan.Expr
+	var err error
+	switch {
+	case limitID == rootID && limitNode.NodeType == plan.Node_PROJECT:
+		childID, preds, err = builder.pullupCorrelatedPredicates(limitNode.Children[0], subCtx)
+		if err != nil {
+			return 0, nil, false, err
+		}
+
+	case limitNode.NodeType == plan.Node_SORT && root.NodeType == plan.Node_PROJECT && root.Children[0] == limitID:
+		childID = limitNode.Children[0]
+		projNode := builder.qry.Nodes[childID]
+		if projNode.NodeType != plan.Node_PROJECT || projNode.BindingTags[0] != subCtx.projectTag {
+			return 0, nil, false, nil
+		}
+		projNode.Children[0], preds, err = builder.pullupCorrelatedPredicates(projNode.Children[0], subCtx)
+		if err != nil {
+			return 0, nil, false, err
+		}
+		for i, pred := range preds {
+			preds[i] = builder.pullupThroughProj(subCtx, projNode, subCtx.projectTag, pred)
+		}
+
+	default:
+		return 0, nil, false, nil
+	}
+
+	keys, ok := lateralEqualityKeys(preds)
+	if !ok {
+		return 0, nil, false, nil
+	}
+
+	windowTag := builder.genNewTag()
+	rowNumber, err := BindFuncExprImplByPlanExpr(builder.GetContext(), "row_number", []*plan.Expr{})
+	if err != nil {
+		return 0, nil, false, err
+	}
+
+	w := &plan.WindowSpec{
+		WindowFunc:  rowNumber,
+		Name:        "row_number",
+		PartitionBy: DeepCopyExprList(keys),
+	}
+	ws := &tree.WindowSpec{}
+	if limitNode.NodeType == plan.Node_SORT {
+		w.OrderBy = make([]*plan.OrderBySpec, 0, len(limitNode.OrderBy))
+		for _, orderBy := range limitNode.OrderBy {
+			w.OrderBy = append(w.OrderBy, &plan.OrderBySpec{
+				Expr: DeepCopyExpr(orderBy.Expr),
+				Flag: orderBy.Flag | plan.OrderBySpec_INTERNAL,
+			})
+		}
+		ws.OrderBy = tree.OrderBy{}
+	}
+	w.Frame = getFrame(ws)
+
+	partitionBy := make([]*plan.OrderBySpec, 0, len(keys))
+	for _, key := range keys {
+		partitionBy = append(partitionBy, &plan.OrderBySpec{
+			Expr: DeepCopyExpr(key),
+			Flag: plan.OrderBySpec_INTERNAL,
+		})
+	}
+
+	nodeID := builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_PARTITION,
+		Children:    []int32{childID},
+		OrderBy:     partitionBy,
+		BindingTags: []int32{windowTag},
+	}, subCtx)
+	nodeID = builder.appendNode(&plan.Node{
+		NodeType: plan.Node_WINDOW,
+		Children: []int32{nodeID},
+		WinSpecList: []*plan.Expr{{
+			Typ:  rowNumber.Typ,
+			Expr: &plan.Expr_W{W: w},
+		}},
+		WindowIdx:   0,
+		BindingTags: []int32{windowTag},
+	}, subCtx)
+	builder.nameByColRef[[2]int32{windowTag, 0}] = "row_number()"
+
+	rowNumberCol := &plan.Expr{
+		Typ: rowNumber.Typ,
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{
+				RelPos: windowTag,
+				ColPos: 0,
+			},
+		},
+	}
+
+	var filters []*plan.Expr
+	upper := limitNode.Limit
+	if limitNode.Offset != nil {
+		cond, err := BindFuncExprImplByPlanExpr(builder.GetContext(), ">", []*plan.Expr{DeepCopyExpr(rowNumberCol), DeepCopyExpr(limitNode.Offset)})
+		if err != nil {
+			return 0, nil, false, err
+		}
+		filters = append(filters, cond)
+
+		if upper != nil {
+			upper, err = BindFuncExprImplByPlanExpr(builder.GetContext(), "+", []*plan.Expr{DeepCopyExpr(limitNode.Offset), DeepCopyExpr(upper)})
+			if err != nil {
+				return 0, nil, false, err
+			}
+		}
+	}
+	if upper != nil {
+		cond, err := BindFuncExprImplByPlanExpr(builder.GetContext(), "<=", []*plan.Expr{DeepCopyExpr(rowNumberCol), DeepCopyExpr(upper)})
+		if err != nil {
+			return 0, nil, false, err
+		}
+		filters = append(filters, cond)
+	}
+
+	nodeID = builder.appendNode(&plan.Node{
+		NodeType:   plan.Node_FILTER,
+		Children:   []int32{nodeID},
+		FilterList: filters,
+	}, subCtx)
+
+	// The order of a derived table is meaningless without LIMIT, so the SORT
+	// is dropped.
+	limitNode.Limit = nil
+	limitNode.Offset = nil
+	root.Children[0] = nodeID
+
+	rootTag := root.BindingTags[0]
+	for i, pred := range preds {
+		preds[i] = builder.pullupThroughProj(subCtx, root, rootTag, pred)
+	}
+
+	return rootID, preds, true, nil
+}
+
+// lateralEqualityKeys returns the inner side of the correlated predicates, it
+// fails if any of them is not an equality between inner and correlated columns.
+func lateralEqualityKeys(preds []*plan.Expr) ([]*plan.Expr, bool) {
+	keys := make([]*plan.Expr, 0, len(preds))
+	for _, pred := range preds {
+		fn, ok := pred.Expr.(*plan.Expr_F)
+		if !ok || fn.F.Func.ObjName != "=" || len(fn.F.Args) != 2 {
+			return nil, false
+		}
+
+		left, right := fn.F.Args[0], fn.F.Args[1]
+		switch {
+		case !hasCorrCol(left) && onlyCorrCols(right):
+			keys = append(keys, left)
+		case !hasCorrCol(right) && onlyCorrCols(left):
+			keys = append(keys, right)
+		default:
+			return nil, false
+		}
+	}
+	return keys, true
+}
+
+// onlyCorrCols reports whether expr references no column other than the
+// correlated ones.
+func onlyCorrCols(expr *plan.Expr) bool {
+	switch exprImpl := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		return false
+
+	case *plan.Expr_F:
+		for _, arg := range exprImpl.F.Args {
+			if !onlyCorrCols(arg) {
+				return false
+			}
+		}
+		return true
+
+	default:
+		return true
+	}
+}
+
+// nodeHasCorrCol reports whether the node has correlated columns other than
+// in the filters of a FILTER node.
+func nodeHasCorrCol(node *plan.Node) bool {
+	exprLists := [][]*plan.Expr{node.ProjectList, node.OnList, node.GroupBy, node.AggList, node.TblFuncExprList}
+	if node.NodeType != plan.Node_FILTER {
+		exprLists = append(exprLists, node.FilterList)
+	}
+	for _, exprs := range exprLists {
+		for _, expr := range exprs {
+			if hasCorrCol(expr) {
+				return true
+			}
+		}
+	}
+
+	for _, orderBy := range node.OrderBy {
+		if hasCorrCol(orderBy.Expr) {
+			return true
+		}
+	}
+
+	for _, expr := range node.WinSpecList {
+		w := expr.GetW()
+		if w == nil {
+			continue
+		}
+		if w.WindowFunc != nil && hasCorrCol(w.WindowFunc) {
+			return true
+		}
+		for _, p := range w.PartitionBy {
+			if hasCorrCol(p) {
+				return true
+			}
+		}
+		for _, orderBy := range w.OrderBy {
+			if hasCorrCol(orderBy.Expr) {
+				return true
+			}
+		}
+	}
+
+	return (node.Limit != nil && hasCorrCol(node.Limit)) || (node.Offset != nil && hasCorrCol(node.Offset))
+}
+
+func isConstTrueExpr(expr *plan.Expr) bool {
+	lit, ok := expr.Expr.(*plan.Expr_Lit)
+	if !ok || lit.Lit.Isnull {
+		return false
+	}
+	bval, ok := lit.Lit.Value.(*plan.Literal_Bval)
+	return ok && bval.Bval
+}
+
+// lateralParamPos maps the ith correlated column of a LATERAL derived table to
+// a negative parameter position, which doesn't clash with the parameters of a
+// prepared statement.
+func lateralParamPos(i int32) int32 {
+	return -i - 1
+}
+
+// BindLateralParams replaces the parameters of the correlated columns in the
+// plan of a LATERAL derived table with their values.
+func BindLateralParams(qry *plan.Query, vals []*plan.Literal) error {
+	bind := func(expr *plan.Expr) (*plan.Expr, error) {
+		p, ok := expr.Expr.(*plan.Expr_P)
+		if !ok || p.P.Pos >= 0 {
+			return expr, nil
+		}
+		return &plan.Expr{
+			Typ: expr.Typ,
+			Expr: &plan.Expr_Lit{
+				Lit: vals[lateralParamPos(p.P.Pos)],
+			},
+		}, nil
+	}
+	for _, node := range qry.Nodes {
+		err := rewriteNodeExprs(node, func(expr *plan.Expr) (*plan.Expr, error) {
+			return rewriteExprLeaves(expr, bind)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// replaceCorrCols replaces the correlated columns in expr with fn(col).
+func replaceCorrCols(expr *plan.Expr, fn func(*plan.Expr) (*plan.Expr, error)) (*plan.Expr, error) {
+	return rewriteExprLeaves(expr, func(e *plan.Expr) (*plan.Expr, error) {
+		if _, ok := e.Expr.(*plan.Expr_Corr); ok {
+			return fn(e)
+		}
+		return e, nil
+	})
+}
+
+// rewriteExprLeaves replaces the expressions in expr other than functions,
+// lists and windows with fn(expr).
+func rewriteExprLeaves(expr *plan.Expr, fn func(*plan.Expr) (*plan.Expr, error)) (*plan.Expr, error) {
+	var err error
+	switch exprImpl := expr.Expr.(type) {
+	case *plan.Expr_F:
+		for i, arg := range exprImpl.F.Args {
+			if exprImpl.F.Args[i], err = rewriteExprLeaves(arg, fn); err != nil {
+				return nil, err
+			}
+		}
+		return expr, nil
+
+	case *plan.Expr_List:
+		for i, arg := range exprImpl.List.List {
+			if exprImpl.List.List[i], err = rewriteExprLeaves(arg, fn); err != nil {
+				return nil, err
+			}
+		}
+		return expr, nil
+
+	case *plan.Expr_W:
+		w := exprImpl.W
+		if w.WindowFunc != nil {
+			if w.WindowFunc, err = rewriteExprLeaves(w.WindowFunc, fn); err != nil {
+				return nil, err
+			}
+		}
+		for i, p := range w.PartitionBy {
+			if w.PartitionBy[i], err = rewriteExprLeaves(p, fn); err != nil {
+				return nil, err
+			}
+		}
+		for _, orderBy := range w.OrderBy {
+			if orderBy.Expr, err = rewriteExprLeaves(orderBy.Expr, fn); err != nil {
+				return nil, err
+			}
+		}
+		return expr, nil
+
+	default:
+		return fn(expr)
+	}
+}
+
+// rewriteNodeExprs replaces every expression of the node with fn(expr).
+func rewriteNodeExprs(node *plan.Node, fn func(*plan.Expr) (*plan.Expr, error)) error {
+	var err error
+	exprLists := [][]*plan.Expr{
+		node.ProjectList, node.OnList, node.FilterList, node.BlockFilterList,
+		node.GroupBy, node.GroupingSet, node.AggList, node.WinSpecList,
+		node.TblFuncExprList, node.FillVal, node.OnUpdateExprs,
+	}
+	for _, exprs := range exprLists {
+		for i, expr := range exprs {
+			if exprs[i], err = fn(expr); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, orderBy := range node.OrderBy {
+		if orderBy.Expr, err = fn(orderBy.Expr); err != nil {
+			return err
+		}
+	}
+
+	for _, expr := range []**plan.Expr{&node.Limit, &node.Offset, &node.Interval, &node.Sliding} {
+		if *expr != nil {
+			if *expr, err = fn(*expr); err != nil {
+				return err
+			}
+		}
+	}
+
+	if node.RowsetData != nil {
+		for _, col := range node.RowsetData.Cols {
+			for _, data := range col.Data {
+				if data.Expr, err = fn(data.Expr); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
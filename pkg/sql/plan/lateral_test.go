@@ -0,0 +1,71 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/stretchr/testify/require"
+)
+
+// countLateralParams counts the parameters of the correlated columns in qry.
+func countLateralParams(t *testing.T, qry *plan.Query) int {
+	var cnt int
+	for _, node := range qry.Nodes {
+		err := rewriteNodeExprs(node, func(expr *plan.Expr) (*plan.Expr, error) {
+			return rewriteExprLeaves(expr, func(e *plan.Expr) (*plan.Expr, error) {
+				if p, ok := e.Expr.(*plan.Expr_P); ok && p.P.Pos < 0 {
+					cnt++
+				}
+				return e, nil
+			})
+		})
+		require.NoError(t, err)
+	}
+	return cnt
+}
+
+func TestLateralApply(t *testing.T) {
+	mock := NewMockOptimizer(false)
+
+	pn, err := runOneStmt(mock, t, "select n_name, r.c from nation, lateral (select count(*) c from region where r_regionkey = nation.n_regionkey) r")
+	require.NoError(t, err)
+
+	qry := pn.GetQuery()
+	var apply, scan *plan.Node
+	for _, node := range qry.Nodes {
+		if node.NodeType == plan.Node_APPLY {
+			apply = node
+			scan = qry.Nodes[node.Children[1]]
+		}
+	}
+	require.NotNil(t, apply)
+	require.Equal(t, plan.Node_INNER, apply.JoinType)
+	require.Equal(t, plan.Node_FUNCTION_SCAN, scan.NodeType)
+	require.Equal(t, lateralFuncName, scan.TableDef.TblFunc.Name)
+	require.Equal(t, 1, len(scan.TableDef.Cols))
+	// the only argument is nation.n_regionkey
+	require.Equal(t, 1, len(scan.TblFuncExprList))
+	require.NotNil(t, scan.TblFuncExprList[0].GetCol())
+
+	lateralQry := &plan.Query{}
+	require.NoError(t, lateralQry.Unmarshal(scan.TableDef.TblFunc.Param))
+	require.NotZero(t, countLateralParams(t, lateralQry))
+
+	err = BindLateralParams(lateralQry, []*plan.Literal{{Value: &plan.Literal_I32Val{I32Val: 1}}})
+	require.NoError(t, err)
+	require.Equal(t, 0, countLateralParams(t, lateralQry))
+}
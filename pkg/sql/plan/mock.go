@@ -39,6 +39,8 @@ type MockCompilerContext struct {
 	id2name         map[uint64]string
 	isDml           bool
 	mysqlCompatible bool
+	// triggers of the tables, keyed by the table name
+	triggers map[string][]*TriggerDef
 
 	// ctx default: nil
 	ctx context.Context
@@ -123,6 +125,7 @@ type index struct {
 	parts      []string
 	cols       []col
 	tableExist bool
+	algo       string
 }
 
 // NewEmptyCompilerContext for test create/drop statement
@@ -687,6 +690,102 @@ func NewMockCompilerContext(isDml bool) *MockCompilerContext {
 		outcnt: 12,
 	}
 
+	constraintTestSchema["articles"] = &Schema{
+		cols: []col{
+			{"id", types.T_int64, false, 64, 0},
+			{"title", types.T_varchar, true, 200, 0},
+			{"body", types.T_text, true, 0, 0},
+			{catalog.Row_ID, types.T_Rowid, false, 16, 0},
+		},
+		pks: []int{0},
+		idxs: []index{
+			{
+				indexName: "ft_title_body",
+				tableName: catalog.SecondaryIndexTableNamePrefix + "0191a8b2-5f47-7c1e-9a3e-0c2b4e8f1d10",
+				parts:     []string{"title", "body"},
+				cols: []col{
+					{catalog.FullTextIndexTableKeyColName, types.T_varchar, false, 65535, 0},
+				},
+				tableExist: true,
+				algo:       catalog.MOIndexFullTextAlgo.ToString(),
+			},
+		},
+		outcnt: 8,
+	}
+
+	constraintTestSchema[catalog.SecondaryIndexTableNamePrefix+"0191a8b2-5f47-7c1e-9a3e-0c2b4e8f1d10"] = &Schema{
+		cols: []col{
+			{catalog.FullTextIndexTableKeyColName, types.T_varchar, false, 65535, 0},
+			{catalog.FullTextIndexTableDocIdColName, types.T_int64, true, 64, 0},
+			{catalog.FullTextIndexTableWordColName, types.T_varchar, true, 65535, 0},
+			{catalog.FullTextIndexTablePosColName, types.T_int32, true, 32, 0},
+			{catalog.Row_ID, types.T_Rowid, false, 16, 0},
+		},
+		pks:    []int{0},
+		outcnt: 40,
+	}
+
+	/*
+		create table contacts(
+			id bigint primary key,
+			email varchar(100),
+			notes text,
+			unique key ((lower(email))),
+			key (notes(10))
+		);
+	*/
+	constraintTestSchema["contacts"] = &Schema{
+		cols: []col{
+			{"id", types.T_int64, false, 64, 0},
+			{"email", types.T_varchar, true, 100, 0},
+			{"notes", types.T_text, true, 0, 0},
+			{catalog.Row_ID, types.T_Rowid, false, 16, 0},
+		},
+		pks: []int{0},
+		idxs: []index{
+			{
+				indexName: "functional_index",
+				tableName: catalog.UniqueIndexTableNamePrefix + "0191a8b2-6c1d-7e2f-8a3b-4c5d6e7f8091",
+				parts:     []string{catalog.CreateExprKeyPart("lower(email)")},
+				cols: []col{
+					{catalog.IndexTableIndexColName, types.T_varchar, true, 100, 0},
+				},
+				tableExist: true,
+				unique:     true,
+			},
+			{
+				indexName: "notes",
+				tableName: catalog.SecondaryIndexTableNamePrefix + "0191a8b2-6c1d-7e2f-8a3b-4c5d6e7f8092",
+				parts:     []string{catalog.CreatePrefixKeyPart("notes", 10, false), catalog.CreateAlias("id")},
+				cols: []col{
+					{catalog.IndexTableIndexColName, types.T_varchar, true, 65535, 0},
+				},
+				tableExist: true,
+			},
+		},
+		outcnt: 10,
+	}
+
+	constraintTestSchema[catalog.UniqueIndexTableNamePrefix+"0191a8b2-6c1d-7e2f-8a3b-4c5d6e7f8091"] = &Schema{
+		cols: []col{
+			{catalog.IndexTableIndexColName, types.T_varchar, true, 100, 0},
+			{catalog.IndexTablePrimaryColName, types.T_int64, true, 64, 0},
+			{catalog.Row_ID, types.T_Rowid, false, 16, 0},
+		},
+		pks:    []int{0},
+		outcnt: 10,
+	}
+
+	constraintTestSchema[catalog.SecondaryIndexTableNamePrefix+"0191a8b2-6c1d-7e2f-8a3b-4c5d6e7f8092"] = &Schema{
+		cols: []col{
+			{catalog.IndexTableIndexColName, types.T_varchar, true, 65535, 0},
+			{catalog.IndexTablePrimaryColName, types.T_int64, true, 64, 0},
+			{catalog.Row_ID, types.T_Rowid, false, 16, 0},
+		},
+		pks:    []int{0},
+		outcnt: 10,
+	}
+
 	constraintTestSchema["t1"] = &Schema{
 		cols: []col{
 			{"a", types.T_int64, false, 0, 0},
@@ -781,6 +880,7 @@ func NewMockCompilerContext(isDml bool) *MockCompilerContext {
 						Unique:         idx.unique,
 						IndexTableName: idx.tableName,
 						TableExist:     true,
+						IndexAlgo:      idx.algo,
 					}
 					tableDef.Indexes[i] = indexdef
 				}
@@ -919,6 +1019,7 @@ func (m *MockCompilerContext) Resolve(dbName string, tableName string, snapshot
 	}
 	if tableDef != nil {
 		tableDef.DbName = dbName
+		tableDef.Triggers = m.triggers[name]
 	}
 	return m.objects[name], tableDef
 }
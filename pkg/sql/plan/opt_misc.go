@@ -170,6 +170,16 @@ func replaceColumnsForNode(node *plan.Node, projMap map[[2]int32]*plan.Expr) {
 	for i := range node.OrderBy {
 		node.OrderBy[i].Expr = replaceColumnsForExpr(node.OrderBy[i].Expr, projMap)
 	}
+	// the primary key to lock is referred by the binding tag of the lock node
+	if node.NodeType == plan.Node_LOCK_OP && len(node.BindingTags) > 1 {
+		target := node.LockTargets[0]
+		if expr, ok := projMap[[2]int32{node.BindingTags[1], target.PrimaryColIdxInBat}]; ok {
+			if col := expr.GetCol(); col != nil {
+				node.BindingTags[1] = col.RelPos
+				target.PrimaryColIdxInBat = col.ColPos
+			}
+		}
+	}
 }
 
 func replaceColumnsForExprList(exprList []*plan.Expr, projMap map[[2]int32]*plan.Expr) {
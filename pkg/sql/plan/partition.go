@@ -688,7 +688,7 @@ func checkPartitionKeys(ctx context.Context, nameByColRef map[[2]int32]string,
 				}
 				if !checkUniqueKeyIncludePartKey(partitionKeys, uniqueKeys) {
 					//return moerr.NewInvalidInput(ctx, "partition key is not part of unique key")
-					return moerr.NewUniqueKeyNeedAllFieldsInPf(ctx, "PRIMARY KEY")
+					return moerr.NewUniqueKeyNeedAllFieldsInPf(ctx, "UNIQUE INDEX")
 				}
 			}
 		}
@@ -467,7 +467,17 @@ func (builder *QueryBuilder) pushdownFilters(nodeID int32, filters []*plan.Expr,
 		childId, _ = builder.pushdownFilters(childId, downFilters, separateNonEquiConds)
 		node.Children[0] = childId
 	case plan.Node_APPLY:
-		childID, cantPushdownChild := builder.pushdownFilters(node.Children[0], filters, separateNonEquiConds)
+		// the filters on the table function are evaluated after the apply
+		rightTag := builder.qry.Nodes[node.Children[1]].BindingTags[0]
+		for _, filter := range filters {
+			if containsTag(filter, rightTag) {
+				cantPushdown = append(cantPushdown, filter)
+			} else {
+				canPushdown = append(canPushdown, filter)
+			}
+		}
+
+		childID, cantPushdownChild := builder.pushdownFilters(node.Children[0], canPushdown, separateNonEquiConds)
 
 		if len(cantPushdownChild) > 0 {
 			childID = builder.appendNode(&plan.Node{
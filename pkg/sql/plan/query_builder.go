@@ -30,6 +30,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/lock"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
@@ -1387,7 +1388,8 @@ func (builder *QueryBuilder) remapAllColRefs(nodeID int32, step int32, colRefCnt
 	case plan.Node_APPLY:
 		internalMap := make(map[[2]int32][2]int32)
 
-		for _, expr := range node.TblFuncExprList {
+		right := builder.qry.Nodes[node.Children[1]]
+		for _, expr := range right.TblFuncExprList {
 			increaseRefCnt(expr, 1, colRefCnt)
 		}
 
@@ -1420,7 +1422,6 @@ func (builder *QueryBuilder) remapAllColRefs(nodeID int32, step int32, colRefCnt
 			})
 		}
 
-		right := builder.qry.Nodes[node.Children[1]]
 		rightTag := right.BindingTags[0]
 		for i, col := range right.TableDef.Cols {
 			globalRef := [2]int32{rightTag, int32(i)}
@@ -2169,8 +2170,8 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 	astLimit := stmt.Limit
 	astTimeWindow := stmt.TimeWindow
 
-	if stmt.SelectLockInfo != nil && stmt.SelectLockInfo.LockType == tree.SelectLockForUpdate {
-		builder.isForUpdate = true
+	if stmt.SelectLockInfo != nil {
+		builder.forUpdateMode, builder.forUpdatePolicy, builder.isForUpdate = getSelectLockOptions(stmt.SelectLockInfo.LockType)
 	}
 
 	// strip parentheses
@@ -2219,6 +2220,7 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 	var resultLen int
 	var havingBinder *HavingBinder
 	var lockNode *plan.Node
+	var lockUnderLimit bool
 	var notCacheable bool
 
 	if clause == nil {
@@ -2349,6 +2351,8 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 				Block:              true,
 				RefreshTsIdxInBat:  -1, //unsupport now
 				FilterColIdxInBat:  -1, //unsupport now
+				Mode:               builder.forUpdateMode,
+				WaitPolicy:         builder.forUpdatePolicy,
 			}
 			lockNode = &Node{
 				NodeType:    plan.Node_LOCK_OP,
@@ -2686,8 +2690,18 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 			}
 		}
 		if builder.isForUpdate {
-			lockNode.Children[0] = nodeID
-			nodeID = builder.appendNode(lockNode, ctx)
+			// With SKIP LOCKED, the rows are locked after the sort and only the
+			// rows up to the limit are locked, so the concurrent workers get the
+			// disjoint sets of rows. The primary key is passed up to the lock node
+			// by a hidden projection.
+			lockUnderLimit = builder.forUpdatePolicy == lock.WaitPolicy_SkipLocked &&
+				lockNode.TableDef.Partition == nil &&
+				!ctx.isDistinct && len(ctx.windows) == 0 && len(ctx.times) == 0 &&
+				!ctx.sampleFunc.hasSampleFunc
+			if !lockUnderLimit {
+				lockNode.Children[0] = nodeID
+				nodeID = builder.appendNode(lockNode, ctx)
+			}
 		}
 	}
 
@@ -2905,6 +2919,21 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 
 	}
 
+	if lockUnderLimit {
+		target := lockNode.LockTargets[0]
+		ctx.projects = append(ctx.projects, &plan.Expr{
+			Typ: target.PrimaryColTyp,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: lockNode.BindingTags[1],
+					ColPos: target.PrimaryColIdxInBat,
+				},
+			},
+		})
+		lockNode.BindingTags[1] = ctx.projectTag
+		target.PrimaryColIdxInBat = int32(len(ctx.projects) - 1)
+	}
+
 	// append PROJECT node
 	for i, proj := range ctx.projects {
 		nodeID, proj, err = builder.flattenSubqueries(nodeID, proj, ctx)
@@ -2940,6 +2969,11 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 		}, ctx)
 	}
 
+	if lockUnderLimit {
+		lockNode.Children[0] = nodeID
+		nodeID = builder.appendNode(lockNode, ctx)
+	}
+
 	if limitExpr != nil || offsetExpr != nil {
 		node := builder.qry.Nodes[nodeID]
 
@@ -3767,7 +3801,14 @@ func (builder *QueryBuilder) buildTable(stmt tree.TableExpr, ctx *BindContext, p
 			}
 		}
 
-		nodeID, err = builder.buildTable(tbl.Expr, ctx, preNodeId, leftCtx)
+		if sel := lateralSubquery(tbl); sel != nil && leftCtx != nil && preNodeId >= 0 {
+			if tbl.As.Alias == "" {
+				return 0, moerr.NewSyntaxErrorf(builder.GetContext(), "subquery in FROM must have an alias: %T", stmt)
+			}
+			nodeID, err = builder.buildLateralSubquery(sel, ctx, leftCtx)
+		} else {
+			nodeID, err = builder.buildTable(tbl.Expr, ctx, preNodeId, leftCtx)
+		}
 		if err != nil {
 			return
 		}
@@ -3948,6 +3989,13 @@ func (builder *QueryBuilder) addBinding(nodeID int32, alias tree.AliasClause, ct
 
 		binding = NewBinding(tag, nodeID, node.TableDef.DbName, table, node.TableDef.TblId, cols, colIsHidden, types,
 			util.TableIsClusterTable(node.TableDef.TableType), defaultVals)
+		if node.NodeType == plan.Node_TABLE_SCAN && hasVirtualCols(node.TableDef) {
+			virtualCols, err := bindVirtualCols(builder, node.TableDef, tag)
+			if err != nil {
+				return err
+			}
+			binding.virtualCols = virtualCols
+		}
 	} else {
 		// Subquery
 		subCtx := builder.ctxByNode[nodeID]
@@ -4030,6 +4078,15 @@ func (builder *QueryBuilder) buildJoinTable(tbl *tree.JoinTableExpr, ctx *BindCo
 		joinType = plan.Node_OUTER
 	}
 
+	if isLateralTableFunction(tbl.Right) {
+		return builder.buildLateralTableFunction(tbl, ctx)
+	}
+
+	isLateral := lateralSubquery(tbl.Right) != nil
+	if isLateral && joinType != plan.Node_INNER && joinType != plan.Node_LEFT {
+		return 0, moerr.NewNotSupported(builder.GetContext(), "LATERAL derived table can only be used in an inner or left join")
+	}
+
 	leftCtx := NewBindContext(builder, ctx)
 	rightCtx := NewBindContext(builder, ctx)
 
@@ -4048,6 +4105,20 @@ func (builder *QueryBuilder) buildJoinTable(tbl *tree.JoinTableExpr, ctx *BindCo
 	}
 	ctx.views = append(ctx.views, rightCtx.views...)
 
+	var lateralPreds []*plan.Expr
+	if isLateral {
+		var decorrelated bool
+		rightChildID, lateralPreds, decorrelated, err = builder.decorrelateLateral(rightChildID)
+		if err != nil {
+			return 0, err
+		}
+		if !decorrelated {
+			// the nodes of the subquery built above stay in the query unused,
+			// so the tables it scans are still checked for privileges.
+			return builder.buildLateralApply(tbl, joinType, leftChildID, leftCtx, ctx)
+		}
+	}
+
 	if builder.qry.Nodes[rightChildID].NodeType == plan.Node_FUNCTION_SCAN {
 		if joinType != plan.Node_INNER {
 			return 0, moerr.NewSyntaxError(builder.GetContext(), "table function can only be used in a inner join")
@@ -4125,6 +4196,7 @@ func (builder *QueryBuilder) buildJoinTable(tbl *tree.JoinTableExpr, ctx *BindCo
 			}
 		}
 	}
+	node.OnList = append(node.OnList, lateralPreds...)
 
 	return nodeID, nil
 }
@@ -4220,6 +4292,10 @@ func (builder *QueryBuilder) buildTableFunction(tbl *tree.TableFunction, ctx *Bi
 		nodeId, err = builder.buildMoCache(tbl, ctx, exprs, childId)
 	case "stage_list":
 		nodeId, err = builder.buildStageList(tbl, ctx, exprs, childId)
+	case fulltextIndexTokenizeFuncName:
+		nodeId, err = builder.buildFullTextIndexTokenize(tbl, ctx, exprs, childId)
+	case jsonTableFuncName:
+		nodeId, err = builder.buildJsonTable(tbl, ctx, exprs, childId)
 	default:
 		err = moerr.NewNotSupportedf(builder.GetContext(), "table function '%s' not supported", id)
 	}
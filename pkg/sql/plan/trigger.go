@@ -0,0 +1,206 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"context"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// TriggerKind is how a trigger body is executed.
+type TriggerKind int
+
+const (
+	// TriggerKindSet is a BEFORE trigger whose body only assigns the NEW
+	// columns, it is compiled into the projection of the DML.
+	TriggerKindSet TriggerKind = iota
+	// TriggerKindInsert is a trigger whose body only inserts single rows of
+	// values, it is compiled into the insert plans of the target table.
+	TriggerKindInsert
+	// TriggerKindProcedural is an AFTER trigger which is interpreted by the
+	// frontend for every transition row while the DML is running.
+	TriggerKindProcedural
+)
+
+// triggerTime returns the action time of the trigger definition.
+func triggerTime(def *TriggerDef) tree.TriggerTime {
+	if strings.EqualFold(def.Time, tree.TriggerTimeAfter.String()) {
+		return tree.TriggerTimeAfter
+	}
+	return tree.TriggerTimeBefore
+}
+
+// triggerEvent returns the event of the trigger definition.
+func triggerEvent(def *TriggerDef) tree.TriggerEvent {
+	switch strings.ToUpper(def.Event) {
+	case tree.TriggerEventUpdate.String():
+		return tree.TriggerEventUpdate
+	case tree.TriggerEventDelete.String():
+		return tree.TriggerEventDelete
+	}
+	return tree.TriggerEventInsert
+}
+
+// ClassifyTrigger checks the trigger body and returns how it is executed.
+//
+// The BEFORE triggers are compiled into the DML, so their body is either a
+// list of SET NEW.col = expr, maybe guarded by IF, or a list of INSERT ...
+// VALUES of a single row. The AFTER triggers may also have any other body,
+// which is interpreted by the frontend.
+func ClassifyTrigger(ctx context.Context, time tree.TriggerTime, event tree.TriggerEvent, body tree.Statement) (TriggerKind, error) {
+	if hasNewColAssign(body) {
+		if event == tree.TriggerEventDelete {
+			return 0, moerr.NewInvalidInput(ctx, "there is no NEW row in on DELETE trigger")
+		}
+		if time == tree.TriggerTimeAfter {
+			return 0, moerr.NewInvalidInput(ctx, "updating of NEW row is not allowed in after trigger")
+		}
+		if !isSetTriggerBody(body) {
+			return 0, moerr.NewNotSupported(ctx, "trigger body mixing SET NEW with other statements")
+		}
+		return TriggerKindSet, nil
+	}
+	if isInsertTriggerBody(body) {
+		return TriggerKindInsert, nil
+	}
+	if time == tree.TriggerTimeBefore {
+		return 0, moerr.NewNotSupported(ctx, "BEFORE trigger whose body is not SET NEW or INSERT ... VALUES")
+	}
+	return TriggerKindProcedural, nil
+}
+
+// getNewColAssign returns the column of SET NEW.col = expr.
+func getNewColAssign(assign *tree.VarAssignmentExpr) (string, bool) {
+	if !assign.System || assign.Global {
+		return "", false
+	}
+	name := strings.ToLower(assign.Name)
+	if !strings.HasPrefix(name, "new.") {
+		return "", false
+	}
+	return name[len("new."):], true
+}
+
+func hasNewColAssign(stmt tree.Statement) bool {
+	anyOf := func(stmts []tree.Statement) bool {
+		for _, s := range stmts {
+			if hasNewColAssign(s) {
+				return true
+			}
+		}
+		return false
+	}
+	switch st := stmt.(type) {
+	case *tree.SetVar:
+		for _, assign := range st.Assignments {
+			if _, ok := getNewColAssign(assign); ok {
+				return true
+			}
+		}
+	case *tree.CompoundStmt:
+		return anyOf(st.Stmts)
+	case *tree.IfStmt:
+		if anyOf(st.Body) || anyOf(st.Else) {
+			return true
+		}
+		for _, elif := range st.Elifs {
+			if anyOf(elif.Body) {
+				return true
+			}
+		}
+	case *tree.CaseStmt:
+		if anyOf(st.Else) {
+			return true
+		}
+		for _, when := range st.Whens {
+			if anyOf(when.Body) {
+				return true
+			}
+		}
+	case *tree.WhileStmt:
+		return anyOf(st.Body)
+	case *tree.RepeatStmt:
+		return anyOf(st.Body)
+	case *tree.LoopStmt:
+		return anyOf(st.Body)
+	}
+	return false
+}
+
+func isSetTriggerBody(stmt tree.Statement) bool {
+	allOf := func(stmts []tree.Statement) bool {
+		for _, s := range stmts {
+			if !isSetTriggerBody(s) {
+				return false
+			}
+		}
+		return true
+	}
+	switch st := stmt.(type) {
+	case nil:
+		// the empty statement of the compound statement
+		return true
+	case *tree.SetVar:
+		for _, assign := range st.Assignments {
+			if _, ok := getNewColAssign(assign); !ok {
+				return false
+			}
+		}
+		return true
+	case *tree.CompoundStmt:
+		return allOf(st.Stmts)
+	case *tree.IfStmt:
+		if !allOf(st.Body) || !allOf(st.Else) {
+			return false
+		}
+		for _, elif := range st.Elifs {
+			if !allOf(elif.Body) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func isInsertTriggerBody(stmt tree.Statement) bool {
+	switch st := stmt.(type) {
+	case *tree.Insert:
+		if _, ok := st.Table.(*tree.TableName); !ok {
+			return false
+		}
+		if st.Rows == nil || len(st.OnDuplicateUpdate) > 0 || len(st.PartitionNames) > 0 {
+			return false
+		}
+		values, ok := st.Rows.Select.(*tree.ValuesClause)
+		return ok && len(values.Rows) == 1 && values.Rows[0] != nil
+	case *tree.CompoundStmt:
+		inserts := 0
+		for _, s := range st.Stmts {
+			if s == nil {
+				continue
+			}
+			if !isInsertTriggerBody(s) {
+				return false
+			}
+			inserts++
+		}
+		return inserts > 0
+	}
+	return false
+}
@@ -0,0 +1,85 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"context"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// NewTriggerBinder binds the expressions of a trigger body. NEW.col and
+// OLD.col are bound to the copies of the expressions of the transition row,
+// newRow or oldRow is nil if the event has no such row.
+func NewTriggerBinder(sysCtx context.Context, builder *QueryBuilder, ctx *BindContext, event tree.TriggerEvent, newRow, oldRow map[string]*Expr) *TriggerBinder {
+	b := &TriggerBinder{event: event, newRow: newRow, oldRow: oldRow}
+	b.sysCtx = sysCtx
+	b.builder = builder
+	b.ctx = ctx
+	b.impl = b
+
+	return b
+}
+
+func (b *TriggerBinder) BindExpr(astExpr tree.Expr, depth int32, isRoot bool) (*plan.Expr, error) {
+	return b.baseBindExpr(astExpr, depth, isRoot)
+}
+
+func (b *TriggerBinder) BindColRef(astExpr *tree.UnresolvedName, depth int32, isRoot bool) (*plan.Expr, error) {
+	if astExpr.NumParts != 2 {
+		return nil, moerr.NewInvalidInputf(b.GetContext(), "column '%s' does not exist", astExpr.ColNameOrigin())
+	}
+
+	var row map[string]*Expr
+	switch strings.ToLower(astExpr.TblName()) {
+	case "new":
+		if b.newRow == nil {
+			return nil, moerr.NewInvalidInputf(b.GetContext(), "there is no NEW row in on %s trigger", b.event)
+		}
+		row = b.newRow
+	case "old":
+		if b.oldRow == nil {
+			return nil, moerr.NewInvalidInputf(b.GetContext(), "there is no OLD row in on %s trigger", b.event)
+		}
+		row = b.oldRow
+	default:
+		return nil, moerr.NewInvalidInputf(b.GetContext(), "column '%s' does not exist", astExpr.ColNameOrigin())
+	}
+
+	expr, ok := row[astExpr.ColName()]
+	if !ok {
+		return nil, moerr.NewInvalidInputf(b.GetContext(), "unknown column '%s' in '%s'", astExpr.ColNameOrigin(), strings.ToUpper(astExpr.TblName()))
+	}
+	return DeepCopyExpr(expr), nil
+}
+
+func (b *TriggerBinder) BindAggFunc(funcName string, astExpr *tree.FuncExpr, depth int32, isRoot bool) (*plan.Expr, error) {
+	return nil, moerr.NewInvalidInputf(b.GetContext(), "cannot bind agregate functions '%s'", funcName)
+}
+
+func (b *TriggerBinder) BindWinFunc(funcName string, astExpr *tree.FuncExpr, depth int32, isRoot bool) (*plan.Expr, error) {
+	return nil, moerr.NewInvalidInputf(b.GetContext(), "cannot bind window functions '%s'", funcName)
+}
+
+func (b *TriggerBinder) BindSubquery(astExpr *tree.Subquery, isRoot bool) (*plan.Expr, error) {
+	return nil, moerr.NewNotSupported(b.GetContext(), "subquery in trigger body")
+}
+
+func (b *TriggerBinder) BindTimeWindowFunc(funcName string, astExpr *tree.FuncExpr, depth int32, isRoot bool) (*plan.Expr, error) {
+	return nil, moerr.NewInvalidInputf(b.GetContext(), "cannot bind time window functions '%s'", funcName)
+}
@@ -0,0 +1,114 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+func TestClassifyTrigger(t *testing.T) {
+	ctx := context.Background()
+	cases := []struct {
+		time  tree.TriggerTime
+		event tree.TriggerEvent
+		body  string
+		kind  TriggerKind
+		code  uint16
+	}{
+		{tree.TriggerTimeBefore, tree.TriggerEventInsert, "set new.a = new.a + 1", TriggerKindSet, 0},
+		{tree.TriggerTimeBefore, tree.TriggerEventUpdate, "begin if new.a > 10 then set new.a = 10; else set new.b = old.b; end if; end", TriggerKindSet, 0},
+		{tree.TriggerTimeBefore, tree.TriggerEventDelete, "set new.a = 1", 0, moerr.ErrInvalidInput},
+		{tree.TriggerTimeAfter, tree.TriggerEventInsert, "set new.a = 1", 0, moerr.ErrInvalidInput},
+		{tree.TriggerTimeBefore, tree.TriggerEventInsert, "begin set new.a = 1; insert into t2 values (new.a); end", 0, moerr.ErrNotSupported},
+		{tree.TriggerTimeAfter, tree.TriggerEventInsert, "insert into t2 values (new.a, now())", TriggerKindInsert, 0},
+		{tree.TriggerTimeBefore, tree.TriggerEventDelete, "begin insert into t2 values (old.a); insert into t3(a) values (old.a); end", TriggerKindInsert, 0},
+		{tree.TriggerTimeAfter, tree.TriggerEventInsert, "insert into t2 values (new.a), (new.b)", TriggerKindProcedural, 0},
+		{tree.TriggerTimeAfter, tree.TriggerEventUpdate, "update t2 set a = new.a where b = old.b", TriggerKindProcedural, 0},
+		{tree.TriggerTimeBefore, tree.TriggerEventUpdate, "update t2 set a = new.a where b = old.b", 0, moerr.ErrNotSupported},
+	}
+	for _, c := range cases {
+		body, err := parsers.ParseOne(ctx, dialect.MYSQL, c.body, 1)
+		require.NoError(t, err, c.body)
+		kind, err := ClassifyTrigger(ctx, c.time, c.event, body)
+		if c.code != 0 {
+			require.True(t, moerr.IsMoErrCode(err, c.code), "%s: %v", c.body, err)
+			continue
+		}
+		require.NoError(t, err, c.body)
+		require.Equal(t, c.kind, kind, c.body)
+	}
+}
+
+func TestDmlWithTriggers(t *testing.T) {
+	mock := NewMockOptimizer(true)
+	mock.ctxt.triggers = map[string][]*TriggerDef{
+		"nation": {
+			{Name: "t1", Time: "BEFORE", Event: "INSERT", Body: "set new.n_comment = concat(new.n_name, '!')"},
+			{Name: "t2", Time: "BEFORE", Event: "INSERT", Body: "begin if new.n_regionkey > 10 then set new.n_regionkey = 10; end if; end"},
+			{Name: "t3", Time: "AFTER", Event: "INSERT", Body: "insert into nation2 values (new.n_nationkey, new.n_name, new.n_regionkey, new.n_comment)"},
+			{Name: "t4", Time: "BEFORE", Event: "UPDATE", Body: "set new.n_comment = old.n_name"},
+			{Name: "t5", Time: "AFTER", Event: "UPDATE", Body: "begin declare x int; set x = new.n_regionkey; end"},
+			{Name: "t6", Time: "AFTER", Event: "DELETE", Body: "insert into nation2 (n_nationkey, n_name, r_regionkey) values (old.n_nationkey, old.n_name, old.n_regionkey)"},
+		},
+	}
+
+	sqls := []string{
+		"INSERT INTO NATION VALUES (1, 'NAME1', 21, 'COMMENT1'), (2, 'NAME2', 22, 'COMMENT2')",
+		"INSERT INTO NATION SELECT * FROM NATION2",
+		"UPDATE NATION SET N_NAME = 'U1' WHERE N_NATIONKEY > 10",
+		"UPDATE NATION SET N_REGIONKEY = N_REGIONKEY + 2",
+		"DELETE FROM NATION WHERE N_NATIONKEY > 10",
+		"DELETE FROM NATION",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	logicPlan, err := runOneStmt(mock, t, "UPDATE NATION SET N_NAME = 'U1'")
+	require.NoError(t, err)
+	require.Len(t, logicPlan.GetQuery().RowTriggers, 1)
+	require.Equal(t, "t5", logicPlan.GetQuery().RowTriggers[0].TriggerName)
+
+	sqls = []string{
+		"INSERT INTO NATION VALUES (1, 'NAME1', 21, 'COMMENT1') ON DUPLICATE KEY UPDATE N_NAME = 'U1'",
+		"REPLACE INTO NATION VALUES (1, 'NAME1', 21, 'COMMENT1')",
+	}
+	runTestShouldError(mock, t, sqls)
+
+	// the trigger can not write the table which fires it
+	mock.ctxt.triggers = map[string][]*TriggerDef{
+		"nation": {
+			{Name: "t1", Time: "AFTER", Event: "INSERT", Body: "insert into nation2 values (new.n_nationkey, new.n_name, new.n_regionkey, new.n_comment)"},
+		},
+		"nation2": {
+			{Name: "t2", Time: "AFTER", Event: "INSERT", Body: "insert into nation values (new.n_nationkey, new.n_name, new.r_regionkey, new.n_comment)"},
+		},
+	}
+	runTestShouldError(mock, t, []string{"INSERT INTO NATION VALUES (1, 'NAME1', 21, 'COMMENT1')"})
+
+	// OLD is not in the INSERT trigger
+	mock.ctxt.triggers = map[string][]*TriggerDef{
+		"nation": {
+			{Name: "t1", Time: "BEFORE", Event: "INSERT", Body: "set new.n_comment = old.n_name"},
+		},
+	}
+	runTestShouldError(mock, t, []string{"INSERT INTO NATION VALUES (1, 'NAME1', 21, 'COMMENT1')"})
+}
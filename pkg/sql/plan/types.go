@@ -20,6 +20,7 @@ import (
 	"math"
 	"strings"
 
+	"github.com/matrixorigin/matrixone/pkg/pb/lock"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	pb "github.com/matrixorigin/matrixone/pkg/pb/statsinfo"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
@@ -71,6 +72,7 @@ type ForeignKeyDef = plan.ForeignKeyDef
 type ClusterTable = plan.ClusterTable
 type PrimaryKeyDef = plan.PrimaryKeyDef
 type IndexDef = plan.IndexDef
+type TriggerDef = plan.TriggerDef
 type SubscriptionMeta = plan.SubscriptionMeta
 type Snapshot = plan.Snapshot
 type SnapshotTenant = plan.SnapshotTenant
@@ -177,9 +179,13 @@ type QueryBuilder struct {
 	mysqlCompatible    bool
 	haveOnDuplicateKey bool // if it's a plan contain onduplicate key node, we can not use some optmize rule
 	isForUpdate        bool // if it's a query plan for update
+	forUpdateMode      lock.LockMode
+	forUpdatePolicy    lock.WaitPolicy
 	isRestore          bool
 
-	deleteNode     map[uint64]int32 //delete node in this query. key is tableId, value is the nodeId of sinkScan node in the delete plan
+	deleteNode     map[uint64]int32      //delete node in this query. key is tableId, value is the nodeId of sinkScan node in the delete plan
+	triggerTables  []uint64              // the tables whose triggers are being compiled, they can not be written by the triggers
+	fulltextScores map[string]*plan.Expr // score columns of the fulltext index scans, keyed by the table tag and the search
 	skipStats      bool
 	optimizerHints *OptimizerHints
 }
@@ -339,6 +345,13 @@ type UpdateBinder struct {
 	cols []*ColDef
 }
 
+type TriggerBinder struct {
+	baseBinder
+	event  tree.TriggerEvent
+	newRow map[string]*Expr
+	oldRow map[string]*Expr
+}
+
 type TableBinder struct {
 	baseBinder
 }
@@ -411,6 +424,9 @@ type Binding struct {
 	colIdByName    map[string]int32
 	isClusterTable bool
 	defaults       []string
+	// virtualCols computes the virtual generated columns of a table scan
+	// from its other columns, by the position of the column.
+	virtualCols map[int32]*plan.Expr
 }
 
 const (
@@ -25,6 +25,7 @@ import (
 	"slices"
 	"strings"
 
+	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
@@ -1389,7 +1390,8 @@ func InitInfileParam(param *tree.ExternParam) error {
 			param.CompressType = param.Option[i+1]
 		case "format":
 			format := strings.ToLower(param.Option[i+1])
-			if format != tree.CSV && format != tree.JSONLINE && format != tree.PARQUET {
+			if format != tree.CSV && format != tree.JSONLINE && format != tree.PARQUET &&
+				format != tree.ORC && format != tree.AVRO {
 				return moerr.NewBadConfigf(param.Ctx, "the format '%s' is not supported", format)
 			}
 			param.Format = format
@@ -1711,7 +1713,8 @@ func GetUniqueColAndIdxFromTableDef(tableDef *TableDef) []map[string]int {
 	}
 
 	for _, index := range tableDef.Indexes {
-		if index.Unique {
+		// the unique key with expression key parts is only checked by its index table
+		if index.Unique && !catalog.HasExprKeyPart(index.Parts) {
 			pkMap := make(map[string]int)
 			for _, part := range index.Parts {
 				pkMap[part] = int(tableDef.Name2ColIndex[part])
@@ -0,0 +1,327 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package avro
+
+import (
+	"encoding/binary"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+func errCorrupted(what string) error {
+	return moerr.NewInvalidInputNoCtxf("corrupted avro file, invalid %s", what)
+}
+
+// decoder decodes the values of the binary encoding.
+type decoder struct {
+	buf []byte
+	pos int
+}
+
+func (d *decoder) remaining() int {
+	return len(d.buf) - d.pos
+}
+
+func (d *decoder) readLong() (int64, error) {
+	v, n := binary.Varint(d.buf[d.pos:])
+	if n <= 0 {
+		return 0, errCorrupted("long")
+	}
+	d.pos += n
+	return v, nil
+}
+
+func (d *decoder) readLength() (int, error) {
+	v, err := d.readLong()
+	if err != nil {
+		return 0, err
+	}
+	if v < 0 || v > int64(d.remaining()) {
+		return 0, errCorrupted("length")
+	}
+	return int(v), nil
+}
+
+func (d *decoder) readFixed(n int) ([]byte, error) {
+	if n < 0 || n > d.remaining() {
+		return nil, errCorrupted("length")
+	}
+	b := d.buf[d.pos : d.pos+n]
+	d.pos += n
+	return b, nil
+}
+
+func (d *decoder) readBytes() ([]byte, error) {
+	n, err := d.readLength()
+	if err != nil {
+		return nil, err
+	}
+	return d.readFixed(n)
+}
+
+// readBlockCount returns the number of the items in the next block of an
+// array or a map. A negative count is followed by the size of the block.
+func (d *decoder) readBlockCount() (int64, error) {
+	count, err := d.readLong()
+	if err != nil {
+		return 0, err
+	}
+	if count < 0 {
+		if _, err = d.readLong(); err != nil {
+			return 0, err
+		}
+		count = -count
+	}
+	return count, nil
+}
+
+// decode decodes a value of the schema. The primitive values are decoded
+// into bool, int32, int64, float32, float64, []byte and string, records and
+// maps into map[string]any, arrays into []any, and enums into their symbols.
+// The logical types are decoded into:
+//   - date, timestamp-*: time.Time in UTC
+//   - local-timestamp-*: time.Time in UTC holding the local wall time
+//   - time-*: time.Duration since midnight
+//   - decimal: the decimal string
+func (d *decoder) decode(s *Schema) (any, error) {
+	switch s.Type {
+	case Null:
+		return nil, nil
+	case Boolean:
+		b, err := d.readFixed(1)
+		if err != nil {
+			return nil, err
+		}
+		return b[0] != 0, nil
+	case Int:
+		v, err := d.readLong()
+		if err != nil {
+			return nil, err
+		}
+		switch s.LogicalType {
+		case LogicalDate:
+			return time.Unix(v*86400, 0).UTC(), nil
+		case LogicalTimeMillis:
+			return time.Duration(v) * time.Millisecond, nil
+		}
+		return int32(v), nil
+	case Long:
+		v, err := d.readLong()
+		if err != nil {
+			return nil, err
+		}
+		switch s.LogicalType {
+		case LogicalTimeMicros:
+			return time.Duration(v) * time.Microsecond, nil
+		case LogicalTimestampMillis, LogicalLocalTimestampMilli:
+			return time.UnixMilli(v).UTC(), nil
+		case LogicalTimestampMicros, LogicalLocalTimestampMicro:
+			return time.UnixMicro(v).UTC(), nil
+		}
+		return v, nil
+	case Float:
+		b, err := d.readFixed(4)
+		if err != nil {
+			return nil, err
+		}
+		return math.Float32frombits(binary.LittleEndian.Uint32(b)), nil
+	case Double:
+		b, err := d.readFixed(8)
+		if err != nil {
+			return nil, err
+		}
+		return math.Float64frombits(binary.LittleEndian.Uint64(b)), nil
+	case Bytes, Fixed:
+		var b []byte
+		var err error
+		if s.Type == Bytes {
+			b, err = d.readBytes()
+		} else {
+			b, err = d.readFixed(s.Size)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if s.LogicalType == LogicalDecimal {
+			return decimalString(b, s.Scale), nil
+		}
+		return append([]byte(nil), b...), nil
+	case String:
+		b, err := d.readBytes()
+		if err != nil {
+			return nil, err
+		}
+		return string(b), nil
+	case Enum:
+		v, err := d.readLong()
+		if err != nil {
+			return nil, err
+		}
+		if v < 0 || v >= int64(len(s.Symbols)) {
+			return nil, errCorrupted("enum")
+		}
+		return s.Symbols[v], nil
+	case Union:
+		v, err := d.readLong()
+		if err != nil {
+			return nil, err
+		}
+		if v < 0 || v >= int64(len(s.Branches)) {
+			return nil, errCorrupted("union")
+		}
+		return d.decode(s.Branches[v])
+	case Record:
+		obj := make(map[string]any, len(s.Fields))
+		for _, f := range s.Fields {
+			v, err := d.decode(f.Schema)
+			if err != nil {
+				return nil, err
+			}
+			obj[f.Name] = v
+		}
+		return obj, nil
+	case Array:
+		list := make([]any, 0)
+		for {
+			count, err := d.readBlockCount()
+			if err != nil {
+				return nil, err
+			}
+			if count == 0 {
+				return list, nil
+			}
+			for ; count > 0; count-- {
+				v, err := d.decode(s.Items)
+				if err != nil {
+					return nil, err
+				}
+				list = append(list, v)
+			}
+		}
+	case Map:
+		obj := make(map[string]any)
+		for {
+			count, err := d.readBlockCount()
+			if err != nil {
+				return nil, err
+			}
+			if count == 0 {
+				return obj, nil
+			}
+			for ; count > 0; count-- {
+				k, err := d.readBytes()
+				if err != nil {
+					return nil, err
+				}
+				v, err := d.decode(s.Items)
+				if err != nil {
+					return nil, err
+				}
+				obj[string(k)] = v
+			}
+		}
+	}
+	return nil, moerr.NewNYINoCtxf("avro type %s", s.Type)
+}
+
+// skip skips a value of the schema without decoding it.
+func (d *decoder) skip(s *Schema) error {
+	var err error
+	switch s.Type {
+	case Null:
+	case Boolean:
+		_, err = d.readFixed(1)
+	case Int, Long, Enum:
+		_, err = d.readLong()
+	case Float:
+		_, err = d.readFixed(4)
+	case Double:
+		_, err = d.readFixed(8)
+	case Bytes, String:
+		_, err = d.readBytes()
+	case Fixed:
+		_, err = d.readFixed(s.Size)
+	case Union:
+		var v int64
+		if v, err = d.readLong(); err != nil {
+			return err
+		}
+		if v < 0 || v >= int64(len(s.Branches)) {
+			return errCorrupted("union")
+		}
+		return d.skip(s.Branches[v])
+	case Record:
+		for _, f := range s.Fields {
+			if err = d.skip(f.Schema); err != nil {
+				return err
+			}
+		}
+	case Array, Map:
+		for {
+			var count int64
+			if count, err = d.readLong(); err != nil || count == 0 {
+				return err
+			}
+			if count < 0 {
+				// the block size is known, skip the whole block.
+				var size int64
+				if size, err = d.readLong(); err != nil {
+					return err
+				}
+				if _, err = d.readFixed(int(size)); err != nil {
+					return err
+				}
+				continue
+			}
+			for ; count > 0; count-- {
+				if s.Type == Map {
+					if _, err = d.readBytes(); err != nil {
+						return err
+					}
+				}
+				if err = d.skip(s.Items); err != nil {
+					return err
+				}
+			}
+		}
+	default:
+		return moerr.NewNYINoCtxf("avro type %s", s.Type)
+	}
+	return err
+}
+
+// decimalString formats the big endian two's complement unscaled value of a
+// decimal with the scale.
+func decimalString(b []byte, scale int) string {
+	v := new(big.Int).SetBytes(b)
+	if len(b) > 0 && b[0]&0x80 != 0 {
+		v.Sub(v, new(big.Int).Lsh(big.NewInt(1), uint(len(b)*8)))
+	}
+	str := v.String()
+	if scale <= 0 {
+		return str
+	}
+	neg := ""
+	if str[0] == '-' {
+		neg, str = "-", str[1:]
+	}
+	for len(str) <= scale {
+		str = "0" + str
+	}
+	return neg + str[:len(str)-scale] + "." + str[len(str)-scale:]
+}
@@ -0,0 +1,262 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package avro
+
+import (
+	"bufio"
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+const (
+	CodecNull      = "null"
+	CodecDeflate   = "deflate"
+	CodecSnappy    = "snappy"
+	CodecZstandard = "zstandard"
+
+	schemaKey = "avro.schema"
+	codecKey  = "avro.codec"
+	syncSize  = 16
+)
+
+var magic = []byte{'O', 'b', 'j', 1}
+
+// Reader reads the records of an object container file. The schema of the
+// file must be a record, whose fields are the columns of the file.
+type Reader struct {
+	r      *bufio.Reader
+	schema *Schema
+	codec  string
+	sync   [syncSize]byte
+
+	// project maps each field to its position in the values returned by
+	// Next, or -1 if the field is skipped.
+	project []int
+	values  []any
+
+	// block is the decoder of the current block, count is the number of the
+	// records left in it.
+	block decoder
+	count int64
+	zstd  *zstd.Decoder
+}
+
+// NewReader reads the header of the file, all the fields are read by Next
+// unless Project is called.
+func NewReader(r io.Reader) (*Reader, error) {
+	rd := &Reader{r: bufio.NewReader(r)}
+	head := make([]byte, len(magic))
+	if _, err := io.ReadFull(rd.r, head); err != nil || !bytes.Equal(head, magic) {
+		return nil, moerr.NewInvalidInputNoCtx("not an avro object container file")
+	}
+	meta, err := rd.readMetadata()
+	if err != nil {
+		return nil, err
+	}
+	if rd.schema, err = ParseSchema(string(meta[schemaKey])); err != nil {
+		return nil, err
+	}
+	if rd.schema.Type != Record {
+		return nil, moerr.NewNYINoCtxf("avro file of %s schema", rd.schema.Type)
+	}
+	rd.codec = string(meta[codecKey])
+	switch rd.codec {
+	case "":
+		rd.codec = CodecNull
+	case CodecNull, CodecDeflate, CodecSnappy:
+	case CodecZstandard:
+		if rd.zstd, err = zstd.NewReader(nil); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, moerr.NewNYINoCtxf("avro codec %s", rd.codec)
+	}
+	if _, err = io.ReadFull(rd.r, rd.sync[:]); err != nil {
+		return nil, errCorrupted("header")
+	}
+	columns := make([]int, len(rd.schema.Fields))
+	for i := range columns {
+		columns[i] = i
+	}
+	rd.Project(columns)
+	return rd, nil
+}
+
+func (rd *Reader) readMetadata() (map[string][]byte, error) {
+	meta := make(map[string][]byte)
+	for {
+		count, err := binary.ReadVarint(rd.r)
+		if err != nil {
+			return nil, errCorrupted("header")
+		}
+		if count == 0 {
+			return meta, nil
+		}
+		if count < 0 {
+			if _, err = binary.ReadVarint(rd.r); err != nil {
+				return nil, errCorrupted("header")
+			}
+			count = -count
+		}
+		for ; count > 0; count-- {
+			k, err := rd.readBytes()
+			if err != nil {
+				return nil, err
+			}
+			v, err := rd.readBytes()
+			if err != nil {
+				return nil, err
+			}
+			meta[string(k)] = v
+		}
+	}
+}
+
+func (rd *Reader) readBytes() ([]byte, error) {
+	n, err := binary.ReadVarint(rd.r)
+	if err != nil || n < 0 {
+		return nil, errCorrupted("header")
+	}
+	b := make([]byte, n)
+	if _, err = io.ReadFull(rd.r, b); err != nil {
+		return nil, errCorrupted("header")
+	}
+	return b, nil
+}
+
+// Schema returns the schema of the file.
+func (rd *Reader) Schema() *Schema {
+	return rd.schema
+}
+
+// Codec returns the compression codec of the file.
+func (rd *Reader) Codec() string {
+	return rd.codec
+}
+
+// Project sets the fields read by Next, columns are the indexes of the
+// fields in the schema, and the other fields are skipped.
+func (rd *Reader) Project(columns []int) {
+	rd.project = make([]int, len(rd.schema.Fields))
+	for i := range rd.project {
+		rd.project[i] = -1
+	}
+	for i, col := range columns {
+		rd.project[col] = i
+	}
+	rd.values = make([]any, len(columns))
+}
+
+// Next returns the values of the projected fields of the next record, in the
+// order of the columns passed to Project. The returned slice is reused by
+// the next call. It returns io.EOF after the last record.
+func (rd *Reader) Next() ([]any, error) {
+	for rd.count == 0 {
+		if err := rd.nextBlock(); err != nil {
+			return nil, err
+		}
+	}
+	for i, f := range rd.schema.Fields {
+		pos := rd.project[i]
+		if pos < 0 {
+			if err := rd.block.skip(f.Schema); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		v, err := rd.block.decode(f.Schema)
+		if err != nil {
+			return nil, err
+		}
+		rd.values[pos] = v
+	}
+	rd.count--
+	return rd.values, nil
+}
+
+func (rd *Reader) nextBlock() error {
+	if rd.block.remaining() != 0 {
+		return errCorrupted("block size")
+	}
+	count, err := binary.ReadVarint(rd.r)
+	if err == io.EOF {
+		return io.EOF
+	}
+	if err != nil || count < 0 {
+		return errCorrupted("block header")
+	}
+	size, err := binary.ReadVarint(rd.r)
+	if err != nil || size < 0 {
+		return errCorrupted("block header")
+	}
+	data := make([]byte, size)
+	if _, err = io.ReadFull(rd.r, data); err != nil {
+		return errCorrupted("block")
+	}
+	var sync [syncSize]byte
+	if _, err = io.ReadFull(rd.r, sync[:]); err != nil || sync != rd.sync {
+		return errCorrupted("sync marker")
+	}
+	if data, err = rd.decompress(data); err != nil {
+		return err
+	}
+	rd.block = decoder{buf: data}
+	rd.count = count
+	return nil
+}
+
+func (rd *Reader) decompress(data []byte) ([]byte, error) {
+	switch rd.codec {
+	case CodecDeflate:
+		out, err := io.ReadAll(flate.NewReader(bytes.NewReader(data)))
+		if err != nil {
+			return nil, errCorrupted("deflate block")
+		}
+		return out, nil
+	case CodecSnappy:
+		// the compressed data is followed by the crc32 of the uncompressed
+		// data.
+		if len(data) < 4 {
+			return nil, errCorrupted("snappy block")
+		}
+		out, err := snappy.Decode(nil, data[:len(data)-4])
+		if err != nil || crc32.ChecksumIEEE(out) != binary.BigEndian.Uint32(data[len(data)-4:]) {
+			return nil, errCorrupted("snappy block")
+		}
+		return out, nil
+	case CodecZstandard:
+		out, err := rd.zstd.DecodeAll(data, nil)
+		if err != nil {
+			return nil, errCorrupted("zstandard block")
+		}
+		return out, nil
+	}
+	return data, nil
+}
+
+// Close releases the resources of the reader.
+func (rd *Reader) Close() {
+	if rd.zstd != nil {
+		rd.zstd.Close()
+		rd.zstd = nil
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package avro
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testSchema = `{
+  "type": "record", "name": "row", "namespace": "mo.test",
+  "fields": [
+    {"name": "id", "type": "int"},
+    {"name": "big", "type": "long"},
+    {"name": "ok", "type": "boolean"},
+    {"name": "f32", "type": "float"},
+    {"name": "f64", "type": "double"},
+    {"name": "name", "type": ["null", "string"]},
+    {"name": "raw", "type": "bytes"},
+    {"name": "color", "type": {"type": "enum", "name": "color", "symbols": ["red", "green"]}},
+    {"name": "dec", "type": {"type": "bytes", "logicalType": "decimal", "precision": 10, "scale": 2}},
+    {"name": "fdec", "type": {"type": "fixed", "name": "fdec", "size": 8, "logicalType": "decimal", "precision": 18, "scale": 3}},
+    {"name": "day", "type": {"type": "int", "logicalType": "date"}},
+    {"name": "tm", "type": {"type": "long", "logicalType": "time-micros"}},
+    {"name": "ts", "type": {"type": "long", "logicalType": "timestamp-millis"}},
+    {"name": "tags", "type": {"type": "array", "items": "string"}},
+    {"name": "attrs", "type": {"type": "map", "values": "long"}},
+    {"name": "inner", "type": ["null", {"type": "record", "name": "inner", "fields": [{"name": "c", "type": "color"}]}]}
+  ]
+}`
+
+func testRecords(n int) []map[string]any {
+	records := make([]map[string]any, n)
+	for i := range records {
+		rec := map[string]any{
+			"id":    int32(i),
+			"big":   int64(i) * 1000000000000,
+			"ok":    i%2 == 0,
+			"f32":   float32(i) + 0.5,
+			"f64":   float64(i) * -1.25,
+			"name":  nil,
+			"raw":   []byte{byte(i), 0xff},
+			"color": []string{"red", "green"}[i%2],
+			"dec":   []string{"12.34", "-0.05", "0.00"}[i%3],
+			"fdec":  []string{"-123456.789", "1.000"}[i%2],
+			"day":   time.Date(2024, 1, 1+i, 0, 0, 0, 0, time.UTC),
+			"tm":    time.Duration(i)*time.Hour + 123*time.Microsecond,
+			"ts":    time.Date(2024, 5, 6, 7, 8, 9, int(i)*int(time.Millisecond), time.UTC),
+			"tags":  []any{},
+			"attrs": map[string]any{},
+			"inner": nil,
+		}
+		if i%3 != 0 {
+			rec["name"] = "name" + string(rune('a'+i%26))
+			rec["tags"] = []any{"x", "y"}
+			rec["attrs"] = map[string]any{"k": int64(i)}
+			rec["inner"] = map[string]any{"c": "green"}
+		}
+		records[i] = rec
+	}
+	return records
+}
+
+func TestReader(t *testing.T) {
+	records := testRecords(10)
+	for _, codec := range []string{"", CodecNull, CodecDeflate, CodecSnappy, CodecZstandard} {
+		data := writeTestFile(t, testSchema, codec, 4, records)
+		rd, err := NewReader(bytes.NewReader(data))
+		require.NoError(t, err)
+		if codec == "" {
+			require.Equal(t, CodecNull, rd.Codec())
+		} else {
+			require.Equal(t, codec, rd.Codec())
+		}
+		schema := rd.Schema()
+		require.Equal(t, "mo.test.row", schema.Name)
+		require.Equal(t, String, schema.Fields[5].Schema.NullableType().Type)
+
+		for _, rec := range records {
+			values, err := rd.Next()
+			require.NoError(t, err)
+			for i, f := range schema.Fields {
+				require.Equal(t, rec[f.Name], values[i], f.Name)
+			}
+		}
+		_, err = rd.Next()
+		require.Equal(t, io.EOF, err)
+		rd.Close()
+	}
+}
+
+func TestReaderProjection(t *testing.T) {
+	records := testRecords(7)
+	data := writeTestFile(t, testSchema, CodecDeflate, 3, records)
+	rd, err := NewReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	defer rd.Close()
+	// the skipped fields include arrays, maps and unions.
+	rd.Project([]int{15, 8, 0})
+	for _, rec := range records {
+		values, err := rd.Next()
+		require.NoError(t, err)
+		require.Equal(t, []any{rec["inner"], rec["dec"], rec["id"]}, values)
+	}
+	_, err = rd.Next()
+	require.Equal(t, io.EOF, err)
+}
+
+func TestParseSchema(t *testing.T) {
+	s, err := ParseSchema(`{"type": "record", "name": "node", "fields": [
+		{"name": "v", "type": {"type": "long"}},
+		{"name": "next", "type": ["null", "node"]}]}`)
+	require.NoError(t, err)
+	require.Equal(t, Long, s.Fields[0].Schema.Type)
+	require.Same(t, s, s.Fields[1].Schema.NullableType())
+
+	for _, bad := range []string{`{`, `"unknown"`, `{"type": "record"}`, `{"name": "x"}`} {
+		_, err = ParseSchema(bad)
+		require.Error(t, err, bad)
+	}
+}
+
+func TestDecimalString(t *testing.T) {
+	require.Equal(t, "0.05", decimalString([]byte{5}, 2))
+	require.Equal(t, "-0.05", decimalString([]byte{0xfb}, 2))
+	require.Equal(t, "-128", decimalString([]byte{0x80}, 0))
+	require.Equal(t, "2.55", decimalString([]byte{0, 0xff}, 2))
+	require.Equal(t, "0", decimalString(nil, 0))
+}
+
+func TestReaderCorrupted(t *testing.T) {
+	data := writeTestFile(t, testSchema, CodecSnappy, 4, testRecords(8))
+
+	_, err := NewReader(bytes.NewReader(data[:3]))
+	require.Error(t, err)
+	_, err = NewReader(bytes.NewReader([]byte("PAR1xxxx")))
+	require.Error(t, err)
+
+	_, err = NewReader(bytes.NewReader(writeTestFile(t, `{"type": "array", "items": "int"}`, "", 1, nil)))
+	require.Error(t, err)
+
+	// break the last sync marker.
+	bad := append([]byte(nil), data...)
+	bad[len(bad)-1] ^= 0xff
+	rd, err := NewReader(bytes.NewReader(bad))
+	require.NoError(t, err)
+	for {
+		if _, err = rd.Next(); err != nil {
+			break
+		}
+	}
+	require.NotEqual(t, io.EOF, err)
+
+	// a truncated file.
+	rd, err = NewReader(bytes.NewReader(data[:len(data)-30]))
+	require.NoError(t, err)
+	for {
+		if _, err = rd.Next(); err != nil {
+			break
+		}
+	}
+	require.NotEqual(t, io.EOF, err)
+}
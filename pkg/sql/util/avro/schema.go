@@ -0,0 +1,229 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package avro
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// Type is the type of a schema.
+type Type string
+
+const (
+	Null    Type = "null"
+	Boolean Type = "boolean"
+	Int     Type = "int"
+	Long    Type = "long"
+	Float   Type = "float"
+	Double  Type = "double"
+	Bytes   Type = "bytes"
+	String  Type = "string"
+	Record  Type = "record"
+	Enum    Type = "enum"
+	Array   Type = "array"
+	Map     Type = "map"
+	Union   Type = "union"
+	Fixed   Type = "fixed"
+)
+
+// The logical types annotating the primitive types.
+const (
+	LogicalDecimal             = "decimal"
+	LogicalUUID                = "uuid"
+	LogicalDate                = "date"
+	LogicalTimeMillis          = "time-millis"
+	LogicalTimeMicros          = "time-micros"
+	LogicalTimestampMillis     = "timestamp-millis"
+	LogicalTimestampMicros     = "timestamp-micros"
+	LogicalLocalTimestampMilli = "local-timestamp-millis"
+	LogicalLocalTimestampMicro = "local-timestamp-micros"
+)
+
+// Schema is a parsed schema.
+type Schema struct {
+	Type Type
+	// Name is the full name of the named types.
+	Name        string
+	LogicalType string
+	Precision   int
+	Scale       int
+	// Fields are the fields of a record.
+	Fields []*Field
+	// Symbols are the symbols of an enum.
+	Symbols []string
+	// Items is the schema of the items of an array, or of the values of a
+	// map.
+	Items *Schema
+	// Branches are the schemas of a union.
+	Branches []*Schema
+	// Size is the size of a fixed.
+	Size int
+}
+
+// Field is a field of a record.
+type Field struct {
+	Name   string
+	Schema *Schema
+}
+
+// NullableType returns the schema of the non-null branch if the schema is a
+// union of null and another type, or the schema itself otherwise.
+func (s *Schema) NullableType() *Schema {
+	if s.Type != Union || len(s.Branches) != 2 {
+		return s
+	}
+	if s.Branches[0].Type == Null {
+		return s.Branches[1]
+	}
+	if s.Branches[1].Type == Null {
+		return s.Branches[0]
+	}
+	return s
+}
+
+// ParseSchema parses the json form of a schema.
+func ParseSchema(text string) (*Schema, error) {
+	var v any
+	if err := json.Unmarshal([]byte(text), &v); err != nil {
+		return nil, moerr.NewInvalidInputNoCtxf("invalid avro schema: %v", err)
+	}
+	p := &schemaParser{named: make(map[string]*Schema)}
+	return p.parse(v, "")
+}
+
+type schemaParser struct {
+	named map[string]*Schema
+}
+
+func (p *schemaParser) parse(v any, namespace string) (*Schema, error) {
+	switch x := v.(type) {
+	case string:
+		switch Type(x) {
+		case Null, Boolean, Int, Long, Float, Double, Bytes, String:
+			return &Schema{Type: Type(x)}, nil
+		}
+		// a reference to a named type
+		if s, ok := p.named[fullName(x, namespace)]; ok {
+			return s, nil
+		}
+		if s, ok := p.named[x]; ok {
+			return s, nil
+		}
+		return nil, moerr.NewInvalidInputNoCtxf("unknown avro type %s", x)
+	case []any:
+		s := &Schema{Type: Union}
+		for _, b := range x {
+			bs, err := p.parse(b, namespace)
+			if err != nil {
+				return nil, err
+			}
+			s.Branches = append(s.Branches, bs)
+		}
+		return s, nil
+	case map[string]any:
+		return p.parseObject(x, namespace)
+	}
+	return nil, moerr.NewInvalidInputNoCtxf("invalid avro schema %v", v)
+}
+
+func (p *schemaParser) parseObject(obj map[string]any, namespace string) (*Schema, error) {
+	typ, _ := obj["type"].(string)
+	if typ == "" {
+		// {"type": {...}} or {"type": [...]}
+		if inner, ok := obj["type"]; ok {
+			return p.parse(inner, namespace)
+		}
+		return nil, moerr.NewInvalidInputNoCtx("avro schema without type")
+	}
+	s := &Schema{Type: Type(typ)}
+	s.LogicalType, _ = obj["logicalType"].(string)
+	if f, ok := obj["precision"].(float64); ok {
+		s.Precision = int(f)
+	}
+	if f, ok := obj["scale"].(float64); ok {
+		s.Scale = int(f)
+	}
+
+	switch s.Type {
+	case Null, Boolean, Int, Long, Float, Double, Bytes, String:
+		return s, nil
+	case Record, Enum, Fixed:
+		name, _ := obj["name"].(string)
+		if name == "" {
+			return nil, moerr.NewInvalidInputNoCtxf("avro %s without name", typ)
+		}
+		if ns, ok := obj["namespace"].(string); ok {
+			namespace = ns
+		}
+		s.Name = fullName(name, namespace)
+		if i := strings.LastIndexByte(s.Name, '.'); i >= 0 {
+			namespace = s.Name[:i]
+		}
+		// register before parsing the fields for recursive types.
+		p.named[s.Name] = s
+	}
+
+	switch s.Type {
+	case Record:
+		fields, _ := obj["fields"].([]any)
+		for _, f := range fields {
+			fo, ok := f.(map[string]any)
+			if !ok {
+				return nil, moerr.NewInvalidInputNoCtxf("invalid field of avro record %s", s.Name)
+			}
+			name, _ := fo["name"].(string)
+			fs, err := p.parse(fo["type"], namespace)
+			if err != nil {
+				return nil, err
+			}
+			s.Fields = append(s.Fields, &Field{Name: name, Schema: fs})
+		}
+	case Enum:
+		symbols, _ := obj["symbols"].([]any)
+		for _, sym := range symbols {
+			str, _ := sym.(string)
+			s.Symbols = append(s.Symbols, str)
+		}
+	case Fixed:
+		size, _ := obj["size"].(float64)
+		s.Size = int(size)
+	case Array:
+		items, err := p.parse(obj["items"], namespace)
+		if err != nil {
+			return nil, err
+		}
+		s.Items = items
+	case Map:
+		values, err := p.parse(obj["values"], namespace)
+		if err != nil {
+			return nil, err
+		}
+		s.Items = values
+	default:
+		// a named type referenced with the object form
+		return p.parse(typ, namespace)
+	}
+	return s, nil
+}
+
+func fullName(name, namespace string) string {
+	if strings.ContainsRune(name, '.') || namespace == "" {
+		return name
+	}
+	return namespace + "." + name
+}
@@ -0,0 +1,221 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package avro
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"hash/crc32"
+	"math"
+	"math/big"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/require"
+)
+
+var testSync = [syncSize]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+
+// writeTestFile writes an object container file of the records, each block
+// holds at most blockRows records.
+func writeTestFile(t *testing.T, schema, codec string, blockRows int, records []map[string]any) []byte {
+	s, err := ParseSchema(schema)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	buf.Write(magic)
+	meta := map[string]string{schemaKey: schema}
+	if codec != "" {
+		meta[codecKey] = codec
+	}
+	keys := make([]string, 0, len(meta))
+	for k := range meta {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	buf.Write(encodeLong(int64(len(keys))))
+	for _, k := range keys {
+		buf.Write(encodeBytes([]byte(k)))
+		buf.Write(encodeBytes([]byte(meta[k])))
+	}
+	buf.Write(encodeLong(0))
+	buf.Write(testSync[:])
+
+	for start := 0; start < len(records); start += blockRows {
+		end := min(start+blockRows, len(records))
+		var block []byte
+		for _, rec := range records[start:end] {
+			block = append(block, encodeValue(t, s, rec)...)
+		}
+		block = compressTest(t, codec, block)
+		buf.Write(encodeLong(int64(end - start)))
+		buf.Write(encodeLong(int64(len(block))))
+		buf.Write(block)
+		buf.Write(testSync[:])
+	}
+	return buf.Bytes()
+}
+
+func compressTest(t *testing.T, codec string, data []byte) []byte {
+	switch codec {
+	case CodecDeflate:
+		var buf bytes.Buffer
+		w, err := flate.NewWriter(&buf, flate.BestCompression)
+		require.NoError(t, err)
+		_, err = w.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+		return buf.Bytes()
+	case CodecSnappy:
+		out := snappy.Encode(nil, data)
+		return binary.BigEndian.AppendUint32(out, crc32.ChecksumIEEE(data))
+	case CodecZstandard:
+		w, err := zstd.NewWriter(nil)
+		require.NoError(t, err)
+		defer w.Close()
+		return w.EncodeAll(data, nil)
+	}
+	return data
+}
+
+func encodeLong(v int64) []byte {
+	return binary.AppendVarint(nil, v)
+}
+
+func encodeBytes(b []byte) []byte {
+	return append(encodeLong(int64(len(b))), b...)
+}
+
+// encodeValue encodes v of the schema, v has the form returned by decode.
+func encodeValue(t *testing.T, s *Schema, v any) []byte {
+	switch s.Type {
+	case Null:
+		return nil
+	case Boolean:
+		if v.(bool) {
+			return []byte{1}
+		}
+		return []byte{0}
+	case Int, Long:
+		switch x := v.(type) {
+		case int32:
+			return encodeLong(int64(x))
+		case int64:
+			return encodeLong(x)
+		case time.Duration:
+			if s.LogicalType == LogicalTimeMillis {
+				return encodeLong(x.Milliseconds())
+			}
+			return encodeLong(x.Microseconds())
+		case time.Time:
+			switch s.LogicalType {
+			case LogicalDate:
+				return encodeLong(x.Unix() / 86400)
+			case LogicalTimestampMillis, LogicalLocalTimestampMilli:
+				return encodeLong(x.UnixMilli())
+			}
+			return encodeLong(x.UnixMicro())
+		}
+	case Float:
+		return binary.LittleEndian.AppendUint32(nil, math.Float32bits(v.(float32)))
+	case Double:
+		return binary.LittleEndian.AppendUint64(nil, math.Float64bits(v.(float64)))
+	case Bytes, Fixed:
+		var b []byte
+		if s.LogicalType == LogicalDecimal {
+			b = encodeDecimal(t, v.(string), s.Scale, s.Size)
+		} else {
+			b = v.([]byte)
+		}
+		if s.Type == Fixed {
+			return b
+		}
+		return encodeBytes(b)
+	case String:
+		return encodeBytes([]byte(v.(string)))
+	case Enum:
+		for i, sym := range s.Symbols {
+			if sym == v.(string) {
+				return encodeLong(int64(i))
+			}
+		}
+	case Union:
+		for i, b := range s.Branches {
+			if (v == nil) == (b.Type == Null) {
+				return append(encodeLong(int64(i)), encodeValue(t, b, v)...)
+			}
+		}
+	case Record:
+		var out []byte
+		obj := v.(map[string]any)
+		for _, f := range s.Fields {
+			out = append(out, encodeValue(t, f.Schema, obj[f.Name])...)
+		}
+		return out
+	case Array:
+		list := v.([]any)
+		if len(list) == 0 {
+			return encodeLong(0)
+		}
+		// write the items as a block with size to test skipping.
+		var items []byte
+		for _, item := range list {
+			items = append(items, encodeValue(t, s.Items, item)...)
+		}
+		out := encodeLong(-int64(len(list)))
+		out = append(out, encodeLong(int64(len(items)))...)
+		out = append(out, items...)
+		return append(out, encodeLong(0)...)
+	case Map:
+		obj := v.(map[string]any)
+		keys := make([]string, 0, len(obj))
+		for k := range obj {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		var out []byte
+		if len(keys) > 0 {
+			out = encodeLong(int64(len(keys)))
+			for _, k := range keys {
+				out = append(out, encodeBytes([]byte(k))...)
+				out = append(out, encodeValue(t, s.Items, obj[k])...)
+			}
+		}
+		return append(out, encodeLong(0)...)
+	}
+	require.Failf(t, "cannot encode", "%v of %s", v, s.Type)
+	return nil
+}
+
+// encodeDecimal encodes the decimal string in big endian two's complement,
+// padded to size if it is positive.
+func encodeDecimal(t *testing.T, str string, scale, size int) []byte {
+	r, ok := new(big.Rat).SetString(str)
+	require.True(t, ok)
+	r.Mul(r, new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(scale)), nil)))
+	v := r.Num()
+	n := len(v.Bytes()) + 1
+	if size > 0 {
+		n = size
+	}
+	if v.Sign() < 0 {
+		v = new(big.Int).Add(v, new(big.Int).Lsh(big.NewInt(1), uint(n*8)))
+	}
+	return v.FillBytes(make([]byte, n))
+}
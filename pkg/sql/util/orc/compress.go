@@ -0,0 +1,124 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orc
+
+import (
+	"bytes"
+	"compress/flate"
+	"io"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+)
+
+// decompress returns the content of a compressed stream. A compressed stream
+// is a sequence of chunks, each of them starts with a 3 bytes little endian
+// header, whose lowest bit tells whether the chunk is stored uncompressed and
+// the other bits are the length of the chunk.
+func decompress(kind CompressionKind, blockSize uint64, data []byte) ([]byte, error) {
+	if kind == CompressionNone {
+		return data, nil
+	}
+	out := make([]byte, 0, len(data)*2)
+	for len(data) > 0 {
+		if len(data) < 3 {
+			return nil, errCorrupted("chunk header")
+		}
+		header := uint32(data[0]) | uint32(data[1])<<8 | uint32(data[2])<<16
+		data = data[3:]
+		length := int(header >> 1)
+		if length > len(data) {
+			return nil, errCorrupted("chunk length")
+		}
+		chunk := data[:length]
+		data = data[length:]
+		if header&1 == 1 {
+			out = append(out, chunk...)
+			continue
+		}
+		var err error
+		if out, err = decompressChunk(kind, blockSize, chunk, out); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+func decompressChunk(kind CompressionKind, blockSize uint64, chunk []byte, out []byte) ([]byte, error) {
+	switch kind {
+	case CompressionZlib:
+		r := flate.NewReader(bytes.NewReader(chunk))
+		defer r.Close()
+		buf := bytes.NewBuffer(out)
+		if _, err := io.Copy(buf, r); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	case CompressionSnappy:
+		n, err := snappy.DecodedLen(chunk)
+		if err != nil {
+			return nil, err
+		}
+		start := len(out)
+		out = grow(out, n)
+		_, err = snappy.Decode(out[start:], chunk)
+		return out, err
+	case CompressionLz4:
+		start := len(out)
+		out = grow(out, int(blockSize))
+		n, err := lz4.UncompressBlock(chunk, out[start:])
+		if err != nil {
+			return nil, err
+		}
+		return out[:start+n], nil
+	case CompressionZstd:
+		d, err := zstd.NewReader(nil)
+		if err != nil {
+			return nil, err
+		}
+		defer d.Close()
+		return d.DecodeAll(chunk, out)
+	default:
+		return nil, errUnsupported("compression " + kind.String())
+	}
+}
+
+func grow(buf []byte, n int) []byte {
+	if cap(buf)-len(buf) < n {
+		nb := make([]byte, len(buf), len(buf)+n)
+		copy(nb, buf)
+		buf = nb
+	}
+	return buf[:len(buf)+n]
+}
+
+func (k CompressionKind) String() string {
+	switch k {
+	case CompressionNone:
+		return "none"
+	case CompressionZlib:
+		return "zlib"
+	case CompressionSnappy:
+		return "snappy"
+	case CompressionLzo:
+		return "lzo"
+	case CompressionLz4:
+		return "lz4"
+	case CompressionZstd:
+		return "zstd"
+	}
+	return "unknown"
+}
@@ -0,0 +1,525 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orc
+
+import (
+	"math"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// The file tail and the stripe footers of ORC are protobuf messages defined
+// in orc_proto.proto. Only the fields used by the reader are decoded here,
+// the others are skipped.
+
+// CompressionKind is the compression codec of a file.
+type CompressionKind uint64
+
+const (
+	CompressionNone   CompressionKind = 0
+	CompressionZlib   CompressionKind = 1
+	CompressionSnappy CompressionKind = 2
+	CompressionLzo    CompressionKind = 3
+	CompressionLz4    CompressionKind = 4
+	CompressionZstd   CompressionKind = 5
+)
+
+// Kind is the kind of a column type.
+type Kind uint64
+
+const (
+	KindBoolean          Kind = 0
+	KindByte             Kind = 1
+	KindShort            Kind = 2
+	KindInt              Kind = 3
+	KindLong             Kind = 4
+	KindFloat            Kind = 5
+	KindDouble           Kind = 6
+	KindString           Kind = 7
+	KindBinary           Kind = 8
+	KindTimestamp        Kind = 9
+	KindList             Kind = 10
+	KindMap              Kind = 11
+	KindStruct           Kind = 12
+	KindUnion            Kind = 13
+	KindDecimal          Kind = 14
+	KindDate             Kind = 15
+	KindVarchar          Kind = 16
+	KindChar             Kind = 17
+	KindTimestampInstant Kind = 18
+)
+
+var kindNames = [...]string{
+	"boolean", "tinyint", "smallint", "int", "bigint", "float", "double", "string",
+	"binary", "timestamp", "array", "map", "struct", "uniontype", "decimal", "date",
+	"varchar", "char", "timestamp with local time zone",
+}
+
+func (k Kind) String() string {
+	if int(k) < len(kindNames) {
+		return kindNames[k]
+	}
+	return "unknown"
+}
+
+type streamKind uint64
+
+const (
+	streamPresent        streamKind = 0
+	streamData           streamKind = 1
+	streamLength         streamKind = 2
+	streamDictionaryData streamKind = 3
+	streamSecondary      streamKind = 5
+)
+
+type encodingKind uint64
+
+const (
+	encodingDirect       encodingKind = 0
+	encodingDictionary   encodingKind = 1
+	encodingDirectV2     encodingKind = 2
+	encodingDictionaryV2 encodingKind = 3
+)
+
+type postScript struct {
+	footerLength         uint64
+	compression          CompressionKind
+	compressionBlockSize uint64
+	metadataLength       uint64
+	magic                string
+}
+
+type stripeInformation struct {
+	offset       uint64
+	indexLength  uint64
+	dataLength   uint64
+	footerLength uint64
+	numberOfRows uint64
+}
+
+type orcType struct {
+	kind          Kind
+	subtypes      []uint32
+	fieldNames    []string
+	maximumLength uint64
+	precision     uint64
+	scale         uint64
+}
+
+type footer struct {
+	stripes      []stripeInformation
+	types        []orcType
+	numberOfRows uint64
+	statistics   []ColumnStatistics
+}
+
+type stream struct {
+	kind   streamKind
+	column uint64
+	length uint64
+}
+
+type columnEncoding struct {
+	kind           encodingKind
+	dictionarySize uint64
+}
+
+type stripeFooter struct {
+	streams        []stream
+	columns        []columnEncoding
+	writerTimezone string
+}
+
+// ColumnStatistics is the statistics of a column in a file or a stripe. The
+// min and max values are valid only if the corresponding Has flag is set.
+type ColumnStatistics struct {
+	NumberOfValues uint64
+	HasNull        bool
+
+	HasInt bool
+	IntMin int64
+	IntMax int64
+
+	HasDouble bool
+	DoubleMin float64
+	DoubleMax float64
+
+	HasString bool
+	StringMin string
+	StringMax string
+
+	// HasDate is set for the date columns, the values are days since epoch.
+	HasDate bool
+	DateMin int32
+	DateMax int32
+}
+
+// forEachField calls fn for each field of the message in data. fn returns
+// the number of bytes it consumed of the field value, or a negative value if
+// it does not care about the field.
+func forEachField(data []byte, fn func(num protowire.Number, typ protowire.Type, value []byte) int) error {
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		data = data[n:]
+		m := fn(num, typ, data)
+		if m < 0 {
+			m = protowire.ConsumeFieldValue(num, typ, data)
+		}
+		if m < 0 {
+			return protowire.ParseError(m)
+		}
+		data = data[m:]
+	}
+	return nil
+}
+
+func consumeUvarint(data []byte, v *uint64) int {
+	x, n := protowire.ConsumeVarint(data)
+	if n >= 0 {
+		*v = x
+	}
+	return n
+}
+
+func consumeSint64(data []byte, v *int64) int {
+	x, n := protowire.ConsumeVarint(data)
+	if n >= 0 {
+		*v = protowire.DecodeZigZag(x)
+	}
+	return n
+}
+
+func consumeDouble(data []byte, v *float64) int {
+	x, n := protowire.ConsumeFixed64(data)
+	if n >= 0 {
+		*v = math.Float64frombits(x)
+	}
+	return n
+}
+
+func consumeString(data []byte, v *string) int {
+	x, n := protowire.ConsumeBytes(data)
+	if n >= 0 {
+		*v = string(x)
+	}
+	return n
+}
+
+func consumeMessage[T any](data []byte, parse func([]byte) (T, error), v *T) int {
+	x, n := protowire.ConsumeBytes(data)
+	if n < 0 {
+		return n
+	}
+	m, err := parse(x)
+	if err != nil {
+		return -1
+	}
+	*v = m
+	return n
+}
+
+// consumeUint32s consumes a repeated uint32 field which may be packed.
+func consumeUint32s(typ protowire.Type, data []byte, vs *[]uint32) int {
+	if typ == protowire.VarintType {
+		x, n := protowire.ConsumeVarint(data)
+		if n >= 0 {
+			*vs = append(*vs, uint32(x))
+		}
+		return n
+	}
+	packed, n := protowire.ConsumeBytes(data)
+	if n < 0 {
+		return n
+	}
+	for len(packed) > 0 {
+		x, m := protowire.ConsumeVarint(packed)
+		if m < 0 {
+			return m
+		}
+		*vs = append(*vs, uint32(x))
+		packed = packed[m:]
+	}
+	return n
+}
+
+func parsePostScript(data []byte) (ps postScript, err error) {
+	err = forEachField(data, func(num protowire.Number, typ protowire.Type, value []byte) int {
+		switch {
+		case num == 1 && typ == protowire.VarintType:
+			return consumeUvarint(value, &ps.footerLength)
+		case num == 2 && typ == protowire.VarintType:
+			return consumeUvarint(value, (*uint64)(&ps.compression))
+		case num == 3 && typ == protowire.VarintType:
+			return consumeUvarint(value, &ps.compressionBlockSize)
+		case num == 5 && typ == protowire.VarintType:
+			return consumeUvarint(value, &ps.metadataLength)
+		case num == 8000 && typ == protowire.BytesType:
+			return consumeString(value, &ps.magic)
+		}
+		return -1
+	})
+	return
+}
+
+func parseStripeInformation(data []byte) (si stripeInformation, err error) {
+	err = forEachField(data, func(num protowire.Number, typ protowire.Type, value []byte) int {
+		if typ != protowire.VarintType {
+			return -1
+		}
+		switch num {
+		case 1:
+			return consumeUvarint(value, &si.offset)
+		case 2:
+			return consumeUvarint(value, &si.indexLength)
+		case 3:
+			return consumeUvarint(value, &si.dataLength)
+		case 4:
+			return consumeUvarint(value, &si.footerLength)
+		case 5:
+			return consumeUvarint(value, &si.numberOfRows)
+		}
+		return -1
+	})
+	return
+}
+
+func parseType(data []byte) (t orcType, err error) {
+	err = forEachField(data, func(num protowire.Number, typ protowire.Type, value []byte) int {
+		switch num {
+		case 1:
+			return consumeUvarint(value, (*uint64)(&t.kind))
+		case 2:
+			return consumeUint32s(typ, value, &t.subtypes)
+		case 3:
+			var name string
+			n := consumeString(value, &name)
+			t.fieldNames = append(t.fieldNames, name)
+			return n
+		case 4:
+			return consumeUvarint(value, &t.maximumLength)
+		case 5:
+			return consumeUvarint(value, &t.precision)
+		case 6:
+			return consumeUvarint(value, &t.scale)
+		}
+		return -1
+	})
+	return
+}
+
+func parseIntStatistics(data []byte, cs *ColumnStatistics) error {
+	return forEachField(data, func(num protowire.Number, typ protowire.Type, value []byte) int {
+		switch num {
+		case 1:
+			cs.HasInt = true
+			return consumeSint64(value, &cs.IntMin)
+		case 2:
+			cs.HasInt = true
+			return consumeSint64(value, &cs.IntMax)
+		}
+		return -1
+	})
+}
+
+func parseDoubleStatistics(data []byte, cs *ColumnStatistics) error {
+	return forEachField(data, func(num protowire.Number, typ protowire.Type, value []byte) int {
+		switch num {
+		case 1:
+			cs.HasDouble = true
+			return consumeDouble(value, &cs.DoubleMin)
+		case 2:
+			cs.HasDouble = true
+			return consumeDouble(value, &cs.DoubleMax)
+		}
+		return -1
+	})
+}
+
+func parseStringStatistics(data []byte, cs *ColumnStatistics) error {
+	var hasMin, hasMax bool
+	err := forEachField(data, func(num protowire.Number, typ protowire.Type, value []byte) int {
+		switch num {
+		case 1:
+			hasMin = true
+			return consumeString(value, &cs.StringMin)
+		case 2:
+			hasMax = true
+			return consumeString(value, &cs.StringMax)
+		}
+		return -1
+	})
+	// the min and max are omitted if they are too long, only the truncated
+	// bounds are written then, which are not used.
+	cs.HasString = hasMin && hasMax
+	return err
+}
+
+func parseDateStatistics(data []byte, cs *ColumnStatistics) error {
+	return forEachField(data, func(num protowire.Number, typ protowire.Type, value []byte) int {
+		var v int64
+		var n int
+		switch num {
+		case 1:
+			n = consumeSint64(value, &v)
+			cs.DateMin = int32(v)
+		case 2:
+			n = consumeSint64(value, &v)
+			cs.DateMax = int32(v)
+		default:
+			return -1
+		}
+		cs.HasDate = true
+		return n
+	})
+}
+
+func parseColumnStatistics(data []byte) (cs ColumnStatistics, err error) {
+	err = forEachField(data, func(num protowire.Number, typ protowire.Type, value []byte) int {
+		var sub func([]byte, *ColumnStatistics) error
+		switch num {
+		case 1:
+			return consumeUvarint(value, &cs.NumberOfValues)
+		case 2:
+			sub = parseIntStatistics
+		case 3:
+			sub = parseDoubleStatistics
+		case 4:
+			sub = parseStringStatistics
+		case 7:
+			sub = parseDateStatistics
+		case 10:
+			var v uint64
+			n := consumeUvarint(value, &v)
+			cs.HasNull = v != 0
+			return n
+		default:
+			return -1
+		}
+		msg, n := protowire.ConsumeBytes(value)
+		if n < 0 {
+			return n
+		}
+		if sub(msg, &cs) != nil {
+			return -1
+		}
+		return n
+	})
+	return
+}
+
+func parseFooter(data []byte) (f footer, err error) {
+	err = forEachField(data, func(num protowire.Number, typ protowire.Type, value []byte) int {
+		switch num {
+		case 3:
+			var si stripeInformation
+			n := consumeMessage(value, parseStripeInformation, &si)
+			f.stripes = append(f.stripes, si)
+			return n
+		case 4:
+			var t orcType
+			n := consumeMessage(value, parseType, &t)
+			f.types = append(f.types, t)
+			return n
+		case 6:
+			return consumeUvarint(value, &f.numberOfRows)
+		case 7:
+			var cs ColumnStatistics
+			n := consumeMessage(value, parseColumnStatistics, &cs)
+			f.statistics = append(f.statistics, cs)
+			return n
+		}
+		return -1
+	})
+	return
+}
+
+func parseStripeStatistics(data []byte) (stats []ColumnStatistics, err error) {
+	err = forEachField(data, func(num protowire.Number, typ protowire.Type, value []byte) int {
+		if num != 1 {
+			return -1
+		}
+		var cs ColumnStatistics
+		n := consumeMessage(value, parseColumnStatistics, &cs)
+		stats = append(stats, cs)
+		return n
+	})
+	return
+}
+
+// parseMetadata returns the column statistics of each stripe.
+func parseMetadata(data []byte) (stats [][]ColumnStatistics, err error) {
+	err = forEachField(data, func(num protowire.Number, typ protowire.Type, value []byte) int {
+		if num != 1 {
+			return -1
+		}
+		var ss []ColumnStatistics
+		n := consumeMessage(value, parseStripeStatistics, &ss)
+		stats = append(stats, ss)
+		return n
+	})
+	return
+}
+
+func parseStream(data []byte) (s stream, err error) {
+	err = forEachField(data, func(num protowire.Number, typ protowire.Type, value []byte) int {
+		switch num {
+		case 1:
+			return consumeUvarint(value, (*uint64)(&s.kind))
+		case 2:
+			return consumeUvarint(value, &s.column)
+		case 3:
+			return consumeUvarint(value, &s.length)
+		}
+		return -1
+	})
+	return
+}
+
+func parseColumnEncoding(data []byte) (e columnEncoding, err error) {
+	err = forEachField(data, func(num protowire.Number, typ protowire.Type, value []byte) int {
+		switch num {
+		case 1:
+			return consumeUvarint(value, (*uint64)(&e.kind))
+		case 2:
+			return consumeUvarint(value, &e.dictionarySize)
+		}
+		return -1
+	})
+	return
+}
+
+func parseStripeFooter(data []byte) (sf stripeFooter, err error) {
+	err = forEachField(data, func(num protowire.Number, typ protowire.Type, value []byte) int {
+		switch num {
+		case 1:
+			var s stream
+			n := consumeMessage(value, parseStream, &s)
+			sf.streams = append(sf.streams, s)
+			return n
+		case 2:
+			var e columnEncoding
+			n := consumeMessage(value, parseColumnEncoding, &e)
+			sf.columns = append(sf.columns, e)
+			return n
+		case 3:
+			return consumeString(value, &sf.writerTimezone)
+		}
+		return -1
+	})
+	return
+}
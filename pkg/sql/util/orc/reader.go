@@ -0,0 +1,547 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orc
+
+import (
+	"encoding/binary"
+	"io"
+	"math"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+const (
+	magic = "ORC"
+	// tailReadSize is the size read from the end of the file at first, it
+	// is enough for the file tail of most files.
+	tailReadSize = 16 * 1024
+)
+
+// Field is a top level field of the file schema.
+type Field struct {
+	Name string
+	// Column is the id of the column of the field, the root struct is the
+	// column 0 and the columns are numbered in pre-order.
+	Column    uint32
+	Kind      Kind
+	Precision int32
+	Scale     int32
+	MaxLength int32
+}
+
+// Reader reads the stripes of an ORC file. Only the streams of the
+// requested columns are read, and the statistics of the stripes are
+// available to skip the stripes before reading them.
+type Reader struct {
+	r           io.ReaderAt
+	ps          postScript
+	footer      footer
+	stripeStats [][]ColumnStatistics
+	fields      []Field
+}
+
+// NewReader reads the file tail of the file of size.
+func NewReader(r io.ReaderAt, size int64) (*Reader, error) {
+	if size < int64(len(magic))+1 {
+		return nil, errCorrupted("file size")
+	}
+	tail := make([]byte, min(size, tailReadSize))
+	if _, err := r.ReadAt(tail, size-int64(len(tail))); err != nil && err != io.EOF {
+		return nil, err
+	}
+	psLen := int(tail[len(tail)-1])
+	if psLen+1 > len(tail) {
+		return nil, errCorrupted("postscript length")
+	}
+	ps, err := parsePostScript(tail[len(tail)-1-psLen : len(tail)-1])
+	if err != nil {
+		return nil, err
+	}
+	if ps.magic != magic {
+		return nil, errCorrupted("magic")
+	}
+	if ps.compression != CompressionNone && ps.compression != CompressionZlib &&
+		ps.compression != CompressionSnappy && ps.compression != CompressionLz4 &&
+		ps.compression != CompressionZstd {
+		return nil, errUnsupported("compression " + ps.compression.String())
+	}
+
+	tailLen := int64(psLen) + 1 + int64(ps.footerLength) + int64(ps.metadataLength)
+	if tailLen > size {
+		return nil, errCorrupted("footer length")
+	}
+	if tailLen > int64(len(tail)) {
+		tail = make([]byte, tailLen)
+		if _, err := r.ReadAt(tail, size-tailLen); err != nil && err != io.EOF {
+			return nil, err
+		}
+	} else {
+		tail = tail[int64(len(tail))-tailLen:]
+	}
+
+	metadata := tail[:ps.metadataLength]
+	footerData := tail[ps.metadataLength : ps.metadataLength+ps.footerLength]
+	if footerData, err = decompress(ps.compression, ps.compressionBlockSize, footerData); err != nil {
+		return nil, err
+	}
+	f, err := parseFooter(footerData)
+	if err != nil {
+		return nil, err
+	}
+	rd := &Reader{r: r, ps: ps, footer: f}
+	if len(metadata) > 0 {
+		if metadata, err = decompress(ps.compression, ps.compressionBlockSize, metadata); err != nil {
+			return nil, err
+		}
+		if rd.stripeStats, err = parseMetadata(metadata); err != nil {
+			return nil, err
+		}
+	}
+	if err = rd.initFields(); err != nil {
+		return nil, err
+	}
+	return rd, nil
+}
+
+func (r *Reader) initFields() error {
+	if len(r.footer.types) == 0 {
+		return errCorrupted("schema")
+	}
+	root := r.footer.types[0]
+	if root.kind != KindStruct {
+		return errUnsupported("file whose schema is not a struct")
+	}
+	if len(root.fieldNames) != len(root.subtypes) {
+		return errCorrupted("schema")
+	}
+	for i, id := range root.subtypes {
+		if int(id) >= len(r.footer.types) {
+			return errCorrupted("schema")
+		}
+		t := r.footer.types[id]
+		r.fields = append(r.fields, Field{
+			Name:      root.fieldNames[i],
+			Column:    id,
+			Kind:      t.kind,
+			Precision: int32(t.precision),
+			Scale:     int32(t.scale),
+			MaxLength: int32(t.maximumLength),
+		})
+	}
+	return nil
+}
+
+// Fields returns the top level fields of the schema.
+func (r *Reader) Fields() []Field {
+	return r.fields
+}
+
+// Compression returns the compression codec of the file.
+func (r *Reader) Compression() CompressionKind {
+	return r.ps.compression
+}
+
+// NumRows returns the number of rows of the file.
+func (r *Reader) NumRows() uint64 {
+	return r.footer.numberOfRows
+}
+
+// NumStripes returns the number of stripes of the file.
+func (r *Reader) NumStripes() int {
+	return len(r.footer.stripes)
+}
+
+// StripeRows returns the number of rows of the i-th stripe.
+func (r *Reader) StripeRows(i int) uint64 {
+	return r.footer.stripes[i].numberOfRows
+}
+
+// StripeStatistics returns the statistics of the columns of the i-th stripe
+// indexed by column id, or nil if the file has no stripe statistics.
+func (r *Reader) StripeStatistics(i int) []ColumnStatistics {
+	if i >= len(r.stripeStats) {
+		return nil
+	}
+	return r.stripeStats[i]
+}
+
+// Statistics returns the statistics of the columns of the whole file indexed
+// by column id.
+func (r *Reader) Statistics() []ColumnStatistics {
+	return r.footer.statistics
+}
+
+// Stripe holds the streams of the requested columns of a stripe.
+type Stripe struct {
+	rows     int
+	codec    CompressionKind
+	types    []orcType
+	columns  []columnEncoding
+	streams  map[streamKey][]byte
+	location *time.Location
+}
+
+type streamKey struct {
+	column uint64
+	kind   streamKind
+}
+
+// ReadStripe reads the streams of the columns of the i-th stripe.
+func (r *Reader) ReadStripe(i int, columns []uint32) (*Stripe, error) {
+	si := r.footer.stripes[i]
+	footerData := make([]byte, si.footerLength)
+	if _, err := r.r.ReadAt(footerData, int64(si.offset+si.indexLength+si.dataLength)); err != nil && err != io.EOF {
+		return nil, err
+	}
+	footerData, err := decompress(r.ps.compression, r.ps.compressionBlockSize, footerData)
+	if err != nil {
+		return nil, err
+	}
+	sf, err := parseStripeFooter(footerData)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Stripe{
+		rows:     int(si.numberOfRows),
+		codec:    r.ps.compression,
+		types:    r.footer.types,
+		columns:  sf.columns,
+		streams:  make(map[streamKey][]byte),
+		location: time.UTC,
+	}
+	if sf.writerTimezone != "" {
+		if loc, err := time.LoadLocation(sf.writerTimezone); err == nil {
+			s.location = loc
+		}
+	}
+
+	wanted := make(map[uint64]bool, len(columns))
+	for _, c := range columns {
+		wanted[uint64(c)] = true
+	}
+	// the streams are stored one after another in the order of the footer,
+	// starting with the index streams.
+	offset := si.offset
+	for _, st := range sf.streams {
+		start := offset
+		offset += st.length
+		if !wanted[st.column] {
+			continue
+		}
+		switch st.kind {
+		case streamPresent, streamData, streamLength, streamDictionaryData, streamSecondary:
+		default:
+			continue
+		}
+		data := make([]byte, st.length)
+		if _, err := r.r.ReadAt(data, int64(start)); err != nil && err != io.EOF {
+			return nil, err
+		}
+		if data, err = decompress(r.ps.compression, r.ps.compressionBlockSize, data); err != nil {
+			return nil, err
+		}
+		s.streams[streamKey{column: st.column, kind: st.kind}] = data
+	}
+	return s, nil
+}
+
+// NumRows returns the number of rows of the stripe.
+func (s *Stripe) NumRows() int {
+	return s.rows
+}
+
+// Column is the decoded values of a column of a stripe. The values are
+// indexed by row, and the values of the null rows are zero.
+type Column struct {
+	Kind Kind
+	// Nulls marks the null rows, it is nil if there is no null.
+	Nulls []bool
+	// Ints holds the values of the boolean (0 or 1), integer and date (days
+	// since epoch) columns.
+	Ints []int64
+	// Floats holds the values of the float and double columns.
+	Floats []float64
+	// Bytes holds the values of the string, varchar, char and binary
+	// columns.
+	Bytes [][]byte
+	// Decimals holds the values of the decimal columns, in the scale of the
+	// column type.
+	Decimals []types.Decimal128
+	// Times holds the values of the timestamp columns. The values of the
+	// timestamp type are in the time zone of the writer, so that their wall
+	// clocks are the values written, and the values of the timestamp with
+	// local time zone type are in UTC.
+	Times []time.Time
+}
+
+// IsNull returns whether the i-th row is null.
+func (c *Column) IsNull(i int) bool {
+	return c.Nulls != nil && c.Nulls[i]
+}
+
+// Column decodes the column of id, which must be requested by ReadStripe.
+func (s *Stripe) Column(id uint32) (*Column, error) {
+	if int(id) >= len(s.types) || int(id) >= len(s.columns) {
+		return nil, errCorrupted("column id")
+	}
+	t := s.types[id]
+	enc := s.columns[id]
+	col := &Column{Kind: t.kind}
+	key := func(kind streamKind) streamKey {
+		return streamKey{column: uint64(id), kind: kind}
+	}
+
+	// present rows
+	nonNull := s.rows
+	if present, ok := s.streams[key(streamPresent)]; ok {
+		bs, err := readBooleanRLE(present, s.rows)
+		if err != nil {
+			return nil, err
+		}
+		col.Nulls = make([]bool, s.rows)
+		nonNull = 0
+		for i, p := range bs {
+			if p {
+				nonNull++
+			} else {
+				col.Nulls[i] = true
+			}
+		}
+	}
+	data := s.streams[key(streamData)]
+
+	var err error
+	switch t.kind {
+	case KindBoolean:
+		var bs []bool
+		if bs, err = readBooleanRLE(data, nonNull); err != nil {
+			return nil, err
+		}
+		col.Ints = spread(col.Nulls, s.rows, bs, func(b bool) int64 {
+			if b {
+				return 1
+			}
+			return 0
+		})
+	case KindByte:
+		var bs []byte
+		if bs, err = readByteRLE(data, nonNull); err != nil {
+			return nil, err
+		}
+		col.Ints = spread(col.Nulls, s.rows, bs, func(b byte) int64 { return int64(int8(b)) })
+	case KindShort, KindInt, KindLong, KindDate:
+		var vs []int64
+		if vs, err = newIntReader(data, true, enc.kind).next(nonNull); err != nil {
+			return nil, err
+		}
+		col.Ints = spread(col.Nulls, s.rows, vs, func(v int64) int64 { return v })
+	case KindFloat:
+		if len(data) < nonNull*4 {
+			return nil, errCorrupted("float stream")
+		}
+		vs := make([]float64, nonNull)
+		for i := range vs {
+			vs[i] = float64(math.Float32frombits(binary.LittleEndian.Uint32(data[i*4:])))
+		}
+		col.Floats = spread(col.Nulls, s.rows, vs, func(v float64) float64 { return v })
+	case KindDouble:
+		if len(data) < nonNull*8 {
+			return nil, errCorrupted("double stream")
+		}
+		vs := make([]float64, nonNull)
+		for i := range vs {
+			vs[i] = math.Float64frombits(binary.LittleEndian.Uint64(data[i*8:]))
+		}
+		col.Floats = spread(col.Nulls, s.rows, vs, func(v float64) float64 { return v })
+	case KindString, KindVarchar, KindChar, KindBinary:
+		var vs [][]byte
+		if vs, err = s.readBytes(id, enc, nonNull); err != nil {
+			return nil, err
+		}
+		col.Bytes = spread(col.Nulls, s.rows, vs, func(v []byte) []byte { return v })
+	case KindDecimal:
+		var vs []types.Decimal128
+		if vs, err = s.readDecimals(id, enc, int32(t.scale), nonNull); err != nil {
+			return nil, err
+		}
+		col.Decimals = spread(col.Nulls, s.rows, vs, func(v types.Decimal128) types.Decimal128 { return v })
+	case KindTimestamp, KindTimestampInstant:
+		var vs []time.Time
+		if vs, err = s.readTimestamps(id, enc, t.kind, nonNull); err != nil {
+			return nil, err
+		}
+		col.Times = spread(col.Nulls, s.rows, vs, func(v time.Time) time.Time { return v })
+	default:
+		return nil, errUnsupported("column type " + t.kind.String())
+	}
+	return col, nil
+}
+
+// spread places the values of the non-null rows to their rows.
+func spread[T, U any](nulls []bool, rows int, values []T, fn func(T) U) []U {
+	out := make([]U, rows)
+	j := 0
+	for i := range out {
+		if nulls != nil && nulls[i] {
+			continue
+		}
+		out[i] = fn(values[j])
+		j++
+	}
+	return out
+}
+
+func (s *Stripe) readBytes(id uint32, enc columnEncoding, n int) ([][]byte, error) {
+	data := s.streams[streamKey{column: uint64(id), kind: streamData}]
+	lengthData := s.streams[streamKey{column: uint64(id), kind: streamLength}]
+	switch enc.kind {
+	case encodingDirect, encodingDirectV2:
+		lengths, err := newIntReader(lengthData, false, enc.kind).next(n)
+		if err != nil {
+			return nil, err
+		}
+		return splitBytes(data, lengths)
+	default:
+		indexes, err := newIntReader(data, false, enc.kind).next(n)
+		if err != nil {
+			return nil, err
+		}
+		lengths, err := newIntReader(lengthData, false, enc.kind).next(int(enc.dictionarySize))
+		if err != nil {
+			return nil, err
+		}
+		dict, err := splitBytes(s.streams[streamKey{column: uint64(id), kind: streamDictionaryData}], lengths)
+		if err != nil {
+			return nil, err
+		}
+		out := make([][]byte, n)
+		for i, idx := range indexes {
+			if idx < 0 || int(idx) >= len(dict) {
+				return nil, errCorrupted("dictionary index")
+			}
+			out[i] = dict[idx]
+		}
+		return out, nil
+	}
+}
+
+func splitBytes(data []byte, lengths []int64) ([][]byte, error) {
+	out := make([][]byte, len(lengths))
+	for i, l := range lengths {
+		if l < 0 || int(l) > len(data) {
+			return nil, errCorrupted("string length")
+		}
+		out[i] = data[:l:l]
+		data = data[l:]
+	}
+	return out, nil
+}
+
+func (s *Stripe) readDecimals(id uint32, enc columnEncoding, scale int32, n int) ([]types.Decimal128, error) {
+	data := s.streams[streamKey{column: uint64(id), kind: streamData}]
+	scales, err := newIntReader(s.streams[streamKey{column: uint64(id), kind: streamSecondary}], true, enc.kind).next(n)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]types.Decimal128, n)
+	for i := range out {
+		v, m, err := readDecimal(data)
+		if err != nil {
+			return nil, err
+		}
+		data = data[m:]
+		if diff := scale - int32(scales[i]); diff != 0 {
+			if v, err = v.Scale(diff); err != nil {
+				return nil, err
+			}
+		}
+		out[i] = v
+	}
+	return out, nil
+}
+
+// readDecimal decodes an unbounded zigzag varint into a 128 bits integer.
+func readDecimal(data []byte) (types.Decimal128, int, error) {
+	var lo, hi uint64
+	for i, shift := 0, uint(0); i < len(data); i, shift = i+1, shift+7 {
+		if shift >= 128 {
+			return types.Decimal128{}, 0, errCorrupted("decimal overflow")
+		}
+		v := uint64(data[i] & 0x7f)
+		if shift < 64 {
+			lo |= v << shift
+			if shift > 57 {
+				hi |= v >> (64 - shift)
+			}
+		} else {
+			hi |= v << (shift - 64)
+		}
+		if data[i] < 0x80 {
+			neg := lo&1 == 1
+			lo = lo>>1 | hi<<63
+			hi >>= 1
+			if neg {
+				lo, hi = ^lo, ^hi
+			}
+			return types.Decimal128{B0_63: lo, B64_127: hi}, i + 1, nil
+		}
+	}
+	return types.Decimal128{}, 0, errCorrupted("decimal")
+}
+
+func (s *Stripe) readTimestamps(id uint32, enc columnEncoding, kind Kind, n int) ([]time.Time, error) {
+	secs, err := newIntReader(s.streams[streamKey{column: uint64(id), kind: streamData}], true, enc.kind).next(n)
+	if err != nil {
+		return nil, err
+	}
+	nanos, err := newIntReader(s.streams[streamKey{column: uint64(id), kind: streamSecondary}], false, enc.kind).next(n)
+	if err != nil {
+		return nil, err
+	}
+	loc := time.UTC
+	if kind == KindTimestamp {
+		loc = s.location
+	}
+	// the seconds are relative to 2015-01-01 00:00:00 of the writer time
+	// zone for the timestamp type.
+	epoch := time.Date(2015, 1, 1, 0, 0, 0, 0, loc).Unix()
+	out := make([]time.Time, n)
+	for i := range out {
+		nano := decodeNanos(uint64(nanos[i]))
+		sec := secs[i] + epoch
+		// the seconds of the times before 1970 are truncated towards zero
+		// by the writer.
+		if sec < 0 && nano > 999999 {
+			sec--
+		}
+		out[i] = time.Unix(sec, nano).In(loc)
+	}
+	return out, nil
+}
+
+// decodeNanos decodes the nanoseconds whose trailing zeros are removed, the
+// lowest 3 bits z tells that z+1 zeros are removed if it is not 0.
+func decodeNanos(v uint64) int64 {
+	zeros := v & 0x07
+	nano := int64(v >> 3)
+	if zeros != 0 {
+		for i := uint64(0); i <= zeros; i++ {
+			nano *= 10
+		}
+	}
+	return nano
+}
@@ -0,0 +1,171 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orc
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testColumns() []testColumn {
+	ts1 := time.Date(2024, 5, 6, 7, 8, 9, 123456000, time.UTC)
+	ts2 := time.Date(1999, 12, 31, 23, 59, 59, 0, time.UTC)
+	return []testColumn{
+		{name: "id", kind: KindLong, values: []any{int64(1), int64(2), int64(3), int64(4), int64(5)}},
+		{name: "flag", kind: KindBoolean, values: []any{true, false, nil, true, false}},
+		{name: "tiny", kind: KindByte, values: []any{int64(-1), int64(127), int64(0), nil, int64(-128)}},
+		{name: "score", kind: KindDouble, values: []any{1.5, nil, -2.25, 3.0, 0.0}},
+		{name: "ratio", kind: KindFloat, values: []any{0.5, 1.5, nil, nil, 2.5}},
+		{name: "name", kind: KindString, values: []any{"a", "bb", nil, "ccc", ""}},
+		{name: "city", kind: KindString, dict: true, values: []any{"sh", "bj", "sh", nil, "bj"}},
+		{name: "price", kind: KindDecimal, scale: 2, values: []any{int64(12345), int64(-1), nil, int64(0), int64(99)}},
+		{name: "day", kind: KindDate, values: []any{int64(0), int64(19000), int64(-1), nil, int64(1)}},
+		{name: "ts", kind: KindTimestamp, values: []any{ts1, ts2, nil, ts1, ts2}},
+	}
+}
+
+func TestReader(t *testing.T) {
+	for _, compression := range []CompressionKind{CompressionNone, CompressionZlib} {
+		t.Run(compression.String(), func(t *testing.T) {
+			cols := testColumns()
+			data := writeTestFile(t, compression, 3, cols)
+			r, err := NewReader(bytes.NewReader(data), int64(len(data)))
+			require.NoError(t, err)
+			require.Equal(t, compression, r.Compression())
+			require.Equal(t, uint64(5), r.NumRows())
+			require.Equal(t, 2, r.NumStripes())
+			require.Equal(t, uint64(3), r.StripeRows(0))
+			require.Equal(t, uint64(2), r.StripeRows(1))
+
+			fields := r.Fields()
+			require.Len(t, fields, len(cols))
+			for i, f := range fields {
+				require.Equal(t, cols[i].name, f.Name)
+				require.Equal(t, cols[i].kind, f.Kind)
+				require.Equal(t, uint32(i+1), f.Column)
+			}
+			require.Equal(t, int32(2), fields[7].Scale)
+
+			ids := make([]uint32, len(fields))
+			for i, f := range fields {
+				ids[i] = f.Column
+			}
+			row := 0
+			for s := 0; s < r.NumStripes(); s++ {
+				stripe, err := r.ReadStripe(s, ids)
+				require.NoError(t, err)
+				decoded := make([]*Column, len(fields))
+				for i, f := range fields {
+					decoded[i], err = stripe.Column(f.Column)
+					require.NoError(t, err)
+				}
+				for i := 0; i < stripe.NumRows(); i++ {
+					for c, col := range cols {
+						expected := col.values[row+i]
+						if expected == nil {
+							require.True(t, decoded[c].IsNull(i), "column %s row %d", col.name, row+i)
+							continue
+						}
+						require.False(t, decoded[c].IsNull(i), "column %s row %d", col.name, row+i)
+						switch col.kind {
+						case KindBoolean:
+							b := int64(0)
+							if expected.(bool) {
+								b = 1
+							}
+							require.Equal(t, b, decoded[c].Ints[i])
+						case KindByte, KindLong, KindDate:
+							require.Equal(t, expected, decoded[c].Ints[i])
+						case KindDouble, KindFloat:
+							require.Equal(t, expected, decoded[c].Floats[i])
+						case KindString:
+							require.Equal(t, expected, string(decoded[c].Bytes[i]))
+						case KindDecimal:
+							require.Equal(t, decimalOf(expected.(int64)), decoded[c].Decimals[i])
+						case KindTimestamp:
+							require.True(t, expected.(time.Time).Equal(decoded[c].Times[i]))
+						}
+					}
+				}
+				row += stripe.NumRows()
+			}
+			require.Equal(t, 5, row)
+		})
+	}
+}
+
+func TestReaderStatistics(t *testing.T) {
+	data := writeTestFile(t, CompressionNone, 3, testColumns())
+	r, err := NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+
+	stats := r.StripeStatistics(0)
+	require.Len(t, stats, 11)
+	id := stats[1]
+	require.True(t, id.HasInt)
+	require.Equal(t, int64(1), id.IntMin)
+	require.Equal(t, int64(3), id.IntMax)
+	require.False(t, id.HasNull)
+
+	score := stats[4]
+	require.True(t, score.HasDouble)
+	require.Equal(t, -2.25, score.DoubleMin)
+	require.Equal(t, 1.5, score.DoubleMax)
+	require.True(t, score.HasNull)
+
+	name := r.StripeStatistics(1)[6]
+	require.True(t, name.HasString)
+	require.Equal(t, "", name.StringMin)
+	require.Equal(t, "ccc", name.StringMax)
+
+	day := stats[9]
+	require.True(t, day.HasDate)
+	require.Equal(t, int32(-1), day.DateMin)
+	require.Equal(t, int32(19000), day.DateMax)
+
+	require.Nil(t, r.StripeStatistics(2))
+}
+
+func TestReaderProjection(t *testing.T) {
+	data := writeTestFile(t, CompressionZlib, 10, testColumns())
+	r, err := NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	stripe, err := r.ReadStripe(0, []uint32{6})
+	require.NoError(t, err)
+	// only the streams of the requested columns are read.
+	for key := range stripe.streams {
+		require.Equal(t, uint64(6), key.column)
+	}
+	col, err := stripe.Column(6)
+	require.NoError(t, err)
+	require.Equal(t, "bb", string(col.Bytes[1]))
+}
+
+func TestReaderCorrupted(t *testing.T) {
+	data := writeTestFile(t, CompressionNone, 3, testColumns())
+	_, err := NewReader(bytes.NewReader(data[:2]), 2)
+	require.Error(t, err)
+
+	bad := bytes.Clone(data)
+	bad[len(bad)-2] = 'X'
+	_, err = NewReader(bytes.NewReader(bad), int64(len(bad)))
+	require.Error(t, err)
+
+	_, err = NewReader(bytes.NewReader(data[:len(data)-5]), int64(len(data)-5))
+	require.Error(t, err)
+}
@@ -0,0 +1,396 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orc
+
+import (
+	"encoding/binary"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+func errCorrupted(what string) error {
+	return moerr.NewInvalidInputNoCtxf("corrupted orc file, invalid %s", what)
+}
+
+func errUnsupported(what string) error {
+	return moerr.NewNYINoCtxf("orc %s", what)
+}
+
+// readByteRLE decodes n bytes of the byte run length encoding. Each run
+// starts with a control byte, a non-negative one c is followed by one byte
+// repeated c+3 times, and a negative one c is followed by -c literal bytes.
+func readByteRLE(data []byte, n int) ([]byte, error) {
+	out := make([]byte, 0, n)
+	for len(out) < n {
+		if len(data) == 0 {
+			return nil, errCorrupted("byte rle")
+		}
+		c := int8(data[0])
+		data = data[1:]
+		if c >= 0 {
+			if len(data) == 0 {
+				return nil, errCorrupted("byte rle")
+			}
+			for i := 0; i < int(c)+3; i++ {
+				out = append(out, data[0])
+			}
+			data = data[1:]
+		} else {
+			l := -int(c)
+			if len(data) < l {
+				return nil, errCorrupted("byte rle")
+			}
+			out = append(out, data[:l]...)
+			data = data[l:]
+		}
+	}
+	return out[:n], nil
+}
+
+// readBooleanRLE decodes n booleans, which are bits of the bytes encoded by
+// the byte run length encoding, from the most significant bit.
+func readBooleanRLE(data []byte, n int) ([]bool, error) {
+	bs, err := readByteRLE(data, (n+7)/8)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]bool, n)
+	for i := range out {
+		out[i] = bs[i/8]&(0x80>>(i%8)) != 0
+	}
+	return out, nil
+}
+
+// intReader decodes the integer run length encodings.
+type intReader struct {
+	data   []byte
+	signed bool
+	v2     bool
+}
+
+func newIntReader(data []byte, signed bool, encoding encodingKind) *intReader {
+	return &intReader{
+		data:   data,
+		signed: signed,
+		v2:     encoding == encodingDirectV2 || encoding == encodingDictionaryV2,
+	}
+}
+
+// next decodes n integers.
+func (r *intReader) next(n int) ([]int64, error) {
+	out := make([]int64, 0, n)
+	var err error
+	for len(out) < n {
+		if len(r.data) == 0 {
+			return nil, errCorrupted("integer rle")
+		}
+		if r.v2 {
+			out, err = r.readRunV2(out)
+		} else {
+			out, err = r.readRunV1(out)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return out[:n], nil
+}
+
+func (r *intReader) readVarint() (int64, error) {
+	if r.signed {
+		v, n := binary.Varint(r.data)
+		if n <= 0 {
+			return 0, errCorrupted("varint")
+		}
+		r.data = r.data[n:]
+		return v, nil
+	}
+	v, n := binary.Uvarint(r.data)
+	if n <= 0 {
+		return 0, errCorrupted("varint")
+	}
+	r.data = r.data[n:]
+	return int64(v), nil
+}
+
+// readRunV1 decodes a run of the version 1 encoding. A non-negative control
+// byte c is followed by a signed delta byte and a base varint, which make c+3
+// values, and a negative one c is followed by -c varints.
+func (r *intReader) readRunV1(out []int64) ([]int64, error) {
+	c := int8(r.data[0])
+	r.data = r.data[1:]
+	if c >= 0 {
+		if len(r.data) == 0 {
+			return nil, errCorrupted("integer rle")
+		}
+		delta := int64(int8(r.data[0]))
+		r.data = r.data[1:]
+		base, err := r.readVarint()
+		if err != nil {
+			return nil, err
+		}
+		for i := 0; i < int(c)+3; i++ {
+			out = append(out, base+int64(i)*delta)
+		}
+		return out, nil
+	}
+	for i := 0; i < -int(c); i++ {
+		v, err := r.readVarint()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return out, nil
+}
+
+// readRunV2 decodes a run of the version 2 encoding, whose sub encoding is
+// given by the highest 2 bits of the first byte.
+func (r *intReader) readRunV2(out []int64) ([]int64, error) {
+	switch r.data[0] >> 6 {
+	case 0:
+		return r.readShortRepeat(out)
+	case 1:
+		return r.readDirect(out)
+	case 2:
+		return r.readPatchedBase(out)
+	default:
+		return r.readDelta(out)
+	}
+}
+
+func (r *intReader) decode(v uint64) int64 {
+	if r.signed {
+		return int64(v>>1) ^ -int64(v&1)
+	}
+	return int64(v)
+}
+
+func (r *intReader) readShortRepeat(out []int64) ([]int64, error) {
+	header := r.data[0]
+	width := int(header>>3&0x07) + 1
+	count := int(header&0x07) + 3
+	if len(r.data) < 1+width {
+		return nil, errCorrupted("short repeat")
+	}
+	var v uint64
+	for _, b := range r.data[1 : 1+width] {
+		v = v<<8 | uint64(b)
+	}
+	r.data = r.data[1+width:]
+	value := r.decode(v)
+	for i := 0; i < count; i++ {
+		out = append(out, value)
+	}
+	return out, nil
+}
+
+func (r *intReader) readDirect(out []int64) ([]int64, error) {
+	if len(r.data) < 2 {
+		return nil, errCorrupted("direct")
+	}
+	width := decodeBitWidth(int(r.data[0] >> 1 & 0x1f))
+	length := (int(r.data[0]&0x01)<<8 | int(r.data[1])) + 1
+	r.data = r.data[2:]
+	values, err := r.readBitPacked(length, width)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range values {
+		out = append(out, r.decode(v))
+	}
+	return out, nil
+}
+
+func (r *intReader) readPatchedBase(out []int64) ([]int64, error) {
+	if len(r.data) < 4 {
+		return nil, errCorrupted("patched base")
+	}
+	width := decodeBitWidth(int(r.data[0] >> 1 & 0x1f))
+	length := (int(r.data[0]&0x01)<<8 | int(r.data[1])) + 1
+	baseWidth := int(r.data[2]>>5&0x07) + 1
+	patchWidth := decodeBitWidth(int(r.data[2] & 0x1f))
+	gapWidth := int(r.data[3]>>5&0x07) + 1
+	patchListLength := int(r.data[3] & 0x1f)
+	r.data = r.data[4:]
+
+	if len(r.data) < baseWidth {
+		return nil, errCorrupted("patched base")
+	}
+	var ubase uint64
+	for _, b := range r.data[:baseWidth] {
+		ubase = ubase<<8 | uint64(b)
+	}
+	r.data = r.data[baseWidth:]
+	// the most significant bit of the base is the sign bit.
+	signMask := uint64(1) << (baseWidth*8 - 1)
+	base := int64(ubase &^ signMask)
+	if ubase&signMask != 0 {
+		base = -base
+	}
+
+	values, err := r.readBitPacked(length, width)
+	if err != nil {
+		return nil, err
+	}
+	patches, err := r.readBitPacked(patchListLength, closestFixedBits(patchWidth+gapWidth))
+	if err != nil {
+		return nil, err
+	}
+	pos := 0
+	patchMask := uint64(1)<<patchWidth - 1
+	for _, p := range patches {
+		pos += int(p >> patchWidth)
+		patch := p & patchMask
+		// a gap longer than 255 is split into entries with empty patches.
+		if patch == 0 {
+			continue
+		}
+		if pos >= length {
+			return nil, errCorrupted("patch list")
+		}
+		values[pos] |= patch << width
+	}
+	for _, v := range values {
+		out = append(out, base+int64(v))
+	}
+	return out, nil
+}
+
+func (r *intReader) readDelta(out []int64) ([]int64, error) {
+	if len(r.data) < 2 {
+		return nil, errCorrupted("delta")
+	}
+	width := int(r.data[0] >> 1 & 0x1f)
+	if width != 0 {
+		width = decodeBitWidth(width)
+	}
+	length := (int(r.data[0]&0x01)<<8 | int(r.data[1])) + 1
+	r.data = r.data[2:]
+
+	base, err := r.readVarint()
+	if err != nil {
+		return nil, err
+	}
+	deltaBase, n := binary.Varint(r.data)
+	if n <= 0 {
+		return nil, errCorrupted("delta")
+	}
+	r.data = r.data[n:]
+
+	out = append(out, base)
+	if length == 1 {
+		return out, nil
+	}
+	prev := base + deltaBase
+	out = append(out, prev)
+	if width == 0 {
+		// fixed delta
+		for i := 2; i < length; i++ {
+			prev += deltaBase
+			out = append(out, prev)
+		}
+		return out, nil
+	}
+	deltas, err := r.readBitPacked(length-2, width)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range deltas {
+		if deltaBase < 0 {
+			prev -= int64(d)
+		} else {
+			prev += int64(d)
+		}
+		out = append(out, prev)
+	}
+	return out, nil
+}
+
+// readBitPacked reads n values of width bits packed from the most
+// significant bit, the run ends at a byte boundary.
+func (r *intReader) readBitPacked(n, width int) ([]uint64, error) {
+	total := (n*width + 7) / 8
+	if len(r.data) < total {
+		return nil, errCorrupted("bit packed values")
+	}
+	data := r.data[:total]
+	r.data = r.data[total:]
+	out := make([]uint64, n)
+	bitPos := 0
+	for i := range out {
+		var v uint64
+		for left := width; left > 0; {
+			b := data[bitPos/8]
+			avail := 8 - bitPos%8
+			take := min(avail, left)
+			shift := avail - take
+			v = v<<take | uint64(b>>shift)&(1<<take-1)
+			left -= take
+			bitPos += take
+		}
+		out[i] = v
+	}
+	return out, nil
+}
+
+// decodeBitWidth decodes the 5 bits width code of the version 2 encoding.
+func decodeBitWidth(code int) int {
+	switch {
+	case code <= 23:
+		return code + 1
+	case code == 24:
+		return 26
+	case code == 25:
+		return 28
+	case code == 26:
+		return 30
+	case code == 27:
+		return 32
+	case code == 28:
+		return 40
+	case code == 29:
+		return 48
+	case code == 30:
+		return 56
+	default:
+		return 64
+	}
+}
+
+func closestFixedBits(n int) int {
+	switch {
+	case n == 0:
+		return 1
+	case n <= 24:
+		return n
+	case n <= 26:
+		return 26
+	case n <= 28:
+		return 28
+	case n <= 30:
+		return 30
+	case n <= 32:
+		return 32
+	case n <= 40:
+		return 40
+	case n <= 48:
+		return 48
+	case n <= 56:
+		return 56
+	default:
+		return 64
+	}
+}
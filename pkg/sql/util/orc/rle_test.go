@@ -0,0 +1,122 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestByteRLE(t *testing.T) {
+	// the examples of the specification
+	bs, err := readByteRLE([]byte{0x61, 0x00}, 100)
+	require.NoError(t, err)
+	require.Equal(t, make([]byte, 100), bs)
+
+	bs, err = readByteRLE([]byte{0xfe, 0x44, 0x45}, 2)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x44, 0x45}, bs)
+
+	bools, err := readBooleanRLE([]byte{0xff, 0x80}, 8)
+	require.NoError(t, err)
+	require.Equal(t, []bool{true, false, false, false, false, false, false, false}, bools)
+
+	_, err = readByteRLE([]byte{0xfe, 0x44}, 2)
+	require.Error(t, err)
+}
+
+func TestIntRLEv1(t *testing.T) {
+	// the examples of the specification
+	vs, err := newIntReader([]byte{0x61, 0x00, 0x07}, false, encodingDirect).next(100)
+	require.NoError(t, err)
+	for _, v := range vs {
+		require.Equal(t, int64(7), v)
+	}
+
+	vs, err = newIntReader([]byte{0x61, 0xff, 0x64}, false, encodingDirect).next(100)
+	require.NoError(t, err)
+	for i, v := range vs {
+		require.Equal(t, int64(100-i), v)
+	}
+
+	vs, err = newIntReader([]byte{0xfb, 0x02, 0x03, 0x06, 0x07, 0xb}, false, encodingDirect).next(5)
+	require.NoError(t, err)
+	require.Equal(t, []int64{2, 3, 6, 7, 11}, vs)
+
+	vs, err = newIntReader([]byte{0xfd, 0x01, 0x02, 0x03}, true, encodingDirect).next(3)
+	require.NoError(t, err)
+	require.Equal(t, []int64{-1, 1, -2}, vs)
+}
+
+func TestIntRLEv2(t *testing.T) {
+	// the examples of the specification
+	cases := []struct {
+		name   string
+		data   []byte
+		values []int64
+	}{
+		{
+			name:   "short repeat",
+			data:   []byte{0x0a, 0x27, 0x10},
+			values: []int64{10000, 10000, 10000, 10000, 10000},
+		},
+		{
+			name:   "direct",
+			data:   []byte{0x5e, 0x03, 0x5c, 0xa1, 0xab, 0x1e, 0xde, 0xad, 0xbe, 0xef},
+			values: []int64{23713, 43806, 57005, 48879},
+		},
+		{
+			name: "patched base",
+			data: []byte{0x8e, 0x13, 0x2b, 0x21, 0x07, 0xd0, 0x1e, 0x00, 0x14, 0x70, 0x28, 0x32,
+				0x3c, 0x46, 0x50, 0x5a, 0x64, 0x6e, 0x78, 0x82, 0x8c, 0x96, 0xa0, 0xaa, 0xb4, 0xbe, 0xfc, 0xe8},
+			values: []int64{2030, 2000, 2020, 1000000, 2040, 2050, 2060, 2070, 2080, 2090, 2100,
+				2110, 2120, 2130, 2140, 2150, 2160, 2170, 2180, 2190},
+		},
+		{
+			name:   "delta",
+			data:   []byte{0xc6, 0x09, 0x02, 0x02, 0x22, 0x42, 0x42, 0x46},
+			values: []int64{2, 3, 5, 7, 11, 13, 17, 19, 23, 29},
+		},
+		{
+			name:   "fixed delta",
+			data:   []byte{0xc0, 0x04, 0x0a, 0x04},
+			values: []int64{10, 12, 14, 16, 18},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			vs, err := newIntReader(c.data, false, encodingDirectV2).next(len(c.values))
+			require.NoError(t, err)
+			require.Equal(t, c.values, vs)
+		})
+	}
+
+	// signed values are zigzag encoded
+	vs, err := newIntReader([]byte{0x0a, 0x27, 0x10}, true, encodingDirectV2).next(5)
+	require.NoError(t, err)
+	require.Equal(t, int64(5000), vs[0])
+
+	_, err = newIntReader([]byte{0x5e, 0x03, 0x5c}, false, encodingDirectV2).next(4)
+	require.Error(t, err)
+}
+
+func TestDecodeNanos(t *testing.T) {
+	require.Equal(t, int64(0), decodeNanos(0))
+	require.Equal(t, int64(123456789), decodeNanos(123456789<<3))
+	require.Equal(t, int64(100000000), decodeNanos(uint64(encodeNanos(100000000))))
+	require.Equal(t, int64(120000), decodeNanos(uint64(encodeNanos(120000))))
+	require.Equal(t, int64(10), decodeNanos(uint64(encodeNanos(10))))
+}
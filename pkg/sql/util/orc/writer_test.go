@@ -0,0 +1,462 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orc
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// testColumn is a top level column written by writeTestFile. The values
+// are nil for nulls, or int64, float64, string, bool, decimal (the unscaled
+// value in the scale of the type) and time.Time according to the kind.
+type testColumn struct {
+	name   string
+	kind   Kind
+	scale  uint64
+	dict   bool
+	values []any
+}
+
+// writeTestFile writes an ORC file with the version 1 integer encoding,
+// stripeRows rows in each stripe.
+func writeTestFile(t *testing.T, compression CompressionKind, stripeRows int, cols []testColumn) []byte {
+	var buf bytes.Buffer
+	buf.WriteString(magic)
+
+	rows := len(cols[0].values)
+	var stripes [][]byte
+	var stripeStats [][]byte
+	for start := 0; start < rows; start += stripeRows {
+		end := min(start+stripeRows, rows)
+		var streams []byte
+		var data bytes.Buffer
+		var encodings []byte
+		// the root struct
+		encodings = protowire.AppendTag(encodings, 2, protowire.BytesType)
+		encodings = protowire.AppendBytes(encodings, encodeColumnEncoding(encodingDirect, 0))
+		stats := [][]byte{encodeStatistics(testColumn{}, nil)}
+		for i, col := range cols {
+			values := col.values[start:end]
+			enc, colStreams := encodeTestColumn(col, values)
+			for _, st := range colStreams {
+				content := compressTest(t, compression, st.data)
+				data.Write(content)
+				streams = protowire.AppendTag(streams, 1, protowire.BytesType)
+				streams = protowire.AppendBytes(streams, encodeStream(st.kind, uint64(i+1), uint64(len(content))))
+			}
+			encodings = protowire.AppendTag(encodings, 2, protowire.BytesType)
+			encodings = protowire.AppendBytes(encodings, enc)
+			stats = append(stats, encodeStatistics(col, values))
+		}
+		sf := append(streams, encodings...)
+		sf = protowire.AppendTag(sf, 3, protowire.BytesType)
+		sf = protowire.AppendString(sf, "UTC")
+		sfData := compressTest(t, compression, sf)
+
+		var si []byte
+		si = protowire.AppendTag(si, 1, protowire.VarintType)
+		si = protowire.AppendVarint(si, uint64(buf.Len()))
+		si = protowire.AppendTag(si, 2, protowire.VarintType)
+		si = protowire.AppendVarint(si, 0)
+		si = protowire.AppendTag(si, 3, protowire.VarintType)
+		si = protowire.AppendVarint(si, uint64(data.Len()))
+		si = protowire.AppendTag(si, 4, protowire.VarintType)
+		si = protowire.AppendVarint(si, uint64(len(sfData)))
+		si = protowire.AppendTag(si, 5, protowire.VarintType)
+		si = protowire.AppendVarint(si, uint64(end-start))
+		stripes = append(stripes, si)
+
+		buf.Write(data.Bytes())
+		buf.Write(sfData)
+
+		var ss []byte
+		for _, s := range stats {
+			ss = protowire.AppendTag(ss, 1, protowire.BytesType)
+			ss = protowire.AppendBytes(ss, s)
+		}
+		stripeStats = append(stripeStats, ss)
+	}
+
+	var metadata []byte
+	for _, ss := range stripeStats {
+		metadata = protowire.AppendTag(metadata, 1, protowire.BytesType)
+		metadata = protowire.AppendBytes(metadata, ss)
+	}
+	metadata = compressTest(t, compression, metadata)
+
+	var footer []byte
+	for _, si := range stripes {
+		footer = protowire.AppendTag(footer, 3, protowire.BytesType)
+		footer = protowire.AppendBytes(footer, si)
+	}
+	var root []byte
+	root = protowire.AppendTag(root, 1, protowire.VarintType)
+	root = protowire.AppendVarint(root, uint64(KindStruct))
+	for i, col := range cols {
+		root = protowire.AppendTag(root, 2, protowire.VarintType)
+		root = protowire.AppendVarint(root, uint64(i+1))
+		root = protowire.AppendTag(root, 3, protowire.BytesType)
+		root = protowire.AppendString(root, col.name)
+	}
+	footer = protowire.AppendTag(footer, 4, protowire.BytesType)
+	footer = protowire.AppendBytes(footer, root)
+	for _, col := range cols {
+		var typ []byte
+		typ = protowire.AppendTag(typ, 1, protowire.VarintType)
+		typ = protowire.AppendVarint(typ, uint64(col.kind))
+		if col.kind == KindDecimal {
+			typ = protowire.AppendTag(typ, 5, protowire.VarintType)
+			typ = protowire.AppendVarint(typ, 38)
+			typ = protowire.AppendTag(typ, 6, protowire.VarintType)
+			typ = protowire.AppendVarint(typ, col.scale)
+		}
+		footer = protowire.AppendTag(footer, 4, protowire.BytesType)
+		footer = protowire.AppendBytes(footer, typ)
+	}
+	footer = protowire.AppendTag(footer, 6, protowire.VarintType)
+	footer = protowire.AppendVarint(footer, uint64(rows))
+	footer = compressTest(t, compression, footer)
+
+	var ps []byte
+	ps = protowire.AppendTag(ps, 1, protowire.VarintType)
+	ps = protowire.AppendVarint(ps, uint64(len(footer)))
+	ps = protowire.AppendTag(ps, 2, protowire.VarintType)
+	ps = protowire.AppendVarint(ps, uint64(compression))
+	ps = protowire.AppendTag(ps, 3, protowire.VarintType)
+	ps = protowire.AppendVarint(ps, 256*1024)
+	ps = protowire.AppendTag(ps, 5, protowire.VarintType)
+	ps = protowire.AppendVarint(ps, uint64(len(metadata)))
+	ps = protowire.AppendTag(ps, 8000, protowire.BytesType)
+	ps = protowire.AppendString(ps, magic)
+
+	buf.Write(metadata)
+	buf.Write(footer)
+	buf.Write(ps)
+	buf.WriteByte(byte(len(ps)))
+	return buf.Bytes()
+}
+
+type testStream struct {
+	kind streamKind
+	data []byte
+}
+
+func encodeTestColumn(col testColumn, values []any) ([]byte, []testStream) {
+	var streams []testStream
+	present := make([]bool, len(values))
+	hasNull := false
+	var nonNull []any
+	for i, v := range values {
+		present[i] = v != nil
+		if v == nil {
+			hasNull = true
+		} else {
+			nonNull = append(nonNull, v)
+		}
+	}
+	if hasNull {
+		streams = append(streams, testStream{kind: streamPresent, data: encodeBooleans(present)})
+	}
+	encoding := encodingDirect
+	var dictSize uint64
+	switch col.kind {
+	case KindBoolean:
+		bs := make([]bool, len(nonNull))
+		for i, v := range nonNull {
+			bs[i] = v.(bool)
+		}
+		streams = append(streams, testStream{kind: streamData, data: encodeBooleans(bs)})
+	case KindByte:
+		bs := make([]byte, len(nonNull))
+		for i, v := range nonNull {
+			bs[i] = byte(v.(int64))
+		}
+		streams = append(streams, testStream{kind: streamData, data: encodeBytes(bs)})
+	case KindShort, KindInt, KindLong, KindDate:
+		vs := make([]int64, len(nonNull))
+		for i, v := range nonNull {
+			vs[i] = v.(int64)
+		}
+		streams = append(streams, testStream{kind: streamData, data: encodeInts(vs, true)})
+	case KindFloat:
+		var data []byte
+		for _, v := range nonNull {
+			data = binary.LittleEndian.AppendUint32(data, math.Float32bits(float32(v.(float64))))
+		}
+		streams = append(streams, testStream{kind: streamData, data: data})
+	case KindDouble:
+		var data []byte
+		for _, v := range nonNull {
+			data = binary.LittleEndian.AppendUint64(data, math.Float64bits(v.(float64)))
+		}
+		streams = append(streams, testStream{kind: streamData, data: data})
+	case KindString, KindVarchar, KindChar, KindBinary:
+		if col.dict {
+			encoding = encodingDictionary
+			var dict []string
+			pos := make(map[string]int64)
+			indexes := make([]int64, len(nonNull))
+			for i, v := range nonNull {
+				s := v.(string)
+				p, ok := pos[s]
+				if !ok {
+					p = int64(len(dict))
+					pos[s] = p
+					dict = append(dict, s)
+				}
+				indexes[i] = p
+			}
+			var data []byte
+			lengths := make([]int64, len(dict))
+			for i, s := range dict {
+				data = append(data, s...)
+				lengths[i] = int64(len(s))
+			}
+			dictSize = uint64(len(dict))
+			streams = append(streams,
+				testStream{kind: streamData, data: encodeInts(indexes, false)},
+				testStream{kind: streamLength, data: encodeInts(lengths, false)},
+				testStream{kind: streamDictionaryData, data: data},
+			)
+		} else {
+			var data []byte
+			lengths := make([]int64, len(nonNull))
+			for i, v := range nonNull {
+				data = append(data, v.(string)...)
+				lengths[i] = int64(len(v.(string)))
+			}
+			streams = append(streams,
+				testStream{kind: streamData, data: data},
+				testStream{kind: streamLength, data: encodeInts(lengths, false)},
+			)
+		}
+	case KindDecimal:
+		var data []byte
+		scales := make([]int64, len(nonNull))
+		for i, v := range nonNull {
+			data = binary.AppendVarint(data, v.(int64))
+			scales[i] = int64(col.scale)
+		}
+		streams = append(streams,
+			testStream{kind: streamData, data: data},
+			testStream{kind: streamSecondary, data: encodeInts(scales, true)},
+		)
+	case KindTimestamp, KindTimestampInstant:
+		epoch := time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC).Unix()
+		secs := make([]int64, len(nonNull))
+		nanos := make([]int64, len(nonNull))
+		for i, v := range nonNull {
+			tm := v.(time.Time)
+			secs[i] = tm.Unix() - epoch
+			nanos[i] = encodeNanos(int64(tm.Nanosecond()))
+		}
+		streams = append(streams,
+			testStream{kind: streamData, data: encodeInts(secs, true)},
+			testStream{kind: streamSecondary, data: encodeInts(nanos, false)},
+		)
+	}
+	return encodeColumnEncoding(encoding, dictSize), streams
+}
+
+func encodeNanos(nano int64) int64 {
+	if nano == 0 {
+		return 0
+	}
+	zeros := int64(0)
+	for nano%10 == 0 && zeros < 8 {
+		nano /= 10
+		zeros++
+	}
+	if zeros < 2 {
+		// not worth to remove
+		for ; zeros > 0; zeros-- {
+			nano *= 10
+		}
+		return nano << 3
+	}
+	return nano<<3 | (zeros - 1)
+}
+
+// encodeBytes writes the bytes as literals of the byte run length encoding.
+func encodeBytes(bs []byte) []byte {
+	var out []byte
+	for len(bs) > 0 {
+		n := min(len(bs), 128)
+		out = append(out, byte(-int8(n-1)-1))
+		out = append(out, bs[:n]...)
+		bs = bs[n:]
+	}
+	return out
+}
+
+func encodeBooleans(bs []bool) []byte {
+	packed := make([]byte, (len(bs)+7)/8)
+	for i, b := range bs {
+		if b {
+			packed[i/8] |= 0x80 >> (i % 8)
+		}
+	}
+	return encodeBytes(packed)
+}
+
+// encodeInts writes the integers as literals of the version 1 encoding.
+func encodeInts(vs []int64, signed bool) []byte {
+	var out []byte
+	for len(vs) > 0 {
+		n := min(len(vs), 128)
+		out = append(out, byte(-int8(n-1)-1))
+		for _, v := range vs[:n] {
+			if signed {
+				out = binary.AppendVarint(out, v)
+			} else {
+				out = binary.AppendUvarint(out, uint64(v))
+			}
+		}
+		vs = vs[n:]
+	}
+	return out
+}
+
+func encodeColumnEncoding(kind encodingKind, dictSize uint64) []byte {
+	var out []byte
+	out = protowire.AppendTag(out, 1, protowire.VarintType)
+	out = protowire.AppendVarint(out, uint64(kind))
+	if dictSize > 0 {
+		out = protowire.AppendTag(out, 2, protowire.VarintType)
+		out = protowire.AppendVarint(out, dictSize)
+	}
+	return out
+}
+
+func encodeStream(kind streamKind, column, length uint64) []byte {
+	var out []byte
+	out = protowire.AppendTag(out, 1, protowire.VarintType)
+	out = protowire.AppendVarint(out, uint64(kind))
+	out = protowire.AppendTag(out, 2, protowire.VarintType)
+	out = protowire.AppendVarint(out, column)
+	out = protowire.AppendTag(out, 3, protowire.VarintType)
+	out = protowire.AppendVarint(out, length)
+	return out
+}
+
+func encodeStatistics(col testColumn, values []any) []byte {
+	var out []byte
+	var n uint64
+	hasNull := false
+	var minV, maxV any
+	for _, v := range values {
+		if v == nil {
+			hasNull = true
+			continue
+		}
+		n++
+		if minV == nil || lessTestValue(v, minV) {
+			minV = v
+		}
+		if maxV == nil || lessTestValue(maxV, v) {
+			maxV = v
+		}
+	}
+	out = protowire.AppendTag(out, 1, protowire.VarintType)
+	out = protowire.AppendVarint(out, n)
+	if minV != nil {
+		var sub []byte
+		num := protowire.Number(0)
+		switch col.kind {
+		case KindByte, KindShort, KindInt, KindLong:
+			num = 2
+			sub = protowire.AppendTag(sub, 1, protowire.VarintType)
+			sub = protowire.AppendVarint(sub, protowire.EncodeZigZag(minV.(int64)))
+			sub = protowire.AppendTag(sub, 2, protowire.VarintType)
+			sub = protowire.AppendVarint(sub, protowire.EncodeZigZag(maxV.(int64)))
+		case KindFloat, KindDouble:
+			num = 3
+			sub = protowire.AppendTag(sub, 1, protowire.Fixed64Type)
+			sub = protowire.AppendFixed64(sub, math.Float64bits(minV.(float64)))
+			sub = protowire.AppendTag(sub, 2, protowire.Fixed64Type)
+			sub = protowire.AppendFixed64(sub, math.Float64bits(maxV.(float64)))
+		case KindString, KindVarchar, KindChar:
+			num = 4
+			sub = protowire.AppendTag(sub, 1, protowire.BytesType)
+			sub = protowire.AppendString(sub, minV.(string))
+			sub = protowire.AppendTag(sub, 2, protowire.BytesType)
+			sub = protowire.AppendString(sub, maxV.(string))
+		case KindDate:
+			num = 7
+			sub = protowire.AppendTag(sub, 1, protowire.VarintType)
+			sub = protowire.AppendVarint(sub, protowire.EncodeZigZag(minV.(int64)))
+			sub = protowire.AppendTag(sub, 2, protowire.VarintType)
+			sub = protowire.AppendVarint(sub, protowire.EncodeZigZag(maxV.(int64)))
+		}
+		if num != 0 {
+			out = protowire.AppendTag(out, num, protowire.BytesType)
+			out = protowire.AppendBytes(out, sub)
+		}
+	}
+	out = protowire.AppendTag(out, 10, protowire.VarintType)
+	out = protowire.AppendVarint(out, protowire.EncodeBool(hasNull))
+	return out
+}
+
+func lessTestValue(a, b any) bool {
+	switch x := a.(type) {
+	case int64:
+		return x < b.(int64)
+	case float64:
+		return x < b.(float64)
+	case string:
+		return x < b.(string)
+	}
+	return false
+}
+
+func compressTest(t *testing.T, kind CompressionKind, data []byte) []byte {
+	if kind == CompressionNone || len(data) == 0 {
+		return data
+	}
+	require.Equal(t, CompressionZlib, kind)
+	var buf bytes.Buffer
+	w, err := flate.NewWriter(&buf, flate.BestCompression)
+	require.NoError(t, err)
+	_, err = w.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	compressed := buf.Bytes()
+	isOriginal := uint32(0)
+	if len(compressed) >= len(data) {
+		compressed = data
+		isOriginal = 1
+	}
+	header := uint32(len(compressed))<<1 | isOriginal
+	out := []byte{byte(header), byte(header >> 8), byte(header >> 16)}
+	return append(out, compressed...)
+}
+
+func decimalOf(v int64) types.Decimal128 {
+	d := types.Decimal128{B0_63: uint64(v)}
+	if v < 0 {
+		d.B64_127 = math.MaxUint64
+	}
+	return d
+}
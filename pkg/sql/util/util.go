@@ -75,6 +75,14 @@ func SplitTableAndColumn(name string) (string, string) {
 	return schema, xs[len(xs)-1]
 }
 
+// QuoteSqlString escapes the string to be used in a single-quoted string
+// literal of the internal sql, the backslash is an escape character too.
+func QuoteSqlString(s string) string {
+	return sqlStringReplacer.Replace(s)
+}
+
+var sqlStringReplacer = strings.NewReplacer(`\`, `\\`, "'", "''")
+
 func TableIsLoggingTable(dbName string, tableName string) bool {
 	if tableName == "statement_info" && dbName == "system" {
 		return true
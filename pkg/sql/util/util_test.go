@@ -74,3 +74,8 @@ func Test_SplitNameOfPartitionTable(t *testing.T) {
 		}
 	}
 }
+
+func Test_QuoteSqlString(t *testing.T) {
+	require.Equal(t, `a''b\\c`, QuoteSqlString(`a'b\c`))
+	require.Equal(t, "abc", QuoteSqlString("abc"))
+}
@@ -23,16 +23,13 @@ import (
 	"fmt"
 	"math"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
 	"github.com/confluentinc/confluent-kafka-go/v2/schemaregistry"
-	"github.com/gogo/protobuf/proto"
 	"github.com/google/uuid"
-	"github.com/jhump/protoreflect/desc"
-	"github.com/jhump/protoreflect/desc/protoparse"
-	"github.com/jhump/protoreflect/dynamic"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
@@ -63,6 +60,9 @@ const (
 	BootstrapServersKey = "bootstrap.servers"
 	ProtobufSchemaKey   = "protobuf.schema"
 	ProtobufMessagekey  = "protobuf.message"
+	// ProtobufDescriptorSetKey is the base64 encoded FileDescriptorSet, it
+	// is used if the protobuf.schema is not specified.
+	ProtobufDescriptorSetKey = "protobuf.descriptor_set"
 
 	SchemaRegistryKey = "schema.registry"
 
@@ -97,13 +97,28 @@ func (j *JsonDataGetter) GetFieldValue(name string) (interface{}, bool) {
 	return val, ok
 }
 
+// ProtoDataGetter gets the fields of a message decoded by DecodeProtobuf.
+// The fields of nested messages are got by the dotted paths like "a.b".
 type ProtoDataGetter struct {
-	Value *dynamic.Message
-	Key   any
+	Data map[string]any
+	Key  any
 }
 
 func (p *ProtoDataGetter) GetFieldValue(name string) (interface{}, bool) {
-	val := p.Value.GetFieldByName(name)
+	val, ok := p.Data[name]
+	if !ok {
+		obj := p.Data
+		for _, part := range strings.Split(name, ".") {
+			if obj == nil {
+				return nil, false
+			}
+			if val, ok = obj[part]; !ok {
+				return nil, false
+			}
+			obj, _ = val.(map[string]any)
+		}
+	}
+	val = protoColumnValue(val)
 	return val, val != nil
 }
 
@@ -119,6 +134,30 @@ type KafkaAdapterInterface interface {
 	GetKafkaConsumer() (*kafka.Consumer, error)
 	ProduceMessage(topic string, key, value []byte) (int64, error)
 }
+
+// Consumer is the part of *kafka.Consumer used to ingest messages from the
+// assigned partitions, the offsets are managed by the caller instead of the
+// consumer group.
+type Consumer interface {
+	Assign(partitions []kafka.TopicPartition) error
+	GetMetadata(topic *string, allTopics bool, timeoutMs int) (*kafka.Metadata, error)
+	Poll(timeoutMs int) kafka.Event
+	IsClosed() bool
+	Close() error
+}
+
+var _ Consumer = (*kafka.Consumer)(nil)
+
+// Producer publishes messages to kafka topics.
+type Producer interface {
+	// ProduceMessage publishes the message and waits for it to be delivered,
+	// the offset of the message in its partition is returned.
+	ProduceMessage(topic string, key, value []byte) (int64, error)
+	Close()
+}
+
+var _ Producer = (*KafkaAdapter)(nil)
+
 type KafkaAdapter struct {
 	Producer       *kafka.Producer
 	Consumer       *kafka.Consumer
@@ -168,6 +207,20 @@ func NewKafkaAdapter(configMap *kafka.ConfigMap) (KafkaAdapterInterface, error)
 	}, nil
 }
 
+// NewKafkaProducer creates a Producer. Unlike NewKafkaAdapter, it creates
+// neither the consumer nor the admin client, so no group.id is required.
+func NewKafkaProducer(configMap *kafka.ConfigMap) (Producer, error) {
+	producer, err := kafka.NewProducer(configMap)
+	if err != nil {
+		return nil, moerr.NewInternalError(context.Background(), fmt.Sprintf("unable to create confluent producer client: %s", err))
+	}
+	return &KafkaAdapter{
+		Producer:  producer,
+		ConfigMap: configMap,
+		Connected: true,
+	}, nil
+}
+
 func (ka *KafkaAdapter) GetKafkaConsumer() (*kafka.Consumer, error) {
 	return ka.Consumer, nil
 }
@@ -470,33 +523,38 @@ func PopulateBatchFromMSG(ctx context.Context, ka KafkaAdapterInterface, typs []
 			}
 		}
 	case PROTOBUF:
-		md, err := convertProtobufSchemaToMD(configs["protobuf.schema"].(string), configs["protobuf.message"].(string))
+		schema, _ := configs[ProtobufSchemaKey].(string)
+		descriptorSet, _ := configs[ProtobufDescriptorSetKey].(string)
+		message, _ := configs[ProtobufMessagekey].(string)
+		md, err := ParseProtobufDescriptor(schema, descriptorSet, message)
 		if err != nil {
 			return nil, err
 		}
 		for i, msg := range msgs {
-			msgValue, err := deserializeProtobuf(md, msg.Value, false)
+			data, err := DecodeProtobuf(md, msg.Value)
 			if err != nil {
 				return nil, err
 			}
-			err = populateOneRowData(ctx, b, attrKeys, &ProtoDataGetter{Value: msgValue, Key: msg.Key}, i, typs, mp)
+			err = populateOneRowData(ctx, b, attrKeys, &ProtoDataGetter{Data: data, Key: msg.Key}, i, typs, mp)
 			if err != nil {
 				return nil, err
 			}
 		}
 
 	case PROTOBUFSR:
-		schema, err := ka.GetSchemaForTopic(configs[TopicKey].(string), false)
-		if err != nil {
-			return nil, err
-		}
-		md, err := convertProtobufSchemaToMD(schema.Schema, schema.SchemaInfo.Schema)
+		url, _ := configs[SchemaRegistryKey].(string)
+		topic, _ := configs[TopicKey].(string)
+		message, _ := configs[ProtobufMessagekey].(string)
+		decoder, err := NewProtobufRegistryDecoder(url, topic, message)
 		if err != nil {
 			return nil, err
 		}
 		for i, msg := range msgs {
-			msgValue, _ := deserializeProtobuf(md, msg.Value, true)
-			err := populateOneRowData(ctx, b, attrKeys, &ProtoDataGetter{Value: msgValue, Key: msg.Key}, i, typs, mp)
+			data, err := decoder.Decode(msg.Value)
+			if err != nil {
+				return nil, err
+			}
+			err = populateOneRowData(ctx, b, attrKeys, &ProtoDataGetter{Data: data, Key: msg.Key}, i, typs, mp)
 			if err != nil {
 				return nil, err
 			}
@@ -959,39 +1017,6 @@ func populateOneRowData(ctx context.Context, bat *batch.Batch, attrKeys []string
 	return nil
 }
 
-func convertProtobufSchemaToMD(schema string, msgTypeName string) (*desc.MessageDescriptor, error) {
-	files := map[string]string{
-		"test.proto": schema,
-	}
-
-	parser := protoparse.Parser{
-		Accessor: protoparse.FileContentsFromMap(files),
-	}
-	fds, err := parser.ParseFiles("test.proto")
-
-	if err != nil {
-		return nil, err
-	}
-	fd := fds[0]
-	md := fd.FindMessage(msgTypeName)
-	return md, nil
-}
-
-func deserializeProtobuf(md *desc.MessageDescriptor, in []byte, isKafkSR bool) (*dynamic.Message, error) {
-	dm := dynamic.NewMessage(md)
-	var err error
-	if isKafkSR {
-		bytesRead, _, err := readMessageIndexes(in[5:])
-		if err != nil {
-			return nil, err
-		}
-		proto.Unmarshal(in[5+bytesRead:], dm)
-	} else {
-		err = dm.Unmarshal(in)
-	}
-	return dm, err
-}
-
 func readMessageIndexes(payload []byte) (int, []int, error) {
 	arrayLen, bytesRead := binary.Varint(payload)
 	if bytesRead <= 0 {
@@ -1049,6 +1074,8 @@ func ValidateConfig(ctx context.Context, configs map[string]interface{}, factory
 		RelkindKey,
 		ProtobufMessagekey,
 		ProtobufSchemaKey,
+		ProtobufDescriptorSetKey,
+		SchemaRegistryKey,
 	}
 
 	// Create a set of allowed keys
@@ -1083,7 +1110,9 @@ func ValidateConfig(ctx context.Context, configs map[string]interface{}, factory
 		// no additional checks required
 	case PROTOBUF:
 		// check the schema and message name has been set or not
-		if _, ok := configs[ProtobufSchemaKey]; !ok {
+		_, hasSchema := configs[ProtobufSchemaKey]
+		_, hasDescriptorSet := configs[ProtobufDescriptorSetKey]
+		if !hasSchema && !hasDescriptorSet {
 			return moerr.NewInternalErrorf(ctx, "missing required key: %s", ProtobufSchemaKey)
 		}
 		if _, ok := configs[ProtobufMessagekey]; !ok {
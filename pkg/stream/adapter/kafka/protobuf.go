@@ -0,0 +1,344 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mokafka
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/schemaregistry"
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/desc/protoparse"
+	"github.com/jhump/protoreflect/dynamic"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	protoTimestampName = "google.protobuf.Timestamp"
+	protoDurationName  = "google.protobuf.Duration"
+
+	// confluentMagicByte is the first byte of the messages serialized with
+	// the confluent schema registry, it is followed by the 4 bytes schema id.
+	confluentMagicByte = 0
+)
+
+// ParseProtobufDescriptor returns the descriptor of the message type from
+// an inline .proto schema, or from a base64 encoded FileDescriptorSet if the
+// schema is empty. If message is empty, the first message type of the last
+// file is used.
+func ParseProtobufDescriptor(schema, descriptorSet, message string) (*desc.MessageDescriptor, error) {
+	ctx := context.Background()
+	var files []*desc.FileDescriptor
+	switch {
+	case schema != "":
+		fd, err := parseProtoFile(schema, nil)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, fd)
+	case descriptorSet != "":
+		data, err := base64.StdEncoding.DecodeString(descriptorSet)
+		if err != nil {
+			return nil, moerr.NewInternalErrorf(ctx, "invalid protobuf descriptor set: %v", err)
+		}
+		set := &descriptorpb.FileDescriptorSet{}
+		if err = proto.Unmarshal(data, set); err != nil {
+			return nil, moerr.NewInternalErrorf(ctx, "invalid protobuf descriptor set: %v", err)
+		}
+		fds, err := desc.CreateFileDescriptorsFromSet(set)
+		if err != nil {
+			return nil, err
+		}
+		// keep the order of the set, the last file is the one depending on
+		// the others.
+		for _, fdp := range set.File {
+			files = append(files, fds[fdp.GetName()])
+		}
+	default:
+		return nil, moerr.NewInternalError(ctx, "neither protobuf schema nor descriptor set is specified")
+	}
+	return findProtoMessage(files, message)
+}
+
+func parseProtoFile(schema string, deps map[string]string) (*desc.FileDescriptor, error) {
+	const name = "schema.proto"
+	files := map[string]string{name: schema}
+	for k, v := range deps {
+		files[k] = v
+	}
+	// the well known types like google/protobuf/timestamp.proto are
+	// provided by the parser itself.
+	parser := protoparse.Parser{
+		Accessor: protoparse.FileContentsFromMap(files),
+	}
+	fds, err := parser.ParseFiles(name)
+	if err != nil {
+		return nil, err
+	}
+	return fds[0], nil
+}
+
+func findProtoMessage(files []*desc.FileDescriptor, message string) (*desc.MessageDescriptor, error) {
+	ctx := context.Background()
+	if len(files) == 0 {
+		return nil, moerr.NewInternalError(ctx, "empty protobuf schema")
+	}
+	if message == "" {
+		mds := files[len(files)-1].GetMessageTypes()
+		if len(mds) == 0 {
+			return nil, moerr.NewInternalError(ctx, "no message type is defined in the protobuf schema")
+		}
+		return mds[0], nil
+	}
+	for _, fd := range files {
+		if md := fd.FindMessage(message); md != nil {
+			return md, nil
+		}
+		// the name without package.
+		if pkg := fd.GetPackage(); pkg != "" {
+			if md := fd.FindMessage(pkg + "." + message); md != nil {
+				return md, nil
+			}
+		}
+	}
+	return nil, moerr.NewInternalErrorf(ctx, "protobuf message %s not found", message)
+}
+
+// messageByIndexes returns the message type located by the message indexes
+// of the confluent wire format, each index is the position of a message type
+// in the file or in its parent message.
+func messageByIndexes(fd *desc.FileDescriptor, indexes []int) (*desc.MessageDescriptor, error) {
+	mds := fd.GetMessageTypes()
+	var md *desc.MessageDescriptor
+	for _, idx := range indexes {
+		if idx < 0 || idx >= len(mds) {
+			return nil, moerr.NewInternalErrorf(context.Background(), "invalid protobuf message indexes %v", indexes)
+		}
+		md = mds[idx]
+		mds = md.GetNestedMessageTypes()
+	}
+	if md == nil {
+		return nil, moerr.NewInternalErrorf(context.Background(), "invalid protobuf message indexes %v", indexes)
+	}
+	return md, nil
+}
+
+// DecodeProtobuf decodes the message of md into a map keyed by the field
+// names. Nested messages are decoded into maps, repeated fields into slices
+// and map fields into maps keyed by the string form of the keys. The well
+// known google.protobuf.Timestamp is decoded into time.Time in UTC, and
+// google.protobuf.Duration into time.Duration. Unset message fields are nil.
+func DecodeProtobuf(md *desc.MessageDescriptor, data []byte) (map[string]any, error) {
+	dm := dynamic.NewMessage(md)
+	if err := dm.Unmarshal(data); err != nil {
+		return nil, err
+	}
+	return protoMessageToMap(dm), nil
+}
+
+func protoMessageToMap(dm *dynamic.Message) map[string]any {
+	fields := dm.GetMessageDescriptor().GetFields()
+	obj := make(map[string]any, len(fields))
+	for _, fd := range fields {
+		if fd.GetMessageType() != nil && !fd.IsRepeated() && !fd.IsMap() && !dm.HasField(fd) {
+			obj[fd.GetName()] = nil
+			continue
+		}
+		obj[fd.GetName()] = protoFieldValue(fd, dm.GetField(fd))
+	}
+	return obj
+}
+
+func protoFieldValue(fd *desc.FieldDescriptor, v any) any {
+	switch {
+	case fd.IsMap():
+		m, _ := v.(map[any]any)
+		obj := make(map[string]any, len(m))
+		valueFd := fd.GetMapValueType()
+		for k, item := range m {
+			obj[fmt.Sprint(k)] = protoSingleValue(valueFd, item)
+		}
+		return obj
+	case fd.IsRepeated():
+		items, _ := v.([]any)
+		list := make([]any, len(items))
+		for i, item := range items {
+			list[i] = protoSingleValue(fd, item)
+		}
+		return list
+	default:
+		return protoSingleValue(fd, v)
+	}
+}
+
+func protoSingleValue(fd *desc.FieldDescriptor, v any) any {
+	md := fd.GetMessageType()
+	if md == nil || v == nil {
+		return v
+	}
+	// the well known types are decoded into their generated types, and the
+	// other nested messages into dynamic messages.
+	switch x := v.(type) {
+	case *timestamppb.Timestamp:
+		return x.AsTime().UTC()
+	case *durationpb.Duration:
+		return x.AsDuration()
+	}
+	dm, ok := v.(*dynamic.Message)
+	if !ok {
+		return v
+	}
+	switch md.GetFullyQualifiedName() {
+	case protoTimestampName:
+		seconds, _ := dm.GetFieldByName("seconds").(int64)
+		nanos, _ := dm.GetFieldByName("nanos").(int32)
+		return time.Unix(seconds, int64(nanos)).UTC()
+	case protoDurationName:
+		seconds, _ := dm.GetFieldByName("seconds").(int64)
+		nanos, _ := dm.GetFieldByName("nanos").(int32)
+		return time.Duration(seconds)*time.Second + time.Duration(nanos)
+	}
+	return protoMessageToMap(dm)
+}
+
+// ProtobufRegistryDecoder decodes the messages serialized with the confluent
+// schema registry. The schema of each message is fetched by the schema id in
+// the message and cached.
+type ProtobufRegistryDecoder struct {
+	client schemaregistry.Client
+	// subject is the subject the schemas are registered under, which is
+	// <topic>-value by the default TopicNameStrategy.
+	subject string
+	// message is the full name of the message type, the message indexes in
+	// the data are used if it is empty.
+	message string
+
+	mu    sync.Mutex
+	files map[int]*desc.FileDescriptor
+}
+
+// NewProtobufRegistryDecoder creates a ProtobufRegistryDecoder of the topic
+// with the schema registry at url.
+func NewProtobufRegistryDecoder(url, topic, message string) (*ProtobufRegistryDecoder, error) {
+	client, err := schemaregistry.NewClient(schemaregistry.NewConfig(url))
+	if err != nil {
+		return nil, err
+	}
+	return NewProtobufRegistryDecoderWithClient(client, topic, message), nil
+}
+
+// NewProtobufRegistryDecoderWithClient creates a ProtobufRegistryDecoder of
+// the topic with the schema registry client.
+func NewProtobufRegistryDecoderWithClient(client schemaregistry.Client, topic, message string) *ProtobufRegistryDecoder {
+	var subject string
+	if topic != "" {
+		subject = topic + "-value"
+	}
+	return &ProtobufRegistryDecoder{
+		client:  client,
+		subject: subject,
+		message: message,
+		files:   make(map[int]*desc.FileDescriptor),
+	}
+}
+
+// Decode decodes the data like DecodeProtobuf.
+func (d *ProtobufRegistryDecoder) Decode(data []byte) (map[string]any, error) {
+	ctx := context.Background()
+	if len(data) < 5 || data[0] != confluentMagicByte {
+		return nil, moerr.NewInternalError(ctx, "invalid confluent protobuf message, unknown magic byte")
+	}
+	id := int(binary.BigEndian.Uint32(data[1:5]))
+	bytesRead, indexes, err := readMessageIndexes(data[5:])
+	if err != nil {
+		return nil, err
+	}
+	fd, err := d.getFile(id)
+	if err != nil {
+		return nil, err
+	}
+	var md *desc.MessageDescriptor
+	if d.message != "" {
+		md, err = findProtoMessage([]*desc.FileDescriptor{fd}, d.message)
+	} else {
+		md, err = messageByIndexes(fd, indexes)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return DecodeProtobuf(md, data[5+bytesRead:])
+}
+
+func (d *ProtobufRegistryDecoder) getFile(id int) (*desc.FileDescriptor, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if fd, ok := d.files[id]; ok {
+		return fd, nil
+	}
+	info, err := d.client.GetBySubjectAndID(d.subject, id)
+	if err != nil {
+		return nil, err
+	}
+	if info.SchemaType != "" && !strings.EqualFold(info.SchemaType, "PROTOBUF") {
+		return nil, moerr.NewInternalErrorf(context.Background(), "schema %d is not a protobuf schema but %s", id, info.SchemaType)
+	}
+	// the referenced schemas are imported by their names.
+	deps := make(map[string]string, len(info.References))
+	for _, ref := range info.References {
+		meta, err := d.client.GetSchemaMetadata(ref.Subject, ref.Version)
+		if err != nil {
+			return nil, err
+		}
+		deps[ref.Name] = meta.Schema
+	}
+	fd, err := parseProtoFile(info.Schema, deps)
+	if err != nil {
+		return nil, err
+	}
+	d.files[id] = fd
+	return fd, nil
+}
+
+// protoColumnValue converts a decoded protobuf value into the form accepted
+// by the column types. Times are formatted like datetime literals, nested
+// messages, lists and maps are encoded in json for json columns.
+func protoColumnValue(v any) any {
+	switch x := v.(type) {
+	case time.Time:
+		return x.Format("2006-01-02 15:04:05.999999")
+	case time.Duration:
+		return x.String()
+	case []byte:
+		return string(x)
+	case map[string]any, []any:
+		data, err := json.Marshal(x)
+		if err != nil {
+			return nil
+		}
+		return string(data)
+	default:
+		return v
+	}
+}
@@ -0,0 +1,207 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mokafka
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/confluentinc/confluent-kafka-go/v2/schemaregistry"
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/dynamic"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+const testOrderSchema = `syntax = "proto3";
+package shop;
+
+import "google/protobuf/timestamp.proto";
+
+message Order {
+  message Address {
+    string city = 1;
+    int32 zip = 2;
+  }
+  int64 id = 1;
+  string customer = 2;
+  Address address = 3;
+  repeated string tags = 4;
+  map<string, int32> counts = 5;
+  google.protobuf.Timestamp created_at = 6;
+  bytes raw = 7;
+}
+`
+
+// newTestOrder returns the serialized order.
+func newTestOrder(t *testing.T, md *desc.MessageDescriptor, withAddress bool) []byte {
+	msg := dynamic.NewMessage(md)
+	msg.SetFieldByName("id", int64(7))
+	msg.SetFieldByName("customer", "bob")
+	if withAddress {
+		addr := dynamic.NewMessage(md.FindFieldByName("address").GetMessageType())
+		addr.SetFieldByName("city", "Shanghai")
+		addr.SetFieldByName("zip", int32(200000))
+		msg.SetFieldByName("address", addr)
+	}
+	msg.SetFieldByName("tags", []string{"a", "b"})
+	msg.PutMapFieldByName("counts", "x", int32(1))
+	ts := dynamic.NewMessage(md.FindFieldByName("created_at").GetMessageType())
+	ts.SetFieldByName("seconds", int64(1700000000))
+	ts.SetFieldByName("nanos", int32(123000000))
+	msg.SetFieldByName("created_at", ts)
+	msg.SetFieldByName("raw", []byte("xyz"))
+	data, err := msg.Marshal()
+	require.NoError(t, err)
+	return data
+}
+
+func TestDecodeProtobuf(t *testing.T) {
+	md, err := ParseProtobufDescriptor(testOrderSchema, "", "shop.Order")
+	require.NoError(t, err)
+	// the name without package and the default message.
+	md2, err := ParseProtobufDescriptor(testOrderSchema, "", "Order")
+	require.NoError(t, err)
+	require.Equal(t, md.GetFullyQualifiedName(), md2.GetFullyQualifiedName())
+	md2, err = ParseProtobufDescriptor(testOrderSchema, "", "")
+	require.NoError(t, err)
+	require.Equal(t, md.GetFullyQualifiedName(), md2.GetFullyQualifiedName())
+	_, err = ParseProtobufDescriptor(testOrderSchema, "", "Unknown")
+	require.Error(t, err)
+	_, err = ParseProtobufDescriptor("", "", "Order")
+	require.Error(t, err)
+	_, err = ParseProtobufDescriptor("message {", "", "")
+	require.Error(t, err)
+
+	obj, err := DecodeProtobuf(md, newTestOrder(t, md, true))
+	require.NoError(t, err)
+	require.Equal(t, int64(7), obj["id"])
+	require.Equal(t, "bob", obj["customer"])
+	require.Equal(t, map[string]any{"city": "Shanghai", "zip": int32(200000)}, obj["address"])
+	require.Equal(t, []any{"a", "b"}, obj["tags"])
+	require.Equal(t, map[string]any{"x": int32(1)}, obj["counts"])
+	require.Equal(t, time.Unix(1700000000, 123000000).UTC(), obj["created_at"])
+	require.Equal(t, []byte("xyz"), obj["raw"])
+
+	obj, err = DecodeProtobuf(md, newTestOrder(t, md, false))
+	require.NoError(t, err)
+	require.Nil(t, obj["address"])
+
+	_, err = DecodeProtobuf(md, []byte{0xff, 0xff})
+	require.Error(t, err)
+}
+
+func TestParseProtobufDescriptorSet(t *testing.T) {
+	md, err := ParseProtobufDescriptor(testOrderSchema, "", "")
+	require.NoError(t, err)
+	set := &descriptorpb.FileDescriptorSet{}
+	for _, dep := range md.GetFile().GetDependencies() {
+		set.File = append(set.File, dep.AsFileDescriptorProto())
+	}
+	set.File = append(set.File, md.GetFile().AsFileDescriptorProto())
+	data, err := proto.Marshal(set)
+	require.NoError(t, err)
+
+	md2, err := ParseProtobufDescriptor("", base64.StdEncoding.EncodeToString(data), "")
+	require.NoError(t, err)
+	require.Equal(t, "shop.Order", md2.GetFullyQualifiedName())
+
+	_, err = ParseProtobufDescriptor("", "not base64!", "")
+	require.Error(t, err)
+}
+
+func TestProtobufRegistryDecoder(t *testing.T) {
+	client, err := schemaregistry.NewClient(schemaregistry.NewConfig("mock://"))
+	require.NoError(t, err)
+	id, err := client.Register("orders-value", schemaregistry.SchemaInfo{
+		Schema:     testOrderSchema,
+		SchemaType: "PROTOBUF",
+	}, false)
+	require.NoError(t, err)
+
+	md, err := ParseProtobufDescriptor(testOrderSchema, "", "")
+	require.NoError(t, err)
+	payload := newTestOrder(t, md, true)
+	// magic byte, schema id, message indexes and the payload.
+	data := []byte{confluentMagicByte, 0, 0, 0, 0}
+	binary.BigEndian.PutUint32(data[1:], uint32(id))
+	data = binary.AppendVarint(data, 0)
+	data = append(data, payload...)
+
+	d := NewProtobufRegistryDecoderWithClient(client, "orders", "")
+	obj, err := d.Decode(data)
+	require.NoError(t, err)
+	require.Equal(t, "bob", obj["customer"])
+
+	// the nested message is located by the message indexes.
+	nested := []byte{confluentMagicByte, 0, 0, 0, 0}
+	binary.BigEndian.PutUint32(nested[1:], uint32(id))
+	nested = binary.AppendVarint(nested, 2)
+	nested = binary.AppendVarint(nested, 0)
+	nested = binary.AppendVarint(nested, 0)
+	addr := dynamic.NewMessage(md.FindFieldByName("address").GetMessageType())
+	addr.SetFieldByName("city", "Beijing")
+	b, err := addr.Marshal()
+	require.NoError(t, err)
+	obj, err = d.Decode(append(nested, b...))
+	require.NoError(t, err)
+	require.Equal(t, "Beijing", obj["city"])
+
+	_, err = d.Decode([]byte{1, 2})
+	require.Error(t, err)
+}
+
+func TestPopulateBatchFromProtobuf(t *testing.T) {
+	md, err := ParseProtobufDescriptor(testOrderSchema, "", "")
+	require.NoError(t, err)
+	msgs := []*kafka.Message{
+		{Value: newTestOrder(t, md, true)},
+		{Value: newTestOrder(t, md, false)},
+	}
+	configs := map[string]interface{}{
+		ValueKey:           string(PROTOBUF),
+		ProtobufSchemaKey:  testOrderSchema,
+		ProtobufMessagekey: "shop.Order",
+	}
+	attrs := []string{"id", "address.city", "tags", "created_at", "raw"}
+	typs := []types.Type{
+		types.T_int64.ToType(),
+		types.New(types.T_varchar, 20, 0),
+		types.T_json.ToType(),
+		types.New(types.T_datetime, 0, 6),
+		types.New(types.T_varchar, 20, 0),
+	}
+	mp := mpool.MustNewZero()
+	bat, err := PopulateBatchFromMSG(context.Background(), nil, typs, attrs, msgs, configs, mp)
+	require.NoError(t, err)
+	defer bat.Clean(mp)
+	require.Equal(t, 2, bat.RowCount())
+
+	require.Equal(t, int64(7), vector.GetFixedAtNoTypeCheck[int64](bat.Vecs[0], 0))
+	require.Equal(t, "Shanghai", bat.Vecs[1].GetStringAt(0))
+	require.True(t, bat.Vecs[1].IsNull(1))
+	require.Equal(t, `["a", "b"]`, types.DecodeJson(bat.Vecs[2].GetBytesAt(0)).String())
+	require.Equal(t, "2023-11-14 22:13:20.123000",
+		vector.GetFixedAtNoTypeCheck[types.Datetime](bat.Vecs[3], 0).String2(6))
+	require.Equal(t, "xyz", bat.Vecs[4].GetStringAt(0))
+}
@@ -0,0 +1,249 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package testutil provides an in-process kafka broker for the tests of the
+// kafka producers and consumers.
+package testutil
+
+import (
+	"context"
+	"hash/fnv"
+	"sync"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	mokafka "github.com/matrixorigin/matrixone/pkg/stream/adapter/kafka"
+)
+
+// maxFakePollWait bounds the time a fake consumer waits in Poll when there
+// is no message, so that tests do not depend on the poll timeout.
+const maxFakePollWait = 10 * time.Millisecond
+
+var _ mokafka.Producer = (*FakeBroker)(nil)
+
+// FakeBroker is an in-process kafka broker which keeps the messages of each
+// partition in memory. It works as a Producer and creates Consumers reading
+// from it, so the producing and consuming paths can be tested without a
+// kafka cluster.
+type FakeBroker struct {
+	sync.Mutex
+	// topics holds the messages of each partition of the topics.
+	topics map[string][][]*kafka.Message
+	// next is used to distribute the messages without key.
+	next map[string]int
+}
+
+// NewFakeBroker creates an empty FakeBroker.
+func NewFakeBroker() *FakeBroker {
+	return &FakeBroker{
+		topics: make(map[string][][]*kafka.Message),
+		next:   make(map[string]int),
+	}
+}
+
+// CreateTopic creates the topic with the number of partitions, it does
+// nothing if the topic exists.
+func (b *FakeBroker) CreateTopic(topic string, partitions int) {
+	b.Lock()
+	defer b.Unlock()
+	b.createTopicLocked(topic, partitions)
+}
+
+func (b *FakeBroker) createTopicLocked(topic string, partitions int) [][]*kafka.Message {
+	if ps, ok := b.topics[topic]; ok {
+		return ps
+	}
+	if partitions <= 0 {
+		partitions = 1
+	}
+	ps := make([][]*kafka.Message, partitions)
+	b.topics[topic] = ps
+	return ps
+}
+
+// ProduceMessage implements the mokafka.Producer interface. Messages with the
+// same key go to the same partition, the topic is created with one partition
+// if it does not exist.
+func (b *FakeBroker) ProduceMessage(topic string, key, value []byte) (int64, error) {
+	b.Lock()
+	defer b.Unlock()
+	ps := b.createTopicLocked(topic, 1)
+	var partition int
+	if len(key) == 0 {
+		partition = b.next[topic] % len(ps)
+		b.next[topic]++
+	} else {
+		h := fnv.New32a()
+		_, _ = h.Write(key)
+		partition = int(h.Sum32() % uint32(len(ps)))
+	}
+	return b.appendLocked(topic, int32(partition), key, value), nil
+}
+
+// ProduceToPartition publishes the message to the partition of the topic.
+func (b *FakeBroker) ProduceToPartition(topic string, partition int32, key, value []byte) (int64, error) {
+	b.Lock()
+	defer b.Unlock()
+	ps, ok := b.topics[topic]
+	if !ok || partition < 0 || int(partition) >= len(ps) {
+		return -1, moerr.NewInternalErrorf(context.Background(), "unknown partition %d of topic %s", partition, topic)
+	}
+	return b.appendLocked(topic, partition, key, value), nil
+}
+
+func (b *FakeBroker) appendLocked(topic string, partition int32, key, value []byte) int64 {
+	ps := b.topics[topic]
+	offset := int64(len(ps[partition]))
+	ps[partition] = append(ps[partition], &kafka.Message{
+		TopicPartition: kafka.TopicPartition{
+			Topic:     &topic,
+			Partition: partition,
+			Offset:    kafka.Offset(offset),
+		},
+		Key:       key,
+		Value:     value,
+		Timestamp: time.Now(),
+	})
+	return offset
+}
+
+// Messages returns the messages of the topic, ordered by partition and
+// offset.
+func (b *FakeBroker) Messages(topic string) []*kafka.Message {
+	b.Lock()
+	defer b.Unlock()
+	var msgs []*kafka.Message
+	for _, p := range b.topics[topic] {
+		msgs = append(msgs, p...)
+	}
+	return msgs
+}
+
+// Close implements the mokafka.Producer interface.
+func (b *FakeBroker) Close() {}
+
+// NewConsumer creates a Consumer reading from the broker.
+func (b *FakeBroker) NewConsumer() mokafka.Consumer {
+	return &fakeConsumer{broker: b}
+}
+
+var _ mokafka.Consumer = (*fakeConsumer)(nil)
+
+type fakeConsumer struct {
+	sync.Mutex
+	broker *FakeBroker
+	// assigned are the assigned partitions with the offsets of the next
+	// messages to read.
+	assigned []kafka.TopicPartition
+	next     int
+	closed   bool
+}
+
+func (c *fakeConsumer) Assign(partitions []kafka.TopicPartition) error {
+	c.broker.Lock()
+	defer c.broker.Unlock()
+	c.Lock()
+	defer c.Unlock()
+	c.assigned = c.assigned[:0]
+	for _, tp := range partitions {
+		if tp.Topic == nil {
+			return moerr.NewInternalError(context.Background(), "missing topic of the assigned partition")
+		}
+		ps := c.broker.topics[*tp.Topic]
+		if int(tp.Partition) >= len(ps) || tp.Partition < 0 {
+			return moerr.NewInternalErrorf(context.Background(), "unknown partition %d of topic %s", tp.Partition, *tp.Topic)
+		}
+		switch tp.Offset {
+		case kafka.OffsetEnd:
+			tp.Offset = kafka.Offset(len(ps[tp.Partition]))
+		case kafka.OffsetBeginning, kafka.OffsetStored, kafka.OffsetInvalid:
+			tp.Offset = 0
+		}
+		c.assigned = append(c.assigned, tp)
+	}
+	return nil
+}
+
+func (c *fakeConsumer) GetMetadata(topic *string, allTopics bool, timeoutMs int) (*kafka.Metadata, error) {
+	c.broker.Lock()
+	defer c.broker.Unlock()
+	meta := &kafka.Metadata{Topics: make(map[string]kafka.TopicMetadata)}
+	for name, ps := range c.broker.topics {
+		if !allTopics && topic != nil && *topic != name {
+			continue
+		}
+		tm := kafka.TopicMetadata{Topic: name}
+		for i := range ps {
+			tm.Partitions = append(tm.Partitions, kafka.PartitionMetadata{ID: int32(i)})
+		}
+		meta.Topics[name] = tm
+	}
+	if topic != nil {
+		if _, ok := meta.Topics[*topic]; !ok {
+			return nil, moerr.NewInternalErrorf(context.Background(), "unknown topic %s", *topic)
+		}
+	}
+	return meta, nil
+}
+
+// Poll returns the next message of the assigned partitions in a round robin
+// way, or nil if all of them are consumed.
+func (c *fakeConsumer) Poll(timeoutMs int) kafka.Event {
+	if msg := c.nextMessage(); msg != nil {
+		return msg
+	}
+	wait := time.Duration(timeoutMs) * time.Millisecond
+	if wait > maxFakePollWait || wait < 0 {
+		wait = maxFakePollWait
+	}
+	time.Sleep(wait)
+	return nil
+}
+
+func (c *fakeConsumer) nextMessage() *kafka.Message {
+	c.broker.Lock()
+	defer c.broker.Unlock()
+	c.Lock()
+	defer c.Unlock()
+	if c.closed {
+		return nil
+	}
+	for i := 0; i < len(c.assigned); i++ {
+		idx := (c.next + i) % len(c.assigned)
+		tp := &c.assigned[idx]
+		msgs := c.broker.topics[*tp.Topic][tp.Partition]
+		if int(tp.Offset) >= len(msgs) {
+			continue
+		}
+		msg := *msgs[tp.Offset]
+		tp.Offset++
+		c.next = idx + 1
+		return &msg
+	}
+	return nil
+}
+
+func (c *fakeConsumer) IsClosed() bool {
+	c.Lock()
+	defer c.Unlock()
+	return c.closed
+}
+
+func (c *fakeConsumer) Close() error {
+	c.Lock()
+	defer c.Unlock()
+	c.closed = true
+	return nil
+}
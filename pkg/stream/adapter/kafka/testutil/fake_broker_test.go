@@ -0,0 +1,73 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testutil
+
+import (
+	"testing"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFakeBroker(t *testing.T) {
+	topic := "fake"
+	b := NewFakeBroker()
+	b.CreateTopic(topic, 2)
+
+	// the messages with the same key go to the same partition.
+	o1, err := b.ProduceMessage(topic, []byte("k"), []byte("v1"))
+	require.NoError(t, err)
+	o2, err := b.ProduceMessage(topic, []byte("k"), []byte("v2"))
+	require.NoError(t, err)
+	require.Equal(t, o1+1, o2)
+	_, err = b.ProduceToPartition(topic, 2, nil, []byte("v"))
+	require.Error(t, err)
+
+	c := b.NewConsumer()
+	meta, err := c.GetMetadata(&topic, false, 1000)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(meta.Topics[topic].Partitions))
+	unknown := "unknown"
+	_, err = c.GetMetadata(&unknown, false, 1000)
+	require.Error(t, err)
+
+	var tps []kafka.TopicPartition
+	for _, p := range meta.Topics[topic].Partitions {
+		tps = append(tps, kafka.TopicPartition{Topic: &topic, Partition: p.ID, Offset: kafka.OffsetBeginning})
+	}
+	require.NoError(t, c.Assign(tps))
+	var values []string
+	for ev := c.Poll(10); ev != nil; ev = c.Poll(10) {
+		values = append(values, string(ev.(*kafka.Message).Value))
+	}
+	require.Equal(t, []string{"v1", "v2"}, values)
+
+	// consume from the assigned offset.
+	_, err = b.ProduceMessage(topic, []byte("k"), []byte("v3"))
+	require.NoError(t, err)
+	for i := range tps {
+		tps[i].Offset = kafka.Offset(o2)
+	}
+	require.NoError(t, c.Assign(tps))
+	values = values[:0]
+	for ev := c.Poll(10); ev != nil; ev = c.Poll(10) {
+		values = append(values, string(ev.(*kafka.Message).Value))
+	}
+	require.Equal(t, []string{"v2", "v3"}, values)
+
+	require.NoError(t, c.Close())
+	require.True(t, c.IsClosed())
+	require.Nil(t, c.Poll(10))
+}
@@ -81,7 +81,7 @@ func KafkaSinkConnectorExecutor(
 		options[mokafka.CREATED_AT] = tasks[0].CreateAt.String()
 		bufferLimit := getBufferLimit(options[mokafka.BufferLimitKey])
 
-		c, err := NewKafkaMoConnector(logger, options, ieFactory(), bufferLimit)
+		c, err := NewKafkaMoConnector(logger, t.GetID(), options, ieFactory(), bufferLimit)
 		if err != nil {
 			return err
 		}
@@ -101,6 +101,8 @@ func KafkaSinkConnectorExecutor(
 type KafkaMoConnector struct {
 	logger       *zap.Logger
 	kafkaAdapter mokafka.KafkaAdapterInterface
+	consumer     mokafka.Consumer
+	offsets      *offsetStore
 	options      map[string]string
 	ie           ie.InternalExecutor
 	decoder      Decoder
@@ -129,22 +131,31 @@ func convertToKafkaConfig(configs map[string]string) *kafka.ConfigMap {
 	}
 	groupId := configs[mokafka.TopicKey] + "-" + configs[mokafka.DatabaseKey] + "-" + configs[mokafka.TableKey] + "-" + configs[mokafka.PartitionKey] + "-" + configs[mokafka.CREATED_AT]
 	kafkaConfigs.SetKey("group.id", groupId)
+	// the offsets are committed together with the inserted rows, see
+	// offsetStore.
+	kafkaConfigs.SetKey("enable.auto.commit", false)
 	return kafkaConfigs
 }
 
-func NewKafkaMoConnector(logger *zap.Logger, options map[string]string, ie ie.InternalExecutor, buffer_limit int) (*KafkaMoConnector, error) {
+func NewKafkaMoConnector(logger *zap.Logger, taskId uint64, options map[string]string, ie ie.InternalExecutor, buffer_limit int) (*KafkaMoConnector, error) {
 	// Validate options before proceeding
 	kmc := &KafkaMoConnector{
 		logger:      logger,
 		options:     options,
 		ie:          ie,
-		decoder:     newJsonDecoder(),
 		bufferLimit: buffer_limit,
 	}
 	if err := kmc.validateParams(); err != nil {
 		return nil, err
 	}
+	decoder, err := newDecoder(context.Background(), options)
+	if err != nil {
+		return nil, err
+	}
+	kmc.decoder = decoder
 	kmc.converter = newSQLConverter(options[mokafka.DatabaseKey], options[mokafka.TableKey])
+	kmc.offsets = newOffsetStore(taskId, options[mokafka.DatabaseKey], options[mokafka.TableKey],
+		options[mokafka.TopicKey], ie)
 
 	// Create a Kafka consumer using the provided options
 	kafkaAdapter, err := mokafka.NewKafkaAdapter(convertToKafkaConfig(options))
@@ -153,6 +164,9 @@ func NewKafkaMoConnector(logger *zap.Logger, options map[string]string, ie ie.In
 	}
 
 	kmc.kafkaAdapter = kafkaAdapter
+	if kmc.consumer, err = kafkaAdapter.GetKafkaConsumer(); err != nil {
+		return nil, err
+	}
 	kmc.resumeC = make(chan struct{})
 	kmc.cancelC = make(chan struct{})
 	kmc.pauseC = make(chan struct{})
@@ -178,7 +192,9 @@ func (k *KafkaMoConnector) validateParams() error {
 	}
 
 	// 3. Check for supported value format
-	if k.options["value"] != "json" {
+	switch k.options["value"] {
+	case FormatJson, FormatProtobuf, FormatProtobufSR:
+	default:
 		return moerr.NewInternalError(context.Background(), "Unsupported value format")
 	}
 
@@ -186,33 +202,17 @@ func (k *KafkaMoConnector) validateParams() error {
 }
 
 // Start begins consuming messages from Kafka and writing them to the MO Table.
+// The partitions are assigned explicitly and start right after the offsets
+// committed with the inserted rows.
 func (k *KafkaMoConnector) Start(ctx context.Context) error {
-	if k.kafkaAdapter == nil {
-		return moerr.NewInternalError(ctx, "Kafka Adapter not initialized")
-	}
-
-	ct, err := k.kafkaAdapter.GetKafkaConsumer()
-
-	if err != nil {
+	if k.consumer == nil {
 		return moerr.NewInternalError(ctx, "Kafka Adapter Consumer not initialized")
 	}
-	// Define the topic to consume from
-	topic := k.options[mokafka.TopicKey]
-
-	// Subscribe to the topic
-	if k.options[mokafka.PartitionKey] != "" {
-		partition, err := strconv.Atoi(k.options[mokafka.PartitionKey])
-		if err != nil {
-			return moerr.NewInternalError(ctx, "Invalid partition")
-		}
-		if err := ct.Assign([]kafka.TopicPartition{{Topic: &topic, Partition: int32(partition)}}); err != nil {
-			return moerr.NewInternalError(ctx, "Failed to assign partition")
-		}
-	} else {
-		if err := ct.Subscribe(topic, nil); err != nil {
-			return moerr.NewInternalError(ctx, "Failed to subscribe to topic")
-		}
+	ct := k.consumer
+	if err := k.assign(ctx); err != nil {
+		return err
 	}
+
 	// Continuously listen for messages
 	var buffered_messages []*kafka.Message
 	timeWindow := getTimeWindow(k.options[mokafka.TimeWindowKey])
@@ -220,6 +220,9 @@ func (k *KafkaMoConnector) Start(ctx context.Context) error {
 	timer = time.NewTimer(time.Duration(timeWindow) * time.Millisecond)
 	timerRunning := false
 	var mutex sync.Mutex
+	// insertErr is the error of the last flush, the task stops and restarts
+	// from the committed offsets.
+	var insertErr error
 
 	for {
 		select {
@@ -228,6 +231,9 @@ func (k *KafkaMoConnector) Start(ctx context.Context) error {
 
 		case <-k.cancelC:
 			timer.Stop()
+			if err := k.offsets.Delete(context.Background()); err != nil {
+				k.logger.Error("failed to delete the offsets", zap.Error(err))
+			}
 			return ct.Close()
 
 		case <-k.pauseC:
@@ -240,6 +246,14 @@ func (k *KafkaMoConnector) Start(ctx context.Context) error {
 			}
 
 		default:
+			mutex.Lock()
+			err := insertErr
+			mutex.Unlock()
+			if err != nil {
+				timer.Stop()
+				_ = ct.Close()
+				return err
+			}
 			if ct.IsClosed() {
 				return nil
 			}
@@ -253,6 +267,15 @@ func (k *KafkaMoConnector) Start(ctx context.Context) error {
 				if e.Value == nil {
 					continue
 				}
+				// skip the messages which cannot be decoded, otherwise they
+				// fail the insertion of the whole buffer again and again.
+				if _, err := k.decoder.Decode(e.Value); err != nil {
+					k.logger.Error("failed to decode message",
+						zap.Int32("partition", e.TopicPartition.Partition),
+						zap.Int64("offset", int64(e.TopicPartition.Offset)),
+						zap.Error(err))
+					continue
+				}
 				mutex.Lock()
 				buffered_messages = append(buffered_messages, e)
 
@@ -261,8 +284,8 @@ func (k *KafkaMoConnector) Start(ctx context.Context) error {
 					timer.Stop()
 					timer = time.AfterFunc(time.Duration(timeWindow)*time.Millisecond, func() {
 						mutex.Lock()
-						if len(buffered_messages) > 0 {
-							k.insertRow(buffered_messages)
+						if len(buffered_messages) > 0 && insertErr == nil {
+							insertErr = k.insertRow(buffered_messages)
 							buffered_messages = buffered_messages[:0]
 						}
 						timerRunning = false
@@ -272,12 +295,12 @@ func (k *KafkaMoConnector) Start(ctx context.Context) error {
 				}
 
 				// Flush the buffer if the limit is reached
-				if len(buffered_messages) >= k.bufferLimit {
+				if len(buffered_messages) >= k.bufferLimit && insertErr == nil {
 					if timerRunning {
 						timer.Stop()
 						timerRunning = false
 					}
-					k.insertRow(buffered_messages)
+					insertErr = k.insertRow(buffered_messages)
 					buffered_messages = buffered_messages[:0]
 				}
 				mutex.Unlock()
@@ -291,6 +314,39 @@ func (k *KafkaMoConnector) Start(ctx context.Context) error {
 	}
 }
 
+// assign assigns the partitions of the topic to the consumer. Only the
+// partition in the options is assigned if it is specified.
+func (k *KafkaMoConnector) assign(ctx context.Context) error {
+	topic := k.options[mokafka.TopicKey]
+	var partitions []int32
+	if k.options[mokafka.PartitionKey] != "" {
+		partition, err := strconv.Atoi(k.options[mokafka.PartitionKey])
+		if err != nil {
+			return moerr.NewInternalError(ctx, "Invalid partition")
+		}
+		partitions = append(partitions, int32(partition))
+	} else {
+		meta, err := k.consumer.GetMetadata(&topic, false, 5000)
+		if err != nil {
+			return err
+		}
+		for _, p := range meta.Topics[topic].Partitions {
+			partitions = append(partitions, p.ID)
+		}
+		if len(partitions) == 0 {
+			return moerr.NewInternalErrorf(ctx, "no partition is found for topic %s", topic)
+		}
+	}
+	committed, err := k.offsets.Load(ctx)
+	if err != nil {
+		return err
+	}
+	if err = k.consumer.Assign(partitionsToAssign(topic, partitions, committed)); err != nil {
+		return moerr.NewInternalError(ctx, "Failed to assign partition")
+	}
+	return nil
+}
+
 // Resume implements the taskservice.ActiveRoutine interface.
 func (k *KafkaMoConnector) Resume() error {
 	k.resumeC <- struct{}{}
@@ -312,31 +368,40 @@ func (k *KafkaMoConnector) Cancel() error {
 
 func (k *KafkaMoConnector) Close() error {
 	// Close the Kafka consumer.
-	ct, err := k.kafkaAdapter.GetKafkaConsumer()
-	if err != nil {
+	if k.consumer == nil {
 		return moerr.NewInternalError(context.Background(), "Kafka Adapter Consumer not initialized")
 	}
-	if err := ct.Close(); err != nil {
+	if err := k.consumer.Close(); err != nil {
 		return moerr.NewInternalError(context.Background(), "Error closing Kafka consumer")
 	}
 	return nil
 }
 
-func (k *KafkaMoConnector) insertRow(msgs []*kafka.Message) {
-	opts := ie.SessionOverrideOptions{}
+// insertRow inserts the rows of msgs and saves their offsets in one
+// transaction.
+func (k *KafkaMoConnector) insertRow(msgs []*kafka.Message) error {
 	ctx := context.Background()
 	sql := k.options["sql"]
 	dbName := k.options[mokafka.DatabaseKey]
 	tableName := k.options[mokafka.TableKey]
 	if sql == "" {
-		return
+		return nil
 	}
 	ctx = context.WithValue(ctx, defines.SourceScanResKey{}, msgs)
+	opts := ie.NewOptsBuilder().Database(dbName).Finish()
 
-	sql = fmt.Sprintf("USE %s; INSERT INTO %s.%s %s ",
-		dbName, dbName, tableName, sql)
-	err := k.ie.Exec(ctx, sql, opts)
+	sql = fmt.Sprintf("INSERT INTO %s.%s %s", dbName, tableName, sql)
+	err := k.ie.ExecTxn(ctx, func(txn ie.TxnExecutor) error {
+		if err := txn.Exec(ctx, sql); err != nil {
+			return err
+		}
+		if saveSql := k.offsets.SqlForSave(msgs); saveSql != "" {
+			return txn.Exec(ctx, saveSql)
+		}
+		return nil
+	}, opts)
 	if err != nil {
 		k.logger.Error("failed to insert row", zap.String("SQL", sql), zap.Error(err))
 	}
+	return err
 }
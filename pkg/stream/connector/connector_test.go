@@ -17,6 +17,8 @@ package moconnector
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -24,22 +26,54 @@ import (
 	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/runtime"
+	kafkatestutil "github.com/matrixorigin/matrixone/pkg/stream/adapter/kafka/testutil"
 	ie "github.com/matrixorigin/matrixone/pkg/util/internalExecutor"
+	"github.com/stretchr/testify/require"
 )
 
 type MockSQLExecutor struct {
 	execCount    int
 	executedSQLs []string
 	wg           *sync.WaitGroup
+	// resultSet is returned by Query, an error is returned if it is nil.
+	resultSet *MysqlResultSet
+	// cleanupSQLs are the statements deleting the offsets of the task.
+	cleanupSQLs []string
+	// txnSQLs are the statements of each txn executed by ExecTxn.
+	txnSQLs [][]string
 }
 
 func (m *MockSQLExecutor) Exec(ctx context.Context, sql string, opts ie.SessionOverrideOptions) error {
+	if strings.HasPrefix(sql, "delete from") {
+		m.cleanupSQLs = append(m.cleanupSQLs, sql)
+		return nil
+	}
 	m.execCount++
 	m.executedSQLs = append(m.executedSQLs, sql)
 	m.wg.Done() // Decrement the WaitGroup counter after processing a message
 	return nil
 }
 
+func (m *MockSQLExecutor) ExecTxn(ctx context.Context, execFunc func(ie.TxnExecutor) error, opts ie.SessionOverrideOptions) error {
+	txn := &mockTxnExecutor{}
+	if err := execFunc(txn); err != nil {
+		return err
+	}
+	m.execCount++
+	m.txnSQLs = append(m.txnSQLs, txn.sqls)
+	m.wg.Done()
+	return nil
+}
+
+type mockTxnExecutor struct {
+	sqls []string
+}
+
+func (m *mockTxnExecutor) Exec(ctx context.Context, sql string) error {
+	m.sqls = append(m.sqls, sql)
+	return nil
+}
+
 type MysqlResultSet struct {
 	//column information
 	Columns []string
@@ -61,34 +95,37 @@ func (res *internalExecResult) Error() error {
 }
 
 func (res *internalExecResult) ColumnCount() uint64 {
-	return 1
+	return uint64(len(res.resultSet.Columns))
 }
 
 func (res *internalExecResult) Column(ctx context.Context, i uint64) (name string, typ uint8, signed bool, err error) {
-	return "test", 1, true, nil
+	return res.resultSet.Columns[i], 1, true, nil
 }
 
 func (res *internalExecResult) RowCount() uint64 {
-	return 1
+	return uint64(len(res.resultSet.Data))
 }
 
 func (res *internalExecResult) Row(ctx context.Context, i uint64) ([]interface{}, error) {
-	return nil, nil
+	return res.resultSet.Data[i], nil
 }
 
 func (res *internalExecResult) Value(ctx context.Context, ridx uint64, cidx uint64) (interface{}, error) {
-	return nil, nil
+	return res.resultSet.Data[ridx][cidx], nil
 }
 
 func (res *internalExecResult) GetFloat64(ctx context.Context, ridx uint64, cid uint64) (float64, error) {
 	return 0.0, nil
 }
 func (res *internalExecResult) GetString(ctx context.Context, ridx uint64, cid uint64) (string, error) {
-	return "", nil
+	return fmt.Sprint(res.resultSet.Data[ridx][cid]), nil
 }
 
 func (m *MockSQLExecutor) Query(ctx context.Context, sql string, pts ie.SessionOverrideOptions) ie.InternalExecResult {
-	return &internalExecResult{affectedRows: 1, resultSet: nil, err: moerr.NewInternalError(context.TODO(), "random")}
+	if m.resultSet == nil {
+		return &internalExecResult{affectedRows: 1, resultSet: nil, err: moerr.NewInternalError(context.TODO(), "random")}
+	}
+	return &internalExecResult{affectedRows: 1, resultSet: m.resultSet}
 }
 
 func (m *MockSQLExecutor) ApplySessionOverride(opts ie.SessionOverrideOptions) {}
@@ -105,7 +142,7 @@ func TestKafkaMoConnector(t *testing.T) {
 	topic := "testTopic"
 
 	var wg sync.WaitGroup
-	mockExecutor := &MockSQLExecutor{wg: &wg}
+	mockExecutor := &MockSQLExecutor{wg: &wg, resultSet: &MysqlResultSet{}}
 
 	// Create KafkaMoConnector instance
 	options := map[string]string{
@@ -118,7 +155,7 @@ func TestKafkaMoConnector(t *testing.T) {
 		"sql":               "select * from testDB.testStream",
 	}
 	rt := runtime.DefaultRuntime()
-	connector, err := NewKafkaMoConnector(rt.Logger().RawLogger(), options, mockExecutor, 1)
+	connector, err := NewKafkaMoConnector(rt.Logger().RawLogger(), 1, options, mockExecutor, 1)
 	if err != nil {
 		t.Fatalf("Failed to create KafkaMoConnector: %s", err)
 	}
@@ -178,3 +215,127 @@ func TestKafkaMoConnector(t *testing.T) {
 		t.Errorf("Expected SQL to be executed 10 times, but got %d", mockExecutor.execCount)
 	}
 }
+
+func TestKafkaMoConnectorResumeFromOffsets(t *testing.T) {
+	broker := kafkatestutil.NewFakeBroker()
+	topic := "testTopic"
+	broker.CreateTopic(topic, 2)
+	for i := 0; i < 6; i++ {
+		_, err := broker.ProduceToPartition(topic, int32(i%2), nil, []byte(fmt.Sprintf(`{"id": %d}`, i)))
+		require.NoError(t, err)
+	}
+
+	var wg sync.WaitGroup
+	// partition 0 has committed the offset 1 and partition 1 has committed
+	// nothing, so 1 message of partition 0 and 3 of partition 1 are left.
+	wg.Add(4)
+	mockExecutor := &MockSQLExecutor{
+		wg: &wg,
+		resultSet: &MysqlResultSet{
+			Columns: []string{"partition_id", "msg_offset"},
+			Data:    [][]interface{}{{int32(0), int64(1)}},
+		},
+	}
+	rt := runtime.DefaultRuntime()
+	options := map[string]string{
+		"type":     "kafka",
+		"topic":    topic,
+		"database": "db",
+		"table":    "t",
+		"value":    "json",
+		"sql":      "select * from db.s",
+	}
+	connector := &KafkaMoConnector{
+		logger:      rt.Logger().RawLogger(),
+		consumer:    broker.NewConsumer(),
+		decoder:     newJsonDecoder(),
+		offsets:     newOffsetStore(7, "db", "t", topic, mockExecutor),
+		options:     options,
+		ie:          mockExecutor,
+		bufferLimit: 1,
+		resumeC:     make(chan struct{}),
+		cancelC:     make(chan struct{}),
+		pauseC:      make(chan struct{}),
+	}
+	errC := make(chan error, 1)
+	go func() {
+		errC <- connector.Start(context.Background())
+	}()
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("Timed out waiting for messages to be processed")
+	}
+	require.NoError(t, connector.Cancel())
+	require.NoError(t, <-errC)
+
+	require.Equal(t, 4, len(mockExecutor.txnSQLs))
+	var saved []string
+	for _, sqls := range mockExecutor.txnSQLs {
+		require.Equal(t, 2, len(sqls))
+		require.Equal(t, "INSERT INTO db.t select * from db.s", sqls[0])
+		sql := sqls[1]
+		saved = append(saved, sql[strings.Index(sql, "values ")+len("values "):strings.Index(sql, " on duplicate")])
+	}
+	require.ElementsMatch(t, []string{
+		"(7, 'db', 't', 'testTopic', 0, 2)",
+		"(7, 'db', 't', 'testTopic', 1, 0)",
+		"(7, 'db', 't', 'testTopic', 1, 1)",
+		"(7, 'db', 't', 'testTopic', 1, 2)",
+	}, saved)
+	// the offsets are deleted when the task is canceled.
+	require.Equal(t, []string{"delete from mo_catalog.mo_stream_offsets where task_id = 7"}, mockExecutor.cleanupSQLs)
+}
+
+func TestOffsetStore(t *testing.T) {
+	topic := "it's"
+	store := newOffsetStore(3, "db", "t", topic, nil)
+	require.Equal(t, "", store.SqlForSave(nil))
+
+	msgs := []*kafka.Message{
+		{TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: 1, Offset: 5}},
+		{TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: 0, Offset: 9}},
+		{TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: 1, Offset: 7}},
+		{TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: 1, Offset: 6}},
+	}
+	require.Equal(t,
+		"insert into mo_catalog.mo_stream_offsets values (3, 'db', 't', 'it''s', 0, 9), (3, 'db', 't', 'it''s', 1, 7) "+
+			"on duplicate key update msg_offset = values(msg_offset)",
+		store.SqlForSave(msgs))
+
+	tps := partitionsToAssign(topic, []int32{0, 1}, map[int32]int64{1: 7})
+	require.Equal(t, 2, len(tps))
+	require.Equal(t, kafka.OffsetBeginning, tps[0].Offset)
+	require.Equal(t, kafka.Offset(8), tps[1].Offset)
+}
+
+func TestPublishQueryResult(t *testing.T) {
+	broker := kafkatestutil.NewFakeBroker()
+	exec := &MockSQLExecutor{
+		resultSet: &MysqlResultSet{
+			Columns: []string{"id", "name"},
+			Data: [][]interface{}{
+				{int64(1), []byte("a")},
+				{int64(2), nil},
+			},
+		},
+	}
+	n, err := PublishQueryResult(context.Background(), exec, broker, "out", "select id, name from t")
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+
+	msgs := broker.Messages("out")
+	require.Equal(t, 2, len(msgs))
+	require.JSONEq(t, `{"id": 1, "name": "a"}`, string(msgs[0].Value))
+	require.JSONEq(t, `{"id": 2, "name": null}`, string(msgs[1].Value))
+
+	exec.resultSet = nil
+	_, err = PublishQueryResult(context.Background(), exec, broker, "out", "select 1")
+	require.Error(t, err)
+}
@@ -0,0 +1,78 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moconnector
+
+import (
+	"context"
+
+	"github.com/jhump/protoreflect/desc"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	mokafka "github.com/matrixorigin/matrixone/pkg/stream/adapter/kafka"
+)
+
+// protobufDecoder decodes the protobuf messages of a message type given by
+// an inline .proto schema or a descriptor set.
+type protobufDecoder struct {
+	md *desc.MessageDescriptor
+}
+
+func newProtobufDecoder(schema, descriptorSet, message string) (Decoder, error) {
+	md, err := mokafka.ParseProtobufDescriptor(schema, descriptorSet, message)
+	if err != nil {
+		return nil, err
+	}
+	return &protobufDecoder{md: md}, nil
+}
+
+func (d *protobufDecoder) Decode(data []byte) (RawObject, error) {
+	return mokafka.DecodeProtobuf(d.md, data)
+}
+
+// protobufRegistryDecoder decodes the protobuf messages serialized with the
+// schema registry, the schemas are fetched from the registry.
+type protobufRegistryDecoder struct {
+	decoder *mokafka.ProtobufRegistryDecoder
+}
+
+func newProtobufRegistryDecoder(url, topic, message string) (Decoder, error) {
+	decoder, err := mokafka.NewProtobufRegistryDecoder(url, topic, message)
+	if err != nil {
+		return nil, err
+	}
+	return &protobufRegistryDecoder{decoder: decoder}, nil
+}
+
+func (d *protobufRegistryDecoder) Decode(data []byte) (RawObject, error) {
+	return d.decoder.Decode(data)
+}
+
+// newDecoder creates the Decoder of the value format in the options.
+func newDecoder(ctx context.Context, options map[string]string) (Decoder, error) {
+	switch options[OptConnectorValue] {
+	case FormatJson:
+		return newJsonDecoder(), nil
+	case FormatProtobuf:
+		return newProtobufDecoder(options[OptConnectorProtobufSchema],
+			options[OptConnectorProtobufDescriptorSet], options[OptConnectorProtobufMessage])
+	case FormatProtobufSR:
+		if options[OptConnectorSchemaRegistry] == "" {
+			return nil, moerr.NewErrLackOption(ctx, OptConnectorSchemaRegistry)
+		}
+		return newProtobufRegistryDecoder(options[OptConnectorSchemaRegistry],
+			options[OptConnectorTopic], options[OptConnectorProtobufMessage])
+	default:
+		return nil, moerr.NewInternalError(ctx, "Unsupported value format")
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moconnector
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jhump/protoreflect/dynamic"
+	mokafka "github.com/matrixorigin/matrixone/pkg/stream/adapter/kafka"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testUserSchema = `syntax = "proto3";
+package test;
+
+import "google/protobuf/timestamp.proto";
+
+message User {
+  message Profile {
+    string email = 1;
+  }
+  int32 id = 1;
+  Profile profile = 2;
+  repeated int32 scores = 3;
+  google.protobuf.Timestamp login_at = 4;
+}
+`
+
+func TestProtobufDecoder_Decode(t *testing.T) {
+	dec, err := newDecoder(context.Background(), map[string]string{
+		OptConnectorValue:          FormatProtobuf,
+		OptConnectorProtobufSchema: testUserSchema,
+	})
+	require.NoError(t, err)
+
+	md, err := mokafka.ParseProtobufDescriptor(testUserSchema, "", "User")
+	require.NoError(t, err)
+	msg := dynamic.NewMessage(md)
+	msg.SetFieldByName("id", int32(3))
+	profile := dynamic.NewMessage(md.FindFieldByName("profile").GetMessageType())
+	profile.SetFieldByName("email", "a@b.c")
+	msg.SetFieldByName("profile", profile)
+	msg.SetFieldByName("scores", []int32{1, 2})
+	ts := dynamic.NewMessage(md.FindFieldByName("login_at").GetMessageType())
+	ts.SetFieldByName("seconds", int64(86400))
+	msg.SetFieldByName("login_at", ts)
+	data, err := msg.Marshal()
+	require.NoError(t, err)
+
+	obj, err := dec.Decode(data)
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), obj["id"])
+	assert.Equal(t, map[string]any{"email": "a@b.c"}, obj["profile"])
+	assert.Equal(t, []any{int32(1), int32(2)}, obj["scores"])
+	assert.Equal(t, time.Unix(86400, 0).UTC(), obj["login_at"])
+
+	_, err = dec.Decode([]byte{0xff})
+	assert.Error(t, err)
+}
+
+func TestNewDecoder(t *testing.T) {
+	ctx := context.Background()
+	dec, err := newDecoder(ctx, map[string]string{OptConnectorValue: FormatJson})
+	require.NoError(t, err)
+	assert.IsType(t, &jsonDecoder{}, dec)
+
+	_, err = newDecoder(ctx, map[string]string{OptConnectorValue: FormatProtobuf})
+	assert.Error(t, err)
+
+	_, err = newDecoder(ctx, map[string]string{
+		OptConnectorValue:           FormatProtobuf,
+		OptConnectorProtobufSchema:  testUserSchema,
+		OptConnectorProtobufMessage: "Unknown",
+	})
+	assert.Error(t, err)
+
+	_, err = newDecoder(ctx, map[string]string{OptConnectorValue: FormatProtobufSR})
+	assert.Error(t, err)
+
+	dec, err = newDecoder(ctx, map[string]string{
+		OptConnectorValue:          FormatProtobufSR,
+		OptConnectorSchemaRegistry: "mock://registry",
+		OptConnectorTopic:          "users",
+	})
+	require.NoError(t, err)
+	assert.IsType(t, &protobufRegistryDecoder{}, dec)
+
+	_, err = newDecoder(ctx, map[string]string{OptConnectorValue: "avro"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,134 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moconnector
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/sql/util"
+	ie "github.com/matrixorigin/matrixone/pkg/util/internalExecutor"
+)
+
+const (
+	getStreamOffsetsFormat = "select partition_id, msg_offset from %s.%s where task_id = %d and topic = '%s'"
+
+	saveStreamOffsetsFormat = "insert into %s.%s values %s on duplicate key update msg_offset = values(msg_offset)"
+
+	deleteStreamOffsetsFormat = "delete from %s.%s where task_id = %d"
+)
+
+// offsetStore keeps the offsets consumed by a connector task in the table
+// mo_catalog.mo_stream_offsets. The offsets are saved by the same transaction
+// which inserts the rows of the messages, so a restarted task continues
+// right after the last committed message, and no message is lost or
+// inserted twice.
+type offsetStore struct {
+	taskId    uint64
+	dbName    string
+	tableName string
+	topic     string
+	ie        ie.InternalExecutor
+}
+
+func newOffsetStore(taskId uint64, dbName, tableName, topic string, ie ie.InternalExecutor) *offsetStore {
+	return &offsetStore{
+		taskId:    taskId,
+		dbName:    dbName,
+		tableName: tableName,
+		topic:     topic,
+		ie:        ie,
+	}
+}
+
+// Load returns the offset of the last committed message of each partition.
+func (s *offsetStore) Load(ctx context.Context) (map[int32]int64, error) {
+	sql := fmt.Sprintf(getStreamOffsetsFormat, catalog.MO_CATALOG, catalog.MO_STREAM_OFFSETS,
+		s.taskId, util.QuoteSqlString(s.topic))
+	res := s.ie.Query(ctx, sql, ie.SessionOverrideOptions{})
+	if res.Error() != nil {
+		return nil, res.Error()
+	}
+	offsets := make(map[int32]int64, res.RowCount())
+	for i := uint64(0); i < res.RowCount(); i++ {
+		var values [2]int64
+		for j := range values {
+			str, err := res.GetString(ctx, i, uint64(j))
+			if err != nil {
+				return nil, err
+			}
+			if values[j], err = strconv.ParseInt(str, 10, 64); err != nil {
+				return nil, moerr.NewInternalErrorf(ctx, "invalid offset of topic %s: %s", s.topic, str)
+			}
+		}
+		offsets[int32(values[0])] = values[1]
+	}
+	return offsets, nil
+}
+
+// SqlForSave returns the statement recording the largest offset of each
+// partition in msgs, or an empty string if msgs is empty.
+func (s *offsetStore) SqlForSave(msgs []*kafka.Message) string {
+	offsets := make(map[int32]int64)
+	for _, msg := range msgs {
+		p, o := msg.TopicPartition.Partition, int64(msg.TopicPartition.Offset)
+		if old, ok := offsets[p]; !ok || o > old {
+			offsets[p] = o
+		}
+	}
+	if len(offsets) == 0 {
+		return ""
+	}
+	partitions := make([]int32, 0, len(offsets))
+	for p := range offsets {
+		partitions = append(partitions, p)
+	}
+	sort.Slice(partitions, func(i, j int) bool { return partitions[i] < partitions[j] })
+	values := make([]string, 0, len(partitions))
+	for _, p := range partitions {
+		values = append(values, fmt.Sprintf("(%d, '%s', '%s', '%s', %d, %d)",
+			s.taskId, util.QuoteSqlString(s.dbName), util.QuoteSqlString(s.tableName), util.QuoteSqlString(s.topic),
+			p, offsets[p]))
+	}
+	return fmt.Sprintf(saveStreamOffsetsFormat, catalog.MO_CATALOG, catalog.MO_STREAM_OFFSETS,
+		strings.Join(values, ", "))
+}
+
+// Delete removes the offsets of the task.
+func (s *offsetStore) Delete(ctx context.Context) error {
+	sql := fmt.Sprintf(deleteStreamOffsetsFormat, catalog.MO_CATALOG, catalog.MO_STREAM_OFFSETS, s.taskId)
+	return s.ie.Exec(ctx, sql, ie.SessionOverrideOptions{})
+}
+
+// partitionsToAssign returns the partitions to consume, each of them starts
+// right after its committed offset, or from the beginning if nothing of it
+// has been committed.
+func partitionsToAssign(topic string, partitions []int32, committed map[int32]int64) []kafka.TopicPartition {
+	tps := make([]kafka.TopicPartition, 0, len(partitions))
+	for _, p := range partitions {
+		offset := kafka.OffsetBeginning
+		if o, ok := committed[p]; ok {
+			offset = kafka.Offset(o + 1)
+		}
+		tps = append(tps, kafka.TopicPartition{Topic: &topic, Partition: p, Offset: offset})
+	}
+	return tps
+}
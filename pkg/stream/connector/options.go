@@ -23,8 +23,10 @@ import (
 )
 
 const (
-	SourceKafka string = "kafka"
-	FormatJson  string = "json"
+	SourceKafka      string = "kafka"
+	FormatJson       string = "json"
+	FormatProtobuf   string = "protobuf"
+	FormatProtobufSR string = "protobuf_sr"
 )
 
 type StmtOpts map[string]string
@@ -102,18 +104,28 @@ const (
 
 	OptConnectorBufferLimit = "buffer_limit"
 	OptConnectorTimeWindow  = "time_window"
+
+	OptConnectorProtobufSchema        = "protobuf.schema"
+	OptConnectorProtobufDescriptorSet = "protobuf.descriptor_set"
+	OptConnectorProtobufMessage       = "protobuf.message"
+	OptConnectorSchemaRegistry        = "schema.registry"
 )
 
 var ConnectorOptConstraint = map[string]OptConstraint{
 	OptConnectorType:        enumOpt(SourceKafka),
 	OptConnectorServers:     addressOpt,
 	OptConnectorTopic:       stringOpt,
-	OptConnectorValue:       enumOpt(FormatJson),
+	OptConnectorValue:       enumOpt(FormatJson, FormatProtobuf, FormatProtobufSR),
 	OptConnectorSql:         stringOpt,
 	OptConnectorRel:         stringOpt,
 	OptConnectorPartition:   integerOpt,
 	OptConnectorBufferLimit: integerOpt,
 	OptConnectorTimeWindow:  integerOpt,
+
+	OptConnectorProtobufSchema:        stringOpt,
+	OptConnectorProtobufDescriptorSet: stringOpt,
+	OptConnectorProtobufMessage:       stringOpt,
+	OptConnectorSchemaRegistry:        stringOpt,
 }
 
 var ConnectorEssentialOpts = map[string]struct{}{
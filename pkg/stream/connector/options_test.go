@@ -75,4 +75,16 @@ func TestMakeStmtOpts(t *testing.T) {
 	o, err = MakeStmtOpts(context.Background(), okOpts)
 	assert.NoError(t, err)
 	assert.Equal(t, o, StmtOpts(okOpts))
+
+	protobufOpts := map[string]string{
+		"type":              "kafka",
+		"bootstrap.servers": "localhost:9092",
+		"topic":             "t1",
+		"value":             "protobuf_sr",
+		"schema.registry":   "http://localhost:8081",
+		"protobuf.message":  "test.User",
+	}
+	o, err = MakeStmtOpts(context.Background(), protobufOpts)
+	assert.NoError(t, err)
+	assert.Equal(t, o, StmtOpts(protobufOpts))
 }
@@ -0,0 +1,82 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moconnector
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	mokafka "github.com/matrixorigin/matrixone/pkg/stream/adapter/kafka"
+	ie "github.com/matrixorigin/matrixone/pkg/util/internalExecutor"
+)
+
+// PublishQueryResult runs the query and publishes each row of its result to
+// the topic as a json object keyed by the column names. It returns the
+// number of the published rows.
+func PublishQueryResult(
+	ctx context.Context,
+	exec ie.InternalExecutor,
+	producer mokafka.Producer,
+	topic string,
+	sql string,
+) (int, error) {
+	res := exec.Query(ctx, sql, ie.SessionOverrideOptions{})
+	if res.Error() != nil {
+		return 0, res.Error()
+	}
+	names := make([]string, res.ColumnCount())
+	for i := range names {
+		name, _, _, err := res.Column(ctx, uint64(i))
+		if err != nil {
+			return 0, err
+		}
+		names[i] = name
+	}
+	for i := uint64(0); i < res.RowCount(); i++ {
+		row, err := res.Row(ctx, i)
+		if err != nil {
+			return int(i), err
+		}
+		obj := make(map[string]any, len(names))
+		for j, name := range names {
+			if j < len(row) {
+				obj[name] = jsonValue(row[j])
+			}
+		}
+		value, err := json.Marshal(obj)
+		if err != nil {
+			return int(i), err
+		}
+		if _, err = producer.ProduceMessage(topic, nil, value); err != nil {
+			return int(i), err
+		}
+	}
+	return int(res.RowCount()), nil
+}
+
+// jsonValue converts a value of the query result into the one encoded by
+// json. Strings are returned as []byte by the executor, and the values of
+// the time and decimal types are formatted by their String methods.
+func jsonValue(v any) any {
+	switch x := v.(type) {
+	case []byte:
+		return string(x)
+	case fmt.Stringer:
+		return x.String()
+	default:
+		return x
+	}
+}
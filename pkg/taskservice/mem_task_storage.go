@@ -194,6 +194,26 @@ func (s *memTaskStorage) UpdateCronTask(ctx context.Context, cron task.CronTask,
 	return 2, nil
 }
 
+func (s *memTaskStorage) DeleteCronTask(ctx context.Context, conds ...Condition) (int, error) {
+	c := newConditions(conds...)
+
+	s.Lock()
+	defer s.Unlock()
+
+	var removeTasks []task.CronTask
+	for _, v := range s.cronTasks {
+		if s.filterCronTask(c, v) {
+			removeTasks = append(removeTasks, v)
+		}
+	}
+
+	for _, v := range removeTasks {
+		delete(s.cronTasks, v.ID)
+		delete(s.cronTaskIndexes, v.Metadata.ID)
+	}
+	return len(removeTasks), nil
+}
+
 func (s *memTaskStorage) AddDaemonTask(ctx context.Context, tasks ...task.DaemonTask) (int, error) {
 	s.Lock()
 	defer s.Unlock()
@@ -335,6 +355,29 @@ func (s *memTaskStorage) filterAsyncTask(c *conditions, task task.AsyncTask) boo
 	return ok
 }
 
+func (s *memTaskStorage) filterCronTask(c *conditions, task task.CronTask) bool {
+	ok := true
+
+	if cond, e := (*c)[CondCronTaskId]; e {
+		ok = cond.eval(task.ID)
+	}
+	if !ok {
+		return false
+	}
+
+	if cond, e := (*c)[CondTaskMetadataId]; e {
+		ok = cond.eval(task.Metadata.ID)
+	}
+	if !ok {
+		return false
+	}
+
+	if cond, e := (*c)[CondTaskExecutor]; e {
+		ok = cond.eval(task.Metadata.Executor)
+	}
+	return ok
+}
+
 func (s *memTaskStorage) filterDaemonTask(c *conditions, task task.DaemonTask) bool {
 	ok := true
 
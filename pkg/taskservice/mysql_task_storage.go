@@ -112,6 +112,8 @@ var (
 
 	deleteAsyncTask = "delete from sys_async_task where 1=1"
 
+	deleteCronTask = "delete from sys_cron_task where 1=1"
+
 	insertDaemonTask = "insert into sys_daemon_task (" +
 		"task_metadata_id," +
 		"task_metadata_executor," +
@@ -573,6 +575,21 @@ func (m *mysqlTaskStorage) UpdateCronTask(ctx context.Context, cronTask task.Cro
 	return int(affected2) + int(affected1), nil
 }
 
+func (m *mysqlTaskStorage) DeleteCronTask(ctx context.Context, condition ...Condition) (int, error) {
+	if taskFrameworkDisabled() {
+		return 0, nil
+	}
+	exec, err := m.db.ExecContext(ctx, deleteCronTask+buildWhereClause(newConditions(condition...)))
+	if err != nil {
+		return 0, err
+	}
+	affected, err := exec.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(affected), nil
+}
+
 func (m *mysqlTaskStorage) taskExists(ctx context.Context, taskMetadataID string) (bool, error) {
 	var count int32
 	if err := m.db.QueryRowContext(ctx, countTaskId, taskMetadataID).Scan(&count); err != nil {
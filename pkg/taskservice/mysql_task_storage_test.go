@@ -153,6 +153,12 @@ func TestCronTaskInSqlMock(t *testing.T) {
 	assert.Equal(t, 1, len(cronTask))
 	assert.Equal(t, "a", cronTask[0].Metadata.ID)
 
+	mock.ExpectExec(deleteCronTask + " AND task_metadata_id = 'a'").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	affected, err = storage.DeleteCronTask(context.Background(), WithTaskMetadataId(EQ, "a"))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, affected)
+
 	mock.ExpectClose()
 	require.NoError(t, storage.Close())
 }
@@ -296,7 +296,11 @@ type taskMetadataIDCond struct {
 }
 
 func (c *taskMetadataIDCond) eval(v any) bool {
-	return false
+	id, ok := v.(string)
+	if !ok {
+		return false
+	}
+	return compare(c.op, id, c.taskMetadataID)
 }
 
 func (c *taskMetadataIDCond) sql() string {
@@ -569,6 +573,8 @@ type TaskStorage interface {
 	// This update must be transactional and needs to be done conditionally
 	// using CronTask.TriggerTimes and the task.Metadata.ID field.
 	UpdateCronTask(context.Context, task.CronTask, task.AsyncTask) (int, error)
+	// DeleteCronTask deletes cron tasks and returns number of successful deleted
+	DeleteCronTask(context.Context, ...Condition) (int, error)
 
 	// AddDaemonTask adds daemon tasks and returns number of successful added.
 	AddDaemonTask(ctx context.Context, tasks ...task.DaemonTask) (int, error)
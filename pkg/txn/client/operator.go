@@ -20,6 +20,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -206,6 +207,13 @@ func WithSkipPushClientReady() TxnOption {
 	}
 }
 
+// txnSavepoint is a savepoint of the transaction, the i-th savepoint is
+// corresponding to the i-th savepoint of the workspace.
+type txnSavepoint struct {
+	name  string
+	locks lockservice.LockSavepoint
+}
+
 type txnOperator struct {
 	sid             string
 	logger          *log.MOLogger
@@ -221,6 +229,7 @@ type txnOperator struct {
 		txn          txn.TxnMeta
 		cachedWrites map[uint64][]txn.TxnRequest
 		lockTables   []lock.LockTable
+		savepoints   []txnSavepoint
 		callbacks    map[EventType][]func(TxnEvent)
 		retry        bool
 		lockSeq      uint64
@@ -323,6 +332,7 @@ func (tc *txnOperator) initProtectedFields() {
 	tc.mu.lockSeq = 0
 	tc.mu.txn = txn.TxnMeta{}
 	tc.mu.lockTables = tc.mu.lockTables[:0]
+	tc.mu.savepoints = tc.mu.savepoints[:0]
 	tc.mu.children = tc.mu.children[:0]
 	if tc.mu.cachedWrites != nil {
 		for k := range tc.mu.cachedWrites {
@@ -689,6 +699,116 @@ func (tc *txnOperator) Rollback(ctx context.Context) (err error) {
 	return nil
 }
 
+func (tc *txnOperator) Savepoint(ctx context.Context, name string) error {
+	if err := tc.checkStatus(false); err != nil {
+		return err
+	}
+
+	// the savepoint with the same name is replaced.
+	if idx := tc.findSavepoint(name); idx >= 0 {
+		if err := tc.releaseSavepoint(ctx, idx); err != nil {
+			return err
+		}
+	}
+
+	if tc.reset.workspace != nil {
+		if err := tc.reset.workspace.Savepoint(ctx); err != nil {
+			return err
+		}
+	}
+
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+	sp := txnSavepoint{name: name}
+	if tc.needUnlockLocked() {
+		sp.locks = tc.lockService.Savepoint(tc.mu.txn.ID)
+	}
+	tc.mu.savepoints = append(tc.mu.savepoints, sp)
+	return nil
+}
+
+func (tc *txnOperator) RollbackToSavepoint(ctx context.Context, name string) error {
+	if err := tc.checkStatus(false); err != nil {
+		return err
+	}
+
+	idx := tc.findSavepoint(name)
+	if idx < 0 {
+		return moerr.NewSavepointNotExist(ctx, name)
+	}
+
+	if tc.reset.workspace != nil {
+		if err := tc.reset.workspace.RollbackToSavepoint(ctx, idx); err != nil {
+			return err
+		}
+	}
+
+	// the locks which can not be released at the savepoint are kept until the
+	// transaction ends, so only the writes are rolled back for them.
+	if err := tc.rollbackLocksToSavepoint(ctx, idx); err != nil {
+		return err
+	}
+
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+	tc.mu.savepoints = tc.mu.savepoints[:idx+1]
+	return nil
+}
+
+func (tc *txnOperator) rollbackLocksToSavepoint(ctx context.Context, idx int) error {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+	if !tc.needUnlockLocked() {
+		return nil
+	}
+	return tc.lockService.RollbackToSavepoint(ctx, tc.mu.txn.ID, tc.mu.savepoints[idx].locks)
+}
+
+func (tc *txnOperator) ReleaseSavepoint(ctx context.Context, name string) error {
+	if err := tc.checkStatus(false); err != nil {
+		return err
+	}
+
+	idx := tc.findSavepoint(name)
+	if idx < 0 {
+		return moerr.NewSavepointNotExist(ctx, name)
+	}
+
+	tc.mu.RLock()
+	n := len(tc.mu.savepoints)
+	tc.mu.RUnlock()
+	for i := n - 1; i >= idx; i-- {
+		if err := tc.releaseSavepoint(ctx, i); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (tc *txnOperator) findSavepoint(name string) int {
+	tc.mu.RLock()
+	defer tc.mu.RUnlock()
+	for i, sp := range tc.mu.savepoints {
+		if strings.EqualFold(sp.name, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (tc *txnOperator) releaseSavepoint(ctx context.Context, idx int) error {
+	if tc.reset.workspace != nil {
+		if err := tc.reset.workspace.ReleaseSavepoint(ctx, idx); err != nil {
+			return err
+		}
+	}
+
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+	tc.mu.savepoints = append(tc.mu.savepoints[:idx], tc.mu.savepoints[idx+1:]...)
+	return nil
+}
+
 func (tc *txnOperator) AddLockTable(value lock.LockTable) error {
 	tc.mu.Lock()
 	defer tc.mu.Unlock()
@@ -650,6 +650,46 @@ func TestBase(t *testing.T) {
 	)
 }
 
+func TestSavepoint(t *testing.T) {
+	runOperatorTests(
+		t,
+		func(
+			ctx context.Context,
+			tc *txnOperator,
+			_ *testTxnSender,
+		) {
+			names := func() []string {
+				var values []string
+				for _, sp := range tc.mu.savepoints {
+					values = append(values, sp.name)
+				}
+				return values
+			}
+
+			require.NoError(t, tc.Savepoint(ctx, "a"))
+			require.NoError(t, tc.Savepoint(ctx, "b"))
+			require.NoError(t, tc.Savepoint(ctx, "c"))
+			// replace the savepoint with the same name
+			require.NoError(t, tc.Savepoint(ctx, "A"))
+			require.Equal(t, []string{"b", "c", "A"}, names())
+
+			require.NoError(t, tc.RollbackToSavepoint(ctx, "C"))
+			require.Equal(t, []string{"b", "c"}, names())
+
+			err := tc.RollbackToSavepoint(ctx, "a")
+			require.True(t, moerr.IsMoErrCode(err, moerr.ErrSavepointNotExist))
+
+			require.NoError(t, tc.ReleaseSavepoint(ctx, "b"))
+			require.Empty(t, names())
+			err = tc.ReleaseSavepoint(ctx, "b")
+			require.True(t, moerr.IsMoErrCode(err, moerr.ErrSavepointNotExist))
+
+			require.NoError(t, tc.Rollback(ctx))
+			require.Error(t, tc.Savepoint(ctx, "a"))
+		},
+	)
+}
+
 func runOperatorTests(
 	t *testing.T,
 	tc func(context.Context, *txnOperator, *testTxnSender),
@@ -165,6 +165,14 @@ type TxnOperator interface {
 	Commit(ctx context.Context) error
 	// Rollback the transaction.
 	Rollback(ctx context.Context) error
+	// Savepoint creates a savepoint with the name in the transaction. The savepoint with
+	// the same name is replaced.
+	Savepoint(ctx context.Context, name string) error
+	// RollbackToSavepoint discards the writes and releases the locks after the savepoint,
+	// the savepoints created after it are removed.
+	RollbackToSavepoint(ctx context.Context, name string) error
+	// ReleaseSavepoint removes the savepoint and the savepoints created after it.
+	ReleaseSavepoint(ctx context.Context, name string) error
 
 	// AddLockTable for pessimistic transactions, if the current transaction is successfully
 	// locked, the metadata corresponding to the lockservice needs to be recorded to the txn, and
@@ -264,6 +272,13 @@ type Workspace interface {
 	IncrStatementID(ctx context.Context, commit bool) error
 	// RollbackLastStatement rollback the last statement.
 	RollbackLastStatement(ctx context.Context) error
+	// Savepoint appends a savepoint of the current state of the workspace.
+	Savepoint(ctx context.Context) error
+	// RollbackToSavepoint discards the writes after the idx-th savepoint and removes
+	// the savepoints after it.
+	RollbackToSavepoint(ctx context.Context, idx int) error
+	// ReleaseSavepoint removes the idx-th savepoint and keeps the writes.
+	ReleaseSavepoint(ctx context.Context, idx int) error
 
 	UpdateSnapshotWriteOffset()
 	GetSnapshotWriteOffset() int
@@ -235,6 +235,18 @@ func (s *StorageTxnOperator) Rollback(ctx context.Context) error {
 	return nil
 }
 
+func (s *StorageTxnOperator) Savepoint(ctx context.Context, name string) error {
+	return moerr.NewNotSupported(ctx, "savepoint in memory storage")
+}
+
+func (s *StorageTxnOperator) RollbackToSavepoint(ctx context.Context, name string) error {
+	return moerr.NewNotSupported(ctx, "savepoint in memory storage")
+}
+
+func (s *StorageTxnOperator) ReleaseSavepoint(ctx context.Context, name string) error {
+	return moerr.NewNotSupported(ctx, "savepoint in memory storage")
+}
+
 func (*StorageTxnOperator) Snapshot() (txn.CNTxnSnapshot, error) {
 	panic("unimplemented")
 }
@@ -91,6 +91,15 @@ type InternalExecutor interface {
 	Exec(context.Context, string, SessionOverrideOptions) error
 	// exec sql and return results set
 	Query(context.Context, string, SessionOverrideOptions) InternalExecResult
+	// exec the sqls of the func in one transaction, the transaction is
+	// committed if the func returns nil, or rolled back otherwise
+	ExecTxn(context.Context, func(TxnExecutor) error, SessionOverrideOptions) error
 	// override session for the executor scope
 	ApplySessionOverride(SessionOverrideOptions)
 }
+
+// TxnExecutor execs the sqls in the transaction of InternalExecutor.ExecTxn
+type TxnExecutor interface {
+	// exec sql without returning results set
+	Exec(context.Context, string) error
+}
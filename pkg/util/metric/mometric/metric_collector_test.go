@@ -54,6 +54,10 @@ func (e *dummySqlExecutor) Query(ctx context.Context, sql string, opts ie.Sessio
 	return nil
 }
 
+func (e *dummySqlExecutor) ExecTxn(ctx context.Context, execFunc func(ie.TxnExecutor) error, opts ie.SessionOverrideOptions) error {
+	return nil
+}
+
 func newExecutorFactory(sqlch chan string) func() ie.InternalExecutor {
 	return func() ie.InternalExecutor {
 		return &dummySqlExecutor{
@@ -187,6 +187,7 @@ func initProxyMetrics() {
 	registry.MustRegister(ProxyAvailableBackendServerNumGauge)
 	registry.MustRegister(ProxyTransferQueueSizeGauge)
 	registry.MustRegister(ProxyConnectionsNeedToTransferGauge)
+	registry.MustRegister(proxyReadRouteCounter)
 }
 
 func initFrontendMetrics() {
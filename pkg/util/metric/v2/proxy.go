@@ -102,4 +102,15 @@ var (
 			Name:      "connections_transfer_intent",
 			Help:      "Proxy connections in transfer intent state",
 		})
+
+	proxyReadRouteCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "mo",
+			Subsystem: "proxy",
+			Name:      "read_route_counter",
+			Help:      "Count of proxy route read-only statements to read CN servers",
+		}, []string{"type"})
+	ProxyReadRouteSuccessCounter  = proxyReadRouteCounter.WithLabelValues("success")
+	ProxyReadRouteFallbackCounter = proxyReadRouteCounter.WithLabelValues("fallback")
+	ProxyReadRouteFailCounter     = proxyReadRouteCounter.WithLabelValues("fail")
 )
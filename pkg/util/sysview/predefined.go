@@ -248,30 +248,30 @@ var (
 		"MAXLEN int unsigned" +
 		")"
 
-	InformationSchemaTriggersDDL = "CREATE TABLE information_schema.TRIGGERS (" +
-		"TRIGGER_CATALOG varchar(64)," +
-		"TRIGGER_SCHEMA varchar(64)," +
-		"TRIGGER_NAME varchar(64)," +
-		"EVENT_MANIPULATION varchar(10)," +
-		"EVENT_OBJECT_CATALOG varchar(64)," +
-		"EVENT_OBJECT_SCHEMA varchar(64)," +
-		"EVENT_OBJECT_TABLE varchar(64)," +
-		"ACTION_ORDER int unsigned," +
-		"ACTION_CONDITION binary(0)," +
-		"ACTION_STATEMENT longtext," +
-		"ACTION_ORIENTATION varchar(3)," +
-		"ACTION_TIMING varchar(10)," +
-		"ACTION_REFERENCE_OLD_TABLE binary(0)," +
-		"ACTION_REFERENCE_NEW_TABLE binary(0)," +
-		"ACTION_REFERENCE_OLD_ROW varchar(3)," +
-		"ACTION_REFERENCE_NEW_ROW varchar(3)," +
-		"CREATED timestamp(2)," +
-		"SQL_MODE varchar(10)," +
-		"DEFINER varchar(288)," +
-		"CHARACTER_SET_CLIENT varchar(64)," +
-		"COLLATION_CONNECTION varchar(64)," +
-		"DATABASE_COLLATION varchar(64)" +
-		")"
+	InformationSchemaTriggersDDL = fmt.Sprintf("CREATE VIEW information_schema.TRIGGERS AS "+
+		"SELECT 'def' AS TRIGGER_CATALOG,"+
+		"db_name AS TRIGGER_SCHEMA,"+
+		"trigger_name AS TRIGGER_NAME,"+
+		"event_manipulation AS EVENT_MANIPULATION,"+
+		"'def' AS EVENT_OBJECT_CATALOG,"+
+		"db_name AS EVENT_OBJECT_SCHEMA,"+
+		"table_name AS EVENT_OBJECT_TABLE,"+
+		"action_order AS ACTION_ORDER,"+
+		"NULL AS ACTION_CONDITION,"+
+		"action_statement AS ACTION_STATEMENT,"+
+		"'ROW' AS ACTION_ORIENTATION,"+
+		"action_timing AS ACTION_TIMING,"+
+		"NULL AS ACTION_REFERENCE_OLD_TABLE,"+
+		"NULL AS ACTION_REFERENCE_NEW_TABLE,"+
+		"'OLD' AS ACTION_REFERENCE_OLD_ROW,"+
+		"'NEW' AS ACTION_REFERENCE_NEW_ROW,"+
+		"created AS CREATED,"+
+		"'' AS SQL_MODE,"+
+		"definer AS `DEFINER`,"+
+		"'utf8mb4' AS CHARACTER_SET_CLIENT,"+
+		"'utf8mb4_0900_ai_ci' AS COLLATION_CONNECTION,"+
+		"'utf8mb4_0900_ai_ci' AS DATABASE_COLLATION "+
+		"FROM %s.%s", catalog.MO_CATALOG, catalog.MO_TRIGGERS)
 
 	InformationSchemaTablesDDL = fmt.Sprintf("CREATE VIEW information_schema.TABLES AS "+
 		"SELECT 'def' AS TABLE_CATALOG,"+
@@ -510,32 +510,40 @@ var (
 		"ENFORCED varchar(3) NOT NULL DEFAULT ''" +
 		")"
 
-	InformationSchemaEventsDDL = "CREATE TABLE information_schema.EVENTS (" +
-		"EVENT_CATALOG varchar(64)," +
-		"EVENT_SCHEMA varchar(64)," +
-		"EVENT_NAME varchar(64) NOT NULL," +
-		"`DEFINER` varchar(288) NOT NULL," +
-		"TIME_ZONE varchar(64) NOT NULL," +
-		"EVENT_BODY varchar(3) NOT NULL DEFAULT ''," +
-		"EVENT_DEFINITION longtext NOT NULL," +
-		"EVENT_TYPE varchar(9) NOT NULL DEFAULT ''," +
-		"EXECUTE_AT datetime," +
-		"INTERVAL_VALUE varchar(256)," +
-		"INTERVAL_FIELD enum('YEAR','QUARTER','MONTH','DAY','HOUR','MINUTE','WEEK','SECOND','MICROSECOND','YEAR_MONTH','DAY_HOUR','DAY_MINUTE','DAY_SECOND','HOUR_MINUTE','HOUR_SECOND','MINUTE_SECOND','DAY_MICROSECOND','HOUR_MICROSECOND','MINUTE_MICROSECOND','SECOND_MICROSECOND')," +
-		"SQL_MODE varchar(64) NOT NULL," +
-		"STARTS datetime," +
-		"ENDS datetime," +
-		"STATUS varchar(21) NOT NULL DEFAULT ''," +
-		"ON_COMPLETION varchar(12) NOT NULL DEFAULT ''," +
-		"CREATED timestamp NOT NULL," +
-		"LAST_ALTERED timestamp NOT NULL," +
-		"LAST_EXECUTED datetime," +
-		"EVENT_COMMENT varchar(2048) NOT NULL," +
-		"ORIGINATOR int unsigned NOT NULL," +
-		"CHARACTER_SET_CLIENT varchar(64) NOT NULL," +
-		"COLLATION_CONNECTION varchar(64) NOT NULL," +
-		"DATABASE_COLLATION varchar(64) NOT NULL" +
-		")"
+	InformationSchemaCheckConstraintsDDL = fmt.Sprintf("CREATE VIEW information_schema.CHECK_CONSTRAINTS AS "+
+		"SELECT 'def' AS CONSTRAINT_CATALOG,"+
+		"tbl.reldatabase AS CONSTRAINT_SCHEMA,"+
+		"chk.name AS CONSTRAINT_NAME,"+
+		"chk.check_clause AS CHECK_CLAUSE "+
+		"FROM %s.%s chk JOIN %s.%s tbl ON chk.table_id = tbl.rel_id",
+		catalog.MO_CATALOG, catalog.MO_CHECK_CONSTRAINTS, catalog.MO_CATALOG, catalog.MO_TABLES)
+
+	InformationSchemaEventsDDL = fmt.Sprintf("CREATE VIEW information_schema.EVENTS AS "+
+		"SELECT 'def' AS EVENT_CATALOG,"+
+		"db_name AS EVENT_SCHEMA,"+
+		"event_name AS EVENT_NAME,"+
+		"definer AS `DEFINER`,"+
+		"'SYSTEM' AS TIME_ZONE,"+
+		"'SQL' AS EVENT_BODY,"+
+		"event_body AS EVENT_DEFINITION,"+
+		"if(execute_at is null, 'RECURRING', 'ONE TIME') AS EVENT_TYPE,"+
+		"execute_at AS EXECUTE_AT,"+
+		"interval_value AS INTERVAL_VALUE,"+
+		"interval_field AS INTERVAL_FIELD,"+
+		"'' AS SQL_MODE,"+
+		"starts AS STARTS,"+
+		"ends AS ENDS,"+
+		"status AS STATUS,"+
+		"on_completion AS ON_COMPLETION,"+
+		"created AS CREATED,"+
+		"last_altered AS LAST_ALTERED,"+
+		"last_executed AS LAST_EXECUTED,"+
+		"event_comment AS EVENT_COMMENT,"+
+		"0 AS ORIGINATOR,"+
+		"'utf8mb4' AS CHARACTER_SET_CLIENT,"+
+		"'utf8mb4_0900_ai_ci' AS COLLATION_CONNECTION,"+
+		"'utf8mb4_0900_ai_ci' AS DATABASE_COLLATION "+
+		"FROM %s.%s", catalog.MO_CATALOG, catalog.MO_EVENTS)
 
 	InformationSchemaFilesDDL = "CREATE TABLE information_schema.FILES (" +
 		"FILE_ID  bigint NOT NULL," +
@@ -63,6 +63,7 @@ var (
 		InformationSchemaColumnPrivilegesDDL,
 		InformationSchemaCollationsDDL,
 		InformationSchemaTableConstraintsDDL,
+		InformationSchemaCheckConstraintsDDL,
 		InformationSchemaEventsDDL,
 		InformationSchemaFilesDDL,
 		informationSchemaKeywordsData,
@@ -45,6 +45,9 @@ func (l logOutputExecutor) Query(ctx context.Context, s string, _ ie.SessionOver
 	logutil.Info(s)
 	return nil
 }
+func (l logOutputExecutor) ExecTxn(context.Context, func(ie.TxnExecutor) error, ie.SessionOverrideOptions) error {
+	return nil
+}
 func (l logOutputExecutor) ApplySessionOverride(ie.SessionOverrideOptions) {}
 
 type dummyStringWriter struct{}
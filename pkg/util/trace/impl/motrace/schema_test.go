@@ -39,6 +39,9 @@ func (e *dummySqlExecutor) Exec(ctx context.Context, sql string, opts ie.Session
 	e.ch <- sql
 	return nil
 }
+func (e *dummySqlExecutor) ExecTxn(ctx context.Context, execFunc func(ie.TxnExecutor) error, opts ie.SessionOverrideOptions) error {
+	return nil
+}
 
 // copy from /Users/jacksonxie/go/src/github.com/matrixorigin/matrixone/pkg/util/metric/metric_collector_test.go
 func newDummyExecutorFactory(sqlch chan string) func() ie.InternalExecutor {
@@ -695,6 +695,8 @@ func getTableDef(tblItem *TableItem, coldefs []engine.TableDef) (*plan.TableDef,
 	var primarykey *plan.PrimaryKeyDef
 	var indexes []*plan.IndexDef
 	var refChildTbls []uint64
+	var checks []*plan.CheckDef
+	var triggers []*plan.TriggerDef
 
 	tableDef := make([]engine.TableDef, 0)
 
@@ -790,6 +792,10 @@ func getTableDef(tblItem *TableItem, coldefs []engine.TableDef) (*plan.TableDef,
 				primarykey = k.Pkey
 			case *engine.StreamConfigsDef:
 				properties = append(properties, k.Configs...)
+			case *engine.CheckDef:
+				checks = k.Checks
+			case *engine.TriggersDef:
+				triggers = k.Triggers
 			}
 		}
 	}
@@ -852,6 +858,8 @@ func getTableDef(tblItem *TableItem, coldefs []engine.TableDef) (*plan.TableDef,
 		Partition:     partitionInfo,
 		Fkeys:         foreignKeys,
 		RefChildTbls:  refChildTbls,
+		Checks:        checks,
+		Triggers:      triggers,
 		ClusterBy:     clusterByDef,
 		Indexes:       indexes,
 		Version:       tblItem.Version,
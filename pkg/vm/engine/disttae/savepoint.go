@@ -0,0 +1,195 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package disttae
+
+import (
+	"context"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+)
+
+// Savepoint records the current state of the workspace, the writes of the
+// statements after it can be discarded by RollbackToSavepoint.
+func (txn *Transaction) Savepoint(ctx context.Context) error {
+	txn.Lock()
+	defer txn.Unlock()
+
+	txn.cn_flushed_s3_tombstone_object_stats_list.RLock()
+	tombstones := len(txn.cn_flushed_s3_tombstone_object_stats_list.data)
+	txn.cn_flushed_s3_tombstone_object_stats_list.RUnlock()
+
+	txn.savepoints = append(txn.savepoints, &savepoint{
+		statementID:   txn.statementID,
+		offset:        len(txn.writes),
+		tombstones:    tombstones,
+		workspaceSize: txn.workspaceSize,
+		insertCount:   txn.insertCount,
+		pkCount:       txn.pkCount,
+		undo:          make(map[int]*batch.Batch),
+	})
+	return nil
+}
+
+// RollbackToSavepoint discards the writes, the tombstones and the s3 objects
+// generated after the idx-th savepoint, the savepoints after it are removed.
+func (txn *Transaction) RollbackToSavepoint(ctx context.Context, idx int) error {
+	txn.Lock()
+	defer txn.Unlock()
+
+	if idx < 0 || idx >= len(txn.savepoints) {
+		return moerr.NewInternalErrorf(ctx, "invalid savepoint %d", idx)
+	}
+	sp := txn.savepoints[idx]
+
+	txn.clearTableCache()
+	txn.tableOps.rollbackToStatement(sp.statementID)
+	for k, v := range txn.tablesInVain {
+		if v > sp.statementID {
+			delete(txn.tablesInVain, k)
+		}
+	}
+
+	// restore the writes before the savepoint modified after it, from the
+	// latest savepoint to the target one.
+	for i := len(txn.savepoints) - 1; i >= idx; i-- {
+		for j, bat := range txn.savepoints[i].undo {
+			cur := txn.writes[j].bat
+			txn.replaceBatchLocked(j, bat)
+			if cur != nil {
+				cur.Clean(txn.proc.Mp())
+			}
+		}
+	}
+	sp.undo = make(map[int]*batch.Batch)
+	txn.savepoints = txn.savepoints[:idx+1]
+
+	if err := txn.gcObjs(sp.offset); err != nil {
+		panic("to gc objects generated by CN failed")
+	}
+	for i := sp.offset; i < len(txn.writes); i++ {
+		if txn.writes[i].bat == nil {
+			continue
+		}
+		txn.writes[i].bat.Clean(txn.proc.Mp())
+	}
+	txn.writes = txn.writes[:sp.offset]
+	txn.statementID = sp.statementID
+	txn.offsets = txn.offsets[:sp.statementID]
+	txn.timestamps = txn.timestamps[:sp.statementID]
+
+	txn.cn_flushed_s3_tombstone_object_stats_list.Lock()
+	txn.cn_flushed_s3_tombstone_object_stats_list.data =
+		txn.cn_flushed_s3_tombstone_object_stats_list.data[:sp.tombstones]
+	txn.cn_flushed_s3_tombstone_object_stats_list.Unlock()
+
+	txn.workspaceSize = sp.workspaceSize
+	txn.insertCount = sp.insertCount
+	txn.pkCount = sp.pkCount
+
+	for b := range txn.batchSelectList {
+		delete(txn.batchSelectList, b)
+	}
+	txn.CleanToFreeBatches()
+	txn.deletedBlocks.clean()
+	return nil
+}
+
+// ReleaseSavepoint removes the idx-th savepoint, the writes after it are kept.
+func (txn *Transaction) ReleaseSavepoint(ctx context.Context, idx int) error {
+	txn.Lock()
+	defer txn.Unlock()
+
+	if idx < 0 || idx >= len(txn.savepoints) {
+		return moerr.NewInternalErrorf(ctx, "invalid savepoint %d", idx)
+	}
+	sp := txn.savepoints[idx]
+
+	// the original batches are still needed by the previous savepoint if they
+	// are not modified between the two savepoints.
+	var prev *savepoint
+	if idx > 0 {
+		prev = txn.savepoints[idx-1]
+	}
+	for i, bat := range sp.undo {
+		if prev != nil && i < prev.offset {
+			if _, ok := prev.undo[i]; !ok {
+				prev.undo[i] = bat
+				continue
+			}
+		}
+		bat.Clean(txn.proc.Mp())
+	}
+	txn.savepoints = append(txn.savepoints[:idx], txn.savepoints[idx+1:]...)
+	return nil
+}
+
+// savepointOffsetLocked returns the offset of txn.writes of the latest
+// savepoint, the writes before it can not be reordered.
+func (txn *Transaction) savepointOffsetLocked() int {
+	if n := len(txn.savepoints); n > 0 {
+		return txn.savepoints[n-1].offset
+	}
+	return 0
+}
+
+// keepBatchLocked keeps the batch of the i-th write for rolling back to the
+// latest savepoint, if the write was made before it. It returns false if the
+// batch is not kept and can be freed by the caller.
+func (txn *Transaction) keepBatchLocked(i int) bool {
+	n := len(txn.savepoints)
+	if n == 0 || i >= txn.savepoints[n-1].offset {
+		return false
+	}
+	sp := txn.savepoints[n-1]
+	if _, ok := sp.undo[i]; ok {
+		return false
+	}
+	sp.undo[i] = txn.writes[i].bat
+	return true
+}
+
+// modifiableBatchLocked returns the batch of the i-th write which can be
+// modified in place. The batch of a write made before the latest savepoint is
+// kept for rolling back, and a copy of it is returned.
+func (txn *Transaction) modifiableBatchLocked(i int) (*batch.Batch, error) {
+	bat := txn.writes[i].bat
+	if !txn.keepBatchLocked(i) {
+		return bat, nil
+	}
+	newBat, err := bat.Dup(txn.proc.Mp())
+	if err != nil {
+		delete(txn.savepoints[len(txn.savepoints)-1].undo, i)
+		return nil, err
+	}
+	txn.replaceBatchLocked(i, newBat)
+	return newBat, nil
+}
+
+// replaceBatchLocked replaces the batch of the i-th write, the uncommitted
+// blocks generated by CN writing S3 refer to the new one.
+func (txn *Transaction) replaceBatchLocked(i int, bat *batch.Batch) {
+	old := txn.writes[i].bat
+	txn.writes[i].bat = bat
+	if old == nil {
+		return
+	}
+	for id, pos := range txn.cnBlkId_Pos {
+		if pos.bat == old {
+			pos.bat = bat
+			txn.cnBlkId_Pos[id] = pos
+		}
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package disttae
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/api"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/engine_util"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+)
+
+// readSet is the tables and the primary keys read by a serializable txn, it
+// is sent to TN with the writes to find the rw-antidependencies at commit.
+type readSet struct {
+	sync.Mutex
+	tables map[uint64]*readSetEntry
+}
+
+type readSetEntry struct {
+	databaseId   uint64
+	databaseName string
+	tableName    string
+	// keys is nil if the whole table is read.
+	keys map[string]struct{}
+}
+
+// addReadSet records the read of the table filtered by exprs. Only the point
+// reads on the primary key are recorded by keys, the others read the whole
+// table.
+func (txn *Transaction) addReadSet(tbl *txnTable, exprs []*plan.Expr) {
+	if !txn.op.Txn().IsSSIIsolation() ||
+		tbl.db.op.IsSnapOp() ||
+		tbl.db.databaseId == catalog.MO_CATALOG_ID {
+		return
+	}
+	keys := tbl.readKeys(exprs)
+
+	txn.readSet.Lock()
+	defer txn.readSet.Unlock()
+	if txn.readSet.tables == nil {
+		txn.readSet.tables = make(map[uint64]*readSetEntry)
+	}
+	entry, ok := txn.readSet.tables[tbl.tableId]
+	if !ok {
+		entry = &readSetEntry{
+			databaseId:   tbl.db.databaseId,
+			databaseName: tbl.db.databaseName,
+			tableName:    tbl.tableName,
+		}
+		if keys != nil {
+			entry.keys = make(map[string]struct{}, len(keys))
+		}
+		txn.readSet.tables[tbl.tableId] = entry
+	}
+	if entry.keys == nil {
+		return
+	}
+	if keys == nil || len(entry.keys)+len(keys) > txnif.MaxRWSetKeys {
+		entry.keys = nil
+		return
+	}
+	for _, key := range keys {
+		entry.keys[string(key)] = struct{}{}
+	}
+}
+
+// readKeys returns the primary keys read by exprs, or nil if they are not
+// point reads on the primary key.
+func (tbl *txnTable) readKeys(exprs []*plan.Expr) [][]byte {
+	if tbl.primaryIdx < 0 || catalog.IsFakePkName(tbl.tableDef.Pkey.PkeyColName) {
+		return nil
+	}
+	size := 0
+	if pkType := tbl.tableDef.Cols[tbl.primaryIdx].Typ; tbl.tableDef.Pkey.CompPkeyCol == nil {
+		if typ := types.T(pkType.Id).ToType(); typ.IsFixedLen() {
+			size = int(typ.TypeSize())
+		}
+	}
+	proc := tbl.proc.Load()
+	for _, expr := range exprs {
+		filter, err := engine_util.ConstructBasePKFilter(expr, tbl.tableDef, proc)
+		if err != nil || !filter.Valid {
+			continue
+		}
+		var keys [][]byte
+		switch filter.Op {
+		case function.EQUAL:
+			keys = [][]byte{filter.LB}
+		case function.IN:
+			keys = make([][]byte, 0, filter.Vec.Length())
+			for i := 0; i < filter.Vec.Length(); i++ {
+				keys = append(keys, filter.Vec.GetRawBytesAt(i))
+			}
+		}
+		if filter.Vec != nil {
+			filter.Vec.Free(proc.Mp())
+		}
+		if keys == nil || len(keys) > txnif.MaxRWSetKeys {
+			continue
+		}
+		valid := true
+		for _, key := range keys {
+			// the literal may be encoded in a type other than the primary key's.
+			if size > 0 && len(key) != size {
+				valid = false
+				break
+			}
+		}
+		if valid {
+			return keys
+		}
+	}
+	return nil
+}
+
+// readSetEntries returns the entries of the read set sent to TN.
+func (txn *Transaction) readSetEntries() ([]*api.Entry, error) {
+	txn.readSet.Lock()
+	defer txn.readSet.Unlock()
+	entries := make([]*api.Entry, 0, len(txn.readSet.tables))
+	for tableId, e := range txn.readSet.tables {
+		entry := &api.Entry{
+			EntryType:    api.Entry_Read,
+			TableId:      tableId,
+			DatabaseId:   e.databaseId,
+			TableName:    e.tableName,
+			DatabaseName: e.databaseName,
+		}
+		if e.keys != nil {
+			vec := vector.NewVec(types.T_varbinary.ToType())
+			for key := range e.keys {
+				if err := vector.AppendBytes(vec, []byte(key), false, txn.proc.Mp()); err != nil {
+					vec.Free(txn.proc.Mp())
+					return nil, err
+				}
+			}
+			bat, err := toPBBatch(&batch.Batch{
+				Attrs: []string{"pk"},
+				Vecs:  []*vector.Vector{vec},
+			})
+			if err == nil {
+				// the proto vector refers to the memory of vec.
+				bat.Vecs[0].Data = bytes.Clone(bat.Vecs[0].Data)
+				bat.Vecs[0].Area = bytes.Clone(bat.Vecs[0].Area)
+			}
+			vec.Free(txn.proc.Mp())
+			if err != nil {
+				return nil, err
+			}
+			entry.Bat = bat
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
@@ -97,6 +97,11 @@ func genWriteReqs(
 		return nil, nil
 	}
 	trace.GetService(txnCommit.proc.GetService()).TxnCommit(op, entries)
+	readEntries, err := txnCommit.readSetEntries()
+	if err != nil {
+		return nil, err
+	}
+	entries = append(entries, readEntries...)
 	reqs := make([]txn.TxnRequest, 0, len(entries))
 	payload, err := types.Encode(&api.PrecommitWriteCmd{EntryList: entries})
 	if err != nil {
@@ -843,18 +843,24 @@ func (txn *Transaction) mergeTxnWorkspaceLocked() error {
 	txn.restoreTxnTableFunc = txn.restoreTxnTableFunc[:0]
 
 	if len(txn.batchSelectList) > 0 {
-		for _, e := range txn.writes {
+		for i, e := range txn.writes {
 			if sels, ok := txn.batchSelectList[e.bat]; ok {
-				txn.insertCount -= e.bat.RowCount() - len(sels)
-				e.bat.Shrink(sels, false)
 				delete(txn.batchSelectList, e.bat)
+				bat, err := txn.modifiableBatchLocked(i)
+				if err != nil {
+					return err
+				}
+				txn.insertCount -= bat.RowCount() - len(sels)
+				bat.Shrink(sels, false)
 			}
 		}
 	}
 	if len(txn.tablesInVain) > 0 {
 		for i, e := range txn.writes {
 			if _, ok := txn.tablesInVain[e.tableId]; e.bat != nil && ok {
-				e.bat.Clean(txn.proc.GetMPool())
+				if !txn.keepBatchLocked(i) {
+					e.bat.Clean(txn.proc.GetMPool())
+				}
 				txn.writes[i].bat = nil
 			}
 		}
@@ -958,8 +964,16 @@ func (txn *Transaction) compactionBlksLocked() error {
 			entry.bat.Attrs[0] != catalog.BlockMeta_MetaLoc {
 			continue
 		}
-		entry.bat.Shrink(compactedEntries[entry.bat], true)
-		if entry.bat.RowCount() == 0 {
+		sels, ok := compactedEntries[entry.bat]
+		if !ok {
+			continue
+		}
+		bat, err := txn.modifiableBatchLocked(i)
+		if err != nil {
+			return err
+		}
+		bat.Shrink(sels, true)
+		if bat.RowCount() == 0 {
 			txn.writes[i].bat.Clean(txn.proc.GetMPool())
 			txn.writes[i].bat = nil
 		}
@@ -1137,6 +1151,12 @@ func (txn *Transaction) delTransaction() {
 		}
 		txn.proc.PutBatch(txn.writes[i].bat)
 	}
+	for _, sp := range txn.savepoints {
+		for _, bat := range sp.undo {
+			txn.proc.PutBatch(bat)
+		}
+	}
+	txn.savepoints = nil
 	txn.CleanToFreeBatches()
 	txn.tableCache = nil
 	txn.tableOps = nil
@@ -1399,6 +1419,26 @@ func (c *tableOpsChain) rollbackLastStatement(statementId int) {
 	}
 }
 
+// rollbackToStatement will rollback the operations caused by the statements after
+// the statementId in the chain.
+func (c *tableOpsChain) rollbackToStatement(statementId int) {
+	c.Lock()
+	defer c.Unlock()
+	for k, v := range c.names {
+		i := len(v) - 1
+		for ; i >= 0; i-- {
+			if v[i].statementId <= statementId {
+				break
+			}
+		}
+		if i < 0 {
+			delete(c.names, k)
+		} else if i < len(v)-1 {
+			c.names[k] = v[:i+1]
+		}
+	}
+}
+
 func (c *tableOpsChain) string() string {
 	c.RLock()
 	defer c.RUnlock()
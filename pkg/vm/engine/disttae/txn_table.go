@@ -539,6 +539,7 @@ func (tbl *txnTable) Ranges(
 	exprs []*plan.Expr,
 	txnOffset int,
 ) (data engine.RelData, err error) {
+	tbl.getTxn().addReadSet(tbl, exprs)
 	return tbl.doRanges(
 		ctx,
 		exprs,
@@ -1006,6 +1007,8 @@ func (tbl *txnTable) GetTableDef(ctx context.Context) *plan.TableDef {
 		var primarykey *plan.PrimaryKeyDef
 		var indexes []*plan.IndexDef
 		var refChildTbls []uint64
+		var checks []*plan.CheckDef
+		var triggers []*plan.TriggerDef
 		var hasRowId bool
 
 		i := int32(0)
@@ -1089,6 +1092,10 @@ func (tbl *txnTable) GetTableDef(ctx context.Context) *plan.TableDef {
 					primarykey = k.Pkey
 				case *engine.StreamConfigsDef:
 					properties = append(properties, k.Configs...)
+				case *engine.CheckDef:
+					checks = k.Checks
+				case *engine.TriggersDef:
+					triggers = k.Triggers
 				}
 			}
 		}
@@ -1142,6 +1149,8 @@ func (tbl *txnTable) GetTableDef(ctx context.Context) *plan.TableDef {
 			Partition:     partitionInfo,
 			Fkeys:         foreignKeys,
 			RefChildTbls:  refChildTbls,
+			Checks:        checks,
+			Triggers:      triggers,
 			ClusterBy:     clusterByDef,
 			Indexes:       indexes,
 			Version:       tbl.version,
@@ -1200,6 +1209,7 @@ func (tbl *txnTable) AlterTable(ctx context.Context, c *engine.ConstraintDef, re
 	oldPartInfo := tbl.partition
 	oldComment := tbl.comment
 	oldConstraint := tbl.constraint
+	oldRelKind := tbl.relKind
 	// The fact that the tableDef brought by alter requests can appended to the tail of original defs presupposes:
 	// 1. late arriving tableDef will overwrite the existing tableDef
 	// 2. any TableDef about columns, like AttritebuteDef, PrimaryKeyDef, or CluterbyDef do not change, ensuring genColumnsFromDefs works well
@@ -1209,9 +1219,11 @@ func (tbl *txnTable) AlterTable(ctx context.Context, c *engine.ConstraintDef, re
 	restore := func() {
 		for _, req := range reqs {
 			switch req.GetKind() {
-			case api.AlterKind_AddPartition:
+			case api.AlterKind_AddPartition, api.AlterKind_DropPartition:
 				tbl.partitioned = oldPart
 				tbl.partition = oldPartInfo
+			case api.AlterKind_UpdateKind:
+				tbl.relKind = oldRelKind
 			case api.AlterKind_UpdateComment:
 				tbl.comment = oldComment
 			case api.AlterKind_UpdateConstraint:
@@ -1242,6 +1254,23 @@ func (tbl *txnTable) AlterTable(ctx context.Context, c *engine.ConstraintDef, re
 				Partitioned: 1,
 				Partition:   tbl.partition,
 			})
+		case api.AlterKind_DropPartition:
+			info, err := req.GetDropPartition().GetPartitionDef().MarshalPartitionInfo()
+			if err != nil {
+				return err
+			}
+			tbl.partition = string(info)
+			appendDef = append(appendDef, &engine.PartitionDef{
+				Partitioned: 1,
+				Partition:   tbl.partition,
+			})
+		case api.AlterKind_UpdateKind:
+			tbl.relKind = req.GetUpdateKind().Kind
+			appendDef = append(appendDef, &engine.PropertiesDef{
+				Properties: []engine.Property{
+					{Key: catalog.SystemRelAttr_Kind, Value: tbl.relKind},
+				},
+			})
 		case api.AlterKind_UpdateComment:
 			tbl.comment = req.GetUpdateComment().Comment
 			appendDef = append(appendDef, &engine.CommentDef{Comment: tbl.comment})
@@ -15,11 +15,16 @@
 package disttae
 
 import (
+	"context"
 	"sync"
 	"testing"
 
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
 	"github.com/stretchr/testify/require"
 )
 
@@ -49,3 +54,68 @@ func Test_GetUncommittedS3Tombstone(t *testing.T) {
 	}
 
 }
+
+func Test_Savepoint(t *testing.T) {
+	ctx := context.Background()
+	proc := testutil.NewProc()
+	txn := &Transaction{
+		proc:            proc,
+		tableCache:      new(sync.Map),
+		tableOps:        newTableOps(),
+		tablesInVain:    make(map[uint64]int),
+		deletedBlocks:   &deletedBlocks{offsets: make(map[types.Blockid][]int64)},
+		cnBlkId_Pos:     make(map[types.Blockid]Pos),
+		batchSelectList: make(map[*batch.Batch][]int64),
+		toFreeBatches:   make(map[tableKey][]*batch.Batch),
+	}
+	newBatch := func(vals ...int64) *batch.Batch {
+		return testutil.NewBatchWithVectors(
+			[]*vector.Vector{testutil.NewInt64Vector(len(vals), types.T_int64.ToType(), proc.Mp(), false, vals)},
+			nil)
+	}
+	statement := func(bats ...*batch.Batch) {
+		for _, bat := range bats {
+			txn.writes = append(txn.writes, Entry{typ: INSERT, bat: bat})
+			txn.insertCount += bat.RowCount()
+		}
+		txn.offsets = append(txn.offsets, len(txn.writes))
+		txn.timestamps = append(txn.timestamps, timestamp.Timestamp{})
+		txn.statementID++
+	}
+
+	statement(newBatch(1, 2, 3))
+	require.NoError(t, txn.Savepoint(ctx))
+	statement(newBatch(4, 5))
+
+	// the batch before the savepoint is copied before modified in place
+	bat, err := txn.modifiableBatchLocked(0)
+	require.NoError(t, err)
+	require.NotSame(t, bat, txn.savepoints[0].undo[0])
+	require.Same(t, bat, txn.writes[0].bat)
+	bat.Shrink([]int64{0}, true)
+	require.Equal(t, 2, txn.writes[0].bat.RowCount())
+
+	// the second modification after the savepoint is done in place
+	again, err := txn.modifiableBatchLocked(0)
+	require.NoError(t, err)
+	require.Same(t, bat, again)
+
+	require.NoError(t, txn.Savepoint(ctx))
+	statement(newBatch(6))
+	require.Equal(t, 3, len(txn.writes))
+
+	require.NoError(t, txn.RollbackToSavepoint(ctx, 0))
+	require.Equal(t, 1, len(txn.savepoints))
+	require.Equal(t, 1, len(txn.writes))
+	require.Equal(t, 3, txn.writes[0].bat.RowCount())
+	require.Equal(t, 1, txn.statementID)
+	require.Equal(t, 1, len(txn.offsets))
+	require.Equal(t, 3, txn.insertCount)
+
+	// the writes after the released savepoint are kept
+	statement(newBatch(7))
+	require.NoError(t, txn.ReleaseSavepoint(ctx, 0))
+	require.Equal(t, 0, len(txn.savepoints))
+	require.Equal(t, 2, len(txn.writes))
+	require.Error(t, txn.RollbackToSavepoint(ctx, 0))
+}
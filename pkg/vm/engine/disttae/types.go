@@ -300,6 +300,10 @@ type Transaction struct {
 	timestamps []timestamp.Timestamp
 	//the start time of first statement in a txn.
 	start time.Time
+	//savepoints created in the txn, ordered by the creation.
+	savepoints []*savepoint
+	//the tables and the keys read by the serializable txn.
+	readSet readSet
 
 	hasS3Op              atomic.Bool
 	removed              bool
@@ -313,6 +317,21 @@ type Transaction struct {
 	haveDDL atomic.Bool
 }
 
+// savepoint records the state of the workspace when a savepoint was created.
+type savepoint struct {
+	statementID int
+	//len(txn.writes) when the savepoint was created.
+	offset int
+	//the count of the flushed tombstone objects.
+	tombstones    int
+	workspaceSize uint64
+	insertCount   int
+	pkCount       int
+	//the original batches of the writes before the savepoint which are
+	//modified after it, index of txn.writes -> batch.
+	undo map[int]*batch.Batch
+}
+
 type Pos struct {
 	bat       *batch.Batch
 	accountId uint32
@@ -462,8 +481,9 @@ func (txn *Transaction) IncrStatementID(ctx context.Context, commit bool) error
 	if err := txn.mergeTxnWorkspaceLocked(); err != nil {
 		return err
 	}
-	// dump batch to s3, starting from 0 (begining of the workspace)
-	if err := txn.dumpBatchLocked(0); err != nil {
+	// dump batch to s3, starting from 0 (begining of the workspace), or from
+	// the latest savepoint, the writes before it must be kept for rolling back.
+	if err := txn.dumpBatchLocked(txn.savepointOffsetLocked()); err != nil {
 		return err
 	}
 	txn.offsets = append(txn.offsets, len(txn.writes))
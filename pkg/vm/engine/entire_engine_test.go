@@ -357,6 +357,18 @@ func (o *testOperator) Rollback(ctx context.Context) error {
 	return nil
 }
 
+func (o *testOperator) Savepoint(ctx context.Context, name string) error {
+	return nil
+}
+
+func (o *testOperator) RollbackToSavepoint(ctx context.Context, name string) error {
+	return nil
+}
+
+func (o *testOperator) ReleaseSavepoint(ctx context.Context, name string) error {
+	return nil
+}
+
 func (o *testOperator) Snapshot() (txn.CNTxnSnapshot, error) {
 	return txn.CNTxnSnapshot{}, nil
 }
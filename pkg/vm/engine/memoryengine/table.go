@@ -267,6 +267,8 @@ func (t *Table) GetTableDef(ctx context.Context) *plan.TableDef {
 	var primarykey *plan2.PrimaryKeyDef
 	var indexes []*plan2.IndexDef
 	var refChildTbls []uint64
+	var checks []*plan.CheckDef
+	var triggers []*plan.TriggerDef
 
 	for _, def := range engineDefs {
 		if attr, ok := def.(*engine.AttributeDef); ok {
@@ -328,6 +330,10 @@ func (t *Table) GetTableDef(ctx context.Context) *plan.TableDef {
 					primarykey = k.Pkey
 				case *engine.StreamConfigsDef:
 					properties = append(properties, k.Configs...)
+				case *engine.CheckDef:
+					checks = k.Checks
+				case *engine.TriggersDef:
+					triggers = k.Triggers
 				}
 			}
 		} else if commnetDef, ok := def.(*engine.CommentDef); ok {
@@ -379,6 +385,8 @@ func (t *Table) GetTableDef(ctx context.Context) *plan.TableDef {
 		Partition:    partitionInfo,
 		Fkeys:        foreignKeys,
 		RefChildTbls: refChildTbls,
+		Checks:       checks,
+		Triggers:     triggers,
 		ClusterBy:    clusterByDef,
 		Indexes:      indexes,
 		Version:      schemaVersion,
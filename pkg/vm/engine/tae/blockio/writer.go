@@ -99,6 +99,10 @@ func (w *BlockWriter) SetAppendable() {
 	w.writer.SetAppendable()
 }
 
+func (w *BlockWriter) SetCompressOption(opt objectio.CompressOption) {
+	w.writer.SetCompressOption(opt)
+}
+
 func (w *BlockWriter) GetObjectStats(opts ...objectio.ObjectStatsOptions) objectio.ObjectStats {
 	return w.writer.GetObjectStats(opts...)
 }
@@ -22,10 +22,14 @@ import (
 	"testing"
 	"time"
 
+	pkgcatalog "github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/objectio"
 	"github.com/matrixorigin/matrixone/pkg/pb/api"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
@@ -439,3 +443,44 @@ func TestTxnManager_GetOrCreateTxnWithMeta(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, string(txn1), meta2.GetID())
 }
+
+func TestSchemaCompressOption(t *testing.T) {
+	defs, err := SchemaToDefs(MockSchema(3, 0))
+	require.NoError(t, err)
+	// the table properties of CREATE TABLE are kept in the constraint
+	defs = append(defs, &engine.ConstraintDef{Cts: []engine.Constraint{
+		&engine.StreamConfigsDef{Configs: []*plan.Property{
+			{Key: pkgcatalog.PropCompressAlg, Value: "zstd"},
+			{Key: pkgcatalog.PropCompressLevel, Value: "9"},
+			{Key: pkgcatalog.PropColumnEncoding, Value: "true"},
+		}},
+	}})
+	schema, err := DefsToSchema("tb", defs)
+	require.NoError(t, err)
+	require.Equal(t, objectio.CompressOption{Alg: compress.Zstd, Level: 9, Encoding: true}, schema.CompressOption())
+
+	// the policy updates only change the compression set explicitly
+	require.NoError(t, schema.ApplyAlterTable(&api.AlterTableReq{
+		Kind: api.AlterKind_UpdatePolicy,
+		Operation: &api.AlterTableReq_UpdatePolicy{
+			UpdatePolicy: &api.AlterTablePolicy{MaxObjOnerun: 16},
+		},
+	}))
+	require.Equal(t, objectio.CompressOption{Alg: compress.Zstd, Level: 9, Encoding: true}, schema.CompressOption())
+
+	require.NoError(t, schema.ApplyAlterTable(&api.AlterTableReq{
+		Kind: api.AlterKind_UpdatePolicy,
+		Operation: &api.AlterTableReq_UpdatePolicy{
+			UpdatePolicy: &api.AlterTablePolicy{SetColumnEncoding: true},
+		},
+	}))
+	require.Equal(t, objectio.CompressOption{Alg: compress.Zstd, Level: 9}, schema.CompressOption())
+
+	require.NoError(t, schema.ApplyAlterTable(&api.AlterTableReq{
+		Kind: api.AlterKind_UpdatePolicy,
+		Operation: &api.AlterTableReq_UpdatePolicy{
+			UpdatePolicy: &api.AlterTablePolicy{CompressAlg: "lz4", SetCompressLevel: true},
+		},
+	}))
+	require.Equal(t, objectio.CompressOption{Alg: compress.Lz4}, schema.CompressOption())
+}
@@ -198,6 +198,7 @@ func DefsToSchema(name string, defs []engine.TableDef) (schema *Schema, err erro
 				case pkgcatalog.SystemRelAttr_CreateSQL:
 					schema.Createsql = property.Value
 				default:
+					schema.applyCompressProperty(property.Key, property.Value)
 				}
 			}
 
@@ -213,6 +214,14 @@ func DefsToSchema(name string, defs []engine.TableDef) (schema *Schema, err erro
 			if err != nil {
 				return nil, err
 			}
+			// the table properties of CREATE TABLE are kept in the constraint
+			for _, ct := range defVal.Cts {
+				if cfg, ok := ct.(*engine.StreamConfigsDef); ok {
+					for _, property := range cfg.Configs {
+						schema.applyCompressProperty(property.Key, property.Value)
+					}
+				}
+			}
 		default:
 			// We will not deal with other cases for the time being
 		}
@@ -20,11 +20,13 @@ import (
 	"fmt"
 	"io"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	pkgcatalog "github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/objectio"
@@ -176,6 +178,41 @@ func (s *Schema) IsSecondaryIndexTable() bool {
 	return s.isSecondaryIndexTable
 }
 
+// CompressOption returns the option of the compression of the column data
+// configured by the policy of the table.
+func (s *Schema) CompressOption() objectio.CompressOption {
+	opt := objectio.DefaultCompressOption
+	if s.Extra == nil {
+		return opt
+	}
+	if alg, ok := compress.Algorithms[s.Extra.CompressAlg]; ok {
+		opt.Alg = uint8(alg)
+	}
+	opt.Level = int(s.Extra.CompressLevel)
+	opt.Encoding = s.Extra.ColumnEncoding
+	return opt
+}
+
+// applyCompressProperty sets the compression of the column data by the
+// table property, the invalid values are ignored as they are checked by
+// CREATE TABLE.
+func (s *Schema) applyCompressProperty(key, value string) {
+	switch strings.ToLower(key) {
+	case pkgcatalog.PropCompressAlg:
+		if _, ok := compress.Algorithms[strings.ToLower(value)]; ok {
+			s.Extra.CompressAlg = strings.ToLower(value)
+		}
+	case pkgcatalog.PropCompressLevel:
+		if level, err := strconv.ParseInt(value, 10, 32); err == nil {
+			s.Extra.CompressLevel = int32(level)
+		}
+	case pkgcatalog.PropColumnEncoding:
+		if encoding, err := strconv.ParseBool(value); err == nil {
+			s.Extra.ColumnEncoding = encoding
+		}
+	}
+}
+
 // ApplyAlterTable modify the schema in place. Unless you know what you are doing, it is
 // recommended to close schema first and then apply alter table.
 func (s *Schema) ApplyAlterTable(req *apipb.AlterTableReq) error {
@@ -187,6 +224,16 @@ func (s *Schema) ApplyAlterTable(req *apipb.AlterTableReq) error {
 		s.Extra.MaxObjOnerun = p.GetMaxObjOnerun()
 		s.Extra.MinCnMergeSize = p.GetMinCnMergeSize()
 		s.Extra.Hints = p.GetHints()
+		// the compression is only changed by the fields set explicitly
+		if alg := p.GetCompressAlg(); alg != "" {
+			s.Extra.CompressAlg = alg
+		}
+		if p.GetSetCompressLevel() {
+			s.Extra.CompressLevel = p.GetCompressLevel()
+		}
+		if p.GetSetColumnEncoding() {
+			s.Extra.ColumnEncoding = p.GetColumnEncoding()
+		}
 	case apipb.AlterKind_UpdateConstraint:
 		s.Constraint = req.GetUpdateCstr().GetConstraints()
 	case apipb.AlterKind_UpdateComment:
@@ -281,6 +328,15 @@ func (s *Schema) ApplyAlterTable(req *apipb.AlterTableReq) error {
 			return err
 		}
 		s.Partition = string(bytes)
+	case apipb.AlterKind_DropPartition:
+		newPartitionDef := req.GetDropPartition().GetPartitionDef()
+		bytes, err := newPartitionDef.MarshalPartitionInfo()
+		if err != nil {
+			return err
+		}
+		s.Partition = string(bytes)
+	case apipb.AlterKind_UpdateKind:
+		s.Relkind = req.GetUpdateKind().GetKind()
 	default:
 		return moerr.NewNYINoCtxf("unsupported alter kind: %v", req.Kind)
 	}
@@ -659,6 +659,9 @@ func (entry *TableEntry) AlterTable(ctx context.Context, txn txnif.TxnReader, re
 			MaxOsizeMergedObj: newSchema.Extra.MaxOsizeMergedObj,
 			MinCnMergeSize:    newSchema.Extra.MinCnMergeSize,
 			Hints:             hints,
+			CompressAlg:       newSchema.Extra.CompressAlg,
+			CompressLevel:     newSchema.Extra.CompressLevel,
+			ColumnEncoding:    newSchema.Extra.ColumnEncoding,
 		}
 
 	}
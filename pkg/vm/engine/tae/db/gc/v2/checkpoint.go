@@ -171,62 +171,99 @@ func (c *checkpointCleaner) isEnableCheckGC() bool {
 	return c.checkGC
 }
 
-func (c *checkpointCleaner) Replay() error {
-	dirs, err := c.fs.ListDir(GCMetaDir)
-	if err != nil {
-		return err
-	}
-	if len(dirs) == 0 {
-		return nil
-	}
-	minMergedStart := types.TS{}
-	minMergedEnd := types.TS{}
-	maxConsumedStart := types.TS{}
-	maxConsumedEnd := types.TS{}
+// gcMetaFiles are the GC metadata files to replay, which are the latest
+// full GCTable and the incremental ones after it.
+type gcMetaFiles struct {
+	minMergedStart   types.TS
+	minMergedEnd     types.TS
+	maxConsumedStart types.TS
+	maxConsumedEnd   types.TS
+	snapFile         string
+	acctFile         string
+	readDirs         []fileservice.DirEntry
+}
+
+func selectGCMetaFiles(dirs []fileservice.DirEntry) (files gcMetaFiles) {
 	maxSnapEnd := types.TS{}
 	maxAcctEnd := types.TS{}
 	var fullGCFile fileservice.DirEntry
 	// Get effective minMerged
-	var snapFile, acctFile string
 	for _, dir := range dirs {
 		start, end, ext := blockio.DecodeGCMetadataFileName(dir.Name)
 		if ext == blockio.GCFullExt {
-			if minMergedStart.IsEmpty() || minMergedStart.Less(&start) {
-				minMergedStart = start
-				minMergedEnd = end
-				maxConsumedStart = start
-				maxConsumedEnd = end
+			if files.minMergedStart.IsEmpty() || files.minMergedStart.Less(&start) {
+				files.minMergedStart = start
+				files.minMergedEnd = end
+				files.maxConsumedStart = start
+				files.maxConsumedEnd = end
 				fullGCFile = dir
 			}
 		}
 		if ext == blockio.SnapshotExt && maxSnapEnd.Less(&end) {
 			maxSnapEnd = end
-			snapFile = dir.Name
+			files.snapFile = dir.Name
 		}
 		if ext == blockio.AcctExt && maxAcctEnd.Less(&end) {
 			maxAcctEnd = end
-			acctFile = dir.Name
+			files.acctFile = dir.Name
 		}
 	}
-	readDirs := make([]fileservice.DirEntry, 0)
-	if !minMergedStart.IsEmpty() {
-		readDirs = append(readDirs, fullGCFile)
+	if !files.minMergedStart.IsEmpty() {
+		files.readDirs = append(files.readDirs, fullGCFile)
 	}
 	for _, dir := range dirs {
 		start, end, ext := blockio.DecodeGCMetadataFileName(dir.Name)
 		if ext == blockio.GCFullExt || ext == blockio.SnapshotExt || ext == blockio.AcctExt {
 			continue
 		}
-		if (maxConsumedStart.IsEmpty() || maxConsumedStart.Less(&end)) &&
-			minMergedEnd.Less(&end) {
-			maxConsumedStart = start
-			maxConsumedEnd = end
-			readDirs = append(readDirs, dir)
+		if (files.maxConsumedStart.IsEmpty() || files.maxConsumedStart.Less(&end)) &&
+			files.minMergedEnd.Less(&end) {
+			files.maxConsumedStart = start
+			files.maxConsumedEnd = end
+			files.readDirs = append(files.readDirs, dir)
+		}
+	}
+	return
+}
+
+// ReadGCTable replays the GC metadata files as the disk cleaner does. The
+// returned table holds the objects which have not been collected, including
+// the ones kept for snapshots, and end is the checkpoint end consumed by GC,
+// which is empty if GC has not run yet.
+func ReadGCTable(ctx context.Context, fs *objectio.ObjectFS) (table *GCTable, end types.TS, err error) {
+	table = NewGCTable()
+	dirs, err := fs.ListDir(GCMetaDir)
+	if err != nil {
+		return
+	}
+	files := selectGCMetaFiles(dirs)
+	for _, dir := range files.readDirs {
+		_, tableEnd, _ := blockio.DecodeGCMetadataFileName(dir.Name)
+		t := NewGCTable()
+		if err = t.ReadTable(ctx, GCMetaDir+dir.Name, dir.Size, fs, tableEnd); err != nil {
+			return
 		}
+		table.Merge(t)
+	}
+	return table, files.maxConsumedEnd, nil
+}
+
+func (c *checkpointCleaner) Replay() error {
+	dirs, err := c.fs.ListDir(GCMetaDir)
+	if err != nil {
+		return err
+	}
+	if len(dirs) == 0 {
+		return nil
 	}
-	if len(readDirs) == 0 {
+	files := selectGCMetaFiles(dirs)
+	if len(files.readDirs) == 0 {
 		return nil
 	}
+	minMergedStart, minMergedEnd := files.minMergedStart, files.minMergedEnd
+	maxConsumedStart, maxConsumedEnd := files.maxConsumedStart, files.maxConsumedEnd
+	snapFile, acctFile := files.snapFile, files.acctFile
+	readDirs := files.readDirs
 	for _, dir := range readDirs {
 		table := NewGCTable()
 		_, end, _ := blockio.DecodeGCMetadataFileName(dir.Name)
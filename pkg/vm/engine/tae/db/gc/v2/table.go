@@ -109,6 +109,20 @@ func (t *GCTable) getTombstones() map[string]*ObjectEntry {
 	return t.tombstones
 }
 
+// ObjectNames returns the names of the objects and tombstones in the table.
+func (t *GCTable) ObjectNames() []string {
+	t.Lock()
+	defer t.Unlock()
+	names := make([]string, 0, len(t.objects)+len(t.tombstones))
+	for name := range t.objects {
+		names = append(names, name)
+	}
+	for name := range t.tombstones {
+		names = append(names, name)
+	}
+	return names
+}
+
 // func (t *GCTable) getTombstonesLocked() map[string]*ObjectEntry {
 // 	t.Lock()
 // 	defer t.Unlock()
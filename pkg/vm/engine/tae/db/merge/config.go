@@ -46,10 +46,19 @@ type BasicPolicyConfig struct {
 	MinCNMergeSize    uint64
 	FromUser          bool
 	MergeHints        []api.MergeHint
+	// the compression of the column data of the new objects. When the
+	// config is used to update the policy, an empty CompressAlg and the
+	// level and encoding not marked by SetCompressLevel and SetColumnEncoding
+	// leave the compression of the table unchanged.
+	CompressAlg       string
+	CompressLevel     int32
+	ColumnEncoding    bool
+	SetCompressLevel  bool
+	SetColumnEncoding bool
 }
 
 func (c *BasicPolicyConfig) String() string {
-	return fmt.Sprintf(
+	s := fmt.Sprintf(
 		"minOsizeObj:%v, maxOneRun:%v, maxOsizeMergedObj: %v, offloadToCNSize:%v, hints: %v",
 		common.HumanReadableBytes(int(c.ObjectMinOsize)),
 		c.MergeMaxOneRun,
@@ -57,6 +66,10 @@ func (c *BasicPolicyConfig) String() string {
 		common.HumanReadableBytes(int(c.MinCNMergeSize)),
 		c.MergeHints,
 	)
+	if c.CompressAlg != "" || c.ColumnEncoding {
+		s += fmt.Sprintf(", compress: %v-%v, encoding: %v", c.CompressAlg, c.CompressLevel, c.ColumnEncoding)
+	}
+	return s
 }
 
 type customConfigProvider struct {
@@ -102,6 +115,9 @@ func (o *customConfigProvider) getConfig(tbl *catalog.TableEntry) *BasicPolicyCo
 				MinCNMergeSize:    cnSize,
 				FromUser:          true,
 				MergeHints:        extra.Hints,
+				CompressAlg:       extra.CompressAlg,
+				CompressLevel:     extra.CompressLevel,
+				ColumnEncoding:    extra.ColumnEncoding,
 			}
 			o.configs[tbl.ID] = p
 		}
@@ -211,6 +211,11 @@ func NewUpdatePolicyReq(c *BasicPolicyConfig) *api.AlterTableReq {
 				MaxOsizeMergedObj: c.MaxOsizeMergedObj,
 				MinCnMergeSize:    c.MinCNMergeSize,
 				Hints:             c.MergeHints,
+				CompressAlg:       c.CompressAlg,
+				CompressLevel:     c.CompressLevel,
+				ColumnEncoding:    c.ColumnEncoding,
+				SetCompressLevel:  c.SetCompressLevel,
+				SetColumnEncoding: c.SetColumnEncoding,
 			},
 		},
 	}
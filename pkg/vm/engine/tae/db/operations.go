@@ -177,6 +177,14 @@ type WriteReq struct {
 	Cancel context.CancelFunc
 }
 
+// ReadReq responds to the read entry of a serializable txn
+type ReadReq struct {
+	DatabaseId uint64
+	TableID    uint64
+	// Keys are the primary keys read, nil if the whole table is read.
+	Keys [][]byte
+}
+
 type InspectResp struct {
 	Typ     int    `json:"-"`
 	Message string `json:"msg"`
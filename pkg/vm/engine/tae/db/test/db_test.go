@@ -32,6 +32,7 @@ import (
 	pkgcatalog "github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
@@ -935,6 +936,66 @@ func TestFlushTableNoPk(t *testing.T) {
 	tae.CheckRowsByScan(100, true)
 }
 
+func TestFlushAndMergeWithCompressOption(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	testutils.EnsureNoLeak(t)
+	ctx := context.Background()
+
+	opts := config.WithLongScanAndCKPOpts(nil)
+	tae := testutil.NewTestEngine(ctx, ModuleName, t, opts)
+	defer tae.Close()
+
+	schema := catalog.MockSchemaAll(18, 13)
+	schema.Extra.CompressAlg = "zstd"
+	schema.Extra.CompressLevel = 9
+	schema.Extra.ColumnEncoding = true
+	schema.BlockMaxRows = 50
+	schema.ObjectMaxBlocks = 4
+	tae.BindSchema(schema)
+	bat := catalog.MockBatch(schema, 400)
+	defer bat.Close()
+	tae.CreateRelAndAppend(bat, true)
+
+	tae.CompactBlocks(false)
+	tae.MergeBlocks(false)
+
+	txn, rel := tae.GetRelation()
+	testutil.CheckAllColRowsByScan(t, rel, bat.Length(), false)
+	for i := 0; i < bat.Length(); i += 37 {
+		filter := handle.NewEQFilter(bat.Vecs[schema.GetSingleSortKeyIdx()].Get(i))
+		for col := range bat.Vecs {
+			v, isNull, err := rel.GetValueByFilter(ctx, filter, col)
+			require.NoError(t, err)
+			require.Equal(t, bat.Vecs[col].IsNull(i), isNull)
+			if !isNull {
+				require.Equal(t, bat.Vecs[col].Get(i), v)
+			}
+		}
+	}
+
+	// the objects are written with the compress option of the table
+	it := rel.MakeObjectIt(false)
+	defer it.Close()
+	checked := 0
+	for it.Next() {
+		entry := it.GetObject().GetMeta().(*catalog.ObjectEntry)
+		if entry.IsAppendable() || entry.HasDropCommitted() {
+			continue
+		}
+		checked++
+		location := entry.GetLocation()
+		meta, err := objectio.FastLoadObjectMeta(ctx, &location, false, tae.Runtime.Fs.Service)
+		require.NoError(t, err)
+		alg := meta.MustDataMeta().GetBlockMeta(0).MustGetColumn(0).Location().Alg()
+		require.Equal(t, uint8(compress.Zstd), objectio.CompressAlg(alg))
+	}
+	require.Positive(t, checked)
+	require.NoError(t, txn.Commit(ctx))
+
+	tae.Restart(ctx)
+	tae.CheckRowsByScan(bat.Length(), false)
+}
+
 func TestFlushTableDroppedEntry(t *testing.T) {
 	defer testutils.AfterTest(t)()
 	testutils.EnsureNoLeak(t)
@@ -891,3 +891,52 @@ func TestTxn9(t *testing.T) {
 
 // 	txn.Commit(context.Background())
 // }
+
+// txn1 and txn2 are concurrent serializable txns, each of them reads the key
+// written by the other, the later committed one is aborted.
+func TestSerializableWriteSkew(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	ctx := context.Background()
+
+	tae := testutil.NewTestEngine(ctx, ModuleName, t, nil)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(3, 2)
+	tae.BindSchema(schema)
+	bats := catalog.MockBatch(schema, 5).Split(5)
+	tae.CreateRelAndAppend(bats[0], true)
+
+	txn1, rel1 := tae.GetRelation()
+	txn2, rel2 := tae.GetRelation()
+	tid := rel1.ID()
+
+	txn1.AddReadSet(tid, [][]byte{[]byte("a")})
+	assert.NoError(t, rel1.Append(ctx, bats[1]))
+	txn1.AddWriteSet(tid, [][]byte{[]byte("b")})
+
+	txn2.AddReadSet(tid, [][]byte{[]byte("b")})
+	assert.NoError(t, rel2.Append(ctx, bats[2]))
+	txn2.AddWriteSet(tid, [][]byte{[]byte("a")})
+
+	assert.NoError(t, txn1.Commit(ctx))
+	err := txn2.Commit(ctx)
+	assert.True(t, moerr.IsMoErrCode(err, moerr.ErrTxnSerializationFailure))
+
+	// the txn started after txn1 committed is not affected.
+	txn3, rel3 := tae.GetRelation()
+	txn3.AddReadSet(tid, [][]byte{[]byte("b")})
+	assert.NoError(t, rel3.Append(ctx, bats[3]))
+	txn3.AddWriteSet(tid, [][]byte{[]byte("a")})
+	assert.NoError(t, txn3.Commit(ctx))
+
+	// the txn reading the whole table conflicts with any write.
+	txn4, rel4 := tae.GetRelation()
+	txn5, rel5 := tae.GetRelation()
+	txn4.AddReadSet(tid, nil)
+	assert.NoError(t, rel4.Append(ctx, bats[4]))
+	txn4.AddWriteSet(tid, [][]byte{[]byte("c")})
+	txn5.AddWriteSet(tid, [][]byte{[]byte("d")})
+	assert.NoError(t, rel5.DeleteByFilter(ctx, handle.NewEQFilter(bats[0].Vecs[2].Get(0))))
+	assert.NoError(t, txn5.Commit(ctx))
+	err = txn4.Commit(ctx)
+	assert.True(t, moerr.IsMoErrCode(err, moerr.ErrTxnSerializationFailure))
+}
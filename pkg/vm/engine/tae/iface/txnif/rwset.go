@@ -0,0 +1,68 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txnif
+
+// MaxRWSetKeys is the max number of the keys of a table in a RWSet, the
+// whole table is used if more keys are added.
+const MaxRWSetKeys = 4096
+
+// RWSet is the read set or the write set of a txn, it is used to find the
+// rw-antidependencies between the txns. A table maps to the primary keys read
+// or written, or nil if the whole table is involved.
+type RWSet map[uint64]map[string]struct{}
+
+// Add adds the keys of the table into the set, nil keys mean the whole table.
+func (s RWSet) Add(tableID uint64, keys [][]byte) {
+	old, ok := s[tableID]
+	if ok && old == nil {
+		return
+	}
+	if keys == nil || len(old)+len(keys) > MaxRWSetKeys {
+		s[tableID] = nil
+		return
+	}
+	if old == nil {
+		old = make(map[string]struct{}, len(keys))
+		s[tableID] = old
+	}
+	for _, key := range keys {
+		old[string(key)] = struct{}{}
+	}
+}
+
+// Intersect returns true if the two sets have any key in common.
+func (s RWSet) Intersect(o RWSet) bool {
+	if len(s) > len(o) {
+		s, o = o, s
+	}
+	for tableID, keys := range s {
+		others, ok := o[tableID]
+		if !ok {
+			continue
+		}
+		if keys == nil || others == nil {
+			return true
+		}
+		if len(keys) > len(others) {
+			keys, others = others, keys
+		}
+		for key := range keys {
+			if _, ok := others[key]; ok {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -76,6 +76,8 @@ type TxnReader interface {
 	Repr() string
 	GetLSN() uint64
 	GetMemo() *TxnMemo
+	GetReadSet() RWSet
+	GetWriteSet() RWSet
 
 	SameTxn(txn TxnReader) bool
 	CommitBefore(startTs types.TS) bool
@@ -118,6 +120,8 @@ type TxnChanger interface {
 	SetDedupType(skip DedupPolicy)
 	SetParticipants(ids []uint64) error
 	SetError(error)
+	AddReadSet(tableID uint64, keys [][]byte)
+	AddWriteSet(tableID uint64, keys [][]byte)
 
 	CommittingInRecovery() error
 	CommitInRecovery(ctx context.Context) error
@@ -0,0 +1,723 @@
+// Copyright 2021 - 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"hash/crc64"
+	"io"
+	"math"
+	"path"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/google/uuid"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/blockio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/checkpoint"
+	gc "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/gc/v2"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logtail"
+	"github.com/spf13/cobra"
+)
+
+const quarantineDir = "quarantine"
+
+type fsckIssueJson struct {
+	Object string   `json:"object"`
+	Tables []uint64 `json:"tables,omitempty"`
+	Reason string   `json:"reason,omitempty"`
+}
+
+type fsckJson struct {
+	Checkpoint     string          `json:"checkpoint"`
+	EntryCount     int             `json:"checkpoint_entry_count"`
+	ObjectCount    int             `json:"object_count"`
+	Missing        []fsckIssueJson `json:"missing,omitempty"`
+	DroppedMissing []fsckIssueJson `json:"dropped_missing,omitempty"`
+	Corrupt        []fsckIssueJson `json:"corrupt,omitempty"`
+	Orphans        []string        `json:"orphans,omitempty"`
+	Quarantined    []string        `json:"quarantined,omitempty"`
+	Tables         []uint64        `json:"affected_tables,omitempty"`
+}
+
+// fsckObject is an object referenced by the checkpoints being verified.
+// stats is nil for the checkpoint files themselves.
+type fsckObject struct {
+	location objectio.Location
+	stats    *objectio.ObjectStats
+	tables   []uint64
+	// dropped is true if every reference has soft deleted the object,
+	// so a missing file may have been collected by GC.
+	dropped bool
+}
+
+type fsckChecker struct {
+	fs   fileservice.FileService
+	full bool
+
+	// objects are verified, referenced only protects files from being
+	// reported as orphans.
+	objects    map[string]*fsckObject
+	referenced map[string]struct{}
+
+	res fsckJson
+}
+
+func newFsckChecker(fs fileservice.FileService, full bool) *fsckChecker {
+	return &fsckChecker{
+		fs:         fs,
+		full:       full,
+		objects:    make(map[string]*fsckObject),
+		referenced: make(map[string]struct{}),
+	}
+}
+
+func (c *fsckChecker) addReference(name string) {
+	c.referenced[name] = struct{}{}
+}
+
+func (c *fsckChecker) addLocation(location objectio.Location) {
+	name := location.Name().String()
+	c.addReference(name)
+	if _, ok := c.objects[name]; !ok {
+		c.objects[name] = &fsckObject{location: location}
+	}
+}
+
+func (c *fsckChecker) addStats(stats *objectio.ObjectStats, tid uint64, dropped bool) {
+	name := stats.ObjectName().String()
+	c.addReference(name)
+	obj, ok := c.objects[name]
+	if !ok {
+		obj = &fsckObject{
+			location: stats.ObjectLocation(),
+			stats:    stats,
+			dropped:  dropped,
+		}
+		c.objects[name] = obj
+	} else {
+		obj.dropped = obj.dropped && dropped
+	}
+	for _, id := range obj.tables {
+		if id == tid {
+			return
+		}
+	}
+	obj.tables = append(obj.tables, tid)
+}
+
+// addObjectBatch adds the objects of a checkpoint object info batch. If
+// verify is false, the objects are only protected from orphan detection.
+func (c *fsckChecker) addObjectBatch(bat *containers.Batch, verify bool) {
+	if bat == nil || bat.Length() == 0 {
+		return
+	}
+	statsVec := bat.GetVectorByName(logtail.ObjectAttr_ObjectStats).GetDownstreamVector()
+	tids := vector.MustFixedColWithTypeCheck[uint64](
+		bat.GetVectorByName(logtail.SnapshotAttr_TID).GetDownstreamVector())
+	deletes := vector.MustFixedColWithTypeCheck[types.TS](
+		bat.GetVectorByName(catalog.EntryNode_DeleteAt).GetDownstreamVector())
+	for i := 0; i < bat.Length(); i++ {
+		stats := objectio.NewObjectStats()
+		stats.UnMarshal(statsVec.GetBytesAt(i))
+		if stats.Extent().End() == 0 {
+			// the object has not been flushed
+			continue
+		}
+		if !verify {
+			c.addReference(stats.ObjectName().String())
+			continue
+		}
+		c.addStats(stats, tids[i], !deletes[i].IsEmpty())
+	}
+}
+
+// addCheckpoint loads a checkpoint entry and adds everything it references.
+func (c *fsckChecker) addCheckpoint(
+	ctx context.Context,
+	sid string,
+	entry *checkpoint.CheckpointEntry,
+	verify bool,
+) error {
+	files, data, err := logtail.LoadCheckpointEntriesFromKey(
+		ctx, sid, c.fs, entry.GetLocation(), entry.GetVersion(), nil, &types.TS{})
+	if err != nil {
+		return err
+	}
+	defer data.Close()
+	for _, file := range files {
+		if !file.CrateTS.IsEmpty() {
+			// objects are added from the batches below with their tables
+			continue
+		}
+		if verify {
+			c.addLocation(file.Location)
+		} else {
+			c.addReference(file.Location.Name().String())
+		}
+	}
+	if tnLoc := entry.GetTNLocation(); !tnLoc.IsEmpty() {
+		c.addReference(tnLoc.Name().String())
+	}
+	c.addObjectBatch(data.GetObjectBatchs(), verify)
+	c.addObjectBatch(data.GetTombstoneObjectBatchs(), verify)
+	return nil
+}
+
+func (c *fsckChecker) checkObjects(ctx context.Context) {
+	names := make([]string, 0, len(c.objects))
+	for name := range c.objects {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	tables := make(map[uint64]struct{})
+	for _, name := range names {
+		obj := c.objects[name]
+		missing, reason := c.checkObject(ctx, name, obj)
+		if !missing && reason == "" {
+			continue
+		}
+		issue := fsckIssueJson{
+			Object: name,
+			Tables: obj.tables,
+			Reason: reason,
+		}
+		switch {
+		case missing && obj.dropped:
+			c.res.DroppedMissing = append(c.res.DroppedMissing, issue)
+			continue
+		case missing:
+			c.res.Missing = append(c.res.Missing, issue)
+		default:
+			c.res.Corrupt = append(c.res.Corrupt, issue)
+		}
+		for _, tid := range obj.tables {
+			tables[tid] = struct{}{}
+		}
+	}
+	c.res.ObjectCount = len(names)
+
+	c.res.Tables = make([]uint64, 0, len(tables))
+	for tid := range tables {
+		c.res.Tables = append(c.res.Tables, tid)
+	}
+	sort.Slice(c.res.Tables, func(i, j int) bool {
+		return c.res.Tables[i] < c.res.Tables[j]
+	})
+}
+
+// checkObject returns whether the object is missing, or the reason why it
+// is corrupt. An empty reason means the object is healthy.
+func (c *fsckChecker) checkObject(
+	ctx context.Context,
+	name string,
+	obj *fsckObject,
+) (missing bool, reason string) {
+	defer func() {
+		// a corrupt meta could make the decoding run out of range
+		if r := recover(); r != nil {
+			reason = fmt.Sprintf("bad meta: %v", r)
+		}
+	}()
+
+	ext := obj.location.Extent()
+	entry, err := c.fs.StatFile(ctx, name)
+	if err != nil {
+		if moerr.IsMoErrCode(err, moerr.ErrFileNotFound) {
+			return true, ""
+		}
+		return false, err.Error()
+	}
+	if expected := int64(ext.End() + objectio.FooterSize); entry.Size < expected {
+		return false, fmt.Sprintf("truncated: size %d, expected %d", entry.Size, expected)
+	}
+
+	reader, err := objectio.NewObjectReaderWithStr(
+		name,
+		c.fs,
+		objectio.WithMetaCachePolicyOption(fileservice.SkipAllCache),
+		objectio.WithDataCachePolicyOption(fileservice.SkipAllCache))
+	if err != nil {
+		return false, err.Error()
+	}
+	header, err := reader.ReadHeader(ctx, nil)
+	if err != nil {
+		return false, fmt.Sprintf("read header: %v", err)
+	}
+	if header.Magic() != objectio.Magic || header.Version() != objectio.Version {
+		return false, fmt.Sprintf("bad header: magic %x, version %d", header.Magic(), header.Version())
+	}
+	if hext := header.Extent(); hext.Offset() != ext.Offset() || hext.Length() != ext.Length() {
+		return false, fmt.Sprintf("meta extent mismatch: header %s, location %s", hext.String(), ext.String())
+	}
+
+	meta, err := objectio.ReadObjectMeta(ctx, name, &ext, fileservice.SkipAllCache, c.fs)
+	if err != nil {
+		return false, fmt.Sprintf("read meta: %v", err)
+	}
+	if h := objectio.DecodeIOEntryHeader(meta); !objectio.IsKnownIOEntry(*h) {
+		return false, fmt.Sprintf("unknown meta version: %s", h.String())
+	}
+	// checkpoint files keep their batches in the sub metas
+	var metas []objectio.ObjectDataMeta
+	if data, ok := meta.DataMeta(); ok {
+		if reason = checkDataMeta(data, obj.stats); reason != "" {
+			return
+		}
+		metas = append(metas, data)
+	}
+	for i := uint16(0); i < meta.SubMetaCount(); i++ {
+		data, _ := meta.SubMeta(i)
+		if reason = checkDataMeta(data, nil); reason != "" {
+			return false, fmt.Sprintf("sub meta %d: %s", i, reason)
+		}
+		metas = append(metas, data)
+	}
+	if len(metas) == 0 {
+		return false, "no data meta"
+	}
+
+	if !c.full {
+		return
+	}
+	for _, data := range metas {
+		start := uint32(data.BlockHeader().StartID())
+		for i := uint32(0); i < data.BlockCount(); i++ {
+			blk := data.GetBlockMeta(start + i)
+			for seq := uint16(0); seq < blk.GetMetaColumnCount(); seq++ {
+				loc := blk.ColumnMeta(seq).Location()
+				if loc.Length() == 0 {
+					continue
+				}
+				if err = objectio.VerifyExtent(ctx, name, &loc, c.fs); err != nil {
+					return false, fmt.Sprintf("block %d column %d: %v", i, seq, err)
+				}
+			}
+		}
+	}
+	return
+}
+
+// checkDataMeta checks the object meta against its stats and that every
+// block zonemap is covered by the object zonemap of the same column.
+func checkDataMeta(data objectio.ObjectDataMeta, stats *objectio.ObjectStats) string {
+	header := data.BlockHeader()
+	if stats != nil {
+		if stats.Rows() != header.Rows() {
+			return fmt.Sprintf("row count mismatch: stats %d, meta %d", stats.Rows(), header.Rows())
+		}
+		if stats.BlkCnt() != data.BlockCount() {
+			return fmt.Sprintf("block count mismatch: stats %d, meta %d", stats.BlkCnt(), data.BlockCount())
+		}
+		sortKey := header.SortKey()
+		statsZM := stats.SortKeyZoneMap()
+		if sortKey != math.MaxUint16 && statsZM.IsInited() {
+			objZM := data.MustGetColumn(sortKey).ZoneMap()
+			if !objZM.IsInited() || objZM.GetType() != statsZM.GetType() ||
+				objZM.CompareMin(statsZM) != 0 || objZM.CompareMax(statsZM) != 0 {
+				return fmt.Sprintf("sort key zonemap mismatch: stats %s, meta %s",
+					statsZM.String(), objZM.String())
+			}
+		}
+	}
+
+	start := uint32(header.StartID())
+	for seq := uint16(0); seq < header.MetaColumnCount(); seq++ {
+		objZM := data.MustGetColumn(seq).ZoneMap()
+		if !objZM.IsInited() {
+			continue
+		}
+		for i := uint32(0); i < data.BlockCount(); i++ {
+			blk := data.GetBlockMeta(start + i)
+			if seq >= blk.GetMetaColumnCount() {
+				continue
+			}
+			blkZM := blk.ColumnMeta(seq).ZoneMap()
+			if !blkZM.IsInited() || blkZM.GetType() != objZM.GetType() ||
+				objZM.MaxTruncated() || blkZM.MaxTruncated() {
+				continue
+			}
+			if objZM.CompareMin(blkZM) > 0 || objZM.CompareMax(blkZM) < 0 {
+				return fmt.Sprintf("block %d column %d zonemap %s out of object zonemap %s",
+					i, seq, blkZM.String(), objZM.String())
+			}
+		}
+	}
+	return ""
+}
+
+// addGCTable protects the objects which GC has not collected, such as the
+// ones kept for snapshots, and returns the checkpoint end consumed by GC.
+func (c *fsckChecker) addGCTable(ctx context.Context) (types.TS, error) {
+	table, end, err := gc.ReadGCTable(ctx, objectio.NewObjectFS(c.fs, ""))
+	if err != nil {
+		return types.TS{}, err
+	}
+	for _, name := range table.ObjectNames() {
+		c.addReference(name)
+	}
+	return end, nil
+}
+
+// findOrphans reports the object files that are not referenced by any
+// checkpoint or GC. Objects created at or after before are skipped, as they
+// may only be recorded in the WAL yet, and so are the objects whose names
+// do not embed the create time.
+func (c *fsckChecker) findOrphans(ctx context.Context, before time.Time) error {
+	entries, err := c.fs.List(ctx, "")
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if entry.IsDir {
+			continue
+		}
+		created, ok := objectCreateTime(entry.Name)
+		if !ok || !created.Before(before) {
+			continue
+		}
+		if _, ok = c.referenced[entry.Name]; ok {
+			continue
+		}
+		c.res.Orphans = append(c.res.Orphans, entry.Name)
+	}
+	sort.Strings(c.res.Orphans)
+	return nil
+}
+
+// objectCreateTime parses an object file name, it returns false if the name
+// is not an object name or the segment id does not embed a timestamp.
+func objectCreateTime(name string) (time.Time, bool) {
+	if len(name) != objectio.NameStringLen || name[36] != '_' {
+		return time.Time{}, false
+	}
+	if _, err := strconv.ParseUint(name[37:], 10, 16); err != nil {
+		return time.Time{}, false
+	}
+	id, err := uuid.Parse(name[:36])
+	if err != nil {
+		return time.Time{}, false
+	}
+	if id.Version() != 7 {
+		return time.Time{}, false
+	}
+	return time.Unix(id.Time().UnixTime()), true
+}
+
+// quarantine moves the orphans into the quarantine directory, so that they
+// could be restored if they turn out to be needed.
+func (c *fsckChecker) quarantine(ctx context.Context) error {
+	for _, name := range c.res.Orphans {
+		if err := moveFile(ctx, c.fs, name, path.Join(quarantineDir, name)); err != nil {
+			return moerr.NewInfoNoCtx(fmt.Sprintf("failed to quarantine %s, %v", name, err))
+		}
+		c.res.Quarantined = append(c.res.Quarantined, name)
+	}
+	return nil
+}
+
+// moveFile copies the file to dst, and deletes the source only after the
+// copy is read back with the same size and checksum.
+func moveFile(ctx context.Context, fs fileservice.FileService, src, dst string) error {
+	size, sum, err := copyFile(ctx, fs, src, dst)
+	if err != nil {
+		return err
+	}
+	dstSize, dstSum, err := checksumFile(ctx, fs, dst)
+	if err != nil {
+		return err
+	}
+	if dstSize != size || dstSum != sum {
+		return moerr.NewInternalErrorNoCtxf("copy of %s mismatch: size %d, expected %d", src, dstSize, size)
+	}
+	return fs.Delete(ctx, src)
+}
+
+// copyFile copies the file to dst and returns the size and the checksum of
+// what is copied.
+func copyFile(ctx context.Context, fs fileservice.FileService, src, dst string) (int64, uint64, error) {
+	var reader io.ReadCloser
+	ioVec := &fileservice.IOVector{
+		FilePath: src,
+		Entries: []fileservice.IOEntry{
+			{
+				ReadCloserForRead: &reader,
+				Offset:            0,
+				Size:              -1,
+			},
+		},
+		Policy: fileservice.SkipAllCache,
+	}
+	if err := fs.Read(ctx, ioVec); err != nil {
+		return 0, 0, err
+	}
+	defer reader.Close()
+	hash := crc64.New(crc64.MakeTable(crc64.ECMA))
+	counter := &countingWriter{}
+	dstVec := fileservice.IOVector{
+		FilePath: dst,
+		Entries: []fileservice.IOEntry{
+			{
+				ReaderForWrite: io.TeeReader(reader, io.MultiWriter(hash, counter)),
+				Offset:         0,
+				Size:           -1,
+			},
+		},
+	}
+	if err := fs.Write(ctx, dstVec); err != nil {
+		return 0, 0, err
+	}
+	return counter.n, hash.Sum64(), nil
+}
+
+// checksumFile reads the whole file and returns its size and checksum.
+func checksumFile(ctx context.Context, fs fileservice.FileService, name string) (int64, uint64, error) {
+	var reader io.ReadCloser
+	ioVec := &fileservice.IOVector{
+		FilePath: name,
+		Entries: []fileservice.IOEntry{
+			{
+				ReadCloserForRead: &reader,
+				Offset:            0,
+				Size:              -1,
+			},
+		},
+		Policy: fileservice.SkipAllCache,
+	}
+	if err := fs.Read(ctx, ioVec); err != nil {
+		return 0, 0, err
+	}
+	defer reader.Close()
+	hash := crc64.New(crc64.MakeTable(crc64.ECMA))
+	n, err := io.Copy(hash, reader)
+	if err != nil {
+		return 0, 0, err
+	}
+	return n, hash.Sum64(), nil
+}
+
+type countingWriter struct {
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.n += int64(len(p))
+	return len(p), nil
+}
+
+type MoFsckArg struct {
+	dir        string
+	config     string
+	local      bool
+	full       bool
+	quarantine bool
+	tables     bool
+	fs         fileservice.FileService
+	res        string
+}
+
+func (c *MoFsckArg) PrepareCommand() *cobra.Command {
+	fsckCmd := &cobra.Command{
+		Use:   "fsck",
+		Short: "Mo fsck",
+		Long:  "Mo fsck verifies the objects referenced by the latest checkpoints",
+		Run:   RunFactory(c),
+	}
+
+	fsckCmd.SetUsageTemplate(c.Usage())
+
+	fsckCmd.Flags().StringP("dir", "d", "", "data dir")
+	fsckCmd.Flags().StringP("config", "f", "", "fileservice config")
+	fsckCmd.Flags().BoolP("local", "", false, "local")
+	fsckCmd.Flags().BoolP("full", "", false, "verify all column extents")
+	fsckCmd.Flags().BoolP("quarantine", "q", false, "quarantine orphans")
+	fsckCmd.Flags().BoolP("tables", "t", false, "list affected tables")
+
+	return fsckCmd
+}
+
+func (c *MoFsckArg) FromCommand(cmd *cobra.Command) (err error) {
+	c.dir, _ = cmd.Flags().GetString("dir")
+	c.config, _ = cmd.Flags().GetString("config")
+	c.local, _ = cmd.Flags().GetBool("local")
+	c.full, _ = cmd.Flags().GetBool("full")
+	c.quarantine, _ = cmd.Flags().GetBool("quarantine")
+	c.tables, _ = cmd.Flags().GetBool("tables")
+	return nil
+}
+
+func (c *MoFsckArg) String() string {
+	return c.res
+}
+
+func (c *MoFsckArg) Usage() (res string) {
+	res += "Examples:\n"
+	res += "  # Verify the objects referenced by the latest checkpoints\n"
+	res += "  mo-tool fsck -d /your/path/mo-data/shared\n"
+	res += "\n"
+	res += "  # Verify every column extent and list the affected tables\n"
+	res += "  mo-tool fsck -d /your/path/mo-data/shared --full -t\n"
+	res += "\n"
+	res += "  # Verify the objects on a configured fileservice and quarantine orphans\n"
+	res += "  mo-tool fsck -f /your/path/fileservice.toml -q\n"
+
+	res += "\n"
+	res += "Options:\n"
+	res += "  -d, --dir='':\n"
+	res += "    The data dir of the shared fileservice\n"
+	res += "  -f, --config='':\n"
+	res += "    The toml file of a fileservice config, such as the [[fileservice]] section of tn.toml\n"
+	res += "  --local=false:\n"
+	res += "    If the dir is a local fileservice, you should use this flag\n"
+	res += "  --full=false:\n"
+	res += "    Read and decode every column extent, which verifies the checksums and the encodings\n"
+	res += "  -q, --quarantine=false:\n"
+	res += "    Move the orphans into the quarantine dir instead of only reporting them\n"
+	res += "    Orphans are the objects not referenced by the checkpoints or GC, and created before\n"
+	res += "    the checkpoints consumed by GC. No orphan is reported before GC runs\n"
+	res += "  -t, --tables=false:\n"
+	res += "    List the tables affected by missing or corrupt objects\n"
+
+	return
+}
+
+func (c *MoFsckArg) Run() (err error) {
+	ctx := context.Background()
+	if c.fs == nil {
+		if err = c.initFs(ctx); err != nil {
+			return moerr.NewInfoNoCtx(fmt.Sprintf("failed to init fileservice %v", err))
+		}
+	}
+	blockio.Start("")
+
+	res, err := c.check(ctx, "")
+	if err != nil {
+		return
+	}
+	if !c.tables {
+		res.Tables = nil
+	}
+
+	var json = jsoniter.ConfigCompatibleWithStandardLibrary
+	data, err := json.MarshalIndent(res, "", "  ")
+	if err != nil {
+		return
+	}
+	c.res = string(data)
+	return
+}
+
+func (c *MoFsckArg) initFs(ctx context.Context) (err error) {
+	if c.config != "" {
+		var cfg fileservice.Config
+		if _, err = toml.DecodeFile(c.config, &cfg); err != nil {
+			return
+		}
+		if cfg.Name == "" {
+			cfg.Name = defines.SharedFileServiceName
+		}
+		cfg.Cache = fileservice.DisabledCacheConfig
+		c.fs, err = fileservice.NewFileService(ctx, cfg, nil)
+		return
+	}
+
+	if c.dir == "" {
+		return moerr.NewInfoNoCtx("empty dir")
+	}
+	if c.local {
+		cfg := fileservice.Config{
+			Name:    defines.LocalFileServiceName,
+			Backend: "DISK",
+			DataDir: c.dir,
+			Cache:   fileservice.DisabledCacheConfig,
+		}
+		c.fs, err = fileservice.NewFileService(ctx, cfg, nil)
+		return
+	}
+
+	arg := fileservice.ObjectStorageArguments{
+		Name:     defines.SharedFileServiceName,
+		Endpoint: "DISK",
+		Bucket:   c.dir,
+	}
+	c.fs, err = fileservice.NewS3FS(ctx, arg, fileservice.DisabledCacheConfig, nil, false, true)
+	return
+}
+
+func (c *MoFsckArg) check(ctx context.Context, sid string) (res fsckJson, err error) {
+	files, idx, err := checkpoint.ListSnapshotMeta(ctx, c.fs, types.MaxTs(), nil)
+	if err != nil {
+		return res, moerr.NewInfoNoCtx(fmt.Sprintf("failed to list checkpoints %v", err))
+	}
+	if len(files) == 0 {
+		return res, moerr.NewInfoNoCtx("no checkpoint found")
+	}
+	entries, err := checkpoint.ListSnapshotCheckpointWithMeta(ctx, sid, c.fs, files, idx, types.TS{}, true)
+	if err != nil || len(entries) == 0 {
+		return res, moerr.NewInfoNoCtx(fmt.Sprintf("failed to read checkpoint meta %s", files[idx].GetName()))
+	}
+
+	// the objects of the entries since the latest global checkpoint are
+	// what a replay needs, the earlier ones only keep their files alive.
+	start := 0
+	for i := len(entries) - 1; i >= 0; i-- {
+		if entries[i].GetType() == checkpoint.ET_Global {
+			start = i
+			break
+		}
+	}
+
+	checker := newFsckChecker(c.fs, c.full)
+	for i, entry := range entries {
+		if err = checker.addCheckpoint(ctx, sid, entry, i >= start); err != nil {
+			return res, moerr.NewInfoNoCtx(fmt.Sprintf("failed to load checkpoint %s, %v", entry.String(), err))
+		}
+	}
+	checker.res.Checkpoint = files[idx].GetName()
+	checker.res.EntryCount = len(entries) - start
+
+	checker.checkObjects(ctx)
+
+	// the objects created after the checkpoints consumed by GC may be only
+	// referenced by the WAL, or not been seen by GC, so they are not orphans.
+	watermark, err := checker.addGCTable(ctx)
+	if err != nil {
+		return res, moerr.NewInfoNoCtx(fmt.Sprintf("failed to read GC meta %v", err))
+	}
+	if end := entries[len(entries)-1].GetEnd(); end.Less(&watermark) {
+		watermark = end
+	}
+	if err = checker.findOrphans(ctx, time.Unix(0, watermark.Physical())); err != nil {
+		return res, moerr.NewInfoNoCtx(fmt.Sprintf("failed to list objects %v", err))
+	}
+	if c.quarantine {
+		if err = checker.quarantine(ctx); err != nil {
+			return
+		}
+	}
+	return checker.res, nil
+}
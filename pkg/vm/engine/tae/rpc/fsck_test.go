@@ -0,0 +1,198 @@
+// Copyright 2021 - 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/blockio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFsckChecker(t *testing.T) {
+	ctx := context.Background()
+	fs, err := fileservice.NewMemoryFS(defines.SharedFileServiceName, fileservice.DisabledCacheConfig, nil)
+	require.NoError(t, err)
+
+	schema := catalog.MockSchema(3, 1)
+	bat := catalog.MockBatch(schema, 40)
+	defer bat.Close()
+	writeObject := func() *objectio.ObjectStats {
+		name := objectio.BuildObjectNameWithObjectID(objectio.NewObjectid())
+		writer, err := blockio.NewBlockWriterNew(fs, name, 0, nil)
+		require.NoError(t, err)
+		writer.SetPrimaryKey(1)
+		for _, b := range bat.Split(2) {
+			_, err = writer.WriteBatch(containers.ToCNBatch(b))
+			require.NoError(t, err)
+		}
+		_, _, err = writer.Sync(ctx)
+		require.NoError(t, err)
+		stats := writer.GetObjectStats()
+		return &stats
+	}
+
+	healthy := writeObject()
+	corrupt := writeObject()
+	dropped := writeObject()
+	orphan := writeObject()
+	missing := writeObject()
+	for _, stats := range []*objectio.ObjectStats{dropped, missing} {
+		require.NoError(t, fs.Delete(ctx, stats.ObjectName().String()))
+	}
+
+	// overwrite the header of the corrupt object
+	name := corrupt.ObjectName().String()
+	require.NoError(t, fs.Delete(ctx, name))
+	require.NoError(t, fs.Write(ctx, fileservice.IOVector{
+		FilePath: name,
+		Entries: []fileservice.IOEntry{
+			{Size: int64(corrupt.Size()), Data: make([]byte, corrupt.Size())},
+		},
+	}))
+
+	checker := newFsckChecker(fs, true)
+	checker.addStats(healthy, 1, false)
+	checker.addStats(corrupt, 2, false)
+	checker.addStats(dropped, 3, true)
+	checker.addStats(missing, 4, false)
+	checker.addStats(missing, 5, false)
+	checker.checkObjects(ctx)
+
+	res := checker.res
+	require.Equal(t, 4, res.ObjectCount)
+	require.Equal(t, 1, len(res.Corrupt))
+	require.Equal(t, name, res.Corrupt[0].Object)
+	require.Equal(t, 1, len(res.Missing))
+	require.Equal(t, missing.ObjectName().String(), res.Missing[0].Object)
+	require.Equal(t, []uint64{4, 5}, res.Missing[0].Tables)
+	require.Equal(t, 1, len(res.DroppedMissing))
+	require.Equal(t, []uint64{2, 4, 5}, res.Tables)
+
+	// the object kept by GC and the one without the create time in its name
+	// are not orphans
+	kept := writeObject()
+	checker.addReference(kept.ObjectName().String())
+	noTime := uuid.New().String() + "_00000"
+	require.NoError(t, fs.Write(ctx, fileservice.IOVector{
+		FilePath: noTime,
+		Entries:  []fileservice.IOEntry{{Size: 1, Data: []byte{1}}},
+	}))
+	_, ok := objectCreateTime(noTime)
+	require.False(t, ok)
+
+	require.NoError(t, checker.findOrphans(ctx, time.Now().Add(-time.Hour)))
+	require.Empty(t, checker.res.Orphans)
+	require.NoError(t, checker.findOrphans(ctx, time.Now().Add(time.Hour)))
+	require.Equal(t, []string{orphan.ObjectName().String()}, checker.res.Orphans)
+
+	require.NoError(t, checker.quarantine(ctx))
+	require.Equal(t, checker.res.Orphans, checker.res.Quarantined)
+	_, err = fs.StatFile(ctx, orphan.ObjectName().String())
+	require.Error(t, err)
+	_, err = fs.StatFile(ctx, quarantineDir+"/"+orphan.ObjectName().String())
+	require.NoError(t, err)
+}
+
+func TestCheckDataMeta(t *testing.T) {
+	ctx := context.Background()
+	fs, err := fileservice.NewMemoryFS(defines.SharedFileServiceName, fileservice.DisabledCacheConfig, nil)
+	require.NoError(t, err)
+
+	schema := catalog.MockSchema(2, 1)
+	bat := catalog.MockBatch(schema, 20)
+	defer bat.Close()
+	name := objectio.BuildObjectNameWithObjectID(objectio.NewObjectid())
+	writer, err := blockio.NewBlockWriterNew(fs, name, 0, nil)
+	require.NoError(t, err)
+	writer.SetPrimaryKey(1)
+	_, err = writer.WriteBatch(containers.ToCNBatch(bat))
+	require.NoError(t, err)
+	_, _, err = writer.Sync(ctx)
+	require.NoError(t, err)
+	stats := writer.GetObjectStats()
+
+	ext := stats.Extent()
+	meta, err := objectio.ReadObjectMeta(ctx, name.String(), &ext, fileservice.SkipAllCache, fs)
+	require.NoError(t, err)
+	data := meta.MustDataMeta()
+	require.Equal(t, "", checkDataMeta(data, &stats))
+
+	require.NoError(t, objectio.SetObjectStatsRowCnt(&stats, stats.Rows()+1))
+	require.Contains(t, checkDataMeta(data, &stats), "row count mismatch")
+
+	// a block zonemap out of the object zonemap
+	zm := data.MustGetColumn(1).ZoneMap().Clone()
+	require.NoError(t, zm.Update(int32(-1)))
+	data.GetBlockMeta(0).ColumnMeta(1).SetZoneMap(zm)
+	require.Contains(t, checkDataMeta(data, nil), "out of object zonemap")
+}
+
+func TestMoFsckCheck(t *testing.T) {
+	ctx := context.Background()
+	opts := config.WithLongScanAndCKPOpts(nil)
+	tae := testutil.NewTestEngine(ctx, ModuleName, t, opts)
+	defer tae.Close()
+
+	schema := catalog.MockSchemaAll(3, 2)
+	schema.BlockMaxRows = 10
+	tae.BindSchema(schema)
+	bat := catalog.MockBatch(schema, 40)
+	defer bat.Close()
+	tae.CreateRelAndAppend(bat, true)
+	tae.CompactBlocks(false)
+	tae.ForceCheckpoint()
+
+	fs := tae.Opts.Fs
+	fsck := MoFsckArg{fs: fs, full: true}
+	res, err := fsck.check(ctx, "")
+	require.NoError(t, err)
+	require.Equal(t, 1, res.EntryCount)
+	require.NotZero(t, res.ObjectCount)
+	require.Empty(t, res.Missing)
+	require.Empty(t, res.Corrupt)
+	require.Empty(t, res.Orphans)
+
+	txn, rel := tae.GetRelation()
+	var name string
+	it := rel.MakeObjectIt(false)
+	for it.Next() {
+		entry := it.GetObject().GetMeta().(*catalog.ObjectEntry)
+		if !entry.IsAppendable() && !entry.HasDropCommitted() {
+			name = entry.ObjectName().String()
+			break
+		}
+	}
+	it.Close()
+	require.NotEmpty(t, name)
+	require.NoError(t, txn.Commit(ctx))
+	require.NoError(t, fs.Delete(ctx, name))
+
+	res, err = fsck.check(ctx, "")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Missing))
+	require.Equal(t, name, res.Missing[0].Object)
+	require.Equal(t, []uint64{rel.ID()}, res.Tables)
+}
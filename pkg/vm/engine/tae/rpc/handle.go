@@ -251,6 +251,8 @@ func (h *Handle) handleRequests(
 			err = h.HandleAlterTable(ctx, txn, req)
 		case *db.WriteReq:
 			err = h.HandleWrite(ctx, txn, req)
+		case *db.ReadReq:
+			txn.AddReadSet(req.TableID, req.Keys)
 		default:
 			err = moerr.NewNotSupportedf(ctx, "unknown txn request type: %T", req)
 		}
@@ -291,6 +293,16 @@ func (h *Handle) HandlePreCommitWrite(
 		case *api.Entry:
 			//Handle DML
 			pe := e.(*api.Entry)
+			if pe.EntryType == api.Entry_Read {
+				req, err := toReadReq(pe)
+				if err != nil {
+					return err
+				}
+				if err = h.CacheTxnRequest(ctx, meta, req); err != nil {
+					return err
+				}
+				continue
+			}
 			moBat, err := batch.ProtoBatchToBatch(pe.GetBat())
 			if err != nil {
 				panic(err)
@@ -744,6 +756,7 @@ func (h *Handle) HandleWrite(
 				err = moerr.NewInternalError(ctx, "object stats doesn't match meta locations")
 				return
 			}
+			txn.AddWriteSet(req.TableID, nil)
 			err = tb.AddObjsWithMetaLoc(ctx, statsVec)
 			return
 		}
@@ -790,6 +803,11 @@ func (h *Handle) HandleWrite(
 			}
 
 		}
+		if schema := tb.Schema(false).(*catalog.Schema); schema.HasPK() {
+			txn.AddWriteSet(req.TableID, rwSetKeys(req.Batch.Vecs[schema.GetSingleSortKey().Idx]))
+		} else {
+			txn.AddWriteSet(req.TableID, nil)
+		}
 		//Appends a batch of data into table.
 		err = AppendDataToTable(ctx, tb, req.Batch)
 		return
@@ -802,6 +820,7 @@ func (h *Handle) HandleWrite(
 		if deadline, ok := ctx.Deadline(); ok {
 			_, req.Cancel = context.WithTimeout(nctx, time.Until(deadline))
 		}
+		txn.AddWriteSet(req.TableID, nil)
 		rowidIdx := 0
 		pkIdx := 1
 
@@ -855,6 +874,7 @@ func (h *Handle) HandleWrite(
 	if len(req.Batch.Vecs) != 2 {
 		panic(fmt.Sprintf("req.Batch.Vecs length is %d, should be 2", len(req.Batch.Vecs)))
 	}
+	txn.AddWriteSet(req.TableID, rwSetKeys(req.Batch.Vecs[1]))
 	rowIDVec := containers.ToTNVector(req.Batch.GetVector(0), common.WorkspaceAllocator)
 	pkVec := containers.ToTNVector(req.Batch.GetVector(1), common.WorkspaceAllocator)
 	//defer pkVec.Close()
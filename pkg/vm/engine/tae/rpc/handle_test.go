@@ -19,6 +19,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/compress"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
 	apipb "github.com/matrixorigin/matrixone/pkg/pb/api"
 	"github.com/matrixorigin/matrixone/pkg/pb/txn"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
@@ -83,4 +85,51 @@ func TestHandleInspectPolicy(t *testing.T) {
 	}, resp)
 	require.NoError(t, err)
 	require.Equal(t, "(1000-test1) maxMergeObjN: 0, maxOsizeObj: 128MB, minOsizeQualified: 0MB, offloadToCnSize: 80000MB, hints: [Auto]", resp.Message)
+
+	_, err = handle.HandleInspectTN(context.Background(), txn.TxnMeta{}, &db.InspectTN{
+		AccessInfo: db.AccessInfo{},
+		Operation:  "policy -t db1.test1 -z ZSTD -l 9 -e",
+	}, resp)
+	require.NoError(t, err)
+	require.Equal(t, "(1000-test1) maxMergeObjN: 16, maxOsizeObj: 128MB, minOsizeQualified: 110MB, offloadToCnSize: 80000MB, hints: [Auto], compress: zstd, level: 9, encoding: true", resp.Message)
+
+	asyncTxn, err = handle.db.StartTxn(nil)
+	require.NoError(t, err)
+	database, err = asyncTxn.GetDatabase("db1")
+	require.NoError(t, err)
+	rel, err := database.GetRelationByName("test1")
+	require.NoError(t, err)
+	require.Equal(t, objectio.CompressOption{Alg: compress.Zstd, Level: 9, Encoding: true},
+		rel.Schema(false).(*catalog.Schema).CompressOption())
+	require.NoError(t, asyncTxn.Commit(context.Background()))
+
+	// the policy updates without the compression flags keep the compression
+	_, err = handle.HandleInspectTN(context.Background(), txn.TxnMeta{}, &db.InspectTN{
+		AccessInfo: db.AccessInfo{},
+		Operation:  "policy -t db1.test1 -r 0 -m 0",
+	}, resp)
+	require.NoError(t, err)
+	_, err = handle.HandleInspectTN(context.Background(), txn.TxnMeta{}, &db.InspectTN{
+		AccessInfo: db.AccessInfo{},
+		Operation:  "policy -t db1.test1 -l 1",
+	}, resp)
+	require.NoError(t, err)
+	require.Equal(t, "(1000-test1) maxMergeObjN: 16, maxOsizeObj: 128MB, minOsizeQualified: 110MB, offloadToCnSize: 80000MB, hints: [Auto], level: 1", resp.Message)
+
+	asyncTxn, err = handle.db.StartTxn(nil)
+	require.NoError(t, err)
+	database, err = asyncTxn.GetDatabase("db1")
+	require.NoError(t, err)
+	rel, err = database.GetRelationByName("test1")
+	require.NoError(t, err)
+	require.Equal(t, objectio.CompressOption{Alg: compress.Zstd, Level: 1, Encoding: true},
+		rel.Schema(false).(*catalog.Schema).CompressOption())
+	require.NoError(t, asyncTxn.Commit(context.Background()))
+
+	_, err = handle.HandleInspectTN(context.Background(), txn.TxnMeta{}, &db.InspectTN{
+		AccessInfo: db.AccessInfo{},
+		Operation:  "policy -t db1.test1 -z snappy",
+	}, resp)
+	require.NoError(t, err)
+	require.Contains(t, resp.Message, "snappy")
 }
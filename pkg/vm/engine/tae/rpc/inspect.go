@@ -31,6 +31,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/objectio"
@@ -756,6 +757,11 @@ type mergePolicyArg struct {
 	maxOsizeObject    int32
 	cnMinMergeSize    int32
 	hints             []api.MergeHint
+	compressAlg       string
+	compressLevel     int32
+	columnEncoding    bool
+	setCompressLevel  bool
+	setColumnEncoding bool
 
 	disableDeltaLocMerge bool
 }
@@ -773,6 +779,9 @@ func (c *mergePolicyArg) PrepareCommand() *cobra.Command {
 	policyCmd.Flags().Int32P("minCNMergeSize", "c", common.DefaultMinCNMergeSize, "Merge task whose memory occupation exceeds minCNMergeSize(MB) will be moved to CN")
 	policyCmd.Flags().Int32SliceP("mergeHints", "n", []int32{0}, "hints to merge the table")
 	policyCmd.Flags().BoolP("disableDeltaLocMerge", "d", merge.DisableDeltaLocMerge.Load(), "enable merging based on delta location")
+	policyCmd.Flags().StringP("compress", "z", "", "compression algorithm of the new objects, lz4, zstd or none, unchanged if not set")
+	policyCmd.Flags().Int32P("compressLevel", "l", 0, "level of the compression algorithm, 0 means the default level, unchanged if not set")
+	policyCmd.Flags().BoolP("columnEncoding", "e", false, "encode the column data by the lightweight encodings before the compression, unchanged if not set")
 	return policyCmd
 }
 
@@ -799,6 +808,15 @@ func (c *mergePolicyArg) FromCommand(cmd *cobra.Command) (err error) {
 		}
 		c.hints = append(c.hints, api.MergeHint(h))
 	}
+	c.compressAlg, _ = cmd.Flags().GetString("compress")
+	c.compressAlg = strings.ToLower(c.compressAlg)
+	if _, ok := compress.Algorithms[c.compressAlg]; c.compressAlg != "" && !ok {
+		return moerr.NewInvalidArgNoCtx("compress", c.compressAlg)
+	}
+	c.compressLevel, _ = cmd.Flags().GetInt32("compressLevel")
+	c.setCompressLevel = cmd.Flags().Changed("compressLevel")
+	c.columnEncoding, _ = cmd.Flags().GetBool("columnEncoding")
+	c.setColumnEncoding = cmd.Flags().Changed("columnEncoding")
 	return nil
 }
 
@@ -807,10 +825,20 @@ func (c *mergePolicyArg) String() string {
 	if c.tbl != nil {
 		t = fmt.Sprintf("%d-%s", c.tbl.ID, c.tbl.GetLastestSchemaLocked(false).Name)
 	}
-	return fmt.Sprintf(
+	s := fmt.Sprintf(
 		"(%s) maxMergeObjN: %v, maxOsizeObj: %vMB, minOsizeQualified: %vMB, offloadToCnSize: %vMB, hints: %v",
 		t, c.maxMergeObjN, c.maxOsizeObject, c.minOsizeQualified, c.cnMinMergeSize, c.hints,
 	)
+	if c.compressAlg != "" {
+		s += fmt.Sprintf(", compress: %v", c.compressAlg)
+	}
+	if c.setCompressLevel {
+		s += fmt.Sprintf(", level: %v", c.compressLevel)
+	}
+	if c.setColumnEncoding {
+		s += fmt.Sprintf(", encoding: %v", c.columnEncoding)
+	}
+	return s
 }
 
 func (c *mergePolicyArg) Run() error {
@@ -841,6 +869,11 @@ func (c *mergePolicyArg) Run() error {
 			MaxOsizeMergedObj: maxosize,
 			MinCNMergeSize:    cnsize,
 			MergeHints:        c.hints,
+			CompressAlg:       c.compressAlg,
+			CompressLevel:     c.compressLevel,
+			ColumnEncoding:    c.columnEncoding,
+			SetCompressLevel:  c.setCompressLevel,
+			SetColumnEncoding: c.setColumnEncoding,
 		})
 	}
 	c.ctx.resp.Payload = []byte("<empty>")
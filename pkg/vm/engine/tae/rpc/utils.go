@@ -18,13 +18,17 @@ import (
 	"context"
 
 	"github.com/matrixorigin/matrixone/pkg/common/util"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/pb/api"
 	"github.com/matrixorigin/matrixone/pkg/pb/txn"
 	v2 "github.com/matrixorigin/matrixone/pkg/util/metric/v2"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/blockio"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logtail"
 	"go.uber.org/zap"
 	"golang.org/x/exp/slices"
@@ -196,3 +200,34 @@ func traverseCatalogForNewAccounts(c *catalog.Catalog, memo *logtail.TNUsageMemo
 
 	c.RecurLoop(processor)
 }
+
+// toReadReq converts the read entry of a serializable txn, the batch holds the
+// primary keys read or is nil if the whole table is read.
+func toReadReq(pe *api.Entry) (*db.ReadReq, error) {
+	req := &db.ReadReq{
+		DatabaseId: pe.GetDatabaseId(),
+		TableID:    pe.GetTableId(),
+	}
+	if pe.GetBat() == nil {
+		return req, nil
+	}
+	bat, err := batch.ProtoBatchToBatch(pe.GetBat())
+	if err != nil {
+		return nil, err
+	}
+	req.Keys = rwSetKeys(bat.Vecs[0])
+	return req, nil
+}
+
+// rwSetKeys returns the keys of vec added into the read or write set of a
+// txn, nil if there are too many keys and the whole table is used.
+func rwSetKeys(vec *vector.Vector) [][]byte {
+	if vec.Length() > txnif.MaxRWSetKeys {
+		return nil
+	}
+	keys := make([][]byte, vec.Length())
+	for i := range keys {
+		keys[i] = vec.GetRawBytesAt(i)
+	}
+	return keys
+}
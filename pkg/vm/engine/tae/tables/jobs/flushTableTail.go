@@ -677,6 +677,9 @@ func (task *flushTableTailTask) mergeAObjs(ctx context.Context, isTombstone bool
 	if err != nil {
 		return err
 	}
+	if !isTombstone {
+		writer.SetCompressOption(schema.CompressOption())
+	}
 
 	if schema.HasPK() {
 		pkIdx := schema.GetSingleSortKeyIdx()
@@ -106,6 +106,9 @@ func (task *flushObjTask) Execute(ctx context.Context) (err error) {
 	if task.isAObj {
 		writer.SetAppendable()
 	}
+	if !task.meta.IsTombstone {
+		writer.SetCompressOption(task.meta.GetTable().GetLastestSchema(false).CompressOption())
+	}
 
 	if task.meta.IsTombstone {
 		writer.SetDataType(objectio.SchemaTombstone)
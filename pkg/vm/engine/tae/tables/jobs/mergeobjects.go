@@ -321,7 +321,11 @@ func (task *mergeObjectsTask) PrepareNewWriter() *blockio.BlockWriter {
 		sortkeyPos = schema.GetSingleSortKeyIdx()
 	}
 
-	return mergesort.GetNewWriter(task.rt.Fs.Service, schema.Version, seqnums, sortkeyPos, sortkeyIsPK, task.isTombstone)
+	writer := mergesort.GetNewWriter(task.rt.Fs.Service, schema.Version, seqnums, sortkeyPos, sortkeyIsPK, task.isTombstone)
+	if !task.isTombstone {
+		writer.SetCompressOption(schema.CompressOption())
+	}
+	return writer
 }
 
 func (task *mergeObjectsTask) DoTransfer() bool {
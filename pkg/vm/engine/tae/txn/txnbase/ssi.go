@@ -0,0 +1,135 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txnbase
+
+import (
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"go.uber.org/zap"
+)
+
+const (
+	// rwTrackerRetention is how long the write sets are kept, the serializable
+	// txns which are longer than it are aborted.
+	rwTrackerRetention = 10 * time.Minute
+	// rwTrackerMaxRecords is the max number of the write sets kept.
+	rwTrackerMaxRecords = 100000
+)
+
+type writeRecord struct {
+	ts       types.TS
+	writeSet txnif.RWSet
+}
+
+// rwTracker keeps the write sets of the recently committed txns to find the
+// rw-antidependencies of the serializable txns.
+//
+// A serializable txn T is aborted at commit if a txn committed after the
+// snapshot of T has written anything read by T, i.e. T has an outgoing
+// rw-antidependency to a txn committed before it. The dangerous structure of
+// SSI always contains such a pivot, so the committed txns are serializable in
+// the order of their commit timestamps. Read-only txns are never aborted, they
+// read a snapshot which is a prefix of the serial order.
+//
+// The write sets are only kept while there are serializable txns, which is
+// within rwTrackerRetention since the last one. The txns whose snapshot is
+// before the truncated write sets are aborted conservatively.
+//
+// rwTracker is only accessed in TxnManager.dequeuePreparing, which runs in a
+// single goroutine in the order of the prepare timestamps.
+type rwTracker struct {
+	records   []writeRecord
+	truncated types.TS
+	// lastSerializable is the time of the last serializable txn checked.
+	lastSerializable time.Time
+}
+
+func (t *rwTracker) enabled(now time.Time) bool {
+	return now.Sub(t.lastSerializable) < rwTrackerRetention
+}
+
+// check returns a serialization failure if the txn reads anything written by
+// the txns committed after its snapshot.
+func (t *rwTracker) check(txn txnif.AsyncTxn) error {
+	readSet := txn.GetReadSet()
+	if len(readSet) == 0 {
+		return nil
+	}
+	t.lastSerializable = time.Now()
+
+	snapshot := txn.GetSnapshotTS()
+	if snapshot.Less(&t.truncated) {
+		logutil.Info(
+			"TAE-SSI-ABORT",
+			zap.String("txn", txn.String()),
+			zap.String("truncated", t.truncated.ToString()),
+		)
+		return moerr.NewTxnSerializationFailureNoCtx()
+	}
+	for i := len(t.records) - 1; i >= 0; i-- {
+		r := t.records[i]
+		if r.ts.LessEq(&snapshot) {
+			break
+		}
+		if readSet.Intersect(r.writeSet) {
+			logutil.Info(
+				"TAE-SSI-ABORT",
+				zap.String("txn", txn.String()),
+				zap.String("conflict-ts", r.ts.ToString()),
+			)
+			return moerr.NewTxnSerializationFailureNoCtx()
+		}
+	}
+	return nil
+}
+
+// record keeps the write set of the txn prepared at ts.
+func (t *rwTracker) record(txn txnif.AsyncTxn, ts types.TS) {
+	writeSet := txn.GetWriteSet()
+	if len(writeSet) == 0 {
+		return
+	}
+	now := time.Now()
+	if !t.enabled(now) {
+		t.truncate(len(t.records))
+		t.truncated = ts
+		return
+	}
+	t.records = append(t.records, writeRecord{ts: ts, writeSet: writeSet})
+
+	n := 0
+	expired := now.Add(-rwTrackerRetention).UnixNano()
+	for n < len(t.records) &&
+		(len(t.records)-n > rwTrackerMaxRecords || t.records[n].ts.Physical() < expired) {
+		n++
+	}
+	t.truncate(n)
+}
+
+// truncate removes the first n records.
+func (t *rwTracker) truncate(n int) {
+	if n == 0 {
+		return
+	}
+	t.truncated = t.records[n-1].ts
+	for i := 0; i < n; i++ {
+		t.records[i] = writeRecord{}
+	}
+	t.records = t.records[n:]
+}
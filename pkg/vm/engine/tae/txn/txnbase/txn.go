@@ -84,6 +84,10 @@ type Txn struct {
 	TenantID, UserID, RoleID atomic.Uint32
 	isReplay                 bool
 	DedupType                txnif.DedupPolicy
+	// ReadSet and WriteSet are used to check the rw-antidependencies of the
+	// serializable txns, see rwTracker.
+	ReadSet  txnif.RWSet
+	WriteSet txnif.RWSet
 
 	PrepareCommitFn   func(txnif.AsyncTxn) error
 	PrepareRollbackFn func(txnif.AsyncTxn) error
@@ -140,6 +144,23 @@ func (txn *Txn) MockIncWriteCnt() int        { return txn.Store.IncreateWriteCnt
 func (txn *Txn) SetError(err error) { txn.Err = err }
 func (txn *Txn) GetError() error    { return txn.Err }
 
+func (txn *Txn) GetReadSet() txnif.RWSet  { return txn.ReadSet }
+func (txn *Txn) GetWriteSet() txnif.RWSet { return txn.WriteSet }
+
+func (txn *Txn) AddReadSet(tableID uint64, keys [][]byte) {
+	if txn.ReadSet == nil {
+		txn.ReadSet = make(txnif.RWSet)
+	}
+	txn.ReadSet.Add(tableID, keys)
+}
+
+func (txn *Txn) AddWriteSet(tableID uint64, keys [][]byte) {
+	if txn.WriteSet == nil {
+		txn.WriteSet = make(txnif.RWSet)
+	}
+	txn.WriteSet.Add(tableID, keys)
+}
+
 func (txn *Txn) SetPrepareCommitFn(fn func(txnif.AsyncTxn) error)   { txn.PrepareCommitFn = fn }
 func (txn *Txn) SetPrepareRollbackFn(fn func(txnif.AsyncTxn) error) { txn.PrepareRollbackFn = fn }
 func (txn *Txn) SetApplyCommitFn(fn func(txnif.AsyncTxn) error)     { txn.ApplyCommitFn = fn }
@@ -96,6 +96,7 @@ type TxnManager struct {
 	cancel          context.CancelFunc
 	wg              sync.WaitGroup
 	workers         *ants.Pool
+	rwTracker       rwTracker
 
 	ts struct {
 		mu        sync.Mutex
@@ -297,6 +298,22 @@ func (mgr *TxnManager) onPrePrepare(op *OpTxn) {
 	})
 }
 
+func (mgr *TxnManager) onCheckSerializable(op *OpTxn) {
+	if !op.IsTryCommitting() || op.IsReplay() || op.Txn.GetError() != nil {
+		return
+	}
+	if err := mgr.rwTracker.check(op.Txn); err != nil {
+		op.Txn.SetError(err)
+	}
+}
+
+func (mgr *TxnManager) onRecordWriteSet(op *OpTxn) {
+	if !op.IsTryCommitting() || op.IsReplay() || op.Txn.GetError() != nil {
+		return
+	}
+	mgr.rwTracker.record(op.Txn, op.Txn.GetPrepareTS())
+}
+
 func (mgr *TxnManager) onPreparCommit(txn txnif.AsyncTxn) {
 	txn.SetError(txn.PrepareCommit())
 }
@@ -462,6 +479,9 @@ func (mgr *TxnManager) dequeuePreparing(items ...any) {
 		//   		   2. push the AppendNode into the MVCCHandle of block
 		mgr.onPrePrepare(op)
 
+		// check the rw-antidependencies of the serializable txn
+		mgr.onCheckSerializable(op)
+
 		//Before this moment, all mvcc nodes of a txn has been pushed into the MVCCHandle.
 		//1. Allocate a timestamp , set it to txn's prepare timestamp and commit timestamp,
 		//   which would be changed in the future if txn is 2PC.
@@ -473,6 +493,7 @@ func (mgr *TxnManager) dequeuePreparing(items ...any) {
 		} else {
 			mgr.onPrepare1PC(op, ts)
 		}
+		mgr.onRecordWriteSet(op)
 		if !op.Txn.IsReplay() {
 			if !mgr.prevPrepareTSInPreparing.IsEmpty() {
 				prepareTS := op.Txn.GetPrepareTS()
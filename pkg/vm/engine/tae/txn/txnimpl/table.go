@@ -878,6 +878,8 @@ func (tbl *txnTable) AlterTable(ctx context.Context, req *apipb.AlterTableReq) e
 		apipb.AlterKind_RenameTable,
 		apipb.AlterKind_UpdatePolicy,
 		apipb.AlterKind_AddPartition,
+		apipb.AlterKind_DropPartition,
+		apipb.AlterKind_UpdateKind,
 		apipb.AlterKind_RenameColumn:
 	default:
 		return moerr.NewNYIf(ctx, "alter table %s", req.Kind.String())
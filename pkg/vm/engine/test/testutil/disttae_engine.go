@@ -502,6 +502,12 @@ func (ml *mockLockService) Unlock(ctx context.Context, txnID []byte,
 	commitTS timestamp.Timestamp, mutations ...lock.ExtraMutation) error {
 	return nil
 }
+func (ml *mockLockService) Savepoint(txnID []byte) lockservice.LockSavepoint {
+	return lockservice.LockSavepoint{}
+}
+func (ml *mockLockService) RollbackToSavepoint(ctx context.Context, txnID []byte, sp lockservice.LockSavepoint) error {
+	return nil
+}
 func (ml *mockLockService) IsOrphanTxn(context.Context, []byte) (bool, error) { return false, nil }
 func (ml *mockLockService) Close() error                                      { return nil }
 func (ml *mockLockService) GetWaitingList(ctx context.Context, txnID []byte) (bool, []lock.WaitTxn, error) {
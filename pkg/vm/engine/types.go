@@ -168,6 +168,14 @@ type StreamConfigsDef struct {
 	Configs []*plan.Property
 }
 
+type CheckDef struct {
+	Checks []*plan.CheckDef
+}
+
+type TriggersDef struct {
+	Triggers []*plan.TriggerDef
+}
+
 type TableDef interface {
 	tableDef()
 
@@ -304,6 +312,8 @@ const (
 	ForeignKey
 	PrimaryKey
 	StreamConfig
+	Check
+	Trigger
 )
 
 type EngineType int8
@@ -396,6 +406,40 @@ func (def *ConstraintDef) MarshalBinary() (data []byte, err error) {
 				}
 				buf.Write(bytes)
 			}
+		case *CheckDef:
+			if err := binary.Write(buf, binary.BigEndian, Check); err != nil {
+				return nil, err
+			}
+			if err := binary.Write(buf, binary.BigEndian, uint64(len(def.Checks))); err != nil {
+				return nil, err
+			}
+			for _, c := range def.Checks {
+				bytes, err := c.Marshal()
+				if err != nil {
+					return nil, err
+				}
+				if err := binary.Write(buf, binary.BigEndian, uint64(len(bytes))); err != nil {
+					return nil, err
+				}
+				buf.Write(bytes)
+			}
+		case *TriggersDef:
+			if err := binary.Write(buf, binary.BigEndian, Trigger); err != nil {
+				return nil, err
+			}
+			if err := binary.Write(buf, binary.BigEndian, uint64(len(def.Triggers))); err != nil {
+				return nil, err
+			}
+			for _, t := range def.Triggers {
+				bytes, err := t.Marshal()
+				if err != nil {
+					return nil, err
+				}
+				if err := binary.Write(buf, binary.BigEndian, uint64(len(bytes))); err != nil {
+					return nil, err
+				}
+				buf.Write(bytes)
+			}
 		}
 	}
 	return buf.Bytes(), nil
@@ -481,6 +525,40 @@ func (def *ConstraintDef) UnmarshalBinary(data []byte) error {
 				configs[i] = config
 			}
 			def.Cts = append(def.Cts, &StreamConfigsDef{configs})
+		case Check:
+			length = binary.BigEndian.Uint64(data[l : l+8])
+			l += 8
+			checks := make([]*plan.CheckDef, length)
+
+			for i := 0; i < int(length); i++ {
+				dataLength := binary.BigEndian.Uint64(data[l : l+8])
+				l += 8
+				check := &plan.CheckDef{}
+				err := check.Unmarshal(data[l : l+int(dataLength)])
+				if err != nil {
+					return err
+				}
+				l += int(dataLength)
+				checks[i] = check
+			}
+			def.Cts = append(def.Cts, &CheckDef{checks})
+		case Trigger:
+			length = binary.BigEndian.Uint64(data[l : l+8])
+			l += 8
+			triggers := make([]*plan.TriggerDef, length)
+
+			for i := 0; i < int(length); i++ {
+				dataLength := binary.BigEndian.Uint64(data[l : l+8])
+				l += 8
+				trigger := &plan.TriggerDef{}
+				err := trigger.Unmarshal(data[l : l+int(dataLength)])
+				if err != nil {
+					return err
+				}
+				l += int(dataLength)
+				triggers[i] = trigger
+			}
+			def.Cts = append(def.Cts, &TriggersDef{triggers})
 		}
 	}
 	return nil
@@ -512,6 +590,12 @@ func (def *ConstraintPB) FromPBVersion() Constraint {
 	if r := def.GetStreamConfigsDef(); r != nil {
 		return r
 	}
+	if r := def.GetCheckDef(); r != nil {
+		return r
+	}
+	if r := def.GetTriggersDef(); r != nil {
+		return r
+	}
 	panic("no corresponding type")
 }
 
@@ -538,6 +622,8 @@ func (*PrimaryKeyDef) constraint()    {}
 func (*RefChildTableDef) constraint() {}
 func (*IndexDef) constraint()         {}
 func (*StreamConfigsDef) constraint() {}
+func (*CheckDef) constraint()         {}
+func (*TriggersDef) constraint()      {}
 
 func (def *ForeignKeyDef) ToPBVersion() ConstraintPB {
 	return ConstraintPB{
@@ -576,6 +662,22 @@ func (def *StreamConfigsDef) ToPBVersion() ConstraintPB {
 	}
 }
 
+func (def *CheckDef) ToPBVersion() ConstraintPB {
+	return ConstraintPB{
+		Ct: &ConstraintPB_CheckDef{
+			CheckDef: def,
+		},
+	}
+}
+
+func (def *TriggersDef) ToPBVersion() ConstraintPB {
+	return ConstraintPB{
+		Ct: &ConstraintPB_TriggersDef{
+			TriggersDef: def,
+		},
+	}
+}
+
 type TombstoneType uint8
 
 const (
@@ -440,11 +440,91 @@ func (m *StreamConfigsDef) GetConfigs() []*plan.Property {
 	return nil
 }
 
+func (m *CheckDef) Reset()         { *m = CheckDef{} }
+func (m *CheckDef) String() string { return proto.CompactTextString(m) }
+func (*CheckDef) ProtoMessage()    {}
+func (*CheckDef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{10}
+}
+func (m *CheckDef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckDef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckDef.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CheckDef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckDef.Merge(m, src)
+}
+func (m *CheckDef) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *CheckDef) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckDef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckDef proto.InternalMessageInfo
+
+func (m *CheckDef) GetChecks() []*plan.CheckDef {
+	if m != nil {
+		return m.Checks
+	}
+	return nil
+}
+
+func (m *TriggersDef) Reset()         { *m = TriggersDef{} }
+func (m *TriggersDef) String() string { return proto.CompactTextString(m) }
+func (*TriggersDef) ProtoMessage()    {}
+func (*TriggersDef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{11}
+}
+func (m *TriggersDef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TriggersDef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TriggersDef.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TriggersDef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggersDef.Merge(m, src)
+}
+func (m *TriggersDef) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *TriggersDef) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggersDef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggersDef proto.InternalMessageInfo
+
+func (m *TriggersDef) GetTriggers() []*plan.TriggerDef {
+	if m != nil {
+		return m.Triggers
+	}
+	return nil
+}
+
 func (m *PrimaryKeyDef) Reset()         { *m = PrimaryKeyDef{} }
 func (m *PrimaryKeyDef) String() string { return proto.CompactTextString(m) }
 func (*PrimaryKeyDef) ProtoMessage()    {}
 func (*PrimaryKeyDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{10}
+	return fileDescriptor_d938547f84707355, []int{12}
 }
 func (m *PrimaryKeyDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -484,7 +564,7 @@ func (m *RefChildTableDef) Reset()         { *m = RefChildTableDef{} }
 func (m *RefChildTableDef) String() string { return proto.CompactTextString(m) }
 func (*RefChildTableDef) ProtoMessage()    {}
 func (*RefChildTableDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{11}
+	return fileDescriptor_d938547f84707355, []int{13}
 }
 func (m *RefChildTableDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -524,7 +604,7 @@ func (m *IndexDef) Reset()         { *m = IndexDef{} }
 func (m *IndexDef) String() string { return proto.CompactTextString(m) }
 func (*IndexDef) ProtoMessage()    {}
 func (*IndexDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{12}
+	return fileDescriptor_d938547f84707355, []int{14}
 }
 func (m *IndexDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -569,7 +649,7 @@ func (m *ConstraintDefPB) Reset()         { *m = ConstraintDefPB{} }
 func (m *ConstraintDefPB) String() string { return proto.CompactTextString(m) }
 func (*ConstraintDefPB) ProtoMessage()    {}
 func (*ConstraintDefPB) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{13}
+	return fileDescriptor_d938547f84707355, []int{15}
 }
 func (m *ConstraintDefPB) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -613,6 +693,8 @@ type ConstraintPB struct {
 	//	*ConstraintPB_RefChildTableDef
 	//	*ConstraintPB_IndexDef
 	//	*ConstraintPB_StreamConfigsDef
+	//	*ConstraintPB_CheckDef
+	//	*ConstraintPB_TriggersDef
 	Ct isConstraintPB_Ct `protobuf_oneof:"ct"`
 }
 
@@ -620,7 +702,7 @@ func (m *ConstraintPB) Reset()         { *m = ConstraintPB{} }
 func (m *ConstraintPB) String() string { return proto.CompactTextString(m) }
 func (*ConstraintPB) ProtoMessage()    {}
 func (*ConstraintPB) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{14}
+	return fileDescriptor_d938547f84707355, []int{16}
 }
 func (m *ConstraintPB) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -670,12 +752,20 @@ type ConstraintPB_IndexDef struct {
 type ConstraintPB_StreamConfigsDef struct {
 	StreamConfigsDef *StreamConfigsDef `protobuf:"bytes,5,opt,name=StreamConfigsDef,proto3,oneof" json:"StreamConfigsDef,omitempty"`
 }
+type ConstraintPB_CheckDef struct {
+	CheckDef *CheckDef `protobuf:"bytes,6,opt,name=CheckDef,proto3,oneof" json:"CheckDef,omitempty"`
+}
+type ConstraintPB_TriggersDef struct {
+	TriggersDef *TriggersDef `protobuf:"bytes,7,opt,name=TriggersDef,proto3,oneof" json:"TriggersDef,omitempty"`
+}
 
 func (*ConstraintPB_ForeignKeyDef) isConstraintPB_Ct()    {}
 func (*ConstraintPB_PrimaryKeyDef) isConstraintPB_Ct()    {}
 func (*ConstraintPB_RefChildTableDef) isConstraintPB_Ct() {}
 func (*ConstraintPB_IndexDef) isConstraintPB_Ct()         {}
 func (*ConstraintPB_StreamConfigsDef) isConstraintPB_Ct() {}
+func (*ConstraintPB_CheckDef) isConstraintPB_Ct()         {}
+func (*ConstraintPB_TriggersDef) isConstraintPB_Ct()      {}
 
 func (m *ConstraintPB) GetCt() isConstraintPB_Ct {
 	if m != nil {
@@ -719,6 +809,20 @@ func (m *ConstraintPB) GetStreamConfigsDef() *StreamConfigsDef {
 	return nil
 }
 
+func (m *ConstraintPB) GetCheckDef() *CheckDef {
+	if x, ok := m.GetCt().(*ConstraintPB_CheckDef); ok {
+		return x.CheckDef
+	}
+	return nil
+}
+
+func (m *ConstraintPB) GetTriggersDef() *TriggersDef {
+	if x, ok := m.GetCt().(*ConstraintPB_TriggersDef); ok {
+		return x.TriggersDef
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ConstraintPB) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -727,6 +831,8 @@ func (*ConstraintPB) XXX_OneofWrappers() []interface{} {
 		(*ConstraintPB_RefChildTableDef)(nil),
 		(*ConstraintPB_IndexDef)(nil),
 		(*ConstraintPB_StreamConfigsDef)(nil),
+		(*ConstraintPB_CheckDef)(nil),
+		(*ConstraintPB_TriggersDef)(nil),
 	}
 }
 
@@ -749,7 +855,7 @@ func (m *TableDefPB) Reset()         { *m = TableDefPB{} }
 func (m *TableDefPB) String() string { return proto.CompactTextString(m) }
 func (*TableDefPB) ProtoMessage()    {}
 func (*TableDefPB) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{15}
+	return fileDescriptor_d938547f84707355, []int{17}
 }
 func (m *TableDefPB) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -911,7 +1017,7 @@ func (m *Property) Reset()         { *m = Property{} }
 func (m *Property) String() string { return proto.CompactTextString(m) }
 func (*Property) ProtoMessage()    {}
 func (*Property) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{16}
+	return fileDescriptor_d938547f84707355, []int{18}
 }
 func (m *Property) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -958,7 +1064,7 @@ func (m *Attribute) Reset()         { *m = Attribute{} }
 func (m *Attribute) String() string { return proto.CompactTextString(m) }
 func (*Attribute) ProtoMessage()    {}
 func (*Attribute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{17}
+	return fileDescriptor_d938547f84707355, []int{19}
 }
 func (m *Attribute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1075,6 +1181,8 @@ func init() {
 	proto.RegisterType((*ClusterByDef)(nil), "engine.ClusterByDef")
 	proto.RegisterType((*ForeignKeyDef)(nil), "engine.ForeignKeyDef")
 	proto.RegisterType((*StreamConfigsDef)(nil), "engine.StreamConfigsDef")
+	proto.RegisterType((*CheckDef)(nil), "engine.CheckDef")
+	proto.RegisterType((*TriggersDef)(nil), "engine.TriggersDef")
 	proto.RegisterType((*PrimaryKeyDef)(nil), "engine.PrimaryKeyDef")
 	proto.RegisterType((*RefChildTableDef)(nil), "engine.RefChildTableDef")
 	proto.RegisterType((*IndexDef)(nil), "engine.IndexDef")
@@ -1088,70 +1196,75 @@ func init() {
 func init() { proto.RegisterFile("types.proto", fileDescriptor_d938547f84707355) }
 
 var fileDescriptor_d938547f84707355 = []byte{
-	// 1005 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x4f, 0x6f, 0xe2, 0x56,
-	0x10, 0x37, 0x60, 0x08, 0x0c, 0xb0, 0x9b, 0x7a, 0xb3, 0xad, 0x15, 0x55, 0x10, 0xa5, 0x95, 0x96,
-	0x76, 0xb7, 0xb0, 0x4a, 0xa3, 0xaa, 0x42, 0x4a, 0xd5, 0x98, 0x14, 0x81, 0x56, 0x6d, 0xd1, 0x6b,
-	0x9a, 0xbb, 0x81, 0x07, 0x6b, 0x05, 0x6c, 0xcb, 0x7e, 0x68, 0x97, 0x6f, 0xd1, 0x8f, 0xd0, 0x1e,
-	0xfb, 0x4d, 0xf6, 0x98, 0x63, 0xd4, 0x43, 0xd4, 0x26, 0x5f, 0xa0, 0xe7, 0x9c, 0xaa, 0x37, 0x9e,
-	0xe7, 0x7f, 0x5c, 0x7a, 0x7b, 0x33, 0xf3, 0x9b, 0x79, 0xe3, 0xf9, 0x8d, 0x7f, 0x36, 0xd4, 0xc5,
-	0xd6, 0xe7, 0x61, 0xd7, 0x0f, 0x3c, 0xe1, 0x19, 0x15, 0xee, 0x2e, 0x1d, 0x97, 0x1f, 0x7e, 0xb5,
-	0x74, 0xc4, 0xdb, 0xcd, 0xb4, 0x3b, 0xf3, 0xd6, 0xbd, 0xa5, 0xb7, 0xf4, 0x7a, 0x18, 0x9e, 0x6e,
-	0x16, 0x68, 0xa1, 0x81, 0xa7, 0x28, 0xed, 0x10, 0xfc, 0x95, 0xed, 0x46, 0xe7, 0xe3, 0x57, 0x00,
-	0x03, 0x6f, 0xbd, 0xe6, 0xae, 0xb8, 0xe0, 0x0b, 0xc3, 0x84, 0x3d, 0xb2, 0xcc, 0xc2, 0x51, 0xa1,
-	0x53, 0x63, 0xca, 0xec, 0xeb, 0xff, 0xfe, 0xd1, 0xd6, 0x24, 0xfa, 0x8a, 0x07, 0xa1, 0xe3, 0xb9,
-	0x84, 0x26, 0x0b, 0xd1, 0x4d, 0xa6, 0x4c, 0x42, 0x9f, 0x40, 0x63, 0x62, 0x07, 0xc2, 0x11, 0x84,
-	0xff, 0x14, 0x6a, 0xb1, 0x4d, 0xf5, 0x13, 0x07, 0xe5, 0x7c, 0x06, 0x7b, 0x57, 0x0e, 0x7f, 0x27,
-	0xe1, 0x06, 0xe8, 0xf2, 0x48, 0x48, 0x3c, 0x13, 0xe8, 0x1c, 0x1a, 0xe7, 0x42, 0x04, 0xce, 0x74,
-	0x23, 0xb8, 0x44, 0xbe, 0x04, 0x5d, 0xda, 0x88, 0xac, 0x9f, 0x7c, 0xd4, 0x8d, 0xc6, 0xd2, 0x8d,
-	0x31, 0x96, 0xfe, 0xe1, 0xae, 0xad, 0x31, 0x04, 0x51, 0x89, 0x19, 0x34, 0xc7, 0xee, 0x9c, 0xbf,
-	0xbf, 0xb4, 0xa7, 0x2b, 0x1e, 0x35, 0x57, 0xba, 0xdc, 0xfa, 0x58, 0xa2, 0x6c, 0xc1, 0xe3, 0x5d,
-	0xbb, 0x12, 0xc5, 0x99, 0x74, 0x1b, 0x87, 0x50, 0x1d, 0x78, 0xab, 0x9f, 0xec, 0x35, 0x0f, 0xcd,
-	0xe2, 0x51, 0xa9, 0x53, 0x63, 0xb1, 0x2d, 0xfb, 0x94, 0x07, 0xb3, 0x14, 0xf5, 0x29, 0xcf, 0x74,
-	0xc9, 0x8f, 0xd0, 0x9c, 0x04, 0x9e, 0xcf, 0x03, 0xe1, 0xf0, 0x50, 0x5e, 0xf2, 0x0d, 0x40, 0xe2,
-	0x30, 0x0b, 0x47, 0xa5, 0x4e, 0xfd, 0x64, 0x5f, 0xb5, 0x4b, 0x91, 0x2d, 0x75, 0x9b, 0x42, 0x52,
-	0xb9, 0x0e, 0x34, 0x06, 0xab, 0x4d, 0x28, 0x78, 0x60, 0x6d, 0x69, 0x40, 0x78, 0x71, 0x61, 0xe7,
-	0xe2, 0xef, 0xa1, 0x39, 0xf4, 0x02, 0xee, 0x2c, 0xdd, 0x37, 0x1c, 0xa1, 0x5f, 0x40, 0x79, 0x78,
-	0xcd, 0xb7, 0xea, 0xce, 0x67, 0x5d, 0x5c, 0x81, 0x0c, 0x86, 0x45, 0x08, 0xaa, 0x60, 0xc1, 0xfe,
-	0x2f, 0x22, 0xe0, 0xf6, 0x7a, 0xe0, 0xb9, 0x0b, 0x67, 0x89, 0xdd, 0x77, 0xe4, 0x76, 0xa0, 0x45,
-	0x65, 0x9e, 0x44, 0x65, 0x54, 0xe3, 0x4c, 0x85, 0xa9, 0xc6, 0x77, 0xf2, 0xf1, 0x9d, 0xb5, 0x1d,
-	0x6c, 0xa9, 0x8b, 0x17, 0xa0, 0x4f, 0xae, 0xf9, 0x96, 0x78, 0x7a, 0xa6, 0xb2, 0x53, 0x10, 0x86,
-	0x00, 0xca, 0x7f, 0x0d, 0xfb, 0x8c, 0x2f, 0x06, 0x6f, 0x9d, 0xd5, 0x3c, 0xa6, 0xe9, 0x63, 0xa8,
-	0xe0, 0x39, 0x6a, 0x41, 0x67, 0x64, 0x51, 0x46, 0x1f, 0xaa, 0xc8, 0x1a, 0x75, 0x8b, 0x67, 0x9e,
-	0xeb, 0x56, 0x01, 0x98, 0x0a, 0x53, 0xee, 0x0f, 0xf0, 0x74, 0xe0, 0xb9, 0xa1, 0x08, 0x6c, 0x07,
-	0x5f, 0x86, 0x89, 0x65, 0xbc, 0x82, 0xd2, 0x40, 0xa8, 0xf4, 0x03, 0xc5, 0x53, 0x82, 0x9a, 0x58,
-	0xc4, 0x95, 0x84, 0x61, 0x99, 0xc2, 0xf1, 0x6d, 0x11, 0x1a, 0x69, 0x84, 0x71, 0x96, 0xe3, 0x82,
-	0x9e, 0xfe, 0xb9, 0x2a, 0x97, 0x09, 0x8e, 0x34, 0x96, 0x63, 0xee, 0x2c, 0x37, 0x44, 0xb3, 0x98,
-	0x4d, 0xcf, 0x04, 0x65, 0x7a, 0x76, 0xe4, 0xc3, 0xdd, 0x19, 0xe2, 0xa2, 0xd6, 0x4f, 0x4c, 0x55,
-	0x21, 0x1f, 0x1f, 0x69, 0x6c, 0x77, 0xee, 0xdd, 0x64, 0xb2, 0xa6, 0x8e, 0xf9, 0xf1, 0xde, 0x2a,
-	0xff, 0x48, 0x63, 0xc9, 0xf4, 0x87, 0xbb, 0xfb, 0x63, 0x96, 0xb3, 0xf7, 0xe6, 0xe3, 0xf2, 0xde,
-	0xbc, 0x2f, 0x1a, 0xaa, 0xa5, 0x43, 0x71, 0x26, 0x8e, 0xff, 0xd4, 0x01, 0x54, 0x43, 0x13, 0xcb,
-	0x38, 0x4d, 0x4b, 0x17, 0x4d, 0xd5, 0x48, 0x48, 0x52, 0x91, 0x91, 0xc6, 0xd2, 0x12, 0xd7, 0xcf,
-	0x8a, 0x12, 0x8d, 0x33, 0x26, 0x37, 0x1d, 0x1b, 0x69, 0x2c, 0x2b, 0x60, 0x2f, 0x63, 0x71, 0xa2,
-	0x19, 0x3e, 0x55, 0x69, 0xe4, 0x1e, 0x69, 0x2c, 0x96, 0xaf, 0x7e, 0x56, 0xa4, 0x68, 0x6a, 0x07,
-	0x3b, 0xe2, 0x44, 0x17, 0x65, 0x04, 0xed, 0x2c, 0xa7, 0x4e, 0x34, 0xba, 0xe7, 0x99, 0x91, 0xa7,
-	0xf8, 0xca, 0x69, 0xd9, 0x59, 0x4e, 0x77, 0xcc, 0x4a, 0x7e, 0x67, 0x52, 0xc1, 0x68, 0x67, 0xd2,
-	0x2a, 0xd5, 0xcf, 0xea, 0x8c, 0xb9, 0x97, 0xed, 0x3c, 0x1d, 0x93, 0x9d, 0x67, 0x34, 0x69, 0xb0,
-	0xf3, 0x16, 0x99, 0x55, 0x4c, 0xff, 0x64, 0xf7, 0xf5, 0xc1, 0xf0, 0x48, 0x63, 0x3b, 0xef, 0xdd,
-	0x69, 0xfa, 0x33, 0x63, 0xd6, 0xb2, 0xcc, 0x26, 0x11, 0xc9, 0x6c, 0x62, 0xd1, 0xaa, 0x94, 0xa1,
-	0x34, 0xe7, 0x8b, 0xe3, 0x6f, 0xa1, 0xaa, 0x64, 0xc9, 0xd8, 0x87, 0xd2, 0x1b, 0x52, 0x9d, 0x1a,
-	0x93, 0x47, 0xe3, 0x00, 0xca, 0x57, 0xf6, 0x6a, 0xc3, 0x91, 0xfd, 0x1a, 0x8b, 0x0c, 0xd2, 0x81,
-	0xdb, 0x12, 0xd4, 0x62, 0x32, 0xa4, 0xf0, 0x8f, 0xc3, 0x91, 0x33, 0x9f, 0xf3, 0xe8, 0x93, 0x55,
-	0x65, 0xb1, 0x2d, 0xbf, 0x7f, 0xe3, 0x90, 0x79, 0xef, 0xc6, 0x73, 0xac, 0x53, 0x65, 0xca, 0x34,
-	0x9e, 0x40, 0x71, 0x7c, 0x81, 0x3b, 0xa2, 0xb3, 0xe2, 0xf8, 0x22, 0x56, 0x6a, 0x3d, 0x51, 0x6a,
-	0x63, 0x08, 0xa5, 0xf3, 0xd5, 0x12, 0x99, 0x6d, 0x5a, 0xa7, 0x8f, 0x77, 0xed, 0xd7, 0xa9, 0xaf,
-	0xf8, 0xda, 0x16, 0x81, 0xf3, 0xde, 0x0b, 0x9c, 0xa5, 0xe3, 0x2a, 0xc3, 0xe5, 0x3d, 0xff, 0x7a,
-	0xd9, 0x9b, 0x79, 0x6b, 0x3f, 0xe0, 0x61, 0xd8, 0xbd, 0x64, 0xb2, 0x80, 0x71, 0x05, 0xfa, 0xe5,
-	0xd6, 0xe7, 0xc8, 0x71, 0xc3, 0xb2, 0xa4, 0x1e, 0xfd, 0x75, 0xd7, 0xee, 0xff, 0xdf, 0x62, 0xae,
-	0xb0, 0x1d, 0x97, 0x07, 0xbd, 0xe8, 0xbf, 0x42, 0x56, 0x62, 0x58, 0xcf, 0x78, 0x01, 0x7b, 0x17,
-	0x7c, 0x61, 0x6f, 0x56, 0x82, 0x16, 0xa0, 0x19, 0xe9, 0x27, 0x39, 0x99, 0x8a, 0x1a, 0x5f, 0x42,
-	0xf5, 0x67, 0xf7, 0x57, 0x7f, 0x6e, 0x0b, 0x4e, 0x5c, 0x93, 0xd2, 0x2a, 0x2f, 0x8b, 0xe3, 0x72,
-	0x64, 0xa4, 0x4f, 0x48, 0x6b, 0x95, 0x29, 0x53, 0xfe, 0x1c, 0xc4, 0x8b, 0x64, 0x02, 0xc6, 0x12,
-	0x47, 0xfa, 0xc7, 0xa4, 0x9e, 0xf9, 0x31, 0x31, 0x3e, 0x87, 0xe6, 0xf9, 0x46, 0x78, 0x63, 0x77,
-	0x16, 0x70, 0x8c, 0x37, 0x30, 0x37, 0xeb, 0x8c, 0xa8, 0xb5, 0x8e, 0x6e, 0xfe, 0x69, 0x69, 0x1f,
-	0xee, 0x5b, 0x85, 0x9b, 0xfb, 0x56, 0xe1, 0xef, 0xfb, 0x96, 0xf6, 0xdb, 0x43, 0x4b, 0xfb, 0xfd,
-	0xa1, 0x55, 0xb8, 0x79, 0x68, 0x69, 0xb7, 0x0f, 0x2d, 0x6d, 0x5a, 0xc1, 0xbf, 0xa2, 0xaf, 0xff,
-	0x0b, 0x00, 0x00, 0xff, 0xff, 0x6a, 0x0b, 0xa0, 0xb7, 0x67, 0x09, 0x00, 0x00,
+	// 1074 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xcd, 0x8e, 0xe3, 0x44,
+	0x10, 0x76, 0xc6, 0xce, 0x5f, 0x25, 0xd9, 0x1d, 0x7a, 0x17, 0xb0, 0x56, 0x28, 0x13, 0x0d, 0x88,
+	0x0d, 0xec, 0x90, 0xac, 0x86, 0x11, 0xa0, 0x48, 0x8b, 0x18, 0x67, 0x88, 0x12, 0xad, 0x80, 0xa8,
+	0x09, 0x73, 0x77, 0x92, 0x8e, 0xc7, 0x9a, 0xc4, 0x8e, 0xec, 0x8e, 0x76, 0xf3, 0x16, 0x3c, 0x02,
+	0x1c, 0x79, 0x0c, 0x6e, 0x7b, 0x9c, 0xe3, 0x8a, 0xc3, 0x08, 0x66, 0x5e, 0x80, 0xf3, 0x9e, 0x50,
+	0xb7, 0xab, 0x6d, 0x77, 0x72, 0xe1, 0xd6, 0x55, 0xdf, 0x57, 0x55, 0xed, 0xaa, 0xcf, 0x65, 0x43,
+	0x8d, 0x6f, 0xd7, 0x2c, 0xee, 0xac, 0xa3, 0x90, 0x87, 0xa4, 0xc4, 0x02, 0xcf, 0x0f, 0xd8, 0x93,
+	0x2f, 0x3c, 0x9f, 0x5f, 0x6d, 0xa6, 0x9d, 0x59, 0xb8, 0xea, 0x7a, 0xa1, 0x17, 0x76, 0x25, 0x3c,
+	0xdd, 0x2c, 0xa4, 0x25, 0x0d, 0x79, 0x4a, 0xc2, 0x9e, 0xc0, 0x7a, 0xe9, 0x06, 0xc9, 0xf9, 0xf8,
+	0x04, 0xa0, 0x1f, 0xae, 0x56, 0x2c, 0xe0, 0x17, 0x6c, 0x41, 0x6c, 0x28, 0xa3, 0x65, 0x17, 0x5a,
+	0x85, 0x76, 0x95, 0x2a, 0xb3, 0x67, 0xfd, 0xfb, 0xfb, 0x91, 0x21, 0xd8, 0x97, 0x2c, 0x8a, 0xfd,
+	0x30, 0x40, 0x36, 0x5a, 0x92, 0xdd, 0xa0, 0xca, 0x44, 0xf6, 0x29, 0xd4, 0xc7, 0x6e, 0xc4, 0x7d,
+	0x8e, 0xfc, 0x8f, 0xa0, 0x9a, 0xda, 0x98, 0x3f, 0x73, 0x60, 0xcc, 0xc7, 0x50, 0xbe, 0xf4, 0xd9,
+	0x2b, 0x41, 0x27, 0x60, 0x89, 0x23, 0x32, 0xe5, 0x19, 0x49, 0xe7, 0x50, 0x3f, 0xe7, 0x3c, 0xf2,
+	0xa7, 0x1b, 0xce, 0x04, 0xf3, 0x19, 0x58, 0xc2, 0x96, 0xcc, 0xda, 0xe9, 0x7b, 0x9d, 0xa4, 0x2d,
+	0x9d, 0x94, 0xe3, 0x58, 0x6f, 0x6e, 0x8f, 0x0c, 0x2a, 0x49, 0x98, 0x62, 0x06, 0x8d, 0x51, 0x30,
+	0x67, 0xaf, 0x27, 0xee, 0x74, 0xc9, 0x92, 0xcb, 0x99, 0x93, 0xed, 0x5a, 0xa6, 0x28, 0x3a, 0xf0,
+	0xee, 0xf6, 0xa8, 0x94, 0xe0, 0x54, 0xb8, 0xc9, 0x13, 0xa8, 0xf4, 0xc3, 0xe5, 0x8f, 0xee, 0x8a,
+	0xc5, 0xf6, 0x41, 0xcb, 0x6c, 0x57, 0x69, 0x6a, 0x8b, 0x7b, 0x8a, 0x83, 0x6d, 0x26, 0xf7, 0x14,
+	0x67, 0x2c, 0xf2, 0x03, 0x34, 0xc6, 0x51, 0xb8, 0x66, 0x11, 0xf7, 0x59, 0x2c, 0x8a, 0x7c, 0x05,
+	0x90, 0x39, 0xec, 0x42, 0xcb, 0x6c, 0xd7, 0x4e, 0x0f, 0xd5, 0x75, 0x11, 0xd9, 0xe2, 0x6d, 0x73,
+	0x4c, 0x4c, 0xd7, 0x86, 0x7a, 0x7f, 0xb9, 0x89, 0x39, 0x8b, 0x9c, 0x2d, 0x36, 0x48, 0x16, 0x2e,
+	0xec, 0x15, 0xfe, 0x0e, 0x1a, 0x83, 0x30, 0x62, 0xbe, 0x17, 0xbc, 0x64, 0x92, 0xfa, 0x19, 0x14,
+	0x07, 0xd7, 0x6c, 0xab, 0x6a, 0x3e, 0xea, 0x48, 0x09, 0x68, 0x1c, 0x9a, 0x30, 0x30, 0x83, 0x03,
+	0x87, 0x3f, 0xf3, 0x88, 0xb9, 0xab, 0x7e, 0x18, 0x2c, 0x7c, 0x4f, 0xde, 0xbe, 0x0d, 0x65, 0xb4,
+	0x30, 0xcd, 0x83, 0x24, 0x8d, 0xba, 0x38, 0x55, 0x30, 0xe6, 0xf8, 0x06, 0x2a, 0xfd, 0x2b, 0x36,
+	0xbb, 0x16, 0xb1, 0x9f, 0x42, 0x49, 0x9e, 0x77, 0x42, 0x15, 0x4e, 0x11, 0x4d, 0x07, 0x5c, 0x9b,
+	0x44, 0xbe, 0xe7, 0xb1, 0x48, 0x16, 0x3e, 0x81, 0x8a, 0x32, 0xd3, 0xa6, 0xc9, 0x70, 0xf4, 0x8a,
+	0x04, 0x29, 0x03, 0x53, 0x7c, 0x2b, 0x7a, 0xef, 0xaf, 0xdc, 0x68, 0x8b, 0x2d, 0x78, 0x0a, 0xd6,
+	0xf8, 0x9a, 0x6d, 0x51, 0x24, 0x8f, 0xd4, 0xd5, 0x73, 0x14, 0x2a, 0x09, 0x18, 0xff, 0x1c, 0x0e,
+	0x29, 0x5b, 0xf4, 0xaf, 0xfc, 0xe5, 0x3c, 0xd5, 0xc8, 0x07, 0x50, 0x92, 0xe7, 0xe4, 0x16, 0x16,
+	0x45, 0x0b, 0x23, 0x7a, 0x50, 0x91, 0x92, 0xc1, 0x56, 0xc9, 0x33, 0xdb, 0x79, 0x5e, 0x45, 0xa0,
+	0x0a, 0xc6, 0xd8, 0xef, 0xe1, 0x61, 0x3f, 0x0c, 0x62, 0x1e, 0xb9, 0xbe, 0x7c, 0x13, 0xc7, 0x0e,
+	0x39, 0x01, 0xb3, 0xcf, 0x55, 0xf8, 0x63, 0x25, 0x92, 0x8c, 0x35, 0x76, 0x50, 0x28, 0x82, 0x26,
+	0xd3, 0x14, 0x8e, 0xff, 0x34, 0xa1, 0x9e, 0x67, 0x90, 0x17, 0x3b, 0x42, 0xc0, 0xa7, 0x7f, 0x5f,
+	0xa5, 0xd3, 0xc0, 0xa1, 0x41, 0x77, 0x64, 0xf3, 0x62, 0xa7, 0x89, 0xf6, 0x81, 0x1e, 0xae, 0x81,
+	0x22, 0x5c, 0x6f, 0xf9, 0x60, 0xbf, 0x87, 0xf2, 0x2d, 0xa9, 0x9d, 0xda, 0x2a, 0xc3, 0x2e, 0x3e,
+	0x34, 0xe8, 0x7e, 0xdf, 0x3b, 0x59, 0x67, 0x6d, 0xab, 0x55, 0xc8, 0xbf, 0x34, 0xca, 0x3f, 0x34,
+	0x68, 0xd6, 0xfd, 0xc1, 0xbe, 0x78, 0xed, 0xa2, 0x5e, 0x77, 0x17, 0x17, 0x75, 0xf7, 0x04, 0xdf,
+	0xc9, 0x04, 0x6c, 0x97, 0xf4, 0xba, 0xca, 0x2f, 0xea, 0xaa, 0x33, 0xf9, 0x5a, 0x93, 0xad, 0x5d,
+	0x46, 0xa5, 0x61, 0x48, 0x0e, 0x1a, 0x1a, 0x34, 0xcf, 0x4c, 0xa6, 0xe7, 0x58, 0x70, 0x30, 0xe3,
+	0xc7, 0x7f, 0x58, 0x00, 0xea, 0xc9, 0xc7, 0x0e, 0x39, 0xcb, 0x2f, 0x68, 0x1c, 0x1f, 0xc9, 0xd4,
+	0xa0, 0x90, 0xa1, 0x41, 0xf3, 0x8b, 0xbc, 0xa7, 0xaf, 0x5e, 0x9c, 0x5b, 0xaa, 0xa2, 0x3c, 0x36,
+	0x34, 0xa8, 0xbe, 0xa6, 0x9f, 0xa5, 0x2b, 0x18, 0x87, 0xf5, 0x50, 0x85, 0xa1, 0x7b, 0x68, 0xd0,
+	0x74, 0x49, 0xf7, 0xf4, 0x55, 0x6c, 0x5b, 0x7a, 0xa1, 0x3c, 0x26, 0x0a, 0xe5, 0x6d, 0xa1, 0x2e,
+	0x6d, 0x07, 0xdb, 0x45, 0x5d, 0x5d, 0x1a, 0x28, 0xd4, 0xa5, 0x39, 0x12, 0x71, 0xe6, 0xb6, 0xab,
+	0x5d, 0xd2, 0xc3, 0x35, 0x30, 0x11, 0x67, 0xce, 0x41, 0x7a, 0xfa, 0x36, 0xb5, 0xcb, 0xfa, 0xcd,
+	0xf3, 0x98, 0xb8, 0x79, 0xde, 0x26, 0xfd, 0xbd, 0xd7, 0xd5, 0xae, 0xc8, 0xf0, 0x0f, 0xf7, 0xdf,
+	0x53, 0x09, 0x0f, 0x0d, 0xba, 0xf7, 0x82, 0x9f, 0xe5, 0x3f, 0xa6, 0x76, 0x55, 0x9f, 0x6c, 0x86,
+	0x88, 0xc9, 0x66, 0x16, 0x4a, 0xa5, 0x08, 0xe6, 0x9c, 0x2d, 0xc4, 0x86, 0x55, 0xcb, 0x97, 0x1c,
+	0x82, 0xf9, 0x12, 0xd7, 0x5b, 0x95, 0x8a, 0x23, 0x79, 0x0c, 0xc5, 0x4b, 0x77, 0xb9, 0x61, 0x72,
+	0xfa, 0x55, 0x9a, 0x18, 0xb8, 0x70, 0xde, 0x9a, 0x50, 0x4d, 0x87, 0x21, 0x3e, 0x6f, 0xa3, 0x78,
+	0xe8, 0xcf, 0xe7, 0x2c, 0xf9, 0x30, 0x57, 0x68, 0x6a, 0x8b, 0xaf, 0xfc, 0x28, 0xa6, 0xe1, 0xab,
+	0xd1, 0x5c, 0xe6, 0xa9, 0x50, 0x65, 0x92, 0x07, 0x70, 0x30, 0xba, 0x90, 0x1a, 0xb1, 0xe8, 0xc1,
+	0xe8, 0x22, 0xfd, 0x1e, 0x59, 0xd9, 0xf7, 0x88, 0x0c, 0xc0, 0x3c, 0x5f, 0x7a, 0x72, 0xb2, 0x0d,
+	0xe7, 0xec, 0xdd, 0xed, 0xd1, 0xf3, 0xdc, 0xbf, 0xca, 0xca, 0xe5, 0x91, 0xff, 0x3a, 0x8c, 0x7c,
+	0xcf, 0x0f, 0x94, 0x11, 0xb0, 0xee, 0xfa, 0xda, 0xeb, 0xce, 0xc2, 0xd5, 0x3a, 0x62, 0x71, 0xdc,
+	0x99, 0x50, 0x91, 0x80, 0x5c, 0x82, 0x35, 0xd9, 0xae, 0x99, 0x9c, 0x71, 0xdd, 0x71, 0xc4, 0xe2,
+	0xfb, 0xeb, 0xf6, 0xa8, 0xf7, 0x7f, 0x93, 0x05, 0xdc, 0xf5, 0x03, 0x16, 0x75, 0x93, 0xbf, 0x27,
+	0x91, 0x89, 0xca, 0x7c, 0xe4, 0x29, 0x94, 0x2f, 0xd8, 0xc2, 0xdd, 0x2c, 0x39, 0x0a, 0xa0, 0x91,
+	0x2c, 0x6a, 0x74, 0x52, 0x85, 0x92, 0xcf, 0xa1, 0xf2, 0x53, 0xf0, 0xcb, 0x7a, 0xee, 0x72, 0x86,
+	0xb3, 0xc6, 0x95, 0xae, 0xbc, 0x34, 0xc5, 0x45, 0xcb, 0x70, 0x11, 0xca, 0xb1, 0x56, 0xa8, 0x32,
+	0xc5, 0x2f, 0x50, 0x2a, 0x24, 0x1b, 0x24, 0x96, 0x39, 0xf2, 0xbf, 0x5f, 0x35, 0xed, 0xf7, 0x8b,
+	0x7c, 0x02, 0x8d, 0xf3, 0x0d, 0x0f, 0x47, 0xc1, 0x2c, 0x62, 0x12, 0xaf, 0xcb, 0x58, 0xdd, 0x99,
+	0x8c, 0xd6, 0x69, 0xdd, 0xfc, 0xd3, 0x34, 0xde, 0xdc, 0x35, 0x0b, 0x37, 0x77, 0xcd, 0xc2, 0xdf,
+	0x77, 0x4d, 0xe3, 0xd7, 0xfb, 0xa6, 0xf1, 0xdb, 0x7d, 0xb3, 0x70, 0x73, 0xdf, 0x34, 0xde, 0xde,
+	0x37, 0x8d, 0x69, 0x49, 0xfe, 0xfb, 0x7d, 0xf9, 0xdf, 0x00, 0x63, 0xf6, 0x4e, 0x48, 0x4d, 0x0a,
+	0x00, 0x00,
 }
 
 func (m *CommentDef) Marshal() (dAtA []byte, err error) {
@@ -1490,6 +1603,80 @@ func (m *StreamConfigsDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CheckDef) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckDef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Checks) > 0 {
+		for iNdEx := len(m.Checks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Checks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TriggersDef) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TriggersDef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TriggersDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Triggers) > 0 {
+		for iNdEx := len(m.Triggers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Triggers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PrimaryKeyDef) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -1777,6 +1964,48 @@ func (m *ConstraintPB_StreamConfigsDef) MarshalToSizedBuffer(dAtA []byte) (int,
 	}
 	return len(dAtA) - i, nil
 }
+func (m *ConstraintPB_CheckDef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConstraintPB_CheckDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CheckDef != nil {
+		{
+			size, err := m.CheckDef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ConstraintPB_TriggersDef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConstraintPB_TriggersDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.TriggersDef != nil {
+		{
+			size, err := m.TriggersDef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *TableDefPB) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -2319,6 +2548,36 @@ func (m *StreamConfigsDef) ProtoSize() (n int) {
 	return n
 }
 
+func (m *CheckDef) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Checks) > 0 {
+		for _, e := range m.Checks {
+			l = e.ProtoSize()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TriggersDef) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Triggers) > 0 {
+		for _, e := range m.Triggers {
+			l = e.ProtoSize()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *PrimaryKeyDef) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -2450,6 +2709,30 @@ func (m *ConstraintPB_StreamConfigsDef) ProtoSize() (n int) {
 	}
 	return n
 }
+func (m *ConstraintPB_CheckDef) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CheckDef != nil {
+		l = m.CheckDef.ProtoSize()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *ConstraintPB_TriggersDef) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TriggersDef != nil {
+		l = m.TriggersDef.ProtoSize()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
 func (m *TableDefPB) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -3506,6 +3789,174 @@ func (m *StreamConfigsDef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CheckDef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckDef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckDef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checks = append(m.Checks, &plan.CheckDef{})
+			if err := m.Checks[len(m.Checks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TriggersDef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TriggersDef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TriggersDef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Triggers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Triggers = append(m.Triggers, &plan.TriggerDef{})
+			if err := m.Triggers[len(m.Triggers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *PrimaryKeyDef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4090,6 +4541,76 @@ func (m *ConstraintPB) Unmarshal(dAtA []byte) error {
 			}
 			m.Ct = &ConstraintPB_StreamConfigsDef{v}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckDef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &CheckDef{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Ct = &ConstraintPB_CheckDef{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggersDef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &TriggersDef{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Ct = &ConstraintPB_TriggersDef{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
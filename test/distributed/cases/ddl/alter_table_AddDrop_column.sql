@@ -233,7 +233,7 @@ select * from test01;
 drop table test01;
 
 
--- partitioned tables do not support column deletion,support column add
+-- partitioned tables support column deletion and column add
 drop table if exists tp5;
 create table tp5 (col1 INT, col2 CHAR(5), col3 DATE) partition by LINEAR key ALGORITHM = 1 (col3) PARTITIONS 5;
 show create table tp5;
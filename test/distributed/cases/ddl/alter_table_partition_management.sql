@@ -0,0 +1,60 @@
+drop database if exists partition_mgmt;
+create database partition_mgmt;
+use partition_mgmt;
+create table pt1 (a int primary key, b varchar(10), key kb (b)) partition by range (a) (partition p0 values less than (10), partition p1 values less than (20), partition p2 values less than (30), partition p3 values less than (40));
+insert into pt1 values (1, 'a'), (5, 'b'), (11, 'c'), (21, 'd'), (31, 'e');
+alter table pt1 drop partition p1;
+select a, b from pt1 order by a;
+select a, b from pt1 where b = 'c';
+insert into pt1 values (12, 'f');
+select a, b from pt1 order by a;
+select partition_name, partition_description from information_schema.partitions where table_schema = 'partition_mgmt' and table_name = 'pt1' order by partition_ordinal_position;
+alter table pt1 drop partition p9;
+alter table pt1 drop partition p0, p2, p3;
+alter table pt1 truncate partition p0;
+select a, b from pt1 order by a;
+alter table pt1 truncate partition p9;
+create table et1 (a int primary key, b varchar(10), key kb (b));
+insert into et1 values (3, 'x'), (7, 'y');
+alter table pt1 exchange partition p0 with table et1;
+select a, b from pt1 order by a;
+select a, b from et1 order by a;
+insert into et1 values (15, 'z');
+alter table pt1 exchange partition p0 with table et1;
+alter table pt1 exchange partition p0 with table et1 without validation;
+select a, b from pt1 order by a;
+select a, b from et1 order by a;
+create table et2 (a int primary key, b varchar(20));
+alter table pt1 exchange partition p0 with table et2;
+alter table pt1 reorganize partition p0, p3 into (partition q0 values less than (40));
+alter table pt1 reorganize partition p0 into (partition q0 values less than (5));
+alter table pt1 reorganize partition p2, p3 into (partition q2 values less than (35), partition q3 values less than (50));
+insert into pt1 values (45, 'g');
+select partition_name, partition_description from information_schema.partitions where table_schema = 'partition_mgmt' and table_name = 'pt1' order by partition_ordinal_position;
+select a, b from pt1 order by a;
+alter table pt1 add column c int default 1;
+alter table pt1 drop column b;
+select a, c from pt1 order by a;
+alter table pt1 drop column a;
+alter table pt1 coalesce partition 1;
+create table pt2 (a int, b int) partition by hash (a) partitions 2;
+insert into pt2 values (1, 1), (2, 2), (3, 3), (4, 4);
+alter table pt2 add partition partitions 2;
+select count(*) from information_schema.partitions where table_schema = 'partition_mgmt' and table_name = 'pt2';
+alter table pt2 coalesce partition 3;
+select count(*) from information_schema.partitions where table_schema = 'partition_mgmt' and table_name = 'pt2';
+alter table pt2 coalesce partition 1;
+alter table pt2 drop partition p0;
+select a, b from pt2 order by a;
+alter table pt2 truncate partition all;
+select count(*) from pt2;
+create table pt3 (a int primary key, b int);
+insert into pt3 values (1, 1), (20, 2);
+alter table pt3 partition by range (a) (partition p0 values less than (10), partition p1 values less than (100));
+alter table pt3 truncate partition p1;
+select a, b from pt3 order by a;
+alter table pt3 remove partitioning;
+select count(*) from information_schema.partitions where table_schema = 'partition_mgmt' and table_name = 'pt3';
+select a, b from pt3 order by a;
+alter table pt3 remove partitioning;
+drop database partition_mgmt;
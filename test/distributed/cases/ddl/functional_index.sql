@@ -0,0 +1,36 @@
+drop database if exists functional_index;
+create database functional_index;
+use functional_index;
+create table t1 (id int primary key, email varchar(100), notes text, unique key uk_email ((lower(email))), key idx_notes (notes(10)));
+show create table t1;
+insert into t1 values (1, 'Abby@Example.com', 'the quick brown fox'), (2, 'bob@example.com', 'the quick brown dog'), (3, 'Carol@example.com', null);
+insert into t1 values (4, 'ABBY@example.com', 'duplicate');
+select id, email from t1 where lower(email) = 'abby@example.com';
+select id, notes from t1 where notes = 'the quick brown dog';
+update t1 set email = 'Dora@example.com' where id = 1;
+select id, email from t1 where lower(email) = 'abby@example.com';
+select id, email from t1 where lower(email) = 'dora@example.com';
+insert into t1 values (4, 'abby@example.com', 'again');
+delete from t1 where id = 2;
+select id, notes from t1 where notes = 'the quick brown dog';
+select id, email, notes from t1 order by id;
+alter table t1 drop column email;
+alter table t1 rename column notes to memo;
+create table t2 (id int primary key, doc json, data blob);
+insert into t2 values (1, '{"name": "a", "age": 10}', 'abcdefghij'), (2, '{"name": "b", "age": 20}', 'abcdezzzzz');
+create index idx_name on t2 ((cast(json_extract(doc, '$.name') as varchar(20))));
+create index idx_data on t2 (data(5));
+select id from t2 where cast(json_extract(doc, '$.name') as varchar(20)) = '"b"';
+select id from t2 where data = 'abcdefghij';
+create index idx_bad on t2 ((rand()));
+create index idx_bad on t2 ((id));
+create index idx_bad on t2 ((doc));
+create index idx_bad on t2 (doc(10));
+create table t3 (id int primary key, code varchar(20), unique key uk_code (code(3)));
+show create table t3;
+insert into t3 values (1, 'abcdef');
+insert into t3 values (2, 'abcxyz');
+insert into t3 values (3, 'abdxyz');
+select id, code from t3 where code = 'abcdef';
+select id, code from t3 order by id;
+drop database functional_index;
@@ -0,0 +1,38 @@
+drop table if exists articles;
+create table articles(id int primary key, title varchar(200), body text, fulltext key ftidx(title, body));
+insert into articles values (1, 'MySQL Tutorial', 'DBMS stands for DataBase');
+insert into articles values (2, 'How To Use MySQL Well', 'After you went through a list');
+insert into articles values (3, 'Optimizing MySQL', 'In this tutorial we show');
+insert into articles values (4, '1001 MySQL Tricks', 'Never run mysqld as root');
+insert into articles values (5, 'MySQL vs. YourSQL', 'In the following database comparison');
+insert into articles values (6, 'MySQL Security', 'When configured properly');
+select id from articles where match(title, body) against('database') order by id;
+select id, match(title, body) against('database') > 0 as matched from articles order by id;
+select id from articles where match(title, body) against('+mysql -yoursql' in boolean mode) order by id;
+select id from articles where match(body, title) against('"mysql tutorial"' in boolean mode) order by id;
+select id from articles where match(title, body) against('optim*' in boolean mode) order by id;
+select id from articles where match(title, body) against('tutorial') order by match(title, body) against('tutorial') desc, id;
+update articles set body = 'database internals' where id = 6;
+select id from articles where match(title, body) against('database') order by id;
+delete from articles where id = 1;
+select id from articles where match(title, body) against('database') order by id;
+select id from articles where match(title) against('database');
+select id from articles where match(title, body) against('database' with query expansion);
+drop table if exists t2;
+create table t2(id int primary key, content text, fulltext(content) with parser ngram);
+insert into t2 values (1, '我们都是中国人'), (2, '国家的人民'), (3, 'hello world');
+select id from t2 where match(content) against('中国人' in boolean mode);
+select id from t2 where match(content) against('hello');
+drop table if exists t3;
+create table t3(a varchar(30), b varchar(100));
+insert into t3 values ('red', 'red apple'), ('green', 'green apple pie'), ('blue', 'blue sky');
+create fulltext index ft3 on t3(b);
+insert into t3 values ('yellow', 'yellow apple');
+select a from t3 where match(b) against('apple') order by a;
+select a from t3 where match(b) against('+apple -pie' in boolean mode) order by a;
+create fulltext index ft4 on t3(a, c);
+drop table if exists t4;
+create table t4(a int primary key, b int, fulltext(b));
+drop table articles;
+drop table t2;
+drop table t3;
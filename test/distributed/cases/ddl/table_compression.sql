@@ -0,0 +1,12 @@
+drop database if exists table_compression;
+create database table_compression;
+use table_compression;
+create table t1 (id int primary key, name varchar(20)) compression = 'ZSTD' properties("compress_level" = "9", "column_encoding" = "true");
+show create table t1;
+insert into t1 select result, concat('name', result % 10) from generate_series(1, 1000) g;
+select count(*), count(distinct name) from t1;
+select id, name from t1 where id = 100;
+create table t2 (id int primary key) compression = 'zlib';
+create table t2 (id int primary key) properties("compress_level" = "high");
+create table t2 (id int primary key) properties("column_encoding" = "yes");
+drop database table_compression;
@@ -0,0 +1,32 @@
+select json_object('a', 1, 'b', 'x', 'c', null) as j;
+select json_object('b', 1, 'a', 2, 'b', 3) as j;
+select json_object('a') as j;
+select json_array(1, 'x', 1.5, true, null) as j;
+select json_array() as j;
+select json_set('{"a": 1}', '$.a', 2, '$.b', 3) as j;
+select json_insert('{"a": 1}', '$.a', 2, '$.b', 3) as j;
+select json_replace('{"a": 1}', '$.a', 2, '$.b', 3) as j;
+select json_set('[1, 2]', '$[5]', 3) as j;
+select json_set('{"a": 1}', '$.*', 2) as j;
+select json_remove('{"a": 1, "b": [1, 2]}', '$.a', '$.b[0]') as j;
+select json_remove('[1]', '$') as j;
+select json_contains('{"a": [1, 2], "b": 3}', '{"a": 2}') as c;
+select json_contains('[1, [2, 3]]', '[3, 1]') as c;
+select json_contains('{"a": [1, 2]}', '2', '$.a') as c;
+select json_contains('{"a": [1, 2]}', '2', '$.b') as c;
+select json_keys('{"b": 1, "a": {"c": 2}}') as k;
+select json_keys('{"b": 1, "a": {"c": 2}}', '$.a') as k;
+select json_keys('[1]') as k;
+select json_length('{"a": 1, "b": [1, 2, 3]}') as l;
+select json_length('{"a": 1, "b": [1, 2, 3]}', '$.b') as l;
+select json_length('{"a": 1}', '$.c') as l;
+drop database if exists json_modify;
+create database json_modify;
+use json_modify;
+create table t1 (id int, grp int, name varchar(10));
+insert into t1 values (1, 1, 'a'), (2, 1, 'b'), (3, 2, 'c'), (4, 2, null);
+select grp, json_arrayagg(name) as a from t1 group by grp order by grp;
+select grp, json_objectagg(name, id) as o from t1 where name is not null group by grp order by grp;
+select json_objectagg(name, id) as o from t1;
+select id, json_set(json_object('id', id), '$.name', name) as j from t1 order by id;
+drop database json_modify;
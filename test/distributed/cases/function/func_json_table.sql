@@ -0,0 +1,17 @@
+select * from json_table('[{"a": 1, "b": "x"}, {"a": 2, "b": "y"}]', '$[*]' columns (id for ordinality, a int path '$.a', b varchar(10) path '$.b')) as jt;
+select * from json_table('[{"a": 1}, {"b": 2}, {"a": "z"}]', '$[*]' columns (a int path '$.a' default '-1' on empty default '0' on error)) as jt;
+select * from json_table('[{"a": 1}, {"b": 2}]', '$[*]' columns (a int path '$.a' error on empty)) as jt;
+select * from json_table('[{"a": [1, 2]}]', '$[*]' columns (a int path '$.a' error on error)) as jt;
+select * from json_table('[{"a": [1, 2]}, {"b": 1}]', '$[*]' columns (a json path '$.a', b int exists path '$.b')) as jt;
+select * from json_table('{"name": "t", "tags": ["x", "y"], "dims": [{"w": 1}]}', '$' columns (name varchar(10) path '$.name', nested path '$.tags[*]' columns (tag varchar(10) path '$'), nested path '$.dims[*]' columns (n for ordinality, w int path '$.w'))) as jt;
+select * from json_table('[{"a": 1}, {"a": 2}]', '$[*]' columns (a int path '$.a', a int path '$.a')) as jt;
+drop database if exists json_table_db;
+create database json_table_db;
+use json_table_db;
+create table events (id int, payload json);
+insert into events values (1, '{"user": "u1", "items": [{"sku": "a", "qty": 2}, {"sku": "b", "qty": 1}]}');
+insert into events values (2, '{"user": "u2", "items": []}');
+insert into events values (3, null);
+select e.id, jt.* from events e, json_table(e.payload, '$' columns (usr varchar(10) path '$.user', nested path '$.items[*]' columns (sku varchar(10) path '$.sku', qty int path '$.qty'))) as jt order by e.id, jt.sku;
+select jt.sku, sum(jt.qty) from events e, json_table(e.payload, '$.items[*]' columns (sku varchar(10) path '$.sku', qty int path '$.qty')) as jt group by jt.sku order by jt.sku;
+drop database json_table_db;
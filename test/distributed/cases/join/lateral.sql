@@ -0,0 +1,18 @@
+drop database if exists lateral_db;
+create database lateral_db;
+use lateral_db;
+create table customers (id int primary key, name varchar(10));
+create table orders (oid int primary key, cid int, amount int);
+insert into customers values (1, 'a'), (2, 'b'), (3, 'c');
+insert into orders values (1, 1, 10), (2, 1, 30), (3, 1, 20), (4, 2, 5), (5, 2, 50);
+select c.name, o.oid, o.amount from customers c, lateral (select oid, amount from orders where orders.cid = c.id order by amount desc limit 2) o order by c.name, o.amount desc;
+select c.name, o.oid from customers c left join lateral (select oid from orders where orders.cid = c.id order by amount limit 1) o on true order by c.name;
+select c.name, o.amount from customers c, lateral (select amount from orders where cid = c.id order by amount limit 1 offset 1) o order by c.name;
+select c.name, o.total from customers c, lateral (select cid, sum(amount) total from orders where cid = c.id group by cid) o order by c.name;
+select c.id, g.result from customers c cross join lateral generate_series(1, c.id, 1) g order by c.id, g.result;
+select c.id, g.result from customers c left join lateral generate_series(2, c.id, 1) g on true order by c.id, g.result;
+select c.name, o.cnt from customers c, lateral (select count(*) cnt from orders where cid = c.id) o order by c.name;
+select c.name, o.cnt from customers c left join lateral (select count(*) cnt from orders where cid = c.id) o on o.cnt > 2 order by c.name;
+select c.name, o.x from customers c, lateral (select amount + c.id x from orders where cid = c.id order by x desc limit 1) o order by c.name;
+select c.name, o.oid, o.amount from customers c, lateral (select o1.oid, o2.amount from orders o1 left join orders o2 on o2.oid = o1.oid and o2.cid = c.id where o1.oid <= 2) o order by c.name, o.oid;
+drop database lateral_db;
@@ -0,0 +1,24 @@
+-- pre
+drop database if exists avro_db;
+create database avro_db;
+use avro_db;
+
+create table t1(id int, name varchar(20), flag bool, score double, price decimal(10,2), day date, ts datetime, color varchar(10), tags json, extra json);
+load data infile {'filepath'='$resources/load_data/simple.avro', 'format'='avro'} into table t1;
+select * from t1;
+
+-- only a part of the fields
+create table t2(color varchar(10), id bigint);
+load data infile {'filepath'='$resources/load_data/simple.avro', 'format'='avro'} into table t2;
+select * from t2;
+
+create table t3(id int, missing int);
+load data infile {'filepath'='$resources/load_data/simple.avro', 'format'='avro'} into table t3;
+create table t4(tags int);
+load data infile {'filepath'='$resources/load_data/simple.avro', 'format'='avro'} into table t4;
+
+create external table ext1(id int, name varchar(20), tags json) infile{'filepath'='$resources/load_data/simple.avro', 'format'='avro'};
+select * from ext1 where id >= 2;
+
+-- post
+drop database avro_db;
@@ -0,0 +1,25 @@
+-- pre
+drop database if exists orc_db;
+create database orc_db;
+use orc_db;
+
+create table t1(id bigint, name varchar(20), city char(4), flag bool, score double, price decimal(10,2), day date, ts datetime);
+load data infile {'filepath'='$resources/load_data/simple.orc', 'format'='orc'} into table t1;
+select * from t1;
+
+-- only a part of the columns
+create table t2(name varchar(20), id int);
+load data infile {'filepath'='$resources/load_data/simple.orc', 'format'='orc'} into table t2;
+select * from t2;
+
+create table t3(id bigint, missing int);
+load data infile {'filepath'='$resources/load_data/simple.orc', 'format'='orc'} into table t3;
+create table t4(name int);
+load data infile {'filepath'='$resources/load_data/simple.orc', 'format'='orc'} into table t4;
+
+create external table ext1(id int, name varchar(20), price decimal(10,2)) infile{'filepath'='$resources/load_data/simple.orc', 'format'='orc'};
+select * from ext1 where id > 3;
+select count(*) from ext1 where name is null;
+
+-- post
+drop database orc_db;
@@ -0,0 +1,36 @@
+drop database if exists select_into_format;
+create database select_into_format;
+use select_into_format;
+create table t1 (a int, b bigint, c varchar(20), d double, e datetime, f timestamp, g time);
+insert into t1 values (1, 100, 'abc', 1.5, '2024-01-02 03:04:05', '2024-01-02 03:04:05', '10:11:12');
+insert into t1 values (2, null, 'x"y,z', null, null, null, null);
+insert into t1 values (3, -3, 'mo', -2.25, '1999-12-31 23:59:59', '2000-01-01 00:00:00', '00:00:01');
+create table t2 (a int, b bigint, c varchar(20), d double, e datetime, f timestamp, g time);
+drop stage if exists fmt_stage;
+create stage fmt_stage url = 'file:///$resources/into_outfile/';
+
+-- parquet
+select * from t1 order by a into outfile 'stage://fmt_stage/fmt_t1.parquet' format parquet row_group_size 2 compression 'zstd';
+load data infile {'filepath'='$resources/into_outfile/fmt_t1.parquet', 'format'='parquet'} into table t2;
+select * from t2 order by a;
+delete from t2;
+select * from t1 order by a into outfile 'stage://fmt_stage/fmt_t1_snappy.parquet' format parquet;
+load data infile {'filepath'='$resources/into_outfile/fmt_t1_snappy.parquet', 'format'='parquet'} into table t2;
+select count(*) from t2;
+delete from t2;
+
+-- jsonline
+select * from t1 order by a into outfile 'stage://fmt_stage/fmt_t1.jl' format jsonline;
+load data infile {'filepath'='$resources/into_outfile/fmt_t1.jl', 'format'='jsonline', 'jsondata'='object'} into table t2;
+select * from t2 order by a;
+delete from t2;
+
+-- errors
+select * from t1 into outfile 'stage://fmt_stage/fmt_err.csv' compression 'zstd';
+select * from t1 into outfile 'stage://fmt_stage/fmt_err.jl' format jsonline row_group_size 10;
+select * from t1 into outfile 'stage://fmt_stage/fmt_err.xml' format xml;
+select * from t1 into outfile 'stage://fmt_stage/fmt_err.parquet' format parquet compression 'lzo';
+select a, a from t1 into outfile 'stage://fmt_stage/fmt_err.parquet' format parquet;
+
+drop stage fmt_stage;
+drop database select_into_format;
@@ -0,0 +1,47 @@
+drop database if exists sp_db;
+create database sp_db;
+use sp_db;
+create table sp_t1 (a int primary key, b int);
+insert into sp_t1 values (1, 1), (2, 2);
+
+begin;
+insert into sp_t1 values (3, 3);
+savepoint sp1;
+insert into sp_t1 values (4, 4);
+update sp_t1 set b = 10 where a = 1;
+delete from sp_t1 where a = 2;
+select * from sp_t1 order by a;
+rollback to savepoint sp1;
+select * from sp_t1 order by a;
+insert into sp_t1 values (4, 40);
+commit;
+select * from sp_t1 order by a;
+
+begin;
+savepoint sp1;
+insert into sp_t1 values (5, 5);
+savepoint sp2;
+insert into sp_t1 values (6, 6);
+release savepoint sp2;
+rollback to sp2;
+rollback work to savepoint sp1;
+select * from sp_t1 order by a;
+insert into sp_t1 values (7, 7);
+savepoint SP1;
+delete from sp_t1 where a = 7;
+rollback to savepoint sp1;
+select * from sp_t1 order by a;
+release savepoint sp1;
+rollback to savepoint sp1;
+commit;
+select * from sp_t1 order by a;
+
+begin;
+insert into sp_t1 values (8, 8);
+savepoint sp1;
+insert into sp_t1 values (9, 9);
+rollback;
+select * from sp_t1 order by a;
+
+rollback to savepoint sp1;
+drop database sp_db;
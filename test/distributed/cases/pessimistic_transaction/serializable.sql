@@ -0,0 +1,44 @@
+drop database if exists ssi_db;
+create database ssi_db;
+use ssi_db;
+create table ssi_t1 (id int primary key, on_call int);
+insert into ssi_t1 values (1, 1), (2, 1);
+
+set session transaction isolation level serializable;
+select @@transaction_isolation;
+select @@tx_isolation;
+
+-- write skew: both txns read the doctors on call and each takes one off.
+begin;
+select count(*) from ssi_t1 where on_call = 1;
+update ssi_t1 set on_call = 0 where id = 1;
+-- @session:id=1{
+use ssi_db;
+set session transaction isolation level serializable;
+begin;
+select count(*) from ssi_t1 where on_call = 1;
+update ssi_t1 set on_call = 0 where id = 2;
+commit;
+-- @session}
+commit;
+select * from ssi_t1 order by id;
+
+-- the point reads on the primary key do not conflict with the writes of
+-- the other keys.
+update ssi_t1 set on_call = 1;
+begin;
+select * from ssi_t1 where id = 1;
+update ssi_t1 set on_call = 2 where id = 1;
+-- @session:id=1{
+begin;
+select * from ssi_t1 where id = 2;
+update ssi_t1 set on_call = 2 where id = 2;
+commit;
+-- @session}
+commit;
+select * from ssi_t1 order by id;
+
+set transaction_isolation = 'REPEATABLE-READ';
+set tx_isolation = 'REPEATABLE-READ';
+select @@transaction_isolation;
+drop database ssi_db;
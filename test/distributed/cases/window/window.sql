@@ -1439,5 +1439,18 @@ select col2, col1, sum(col1) over (partition by col2 order by col1 desc) from wi
 select col2, col1, min(col1) over (partition by col2 order by col1 desc) from window01;
 select col2, col1, max(col1) over (partition by col2 order by col1 desc) from window01;
 select col2, col1, count(col1) over (partition by col2 order by col1 desc) from window01;
+drop table if exists wf_value;
+create table wf_value(a int, b int, c varchar(10));
+insert into wf_value values (1, 1, 'a'), (1, 2, 'b'), (1, 2, 'c'), (1, 4, 'd'), (1, 5, 'e'), (2, 1, 'x'), (2, 3, null);
+select a, b, percent_rank() over (partition by a order by b) as pr from wf_value order by a, b;
+select a, b, cume_dist() over (partition by a order by b) as cd from wf_value order by a, b;
+select a, c, ntile(2) over (partition by a order by b, c) as n from wf_value order by a, c;
+select a, c, lag(c) over (partition by a order by b, c) as l from wf_value order by a, b, c;
+select a, c, lead(c, 2, 'z') over (partition by a order by b, c) as l from wf_value order by a, b, c;
+select a, c, first_value(c) over (partition by a order by b, c rows between 1 preceding and current row) as f from wf_value order by a, b, c;
+select a, c, last_value(c) over (partition by a order by b, c rows between current row and 1 following) as l from wf_value order by a, b, c;
+select a, c, nth_value(c, 2) over (partition by a order by b, c rows between unbounded preceding and current row) as n from wf_value order by a, b, c;
+select a, c, ntile(0) over (partition by a order by c) as n from wf_value;
+drop table wf_value;
 drop database test;
 
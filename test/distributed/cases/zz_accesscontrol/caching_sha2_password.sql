@@ -0,0 +1,9 @@
+drop user if exists sha2_user1, sha2_user2;
+create user sha2_user1 identified with caching_sha2_password by '111', sha2_user2 identified with mysql_native_password by '111';
+select user_name, length(authentication_string), left(authentication_string, 7) from mo_catalog.mo_user where user_name like 'sha2_user%' order by user_name;
+alter user sha2_user2 identified with caching_sha2_password by '222';
+select user_name, length(authentication_string), left(authentication_string, 7) from mo_catalog.mo_user where user_name like 'sha2_user%' order by user_name;
+alter user sha2_user1 identified by '111';
+select user_name, authentication_string from mo_catalog.mo_user where user_name = 'sha2_user1';
+create user sha2_user3 identified with sha256_password by '111';
+drop user if exists sha2_user1, sha2_user2;